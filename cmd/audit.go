@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/metal-toolbox/governor-api/internal/auditchain"
+)
+
+// auditCmd groups the audit log commands
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "manages the signed checkpoints of the audit log",
+}
+
+// auditVerifyCmd verifies the audit log against its signed checkpoints
+var auditVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "verifies the audit events against the signed checkpoints",
+	Long: `Verify recomputes the hash chain of the audit events and checks it against
+every signed checkpoint. It prints the verification report and exits with an
+error if an audit event covered by a checkpoint was modified, removed or inserted.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return auditVerify(cmd)
+	},
+}
+
+// auditCheckpointCmd creates a signed checkpoint of the audit log
+var auditCheckpointCmd = &cobra.Command{
+	Use:   "checkpoint",
+	Short: "creates a signed checkpoint of the audit events since the last checkpoint",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return auditCheckpoint(cmd)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(auditCmd)
+	auditCmd.AddCommand(auditVerifyCmd)
+	auditCmd.AddCommand(auditCheckpointCmd)
+
+	auditVerifyCmd.Flags().String("public-key", "", "path to the PEM encoded ed25519 public key verifying the checkpoints, defaults to the public key of the audit signing key")
+	viperBindFlag("audit.public-key", auditVerifyCmd.Flags().Lookup("public-key"))
+}
+
+func auditVerify(cmd *cobra.Command) error {
+	var opt auditchain.Option
+
+	switch {
+	case viper.GetString("audit.public-key") != "":
+		pub, err := auditchain.LoadPublicKey(viper.GetString("audit.public-key"))
+		if err != nil {
+			return err
+		}
+
+		opt = auditchain.WithPublicKey(pub)
+	case viper.GetString("audit.signing-key") != "":
+		key, err := auditchain.LoadSigningKey(viper.GetString("audit.signing-key"))
+		if err != nil {
+			return err
+		}
+
+		opt = auditchain.WithPublicKey(key.Public().(ed25519.PublicKey))
+	default:
+		return auditchain.ErrNoSigningKey
+	}
+
+	db := initTracingAndDB()
+	defer db.Close()
+
+	chain := auditchain.New(db, opt, auditchain.WithLogger(logger.Desugar()))
+
+	report, err := chain.Verify(cmd.Context(), func(processed, total int64) error {
+		logger.Debugw("verifying audit log", "processed", processed, "total", total)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+
+	if !report.Verified {
+		return ErrAuditVerificationFailed
+	}
+
+	return nil
+}
+
+func auditCheckpoint(cmd *cobra.Command) error {
+	if viper.GetString("audit.signing-key") == "" {
+		return auditchain.ErrNoSigningKey
+	}
+
+	key, err := auditchain.LoadSigningKey(viper.GetString("audit.signing-key"))
+	if err != nil {
+		return err
+	}
+
+	db := initTracingAndDB()
+	defer db.Close()
+
+	chain := auditchain.New(db, auditchain.WithSigningKey(key), auditchain.WithLogger(logger.Desugar()))
+
+	cp, err := chain.Checkpoint(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	if cp == nil {
+		logger.Info("no new audit events to checkpoint")
+	}
+
+	return nil
+}
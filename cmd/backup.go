@@ -0,0 +1,236 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/metal-toolbox/governor-api/internal/backup"
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+)
+
+// backupCmd groups the backup commands
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "exports and restores logical backups of the governor entities",
+}
+
+// backupCreateCmd exports a backup of the governor entities
+var backupCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "exports a consistent backup of the governor entities",
+	Long: `Create exports a consistent backup of the governor entities, without the
+secrets and the audit log. The backup is written to the --output file, or to
+stdout with "-", otherwise it is stored in the --backup-dir.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return backupCreate(cmd)
+	},
+}
+
+// backupListCmd lists the backups of the backup directory
+var backupListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "lists the backups in the --backup-dir",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return backupList(cmd)
+	},
+}
+
+// backupRestoreCmd restores a backup into an empty database
+var backupRestoreCmd = &cobra.Command{
+	Use:   "restore [name]",
+	Short: "restores a backup into an empty database",
+	Long: `Restore restores the backup with the name in the --backup-dir, or the --input
+file, into an empty database migrated to the schema version of the backup. It
+prints the comparison of the backup with the database, a dry run only prints it.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return backupRestore(cmd, args)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(backupCmd)
+	backupCmd.AddCommand(backupCreateCmd)
+	backupCmd.AddCommand(backupListCmd)
+	backupCmd.AddCommand(backupRestoreCmd)
+
+	backupCreateCmd.Flags().StringP("output", "o", "", "file to write the backup to, - for stdout, the backup is stored in the --backup-dir when empty")
+	backupRestoreCmd.Flags().StringP("input", "i", "", "file to read the backup from, - for stdin")
+	backupRestoreCmd.Flags().Bool("dry-run", false, "only compare the backup with the database")
+}
+
+// backupStore returns the store of the --backup-dir
+func backupStore() (backup.Store, error) {
+	dir := viper.GetString("backup.dir")
+	if dir == "" {
+		return nil, ErrMissingBackupDir
+	}
+
+	return backup.NewDirStore(dir), nil
+}
+
+func backupCreate(cmd *cobra.Command) error {
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return err
+	}
+
+	db := initTracingAndDB()
+	defer db.Close()
+
+	ctx := cmd.Context()
+
+	b, err := backup.New(db.DB, backup.WithLogger(logger.Desugar())).Export(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch output {
+	case "":
+		store, err := backupStore()
+		if err != nil {
+			return err
+		}
+
+		if err := store.Put(ctx, b); err != nil {
+			return err
+		}
+	case "-":
+		if err := json.NewEncoder(os.Stdout).Encode(b); err != nil {
+			return err
+		}
+	default:
+		f, err := os.OpenFile(output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err != nil {
+			return err
+		}
+
+		if err := json.NewEncoder(f).Encode(b); err != nil {
+			f.Close()
+			return err
+		}
+
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := dbtools.AuditBackupCreated(ctx, db, "", nil, b.Name(), b.SchemaVersion); err != nil {
+		return err
+	}
+
+	logger.Infow("created backup", "name", b.Name(), "schema_version", b.SchemaVersion, "records", b.Records())
+
+	return nil
+}
+
+func backupList(cmd *cobra.Command) error {
+	store, err := backupStore()
+	if err != nil {
+		return err
+	}
+
+	names, err := store.List(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		fmt.Fprintln(os.Stdout, name)
+	}
+
+	return nil
+}
+
+// readBackup reads the backup of the --input file, or the backup with the
+// name in the --backup-dir
+func readBackup(ctx context.Context, input string, args []string) (*backup.Backup, error) {
+	if input == "" {
+		if len(args) == 0 {
+			return nil, ErrMissingBackup
+		}
+
+		store, err := backupStore()
+		if err != nil {
+			return nil, err
+		}
+
+		return store.Get(ctx, args[0])
+	}
+
+	var r io.Reader = os.Stdin
+
+	if input != "-" {
+		f, err := os.Open(input)
+		if err != nil {
+			return nil, err
+		}
+
+		defer f.Close()
+
+		r = f
+	}
+
+	b := &backup.Backup{}
+	if err := json.NewDecoder(r).Decode(b); err != nil {
+		return nil, fmt.Errorf("error reading backup: %w", err)
+	}
+
+	return b, nil
+}
+
+func backupRestore(cmd *cobra.Command, args []string) error {
+	input, err := cmd.Flags().GetString("input")
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+
+	b, err := readBackup(ctx, input, args)
+	if err != nil {
+		return err
+	}
+
+	db := initTracingAndDB()
+	defer db.Close()
+
+	// the restored extension resources record their first version
+	dbtools.RegisterHooks()
+
+	m := backup.New(db.DB, backup.WithLogger(logger.Desugar()))
+
+	var diff *backup.Diff
+
+	if dryRun {
+		diff, err = m.Diff(ctx, b)
+	} else {
+		diff, err = m.Restore(ctx, b, func(ctx context.Context, tx *sql.Tx, _ *backup.Diff) error {
+			_, err := dbtools.AuditBackupRestored(ctx, tx, "", nil, b.Name(), b.SchemaVersion)
+			return err
+		})
+	}
+
+	if diff != nil {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		if err := enc.Encode(diff); err != nil {
+			return err
+		}
+	}
+
+	return err
+}
@@ -2,5 +2,19 @@ package cmd
 
 import "errors"
 
-// ErrMissingNATSCreds is returned when nats creds are not provided
-var ErrMissingNATSCreds = errors.New("nats creds are required")
+var (
+	// ErrMissingNATSCreds is returned when nats creds are not provided
+	ErrMissingNATSCreds = errors.New("nats creds are required")
+	// ErrMissingEventBackend is returned when neither nats nor the postgres notifications are enabled
+	ErrMissingEventBackend = errors.New("nats or the postgres notifications must be enabled")
+	// ErrAuditVerificationFailed is returned when the audit log doesn't match its checkpoints
+	ErrAuditVerificationFailed = errors.New("audit log verification failed")
+	// ErrMissingLDAPBindPassword is returned when the ldap gateway is enabled without a bind password
+	ErrMissingLDAPBindPassword = errors.New("ldap bind password file is required")
+	// ErrInvalidSchemaSkewAction is returned when the schema skew action is unknown
+	ErrInvalidSchemaSkewAction = errors.New("schema skew action must be refuse or read-only")
+	// ErrMissingBackupDir is returned when a backup command needs the backup directory and it isn't set
+	ErrMissingBackupDir = errors.New("backup dir is required")
+	// ErrMissingBackup is returned when restoring without a backup name or input file
+	ErrMissingBackup = errors.New("a backup name or an input file is required")
+)
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"crypto/tls"
+
+	"github.com/spf13/viper"
+
+	"github.com/metal-toolbox/governor-api/internal/auth"
+	"github.com/metal-toolbox/governor-api/internal/grpcapi"
+)
+
+// newGRPCServer returns the grpc api listening on listen, verifying the tokens
+// of the trusted issuers
+func newGRPCServer(listen string, issuers []auth.TrustedIssuer) (*grpcapi.Server, error) {
+	verifiers, err := auth.NewVerifiers(issuers)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &grpcapi.Server{
+		AdminGroups: viper.GetStringSlice("admin-groups"),
+		Listen:      listen,
+		Logger:      logger.Desugar(),
+		Verifiers:   verifiers,
+
+		ShutdownTimeout: viper.GetDuration("api.shutdown.timeout"),
+	}
+
+	if cert := viper.GetString("grpc.tls.cert"); cert != "" {
+		keyPair, err := tls.LoadX509KeyPair(cert, viper.GetString("grpc.tls.key"))
+		if err != nil {
+			return nil, err
+		}
+
+		s.TLSConfig = &tls.Config{
+			Certificates: []tls.Certificate{keyPair},
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
+
+	return s, nil
+}
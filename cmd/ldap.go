@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"crypto/tls"
+	"os"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/spf13/viper"
+
+	"github.com/metal-toolbox/governor-api/internal/ldapgw"
+)
+
+// newLDAPServer returns the read-only ldap gateway listening on listen
+func newLDAPServer(listen string, db *sqlx.DB) (*ldapgw.Server, error) {
+	passwordFile := viper.GetString("ldap.bind-password-file")
+	if passwordFile == "" {
+		return nil, ErrMissingLDAPBindPassword
+	}
+
+	password, err := os.ReadFile(passwordFile)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &ldapgw.Server{
+		BindDN:       viper.GetString("ldap.bind-dn"),
+		BindPassword: strings.TrimSpace(string(password)),
+		Listen:       listen,
+		Load:         ldapgw.DBLoader(db, viper.GetString("ldap.base-dn")),
+		Logger:       logger.Desugar(),
+
+		ShutdownTimeout: viper.GetDuration("api.shutdown.timeout"),
+	}
+
+	if cert := viper.GetString("ldap.tls.cert"); cert != "" {
+		keyPair, err := tls.LoadX509KeyPair(cert, viper.GetString("ldap.tls.key"))
+		if err != nil {
+			return nil, err
+		}
+
+		s.TLSConfig = &tls.Config{
+			Certificates: []tls.Certificate{keyPair},
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
+
+	return s, nil
+}
@@ -5,9 +5,14 @@ import (
 	"github.com/spf13/viper"
 )
 
+// newNATSConnection connects to nats, the returned close function drains the
+// connection so the pending messages are flushed before it is closed
 func newNATSConnection(v *viper.Viper) (*nats.Conn, func(), error) {
+	closed := make(chan struct{})
+
 	opts := []nats.Option{
 		nats.Name(appName),
+		nats.ClosedHandler(func(_ *nats.Conn) { close(closed) }),
 	}
 
 	if credsFile := v.GetString("nats.creds-file"); credsFile != "" {
@@ -21,5 +26,14 @@ func newNATSConnection(v *viper.Viper) (*nats.Conn, func(), error) {
 		return nil, nil, err
 	}
 
-	return nc, nc.Close, nil
+	drain := func() {
+		if err := nc.Drain(); err != nil {
+			nc.Close()
+			return
+		}
+
+		<-closed
+	}
+
+	return nc, drain, nil
 }
@@ -3,6 +3,7 @@ package cmd
 
 import (
 	"strings"
+	"time"
 
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/spf13/cobra"
@@ -45,9 +46,18 @@ func init() {
 	rootCmd.PersistentFlags().String("db-uri", "postgresql://root@localhost:26257/governor?sslmode=disable", "URI for database connection")
 	viperBindFlag("db.uri", rootCmd.PersistentFlags().Lookup("db-uri"))
 
+	rootCmd.PersistentFlags().Duration("db-slow-query-threshold", 500*time.Millisecond, "duration above which a SQL statement is logged, without its parameters, 0 disables the slow query log") //nolint:mnd
+	viperBindFlag("db.slow-query-threshold", rootCmd.PersistentFlags().Lookup("db-slow-query-threshold"))
+
 	rootCmd.PersistentFlags().String("audit-log-path", "/app-audit/audit.log", "file path to write audit logs to.")
 	viperBindFlag("audit.log-path", rootCmd.PersistentFlags().Lookup("audit-log-path"))
 
+	rootCmd.PersistentFlags().String("audit-signing-key", "", "path to the PEM encoded ed25519 private key signing the audit log checkpoints, audit signing is disabled when empty")
+	viperBindFlag("audit.signing-key", rootCmd.PersistentFlags().Lookup("audit-signing-key"))
+
+	rootCmd.PersistentFlags().String("backup-dir", "", "directory storing the backups of the governor entities, like a mounted object storage bucket, the backup endpoints are disabled when empty")
+	viperBindFlag("backup.dir", rootCmd.PersistentFlags().Lookup("backup-dir"))
+
 	rootCmd.PersistentFlags().Bool("development", false, "enable development settings")
 	viperBindFlag("development", rootCmd.PersistentFlags().Lookup("development"))
 
@@ -60,6 +70,15 @@ func init() {
 
 	rootCmd.PersistentFlags().String("nats-subject-prefix", "governor.events", "prefix for NATS subjects")
 	viperBindFlag("nats.subject-prefix", rootCmd.PersistentFlags().Lookup("nats-subject-prefix"))
+
+	rootCmd.PersistentFlags().Bool("nats-v2-events", true, "publish the v2 events alongside the v1alpha1 events")
+	viperBindFlag("nats.v2-events", rootCmd.PersistentFlags().Lookup("nats-v2-events"))
+
+	rootCmd.PersistentFlags().StringSlice("nats-muted-subjects", []string{}, "event subjects that are not published, unless unmuted through the api")
+	viperBindFlag("nats.muted-subjects", rootCmd.PersistentFlags().Lookup("nats-muted-subjects"))
+
+	rootCmd.PersistentFlags().String("nats-extension-subject-prefix", "", "subject prefix of the extension resource events of extensions without their own prefix")
+	viperBindFlag("nats.extension-subject-prefix", rootCmd.PersistentFlags().Lookup("nats-extension-subject-prefix"))
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -1,18 +1,44 @@
 package cmd
 
 import (
+	"context"
+	"crypto/ed25519"
+	"database/sql"
 	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/metal-toolbox/auditevent"
 	audithelpers "github.com/metal-toolbox/auditevent/helpers"
+	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.hollow.sh/toolbox/ginjwt"
 
+	dbm "github.com/metal-toolbox/governor-api/db"
 	"github.com/metal-toolbox/governor-api/internal/api"
+	"github.com/metal-toolbox/governor-api/internal/auditchain"
+	"github.com/metal-toolbox/governor-api/internal/auth"
+	"github.com/metal-toolbox/governor-api/internal/backup"
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/emailverify"
 	"github.com/metal-toolbox/governor-api/internal/eventbus"
+	"github.com/metal-toolbox/governor-api/internal/eventrules"
+	"github.com/metal-toolbox/governor-api/internal/featureflags"
+	"github.com/metal-toolbox/governor-api/internal/jobs"
+	"github.com/metal-toolbox/governor-api/internal/outbox"
+	"github.com/metal-toolbox/governor-api/internal/respcache"
+	"github.com/metal-toolbox/governor-api/internal/schemaversion"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+	v1alpha "github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+	eventsv2 "github.com/metal-toolbox/governor-api/pkg/events/v2"
 )
 
 // serveCmd invokes the governor api
@@ -33,6 +59,158 @@ func init() {
 	serveCmd.Flags().StringSlice("admin-groups", []string{"delivery-engineering"}, "The slug of the groups that have admin functions")
 	viperBindFlag("admin-groups", serveCmd.Flags().Lookup("admin-groups"))
 
+	serveCmd.Flags().Duration("response-cache-ttl", 0, "how long hot read responses are cached for, 0 disables the response cache")
+	viperBindFlag("api.response-cache-ttl", serveCmd.Flags().Lookup("response-cache-ttl"))
+
+	serveCmd.Flags().Duration("shutdown-drain-delay", 5*time.Second, "how long the readiness check reports the server as draining before it stops accepting requests") //nolint:mnd
+	viperBindFlag("api.shutdown.drain-delay", serveCmd.Flags().Lookup("shutdown-drain-delay"))
+
+	serveCmd.Flags().Duration("shutdown-timeout", 30*time.Second, "how long the in-flight requests are given to finish on shutdown") //nolint:mnd
+	viperBindFlag("api.shutdown.timeout", serveCmd.Flags().Lookup("shutdown-timeout"))
+
+	serveCmd.Flags().Float64("access-log-sample-rate", 1, "fraction of the successful requests written to the access log, failed and slow requests are always written")
+	viperBindFlag("api.access-log.sample-rate", serveCmd.Flags().Lookup("access-log-sample-rate"))
+
+	serveCmd.Flags().Duration("access-log-slow-threshold", time.Second, "latency above which a request is always written to the access log")
+	viperBindFlag("api.access-log.slow-threshold", serveCmd.Flags().Lookup("access-log-slow-threshold"))
+
+	serveCmd.Flags().StringSlice("trusted-proxies", []string{}, "addresses or CIDR ranges of the proxies trusted to set the client address with X-Forwarded-For")
+	viperBindFlag("api.trusted-proxies", serveCmd.Flags().Lookup("trusted-proxies"))
+
+	serveCmd.Flags().Int("job-workers", 2, "number of background jobs run at the same time, 0 disables running jobs on this instance") //nolint:mnd
+	viperBindFlag("jobs.workers", serveCmd.Flags().Lookup("job-workers"))
+
+	serveCmd.Flags().Int("nats-publish-attempts", 3, "how many times publishing an event is attempted before it is stored in the outbox") //nolint:mnd
+	viperBindFlag("nats.publish-attempts", serveCmd.Flags().Lookup("nats-publish-attempts"))
+
+	serveCmd.Flags().Int("nats-circuit-breaker-threshold", 5, "consecutive publishing failures after which publishing stops being attempted for the cooldown, 0 disables the circuit breaker") //nolint:mnd
+	viperBindFlag("nats.circuit-breaker.threshold", serveCmd.Flags().Lookup("nats-circuit-breaker-threshold"))
+
+	serveCmd.Flags().Duration("nats-circuit-breaker-cooldown", 30*time.Second, "how long publishing isn't attempted once the circuit breaker opens") //nolint:mnd
+	viperBindFlag("nats.circuit-breaker.cooldown", serveCmd.Flags().Lookup("nats-circuit-breaker-cooldown"))
+
+	serveCmd.Flags().Bool("nats-enabled", true, "publish the events on nats, small deployments can disable it and only use the postgres notifications")
+	viperBindFlag("nats.enabled", serveCmd.Flags().Lookup("nats-enabled"))
+
+	serveCmd.Flags().String("nats-shadow-subject-prefix", "", "subject prefix a sample of the events is also published under, like governor.events-canary, to validate new consumers against live events")
+	viperBindFlag("nats.shadow.subject-prefix", serveCmd.Flags().Lookup("nats-shadow-subject-prefix"))
+
+	serveCmd.Flags().Float64("nats-shadow-sample-rate", 0, "fraction of the events also published under the shadow subject prefix, 0 disables the shadow events")
+	viperBindFlag("nats.shadow.sample-rate", serveCmd.Flags().Lookup("nats-shadow-sample-rate"))
+
+	serveCmd.Flags().Bool("pg-notify", false, "also publish the events as postgres notifications on a channel per subject, postgres only")
+	viperBindFlag("events.pg-notify", serveCmd.Flags().Lookup("pg-notify"))
+
+	serveCmd.Flags().Bool("event-outbox", true, "store the events that can't be published in the database outbox and publish them later")
+	viperBindFlag("nats.outbox.enabled", serveCmd.Flags().Lookup("event-outbox"))
+
+	serveCmd.Flags().Duration("event-outbox-interval", 30*time.Second, "how often the events stored in the outbox are published") //nolint:mnd
+	viperBindFlag("nats.outbox.interval", serveCmd.Flags().Lookup("event-outbox-interval"))
+
+	serveCmd.Flags().Duration("audit-checkpoint-interval", time.Hour, "how often a signed checkpoint of the audit log is created when audit signing is enabled")
+	viperBindFlag("audit.checkpoint-interval", serveCmd.Flags().Lookup("audit-checkpoint-interval"))
+
+	serveCmd.Flags().Duration("application-link-reaper-interval", time.Minute, "how often the expired group application links are removed, 0 disables the reaper")
+	viperBindFlag("api.application-link-reaper.interval", serveCmd.Flags().Lookup("application-link-reaper-interval"))
+	serveCmd.Flags().Duration("scheduled-membership-change-interval", time.Minute, "how often the due scheduled membership changes are applied, 0 disables them")
+	viperBindFlag("api.scheduled-membership-changes.interval", serveCmd.Flags().Lookup("scheduled-membership-change-interval"))
+
+	serveCmd.Flags().Duration("notification-failover-interval", 30*time.Second, "how often the undelivered notification dispatches fail over to their next target, 0 disables the failover") //nolint:mnd
+	viperBindFlag("api.notification-failover.interval", serveCmd.Flags().Lookup("notification-failover-interval"))
+	serveCmd.Flags().Duration("notification-broadcast-interval", 100*time.Millisecond, "how long a notification broadcast waits between two recipients") //nolint:mnd
+	viperBindFlag("api.notification-broadcast.interval", serveCmd.Flags().Lookup("notification-broadcast-interval"))
+	serveCmd.Flags().Duration("notification-broadcast-cooldown", 5*time.Minute, "how long an admin waits between two notification broadcasts, 0 disables the cooldown") //nolint:mnd
+	viperBindFlag("api.notification-broadcast.cooldown", serveCmd.Flags().Lookup("notification-broadcast-cooldown"))
+	serveCmd.Flags().Duration("admin-promotion-request-cooldown", 7*24*time.Hour, "how long a user waits before requesting an admin promotion again after a denial, 0 disables the cooldown") //nolint:mnd
+	viperBindFlag("api.admin-promotion-request.cooldown", serveCmd.Flags().Lookup("admin-promotion-request-cooldown"))
+	serveCmd.Flags().String("break-glass-responders-group", "", "group whose members can grant themselves break-glass access to the approved groups, empty disables break-glass access")
+	viperBindFlag("api.break-glass.responders-group", serveCmd.Flags().Lookup("break-glass-responders-group"))
+	serveCmd.Flags().String("break-glass-security-group", "", "group whose members are notified of the break-glass accesses")
+	viperBindFlag("api.break-glass.security-group", serveCmd.Flags().Lookup("break-glass-security-group"))
+	serveCmd.Flags().String("break-glass-notification-type", "break-glass", "notification type of the break-glass notifications")
+	viperBindFlag("api.break-glass.notification-type", serveCmd.Flags().Lookup("break-glass-notification-type"))
+	serveCmd.Flags().Duration("purge-retention", 30*24*time.Hour, "how long soft deleted records are kept before they can be purged") //nolint:mnd
+	viperBindFlag("api.purge-retention", serveCmd.Flags().Lookup("purge-retention"))
+	serveCmd.Flags().Duration("tombstone-retention", 30*24*time.Hour, "how long the tombstones of the removed relationships are kept for the differential sync, 0 keeps them forever") //nolint:mnd
+	viperBindFlag("api.tombstones.retention", serveCmd.Flags().Lookup("tombstone-retention"))
+	serveCmd.Flags().Duration("tombstone-reaper-interval", time.Hour, "how often the tombstones past their retention are purged, 0 disables the reaper")
+	viperBindFlag("api.tombstones.reaper-interval", serveCmd.Flags().Lookup("tombstone-reaper-interval"))
+
+	serveCmd.Flags().Bool("tenancy", false, "scope every request to the organization in the org claim of its token")
+	viperBindFlag("api.tenancy.enabled", serveCmd.Flags().Lookup("tenancy"))
+
+	serveCmd.Flags().String("tenancy-claim", "org", "token claim holding the id or slug of the organization a request is scoped to")
+	viperBindFlag("api.tenancy.claim", serveCmd.Flags().Lookup("tenancy-claim"))
+
+	serveCmd.Flags().StringSlice("tenancy-global-subjects", []string{}, "token subjects that aren't scoped to an organization, usually the service accounts of the addons")
+	viperBindFlag("api.tenancy.global-subjects", serveCmd.Flags().Lookup("tenancy-global-subjects"))
+
+	serveCmd.Flags().Duration("extension-token-ttl", time.Hour, "how long the tokens issued to the extensions with their client credentials are valid")
+	viperBindFlag("api.extension-token-ttl", serveCmd.Flags().Lookup("extension-token-ttl"))
+	serveCmd.Flags().Duration("extension-credential-max-age", 90*24*time.Hour, "how long the extension client credentials are valid after they are issued or rotated, 0 disables the expiration") //nolint:mnd
+	viperBindFlag("api.extension-credential.max-age", serveCmd.Flags().Lookup("extension-credential-max-age"))
+	serveCmd.Flags().Duration("extension-credential-rotation-grace", 24*time.Hour, "how long the previous secret of rotated extension client credentials keeps working") //nolint:mnd
+	viperBindFlag("api.extension-credential.rotation-grace", serveCmd.Flags().Lookup("extension-credential-rotation-grace"))
+	serveCmd.Flags().Duration("session-token-ttl", 15*time.Minute, "how long the session tokens the UI exchanges for the user tokens are valid") //nolint:mnd
+	viperBindFlag("api.session-token-ttl", serveCmd.Flags().Lookup("session-token-ttl"))
+
+	serveCmd.Flags().Bool("read-only", false, "put the api in read-only maintenance mode, the mutating requests are rejected whatever the read-only feature flag is")
+	viperBindFlag("api.read-only", serveCmd.Flags().Lookup("read-only"))
+
+	serveCmd.Flags().Bool("db-migrate", true, "run the embedded database migrations on startup")
+	viperBindFlag("db.migrate", serveCmd.Flags().Lookup("db-migrate"))
+
+	serveCmd.Flags().Int64("schema-max-skew", 0, "how many versions the database schema can be ahead of or behind the migrations of this binary")
+	viperBindFlag("db.schema.max-skew", serveCmd.Flags().Lookup("schema-max-skew"))
+
+	serveCmd.Flags().String("schema-skew-action", schemaversion.ActionRefuse, "what to do on startup when the schema version skew is exceeded, refuse to start or start in read-only mode (refuse, read-only)")
+	viperBindFlag("db.schema.skew-action", serveCmd.Flags().Lookup("schema-skew-action"))
+
+	serveCmd.Flags().String("okta-event-hook-secret-file", "", "path to the file holding the shared secret of the okta event hooks, the okta event hooks are disabled when empty")
+	viperBindFlag("api.okta.event-hook-secret-file", serveCmd.Flags().Lookup("okta-event-hook-secret-file"))
+
+	serveCmd.Flags().Duration("avatar-cache-ttl", time.Hour, "how long the proxied user avatars are cached")
+	viperBindFlag("api.avatar.cache-ttl", serveCmd.Flags().Lookup("avatar-cache-ttl"))
+
+	serveCmd.Flags().String("email-verification-secret-file", "", "path to the file holding the secret signing the email verification tokens, email changes are applied without verification when empty")
+	viperBindFlag("api.email-verification.secret-file", serveCmd.Flags().Lookup("email-verification-secret-file"))
+
+	serveCmd.Flags().Duration("email-verification-ttl", emailverify.DefaultTTL, "how long the email verification tokens are valid")
+	viperBindFlag("api.email-verification.ttl", serveCmd.Flags().Lookup("email-verification-ttl"))
+
+	serveCmd.Flags().StringSlice("user-match-order", dbtools.DefaultUserMatcher().Order(), "identifiers matching inbound user records to existing users, in order (external_id, email, github_id)")
+	viperBindFlag("api.user-match.order", serveCmd.Flags().Lookup("user-match-order"))
+
+	serveCmd.Flags().Bool("user-match-email-case-sensitive", false, "compare emails case sensitively when matching inbound user records to existing users")
+	viperBindFlag("api.user-match.email-case-sensitive", serveCmd.Flags().Lookup("user-match-email-case-sensitive"))
+
+	serveCmd.Flags().String("grpc-listen", "", "address for the grpc api to listen on, the grpc api is disabled when empty")
+	viperBindFlag("grpc.listen", serveCmd.Flags().Lookup("grpc-listen"))
+
+	serveCmd.Flags().String("grpc-tls-cert", "", "path to the certificate of the grpc api, it only accepts tls connections when set")
+	viperBindFlag("grpc.tls.cert", serveCmd.Flags().Lookup("grpc-tls-cert"))
+
+	serveCmd.Flags().String("grpc-tls-key", "", "path to the private key of the grpc api certificate")
+	viperBindFlag("grpc.tls.key", serveCmd.Flags().Lookup("grpc-tls-key"))
+
+	serveCmd.Flags().String("ldap-listen", "", "address for the read-only ldap gateway to listen on, the ldap gateway is disabled when empty")
+	viperBindFlag("ldap.listen", serveCmd.Flags().Lookup("ldap-listen"))
+
+	serveCmd.Flags().String("ldap-base-dn", "dc=governor", "base dn of the entries served by the ldap gateway")
+	viperBindFlag("ldap.base-dn", serveCmd.Flags().Lookup("ldap-base-dn"))
+
+	serveCmd.Flags().String("ldap-bind-dn", "", "dn the ldap gateway clients bind with")
+	viperBindFlag("ldap.bind-dn", serveCmd.Flags().Lookup("ldap-bind-dn"))
+
+	serveCmd.Flags().String("ldap-bind-password-file", "", "path to the file holding the password the ldap gateway clients bind with")
+	viperBindFlag("ldap.bind-password-file", serveCmd.Flags().Lookup("ldap-bind-password-file"))
+
+	serveCmd.Flags().String("ldap-tls-cert", "", "path to the certificate of the ldap gateway, it only accepts ldaps connections when set")
+	viperBindFlag("ldap.tls.cert", serveCmd.Flags().Lookup("ldap-tls-cert"))
+
+	serveCmd.Flags().String("ldap-tls-key", "", "path to the private key of the ldap gateway certificate")
+	viperBindFlag("ldap.tls.key", serveCmd.Flags().Lookup("ldap-tls-key"))
+
 	ginjwt.RegisterViperOIDCFlags(viper.GetViper(), serveCmd)
 }
 
@@ -43,8 +221,15 @@ func startAPI() error {
 
 	dbtools.RegisterHooks()
 
-	// Run the embedded migration in the event that this is the first run or first run since a new migration was added.
-	RunMigration(db.DB)
+	if viper.GetBool("db.migrate") {
+		// Run the embedded migration in the event that this is the first run or first run since a new migration was added.
+		RunMigration(db.DB)
+	}
+
+	schema, readOnly, err := checkSchemaVersion(db.DB)
+	if err != nil {
+		return err
+	}
 
 	// NOTE: oidc config only works when loading from config file, not env variables,
 	// since GetAuthConfigsFromFlags expects a slice of oidc structs
@@ -61,6 +246,18 @@ func startAPI() error {
 
 	logger.Debugf("loaded %d oidc config(s)", len(authcfgs))
 
+	// the governor options of the oidc configs, like the extra audiences and the scope mappings
+	issuerOpts := []auth.IssuerOptions{}
+	if err := viper.UnmarshalKey("oidc", &issuerOpts); err != nil {
+		logger.Fatalw("failed getting oidc issuer options", "error", err)
+	}
+
+	for _, o := range issuerOpts {
+		if len(o.Audiences) > 0 || len(o.ScopeMappings) > 0 {
+			logger.Infow("OIDC issuer options", "Issuer", o.Issuer, "Audiences", o.Audiences, "ScopeMappings", o.ScopeMappings)
+		}
+	}
+
 	for _, ac := range authcfgs {
 		logger.Infow(
 			"OIDC Config",
@@ -80,12 +277,87 @@ func startAPI() error {
 		logger.Infof("using admin group(s): %v", adminGroups)
 	}
 
+	// NOTE: like the oidc config, step-up policies only work when loading from config file
+	stepUp := map[string]auth.StepUpPolicy{}
+	if err := viper.UnmarshalKey("api.step-up", &stepUp); err != nil {
+		logger.Fatalw("failed getting step-up policies", "error", err)
+	}
+
+	for group, p := range stepUp {
+		logger.Infow("step-up policy", "route_group", group, "max_age", p.MaxAge, "amr", p.AMR, "acr", p.ACR)
+	}
+
 	conf := &api.Conf{
-		AdminGroups: adminGroups,
-		AuthConf:    authcfgs,
-		Debug:       viper.GetBool("logging.debug"),
-		Listen:      viper.GetString("api.listen"),
-		Logger:      logger.Desugar(),
+		AccessLogSampleRate:           viper.GetFloat64("api.access-log.sample-rate"),
+		AccessLogSlowThreshold:        viper.GetDuration("api.access-log.slow-threshold"),
+		AdminGroups:                   adminGroups,
+		AdminPromotionRequestCooldown: viper.GetDuration("api.admin-promotion-request.cooldown"),
+		AuthConf:                      authcfgs,
+		IssuerOptions:                 issuerOpts,
+		AvatarCacheTTL:                viper.GetDuration("api.avatar.cache-ttl"),
+		BreakGlassRespondersGroup:     viper.GetString("api.break-glass.responders-group"),
+		BreakGlassSecurityGroup:       viper.GetString("api.break-glass.security-group"),
+		BreakGlassNotificationType:    viper.GetString("api.break-glass.notification-type"),
+		Debug:                         viper.GetBool("logging.debug"),
+		EventSubjectPrefixes: v1alpha.EventSubjectPrefixes{
+			Events:     viper.GetString("nats.subject-prefix"),
+			Extensions: viper.GetString("nats.extension-subject-prefix"),
+		},
+		ExtensionCredentialMaxAge:        viper.GetDuration("api.extension-credential.max-age"),
+		ExtensionCredentialRotationGrace: viper.GetDuration("api.extension-credential.rotation-grace"),
+		ExtensionTokenTTL:                viper.GetDuration("api.extension-token-ttl"),
+		DrainDelay:                       viper.GetDuration("api.shutdown.drain-delay"),
+		Listen:                           viper.GetString("api.listen"),
+		Logger:                           logger.Desugar(),
+		NotificationBroadcastCooldown:    viper.GetDuration("api.notification-broadcast.cooldown"),
+		PurgeRetention:                   viper.GetDuration("api.purge-retention"),
+		SessionTokenTTL:                  viper.GetDuration("api.session-token-ttl"),
+		ShutdownTimeout:                  viper.GetDuration("api.shutdown.timeout"),
+		StepUp:                           stepUp,
+		TombstoneRetention:               viper.GetDuration("api.tombstones.retention"),
+		TrustedProxies:                   viper.GetStringSlice("api.trusted-proxies"),
+	}
+
+	if viper.GetBool("nats.v2-events") {
+		conf.EventSubjectPrefixes.V2Events = conf.EventSubjectPrefixes.Events + "." + eventsv2.SubjectToken
+	}
+
+	if path := viper.GetString("api.okta.event-hook-secret-file"); path != "" {
+		secret, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		conf.OktaEventHookSecret = strings.TrimSpace(string(secret))
+	}
+
+	if dir := viper.GetString("backup.dir"); dir != "" {
+		conf.Backups = backup.NewDirStore(dir)
+	}
+
+	userMatcher, err := dbtools.NewUserMatcher(
+		viper.GetStringSlice("api.user-match.order"),
+		viper.GetBool("api.user-match.email-case-sensitive"),
+	)
+	if err != nil {
+		return err
+	}
+
+	conf.UserMatcher = userMatcher
+
+	if path := viper.GetString("api.email-verification.secret-file"); path != "" {
+		secret, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		conf.EmailVerifier, err = emailverify.New(
+			[]byte(strings.TrimSpace(string(secret))),
+			emailverify.WithTTL(viper.GetDuration("api.email-verification.ttl")),
+		)
+		if err != nil {
+			return err
+		}
 	}
 
 	auditpath := viper.GetString("audit.log-path")
@@ -124,27 +396,309 @@ func startAPI() error {
 		"nats.subject-prefix", viper.GetString("nats.subject-prefix"),
 	)
 
-	nc, natsClose, err := newNATSConnection(viper.GetViper())
-	if err != nil {
-		return err
+	if !viper.GetBool("nats.enabled") && !viper.GetBool("events.pg-notify") {
+		return ErrMissingEventBackend
 	}
 
-	defer natsClose()
+	var nc *nats.Conn
+
+	if viper.GetBool("nats.enabled") {
+		conn, natsClose, err := newNATSConnection(viper.GetViper())
+		if err != nil {
+			return err
+		}
 
-	eb := eventbus.NewClient(
+		defer natsClose()
+
+		nc = conn
+	}
+
+	rules := eventrules.New(
+		eventrules.DBLoader(db),
+		eventrules.WithConfig(eventrules.Config{
+			Muted:           viper.GetStringSlice("nats.muted-subjects"),
+			ExtensionPrefix: viper.GetString("nats.extension-subject-prefix"),
+		}),
+		eventrules.WithLogger(logger.Desugar()),
+	)
+
+	ebOpts := []eventbus.Option{
 		eventbus.WithLogger(logger.Desugar()),
-		eventbus.WithNATSConn(nc),
 		eventbus.WithNATSPrefix(viper.GetString("nats.subject-prefix")),
-	)
+		eventbus.WithV2Events(viper.GetBool("nats.v2-events")),
+		eventbus.WithSubjectRules(rules),
+		eventbus.WithUserFieldSubscriptions(rules),
+		eventbus.WithRetry(viper.GetInt("nats.publish-attempts"), 100*time.Millisecond, 2*time.Second), //nolint:mnd
+		eventbus.WithCircuitBreaker(viper.GetInt("nats.circuit-breaker.threshold"), viper.GetDuration("nats.circuit-breaker.cooldown")),
+		eventbus.WithShadowPrefix(viper.GetString("nats.shadow.subject-prefix"), viper.GetFloat64("nats.shadow.sample-rate")),
+	}
+
+	if nc != nil {
+		ebOpts = append(ebOpts, eventbus.WithNATSConn(nc))
+	}
+
+	if viper.GetBool("events.pg-notify") {
+		logger.Info("publishing events as postgres notifications")
+
+		ebOpts = append(ebOpts, eventbus.WithPGNotify(eventbus.NewPGNotifier(db)))
+	}
+
+	var eventOutbox *outbox.Outbox
+
+	if viper.GetBool("nats.outbox.enabled") {
+		eventOutbox = outbox.New(db, outbox.WithLogger(logger.Desugar()))
+
+		ebOpts = append(ebOpts, eventbus.WithOutbox(eventOutbox))
+	}
+
+	var cache *respcache.Cache
+
+	if ttl := viper.GetDuration("api.response-cache-ttl"); ttl > 0 {
+		logger.Infof("enabling response cache with ttl: %s", ttl)
+
+		cache = respcache.New(respcache.WithTTL(ttl))
+
+		ebOpts = append(ebOpts, eventbus.WithPublishHook(cache.Invalidate))
+	}
+
+	eb := eventbus.NewClient(ebOpts...)
+
+	if cache != nil && nc != nil {
+		// events published by other instances also need to invalidate the cache
+		prefix := viper.GetString("nats.subject-prefix") + "."
+
+		unsubscribe, err := eb.Subscribe(">", func(m *nats.Msg) {
+			cache.Invalidate(strings.TrimPrefix(m.Subject, prefix))
+		})
+		if err != nil {
+			return err
+		}
+
+		defer unsubscribe()
+	}
+
+	svcOpts := []service.Option{
+		service.WithDB(db),
+		service.WithEventBus(eb),
+		service.WithLogger(logger.Desugar()),
+	}
+
+	var chain *auditchain.Chain
+
+	if path := viper.GetString("audit.signing-key"); path != "" {
+		key, err := auditchain.LoadSigningKey(path)
+		if err != nil {
+			return err
+		}
+
+		chain = auditchain.New(db, auditchain.WithSigningKey(key), auditchain.WithLogger(logger.Desugar()))
+
+		logger.Infow("audit signing enabled", "key_id", auditchain.KeyID(key.Public().(ed25519.PublicKey)))
+
+		svcOpts = append(svcOpts, service.WithAuditChain(chain))
+	}
+
+	svc := service.New(svcOpts...)
+
+	// the lag of the downstream event consumers is exported with the api metrics
+	if err := prometheus.Register(service.NewEventConsumerCollector(svc)); err != nil {
+		logger.Fatalw("failed initializing event consumer collector", "error", err)
+	}
+
+	flagOpts := []featureflags.Option{featureflags.WithLogger(logger.Desugar())}
+
+	if readOnly || viper.GetBool("api.read-only") {
+		logger.Warn("api is in read-only maintenance mode")
+
+		flagOpts = append(flagOpts, featureflags.WithForced(featureflags.ReadOnly))
+	}
+
+	flags := featureflags.New(featureflags.DBLoader(db), flagOpts...)
+
+	jobPool := jobs.New(db, jobs.WithLogger(logger.Desugar()), jobs.WithWorkers(viper.GetInt("jobs.workers")))
+
+	jobPool.Register(service.JobKindNotificationBroadcast, svc.NotificationBroadcastJob(viper.GetDuration("api.notification-broadcast.interval")))
+
+	// the background workers are stopped once the servers are done with the
+	// in-flight requests, which can still queue jobs and events
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	serveCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var servers sync.WaitGroup
+
+	if chain != nil {
+		jobPool.Register(auditchain.JobKindVerify, chain.VerifyJob)
+
+		go chain.Run(ctx, viper.GetDuration("audit.checkpoint-interval"))
+	}
+
+	if viper.GetInt("jobs.workers") > 0 {
+		go jobPool.Run(ctx)
+	}
+
+	if eventOutbox != nil {
+		go eventOutbox.Run(ctx, viper.GetDuration("nats.outbox.interval"), eb)
+	}
+
+	// backfills the extension resource indexes that are missing or kept
+	// after their ERD was deleted
+	go svc.SyncExtensionResourceIndexes(ctx)
+
+	if interval := viper.GetDuration("api.application-link-reaper.interval"); interval > 0 {
+		go svc.RunApplicationLinkReaper(ctx, interval)
+	}
+
+	if interval, retention := viper.GetDuration("api.tombstones.reaper-interval"), viper.GetDuration("api.tombstones.retention"); interval > 0 && retention > 0 {
+		go svc.RunRelationshipTombstoneReaper(ctx, interval, retention)
+	}
+
+	if interval := viper.GetDuration("api.scheduled-membership-changes.interval"); interval > 0 {
+		go svc.RunScheduledMembershipChanges(ctx, interval)
+	}
+
+	if interval := viper.GetDuration("api.notification-failover.interval"); interval > 0 {
+		go svc.RunNotificationFailover(ctx, interval)
+	}
+
+	var tenants *tenancy.Resolver
+
+	if viper.GetBool("api.tenancy.enabled") {
+		logger.Infow("tenancy enabled", "claim", viper.GetString("api.tenancy.claim"))
+
+		tenancy.RegisterHooks()
+
+		tenants = tenancy.NewResolver(db,
+			tenancy.WithClaim(viper.GetString("api.tenancy.claim")),
+			tenancy.WithGlobalSubjects(viper.GetStringSlice("api.tenancy.global-subjects")),
+		)
+	}
+
+	var grpcErr error
+
+	if listen := viper.GetString("grpc.listen"); listen != "" {
+		grpcServer, err := newGRPCServer(listen, auth.TrustedIssuers(authcfgs, issuerOpts))
+		if err != nil {
+			return err
+		}
+
+		grpcServer.AuditLogWriter = auditevent.NewDefaultAuditEventWriter(auf)
+		grpcServer.DB = db
+		grpcServer.EventBus = eb
+		grpcServer.FeatureFlags = flags
+		grpcServer.Service = svc
+		grpcServer.Tenancy = tenants
+
+		servers.Add(1)
+
+		// the grpc server stops gracefully when serveCtx is done, it shuts the
+		// other servers down when it fails
+		go func() {
+			defer servers.Done()
+
+			if err := grpcServer.Run(serveCtx); err != nil {
+				logger.Errorw("grpc server failed", "error", err)
+
+				grpcErr = err
+
+				stop()
+			}
+		}()
+	}
+
+	if listen := viper.GetString("ldap.listen"); listen != "" {
+		ldapServer, err := newLDAPServer(listen, db)
+		if err != nil {
+			return err
+		}
+
+		servers.Add(1)
+
+		go func() {
+			defer servers.Done()
+
+			if err := ldapServer.Run(serveCtx); err != nil {
+				logger.Fatalw("ldap gateway failed", "error", err)
+			}
+		}()
+	}
 
 	logger.Debug("building api server and router")
 
 	apiServer := &api.Server{
 		AuditLogWriter: auf,
+		Cache:          cache,
 		Conf:           conf,
 		DB:             db,
 		EventBus:       eb,
+		EventOutbox:    eventOutbox,
+		EventRules:     rules,
+		FeatureFlags:   flags,
+		Jobs:           jobPool,
+		Schema:         schema,
+		Service:        svc,
+		Tenancy:        tenants,
+	}
+
+	err = apiServer.Run(serveCtx)
+
+	logger.Info("shutting down")
+
+	stop()
+	servers.Wait()
+	cancel()
+
+	if err == nil {
+		err = grpcErr
+	}
+
+	if eventOutbox != nil {
+		flushCtx, flushCancel := context.WithTimeout(context.Background(), viper.GetDuration("api.shutdown.timeout"))
+		defer flushCancel()
+
+		n, ferr := eventOutbox.Flush(flushCtx, eb)
+		if ferr != nil {
+			logger.Errorw("failed to flush the event outbox", "error", ferr)
+		}
+
+		logger.Infow("flushed the event outbox", "published", n)
+	}
+
+	return err
+}
+
+// checkSchemaVersion compares the database schema version with the embedded
+// migrations. When the skew is exceeded it returns an error, or true to start
+// in read-only mode when that's the configured action.
+func checkSchemaVersion(db *sql.DB) (*schemaversion.Checker, bool, error) {
+	action := viper.GetString("db.schema.skew-action")
+	if action != schemaversion.ActionRefuse && action != schemaversion.ActionReadOnly {
+		return nil, false, fmt.Errorf("%w: %s", ErrInvalidSchemaSkewAction, action)
+	}
+
+	schema, err := schemaversion.NewChecker(db, dbm.Migrations, "migrations", viper.GetInt64("db.schema.max-skew"))
+	if err != nil {
+		return nil, false, err
+	}
+
+	status, err := schema.Check(context.Background())
+	if err != nil {
+		return nil, false, err
+	}
+
+	logger.Infow("database schema version", "expected", status.Expected, "current", status.Current, "max_skew", status.MaxSkew)
+
+	if err := status.Err(); err != nil {
+		if action == schemaversion.ActionRefuse {
+			return nil, false, err
+		}
+
+		logger.Errorw("starting in read-only mode", "error", err)
+
+		return schema, true, nil
 	}
 
-	return apiServer.Run()
+	return schema, false, nil
 }
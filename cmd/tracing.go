@@ -21,16 +21,26 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+	"github.com/metal-toolbox/governor-api/internal/dbstats"
 )
 
 func initTracingAndDB() *sqlx.DB {
 	dbDriverName := "postgres"
 
-	connector, err := pq.NewConnector(viper.GetString("db.uri"))
+	pqConnector, err := pq.NewConnector(viper.GetString("db.uri"))
 	if err != nil {
 		logger.Fatalw("failed initializing sql connector", "error", err)
 	}
 
+	// the statements are timed per api handler, and the slow ones logged
+	queryStats := dbstats.New(
+		dbstats.WithLogger(logger.Desugar()),
+		dbstats.WithSlowThreshold(viper.GetDuration("db.slow-query-threshold")),
+	)
+
+	connector := queryStats.Connector(pqConnector)
+
 	var innerDB *sql.DB
 
 	if viper.GetBool("tracing.enabled") {
@@ -75,6 +85,10 @@ func initTracingAndDB() *sqlx.DB {
 		logger.Fatalw("failed initializing prometheus collector", "error", err)
 	}
 
+	if err := prometheus.Register(queryStats); err != nil {
+		logger.Fatalw("failed initializing prometheus collector", "error", err)
+	}
+
 	return db
 }
 
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE request_comments (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    group_membership_request_id UUID NULL REFERENCES group_membership_requests(id) ON DELETE CASCADE,
+    group_application_request_id UUID NULL REFERENCES group_application_requests(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id),
+    body STRING NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT request_comments_one_request CHECK ((group_membership_request_id IS NULL) != (group_application_request_id IS NULL))
+);
+CREATE INDEX ON request_comments (group_membership_request_id, created_at);
+CREATE INDEX ON request_comments (group_application_request_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS request_comments;
+-- +goose StatementEnd
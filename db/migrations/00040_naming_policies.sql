@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE naming_policies (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    organization_id UUID NULL UNIQUE REFERENCES organizations(id) ON DELETE CASCADE,
+    reserved_slugs STRING[] NOT NULL DEFAULT ARRAY[],
+    name_patterns STRING[] NOT NULL DEFAULT ARRAY[],
+    required_prefixes STRING[] NOT NULL DEFAULT ARRAY[],
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS naming_policies;
+-- +goose StatementEnd
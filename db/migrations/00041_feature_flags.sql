@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE feature_flags (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    name STRING NOT NULL UNIQUE,
+    description STRING NOT NULL DEFAULT '',
+    enabled BOOL NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS feature_flags;
+-- +goose StatementEnd
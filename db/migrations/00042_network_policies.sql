@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE network_policies (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    subject STRING NOT NULL UNIQUE,
+    description STRING NOT NULL DEFAULT '',
+    allowed_cidrs STRING[] NOT NULL DEFAULT ARRAY[],
+    denied_cidrs STRING[] NOT NULL DEFAULT ARRAY[],
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS network_policies;
+-- +goose StatementEnd
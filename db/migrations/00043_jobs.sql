@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE jobs (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    kind STRING NOT NULL,
+    status STRING NOT NULL DEFAULT 'pending',
+    params JSONB NOT NULL DEFAULT '{}',
+    result JSONB NULL,
+    error STRING NOT NULL DEFAULT '',
+    total INT NOT NULL DEFAULT 0,
+    processed INT NOT NULL DEFAULT 0,
+    audit_id STRING NULL,
+    created_by UUID NULL REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    started_at TIMESTAMPTZ NULL,
+    finished_at TIMESTAMPTZ NULL,
+    INDEX jobs_status_created_at_idx (status, created_at)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS jobs;
+-- +goose StatementEnd
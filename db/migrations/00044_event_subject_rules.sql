@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE event_subject_rules (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    subject STRING NULL UNIQUE,
+    extension_id UUID NULL UNIQUE REFERENCES extensions(id) ON DELETE CASCADE,
+    muted BOOL NOT NULL DEFAULT false,
+    prefix STRING NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT event_subject_rules_subject_or_extension CHECK ((subject IS NULL) != (extension_id IS NULL))
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_subject_rules;
+-- +goose StatementEnd
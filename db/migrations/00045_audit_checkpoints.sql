@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_checkpoints (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    sequence INT NOT NULL UNIQUE,
+    last_event_id UUID NOT NULL,
+    last_event_created_at TIMESTAMPTZ NOT NULL,
+    event_count INT NOT NULL,
+    chain_hash STRING NOT NULL,
+    signature STRING NOT NULL,
+    key_id STRING NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_checkpoints;
+-- +goose StatementEnd
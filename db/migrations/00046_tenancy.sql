@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS tenant_id UUID NULL REFERENCES organizations(id);
+CREATE INDEX IF NOT EXISTS groups_tenant_id_idx ON groups (tenant_id);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS tenant_id UUID NULL REFERENCES organizations(id);
+CREATE INDEX IF NOT EXISTS users_tenant_id_idx ON users (tenant_id);
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS tenant_id UUID NULL REFERENCES organizations(id);
+CREATE INDEX IF NOT EXISTS applications_tenant_id_idx ON applications (tenant_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS applications_tenant_id_idx;
+ALTER TABLE applications DROP COLUMN IF EXISTS tenant_id;
+DROP INDEX IF EXISTS users_tenant_id_idx;
+ALTER TABLE users DROP COLUMN IF EXISTS tenant_id;
+DROP INDEX IF EXISTS groups_tenant_id_idx;
+ALTER TABLE groups DROP COLUMN IF EXISTS tenant_id;
+-- +goose StatementEnd
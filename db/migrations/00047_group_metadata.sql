@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS owner_contact STRING NOT NULL DEFAULT '';
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS docs_url STRING NOT NULL DEFAULT '';
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS slack_channel STRING NOT NULL DEFAULT '';
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS cost_center STRING NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE groups DROP COLUMN IF EXISTS cost_center;
+ALTER TABLE groups DROP COLUMN IF EXISTS slack_channel;
+ALTER TABLE groups DROP COLUMN IF EXISTS docs_url;
+ALTER TABLE groups DROP COLUMN IF EXISTS owner_contact;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS default_membership_ttl_days INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE groups DROP COLUMN IF EXISTS default_membership_ttl_days;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE membership_duration_policies (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    organization_id UUID NULL UNIQUE REFERENCES organizations(id) ON DELETE CASCADE,
+    group_id UUID NULL UNIQUE REFERENCES groups(id) ON DELETE CASCADE,
+    max_days INT NOT NULL,
+    mode STRING NOT NULL DEFAULT 'reject',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT membership_duration_policies_scope CHECK ((organization_id IS NULL) != (group_id IS NULL))
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS membership_duration_policies;
+-- +goose StatementEnd
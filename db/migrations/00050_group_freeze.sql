@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS frozen_at TIMESTAMPTZ NULL;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS frozen_reason STRING NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE groups DROP COLUMN IF EXISTS frozen_reason;
+ALTER TABLE groups DROP COLUMN IF EXISTS frozen_at;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE extension_credentials (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    extension_id UUID NOT NULL REFERENCES extensions(id) ON DELETE CASCADE,
+    client_id STRING NOT NULL UNIQUE,
+    secret_hash STRING NOT NULL,
+    description STRING NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    last_used_at TIMESTAMPTZ NULL,
+    INDEX extension_credentials_extension_id (extension_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE extension_tokens (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    credential_id UUID NOT NULL REFERENCES extension_credentials(id) ON DELETE CASCADE,
+    token_hash STRING NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    INDEX extension_tokens_expires_at (expires_at)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS extension_tokens;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS extension_credentials;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+CREATE TYPE IF NOT EXISTS erd_state AS ENUM ('draft', 'active', 'deprecated', 'disabled');
+ALTER TABLE extension_resource_definitions ADD COLUMN IF NOT EXISTS state erd_state NOT NULL DEFAULT 'active';
+UPDATE extension_resource_definitions SET state = 'disabled' WHERE enabled = false;
+
+-- +goose NO TRANSACTION
+-- +goose Down
+ALTER TABLE extension_resource_definitions DROP COLUMN IF EXISTS state;
+DROP TYPE IF EXISTS erd_state;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE system_extension_resources ADD COLUMN owner_id UUID NULL REFERENCES groups(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX system_extension_resources_owner_id ON system_extension_resources (owner_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS system_extension_resources@system_extension_resources_owner_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE system_extension_resources DROP COLUMN IF EXISTS owner_id;
+-- +goose StatementEnd
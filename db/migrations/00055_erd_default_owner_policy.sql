@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE extension_resource_definitions ADD COLUMN default_owner_policy STRING NOT NULL DEFAULT 'none';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE extension_resource_definitions ADD COLUMN default_owner_group UUID NULL REFERENCES groups(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE extension_resource_definitions DROP COLUMN IF EXISTS default_owner_group;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE extension_resource_definitions DROP COLUMN IF EXISTS default_owner_policy;
+-- +goose StatementEnd
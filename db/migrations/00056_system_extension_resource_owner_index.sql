@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX system_extension_resources_erd_owner_id ON system_extension_resources (extension_resource_definition_id, owner_id, deleted_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS system_extension_resources@system_extension_resources_owner_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS system_extension_resources_owner_id ON system_extension_resources (owner_id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS system_extension_resources@system_extension_resources_erd_owner_id;
+-- +goose StatementEnd
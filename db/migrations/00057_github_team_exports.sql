@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE github_team_exports (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    group_id UUID NOT NULL UNIQUE REFERENCES groups(id) ON DELETE CASCADE,
+    team_slug STRING NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS github_team_exports;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_field_subscriptions (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    name STRING NOT NULL UNIQUE,
+    fields STRING[] NOT NULL DEFAULT ARRAY[],
+    description STRING NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_field_subscriptions;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE extension_resource_definitions ADD COLUMN previous_version STRING NULL;
+ALTER TABLE extension_resource_definitions ADD COLUMN schema_diff JSONB NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE extension_resource_definitions DROP COLUMN IF EXISTS schema_diff;
+ALTER TABLE extension_resource_definitions DROP COLUMN IF EXISTS previous_version;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS member_soft_limit INT NOT NULL DEFAULT 0;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS member_hard_limit INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE groups DROP COLUMN IF EXISTS member_hard_limit;
+ALTER TABLE groups DROP COLUMN IF EXISTS member_soft_limit;
+-- +goose StatementEnd
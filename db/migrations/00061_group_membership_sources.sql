@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE group_memberships ADD COLUMN IF NOT EXISTS source STRING NOT NULL DEFAULT 'direct' CHECK (source IN ('direct', 'request', 'import', 'rule'));
+ALTER TABLE group_memberships ADD COLUMN IF NOT EXISTS source_ref STRING NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE group_memberships DROP COLUMN IF EXISTS source_ref;
+ALTER TABLE group_memberships DROP COLUMN IF EXISTS source;
+-- +goose StatementEnd
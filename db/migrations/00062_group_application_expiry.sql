@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE group_applications ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ NULL;
+ALTER TABLE group_application_requests ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE group_applications DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE group_application_requests DROP COLUMN IF EXISTS expires_at;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS archived_requests (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    request_id UUID NOT NULL,
+    request_type STRING NOT NULL,
+    kind STRING NULL,
+    group_id UUID NOT NULL REFERENCES groups(id),
+    user_id UUID NULL REFERENCES users(id),
+    application_id UUID NULL REFERENCES applications(id),
+    approver_group_id UUID NULL REFERENCES groups(id),
+    requester_user_id UUID NOT NULL REFERENCES users(id),
+    note STRING NOT NULL DEFAULT '',
+    is_admin BOOL NOT NULL DEFAULT false,
+    expires_at TIMESTAMPTZ NULL,
+    admin_expires_at TIMESTAMPTZ NULL,
+    decision STRING NOT NULL,
+    decided_by_user_id UUID NULL REFERENCES users(id),
+    requested_at TIMESTAMPTZ NOT NULL,
+    decided_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT archived_requests_request_type CHECK (request_type IN ('group_membership', 'group_application')),
+    CONSTRAINT archived_requests_decision CHECK (decision IN ('approved', 'denied')),
+    INDEX archived_requests_group_id (group_id, decided_at),
+    INDEX archived_requests_approver_group_id (approver_group_id, decided_at),
+    INDEX archived_requests_user_id (user_id, decided_at),
+    INDEX archived_requests_requester_user_id (requester_user_id, decided_at)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS archived_requests;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS notification_group_preferences (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    notification_type_id UUID NULL REFERENCES notification_types(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    notification_type_id_null_string UUID AS (IFNULL(notification_type_id, '00000000-0000-0000-0000-000000000000')) STORED,
+    level STRING NOT NULL CHECK (level IN ('muted', 'elevated')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT unique_user_group_type UNIQUE (user_id, group_id, notification_type_id_null_string),
+    INDEX (user_id) STORING (group_id, notification_type_id, level)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notification_group_preferences;
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS notification_target_rankings (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    notification_target_id UUID NOT NULL REFERENCES notification_targets(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    rank INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT unique_user_ranked_target UNIQUE (user_id, notification_target_id)
+);
+
+CREATE TABLE IF NOT EXISTS notification_failover_policies (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    notification_type_id UUID NOT NULL REFERENCES notification_types(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    failover_after_seconds INT NOT NULL CHECK (failover_after_seconds > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT unique_user_failover_type UNIQUE (user_id, notification_type_id)
+);
+
+CREATE TABLE IF NOT EXISTS notification_dispatches (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    notification_type_id UUID NOT NULL REFERENCES notification_types(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    group_id UUID NULL REFERENCES groups(id) ON DELETE SET NULL ON UPDATE CASCADE,
+    targets JSONB NOT NULL,
+    attempt INT NOT NULL DEFAULT 0,
+    failover_after_seconds INT NULL,
+    status STRING NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'failed')),
+    payload JSONB NULL,
+    delivered_target_id UUID NULL REFERENCES notification_targets(id) ON DELETE SET NULL ON UPDATE CASCADE,
+    delivered_at TIMESTAMPTZ NULL,
+    next_failover_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    INDEX notification_dispatches_failover (status, next_failover_at),
+    INDEX notification_dispatches_user_id (user_id, created_at)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notification_dispatches;
+DROP TABLE IF EXISTS notification_failover_policies;
+DROP TABLE IF EXISTS notification_target_rankings;
+-- +goose StatementEnd
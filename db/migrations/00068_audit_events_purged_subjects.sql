@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the audit events are hash chained and can't be updated, so they keep the ids
+-- of the actors and subjects that are purged instead of referencing them
+ALTER TABLE audit_events DROP CONSTRAINT IF EXISTS audit_events_actor_id_fkey;
+ALTER TABLE audit_events DROP CONSTRAINT IF EXISTS fk_actor_id_ref_users;
+ALTER TABLE audit_events DROP CONSTRAINT IF EXISTS audit_events_subject_user_id_fkey;
+ALTER TABLE audit_events DROP CONSTRAINT IF EXISTS fk_subject_user_id_ref_users;
+ALTER TABLE audit_events DROP CONSTRAINT IF EXISTS audit_events_subject_group_id_fkey;
+ALTER TABLE audit_events DROP CONSTRAINT IF EXISTS fk_subject_group_id_ref_groups;
+ALTER TABLE audit_events DROP CONSTRAINT IF EXISTS audit_events_subject_application_id_fkey;
+ALTER TABLE audit_events DROP CONSTRAINT IF EXISTS fk_subject_application_id_ref_applications;
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS subject_extension_id UUID NULL;
+CREATE INDEX IF NOT EXISTS audit_events_subject_extension_id ON audit_events (subject_extension_id) WHERE subject_extension_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS audit_events@audit_events_subject_extension_id;
+ALTER TABLE audit_events DROP COLUMN IF EXISTS subject_extension_id;
+ALTER TABLE audit_events ADD CONSTRAINT audit_events_actor_id_fkey FOREIGN KEY (actor_id) REFERENCES users(id) NOT VALID;
+ALTER TABLE audit_events ADD CONSTRAINT audit_events_subject_user_id_fkey FOREIGN KEY (subject_user_id) REFERENCES users(id) NOT VALID;
+ALTER TABLE audit_events ADD CONSTRAINT audit_events_subject_group_id_fkey FOREIGN KEY (subject_group_id) REFERENCES groups(id) NOT VALID;
+ALTER TABLE audit_events ADD CONSTRAINT audit_events_subject_application_id_fkey FOREIGN KEY (subject_application_id) REFERENCES applications(id) NOT VALID;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS system_extension_resource_versions (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    resource_id UUID NOT NULL,
+    extension_resource_definition_id UUID NOT NULL REFERENCES extension_resource_definitions(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    resource JSONB NOT NULL,
+    owner_id UUID NULL,
+    resource_created_at TIMESTAMPTZ NOT NULL,
+    resource_updated_at TIMESTAMPTZ NOT NULL,
+    resource_deleted_at TIMESTAMPTZ NULL,
+    recorded_at TIMESTAMPTZ NOT NULL,
+    INDEX system_extension_resource_versions_resource_id (resource_id, recorded_at),
+    INDEX system_extension_resource_versions_erd_id (extension_resource_definition_id, recorded_at)
+);
+
+CREATE TABLE IF NOT EXISTS user_extension_resource_versions (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    resource_id UUID NOT NULL,
+    extension_resource_definition_id UUID NOT NULL REFERENCES extension_resource_definitions(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    resource JSONB NOT NULL,
+    resource_created_at TIMESTAMPTZ NOT NULL,
+    resource_updated_at TIMESTAMPTZ NOT NULL,
+    resource_deleted_at TIMESTAMPTZ NULL,
+    recorded_at TIMESTAMPTZ NOT NULL,
+    INDEX user_extension_resource_versions_resource_id (resource_id, recorded_at),
+    INDEX user_extension_resource_versions_user_id (user_id, extension_resource_definition_id, recorded_at)
+);
+
+-- the current state of the existing resources is their first version
+INSERT INTO system_extension_resource_versions
+    (resource_id, extension_resource_definition_id, resource, owner_id, resource_created_at, resource_updated_at, resource_deleted_at, recorded_at)
+SELECT id, extension_resource_definition_id, resource, owner_id, created_at, updated_at, deleted_at, GREATEST(updated_at, COALESCE(deleted_at, updated_at))
+FROM system_extension_resources;
+
+INSERT INTO user_extension_resource_versions
+    (resource_id, extension_resource_definition_id, user_id, resource, resource_created_at, resource_updated_at, resource_deleted_at, recorded_at)
+SELECT id, extension_resource_definition_id, user_id, resource, created_at, updated_at, deleted_at, GREATEST(updated_at, COALESCE(deleted_at, updated_at))
+FROM user_extension_resources;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_extension_resource_versions;
+DROP TABLE IF EXISTS system_extension_resource_versions;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+ALTER TABLE system_extension_resource_versions ADD COLUMN IF NOT EXISTS version INT8 NOT NULL DEFAULT 0;
+ALTER TABLE user_extension_resource_versions ADD COLUMN IF NOT EXISTS version INT8 NOT NULL DEFAULT 0;
+
+-- the recorded versions of each resource are numbered from 1 in the order
+-- they were recorded
+UPDATE system_extension_resource_versions AS v SET version = n.version
+FROM (
+    SELECT id, row_number() OVER (PARTITION BY resource_id ORDER BY recorded_at, id) AS version
+    FROM system_extension_resource_versions
+) AS n
+WHERE v.id = n.id;
+
+UPDATE user_extension_resource_versions AS v SET version = n.version
+FROM (
+    SELECT id, row_number() OVER (PARTITION BY resource_id ORDER BY recorded_at, id) AS version
+    FROM user_extension_resource_versions
+) AS n
+WHERE v.id = n.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS system_extension_resource_versions_version ON system_extension_resource_versions (resource_id, version);
+CREATE UNIQUE INDEX IF NOT EXISTS user_extension_resource_versions_version ON user_extension_resource_versions (resource_id, version);
+
+-- +goose NO TRANSACTION
+-- +goose Down
+DROP INDEX IF EXISTS user_extension_resource_versions_version CASCADE;
+DROP INDEX IF EXISTS system_extension_resource_versions_version CASCADE;
+ALTER TABLE user_extension_resource_versions DROP COLUMN IF EXISTS version;
+ALTER TABLE system_extension_resource_versions DROP COLUMN IF EXISTS version;
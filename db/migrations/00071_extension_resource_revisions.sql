@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE system_extension_resources ADD COLUMN IF NOT EXISTS revision INT8 NOT NULL DEFAULT 1;
+ALTER TABLE user_extension_resources ADD COLUMN IF NOT EXISTS revision INT8 NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_extension_resources DROP COLUMN IF EXISTS revision;
+ALTER TABLE system_extension_resources DROP COLUMN IF EXISTS revision;
+-- +goose StatementEnd
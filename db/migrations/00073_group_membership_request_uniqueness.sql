@@ -0,0 +1,65 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the duplicates are archived as denied and removed, the oldest request of
+-- each group, user and kind is kept. The archived duplicates are recorded with
+-- their update time so the down migration can restore them.
+CREATE TABLE IF NOT EXISTS group_membership_request_duplicates (
+    archived_request_id UUID PRIMARY KEY NOT NULL REFERENCES archived_requests(id) ON DELETE CASCADE,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+WITH duplicates AS (
+    SELECT * FROM (
+        SELECT *, row_number() OVER (PARTITION BY group_id, user_id, kind ORDER BY created_at, id) AS n
+        FROM group_membership_requests
+    ) AS ranked
+    WHERE n > 1
+), archived AS (
+    INSERT INTO archived_requests (request_id, request_type, kind, group_id, user_id, requester_user_id, note, is_admin, expires_at, admin_expires_at, decision, requested_at)
+    SELECT id, 'group_membership', kind::STRING, group_id, user_id, user_id, note, is_admin, expires_at, admin_expires_at, 'denied', created_at
+    FROM duplicates
+    RETURNING id, request_id
+)
+INSERT INTO group_membership_request_duplicates (archived_request_id, updated_at)
+SELECT archived.id, duplicates.updated_at
+FROM archived
+JOIN duplicates ON duplicates.id = archived.request_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DELETE FROM group_membership_requests WHERE id IN (
+    SELECT archived_requests.request_id
+    FROM archived_requests
+    JOIN group_membership_request_duplicates ON group_membership_request_duplicates.archived_request_id = archived_requests.id
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE UNIQUE INDEX IF NOT EXISTS group_membership_requests_group_id_user_id_kind ON group_membership_requests (group_id, user_id, kind);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS group_membership_requests@group_membership_requests_group_id_user_id_kind CASCADE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO group_membership_requests (id, group_id, user_id, created_at, updated_at, is_admin, note, expires_at, kind, admin_expires_at)
+SELECT archived_requests.request_id, archived_requests.group_id, archived_requests.user_id, archived_requests.requested_at,
+    group_membership_request_duplicates.updated_at, archived_requests.is_admin, archived_requests.note, archived_requests.expires_at,
+    archived_requests.kind::request_kind, archived_requests.admin_expires_at
+FROM archived_requests
+JOIN group_membership_request_duplicates ON group_membership_request_duplicates.archived_request_id = archived_requests.id
+-- the requests of the purged users were removed with them
+WHERE archived_requests.user_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DELETE FROM archived_requests WHERE id IN (SELECT archived_request_id FROM group_membership_request_duplicates);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS group_membership_request_duplicates;
+-- +goose StatementEnd
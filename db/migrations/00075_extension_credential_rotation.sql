@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE extension_credentials ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE extension_credentials ADD COLUMN IF NOT EXISTS rotated_at TIMESTAMPTZ NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE extension_credentials ADD COLUMN IF NOT EXISTS previous_secret_hash STRING NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE extension_credentials ADD COLUMN IF NOT EXISTS previous_secret_expires_at TIMESTAMPTZ NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE extension_credentials DROP COLUMN IF EXISTS previous_secret_expires_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE extension_credentials DROP COLUMN IF EXISTS previous_secret_hash;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE extension_credentials DROP COLUMN IF EXISTS rotated_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE extension_credentials DROP COLUMN IF EXISTS expires_at;
+-- +goose StatementEnd
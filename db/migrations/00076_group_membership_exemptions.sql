@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE group_memberships ADD COLUMN IF NOT EXISTS exempted_at TIMESTAMPTZ NULL;
+ALTER TABLE group_memberships ADD COLUMN IF NOT EXISTS exemption_expires_at TIMESTAMPTZ NULL;
+ALTER TABLE group_memberships ADD COLUMN IF NOT EXISTS exemption_justification STRING NULL;
+ALTER TABLE group_memberships ADD COLUMN IF NOT EXISTS exemption_approved_by UUID NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE group_memberships DROP COLUMN IF EXISTS exemption_approved_by;
+ALTER TABLE group_memberships DROP COLUMN IF EXISTS exemption_justification;
+ALTER TABLE group_memberships DROP COLUMN IF EXISTS exemption_expires_at;
+ALTER TABLE group_memberships DROP COLUMN IF EXISTS exempted_at;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS scheduled_membership_changes (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    group_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    action STRING NOT NULL CHECK (action IN ('add', 'remove')),
+    is_admin BOOL NOT NULL DEFAULT false,
+    expires_at TIMESTAMPTZ NULL,
+    admin_expires_at TIMESTAMPTZ NULL,
+    scheduled_for TIMESTAMPTZ NOT NULL,
+    status STRING NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'applied', 'failed', 'canceled')),
+    error STRING NOT NULL DEFAULT '',
+    created_by UUID NULL,
+    audit_id UUID NULL,
+    applied_at TIMESTAMPTZ NULL,
+    canceled_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    INDEX scheduled_membership_changes_status_scheduled_for (status, scheduled_for),
+    INDEX scheduled_membership_changes_group_id (group_id, scheduled_for),
+    INDEX scheduled_membership_changes_user_id (user_id, scheduled_for)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS scheduled_membership_changes;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS justification_min_length INT NOT NULL DEFAULT 0;
+ALTER TABLE group_memberships ADD COLUMN IF NOT EXISTS justification STRING NOT NULL DEFAULT '';
+ALTER TABLE scheduled_membership_changes ADD COLUMN IF NOT EXISTS justification STRING NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE scheduled_membership_changes DROP COLUMN IF EXISTS justification;
+ALTER TABLE group_memberships DROP COLUMN IF EXISTS justification;
+ALTER TABLE groups DROP COLUMN IF EXISTS justification_min_length;
+-- +goose StatementEnd
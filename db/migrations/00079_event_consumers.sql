@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS event_consumers (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    name STRING NOT NULL UNIQUE,
+    last_audit_id UUID NULL,
+    last_audit_at TIMESTAMPTZ NULL,
+    reported_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS audit_events_created_at ON audit_events (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS audit_events@audit_events_created_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_consumers;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS users_updated_at ON users (updated_at);
+CREATE INDEX IF NOT EXISTS users_deleted_at ON users (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS groups_updated_at ON groups (updated_at);
+CREATE INDEX IF NOT EXISTS groups_deleted_at ON groups (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS group_memberships_updated_at ON group_memberships (updated_at);
+CREATE INDEX IF NOT EXISTS system_extension_resources_updated_at ON system_extension_resources (extension_resource_definition_id, updated_at);
+CREATE INDEX IF NOT EXISTS system_extension_resources_deleted_at ON system_extension_resources (extension_resource_definition_id, deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS user_extension_resources_updated_at ON user_extension_resources (user_id, updated_at);
+CREATE INDEX IF NOT EXISTS user_extension_resources_deleted_at ON user_extension_resources (user_id, deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS audit_events_action_created_at ON audit_events (action, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS audit_events@audit_events_action_created_at;
+DROP INDEX IF EXISTS user_extension_resources@user_extension_resources_deleted_at;
+DROP INDEX IF EXISTS user_extension_resources@user_extension_resources_updated_at;
+DROP INDEX IF EXISTS system_extension_resources@system_extension_resources_deleted_at;
+DROP INDEX IF EXISTS system_extension_resources@system_extension_resources_updated_at;
+DROP INDEX IF EXISTS group_memberships@group_memberships_updated_at;
+DROP INDEX IF EXISTS groups@groups_deleted_at;
+DROP INDEX IF EXISTS groups@groups_updated_at;
+DROP INDEX IF EXISTS users@users_deleted_at;
+DROP INDEX IF EXISTS users@users_updated_at;
+-- +goose StatementEnd
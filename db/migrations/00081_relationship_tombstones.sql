@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS relationship_tombstones (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    kind STRING NOT NULL,
+    record_id UUID NOT NULL,
+    group_id UUID NOT NULL,
+    subject_id UUID NOT NULL,
+    record JSONB NOT NULL,
+    removed_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    INDEX relationship_tombstones_kind_removed_at (kind, removed_at),
+    INDEX relationship_tombstones_removed_at (removed_at)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS relationship_tombstones;
+-- +goose StatementEnd
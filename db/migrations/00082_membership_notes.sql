@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE group_memberships ADD COLUMN IF NOT EXISTS note STRING NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE group_memberships DROP COLUMN IF EXISTS note;
+-- +goose StatementEnd
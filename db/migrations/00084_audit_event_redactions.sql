@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_event_redactions (
+    audit_event_id UUID PRIMARY KEY NOT NULL REFERENCES audit_events(id),
+    prev_chain_hash STRING NOT NULL,
+    chain_hash STRING NOT NULL,
+    signature STRING NOT NULL,
+    key_id STRING NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_event_redactions;
+-- +goose StatementEnd
@@ -0,0 +1,150 @@
+package accesslog
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/auditevent/ginaudit"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/metal-toolbox/governor-api/internal/dbstats"
+)
+
+const (
+	// subjectContextKey is the gin context key of the authenticated token subject
+	subjectContextKey = "jwt.subject"
+
+	defaultSlowThreshold = time.Second
+)
+
+// Logger logs the api requests
+type Logger struct {
+	logger        *zap.Logger
+	sampleRate    float64
+	skipPaths     map[string]struct{}
+	slowThreshold time.Duration
+	sample        func() float64
+}
+
+// Option is a functional configuration option for the access logger
+type Option func(l *Logger)
+
+// New returns an access logger writing to logger
+func New(logger *zap.Logger, opts ...Option) *Logger {
+	l := Logger{
+		logger:        logger,
+		sampleRate:    1,
+		skipPaths:     map[string]struct{}{},
+		slowThreshold: defaultSlowThreshold,
+		sample:        rand.Float64, //nolint:gosec
+	}
+
+	if l.logger == nil {
+		l.logger = zap.NewNop()
+	}
+
+	for _, opt := range opts {
+		opt(&l)
+	}
+
+	return &l
+}
+
+// WithSampleRate sets the fraction of the successful requests that are logged,
+// failed and slow requests are always logged. Every request is logged by default.
+func WithSampleRate(r float64) Option {
+	return func(l *Logger) {
+		if r > 0 && r <= 1 {
+			l.sampleRate = r
+		}
+	}
+}
+
+// WithSlowThreshold sets the latency above which a request is always logged
+func WithSlowThreshold(d time.Duration) Option {
+	return func(l *Logger) {
+		if d > 0 {
+			l.slowThreshold = d
+		}
+	}
+}
+
+// WithSkipPaths sets the paths that aren't logged, like the health checks
+func WithSkipPaths(paths ...string) Option {
+	return func(l *Logger) {
+		for _, p := range paths {
+			l.skipPaths[p] = struct{}{}
+		}
+	}
+}
+
+// Middleware returns the gin middleware logging the requests once they are handled
+func (l *Logger) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		path := c.Request.URL.Path
+
+		c.Next()
+
+		if _, ok := l.skipPaths[path]; ok {
+			return
+		}
+
+		latency := time.Since(start)
+		status := c.Writer.Status()
+
+		if !l.sampled(status, latency) {
+			return
+		}
+
+		fields := []zap.Field{
+			zap.String("method", c.Request.Method),
+			zap.String("route", c.FullPath()),
+			zap.String("path", path),
+			zap.Int("status", status),
+			zap.Float64("latency_ms", float64(latency.Microseconds())/1000), //nolint:mnd
+			zap.String("subject", c.GetString(subjectContextKey)),
+			zap.String("audit_id", c.GetString(ginaudit.AuditIDContextKey)),
+			zap.String("client_ip", c.ClientIP()),
+			zap.String("user_agent", c.Request.UserAgent()),
+			zap.Int("response_size", c.Writer.Size()),
+		}
+
+		if stats := dbstats.FromContext(c.Request.Context()); stats != nil {
+			fields = append(fields,
+				zap.Int64("db_queries", stats.Queries()),
+				zap.Float64("db_time_ms", float64(stats.Duration().Microseconds())/1000), //nolint:mnd
+			)
+		}
+
+		if len(c.Errors) > 0 {
+			fields = append(fields, zap.String("errors", c.Errors.String()))
+		}
+
+		l.logger.Log(level(status), "api request", fields...)
+	}
+}
+
+// sampled returns whether a request is logged
+func (l *Logger) sampled(status int, latency time.Duration) bool {
+	if status >= http.StatusBadRequest || latency >= l.slowThreshold {
+		return true
+	}
+
+	return l.sampleRate >= 1 || l.sample() < l.sampleRate
+}
+
+// level returns the log level of a response status
+func level(status int) zapcore.Level {
+	switch {
+	case status >= http.StatusInternalServerError:
+		return zapcore.ErrorLevel
+	case status >= http.StatusBadRequest:
+		return zapcore.WarnLevel
+	default:
+		return zapcore.InfoLevel
+	}
+}
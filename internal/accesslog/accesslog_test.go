@@ -0,0 +1,111 @@
+package accesslog
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/auditevent/ginaudit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/metal-toolbox/governor-api/internal/dbstats"
+)
+
+func serve(t *testing.T, l *Logger, path string, status int) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(l.Middleware())
+	router.GET("/users/:id", func(c *gin.Context) {
+		c.Set(subjectContextKey, "user@example.com")
+		c.Set(ginaudit.AuditIDContextKey, "audit-id")
+		c.Status(status)
+	})
+
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, path, nil)
+	require.NoError(t, err)
+
+	router.ServeHTTP(httptest.NewRecorder(), req)
+}
+
+func TestMiddleware(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+
+	serve(t, New(zap.New(core)), "/users/1", http.StatusOK)
+
+	require.Equal(t, 1, logs.Len())
+
+	entry := logs.All()[0]
+	fields := entry.ContextMap()
+
+	assert.Equal(t, zapcore.InfoLevel, entry.Level)
+	assert.Equal(t, "GET", fields["method"])
+	assert.Equal(t, "/users/:id", fields["route"])
+	assert.Equal(t, "/users/1", fields["path"])
+	assert.Equal(t, int64(http.StatusOK), fields["status"])
+	assert.Equal(t, "user@example.com", fields["subject"])
+	assert.Equal(t, "audit-id", fields["audit_id"])
+}
+
+func TestMiddlewareDBStats(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	core, logs := observer.New(zapcore.InfoLevel)
+
+	router := gin.New()
+	router.Use(New(zap.New(core)).Middleware(), dbstats.Middleware())
+	router.GET("/users/:id", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, "/users/1", nil)
+	require.NoError(t, err)
+
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Equal(t, 1, logs.Len())
+
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, int64(0), fields["db_queries"])
+	assert.Contains(t, fields, "db_time_ms")
+}
+
+func TestMiddlewareSkipPaths(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+
+	serve(t, New(zap.New(core), WithSkipPaths("/users/1")), "/users/1", http.StatusOK)
+
+	assert.Equal(t, 0, logs.Len())
+}
+
+func TestSampled(t *testing.T) {
+	l := New(nil, WithSampleRate(0.5), WithSlowThreshold(time.Second))
+
+	l.sample = func() float64 { return 0.7 }
+
+	assert.False(t, l.sampled(http.StatusOK, time.Millisecond))
+	assert.True(t, l.sampled(http.StatusNotFound, time.Millisecond))
+	assert.True(t, l.sampled(http.StatusInternalServerError, time.Millisecond))
+	assert.True(t, l.sampled(http.StatusOK, 2*time.Second))
+
+	l.sample = func() float64 { return 0.2 }
+
+	assert.True(t, l.sampled(http.StatusOK, time.Millisecond))
+
+	assert.Equal(t, 1.0, New(nil, WithSampleRate(0)).sampleRate)
+}
+
+func TestLevel(t *testing.T) {
+	assert.Equal(t, zapcore.InfoLevel, level(http.StatusOK))
+	assert.Equal(t, zapcore.WarnLevel, level(http.StatusForbidden))
+	assert.Equal(t, zapcore.ErrorLevel, level(http.StatusBadGateway))
+}
@@ -0,0 +1,4 @@
+// Package accesslog provides the api access log middleware. Every request is
+// logged with its route template, status, latency, authenticated subject and
+// audit id, so access logs can be correlated with the audit events.
+package accesslog
@@ -17,10 +17,47 @@ func (s *Server) livenessCheck(c *gin.Context) {
 	})
 }
 
+// schemaCheck reports the version of the database schema and how far it is
+// from the version expected by the binary, it fails when the skew is exceeded
+func (s *Server) schemaCheck(c *gin.Context) {
+	status, err := s.Schema.Check(c.Request.Context())
+	if err != nil {
+		s.Conf.Logger.Error("schema version check failed", zap.Error(err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"status": "DOWN",
+		})
+
+		return
+	}
+
+	if err := status.Err(); err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"status": "SKEWED",
+			"schema": status,
+			"error":  err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "UP",
+		"schema": status,
+	})
+}
+
 // readinessCheck ensures that the server is up and that we are able to process
-// requests. It will check that the database is up and that we can reach all
-// configured auth providers.
+// requests. It will check that the server isn't draining, that the database is
+// up and that we can reach all configured auth providers.
 func (s *Server) readinessCheck(c *gin.Context) {
+	if s.draining.Load() {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"status": "DRAINING",
+		})
+
+		return
+	}
+
 	if err := s.DB.PingContext(c.Request.Context()); err != nil {
 		s.Conf.Logger.Error("readiness check db ping failed", zap.Error(err))
 		c.JSON(http.StatusServiceUnavailable, gin.H{
@@ -2,9 +2,12 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"io"
 	"net/http"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/gin-contrib/cors"
@@ -19,48 +22,180 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 
-	"github.com/metal-toolbox/governor-api/internal/eventbus"
+	"github.com/metal-toolbox/governor-api/internal/accesslog"
+	"github.com/metal-toolbox/governor-api/internal/auth"
+	"github.com/metal-toolbox/governor-api/internal/avatar"
+	"github.com/metal-toolbox/governor-api/internal/backup"
+	"github.com/metal-toolbox/governor-api/internal/dbstats"
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/emailverify"
+	"github.com/metal-toolbox/governor-api/internal/eventrules"
+	"github.com/metal-toolbox/governor-api/internal/featureflags"
+	"github.com/metal-toolbox/governor-api/internal/jobs"
+	"github.com/metal-toolbox/governor-api/internal/netpolicy"
+	"github.com/metal-toolbox/governor-api/internal/outbox"
+	"github.com/metal-toolbox/governor-api/internal/respcache"
+	"github.com/metal-toolbox/governor-api/internal/schemaversion"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/internal/sessiontokens"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
 	v1alpha "github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
 	v1beta "github.com/metal-toolbox/governor-api/pkg/api/v1beta1"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
 )
 
 var (
 	readTimeout  = 10 * time.Second
 	writeTimeout = 20 * time.Second
 	corsMaxAge   = 12 * time.Hour
+
+	defaultShutdownTimeout = 30 * time.Second
 )
 
 // Conf allows other packages to compose their api configuration and use our NewAPI factor to put it together for them
 type Conf struct {
-	AdminGroups []string
-	AuthConf    []ginjwt.AuthConfig
-	Debug       bool
-	Listen      string
-	Logger      *zap.Logger
+	// AccessLogSampleRate is the fraction of the successful requests written to the
+	// access log, failed and slow requests are always written. Every request is
+	// written when it is 0.
+	AccessLogSampleRate float64
+	// AccessLogSlowThreshold is the latency above which a request is always written to the access log
+	AccessLogSlowThreshold time.Duration
+	// AdminPromotionRequestCooldown is how long a user waits before requesting an admin promotion again after a denial
+	AdminPromotionRequestCooldown time.Duration
+	AdminGroups                   []string
+	AuthConf                      []ginjwt.AuthConfig
+	Debug                         bool
+	// AvatarCacheTTL is how long the proxied avatars are cached
+	AvatarCacheTTL time.Duration
+	// Backups stores the backups of the governor entities, the backup endpoints are disabled when it is nil
+	Backups backup.Store
+	// BreakGlassRespondersGroup is the group whose members can grant themselves break-glass access, it is disabled
+	// when it is empty
+	BreakGlassRespondersGroup string
+	// BreakGlassSecurityGroup is the group whose members are notified of the break-glass accesses
+	BreakGlassSecurityGroup string
+	// BreakGlassNotificationType is the notification type of the break-glass notifications
+	BreakGlassNotificationType string
+	// DrainDelay is how long the readiness check reports the server as draining
+	// before it stops accepting requests, so load balancers can stop routing to it
+	DrainDelay time.Duration
+	// EmailVerifier signs the tokens confirming the email changes, the email
+	// changes are applied right away when it is nil
+	EmailVerifier *emailverify.Signer
+	// EventSubjectPrefixes are the prefixes of the subjects the events are published on
+	EventSubjectPrefixes v1alpha.EventSubjectPrefixes
+	// ExtensionCredentialMaxAge is how long the extension client credentials are valid after they are issued or rotated
+	ExtensionCredentialMaxAge time.Duration
+	// ExtensionCredentialRotationGrace is how long the previous secret of rotated extension client credentials keeps working
+	ExtensionCredentialRotationGrace time.Duration
+	// ExtensionTokenTTL is how long the tokens issued to the extensions are valid
+	ExtensionTokenTTL time.Duration
+	// IssuerOptions are the extra audiences and the scope mappings of the oidc configs
+	IssuerOptions []auth.IssuerOptions
+	Listen        string
+	Logger        *zap.Logger
+	// NotificationBroadcastCooldown is how long an admin waits between two notification broadcasts
+	NotificationBroadcastCooldown time.Duration
+	// ShutdownTimeout is how long the in-flight requests are given to finish on shutdown
+	ShutdownTimeout time.Duration
+	// OktaEventHookSecret is the shared secret of the Okta event hooks
+	OktaEventHookSecret string
+	// PurgeRetention is how long soft deleted records are kept before they can be purged
+	PurgeRetention time.Duration
+	// SessionTokenTTL is how long the session tokens issued to the UI are valid
+	SessionTokenTTL time.Duration
+	// StepUp are the step-up authentication policies of the high-risk route groups, keyed by route group
+	StepUp map[string]auth.StepUpPolicy
+	// TombstoneRetention is how long the tombstones of the removed relationships are kept
+	TombstoneRetention time.Duration
+	// TrustedProxies are the addresses or CIDR ranges of the proxies allowed to set the
+	// client address with the X-Forwarded-For header, it is ignored when empty
+	TrustedProxies []string
+	// UserMatcher matches inbound user records to the existing users, the
+	// default matcher is used when it is nil
+	UserMatcher *dbtools.UserMatcher
 }
 
 // Server holds data necessary to run the API and has associated methods
 type Server struct {
 	AuthMW         *ginauth.MultiTokenMiddleware
+	Cache          *respcache.Cache
 	Conf           *Conf
 	DB             *sqlx.DB
 	Router         *gin.Engine
 	AuditLogWriter io.Writer
 	aumdw          *ginaudit.Middleware
-	EventBus       *eventbus.Client
+	draining       atomic.Bool
+	EventBus       eventbus.EventBus
+	EventRules     *eventrules.Cache
+	EventOutbox    *outbox.Outbox
+	FeatureFlags   *featureflags.Cache
+	Jobs           *jobs.Pool
+	Schema         *schemaversion.Checker
+	Service        *service.Service
+	Tenancy        *tenancy.Resolver
 }
 
 func (s *Server) setupRoutes(router *gin.Engine) {
 	s.Conf.Logger.Sugar().Info("Setting up routes")
 
+	svc := s.Service
+	if svc == nil {
+		svc = service.New(
+			service.WithDB(s.DB),
+			service.WithEventBus(s.EventBus),
+			service.WithLogger(s.Conf.Logger),
+		)
+	}
+
+	flags := s.FeatureFlags
+	if flags == nil {
+		flags = featureflags.New(
+			featureflags.DBLoader(s.DB),
+			featureflags.WithLogger(s.Conf.Logger),
+		)
+	}
+
+	policies := netpolicy.New(
+		netpolicy.DBLoader(s.DB, s.Conf.Logger),
+		netpolicy.WithLogger(s.Conf.Logger),
+	)
+
 	v1alphaRtr := v1alpha.Router{
-		AdminGroups: s.Conf.AdminGroups,
-		AuthMW:      s.AuthMW,
-		AuditMW:     s.aumdw,
-		AuthConf:    s.Conf.AuthConf,
-		Logger:      s.Conf.Logger,
-		DB:          s.DB,
-		EventBus:    s.EventBus,
+		AdminGroups:                      s.Conf.AdminGroups,
+		APIVersions:                      []string{v1alpha.Version, v1beta.Version},
+		AuthMW:                           s.AuthMW,
+		AuditMW:                          s.aumdw,
+		AuthConf:                         s.Conf.AuthConf,
+		Avatars:                          avatar.New(avatar.WithCacheTTL(s.Conf.AvatarCacheTTL)),
+		Backups:                          s.Conf.Backups,
+		BreakGlassRespondersGroup:        s.Conf.BreakGlassRespondersGroup,
+		BreakGlassSecurityGroup:          s.Conf.BreakGlassSecurityGroup,
+		BreakGlassNotificationType:       s.Conf.BreakGlassNotificationType,
+		AdminPromotionRequestCooldown:    s.Conf.AdminPromotionRequestCooldown,
+		Cache:                            s.Cache,
+		Logger:                           s.Conf.Logger,
+		DB:                               s.DB,
+		EventBus:                         s.EventBus,
+		EmailVerifier:                    s.Conf.EmailVerifier,
+		EventOutbox:                      s.EventOutbox,
+		EventRules:                       s.EventRules,
+		EventSubjectPrefixes:             s.Conf.EventSubjectPrefixes,
+		ExtensionCredentialMaxAge:        s.Conf.ExtensionCredentialMaxAge,
+		ExtensionCredentialRotationGrace: s.Conf.ExtensionCredentialRotationGrace,
+		ExtensionTokenTTL:                s.Conf.ExtensionTokenTTL,
+		FeatureFlags:                     flags,
+		Jobs:                             s.Jobs,
+		NetworkPolicies:                  policies,
+		NotificationBroadcastCooldown:    s.Conf.NotificationBroadcastCooldown,
+		OktaEventHookSecret:              s.Conf.OktaEventHookSecret,
+		PurgeRetention:                   s.Conf.PurgeRetention,
+		SessionTokenTTL:                  s.Conf.SessionTokenTTL,
+		Service:                          svc,
+		StepUpPolicies:                   s.Conf.StepUp,
+		Tenancy:                          s.Tenancy,
+		TombstoneRetention:               s.Conf.TombstoneRetention,
+		UserMatcher:                      s.Conf.UserMatcher,
 	}
 
 	v1alpha1 := router.Group("/api/v1alpha1")
@@ -74,6 +209,8 @@ func (s *Server) setupRoutes(router *gin.Engine) {
 		Logger:      s.Conf.Logger,
 		DB:          s.DB,
 		EventBus:    s.EventBus,
+		Service:     svc,
+		Tenancy:     s.Tenancy,
 	}
 
 	v1beta1 := router.Group("/api/v1beta1")
@@ -97,11 +234,16 @@ func (s *Server) setup() *gin.Engine {
 
 	s.Conf.Logger.Sugar().Info("Setting up auth middleware")
 
-	authMW, err := ginjwt.NewMultiTokenMiddlewareFromConfigs(s.Conf.AuthConf...)
+	authMW, err := auth.NewAuthMiddleware(auth.TrustedIssuers(s.Conf.AuthConf, s.Conf.IssuerOptions))
 	if err != nil {
 		s.Conf.Logger.Sugar().Fatal("failed to initialize auth middleware", "error", err)
 	}
 
+	// the session tokens issued to the UI are accepted next to the tokens of the identity providers
+	if err := authMW.Add(sessiontokens.NewMiddleware(s.DB)); err != nil {
+		s.Conf.Logger.Sugar().Fatal("failed to add session token auth middleware", "error", err)
+	}
+
 	s.AuthMW = authMW
 
 	s.Conf.Logger.Sugar().Info("Setting up prometheus")
@@ -115,15 +257,17 @@ func (s *Server) setup() *gin.Engine {
 
 	prom.Use(router)
 
-	customLogger := s.Conf.Logger.With(zap.String("component", "api"))
-	router.Use(
-		ginzap.GinzapWithConfig(customLogger, &ginzap.Config{
-			TimeFormat: time.RFC3339,
-			SkipPaths:  []string{"/healthz", "/healthz/readiness", "/healthz/liveness"},
-			UTC:        true,
-		}),
+	accessLog := accesslog.New(s.Conf.Logger.With(zap.String("component", "api")),
+		accesslog.WithSampleRate(s.Conf.AccessLogSampleRate),
+		accesslog.WithSlowThreshold(s.Conf.AccessLogSlowThreshold),
+		accesslog.WithSkipPaths("/healthz", "/healthz/readiness", "/healthz/liveness", "/healthz/schema"),
 	)
 
+	router.Use(accessLog.Middleware())
+
+	// the SQL statements are recorded for the route of the handler running them
+	router.Use(dbstats.Middleware())
+
 	router.Use(ginzap.RecoveryWithZap(s.Conf.Logger.With(zap.String("component", "api")), true))
 
 	tp := otel.GetTracerProvider()
@@ -143,6 +287,10 @@ func (s *Server) setup() *gin.Engine {
 	router.GET("/healthz/liveness", s.livenessCheck)
 	router.GET("/healthz/readiness", s.readinessCheck)
 
+	if s.Schema != nil {
+		router.GET("/healthz/schema", s.schemaCheck)
+	}
+
 	s.setupRoutes(router)
 
 	return router
@@ -170,11 +318,60 @@ func (s *Server) NewAPI() *http.Server {
 	}
 }
 
-// Run will start the server listening on the specified address
-func (s *Server) Run() error {
+// Run will start the server listening on the specified address, when the
+// context is canceled the server drains and waits for the in-flight requests
+func (s *Server) Run(ctx context.Context) error {
 	if !s.Conf.Debug {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
-	return s.setup().Run(s.Conf.Listen)
+	srv := s.NewAPI()
+
+	if err := s.Router.SetTrustedProxies(s.Conf.TrustedProxies); err != nil {
+		return err
+	}
+
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	return s.shutdown(srv, errs)
+}
+
+// shutdown reports the server as draining, then stops accepting requests and
+// waits for the in-flight ones to finish
+func (s *Server) shutdown(srv *http.Server, errs <-chan error) error {
+	s.draining.Store(true)
+
+	s.Conf.Logger.Info("draining api server", zap.Duration("delay", s.Conf.DrainDelay))
+
+	time.Sleep(s.Conf.DrainDelay)
+
+	timeout := s.Conf.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = defaultShutdownTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		return err
+	}
+
+	if err := <-errs; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	s.Conf.Logger.Info("api server stopped")
+
+	return nil
 }
@@ -85,3 +85,25 @@ func TestAPIHealthzCheck(t *testing.T) {
 	assert.Equal(t, 200, w.Code)
 	assert.Equal(t, `{"status":"UP"}`, w.Body.String())
 }
+
+func TestAPIReadinessCheckDraining(t *testing.T) {
+	apiserver := &Server{
+		Conf: &Conf{},
+	}
+
+	apiserver.draining.Store(true)
+
+	api := apiserver.NewAPI()
+
+	w := httptest.NewRecorder()
+
+	req, err := http.NewRequestWithContext(context.TODO(), "GET", "/healthz/readiness", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	api.Handler.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, `{"status":"DRAINING"}`, w.Body.String())
+}
@@ -0,0 +1,473 @@
+package auditchain
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/jobs"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const (
+	// JobKindVerify is the kind of the jobs verifying the audit log
+	JobKindVerify = "audit.verify"
+
+	defaultSettle = time.Minute
+	batchSize     = 1000
+)
+
+var (
+	// ErrInvalidKey is returned when the signing key isn't an ed25519 private key
+	ErrInvalidKey = errors.New("invalid audit signing key")
+	// ErrNoSigningKey is returned when creating a checkpoint without a signing key
+	ErrNoSigningKey = errors.New("no audit signing key")
+)
+
+// Chain creates and verifies the signed checkpoints of the audit log
+type Chain struct {
+	db     boil.ContextExecutor
+	key    ed25519.PrivateKey
+	public ed25519.PublicKey
+	logger *zap.Logger
+	settle time.Duration
+}
+
+// Option is a functional configuration option for the audit chain
+type Option func(c *Chain)
+
+// New returns an audit chain over the audit events stored in db
+func New(db boil.ContextExecutor, opts ...Option) *Chain {
+	c := Chain{
+		db:     db,
+		logger: zap.NewNop(),
+		settle: defaultSettle,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return &c
+}
+
+// WithSigningKey sets the key signing the checkpoints, its public key is used
+// for verification
+func WithSigningKey(k ed25519.PrivateKey) Option {
+	return func(c *Chain) {
+		c.key = k
+		c.public = k.Public().(ed25519.PublicKey)
+	}
+}
+
+// WithPublicKey sets the key verifying the checkpoints, for verifying without
+// the signing key
+func WithPublicKey(k ed25519.PublicKey) Option {
+	return func(c *Chain) {
+		c.public = k
+	}
+}
+
+// WithSettle sets how old an audit event must be before a checkpoint covers
+// it, so events of transactions committed late aren't left out of the chain
+func WithSettle(d time.Duration) Option {
+	return func(c *Chain) {
+		c.settle = d
+	}
+}
+
+// WithLogger sets the audit chain logger
+func WithLogger(l *zap.Logger) Option {
+	return func(c *Chain) {
+		if l != nil {
+			c.logger = l
+		}
+	}
+}
+
+// LoadSigningKey reads a PEM encoded PKCS #8 ed25519 private key, as created
+// by `openssl genpkey -algorithm ed25519`
+func LoadSigningKey(path string) (ed25519.PrivateKey, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM data found", ErrInvalidKey)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidKey, err)
+	}
+
+	ek, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: not an ed25519 key", ErrInvalidKey)
+	}
+
+	return ek, nil
+}
+
+// LoadPublicKey reads a PEM encoded PKIX ed25519 public key, as created by
+// `openssl pkey -pubout`
+func LoadPublicKey(path string) (ed25519.PublicKey, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM data found", ErrInvalidKey)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidKey, err)
+	}
+
+	ek, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: not an ed25519 key", ErrInvalidKey)
+	}
+
+	return ek, nil
+}
+
+// KeyID returns the identifier of a public key stored with the checkpoints it signed
+func KeyID(k ed25519.PublicKey) string {
+	sum := sha256.Sum256(k)
+
+	return hex.EncodeToString(sum[:8])
+}
+
+// chainedEvent is the hashed representation of an audit event, its fields
+// must not be reordered or the existing checkpoints can't be verified anymore.
+// Fields added later are omitted when empty, so the events recorded before
+// them hash the same.
+type chainedEvent struct {
+	ID                    string   `json:"id"`
+	ParentID              string   `json:"parent_id"`
+	ActorID               string   `json:"actor_id"`
+	Action                string   `json:"action"`
+	Message               string   `json:"message"`
+	Changeset             []string `json:"changeset"`
+	SubjectGroupID        string   `json:"subject_group_id"`
+	SubjectUserID         string   `json:"subject_user_id"`
+	SubjectOrganizationID string   `json:"subject_organization_id"`
+	SubjectApplicationID  string   `json:"subject_application_id"`
+	CreatedAt             string   `json:"created_at"`
+	SubjectExtensionID    string   `json:"subject_extension_id,omitempty"`
+}
+
+// Hash returns the chain hash after the audit event, given the chain hash
+// before it
+func Hash(prev []byte, e *models.AuditEvent) ([]byte, error) {
+	changeset := []string(e.Changeset)
+	if changeset == nil {
+		changeset = []string{}
+	}
+
+	data, err := json.Marshal(chainedEvent{
+		ID:                    e.ID,
+		ParentID:              e.ParentID.String,
+		ActorID:               e.ActorID.String,
+		Action:                e.Action,
+		Message:               e.Message,
+		Changeset:             changeset,
+		SubjectGroupID:        e.SubjectGroupID.String,
+		SubjectUserID:         e.SubjectUserID.String,
+		SubjectOrganizationID: e.SubjectOrganizationID.String,
+		SubjectApplicationID:  e.SubjectApplicationID.String,
+		CreatedAt:             e.CreatedAt.UTC().Format(time.RFC3339Nano),
+		SubjectExtensionID:    e.SubjectExtensionID.String,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	h.Write(prev)
+	h.Write(data)
+
+	return h.Sum(nil), nil
+}
+
+// signedMessage is the message signed for a checkpoint
+func signedMessage(cp *models.AuditCheckpoint) []byte {
+	return []byte(fmt.Sprintf("governor-audit-checkpoint:v1:%d:%s:%s:%d:%s",
+		cp.Sequence,
+		cp.LastEventID,
+		cp.LastEventCreatedAt.UTC().Format(time.RFC3339Nano),
+		cp.EventCount,
+		cp.ChainHash,
+	))
+}
+
+// events returns the next batch of audit events after the given event, in chain order
+func events(ctx context.Context, exec boil.ContextExecutor, after *models.AuditCheckpoint, before time.Time, limit int) (models.AuditEventSlice, error) {
+	mods := []qm.QueryMod{
+		qm.OrderBy("created_at, id"),
+		qm.Limit(limit),
+	}
+
+	if after != nil {
+		mods = append(mods, qm.Where("(created_at, id) > (?, ?)", after.LastEventCreatedAt, after.LastEventID))
+	}
+
+	if !before.IsZero() {
+		mods = append(mods, qm.Where("created_at <= ?", before))
+	}
+
+	return models.AuditEvents(mods...).All(ctx, exec)
+}
+
+// Checkpoint extends the chain with the audit events created since the last
+// checkpoint, and stores a new signed checkpoint. It returns nil when there
+// are no new events.
+func (c *Chain) Checkpoint(ctx context.Context) (*models.AuditCheckpoint, error) {
+	if c.key == nil {
+		return nil, ErrNoSigningKey
+	}
+
+	last, err := models.AuditCheckpoints(qm.OrderBy("sequence DESC")).One(ctx, c.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	cp := &models.AuditCheckpoint{Sequence: 1}
+
+	var hash []byte
+
+	if last != nil {
+		if hash, err = hex.DecodeString(last.ChainHash); err != nil {
+			return nil, err
+		}
+
+		cp.Sequence = last.Sequence + 1
+		cp.EventCount = last.EventCount
+	}
+
+	before := time.Now().Add(-c.settle)
+	after := last
+
+	for {
+		batch, err := events(ctx, c.db, after, before, batchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range batch {
+			if hash, err = Hash(hash, e); err != nil {
+				return nil, err
+			}
+
+			cp.EventCount++
+			cp.LastEventID = e.ID
+			cp.LastEventCreatedAt = e.CreatedAt
+		}
+
+		if len(batch) < batchSize {
+			break
+		}
+
+		after = cp
+	}
+
+	if cp.LastEventID == "" {
+		return nil, nil
+	}
+
+	cp.ChainHash = hex.EncodeToString(hash)
+	cp.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(c.key, signedMessage(cp)))
+	cp.KeyID = KeyID(c.public)
+
+	if err := cp.Insert(ctx, c.db, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("created audit checkpoint",
+		zap.Int64("sequence", cp.Sequence),
+		zap.Int64("event_count", cp.EventCount),
+		zap.String("last_event_id", cp.LastEventID),
+	)
+
+	return cp, nil
+}
+
+// Run creates a checkpoint every interval until the context is canceled
+func (c *Chain) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// another api instance creating the same checkpoint fails on
+			// the unique sequence, the next tick catches up
+			if _, err := c.Checkpoint(ctx); err != nil {
+				c.logger.Warn("failed to create audit checkpoint", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Report is the result of an audit log verification
+type Report struct {
+	// Verified is true when every checkpoint matched the audit events it covers
+	Verified bool `json:"verified"`
+	// Checkpoints is the number of checkpoints verified
+	Checkpoints int64 `json:"checkpoints"`
+	// Events is the number of audit events covered by the verified checkpoints
+	Events int64 `json:"events"`
+	// Unsealed is the number of audit events created after the last checkpoint,
+	// which can't be verified yet
+	Unsealed int64 `json:"unsealed"`
+	// FailedSequence is the sequence of the first checkpoint that didn't match
+	FailedSequence int64 `json:"failed_sequence,omitempty"`
+	// Reason describes why the verification failed
+	Reason string `json:"reason,omitempty"`
+}
+
+func (r *Report) fail(cp *models.AuditCheckpoint, reason string) *Report {
+	r.Verified = false
+	r.FailedSequence = cp.Sequence
+	r.Reason = reason
+
+	return r
+}
+
+// Verify recomputes the chain of the audit events and checks it against the
+// checkpoints and their signatures. The redacted events are chained with the
+// hashes recorded before their redaction. The progress function, when set, is
+// called with the number of events verified after each checkpoint.
+func (c *Chain) Verify(ctx context.Context, progress func(processed, total int64) error) (*Report, error) {
+	if c.public == nil {
+		return nil, ErrNoSigningKey
+	}
+
+	checkpoints, err := models.AuditCheckpoints(qm.OrderBy("sequence")).All(ctx, c.db)
+	if err != nil {
+		return nil, err
+	}
+
+	redacted, err := redactions(ctx, c.db)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Verified: true}
+
+	var (
+		total int64
+		hash  []byte
+		prev  *models.AuditCheckpoint
+	)
+
+	if len(checkpoints) > 0 {
+		total = checkpoints[len(checkpoints)-1].EventCount
+	}
+
+	keyID := KeyID(c.public)
+
+	for i, cp := range checkpoints {
+		if cp.Sequence != int64(i+1) {
+			return report.fail(cp, fmt.Sprintf("expected checkpoint sequence %d", i+1)), nil
+		}
+
+		if cp.KeyID != keyID {
+			return report.fail(cp, "checkpoint was signed with an unknown key: "+cp.KeyID), nil
+		}
+
+		sig, err := base64.StdEncoding.DecodeString(cp.Signature)
+		if err != nil || !ed25519.Verify(c.public, signedMessage(cp), sig) {
+			return report.fail(cp, "invalid checkpoint signature"), nil
+		}
+
+		// the events after the previous checkpoint up to this one
+		after := prev
+
+		for {
+			batch, err := events(ctx, c.db, after, cp.LastEventCreatedAt, batchSize)
+			if err != nil {
+				return nil, err
+			}
+
+			var last *models.AuditEvent
+
+			for _, e := range batch {
+				if e.CreatedAt.Equal(cp.LastEventCreatedAt) && e.ID > cp.LastEventID {
+					break
+				}
+
+				if hash, err = c.chainHash(hash, e, redacted); err != nil {
+					return nil, err
+				}
+
+				report.Events++
+				last = e
+			}
+
+			if last == nil || last.ID == cp.LastEventID || len(batch) < batchSize {
+				break
+			}
+
+			after = &models.AuditCheckpoint{LastEventID: last.ID, LastEventCreatedAt: last.CreatedAt}
+		}
+
+		if report.Events != cp.EventCount {
+			return report.fail(cp, fmt.Sprintf("checkpoint covers %d events, found %d", cp.EventCount, report.Events)), nil
+		}
+
+		if hex.EncodeToString(hash) != cp.ChainHash {
+			return report.fail(cp, "chain hash mismatch, the audit events were modified"), nil
+		}
+
+		report.Checkpoints++
+		prev = cp
+
+		if progress != nil {
+			if err := progress(report.Events, total); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	q := []qm.QueryMod{}
+	if prev != nil {
+		q = append(q, qm.Where("(created_at, id) > (?, ?)", prev.LastEventCreatedAt, prev.LastEventID))
+	}
+
+	if report.Unsealed, err = models.AuditEvents(q...).Count(ctx, c.db); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// VerifyJob is the job handler verifying the audit log, its result is the
+// verification report
+func (c *Chain) VerifyJob(ctx context.Context, _ *models.Job, progress jobs.Progress) (interface{}, error) {
+	return c.Verify(ctx, func(processed, total int64) error {
+		return progress(ctx, processed, total)
+	})
+}
@@ -0,0 +1,133 @@
+package auditchain
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func testEvent(id, message string) *models.AuditEvent {
+	return &models.AuditEvent{
+		ID:        id,
+		ActorID:   null.StringFrom("actor-id"),
+		Action:    "group.updated",
+		Message:   message,
+		Changeset: types.StringArray{"name: a => b"},
+		CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC),
+	}
+}
+
+func TestHash(t *testing.T) {
+	first, err := Hash(nil, testEvent("1", "hello"))
+	require.NoError(t, err)
+
+	again, err := Hash(nil, testEvent("1", "hello"))
+	require.NoError(t, err)
+	assert.Equal(t, first, again)
+
+	modified, err := Hash(nil, testEvent("1", "hello!"))
+	require.NoError(t, err)
+	assert.NotEqual(t, first, modified)
+
+	second, err := Hash(first, testEvent("2", "world"))
+	require.NoError(t, err)
+
+	unchained, err := Hash(nil, testEvent("2", "world"))
+	require.NoError(t, err)
+	assert.NotEqual(t, second, unchained)
+
+	local := testEvent("1", "hello")
+	local.CreatedAt = local.CreatedAt.In(time.FixedZone("test", 3600))
+
+	inZone, err := Hash(nil, local)
+	require.NoError(t, err)
+	assert.Equal(t, first, inZone)
+
+	// the hash of the events without the fields added later doesn't change
+	assert.Equal(t, "e8086aeea7f2b9d4e0bd96a055c9cfee9d790fdb47ff75207a876d2746357706", hex.EncodeToString(first))
+
+	extension := testEvent("1", "hello")
+	extension.SubjectExtensionID = null.StringFrom("extension-id")
+
+	withExtension, err := Hash(nil, extension)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, withExtension)
+}
+
+func TestSignedMessage(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	cp := &models.AuditCheckpoint{
+		Sequence:           3,
+		LastEventID:        "event-id",
+		LastEventCreatedAt: time.Now(),
+		EventCount:         42,
+		ChainHash:          "abcd",
+	}
+
+	sig := ed25519.Sign(key, signedMessage(cp))
+	assert.True(t, ed25519.Verify(pub, signedMessage(cp), sig))
+
+	cp.EventCount = 41
+	assert.False(t, ed25519.Verify(pub, signedMessage(cp), sig))
+}
+
+func TestLoadSigningKey(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+	loaded, err := LoadSigningKey(path)
+	require.NoError(t, err)
+	assert.Equal(t, key, loaded)
+
+	invalid := filepath.Join(dir, "invalid.pem")
+	require.NoError(t, os.WriteFile(invalid, []byte("not a key"), 0o600))
+
+	_, err = LoadSigningKey(invalid)
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
+
+func TestLoadPublicKey(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "key.pub")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+	loaded, err := LoadPublicKey(path)
+	require.NoError(t, err)
+	assert.Equal(t, pub, loaded)
+}
+
+func TestKeyID(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	assert.Len(t, KeyID(pub), 16)
+	assert.Equal(t, KeyID(pub), KeyID(pub))
+}
@@ -0,0 +1,7 @@
+// Package auditchain makes the audit log tamper evident. The audit events are
+// hash chained in the order they were created and the chain is periodically
+// sealed with a checkpoint signed with an ed25519 key, so modifying, removing
+// or inserting an event covered by a checkpoint is detected on verification.
+// Events redacted after they were sealed, to erase personal data, are chained
+// with the hashes recorded and signed before the redaction.
+package auditchain
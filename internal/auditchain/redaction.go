@@ -0,0 +1,209 @@
+package auditchain
+
+import (
+	"context"
+	"crypto/ed25519"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// ErrEventNotChained is returned when redacting an audit event that isn't in the chain
+var ErrEventNotChained = errors.New("audit event not found in the chain")
+
+const (
+	redactionsQuery = `SELECT audit_event_id, prev_chain_hash, chain_hash, signature, key_id FROM audit_event_redactions`
+
+	insertRedactionQuery = `INSERT INTO audit_event_redactions (audit_event_id, prev_chain_hash, chain_hash, signature, key_id)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (audit_event_id) DO NOTHING`
+)
+
+// redaction records the chain hashes around an audit event covered by a
+// checkpoint before its content was redacted, so the chain can still be
+// verified without the redacted content
+type redaction struct {
+	AuditEventID  string `boil:"audit_event_id"`
+	PrevChainHash string `boil:"prev_chain_hash"`
+	ChainHash     string `boil:"chain_hash"`
+	Signature     string `boil:"signature"`
+	KeyID         string `boil:"key_id"`
+}
+
+// signedRedactionMessage is the message signed for a redaction
+func signedRedactionMessage(r *redaction) []byte {
+	return []byte(fmt.Sprintf("governor-audit-redaction:v1:%s:%s:%s",
+		r.AuditEventID,
+		r.PrevChainHash,
+		r.ChainHash,
+	))
+}
+
+// redactions returns the redactions of the audit events by event id
+func redactions(ctx context.Context, exec boil.ContextExecutor) (map[string]*redaction, error) {
+	records := []*redaction{}
+
+	if err := queries.Raw(redactionsQuery).Bind(ctx, exec, &records); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	byEvent := make(map[string]*redaction, len(records))
+	for _, r := range records {
+		byEvent[r.AuditEventID] = r
+	}
+
+	return byEvent, nil
+}
+
+// chainHash returns the chain hash after the audit event. A redacted event
+// chains to the hash recorded before its redaction, when it follows the same
+// hash and the record was signed with the chain key.
+func (c *Chain) chainHash(prev []byte, e *models.AuditEvent, redacted map[string]*redaction) ([]byte, error) {
+	if r, ok := redacted[e.ID]; ok && r.PrevChainHash == hex.EncodeToString(prev) && r.KeyID == KeyID(c.public) {
+		sig, err := base64.StdEncoding.DecodeString(r.Signature)
+		if err == nil && ed25519.Verify(c.public, signedRedactionMessage(r), sig) {
+			return hex.DecodeString(r.ChainHash)
+		}
+	}
+
+	return Hash(prev, e)
+}
+
+// sealed returns true when the audit event is covered by the checkpoint
+func sealed(cp *models.AuditCheckpoint, e *models.AuditEvent) bool {
+	return e.CreatedAt.Before(cp.LastEventCreatedAt) ||
+		(e.CreatedAt.Equal(cp.LastEventCreatedAt) && e.ID <= cp.LastEventID)
+}
+
+// hashBefore returns the chain hash before the audit event, starting from the
+// last checkpoint before it
+func (c *Chain) hashBefore(ctx context.Context, exec boil.ContextExecutor, e *models.AuditEvent, redacted map[string]*redaction) ([]byte, error) {
+	cp, err := models.AuditCheckpoints(
+		qm.Where("(last_event_created_at, last_event_id) < (?, ?)", e.CreatedAt, e.ID),
+		qm.OrderBy("sequence DESC"),
+	).One(ctx, exec)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	var hash []byte
+
+	if cp != nil {
+		if hash, err = hex.DecodeString(cp.ChainHash); err != nil {
+			return nil, err
+		}
+	}
+
+	after := cp
+
+	for {
+		batch, err := events(ctx, exec, after, e.CreatedAt, batchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, be := range batch {
+			if be.ID == e.ID {
+				return hash, nil
+			}
+
+			if hash, err = c.chainHash(hash, be, redacted); err != nil {
+				return nil, err
+			}
+
+			after = &models.AuditCheckpoint{LastEventID: be.ID, LastEventCreatedAt: be.CreatedAt}
+		}
+
+		if len(batch) < batchSize {
+			return nil, fmt.Errorf("%w: %s", ErrEventNotChained, e.ID)
+		}
+	}
+}
+
+// Redact records signed redactions of the audit events covered by a
+// checkpoint, so their content can be redacted without failing the
+// verification. It must be called with the events as they are before the
+// redaction, in the transaction redacting them. The events created after the
+// last checkpoint are chained with their redacted content and need no record.
+func (c *Chain) Redact(ctx context.Context, exec boil.ContextExecutor, evts models.AuditEventSlice) error {
+	if c.key == nil {
+		return ErrNoSigningKey
+	}
+
+	last, err := models.AuditCheckpoints(qm.OrderBy("sequence DESC")).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	redacted, err := redactions(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	// in chain order, so the redactions of the earlier events are recorded
+	// before the hashes of the later ones are computed
+	ordered := make(models.AuditEventSlice, len(evts))
+	copy(ordered, evts)
+
+	sort.Slice(ordered, func(i, j int) bool {
+		if ordered[i].CreatedAt.Equal(ordered[j].CreatedAt) {
+			return ordered[i].ID < ordered[j].ID
+		}
+
+		return ordered[i].CreatedAt.Before(ordered[j].CreatedAt)
+	})
+
+	keyID := KeyID(c.public)
+	recorded := 0
+
+	for _, e := range ordered {
+		if _, ok := redacted[e.ID]; ok || !sealed(last, e) {
+			continue
+		}
+
+		prev, err := c.hashBefore(ctx, exec, e, redacted)
+		if err != nil {
+			return err
+		}
+
+		hash, err := Hash(prev, e)
+		if err != nil {
+			return err
+		}
+
+		r := &redaction{
+			AuditEventID:  e.ID,
+			PrevChainHash: hex.EncodeToString(prev),
+			ChainHash:     hex.EncodeToString(hash),
+			KeyID:         keyID,
+		}
+		r.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(c.key, signedRedactionMessage(r)))
+
+		if _, err := exec.ExecContext(ctx, insertRedactionQuery, r.AuditEventID, r.PrevChainHash, r.ChainHash, r.Signature, r.KeyID); err != nil {
+			return err
+		}
+
+		redacted[e.ID] = r
+		recorded++
+	}
+
+	if recorded > 0 {
+		c.logger.Info("recorded audit event redactions", zap.Int("events", recorded))
+	}
+
+	return nil
+}
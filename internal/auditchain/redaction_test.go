@@ -0,0 +1,65 @@
+package auditchain
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func TestChainHashRedacted(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	c := New(nil, WithSigningKey(key))
+
+	prev, err := Hash(nil, testEvent("1", "hello"))
+	require.NoError(t, err)
+
+	original := testEvent("2", "world")
+
+	sealedHash, err := Hash(prev, original)
+	require.NoError(t, err)
+
+	r := &redaction{
+		AuditEventID:  "2",
+		PrevChainHash: hex.EncodeToString(prev),
+		ChainHash:     hex.EncodeToString(sealedHash),
+		KeyID:         KeyID(c.public),
+	}
+	r.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(key, signedRedactionMessage(r)))
+
+	redacted := testEvent("2", "world")
+	redacted.Changeset = types.StringArray{"name: scrubbed"}
+
+	// the redacted event chains to the hash recorded before the redaction
+	hash, err := c.chainHash(prev, redacted, map[string]*redaction{"2": r})
+	require.NoError(t, err)
+	assert.Equal(t, sealedHash, hash)
+
+	// the record doesn't apply after another previous hash
+	hash, err = c.chainHash(nil, redacted, map[string]*redaction{"2": r})
+	require.NoError(t, err)
+	assert.NotEqual(t, sealedHash, hash)
+
+	// nor when it wasn't signed with the chain key
+	_, other, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	forged := *r
+	forged.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(other, signedRedactionMessage(&forged)))
+
+	hash, err = c.chainHash(prev, redacted, map[string]*redaction{"2": &forged})
+	require.NoError(t, err)
+	assert.NotEqual(t, sealedHash, hash)
+
+	// the events without a redaction are hashed as they are
+	hash, err = c.chainHash(prev, original, map[string]*redaction{})
+	require.NoError(t, err)
+	assert.Equal(t, sealedHash, hash)
+}
@@ -0,0 +1,186 @@
+package auth
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"go.hollow.sh/toolbox/ginauth"
+	"go.hollow.sh/toolbox/ginjwt"
+)
+
+// IssuerOptions are the governor options of an oidc config entry, read from
+// the same entry as its ginjwt config and matched to it by issuer and audience
+type IssuerOptions struct {
+	Issuer   string `mapstructure:"issuer"`
+	Audience string `mapstructure:"audience"`
+	// Audiences are more audiences accepted from the issuer, a token is
+	// accepted when it was issued for one of them or for the entry audience
+	Audiences []string `mapstructure:"audiences"`
+	// ScopeMappings map the scopes of the tokens of the issuer to the governor
+	// scopes they grant, for example a workload identity scope to the read
+	// scopes of the resources the workload needs
+	ScopeMappings map[string][]string `mapstructure:"scope-mappings"`
+}
+
+// TrustedIssuer is an issuer and audience the api accepts tokens from
+type TrustedIssuer struct {
+	Config        ginjwt.AuthConfig
+	ScopeMappings map[string][]string
+}
+
+// TrustedIssuers returns the issuers the api accepts tokens from, one for
+// each oidc config and each of the extra audiences of its options
+func TrustedIssuers(cfgs []ginjwt.AuthConfig, opts []IssuerOptions) []TrustedIssuer {
+	issuers := []TrustedIssuer{}
+
+	for _, cfg := range cfgs {
+		var o IssuerOptions
+
+		for _, opt := range opts {
+			if opt.Issuer == cfg.Issuer && opt.Audience == cfg.Audience {
+				o = opt
+				break
+			}
+		}
+
+		issuers = append(issuers, TrustedIssuer{Config: cfg, ScopeMappings: o.ScopeMappings})
+
+		for _, aud := range o.Audiences {
+			if aud == cfg.Audience {
+				continue
+			}
+
+			c := cfg
+			c.Audience = aud
+
+			issuers = append(issuers, TrustedIssuer{Config: c, ScopeMappings: o.ScopeMappings})
+		}
+	}
+
+	return issuers
+}
+
+// NewAuthMiddleware returns the auth middleware verifying the tokens of the
+// trusted issuers. The scopes of the tokens of the issuers with scope mappings
+// are mapped to the governor scopes before the route scopes are checked.
+func NewAuthMiddleware(issuers []TrustedIssuer) (*ginauth.MultiTokenMiddleware, error) {
+	plain := []ginjwt.AuthConfig{}
+	mapped := []TrustedIssuer{}
+
+	for _, i := range issuers {
+		if i.Config.Enabled && len(i.ScopeMappings) > 0 {
+			mapped = append(mapped, i)
+			continue
+		}
+
+		plain = append(plain, i.Config)
+	}
+
+	mtm, err := ginjwt.NewMultiTokenMiddlewareFromConfigs(plain...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range mapped {
+		mw, err := ginjwt.NewAuthMiddleware(i.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := mtm.Add(&scopeMapper{next: mw, mappings: i.ScopeMappings}); err != nil {
+			return nil, err
+		}
+	}
+
+	return mtm, nil
+}
+
+// NewVerifiers returns a token verifier for each trusted issuer, for the
+// servers that verify the tokens outside of a gin router like the grpc api.
+// The scopes of the tokens of the issuers with scope mappings are mapped the
+// same way as by the auth middleware.
+func NewVerifiers(issuers []TrustedIssuer) ([]ginauth.GenericAuthMiddleware, error) {
+	verifiers := []ginauth.GenericAuthMiddleware{}
+
+	for _, i := range issuers {
+		mw, err := ginjwt.NewAuthMiddleware(i.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		if i.Config.Enabled && len(i.ScopeMappings) > 0 {
+			verifiers = append(verifiers, &scopeMapper{next: mw, mappings: i.ScopeMappings})
+			continue
+		}
+
+		verifiers = append(verifiers, mw)
+	}
+
+	return verifiers, nil
+}
+
+// scopeMapper maps the scopes of the tokens of an issuer to the governor
+// scopes around the middleware verifying them
+type scopeMapper struct {
+	next     ginauth.GenericAuthMiddleware
+	mappings map[string][]string
+}
+
+var _ ginauth.GenericAuthMiddleware = (*scopeMapper)(nil)
+
+// VerifyTokenWithScopes verifies the token with the route scopes and the
+// issuer scopes granting one of them, the claims carry the mapped scopes
+func (m *scopeMapper) VerifyTokenWithScopes(c *gin.Context, scopes []string) (ginauth.ClaimMetadata, error) {
+	cm, err := m.next.VerifyTokenWithScopes(c, m.issuerScopes(scopes))
+	if err != nil {
+		return cm, err
+	}
+
+	cm.Roles = m.mapScopes(cm.Roles)
+
+	return cm, nil
+}
+
+// SetMetadata sets the claims in the context
+func (m *scopeMapper) SetMetadata(c *gin.Context, cm ginauth.ClaimMetadata) {
+	m.next.SetMetadata(c, cm)
+}
+
+// issuerScopes returns the route scopes with the issuer scopes mapped to one
+// of them, a token with any of them is allowed on the route
+func (m *scopeMapper) issuerScopes(scopes []string) []string {
+	if len(scopes) == 0 {
+		return scopes
+	}
+
+	result := slices.Clone(scopes)
+
+	for from, to := range m.mappings {
+		for _, s := range to {
+			if slices.Contains(scopes, s) && !slices.Contains(result, from) {
+				result = append(result, from)
+				break
+			}
+		}
+	}
+
+	sort.Strings(result[len(scopes):])
+
+	return result
+}
+
+// mapScopes returns the token scopes with the governor scopes they are mapped to
+func (m *scopeMapper) mapScopes(roles []string) []string {
+	result := slices.Clone(roles)
+
+	for _, r := range roles {
+		for _, s := range m.mappings[r] {
+			if !slices.Contains(result, s) {
+				result = append(result, s)
+			}
+		}
+	}
+
+	return result
+}
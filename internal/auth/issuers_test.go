@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.hollow.sh/toolbox/ginauth"
+	"go.hollow.sh/toolbox/ginjwt"
+)
+
+func TestTrustedIssuers(t *testing.T) {
+	cfgs := []ginjwt.AuthConfig{
+		{Enabled: true, Issuer: "https://idp.example.com", Audience: "governor"},
+		{Enabled: true, Issuer: "https://workloads.example.com", Audience: "governor"},
+	}
+
+	opts := []IssuerOptions{
+		{
+			Issuer:        "https://workloads.example.com",
+			Audience:      "governor",
+			Audiences:     []string{"governor", "governor-api"},
+			ScopeMappings: map[string][]string{"workload": {"read:governor:groups"}},
+		},
+	}
+
+	issuers := TrustedIssuers(cfgs, opts)
+	require.Len(t, issuers, 3)
+
+	assert.Equal(t, cfgs[0], issuers[0].Config)
+	assert.Nil(t, issuers[0].ScopeMappings)
+
+	assert.Equal(t, cfgs[1], issuers[1].Config)
+	assert.Equal(t, "governor-api", issuers[2].Config.Audience)
+	assert.Equal(t, cfgs[1].Issuer, issuers[2].Config.Issuer)
+	assert.Equal(t, opts[0].ScopeMappings, issuers[2].ScopeMappings)
+}
+
+type fakeMiddleware struct {
+	scopes []string
+	roles  []string
+}
+
+func (f *fakeMiddleware) VerifyTokenWithScopes(_ *gin.Context, scopes []string) (ginauth.ClaimMetadata, error) {
+	f.scopes = scopes
+
+	return ginauth.ClaimMetadata{Subject: "workload", Roles: f.roles}, nil
+}
+
+func (f *fakeMiddleware) SetMetadata(_ *gin.Context, _ ginauth.ClaimMetadata) {}
+
+func TestScopeMapper(t *testing.T) {
+	next := &fakeMiddleware{roles: []string{"workload"}}
+	m := &scopeMapper{
+		next: next,
+		mappings: map[string][]string{
+			"workload": {"read:governor:groups", "read:governor:users"},
+			"deployer": {"write"},
+		},
+	}
+
+	cm, err := m.VerifyTokenWithScopes(nil, []string{"openid", "read", "read:governor:groups"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"openid", "read", "read:governor:groups", "workload"}, next.scopes)
+	assert.Equal(t, []string{"workload", "read:governor:groups", "read:governor:users"}, cm.Roles)
+
+	_, err = m.VerifyTokenWithScopes(nil, []string{"write", "delete:governor:groups"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"write", "delete:governor:groups", "deployer"}, next.scopes)
+
+	_, err = m.VerifyTokenWithScopes(nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, next.scopes)
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// ErrStepUpRequired is returned when a token doesn't satisfy a step-up policy
+var ErrStepUpRequired = errors.New("step-up authentication required")
+
+// StepUpPolicy is the authentication a token must carry to be allowed on a
+// high-risk endpoint. A token satisfies the policy when it was authenticated
+// within MaxAge and, when set, has one of the AMR methods and one of the ACR
+// values. An empty policy is always satisfied.
+type StepUpPolicy struct {
+	MaxAge time.Duration `mapstructure:"max-age"`
+	AMR    []string      `mapstructure:"amr"`
+	ACR    []string      `mapstructure:"acr"`
+}
+
+// StepUpClaims are the authentication claims of a token
+type StepUpClaims struct {
+	AuthTime int64    `json:"auth_time"`
+	AMR      []string `json:"amr"`
+	ACR      string   `json:"acr"`
+}
+
+// StepUpClaimsFromJWT reads the authentication claims of a token. The
+// signature isn't verified, the token must already be validated by the auth
+// middleware.
+func StepUpClaimsFromJWT(rawToken string) (*StepUpClaims, error) {
+	token, err := jwt.ParseSigned(rawToken)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := StepUpClaims{}
+	if err := token.UnsafeClaimsWithoutVerification(&claims); err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
+
+// Check returns ErrStepUpRequired when the claims don't satisfy the policy
+func (p StepUpPolicy) Check(claims *StepUpClaims, now time.Time) error {
+	if claims == nil {
+		claims = &StepUpClaims{}
+	}
+
+	if p.MaxAge > 0 {
+		if claims.AuthTime == 0 {
+			return fmt.Errorf("%w: token has no auth_time", ErrStepUpRequired)
+		}
+
+		if now.Sub(time.Unix(claims.AuthTime, 0)) > p.MaxAge {
+			return fmt.Errorf("%w: authentication is older than %s", ErrStepUpRequired, p.MaxAge)
+		}
+	}
+
+	if len(p.AMR) > 0 && !anyOf(p.AMR, claims.AMR...) {
+		return fmt.Errorf("%w: authentication method must be one of %s", ErrStepUpRequired, strings.Join(p.AMR, ", "))
+	}
+
+	if len(p.ACR) > 0 && !anyOf(p.ACR, claims.ACR) {
+		return fmt.Errorf("%w: authentication context must be one of %s", ErrStepUpRequired, strings.Join(p.ACR, ", "))
+	}
+
+	return nil
+}
+
+// Challenge returns the WWW-Authenticate header value asking the client to
+// authenticate again, as described in RFC 9470
+func (p StepUpPolicy) Challenge() string {
+	parts := []string{
+		`Bearer error="insufficient_user_authentication"`,
+		`error_description="a more recent or stronger authentication is required"`,
+	}
+
+	if len(p.ACR) > 0 {
+		parts = append(parts, fmt.Sprintf(`acr_values="%s"`, strings.Join(p.ACR, " ")))
+	}
+
+	if p.MaxAge > 0 {
+		parts = append(parts, fmt.Sprintf(`max_age=%d`, int64(p.MaxAge.Seconds())))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func anyOf(allowed []string, values ...string) bool {
+	for _, v := range values {
+		for _, a := range allowed {
+			if v == a {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+func TestStepUpPolicyCheck(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		policy  StepUpPolicy
+		claims  *StepUpClaims
+		wantErr bool
+	}{
+		{
+			name:   "empty policy",
+			claims: &StepUpClaims{},
+		},
+		{
+			name:   "recent authentication",
+			policy: StepUpPolicy{MaxAge: 15 * time.Minute},
+			claims: &StepUpClaims{AuthTime: now.Add(-5 * time.Minute).Unix()},
+		},
+		{
+			name:    "stale authentication",
+			policy:  StepUpPolicy{MaxAge: 15 * time.Minute},
+			claims:  &StepUpClaims{AuthTime: now.Add(-time.Hour).Unix()},
+			wantErr: true,
+		},
+		{
+			name:    "missing auth_time",
+			policy:  StepUpPolicy{MaxAge: 15 * time.Minute},
+			claims:  &StepUpClaims{},
+			wantErr: true,
+		},
+		{
+			name:   "matching amr",
+			policy: StepUpPolicy{AMR: []string{"mfa", "hwk"}},
+			claims: &StepUpClaims{AMR: []string{"pwd", "hwk"}},
+		},
+		{
+			name:    "missing amr",
+			policy:  StepUpPolicy{AMR: []string{"mfa"}},
+			claims:  &StepUpClaims{AMR: []string{"pwd"}},
+			wantErr: true,
+		},
+		{
+			name:   "matching acr",
+			policy: StepUpPolicy{ACR: []string{"phr"}},
+			claims: &StepUpClaims{ACR: "phr"},
+		},
+		{
+			name:    "missing acr",
+			policy:  StepUpPolicy{ACR: []string{"phr"}},
+			claims:  nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Check(tt.claims, now)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrStepUpRequired)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestStepUpPolicyChallenge(t *testing.T) {
+	assert.Equal(t,
+		`Bearer error="insufficient_user_authentication", error_description="a more recent or stronger authentication is required", acr_values="phr phrh", max_age=900`,
+		StepUpPolicy{ACR: []string{"phr", "phrh"}, MaxAge: 15 * time.Minute}.Challenge(),
+	)
+}
+
+func TestStepUpClaimsFromJWT(t *testing.T) {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte("0123456789abcdef0123456789abcdef")}, nil)
+	require.NoError(t, err)
+
+	raw, err := jwt.Signed(signer).Claims(map[string]interface{}{
+		"sub":       "hjass",
+		"auth_time": 1704110400,
+		"amr":       []string{"pwd", "mfa"},
+		"acr":       "phr",
+	}).CompactSerialize()
+	require.NoError(t, err)
+
+	claims, err := StepUpClaimsFromJWT(raw)
+	require.NoError(t, err)
+	assert.Equal(t, &StepUpClaims{AuthTime: 1704110400, AMR: []string{"pwd", "mfa"}, ACR: "phr"}, claims)
+
+	_, err = StepUpClaimsFromJWT("not-a-jwt")
+	assert.Error(t, err)
+}
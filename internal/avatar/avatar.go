@@ -0,0 +1,278 @@
+package avatar
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"  // register the gif decoder
+	_ "image/jpeg" // register the jpeg decoder
+	"image/png"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/metal-toolbox/governor-api/internal/respcache"
+)
+
+const (
+	// DefaultSize is the size of the avatars when none is requested
+	DefaultSize = 128
+
+	// userAgent is sent to the avatar hosts instead of the user agent of the clients
+	userAgent = "governor-api"
+
+	defaultCacheTTL   = time.Hour
+	defaultMaxBytes   = 2 << 20
+	maxDimension      = 4096
+	defaultMaxEntries = 1000
+	defaultTimeout    = 5 * time.Second
+	contentType       = "image/png"
+)
+
+// Sizes are the width and height, in pixels, the avatars can be requested in
+var Sizes = []int{32, 64, 128, 256}
+
+var (
+	// ErrInvalidSize is returned when an avatar is requested in an unsupported size
+	ErrInvalidSize = errors.New("invalid avatar size")
+	// ErrInvalidURL is returned when an avatar url isn't an absolute http or https url
+	ErrInvalidURL = errors.New("invalid avatar url")
+	// ErrForbiddenAddress is returned when an avatar host resolves to a private address
+	ErrForbiddenAddress = errors.New("avatar host resolves to a forbidden address")
+	// ErrFetchFailed is returned when an avatar host doesn't respond with an image
+	ErrFetchFailed = errors.New("error fetching avatar")
+)
+
+// Avatar is a scaled avatar image. Fallback is set when the avatar is a placeholder.
+type Avatar struct {
+	ContentType string
+	Body        []byte
+	Fallback    bool
+}
+
+// Proxy fetches, scales and caches the avatars
+type Proxy struct {
+	client   *http.Client
+	cache    *respcache.Cache
+	maxBytes int64
+}
+
+// Option is a functional configuration option for the avatar proxy
+type Option func(p *Proxy)
+
+// New returns an avatar proxy. By default the avatars are cached for an hour
+// and hosts resolving to loopback, private or link-local addresses are refused.
+func New(opts ...Option) *Proxy {
+	p := Proxy{
+		client: &http.Client{
+			Timeout: defaultTimeout,
+			Transport: &http.Transport{
+				DialContext: (&net.Dialer{Timeout: defaultTimeout, Control: denyPrivateAddresses}).DialContext,
+			},
+		},
+		cache:    respcache.New(respcache.WithTTL(defaultCacheTTL), respcache.WithMaxEntries(defaultMaxEntries)),
+		maxBytes: defaultMaxBytes,
+	}
+
+	for _, opt := range opts {
+		opt(&p)
+	}
+
+	return &p
+}
+
+// WithHTTPClient sets the client fetching the avatars
+func WithHTTPClient(c *http.Client) Option {
+	return func(p *Proxy) {
+		p.client = c
+	}
+}
+
+// WithCacheTTL sets how long the avatars are cached for
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(p *Proxy) {
+		p.cache = respcache.New(respcache.WithTTL(ttl), respcache.WithMaxEntries(defaultMaxEntries))
+	}
+}
+
+// WithMaxBytes sets the maximum size of the fetched avatars
+func WithMaxBytes(n int64) Option {
+	return func(p *Proxy) {
+		p.maxBytes = n
+	}
+}
+
+// ParseSize parses a requested avatar size, an empty size is the default size
+func ParseSize(s string) (int, error) {
+	if s == "" {
+		return DefaultSize, nil
+	}
+
+	size, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidSize, s)
+	}
+
+	for _, v := range Sizes {
+		if v == size {
+			return size, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %d", ErrInvalidSize, size)
+}
+
+// Get returns the avatar at the url scaled to size. When the url is empty or
+// the avatar can't be fetched, a placeholder seeded by seed is returned with
+// the error, so callers can serve it and log the error.
+func (p *Proxy) Get(ctx context.Context, avatarURL, seed string, size int) (*Avatar, error) {
+	key := strconv.Itoa(size) + "|" + avatarURL
+
+	if avatarURL != "" {
+		if resp, ok := p.cache.Get(key); ok {
+			return &Avatar{ContentType: resp.ContentType, Body: resp.Body}, nil
+		}
+	}
+
+	body, err := p.fetch(ctx, avatarURL, size)
+	if err != nil {
+		return placeholder(seed, size), err
+	}
+
+	p.cache.Set(key, respcache.Response{Status: http.StatusOK, ContentType: contentType, Body: body})
+
+	return &Avatar{ContentType: contentType, Body: body}, nil
+}
+
+// fetch downloads the avatar and returns it scaled to size as a png
+func (p *Proxy) fetch(ctx context.Context, avatarURL string, size int) ([]byte, error) {
+	u, err := url.Parse(avatarURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidURL, avatarURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "image/png, image/jpeg, image/gif")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFetchFailed, err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: unexpected status %d", ErrFetchFailed, resp.StatusCode)
+	}
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, p.maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFetchFailed, err)
+	}
+
+	if int64(len(b)) > p.maxBytes {
+		return nil, fmt.Errorf("%w: avatar larger than %d bytes", ErrFetchFailed, p.maxBytes)
+	}
+
+	// small images can declare huge dimensions, check them before decoding
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFetchFailed, err)
+	}
+
+	if cfg.Width > maxDimension || cfg.Height > maxDimension || cfg.Width == 0 || cfg.Height == 0 {
+		return nil, fmt.Errorf("%w: invalid avatar dimensions %dx%d", ErrFetchFailed, cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFetchFailed, err)
+	}
+
+	return encode(scale(img, size))
+}
+
+// scale crops the center square of the image and scales it to size
+func scale(img image.Image, size int) image.Image {
+	b := img.Bounds()
+
+	side := b.Dx()
+	if b.Dy() < side {
+		side = b.Dy()
+	}
+
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			dst.Set(x, y, img.At(x0+x*side/size, y0+y*side/size))
+		}
+	}
+
+	return dst
+}
+
+// placeholder returns a square of a color derived from the seed
+func placeholder(seed string, size int) *Avatar {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(seed))
+	sum := h.Sum32()
+
+	// keep the colors away from black and white
+	c := color.RGBA{R: 64 + uint8(sum)%128, G: 64 + uint8(sum>>8)%128, B: 64 + uint8(sum>>16)%128, A: 255}
+
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: c}, image.Point{}, draw.Src)
+
+	body, err := encode(img)
+	if err != nil {
+		// encoding an in-memory image doesn't fail
+		return &Avatar{ContentType: contentType, Fallback: true}
+	}
+
+	return &Avatar{ContentType: contentType, Body: body, Fallback: true}
+}
+
+func encode(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// denyPrivateAddresses refuses connections to loopback, private, link-local
+// and unspecified addresses, so avatar urls can't reach internal services
+func denyPrivateAddresses(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsUnspecified() || ip.IsMulticast() {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package avatar
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testImage(t *testing.T, w, h int) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for x := 0; x < w; x++ {
+		img.Set(x, h/2, color.RGBA{R: 255, A: 255})
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	return buf.Bytes()
+}
+
+func decodedSize(t *testing.T, b []byte) image.Point {
+	t.Helper()
+
+	img, err := png.Decode(bytes.NewReader(b))
+	require.NoError(t, err)
+
+	return img.Bounds().Size()
+}
+
+func TestParseSize(t *testing.T) {
+	size, err := ParseSize("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultSize, size)
+
+	size, err = ParseSize("64")
+	require.NoError(t, err)
+	assert.Equal(t, 64, size)
+
+	for _, s := range []string{"63", "big", "-1"} {
+		_, err := ParseSize(s)
+		assert.ErrorIs(t, err, ErrInvalidSize, s)
+	}
+}
+
+func TestProxy_Get(t *testing.T) {
+	body := testImage(t, 300, 200)
+	requests := 0
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		assert.Equal(t, userAgent, r.UserAgent())
+
+		switch r.URL.Path {
+		case "/avatar.png":
+			_, _ = w.Write(body)
+		case "/text":
+			_, _ = w.Write([]byte("not an image"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	p := New(WithHTTPClient(srv.Client()))
+
+	a, err := p.Get(context.Background(), srv.URL+"/avatar.png", "user", 64)
+	require.NoError(t, err)
+	assert.False(t, a.Fallback)
+	assert.Equal(t, "image/png", a.ContentType)
+	assert.Equal(t, image.Pt(64, 64), decodedSize(t, a.Body))
+
+	cached, err := p.Get(context.Background(), srv.URL+"/avatar.png", "user", 64)
+	require.NoError(t, err)
+	assert.Equal(t, a.Body, cached.Body)
+	assert.Equal(t, 1, requests, "avatars are cached")
+
+	_, err = p.Get(context.Background(), srv.URL+"/avatar.png", "user", 32)
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests, "sizes are cached separately")
+
+	for _, u := range []string{srv.URL + "/missing.png", srv.URL + "/text", "", "ftp://example.com/a.png"} {
+		a, err := p.Get(context.Background(), u, "user", 32)
+		assert.Error(t, err, u)
+		assert.True(t, a.Fallback, u)
+		assert.Equal(t, image.Pt(32, 32), decodedSize(t, a.Body), u)
+	}
+}
+
+func TestProxy_GetMaxBytes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(testImage(t, 300, 300))
+	}))
+	defer srv.Close()
+
+	a, err := New(WithHTTPClient(srv.Client()), WithMaxBytes(10)).Get(context.Background(), srv.URL, "user", 32)
+	assert.ErrorIs(t, err, ErrFetchFailed)
+	assert.True(t, a.Fallback)
+}
+
+func TestProxy_DeniesPrivateAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(testImage(t, 32, 32))
+	}))
+	defer srv.Close()
+
+	_, err := New().Get(context.Background(), srv.URL, "user", 32)
+	assert.ErrorIs(t, err, ErrForbiddenAddress)
+}
+
+func TestPlaceholder(t *testing.T) {
+	a := placeholder("user-1", 32)
+	assert.True(t, a.Fallback)
+	assert.Equal(t, image.Pt(32, 32), decodedSize(t, a.Body))
+	assert.Equal(t, a.Body, placeholder("user-1", 32).Body, "placeholders are stable")
+	assert.NotEqual(t, a.Body, placeholder("user-2", 32).Body)
+}
@@ -0,0 +1,5 @@
+// Package avatar proxies the user avatars hosted elsewhere. Avatars are
+// fetched server-side, so the third-party hosts never see the clients, scaled
+// to a fixed set of sizes and cached. Avatars that can't be fetched are
+// replaced with a generated placeholder instead of a broken image.
+package avatar
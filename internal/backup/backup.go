@@ -0,0 +1,523 @@
+// Package backup exports consistent logical backups of the governor entities
+// and restores them into an empty database, to move governor between
+// environments. The secrets, like the extension credentials and the session
+// tokens, the audit log and the transient records, like the requests and the
+// jobs, aren't part of the backups.
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pressly/goose/v3"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// FormatVersion is the version of the backup format
+const FormatVersion = 1
+
+var (
+	// ErrUnsupportedFormat is returned when restoring a backup of another format version
+	ErrUnsupportedFormat = errors.New("unsupported backup format version")
+	// ErrSchemaVersionMismatch is returned when restoring a backup taken with another database schema version
+	ErrSchemaVersionMismatch = errors.New("backup schema version doesn't match the database schema version")
+	// ErrTargetNotEmpty is returned when restoring a backup into a database with governor entities
+	ErrTargetNotEmpty = errors.New("backups can only be restored into an empty database")
+)
+
+// Backup is a logical backup of the governor entities, every record is kept
+// with its id and timestamps, including the soft deleted ones
+type Backup struct {
+	Version       int       `json:"version"`
+	SchemaVersion int64     `json:"schema_version"`
+	CreatedAt     time.Time `json:"created_at"`
+
+	Organizations                models.OrganizationSlice                `json:"organizations"`
+	Users                        models.UserSlice                        `json:"users"`
+	ApplicationTypes             models.ApplicationTypeSlice             `json:"application_types"`
+	Groups                       models.GroupSlice                       `json:"groups"`
+	Applications                 models.ApplicationSlice                 `json:"applications"`
+	GroupMemberships             models.GroupMembershipSlice             `json:"group_memberships"`
+	GroupHierarchies             models.GroupHierarchySlice              `json:"group_hierarchies"`
+	GroupOrganizations           models.GroupOrganizationSlice           `json:"group_organizations"`
+	GroupApplications            models.GroupApplicationSlice            `json:"group_applications"`
+	NotificationTypes            models.NotificationTypeSlice            `json:"notification_types"`
+	NotificationTargets          models.NotificationTargetSlice          `json:"notification_targets"`
+	Extensions                   models.ExtensionSlice                   `json:"extensions"`
+	ExtensionResourceDefinitions models.ExtensionResourceDefinitionSlice `json:"extension_resource_definitions"`
+	SystemExtensionResources     models.SystemExtensionResourceSlice     `json:"system_extension_resources"`
+	UserExtensionResources       models.UserExtensionResourceSlice       `json:"user_extension_resources"`
+}
+
+// Name returns the name of the backup in a store
+func (b *Backup) Name() string {
+	return "governor-" + b.CreatedAt.UTC().Format("20060102T150405Z") + ".json"
+}
+
+// entitySet is the records of one table of a backup
+type entitySet struct {
+	table string
+	ids   []string
+}
+
+// sets returns the records of the backup by table, in the order they are restored
+func (b *Backup) sets() []entitySet {
+	sets := []entitySet{
+		{table: models.TableNames.Organizations},
+		{table: models.TableNames.Users},
+		{table: models.TableNames.ApplicationTypes},
+		{table: models.TableNames.Groups},
+		{table: models.TableNames.Applications},
+		{table: models.TableNames.GroupMemberships},
+		{table: models.TableNames.GroupHierarchies},
+		{table: models.TableNames.GroupOrganizations},
+		{table: models.TableNames.GroupApplications},
+		{table: models.TableNames.NotificationTypes},
+		{table: models.TableNames.NotificationTargets},
+		{table: models.TableNames.Extensions},
+		{table: models.TableNames.ExtensionResourceDefinitions},
+		{table: models.TableNames.SystemExtensionResources},
+		{table: models.TableNames.UserExtensionResources},
+	}
+
+	for _, o := range b.Organizations {
+		sets[0].ids = append(sets[0].ids, o.ID)
+	}
+
+	for _, u := range b.Users {
+		sets[1].ids = append(sets[1].ids, u.ID)
+	}
+
+	for _, t := range b.ApplicationTypes {
+		sets[2].ids = append(sets[2].ids, t.ID)
+	}
+
+	for _, g := range b.Groups {
+		sets[3].ids = append(sets[3].ids, g.ID)
+	}
+
+	for _, a := range b.Applications {
+		sets[4].ids = append(sets[4].ids, a.ID)
+	}
+
+	for _, m := range b.GroupMemberships {
+		sets[5].ids = append(sets[5].ids, m.ID)
+	}
+
+	for _, h := range b.GroupHierarchies {
+		sets[6].ids = append(sets[6].ids, h.ID)
+	}
+
+	for _, o := range b.GroupOrganizations {
+		sets[7].ids = append(sets[7].ids, o.ID)
+	}
+
+	for _, a := range b.GroupApplications {
+		sets[8].ids = append(sets[8].ids, a.ID)
+	}
+
+	for _, t := range b.NotificationTypes {
+		sets[9].ids = append(sets[9].ids, t.ID)
+	}
+
+	for _, t := range b.NotificationTargets {
+		sets[10].ids = append(sets[10].ids, t.ID)
+	}
+
+	for _, e := range b.Extensions {
+		sets[11].ids = append(sets[11].ids, e.ID)
+	}
+
+	for _, erd := range b.ExtensionResourceDefinitions {
+		sets[12].ids = append(sets[12].ids, erd.ID)
+	}
+
+	for _, r := range b.SystemExtensionResources {
+		sets[13].ids = append(sets[13].ids, r.ID)
+	}
+
+	for _, r := range b.UserExtensionResources {
+		sets[14].ids = append(sets[14].ids, r.ID)
+	}
+
+	return sets
+}
+
+// Records returns the number of records of each table in the backup
+func (b *Backup) Records() map[string]int {
+	records := map[string]int{}
+
+	for _, set := range b.sets() {
+		records[set.table] = len(set.ids)
+	}
+
+	return records
+}
+
+// TableDiff compares the records of a table in a backup with the database
+type TableDiff struct {
+	Table string `json:"table"`
+	// Records is the number of records of the table in the backup
+	Records int `json:"records"`
+	// Existing is the number of rows of the table in the database
+	Existing int64 `json:"existing"`
+	// Conflicts is the number of records of the backup whose id is already in the database
+	Conflicts int64 `json:"conflicts"`
+}
+
+// Diff compares a backup with the database it would be restored into
+type Diff struct {
+	BackupSchemaVersion   int64       `json:"backup_schema_version"`
+	DatabaseSchemaVersion int64       `json:"database_schema_version"`
+	Tables                []TableDiff `json:"tables"`
+}
+
+// Err returns why the backup can't be restored into the database, nil when it can
+func (d *Diff) Err() error {
+	if d.BackupSchemaVersion != d.DatabaseSchemaVersion {
+		return fmt.Errorf("%w: backup version %d, database version %d", ErrSchemaVersionMismatch, d.BackupSchemaVersion, d.DatabaseSchemaVersion)
+	}
+
+	for _, t := range d.Tables {
+		if t.Existing > 0 {
+			return fmt.Errorf("%w: %s has %d rows", ErrTargetNotEmpty, t.Table, t.Existing)
+		}
+	}
+
+	return nil
+}
+
+// Manager exports and restores the backups of a governor database
+type Manager struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Option is a functional configuration option for the backup manager
+type Option func(m *Manager)
+
+// New returns a backup manager of the governor database
+func New(db *sql.DB, opts ...Option) *Manager {
+	m := Manager{
+		db:     db,
+		logger: zap.NewNop(),
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return &m
+}
+
+// WithLogger sets the backup manager logger
+func WithLogger(l *zap.Logger) Option {
+	return func(m *Manager) {
+		if l != nil {
+			m.logger = l
+		}
+	}
+}
+
+// Export reads the governor entities in a single read-only transaction, so
+// the backup is a consistent snapshot of the database
+func (m *Manager) Export(ctx context.Context) (*Backup, error) {
+	version, err := goose.GetDBVersionContext(ctx, m.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the database schema version: %w", err)
+	}
+
+	tx, err := m.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback() //nolint:errcheck
+
+	b := &Backup{
+		Version:       FormatVersion,
+		SchemaVersion: version,
+		CreatedAt:     time.Now().UTC(),
+	}
+
+	all := []qm.QueryMod{qm.WithDeleted(), qm.OrderBy("id")}
+
+	if b.Organizations, err = models.Organizations(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting organizations: %w", err)
+	}
+
+	if b.Users, err = models.Users(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting users: %w", err)
+	}
+
+	if b.ApplicationTypes, err = models.ApplicationTypes(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting application types: %w", err)
+	}
+
+	if b.Groups, err = models.Groups(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting groups: %w", err)
+	}
+
+	if b.Applications, err = models.Applications(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting applications: %w", err)
+	}
+
+	if b.GroupMemberships, err = models.GroupMemberships(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting group memberships: %w", err)
+	}
+
+	if b.GroupHierarchies, err = models.GroupHierarchies(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting group hierarchies: %w", err)
+	}
+
+	if b.GroupOrganizations, err = models.GroupOrganizations(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting group organizations: %w", err)
+	}
+
+	if b.GroupApplications, err = models.GroupApplications(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting group applications: %w", err)
+	}
+
+	if b.NotificationTypes, err = models.NotificationTypes(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting notification types: %w", err)
+	}
+
+	if b.NotificationTargets, err = models.NotificationTargets(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting notification targets: %w", err)
+	}
+
+	if b.Extensions, err = models.Extensions(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting extensions: %w", err)
+	}
+
+	if b.ExtensionResourceDefinitions, err = models.ExtensionResourceDefinitions(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting extension resource definitions: %w", err)
+	}
+
+	if b.SystemExtensionResources, err = models.SystemExtensionResources(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting system extension resources: %w", err)
+	}
+
+	if b.UserExtensionResources, err = models.UserExtensionResources(all...).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("error exporting user extension resources: %w", err)
+	}
+
+	return b, nil
+}
+
+// Diff compares a backup with the database without changing it
+func (m *Manager) Diff(ctx context.Context, b *Backup) (*Diff, error) {
+	return m.diff(ctx, m.db, b)
+}
+
+func (m *Manager) diff(ctx context.Context, exec boil.ContextExecutor, b *Backup) (*Diff, error) {
+	if b.Version != FormatVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedFormat, b.Version)
+	}
+
+	version, err := goose.GetDBVersionContext(ctx, m.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the database schema version: %w", err)
+	}
+
+	d := &Diff{
+		BackupSchemaVersion:   b.SchemaVersion,
+		DatabaseSchemaVersion: version,
+	}
+
+	for _, set := range b.sets() {
+		t := TableDiff{Table: set.table, Records: len(set.ids)}
+
+		// the table names are the model table names, not user input
+		if err := exec.QueryRowContext(ctx, "SELECT count(*) FROM "+set.table).Scan(&t.Existing); err != nil { //nolint:gosec
+			return nil, fmt.Errorf("error counting %s: %w", set.table, err)
+		}
+
+		if t.Existing > 0 && len(set.ids) > 0 {
+			if err := exec.QueryRowContext(ctx, "SELECT count(*) FROM "+set.table+" WHERE id = ANY($1)", pq.Array(set.ids)).Scan(&t.Conflicts); err != nil { //nolint:gosec
+				return nil, fmt.Errorf("error counting conflicting %s: %w", set.table, err)
+			}
+		}
+
+		d.Tables = append(d.Tables, t)
+	}
+
+	return d, nil
+}
+
+// Restore inserts the records of a backup into an empty database with the
+// same schema version, in a single transaction. The record timestamps are
+// kept. The audit function, when it isn't nil, runs in the transaction
+// before it commits, to record the restore.
+func (m *Manager) Restore(ctx context.Context, b *Backup, audit func(ctx context.Context, tx *sql.Tx, d *Diff) error) (*Diff, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback() //nolint:errcheck
+
+	d, err := m.diff(ctx, tx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Err(); err != nil {
+		return d, err
+	}
+
+	if err := restore(boil.SkipTimestamps(ctx), tx, b); err != nil {
+		return d, err
+	}
+
+	if audit != nil {
+		if err := audit(ctx, tx, d); err != nil {
+			return d, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return d, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	m.logger.Info("restored backup", zap.String("backup", b.Name()), zap.Int64("schema_version", b.SchemaVersion))
+
+	return d, nil
+}
+
+// allColumns returns the columns of a model columns struct, like
+// models.GroupColumns, so every column is inserted even when its value is the
+// zero value of a column with a default
+func allColumns(columns interface{}) boil.Columns {
+	v := reflect.ValueOf(columns)
+	names := make([]string, v.NumField())
+
+	for i := range names {
+		names[i] = v.Field(i).String()
+	}
+
+	return boil.Greylist(names...)
+}
+
+// restore inserts the records of a backup, the groups are inserted without
+// their approver groups, which are set once every group exists
+func restore(ctx context.Context, exec boil.ContextExecutor, b *Backup) error {
+	for _, o := range b.Organizations {
+		if err := o.Insert(ctx, exec, allColumns(models.OrganizationColumns)); err != nil {
+			return fmt.Errorf("error restoring organization %s: %w", o.ID, err)
+		}
+	}
+
+	for _, u := range b.Users {
+		if err := u.Insert(ctx, exec, allColumns(models.UserColumns)); err != nil {
+			return fmt.Errorf("error restoring user %s: %w", u.ID, err)
+		}
+	}
+
+	for _, t := range b.ApplicationTypes {
+		if err := t.Insert(ctx, exec, allColumns(models.ApplicationTypeColumns)); err != nil {
+			return fmt.Errorf("error restoring application type %s: %w", t.ID, err)
+		}
+	}
+
+	for _, g := range b.Groups {
+		approver := g.ApproverGroup
+		g.ApproverGroup = null.String{}
+
+		err := g.Insert(ctx, exec, allColumns(models.GroupColumns))
+
+		g.ApproverGroup = approver
+
+		if err != nil {
+			return fmt.Errorf("error restoring group %s: %w", g.ID, err)
+		}
+	}
+
+	for _, g := range b.Groups {
+		if !g.ApproverGroup.Valid {
+			continue
+		}
+
+		if _, err := g.Update(ctx, exec, boil.Whitelist(models.GroupColumns.ApproverGroup)); err != nil {
+			return fmt.Errorf("error restoring the approver group of group %s: %w", g.ID, err)
+		}
+	}
+
+	for _, a := range b.Applications {
+		if err := a.Insert(ctx, exec, allColumns(models.ApplicationColumns)); err != nil {
+			return fmt.Errorf("error restoring application %s: %w", a.ID, err)
+		}
+	}
+
+	for _, gm := range b.GroupMemberships {
+		if err := gm.Insert(ctx, exec, allColumns(models.GroupMembershipColumns)); err != nil {
+			return fmt.Errorf("error restoring group membership %s: %w", gm.ID, err)
+		}
+	}
+
+	for _, h := range b.GroupHierarchies {
+		if err := h.Insert(ctx, exec, allColumns(models.GroupHierarchyColumns)); err != nil {
+			return fmt.Errorf("error restoring group hierarchy %s: %w", h.ID, err)
+		}
+	}
+
+	for _, o := range b.GroupOrganizations {
+		if err := o.Insert(ctx, exec, allColumns(models.GroupOrganizationColumns)); err != nil {
+			return fmt.Errorf("error restoring group organization %s: %w", o.ID, err)
+		}
+	}
+
+	for _, a := range b.GroupApplications {
+		if err := a.Insert(ctx, exec, allColumns(models.GroupApplicationColumns)); err != nil {
+			return fmt.Errorf("error restoring group application %s: %w", a.ID, err)
+		}
+	}
+
+	for _, t := range b.NotificationTypes {
+		if err := t.Insert(ctx, exec, allColumns(models.NotificationTypeColumns)); err != nil {
+			return fmt.Errorf("error restoring notification type %s: %w", t.ID, err)
+		}
+	}
+
+	for _, t := range b.NotificationTargets {
+		if err := t.Insert(ctx, exec, allColumns(models.NotificationTargetColumns)); err != nil {
+			return fmt.Errorf("error restoring notification target %s: %w", t.ID, err)
+		}
+	}
+
+	for _, e := range b.Extensions {
+		if err := e.Insert(ctx, exec, allColumns(models.ExtensionColumns)); err != nil {
+			return fmt.Errorf("error restoring extension %s: %w", e.ID, err)
+		}
+	}
+
+	for _, erd := range b.ExtensionResourceDefinitions {
+		if err := erd.Insert(ctx, exec, allColumns(models.ExtensionResourceDefinitionColumns)); err != nil {
+			return fmt.Errorf("error restoring extension resource definition %s: %w", erd.ID, err)
+		}
+	}
+
+	for _, r := range b.SystemExtensionResources {
+		if err := r.Insert(ctx, exec, allColumns(models.SystemExtensionResourceColumns)); err != nil {
+			return fmt.Errorf("error restoring system extension resource %s: %w", r.ID, err)
+		}
+	}
+
+	for _, r := range b.UserExtensionResources {
+		if err := r.Insert(ctx, exec, allColumns(models.UserExtensionResourceColumns)); err != nil {
+			return fmt.Errorf("error restoring user extension resource %s: %w", r.ID, err)
+		}
+	}
+
+	return nil
+}
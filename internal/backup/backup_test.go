@@ -0,0 +1,124 @@
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func testBackup() *Backup {
+	return &Backup{
+		Version:       FormatVersion,
+		SchemaVersion: 81,
+		CreatedAt:     time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC),
+		Groups: models.GroupSlice{
+			{ID: "group-id", Name: "Group", Slug: "group", ApproverGroup: null.StringFrom("group-id")},
+		},
+		Users: models.UserSlice{
+			{ID: "user-id", Name: "User", Email: "user@example.com"},
+		},
+	}
+}
+
+func TestBackupName(t *testing.T) {
+	assert.Equal(t, "governor-20240501T123000Z.json", testBackup().Name())
+}
+
+func TestBackupSets(t *testing.T) {
+	sets := testBackup().sets()
+
+	require.Len(t, sets, 15)
+
+	for _, s := range sets {
+		switch s.table {
+		case models.TableNames.Groups:
+			assert.Equal(t, []string{"group-id"}, s.ids)
+		case models.TableNames.Users:
+			assert.Equal(t, []string{"user-id"}, s.ids)
+		default:
+			assert.Empty(t, s.ids, s.table)
+		}
+	}
+}
+
+func TestBackupRecords(t *testing.T) {
+	records := testBackup().Records()
+
+	assert.Len(t, records, 15)
+	assert.Equal(t, 1, records[models.TableNames.Groups])
+	assert.Equal(t, 0, records[models.TableNames.Extensions])
+}
+
+func TestDiffErr(t *testing.T) {
+	tests := []struct {
+		name    string
+		diff    Diff
+		wantErr error
+	}{
+		{
+			name: "empty database",
+			diff: Diff{BackupSchemaVersion: 81, DatabaseSchemaVersion: 81, Tables: []TableDiff{{Table: "groups", Records: 1}}},
+		},
+		{
+			name:    "schema version mismatch",
+			diff:    Diff{BackupSchemaVersion: 80, DatabaseSchemaVersion: 81},
+			wantErr: ErrSchemaVersionMismatch,
+		},
+		{
+			name:    "not empty",
+			diff:    Diff{BackupSchemaVersion: 81, DatabaseSchemaVersion: 81, Tables: []TableDiff{{Table: "groups", Records: 1, Existing: 2}}},
+			wantErr: ErrTargetNotEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.diff.Err(), tt.wantErr)
+		})
+	}
+}
+
+func TestAllColumns(t *testing.T) {
+	cols := allColumns(models.GroupColumns)
+	assert.Contains(t, cols.Cols, models.GroupColumns.ApproverGroup)
+	assert.Contains(t, cols.Cols, models.GroupColumns.ID)
+}
+
+func TestDirStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s := NewDirStore(dir)
+
+	names, err := s.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, names)
+
+	b := testBackup()
+	require.NoError(t, s.Put(ctx, b))
+
+	// files that aren't backups aren't listed
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("hello"), 0o600))
+
+	names, err = s.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{b.Name()}, names)
+
+	got, err := s.Get(ctx, b.Name())
+	require.NoError(t, err)
+	assert.Equal(t, b.SchemaVersion, got.SchemaVersion)
+	assert.Equal(t, b.Groups[0].ApproverGroup, got.Groups[0].ApproverGroup)
+
+	_, err = s.Get(ctx, "governor-20200101T000000Z.json")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = s.Get(ctx, "../governor.json")
+	assert.ErrorIs(t, err, ErrInvalidName)
+}
@@ -0,0 +1,112 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+)
+
+var (
+	// ErrInvalidName is returned when a backup name isn't a backup file name
+	ErrInvalidName = errors.New("invalid backup name")
+	// ErrNotFound is returned when a backup isn't in the store
+	ErrNotFound = errors.New("backup not found")
+)
+
+// validName matches the backup file names, so a name can't escape the store
+var validName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*\.json$`)
+
+// Store keeps the backups, like an object storage bucket
+type Store interface {
+	// Put stores a backup under its name
+	Put(ctx context.Context, b *Backup) error
+	// Get reads the backup with the name
+	Get(ctx context.Context, name string) (*Backup, error)
+	// List returns the names of the stored backups, oldest first
+	List(ctx context.Context) ([]string, error)
+}
+
+// DirStore stores the backups as JSON files in a directory, like an object
+// storage bucket mounted on the governor hosts
+type DirStore struct {
+	dir string
+}
+
+// NewDirStore returns a store of the backups in the directory
+func NewDirStore(dir string) *DirStore {
+	return &DirStore{dir: dir}
+}
+
+// Put writes a backup to a file named after it, the file is written under a
+// temporary name and renamed so a partial backup is never listed
+func (s *DirStore) Put(_ context.Context, b *Backup) error {
+	f, err := os.CreateTemp(s.dir, ".backup-*")
+	if err != nil {
+		return fmt.Errorf("error creating backup file: %w", err)
+	}
+
+	defer os.Remove(f.Name()) //nolint:errcheck
+
+	if err := json.NewEncoder(f).Encode(b); err != nil {
+		f.Close()
+		return fmt.Errorf("error writing backup: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error writing backup: %w", err)
+	}
+
+	return os.Rename(f.Name(), filepath.Join(s.dir, b.Name()))
+}
+
+// Get reads the backup file with the name
+func (s *DirStore) Get(_ context.Context, name string) (*Backup, error) {
+	if !validName.MatchString(name) {
+		return nil, ErrInvalidName
+	}
+
+	f, err := os.Open(filepath.Join(s.dir, name))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("error opening backup: %w", err)
+	}
+
+	defer f.Close()
+
+	b := &Backup{}
+	if err := json.NewDecoder(f).Decode(b); err != nil {
+		return nil, fmt.Errorf("error reading backup: %w", err)
+	}
+
+	return b, nil
+}
+
+// List returns the names of the backup files in the directory, the names
+// sort by creation time
+func (s *DirStore) List(_ context.Context) ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("error listing backups: %w", err)
+	}
+
+	names := []string{}
+
+	for _, e := range entries {
+		if e.Type().IsRegular() && validName.MatchString(e.Name()) {
+			names = append(names, e.Name())
+		}
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
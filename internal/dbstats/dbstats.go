@@ -0,0 +1,201 @@
+// Package dbstats records how long the SQL statements run, per api handler.
+// It wraps the database driver so every statement, whether it is built by
+// sqlboiler, sqlx or written by hand, is timed. The durations are exported as
+// prometheus metrics labelled with the route of the handler that ran them,
+// and the statements slower than a threshold are logged without their bound
+// parameters.
+package dbstats
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+const (
+	// BackgroundHandler is the handler label of the statements run outside of
+	// an api request, like by the background workers
+	BackgroundHandler = "background"
+
+	// unmatchedHandler is the handler label of the requests that didn't match
+	// a route
+	unmatchedHandler = "unmatched"
+
+	// maxLoggedQueryLength caps the length of the logged slow statements
+	maxLoggedQueryLength = 1000
+)
+
+// stringLiteral matches the quoted string literals of a statement
+var stringLiteral = regexp.MustCompile(`'(?:[^']|'')*'`)
+
+// Recorder times the SQL statements and exports their durations
+type Recorder struct {
+	logger        *zap.Logger
+	slowThreshold time.Duration
+
+	queries  *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	slow     *prometheus.CounterVec
+}
+
+// Option is a functional configuration option for the recorder
+type Option func(r *Recorder)
+
+// New returns a recorder of the SQL statement durations
+func New(opts ...Option) *Recorder {
+	r := &Recorder{
+		logger: zap.NewNop(),
+		queries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "governor_db_queries_total",
+			Help: "Number of SQL statements run, by api handler.",
+		}, []string{"handler"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "governor_db_query_duration_seconds",
+			Help:    "Duration of the SQL statements, by api handler.",
+			Buckets: prometheus.ExponentialBuckets(0.0005, 4, 8), //nolint:mnd
+		}, []string{"handler"}),
+		slow: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "governor_db_slow_queries_total",
+			Help: "Number of SQL statements slower than the slow query threshold, by api handler.",
+		}, []string{"handler"}),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// WithLogger sets the logger of the slow statements
+func WithLogger(l *zap.Logger) Option {
+	return func(r *Recorder) {
+		r.logger = l
+	}
+}
+
+// WithSlowThreshold sets the duration above which a statement is logged, 0
+// disables logging the slow statements
+func WithSlowThreshold(d time.Duration) Option {
+	return func(r *Recorder) {
+		r.slowThreshold = d
+	}
+}
+
+// Describe implements prometheus.Collector
+func (r *Recorder) Describe(ch chan<- *prometheus.Desc) {
+	r.queries.Describe(ch)
+	r.duration.Describe(ch)
+	r.slow.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (r *Recorder) Collect(ch chan<- prometheus.Metric) {
+	r.queries.Collect(ch)
+	r.duration.Collect(ch)
+	r.slow.Collect(ch)
+}
+
+// observe records a statement that started at start
+func (r *Recorder) observe(ctx context.Context, query string, args int, start time.Time, err error) {
+	elapsed := time.Since(start)
+
+	handler := BackgroundHandler
+
+	if stats := FromContext(ctx); stats != nil {
+		handler = stats.handler
+		stats.queries.Add(1)
+		stats.duration.Add(int64(elapsed))
+	}
+
+	r.queries.WithLabelValues(handler).Inc()
+	r.duration.WithLabelValues(handler).Observe(elapsed.Seconds())
+
+	if r.slowThreshold <= 0 || elapsed < r.slowThreshold {
+		return
+	}
+
+	r.slow.WithLabelValues(handler).Inc()
+
+	fields := []zap.Field{
+		zap.String("handler", handler),
+		zap.Float64("duration_ms", float64(elapsed.Microseconds())/1000), //nolint:mnd
+		zap.String("query", redactQuery(query)),
+		zap.Int("args", args),
+	}
+
+	if err != nil {
+		fields = append(fields, zap.Error(err))
+	}
+
+	r.logger.Warn("slow query", fields...)
+}
+
+// redactQuery removes the string literals of a statement, the bound
+// parameters are never logged, and collapses its whitespace
+func redactQuery(query string) string {
+	query = strings.Join(strings.Fields(stringLiteral.ReplaceAllString(query, "'?'")), " ")
+
+	if len(query) > maxLoggedQueryLength {
+		query = query[:maxLoggedQueryLength] + "..."
+	}
+
+	return query
+}
+
+// RequestStats are the statements run for an api request
+type RequestStats struct {
+	handler  string
+	queries  atomic.Int64
+	duration atomic.Int64
+}
+
+// Queries returns the number of statements run for the request
+func (s *RequestStats) Queries() int64 {
+	return s.queries.Load()
+}
+
+// Duration returns the total duration of the statements run for the request
+func (s *RequestStats) Duration() time.Duration {
+	return time.Duration(s.duration.Load())
+}
+
+type requestStatsKey struct{}
+
+// WithHandler returns a context whose statements are recorded for the
+// handler, and their stats
+func WithHandler(ctx context.Context, handler string) (context.Context, *RequestStats) {
+	stats := &RequestStats{handler: handler}
+
+	return context.WithValue(ctx, requestStatsKey{}, stats), stats
+}
+
+// FromContext returns the stats of the request of the context, or nil
+// outside of a request
+func FromContext(ctx context.Context) *RequestStats {
+	stats, _ := ctx.Value(requestStatsKey{}).(*RequestStats)
+
+	return stats
+}
+
+// Middleware returns the gin middleware recording the statements of the
+// requests for the route of their handler
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		handler := c.FullPath()
+		if handler == "" {
+			handler = unmatchedHandler
+		}
+
+		ctx, _ := WithHandler(c.Request.Context(), c.Request.Method+" "+handler)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,151 @@
+package dbstats
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// fakeConnector connects to a database whose statements take delay
+type fakeConnector struct {
+	delay time.Duration
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{delay: c.delay}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver { return nil }
+
+type fakeConn struct {
+	delay time.Duration
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+func (c *fakeConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	time.Sleep(c.delay)
+	return &fakeRows{}, nil
+}
+
+func (c *fakeConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	time.Sleep(c.delay)
+	return driver.RowsAffected(1), nil
+}
+
+type fakeRows struct{}
+
+func (r *fakeRows) Columns() []string         { return []string{"id"} }
+func (r *fakeRows) Close() error              { return nil }
+func (r *fakeRows) Next([]driver.Value) error { return io.EOF }
+
+func TestRecorder(t *testing.T) {
+	core, logs := observer.New(zapcore.WarnLevel)
+
+	rec := New(WithLogger(zap.New(core)), WithSlowThreshold(5*time.Millisecond))
+
+	db := sql.OpenDB(rec.Connector(&fakeConnector{}))
+	defer db.Close()
+
+	ctx, stats := WithHandler(context.Background(), "GET /api/v1alpha1/groups")
+
+	_, err := db.ExecContext(ctx, "UPDATE groups SET name = $1 WHERE id = $2", "secret", "id")
+	require.NoError(t, err)
+
+	rows, err := db.QueryContext(ctx, "SELECT id FROM groups")
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+
+	assert.Equal(t, int64(2), stats.Queries())
+	assert.Equal(t, 0, logs.Len(), "fast statements aren't logged")
+
+	slowDB := sql.OpenDB(rec.Connector(&fakeConnector{delay: 10 * time.Millisecond}))
+	defer slowDB.Close()
+
+	_, err = slowDB.ExecContext(ctx, "UPDATE users SET email = 'user@example.com' WHERE id = $1", "user-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), stats.Queries())
+	assert.GreaterOrEqual(t, stats.Duration(), 10*time.Millisecond)
+
+	require.Equal(t, 1, logs.Len())
+
+	entry := logs.All()[0].ContextMap()
+	assert.Equal(t, "GET /api/v1alpha1/groups", entry["handler"])
+	assert.Equal(t, "UPDATE users SET email = '?' WHERE id = $1", entry["query"])
+	assert.Equal(t, int64(1), entry["args"])
+	assert.NotContains(t, entry["query"], "user-id")
+
+	// statements outside of a request are recorded as background
+	_, err = slowDB.ExecContext(context.Background(), "DELETE FROM jobs")
+	require.NoError(t, err)
+
+	require.Equal(t, 2, logs.Len())
+	assert.Equal(t, BackgroundHandler, logs.All()[1].ContextMap()["handler"])
+}
+
+func TestRedactQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "placeholders",
+			query: "SELECT * FROM users WHERE id = $1",
+			want:  "SELECT * FROM users WHERE id = $1",
+		},
+		{
+			name:  "string literals",
+			query: "SELECT * FROM users WHERE email = 'user@example.com' AND name = 'O''Brien'",
+			want:  "SELECT * FROM users WHERE email = '?' AND name = '?'",
+		},
+		{
+			name:  "whitespace",
+			query: "SELECT *\n\tFROM   users",
+			want:  "SELECT * FROM users",
+		},
+		{
+			name:  "long statement",
+			query: "SELECT " + strings.Repeat("x", 2*maxLoggedQueryLength),
+			want:  "SELECT " + strings.Repeat("x", maxLoggedQueryLength-len("SELECT ")) + "...",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, redactQuery(tt.query))
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var handler string
+
+	engine := gin.New()
+	engine.Use(Middleware())
+	engine.GET("/groups/:id", func(c *gin.Context) {
+		handler = FromContext(c.Request.Context()).handler
+		c.Status(http.StatusOK)
+	})
+
+	engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/groups/some-id", nil))
+	assert.Equal(t, "GET /groups/:id", handler)
+}
@@ -0,0 +1,190 @@
+package dbstats
+
+import (
+	"context"
+	"database/sql/driver"
+	"time"
+)
+
+// Connector wraps a database connector so the statements of its connections
+// are recorded
+func (r *Recorder) Connector(c driver.Connector) driver.Connector {
+	return &connector{Connector: c, recorder: r}
+}
+
+type connector struct {
+	driver.Connector
+	recorder *Recorder
+}
+
+// Connect implements driver.Connector
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	cn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &conn{Conn: cn, recorder: c.recorder}, nil
+}
+
+// conn records the statements of a connection. The optional driver
+// interfaces are passed through, driver.ErrSkip makes database/sql fall back
+// to the ones the wrapped connection supports.
+type conn struct {
+	driver.Conn
+	recorder *Recorder
+}
+
+// QueryContext implements driver.QueryerContext
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	q, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	start := time.Now()
+	rows, err := q.QueryContext(ctx, query, args)
+
+	if err != driver.ErrSkip { //nolint:errorlint
+		c.recorder.observe(ctx, query, len(args), start, err)
+	}
+
+	return rows, err
+}
+
+// ExecContext implements driver.ExecerContext
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	e, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	start := time.Now()
+	res, err := e.ExecContext(ctx, query, args)
+
+	if err != driver.ErrSkip { //nolint:errorlint
+		c.recorder.observe(ctx, query, len(args), start, err)
+	}
+
+	return res, err
+}
+
+// PrepareContext implements driver.ConnPrepareContext
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	var (
+		st  driver.Stmt
+		err error
+	)
+
+	if p, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		st, err = p.PrepareContext(ctx, query)
+	} else {
+		st, err = c.Conn.Prepare(query)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &stmt{Stmt: st, query: query, recorder: c.recorder}, nil
+}
+
+// Prepare implements driver.Conn
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+// BeginTx implements driver.ConnBeginTx
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if b, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return b.BeginTx(ctx, opts)
+	}
+
+	return c.Conn.Begin() //nolint:staticcheck
+}
+
+// Ping implements driver.Pinger
+func (c *conn) Ping(ctx context.Context) error {
+	if p, ok := c.Conn.(driver.Pinger); ok {
+		return p.Ping(ctx)
+	}
+
+	return nil
+}
+
+// ResetSession implements driver.SessionResetter
+func (c *conn) ResetSession(ctx context.Context) error {
+	if r, ok := c.Conn.(driver.SessionResetter); ok {
+		return r.ResetSession(ctx)
+	}
+
+	return nil
+}
+
+// IsValid implements driver.Validator
+func (c *conn) IsValid() bool {
+	if v, ok := c.Conn.(driver.Validator); ok {
+		return v.IsValid()
+	}
+
+	return true
+}
+
+// stmt records the executions of a prepared statement
+type stmt struct {
+	driver.Stmt
+	query    string
+	recorder *Recorder
+}
+
+// QueryContext implements driver.StmtQueryContext
+func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+
+	var (
+		rows driver.Rows
+		err  error
+	)
+
+	if q, ok := s.Stmt.(driver.StmtQueryContext); ok {
+		rows, err = q.QueryContext(ctx, args)
+	} else {
+		rows, err = s.Stmt.Query(namedValues(args)) //nolint:staticcheck
+	}
+
+	s.recorder.observe(ctx, s.query, len(args), start, err)
+
+	return rows, err
+}
+
+// ExecContext implements driver.StmtExecContext
+func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+
+	var (
+		res driver.Result
+		err error
+	)
+
+	if e, ok := s.Stmt.(driver.StmtExecContext); ok {
+		res, err = e.ExecContext(ctx, args)
+	} else {
+		res, err = s.Stmt.Exec(namedValues(args)) //nolint:staticcheck
+	}
+
+	s.recorder.observe(ctx, s.query, len(args), start, err)
+
+	return res, err
+}
+
+// namedValues returns the values of the arguments, for the drivers without
+// context support
+func namedValues(args []driver.NamedValue) []driver.Value {
+	values := make([]driver.Value, len(args))
+
+	for i, a := range args {
+		values[i] = a.Value
+	}
+
+	return values
+}
@@ -0,0 +1,136 @@
+package dbtools
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// applicationAccessQuery starts from the direct group to application links in `group_applications` and recursively joins them
+// with `group_hierarchies`, the same way as allMembershipsQuery, except that access flows down the hierarchy: members of a member
+// group are members of the parent group, so a member group is granted every application of its parent groups. The `granted_by`
+// column keeps the groups holding the direct link so that indirect access can be traced back. Multiple paths to the same
+// application are collapsed with a GROUP BY, and the names and slugs are joined in so that no extra lookups are needed.
+// Links past their `expires_at` don't grant access, even before they are removed by the reaper. The groups and applications
+// are limited to the ones visible to the organization in `$1`, like the models queries are, NULL doesn't limit them.
+
+// applicationAccessByApplicationQuery filters the "initial state" on `application_id` in `$2` for the same reason
+// membershipsByUserQuery filters on `user_id`, CRDB doesn't push the predicate down into the recursive query.
+
+const (
+	unexpiredGroupApplication = `(group_applications.expires_at IS NULL OR group_applications.expires_at > NOW())`
+	applicationAccessSelect   = `
+	SELECT
+		access_query.application_id,
+		applications.slug AS application_slug,
+		applications.name AS application_name,
+		access_query.group_id,
+		groups.slug AS group_slug,
+		groups.name AS group_name,
+		BOOL_OR(access_query.direct) AS direct,
+		ARRAY_AGG(DISTINCT access_query.granted_by ORDER BY access_query.granted_by) AS granted_by
+	FROM
+		access_query
+		INNER JOIN applications ON applications.id = access_query.application_id
+		INNER JOIN groups ON groups.id = access_query.group_id
+	GROUP BY
+		access_query.application_id,
+		applications.slug,
+		applications.name,
+		access_query.group_id,
+		groups.slug,
+		groups.name
+	ORDER BY
+		applications.slug,
+		groups.slug;`
+	applicationAccessRecursion = `
+		UNION ALL
+		SELECT
+			a.application_id,
+			b.member_group_id AS group_id,
+			a.granted_by,
+			FALSE AS direct
+		FROM
+			access_query AS a
+			INNER JOIN group_hierarchies AS b ON a.group_id = b.parent_group_id
+			INNER JOIN groups as membergroup ON membergroup.id = b.member_group_id AND membergroup.deleted_at IS NULL
+				AND ($1::UUID IS NULL OR membergroup.tenant_id IS NULL OR membergroup.tenant_id = $1::UUID)
+	)`
+	applicationAccessQuery = `WITH RECURSIVE access_query AS (
+		SELECT
+			group_applications.application_id,
+			group_applications.group_id,
+			group_applications.group_id AS granted_by,
+			TRUE AS direct
+		FROM
+			group_applications
+			INNER JOIN groups ON groups.id = group_applications.group_id AND groups.deleted_at IS NULL
+				AND ($1::UUID IS NULL OR groups.tenant_id IS NULL OR groups.tenant_id = $1::UUID)
+			INNER JOIN applications ON applications.id = group_applications.application_id AND applications.deleted_at IS NULL
+				AND ($1::UUID IS NULL OR applications.tenant_id IS NULL OR applications.tenant_id = $1::UUID)
+		WHERE
+			group_applications.deleted_at IS NULL AND ` + unexpiredGroupApplication + applicationAccessRecursion + applicationAccessSelect
+	applicationAccessByApplicationQuery = `WITH RECURSIVE access_query AS (
+		SELECT
+			group_applications.application_id,
+			group_applications.group_id,
+			group_applications.group_id AS granted_by,
+			TRUE AS direct
+		FROM
+			group_applications
+			INNER JOIN groups ON groups.id = group_applications.group_id AND groups.deleted_at IS NULL
+				AND ($1::UUID IS NULL OR groups.tenant_id IS NULL OR groups.tenant_id = $1::UUID)
+			INNER JOIN applications ON applications.id = group_applications.application_id AND applications.deleted_at IS NULL
+				AND ($1::UUID IS NULL OR applications.tenant_id IS NULL OR applications.tenant_id = $1::UUID)
+		WHERE
+			group_applications.deleted_at IS NULL AND ` + unexpiredGroupApplication + ` AND group_applications.application_id = $2` + applicationAccessRecursion + applicationAccessSelect
+)
+
+// UnexpiredGroupApplications is a query mod filtering out the group application links past their expiry
+func UnexpiredGroupApplications() qm.QueryMod {
+	return qm.Where(unexpiredGroupApplication)
+}
+
+// ApplicationAccess represents a group being granted access to an application, either directly or through a parent group
+type ApplicationAccess struct {
+	ApplicationID   string            `boil:"application_id" json:"application_id"`
+	ApplicationSlug string            `boil:"application_slug" json:"application_slug"`
+	ApplicationName string            `boil:"application_name" json:"application_name"`
+	GroupID         string            `boil:"group_id" json:"group_id"`
+	GroupSlug       string            `boil:"group_slug" json:"group_slug"`
+	GroupName       string            `boil:"group_name" json:"group_name"`
+	Direct          bool              `boil:"direct" json:"direct"`
+	GrantedBy       types.StringArray `boil:"granted_by" json:"granted_by"`
+}
+
+// GetApplicationAccess returns every group to application access in the database, including access inherited through the group hierarchy,
+// limited to the groups and applications visible to the organization of the context
+func GetApplicationAccess(ctx context.Context, db boil.ContextExecutor) ([]ApplicationAccess, error) {
+	access := []ApplicationAccess{}
+
+	if err := queries.Raw(applicationAccessQuery, tenantParam(ctx)).Bind(ctx, db, &access); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return access, nil
+}
+
+// GetApplicationAccessForApplication returns the groups granted access to an application, including access inherited through the group hierarchy
+func GetApplicationAccessForApplication(ctx context.Context, db boil.ContextExecutor, applicationID string) ([]ApplicationAccess, error) {
+	access := []ApplicationAccess{}
+
+	if err := queries.Raw(applicationAccessByApplicationQuery, tenantParam(ctx), applicationID).Bind(ctx, db, &access); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return access, nil
+}
@@ -0,0 +1,138 @@
+package dbtools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+)
+
+func seedApplicationAccess(t *testing.T) {
+	t.Helper()
+
+	testData := []string{
+		`INSERT INTO "applications" ("id", "name", "slug", "created_at", "updated_at", "deleted_at") VALUES
+		('00000005-0000-0000-0000-000000000001', 'App1', 'app-1', '2023-07-12 12:00:00.000000+00', '2023-07-12 12:00:00.000000+00', NULL)
+		ON CONFLICT DO NOTHING;`,
+		`INSERT INTO "applications" ("id", "name", "slug", "created_at", "updated_at", "deleted_at") VALUES
+		('00000005-0000-0000-0000-000000000002', 'App2', 'app-2', '2023-07-12 12:00:00.000000+00', '2023-07-12 12:00:00.000000+00', NULL)
+		ON CONFLICT DO NOTHING;`,
+		`INSERT INTO "group_applications" ("id", "group_id", "application_id", "created_at", "updated_at", "deleted_at") VALUES
+		('00000006-0000-0000-0000-000000000001', '00000002-0000-0000-0000-000000000001', '00000005-0000-0000-0000-000000000001', '2023-07-12 12:00:00.000000+00', '2023-07-12 12:00:00.000000+00', NULL)
+		ON CONFLICT DO NOTHING;`,
+		`INSERT INTO "group_applications" ("id", "group_id", "application_id", "created_at", "updated_at", "deleted_at") VALUES
+		('00000006-0000-0000-0000-000000000002', '00000002-0000-0000-0000-000000000003', '00000005-0000-0000-0000-000000000002', '2023-07-12 12:00:00.000000+00', '2023-07-12 12:00:00.000000+00', NULL)
+		ON CONFLICT DO NOTHING;`,
+	}
+
+	for _, q := range testData {
+		_, err := db.Exec(q)
+		require.NoError(t, err)
+	}
+}
+
+func TestGetApplicationAccess(t *testing.T) {
+	seedApplicationAccess(t)
+
+	app1 := func(groupID, groupSlug, groupName string, direct bool) ApplicationAccess {
+		return ApplicationAccess{
+			ApplicationID:   "00000005-0000-0000-0000-000000000001",
+			ApplicationSlug: "app-1",
+			ApplicationName: "App1",
+			GroupID:         groupID,
+			GroupSlug:       groupSlug,
+			GroupName:       groupName,
+			Direct:          direct,
+			GrantedBy:       types.StringArray{"00000002-0000-0000-0000-000000000001"},
+		}
+	}
+
+	expectApp1 := []ApplicationAccess{
+		app1("00000002-0000-0000-0000-000000000001", "group-1", "Group1", true),
+		app1("00000002-0000-0000-0000-000000000002", "group-2", "Group2", false),
+		app1("00000002-0000-0000-0000-000000000003", "group-3", "Group3", false),
+	}
+
+	expectApp2 := ApplicationAccess{
+		ApplicationID:   "00000005-0000-0000-0000-000000000002",
+		ApplicationSlug: "app-2",
+		ApplicationName: "App2",
+		GroupID:         "00000002-0000-0000-0000-000000000003",
+		GroupSlug:       "group-3",
+		GroupName:       "Group3",
+		Direct:          true,
+		GrantedBy:       types.StringArray{"00000002-0000-0000-0000-000000000003"},
+	}
+
+	all, err := GetApplicationAccess(context.TODO(), db)
+	require.NoError(t, err)
+	assert.Equal(t, append(expectApp1, expectApp2), all)
+
+	byApp, err := GetApplicationAccessForApplication(context.TODO(), db, "00000005-0000-0000-0000-000000000001")
+	require.NoError(t, err)
+	assert.Equal(t, expectApp1, byApp)
+}
+
+func TestGetApplicationAccessTenants(t *testing.T) {
+	seedApplicationAccess(t)
+
+	const (
+		group1 = "00000002-0000-0000-0000-000000000001"
+		group2 = "00000002-0000-0000-0000-000000000002"
+		group3 = "00000002-0000-0000-0000-000000000003"
+		app1   = "00000005-0000-0000-0000-000000000001"
+		app2   = "00000005-0000-0000-0000-000000000002"
+	)
+
+	ctx := context.TODO()
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+
+	defer func() { _ = tx.Rollback() }()
+
+	// app-1 is linked to group-1 and inherited by group-2 and group-3, which
+	// belongs to the other tenant with app-2
+	seedTenants(t, tx, map[string]map[string][]string{
+		testTenantA: {"groups": {group1, group2}, "applications": {app1}},
+		testTenantB: {"groups": {group3}, "applications": {app2}},
+	})
+
+	type grant struct{ app, group string }
+
+	grants := func(access []ApplicationAccess) []grant {
+		g := []grant{}
+		for _, a := range access {
+			g = append(g, grant{a.ApplicationID, a.GroupID})
+		}
+
+		return g
+	}
+
+	all, err := GetApplicationAccess(ctx, tx)
+	require.NoError(t, err)
+	assert.Subset(t, grants(all), []grant{{app1, group1}, {app1, group2}, {app1, group3}, {app2, group3}})
+
+	tenantA, err := GetApplicationAccess(tenancy.WithTenant(ctx, testTenantA), tx)
+	require.NoError(t, err)
+	assert.Subset(t, grants(tenantA), []grant{{app1, group1}, {app1, group2}})
+	assert.NotContains(t, grants(tenantA), grant{app1, group3})
+	assert.NotContains(t, grants(tenantA), grant{app2, group3})
+
+	tenantB, err := GetApplicationAccess(tenancy.WithTenant(ctx, testTenantB), tx)
+	require.NoError(t, err)
+	assert.Contains(t, grants(tenantB), grant{app2, group3})
+	assert.NotContains(t, grants(tenantB), grant{app1, group3})
+
+	byApp, err := GetApplicationAccessForApplication(tenancy.WithTenant(ctx, testTenantB), tx, app1)
+	require.NoError(t, err)
+	assert.Empty(t, byApp)
+
+	byApp, err = GetApplicationAccessForApplication(tenancy.WithTenant(ctx, testTenantA), tx, app1)
+	require.NoError(t, err)
+	assert.Equal(t, []grant{{app1, group1}, {app1, group2}}, grants(byApp))
+}
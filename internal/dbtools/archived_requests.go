@@ -0,0 +1,81 @@
+package dbtools
+
+import (
+	"context"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const (
+	// ArchivedRequestTypeGroupMembership is the type of the archived group membership requests
+	ArchivedRequestTypeGroupMembership = "group_membership"
+	// ArchivedRequestTypeGroupApplication is the type of the archived group application requests
+	ArchivedRequestTypeGroupApplication = "group_application"
+
+	// ArchivedRequestApproved is the decision of the approved requests
+	ArchivedRequestApproved = "approved"
+	// ArchivedRequestDenied is the decision of the denied requests
+	ArchivedRequestDenied = "denied"
+)
+
+// ArchiveGroupMembershipRequest records the decision on a group membership request in the archive,
+// it must run in the transaction deleting the request
+func ArchiveGroupMembershipRequest(
+	ctx context.Context, exec boil.ContextExecutor, r *models.GroupMembershipRequest, decision string, decidedBy *models.User,
+) (*models.ArchivedRequest, error) {
+	archived := models.ArchivedRequest{
+		RequestID:       r.ID,
+		RequestType:     ArchivedRequestTypeGroupMembership,
+		Kind:            null.StringFrom(r.Kind),
+		GroupID:         r.GroupID,
+		UserID:          null.StringFrom(r.UserID),
+		RequesterUserID: r.UserID,
+		Note:            r.Note,
+		IsAdmin:         r.IsAdmin,
+		ExpiresAt:       r.ExpiresAt,
+		AdminExpiresAt:  r.AdminExpiresAt,
+		Decision:        decision,
+		DecidedByUserID: decidedByID(decidedBy),
+		RequestedAt:     r.CreatedAt,
+		DecidedAt:       time.Now(),
+	}
+
+	return &archived, archived.Insert(ctx, exec, boil.Infer())
+}
+
+// ArchiveGroupApplicationRequest records the decision on a group application request in the archive,
+// it must run in the transaction deleting the request
+func ArchiveGroupApplicationRequest(
+	ctx context.Context, exec boil.ContextExecutor, r *models.GroupApplicationRequest, decision string, decidedBy *models.User,
+) (*models.ArchivedRequest, error) {
+	archived := models.ArchivedRequest{
+		RequestID:       r.ID,
+		RequestType:     ArchivedRequestTypeGroupApplication,
+		GroupID:         r.GroupID,
+		ApplicationID:   null.StringFrom(r.ApplicationID),
+		ApproverGroupID: null.StringFrom(r.ApproverGroupID),
+		RequesterUserID: r.RequesterUserID,
+		Note:            r.Note.String,
+		ExpiresAt:       r.ExpiresAt,
+		Decision:        decision,
+		DecidedByUserID: decidedByID(decidedBy),
+		RequestedAt:     r.CreatedAt,
+		DecidedAt:       time.Now(),
+	}
+
+	return &archived, archived.Insert(ctx, exec, boil.Infer())
+}
+
+// decidedByID returns the id of the user deciding on a request, non-user api
+// clients aren't recorded
+func decidedByID(u *models.User) null.String {
+	if u == nil {
+		return null.String{}
+	}
+
+	return null.StringFrom(u.ID)
+}
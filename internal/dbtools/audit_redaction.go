@@ -0,0 +1,72 @@
+package dbtools
+
+import (
+	"context"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// redactChangeset returns the changeset with the lines of the given fields
+// replaced by a line without their values, and whether any line was replaced
+func redactChangeset(changeset []string, fields []string) ([]string, bool) {
+	redacted := make([]string, len(changeset))
+	changed := false
+
+	for i, line := range changeset {
+		redacted[i] = line
+
+		for _, f := range fields {
+			if strings.HasPrefix(line, f+": ") && line != f+": scrubbed" {
+				redacted[i] = f + ": scrubbed"
+				changed = true
+
+				break
+			}
+		}
+	}
+
+	return redacted, changed
+}
+
+// UserAuditEventsWithFields returns the audit events of a user with changeset
+// lines of the given fields, such as the user.created and user.updated events
+func UserAuditEventsWithFields(ctx context.Context, exec boil.ContextExecutor, userID string, fields []string) (models.AuditEventSlice, error) {
+	all, err := models.AuditEvents(qm.Where("subject_user_id = ?", userID)).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	found := models.AuditEventSlice{}
+
+	for _, e := range all {
+		if _, changed := redactChangeset(e.Changeset, fields); changed {
+			found = append(found, e)
+		}
+	}
+
+	return found, nil
+}
+
+// RedactAuditEvents replaces the changeset lines of the given fields with a
+// line without their values, the events are updated in place
+func RedactAuditEvents(ctx context.Context, exec boil.ContextExecutor, events models.AuditEventSlice, fields []string) error {
+	for _, e := range events {
+		changeset, changed := redactChangeset(e.Changeset, fields)
+		if !changed {
+			continue
+		}
+
+		e.Changeset = types.StringArray(changeset)
+
+		if _, err := e.Update(ctx, exec, boil.Whitelist(models.AuditEventColumns.Changeset)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package dbtools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestRedactChangeset(t *testing.T) {
+	fields := []string{"Name", "Email", "ExternalID", "AvatarURL", "GithubUsername", "GithubID"}
+
+	created := calculateChangeset(&models.User{}, &models.User{
+		Name:           "Jane Doe",
+		Email:          "jane@example.com",
+		GithubUsername: null.StringFrom("janedoe"),
+		GithubID:       null.Int64From(4242),
+		Status:         null.StringFrom("active"),
+	})
+
+	updated := calculateChangeset(&models.User{
+		Name:  "Jane Doe",
+		Email: "jane@example.com",
+	}, &models.User{
+		Name:  "Jane Roe",
+		Email: "jane.roe@example.com",
+	})
+
+	for _, changeset := range [][]string{created, updated} {
+		redacted, changed := redactChangeset(changeset, fields)
+		assert.True(t, changed)
+		assert.Len(t, redacted, len(changeset))
+
+		for _, line := range redacted {
+			for _, pii := range []string{"Jane", "jane", "example.com", "4242"} {
+				assert.NotContains(t, line, pii)
+			}
+		}
+	}
+
+	redacted, _ := redactChangeset(created, fields)
+	assert.Contains(t, redacted, "Name: scrubbed")
+	assert.Contains(t, redacted, "Email: scrubbed")
+	assert.Contains(t, redacted, "GithubUsername: scrubbed")
+	assert.Contains(t, redacted, `Status: "" => "active"`, "the other fields are kept")
+	assert.Contains(t, redacted, "GithubID: scrubbed")
+
+	// the changesets already redacted or without personal data don't change
+	_, changed := redactChangeset(redacted, fields)
+	assert.False(t, changed)
+
+	_, changed = redactChangeset([]string{`Status: "active" => "suspended"`}, fields)
+	assert.False(t, changed)
+}
@@ -0,0 +1,245 @@
+package dbtools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+)
+
+const (
+	// DeletedUsers is the kind of the soft deleted users
+	DeletedUsers = "users"
+	// DeletedGroups is the kind of the soft deleted groups
+	DeletedGroups = "groups"
+	// DeletedApplications is the kind of the soft deleted applications
+	DeletedApplications = "applications"
+	// DeletedExtensions is the kind of the soft deleted extensions
+	DeletedExtensions = "extensions"
+)
+
+var (
+	// ErrUnknownDeletedKind is returned for a kind of soft deleted records that doesn't exist
+	ErrUnknownDeletedKind = errors.New("unknown kind of deleted records")
+	// ErrDeletedRecordNotPurged is returned when the record to purge doesn't exist or isn't soft deleted
+	ErrDeletedRecordNotPurged = errors.New("deleted record was not purged")
+)
+
+// DeletedRecord is a soft deleted user, group, application or extension, with
+// the actor and audit event of its deletion when they were recorded
+type DeletedRecord struct {
+	ID              string      `boil:"id" json:"id"`
+	Name            string      `boil:"name" json:"name"`
+	Slug            null.String `boil:"slug" json:"slug,omitempty"`
+	Email           null.String `boil:"email" json:"email,omitempty"`
+	DeletedAt       time.Time   `boil:"deleted_at" json:"deleted_at"`
+	DeletedByID     null.String `boil:"deleted_by_id" json:"deleted_by_id,omitempty"`
+	DeletedByName   null.String `boil:"deleted_by_name" json:"deleted_by_name,omitempty"`
+	DeletionAuditID null.String `boil:"deletion_audit_id" json:"deletion_audit_id,omitempty"`
+}
+
+// deletedKind describes how the soft deleted records of a kind are listed and
+// purged
+type deletedKind struct {
+	table       string
+	singular    string
+	slug        string
+	email       string
+	subject     string
+	tenantScope bool
+	// purge removes the records referencing the record $1, the references
+	// that are history are cleared instead, and the record itself last
+	purge []string
+}
+
+var deletedKinds = map[string]deletedKind{
+	DeletedUsers: {
+		table:       models.TableNames.Users,
+		singular:    "user",
+		slug:        "NULL::STRING",
+		email:       "users.email",
+		subject:     models.AuditEventColumns.SubjectUserID,
+		tenantScope: true,
+		purge: []string{
+			`DELETE FROM group_memberships WHERE user_id = $1`,
+			`DELETE FROM group_membership_requests WHERE user_id = $1`,
+			`DELETE FROM user_pubkeys WHERE user_id = $1`,
+			`DELETE FROM user_email_changes WHERE user_id = $1`,
+			`DELETE FROM request_comments WHERE user_id = $1`,
+			`DELETE FROM archived_requests WHERE requester_user_id = $1`,
+			`UPDATE archived_requests SET user_id = NULL WHERE user_id = $1`,
+			`UPDATE archived_requests SET decided_by_user_id = NULL WHERE decided_by_user_id = $1`,
+			`UPDATE jobs SET created_by = NULL WHERE created_by = $1`,
+			`DELETE FROM users WHERE id = $1 AND deleted_at IS NOT NULL`,
+		},
+	},
+	DeletedGroups: {
+		table:       models.TableNames.Groups,
+		singular:    "group",
+		slug:        "groups.slug",
+		email:       "NULL::STRING",
+		subject:     models.AuditEventColumns.SubjectGroupID,
+		tenantScope: true,
+		purge: []string{
+			`DELETE FROM group_memberships WHERE group_id = $1`,
+			`DELETE FROM group_membership_requests WHERE group_id = $1`,
+			`DELETE FROM group_organizations WHERE group_id = $1`,
+			`DELETE FROM group_applications WHERE group_id = $1`,
+			`DELETE FROM group_hierarchies WHERE parent_group_id = $1 OR member_group_id = $1`,
+			`DELETE FROM archived_requests WHERE group_id = $1`,
+			`UPDATE archived_requests SET approver_group_id = NULL WHERE approver_group_id = $1`,
+			`UPDATE applications SET approver_group_id = NULL WHERE approver_group_id = $1`,
+			`UPDATE groups SET approver_group = NULL WHERE approver_group = $1`,
+			`UPDATE extension_resource_definitions SET admin_group = NULL WHERE admin_group = $1`,
+			`DELETE FROM groups WHERE id = $1 AND deleted_at IS NOT NULL`,
+		},
+	},
+	DeletedApplications: {
+		table:       models.TableNames.Applications,
+		singular:    "application",
+		slug:        "applications.slug",
+		email:       "NULL::STRING",
+		subject:     models.AuditEventColumns.SubjectApplicationID,
+		tenantScope: true,
+		purge: []string{
+			`UPDATE archived_requests SET application_id = NULL WHERE application_id = $1`,
+			`DELETE FROM applications WHERE id = $1 AND deleted_at IS NOT NULL`,
+		},
+	},
+	DeletedExtensions: {
+		table:    models.TableNames.Extensions,
+		singular: "extension",
+		slug:     "extensions.slug",
+		email:    "NULL::STRING",
+		subject:  models.AuditEventColumns.SubjectExtensionID,
+		// the resource definitions, resources, credentials and event subject
+		// rules of the extension are removed by cascade
+		purge: []string{
+			`DELETE FROM extensions WHERE id = $1 AND deleted_at IS NOT NULL`,
+		},
+	},
+}
+
+// IsDeletedKind returns true if kind is a kind of soft deleted records
+func IsDeletedKind(kind string) bool {
+	_, ok := deletedKinds[kind]
+	return ok
+}
+
+// GetDeletedRecords returns the soft deleted records of a kind visible to the
+// organization of the context, most recently deleted first. The actor of the
+// deletion comes from the latest deletion audit event of each record. Only
+// the records with the ids are returned when ids aren't empty.
+func GetDeletedRecords(ctx context.Context, exec boil.ContextExecutor, kind string, ids ...string) ([]*DeletedRecord, error) {
+	k, ok := deletedKinds[kind]
+	if !ok {
+		return nil, ErrUnknownDeletedKind
+	}
+
+	mods := []qm.QueryMod{
+		qm.Select(
+			k.table+".id",
+			k.table+".name",
+			k.slug+" AS slug",
+			k.email+" AS email",
+			k.table+".deleted_at",
+			"deletion.actor_id AS deleted_by_id",
+			"actors.name AS deleted_by_name",
+			"deletion.id AS deletion_audit_id",
+		),
+		qm.From(k.table),
+		qm.LeftOuterJoin(fmt.Sprintf(
+			`LATERAL (SELECT id, actor_id FROM audit_events WHERE %s = %s.id AND action = '%s.deleted' ORDER BY created_at DESC LIMIT 1) AS deletion ON true`,
+			k.subject, k.table, k.singular,
+		)),
+		qm.LeftOuterJoin("users AS actors ON actors.id = deletion.actor_id"),
+		qm.Where(k.table + ".deleted_at IS NOT NULL"),
+		qm.OrderBy(k.table + ".deleted_at DESC, " + k.table + ".id"),
+	}
+
+	if k.tenantScope {
+		mods = append(mods, tenancy.Scope(ctx, k.table))
+	}
+
+	if len(ids) > 0 {
+		mods = append(mods, qm.WhereIn(k.table+".id IN ?", stringSliceToInterface(ids)...))
+	}
+
+	records := []*DeletedRecord{}
+	if err := models.NewQuery(mods...).Bind(ctx, exec, &records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// PurgeDeletedRecord permanently removes a soft deleted record of a kind and
+// the records referencing it. The audit events are kept, with the id of the
+// purged record.
+func PurgeDeletedRecord(ctx context.Context, exec boil.ContextExecutor, kind, id string) error {
+	k, ok := deletedKinds[kind]
+	if !ok {
+		return ErrUnknownDeletedKind
+	}
+
+	for i, q := range k.purge {
+		res, err := exec.ExecContext(ctx, q, id)
+		if err != nil {
+			return fmt.Errorf("error purging %s %s: %w", k.singular, id, err)
+		}
+
+		if i < len(k.purge)-1 {
+			continue
+		}
+
+		if n, err := res.RowsAffected(); err != nil || n != 1 {
+			return fmt.Errorf("error purging %s %s: %w", k.singular, id, ErrDeletedRecordNotPurged)
+		}
+	}
+
+	return nil
+}
+
+// AuditDeletedRecordPurged inserts an event representing a soft deleted record
+// being permanently removed, it doesn't record the personal data of the record
+func AuditDeletedRecordPurged(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, kind string, r *DeletedRecord) (*models.AuditEvent, error) {
+	k, ok := deletedKinds[kind]
+	if !ok {
+		return nil, ErrUnknownDeletedKind
+	}
+
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID: null.StringFrom(pID),
+		ActorID:  actorID,
+		Action:   k.singular + ".purged",
+		Changeset: []string{
+			fmt.Sprintf("DeletedAt: %s", r.DeletedAt.UTC().Format(time.RFC3339)),
+		},
+		Message: fmt.Sprintf("Deleted %s %s was purged.", k.singular, r.ID),
+	}
+
+	switch kind {
+	case DeletedUsers:
+		event.SubjectUserID = null.StringFrom(r.ID)
+	case DeletedGroups:
+		event.SubjectGroupID = null.StringFrom(r.ID)
+	case DeletedApplications:
+		event.SubjectApplicationID = null.StringFrom(r.ID)
+	case DeletedExtensions:
+		event.SubjectExtensionID = null.StringFrom(r.ID)
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
@@ -0,0 +1,27 @@
+package dbtools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeletedKindsPurgeRecordLast(t *testing.T) {
+	for kind, k := range deletedKinds {
+		t.Run(kind, func(t *testing.T) {
+			assert.True(t, IsDeletedKind(kind))
+
+			if assert.NotEmpty(t, k.purge) {
+				last := k.purge[len(k.purge)-1]
+				assert.True(t, strings.HasPrefix(last, "DELETE FROM "+k.table+" WHERE id = $1 AND deleted_at IS NOT NULL"), last)
+			}
+
+			for _, q := range k.purge {
+				assert.Contains(t, q, "$1")
+			}
+		})
+	}
+
+	assert.False(t, IsDeletedKind("organizations"))
+}
@@ -0,0 +1,18 @@
+package dbtools
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// ChangedSince returns the query mods keeping the rows of a soft deletable
+// table created, updated or deleted after since. The deleted rows are kept as
+// the tombstones of the deletions.
+func ChangedSince(table string, since time.Time) []qm.QueryMod {
+	return []qm.QueryMod{
+		qm.WithDeleted(),
+		qm.Where(fmt.Sprintf("(%[1]s.updated_at > ? OR %[1]s.deleted_at > ?)", table), since, since),
+	}
+}
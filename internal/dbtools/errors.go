@@ -1,6 +1,20 @@
 package dbtools
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/lib/pq"
+)
 
 // ErrUnknownRequestKind is returned a request kind is unknown
 var ErrUnknownRequestKind = errors.New("request kind is unrecognized")
+
+// pqUniqueViolation is the postgres error code of unique constraint violations
+const pqUniqueViolation = "23505"
+
+// IsUniqueViolation returns true when err is caused by a unique constraint violation
+func IsUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && pqErr.Code == pqUniqueViolation
+}
@@ -0,0 +1,190 @@
+package dbtools
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/pkg/jsonschema"
+)
+
+// ExtensionResourceIndexName returns the name of the index of a field of the
+// resources of an ERD
+func ExtensionResourceIndexName(erdID, field string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(field))
+
+	return fmt.Sprintf("erd_%s_%08x_idx", strings.ReplaceAll(erdID, "-", ""), h.Sum32())
+}
+
+// ExtensionResourceField returns the expression of a field of the resource
+// payloads, the filters on the indexed fields must use it for the queries to
+// match the index expression
+func ExtensionResourceField(field string) string {
+	return "resource->>" + pq.QuoteLiteral(field)
+}
+
+// ExtensionResourceFieldFilters returns the query mods filtering the resources
+// of an ERD on top level fields of their payload. The fields indexed by the
+// ERD are compared with the expression of their index, so the index is used.
+func ExtensionResourceFieldFilters(erd *models.ExtensionResourceDefinition, fields map[string]string) []qm.QueryMod {
+	// the hints were validated when the ERD was created
+	hints, _ := jsonschema.IndexHints(erd.Schema)
+
+	indexed := make(map[string]bool, len(hints))
+	for _, h := range hints {
+		indexed[h] = true
+	}
+
+	mods := make([]qm.QueryMod, 0, len(fields))
+
+	for k, v := range fields {
+		if indexed[k] {
+			mods = append(mods, qm.Where(ExtensionResourceField(k)+" = ?", v))
+			continue
+		}
+
+		mods = append(mods, qm.Where("resource->>? = ?", k, v))
+	}
+
+	return mods
+}
+
+// extensionResourceIndexStatement returns the statement creating the partial
+// expression index of a field of the resources of an ERD, in the table of its
+// scope
+func extensionResourceIndexStatement(erd *models.ExtensionResourceDefinition, field string) string {
+	return fmt.Sprintf(
+		"CREATE INDEX IF NOT EXISTS %s ON %s ((%s)) WHERE extension_resource_definition_id = %s",
+		ExtensionResourceIndexName(erd.ID, field), extensionResourceIndexTable(erd.Scope), ExtensionResourceField(field), pq.QuoteLiteral(erd.ID),
+	)
+}
+
+// CreateExtensionResourceIndexes creates the indexes of the fields of the
+// resources of an ERD, the ones the ERD schema lists in x-governor-index. The
+// indexes are partial, only the resources of the ERD are indexed. It is a
+// schema change, so it shouldn't run in the transaction writing the ERD.
+func CreateExtensionResourceIndexes(ctx context.Context, exec boil.ContextExecutor, erd *models.ExtensionResourceDefinition, fields []string) error {
+	for _, f := range fields {
+		if _, err := exec.ExecContext(ctx, extensionResourceIndexStatement(erd, f)); err != nil {
+			return fmt.Errorf("error creating the index of %s: %w", f, err)
+		}
+	}
+
+	return nil
+}
+
+// extensionResourceIndexTable returns the table of the resources of an ERD
+func extensionResourceIndexTable(scope string) string {
+	if scope == "system" {
+		return models.TableNames.SystemExtensionResources
+	}
+
+	return models.TableNames.UserExtensionResources
+}
+
+// DropExtensionResourceIndexes drops the indexes of the fields of the
+// resources of a deleted ERD. Like their creation, it is a schema change and
+// shouldn't run in the transaction deleting the ERD.
+func DropExtensionResourceIndexes(ctx context.Context, exec boil.ContextExecutor, erd *models.ExtensionResourceDefinition, fields []string) error {
+	for _, f := range fields {
+		if err := dropExtensionResourceIndex(ctx, exec, extensionResourceIndexTable(erd.Scope), ExtensionResourceIndexName(erd.ID, f)); err != nil {
+			return fmt.Errorf("error dropping the index of %s: %w", f, err)
+		}
+	}
+
+	return nil
+}
+
+func dropExtensionResourceIndex(ctx context.Context, exec boil.ContextExecutor, table, name string) error {
+	_, err := exec.ExecContext(ctx, fmt.Sprintf("DROP INDEX IF EXISTS %s@%s", table, name))
+	return err
+}
+
+// extensionResourceIndexPattern matches the names of the ERD indexes
+var extensionResourceIndexPattern = regexp.MustCompile(`^erd_[0-9a-f]{32}_[0-9a-f]{8}_idx$`)
+
+// existingExtensionResourceIndexesQuery lists the indexes of the extension
+// resource tables
+const existingExtensionResourceIndexesQuery = `SELECT DISTINCT tablename, indexname FROM pg_indexes WHERE tablename IN ($1, $2)`
+
+// SyncExtensionResourceIndexes creates the missing indexes of the ERDs that
+// aren't deleted and drops the indexes of the deleted and purged ERDs, it
+// returns the number of indexes dropped. It backfills the indexes of the ERDs
+// created before they were indexed or whose index creation failed.
+func SyncExtensionResourceIndexes(ctx context.Context, exec boil.ContextExecutor) (int, error) {
+	// the indexes are listed before the ERDs, an index created meanwhile
+	// belongs to an ERD committed before the ERDs are listed
+	rows, err := exec.QueryContext(
+		ctx, existingExtensionResourceIndexesQuery,
+		models.TableNames.SystemExtensionResources, models.TableNames.UserExtensionResources,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("error listing the extension resource indexes: %w", err)
+	}
+
+	existing := map[string]string{}
+
+	for rows.Next() {
+		var table, name string
+		if err := rows.Scan(&table, &name); err != nil {
+			_ = rows.Close()
+			return 0, fmt.Errorf("error listing the extension resource indexes: %w", err)
+		}
+
+		if extensionResourceIndexPattern.MatchString(name) {
+			existing[name] = table
+		}
+	}
+
+	if err := rows.Close(); err != nil {
+		return 0, fmt.Errorf("error listing the extension resource indexes: %w", err)
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("error listing the extension resource indexes: %w", err)
+	}
+
+	erds, err := models.ExtensionResourceDefinitions().All(ctx, exec)
+	if err != nil {
+		return 0, fmt.Errorf("error listing the ERDs: %w", err)
+	}
+
+	wanted := map[string]bool{}
+
+	for _, erd := range erds {
+		// the hints were validated when the ERD was created
+		fields, _ := jsonschema.IndexHints(erd.Schema)
+
+		for _, f := range fields {
+			wanted[ExtensionResourceIndexName(erd.ID, f)] = true
+		}
+
+		if err := CreateExtensionResourceIndexes(ctx, exec, erd, fields); err != nil {
+			return 0, fmt.Errorf("error creating the indexes of ERD %s: %w", erd.ID, err)
+		}
+	}
+
+	dropped := 0
+
+	for name, table := range existing {
+		if wanted[name] {
+			continue
+		}
+
+		if err := dropExtensionResourceIndex(ctx, exec, table, name); err != nil {
+			return dropped, fmt.Errorf("error dropping index %s: %w", name, err)
+		}
+
+		dropped++
+	}
+
+	return dropped, nil
+}
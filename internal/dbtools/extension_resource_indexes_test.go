@@ -0,0 +1,82 @@
+package dbtools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestExtensionResourceIndexStatement(t *testing.T) {
+	erd := &models.ExtensionResourceDefinition{ID: "00000001-0000-0000-0000-000000000002", Scope: "system"}
+
+	name := ExtensionResourceIndexName(erd.ID, "lastName")
+	assert.Regexp(t, `^erd_00000001000000000000000000000002_[0-9a-f]{8}_idx$`, name)
+	assert.NotEqual(t, name, ExtensionResourceIndexName(erd.ID, "serial"))
+
+	assert.Equal(
+		t,
+		"CREATE INDEX IF NOT EXISTS "+name+" ON system_extension_resources ((resource->>'lastName')) "+
+			"WHERE extension_resource_definition_id = '00000001-0000-0000-0000-000000000002'",
+		extensionResourceIndexStatement(erd, "lastName"),
+	)
+
+	erd.Scope = "user"
+	assert.Contains(t, extensionResourceIndexStatement(erd, "it's"), "ON user_extension_resources ((resource->>'it''s'))")
+}
+
+func extensionResourceIndexExists(t *testing.T, name string) bool {
+	t.Helper()
+
+	var count int
+
+	err := db.QueryRow("SELECT count(*) FROM pg_indexes WHERE tablename = 'system_extension_resources' AND indexname = $1", name).Scan(&count)
+	require.NoError(t, err)
+
+	return count > 0
+}
+
+func TestSyncExtensionResourceIndexes(t *testing.T) {
+	const schema = `{"type": "object", "properties": {"serial": {"type": "string"}}, "x-governor-index": ["serial"]}`
+
+	testData := []string{
+		`INSERT INTO extensions (id, name, description, enabled, slug, status)
+		VALUES ('0000000e-0000-0000-0000-000000000001', 'Indexed Extension', 'some extension', true, 'indexed-extension', 'online')
+		ON CONFLICT DO NOTHING;`,
+		`INSERT INTO extension_resource_definitions (id, name, description, enabled, slug_singular, slug_plural, version, scope, schema, extension_id)
+		VALUES ('0000000e-0001-0000-0000-000000000001', 'Device', 'some-description', true, 'device', 'devices', 'v1', 'system',
+		'` + schema + `'::jsonb, '0000000e-0000-0000-0000-000000000001')
+		ON CONFLICT DO NOTHING;`,
+		`INSERT INTO extension_resource_definitions (id, name, description, enabled, slug_singular, slug_plural, version, scope, schema, extension_id, deleted_at)
+		VALUES ('0000000e-0001-0000-0000-000000000002', 'Device', 'some-description', true, 'device', 'devices', 'v2', 'system',
+		'` + schema + `'::jsonb, '0000000e-0000-0000-0000-000000000001', '2023-07-12 12:00:00.000000+00')
+		ON CONFLICT DO NOTHING;`,
+	}
+
+	for _, q := range testData {
+		_, err := db.Exec(q)
+		require.NoError(t, err)
+	}
+
+	live := &models.ExtensionResourceDefinition{ID: "0000000e-0001-0000-0000-000000000001", Scope: "system"}
+	deleted := &models.ExtensionResourceDefinition{ID: "0000000e-0001-0000-0000-000000000002", Scope: "system"}
+
+	// the index of the deleted ERD was kept
+	require.NoError(t, CreateExtensionResourceIndexes(context.TODO(), db, deleted, []string{"serial"}))
+
+	dropped, err := SyncExtensionResourceIndexes(context.TODO(), db)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, dropped, 1)
+
+	assert.True(t, extensionResourceIndexExists(t, ExtensionResourceIndexName(live.ID, "serial")))
+	assert.False(t, extensionResourceIndexExists(t, ExtensionResourceIndexName(deleted.ID, "serial")))
+
+	require.NoError(t, DropExtensionResourceIndexes(context.TODO(), db, live, []string{"serial"}))
+	assert.False(t, extensionResourceIndexExists(t, ExtensionResourceIndexName(live.ID, "serial")))
+
+	// dropping is idempotent
+	require.NoError(t, DropExtensionResourceIndexes(context.TODO(), db, live, []string{"serial"}))
+}
@@ -0,0 +1,74 @@
+package dbtools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// ErrExtensionResourceRevisionConflict is returned when an extension resource
+// was changed since the revision the update is based on
+var ErrExtensionResourceRevisionConflict = errors.New("extension resource revision conflict")
+
+// registerExtensionResourceRevisionHooks increments the revision of the
+// extension resources every time they are updated through the models
+func registerExtensionResourceRevisionHooks() {
+	models.AddSystemExtensionResourceHook(boil.BeforeUpdateHook, func(_ context.Context, _ boil.ContextExecutor, r *models.SystemExtensionResource) error {
+		r.Revision++
+		return nil
+	})
+
+	models.AddUserExtensionResourceHook(boil.BeforeUpdateHook, func(_ context.Context, _ boil.ContextExecutor, r *models.UserExtensionResource) error {
+		r.Revision++
+		return nil
+	})
+}
+
+// LockSystemExtensionResource reloads a system extension resource and locks it
+// until the end of the transaction. It returns
+// ErrExtensionResourceRevisionConflict, with the resource reloaded, when
+// revision isn't nil and isn't the current revision of the resource.
+func LockSystemExtensionResource(ctx context.Context, exec boil.ContextExecutor, r *models.SystemExtensionResource, revision *int64) error {
+	locked, err := models.SystemExtensionResources(
+		models.SystemExtensionResourceWhere.ID.EQ(r.ID),
+		qm.For("UPDATE"),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*r = *locked
+
+	if revision != nil && *revision != r.Revision {
+		return fmt.Errorf("%w: current revision is %d, not %d", ErrExtensionResourceRevisionConflict, r.Revision, *revision)
+	}
+
+	return nil
+}
+
+// LockUserExtensionResource reloads a user extension resource and locks it
+// until the end of the transaction. It returns
+// ErrExtensionResourceRevisionConflict, with the resource reloaded, when
+// revision isn't nil and isn't the current revision of the resource.
+func LockUserExtensionResource(ctx context.Context, exec boil.ContextExecutor, r *models.UserExtensionResource, revision *int64) error {
+	locked, err := models.UserExtensionResources(
+		models.UserExtensionResourceWhere.ID.EQ(r.ID),
+		qm.For("UPDATE"),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*r = *locked
+
+	if revision != nil && *revision != r.Revision {
+		return fmt.Errorf("%w: current revision is %d, not %d", ErrExtensionResourceRevisionConflict, r.Revision, *revision)
+	}
+
+	return nil
+}
@@ -0,0 +1,324 @@
+package dbtools
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// ExtensionResourceFilters selects the resources listed as of a time, Fields
+// match top level fields of the resources
+type ExtensionResourceFilters struct {
+	Fields  map[string]string
+	OwnerID string
+	Deleted bool
+}
+
+// ErrExtensionResourceVersionNotFound is returned when a version of an extension resource doesn't exist
+var ErrExtensionResourceVersionNotFound = errors.New("extension resource version not found")
+
+// nextVersion returns the number of the next version of a resource in a
+// history table, the versions are numbered from 1
+func nextVersion(ctx context.Context, exec boil.ContextExecutor, table, resourceID string) (int64, error) {
+	var n int64
+
+	q := fmt.Sprintf("SELECT COALESCE(MAX(version), 0) + 1 FROM %s WHERE resource_id = $1", table)
+	if err := exec.QueryRowContext(ctx, q, resourceID).Scan(&n); err != nil {
+		return 0, fmt.Errorf("error numbering extension resource version: %w", err)
+	}
+
+	return n, nil
+}
+
+// RecordSystemExtensionResourceVersion stores the current state of a system
+// extension resource in its history, the version is valid from when the
+// resource was last updated or deleted
+func RecordSystemExtensionResourceVersion(ctx context.Context, exec boil.ContextExecutor, r *models.SystemExtensionResource) error {
+	n, err := nextVersion(ctx, exec, models.TableNames.SystemExtensionResourceVersions, r.ID)
+	if err != nil {
+		return err
+	}
+
+	v := &models.SystemExtensionResourceVersion{
+		Version:                       n,
+		ResourceID:                    r.ID,
+		ExtensionResourceDefinitionID: r.ExtensionResourceDefinitionID,
+		Resource:                      r.Resource,
+		OwnerID:                       r.OwnerID,
+		ResourceCreatedAt:             r.CreatedAt,
+		ResourceUpdatedAt:             r.UpdatedAt,
+		ResourceDeletedAt:             r.DeletedAt,
+		RecordedAt:                    versionRecordedAt(r.UpdatedAt, r.DeletedAt.Ptr()),
+	}
+
+	if err := v.Insert(ctx, exec, boil.Infer()); err != nil {
+		return fmt.Errorf("error recording system extension resource version: %w", err)
+	}
+
+	return nil
+}
+
+// RecordUserExtensionResourceVersion stores the current state of a user
+// extension resource in its history, the version is valid from when the
+// resource was last updated or deleted
+func RecordUserExtensionResourceVersion(ctx context.Context, exec boil.ContextExecutor, r *models.UserExtensionResource) error {
+	n, err := nextVersion(ctx, exec, models.TableNames.UserExtensionResourceVersions, r.ID)
+	if err != nil {
+		return err
+	}
+
+	v := &models.UserExtensionResourceVersion{
+		Version:                       n,
+		ResourceID:                    r.ID,
+		ExtensionResourceDefinitionID: r.ExtensionResourceDefinitionID,
+		UserID:                        r.UserID,
+		Resource:                      r.Resource,
+		ResourceCreatedAt:             r.CreatedAt,
+		ResourceUpdatedAt:             r.UpdatedAt,
+		ResourceDeletedAt:             r.DeletedAt,
+		RecordedAt:                    versionRecordedAt(r.UpdatedAt, r.DeletedAt.Ptr()),
+	}
+
+	if err := v.Insert(ctx, exec, boil.Infer()); err != nil {
+		return fmt.Errorf("error recording user extension resource version: %w", err)
+	}
+
+	return nil
+}
+
+// versionRecordedAt returns when a version of a resource became current, a
+// hard deleted resource stops existing now
+func versionRecordedAt(updatedAt time.Time, deletedAt *time.Time) time.Time {
+	if deletedAt != nil && deletedAt.After(updatedAt) {
+		return *deletedAt
+	}
+
+	if updatedAt.IsZero() {
+		return time.Now()
+	}
+
+	return updatedAt
+}
+
+// registerExtensionResourceVersionHooks records a version of the extension
+// resources every time they are created, updated or deleted through the models
+func registerExtensionResourceVersionHooks() {
+	recordSystem := func(ctx context.Context, exec boil.ContextExecutor, r *models.SystemExtensionResource) error {
+		return RecordSystemExtensionResourceVersion(ctx, exec, r)
+	}
+
+	recordUser := func(ctx context.Context, exec boil.ContextExecutor, r *models.UserExtensionResource) error {
+		return RecordUserExtensionResourceVersion(ctx, exec, r)
+	}
+
+	for _, h := range []boil.HookPoint{boil.AfterInsertHook, boil.AfterUpdateHook, boil.AfterUpsertHook} {
+		models.AddSystemExtensionResourceHook(h, recordSystem)
+		models.AddUserExtensionResourceHook(h, recordUser)
+	}
+
+	// hard deleted resources don't have a deletion time, they are recorded as
+	// deleted now
+	models.AddSystemExtensionResourceHook(boil.AfterDeleteHook, func(ctx context.Context, exec boil.ContextExecutor, r *models.SystemExtensionResource) error {
+		deleted := *r
+		if !deleted.DeletedAt.Valid {
+			deleted.DeletedAt.SetValid(time.Now())
+		}
+
+		return RecordSystemExtensionResourceVersion(ctx, exec, &deleted)
+	})
+
+	models.AddUserExtensionResourceHook(boil.AfterDeleteHook, func(ctx context.Context, exec boil.ContextExecutor, r *models.UserExtensionResource) error {
+		deleted := *r
+		if !deleted.DeletedAt.Valid {
+			deleted.DeletedAt.SetValid(time.Now())
+		}
+
+		return RecordUserExtensionResourceVersion(ctx, exec, &deleted)
+	})
+}
+
+// latestVersionsQuery selects the version of each resource of an ERD that was
+// current at $2, the %s are the extra columns and conditions
+const latestVersionsQuery = `SELECT
+		resource_id AS id,
+		extension_resource_definition_id,
+		resource,
+		resource_created_at AS created_at,
+		resource_updated_at AS updated_at,
+		resource_deleted_at AS deleted_at%s
+	FROM (
+		SELECT DISTINCT ON (resource_id) *
+		FROM %s
+		WHERE extension_resource_definition_id = $1 AND recorded_at <= $2%s
+		ORDER BY resource_id, recorded_at DESC, version DESC
+	) AS versions
+	WHERE true%s
+	ORDER BY resource_created_at, resource_id`
+
+// asOfQuery builds the query listing the resources of an ERD as of a time.
+// The scope conditions select the versions, the filters their current state.
+func asOfQuery(table, columns, scope string, args []interface{}, filters ExtensionResourceFilters) (string, []interface{}) {
+	where := ""
+
+	if !filters.Deleted {
+		where += " AND resource_deleted_at IS NULL"
+	}
+
+	if filters.OwnerID != "" {
+		args = append(args, filters.OwnerID)
+		where += fmt.Sprintf(" AND owner_id = $%d", len(args))
+	}
+
+	// sorted so the same filters build the same query
+	keys := make([]string, 0, len(filters.Fields))
+	for k := range filters.Fields {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		args = append(args, k, filters.Fields[k])
+		where += fmt.Sprintf(" AND resource->>$%d = $%d", len(args)-1, len(args))
+	}
+
+	return fmt.Sprintf(latestVersionsQuery, columns, table, scope, where), args
+}
+
+// SystemExtensionResourcesAsOf returns the system extension resources of an
+// ERD as they were at a time, from their history
+func SystemExtensionResourcesAsOf(
+	ctx context.Context, exec boil.ContextExecutor, erdID string, asOf time.Time, filters ExtensionResourceFilters,
+) (models.SystemExtensionResourceSlice, error) {
+	q, args := asOfQuery(models.TableNames.SystemExtensionResourceVersions, ", owner_id", "", []interface{}{erdID, asOf}, filters)
+
+	resources := models.SystemExtensionResourceSlice{}
+	if err := queries.Raw(q, args...).Bind(ctx, exec, &resources); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	return resources, nil
+}
+
+// SystemExtensionResourceAsOf returns a system extension resource of an ERD as
+// it was at a time, from its history. It returns sql.ErrNoRows when the
+// resource didn't exist, or was deleted and deleted is false.
+func SystemExtensionResourceAsOf(
+	ctx context.Context, exec boil.ContextExecutor, erdID, resourceID string, asOf time.Time, deleted bool,
+) (*models.SystemExtensionResource, error) {
+	q, args := asOfQuery(
+		models.TableNames.SystemExtensionResourceVersions, ", owner_id", " AND resource_id = $3",
+		[]interface{}{erdID, asOf, resourceID}, ExtensionResourceFilters{Deleted: deleted},
+	)
+
+	resource := &models.SystemExtensionResource{}
+	if err := queries.Raw(q, args...).Bind(ctx, exec, resource); err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+// UserExtensionResourcesAsOf returns the extension resources of a user for an
+// ERD as they were at a time, from their history
+func UserExtensionResourcesAsOf(
+	ctx context.Context, exec boil.ContextExecutor, erdID, userID string, asOf time.Time, filters ExtensionResourceFilters,
+) (models.UserExtensionResourceSlice, error) {
+	q, args := asOfQuery(
+		models.TableNames.UserExtensionResourceVersions, ", user_id", " AND user_id = $3",
+		[]interface{}{erdID, asOf, userID}, filters,
+	)
+
+	resources := models.UserExtensionResourceSlice{}
+	if err := queries.Raw(q, args...).Bind(ctx, exec, &resources); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	return resources, nil
+}
+
+// UserExtensionResourceAsOf returns an extension resource of a user for an
+// ERD as it was at a time, from its history. It returns sql.ErrNoRows when
+// the resource didn't exist, or was deleted and deleted is false.
+func UserExtensionResourceAsOf(
+	ctx context.Context, exec boil.ContextExecutor, erdID, userID, resourceID string, asOf time.Time, deleted bool,
+) (*models.UserExtensionResource, error) {
+	q, args := asOfQuery(
+		models.TableNames.UserExtensionResourceVersions, ", user_id", " AND user_id = $3 AND resource_id = $4",
+		[]interface{}{erdID, asOf, userID, resourceID}, ExtensionResourceFilters{Deleted: deleted},
+	)
+
+	resource := &models.UserExtensionResource{}
+	if err := queries.Raw(q, args...).Bind(ctx, exec, resource); err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+// SystemExtensionResourceVersions returns the recorded versions of a system
+// extension resource of an ERD, the latest first
+func SystemExtensionResourceVersions(ctx context.Context, exec boil.ContextExecutor, erdID, resourceID string) (models.SystemExtensionResourceVersionSlice, error) {
+	return models.SystemExtensionResourceVersions(
+		models.SystemExtensionResourceVersionWhere.ExtensionResourceDefinitionID.EQ(erdID),
+		models.SystemExtensionResourceVersionWhere.ResourceID.EQ(resourceID),
+		qm.OrderBy(models.SystemExtensionResourceVersionColumns.Version+" DESC"),
+	).All(ctx, exec)
+}
+
+// GetSystemExtensionResourceVersion returns a version of a system extension
+// resource of an ERD, or ErrExtensionResourceVersionNotFound
+func GetSystemExtensionResourceVersion(
+	ctx context.Context, exec boil.ContextExecutor, erdID, resourceID string, version int64,
+) (*models.SystemExtensionResourceVersion, error) {
+	v, err := models.SystemExtensionResourceVersions(
+		models.SystemExtensionResourceVersionWhere.ExtensionResourceDefinitionID.EQ(erdID),
+		models.SystemExtensionResourceVersionWhere.ResourceID.EQ(resourceID),
+		models.SystemExtensionResourceVersionWhere.Version.EQ(version),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrExtensionResourceVersionNotFound
+	}
+
+	return v, err
+}
+
+// UserExtensionResourceVersions returns the recorded versions of an extension
+// resource of a user for an ERD, the latest first
+func UserExtensionResourceVersions(
+	ctx context.Context, exec boil.ContextExecutor, erdID, userID, resourceID string,
+) (models.UserExtensionResourceVersionSlice, error) {
+	return models.UserExtensionResourceVersions(
+		models.UserExtensionResourceVersionWhere.ExtensionResourceDefinitionID.EQ(erdID),
+		models.UserExtensionResourceVersionWhere.UserID.EQ(userID),
+		models.UserExtensionResourceVersionWhere.ResourceID.EQ(resourceID),
+		qm.OrderBy(models.UserExtensionResourceVersionColumns.Version+" DESC"),
+	).All(ctx, exec)
+}
+
+// GetUserExtensionResourceVersion returns a version of an extension resource
+// of a user for an ERD, or ErrExtensionResourceVersionNotFound
+func GetUserExtensionResourceVersion(
+	ctx context.Context, exec boil.ContextExecutor, erdID, userID, resourceID string, version int64,
+) (*models.UserExtensionResourceVersion, error) {
+	v, err := models.UserExtensionResourceVersions(
+		models.UserExtensionResourceVersionWhere.ExtensionResourceDefinitionID.EQ(erdID),
+		models.UserExtensionResourceVersionWhere.UserID.EQ(userID),
+		models.UserExtensionResourceVersionWhere.ResourceID.EQ(resourceID),
+		models.UserExtensionResourceVersionWhere.Version.EQ(version),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrExtensionResourceVersionNotFound
+	}
+
+	return v, err
+}
@@ -0,0 +1,49 @@
+package dbtools
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionRecordedAt(t *testing.T) {
+	updated := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	deleted := updated.Add(time.Hour)
+	earlier := updated.Add(-time.Hour)
+
+	assert.Equal(t, updated, versionRecordedAt(updated, nil))
+	assert.Equal(t, deleted, versionRecordedAt(updated, &deleted))
+	assert.Equal(t, updated, versionRecordedAt(updated, &earlier))
+	assert.WithinDuration(t, time.Now(), versionRecordedAt(time.Time{}, nil), time.Minute)
+}
+
+func TestAsOfQuery(t *testing.T) {
+	asOf := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	q, args := asOfQuery(
+		"system_extension_resource_versions", ", owner_id", "",
+		[]interface{}{"erd-id", asOf},
+		ExtensionResourceFilters{
+			Fields:  map[string]string{"name": "a", "color": "blue"},
+			OwnerID: "group-id",
+		},
+	)
+
+	assert.Contains(t, q, "FROM system_extension_resource_versions")
+	assert.Contains(t, q, "resource_deleted_at AS deleted_at, owner_id")
+	assert.Contains(t, q, "WHERE extension_resource_definition_id = $1 AND recorded_at <= $2\n")
+	assert.Contains(t, q, "WHERE true AND resource_deleted_at IS NULL AND owner_id = $3 AND resource->>$4 = $5 AND resource->>$6 = $7\n")
+	assert.Equal(t, []interface{}{"erd-id", asOf, "group-id", "color", "blue", "name", "a"}, args)
+
+	q, args = asOfQuery(
+		"user_extension_resource_versions", ", user_id", " AND user_id = $3 AND resource_id = $4",
+		[]interface{}{"erd-id", asOf, "user-id", "resource-id"},
+		ExtensionResourceFilters{Deleted: true},
+	)
+
+	assert.Contains(t, q, "recorded_at <= $2 AND user_id = $3 AND resource_id = $4\n")
+	assert.False(t, strings.Contains(q, "resource_deleted_at IS NULL"))
+	assert.Len(t, args, 4)
+}
@@ -0,0 +1,71 @@
+package dbtools
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// extensionUsageQuery joins the resource definitions of an extension with both the system and the user resources, soft
+// deleted resources are counted separately but their payloads still count towards the storage used since the rows are kept.
+// The payload size is the length of the JSON encoded resource, which is close enough to the stored size to compare extensions.
+// The user resources are limited to the ones of the users visible to the organization in `$2`, NULL doesn't limit them.
+const extensionUsageQuery = `
+	SELECT
+		erd.id AS erd_id,
+		erd.slug_plural,
+		erd.version,
+		erd.scope,
+		COUNT(resources.id) FILTER (WHERE resources.deleted_at IS NULL) AS resource_count,
+		COUNT(resources.id) FILTER (WHERE resources.deleted_at IS NOT NULL) AS deleted_resource_count,
+		COALESCE(SUM(octet_length(resources.resource::STRING)), 0) AS payload_bytes,
+		MAX(GREATEST(resources.updated_at, COALESCE(resources.deleted_at, resources.updated_at))) AS last_activity_at
+	FROM
+		extension_resource_definitions AS erd
+		LEFT JOIN (
+			SELECT id, extension_resource_definition_id, resource, updated_at, deleted_at FROM system_extension_resources
+			UNION ALL
+			SELECT uer.id, uer.extension_resource_definition_id, uer.resource, uer.updated_at, uer.deleted_at
+			FROM user_extension_resources AS uer INNER JOIN users ON users.id = uer.user_id
+			WHERE ($2::UUID IS NULL OR users.tenant_id IS NULL OR users.tenant_id = $2::UUID)
+		) AS resources ON resources.extension_resource_definition_id = erd.id
+	WHERE
+		erd.extension_id = $1 AND erd.deleted_at IS NULL
+	GROUP BY
+		erd.id,
+		erd.slug_plural,
+		erd.version,
+		erd.scope
+	ORDER BY
+		erd.slug_plural,
+		erd.version;`
+
+// ERDUsage is the resource count and storage usage of an extension resource definition
+type ERDUsage struct {
+	ERDID                string    `boil:"erd_id" json:"erd_id"`
+	SlugPlural           string    `boil:"slug_plural" json:"slug_plural"`
+	Version              string    `boil:"version" json:"version"`
+	Scope                string    `boil:"scope" json:"scope"`
+	ResourceCount        int64     `boil:"resource_count" json:"resource_count"`
+	DeletedResourceCount int64     `boil:"deleted_resource_count" json:"deleted_resource_count"`
+	PayloadBytes         int64     `boil:"payload_bytes" json:"payload_bytes"`
+	LastActivityAt       null.Time `boil:"last_activity_at" json:"last_activity_at"`
+}
+
+// GetExtensionUsage returns the usage of every resource definition of an extension, the resources of the users of other
+// organizations than the one of the context aren't counted
+func GetExtensionUsage(ctx context.Context, db boil.ContextExecutor, extensionID string) ([]ERDUsage, error) {
+	usage := []ERDUsage{}
+
+	if err := queries.Raw(extensionUsageQuery, extensionID, tenantParam(ctx)).Bind(ctx, db, &usage); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,221 @@
+package dbtools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// GroupDetachment is everything DetachGroup removed from a group
+type GroupDetachment struct {
+	Memberships         models.GroupMembershipSlice
+	MembershipRequests  models.GroupMembershipRequestSlice
+	ApplicationRequests models.GroupApplicationRequestSlice
+	Hierarchies         models.GroupHierarchySlice
+	Organizations       models.GroupOrganizationSlice
+	Applications        models.GroupApplicationSlice
+}
+
+// loadGroupDetachment loads everything DetachGroup removes from a group
+func loadGroupDetachment(ctx context.Context, exec boil.ContextExecutor, groupID string) (*GroupDetachment, error) {
+	d := &GroupDetachment{}
+
+	var err error
+
+	if d.Memberships, err = models.GroupMemberships(qm.Where("group_id = ?", groupID)).All(ctx, exec); err != nil {
+		return nil, fmt.Errorf("getting group memberships: %w", err)
+	}
+
+	if d.MembershipRequests, err = models.GroupMembershipRequests(qm.Where("group_id = ?", groupID)).All(ctx, exec); err != nil {
+		return nil, fmt.Errorf("getting group membership requests: %w", err)
+	}
+
+	if d.ApplicationRequests, err = models.GroupApplicationRequests(qm.Where("group_id = ?", groupID)).All(ctx, exec); err != nil {
+		return nil, fmt.Errorf("getting group application requests: %w", err)
+	}
+
+	if d.Hierarchies, err = models.GroupHierarchies(
+		qm.Where("parent_group_id = ?", groupID),
+		qm.Or("member_group_id = ?", groupID),
+	).All(ctx, exec); err != nil {
+		return nil, fmt.Errorf("getting group hierarchies: %w", err)
+	}
+
+	if d.Organizations, err = models.GroupOrganizations(qm.Where("group_id = ?", groupID)).All(ctx, exec); err != nil {
+		return nil, fmt.Errorf("getting group organizations: %w", err)
+	}
+
+	if d.Applications, err = models.GroupApplications(qm.Where("group_id = ?", groupID)).All(ctx, exec); err != nil {
+		return nil, fmt.Errorf("getting group applications: %w", err)
+	}
+
+	return d, nil
+}
+
+// DetachGroup removes the memberships of a group, revokes its pending membership and application requests,
+// removes the hierarchies it is the parent or the member of and unlinks its organizations and applications.
+// Each removal is audited with pID as the parent audit event, exec should be a transaction so the group is
+// either fully detached or not at all.
+func DetachGroup(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, groupID string) (*GroupDetachment, error) {
+	d, err := loadGroupDetachment(ctx, exec, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := d.Memberships.DeleteAll(ctx, exec); err != nil {
+		return nil, fmt.Errorf("deleting group memberships: %w", err)
+	}
+
+	for _, m := range d.Memberships {
+		if _, err := AuditGroupMembershipDeleted(ctx, exec, pID, actor, m); err != nil {
+			return nil, fmt.Errorf("auditing group membership deletion: %w", err)
+		}
+	}
+
+	if _, err := d.MembershipRequests.DeleteAll(ctx, exec); err != nil {
+		return nil, fmt.Errorf("deleting group membership requests: %w", err)
+	}
+
+	for _, r := range d.MembershipRequests {
+		if _, err := AuditGroupMembershipRevoked(ctx, exec, pID, actor, r); err != nil {
+			return nil, fmt.Errorf("auditing group membership request revocation: %w", err)
+		}
+	}
+
+	if _, err := d.ApplicationRequests.DeleteAll(ctx, exec); err != nil {
+		return nil, fmt.Errorf("deleting group application requests: %w", err)
+	}
+
+	for _, r := range d.ApplicationRequests {
+		if _, err := AuditGroupApplicationRequestRevoked(ctx, exec, pID, actor, r); err != nil {
+			return nil, fmt.Errorf("auditing group application request revocation: %w", err)
+		}
+	}
+
+	if _, err := d.Hierarchies.DeleteAll(ctx, exec); err != nil {
+		return nil, fmt.Errorf("deleting group hierarchies: %w", err)
+	}
+
+	for _, h := range d.Hierarchies {
+		if _, err := AuditGroupHierarchyDeleted(ctx, exec, pID, actor, h); err != nil {
+			return nil, fmt.Errorf("auditing group hierarchy deletion: %w", err)
+		}
+	}
+
+	if _, err := d.Organizations.DeleteAll(ctx, exec); err != nil {
+		return nil, fmt.Errorf("deleting group organizations: %w", err)
+	}
+
+	for _, o := range d.Organizations {
+		if _, err := AuditGroupOrganizationDeleted(ctx, exec, pID, actor, o); err != nil {
+			return nil, fmt.Errorf("auditing group organization deletion: %w", err)
+		}
+	}
+
+	if _, err := d.Applications.DeleteAll(ctx, exec, false); err != nil {
+		return nil, fmt.Errorf("deleting group applications: %w", err)
+	}
+
+	for _, a := range d.Applications {
+		if _, err := AuditGroupApplicationDeleted(ctx, exec, pID, actor, a); err != nil {
+			return nil, fmt.Errorf("auditing group application deletion: %w", err)
+		}
+	}
+
+	return d, nil
+}
+
+// GroupDeletionImpact is what deleting a group would remove
+type GroupDeletionImpact struct {
+	GroupID string `json:"group_id"`
+	// Members is the number of direct members of the group
+	Members int `json:"members"`
+	// LostMemberships are the effective memberships that would be lost, the ones of the group and the
+	// ones the parent groups inherit through it
+	LostMemberships []LostMembership `json:"lost_memberships"`
+	// ParentGroupIDs are the groups that would stop inheriting the members of the group
+	ParentGroupIDs []string `json:"parent_group_ids"`
+	// MemberGroupIDs are the groups whose members would stop being inherited by the group
+	MemberGroupIDs      []string `json:"member_group_ids"`
+	ApplicationIDs      []string `json:"application_ids"`
+	OrganizationIDs     []string `json:"organization_ids"`
+	MembershipRequests  int      `json:"membership_requests"`
+	ApplicationRequests int      `json:"application_requests"`
+}
+
+// LostMembership is an effective membership a group deletion would remove
+type LostMembership struct {
+	GroupID string `json:"group_id"`
+	UserID  string `json:"user_id"`
+	Direct  bool   `json:"direct"`
+}
+
+// GetGroupDeletionImpact returns what deleting a group would remove. It removes the memberships and the
+// hierarchies of the group to compute the effective memberships lost, so exec must be a transaction the
+// caller rolls back.
+func GetGroupDeletionImpact(ctx context.Context, exec boil.ContextExecutor, groupID string) (*GroupDeletionImpact, error) {
+	d, err := loadGroupDetachment(ctx, exec, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	impact := &GroupDeletionImpact{
+		GroupID:             groupID,
+		Members:             len(d.Memberships),
+		LostMemberships:     []LostMembership{},
+		ParentGroupIDs:      []string{},
+		MemberGroupIDs:      []string{},
+		ApplicationIDs:      make([]string, len(d.Applications)),
+		OrganizationIDs:     make([]string, len(d.Organizations)),
+		MembershipRequests:  len(d.MembershipRequests),
+		ApplicationRequests: len(d.ApplicationRequests),
+	}
+
+	for _, h := range d.Hierarchies {
+		if h.MemberGroupID == groupID {
+			impact.ParentGroupIDs = append(impact.ParentGroupIDs, h.ParentGroupID)
+		} else {
+			impact.MemberGroupIDs = append(impact.MemberGroupIDs, h.MemberGroupID)
+		}
+	}
+
+	for i, a := range d.Applications {
+		impact.ApplicationIDs[i] = a.ApplicationID
+	}
+
+	for i, o := range d.Organizations {
+		impact.OrganizationIDs[i] = o.OrganizationID
+	}
+
+	before, err := GetAllGroupMemberships(ctx, exec, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := d.Memberships.DeleteAll(ctx, exec); err != nil {
+		return nil, fmt.Errorf("deleting group memberships: %w", err)
+	}
+
+	if _, err := d.Hierarchies.DeleteAll(ctx, exec); err != nil {
+		return nil, fmt.Errorf("deleting group hierarchies: %w", err)
+	}
+
+	after, err := GetAllGroupMemberships(ctx, exec, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range FindMemberDiff(after, before) {
+		impact.LostMemberships = append(impact.LostMemberships, LostMembership{
+			GroupID: m.GroupID,
+			UserID:  m.UserID,
+			Direct:  m.Direct,
+		})
+	}
+
+	return impact, nil
+}
@@ -0,0 +1,68 @@
+package dbtools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestDetachGroup(t *testing.T) {
+	ctx := context.TODO()
+	groupID := "00000002-0000-0000-0000-000000000002"
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+
+	defer func() { _ = tx.Rollback() }()
+
+	auditID := uuid.NewString()
+
+	d, err := DetachGroup(ctx, tx, auditID, nil, groupID)
+	require.NoError(t, err)
+
+	assert.Len(t, d.Memberships, 1)
+	assert.Len(t, d.Hierarchies, 3)
+
+	memberships, err := models.GroupMemberships(qm.Where("group_id = ?", groupID)).Count(ctx, tx)
+	require.NoError(t, err)
+	assert.Zero(t, memberships)
+
+	hierarchies, err := models.GroupHierarchies(
+		qm.Where("parent_group_id = ?", groupID),
+		qm.Or("member_group_id = ?", groupID),
+	).Count(ctx, tx)
+	require.NoError(t, err)
+	assert.Zero(t, hierarchies)
+
+	audits, err := models.AuditEvents(qm.Where("parent_id = ?", auditID)).Count(ctx, tx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, audits)
+}
+
+func TestGetGroupDeletionImpact(t *testing.T) {
+	ctx := context.TODO()
+	groupID := "00000002-0000-0000-0000-000000000002"
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+
+	impact, err := GetGroupDeletionImpact(ctx, tx, groupID)
+	require.NoError(t, err)
+	require.NoError(t, tx.Rollback())
+
+	assert.Equal(t, 1, impact.Members)
+	assert.Equal(t, []string{"00000002-0000-0000-0000-000000000001"}, impact.ParentGroupIDs)
+	assert.ElementsMatch(t, []string{"00000002-0000-0000-0000-000000000003", "00000002-0000-0000-0000-000000000004"}, impact.MemberGroupIDs)
+	assert.Contains(t, impact.LostMemberships, LostMembership{GroupID: groupID, UserID: "00000001-0000-0000-0000-000000000002", Direct: true})
+	assert.Contains(t, impact.LostMemberships, LostMembership{GroupID: "00000002-0000-0000-0000-000000000001", UserID: "00000001-0000-0000-0000-000000000002"})
+
+	memberships, err := models.GroupMemberships(qm.Where("group_id = ?", groupID)).Count(ctx, db)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, memberships)
+}
@@ -1,10 +1,13 @@
 package dbtools
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/gosimple/slug"
@@ -13,10 +16,18 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/types"
 
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/pkg/jsonschema"
 )
 
+var registerOnce sync.Once
+
 // RegisterHooks adds any hooks that are configured to the models library
 func RegisterHooks() {
+	registerOnce.Do(func() {
+		registerExtensionResourceRevisionHooks()
+		registerExtensionResourceVersionHooks()
+		registerRelationshipTombstoneHooks()
+	})
 }
 
 // SetGroupSlug assigns a Group model a slug from the Group name
@@ -59,6 +70,8 @@ func changesetLine(set []string, key string, old, new interface{}) []string { //
 		str = fmt.Sprintf(`%s: "%t" => "%t"`, key, o, new)
 	case time.Time:
 		str = fmt.Sprintf(`%s: "%s" => "%s"`, key, o.UTC().Format(time.RFC3339), new.(time.Time).UTC().Format(time.RFC3339))
+	case null.Time:
+		str = fmt.Sprintf(`%s: "%s" => "%s"`, key, formatNullTime(o), formatNullTime(new.(null.Time)))
 	case types.JSON:
 		str = fmt.Sprintf(`%s: "%s" => "%s"`, key, string(o), string(new.(types.JSON)))
 	default:
@@ -68,6 +81,14 @@ func changesetLine(set []string, key string, old, new interface{}) []string { //
 	return append(set, str)
 }
 
+func formatNullTime(t null.Time) string {
+	if !t.Valid {
+		return ""
+	}
+
+	return t.Time.UTC().Format(time.RFC3339)
+}
+
 // use reflect to iterate through each element of a given type, and construct changesetLine with each attribute
 // it can only accept non nil pointers
 // more information for the reflect package can be found here: https://pkg.go.dev/reflect
@@ -103,6 +124,17 @@ func calculateChangeset(original, new interface{}) []string { //nolint:revive
 func calculateGroupMembershipChangeset(origGM, newGM *models.GroupMembership) []string {
 	changeset := []string{}
 	changeset = changesetLine(changeset, "is_admin", origGM.IsAdmin, newGM.IsAdmin)
+	changeset = changesetLine(changeset, "source", origGM.Source, newGM.Source)
+	changeset = changesetLine(changeset, "note", origGM.Note, newGM.Note)
+
+	return changeset
+}
+
+func calculateGroupMembershipExemptionChangeset(origGM, newGM *models.GroupMembership) []string {
+	changeset := []string{}
+	changeset = changesetLine(changeset, "exemption_expires_at", origGM.ExemptionExpiresAt, newGM.ExemptionExpiresAt)
+	changeset = changesetLine(changeset, "exemption_justification", origGM.ExemptionJustification, newGM.ExemptionJustification)
+	changeset = changesetLine(changeset, "exemption_approved_by", origGM.ExemptionApprovedBy, newGM.ExemptionApprovedBy)
 
 	return changeset
 }
@@ -167,6 +199,104 @@ func AuditUserUpdated(ctx context.Context, exec boil.ContextExecutor, pID string
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
 
+// AuditUserAnonymized inserts an event representing the erasure of the personal data of a user into the events
+// table. The scrubbed values aren't recorded, only the fields that were scrubbed.
+func AuditUserAnonymized(ctx context.Context, exec boil.ContextExecutor, pID string, actor, user *models.User, scrubbed []string) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	changeset := make([]string, len(scrubbed))
+	for i, f := range scrubbed {
+		changeset[i] = f + ": scrubbed"
+	}
+
+	event := models.AuditEvent{
+		ParentID:      null.StringFrom(pID),
+		ActorID:       actorID,
+		SubjectUserID: null.StringFrom(user.ID),
+		Action:        "user.anonymized",
+		Changeset:     changeset,
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditUserEmailChangeRequested inserts an event representing a pending change of a user email into the events table,
+// the email isn't changed until the user confirms the new address
+func AuditUserEmailChangeRequested(ctx context.Context, exec boil.ContextExecutor, pID string, actor, user *models.User, change *models.UserEmailChange) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:      null.StringFrom(pID),
+		ActorID:       actorID,
+		SubjectUserID: null.StringFrom(user.ID),
+		Action:        "user.email.change.requested",
+		Changeset:     changesetLine([]string{}, "Email", user.Email, change.Email),
+		Message:       "Verification of the new email was requested, it expires at " + change.ExpiresAt.UTC().Format(time.RFC3339) + ".",
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditUserEmailChangeConfirmed inserts an event representing a user confirming a new email into the events table
+func AuditUserEmailChangeConfirmed(ctx context.Context, exec boil.ContextExecutor, pID string, original, new *models.User) (*models.AuditEvent, error) { //nolint:revive
+	event := models.AuditEvent{
+		ParentID:      null.StringFrom(pID),
+		ActorID:       null.StringFrom(new.ID),
+		SubjectUserID: null.StringFrom(new.ID),
+		Action:        "user.email.change.confirmed",
+		Changeset:     calculateChangeset(original, new),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditUserStatusSynced inserts an event representing the status of a user
+// being changed by an identity provider event into the events table, the event
+// source and id are recorded in the changeset
+func AuditUserStatusSynced(ctx context.Context, exec boil.ContextExecutor, pID, source, sourceEventID string, original, new *models.User) (*models.AuditEvent, error) { //nolint:revive
+	changeset := calculateChangeset(original, new)
+	changeset = changesetLine(changeset, "Source", "", source)
+	changeset = changesetLine(changeset, "SourceEventID", "", sourceEventID)
+
+	event := models.AuditEvent{
+		ParentID:      null.StringFrom(pID),
+		SubjectUserID: null.StringFrom(original.ID),
+		Action:        "user.status.synced",
+		Changeset:     changeset,
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditUserMerged inserts an event representing the merge of a duplicate user into another user into the events table
+func AuditUserMerged(ctx context.Context, exec boil.ContextExecutor, pID string, actor, original, new, merged *models.User) (*models.AuditEvent, error) { //nolint:revive
+	// TODO non-user API actors don't exist in the governor database,
+	// we need to figure out how to handle that relationship in the audit table
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	changeset := changesetLine([]string{}, "MergedUserID", "", merged.ID)
+	changeset = changesetLine(changeset, "MergedUserEmail", "", merged.Email)
+
+	event := models.AuditEvent{
+		ParentID:      null.StringFrom(pID),
+		ActorID:       actorID,
+		SubjectUserID: null.StringFrom(original.ID),
+		Action:        "user.merged",
+		Changeset:     append(changeset, calculateChangeset(original, new)...),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
 // AuditGroupCreated inserts an event representing group creation into the events table
 func AuditGroupCreated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, g *models.Group) (*models.AuditEvent, error) {
 	// TODO non-user API actors don't exist in the governor database,
@@ -207,6 +337,209 @@ func AuditGroupUpdated(ctx context.Context, exec boil.ContextExecutor, pID strin
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
 
+// AuditGroupFrozen inserts an event representing a group being frozen into the events table
+func AuditGroupFrozen(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, g *models.Group) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	msg := "Group was frozen."
+	if g.FrozenReason != "" {
+		msg = fmt.Sprintf("Group was frozen: %s", g.FrozenReason)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(g.ID),
+		Action:         "group.frozen",
+		Changeset:      calculateChangeset(o, g),
+		Message:        msg,
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGroupUnfrozen inserts an event representing a group being unfrozen into the events table
+func AuditGroupUnfrozen(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, g *models.Group) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(g.ID),
+		Action:         "group.unfrozen",
+		Changeset:      calculateChangeset(o, g),
+		Message:        "Group was unfrozen.",
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGroupChangeDenied inserts an event representing a membership change of a frozen group being denied into the events table
+func AuditGroupChangeDenied(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, g *models.Group, change string) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(g.ID),
+		Action:         "group.change.denied",
+		Changeset:      []string{},
+		Message:        fmt.Sprintf("Group is frozen, %s was denied.", change),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGroupProtected inserts an event representing a group being protected into the events table
+func AuditGroupProtected(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, g *models.Group) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(g.ID),
+		Action:         "group.protected",
+		Changeset:      calculateChangeset(o, g),
+		Message:        "Group was protected.",
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGroupUnprotected inserts an event representing a group protection being removed into the events table
+func AuditGroupUnprotected(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, g *models.Group) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(g.ID),
+		Action:         "group.unprotected",
+		Changeset:      calculateChangeset(o, g),
+		Message:        "Group was unprotected.",
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGroupProtectionViolated inserts an event representing the deletion or detachment of a protected group
+// being denied into the events table
+func AuditGroupProtectionViolated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, g *models.Group, change string) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(g.ID),
+		Action:         "group.protection.violated",
+		Changeset:      []string{},
+		Message:        fmt.Sprintf("Group is protected, %s was denied.", change),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGroupBreakGlassEnabled inserts an event representing a group being approved for break-glass access into the
+// events table
+func AuditGroupBreakGlassEnabled(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, g *models.Group) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(g.ID),
+		Action:         "group.break_glass.enabled",
+		Changeset:      calculateChangeset(o, g),
+		Message:        "Group was approved for break-glass access.",
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGroupBreakGlassDisabled inserts an event representing the break-glass approval of a group being removed into
+// the events table
+func AuditGroupBreakGlassDisabled(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, g *models.Group) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(g.ID),
+		Action:         "group.break_glass.disabled",
+		Changeset:      calculateChangeset(o, g),
+		Message:        "Group is no longer approved for break-glass access.",
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditBreakGlassAccessGranted inserts an event representing a responder granting themselves break-glass access to a
+// group into the events table, it is the parent of the events of the membership it grants
+func AuditBreakGlassAccessGranted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, m *models.GroupMembership, incidentRef string) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(m.GroupID),
+		SubjectUserID:  null.StringFrom(m.UserID),
+		Action:         "group.member.break_glass",
+		Changeset:      calculateGroupMembershipChangeset(&models.GroupMembership{}, m),
+		Message: fmt.Sprintf("BREAK-GLASS access was granted for incident %s until %s.",
+			incidentRef, m.ExpiresAt.Time.UTC().Format(time.RFC3339)),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditBreakGlassAccessDenied inserts an event representing a break-glass access attempt being denied into the events
+// table
+func AuditBreakGlassAccessDenied(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, g *models.Group, reason string) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(g.ID),
+		SubjectUserID:  actorID,
+		Action:         "group.member.break_glass.denied",
+		Changeset:      []string{},
+		Message:        fmt.Sprintf("BREAK-GLASS access was denied, %s.", reason),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
 // AuditGroupDeleted inserts an event representing group deletion into the events table
 func AuditGroupDeleted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, g *models.Group) (*models.AuditEvent, error) {
 	// TODO non-user API actors don't exist in the governor database,
@@ -227,6 +560,27 @@ func AuditGroupDeleted(ctx context.Context, exec boil.ContextExecutor, pID strin
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
 
+// AuditGroupDetached inserts an event representing a group being detached from its members, hierarchies,
+// requests and links without being deleted into the events table
+func AuditGroupDetached(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, g *models.Group) (*models.AuditEvent, error) {
+	// TODO non-user API actors don't exist in the governor database,
+	// we need to figure out how to handle that relationship in the audit table
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(g.ID),
+		Action:         "group.detached",
+		Changeset:      []string{},
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
 // AuditGroupHierarchyCreated inserts an event representing group hierarchy creation into the events table
 func AuditGroupHierarchyCreated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, m *models.GroupHierarchy) (*models.AuditEvent, error) {
 	// TODO non-user API actors don't exist in the governor database,
@@ -287,8 +641,9 @@ func AuditGroupHierarchyDeleted(ctx context.Context, exec boil.ContextExecutor,
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
 
-// AuditGroupMembershipCreated inserts an event representing group membership creation into the events table
-func AuditGroupMembershipCreated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, m *models.GroupMembership) (*models.AuditEvent, error) {
+// AuditGroupMembershipCreated inserts an event representing group membership creation into the events table,
+// msg records the membership duration policy decision when there is one
+func AuditGroupMembershipCreated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, m *models.GroupMembership, msg string) (*models.AuditEvent, error) {
 	// TODO non-user API actors don't exist in the governor database,
 	// we need to figure out how to handle that relationship in the audit table
 	var actorID null.String
@@ -303,6 +658,7 @@ func AuditGroupMembershipCreated(ctx context.Context, exec boil.ContextExecutor,
 		SubjectUserID:  null.StringFrom(m.UserID),
 		Action:         "group.member.added",
 		Changeset:      calculateGroupMembershipChangeset(&models.GroupMembership{}, m),
+		Message:        msg,
 	}
 
 	return &event, event.Insert(ctx, exec, boil.Infer())
@@ -343,17 +699,99 @@ func AuditGroupMembershipDeleted(ctx context.Context, exec boil.ContextExecutor,
 		ActorID:        actorID,
 		SubjectGroupID: null.StringFrom(m.GroupID),
 		SubjectUserID:  null.StringFrom(m.UserID),
-		Action:         "group.member.removed",
-		Changeset:      []string{},
+		Action:         "group.member.removed",
+		Changeset:      []string{},
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGroupMemberDemoted inserts an event representing group member being demoted from admin into the events table
+func AuditGroupMemberDemoted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, m *models.GroupMembership) (*models.AuditEvent, error) {
+	// TODO non-user API actors don't exist in the governor database,
+	// we need to figure out how to handle that relationship in the audit table
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(m.GroupID),
+		SubjectUserID:  null.StringFrom(m.UserID),
+		Action:         "group.member.demoted",
+		Changeset:      []string{},
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGroupMemberPromoted inserts an event representing group member being promoted to admin into the events table
+func AuditGroupMemberPromoted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, m *models.GroupMembership) (*models.AuditEvent, error) {
+	// TODO non-user API actors don't exist in the governor database,
+	// we need to figure out how to handle that relationship in the audit table
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(m.GroupID),
+		SubjectUserID:  null.StringFrom(m.UserID),
+		Action:         "group.member.promoted",
+		Changeset:      []string{},
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGroupMembershipExempted inserts an event representing a group membership being exempted from expiration
+// and review into the events table
+func AuditGroupMembershipExempted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, original, m *models.GroupMembership) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(m.GroupID),
+		SubjectUserID:  null.StringFrom(m.UserID),
+		Action:         "group.member.exempted",
+		Changeset:      calculateGroupMembershipExemptionChangeset(original, m),
+		Message:        "Membership was exempted: " + m.ExemptionJustification.String,
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGroupMembershipExemptionRemoved inserts an event representing the exemption of a group membership being
+// removed into the events table
+func AuditGroupMembershipExemptionRemoved(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, original, m *models.GroupMembership) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(m.GroupID),
+		SubjectUserID:  null.StringFrom(m.UserID),
+		Action:         "group.member.exemption_removed",
+		Changeset:      calculateGroupMembershipExemptionChangeset(original, m),
+		Message:        "Membership exemption was removed.",
 	}
 
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
 
-// AuditGroupMemberDemoted inserts an event representing group member being demoted from admin into the events table
-func AuditGroupMemberDemoted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, m *models.GroupMembership) (*models.AuditEvent, error) {
-	// TODO non-user API actors don't exist in the governor database,
-	// we need to figure out how to handle that relationship in the audit table
+// AuditScheduledMembershipChangeCreated inserts an event representing a membership change being scheduled into the events table
+func AuditScheduledMembershipChangeCreated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, c *models.ScheduledMembershipChange) (*models.AuditEvent, error) {
 	var actorID null.String
 	if actor != nil {
 		actorID = null.StringFrom(actor.ID)
@@ -362,19 +800,19 @@ func AuditGroupMemberDemoted(ctx context.Context, exec boil.ContextExecutor, pID
 	event := models.AuditEvent{
 		ParentID:       null.StringFrom(pID),
 		ActorID:        actorID,
-		SubjectGroupID: null.StringFrom(m.GroupID),
-		SubjectUserID:  null.StringFrom(m.UserID),
-		Action:         "group.member.demoted",
-		Changeset:      []string{},
+		SubjectGroupID: null.StringFrom(c.GroupID),
+		SubjectUserID:  null.StringFrom(c.UserID),
+		Action:         "group.member.change.scheduled",
+		Changeset:      calculateChangeset(&models.ScheduledMembershipChange{}, c),
+		Message:        "Member " + c.Action + " was scheduled for " + c.ScheduledFor.UTC().Format(time.RFC3339) + ".",
 	}
 
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
 
-// AuditGroupMemberPromoted inserts an event representing group member being promoted to admin into the events table
-func AuditGroupMemberPromoted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, m *models.GroupMembership) (*models.AuditEvent, error) {
-	// TODO non-user API actors don't exist in the governor database,
-	// we need to figure out how to handle that relationship in the audit table
+// AuditScheduledMembershipChangeCanceled inserts an event representing a scheduled membership change being canceled
+// into the events table
+func AuditScheduledMembershipChangeCanceled(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, c *models.ScheduledMembershipChange) (*models.AuditEvent, error) {
 	var actorID null.String
 	if actor != nil {
 		actorID = null.StringFrom(actor.ID)
@@ -383,17 +821,33 @@ func AuditGroupMemberPromoted(ctx context.Context, exec boil.ContextExecutor, pI
 	event := models.AuditEvent{
 		ParentID:       null.StringFrom(pID),
 		ActorID:        actorID,
-		SubjectGroupID: null.StringFrom(m.GroupID),
-		SubjectUserID:  null.StringFrom(m.UserID),
-		Action:         "group.member.promoted",
+		SubjectGroupID: null.StringFrom(c.GroupID),
+		SubjectUserID:  null.StringFrom(c.UserID),
+		Action:         "group.member.change.canceled",
+		Changeset:      []string{},
+		Message:        "Member " + c.Action + " scheduled for " + c.ScheduledFor.UTC().Format(time.RFC3339) + " was canceled.",
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditScheduledMembershipChangeFailed inserts an event representing a scheduled membership change that couldn't be
+// applied into the events table, the scheduling event is its parent
+func AuditScheduledMembershipChangeFailed(ctx context.Context, exec boil.ContextExecutor, c *models.ScheduledMembershipChange) (*models.AuditEvent, error) {
+	event := models.AuditEvent{
+		ParentID:       c.AuditID,
+		SubjectGroupID: null.StringFrom(c.GroupID),
+		SubjectUserID:  null.StringFrom(c.UserID),
+		Action:         "group.member.change.failed",
 		Changeset:      []string{},
+		Message:        "Scheduled member " + c.Action + " failed: " + c.Error,
 	}
 
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
 
 // AuditGroupMembershipApproved inserts an event representing group membership approval into the events table
-func AuditGroupMembershipApproved(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, m *models.GroupMembership, kind string) ([]*models.AuditEvent, error) {
+func AuditGroupMembershipApproved(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, m *models.GroupMembership, kind, msg string) ([]*models.AuditEvent, error) {
 	// TODO non-user API actors don't exist in the governor database,
 	// we need to figure out how to handle that relationship in the audit table
 	var actorID null.String
@@ -419,14 +873,14 @@ func AuditGroupMembershipApproved(ctx context.Context, exec boil.ContextExecutor
 		SubjectUserID:  null.StringFrom(m.UserID),
 		Action:         action,
 		Changeset:      calculateGroupMembershipChangeset(&models.GroupMembership{}, m),
-		Message:        "Request was approved.",
+		Message:        strings.TrimSpace("Request was approved. " + msg),
 	}
 
 	if err := event.Insert(ctx, exec, boil.Infer()); err != nil {
 		return nil, err
 	}
 
-	memEvent, err := AuditGroupMembershipCreated(ctx, exec, pID, actor, m)
+	memEvent, err := AuditGroupMembershipCreated(ctx, exec, pID, actor, m, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -630,7 +1084,7 @@ func AuditGroupApplicationCreated(ctx context.Context, exec boil.ContextExecutor
 		SubjectGroupID:       null.StringFrom(m.GroupID),
 		SubjectApplicationID: null.StringFrom(m.ApplicationID),
 		Action:               "group.application.linked",
-		Changeset:            []string{},
+		Changeset:            changesetLine([]string{}, "expires_at", null.Time{}, m.ExpiresAt),
 	}
 
 	return &event, event.Insert(ctx, exec, boil.Infer())
@@ -657,6 +1111,20 @@ func AuditGroupApplicationDeleted(ctx context.Context, exec boil.ContextExecutor
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
 
+// AuditGroupApplicationExpired inserts an event representing an expired group application link being removed into the events table,
+// the links are removed by the reaper so the event has neither a parent nor an actor
+func AuditGroupApplicationExpired(ctx context.Context, exec boil.ContextExecutor, m *models.GroupApplication) (*models.AuditEvent, error) {
+	event := models.AuditEvent{
+		SubjectGroupID:       null.StringFrom(m.GroupID),
+		SubjectApplicationID: null.StringFrom(m.ApplicationID),
+		Action:               "group.application.expired",
+		Changeset:            []string{},
+		Message:              "Link expired at " + m.ExpiresAt.Time.UTC().Format(time.RFC3339) + ".",
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
 // AuditApplicationCreated inserts an event representing an application being created
 func AuditApplicationCreated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.Application) (*models.AuditEvent, error) {
 	// TODO non-user API actors don't exist in the governor database,
@@ -842,26 +1310,350 @@ func AuditGroupApplicationRequestCreated(ctx context.Context, exec boil.ContextE
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
 
-// AuditGroupApplicationRequestRevoked inserts an event representing group application request revokation into the events table
-func AuditGroupApplicationRequestRevoked(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, r *models.GroupApplicationRequest) (*models.AuditEvent, error) {
+// AuditGroupApplicationRequestRevoked inserts an event representing group application request revokation into the events table
+func AuditGroupApplicationRequestRevoked(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, r *models.GroupApplicationRequest) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:             null.StringFrom(pID),
+		ActorID:              actorID,
+		SubjectApplicationID: null.StringFrom(r.ApplicationID),
+		SubjectGroupID:       null.StringFrom(r.GroupID),
+		Action:               "group.application.request.revoked",
+		Changeset:            []string{},
+		Message:              "Request was revoked.",
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGroupMembershipRequestCommented inserts an event representing a comment on a group membership request into the events table
+func AuditGroupMembershipRequestCommented(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, r *models.GroupMembershipRequest, c *models.RequestComment) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(r.GroupID),
+		SubjectUserID:  null.StringFrom(r.UserID),
+		Action:         "group.member.request.commented",
+		Changeset:      calculateChangeset(&models.RequestComment{}, c),
+		Message:        "Commented on request.",
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGroupApplicationRequestCommented inserts an event representing a comment on a group application request into the events table
+func AuditGroupApplicationRequestCommented(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, r *models.GroupApplicationRequest, c *models.RequestComment) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:             null.StringFrom(pID),
+		ActorID:              actorID,
+		SubjectApplicationID: null.StringFrom(r.ApplicationID),
+		SubjectGroupID:       null.StringFrom(r.GroupID),
+		Action:               "group.application.request.commented",
+		Changeset:            calculateChangeset(&models.RequestComment{}, c),
+		Message:              "Commented on request.",
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditNamingPolicyUpdated inserts an event representing a naming policy being created or updated
+func AuditNamingPolicyUpdated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, a *models.NamingPolicy) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:              null.StringFrom(pID),
+		ActorID:               actorID,
+		SubjectOrganizationID: a.OrganizationID,
+		Action:                "naming_policy.updated",
+		Changeset:             calculateChangeset(o, a),
+		Message:               "Naming policy was updated.",
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditNamingPolicyDeleted inserts an event representing a naming policy being deleted
+func AuditNamingPolicyDeleted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.NamingPolicy) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:              null.StringFrom(pID),
+		ActorID:               actorID,
+		SubjectOrganizationID: a.OrganizationID,
+		Action:                "naming_policy.deleted",
+		Changeset:             []string{},
+		Message:               "Naming policy was deleted.",
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditDurationPolicyUpdated inserts an event representing a membership duration policy being created or updated
+func AuditDurationPolicyUpdated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, a *models.MembershipDurationPolicy) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:              null.StringFrom(pID),
+		ActorID:               actorID,
+		SubjectOrganizationID: a.OrganizationID,
+		SubjectGroupID:        a.GroupID,
+		Action:                "duration_policy.updated",
+		Changeset:             calculateChangeset(o, a),
+		Message:               fmt.Sprintf("Membership duration policy was set to %d days (%s).", a.MaxDays, a.Mode),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditDurationPolicyDeleted inserts an event representing a membership duration policy being deleted
+func AuditDurationPolicyDeleted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.MembershipDurationPolicy) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:              null.StringFrom(pID),
+		ActorID:               actorID,
+		SubjectOrganizationID: a.OrganizationID,
+		SubjectGroupID:        a.GroupID,
+		Action:                "duration_policy.deleted",
+		Changeset:             []string{},
+		Message:               "Membership duration policy was deleted.",
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditFeatureFlagUpdated inserts an event representing a feature flag being set into the events table
+func AuditFeatureFlagUpdated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, a *models.FeatureFlag) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:  null.StringFrom(pID),
+		ActorID:   actorID,
+		Action:    "feature_flag.updated",
+		Changeset: calculateChangeset(o, a),
+		Message:   fmt.Sprintf("Feature flag %s was set to %t.", a.Name, a.Enabled),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditFeatureFlagDeleted inserts an event representing a feature flag being reset to its default into the events table
+func AuditFeatureFlagDeleted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.FeatureFlag) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:  null.StringFrom(pID),
+		ActorID:   actorID,
+		Action:    "feature_flag.deleted",
+		Changeset: calculateChangeset(a, &models.FeatureFlag{}),
+		Message:   fmt.Sprintf("Feature flag %s was reset to its default.", a.Name),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditNetworkPolicyUpdated inserts an event representing a network policy being created or updated into the events table
+func AuditNetworkPolicyUpdated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, a *models.NetworkPolicy) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:  null.StringFrom(pID),
+		ActorID:   actorID,
+		Action:    "network_policy.updated",
+		Changeset: calculateChangeset(o, a),
+		Message:   fmt.Sprintf("Network policy for %s was updated.", a.Subject),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditNetworkPolicyDeleted inserts an event representing a network policy being deleted into the events table
+func AuditNetworkPolicyDeleted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.NetworkPolicy) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:  null.StringFrom(pID),
+		ActorID:   actorID,
+		Action:    "network_policy.deleted",
+		Changeset: calculateChangeset(a, &models.NetworkPolicy{}),
+		Message:   fmt.Sprintf("Network policy for %s was deleted.", a.Subject),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditNetworkPolicyDenied inserts an event representing a request being rejected by a network policy into the events table
+func AuditNetworkPolicyDenied(ctx context.Context, exec boil.ContextExecutor, pID, subject, addr string) (*models.AuditEvent, error) {
+	event := models.AuditEvent{
+		ParentID: null.StringFrom(pID),
+		Action:   "network_policy.denied",
+		Changeset: []string{
+			fmt.Sprintf("Subject: %s", subject),
+			fmt.Sprintf("ClientIP: %s", addr),
+		},
+		Message: fmt.Sprintf("Request from %s was denied by the network policy for %s.", addr, subject),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditJobCreated inserts an event representing a job being enqueued into the events table
+func AuditJobCreated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.Job) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:  null.StringFrom(pID),
+		ActorID:   actorID,
+		Action:    "job.created",
+		Changeset: calculateChangeset(&models.Job{}, a),
+		Message:   fmt.Sprintf("Job %s (%s) was created.", a.ID, a.Kind),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditJobFinished inserts an event representing a job finishing into the events
+// table, the event is attributed to the user who created the job
+func AuditJobFinished(ctx context.Context, exec boil.ContextExecutor, a *models.Job) (*models.AuditEvent, error) {
+	event := models.AuditEvent{
+		ParentID: a.AuditID,
+		ActorID:  a.CreatedBy,
+		Action:   "job.finished",
+		Changeset: []string{
+			fmt.Sprintf("Status: %s", a.Status),
+			fmt.Sprintf("Processed: %d/%d", a.Processed, a.Total),
+		},
+		Message: fmt.Sprintf("Job %s (%s) finished with status %s.", a.ID, a.Kind, a.Status),
+	}
+
+	if a.Error != "" {
+		event.Changeset = append(event.Changeset, fmt.Sprintf("Error: %s", a.Error))
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditEventSubjectRuleUpdated inserts an event representing an event subject rule being set into the events table
+func AuditEventSubjectRuleUpdated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, a *models.EventSubjectRule) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:  null.StringFrom(pID),
+		ActorID:   actorID,
+		Action:    "event_subject_rule.updated",
+		Changeset: calculateChangeset(o, a),
+		Message:   fmt.Sprintf("Event subject rule for %s was set.", eventSubjectRuleTarget(a)),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditEventSubjectRuleDeleted inserts an event representing an event subject rule being deleted into the events table
+func AuditEventSubjectRuleDeleted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.EventSubjectRule) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:  null.StringFrom(pID),
+		ActorID:   actorID,
+		Action:    "event_subject_rule.deleted",
+		Changeset: calculateChangeset(a, &models.EventSubjectRule{}),
+		Message:   fmt.Sprintf("Event subject rule for %s was deleted.", eventSubjectRuleTarget(a)),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditUserFieldSubscriptionUpdated inserts an event representing a user field subscription being set into the events table
+func AuditUserFieldSubscriptionUpdated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, a *models.UserFieldSubscription) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:  null.StringFrom(pID),
+		ActorID:   actorID,
+		Action:    "user_field_subscription.updated",
+		Changeset: calculateChangeset(o, a),
+		Message:   fmt.Sprintf("User field subscription %s was set.", a.Name),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditUserFieldSubscriptionDeleted inserts an event representing a user field subscription being deleted into the events table
+func AuditUserFieldSubscriptionDeleted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.UserFieldSubscription) (*models.AuditEvent, error) {
 	var actorID null.String
 	if actor != nil {
 		actorID = null.StringFrom(actor.ID)
 	}
 
 	event := models.AuditEvent{
-		ParentID:             null.StringFrom(pID),
-		ActorID:              actorID,
-		SubjectApplicationID: null.StringFrom(r.ApplicationID),
-		SubjectGroupID:       null.StringFrom(r.GroupID),
-		Action:               "group.application.request.revoked",
-		Changeset:            []string{},
-		Message:              "Request was revoked.",
+		ParentID:  null.StringFrom(pID),
+		ActorID:   actorID,
+		Action:    "user_field_subscription.deleted",
+		Changeset: calculateChangeset(a, &models.UserFieldSubscription{}),
+		Message:   fmt.Sprintf("User field subscription %s was deleted.", a.Name),
 	}
 
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
 
+func eventSubjectRuleTarget(r *models.EventSubjectRule) string {
+	if r.ExtensionID.Valid {
+		return "extension " + r.ExtensionID.String
+	}
+
+	return "subject " + r.Subject.String
+}
+
 // AuditNotificationTypeCreated inserts an event representing a notification type being created
 func AuditNotificationTypeCreated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.NotificationType) (*models.AuditEvent, error) {
 	// TODO non-user API actors don't exist in the governor database,
@@ -1013,6 +1805,114 @@ func AuditNotificationPreferencesUpdated(ctx context.Context, exec boil.ContextE
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
 
+// AuditNotificationGroupPreferencesUpdated inserts an event representing notification group preferences update into the events table
+func AuditNotificationGroupPreferencesUpdated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, userID string, o, a UserNotificationGroupPreferences) (*models.AuditEvent, error) {
+	// TODO non-user API actors don't exist in the governor database,
+	// we need to figure out how to handle that relationship in the audit table
+	type userNotificationGroupPreferencesAuditRecord struct {
+		GroupPreferences string `json:"group_preferences"`
+	}
+
+	beforeJSON, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+
+	afterJSON, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+
+	before := &userNotificationGroupPreferencesAuditRecord{GroupPreferences: string(beforeJSON)}
+	after := &userNotificationGroupPreferencesAuditRecord{GroupPreferences: string(afterJSON)}
+
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:      null.StringFrom(pID),
+		ActorID:       actorID,
+		Action:        "notification_group_preferences.updated",
+		SubjectUserID: null.NewString(userID, true),
+		Changeset:     calculateChangeset(before, after),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditNotificationFailoverUpdated inserts an event representing notification target ranking and failover update into the events table
+func AuditNotificationFailoverUpdated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, userID string, o, a *UserNotificationFailover) (*models.AuditEvent, error) {
+	// TODO non-user API actors don't exist in the governor database,
+	// we need to figure out how to handle that relationship in the audit table
+	type userNotificationFailoverAuditRecord struct {
+		Failover string `json:"failover"`
+	}
+
+	beforeJSON, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+
+	afterJSON, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+
+	before := &userNotificationFailoverAuditRecord{Failover: string(beforeJSON)}
+	after := &userNotificationFailoverAuditRecord{Failover: string(afterJSON)}
+
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:      null.StringFrom(pID),
+		ActorID:       actorID,
+		Action:        "notification_failover.updated",
+		SubjectUserID: null.NewString(userID, true),
+		Changeset:     calculateChangeset(before, after),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditNotificationBroadcast inserts an event representing a notification broadcast being sent to the members of
+// groups into the events table, with the message and the audience
+func AuditNotificationBroadcast(
+	ctx context.Context,
+	exec boil.ContextExecutor,
+	pID string,
+	actor *models.User,
+	job *models.Job,
+	notificationType, subject, message string,
+	groups []string,
+	recipients int,
+) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID: null.StringFrom(pID),
+		ActorID:  actorID,
+		Action:   "notification.broadcast",
+		Changeset: []string{
+			fmt.Sprintf("NotificationType: %s", notificationType),
+			fmt.Sprintf("Subject: %s", subject),
+			fmt.Sprintf("Message: %s", message),
+			fmt.Sprintf("Groups: %s", strings.Join(groups, ", ")),
+			fmt.Sprintf("Recipients: %d", recipients),
+		},
+		Message: fmt.Sprintf("Notification broadcast to %d members of %d groups was started by job %s.", recipients, len(groups), job.ID),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
 // AuditExtensionCreated inserts an event representing a extension being created
 func AuditExtensionCreated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.Extension) (*models.AuditEvent, error) {
 	// TODO non-user API actors don't exist in the governor database,
@@ -1060,11 +1960,108 @@ func AuditExtensionDeleted(ctx context.Context, exec boil.ContextExecutor, pID s
 		actorID = null.StringFrom(actor.ID)
 	}
 
+	event := models.AuditEvent{
+		ParentID:           null.StringFrom(pID),
+		ActorID:            actorID,
+		SubjectExtensionID: null.StringFrom(a.ID),
+		Action:             "extension.deleted",
+		Changeset:          calculateChangeset(a, &models.Extension{}),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditExtensionCredentialCreated inserts an event representing client credentials being issued to an extension
+func AuditExtensionCredentialCreated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, ext *models.Extension, cred *models.ExtensionCredential) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID: null.StringFrom(pID),
+		ActorID:  actorID,
+		Action:   "extension.credential.created",
+		Changeset: []string{
+			fmt.Sprintf("ClientID: %s", cred.ClientID),
+			fmt.Sprintf("Description: %s", cred.Description),
+		},
+		Message: fmt.Sprintf("Client credentials %s were issued to extension %s.", cred.ClientID, ext.Slug),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditExtensionCredentialDeleted inserts an event representing the client credentials of an extension being revoked
+func AuditExtensionCredentialDeleted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, ext *models.Extension, cred *models.ExtensionCredential) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID: null.StringFrom(pID),
+		ActorID:  actorID,
+		Action:   "extension.credential.deleted",
+		Changeset: []string{
+			fmt.Sprintf("ClientID: %s", cred.ClientID),
+		},
+		Message: fmt.Sprintf("Client credentials %s of extension %s were revoked.", cred.ClientID, ext.Slug),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditExtensionCredentialRotated inserts an event representing the secret of the client credentials of an
+// extension being replaced, the secrets themselves are never recorded
+func AuditExtensionCredentialRotated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, ext *models.Extension, cred *models.ExtensionCredential) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	changeset := []string{fmt.Sprintf("ClientID: %s", cred.ClientID)}
+
+	if cred.ExpiresAt.Valid {
+		changeset = append(changeset, fmt.Sprintf("ExpiresAt: %s", cred.ExpiresAt.Time.Format(time.RFC3339)))
+	}
+
+	previous := "the previous secret was revoked"
+	if cred.PreviousSecretExpiresAt.Valid {
+		previous = "the previous secret is valid until " + cred.PreviousSecretExpiresAt.Time.Format(time.RFC3339)
+		changeset = append(changeset, fmt.Sprintf("PreviousSecretExpiresAt: %s", cred.PreviousSecretExpiresAt.Time.Format(time.RFC3339)))
+	}
+
 	event := models.AuditEvent{
 		ParentID:  null.StringFrom(pID),
 		ActorID:   actorID,
-		Action:    "extension.deleted",
-		Changeset: calculateChangeset(a, &models.Extension{}),
+		Action:    "extension.credential.rotated",
+		Changeset: changeset,
+		Message:   fmt.Sprintf("The secret of client credentials %s of extension %s was rotated, %s.", cred.ClientID, ext.Slug, previous),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditExtensionCredentialUsed inserts an event representing the client credentials of an extension being
+// exchanged for a token. Only the usage metadata is recorded, never the secret or the token.
+func AuditExtensionCredentialUsed(
+	ctx context.Context, exec boil.ContextExecutor, pID string, ext *models.Extension, cred *models.ExtensionCredential, token *models.ExtensionToken, previousSecret bool,
+) (*models.AuditEvent, error) {
+	secret := "current"
+	if previousSecret {
+		secret = "previous"
+	}
+
+	event := models.AuditEvent{
+		ParentID: null.StringFrom(pID),
+		Action:   "extension.credential.used",
+		Changeset: []string{
+			fmt.Sprintf("ClientID: %s", cred.ClientID),
+			fmt.Sprintf("Secret: %s", secret),
+			fmt.Sprintf("TokenExpiresAt: %s", token.ExpiresAt.Format(time.RFC3339)),
+		},
+		Message: fmt.Sprintf("Client credentials %s of extension %s were exchanged for a token with the %s secret.", cred.ClientID, ext.Slug, secret),
 	}
 
 	return &event, event.Insert(ctx, exec, boil.Infer())
@@ -1083,7 +2080,7 @@ func AuditExtensionResourceDefinitionCreated(ctx context.Context, exec boil.Cont
 		ParentID:  null.StringFrom(pID),
 		ActorID:   actorID,
 		Action:    "extension.erd.created",
-		Changeset: calculateChangeset(&models.ExtensionResourceDefinition{}, erd),
+		Changeset: calculateERDChangeset(&models.ExtensionResourceDefinition{}, erd),
 	}
 
 	return &event, event.Insert(ctx, exec, boil.Infer())
@@ -1102,7 +2099,7 @@ func AuditExtensionResourceDefinitionUpdated(ctx context.Context, exec boil.Cont
 		ParentID:  null.StringFrom(pID),
 		ActorID:   actorID,
 		Action:    "extension.erd.updated",
-		Changeset: calculateChangeset(o, a),
+		Changeset: calculateERDChangeset(o, a),
 	}
 
 	return &event, event.Insert(ctx, exec, boil.Infer())
@@ -1121,12 +2118,45 @@ func AuditExtensionResourceDefinitionDeleted(ctx context.Context, exec boil.Cont
 		ParentID:  null.StringFrom(pID),
 		ActorID:   actorID,
 		Action:    "extension.erd.deleted",
-		Changeset: calculateChangeset(erd, &models.ExtensionResourceDefinition{}),
+		Changeset: calculateERDChangeset(erd, &models.ExtensionResourceDefinition{}),
 	}
 
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
 
+// calculateERDChangeset is the changeset of an ERD. A new version of an ERD
+// summarizes the schema diff with its previous version instead of dumping the
+// whole schema, and so do schema changes.
+func calculateERDChangeset(o, a *models.ExtensionResourceDefinition) []string {
+	ob, ab := *o, *a
+	ob.Schema, ab.Schema = nil, nil
+	ob.SchemaDiff, ab.SchemaDiff = null.JSON{}, null.JSON{}
+
+	changeset := calculateChangeset(&ob, &ab)
+
+	switch {
+	case bytes.Equal(o.Schema, a.Schema):
+	case len(o.Schema) == 0 && a.SchemaDiff.Valid:
+		diff := jsonschema.SchemaDiff{}
+		if err := json.Unmarshal(a.SchemaDiff.JSON, &diff); err != nil {
+			return changesetLine(changeset, "Schema", o.Schema, a.Schema)
+		}
+
+		changeset = append(changeset, fmt.Sprintf(`Schema: "%s" => "%s": %s`, a.PreviousVersion.String, a.Version, diff.Summary()))
+	case len(o.Schema) > 0 && len(a.Schema) > 0:
+		diff, err := jsonschema.Diff(o.Schema, a.Schema)
+		if err != nil {
+			return changesetLine(changeset, "Schema", o.Schema, a.Schema)
+		}
+
+		changeset = append(changeset, "Schema: "+diff.Summary())
+	default:
+		changeset = changesetLine(changeset, "Schema", o.Schema, a.Schema)
+	}
+
+	return changeset
+}
+
 // AuditSystemExtensionResourceCreated inserts an event representing an extension resource being created
 func AuditSystemExtensionResourceCreated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.SystemExtensionResource) (*models.AuditEvent, error) {
 	// TODO non-user API actors don't exist in the governor database,
@@ -1165,6 +2195,45 @@ func AuditSystemExtensionResourceUpdated(ctx context.Context, exec boil.ContextE
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
 
+// AuditSystemExtensionResourceRolledBack inserts an event representing an extension resource being restored to a previous version
+func AuditSystemExtensionResourceRolledBack(
+	ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, version int64, o, a *models.SystemExtensionResource,
+) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:  null.StringFrom(pID),
+		ActorID:   actorID,
+		Action:    "extension.resource.rolled_back",
+		Changeset: calculateChangeset(o, a),
+		Message:   fmt.Sprintf("Extension resource was rolled back to version %d.", version),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditSystemExtensionResourceOwnerTransferred inserts an event representing the owner group of an extension resource being changed
+func AuditSystemExtensionResourceOwnerTransferred(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, a *models.SystemExtensionResource) (*models.AuditEvent, error) {
+	// TODO non-user API actors don't exist in the governor database,
+	// we need to figure out how to handle that relationship in the audit table
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:  null.StringFrom(pID),
+		ActorID:   actorID,
+		Action:    "extension.resource.owner.transferred",
+		Changeset: calculateChangeset(o, a),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
 // AuditSystemExtensionResourceDeleted inserts an event representing an extension being deleted
 func AuditSystemExtensionResourceDeleted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.SystemExtensionResource) (*models.AuditEvent, error) {
 	// TODO non-user API actors don't exist in the governor database,
@@ -1222,6 +2291,26 @@ func AuditUserExtensionResourceUpdated(ctx context.Context, exec boil.ContextExe
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
 
+// AuditUserExtensionResourceRolledBack inserts an event representing an extension resource being restored to a previous version
+func AuditUserExtensionResourceRolledBack(
+	ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, version int64, o, a *models.UserExtensionResource,
+) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:  null.StringFrom(pID),
+		ActorID:   actorID,
+		Action:    "extension.resource.rolled_back",
+		Changeset: calculateChangeset(o, a),
+		Message:   fmt.Sprintf("Extension resource was rolled back to version %d.", version),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
 // AuditUserExtensionResourceDeleted inserts an event representing an extension being deleted
 func AuditUserExtensionResourceDeleted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.UserExtensionResource) (*models.AuditEvent, error) {
 	// TODO non-user API actors don't exist in the governor database,
@@ -1240,3 +2329,95 @@ func AuditUserExtensionResourceDeleted(ctx context.Context, exec boil.ContextExe
 
 	return &event, event.Insert(ctx, exec, boil.Infer())
 }
+
+// AuditGithubTeamExportCreated inserts an event representing a group export as a github team being created into the events table
+func AuditGithubTeamExportCreated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.GithubTeamExport) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(a.GroupID),
+		Action:         "github.team.export.created",
+		Changeset:      calculateChangeset(&models.GithubTeamExport{}, a),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGithubTeamExportUpdated inserts an event representing a group export as a github team being updated into the events table
+func AuditGithubTeamExportUpdated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, a *models.GithubTeamExport) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(a.GroupID),
+		Action:         "github.team.export.updated",
+		Changeset:      calculateChangeset(o, a),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditGithubTeamExportDeleted inserts an event representing a group export as a github team being deleted into the events table
+func AuditGithubTeamExportDeleted(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, a *models.GithubTeamExport) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:       null.StringFrom(pID),
+		ActorID:        actorID,
+		SubjectGroupID: null.StringFrom(a.GroupID),
+		Action:         "github.team.export.deleted",
+		Changeset:      calculateChangeset(a, &models.GithubTeamExport{}),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditBackupCreated inserts an event representing a backup of the governor entities being created into the events table,
+// the parent id is empty for the backups created with the cli
+func AuditBackupCreated(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, name string, schemaVersion int64) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:  null.NewString(pID, pID != ""),
+		ActorID:   actorID,
+		Action:    "backup.created",
+		Changeset: []string{fmt.Sprintf("SchemaVersion: %d", schemaVersion)},
+		Message:   fmt.Sprintf("Backup %s was created.", name),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
+
+// AuditBackupRestored inserts an event representing a backup of the governor entities being restored into the events table,
+// the parent id is empty for the backups restored with the cli
+func AuditBackupRestored(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, name string, schemaVersion int64) (*models.AuditEvent, error) {
+	var actorID null.String
+	if actor != nil {
+		actorID = null.StringFrom(actor.ID)
+	}
+
+	event := models.AuditEvent{
+		ParentID:  null.NewString(pID, pID != ""),
+		ActorID:   actorID,
+		Action:    "backup.restored",
+		Changeset: []string{fmt.Sprintf("SchemaVersion: %d", schemaVersion)},
+		Message:   fmt.Sprintf("Backup %s was restored.", name),
+	}
+
+	return &event, event.Insert(ctx, exec, boil.Infer())
+}
@@ -2,8 +2,11 @@ package dbtools
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
 
 	"github.com/metal-toolbox/governor-api/internal/models"
 )
@@ -33,6 +36,12 @@ func TestCalculateChangeset(t *testing.T) {
 			new:         &models.User{Email: "dev@null.zombocom"},
 			expected:    []string{"Email: \"\" => \"dev@null.zombocom\""},
 		},
+		{
+			description: "compare GroupApplication models with a new expiry",
+			original:    &models.GroupApplication{},
+			new:         &models.GroupApplication{ExpiresAt: null.TimeFrom(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))},
+			expected:    []string{"ExpiresAt: \"\" => \"2024-01-02T03:04:05Z\""},
+		},
 	}
 
 	for _, tt := range tests {
@@ -40,3 +49,30 @@ func TestCalculateChangeset(t *testing.T) {
 		assert.Equal(t, tt.expected, got, "test: %s\texpected: %v\tgot: %v\n", tt.description, tt.expected, got)
 	}
 }
+
+func TestCalculateERDChangeset(t *testing.T) {
+	erd := &models.ExtensionResourceDefinition{
+		Version:         "v2",
+		PreviousVersion: null.StringFrom("v1"),
+		Schema:          types.JSON(`{"properties": {"name": {"type": "string"}, "size": {"type": "integer"}}}`),
+		SchemaDiff:      null.JSONFrom([]byte(`{"added": ["size"], "removed": [], "changed": []}`)),
+	}
+
+	assert.Equal(t, []string{
+		`Version: "" => "v2"`,
+		`PreviousVersion: "" => "v1"`,
+		`Schema: "v1" => "v2": added size`,
+	}, calculateERDChangeset(&models.ExtensionResourceDefinition{}, erd), "new versions summarize the schema diff")
+
+	first := &models.ExtensionResourceDefinition{Version: "v1", Schema: types.JSON(`{}`)}
+
+	assert.Equal(t, []string{
+		`Version: "" => "v1"`,
+		`Schema: "" => "{}"`,
+	}, calculateERDChangeset(&models.ExtensionResourceDefinition{}, first), "first versions keep the schema")
+
+	changed := *erd
+	changed.Schema = types.JSON(`{"properties": {"name": {"type": "string"}}}`)
+
+	assert.Equal(t, []string{"Schema: removed size"}, calculateERDChangeset(erd, &changed))
+}
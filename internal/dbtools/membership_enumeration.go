@@ -6,6 +6,8 @@ import (
 	"errors"
 
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
@@ -17,6 +19,7 @@ import (
 // groups as defined hierarchically, called "indirect memberships". A user cannot be an admin of a group via indirect membership. Then, a
 // GROUP BY is applied on `group_id` and `user_id`, so that multiple paths of membership are collapsed into one entry, and `is_admin` and
 // `direct` are aggregated together using a boolean OR so that the values from the direct membership are preferred (if they exist).
+// The `source` of the direct membership is kept the same way, memberships that are only indirect have the `hierarchy` source.
 
 // membershipsByUserQuery works almost exactly the same way as allMembershipsQuery, but applies a WHERE clause filtering on `user_id` to the
 // very first "initial state" query. This works because we know that all paths to direct or indirect membership begin with an entry in the
@@ -43,6 +46,8 @@ const (
 			expires_at,
 			admin_expires_at,
 			is_admin,
+			source,
+			source_ref,
 			TRUE AS direct
 		FROM
 			group_memberships
@@ -55,6 +60,8 @@ const (
 			b.expires_at,
 			NULL as admin_expires_at,
 			FALSE AS is_admin,
+			'hierarchy' AS source,
+			NULL AS source_ref,
 			FALSE AS direct
 		FROM
 			membership_query AS a
@@ -76,6 +83,12 @@ const (
 			NULL
 		END AS admin_expires_at,
 		BOOL_OR(is_admin) as is_admin,
+		CASE WHEN BOOL_OR(direct) THEN
+			MAX(CASE WHEN direct THEN source END)
+		ELSE
+			'hierarchy'
+		END AS source,
+		MAX(CASE WHEN direct THEN source_ref END) AS source_ref,
 		BOOL_OR(direct) as direct
 	FROM
 		membership_query
@@ -89,6 +102,8 @@ const (
 			is_admin,
 			expires_at,
 			admin_expires_at,
+			source,
+			source_ref,
 			TRUE AS direct
 		FROM
 			group_memberships
@@ -101,6 +116,8 @@ const (
 			FALSE AS is_admin,
 			NULL as expires_at,
 			NULL as admin_expires_at,
+			'hierarchy' AS source,
+			NULL AS source_ref,
 			FALSE AS direct
 		FROM
 			membership_query AS a
@@ -122,6 +139,12 @@ const (
 			NULL
 		END AS admin_expires_at,
 		BOOL_OR(is_admin) as is_admin,
+		CASE WHEN BOOL_OR(direct) THEN
+			MAX(CASE WHEN direct THEN source END)
+		ELSE
+			'hierarchy'
+		END AS source,
+		MAX(CASE WHEN direct THEN source_ref END) AS source_ref,
 		BOOL_OR(direct) as direct
 	FROM
 		membership_query
@@ -177,6 +200,12 @@ const (
 		ELSE
 			NULL
 		END AS admin_expires_at,
+		CASE WHEN BOOL_OR(direct) THEN
+			MAX(CASE WHEN direct THEN group_memberships.source END)
+		ELSE
+			'hierarchy'
+		END AS source,
+		MAX(CASE WHEN direct THEN group_memberships.source_ref END) AS source_ref,
 		BOOL_OR(direct) as direct
 	FROM
 		ensure_root
@@ -198,6 +227,41 @@ type EnumeratedMembership struct {
 	ExpiresAt      null.Time
 	AdminExpiresAt null.Time
 	Direct         bool
+	Source         string
+	SourceRef      null.String
+}
+
+// EventMember returns the membership details carried by members events
+func (e EnumeratedMembership) EventMember() *events.Member {
+	return &events.Member{
+		IsAdmin:        e.IsAdmin,
+		ExpiresAt:      e.ExpiresAt.Ptr(),
+		AdminExpiresAt: e.AdminExpiresAt.Ptr(),
+		Direct:         e.Direct,
+		Source:         e.Source,
+		SourceRef:      e.SourceRef.String,
+	}
+}
+
+// GroupMembershipEventMember returns the details of a direct membership carried by members events
+func GroupMembershipEventMember(m *models.GroupMembership) *events.Member {
+	return &events.Member{
+		IsAdmin:        m.IsAdmin,
+		ExpiresAt:      m.ExpiresAt.Ptr(),
+		AdminExpiresAt: m.AdminExpiresAt.Ptr(),
+		Direct:         true,
+		Source:         m.Source,
+		SourceRef:      m.SourceRef.String,
+	}
+}
+
+// GroupHierarchyEventHierarchy returns the details of a group hierarchy carried by hierarchies events
+func GroupHierarchyEventHierarchy(h *models.GroupHierarchy) *events.Hierarchy {
+	return &events.Hierarchy{
+		ParentGroupID: h.ParentGroupID,
+		MemberGroupID: h.MemberGroupID,
+		ExpiresAt:     h.ExpiresAt.Ptr(),
+	}
 }
 
 // GetMembershipsForUser returns a fully enumerated list of memberships for a user, optionally with sqlboiler's generated models populated
@@ -363,6 +427,7 @@ func populateModels(ctx context.Context, db boil.ContextExecutor, memberships []
 
 	queryMods := []qm.QueryMod{
 		qm.WhereIn("id in ?", stringSliceToInterface(groupIDs)...),
+		tenancy.Scope(ctx, models.TableNames.Groups),
 	}
 
 	groups, err := models.Groups(queryMods...).All(ctx, db)
@@ -372,6 +437,7 @@ func populateModels(ctx context.Context, db boil.ContextExecutor, memberships []
 
 	queryMods = []qm.QueryMod{
 		qm.WhereIn("id in ?", stringSliceToInterface(userIDs)...),
+		tenancy.Scope(ctx, models.TableNames.Users),
 	}
 
 	users, err := models.Users(queryMods...).All(ctx, db)
@@ -379,12 +445,22 @@ func populateModels(ctx context.Context, db boil.ContextExecutor, memberships []
 		return []EnumeratedMembership{}, err
 	}
 
-	for i, m := range memberships {
-		memberships[i].Group = findGroupByID(groups, m.GroupID)
-		memberships[i].User = findUserByID(users, m.UserID)
+	_, scoped := tenancy.FromContext(ctx)
+	visible := make([]EnumeratedMembership, 0, len(memberships))
+
+	for _, m := range memberships {
+		m.Group = findGroupByID(groups, m.GroupID)
+		m.User = findUserByID(users, m.UserID)
+
+		// the group or user belongs to another organization
+		if scoped && (m.Group == nil || m.User == nil) {
+			continue
+		}
+
+		visible = append(visible, m)
 	}
 
-	return memberships, nil
+	return visible, nil
 }
 
 func findGroupByID(list models.GroupSlice, id string) *models.Group {
@@ -439,3 +515,42 @@ func contains(list []string, item string) bool {
 
 	return false
 }
+
+// FindChangedMembers returns the memberships in after that also are in before, but with
+// different details, like an inherited membership whose expiration changed with its hierarchy
+func FindChangedMembers(before, after []EnumeratedMembership) []EnumeratedMembership {
+	type key struct {
+		groupID string
+		userID  string
+	}
+
+	beforeMap := make(map[key]EnumeratedMembership)
+
+	for _, e := range before {
+		beforeMap[key{groupID: e.GroupID, userID: e.UserID}] = e
+	}
+
+	changed := make([]EnumeratedMembership, 0)
+
+	for _, e := range after {
+		b, exists := beforeMap[key{groupID: e.GroupID, userID: e.UserID}]
+		if !exists {
+			continue
+		}
+
+		if b.IsAdmin != e.IsAdmin || b.Direct != e.Direct || b.Source != e.Source ||
+			!nullTimeEqual(b.ExpiresAt, e.ExpiresAt) || !nullTimeEqual(b.AdminExpiresAt, e.AdminExpiresAt) {
+			changed = append(changed, e)
+		}
+	}
+
+	return changed
+}
+
+func nullTimeEqual(a, b null.Time) bool {
+	if a.Valid != b.Valid {
+		return false
+	}
+
+	return !a.Valid || a.Time.Equal(b.Time)
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach-go/v2/testserver"
 	dbm "github.com/metal-toolbox/governor-api/db"
@@ -61,6 +62,7 @@ func TestGetAllGroupMemberships(t *testing.T) {
 			GroupID:   "00000002-0000-0000-0000-000000000001",
 			IsAdmin:   true,
 			Direct:    true,
+			Source:    "direct",
 			ExpiresAt: null.Time{},
 		},
 		{
@@ -68,6 +70,7 @@ func TestGetAllGroupMemberships(t *testing.T) {
 			GroupID:   "00000002-0000-0000-0000-000000000002",
 			IsAdmin:   false,
 			Direct:    true,
+			Source:    "direct",
 			ExpiresAt: null.Time{},
 		},
 		{
@@ -75,6 +78,7 @@ func TestGetAllGroupMemberships(t *testing.T) {
 			GroupID:   "00000002-0000-0000-0000-000000000001",
 			IsAdmin:   false,
 			Direct:    false,
+			Source:    "hierarchy",
 			ExpiresAt: null.Time{},
 		},
 		{
@@ -82,6 +86,7 @@ func TestGetAllGroupMemberships(t *testing.T) {
 			GroupID:   "00000002-0000-0000-0000-000000000003",
 			IsAdmin:   false,
 			Direct:    true,
+			Source:    "direct",
 			ExpiresAt: null.Time{},
 		},
 		{
@@ -89,6 +94,7 @@ func TestGetAllGroupMemberships(t *testing.T) {
 			GroupID:   "00000002-0000-0000-0000-000000000002",
 			IsAdmin:   false,
 			Direct:    false,
+			Source:    "hierarchy",
 			ExpiresAt: null.Time{},
 		},
 		{
@@ -96,6 +102,7 @@ func TestGetAllGroupMemberships(t *testing.T) {
 			GroupID:   "00000002-0000-0000-0000-000000000001",
 			IsAdmin:   false,
 			Direct:    false,
+			Source:    "hierarchy",
 			ExpiresAt: null.Time{},
 		},
 		{
@@ -103,6 +110,7 @@ func TestGetAllGroupMemberships(t *testing.T) {
 			GroupID:   "00000002-0000-0000-0000-000000000001",
 			IsAdmin:   false,
 			Direct:    true,
+			Source:    "direct",
 			ExpiresAt: null.Time{},
 		},
 		{
@@ -110,6 +118,7 @@ func TestGetAllGroupMemberships(t *testing.T) {
 			GroupID:   "00000002-0000-0000-0000-000000000003",
 			IsAdmin:   false,
 			Direct:    true,
+			Source:    "direct",
 			ExpiresAt: null.Time{},
 		},
 		{
@@ -117,6 +126,7 @@ func TestGetAllGroupMemberships(t *testing.T) {
 			GroupID:   "00000002-0000-0000-0000-000000000002",
 			IsAdmin:   false,
 			Direct:    false,
+			Source:    "hierarchy",
 			ExpiresAt: null.Time{},
 		},
 		{
@@ -124,6 +134,7 @@ func TestGetAllGroupMemberships(t *testing.T) {
 			GroupID:   "00000002-0000-0000-0000-000000000005",
 			IsAdmin:   false,
 			Direct:    true,
+			Source:    "direct",
 			ExpiresAt: null.Time{},
 		},
 	}
@@ -143,6 +154,7 @@ func TestGetMembershipsForUser(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000001",
 				IsAdmin:   true,
 				Direct:    true,
+				Source:    "direct",
 				ExpiresAt: null.Time{},
 			},
 		},
@@ -152,6 +164,7 @@ func TestGetMembershipsForUser(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000002",
 				IsAdmin:   false,
 				Direct:    true,
+				Source:    "direct",
 				ExpiresAt: null.Time{},
 			},
 			{
@@ -159,6 +172,7 @@ func TestGetMembershipsForUser(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000001",
 				IsAdmin:   false,
 				Direct:    false,
+				Source:    "hierarchy",
 				ExpiresAt: null.Time{},
 			},
 		},
@@ -168,6 +182,7 @@ func TestGetMembershipsForUser(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000003",
 				IsAdmin:   false,
 				Direct:    true,
+				Source:    "direct",
 				ExpiresAt: null.Time{},
 			},
 			{
@@ -175,6 +190,7 @@ func TestGetMembershipsForUser(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000002",
 				IsAdmin:   false,
 				Direct:    false,
+				Source:    "hierarchy",
 				ExpiresAt: null.Time{},
 			},
 			{
@@ -182,6 +198,7 @@ func TestGetMembershipsForUser(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000001",
 				IsAdmin:   false,
 				Direct:    false,
+				Source:    "hierarchy",
 				ExpiresAt: null.Time{},
 			},
 		},
@@ -191,6 +208,7 @@ func TestGetMembershipsForUser(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000001",
 				IsAdmin:   false,
 				Direct:    true,
+				Source:    "direct",
 				ExpiresAt: null.Time{},
 			},
 			{
@@ -198,6 +216,7 @@ func TestGetMembershipsForUser(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000003",
 				IsAdmin:   false,
 				Direct:    true,
+				Source:    "direct",
 				ExpiresAt: null.Time{},
 			},
 			{
@@ -205,6 +224,7 @@ func TestGetMembershipsForUser(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000002",
 				IsAdmin:   false,
 				Direct:    false,
+				Source:    "hierarchy",
 				ExpiresAt: null.Time{},
 			},
 		},
@@ -214,6 +234,7 @@ func TestGetMembershipsForUser(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000005",
 				IsAdmin:   false,
 				Direct:    true,
+				Source:    "direct",
 				ExpiresAt: null.Time{},
 			},
 		},
@@ -238,6 +259,7 @@ func TestGetMembersOfGroup(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000003",
 				IsAdmin:   false,
 				Direct:    true,
+				Source:    "direct",
 				ExpiresAt: null.Time{},
 			},
 			{
@@ -245,6 +267,7 @@ func TestGetMembersOfGroup(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000003",
 				IsAdmin:   false,
 				Direct:    true,
+				Source:    "direct",
 				ExpiresAt: null.Time{},
 			},
 		},
@@ -254,6 +277,7 @@ func TestGetMembersOfGroup(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000002",
 				IsAdmin:   false,
 				Direct:    true,
+				Source:    "direct",
 				ExpiresAt: null.Time{},
 			},
 			{
@@ -261,6 +285,7 @@ func TestGetMembersOfGroup(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000002",
 				IsAdmin:   false,
 				Direct:    false,
+				Source:    "hierarchy",
 				ExpiresAt: null.Time{},
 			},
 			{
@@ -268,6 +293,7 @@ func TestGetMembersOfGroup(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000002",
 				IsAdmin:   false,
 				Direct:    false,
+				Source:    "hierarchy",
 				ExpiresAt: null.Time{},
 			},
 		},
@@ -277,6 +303,7 @@ func TestGetMembersOfGroup(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000001",
 				IsAdmin:   true,
 				Direct:    true,
+				Source:    "direct",
 				ExpiresAt: null.Time{},
 			},
 			{
@@ -284,6 +311,7 @@ func TestGetMembersOfGroup(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000001",
 				IsAdmin:   false,
 				Direct:    false,
+				Source:    "hierarchy",
 				ExpiresAt: null.Time{},
 			},
 			{
@@ -291,6 +319,7 @@ func TestGetMembersOfGroup(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000001",
 				IsAdmin:   false,
 				Direct:    false,
+				Source:    "hierarchy",
 				ExpiresAt: null.Time{},
 			},
 			{
@@ -298,6 +327,7 @@ func TestGetMembersOfGroup(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000001",
 				IsAdmin:   false,
 				Direct:    true,
+				Source:    "direct",
 				ExpiresAt: null.Time{},
 			},
 		},
@@ -307,6 +337,7 @@ func TestGetMembersOfGroup(t *testing.T) {
 				GroupID:   "00000002-0000-0000-0000-000000000005",
 				IsAdmin:   false,
 				Direct:    true,
+				Source:    "direct",
 				ExpiresAt: null.Time{},
 			},
 		},
@@ -370,6 +401,27 @@ func TestHierarchyWouldCreateCycle(t *testing.T) {
 //     │        ▼                                   
 //     └────► User5                                 
 
+func TestFindChangedMembers(t *testing.T) {
+	expires := null.TimeFrom(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	before := []EnumeratedMembership{
+		{GroupID: "parent", UserID: "kept"},
+		{GroupID: "parent", UserID: "expiring"},
+		{GroupID: "parent", UserID: "removed"},
+		{GroupID: "child", UserID: "expiring", ExpiresAt: expires, Direct: true},
+	}
+
+	after := []EnumeratedMembership{
+		{GroupID: "parent", UserID: "kept"},
+		{GroupID: "parent", UserID: "expiring", ExpiresAt: expires},
+		{GroupID: "parent", UserID: "added"},
+		{GroupID: "child", UserID: "expiring", ExpiresAt: null.TimeFrom(expires.Time.In(time.Local)), Direct: true},
+	}
+
+	assert.Equal(t, []EnumeratedMembership{{GroupID: "parent", UserID: "expiring", ExpiresAt: expires}}, FindChangedMembers(before, after))
+	assert.Empty(t, FindChangedMembers(after, after))
+}
+
 func seedTestDB(db *sql.DB) error {
 	testData := []string{
 		`INSERT INTO "users" ("id", "external_id", "name", "email", "login_count", "avatar_url", "last_login_at", "created_at", "updated_at", "github_id", "github_username", "deleted_at", "status") VALUES
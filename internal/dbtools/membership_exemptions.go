@@ -0,0 +1,17 @@
+package dbtools
+
+import "github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+const exemptGroupMembership = `(group_memberships.exemption_expires_at IS NOT NULL AND group_memberships.exemption_expires_at > NOW())`
+
+// ExemptGroupMemberships is a query mod keeping the group memberships with an
+// unexpired exemption from expiration and review
+func ExemptGroupMemberships() qm.QueryMod {
+	return qm.Where(exemptGroupMembership)
+}
+
+// UnexemptGroupMemberships is a query mod filtering out the group memberships
+// with an unexpired exemption from expiration and review
+func UnexemptGroupMemberships() qm.QueryMod {
+	return qm.Where("NOT " + exemptGroupMembership)
+}
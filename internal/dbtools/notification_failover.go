@@ -0,0 +1,191 @@
+package dbtools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// UserNotificationFailoverPolicy makes the notifications of a type go to one
+// target at a time, failing over to the next ranked target when they aren't
+// delivered after FailoverAfterSeconds
+type UserNotificationFailoverPolicy struct {
+	NotificationType     string `json:"notification_type"`
+	FailoverAfterSeconds int    `json:"failover_after_seconds"`
+}
+
+// UserNotificationFailover is the order a user's notification targets are
+// tried in, and the notification types failing over between them
+type UserNotificationFailover struct {
+	TargetRanking []string                          `json:"target_ranking"`
+	Policies      []*UserNotificationFailoverPolicy `json:"policies"`
+}
+
+// GetNotificationFailover fetch a user's notification target ranking and
+// failover policies from the governor DB, deleted targets and types are
+// left out
+func GetNotificationFailover(ctx context.Context, uid string, ex boil.ContextExecutor) (*UserNotificationFailover, error) {
+	rankings, err := models.NotificationTargetRankings(
+		qm.Where("user_id = ?", uid),
+		qm.Load(models.NotificationTargetRankingRels.NotificationTarget),
+		qm.OrderBy("rank"),
+	).All(ctx, ex)
+	if err != nil {
+		return nil, newErrDBGetNotificationPreferences(err.Error())
+	}
+
+	policies, err := models.NotificationFailoverPolicies(
+		qm.Where("user_id = ?", uid),
+		qm.Load(models.NotificationFailoverPolicyRels.NotificationType),
+	).All(ctx, ex)
+	if err != nil {
+		return nil, newErrDBGetNotificationPreferences(err.Error())
+	}
+
+	failover := &UserNotificationFailover{
+		TargetRanking: []string{},
+		Policies:      []*UserNotificationFailoverPolicy{},
+	}
+
+	for _, r := range rankings {
+		if r.R == nil || r.R.NotificationTarget == nil || r.R.NotificationTarget.DeletedAt.Valid {
+			continue
+		}
+
+		failover.TargetRanking = append(failover.TargetRanking, r.R.NotificationTarget.Slug)
+	}
+
+	for _, p := range policies {
+		if p.R == nil || p.R.NotificationType == nil || p.R.NotificationType.DeletedAt.Valid {
+			continue
+		}
+
+		failover.Policies = append(failover.Policies, &UserNotificationFailoverPolicy{
+			NotificationType:     p.R.NotificationType.Slug,
+			FailoverAfterSeconds: p.FailoverAfterSeconds,
+		})
+	}
+
+	sort.Slice(failover.Policies, func(i, j int) bool {
+		return failover.Policies[i].NotificationType < failover.Policies[j].NotificationType
+	})
+
+	return failover, nil
+}
+
+// ReplaceNotificationFailover replaces a user's notification target ranking
+// and failover policies
+func ReplaceNotificationFailover(
+	ctx context.Context,
+	user *models.User,
+	failover *UserNotificationFailover,
+	ex boil.ContextExecutor,
+	auditID string,
+	actor *models.User,
+) (*models.AuditEvent, error) {
+	typeSlugToID, err := slugToIDMap(ctx, models.TableNames.NotificationTypes, ex)
+	if err != nil {
+		return nil, err
+	}
+
+	targetSlugToID, err := slugToIDMap(ctx, models.TableNames.NotificationTargets, ex)
+	if err != nil {
+		return nil, err
+	}
+
+	curr, err := GetNotificationFailover(ctx, user.ID, ex)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := models.NotificationTargetRankings(qm.Where("user_id = ?", user.ID)).DeleteAll(ctx, ex); err != nil {
+		return nil, err
+	}
+
+	if _, err := models.NotificationFailoverPolicies(qm.Where("user_id = ?", user.ID)).DeleteAll(ctx, ex); err != nil {
+		return nil, err
+	}
+
+	ranked := map[string]bool{}
+
+	for i, target := range failover.TargetRanking {
+		notificationTargetID, ok := targetSlugToID[target]
+		if !ok {
+			return nil, newErrDBUpdateNotificationPreferences(fmt.Sprintf("notificationTarget %s not found", target))
+		}
+
+		if ranked[target] {
+			return nil, newErrDBUpdateNotificationPreferences(fmt.Sprintf("notificationTarget %s ranked more than once", target))
+		}
+
+		ranked[target] = true
+
+		r := &models.NotificationTargetRanking{
+			UserID:               user.ID,
+			NotificationTargetID: notificationTargetID,
+			Rank:                 i,
+		}
+
+		if err := r.Insert(ctx, ex, boil.Infer()); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, p := range failover.Policies {
+		notificationTypeID, ok := typeSlugToID[p.NotificationType]
+		if !ok {
+			return nil,
+				newErrDBUpdateNotificationPreferences(fmt.Sprintf("notificationType %s not found", p.NotificationType))
+		}
+
+		if p.FailoverAfterSeconds <= 0 {
+			return nil, newErrDBUpdateNotificationPreferences(fmt.Sprintf(
+				"notification type [%s] failover_after_seconds must be positive", p.NotificationType,
+			))
+		}
+
+		policy := &models.NotificationFailoverPolicy{
+			UserID:               user.ID,
+			NotificationTypeID:   notificationTypeID,
+			FailoverAfterSeconds: p.FailoverAfterSeconds,
+		}
+
+		if err := policy.Insert(ctx, ex, boil.Infer()); err != nil {
+			return nil, err
+		}
+	}
+
+	return AuditNotificationFailoverUpdated(ctx, ex, auditID, actor, user.ID, curr, failover)
+}
+
+// RankNotificationTargets orders the targets by the user's ranking, the
+// targets the user didn't rank come last in their original order
+func RankNotificationTargets(targets, ranking []string) []string {
+	rank := make(map[string]int, len(ranking))
+	for i, t := range ranking {
+		rank[t] = i
+	}
+
+	ranked := make([]string, len(targets))
+	copy(ranked, targets)
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		ri, iok := rank[ranked[i]]
+		rj, jok := rank[ranked[j]]
+
+		switch {
+		case iok && jok:
+			return ri < rj
+		case iok != jok:
+			return iok
+		default:
+			return false
+		}
+	})
+
+	return ranked
+}
@@ -0,0 +1,17 @@
+package dbtools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankNotificationTargets(t *testing.T) {
+	targets := []string{"email", "pager", "slack", "sms"}
+
+	assert.Equal(t, []string{"slack", "email", "pager", "sms"}, RankNotificationTargets(targets, []string{"slack", "email"}))
+	assert.Equal(t, targets, RankNotificationTargets(targets, nil), "unranked targets keep their order")
+	assert.Equal(t, []string{"sms", "email", "pager", "slack"}, RankNotificationTargets(targets, []string{"webhook", "sms"}),
+		"ranked targets the notification isn't sent to are ignored")
+	assert.Equal(t, []string{"email", "pager", "slack", "sms"}, targets, "the targets aren't modified")
+}
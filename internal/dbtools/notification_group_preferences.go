@@ -0,0 +1,244 @@
+package dbtools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// NotificationLevelMuted drops the notifications about a group
+	NotificationLevelMuted = "muted"
+	// NotificationLevelElevated delivers the notifications about a group even
+	// when their type or target is disabled
+	NotificationLevelElevated = "elevated"
+)
+
+// UserNotificationGroupPreference overrides the notification preferences of
+// a user for the notifications about a group. An empty notification type
+// applies to every type.
+type UserNotificationGroupPreference struct {
+	Group            string `json:"group"`
+	NotificationType string `json:"notification_type,omitempty"`
+	Level            string `json:"level"`
+}
+
+// UserNotificationGroupPreferences is an alias for user notification group
+// preference slice
+type UserNotificationGroupPreferences []*UserNotificationGroupPreference
+
+// NotificationDelivery is how a notification should be delivered to a user
+type NotificationDelivery struct {
+	Deliver  bool   `json:"deliver"`
+	Elevated bool   `json:"elevated"`
+	Level    string `json:"level,omitempty"`
+}
+
+// GetNotificationGroupPreferences fetch a user's notification group
+// preferences from the governor DB, preferences for deleted groups or
+// notification types are left out
+func GetNotificationGroupPreferences(ctx context.Context, uid string, ex boil.ContextExecutor) (UserNotificationGroupPreferences, error) {
+	records, err := models.NotificationGroupPreferences(
+		qm.Where("user_id = ?", uid),
+		qm.Load(models.NotificationGroupPreferenceRels.Group),
+		qm.Load(models.NotificationGroupPreferenceRels.NotificationType),
+	).All(ctx, ex)
+	if err != nil {
+		return nil, newErrDBGetNotificationPreferences(err.Error())
+	}
+
+	preferences := UserNotificationGroupPreferences{}
+
+	for _, r := range records {
+		if r.R == nil || r.R.Group == nil {
+			continue
+		}
+
+		p := &UserNotificationGroupPreference{
+			Group: r.R.Group.Slug,
+			Level: r.Level,
+		}
+
+		if r.NotificationTypeID.Valid {
+			if r.R.NotificationType == nil || r.R.NotificationType.DeletedAt.Valid {
+				continue
+			}
+
+			p.NotificationType = r.R.NotificationType.Slug
+		}
+
+		preferences = append(preferences, p)
+	}
+
+	sort.Slice(preferences, func(i, j int) bool {
+		if preferences[i].Group != preferences[j].Group {
+			return preferences[i].Group < preferences[j].Group
+		}
+
+		return preferences[i].NotificationType < preferences[j].NotificationType
+	})
+
+	return preferences, nil
+}
+
+// ReplaceNotificationGroupPreferences replaces all of a user's notification
+// group preferences, an empty list resets the user to the preferences of the
+// notification types and targets
+func ReplaceNotificationGroupPreferences(
+	ctx context.Context,
+	user *models.User,
+	groupPreferences UserNotificationGroupPreferences,
+	ex boil.ContextExecutor,
+	auditID string,
+	actor *models.User,
+) (*models.AuditEvent, error) {
+	typeSlugToID, err := slugToIDMap(ctx, models.TableNames.NotificationTypes, ex)
+	if err != nil {
+		return nil, err
+	}
+
+	groupSlugs := []interface{}{}
+	seen := map[string]bool{}
+
+	for _, p := range groupPreferences {
+		if p.Level != NotificationLevelMuted && p.Level != NotificationLevelElevated {
+			return nil, newErrDBUpdateNotificationPreferences(fmt.Sprintf(
+				"group [%s] notification level must be one of %s or %s",
+				p.Group, NotificationLevelMuted, NotificationLevelElevated,
+			))
+		}
+
+		key := p.Group + "/" + p.NotificationType
+		if seen[key] {
+			return nil, newErrDBUpdateNotificationPreferences(fmt.Sprintf(
+				"duplicate preference for group [%s] notification type [%s]", p.Group, p.NotificationType,
+			))
+		}
+
+		seen[key] = true
+
+		groupSlugs = append(groupSlugs, p.Group)
+	}
+
+	groupSlugToID := map[string]string{}
+
+	if len(groupSlugs) > 0 {
+		groups, err := models.Groups(qm.WhereIn("slug IN ?", groupSlugs...)).All(ctx, ex)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, g := range groups {
+			groupSlugToID[g.Slug] = g.ID
+		}
+	}
+
+	curr, err := GetNotificationGroupPreferences(ctx, user.ID, ex)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := models.NotificationGroupPreferences(qm.Where("user_id = ?", user.ID)).DeleteAll(ctx, ex); err != nil {
+		return nil, err
+	}
+
+	for _, p := range groupPreferences {
+		groupID, ok := groupSlugToID[p.Group]
+		if !ok {
+			return nil, newErrDBUpdateNotificationPreferences(fmt.Sprintf("group %s not found", p.Group))
+		}
+
+		ngp := &models.NotificationGroupPreference{
+			UserID:  user.ID,
+			GroupID: groupID,
+			Level:   p.Level,
+		}
+
+		if p.NotificationType != "" {
+			notificationTypeID, ok := typeSlugToID[p.NotificationType]
+			if !ok {
+				return nil,
+					newErrDBUpdateNotificationPreferences(fmt.Sprintf("notificationType %s not found", p.NotificationType))
+			}
+
+			ngp.NotificationTypeID = null.StringFrom(notificationTypeID)
+		}
+
+		if err := ngp.Insert(ctx, ex, boil.Infer()); err != nil {
+			return nil, err
+		}
+	}
+
+	return AuditNotificationGroupPreferencesUpdated(ctx, ex, auditID, actor, user.ID, curr, groupPreferences)
+}
+
+// ResolveNotificationDelivery decides how a notification of a type, sent
+// through a target about a group, is delivered to a user. The group
+// preference for the notification type takes precedence over the one for
+// every type: a muted group drops the notification and an elevated group
+// delivers it even when the type or target is disabled. Without a group
+// preference, the notification is delivered when both its type and target
+// are enabled. The preferences must include the defaults, an empty target is
+// the notification type itself.
+func ResolveNotificationDelivery(
+	preferences UserNotificationPreferences,
+	groupPreferences UserNotificationGroupPreferences,
+	group, notificationType, target string,
+) NotificationDelivery {
+	var groupPreference *UserNotificationGroupPreference
+
+	if group != "" {
+		for _, p := range groupPreferences {
+			if p.Group != group {
+				continue
+			}
+
+			if p.NotificationType == notificationType {
+				groupPreference = p
+				break
+			}
+
+			if p.NotificationType == "" {
+				groupPreference = p
+			}
+		}
+	}
+
+	if groupPreference != nil {
+		switch groupPreference.Level {
+		case NotificationLevelMuted:
+			return NotificationDelivery{Level: NotificationLevelMuted}
+		case NotificationLevelElevated:
+			return NotificationDelivery{Deliver: true, Elevated: true, Level: NotificationLevelElevated}
+		}
+	}
+
+	for _, p := range preferences {
+		if p.NotificationType != notificationType {
+			continue
+		}
+
+		if p.Enabled == nil || !*p.Enabled {
+			return NotificationDelivery{}
+		}
+
+		if target == "" {
+			return NotificationDelivery{Deliver: true}
+		}
+
+		for _, t := range p.NotificationTargets {
+			if t.Target == target {
+				return NotificationDelivery{Deliver: t.Enabled != nil && *t.Enabled}
+			}
+		}
+
+		return NotificationDelivery{}
+	}
+
+	return NotificationDelivery{}
+}
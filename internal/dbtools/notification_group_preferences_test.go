@@ -0,0 +1,107 @@
+package dbtools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveNotificationDelivery(t *testing.T) {
+	enabled, disabled := true, false
+
+	preferences := UserNotificationPreferences{
+		{
+			NotificationType: "announcements",
+			Enabled:          &enabled,
+			NotificationTargets: UserNotificationPreferenceTargets{
+				{Target: "slack", Enabled: &enabled},
+				{Target: "email", Enabled: &disabled},
+			},
+		},
+		{
+			NotificationType: "approvals",
+			Enabled:          &disabled,
+			NotificationTargets: UserNotificationPreferenceTargets{
+				{Target: "slack", Enabled: &enabled},
+			},
+		},
+	}
+
+	groupPreferences := UserNotificationGroupPreferences{
+		{Group: "noisy", Level: NotificationLevelMuted},
+		{Group: "noisy", NotificationType: "approvals", Level: NotificationLevelElevated},
+		{Group: "oncall", Level: NotificationLevelElevated},
+	}
+
+	tests := []struct {
+		name             string
+		group            string
+		notificationType string
+		target           string
+		want             NotificationDelivery
+	}{
+		{
+			name:             "enabled type and target",
+			group:            "other",
+			notificationType: "announcements",
+			target:           "slack",
+			want:             NotificationDelivery{Deliver: true},
+		},
+		{
+			name:             "disabled target",
+			notificationType: "announcements",
+			target:           "email",
+			want:             NotificationDelivery{},
+		},
+		{
+			name:             "enabled type without target",
+			notificationType: "announcements",
+			want:             NotificationDelivery{Deliver: true},
+		},
+		{
+			name:             "disabled type mutes the targets",
+			notificationType: "approvals",
+			target:           "slack",
+			want:             NotificationDelivery{},
+		},
+		{
+			name:             "unknown target",
+			notificationType: "announcements",
+			target:           "pager",
+			want:             NotificationDelivery{},
+		},
+		{
+			name:             "unknown type",
+			notificationType: "unknown",
+			want:             NotificationDelivery{},
+		},
+		{
+			name:             "muted group",
+			group:            "noisy",
+			notificationType: "announcements",
+			target:           "slack",
+			want:             NotificationDelivery{Level: NotificationLevelMuted},
+		},
+		{
+			name:             "group type preference takes precedence",
+			group:            "noisy",
+			notificationType: "approvals",
+			target:           "slack",
+			want:             NotificationDelivery{Deliver: true, Elevated: true, Level: NotificationLevelElevated},
+		},
+		{
+			name:             "elevated group overrides disabled target",
+			group:            "oncall",
+			notificationType: "announcements",
+			target:           "email",
+			want:             NotificationDelivery{Deliver: true, Elevated: true, Level: NotificationLevelElevated},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ResolveNotificationDelivery(preferences, groupPreferences, tt.group, tt.notificationType, tt.target)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package dbtools
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const (
+	// TombstoneKindGroupMembership is the tombstone kind of a removed group membership
+	TombstoneKindGroupMembership = "group_membership"
+	// TombstoneKindGroupHierarchy is the tombstone kind of a removed group hierarchy
+	TombstoneKindGroupHierarchy = "group_hierarchy"
+	// TombstoneKindGroupOrganization is the tombstone kind of a removed group organization link
+	TombstoneKindGroupOrganization = "group_organization"
+)
+
+// TombstoneKinds are the kinds of the relationship tombstones
+var TombstoneKinds = []string{
+	TombstoneKindGroupMembership,
+	TombstoneKindGroupHierarchy,
+	TombstoneKindGroupOrganization,
+}
+
+// registerRelationshipTombstoneHooks records a tombstone in the same
+// transaction whenever a hard deleted relationship is removed, so the
+// removals can be synced like the soft deleted records. The deletes with a
+// query, rather than a model or a slice, don't run the hooks and aren't
+// recorded.
+func registerRelationshipTombstoneHooks() {
+	models.AddGroupMembershipHook(boil.AfterDeleteHook, func(ctx context.Context, exec boil.ContextExecutor, m *models.GroupMembership) error {
+		return insertRelationshipTombstone(ctx, exec, TombstoneKindGroupMembership, m.ID, m.GroupID, m.UserID, m)
+	})
+
+	models.AddGroupHierarchyHook(boil.AfterDeleteHook, func(ctx context.Context, exec boil.ContextExecutor, h *models.GroupHierarchy) error {
+		return insertRelationshipTombstone(ctx, exec, TombstoneKindGroupHierarchy, h.ID, h.ParentGroupID, h.MemberGroupID, h)
+	})
+
+	models.AddGroupOrganizationHook(boil.AfterDeleteHook, func(ctx context.Context, exec boil.ContextExecutor, o *models.GroupOrganization) error {
+		return insertRelationshipTombstone(ctx, exec, TombstoneKindGroupOrganization, o.ID, o.GroupID, o.OrganizationID, o)
+	})
+}
+
+// insertRelationshipTombstone records the removal of a relationship along
+// with a snapshot of the removed row
+func insertRelationshipTombstone(ctx context.Context, exec boil.ContextExecutor, kind, recordID, groupID, subjectID string, record interface{}) error {
+	snapshot, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	tombstone := &models.RelationshipTombstone{
+		Kind:      kind,
+		RecordID:  recordID,
+		GroupID:   groupID,
+		SubjectID: subjectID,
+		Record:    snapshot,
+		RemovedAt: time.Now().UTC(),
+	}
+
+	return tombstone.Insert(ctx, exec, boil.Infer())
+}
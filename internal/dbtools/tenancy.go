@@ -0,0 +1,21 @@
+package dbtools
+
+import (
+	"context"
+
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+)
+
+// tenantParam returns the organization the context is scoped to as the
+// parameter of a raw query, NULL when it isn't scoped. The raw queries skip
+// the tenancy hooks of the models, they limit the rows with a tenant_id to the
+// ones visible to the organization like tenancy.Scope does:
+//
+//	($1::UUID IS NULL OR groups.tenant_id IS NULL OR groups.tenant_id = $1::UUID)
+func tenantParam(ctx context.Context) null.String {
+	id, ok := tenancy.FromContext(ctx)
+
+	return null.NewString(id, ok)
+}
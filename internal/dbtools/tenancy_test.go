@@ -0,0 +1,32 @@
+package dbtools
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTenantA = "00000009-0000-0000-0000-00000000000a"
+	testTenantB = "00000009-0000-0000-0000-00000000000b"
+)
+
+// seedTenants creates the testTenantA and testTenantB organizations in tx and
+// sets the tenant of the rows listed by tenant and table
+func seedTenants(t *testing.T, tx *sql.Tx, tenants map[string]map[string][]string) {
+	t.Helper()
+
+	_, err := tx.Exec(`INSERT INTO organizations (id, name, slug) VALUES ($1, 'Tenant A', 'tenant-a'), ($2, 'Tenant B', 'tenant-b')`,
+		testTenantA, testTenantB)
+	require.NoError(t, err)
+
+	for tenantID, tables := range tenants {
+		for table, ids := range tables {
+			for _, id := range ids {
+				_, err := tx.Exec(`UPDATE `+table+` SET tenant_id = $1 WHERE id = $2`, tenantID, id)
+				require.NoError(t, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package dbtools
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// WithTransaction runs fn in a transaction of db. The transaction is committed
+// when fn returns nil, and rolled back when fn returns an error or panics, the
+// panic is then propagated. The error of fn is returned as is, with the
+// rollback error appended when rolling back fails too, so callers can still
+// match it. A failed commit isn't rolled back, the transaction is already
+// done.
+func WithTransaction(ctx context.Context, db boil.ContextBeginner, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+
+			panic(p)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: error rolling back transaction: %s", err, rerr.Error())
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package dbtools
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestTx = errors.New("test transaction error")
+
+// txRecorder is a database driver recording how its transactions end
+type txRecorder struct {
+	commits   int
+	rollbacks int
+	commitErr error
+}
+
+func (r *txRecorder) Connect(context.Context) (driver.Conn, error) { return &txRecorderConn{r}, nil }
+func (r *txRecorder) Driver() driver.Driver                        { return nil }
+
+type txRecorderConn struct {
+	r *txRecorder
+}
+
+func (c *txRecorderConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c *txRecorderConn) Close() error                        { return nil }
+func (c *txRecorderConn) Begin() (driver.Tx, error)           { return &txRecorderTx{c.r}, nil }
+
+type txRecorderTx struct {
+	r *txRecorder
+}
+
+func (t *txRecorderTx) Commit() error {
+	t.r.commits++
+	return t.r.commitErr
+}
+
+func (t *txRecorderTx) Rollback() error {
+	t.r.rollbacks++
+	return nil
+}
+
+func TestWithTransaction(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("commit", func(t *testing.T) {
+		r := &txRecorder{}
+		db := sql.OpenDB(r)
+
+		called := false
+
+		require.NoError(t, WithTransaction(ctx, db, func(tx *sql.Tx) error {
+			called = tx != nil
+			return nil
+		}))
+
+		assert.True(t, called)
+		assert.Equal(t, 1, r.commits)
+		assert.Equal(t, 0, r.rollbacks)
+	})
+
+	t.Run("rollback on error", func(t *testing.T) {
+		r := &txRecorder{}
+		db := sql.OpenDB(r)
+
+		err := WithTransaction(ctx, db, func(*sql.Tx) error {
+			return errTestTx
+		})
+
+		assert.Equal(t, errTestTx, err)
+		assert.Equal(t, 0, r.commits)
+		assert.Equal(t, 1, r.rollbacks)
+	})
+
+	t.Run("failed commit isn't rolled back", func(t *testing.T) {
+		r := &txRecorder{commitErr: errTestTx}
+		db := sql.OpenDB(r)
+
+		err := WithTransaction(ctx, db, func(*sql.Tx) error {
+			return nil
+		})
+
+		assert.ErrorIs(t, err, errTestTx)
+		assert.Contains(t, err.Error(), "error committing transaction")
+		assert.Equal(t, 1, r.commits)
+		assert.Equal(t, 0, r.rollbacks)
+	})
+
+	t.Run("rollback on panic", func(t *testing.T) {
+		r := &txRecorder{}
+		db := sql.OpenDB(r)
+
+		assert.PanicsWithValue(t, "boom", func() {
+			_ = WithTransaction(ctx, db, func(*sql.Tx) error {
+				panic("boom")
+			})
+		})
+
+		assert.Equal(t, 0, r.commits)
+		assert.Equal(t, 1, r.rollbacks)
+	})
+}
@@ -0,0 +1,73 @@
+package dbtools
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// membershipPathsByUserQuery follows the same recursion as membershipsByUserQuery, but instead of collapsing the paths of membership
+// with a GROUP BY it keeps every one of them. Each row carries the groups walked from the user's direct membership up to `group_id`
+// in `path`, and the `group_hierarchies` edges that were followed in `hierarchy_ids`, so that indirect membership can be explained.
+// The groups are limited to the ones visible to the organization in `$2`, NULL doesn't limit them.
+const membershipPathsByUserQuery = `WITH RECURSIVE membership_paths AS (
+		SELECT
+			group_memberships.group_id,
+			ARRAY[group_memberships.group_id] AS path,
+			ARRAY[]::UUID[] AS hierarchy_ids,
+			TRUE AS direct
+		FROM
+			group_memberships
+			INNER JOIN groups ON groups.id = group_memberships.group_id AND groups.deleted_at IS NULL
+				AND ($2::UUID IS NULL OR groups.tenant_id IS NULL OR groups.tenant_id = $2::UUID)
+		WHERE
+			group_memberships.user_id = $1
+		UNION ALL
+		SELECT
+			b.parent_group_id,
+			a.path || b.parent_group_id,
+			a.hierarchy_ids || b.id,
+			FALSE AS direct
+		FROM
+			membership_paths AS a
+			INNER JOIN group_hierarchies AS b ON a.group_id = b.member_group_id
+			INNER JOIN groups as parentgroup ON parentgroup.id = b.parent_group_id AND parentgroup.deleted_at IS NULL
+				AND ($2::UUID IS NULL OR parentgroup.tenant_id IS NULL OR parentgroup.tenant_id = $2::UUID)
+	)
+	SELECT
+		group_id,
+		direct,
+		path,
+		hierarchy_ids
+	FROM
+		membership_paths
+	ORDER BY
+		group_id,
+		ARRAY_LENGTH(path, 1);`
+
+// MembershipPath is a single path through which a user is a member of a group. Path lists the groups from the
+// user's direct membership to the group and HierarchyIDs lists the group hierarchies that link them.
+type MembershipPath struct {
+	GroupID      string            `boil:"group_id"`
+	Direct       bool              `boil:"direct"`
+	Path         types.StringArray `boil:"path"`
+	HierarchyIDs types.StringArray `boil:"hierarchy_ids"`
+}
+
+// GetMembershipPathsForUser returns every path through which a user is a direct or indirect member of a group, through the groups
+// visible to the organization of the context
+func GetMembershipPathsForUser(ctx context.Context, db boil.ContextExecutor, userID string) ([]MembershipPath, error) {
+	paths := []MembershipPath{}
+
+	if err := queries.Raw(membershipPathsByUserQuery, userID, tenantParam(ctx)).Bind(ctx, db, &paths); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return paths, nil
+}
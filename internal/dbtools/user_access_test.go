@@ -0,0 +1,95 @@
+package dbtools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+)
+
+func TestGetMembershipPathsForUser(t *testing.T) {
+	expect := []MembershipPath{
+		{
+			GroupID:      "00000002-0000-0000-0000-000000000001",
+			Direct:       true,
+			Path:         types.StringArray{"00000002-0000-0000-0000-000000000001"},
+			HierarchyIDs: types.StringArray{},
+		},
+		{
+			GroupID: "00000002-0000-0000-0000-000000000001",
+			Direct:  false,
+			Path: types.StringArray{
+				"00000002-0000-0000-0000-000000000003",
+				"00000002-0000-0000-0000-000000000002",
+				"00000002-0000-0000-0000-000000000001",
+			},
+			HierarchyIDs: types.StringArray{
+				"00000004-0000-0000-0000-000000000002",
+				"00000004-0000-0000-0000-000000000001",
+			},
+		},
+		{
+			GroupID: "00000002-0000-0000-0000-000000000002",
+			Direct:  false,
+			Path: types.StringArray{
+				"00000002-0000-0000-0000-000000000003",
+				"00000002-0000-0000-0000-000000000002",
+			},
+			HierarchyIDs: types.StringArray{"00000004-0000-0000-0000-000000000002"},
+		},
+		{
+			GroupID:      "00000002-0000-0000-0000-000000000003",
+			Direct:       true,
+			Path:         types.StringArray{"00000002-0000-0000-0000-000000000003"},
+			HierarchyIDs: types.StringArray{},
+		},
+	}
+
+	paths, err := GetMembershipPathsForUser(context.TODO(), db, "00000001-0000-0000-0000-000000000004")
+	require.NoError(t, err)
+	assert.Equal(t, expect, paths)
+}
+
+func TestGetMembershipPathsForUserTenants(t *testing.T) {
+	ctx := context.TODO()
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+
+	defer func() { _ = tx.Rollback() }()
+
+	// the user is a direct member of group-1 and group-3, and an indirect
+	// member of group-1 through group-2 which belongs to the other tenant
+	seedTenants(t, tx, map[string]map[string][]string{
+		testTenantA: {"groups": {"00000002-0000-0000-0000-000000000001", "00000002-0000-0000-0000-000000000003"}},
+		testTenantB: {"groups": {"00000002-0000-0000-0000-000000000002"}},
+	})
+
+	groups := func(paths []MembershipPath) []types.StringArray {
+		g := []types.StringArray{}
+		for _, p := range paths {
+			g = append(g, p.Path)
+		}
+
+		return g
+	}
+
+	paths, err := GetMembershipPathsForUser(tenancy.WithTenant(ctx, testTenantA), tx, "00000001-0000-0000-0000-000000000004")
+	require.NoError(t, err)
+	assert.Equal(t, []types.StringArray{
+		{"00000002-0000-0000-0000-000000000001"},
+		{"00000002-0000-0000-0000-000000000003"},
+	}, groups(paths))
+
+	paths, err = GetMembershipPathsForUser(tenancy.WithTenant(ctx, testTenantB), tx, "00000001-0000-0000-0000-000000000004")
+	require.NoError(t, err)
+	assert.Empty(t, paths)
+
+	paths, err = GetMembershipPathsForUser(ctx, tx, "00000001-0000-0000-0000-000000000004")
+	require.NoError(t, err)
+	assert.Len(t, paths, 4)
+}
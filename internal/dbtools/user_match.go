@@ -0,0 +1,127 @@
+package dbtools
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const (
+	// UserMatchExternalID matches users on their IdP external id
+	UserMatchExternalID = "external_id"
+	// UserMatchEmail matches users on their email address
+	UserMatchEmail = "email"
+	// UserMatchGithubID matches users on their github id
+	UserMatchGithubID = "github_id"
+)
+
+// ErrUnknownUserMatch is returned when a user matching strategy has an unknown identifier
+var ErrUnknownUserMatch = errors.New("unknown user match identifier")
+
+// UserIdentity holds the identifiers of an inbound user record, the empty ones are ignored
+type UserIdentity struct {
+	ExternalID string
+	Email      string
+	GithubID   int64
+}
+
+// UserMatcher matches inbound user records to the existing users. The
+// identifiers are tried in order and the first one matching a user wins, so
+// the most stable identifier of the IdP should come first.
+type UserMatcher struct {
+	order              []string
+	caseSensitiveEmail bool
+}
+
+// DefaultUserMatcher matches users on their external id, then on their email ignoring case
+func DefaultUserMatcher() *UserMatcher {
+	return &UserMatcher{order: []string{UserMatchExternalID, UserMatchEmail}}
+}
+
+// NewUserMatcher returns a matcher trying the identifiers in order, emails are
+// compared ignoring case unless caseSensitiveEmail is set
+func NewUserMatcher(order []string, caseSensitiveEmail bool) (*UserMatcher, error) {
+	if len(order) == 0 {
+		return nil, fmt.Errorf("%w: at least one identifier is required", ErrUnknownUserMatch)
+	}
+
+	seen := map[string]bool{}
+
+	for _, o := range order {
+		switch o {
+		case UserMatchExternalID, UserMatchEmail, UserMatchGithubID:
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnknownUserMatch, o)
+		}
+
+		if seen[o] {
+			return nil, fmt.Errorf("%w: %s is listed more than once", ErrUnknownUserMatch, o)
+		}
+
+		seen[o] = true
+	}
+
+	return &UserMatcher{order: order, caseSensitiveEmail: caseSensitiveEmail}, nil
+}
+
+// Order returns the identifiers in the order they are tried
+func (m *UserMatcher) Order() []string {
+	return append([]string{}, m.order...)
+}
+
+// Match returns the first user matching the identity and the identifier it
+// matched on, the user is nil when none matches. The mods narrow down the
+// users considered, deleted users are never matched.
+func (m *UserMatcher) Match(ctx context.Context, exec boil.ContextExecutor, id UserIdentity, mods ...qm.QueryMod) (*models.User, string, error) {
+	for _, o := range m.order {
+		where := m.where(o, id)
+		if where == nil {
+			continue
+		}
+
+		user, err := models.Users(append([]qm.QueryMod{where, qm.OrderBy("created_at, id")}, mods...)...).One(ctx, exec)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+
+			return nil, "", fmt.Errorf("error matching user on %s: %w", o, err)
+		}
+
+		return user, o, nil
+	}
+
+	return nil, "", nil
+}
+
+// where returns the query mod matching the identifier, it is nil when the identity doesn't have it
+func (m *UserMatcher) where(o string, id UserIdentity) qm.QueryMod {
+	switch o {
+	case UserMatchExternalID:
+		if id.ExternalID != "" {
+			return qm.Where("external_id = ?", id.ExternalID)
+		}
+	case UserMatchEmail:
+		if id.Email == "" {
+			return nil
+		}
+
+		if m.caseSensitiveEmail {
+			return qm.Where("email = ?", id.Email)
+		}
+
+		return qm.Where("LOWER(email) = LOWER(?)", id.Email)
+	case UserMatchGithubID:
+		if id.GithubID != 0 {
+			return qm.Where("github_id = ?", id.GithubID)
+		}
+	}
+
+	return nil
+}
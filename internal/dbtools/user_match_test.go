@@ -0,0 +1,35 @@
+package dbtools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserMatcher(t *testing.T) {
+	m, err := NewUserMatcher([]string{UserMatchGithubID, UserMatchEmail}, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{UserMatchGithubID, UserMatchEmail}, m.Order())
+
+	for _, order := range [][]string{
+		nil,
+		{"username"},
+		{UserMatchEmail, UserMatchEmail},
+	} {
+		_, err := NewUserMatcher(order, false)
+		assert.ErrorIs(t, err, ErrUnknownUserMatch, order)
+	}
+
+	assert.Equal(t, []string{UserMatchExternalID, UserMatchEmail}, DefaultUserMatcher().Order())
+}
+
+func TestUserMatcherWhere(t *testing.T) {
+	id := UserIdentity{Email: "User@example.com"}
+
+	m := DefaultUserMatcher()
+	assert.Nil(t, m.where(UserMatchExternalID, id), "empty identifiers are skipped")
+	assert.Nil(t, m.where(UserMatchGithubID, id), "empty identifiers are skipped")
+	assert.NotNil(t, m.where(UserMatchEmail, id))
+	assert.NotNil(t, m.where(UserMatchGithubID, UserIdentity{GithubID: 42}))
+}
@@ -0,0 +1,212 @@
+package dbtools
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+const (
+	// DuplicateReasonEmail is the reason of users sharing an email address, ignoring case and any +suffix
+	DuplicateReasonEmail = "email"
+	// DuplicateReasonGithubID is the reason of users sharing a github id
+	DuplicateReasonGithubID = "github_id"
+)
+
+// duplicateUsersQuery groups the users that aren't deleted by their normalized email address and by their github id,
+// and returns each group with more than one user, oldest user first.
+const duplicateUsersQuery = `SELECT * FROM (
+		SELECT
+			'email' AS reason,
+			LOWER(REGEXP_REPLACE(email, '\+[^@]*@', '@')) AS match,
+			ARRAY_AGG(id ORDER BY created_at, id) AS user_ids
+		FROM
+			users
+		WHERE
+			deleted_at IS NULL
+		GROUP BY
+			match
+		HAVING
+			COUNT(*) > 1
+		UNION ALL
+		SELECT
+			'github_id' AS reason,
+			github_id::STRING AS match,
+			ARRAY_AGG(id ORDER BY created_at, id) AS user_ids
+		FROM
+			users
+		WHERE
+			deleted_at IS NULL AND github_id IS NOT NULL
+		GROUP BY
+			match
+		HAVING
+			COUNT(*) > 1
+	) AS duplicates
+	ORDER BY
+		reason,
+		match;`
+
+// DuplicateUsers is a set of users that probably belong to the same person
+type DuplicateUsers struct {
+	Reason  string            `boil:"reason"`
+	Match   string            `boil:"match"`
+	UserIDs types.StringArray `boil:"user_ids"`
+}
+
+// GetDuplicateUsers returns the sets of users sharing an email address or a github id
+func GetDuplicateUsers(ctx context.Context, db boil.ContextExecutor) ([]DuplicateUsers, error) {
+	dups := []DuplicateUsers{}
+
+	if err := queries.Raw(duplicateUsersQuery).Bind(ctx, db, &dups); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return dups, nil
+}
+
+// UserMergeCounts is the number of records moved from one user to another by MergeUserRecords
+type UserMergeCounts struct {
+	MembershipRequests      int64 `json:"membership_requests"`
+	ApplicationRequests     int64 `json:"application_requests"`
+	ExtensionResources      int64 `json:"extension_resources"`
+	NotificationPreferences int64 `json:"notification_preferences"`
+	RequestComments         int64 `json:"request_comments"`
+	AuditEvents             int64 `json:"audit_events"`
+}
+
+// userMergeStatements move the records of user $2 to user $1. Membership requests and notification preferences
+// the target user already has an equivalent of are dropped instead of moved. Group memberships aren't moved here
+// since each of them needs to be audited.
+var userMergeStatements = []struct {
+	name  string
+	query string
+	count func(c *UserMergeCounts) *int64
+}{
+	{
+		name: "duplicate group membership requests",
+		query: `DELETE FROM group_membership_requests AS o WHERE o.user_id = $2 AND EXISTS (
+			SELECT 1 FROM group_membership_requests AS t WHERE t.user_id = $1 AND t.group_id = o.group_id AND t.kind = o.kind
+		)`,
+	},
+	{
+		name:  "group membership requests",
+		query: `UPDATE group_membership_requests SET user_id = $1, updated_at = now() WHERE user_id = $2`,
+		count: func(c *UserMergeCounts) *int64 { return &c.MembershipRequests },
+	},
+	{
+		name:  "group application requests",
+		query: `UPDATE group_application_requests SET requester_user_id = $1, updated_at = now() WHERE requester_user_id = $2`,
+		count: func(c *UserMergeCounts) *int64 { return &c.ApplicationRequests },
+	},
+	{
+		name:  "user extension resources",
+		query: `UPDATE user_extension_resources SET user_id = $1, updated_at = now() WHERE user_id = $2`,
+		count: func(c *UserMergeCounts) *int64 { return &c.ExtensionResources },
+	},
+	{
+		name:  "user extension resource versions",
+		query: `UPDATE user_extension_resource_versions SET user_id = $1 WHERE user_id = $2`,
+	},
+	{
+		name: "duplicate notification preferences",
+		query: `DELETE FROM notification_preferences AS o WHERE o.user_id = $2 AND EXISTS (
+			SELECT 1 FROM notification_preferences AS t WHERE t.user_id = $1
+				AND t.notification_type_id = o.notification_type_id
+				AND t.notification_target_id_null_string = o.notification_target_id_null_string
+		)`,
+	},
+	{
+		name:  "notification preferences",
+		query: `UPDATE notification_preferences SET user_id = $1 WHERE user_id = $2`,
+		count: func(c *UserMergeCounts) *int64 { return &c.NotificationPreferences },
+	},
+	{
+		name: "duplicate notification group preferences",
+		query: `DELETE FROM notification_group_preferences AS o WHERE o.user_id = $2 AND EXISTS (
+			SELECT 1 FROM notification_group_preferences AS t WHERE t.user_id = $1
+				AND t.group_id = o.group_id
+				AND t.notification_type_id_null_string = o.notification_type_id_null_string
+		)`,
+	},
+	{
+		name:  "notification group preferences",
+		query: `UPDATE notification_group_preferences SET user_id = $1, updated_at = now() WHERE user_id = $2`,
+		count: func(c *UserMergeCounts) *int64 { return &c.NotificationPreferences },
+	},
+	{
+		name: "duplicate notification target rankings",
+		query: `DELETE FROM notification_target_rankings AS o WHERE o.user_id = $2 AND EXISTS (
+			SELECT 1 FROM notification_target_rankings AS t WHERE t.user_id = $1
+				AND t.notification_target_id = o.notification_target_id
+		)`,
+	},
+	{
+		name:  "notification target rankings",
+		query: `UPDATE notification_target_rankings SET user_id = $1, updated_at = now() WHERE user_id = $2`,
+		count: func(c *UserMergeCounts) *int64 { return &c.NotificationPreferences },
+	},
+	{
+		name: "duplicate notification failover policies",
+		query: `DELETE FROM notification_failover_policies AS o WHERE o.user_id = $2 AND EXISTS (
+			SELECT 1 FROM notification_failover_policies AS t WHERE t.user_id = $1
+				AND t.notification_type_id = o.notification_type_id
+		)`,
+	},
+	{
+		name:  "notification failover policies",
+		query: `UPDATE notification_failover_policies SET user_id = $1, updated_at = now() WHERE user_id = $2`,
+		count: func(c *UserMergeCounts) *int64 { return &c.NotificationPreferences },
+	},
+	{
+		name:  "notification dispatches",
+		query: `UPDATE notification_dispatches SET user_id = $1, updated_at = now() WHERE user_id = $2`,
+	},
+	{
+		name:  "request comments",
+		query: `UPDATE request_comments SET user_id = $1, updated_at = now() WHERE user_id = $2`,
+		count: func(c *UserMergeCounts) *int64 { return &c.RequestComments },
+	},
+	{
+		name:  "audit event actors",
+		query: `UPDATE audit_events SET actor_id = $1 WHERE actor_id = $2`,
+		count: func(c *UserMergeCounts) *int64 { return &c.AuditEvents },
+	},
+	{
+		name:  "audit event subjects",
+		query: `UPDATE audit_events SET subject_user_id = $1 WHERE subject_user_id = $2`,
+		count: func(c *UserMergeCounts) *int64 { return &c.AuditEvents },
+	},
+}
+
+// MergeUserRecords moves the requests, extension resources, notification preferences, request comments and
+// audit events of the source user onto the target user. It should be run in a transaction.
+func MergeUserRecords(ctx context.Context, exec boil.ContextExecutor, targetID, sourceID string) (*UserMergeCounts, error) {
+	counts := &UserMergeCounts{}
+
+	for _, s := range userMergeStatements {
+		res, err := exec.ExecContext(ctx, s.query, targetID, sourceID)
+		if err != nil {
+			return nil, fmt.Errorf("error merging %s: %w", s.name, err)
+		}
+
+		if s.count == nil {
+			continue
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return nil, fmt.Errorf("error merging %s: %w", s.name, err)
+		}
+
+		*s.count(counts) += n
+	}
+
+	return counts, nil
+}
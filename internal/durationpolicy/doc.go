@@ -0,0 +1,4 @@
+// Package durationpolicy caps how far in the future group memberships can
+// expire, with policies configured by governor admins for an organization or
+// a group.
+package durationpolicy
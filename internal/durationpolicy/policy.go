@@ -0,0 +1,94 @@
+package durationpolicy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	// ModeReject rejects memberships expiring past the maximum duration
+	ModeReject = "reject"
+	// ModeClamp moves the expiration of memberships expiring past the maximum
+	// duration back to the maximum
+	ModeClamp = "clamp"
+)
+
+var (
+	// ErrInvalidMode is returned when the policy mode is neither reject nor clamp
+	ErrInvalidMode = errors.New("invalid membership duration policy mode")
+	// ErrInvalidMaxDays is returned when the maximum duration isn't positive
+	ErrInvalidMaxDays = errors.New("membership duration policy max days must be positive")
+	// ErrDurationExceeded is returned when a membership expires past the
+	// maximum duration of a reject policy
+	ErrDurationExceeded = errors.New("membership expiration exceeds the maximum duration policy")
+)
+
+// Policy is the maximum duration of the memberships of a group
+type Policy struct {
+	// Scope describes where the policy is configured, it is used in the
+	// decision messages, for example "group" or "organization"
+	Scope string
+	// MaxDays is the number of days after which memberships must expire
+	MaxDays int64
+	// Mode is either ModeReject or ModeClamp
+	Mode string
+}
+
+// Validate checks the maximum duration and the mode of the policy
+func (p Policy) Validate() error {
+	if p.MaxDays <= 0 {
+		return ErrInvalidMaxDays
+	}
+
+	if p.Mode != ModeReject && p.Mode != ModeClamp {
+		return fmt.Errorf("%w: %q", ErrInvalidMode, p.Mode)
+	}
+
+	return nil
+}
+
+// Strictest returns the policy with the shortest maximum duration, reject
+// policies win ties. It returns nil when no policy is given.
+func Strictest(policies ...Policy) *Policy {
+	var strictest *Policy
+
+	for i := range policies {
+		p := policies[i]
+
+		switch {
+		case strictest == nil,
+			p.MaxDays < strictest.MaxDays,
+			p.MaxDays == strictest.MaxDays && p.Mode == ModeReject:
+			strictest = &p
+		}
+	}
+
+	return strictest
+}
+
+// Apply checks a membership expiration against the policy. Memberships that
+// never expire exceed any maximum duration. It returns the expiration to
+// use, clamped when needed, and a message describing the decision for the
+// audit events. ErrDurationExceeded is returned when a reject policy is
+// broken.
+func (p Policy) Apply(expiresAt null.Time, now time.Time) (null.Time, string, error) {
+	limit := now.UTC().AddDate(0, 0, int(p.MaxDays))
+
+	if expiresAt.Valid && !expiresAt.Time.After(limit) {
+		return expiresAt, fmt.Sprintf("Membership expiration is within the %s membership duration policy of %d days.", p.Scope, p.MaxDays), nil
+	}
+
+	if p.Mode == ModeClamp {
+		return null.TimeFrom(limit), fmt.Sprintf("Membership expiration was clamped to %s by the %s membership duration policy of %d days.", limit.Format(time.RFC3339), p.Scope, p.MaxDays), nil
+	}
+
+	requested := "never"
+	if expiresAt.Valid {
+		requested = expiresAt.Time.UTC().Format(time.RFC3339)
+	}
+
+	return expiresAt, "", fmt.Errorf("%w: expiration %s is past the %s maximum of %d days", ErrDurationExceeded, requested, p.Scope, p.MaxDays)
+}
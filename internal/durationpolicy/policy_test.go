@@ -0,0 +1,91 @@
+package durationpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestPolicyApply(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	limit := now.AddDate(0, 0, 30)
+
+	tests := map[string]struct {
+		policy    Policy
+		expiresAt null.Time
+		want      null.Time
+		wantErr   error
+	}{
+		"Within": {
+			Policy{Scope: "group", MaxDays: 30, Mode: ModeReject},
+			null.TimeFrom(now.AddDate(0, 0, 10)),
+			null.TimeFrom(now.AddDate(0, 0, 10)),
+			nil,
+		},
+		"AtLimit": {
+			Policy{Scope: "group", MaxDays: 30, Mode: ModeReject},
+			null.TimeFrom(limit),
+			null.TimeFrom(limit),
+			nil,
+		},
+		"RejectPastLimit": {
+			Policy{Scope: "group", MaxDays: 30, Mode: ModeReject},
+			null.TimeFrom(limit.Add(time.Hour)),
+			null.TimeFrom(limit.Add(time.Hour)),
+			ErrDurationExceeded,
+		},
+		"RejectNeverExpires": {
+			Policy{Scope: "organization", MaxDays: 30, Mode: ModeReject},
+			null.Time{},
+			null.Time{},
+			ErrDurationExceeded,
+		},
+		"ClampPastLimit": {
+			Policy{Scope: "group", MaxDays: 30, Mode: ModeClamp},
+			null.TimeFrom(limit.AddDate(1, 0, 0)),
+			null.TimeFrom(limit),
+			nil,
+		},
+		"ClampNeverExpires": {
+			Policy{Scope: "organization", MaxDays: 30, Mode: ModeClamp},
+			null.Time{},
+			null.TimeFrom(limit),
+			nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, msg, err := tt.policy.Apply(tt.expiresAt, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Contains(t, msg, tt.policy.Scope+" membership duration policy of 30 days")
+		})
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	assert.NoError(t, Policy{MaxDays: 30, Mode: ModeClamp}.Validate())
+	assert.ErrorIs(t, Policy{MaxDays: 0, Mode: ModeClamp}.Validate(), ErrInvalidMaxDays)
+	assert.ErrorIs(t, Policy{MaxDays: 30, Mode: "truncate"}.Validate(), ErrInvalidMode)
+}
+
+func TestStrictest(t *testing.T) {
+	assert.Nil(t, Strictest())
+
+	got := Strictest(
+		Policy{Scope: "organization", MaxDays: 90, Mode: ModeReject},
+		Policy{Scope: "organization", MaxDays: 30, Mode: ModeClamp},
+		Policy{Scope: "organization", MaxDays: 30, Mode: ModeReject},
+	)
+	require.NotNil(t, got)
+	assert.Equal(t, Policy{Scope: "organization", MaxDays: 30, Mode: ModeReject}, *got)
+}
@@ -0,0 +1,5 @@
+// Package emailverify signs and verifies the tokens confirming user email
+// changes. A token carries the pending change, the user and the new email, and
+// is signed with a server secret so that it can't be forged or reused for
+// another address.
+package emailverify
@@ -0,0 +1,123 @@
+package emailverify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultTTL is how long the tokens are valid unless configured otherwise
+const DefaultTTL = 24 * time.Hour
+
+var (
+	// ErrMissingSecret is returned when a signer is created without a secret
+	ErrMissingSecret = errors.New("missing email verification secret")
+	// ErrInvalidToken is returned when a token is malformed or its signature doesn't match
+	ErrInvalidToken = errors.New("invalid email verification token")
+	// ErrExpiredToken is returned when a token is past its expiry
+	ErrExpiredToken = errors.New("expired email verification token")
+)
+
+// Claims are the pending email change a token confirms
+type Claims struct {
+	ChangeID  string    `json:"cid"`
+	UserID    string    `json:"uid"`
+	Email     string    `json:"email"`
+	ExpiresAt time.Time `json:"exp"`
+}
+
+// Signer signs and verifies the email verification tokens
+type Signer struct {
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// Option is a functional configuration option for the signer
+type Option func(s *Signer)
+
+// New returns a signer using the secret to sign the tokens
+func New(secret []byte, opts ...Option) (*Signer, error) {
+	if len(secret) == 0 {
+		return nil, ErrMissingSecret
+	}
+
+	s := Signer{
+		secret: secret,
+		ttl:    DefaultTTL,
+		now:    time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	return &s, nil
+}
+
+// WithTTL sets how long the tokens are valid
+func WithTTL(ttl time.Duration) Option {
+	return func(s *Signer) {
+		if ttl > 0 {
+			s.ttl = ttl
+		}
+	}
+}
+
+// ExpiresAt returns when a token signed now expires
+func (s *Signer) ExpiresAt() time.Time {
+	return s.now().Add(s.ttl).UTC().Truncate(time.Second)
+}
+
+// Sign returns a token for the claims
+func (s *Signer) Sign(c Claims) (string, error) {
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("error encoding email verification claims: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.mac(encoded)), nil
+}
+
+// Verify checks the signature and the expiry of a token and returns its claims
+func (s *Signer) Verify(token string) (*Claims, error) {
+	encoded, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, s.mac(encoded)) {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	c := Claims{}
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if !s.now().Before(c.ExpiresAt) {
+		return nil, ErrExpiredToken
+	}
+
+	return &c, nil
+}
+
+func (s *Signer) mac(payload string) []byte {
+	h := hmac.New(sha256.New, s.secret)
+	h.Write([]byte(payload))
+
+	return h.Sum(nil)
+}
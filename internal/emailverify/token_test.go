@@ -0,0 +1,63 @@
+package emailverify
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	_, err := New(nil)
+	assert.ErrorIs(t, err, ErrMissingSecret)
+}
+
+func TestSignVerify(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	s, err := New([]byte("topSekret"), WithTTL(time.Hour))
+	require.NoError(t, err)
+
+	s.now = func() time.Time { return now }
+
+	claims := Claims{
+		ChangeID:  "change-id",
+		UserID:    "user-id",
+		Email:     "new@example.com",
+		ExpiresAt: s.ExpiresAt(),
+	}
+
+	assert.Equal(t, now.Add(time.Hour), claims.ExpiresAt)
+
+	token, err := s.Sign(claims)
+	require.NoError(t, err)
+
+	got, err := s.Verify(token)
+	require.NoError(t, err)
+	assert.Equal(t, &claims, got)
+
+	other, err := New([]byte("otherSekret"))
+	require.NoError(t, err)
+
+	_, err = other.Verify(token)
+	assert.ErrorIs(t, err, ErrInvalidToken, "tokens signed with another secret are rejected")
+
+	forged, err := other.Sign(Claims{ChangeID: "change-id", UserID: "user-id", Email: "attacker@example.com", ExpiresAt: claims.ExpiresAt})
+	require.NoError(t, err)
+
+	payload, _, _ := strings.Cut(forged, ".")
+	_, sig, _ := strings.Cut(token, ".")
+
+	_, err = s.Verify(payload + "." + sig)
+	assert.ErrorIs(t, err, ErrInvalidToken, "the signature doesn't match another payload")
+
+	_, err = s.Verify("nope")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	s.now = func() time.Time { return now.Add(time.Hour) }
+
+	_, err = s.Verify(token)
+	assert.ErrorIs(t, err, ErrExpiredToken)
+}
@@ -0,0 +1,5 @@
+// Package erdstate provides the lifecycle states of the extension resource
+// definitions. A definition starts as a draft, is activated to accept
+// resources, can be deprecated to only allow reading and deleting its
+// resources, and disabled to block them altogether.
+package erdstate
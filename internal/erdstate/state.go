@@ -0,0 +1,118 @@
+package erdstate
+
+import (
+	"errors"
+	"fmt"
+)
+
+// State is the lifecycle state of an extension resource definition
+type State string
+
+const (
+	// Draft definitions don't accept resources yet, their schema can still be reviewed
+	Draft State = "draft"
+	// Active definitions accept every operation on their resources
+	Active State = "active"
+	// Deprecated definitions only allow reading and deleting their resources
+	Deprecated State = "deprecated"
+	// Disabled definitions block every operation on their resources
+	Disabled State = "disabled"
+)
+
+// Operation is an operation on the resources of a definition
+type Operation string
+
+const (
+	// Read lists or gets resources
+	Read Operation = "read"
+	// Create creates resources
+	Create Operation = "create"
+	// Update updates resources
+	Update Operation = "update"
+	// Delete deletes resources
+	Delete Operation = "delete"
+)
+
+var (
+	// ErrInvalidState is returned when a state is unknown
+	ErrInvalidState = errors.New("invalid ERD state")
+	// ErrInvalidTransition is returned when a definition can't move from its state to another
+	ErrInvalidTransition = errors.New("invalid ERD state transition")
+	// ErrOperationNotAllowed is returned when the state of a definition doesn't allow an operation on its resources
+	ErrOperationNotAllowed = errors.New("operation not allowed in the ERD state")
+)
+
+// transitions are the states each state can move to
+var transitions = map[State][]State{
+	Draft:      {Active, Disabled},
+	Active:     {Deprecated, Disabled},
+	Deprecated: {Active, Disabled},
+	Disabled:   {Active, Deprecated},
+}
+
+// operations are the operations allowed in each state
+var operations = map[State][]Operation{
+	Draft:      {Read},
+	Active:     {Read, Create, Update, Delete},
+	Deprecated: {Read, Delete},
+	Disabled:   {},
+}
+
+// Parse returns the state named s
+func Parse(s string) (State, error) {
+	state := State(s)
+	if _, ok := transitions[state]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrInvalidState, s)
+	}
+
+	return state, nil
+}
+
+// Initial returns whether a definition can be created in the state, only
+// existing definitions can be deprecated
+func (s State) Initial() bool {
+	return s == Draft || s == Active || s == Disabled
+}
+
+// Enabled returns whether the state accepts resources, it backs the
+// deprecated enabled flag of the definitions
+func (s State) Enabled() bool {
+	return s == Active || s == Deprecated
+}
+
+// FromEnabled returns the state set by the deprecated enabled flag
+func FromEnabled(enabled bool) State {
+	if enabled {
+		return Active
+	}
+
+	return Disabled
+}
+
+// Transition returns an error when a definition can't move from one state to
+// another, moving to the same state is allowed
+func Transition(from, to State) error {
+	if from == to {
+		return nil
+	}
+
+	for _, s := range transitions[from] {
+		if s == to {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: from %s to %s", ErrInvalidTransition, from, to)
+}
+
+// Allows returns an error when the state doesn't allow an operation on the
+// resources of the definition
+func (s State) Allows(op Operation) error {
+	for _, o := range operations[s] {
+		if o == op {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: cannot %s resources of a %s ERD", ErrOperationNotAllowed, op, s)
+}
@@ -0,0 +1,71 @@
+package erdstate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	s, err := Parse("deprecated")
+	assert.NoError(t, err)
+	assert.Equal(t, Deprecated, s)
+
+	_, err = Parse("archived")
+	assert.ErrorIs(t, err, ErrInvalidState)
+}
+
+func TestTransition(t *testing.T) {
+	tests := []struct {
+		from State
+		to   State
+		ok   bool
+	}{
+		{Draft, Active, true},
+		{Draft, Disabled, true},
+		{Draft, Deprecated, false},
+		{Active, Deprecated, true},
+		{Active, Draft, false},
+		{Deprecated, Active, true},
+		{Disabled, Active, true},
+		{Disabled, Draft, false},
+		{Active, Active, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"-"+string(tt.to), func(t *testing.T) {
+			err := Transition(tt.from, tt.to)
+			if tt.ok {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, ErrInvalidTransition)
+		})
+	}
+}
+
+func TestAllows(t *testing.T) {
+	assert.NoError(t, Draft.Allows(Read))
+	assert.ErrorIs(t, Draft.Allows(Create), ErrOperationNotAllowed)
+
+	for _, op := range []Operation{Read, Create, Update, Delete} {
+		assert.NoError(t, Active.Allows(op))
+		assert.ErrorIs(t, Disabled.Allows(op), ErrOperationNotAllowed)
+	}
+
+	assert.NoError(t, Deprecated.Allows(Read))
+	assert.NoError(t, Deprecated.Allows(Delete))
+	assert.ErrorIs(t, Deprecated.Allows(Create), ErrOperationNotAllowed)
+	assert.ErrorIs(t, Deprecated.Allows(Update), ErrOperationNotAllowed)
+}
+
+func TestEnabled(t *testing.T) {
+	assert.True(t, Active.Enabled())
+	assert.True(t, Deprecated.Enabled())
+	assert.False(t, Draft.Enabled())
+	assert.False(t, Disabled.Enabled())
+
+	assert.Equal(t, Active, FromEnabled(true))
+	assert.Equal(t, Disabled, FromEnabled(false))
+}
@@ -3,6 +3,9 @@ package eventbus
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -11,7 +14,9 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
+	pkgeventbus "github.com/metal-toolbox/governor-api/pkg/eventbus"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+	eventsv2 "github.com/metal-toolbox/governor-api/pkg/events/v2"
 	"github.com/nats-io/nats.go"
 )
 
@@ -26,12 +31,27 @@ type conn interface {
 	Drain() error
 }
 
+type subscriber interface {
+	Subscribe(subject string, cb nats.MsgHandler) (*nats.Subscription, error)
+}
+
 // Client is an event bus client with some configuration
 type Client struct {
-	conn   conn
-	logger *zap.Logger
-	prefix string
-	tracer trace.Tracer
+	breaker         *breaker
+	conn            conn
+	hooks           []func(sub string)
+	logger          *zap.Logger
+	outbox          Outbox
+	pgNotify        *PGNotifier
+	prefix          string
+	retryAttempts   int
+	retryBackoff    time.Duration
+	retryMaxBackoff time.Duration
+	rules           SubjectRules
+	shadow          *shadow
+	tracer          trace.Tracer
+	userFields      UserFieldSubscriptions
+	v2              bool
 }
 
 // Option is a functional configuration option for governor eventing
@@ -40,15 +60,38 @@ type Option func(c *Client)
 // NewClient configures and establishes a new event bus client connection
 func NewClient(opts ...Option) *Client {
 	client := Client{
-		logger: zap.NewNop(),
-		prefix: defaultSubject,
-		tracer: otel.GetTracerProvider().Tracer(natsTracerName),
+		logger:          zap.NewNop(),
+		prefix:          defaultSubject,
+		retryAttempts:   defaultRetryAttempts,
+		retryBackoff:    defaultRetryBackoff,
+		retryMaxBackoff: defaultRetryMaxBackoff,
+		tracer:          otel.GetTracerProvider().Tracer(natsTracerName),
+		v2:              true,
 	}
 
 	for _, opt := range opts {
 		opt(&client)
 	}
 
+	// the shadow copies are only published on nats, the postgres
+	// notifications are for production consumers
+	if client.shadow != nil {
+		client.shadow.conn = client.conn
+
+		if client.shadow.prefix == client.prefix {
+			client.logger.Warn("not publishing shadow events under the events subject prefix", zap.String("prefix", client.prefix))
+			client.shadow = nil
+		}
+	}
+
+	if client.pgNotify != nil {
+		if client.conn == nil {
+			client.conn = client.pgNotify
+		} else {
+			client.conn = &teeConn{conn: client.conn, notify: client.pgNotify, logger: client.logger}
+		}
+	}
+
 	return &client
 }
 
@@ -73,18 +116,51 @@ func WithLogger(l *zap.Logger) Option {
 	}
 }
 
+// WithV2Events sets whether the v2 events are published alongside the
+// v1alpha1 events, they are by default
+func WithV2Events(enabled bool) Option {
+	return func(c *Client) {
+		c.v2 = enabled
+	}
+}
+
+// WithPublishHook registers a function that is called with the (unprefixed)
+// subject after every successfully published event
+func WithPublishHook(h func(sub string)) Option {
+	return func(c *Client) {
+		c.hooks = append(c.hooks, h)
+	}
+}
+
 // Shutdown drains the event bus and closes the connections
 func (c *Client) Shutdown() error {
 	return c.conn.Drain()
 }
 
-// Publish an event on the event bus
+// Publish an event on the event bus. ErrQueued is returned when the event
+// was stored in the outbox, the event is considered published then.
 func (c *Client) Publish(ctx context.Context, sub string, event *events.Event) error {
 	if event == nil {
 		return ErrEmptyEvent
 	}
 
-	subject := c.prefix + "." + sub
+	routed, ok := c.route(ctx, sub, event)
+	if !ok {
+		c.logger.Debug("not publishing muted event", zap.String("subject", sub), zap.Any("action", event.Action))
+
+		// muted events still change governor's state
+		for _, h := range c.hooks {
+			h(sub)
+		}
+
+		// the field change events have their own subjects, consumers
+		// subscribing to them may have muted the users events
+		c.publishUserFieldChanges(ctx, sub, event)
+
+		return nil
+	}
+
+	subject := c.prefix + "." + routed
 
 	c.logger.Info("publishing event to the event bus", zap.String("subject", subject), zap.Any("action", event.Action))
 
@@ -113,7 +189,8 @@ func (c *Client) Publish(ctx context.Context, sub string, event *events.Event) e
 
 	headers := nats.Header{}
 
-	if cid := events.ExtractCorrelationID(ctx); cid != "" {
+	cid := events.ExtractCorrelationID(ctx)
+	if cid != "" {
 		c.logger.Debug("publishing event with correlation ID", zap.String("correlationID", cid))
 		span.SetAttributes(attribute.String("event.correlation_id", cid))
 		headers.Add(events.GovernorEventCorrelationIDHeader, cid)
@@ -125,5 +202,86 @@ func (c *Client) Publish(ctx context.Context, sub string, event *events.Event) e
 		Header:  headers,
 	}
 
-	return c.conn.PublishMsg(msg)
+	queued := c.deliver(ctx, msg)
+	if queued != nil && !errors.Is(queued, pkgeventbus.ErrQueued) {
+		span.RecordError(queued)
+		span.SetStatus(codes.Error, queued.Error())
+
+		return queued
+	}
+
+	for _, h := range c.hooks {
+		h(sub)
+	}
+
+	shadowed := c.shadow.sampled()
+	if shadowed {
+		c.publishShadow(routed, msg)
+	}
+
+	if c.v2 {
+		if v2 := c.publishV2(ctx, routed, event, cid, headers); v2 != nil && shadowed {
+			c.publishShadow(eventsv2.SubjectToken+"."+routed, v2)
+		}
+	}
+
+	c.publishUserFieldChanges(ctx, sub, event)
+
+	return queued
+}
+
+// publishV2 publishes the v2 event of a v1alpha1 event under the v2 subject
+// token, and returns the published message. The v1alpha1 event was already
+// published so errors are only logged.
+func (c *Client) publishV2(ctx context.Context, sub string, event *events.Event, cid string, headers nats.Header) *nats.Msg {
+	env := toV2(sub, event, cid, time.Now().UTC())
+	if env == nil {
+		return nil
+	}
+
+	subject := c.prefix + "." + eventsv2.SubjectToken + "." + sub
+
+	payload, err := json.Marshal(env)
+	if err != nil {
+		c.logger.Warn("failed to encode v2 event", zap.String("subject", subject), zap.Error(err))
+		return nil
+	}
+
+	msg := &nats.Msg{
+		Subject: subject,
+		Data:    payload,
+		Header:  headers,
+	}
+
+	if err := c.deliver(ctx, msg); err != nil && !errors.Is(err, pkgeventbus.ErrQueued) {
+		c.logger.Warn("failed to publish v2 event", zap.String("subject", subject), zap.Error(err))
+		return nil
+	}
+
+	return msg
+}
+
+// Subscribe registers a handler for messages published on the given subject
+// under the configured prefix.  The returned function removes the subscription.
+func (c *Client) Subscribe(sub string, handler func(*nats.Msg)) (func() error, error) {
+	s, ok := c.conn.(subscriber)
+	if !ok {
+		return nil, ErrSubscribeNotSupported
+	}
+
+	subject := c.prefix + "." + sub
+
+	c.logger.Debug("subscribing to event bus subject", zap.String("subject", subject))
+
+	subscription, err := s.Subscribe(subject, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription.Unsubscribe, nil
+}
+
+// TrimPrefix returns the subject of a received message without the subject prefix
+func (c *Client) TrimPrefix(subject string) string {
+	return strings.TrimPrefix(subject, c.prefix+".")
 }
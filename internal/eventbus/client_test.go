@@ -179,3 +179,34 @@ func TestClient_Publish(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_SubscribeNotSupported(t *testing.T) {
+	c := &Client{
+		logger: zap.NewNop(),
+		conn:   &mockConn{t: t},
+		prefix: "test",
+		tracer: otel.GetTracerProvider().Tracer("test"),
+	}
+
+	_, err := c.Subscribe("test", func(_ *nats.Msg) {})
+	assert.ErrorIs(t, err, ErrSubscribeNotSupported)
+}
+
+func TestClient_PublishHook(t *testing.T) {
+	var got []string
+
+	c := NewClient(
+		WithNATSConn(&mockConn{t: t, data: []byte(`{"version":"v1alpha1","action":"CREATE","traceContext":{}}`)}),
+		WithPublishHook(func(sub string) { got = append(got, sub) }),
+	)
+
+	err := c.Publish(context.TODO(), "groups", &events.Event{Version: events.Version, Action: events.GovernorEventCreate})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"groups"}, got)
+
+	c.conn = &mockConn{t: t, err: errors.New("boom")} //nolint:goerr113
+
+	err = c.Publish(context.TODO(), "users", &events.Event{Version: events.Version, Action: events.GovernorEventCreate})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"groups"}, got)
+}
@@ -1,3 +1,4 @@
 // Package eventbus provides access to an eventbus where governor events can
-// be published.  Currently, the only concrete implementation is a NATS client.
+// be published.  Currently, the only concrete implementation is a NATS client,
+// which implements the EventBus interface of pkg/eventbus.
 package eventbus
@@ -4,3 +4,12 @@ import "errors"
 
 // ErrEmptyEvent is returned when an empty event is passed
 var ErrEmptyEvent = errors.New("event is empty")
+
+// ErrSubscribeNotSupported is returned when the underlying connection cannot subscribe
+var ErrSubscribeNotSupported = errors.New("event bus connection does not support subscriptions")
+
+// ErrCircuitOpen is returned when publishing while the circuit breaker is open
+var ErrCircuitOpen = errors.New("event bus circuit breaker is open")
+
+// ErrUnknownUserField is returned when a user field subscription registers interest in an unknown user field
+var ErrUnknownUserField = errors.New("unknown user field")
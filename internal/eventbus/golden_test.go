@@ -0,0 +1,148 @@
+package eventbus_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+	"github.com/metal-toolbox/governor-api/pkg/eventtest"
+)
+
+// TestPublishGolden compares the v1alpha1 and v2 payloads of the events with
+// the fixtures in testdata, run with GOVERNOR_UPDATE_GOLDEN=1 to refresh them
+// after an intended payload change
+func TestPublishGolden(t *testing.T) {
+	expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		subject string
+		event   *events.Event
+	}{
+		{
+			name:    "group_create",
+			subject: events.GovernorGroupsEventSubject,
+			event: &events.Event{
+				Version: events.Version,
+				Action:  events.GovernorEventCreate,
+				AuditID: "audit-id",
+				GroupID: "group-id",
+				ActorID: "actor-id",
+				After: &models.Group{
+					ID:            "group-id",
+					Name:          "Group",
+					Slug:          "group",
+					Description:   "a group",
+					ApproverGroup: null.StringFrom("approvers-id"),
+				},
+			},
+		},
+		{
+			name:    "member_create",
+			subject: events.GovernorMembersEventSubject,
+			event: &events.Event{
+				Version: events.Version,
+				Action:  events.GovernorEventCreate,
+				AuditID: "audit-id",
+				GroupID: "group-id",
+				UserID:  "user-id",
+				ActorID: "actor-id",
+				Member: &events.Member{
+					IsAdmin:   false,
+					ExpiresAt: &expires,
+					Direct:    true,
+					Source:    events.MembershipSourceRequest,
+					SourceRef: "request-id",
+				},
+			},
+		},
+		{
+			name:    "member_delete",
+			subject: events.GovernorMembersEventSubject,
+			event: &events.Event{
+				Version: events.Version,
+				Action:  events.GovernorEventDelete,
+				GroupID: "group-id",
+				UserID:  "user-id",
+				ActorID: "actor-id",
+				Member:  &events.Member{IsAdmin: true, Direct: true, Source: events.MembershipSourceDirect},
+			},
+		},
+		{
+			name:    "member_limit_warn",
+			subject: events.GovernorGroupMemberLimitsEventSubject,
+			event: &events.Event{
+				Version: events.Version,
+				Action:  events.GovernorEventWarn,
+				GroupID: "group-id",
+				ActorID: "actor-id",
+				MemberLimit: &events.MemberLimit{
+					Members:   11,
+					SoftLimit: 10,
+					HardLimit: 20,
+					AdminIDs:  []string{"admin-id"},
+				},
+			},
+		},
+		{
+			name:    "hierarchy_update",
+			subject: events.GovernorHierarchiesEventSubject,
+			event: &events.Event{
+				Version: events.HierarchyVersion,
+				Action:  events.GovernorEventUpdate,
+				AuditID: "audit-id",
+				GroupID: "parent-id",
+				ActorID: "actor-id",
+				Hierarchy: &events.Hierarchy{
+					ParentGroupID: "parent-id",
+					MemberGroupID: "member-id",
+					ExpiresAt:     &expires,
+				},
+				Before: &models.GroupHierarchy{
+					ID:            "hierarchy-id",
+					ParentGroupID: "parent-id",
+					MemberGroupID: "member-id",
+				},
+				After: &models.GroupHierarchy{
+					ID:            "hierarchy-id",
+					ParentGroupID: "parent-id",
+					MemberGroupID: "member-id",
+					ExpiresAt:     null.TimeFrom(expires),
+				},
+			},
+		},
+		{
+			name:    "extension_resource_create",
+			subject: "test-extension.widgets",
+			event: &events.Event{
+				Version:                       "v1",
+				Action:                        events.GovernorEventCreate,
+				ExtensionID:                   "extension-id",
+				ExtensionResourceDefinitionID: "erd-id",
+				ExtensionResourceID:           "resource-id",
+				After: &models.SystemExtensionResource{
+					ID:                            "resource-id",
+					Resource:                      []byte(`{"name":"widget","size":3}`),
+					ExtensionResourceDefinitionID: "erd-id",
+				},
+			},
+		},
+	}
+
+	golden := eventtest.NewGolden("testdata")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := eventtest.NewRecorder()
+
+			require.NoError(t, rec.EventBus().Publish(context.TODO(), tt.subject, tt.event))
+
+			golden.Assert(t, tt.name, rec.Snapshot().Events)
+		})
+	}
+}
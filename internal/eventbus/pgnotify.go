@@ -0,0 +1,145 @@
+package eventbus
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+const (
+	// pgMaxChannelLength is the longest channel name postgres accepts
+	pgMaxChannelLength = 63
+	// pgMaxPayloadLength is the longest notification payload postgres accepts
+	pgMaxPayloadLength = 7999
+	// pgNotifyTimeout bounds a notification, publishing has no context
+	pgNotifyTimeout = 5 * time.Second
+)
+
+// pgExecer executes the notifications, a *sqlx.DB or *sql.DB
+type pgExecer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// PGNotifier publishes messages as postgres notifications, on a channel per
+// subject, so small deployments can LISTEN to governor's events without
+// running NATS. It doesn't support subscriptions. CockroachDB doesn't support
+// notifications, it needs a postgres database.
+type PGNotifier struct {
+	db pgExecer
+}
+
+// NewPGNotifier returns a notifier sending the notifications with db
+func NewPGNotifier(db pgExecer) *PGNotifier {
+	return &PGNotifier{db: db}
+}
+
+// PGNotifyChannel returns the notification channel of a subject, which is the
+// subject itself when it fits in a postgres channel name. Longer subjects are
+// cut, and end with a hash of the whole subject to keep them apart.
+func PGNotifyChannel(subject string) string {
+	if len(subject) <= pgMaxChannelLength {
+		return subject
+	}
+
+	sum := sha256.Sum256([]byte(subject))
+	suffix := "." + hex.EncodeToString(sum[:])[:8]
+
+	return subject[:pgMaxChannelLength-len(suffix)] + suffix
+}
+
+// pgOversizedPayload replaces the payloads too large for a notification,
+// listeners get the full event from the api or the event bus
+type pgOversizedPayload struct {
+	Subject   string `json:"subject"`
+	Oversized bool   `json:"oversized"`
+	Size      int    `json:"size"`
+}
+
+// Publish sends a notification with data on the channel of the subject
+func (n *PGNotifier) Publish(subject string, data []byte) error {
+	payload := string(data)
+
+	if len(data) > pgMaxPayloadLength {
+		stub, err := json.Marshal(pgOversizedPayload{Subject: subject, Oversized: true, Size: len(data)})
+		if err != nil {
+			return err
+		}
+
+		payload = string(stub)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pgNotifyTimeout)
+	defer cancel()
+
+	_, err := n.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", PGNotifyChannel(subject), payload)
+
+	return err
+}
+
+// PublishMsg sends a notification with the message data, the headers are
+// dropped
+func (n *PGNotifier) PublishMsg(m *nats.Msg) error {
+	return n.Publish(m.Subject, m.Data)
+}
+
+// Drain is a no-op, the database is closed by its owner
+func (n *PGNotifier) Drain() error { return nil }
+
+// WithPGNotify also publishes the events as postgres notifications. Without a
+// nats connection, the events are only published as notifications.
+func WithPGNotify(n *PGNotifier) Option {
+	return func(c *Client) {
+		c.pgNotify = n
+	}
+}
+
+// teeConn publishes the messages on nats, then as postgres notifications.
+// Notifying is best effort, a failure is logged but doesn't fail publishing
+// so the retries don't publish the message on nats again.
+type teeConn struct {
+	conn
+	notify *PGNotifier
+	logger *zap.Logger
+}
+
+func (t *teeConn) Publish(subject string, data []byte) error {
+	if err := t.conn.Publish(subject, data); err != nil {
+		return err
+	}
+
+	t.notifyBestEffort(subject, data)
+
+	return nil
+}
+
+func (t *teeConn) PublishMsg(m *nats.Msg) error {
+	if err := t.conn.PublishMsg(m); err != nil {
+		return err
+	}
+
+	t.notifyBestEffort(m.Subject, m.Data)
+
+	return nil
+}
+
+// Subscribe subscribes with the nats connection
+func (t *teeConn) Subscribe(subject string, cb nats.MsgHandler) (*nats.Subscription, error) {
+	s, ok := t.conn.(subscriber)
+	if !ok {
+		return nil, ErrSubscribeNotSupported
+	}
+
+	return s.Subscribe(subject, cb)
+}
+
+func (t *teeConn) notifyBestEffort(subject string, data []byte) {
+	if err := t.notify.Publish(subject, data); err != nil {
+		t.logger.Warn("failed to send postgres notification", zap.String("subject", subject), zap.Error(err))
+	}
+}
@@ -0,0 +1,111 @@
+package eventbus
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+var errTestNotify = errors.New("pg_notify failed")
+
+type notification struct {
+	channel string
+	payload string
+}
+
+type mockExecer struct {
+	err           error
+	notifications []notification
+}
+
+func (m *mockExecer) ExecContext(_ context.Context, _ string, args ...interface{}) (sql.Result, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	m.notifications = append(m.notifications, notification{args[0].(string), args[1].(string)})
+
+	return nil, nil
+}
+
+func TestPGNotifyChannel(t *testing.T) {
+	assert.Equal(t, "governor.groups", PGNotifyChannel("governor.groups"))
+
+	long := "governor." + strings.Repeat("a", 30) + ".v2." + strings.Repeat("b", 40)
+	channel := PGNotifyChannel(long)
+	assert.Len(t, channel, pgMaxChannelLength)
+	assert.True(t, strings.HasPrefix(channel, "governor.aaa"))
+	assert.NotEqual(t, channel, PGNotifyChannel(long+"c"))
+}
+
+func TestPGNotifier(t *testing.T) {
+	db := &mockExecer{}
+	n := NewPGNotifier(db)
+
+	require.NoError(t, n.Publish("governor.groups", []byte(`{"action":"CREATE"}`)))
+	require.NoError(t, n.Publish("governor.v2.groups", []byte(strings.Repeat("x", pgMaxPayloadLength+1))))
+
+	require.Len(t, db.notifications, 2)
+	assert.Equal(t, notification{"governor.groups", `{"action":"CREATE"}`}, db.notifications[0])
+
+	stub := pgOversizedPayload{}
+	require.NoError(t, json.Unmarshal([]byte(db.notifications[1].payload), &stub))
+	assert.Equal(t, pgOversizedPayload{Subject: "governor.v2.groups", Oversized: true, Size: pgMaxPayloadLength + 1}, stub)
+
+	db.err = errTestNotify
+	assert.ErrorIs(t, n.Publish("governor.groups", nil), errTestNotify)
+}
+
+func TestClient_PGNotify(t *testing.T) {
+	ctx := context.TODO()
+	event := &events.Event{Action: events.GovernorEventCreate, GroupID: "group-id"}
+
+	t.Run("without nats", func(t *testing.T) {
+		db := &mockExecer{}
+		c := NewClient(WithNATSPrefix("governor"), WithV2Events(false), WithPGNotify(NewPGNotifier(db)))
+
+		require.NoError(t, c.Publish(ctx, events.GovernorGroupsEventSubject, event))
+		require.Len(t, db.notifications, 1)
+		assert.Equal(t, "governor.groups", db.notifications[0].channel)
+		assert.Contains(t, db.notifications[0].payload, `"group_id":"group-id"`)
+
+		_, err := c.Subscribe(">", nil)
+		assert.ErrorIs(t, err, ErrSubscribeNotSupported)
+	})
+
+	t.Run("with nats", func(t *testing.T) {
+		db := &mockExecer{}
+		nc := &recordingConn{}
+		c := NewClient(WithNATSConn(nc), WithNATSPrefix("governor"), WithPGNotify(NewPGNotifier(db)))
+
+		require.NoError(t, c.Publish(ctx, events.GovernorGroupsEventSubject, event))
+		assert.Len(t, nc.msgs, 2)
+		require.Len(t, db.notifications, 2)
+		assert.Equal(t, "governor.v2.groups", db.notifications[1].channel)
+
+		// the notifications are best effort
+		db.err = errTestNotify
+		require.NoError(t, c.Publish(ctx, events.GovernorGroupsEventSubject, event))
+		assert.Len(t, nc.msgs, 4)
+	})
+
+	t.Run("nats failure", func(t *testing.T) {
+		db := &mockExecer{}
+		c := NewClient(
+			WithNATSConn(&mockConn{t: t, err: errTestNotify}),
+			WithRetry(1, 0, 0),
+			WithPGNotify(NewPGNotifier(db)),
+		)
+
+		assert.Error(t, c.Publish(ctx, events.GovernorGroupsEventSubject, event))
+		assert.Empty(t, db.notifications)
+	})
+}
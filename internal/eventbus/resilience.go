@@ -0,0 +1,190 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	pkgeventbus "github.com/metal-toolbox/governor-api/pkg/eventbus"
+)
+
+const (
+	defaultRetryAttempts   = 3
+	defaultRetryBackoff    = 100 * time.Millisecond
+	defaultRetryMaxBackoff = 2 * time.Second
+)
+
+// Outbox stores the events that couldn't be published so they are published later
+type Outbox interface {
+	Enqueue(ctx context.Context, msg *nats.Msg) error
+}
+
+// WithRetry sets how many times publishing a message is attempted, and the
+// backoff between the attempts which doubles after each attempt up to
+// maxBackoff
+func WithRetry(attempts int, backoff, maxBackoff time.Duration) Option {
+	return func(c *Client) {
+		if attempts > 0 {
+			c.retryAttempts = attempts
+		}
+
+		c.retryBackoff = backoff
+		c.retryMaxBackoff = maxBackoff
+	}
+}
+
+// WithCircuitBreaker stops attempting to publish messages for the cooldown
+// after threshold consecutive publishing failures, a threshold of 0 disables
+// the circuit breaker
+func WithCircuitBreaker(threshold int, cooldown time.Duration) Option {
+	return func(c *Client) {
+		if threshold <= 0 {
+			c.breaker = nil
+			return
+		}
+
+		c.breaker = &breaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+	}
+}
+
+// WithOutbox sets the outbox the events that couldn't be published are
+// stored in, publishing returns ErrQueued once the event is in the outbox
+func WithOutbox(o Outbox) Option {
+	return func(c *Client) {
+		c.outbox = o
+	}
+}
+
+// deliver publishes a message with retries, the message is stored in the
+// outbox when it still can't be published and ErrQueued is returned
+func (c *Client) deliver(ctx context.Context, msg *nats.Msg) error {
+	err := c.Redeliver(ctx, msg)
+	if err == nil || c.outbox == nil {
+		return err
+	}
+
+	if oerr := c.outbox.Enqueue(ctx, msg); oerr != nil {
+		c.logger.Error("failed to store event in the outbox", zap.String("subject", msg.Subject), zap.Error(oerr))
+		return err
+	}
+
+	c.logger.Warn("failed to publish event, stored in the outbox", zap.String("subject", msg.Subject), zap.Error(err))
+
+	return fmt.Errorf("%w: %s", pkgeventbus.ErrQueued, err)
+}
+
+// Redeliver publishes a message with retries, without falling back to the
+// outbox. ErrCircuitOpen is returned without attempting to publish when the
+// circuit breaker is open. The event of a staged message is published like
+// Publish does.
+func (c *Client) Redeliver(ctx context.Context, msg *nats.Msg) error {
+	if sub := msg.Header.Get(StagedSubjectHeader); sub != "" {
+		return c.publishStaged(ctx, sub, msg)
+	}
+
+	if !c.breaker.allow() {
+		return ErrCircuitOpen
+	}
+
+	backoff := c.retryBackoff
+
+	var err error
+
+	for attempt := 1; ; attempt++ {
+		if err = c.conn.PublishMsg(msg); err == nil {
+			c.breaker.success()
+			return nil
+		}
+
+		if c.breaker.failure() || attempt >= c.retryAttempts {
+			return err
+		}
+
+		c.logger.Debug("retrying event publish", zap.String("subject", msg.Subject), zap.Int("attempt", attempt), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if c.retryMaxBackoff > 0 && backoff > c.retryMaxBackoff {
+			backoff = c.retryMaxBackoff
+		}
+	}
+}
+
+// breaker is a circuit breaker that opens after threshold consecutive
+// failures. Once the cooldown is over a single attempt is let through, the
+// breaker closes when it succeeds and opens again when it fails.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// allow returns true when an attempt can be made, a nil breaker always allows
+func (b *breaker) allow() bool {
+	if b == nil {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+
+	if b.probing || b.now().Before(b.openUntil) {
+		return false
+	}
+
+	b.probing = true
+
+	return true
+}
+
+// success records a successful attempt and closes the breaker
+func (b *breaker) success() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.probing = false
+}
+
+// failure records a failed attempt and returns true when the breaker is open
+func (b *breaker) failure() bool {
+	if b == nil {
+		return false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+
+	if b.failures < b.threshold {
+		return false
+	}
+
+	b.openUntil = b.now().Add(b.cooldown)
+
+	return true
+}
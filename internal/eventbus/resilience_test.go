@@ -0,0 +1,126 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgeventbus "github.com/metal-toolbox/governor-api/pkg/eventbus"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+var errTestPublish = errors.New("nats: connection closed")
+
+// flakyConn fails the first failures publish attempts
+type flakyConn struct {
+	failures int
+	attempts int
+	msgs     []*nats.Msg
+}
+
+func (f *flakyConn) Publish(_ string, _ []byte) error { return nil }
+
+func (f *flakyConn) Drain() error { return nil }
+
+func (f *flakyConn) PublishMsg(msg *nats.Msg) error {
+	f.attempts++
+
+	if f.attempts <= f.failures {
+		return errTestPublish
+	}
+
+	f.msgs = append(f.msgs, msg)
+
+	return nil
+}
+
+type memOutbox struct {
+	msgs []*nats.Msg
+	err  error
+}
+
+func (o *memOutbox) Enqueue(_ context.Context, msg *nats.Msg) error {
+	if o.err != nil {
+		return o.err
+	}
+
+	o.msgs = append(o.msgs, msg)
+
+	return nil
+}
+
+func TestClient_PublishRetries(t *testing.T) {
+	conn := &flakyConn{failures: 2}
+	client := NewClient(WithNATSConn(conn), WithV2Events(false), WithRetry(3, time.Millisecond, time.Millisecond))
+
+	err := client.Publish(context.TODO(), "groups", &events.Event{Action: events.GovernorEventCreate})
+	require.NoError(t, err)
+	assert.Equal(t, 3, conn.attempts)
+	assert.Len(t, conn.msgs, 1)
+}
+
+func TestClient_PublishOutbox(t *testing.T) {
+	conn := &flakyConn{failures: 100}
+	outbox := &memOutbox{}
+
+	invalidated := []string{}
+
+	client := NewClient(
+		WithNATSConn(conn),
+		WithV2Events(false),
+		WithRetry(2, time.Millisecond, time.Millisecond),
+		WithOutbox(outbox),
+		WithPublishHook(func(sub string) { invalidated = append(invalidated, sub) }),
+	)
+
+	// the queued event is published later, the caller is told it was queued
+	err := client.Publish(context.TODO(), "groups", &events.Event{Action: events.GovernorEventCreate})
+	require.ErrorIs(t, err, pkgeventbus.ErrQueued)
+	assert.Equal(t, 2, conn.attempts)
+	require.Len(t, outbox.msgs, 1)
+	assert.Equal(t, "events.groups", outbox.msgs[0].Subject)
+	assert.Equal(t, []string{"groups"}, invalidated)
+
+	// the error is returned when the outbox fails too
+	outbox.err = errors.New("outbox unavailable") //nolint:goerr113
+
+	err = client.Publish(context.TODO(), "groups", &events.Event{Action: events.GovernorEventCreate})
+	assert.ErrorIs(t, err, errTestPublish)
+	assert.NotErrorIs(t, err, pkgeventbus.ErrQueued)
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	conn := &flakyConn{failures: 3}
+	client := NewClient(
+		WithNATSConn(conn),
+		WithV2Events(false),
+		WithRetry(5, time.Millisecond, time.Millisecond),
+		WithCircuitBreaker(3, time.Minute),
+	)
+	client.breaker.now = func() time.Time { return now }
+
+	msg := &nats.Msg{Subject: "events.groups"}
+
+	// the breaker opens after 3 failures, before the retries are used up
+	assert.ErrorIs(t, client.Redeliver(context.TODO(), msg), errTestPublish)
+	assert.Equal(t, 3, conn.attempts)
+
+	// no attempt is made while the breaker is open
+	assert.ErrorIs(t, client.Redeliver(context.TODO(), msg), ErrCircuitOpen)
+	assert.Equal(t, 3, conn.attempts)
+
+	// a single attempt is let through after the cooldown, it closes the breaker
+	now = now.Add(2 * time.Minute)
+
+	assert.NoError(t, client.Redeliver(context.TODO(), msg))
+	assert.Equal(t, 4, conn.attempts)
+	assert.NoError(t, client.Redeliver(context.TODO(), msg))
+	assert.Equal(t, 5, conn.attempts)
+}
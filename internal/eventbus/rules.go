@@ -0,0 +1,66 @@
+package eventbus
+
+import (
+	"context"
+
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// SubjectRules decide which events are published, and the subject of the
+// extension resource events
+type SubjectRules interface {
+	// Muted returns true when the events on the (unprefixed) subject aren't published
+	Muted(ctx context.Context, sub string) bool
+	// Extension returns the subject prefix of the extension resource events of
+	// an extension, and true when they aren't published
+	Extension(ctx context.Context, extensionID string) (prefix string, muted bool)
+}
+
+// WithSubjectRules sets the rules deciding which events are published
+func WithSubjectRules(r SubjectRules) Option {
+	return func(c *Client) {
+		c.rules = r
+	}
+}
+
+// route returns the subject an event is published on, without the subject
+// prefix, and false when the event is muted
+func (c *Client) route(ctx context.Context, sub string, event *events.Event) (string, bool) {
+	if c.rules == nil {
+		return sub, true
+	}
+
+	if event.ExtensionResourceID != "" {
+		prefix, muted := c.rules.Extension(ctx, event.ExtensionID)
+		if muted {
+			return "", false
+		}
+
+		if prefix != "" {
+			sub = prefix + "." + sub
+		}
+
+		return sub, true
+	}
+
+	if c.rules.Muted(ctx, sub) {
+		return "", false
+	}
+
+	return sub, true
+}
+
+// ExtensionSubject returns the subject, without the subject prefix, the
+// extension resource events of an extension are published on for the given
+// resource definition plural slug
+func (c *Client) ExtensionSubject(ctx context.Context, extensionID, slugPlural string) string {
+	if c.rules == nil {
+		return slugPlural
+	}
+
+	if prefix, _ := c.rules.Extension(ctx, extensionID); prefix != "" {
+		return prefix + "." + slugPlural
+	}
+
+	return slugPlural
+}
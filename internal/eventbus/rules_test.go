@@ -0,0 +1,61 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+type stubRules struct {
+	muted      map[string]bool
+	extensions map[string]string
+}
+
+func (s *stubRules) Muted(_ context.Context, sub string) bool {
+	return s.muted[sub]
+}
+
+func (s *stubRules) Extension(_ context.Context, extensionID string) (string, bool) {
+	prefix, ok := s.extensions[extensionID]
+
+	return prefix, ok && prefix == ""
+}
+
+func TestClient_PublishWithRules(t *testing.T) {
+	conn := &recordingConn{}
+
+	var hooked []string
+
+	c := NewClient(
+		WithNATSConn(conn),
+		WithNATSPrefix("test"),
+		WithV2Events(false),
+		WithPublishHook(func(sub string) { hooked = append(hooked, sub) }),
+		WithSubjectRules(&stubRules{
+			muted:      map[string]bool{events.GovernorGroupsEventSubject: true},
+			extensions: map[string]string{"ext-prefixed": "staging", "ext-muted": ""},
+		}),
+	)
+
+	ctx := context.TODO()
+
+	require.NoError(t, c.Publish(ctx, events.GovernorGroupsEventSubject, &events.Event{Action: events.GovernorEventCreate}))
+	require.NoError(t, c.Publish(ctx, events.GovernorUsersEventSubject, &events.Event{Action: events.GovernorEventCreate}))
+	require.NoError(t, c.Publish(ctx, "widgets", &events.Event{Action: events.GovernorEventCreate, ExtensionID: "ext-prefixed", ExtensionResourceID: "id"}))
+	require.NoError(t, c.Publish(ctx, "widgets", &events.Event{Action: events.GovernorEventCreate, ExtensionID: "ext-muted", ExtensionResourceID: "id"}))
+
+	subjects := make([]string, len(conn.msgs))
+	for i, m := range conn.msgs {
+		subjects[i] = m.Subject
+	}
+
+	assert.Equal(t, []string{"test.users", "test.staging.widgets"}, subjects)
+	assert.Equal(t, []string{"groups", "users", "widgets", "widgets"}, hooked, "hooks run for muted events")
+
+	assert.Equal(t, "staging.widgets", c.ExtensionSubject(ctx, "ext-prefixed", "widgets"))
+	assert.Equal(t, "widgets", c.ExtensionSubject(ctx, "other", "widgets"))
+}
@@ -0,0 +1,60 @@
+package eventbus
+
+import (
+	"math/rand/v2"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+// shadow duplicates a sample of the published events under a secondary
+// subject prefix, so new consumers can be validated against live events
+// without subscribing to the production subjects
+type shadow struct {
+	conn   conn
+	prefix string
+	rate   float64
+	sample func() float64
+}
+
+// WithShadowPrefix duplicates a rate fraction of the published events under
+// the prefix, like events-canary. The copies are only published on nats, once
+// and without the outbox, and failing to publish them is only logged. An
+// empty prefix or a rate of 0 disables it.
+func WithShadowPrefix(prefix string, rate float64) Option {
+	return func(c *Client) {
+		if prefix == "" || rate <= 0 {
+			c.shadow = nil
+			return
+		}
+
+		c.shadow = &shadow{
+			prefix: prefix,
+			rate:   min(rate, 1),
+			sample: rand.Float64, //nolint:gosec
+		}
+	}
+}
+
+// sampled returns true when the event being published is duplicated
+func (s *shadow) sampled() bool {
+	if s == nil || s.conn == nil {
+		return false
+	}
+
+	return s.rate >= 1 || s.sample() < s.rate
+}
+
+// publishShadow publishes a copy of a published message, with the subject
+// under the shadow prefix
+func (c *Client) publishShadow(sub string, msg *nats.Msg) {
+	subject := c.shadow.prefix + "." + sub
+
+	if err := c.shadow.conn.PublishMsg(&nats.Msg{
+		Subject: subject,
+		Data:    msg.Data,
+		Header:  msg.Header,
+	}); err != nil {
+		c.logger.Warn("failed to publish shadow event", zap.String("subject", subject), zap.Error(err))
+	}
+}
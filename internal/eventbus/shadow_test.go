@@ -0,0 +1,84 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+func TestClient_PublishShadow(t *testing.T) {
+	event := func() *events.Event {
+		return &events.Event{
+			Version: events.Version,
+			Action:  events.GovernorEventUpdate,
+			UserID:  "user-id",
+			After:   &models.User{ID: "user-id", Email: "user@example.com"},
+		}
+	}
+
+	t.Run("every event", func(t *testing.T) {
+		conn := &recordingConn{}
+		c := NewClient(WithNATSConn(conn), WithNATSPrefix("test"), WithShadowPrefix("test-canary", 1))
+
+		require.NoError(t, c.Publish(context.TODO(), events.GovernorUsersEventSubject, event()))
+		require.Len(t, conn.msgs, 4)
+
+		subjects := []string{}
+		for _, m := range conn.msgs {
+			subjects = append(subjects, m.Subject)
+		}
+
+		assert.Equal(t, []string{"test.users", "test-canary.users", "test.v2.users", "test-canary.v2.users"}, subjects)
+		assert.Equal(t, conn.msgs[0].Data, conn.msgs[1].Data)
+		assert.Equal(t, conn.msgs[2].Data, conn.msgs[3].Data)
+	})
+
+	t.Run("sampled events", func(t *testing.T) {
+		conn := &recordingConn{}
+		c := NewClient(WithNATSConn(conn), WithNATSPrefix("test"), WithV2Events(false), WithShadowPrefix("test-canary", 0.25))
+
+		samples := []float64{0.1, 0.5}
+		c.shadow.sample = func() float64 {
+			s := samples[0]
+			samples = samples[1:]
+
+			return s
+		}
+
+		require.NoError(t, c.Publish(context.TODO(), events.GovernorUsersEventSubject, event()))
+		require.NoError(t, c.Publish(context.TODO(), events.GovernorUsersEventSubject, event()))
+		require.Len(t, conn.msgs, 3)
+
+		assert.Equal(t, "test.users", conn.msgs[0].Subject)
+		assert.Equal(t, "test-canary.users", conn.msgs[1].Subject)
+		assert.Equal(t, "test.users", conn.msgs[2].Subject)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		for _, opt := range []Option{
+			WithShadowPrefix("test-canary", 0),
+			WithShadowPrefix("", 1),
+			WithShadowPrefix("test", 1),
+		} {
+			conn := &recordingConn{}
+			c := NewClient(WithNATSConn(conn), WithNATSPrefix("test"), WithV2Events(false), opt)
+
+			require.NoError(t, c.Publish(context.TODO(), events.GovernorUsersEventSubject, event()))
+			assert.Len(t, conn.msgs, 1)
+		}
+	})
+
+	t.Run("shadow failure doesn't fail publishing", func(t *testing.T) {
+		conn := &recordingConn{}
+		c := NewClient(WithNATSConn(conn), WithNATSPrefix("test"), WithV2Events(false), WithShadowPrefix("test-canary", 1))
+		c.shadow.conn = &mockConn{t: t, err: assert.AnError}
+
+		require.NoError(t, c.Publish(context.TODO(), events.GovernorUsersEventSubject, event()))
+		assert.Len(t, conn.msgs, 1)
+	})
+}
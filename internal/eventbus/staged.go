@@ -0,0 +1,57 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+
+	pkgeventbus "github.com/metal-toolbox/governor-api/pkg/eventbus"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// StagedSubjectHeader is the header of the staged messages with the subject
+// the event is published on, without the subject prefix
+const StagedSubjectHeader = "X-Governor-Staged-Subject"
+
+// StagedMessage returns the message staging an event in the outbox, within
+// the transaction changing governor's state. The outbox relays it with
+// Redeliver, which publishes the event like Publish does.
+func StagedMessage(sub string, event *events.Event) (*nats.Msg, error) {
+	if event == nil {
+		return nil, ErrEmptyEvent
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding staged event: %w", err)
+	}
+
+	headers := nats.Header{}
+	headers.Set(StagedSubjectHeader, sub)
+
+	return &nats.Msg{
+		Subject: sub,
+		Data:    payload,
+		Header:  headers,
+	}, nil
+}
+
+// publishStaged publishes the event of a staged message, the event is stored
+// in the outbox again when it can't be published, so the staged message is
+// relayed either way
+func (c *Client) publishStaged(ctx context.Context, sub string, msg *nats.Msg) error {
+	var event events.Event
+
+	if err := json.Unmarshal(msg.Data, &event); err != nil {
+		return fmt.Errorf("error decoding staged event: %w", err)
+	}
+
+	if err := c.Publish(ctx, sub, &event); err != nil && !errors.Is(err, pkgeventbus.ErrQueued) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+func TestClient_RedeliverStaged(t *testing.T) {
+	msg, err := StagedMessage("groups", &events.Event{Action: events.GovernorEventCreate, GroupID: "group-id"})
+	require.NoError(t, err)
+	assert.Equal(t, "groups", msg.Header.Get(StagedSubjectHeader))
+
+	conn := &flakyConn{}
+	client := NewClient(WithNATSConn(conn), WithV2Events(false))
+
+	require.NoError(t, client.Redeliver(context.TODO(), msg))
+	require.Len(t, conn.msgs, 1)
+	assert.Equal(t, "events.groups", conn.msgs[0].Subject)
+	assert.Empty(t, conn.msgs[0].Header.Get(StagedSubjectHeader))
+
+	var event events.Event
+
+	require.NoError(t, json.Unmarshal(conn.msgs[0].Data, &event))
+	assert.Equal(t, "group-id", event.GroupID)
+
+	// the staged event is stored in the outbox again when it can't be published
+	outbox := &memOutbox{}
+	client = NewClient(
+		WithNATSConn(&flakyConn{failures: 100}),
+		WithV2Events(false),
+		WithRetry(1, time.Millisecond, time.Millisecond),
+		WithOutbox(outbox),
+	)
+
+	require.NoError(t, client.Redeliver(context.TODO(), msg))
+	require.Len(t, outbox.msgs, 1)
+	assert.Equal(t, "events.groups", outbox.msgs[0].Subject)
+
+	_, err = StagedMessage("groups", nil)
+	assert.ErrorIs(t, err, ErrEmptyEvent)
+}
@@ -0,0 +1,155 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	pkgeventbus "github.com/metal-toolbox/governor-api/pkg/eventbus"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// UserFields are the user fields the user field subscriptions can register interest in
+var UserFields = []string{
+	models.UserColumns.AvatarURL,
+	models.UserColumns.Email,
+	models.UserColumns.ExternalID,
+	models.UserColumns.GithubID,
+	models.UserColumns.GithubUsername,
+	models.UserColumns.Name,
+	models.UserColumns.Status,
+}
+
+// ValidateUserFields returns ErrUnknownUserField if one of the fields isn't
+// in UserFields
+func ValidateUserFields(fields []string) error {
+	for _, f := range fields {
+		if !slices.Contains(UserFields, f) {
+			return fmt.Errorf("%w: %q", ErrUnknownUserField, f)
+		}
+	}
+
+	return nil
+}
+
+// UserFieldSubscriptions are the downstream consumers' interest in specific user fields
+type UserFieldSubscriptions interface {
+	// UserFieldSubscriptions returns the user fields each subscription registered
+	// interest in, keyed by subscription name
+	UserFieldSubscriptions(ctx context.Context) map[string][]string
+}
+
+// WithUserFieldSubscriptions sets the subscriptions the user field change
+// events are published for
+func WithUserFieldSubscriptions(s UserFieldSubscriptions) Option {
+	return func(c *Client) {
+		c.userFields = s
+	}
+}
+
+// UserFieldSubject returns the subject, without the subject prefix, the user
+// field change events of a subscription are published on
+func UserFieldSubject(name string) string {
+	return events.GovernorUserFieldsEventSubject + "." + name
+}
+
+// publishUserFieldChanges publishes the changed user fields to every
+// subscription interested in one of them, so sync services don't need to
+// refetch the user on every update. The users event was already handled so
+// errors are only logged.
+func (c *Client) publishUserFieldChanges(ctx context.Context, sub string, event *events.Event) {
+	if c.userFields == nil || sub != events.GovernorUsersEventSubject || event.Action != events.GovernorEventUpdate {
+		return
+	}
+
+	before, ok := event.Before.(*models.User)
+	if !ok || before == nil {
+		return
+	}
+
+	after, ok := event.After.(*models.User)
+	if !ok || after == nil {
+		return
+	}
+
+	changes := userFieldChanges(before, after)
+	if len(changes) == 0 {
+		return
+	}
+
+	subscriptions := c.userFields.UserFieldSubscriptions(ctx)
+
+	names := make([]string, 0, len(subscriptions))
+	for name := range subscriptions {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		subscribed := map[string]events.FieldChange{}
+
+		for _, f := range subscriptions[name] {
+			if change, ok := changes[f]; ok {
+				subscribed[f] = change
+			}
+		}
+
+		if len(subscribed) == 0 {
+			continue
+		}
+
+		if err := c.Publish(ctx, UserFieldSubject(name), &events.Event{
+			Version: events.Version,
+			Action:  events.GovernorEventUpdate,
+			AuditID: event.AuditID,
+			ActorID: event.ActorID,
+			UserID:  event.UserID,
+			Changes: subscribed,
+		}); err != nil && !errors.Is(err, pkgeventbus.ErrQueued) {
+			c.logger.Warn("failed to publish user field change event", zap.String("subscription", name), zap.Error(err))
+		}
+	}
+}
+
+// userFieldChanges returns the old and new values of the user fields that
+// differ between the two snapshots
+func userFieldChanges(before, after *models.User) map[string]events.FieldChange {
+	old, updated := userFieldValues(before), userFieldValues(after)
+	changes := map[string]events.FieldChange{}
+
+	for _, f := range UserFields {
+		if old[f] != updated[f] {
+			changes[f] = events.FieldChange{Old: old[f], New: updated[f]}
+		}
+	}
+
+	return changes
+}
+
+// userFieldValues returns the values of the user fields, unset fields are nil
+func userFieldValues(u *models.User) map[string]interface{} {
+	return map[string]interface{}{
+		models.UserColumns.AvatarURL:      nullable(u.AvatarURL.Ptr()),
+		models.UserColumns.Email:          u.Email,
+		models.UserColumns.ExternalID:     nullable(u.ExternalID.Ptr()),
+		models.UserColumns.GithubID:       nullable(u.GithubID.Ptr()),
+		models.UserColumns.GithubUsername: nullable(u.GithubUsername.Ptr()),
+		models.UserColumns.Name:           u.Name,
+		models.UserColumns.Status:         nullable(u.Status.Ptr()),
+	}
+}
+
+// nullable dereferences p, so values of nullable fields can be compared
+func nullable[T comparable](p *T) interface{} {
+	if p == nil {
+		return nil
+	}
+
+	return *p
+}
@@ -0,0 +1,73 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+type stubUserFields map[string][]string
+
+func (s stubUserFields) UserFieldSubscriptions(_ context.Context) map[string][]string {
+	return s
+}
+
+func TestValidateUserFields(t *testing.T) {
+	assert.NoError(t, ValidateUserFields([]string{"email", "status", "github_username"}))
+	assert.ErrorIs(t, ValidateUserFields([]string{"email", "login_count"}), ErrUnknownUserField)
+}
+
+func TestClient_PublishUserFieldChanges(t *testing.T) {
+	conn := &recordingConn{}
+
+	c := NewClient(
+		WithNATSConn(conn),
+		WithNATSPrefix("test"),
+		WithV2Events(false),
+		WithSubjectRules(&stubRules{muted: map[string]bool{events.GovernorUsersEventSubject: true}}),
+		WithUserFieldSubscriptions(stubUserFields{
+			"github-sync": {"github_username", "status"},
+			"mailer":      {"email"},
+			"ldap":        {"name"},
+		}),
+	)
+
+	before := &models.User{ID: "user-id", Name: "Alice", Email: "alice@example.com", Status: null.StringFrom("active")}
+	after := &models.User{ID: "user-id", Name: "Alice", Email: "alice@example.com", Status: null.StringFrom("suspended"), GithubUsername: null.StringFrom("alice")}
+
+	ctx := context.TODO()
+
+	require.NoError(t, c.Publish(ctx, events.GovernorUsersEventSubject, &events.Event{
+		Action: events.GovernorEventUpdate,
+		UserID: "user-id",
+		Before: before,
+		After:  after,
+	}))
+
+	// create events don't have field changes
+	require.NoError(t, c.Publish(ctx, events.GovernorUsersEventSubject, &events.Event{
+		Action: events.GovernorEventCreate,
+		UserID: "user-id",
+		After:  after,
+	}))
+
+	require.Len(t, conn.msgs, 1, "only the subscription interested in the changed fields gets an event, even with the users events muted")
+	assert.Equal(t, "test.users.fields.github-sync", conn.msgs[0].Subject)
+
+	e := events.Event{}
+	require.NoError(t, json.Unmarshal(conn.msgs[0].Data, &e))
+
+	assert.Equal(t, "user-id", e.UserID)
+	assert.Equal(t, events.GovernorEventUpdate, e.Action)
+	assert.Equal(t, map[string]events.FieldChange{
+		"github_username": {Old: nil, New: "alice"},
+		"status":          {Old: "active", New: "suspended"},
+	}, e.Changes)
+}
@@ -0,0 +1,207 @@
+package eventbus
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+	eventsv2 "github.com/metal-toolbox/governor-api/pkg/events/v2"
+)
+
+// toV2 returns the v2 event of a v1alpha1 event, or nil when the subject
+// doesn't have a v2 payload yet
+func toV2(sub string, e *events.Event, correlationID string, now time.Time) interface{} {
+	switch {
+	case sub == events.GovernorMembersEventSubject:
+		return envelope(sub, e, correlationID, now, memberEventToV2(e))
+	case sub == events.GovernorGroupsEventSubject:
+		return envelope(sub, e, correlationID, now, eventsv2.GroupEvent{
+			GroupID: e.GroupID,
+			Before:  groupToV2(e.Before),
+			After:   groupToV2(e.After),
+		})
+	case sub == events.GovernorUsersEventSubject:
+		return envelope(sub, e, correlationID, now, eventsv2.UserEvent{
+			UserID: e.UserID,
+			Before: userToV2(e.Before),
+			After:  userToV2(e.After),
+		})
+	case sub == events.GovernorHierarchiesEventSubject:
+		return envelope(sub, e, correlationID, now, hierarchyEventToV2(e))
+	case e.ExtensionResourceID != "":
+		// extension resources are published on the plural slug of their definition
+		return envelope(sub, e, correlationID, now, eventsv2.ExtensionResourceEvent{
+			ExtensionID:                   e.ExtensionID,
+			ExtensionResourceDefinitionID: e.ExtensionResourceDefinitionID,
+			ExtensionResourceID:           e.ExtensionResourceID,
+			ResourceVersion:               e.Version,
+			UserID:                        e.UserID,
+			Before:                        extensionResourceToV2(e.Before),
+			After:                         extensionResourceToV2(e.After),
+		})
+	default:
+		return nil
+	}
+}
+
+func envelope[T any](sub string, e *events.Event, correlationID string, now time.Time, payload T) *eventsv2.Envelope[T] {
+	return &eventsv2.Envelope[T]{
+		SchemaVersion: eventsv2.SchemaVersion,
+		Subject:       sub,
+		Action:        e.Action,
+		Time:          now,
+		AuditID:       e.AuditID,
+		ActorID:       e.ActorID,
+		CorrelationID: correlationID,
+		TraceContext:  e.TraceContext,
+		Payload:       payload,
+	}
+}
+
+// memberEventToV2 builds the payload of a members event, the membership
+// details of a delete event are the removed membership
+func memberEventToV2(e *events.Event) eventsv2.MemberEvent {
+	p := eventsv2.MemberEvent{
+		GroupID: e.GroupID,
+		UserID:  e.UserID,
+		Before:  memberToV2(e.Before),
+	}
+
+	if e.Member == nil {
+		return p
+	}
+
+	m := &eventsv2.Member{
+		IsAdmin:        e.Member.IsAdmin,
+		ExpiresAt:      e.Member.ExpiresAt,
+		AdminExpiresAt: e.Member.AdminExpiresAt,
+		Direct:         e.Member.Direct,
+		Source:         e.Member.Source,
+		SourceRef:      e.Member.SourceRef,
+	}
+
+	if e.Action == events.GovernorEventDelete {
+		p.Before = m
+	} else {
+		p.After = m
+	}
+
+	return p
+}
+
+func memberToV2(o interface{}) *eventsv2.Member {
+	m, ok := o.(*models.GroupMembership)
+	if !ok || m == nil {
+		return nil
+	}
+
+	return &eventsv2.Member{
+		IsAdmin:        m.IsAdmin,
+		ExpiresAt:      m.ExpiresAt.Ptr(),
+		AdminExpiresAt: m.AdminExpiresAt.Ptr(),
+		Direct:         true,
+		Source:         m.Source,
+		SourceRef:      m.SourceRef.String,
+	}
+}
+
+// hierarchyEventToV2 builds the payload of a hierarchies event, events
+// published before HierarchyVersion only have the parent group id
+func hierarchyEventToV2(e *events.Event) eventsv2.HierarchyEvent {
+	p := eventsv2.HierarchyEvent{
+		ParentGroupID: e.GroupID,
+		Before:        hierarchyToV2(e.Before),
+		After:         hierarchyToV2(e.After),
+	}
+
+	if e.Hierarchy != nil {
+		p.ParentGroupID = e.Hierarchy.ParentGroupID
+		p.MemberGroupID = e.Hierarchy.MemberGroupID
+	}
+
+	return p
+}
+
+func hierarchyToV2(o interface{}) *eventsv2.Hierarchy {
+	h, ok := o.(*models.GroupHierarchy)
+	if !ok || h == nil {
+		return nil
+	}
+
+	return &eventsv2.Hierarchy{
+		ID:        h.ID,
+		ExpiresAt: h.ExpiresAt.Ptr(),
+		CreatedAt: h.CreatedAt,
+		UpdatedAt: h.UpdatedAt,
+	}
+}
+
+func groupToV2(o interface{}) *eventsv2.Group {
+	g, ok := o.(*models.Group)
+	if !ok || g == nil {
+		return nil
+	}
+
+	return &eventsv2.Group{
+		ID:            g.ID,
+		Name:          g.Name,
+		Slug:          g.Slug,
+		Description:   g.Description,
+		Note:          g.Note,
+		ApproverGroup: g.ApproverGroup.String,
+		OwnerContact:  g.OwnerContact,
+		DocsURL:       g.DocsURL,
+		SlackChannel:  g.SlackChannel,
+		CostCenter:    g.CostCenter,
+		FrozenAt:      g.FrozenAt.Ptr(),
+		ProtectedAt:   g.ProtectedAt.Ptr(),
+		BreakGlassAt:  g.BreakGlassAt.Ptr(),
+		CreatedAt:     g.CreatedAt,
+		UpdatedAt:     g.UpdatedAt,
+		DeletedAt:     g.DeletedAt.Ptr(),
+	}
+}
+
+func userToV2(o interface{}) *eventsv2.User {
+	u, ok := o.(*models.User)
+	if !ok || u == nil {
+		return nil
+	}
+
+	return &eventsv2.User{
+		ID:             u.ID,
+		ExternalID:     u.ExternalID.String,
+		Name:           u.Name,
+		Email:          u.Email,
+		Status:         u.Status.String,
+		GithubID:       u.GithubID.Ptr(),
+		GithubUsername: u.GithubUsername.String,
+		CreatedAt:      u.CreatedAt,
+		UpdatedAt:      u.UpdatedAt,
+		DeletedAt:      u.DeletedAt.Ptr(),
+	}
+}
+
+func extensionResourceToV2(o interface{}) json.RawMessage {
+	var resource types.JSON
+
+	switch er := o.(type) {
+	case *models.SystemExtensionResource:
+		if er != nil {
+			resource = er.Resource
+		}
+	case *models.UserExtensionResource:
+		if er != nil {
+			resource = er.Resource
+		}
+	}
+
+	if len(resource) == 0 {
+		return nil
+	}
+
+	return json.RawMessage(resource)
+}
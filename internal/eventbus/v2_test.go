@@ -0,0 +1,148 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+	eventsv2 "github.com/metal-toolbox/governor-api/pkg/events/v2"
+)
+
+type recordingConn struct {
+	mockConn
+	msgs []*nats.Msg
+}
+
+func (r *recordingConn) PublishMsg(msg *nats.Msg) error {
+	r.msgs = append(r.msgs, msg)
+	return nil
+}
+
+func TestToV2(t *testing.T) {
+	now := time.Now().UTC()
+	expires := now.Add(time.Hour)
+
+	t.Run("member delete", func(t *testing.T) {
+		env, ok := toV2(events.GovernorMembersEventSubject, &events.Event{
+			Action:  events.GovernorEventDelete,
+			GroupID: "group-id",
+			UserID:  "user-id",
+			Member:  &events.Member{IsAdmin: true, ExpiresAt: &expires},
+		}, "cid", now).(*eventsv2.Envelope[eventsv2.MemberEvent])
+		require.True(t, ok)
+
+		assert.Equal(t, eventsv2.SchemaVersion, env.SchemaVersion)
+		assert.Equal(t, "cid", env.CorrelationID)
+		assert.Nil(t, env.Payload.After)
+		require.NotNil(t, env.Payload.Before)
+		assert.True(t, env.Payload.Before.IsAdmin)
+		assert.Equal(t, &expires, env.Payload.Before.ExpiresAt)
+	})
+
+	t.Run("member update", func(t *testing.T) {
+		env, ok := toV2(events.GovernorMembersEventSubject, &events.Event{
+			Action: events.GovernorEventUpdate,
+			Member: &events.Member{IsAdmin: true, Direct: true, Source: events.MembershipSourceRequest, SourceRef: "request-id"},
+			Before: &models.GroupMembership{IsAdmin: false, Source: events.MembershipSourceRequest, SourceRef: null.StringFrom("request-id")},
+		}, "", now).(*eventsv2.Envelope[eventsv2.MemberEvent])
+		require.True(t, ok)
+
+		require.NotNil(t, env.Payload.Before)
+		assert.False(t, env.Payload.Before.IsAdmin)
+		assert.Equal(t, "request-id", env.Payload.Before.SourceRef)
+		require.NotNil(t, env.Payload.After)
+		assert.True(t, env.Payload.After.IsAdmin)
+		assert.Equal(t, events.MembershipSourceRequest, env.Payload.After.Source)
+	})
+
+	t.Run("group update", func(t *testing.T) {
+		env, ok := toV2(events.GovernorGroupsEventSubject, &events.Event{
+			Action:  events.GovernorEventUpdate,
+			GroupID: "group-id",
+			Before:  &models.Group{ID: "group-id", Name: "old"},
+			After:   &models.Group{ID: "group-id", Name: "new", ApproverGroup: null.StringFrom("approvers")},
+		}, "", now).(*eventsv2.Envelope[eventsv2.GroupEvent])
+		require.True(t, ok)
+
+		assert.Equal(t, "old", env.Payload.Before.Name)
+		assert.Equal(t, "new", env.Payload.After.Name)
+		assert.Equal(t, "approvers", env.Payload.After.ApproverGroup)
+	})
+
+	t.Run("hierarchy update", func(t *testing.T) {
+		env, ok := toV2(events.GovernorHierarchiesEventSubject, &events.Event{
+			Version:   events.HierarchyVersion,
+			Action:    events.GovernorEventUpdate,
+			GroupID:   "parent-id",
+			Hierarchy: &events.Hierarchy{ParentGroupID: "parent-id", MemberGroupID: "member-id", ExpiresAt: &expires},
+			Before:    &models.GroupHierarchy{ID: "hierarchy-id"},
+			After:     &models.GroupHierarchy{ID: "hierarchy-id", ExpiresAt: null.TimeFrom(expires)},
+		}, "", now).(*eventsv2.Envelope[eventsv2.HierarchyEvent])
+		require.True(t, ok)
+
+		assert.Equal(t, "parent-id", env.Payload.ParentGroupID)
+		assert.Equal(t, "member-id", env.Payload.MemberGroupID)
+		assert.Nil(t, env.Payload.Before.ExpiresAt)
+		assert.Equal(t, &expires, env.Payload.After.ExpiresAt)
+	})
+
+	t.Run("extension resource create", func(t *testing.T) {
+		env, ok := toV2("widgets", &events.Event{
+			Version:             "v1",
+			Action:              events.GovernorEventCreate,
+			ExtensionResourceID: "resource-id",
+			After:               &models.SystemExtensionResource{Resource: []byte(`{"a":1}`)},
+		}, "", now).(*eventsv2.Envelope[eventsv2.ExtensionResourceEvent])
+		require.True(t, ok)
+
+		assert.Equal(t, "v1", env.Payload.ResourceVersion)
+		assert.Nil(t, env.Payload.Before)
+		assert.JSONEq(t, `{"a":1}`, string(env.Payload.After))
+	})
+
+	t.Run("subject without v2 payload", func(t *testing.T) {
+		assert.Nil(t, toV2(events.GovernorApplicationsEventSubject, &events.Event{Action: events.GovernorEventCreate}, "", now))
+	})
+}
+
+func TestClient_PublishV2(t *testing.T) {
+	conn := &recordingConn{}
+
+	c := NewClient(WithNATSConn(conn), WithNATSPrefix("test"))
+
+	err := c.Publish(context.TODO(), events.GovernorUsersEventSubject, &events.Event{
+		Version: events.Version,
+		Action:  events.GovernorEventUpdate,
+		UserID:  "user-id",
+		After:   &models.User{ID: "user-id", Email: "user@example.com"},
+	})
+	require.NoError(t, err)
+	require.Len(t, conn.msgs, 2)
+
+	assert.Equal(t, "test.users", conn.msgs[0].Subject)
+	assert.NotContains(t, string(conn.msgs[0].Data), "user@example.com")
+
+	assert.Equal(t, "test.v2.users", conn.msgs[1].Subject)
+
+	env, err := eventsv2.Decode[eventsv2.UserEvent](conn.msgs[1].Data)
+	require.NoError(t, err)
+	assert.Equal(t, "user@example.com", env.Payload.After.Email)
+
+	conn.msgs = nil
+	c = NewClient(WithNATSConn(conn), WithNATSPrefix("test"), WithV2Events(false))
+
+	require.NoError(t, c.Publish(context.TODO(), events.GovernorUsersEventSubject, &events.Event{Action: events.GovernorEventUpdate}))
+	assert.Len(t, conn.msgs, 1)
+
+	var v1 map[string]interface{}
+	require.NoError(t, json.Unmarshal(conn.msgs[0].Data, &v1))
+	assert.Equal(t, events.GovernorEventUpdate, v1["action"])
+}
@@ -0,0 +1,7 @@
+// Package eventrules decides which events governor publishes, and the subject
+// prefix of each extension's resource events. Rules come from the static
+// configuration and from the event_subject_rules table, which takes
+// precedence so subjects can be muted at runtime. The user field
+// subscriptions, which decide the user field change events, are cached with
+// the rules.
+package eventrules
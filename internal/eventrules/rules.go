@@ -0,0 +1,236 @@
+package eventrules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const defaultTTL = 30 * time.Second
+
+var (
+	// ErrInvalidSubject is returned when a subject or subject prefix isn't a valid NATS subject
+	ErrInvalidSubject = errors.New("invalid event subject")
+
+	// subjectRegexp matches dot separated subject tokens, without wildcards
+	subjectRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+(\.[a-zA-Z0-9_-]+)*$`)
+)
+
+// ValidateSubject returns ErrInvalidSubject if s isn't a valid subject, or
+// subject prefix, to publish on
+func ValidateSubject(s string) error {
+	if !subjectRegexp.MatchString(s) {
+		return fmt.Errorf("%w: %q", ErrInvalidSubject, s)
+	}
+
+	return nil
+}
+
+// Config is the static configuration of the rules
+type Config struct {
+	// Muted are the subjects, without the subject prefix, that aren't published
+	Muted []string
+	// ExtensionPrefix is the subject prefix of the extension resource events of
+	// every extension without its own prefix
+	ExtensionPrefix string
+}
+
+// Rules are the rules stored in the database
+type Rules struct {
+	// Subjects maps subjects to whether they are muted
+	Subjects map[string]bool
+	// Extensions maps extension ids to their rule
+	Extensions map[string]ExtensionRule
+	// UserFields maps the user field subscription names to the user fields
+	// they registered interest in
+	UserFields map[string][]string
+}
+
+// ExtensionRule is the rule of the resource events of an extension
+type ExtensionRule struct {
+	Prefix string
+	Muted  bool
+}
+
+// Loader loads the rules stored in the database
+type Loader func(ctx context.Context) (*Rules, error)
+
+// DBLoader returns a loader reading the rules from the event_subject_rules
+// and user_field_subscriptions tables
+func DBLoader(exec boil.ContextExecutor) Loader {
+	return func(ctx context.Context) (*Rules, error) {
+		stored, err := models.EventSubjectRules().All(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+
+		subscriptions, err := models.UserFieldSubscriptions().All(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+
+		rules := &Rules{
+			Subjects:   map[string]bool{},
+			Extensions: map[string]ExtensionRule{},
+			UserFields: map[string][]string{},
+		}
+
+		for _, r := range stored {
+			switch {
+			case r.Subject.Valid:
+				rules.Subjects[r.Subject.String] = r.Muted
+			case r.ExtensionID.Valid:
+				rules.Extensions[r.ExtensionID.String] = ExtensionRule{Prefix: r.Prefix, Muted: r.Muted}
+			}
+		}
+
+		for _, s := range subscriptions {
+			rules.UserFields[s.Name] = s.Fields
+		}
+
+		return rules, nil
+	}
+}
+
+// Cache is an in-memory cache of the rules. The stored rules are reloaded when
+// the ttl expires, so changes made through another instance are picked up
+// within the ttl.
+type Cache struct {
+	mu      sync.RWMutex
+	config  Config
+	muted   map[string]bool
+	rules   *Rules
+	expires time.Time
+	ttl     time.Duration
+	load    Loader
+	logger  *zap.Logger
+	now     func() time.Time
+}
+
+// Option is a functional configuration option for the rules cache
+type Option func(c *Cache)
+
+// New returns a new rules cache using the loader to read the stored rules
+func New(load Loader, opts ...Option) *Cache {
+	c := Cache{
+		muted:  map[string]bool{},
+		rules:  &Rules{},
+		ttl:    defaultTTL,
+		load:   load,
+		logger: zap.NewNop(),
+		now:    time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return &c
+}
+
+// WithConfig sets the static configuration of the rules
+func WithConfig(cfg Config) Option {
+	return func(c *Cache) {
+		c.config = cfg
+
+		for _, s := range cfg.Muted {
+			c.muted[s] = true
+		}
+	}
+}
+
+// WithTTL sets how long the stored rules are cached before being reloaded
+func WithTTL(ttl time.Duration) Option {
+	return func(c *Cache) {
+		c.ttl = ttl
+	}
+}
+
+// WithLogger sets the cache logger
+func WithLogger(l *zap.Logger) Option {
+	return func(c *Cache) {
+		if l != nil {
+			c.logger = l
+		}
+	}
+}
+
+// Config returns the static configuration of the rules
+func (c *Cache) Config() Config {
+	return c.config
+}
+
+// Muted returns true when the events on the subject aren't published. A
+// stored rule for the subject overrides the configuration.
+func (c *Cache) Muted(ctx context.Context, sub string) bool {
+	rules := c.get(ctx)
+
+	if muted, ok := rules.Subjects[sub]; ok {
+		return muted
+	}
+
+	return c.muted[sub]
+}
+
+// Extension returns the subject prefix of the resource events of the
+// extension and true when they aren't published. Extensions without a stored
+// prefix use the configured one.
+func (c *Cache) Extension(ctx context.Context, extensionID string) (string, bool) {
+	rules := c.get(ctx)
+
+	rule, ok := rules.Extensions[extensionID]
+	if !ok || rule.Prefix == "" {
+		return c.config.ExtensionPrefix, rule.Muted
+	}
+
+	return rule.Prefix, rule.Muted
+}
+
+// UserFieldSubscriptions returns the user fields each user field subscription
+// registered interest in, keyed by subscription name
+func (c *Cache) UserFieldSubscriptions(ctx context.Context) map[string][]string {
+	return c.get(ctx).UserFields
+}
+
+// Invalidate drops the cached rules so they are reloaded on the next lookup
+func (c *Cache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expires = time.Time{}
+}
+
+func (c *Cache) get(ctx context.Context) *Rules {
+	c.mu.RLock()
+	rules := c.rules
+	stale := c.now().After(c.expires)
+	c.mu.RUnlock()
+
+	if !stale {
+		return rules
+	}
+
+	loaded, err := c.load(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err != nil {
+		c.logger.Warn("error loading event subject rules, using cached rules", zap.Error(err))
+	} else {
+		c.rules = loaded
+	}
+
+	// don't retry a failed load on every lookup
+	c.expires = c.now().Add(c.ttl)
+
+	return c.rules
+}
@@ -0,0 +1,82 @@
+package eventrules
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errTestLoad = errors.New("boom")
+
+func TestValidateSubject(t *testing.T) {
+	for _, s := range []string{"members", "staging.widgets", "a-b_c.d1"} {
+		assert.NoError(t, ValidateSubject(s), s)
+	}
+
+	for _, s := range []string{"", ".members", "members.", "members.>", "members.*", "a b", "a..b"} {
+		assert.ErrorIs(t, ValidateSubject(s), ErrInvalidSubject, s)
+	}
+}
+
+func TestCache(t *testing.T) {
+	rules := &Rules{
+		Subjects: map[string]bool{
+			"groups":  true,
+			"members": false,
+		},
+		Extensions: map[string]ExtensionRule{
+			"ext-muted":  {Muted: true},
+			"ext-prefix": {Prefix: "staging"},
+		},
+		UserFields: map[string][]string{
+			"github-sync": {"github_username"},
+		},
+	}
+
+	var loadErr error
+
+	loads := 0
+
+	c := New(func(_ context.Context) (*Rules, error) {
+		loads++
+
+		if loadErr != nil {
+			return nil, loadErr
+		}
+
+		return rules, nil
+	}, WithConfig(Config{Muted: []string{"members", "users"}, ExtensionPrefix: "default"}))
+
+	ctx := context.TODO()
+
+	assert.True(t, c.Muted(ctx, "groups"), "muted by a stored rule")
+	assert.False(t, c.Muted(ctx, "members"), "stored rule overrides the config")
+	assert.True(t, c.Muted(ctx, "users"), "muted by config")
+	assert.False(t, c.Muted(ctx, "apps"))
+
+	prefix, muted := c.Extension(ctx, "ext-prefix")
+	assert.Equal(t, "staging", prefix)
+	assert.False(t, muted)
+
+	prefix, muted = c.Extension(ctx, "ext-muted")
+	assert.Equal(t, "default", prefix)
+	assert.True(t, muted)
+
+	prefix, muted = c.Extension(ctx, "other")
+	assert.Equal(t, "default", prefix)
+	assert.False(t, muted)
+
+	assert.Equal(t, map[string][]string{"github-sync": {"github_username"}}, c.UserFieldSubscriptions(ctx))
+
+	assert.Equal(t, 1, loads)
+
+	// failed reloads keep the previous rules
+	loadErr = errTestLoad
+
+	c.Invalidate()
+
+	assert.True(t, c.Muted(ctx, "groups"))
+	assert.Equal(t, 2, loads)
+}
@@ -0,0 +1,176 @@
+package extcreds
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// ClientIDPrefix is the prefix of the client ids
+	ClientIDPrefix = "gxc_"
+	// SecretPrefix is the prefix of the client secrets
+	SecretPrefix = "gxs_"
+	// TokenPrefix is the prefix of the bearer tokens issued to the extensions,
+	// it tells them apart from the tokens of the identity providers
+	TokenPrefix = "gxt_"
+
+	clientIDBytes = 12
+	secretBytes   = 32
+)
+
+// route is a route template extension tokens are accepted on
+type route struct {
+	methods []string
+	// param is the route parameter identifying the extension
+	param string
+}
+
+// routes are the route templates, relative to the api version, that extension
+// tokens are accepted on
+var routes = map[string]route{
+	"/extensions/:eid":                                {methods: []string{http.MethodGet}, param: "eid"},
+	"/extensions/:eid/events":                         {methods: []string{http.MethodGet}, param: "eid"},
+	"/extensions/:eid/erds":                           {methods: []string{http.MethodGet}, param: "eid"},
+	"/extensions/:eid/erds/:erd-id-slug":              {methods: []string{http.MethodGet}, param: "eid"},
+	"/extensions/:eid/erds/:erd-id-slug/:erd-version": {methods: []string{http.MethodGet}, param: "eid"},
+	"/extensions/:eid/erds/validate-schema":           {methods: []string{http.MethodPost}, param: "eid"},
+	"/extension-resources/:ex-slug/:erd-slug-plural/:erd-version": {
+		methods: []string{http.MethodGet, http.MethodHead, http.MethodPost},
+		param:   "ex-slug",
+	},
+	"/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/count": {
+		methods: []string{http.MethodGet},
+		param:   "ex-slug",
+	},
+	"/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id": {
+		methods: []string{http.MethodGet, http.MethodPatch, http.MethodDelete},
+		param:   "ex-slug",
+	},
+	"/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/owner": {
+		methods: []string{http.MethodPatch},
+		param:   "ex-slug",
+	},
+	"/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version": {
+		methods: []string{http.MethodGet, http.MethodHead, http.MethodPost},
+		param:   "ex-slug",
+	},
+	"/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/count": {
+		methods: []string{http.MethodGet},
+		param:   "ex-slug",
+	},
+	"/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id": {
+		methods: []string{http.MethodGet, http.MethodPatch, http.MethodDelete},
+		param:   "ex-slug",
+	},
+}
+
+// NewClientID returns a new random client id
+func NewClientID() (string, error) {
+	b := make([]byte, clientIDBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return ClientIDPrefix + hex.EncodeToString(b), nil
+}
+
+// NewSecret returns a new random client secret
+func NewSecret() (string, error) {
+	return random(SecretPrefix)
+}
+
+// NewToken returns a new random bearer token
+func NewToken() (string, error) {
+	return random(TokenPrefix)
+}
+
+func random(prefix string) (string, error) {
+	b := make([]byte, secretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return prefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Hash returns the hash stored in place of a secret or token. The secrets and
+// tokens are random so they don't need a slow password hash.
+func Hash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify returns whether a secret matches its stored hash
+func Verify(hash, secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(Hash(secret))) == 1
+}
+
+// Match is how a client secret matched its credentials
+type Match int
+
+const (
+	// NoMatch is a secret matching neither the current nor the previous secret
+	// of the credentials, or credentials that expired
+	NoMatch Match = iota
+	// CurrentSecret is a secret matching the current secret
+	CurrentSecret
+	// PreviousSecret is a secret matching the secret replaced by the last
+	// rotation, within its grace period
+	PreviousSecret
+)
+
+// Secrets are the stored hashes a client secret is verified against, the zero
+// expirations never expire
+type Secrets struct {
+	Hash                  string
+	ExpiresAt             time.Time
+	PreviousHash          string
+	PreviousHashExpiresAt time.Time
+}
+
+// Check returns how a secret matches the secrets at the time now
+func (s Secrets) Check(secret string, now time.Time) Match {
+	if !s.ExpiresAt.IsZero() && !now.Before(s.ExpiresAt) {
+		return NoMatch
+	}
+
+	if Verify(s.Hash, secret) {
+		return CurrentSecret
+	}
+
+	if s.PreviousHash != "" && now.Before(s.PreviousHashExpiresAt) && Verify(s.PreviousHash, secret) {
+		return PreviousSecret
+	}
+
+	return NoMatch
+}
+
+// IsToken returns whether a bearer token was issued to an extension
+func IsToken(bearer string) bool {
+	return strings.HasPrefix(bearer, TokenPrefix)
+}
+
+// RouteParam returns the parameter identifying the extension of a route
+// template, it returns false when extension tokens aren't accepted on the
+// route or with the method
+func RouteParam(method, tmpl string) (string, bool) {
+	r, ok := routes[tmpl]
+	if !ok {
+		return "", false
+	}
+
+	for _, m := range r.methods {
+		if m == method {
+			return r.param, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,131 @@
+package extcreds
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	id, err := NewClientID()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(id, ClientIDPrefix))
+
+	secret, err := NewSecret()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, SecretPrefix))
+
+	token, err := NewToken()
+	require.NoError(t, err)
+	assert.True(t, IsToken(token))
+	assert.False(t, IsToken(secret))
+
+	other, err := NewToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
+
+func TestVerify(t *testing.T) {
+	hash := Hash("gxs_secret")
+
+	assert.True(t, Verify(hash, "gxs_secret"))
+	assert.False(t, Verify(hash, "gxs_other"))
+	assert.False(t, Verify("", "gxs_secret"))
+}
+
+func TestRouteParam(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		tmpl   string
+		param  string
+		ok     bool
+	}{
+		{
+			name:   "system resource",
+			method: http.MethodPost,
+			tmpl:   "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
+			param:  "ex-slug",
+			ok:     true,
+		},
+		{
+			name:   "user resource",
+			method: http.MethodDelete,
+			tmpl:   "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
+			param:  "ex-slug",
+			ok:     true,
+		},
+		{
+			name:   "system resources existence",
+			method: http.MethodHead,
+			tmpl:   "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
+			param:  "ex-slug",
+			ok:     true,
+		},
+		{
+			name:   "user resources count",
+			method: http.MethodGet,
+			tmpl:   "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/count",
+			param:  "ex-slug",
+			ok:     true,
+		},
+		{
+			name:   "events",
+			method: http.MethodGet,
+			tmpl:   "/extensions/:eid/events",
+			param:  "eid",
+			ok:     true,
+		},
+		{
+			name:   "erd schema validation",
+			method: http.MethodPost,
+			tmpl:   "/extensions/:eid/erds/validate-schema",
+			param:  "eid",
+			ok:     true,
+		},
+		{
+			name:   "erd update",
+			method: http.MethodPatch,
+			tmpl:   "/extensions/:eid/erds/:erd-id-slug",
+		},
+		{
+			name:   "other route",
+			method: http.MethodGet,
+			tmpl:   "/groups",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			param, ok := RouteParam(tt.method, tt.tmpl)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.param, param)
+		})
+	}
+}
+
+func TestSecretsCheck(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	s := Secrets{
+		Hash:                  Hash("gxs_current"),
+		PreviousHash:          Hash("gxs_previous"),
+		PreviousHashExpiresAt: now.Add(time.Hour),
+	}
+
+	assert.Equal(t, CurrentSecret, s.Check("gxs_current", now))
+	assert.Equal(t, PreviousSecret, s.Check("gxs_previous", now))
+	assert.Equal(t, NoMatch, s.Check("gxs_other", now))
+	assert.Equal(t, NoMatch, s.Check("gxs_previous", now.Add(time.Hour)), "the grace period is over")
+
+	s.ExpiresAt = now.Add(time.Minute)
+	assert.Equal(t, CurrentSecret, s.Check("gxs_current", now))
+	assert.Equal(t, NoMatch, s.Check("gxs_current", now.Add(time.Minute)), "the credentials expired")
+	assert.Equal(t, NoMatch, s.Check("gxs_previous", now.Add(time.Minute)), "the credentials expired")
+
+	assert.Equal(t, NoMatch, Secrets{Hash: Hash("gxs_current")}.Check("", now))
+}
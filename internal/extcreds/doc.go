@@ -0,0 +1,5 @@
+// Package extcreds provides the client credentials of the extensions. An
+// extension exchanges its client id and secret for a short-lived bearer token
+// which is only accepted on the routes of the extension's own resources and
+// events, instead of sharing an all-powerful token between extensions.
+package extcreds
@@ -0,0 +1,4 @@
+// Package featureflags provides runtime toggles for api behaviors. Flags are
+// stored in the database so operators can change them without redeploying,
+// and are cached in memory for a short time to keep them off the hot path.
+package featureflags
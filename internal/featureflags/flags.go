@@ -0,0 +1,192 @@
+package featureflags
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const (
+	// EnforceNamingPolicy checks new group names against the configured naming policies
+	EnforceNamingPolicy = "enforce-naming-policy"
+	// RequireStepUp requires a recent step-up authentication on the high-risk endpoints with a step-up policy
+	RequireStepUp = "require-step-up"
+	// ReadOnly puts the api in read-only maintenance mode, the mutating requests are rejected
+	ReadOnly = "read-only"
+
+	defaultTTL = 30 * time.Second
+)
+
+// Known are the flags governor understands and their value when they aren't
+// set in the database
+var Known = map[string]Flag{
+	EnforceNamingPolicy: {
+		Description: "Check new group names against the global and organization naming policies",
+		Default:     true,
+	},
+	RequireStepUp: {
+		Description: "Require a recent step-up authentication on the high-risk endpoints with a step-up policy",
+		Default:     true,
+	},
+	ReadOnly: {
+		Description: "Reject the mutating requests while operators run migrations or restores, reads keep working",
+		Default:     false,
+	},
+}
+
+// Flag describes a known feature flag
+type Flag struct {
+	Description string
+	Default     bool
+}
+
+// IsKnown returns true if the flag is one governor understands
+func IsKnown(name string) bool {
+	_, ok := Known[name]
+	return ok
+}
+
+// Default returns the value of a flag that isn't set in the database
+func Default(name string) bool {
+	return Known[name].Default
+}
+
+// Loader loads the flags set in the database, keyed by name
+type Loader func(ctx context.Context) (map[string]bool, error)
+
+// DBLoader returns a loader reading the flags from the feature_flags table
+func DBLoader(exec boil.ContextExecutor) Loader {
+	return func(ctx context.Context) (map[string]bool, error) {
+		flags, err := models.FeatureFlags().All(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+
+		out := make(map[string]bool, len(flags))
+		for _, f := range flags {
+			out[f.Name] = f.Enabled
+		}
+
+		return out, nil
+	}
+}
+
+// Cache is an in-memory cache of the flags set in the database. It is
+// refreshed when the ttl expires, so flag changes made through another
+// instance are picked up within the ttl.
+type Cache struct {
+	mu      sync.RWMutex
+	flags   map[string]bool
+	expires time.Time
+	ttl     time.Duration
+	load    Loader
+	logger  *zap.Logger
+	now     func() time.Time
+	forced  map[string]bool
+}
+
+// Option is a functional configuration option for the flag cache
+type Option func(c *Cache)
+
+// New returns a new flag cache using the loader to read the flags
+func New(load Loader, opts ...Option) *Cache {
+	c := Cache{
+		flags:  map[string]bool{},
+		ttl:    defaultTTL,
+		load:   load,
+		logger: zap.NewNop(),
+		now:    time.Now,
+		forced: map[string]bool{},
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return &c
+}
+
+// WithTTL sets how long the flags are cached before being reloaded
+func WithTTL(ttl time.Duration) Option {
+	return func(c *Cache) {
+		c.ttl = ttl
+	}
+}
+
+// WithLogger sets the cache logger
+func WithLogger(l *zap.Logger) Option {
+	return func(c *Cache) {
+		if l != nil {
+			c.logger = l
+		}
+	}
+}
+
+// WithForced forces the flag on whatever is set in the database, for flags
+// set by the configuration
+func WithForced(name string) Option {
+	return func(c *Cache) {
+		c.forced[name] = true
+	}
+}
+
+// Forced returns true when the flag is forced on by the configuration
+func (c *Cache) Forced(name string) bool {
+	return c.forced[name]
+}
+
+// Enabled returns the value of the flag, or its default when it isn't set.
+// When the flags can't be reloaded the previously loaded values are used.
+func (c *Cache) Enabled(ctx context.Context, name string) bool {
+	if c.forced[name] {
+		return true
+	}
+
+	c.mu.RLock()
+	enabled, ok := c.flags[name]
+	stale := c.now().After(c.expires)
+	c.mu.RUnlock()
+
+	if stale {
+		enabled, ok = c.refresh(ctx, name)
+	}
+
+	if !ok {
+		return Default(name)
+	}
+
+	return enabled
+}
+
+// Invalidate drops the cached flags so they are reloaded on the next lookup
+func (c *Cache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expires = time.Time{}
+}
+
+func (c *Cache) refresh(ctx context.Context, name string) (bool, bool) {
+	flags, err := c.load(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err != nil {
+		c.logger.Warn("error loading feature flags, using cached values", zap.Error(err))
+	} else {
+		c.flags = flags
+	}
+
+	// don't retry a failed load on every lookup
+	c.expires = c.now().Add(c.ttl)
+
+	enabled, ok := c.flags[name]
+
+	return enabled, ok
+}
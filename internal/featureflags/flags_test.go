@@ -0,0 +1,78 @@
+package featureflags
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheEnabled(t *testing.T) {
+	stored := map[string]bool{"some-flag": true}
+	loads := 0
+
+	var loadErr error
+
+	c := New(func(_ context.Context) (map[string]bool, error) {
+		loads++
+
+		if loadErr != nil {
+			return nil, loadErr
+		}
+
+		out := map[string]bool{}
+		for k, v := range stored {
+			out[k] = v
+		}
+
+		return out, nil
+	}, WithTTL(time.Minute))
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+
+	ctx := context.TODO()
+
+	assert.True(t, c.Enabled(ctx, "some-flag"))
+	assert.True(t, c.Enabled(ctx, EnforceNamingPolicy), "unset flags use their default")
+	assert.False(t, c.Enabled(ctx, "unknown-flag"))
+	assert.Equal(t, 1, loads)
+
+	stored["some-flag"] = false
+	stored[EnforceNamingPolicy] = false
+
+	assert.True(t, c.Enabled(ctx, "some-flag"), "flags are cached until the ttl expires")
+
+	c.Invalidate()
+
+	assert.False(t, c.Enabled(ctx, "some-flag"))
+	assert.False(t, c.Enabled(ctx, EnforceNamingPolicy))
+	assert.Equal(t, 2, loads)
+
+	loadErr = errors.New("boom")
+	now = now.Add(2 * time.Minute)
+
+	assert.False(t, c.Enabled(ctx, EnforceNamingPolicy), "previous values are used when loading fails")
+	assert.Equal(t, 3, loads)
+	assert.False(t, c.Enabled(ctx, EnforceNamingPolicy))
+	assert.Equal(t, 3, loads, "failed loads aren't retried until the ttl expires")
+}
+
+func TestCacheForced(t *testing.T) {
+	loads := 0
+
+	c := New(func(_ context.Context) (map[string]bool, error) {
+		loads++
+		return map[string]bool{ReadOnly: false}, nil
+	}, WithForced(ReadOnly))
+
+	assert.True(t, c.Forced(ReadOnly))
+	assert.True(t, c.Enabled(context.TODO(), ReadOnly), "forced flags ignore the database")
+	assert.Equal(t, 0, loads)
+
+	assert.False(t, c.Forced(EnforceNamingPolicy))
+	assert.True(t, c.Enabled(context.TODO(), EnforceNamingPolicy))
+	assert.Equal(t, 1, loads)
+}
@@ -0,0 +1,270 @@
+package grpcapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/metal-toolbox/auditevent"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.hollow.sh/toolbox/ginauth"
+	"go.hollow.sh/toolbox/ginjwt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+	pb "github.com/metal-toolbox/governor-api/pkg/grpc/v1alpha1"
+)
+
+// methodScopes are the scopes accepted by each method, any one of them is
+// enough. Methods that are not listed are denied.
+var methodScopes = map[string][]string{
+	pb.Governor_GetUser_FullMethodName:                      ginjwt.ReadScopes("governor:users"),
+	pb.Governor_ListUsers_FullMethodName:                    ginjwt.ReadScopes("governor:users"),
+	pb.Governor_GetGroup_FullMethodName:                     ginjwt.ReadScopes("governor:groups"),
+	pb.Governor_ListGroups_FullMethodName:                   ginjwt.ReadScopes("governor:groups"),
+	pb.Governor_ListGroupMembers_FullMethodName:             ginjwt.ReadScopes("governor:groups"),
+	pb.Governor_AddGroupMember_FullMethodName:               ginjwt.CreateScopes("governor:groups"),
+	pb.Governor_RemoveGroupMember_FullMethodName:            ginjwt.DeleteScopes("governor:groups"),
+	pb.Governor_ListSystemExtensionResources_FullMethodName: ginjwt.ReadScopes("governor:extensionresources"),
+	pb.Governor_WatchEvents_FullMethodName:                  ginjwt.ReadScopes("governor:events"),
+}
+
+const (
+	// oidcScope is the scope of the user tokens, the actor of the calls made
+	// with them is the governor user of the token
+	oidcScope = "openid"
+
+	// auditComponent is the component of the call audit events, the same as
+	// the one of the http api request audit events
+	auditComponent = "governor-api"
+)
+
+type actorContextKey struct{}
+
+// authenticate verifies the bearer token in the request metadata against the
+// scopes of the method with the verifiers of the server, the first one
+// accepting the token authenticates the call. The verifiers are the same as
+// the http api ones so both accept the same tokens.
+func (s *Server) authenticate(ctx context.Context, method string) (ginauth.ClaimMetadata, error) {
+	scopes, ok := methodScopes[method]
+	if !ok {
+		return ginauth.ClaimMetadata{}, status.Error(codes.PermissionDenied, "method is not allowed")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	authorization := md.Get("authorization")
+	if len(authorization) == 0 {
+		return ginauth.ClaimMetadata{}, status.Error(codes.Unauthenticated, "missing authorization metadata")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, method, nil)
+	if err != nil {
+		return ginauth.ClaimMetadata{}, status.Error(codes.Internal, err.Error())
+	}
+
+	req.Header.Set("Authorization", authorization[0])
+
+	// the verifiers read the token from the request of a gin context
+	c := &gin.Context{Request: req}
+
+	err = status.Error(codes.Unauthenticated, "invalid token")
+
+	for _, v := range s.Verifiers {
+		cm, verr := v.VerifyTokenWithScopes(c, scopes)
+		if verr == nil {
+			return cm, nil
+		}
+
+		s.Logger.Debug("grpc token not verified", zap.String("method", method), zap.Error(verr))
+
+		var authErr *ginauth.AuthError
+		if errors.As(verr, &authErr) && authErr.HTTPErrorCode == http.StatusForbidden {
+			err = status.Error(codes.PermissionDenied, "token is missing the required scopes")
+		}
+	}
+
+	return ginauth.ClaimMetadata{}, err
+}
+
+// resolveActor returns the actor of a call. The actor of a user token is the
+// governor user of its subject, looked up the same way as the http api looks
+// up the user of a request, machine clients aren't governor users and only
+// the audit id is recorded for them.
+func (s *Server) resolveActor(ctx context.Context, auditID string, claims ginauth.ClaimMetadata) (service.Actor, error) {
+	actor := service.Actor{AuditID: auditID}
+
+	if !slices.Contains(claims.Roles, oidcScope) {
+		return actor, nil
+	}
+
+	if claims.User == "" {
+		return actor, status.Error(codes.Unauthenticated, "missing jwt user")
+	}
+
+	user, err := models.Users(
+		models.UserWhere.ExternalID.EQ(null.StringFrom(claims.User)),
+	).One(ctx, s.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// users are registered with their oidc userinfo on their first http api request
+			return actor, status.Error(codes.PermissionDenied, "user not found")
+		}
+
+		return actor, status.Error(codes.Internal, "error getting user: "+err.Error())
+	}
+
+	memberships, err := dbtools.GetMembershipsForUser(ctx, s.DB, user.ID, false)
+	if err != nil {
+		return actor, status.Error(codes.Internal, "error getting enumerated groups: "+err.Error())
+	}
+
+	ag := make([]interface{}, len(s.AdminGroups))
+	for i, a := range s.AdminGroups {
+		ag[i] = a
+	}
+
+	adminGroups, err := models.Groups(qm.WhereIn("slug IN ?", ag...), tenancy.Scope(ctx, models.TableNames.Groups)).All(ctx, s.DB)
+	if err != nil {
+		return actor, status.Error(codes.Internal, "error getting admin groups: "+err.Error())
+	}
+
+	for _, g := range adminGroups {
+		if slices.ContainsFunc(memberships, func(m dbtools.EnumeratedMembership) bool { return m.GroupID == g.ID }) {
+			actor.Admin = true
+			break
+		}
+	}
+
+	actor.User = user
+
+	return actor, nil
+}
+
+// authorize authenticates the call and returns its context, scoped to the
+// organization of the token and carrying the actor of the call
+func (s *Server) authorize(ctx context.Context, method, auditID string) (context.Context, ginauth.ClaimMetadata, error) {
+	claims, err := s.authenticate(ctx, method)
+	if err != nil {
+		return nil, claims, err
+	}
+
+	s.Logger.Debug("grpc request",
+		zap.String("method", method),
+		zap.String("subject", claims.Subject),
+	)
+
+	if s.Tenancy != nil {
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		orgID, err := s.Tenancy.Resolve(ctx, strings.TrimPrefix(md.Get("authorization")[0], "Bearer "))
+		if err != nil {
+			return nil, claims, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		if orgID != "" {
+			ctx = tenancy.WithTenant(ctx, orgID)
+		}
+	}
+
+	actor, err := s.resolveActor(ctx, auditID, claims)
+	if err != nil {
+		return nil, claims, err
+	}
+
+	return context.WithValue(ctx, actorContextKey{}, actor), claims, nil
+}
+
+// audit writes the audit event of a call, it is the parent of the audit
+// events of the changes made by the call like the request audit event of the
+// http api. The calls aren't audited when the server has no audit writer.
+func (s *Server) audit(ctx context.Context, auditID, method string, claims ginauth.ClaimMetadata, err error) {
+	if s.AuditLogWriter == nil {
+		return
+	}
+
+	source := auditevent.EventSource{Type: "IP"}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		source.Value = p.Addr.String()
+	}
+
+	outcome := auditevent.OutcomeSucceeded
+
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.Unauthenticated, codes.PermissionDenied:
+		outcome = auditevent.OutcomeDenied
+	default:
+		outcome = auditevent.OutcomeFailed
+	}
+
+	subjects := map[string]string{"sub": claims.Subject, "user": claims.User}
+	for k, v := range subjects {
+		if v == "" {
+			subjects[k] = "Unknown"
+		}
+	}
+
+	event := auditevent.NewAuditEventWithID(auditID, method, source, outcome, subjects, auditComponent).
+		WithTarget(map[string]string{"method": method})
+
+	if err := s.AuditLogWriter.Write(event); err != nil {
+		s.Logger.Error("error writing grpc audit event", zap.String("method", method), zap.Error(err))
+	}
+}
+
+// actorFromContext returns the actor set by the auth interceptors
+func actorFromContext(ctx context.Context) service.Actor {
+	actor, _ := ctx.Value(actorContextKey{}).(service.Actor)
+	return actor
+}
+
+func (s *Server) unaryAuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	auditID := uuid.New().String()
+
+	authCtx, claims, err := s.authorize(ctx, info.FullMethod, auditID)
+
+	var resp any
+	if err == nil {
+		resp, err = handler(authCtx, req)
+	}
+
+	s.audit(ctx, auditID, info.FullMethod, claims, err)
+
+	return resp, err
+}
+
+// authorizedStream overrides the stream context with the authorized context
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context { return s.ctx }
+
+func (s *Server) streamAuthInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	auditID := uuid.New().String()
+
+	ctx, claims, err := s.authorize(ss.Context(), info.FullMethod, auditID)
+	if err == nil {
+		err = handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+	}
+
+	s.audit(ss.Context(), auditID, info.FullMethod, claims, err)
+
+	return err
+}
@@ -0,0 +1,102 @@
+package grpcapi
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	pb "github.com/metal-toolbox/governor-api/pkg/grpc/v1alpha1"
+)
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	return timestamppb.New(t)
+}
+
+func nullTimestamp(t null.Time) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+
+	return timestamppb.New(t.Time)
+}
+
+func nullTime(t *timestamppb.Timestamp) null.Time {
+	if t == nil {
+		return null.Time{}
+	}
+
+	return null.TimeFrom(t.AsTime())
+}
+
+func userToProto(u *models.User) *pb.User {
+	return &pb.User{
+		Id:         u.ID,
+		ExternalId: u.ExternalID.String,
+		Name:       u.Name,
+		Email:      u.Email,
+		AvatarUrl:  u.AvatarURL.String,
+		Status:     u.Status.String,
+		CreatedAt:  timestamp(u.CreatedAt),
+		UpdatedAt:  timestamp(u.UpdatedAt),
+		DeletedAt:  nullTimestamp(u.DeletedAt),
+	}
+}
+
+func groupToProto(g *models.Group) *pb.Group {
+	return &pb.Group{
+		Id:            g.ID,
+		Name:          g.Name,
+		Slug:          g.Slug,
+		Description:   g.Description,
+		Note:          g.Note,
+		ApproverGroup: g.ApproverGroup.String,
+		OwnerContact:  g.OwnerContact,
+		DocsUrl:       g.DocsURL,
+		SlackChannel:  g.SlackChannel,
+		CostCenter:    g.CostCenter,
+		CreatedAt:     timestamp(g.CreatedAt),
+		UpdatedAt:     timestamp(g.UpdatedAt),
+		DeletedAt:     nullTimestamp(g.DeletedAt),
+	}
+}
+
+func groupMemberToProto(m dbtools.EnumeratedMembership) *pb.GroupMember {
+	return &pb.GroupMember{
+		Id:             m.User.ID,
+		Name:           m.User.Name,
+		Email:          m.User.Email,
+		AvatarUrl:      m.User.AvatarURL.String,
+		Status:         m.User.Status.String,
+		IsAdmin:        m.IsAdmin,
+		ExpiresAt:      nullTimestamp(m.ExpiresAt),
+		AdminExpiresAt: nullTimestamp(m.AdminExpiresAt),
+		Direct:         m.Direct,
+	}
+}
+
+func systemExtensionResourceToProto(r *models.SystemExtensionResource) (*pb.SystemExtensionResource, error) {
+	fields := map[string]any{}
+
+	if err := json.Unmarshal(r.Resource, &fields); err != nil {
+		return nil, err
+	}
+
+	resource, err := structpb.NewStruct(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SystemExtensionResource{
+		Id:                            r.ID,
+		ExtensionResourceDefinitionId: r.ExtensionResourceDefinitionID,
+		Resource:                      resource,
+		CreatedAt:                     timestamp(r.CreatedAt),
+		UpdatedAt:                     timestamp(r.UpdatedAt),
+		DeletedAt:                     nullTimestamp(r.DeletedAt),
+	}, nil
+}
@@ -0,0 +1,3 @@
+// Package grpcapi provides a grpc server for governor's machine consumers. It
+// runs alongside the http api and is backed by the same service layer.
+package grpcapi
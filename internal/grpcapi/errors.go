@@ -0,0 +1,73 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
+)
+
+// eventsHeader is set on the responses of the changes whose events weren't
+// published right away, like the X-Governor-Events header of the http api
+const eventsHeader = "x-governor-events"
+
+// errorCodes maps the service layer error values to grpc status codes
+var errorCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{service.ErrGroupNotFound, codes.NotFound},
+	{service.ErrUserNotFound, codes.NotFound},
+	{service.ErrMembershipNotFound, codes.NotFound},
+	{service.ErrRequestNotFound, codes.NotFound},
+	{service.ErrExtensionNotFound, codes.NotFound},
+	{service.ErrERDNotFound, codes.NotFound},
+	{service.ErrUserAlreadyMember, codes.AlreadyExists},
+	{service.ErrUserAlreadyAdmin, codes.AlreadyExists},
+	{service.ErrExtensionDisabled, codes.FailedPrecondition},
+	{service.ErrGetDeletedBySlug, codes.InvalidArgument},
+	{service.ErrMembershipDurationExceeded, codes.FailedPrecondition},
+	{service.ErrGroupFrozen, codes.FailedPrecondition},
+	{service.ErrGroupProtected, codes.FailedPrecondition},
+	{service.ErrGroupMemberLimitReached, codes.ResourceExhausted},
+	{service.ErrERDScopeMismatch, codes.InvalidArgument},
+}
+
+// toStatus converts a service layer error into a grpc status error
+func toStatus(err error) error {
+	for _, ec := range errorCodes {
+		if errors.Is(err, ec.err) {
+			return status.Error(ec.code, err.Error())
+		}
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+// changeStatus converts the error of a service layer change into a grpc status
+// error. The change was committed when only publishing its events failed, nil
+// is returned then and the events header tells whether they were queued.
+func changeStatus(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if !errors.Is(err, service.ErrEventNotPublished) {
+		return toStatus(err)
+	}
+
+	events := "failed"
+	if errors.Is(err, eventbus.ErrQueued) {
+		events = "queued"
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(eventsHeader, events))
+
+	return nil
+}
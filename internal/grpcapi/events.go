@@ -0,0 +1,124 @@
+package grpcapi
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/metal-toolbox/governor-api/internal/eventbus"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+	eventsv2 "github.com/metal-toolbox/governor-api/pkg/events/v2"
+	pb "github.com/metal-toolbox/governor-api/pkg/grpc/v1alpha1"
+)
+
+// watchEventsBufferSize is the number of events buffered per stream before
+// new events are dropped
+const watchEventsBufferSize = 256
+
+// WatchEvents streams the events published on the event bus until the client
+// cancels the stream
+func (s *Server) WatchEvents(req *pb.WatchEventsRequest, stream pb.Governor_WatchEventsServer) error {
+	subjects := req.GetSubjects()
+	if len(subjects) == 0 {
+		subjects = []string{">"}
+	}
+
+	msgs := make(chan *pb.Event, watchEventsBufferSize)
+
+	handler := func(m *nats.Msg) {
+		subject := s.EventBus.TrimPrefix(m.Subject)
+
+		// the stream carries v1alpha1 events, skip their v2 copies
+		if strings.HasPrefix(subject, eventsv2.SubjectToken+".") {
+			return
+		}
+
+		event := &events.Event{}
+		if err := json.Unmarshal(m.Data, event); err != nil {
+			s.Logger.Warn("failed to unmarshal event", zap.String("subject", m.Subject), zap.Error(err))
+			return
+		}
+
+		select {
+		case msgs <- eventToProto(subject, event):
+		default:
+			s.Logger.Warn("event stream buffer full, dropping event", zap.String("subject", m.Subject))
+		}
+	}
+
+	for _, sub := range subjects {
+		unsubscribe, err := s.EventBus.Subscribe(sub, handler)
+		if err != nil {
+			if errors.Is(err, eventbus.ErrSubscribeNotSupported) {
+				return status.Error(codes.Unimplemented, err.Error())
+			}
+
+			return status.Errorf(codes.Internal, "error subscribing to events: %s", err)
+		}
+
+		defer func() {
+			if err := unsubscribe(); err != nil {
+				s.Logger.Warn("failed to unsubscribe from events", zap.Error(err))
+			}
+		}()
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-msgs:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func eventToProto(subject string, e *events.Event) *pb.Event {
+	return &pb.Event{
+		Subject:                       subject,
+		Version:                       e.Version,
+		Action:                        e.Action,
+		AuditId:                       e.AuditID,
+		GroupId:                       e.GroupID,
+		UserId:                        e.UserID,
+		ActorId:                       e.ActorID,
+		ApplicationId:                 e.ApplicationID,
+		ApplicationTypeId:             e.ApplicationTypeID,
+		NotificationTypeId:            e.NotificationTypeID,
+		NotificationTargetId:          e.NotificationTargetID,
+		ExtensionId:                   e.ExtensionID,
+		ExtensionResourceDefinitionId: e.ExtensionResourceDefinitionID,
+		ExtensionResourceId:           e.ExtensionResourceID,
+		RequestId:                     e.RequestID,
+		RequestCommentId:              e.RequestCommentID,
+		Member:                        memberToProto(e.Member),
+	}
+}
+
+func memberToProto(m *events.Member) *pb.Member {
+	if m == nil {
+		return nil
+	}
+
+	member := &pb.Member{
+		IsAdmin: m.IsAdmin,
+		Direct:  m.Direct,
+	}
+
+	if m.ExpiresAt != nil {
+		member.ExpiresAt = timestamp(*m.ExpiresAt)
+	}
+
+	if m.AdminExpiresAt != nil {
+		member.AdminExpiresAt = timestamp(*m.AdminExpiresAt)
+	}
+
+	return member
+}
@@ -0,0 +1,37 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/metal-toolbox/governor-api/pkg/grpc/v1alpha1"
+)
+
+// ListSystemExtensionResources returns the resources of a system scoped extension resource definition
+func (s *Server) ListSystemExtensionResources(
+	ctx context.Context, req *pb.ListSystemExtensionResourcesRequest,
+) (*pb.ListSystemExtensionResourcesResponse, error) {
+	resources, err := s.Service.ListSystemExtensionResources(
+		ctx,
+		req.GetExtensionSlug(), req.GetErdSlugPlural(), req.GetErdVersion(),
+		req.GetFilters(), req.GetDeleted(),
+	)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ListSystemExtensionResourcesResponse{
+		Resources: make([]*pb.SystemExtensionResource, len(resources)),
+	}
+
+	for i, r := range resources {
+		resp.Resources[i], err = systemExtensionResourceToProto(r)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error converting resource %s: %s", r.ID, err)
+		}
+	}
+
+	return resp, nil
+}
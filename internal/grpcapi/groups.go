@@ -0,0 +1,119 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/metal-toolbox/governor-api/internal/service"
+	pb "github.com/metal-toolbox/governor-api/pkg/grpc/v1alpha1"
+)
+
+const (
+	// defaultPageSize is the number of records returned when no page size is requested
+	defaultPageSize = 100
+	// maxPageSize is the maximum number of records returned per page
+	maxPageSize = 1000
+)
+
+// pageSize returns the page size to use for a requested page size
+func pageSize(requested int32) int {
+	switch {
+	case requested <= 0:
+		return defaultPageSize
+	case requested > maxPageSize:
+		return maxPageSize
+	default:
+		return int(requested)
+	}
+}
+
+// GetGroup returns a group with its members, membership requests, organizations and applications
+func (s *Server) GetGroup(ctx context.Context, req *pb.GetGroupRequest) (*pb.Group, error) {
+	details, err := s.Service.GetGroup(ctx, req.GetId(), req.GetDeleted())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	group := groupToProto(details.Group)
+	group.Members = details.Members
+	group.MembersDirect = details.MembersDirect
+	group.MembershipRequests = details.MembershipRequests
+	group.Organizations = details.Organizations
+	group.Applications = details.Applications
+
+	return group, nil
+}
+
+// ListGroups returns a page of groups ordered by id
+func (s *Server) ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsResponse, error) {
+	limit := pageSize(req.GetPageSize())
+
+	mods := []qm.QueryMod{qm.OrderBy("id asc"), qm.Limit(limit + 1)}
+
+	if req.GetPageToken() != "" {
+		mods = append(mods, qm.Where("id > ?", req.GetPageToken()))
+	}
+
+	groups, err := s.Service.ListGroups(ctx, req.GetDeleted(), mods...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ListGroupsResponse{}
+
+	if len(groups) > limit {
+		groups = groups[:limit]
+		resp.NextPageToken = groups[limit-1].ID
+	}
+
+	resp.Groups = make([]*pb.Group, len(groups))
+	for i, g := range groups {
+		resp.Groups[i] = groupToProto(g)
+	}
+
+	return resp, nil
+}
+
+// ListGroupMembers returns the direct and indirect members of a group
+func (s *Server) ListGroupMembers(ctx context.Context, req *pb.ListGroupMembersRequest) (*pb.ListGroupMembersResponse, error) {
+	_, members, err := s.Service.ListGroupMembers(ctx, req.GetGroupId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ListGroupMembersResponse{
+		Members: make([]*pb.GroupMember, len(members)),
+	}
+
+	for i, m := range members {
+		resp.Members[i] = groupMemberToProto(m)
+	}
+
+	return resp, nil
+}
+
+// AddGroupMember adds a user as a direct member of a group
+func (s *Server) AddGroupMember(ctx context.Context, req *pb.AddGroupMemberRequest) (*emptypb.Empty, error) {
+	_, err := s.Service.AddMember(ctx, actorFromContext(ctx), req.GetGroupId(), req.GetUserId(), service.AddMemberParams{
+		IsAdmin:        req.GetIsAdmin(),
+		ExpiresAt:      nullTime(req.GetExpiresAt()),
+		AdminExpiresAt: nullTime(req.GetAdminExpiresAt()),
+	})
+	if err := changeStatus(ctx, err); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveGroupMember removes a user's direct membership from a group
+func (s *Server) RemoveGroupMember(ctx context.Context, req *pb.RemoveGroupMemberRequest) (*emptypb.Empty, error) {
+	_, err := s.Service.RemoveMember(ctx, actorFromContext(ctx), req.GetGroupId(), req.GetUserId())
+	if err := changeStatus(ctx, err); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,249 @@
+package grpcapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/auditevent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.hollow.sh/toolbox/ginauth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/metal-toolbox/governor-api/internal/featureflags"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+	pb "github.com/metal-toolbox/governor-api/pkg/grpc/v1alpha1"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"not found", service.ErrGroupNotFound, codes.NotFound},
+		{"wrapped not found", fmt.Errorf("lookup: %w", service.ErrUserNotFound), codes.NotFound},
+		{"already exists", service.ErrUserAlreadyMember, codes.AlreadyExists},
+		{"disabled", service.ErrExtensionDisabled, codes.FailedPrecondition},
+		{"unknown", assert.AnError, codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, status.Code(toStatus(tt.err)))
+		})
+	}
+}
+
+func TestChangeStatus(t *testing.T) {
+	queued := fmt.Errorf("%w: %w", service.ErrEventNotPublished, eventbus.ErrQueued)
+
+	assert.NoError(t, changeStatus(context.Background(), nil))
+	assert.NoError(t, changeStatus(context.Background(), queued))
+	assert.NoError(t, changeStatus(context.Background(), fmt.Errorf("%w: %w", service.ErrEventNotPublished, assert.AnError)))
+	assert.Equal(t, codes.AlreadyExists, status.Code(changeStatus(context.Background(), service.ErrUserAlreadyMember)))
+}
+
+func TestPageSize(t *testing.T) {
+	assert.Equal(t, defaultPageSize, pageSize(0))
+	assert.Equal(t, defaultPageSize, pageSize(-1))
+	assert.Equal(t, 10, pageSize(10))
+	assert.Equal(t, maxPageSize, pageSize(maxPageSize+1))
+}
+
+// fakeVerifier accepts the tokens when it has claims and rejects them otherwise
+type fakeVerifier struct {
+	claims *ginauth.ClaimMetadata
+	scopes []string
+}
+
+func (f *fakeVerifier) VerifyTokenWithScopes(c *gin.Context, scopes []string) (ginauth.ClaimMetadata, error) {
+	f.scopes = scopes
+
+	if f.claims == nil || c.Request.Header.Get("Authorization") != "Bearer token" {
+		return ginauth.ClaimMetadata{}, assert.AnError
+	}
+
+	return *f.claims, nil
+}
+
+func (f *fakeVerifier) SetMetadata(_ *gin.Context, _ ginauth.ClaimMetadata) {}
+
+func TestAuthorize(t *testing.T) {
+	s := &Server{Logger: zap.NewNop()}
+
+	_, _, err := s.authorize(context.Background(), "/governor.v1alpha1.Governor/Unknown", "audit-id")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, _, err = s.authorize(context.Background(), pb.Governor_GetUser_FullMethodName, "audit-id")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+
+	s.Verifiers = []ginauth.GenericAuthMiddleware{&fakeVerifier{}}
+
+	_, _, err = s.authorize(ctx, pb.Governor_GetUser_FullMethodName, "audit-id")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// the first verifier accepting the token authenticates the call
+	verifier := &fakeVerifier{claims: &ginauth.ClaimMetadata{Subject: "workload", Roles: []string{"read:governor:users"}}}
+	s.Verifiers = append(s.Verifiers, verifier)
+
+	authCtx, claims, err := s.authorize(ctx, pb.Governor_GetUser_FullMethodName, "audit-id")
+	require.NoError(t, err)
+	assert.Equal(t, "workload", claims.Subject)
+	assert.Equal(t, methodScopes[pb.Governor_GetUser_FullMethodName], verifier.scopes)
+
+	// machine clients aren't governor users
+	actor := actorFromContext(authCtx)
+	assert.Equal(t, "audit-id", actor.AuditID)
+	assert.Nil(t, actor.User)
+	assert.False(t, actor.Admin)
+}
+
+func TestUnaryAuthInterceptorAudit(t *testing.T) {
+	var buf bytes.Buffer
+
+	s := &Server{
+		AuditLogWriter: auditevent.NewDefaultAuditEventWriter(&buf),
+		Logger:         zap.NewNop(),
+		Verifiers: []ginauth.GenericAuthMiddleware{
+			&fakeVerifier{claims: &ginauth.ClaimMetadata{Subject: "workload", Roles: []string{"read:governor:groups"}}},
+		},
+	}
+
+	var auditID string
+
+	handler := func(ctx context.Context, _ any) (any, error) {
+		auditID = actorFromContext(ctx).AuditID
+		return "ok", nil
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: pb.Governor_GetGroup_FullMethodName}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+
+	resp, err := s.unaryAuthInterceptor(ctx, nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = s.unaryAuthInterceptor(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	dec := json.NewDecoder(&buf)
+
+	// the actor audit id is the id of the call audit event
+	var event auditevent.AuditEvent
+	require.NoError(t, dec.Decode(&event))
+	assert.Equal(t, auditID, event.Metadata.AuditID)
+	assert.Equal(t, pb.Governor_GetGroup_FullMethodName, event.Type)
+	assert.Equal(t, auditevent.OutcomeSucceeded, event.Outcome)
+	assert.Equal(t, map[string]string{"sub": "workload", "user": "Unknown"}, event.Subjects)
+
+	require.NoError(t, dec.Decode(&event))
+	assert.NotEqual(t, auditID, event.Metadata.AuditID)
+	assert.Equal(t, auditevent.OutcomeDenied, event.Outcome)
+	assert.Equal(t, map[string]string{"sub": "Unknown", "user": "Unknown"}, event.Subjects)
+}
+
+func TestUnaryReadOnlyInterceptor(t *testing.T) {
+	s := &Server{
+		FeatureFlags: featureflags.New(func(_ context.Context) (map[string]bool, error) {
+			return map[string]bool{}, nil
+		}, featureflags.WithForced(featureflags.ReadOnly)),
+	}
+
+	handler := func(_ context.Context, _ any) (any, error) { return "ok", nil }
+
+	_, err := s.unaryReadOnlyInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: pb.Governor_AddGroupMember_FullMethodName}, handler)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	resp, err := s.unaryReadOnlyInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: pb.Governor_GetGroup_FullMethodName}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
+
+func TestEventToProto(t *testing.T) {
+	got := eventToProto("members", &events.Event{
+		Version: events.Version,
+		Action:  events.GovernorEventCreate,
+		GroupID: "group-id",
+		UserID:  "user-id",
+	})
+
+	assert.Equal(t, "members", got.GetSubject())
+	assert.Equal(t, events.GovernorEventCreate, got.GetAction())
+	assert.Equal(t, "group-id", got.GetGroupId())
+	assert.Equal(t, "user-id", got.GetUserId())
+	assert.Nil(t, got.GetMember())
+
+	expires := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	got = eventToProto("members", &events.Event{
+		Version: events.MemberVersion,
+		Action:  events.GovernorEventUpdate,
+		GroupID: "group-id",
+		UserID:  "user-id",
+		Member:  &events.Member{IsAdmin: true, ExpiresAt: &expires, Direct: true},
+	})
+
+	assert.Equal(t, events.MemberVersion, got.GetVersion())
+	assert.True(t, got.GetMember().GetIsAdmin())
+	assert.True(t, got.GetMember().GetDirect())
+	assert.Equal(t, expires, got.GetMember().GetExpiresAt().AsTime())
+	assert.Nil(t, got.GetMember().GetAdminExpiresAt())
+}
+
+// watchEventsStream is a WatchEvents stream collecting the sent events
+type watchEventsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.Event
+}
+
+func (s *watchEventsStream) Context() context.Context { return s.ctx }
+
+func (s *watchEventsStream) Send(e *pb.Event) error {
+	s.sent <- e
+	return nil
+}
+
+func TestWatchEvents(t *testing.T) {
+	bus := eventbus.NewMemory()
+	s := &Server{EventBus: bus, Logger: zap.NewNop()}
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	stream := &watchEventsStream{ctx: ctx, sent: make(chan *pb.Event, 1)}
+
+	done := make(chan error)
+
+	go func() {
+		done <- s.WatchEvents(&pb.WatchEventsRequest{Subjects: []string{events.GovernorMembersEventSubject}}, stream)
+	}()
+
+	// events are published until the stream has subscribed
+	assert.Eventually(t, func() bool {
+		_ = bus.Publish(context.TODO(), events.GovernorMembersEventSubject, &events.Event{Action: events.GovernorEventCreate, UserID: "user-id"})
+
+		select {
+		case e := <-stream.sent:
+			return e.GetSubject() == events.GovernorMembersEventSubject && e.GetUserId() == "user-id"
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
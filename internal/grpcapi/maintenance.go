@@ -0,0 +1,28 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/metal-toolbox/governor-api/internal/featureflags"
+	pb "github.com/metal-toolbox/governor-api/pkg/grpc/v1alpha1"
+)
+
+// mutatingMethods are the methods rejected in read-only maintenance mode
+var mutatingMethods = map[string]bool{
+	pb.Governor_AddGroupMember_FullMethodName:    true,
+	pb.Governor_RemoveGroupMember_FullMethodName: true,
+}
+
+// unaryReadOnlyInterceptor rejects the mutating calls while the api is in
+// read-only maintenance mode
+func (s *Server) unaryReadOnlyInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if mutatingMethods[info.FullMethod] && s.FeatureFlags != nil && s.FeatureFlags.Enabled(ctx, featureflags.ReadOnly) {
+		return nil, status.Error(codes.Unavailable, "governor is in read-only maintenance mode, try again later")
+	}
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,124 @@
+package grpcapi
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/metal-toolbox/auditevent"
+	"go.hollow.sh/toolbox/ginauth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/metal-toolbox/governor-api/internal/featureflags"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
+	pb "github.com/metal-toolbox/governor-api/pkg/grpc/v1alpha1"
+)
+
+// Server implements the governor grpc api
+type Server struct {
+	pb.UnimplementedGovernorServer
+
+	// AdminGroups are the slugs of the groups whose members are governor admins
+	AdminGroups []string
+	// AuditLogWriter writes the audit event of each call, the calls aren't
+	// audited when it is nil
+	AuditLogWriter *auditevent.EventWriter
+	DB             *sqlx.DB
+	EventBus       eventbus.EventBus
+	// FeatureFlags are the runtime flags, the mutating calls are rejected
+	// when the read-only flag is on
+	FeatureFlags *featureflags.Cache
+	Listen       string
+	Logger       *zap.Logger
+	Service      *service.Service
+	Tenancy      *tenancy.Resolver
+	// TLSConfig makes the server accept TLS connections only when set
+	TLSConfig *tls.Config
+	// Verifiers verify the bearer tokens of the calls, the first one accepting
+	// a token authenticates the call
+	Verifiers []ginauth.GenericAuthMiddleware
+	// ShutdownTimeout is how long the in-flight calls are given to finish on
+	// shutdown before they are canceled, they aren't canceled when it is 0
+	ShutdownTimeout time.Duration
+}
+
+// NewGRPCServer returns a grpc server with the governor service and the auth
+// interceptors registered
+func (s *Server) NewGRPCServer() *grpc.Server {
+	if s.Logger == nil {
+		s.Logger = zap.NewNop()
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(s.unaryAuthInterceptor, s.unaryReadOnlyInterceptor),
+		grpc.ChainStreamInterceptor(s.streamAuthInterceptor),
+	}
+
+	if s.TLSConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.TLSConfig)))
+	}
+
+	gs := grpc.NewServer(opts...)
+
+	pb.RegisterGovernorServer(gs, s)
+
+	return gs
+}
+
+// Run will start the server listening on the specified address, when the
+// context is canceled the server stops accepting calls and waits for the
+// in-flight ones
+func (s *Server) Run(ctx context.Context) error {
+	lis, err := net.Listen("tcp", s.Listen)
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Info("starting grpc server", zap.String("address", s.Listen), zap.Bool("tls", s.TLSConfig != nil))
+
+	gs := s.NewGRPCServer()
+
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- gs.Serve(lis)
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	stopped := make(chan struct{})
+
+	go func() {
+		gs.GracefulStop()
+		close(stopped)
+	}()
+
+	if s.ShutdownTimeout > 0 {
+		timer := time.NewTimer(s.ShutdownTimeout)
+		defer timer.Stop()
+
+		select {
+		case <-stopped:
+		case <-timer.C:
+			s.Logger.Warn("grpc calls didn't finish before the shutdown timeout")
+
+			gs.Stop()
+		}
+	}
+
+	<-stopped
+
+	s.Logger.Info("grpc server stopped")
+
+	return <-errs
+}
@@ -0,0 +1,54 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	pb "github.com/metal-toolbox/governor-api/pkg/grpc/v1alpha1"
+)
+
+// GetUser returns a user and their group memberships
+func (s *Server) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	details, err := s.Service.GetUser(ctx, req.GetId(), req.GetDeleted())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	user := userToProto(details.User)
+	user.Memberships = details.Memberships
+	user.MembershipsDirect = details.MembershipsDirect
+	user.MembershipRequests = details.MembershipRequests
+
+	return user, nil
+}
+
+// ListUsers returns a page of users ordered by id
+func (s *Server) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	limit := pageSize(req.GetPageSize())
+
+	mods := []qm.QueryMod{qm.OrderBy("id asc"), qm.Limit(limit + 1)}
+
+	if req.GetPageToken() != "" {
+		mods = append(mods, qm.Where("id > ?", req.GetPageToken()))
+	}
+
+	users, err := s.Service.ListUsers(ctx, req.GetDeleted(), mods...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ListUsersResponse{}
+
+	if len(users) > limit {
+		users = users[:limit]
+		resp.NextPageToken = users[limit-1].ID
+	}
+
+	resp.Users = make([]*pb.User, len(users))
+	for i, u := range users {
+		resp.Users[i] = userToProto(u)
+	}
+
+	return resp, nil
+}
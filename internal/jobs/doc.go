@@ -0,0 +1,5 @@
+// Package jobs runs long-running work, like bulk imports or purges, outside
+// of the api request. Jobs are stored in the jobs table so any api instance
+// can pick them up, and a pool of workers runs them with the handler
+// registered for their kind, recording their progress and result.
+package jobs
@@ -0,0 +1,431 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const (
+	// StatusPending is the status of a job waiting for a worker
+	StatusPending = "pending"
+	// StatusRunning is the status of a job being run by a worker
+	StatusRunning = "running"
+	// StatusSucceeded is the status of a job that finished without error
+	StatusSucceeded = "succeeded"
+	// StatusFailed is the status of a job that returned an error or was abandoned
+	StatusFailed = "failed"
+
+	defaultWorkers      = 2
+	defaultPollInterval = 10 * time.Second
+	defaultStaleAfter   = 15 * time.Minute
+
+	// heartbeatsPerStale is how many times a running job is refreshed within the stale duration
+	heartbeatsPerStale = 3
+)
+
+var (
+	// ErrUnknownKind is returned when enqueuing a job without a registered handler
+	ErrUnknownKind = errors.New("unknown job kind")
+	// ErrAbandoned is the error of a running job that stopped reporting, usually
+	// because the api instance running it went away
+	ErrAbandoned = errors.New("job was abandoned by its worker")
+	// ErrPanicked is the error of a job whose handler panicked
+	ErrPanicked = errors.New("job panicked")
+)
+
+// Progress records how many of the total items of a job were processed
+type Progress func(ctx context.Context, processed, total int64) error
+
+// Handler runs a job and returns its result, which is stored as json
+type Handler func(ctx context.Context, job *models.Job, progress Progress) (interface{}, error)
+
+// Pool is a pool of workers running the jobs stored in the database
+type Pool struct {
+	db           boil.ContextExecutor
+	handlers     map[string]Handler
+	logger       *zap.Logger
+	pollInterval time.Duration
+	staleAfter   time.Duration
+	wake         chan struct{}
+	workers      int
+
+	mu sync.RWMutex
+}
+
+// Option is a functional configuration option for the job pool
+type Option func(p *Pool)
+
+// New returns a job pool running the jobs stored in db
+func New(db boil.ContextExecutor, opts ...Option) *Pool {
+	p := Pool{
+		db:           db,
+		handlers:     map[string]Handler{},
+		logger:       zap.NewNop(),
+		pollInterval: defaultPollInterval,
+		staleAfter:   defaultStaleAfter,
+		wake:         make(chan struct{}, 1),
+		workers:      defaultWorkers,
+	}
+
+	for _, opt := range opts {
+		opt(&p)
+	}
+
+	return &p
+}
+
+// WithWorkers sets the number of jobs run at the same time
+func WithWorkers(n int) Option {
+	return func(p *Pool) {
+		if n > 0 {
+			p.workers = n
+		}
+	}
+}
+
+// WithPollInterval sets how often the workers look for pending jobs enqueued
+// by other api instances
+func WithPollInterval(d time.Duration) Option {
+	return func(p *Pool) {
+		p.pollInterval = d
+	}
+}
+
+// WithStaleAfter sets how long a running job can go without reporting before
+// it is marked as failed
+func WithStaleAfter(d time.Duration) Option {
+	return func(p *Pool) {
+		p.staleAfter = d
+	}
+}
+
+// WithLogger sets the job pool logger
+func WithLogger(l *zap.Logger) Option {
+	return func(p *Pool) {
+		if l != nil {
+			p.logger = l
+		}
+	}
+}
+
+// Register sets the handler running the jobs of a kind
+func (p *Pool) Register(kind string, h Handler) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.handlers[kind] = h
+}
+
+// Kinds returns the job kinds with a registered handler
+func (p *Pool) Kinds() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	kinds := make([]string, 0, len(p.handlers))
+	for k := range p.handlers {
+		kinds = append(kinds, k)
+	}
+
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+func (p *Pool) handler(kind string) (Handler, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	h, ok := p.handlers[kind]
+
+	return h, ok
+}
+
+// Enqueue stores a pending job of the kind with its params and audits it.
+// The job is only visible to the workers once exec is committed, Wake can be
+// called then so it doesn't wait for the next poll.
+func (p *Pool) Enqueue(ctx context.Context, exec boil.ContextExecutor, auditID string, actor *models.User, kind string, params interface{}) (*models.Job, *models.AuditEvent, error) {
+	if _, ok := p.handler(kind); !ok {
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnknownKind, kind)
+	}
+
+	if params == nil {
+		params = struct{}{}
+	}
+
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error encoding job params: %w", err)
+	}
+
+	job := &models.Job{
+		Kind:    kind,
+		Status:  StatusPending,
+		Params:  raw,
+		AuditID: null.NewString(auditID, auditID != ""),
+	}
+
+	if actor != nil {
+		job.CreatedBy = null.StringFrom(actor.ID)
+	}
+
+	if err := job.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, nil, fmt.Errorf("error creating job: %w", err)
+	}
+
+	event, err := dbtools.AuditJobCreated(ctx, exec, auditID, actor, job)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating job (audit): %w", err)
+	}
+
+	return job, event, nil
+}
+
+// Wake makes an idle worker look for pending jobs right away
+func (p *Pool) Wake() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run starts the workers and blocks until the context is canceled
+func (p *Pool) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < p.workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			p.work(ctx)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (p *Pool) work(ctx context.Context) {
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.failStale(ctx); err != nil {
+			p.logger.Warn("error failing abandoned jobs", zap.Error(err))
+		}
+
+		// run jobs until there are none left before waiting
+		for ctx.Err() == nil {
+			job, err := p.claim(ctx)
+			if err != nil {
+				p.logger.Warn("error claiming job", zap.Error(err))
+				break
+			}
+
+			if job == nil {
+				break
+			}
+
+			p.run(ctx, job)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-p.wake:
+		}
+	}
+}
+
+// claim marks the oldest pending job with a handler as running and returns
+// it, or nil when there is none. Another instance may claim the same job
+// first, in which case the next one is tried.
+func (p *Pool) claim(ctx context.Context) (*models.Job, error) {
+	kinds := p.Kinds()
+	if len(kinds) == 0 {
+		return nil, nil
+	}
+
+	kindArgs := make([]interface{}, len(kinds))
+	for i, k := range kinds {
+		kindArgs[i] = k
+	}
+
+	for {
+		job, err := models.Jobs(
+			models.JobWhere.Status.EQ(StatusPending),
+			qm.WhereIn("kind IN ?", kindArgs...),
+			qm.OrderBy("created_at"),
+		).One(ctx, p.db)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		now := time.Now()
+
+		n, err := models.Jobs(
+			models.JobWhere.ID.EQ(job.ID),
+			models.JobWhere.Status.EQ(StatusPending),
+		).UpdateAll(ctx, p.db, models.M{
+			models.JobColumns.Status:    StatusRunning,
+			models.JobColumns.StartedAt: null.TimeFrom(now),
+			models.JobColumns.UpdatedAt: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if n == 1 {
+			job.Status = StatusRunning
+			job.StartedAt = null.TimeFrom(now)
+			job.UpdatedAt = now
+
+			return job, nil
+		}
+	}
+}
+
+// run runs a claimed job and records its outcome, the job's updated_at is
+// refreshed while it runs so other instances don't consider it abandoned
+func (p *Pool) run(ctx context.Context, job *models.Job) {
+	logger := p.logger.With(zap.String("job.id", job.ID), zap.String("job.kind", job.Kind))
+	logger.Info("running job")
+
+	h, _ := p.handler(job.Kind)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go p.heartbeat(runCtx, job.ID)
+
+	progress := func(ctx context.Context, processed, total int64) error {
+		_, err := models.Jobs(models.JobWhere.ID.EQ(job.ID)).UpdateAll(ctx, p.db, models.M{
+			models.JobColumns.Processed: processed,
+			models.JobColumns.Total:     total,
+			models.JobColumns.UpdatedAt: time.Now(),
+		})
+
+		return err
+	}
+
+	result, err := runHandler(runCtx, h, job, progress)
+
+	cancel()
+
+	finish(job, result, err, time.Now())
+
+	if err := p.save(ctx, job); err != nil {
+		logger.Error("error saving job result", zap.Error(err))
+		return
+	}
+
+	logger.Info("job finished", zap.String("job.status", job.Status), zap.String("job.error", job.Error))
+}
+
+// runHandler runs the handler, turning a panic into an error
+func runHandler(ctx context.Context, h Handler, job *models.Job, progress Progress) (result interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrPanicked, r)
+		}
+	}()
+
+	return h(ctx, job, progress)
+}
+
+// finish sets the final state of a job from its handler result
+func finish(job *models.Job, result interface{}, err error, now time.Time) {
+	job.FinishedAt = null.TimeFrom(now)
+	job.UpdatedAt = now
+
+	if err != nil {
+		job.Status = StatusFailed
+		job.Error = err.Error()
+
+		return
+	}
+
+	job.Status = StatusSucceeded
+
+	if result == nil {
+		return
+	}
+
+	raw, err := json.Marshal(result)
+	if err != nil {
+		job.Status = StatusFailed
+		job.Error = "error encoding job result: " + err.Error()
+
+		return
+	}
+
+	job.Result = null.JSONFrom(raw)
+}
+
+func (p *Pool) heartbeat(ctx context.Context, id string) {
+	ticker := time.NewTicker(p.staleAfter / heartbeatsPerStale)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := models.Jobs(models.JobWhere.ID.EQ(id)).UpdateAll(ctx, p.db, models.M{
+				models.JobColumns.UpdatedAt: time.Now(),
+			}); err != nil {
+				p.logger.Warn("error updating job heartbeat", zap.String("job.id", id), zap.Error(err))
+			}
+		}
+	}
+}
+
+// failStale marks the running jobs that stopped reporting as failed
+func (p *Pool) failStale(ctx context.Context) error {
+	stale, err := models.Jobs(
+		models.JobWhere.Status.EQ(StatusRunning),
+		models.JobWhere.UpdatedAt.LT(time.Now().Add(-p.staleAfter)),
+	).All(ctx, p.db)
+	if err != nil {
+		return err
+	}
+
+	for _, job := range stale {
+		finish(job, nil, ErrAbandoned, time.Now())
+
+		if err := p.save(ctx, job); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// save stores the final state of a job and audits it
+func (p *Pool) save(ctx context.Context, job *models.Job) error {
+	if _, err := job.Update(ctx, p.db, boil.Infer()); err != nil {
+		return err
+	}
+
+	_, err := dbtools.AuditJobFinished(ctx, p.db, job)
+
+	return err
+}
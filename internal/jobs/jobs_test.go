@@ -0,0 +1,105 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+var errTestJob = errors.New("boom")
+
+func noopHandler(_ context.Context, _ *models.Job, _ Progress) (interface{}, error) {
+	return nil, nil
+}
+
+func TestRegister(t *testing.T) {
+	p := New(nil)
+
+	p.Register("purge", noopHandler)
+	p.Register("export", noopHandler)
+
+	assert.Equal(t, []string{"export", "purge"}, p.Kinds())
+
+	_, _, err := p.Enqueue(context.TODO(), nil, "", nil, "import", nil)
+	assert.ErrorIs(t, err, ErrUnknownKind)
+}
+
+func TestWake(t *testing.T) {
+	p := New(nil)
+
+	// waking an idle pool more than once must not block
+	p.Wake()
+	p.Wake()
+
+	assert.Len(t, p.wake, 1)
+}
+
+func TestRunHandlerPanic(t *testing.T) {
+	_, err := runHandler(context.TODO(), func(_ context.Context, _ *models.Job, _ Progress) (interface{}, error) {
+		panic("oops")
+	}, &models.Job{}, nil)
+
+	assert.ErrorIs(t, err, ErrPanicked)
+}
+
+func TestFinish(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		result     interface{}
+		err        error
+		wantStatus string
+		wantResult string
+		wantError  string
+	}{
+		{
+			name:       "succeeded without result",
+			wantStatus: StatusSucceeded,
+		},
+		{
+			name:       "succeeded with result",
+			result:     map[string]int{"deleted": 3},
+			wantStatus: StatusSucceeded,
+			wantResult: `{"deleted":3}`,
+		},
+		{
+			name:       "failed",
+			err:        errTestJob,
+			wantStatus: StatusFailed,
+			wantError:  "boom",
+		},
+		{
+			name:       "unencodable result",
+			result:     make(chan int),
+			wantStatus: StatusFailed,
+			wantError:  "error encoding job result: json: unsupported type: chan int",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &models.Job{Status: StatusRunning}
+
+			finish(job, tt.result, tt.err, now)
+
+			assert.Equal(t, tt.wantStatus, job.Status)
+			assert.Equal(t, tt.wantError, job.Error)
+			require.True(t, job.FinishedAt.Valid)
+			assert.Equal(t, now, job.FinishedAt.Time)
+
+			if tt.wantResult == "" {
+				assert.False(t, job.Result.Valid)
+				return
+			}
+
+			assert.JSONEq(t, tt.wantResult, string(job.Result.JSON))
+		})
+	}
+}
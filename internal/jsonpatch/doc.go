@@ -0,0 +1,5 @@
+// Package jsonpatch applies the partial updates of JSON documents: the JSON
+// Patch operations of RFC 6902 and the JSON Merge Patch of RFC 7386. The
+// extension resources are patched with it, so clients can change one field of
+// a large resource without sending it whole.
+package jsonpatch
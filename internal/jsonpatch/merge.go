@@ -0,0 +1,46 @@
+package jsonpatch
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Merge applies a JSON Merge Patch document to a JSON document and returns the
+// merged document. The members of the patch objects are merged recursively,
+// the null members are removed and any other value replaces the target.
+func Merge(doc, patch []byte) ([]byte, error) {
+	p, err := decode(patch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPatch, err.Error())
+	}
+
+	target, err := decode(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDocument, err.Error())
+	}
+
+	return json.Marshal(merge(target, p))
+}
+
+func merge(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = map[string]interface{}{}
+	}
+
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+
+		t[k] = merge(t[k], v)
+	}
+
+	return t
+}
@@ -0,0 +1,419 @@
+package jsonpatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ContentTypePatch is the media type of the RFC 6902 JSON Patch documents
+	ContentTypePatch = "application/json-patch+json"
+	// ContentTypeMergePatch is the media type of the RFC 7386 JSON Merge Patch documents
+	ContentTypeMergePatch = "application/merge-patch+json"
+)
+
+var (
+	// ErrInvalidPatch is returned when a patch document is malformed
+	ErrInvalidPatch = errors.New("invalid patch")
+	// ErrInvalidDocument is returned when the patched document isn't valid JSON
+	ErrInvalidDocument = errors.New("invalid document")
+	// ErrPathNotFound is returned when a patch operation refers to a location
+	// that doesn't exist in the document
+	ErrPathNotFound = errors.New("path not found")
+	// ErrTestFailed is returned when a test operation of a patch doesn't match
+	// the document
+	ErrTestFailed = errors.New("test operation failed")
+)
+
+// Operation is an operation of a JSON Patch document
+type Operation struct {
+	Op    string           `json:"op"`
+	Path  *string          `json:"path"`
+	From  *string          `json:"from,omitempty"`
+	Value *json.RawMessage `json:"value,omitempty"`
+}
+
+// Patch applies the operations of a JSON Patch document to a JSON document
+// and returns the patched document. The operations are applied in order and
+// the document is left unchanged when one of them fails.
+func Patch(doc, patch []byte) ([]byte, error) {
+	ops := []Operation{}
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPatch, err.Error())
+	}
+
+	node, err := decode(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDocument, err.Error())
+	}
+
+	for i, op := range ops {
+		if node, err = apply(node, op); err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+	}
+
+	return json.Marshal(node)
+}
+
+// apply applies an operation to a document
+func apply(doc interface{}, op Operation) (interface{}, error) {
+	if op.Path == nil {
+		return nil, fmt.Errorf("%w: %s operation without a path", ErrInvalidPatch, op.Op)
+	}
+
+	path, err := parsePointer(*op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "add", "replace", "test":
+		if op.Value == nil {
+			return nil, fmt.Errorf("%w: %s operation without a value", ErrInvalidPatch, op.Op)
+		}
+
+		value, err := decode(*op.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPatch, err.Error())
+		}
+
+		switch op.Op {
+		case "add":
+			return add(doc, path, value)
+		case "replace":
+			if _, err := get(doc, path); err != nil {
+				return nil, err
+			}
+
+			if len(path) == 0 {
+				return value, nil
+			}
+
+			return update(doc, path, func(parent interface{}, key string) (interface{}, error) {
+				return setChild(parent, key, value)
+			})
+		default:
+			current, err := get(doc, path)
+			if err != nil {
+				return nil, err
+			}
+
+			if !equal(current, value) {
+				return nil, fmt.Errorf("%w: %s", ErrTestFailed, *op.Path)
+			}
+
+			return doc, nil
+		}
+	case "remove":
+		doc, _, err := remove(doc, path)
+		return doc, err
+	case "move", "copy":
+		if op.From == nil {
+			return nil, fmt.Errorf("%w: %s operation without a from", ErrInvalidPatch, op.Op)
+		}
+
+		from, err := parsePointer(*op.From)
+		if err != nil {
+			return nil, err
+		}
+
+		if op.Op == "copy" {
+			value, err := get(doc, from)
+			if err != nil {
+				return nil, err
+			}
+
+			return add(doc, path, deepCopy(value))
+		}
+
+		if *op.From == *op.Path {
+			return doc, nil
+		}
+
+		if strings.HasPrefix(*op.Path, *op.From+"/") {
+			return nil, fmt.Errorf("%w: %s can't be moved into one of its children", ErrInvalidPatch, *op.From)
+		}
+
+		doc, value, err := remove(doc, from)
+		if err != nil {
+			return nil, err
+		}
+
+		return add(doc, path, value)
+	}
+
+	return nil, fmt.Errorf("%w: unknown operation %q", ErrInvalidPatch, op.Op)
+}
+
+// parsePointer returns the reference tokens of an RFC 6901 JSON Pointer
+func parsePointer(p string) ([]string, error) {
+	if p == "" {
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(p, "/") {
+		return nil, fmt.Errorf("%w: pointer %q doesn't start with /", ErrInvalidPatch, p)
+	}
+
+	tokens := strings.Split(p[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+// get returns the value at a location of a document
+func get(doc interface{}, path []string) (interface{}, error) {
+	node := doc
+
+	for _, key := range path {
+		child, err := getChild(node, key)
+		if err != nil {
+			return nil, err
+		}
+
+		node = child
+	}
+
+	return node, nil
+}
+
+// add adds a value at a location of a document, the members of objects are
+// replaced and the values are inserted in arrays
+func add(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	return update(doc, path, func(parent interface{}, key string) (interface{}, error) {
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			p[key] = value
+			return p, nil
+		case []interface{}:
+			if key == "-" {
+				return append(p, value), nil
+			}
+
+			i, err := index(key, len(p)+1)
+			if err != nil {
+				return nil, err
+			}
+
+			p = append(p, nil)
+			copy(p[i+1:], p[i:])
+			p[i] = value
+
+			return p, nil
+		}
+
+		return nil, fmt.Errorf("%w: %s isn't in an object or array", ErrPathNotFound, key)
+	})
+}
+
+// remove removes the value at a location of a document and returns it
+func remove(doc interface{}, path []string) (interface{}, interface{}, error) {
+	if len(path) == 0 {
+		return nil, nil, fmt.Errorf("%w: the whole document can't be removed", ErrInvalidPatch)
+	}
+
+	var removed interface{}
+
+	doc, err := update(doc, path, func(parent interface{}, key string) (interface{}, error) {
+		v, err := getChild(parent, key)
+		if err != nil {
+			return nil, err
+		}
+
+		removed = v
+
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			delete(p, key)
+			return p, nil
+		case []interface{}:
+			i, _ := index(key, len(p))
+			return append(p[:i], p[i+1:]...), nil
+		}
+
+		return nil, fmt.Errorf("%w: %s", ErrPathNotFound, key)
+	})
+
+	return doc, removed, err
+}
+
+// update replaces the parent of the last token of a path with the result of
+// fn, the containers of the document are updated on the way back up
+func update(node interface{}, path []string, fn func(parent interface{}, key string) (interface{}, error)) (interface{}, error) {
+	if len(path) == 1 {
+		return fn(node, path[0])
+	}
+
+	child, err := getChild(node, path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	child, err = update(child, path[1:], fn)
+	if err != nil {
+		return nil, err
+	}
+
+	return setChild(node, path[0], child)
+}
+
+// getChild returns a member of an object or an element of an array
+func getChild(node interface{}, key string) (interface{}, error) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		v, ok := n[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrPathNotFound, key)
+		}
+
+		return v, nil
+	case []interface{}:
+		i, err := index(key, len(n))
+		if err != nil {
+			return nil, err
+		}
+
+		return n[i], nil
+	}
+
+	return nil, fmt.Errorf("%w: %s isn't in an object or array", ErrPathNotFound, key)
+}
+
+// setChild replaces an existing member of an object or element of an array
+func setChild(node interface{}, key string, value interface{}) (interface{}, error) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if _, ok := n[key]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrPathNotFound, key)
+		}
+
+		n[key] = value
+
+		return n, nil
+	case []interface{}:
+		i, err := index(key, len(n))
+		if err != nil {
+			return nil, err
+		}
+
+		n[i] = value
+
+		return n, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s isn't in an object or array", ErrPathNotFound, key)
+}
+
+// index returns the array index of a reference token, it must be lower than
+// size and can't have leading zeros
+func index(key string, size int) (int, error) {
+	if key == "" || (len(key) > 1 && key[0] == '0') || key[0] == '+' || key[0] == '-' {
+		return 0, fmt.Errorf("%w: invalid array index %q", ErrPathNotFound, key)
+	}
+
+	i, err := strconv.Atoi(key)
+	if err != nil || i >= size {
+		return 0, fmt.Errorf("%w: invalid array index %q", ErrPathNotFound, key)
+	}
+
+	return i, nil
+}
+
+// decode decodes a JSON value, keeping the numbers as they are written
+func decode(data []byte) (interface{}, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	if d.More() {
+		return nil, errors.New("unexpected data after the JSON value") //nolint:goerr113
+	}
+
+	return v, nil
+}
+
+// deepCopy copies a decoded JSON value
+func deepCopy(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, c := range t {
+			m[k] = deepCopy(c)
+		}
+
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(t))
+		for i, c := range t {
+			s[i] = deepCopy(c)
+		}
+
+		return s
+	}
+
+	return v
+}
+
+// equal compares decoded JSON values, numbers are equal when their values are
+func equal(a, b interface{}) bool {
+	switch x := a.(type) {
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+
+		for k, v := range x {
+			w, ok := y[k]
+			if !ok || !equal(v, w) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+
+		for i := range x {
+			if !equal(x[i], y[i]) {
+				return false
+			}
+		}
+
+		return true
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+
+		if x == y {
+			return true
+		}
+
+		fx, errx := x.Float64()
+		fy, erry := y.Float64()
+
+		return errx == nil && erry == nil && fx == fy
+	}
+
+	return a == b
+}
@@ -0,0 +1,207 @@
+package jsonpatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPatch(t *testing.T) {
+	doc := `{"name":"test","tags":["a","b"],"nested":{"a/b":1,"m~n":2},"count":1.0}`
+
+	tests := []struct {
+		name    string
+		doc     string
+		patch   string
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "add member",
+			doc:   doc,
+			patch: `[{"op":"add","path":"/owner","value":{"id":"x"}}]`,
+			want:  `{"name":"test","tags":["a","b"],"nested":{"a/b":1,"m~n":2},"count":1.0,"owner":{"id":"x"}}`,
+		},
+		{
+			name:  "add array element",
+			doc:   doc,
+			patch: `[{"op":"add","path":"/tags/1","value":"c"},{"op":"add","path":"/tags/-","value":"d"}]`,
+			want:  `{"name":"test","tags":["a","c","b","d"],"nested":{"a/b":1,"m~n":2},"count":1.0}`,
+		},
+		{
+			name:  "replace escaped members",
+			doc:   doc,
+			patch: `[{"op":"replace","path":"/nested/a~1b","value":3},{"op":"replace","path":"/nested/m~0n","value":4}]`,
+			want:  `{"name":"test","tags":["a","b"],"nested":{"a/b":3,"m~n":4},"count":1.0}`,
+		},
+		{
+			name:  "replace root",
+			doc:   doc,
+			patch: `[{"op":"replace","path":"","value":{"a":1}}]`,
+			want:  `{"a":1}`,
+		},
+		{
+			name:  "remove",
+			doc:   doc,
+			patch: `[{"op":"remove","path":"/tags/0"},{"op":"remove","path":"/nested"}]`,
+			want:  `{"name":"test","tags":["b"],"count":1.0}`,
+		},
+		{
+			name:  "move",
+			doc:   doc,
+			patch: `[{"op":"move","from":"/name","path":"/nested/name"}]`,
+			want:  `{"tags":["a","b"],"nested":{"a/b":1,"m~n":2,"name":"test"},"count":1.0}`,
+		},
+		{
+			name:  "copy",
+			doc:   doc,
+			patch: `[{"op":"copy","from":"/tags","path":"/labels"},{"op":"add","path":"/labels/-","value":"c"}]`,
+			want:  `{"name":"test","tags":["a","b"],"labels":["a","b","c"],"nested":{"a/b":1,"m~n":2},"count":1.0}`,
+		},
+		{
+			name:  "test",
+			doc:   doc,
+			patch: `[{"op":"test","path":"/count","value":1},{"op":"test","path":"/tags","value":["a","b"]},{"op":"replace","path":"/name","value":"x"}]`,
+			want:  `{"name":"x","tags":["a","b"],"nested":{"a/b":1,"m~n":2},"count":1.0}`,
+		},
+		{
+			name:    "failed test",
+			doc:     doc,
+			patch:   `[{"op":"replace","path":"/name","value":"x"},{"op":"test","path":"/name","value":"test"}]`,
+			wantErr: ErrTestFailed,
+		},
+		{
+			name:    "missing member",
+			doc:     doc,
+			patch:   `[{"op":"replace","path":"/missing","value":1}]`,
+			wantErr: ErrPathNotFound,
+		},
+		{
+			name:    "missing parent",
+			doc:     doc,
+			patch:   `[{"op":"add","path":"/missing/a","value":1}]`,
+			wantErr: ErrPathNotFound,
+		},
+		{
+			name:    "array index out of range",
+			doc:     doc,
+			patch:   `[{"op":"add","path":"/tags/3","value":"c"}]`,
+			wantErr: ErrPathNotFound,
+		},
+		{
+			name:    "array index with leading zero",
+			doc:     doc,
+			patch:   `[{"op":"remove","path":"/tags/01"}]`,
+			wantErr: ErrPathNotFound,
+		},
+		{
+			name:    "move into a child",
+			doc:     doc,
+			patch:   `[{"op":"move","from":"/nested","path":"/nested/child"}]`,
+			wantErr: ErrInvalidPatch,
+		},
+		{
+			name:    "unknown operation",
+			doc:     doc,
+			patch:   `[{"op":"increment","path":"/count"}]`,
+			wantErr: ErrInvalidPatch,
+		},
+		{
+			name:    "missing value",
+			doc:     doc,
+			patch:   `[{"op":"add","path":"/count"}]`,
+			wantErr: ErrInvalidPatch,
+		},
+		{
+			name:    "invalid pointer",
+			doc:     doc,
+			patch:   `[{"op":"remove","path":"count"}]`,
+			wantErr: ErrInvalidPatch,
+		},
+		{
+			name:    "not a patch",
+			doc:     doc,
+			patch:   `{"op":"remove","path":"/count"}`,
+			wantErr: ErrInvalidPatch,
+		},
+		{
+			name:    "invalid document",
+			doc:     `{"name":`,
+			patch:   `[]`,
+			wantErr: ErrInvalidDocument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Patch([]byte(tt.doc), []byte(tt.patch))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		patch   string
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "replace member",
+			doc:   `{"a":"b","c":{"d":"e","f":"g"}}`,
+			patch: `{"a":"z"}`,
+			want:  `{"a":"z","c":{"d":"e","f":"g"}}`,
+		},
+		{
+			name:  "merge nested and remove null members",
+			doc:   `{"a":"b","c":{"d":"e","f":"g"}}`,
+			patch: `{"c":{"f":null,"h":1}}`,
+			want:  `{"a":"b","c":{"d":"e","h":1}}`,
+		},
+		{
+			name:  "replace array",
+			doc:   `{"a":["b","c"]}`,
+			patch: `{"a":["d"]}`,
+			want:  `{"a":["d"]}`,
+		},
+		{
+			name:  "object replaces scalar",
+			doc:   `{"a":"b"}`,
+			patch: `{"a":{"b":"c","d":null}}`,
+			want:  `{"a":{"b":"c"}}`,
+		},
+		{
+			name:  "non object patch replaces document",
+			doc:   `{"a":"b"}`,
+			patch: `["c"]`,
+			want:  `["c"]`,
+		},
+		{
+			name:    "invalid patch",
+			doc:     `{"a":"b"}`,
+			patch:   `{"a":`,
+			wantErr: ErrInvalidPatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Merge([]byte(tt.doc), []byte(tt.patch))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
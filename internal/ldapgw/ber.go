@@ -0,0 +1,276 @@
+package ldapgw
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// maxPacketSize is the largest LDAP message accepted from a client
+const maxPacketSize = 1 << 20
+
+const (
+	classUniversal   byte = 0x00
+	classApplication byte = 0x40
+	classContext     byte = 0x80
+
+	flagConstructed byte = 0x20
+)
+
+// universal BER tags
+const (
+	tagBoolean     = 0x01
+	tagInteger     = 0x02
+	tagOctetString = 0x04
+	tagEnumerated  = 0x0a
+	tagSequence    = 0x10
+	tagSet         = 0x11
+)
+
+var (
+	// ErrPacketTooLarge is returned when a client sends a message larger than maxPacketSize
+	ErrPacketTooLarge = errors.New("ldap message too large")
+	// ErrMalformedPacket is returned when a client sends a message that isn't valid BER
+	ErrMalformedPacket = errors.New("malformed ldap message")
+)
+
+// packet is a BER encoded element, constructed packets hold their children
+// and primitive ones their value
+type packet struct {
+	class       byte
+	constructed bool
+	tag         int
+	value       []byte
+	children    []*packet
+}
+
+// newSequence returns a universal sequence holding the children
+func newSequence(children ...*packet) *packet {
+	return &packet{class: classUniversal, constructed: true, tag: tagSequence, children: children}
+}
+
+// newSet returns a universal set holding the children
+func newSet(children ...*packet) *packet {
+	return &packet{class: classUniversal, constructed: true, tag: tagSet, children: children}
+}
+
+// newString returns a universal octet string
+func newString(s string) *packet {
+	return &packet{class: classUniversal, tag: tagOctetString, value: []byte(s)}
+}
+
+// newInteger returns a universal integer
+func newInteger(i int64) *packet {
+	return &packet{class: classUniversal, tag: tagInteger, value: encodeInteger(i)}
+}
+
+// newEnumerated returns a universal enumerated
+func newEnumerated(i int64) *packet {
+	return &packet{class: classUniversal, tag: tagEnumerated, value: encodeInteger(i)}
+}
+
+// newApplication returns a constructed application packet holding the children
+func newApplication(tag int, children ...*packet) *packet {
+	return &packet{class: classApplication, constructed: true, tag: tag, children: children}
+}
+
+// readPacket reads a single BER element from r
+func readPacket(r *bufio.Reader) (*packet, error) {
+	id, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	if id&0x1f == 0x1f {
+		return nil, fmt.Errorf("%w: high tag numbers aren't supported", ErrMalformedPacket)
+	}
+
+	length, err := readLength(r)
+	if err != nil {
+		return nil, err
+	}
+
+	value := make([]byte, length)
+	if _, err := io.ReadFull(r, value); err != nil {
+		return nil, err
+	}
+
+	return decodePacket(id, value)
+}
+
+// readLength reads a definite BER length
+func readLength(r *bufio.Reader) (int, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+
+	if b&0x80 == 0 {
+		return int(b), nil
+	}
+
+	n := int(b & 0x7f)
+	if n == 0 || n > 4 {
+		return 0, fmt.Errorf("%w: unsupported length encoding", ErrMalformedPacket)
+	}
+
+	length := 0
+
+	for i := 0; i < n; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+
+		length = length<<8 | int(b)
+	}
+
+	if length > maxPacketSize {
+		return 0, ErrPacketTooLarge
+	}
+
+	return length, nil
+}
+
+// decodePacket decodes the element with the given identifier and content
+func decodePacket(id byte, value []byte) (*packet, error) {
+	p := &packet{
+		class:       id & 0xc0,
+		constructed: id&flagConstructed != 0,
+		tag:         int(id & 0x1f),
+	}
+
+	if !p.constructed {
+		p.value = value
+
+		return p, nil
+	}
+
+	for len(value) > 0 {
+		child, n, err := decodeChild(value)
+		if err != nil {
+			return nil, err
+		}
+
+		p.children = append(p.children, child)
+		value = value[n:]
+	}
+
+	return p, nil
+}
+
+// decodeChild decodes the first element in b and returns it with its encoded size
+func decodeChild(b []byte) (*packet, int, error) {
+	if len(b) < 2 || b[0]&0x1f == 0x1f { //nolint:mnd
+		return nil, 0, ErrMalformedPacket
+	}
+
+	length, offset := int(b[1]), 2
+
+	if length&0x80 != 0 {
+		n := length & 0x7f
+		if n == 0 || n > 4 || len(b) < offset+n {
+			return nil, 0, ErrMalformedPacket
+		}
+
+		length = 0
+
+		for _, c := range b[offset : offset+n] {
+			length = length<<8 | int(c)
+		}
+
+		offset += n
+	}
+
+	if length < 0 || len(b) < offset+length {
+		return nil, 0, ErrMalformedPacket
+	}
+
+	p, err := decodePacket(b[0], b[offset:offset+length])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return p, offset + length, nil
+}
+
+// bytes returns the BER encoding of the packet
+func (p *packet) bytes() []byte {
+	value := p.value
+
+	if p.constructed {
+		value = nil
+
+		for _, c := range p.children {
+			value = append(value, c.bytes()...)
+		}
+	}
+
+	id := p.class | byte(p.tag)
+	if p.constructed {
+		id |= flagConstructed
+	}
+
+	out := []byte{id}
+	out = append(out, encodeLength(len(value))...)
+
+	return append(out, value...)
+}
+
+// is returns whether the packet has the given class and tag
+func (p *packet) is(class byte, tag int) bool {
+	return p.class == class && p.tag == tag
+}
+
+// str returns the value of a primitive packet as a string
+func (p *packet) str() string {
+	return string(p.value)
+}
+
+// int returns the value of a primitive integer or enumerated packet
+func (p *packet) int() (int64, error) {
+	if p.constructed || len(p.value) == 0 || len(p.value) > 8 {
+		return 0, fmt.Errorf("%w: invalid integer", ErrMalformedPacket)
+	}
+
+	v := int64(0)
+	if p.value[0]&0x80 != 0 {
+		v = -1
+	}
+
+	for _, b := range p.value {
+		v = v<<8 | int64(b)
+	}
+
+	return v, nil
+}
+
+func encodeLength(n int) []byte {
+	if n < 0x80 {
+		return []byte{byte(n)}
+	}
+
+	var b []byte
+
+	for ; n > 0; n >>= 8 {
+		b = append([]byte{byte(n)}, b...)
+	}
+
+	return append([]byte{0x80 | byte(len(b))}, b...)
+}
+
+func encodeInteger(i int64) []byte {
+	b := []byte{byte(i)}
+
+	for {
+		next := i >> 8
+		// stop once the remaining bytes only repeat the sign bit
+		if (next == 0 && b[0]&0x80 == 0) || (next == -1 && b[0]&0x80 != 0) {
+			return b
+		}
+
+		i = next
+		b = append([]byte{byte(i)}, b...)
+	}
+}
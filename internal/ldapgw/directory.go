@@ -0,0 +1,327 @@
+package ldapgw
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const (
+	groupsOU = "groups"
+	usersOU  = "users"
+
+	userStatusActive = "active"
+)
+
+// Attribute is an LDAP attribute with its values
+type Attribute struct {
+	Name   string
+	Values []string
+}
+
+// Entry is an LDAP entry served by the gateway
+type Entry struct {
+	DN         string
+	Attributes []Attribute
+
+	normalizedDN string
+}
+
+// get returns the values of the attribute, attribute names are case insensitive
+func (e *Entry) get(name string) []string {
+	for _, a := range e.Attributes {
+		if strings.EqualFold(a.Name, name) {
+			return a.Values
+		}
+	}
+
+	return nil
+}
+
+// Directory is the tree of entries served by the gateway: the base entry, the
+// groups and users organizational units and an entry for every group and user
+type Directory struct {
+	BaseDN  string
+	Entries []*Entry
+}
+
+// Loader reads the directory served by the gateway
+type Loader func(ctx context.Context) (*Directory, error)
+
+// DBLoader returns a loader reading the groups, the active users and their
+// unexpired memberships from the database. Memberships are enumerated, the
+// members of a group include the members of its member groups.
+func DBLoader(exec boil.ContextExecutor, baseDN string) Loader {
+	return func(ctx context.Context) (*Directory, error) {
+		groups, err := models.Groups(qm.OrderBy(models.GroupColumns.Slug)).All(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+
+		users, err := models.Users(
+			models.UserWhere.Status.EQ(null.StringFrom(userStatusActive)),
+			qm.OrderBy(models.UserColumns.Email),
+		).All(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+
+		memberships, err := dbtools.GetAllGroupMemberships(ctx, exec, false)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewDirectory(baseDN, groups, users, memberships, time.Now()), nil
+	}
+}
+
+// NewDirectory returns the directory of the groups and users, memberships
+// expired at now and memberships of users or groups that aren't listed are
+// left out
+func NewDirectory(
+	baseDN string, groups models.GroupSlice, users models.UserSlice, memberships []dbtools.EnumeratedMembership, now time.Time,
+) *Directory {
+	d := &Directory{BaseDN: baseDN}
+
+	d.add(&Entry{
+		DN: baseDN,
+		Attributes: []Attribute{
+			{Name: "objectClass", Values: []string{"top", "organization"}},
+			{Name: "o", Values: []string{"governor"}},
+		},
+	})
+
+	for _, ou := range []string{groupsOU, usersOU} {
+		d.add(&Entry{
+			DN: "ou=" + ou + "," + baseDN,
+			Attributes: []Attribute{
+				{Name: "objectClass", Values: []string{"top", "organizationalUnit"}},
+				{Name: "ou", Values: []string{ou}},
+			},
+		})
+	}
+
+	groupDNs := make(map[string]string, len(groups))
+	for _, g := range groups {
+		groupDNs[g.ID] = d.groupDN(g.Slug)
+	}
+
+	userDNs := make(map[string]string, len(users))
+	for _, u := range users {
+		userDNs[u.ID] = d.userDN(u.Email)
+	}
+
+	members := map[string][]string{}
+	memberOf := map[string][]string{}
+
+	for _, m := range memberships {
+		if m.ExpiresAt.Valid && !m.ExpiresAt.Time.After(now) {
+			continue
+		}
+
+		groupDN, ok := groupDNs[m.GroupID]
+		if !ok {
+			continue
+		}
+
+		if _, ok := userDNs[m.UserID]; !ok {
+			continue
+		}
+
+		members[m.GroupID] = append(members[m.GroupID], m.UserID)
+		memberOf[m.UserID] = append(memberOf[m.UserID], groupDN)
+	}
+
+	emails := make(map[string]string, len(users))
+	for _, u := range users {
+		emails[u.ID] = u.Email
+	}
+
+	for _, g := range groups {
+		var memberDNs, memberUIDs []string
+
+		for _, id := range members[g.ID] {
+			memberDNs = append(memberDNs, userDNs[id])
+			memberUIDs = append(memberUIDs, emails[id])
+		}
+
+		sort.Strings(memberDNs)
+		sort.Strings(memberUIDs)
+
+		attrs := []Attribute{
+			{Name: "objectClass", Values: []string{"top", "groupOfNames"}},
+			{Name: "cn", Values: []string{g.Slug}},
+			{Name: "displayName", Values: []string{g.Name}},
+			{Name: "entryUUID", Values: []string{g.ID}},
+		}
+
+		if g.Description != "" {
+			attrs = append(attrs, Attribute{Name: "description", Values: []string{g.Description}})
+		}
+
+		if len(memberDNs) > 0 {
+			attrs = append(attrs,
+				Attribute{Name: "member", Values: memberDNs},
+				Attribute{Name: "memberUid", Values: memberUIDs},
+			)
+		}
+
+		d.add(&Entry{DN: groupDNs[g.ID], Attributes: attrs})
+	}
+
+	for _, u := range users {
+		groupsOf := memberOf[u.ID]
+		sort.Strings(groupsOf)
+
+		attrs := []Attribute{
+			{Name: "objectClass", Values: []string{"top", "person", "organizationalPerson", "inetOrgPerson"}},
+			{Name: "uid", Values: []string{u.Email}},
+			{Name: "cn", Values: []string{u.Name}},
+			{Name: "sn", Values: []string{u.Name}},
+			{Name: "mail", Values: []string{u.Email}},
+			{Name: "entryUUID", Values: []string{u.ID}},
+		}
+
+		if len(groupsOf) > 0 {
+			attrs = append(attrs, Attribute{Name: "memberOf", Values: groupsOf})
+		}
+
+		d.add(&Entry{DN: userDNs[u.ID], Attributes: attrs})
+	}
+
+	return d
+}
+
+func (d *Directory) add(e *Entry) {
+	e.normalizedDN = normalizeDN(e.DN)
+	d.Entries = append(d.Entries, e)
+}
+
+func (d *Directory) groupDN(slug string) string {
+	return "cn=" + escapeDNValue(slug) + ",ou=" + groupsOU + "," + d.BaseDN
+}
+
+func (d *Directory) userDN(email string) string {
+	return "uid=" + escapeDNValue(email) + ",ou=" + usersOU + "," + d.BaseDN
+}
+
+// find returns the entry with the given DN
+func (d *Directory) find(dn string) *Entry {
+	n := normalizeDN(dn)
+
+	for _, e := range d.Entries {
+		if e.normalizedDN == n {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// Search scopes
+const (
+	scopeBaseObject   = 0
+	scopeSingleLevel  = 1
+	scopeWholeSubtree = 2
+)
+
+// search returns the entries under the base DN in the scope matching the filter
+func (d *Directory) search(baseDN string, scope int64, f *filter) []*Entry {
+	base := normalizeDN(baseDN)
+
+	var found []*Entry
+
+	for _, e := range d.Entries {
+		if !inScope(e.normalizedDN, base, scope) || !f.matches(e) {
+			continue
+		}
+
+		found = append(found, e)
+	}
+
+	return found
+}
+
+func inScope(dn, base string, scope int64) bool {
+	switch scope {
+	case scopeBaseObject:
+		return dn == base
+	case scopeSingleLevel:
+		parts := splitDN(dn)
+		return len(parts) > 1 && strings.Join(parts[1:], ",") == base
+	case scopeWholeSubtree:
+		return dn == base || base == "" || strings.HasSuffix(dn, ","+base)
+	default:
+		return false
+	}
+}
+
+// normalizeDN lowercases a DN and removes the spaces around its separators so
+// DNs can be compared as strings
+func normalizeDN(dn string) string {
+	parts := splitDN(dn)
+
+	for i, p := range parts {
+		attr, value, _ := strings.Cut(p, "=")
+		parts[i] = strings.ToLower(strings.TrimSpace(attr)) + "=" + strings.ToLower(strings.TrimSpace(value))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// splitDN splits a DN into its RDNs, escaped commas don't split it
+func splitDN(dn string) []string {
+	if strings.TrimSpace(dn) == "" {
+		return nil
+	}
+
+	var (
+		parts   []string
+		current strings.Builder
+		escaped bool
+	)
+
+	for _, r := range dn {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ',':
+			parts = append(parts, current.String())
+			current.Reset()
+
+			continue
+		}
+
+		current.WriteRune(r)
+	}
+
+	return append(parts, current.String())
+}
+
+// escapeDNValue escapes the characters that are special in a DN attribute value
+func escapeDNValue(v string) string {
+	var b strings.Builder
+
+	for i, r := range v {
+		switch {
+		case strings.ContainsRune(`,+"\<>;=`, r),
+			i == 0 && (r == '#' || r == ' '),
+			i == len(v)-1 && r == ' ':
+			b.WriteRune('\\')
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
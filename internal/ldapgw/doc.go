@@ -0,0 +1,5 @@
+// Package ldapgw provides a read-only LDAP gateway serving the governor groups
+// and their members to the systems that can only consume group data with LDAP
+// binds and searches. It runs alongside the http api and reads from the same
+// database.
+package ldapgw
@@ -0,0 +1,190 @@
+package ldapgw
+
+import (
+	"fmt"
+	"strings"
+)
+
+// search filter choices, RFC 4511 section 4.5.1
+const (
+	filterAnd             = 0
+	filterOr              = 1
+	filterNot             = 2
+	filterEqualityMatch   = 3
+	filterSubstrings      = 4
+	filterGreaterOrEqual  = 5
+	filterLessOrEqual     = 6
+	filterPresent         = 7
+	filterApproxMatch     = 8
+	filterExtensibleMatch = 9
+)
+
+// substring filter parts
+const (
+	substringInitial = 0
+	substringAny     = 1
+	substringFinal   = 2
+)
+
+// filter is a decoded search filter. Values are compared case insensitively,
+// which matches the equality rules of the attributes served by the gateway.
+type filter struct {
+	kind      int
+	attribute string
+	value     string
+	initial   string
+	any       []string
+	final     string
+	children  []*filter
+}
+
+// decodeFilter decodes a search filter, extensible matches aren't supported
+func decodeFilter(p *packet) (*filter, error) {
+	if p.class != classContext {
+		return nil, fmt.Errorf("%w: invalid filter", ErrMalformedPacket)
+	}
+
+	f := &filter{kind: p.tag}
+
+	switch p.tag {
+	case filterAnd, filterOr, filterNot:
+		if !p.constructed || (p.tag == filterNot && len(p.children) != 1) {
+			return nil, fmt.Errorf("%w: invalid filter", ErrMalformedPacket)
+		}
+
+		for _, c := range p.children {
+			child, err := decodeFilter(c)
+			if err != nil {
+				return nil, err
+			}
+
+			f.children = append(f.children, child)
+		}
+	case filterEqualityMatch, filterGreaterOrEqual, filterLessOrEqual, filterApproxMatch:
+		if !p.constructed || len(p.children) != 2 { //nolint:mnd
+			return nil, fmt.Errorf("%w: invalid attribute value assertion", ErrMalformedPacket)
+		}
+
+		f.attribute, f.value = p.children[0].str(), p.children[1].str()
+	case filterSubstrings:
+		if !p.constructed || len(p.children) != 2 || !p.children[1].constructed { //nolint:mnd
+			return nil, fmt.Errorf("%w: invalid substring filter", ErrMalformedPacket)
+		}
+
+		f.attribute = p.children[0].str()
+
+		for _, s := range p.children[1].children {
+			switch s.tag {
+			case substringInitial:
+				f.initial = s.str()
+			case substringAny:
+				f.any = append(f.any, s.str())
+			case substringFinal:
+				f.final = s.str()
+			}
+		}
+	case filterPresent:
+		if p.constructed {
+			return nil, fmt.Errorf("%w: invalid present filter", ErrMalformedPacket)
+		}
+
+		f.attribute = p.str()
+	default:
+		return nil, fmt.Errorf("%w: unsupported filter", ErrMalformedPacket)
+	}
+
+	return f, nil
+}
+
+// matches returns whether the entry matches the filter
+func (f *filter) matches(e *Entry) bool {
+	switch f.kind {
+	case filterAnd:
+		for _, c := range f.children {
+			if !c.matches(e) {
+				return false
+			}
+		}
+
+		return true
+	case filterOr:
+		for _, c := range f.children {
+			if c.matches(e) {
+				return true
+			}
+		}
+
+		return false
+	case filterNot:
+		return !f.children[0].matches(e)
+	case filterPresent:
+		if strings.EqualFold(f.attribute, "objectClass") {
+			return true
+		}
+
+		return len(e.get(f.attribute)) > 0
+	}
+
+	for _, v := range e.get(f.attribute) {
+		if f.matchesValue(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *filter) matchesValue(v string) bool {
+	v = strings.ToLower(v)
+	want := strings.ToLower(f.value)
+
+	if isDNAttribute(f.attribute) {
+		v, want = normalizeDN(v), normalizeDN(want)
+	}
+
+	switch f.kind {
+	case filterEqualityMatch, filterApproxMatch:
+		return v == want
+	case filterGreaterOrEqual:
+		return v >= want
+	case filterLessOrEqual:
+		return v <= want
+	case filterSubstrings:
+		return matchesSubstrings(v, strings.ToLower(f.initial), lowerAll(f.any), strings.ToLower(f.final))
+	}
+
+	return false
+}
+
+func matchesSubstrings(v, initial string, parts []string, final string) bool {
+	if !strings.HasPrefix(v, initial) {
+		return false
+	}
+
+	v = v[len(initial):]
+
+	for _, p := range parts {
+		i := strings.Index(v, p)
+		if i < 0 {
+			return false
+		}
+
+		v = v[i+len(p):]
+	}
+
+	return strings.HasSuffix(v, final)
+}
+
+func isDNAttribute(name string) bool {
+	return strings.EqualFold(name, "member") || strings.EqualFold(name, "memberOf")
+}
+
+func lowerAll(s []string) []string {
+	out := make([]string, len(s))
+
+	for i, v := range s {
+		out[i] = strings.ToLower(v)
+	}
+
+	return out
+}
@@ -0,0 +1,254 @@
+package ldapgw
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const testBaseDN = "dc=governor,dc=example"
+
+func testDirectory() *Directory {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	groups := models.GroupSlice{
+		{ID: "g1", Slug: "platform", Name: "Platform", Description: "platform team"},
+		{ID: "g2", Slug: "sre", Name: "SRE"},
+	}
+
+	users := models.UserSlice{
+		{ID: "u1", Email: "alice@example.com", Name: "Alice"},
+		{ID: "u2", Email: "bob+ops@example.com", Name: "Bob"},
+	}
+
+	memberships := []dbtools.EnumeratedMembership{
+		{GroupID: "g1", UserID: "u1", Direct: true},
+		{GroupID: "g1", UserID: "u2", Direct: true},
+		{GroupID: "g2", UserID: "u2", ExpiresAt: null.TimeFrom(now.Add(-time.Hour))},
+		{GroupID: "g2", UserID: "u3", Direct: true},
+	}
+
+	return NewDirectory(testBaseDN, groups, users, memberships, now)
+}
+
+func TestEncodeInteger(t *testing.T) {
+	for _, i := range []int64{0, 1, 127, 128, 255, 256, 65535, -1, -128, -129, 1 << 40} {
+		p, err := decodePacket(tagInteger, encodeInteger(i))
+		require.NoError(t, err)
+
+		got, err := p.int()
+		require.NoError(t, err)
+		assert.Equal(t, i, got)
+	}
+}
+
+func TestPacketRoundTrip(t *testing.T) {
+	long := make([]byte, 300)
+
+	p := newSequence(newInteger(7), newApplication(opSearchResultEntry, newString("cn=x"), newSet(newString(string(long)))))
+
+	got, err := readPacket(bufio.NewReader(bytes.NewReader(p.bytes())))
+	require.NoError(t, err)
+
+	assert.Equal(t, p.bytes(), got.bytes())
+	assert.True(t, got.children[1].is(classApplication, opSearchResultEntry))
+	assert.Len(t, got.children[1].children[1].children[0].value, 300)
+}
+
+func TestNewDirectory(t *testing.T) {
+	d := testDirectory()
+
+	platform := d.find("CN=platform, OU=groups, " + testBaseDN)
+	require.NotNil(t, platform)
+	assert.Equal(t, []string{
+		"uid=alice@example.com,ou=users," + testBaseDN,
+		`uid=bob\+ops@example.com,ou=users,` + testBaseDN,
+	}, platform.get("member"))
+	assert.Equal(t, []string{"platform team"}, platform.get("description"))
+
+	sre := d.find("cn=sre,ou=groups," + testBaseDN)
+	require.NotNil(t, sre)
+	assert.Empty(t, sre.get("member"), "expired memberships and unknown users are left out")
+
+	bob := d.find(`uid=bob\+ops@example.com,ou=users,` + testBaseDN)
+	require.NotNil(t, bob)
+	assert.Equal(t, []string{"cn=platform,ou=groups," + testBaseDN}, bob.get("memberOf"))
+}
+
+func TestSearch(t *testing.T) {
+	d := testDirectory()
+
+	and := func(children ...*filter) *filter { return &filter{kind: filterAnd, children: children} }
+	eq := func(attr, value string) *filter {
+		return &filter{kind: filterEqualityMatch, attribute: attr, value: value}
+	}
+	present := &filter{kind: filterPresent, attribute: "objectClass"}
+
+	tests := []struct {
+		name   string
+		base   string
+		scope  int64
+		filter *filter
+		want   []string
+	}{
+		{
+			name:   "base object",
+			base:   "cn=sre,ou=groups," + testBaseDN,
+			scope:  scopeBaseObject,
+			filter: present,
+			want:   []string{"cn=sre,ou=groups," + testBaseDN},
+		},
+		{
+			name:   "single level",
+			base:   testBaseDN,
+			scope:  scopeSingleLevel,
+			filter: present,
+			want:   []string{"ou=groups," + testBaseDN, "ou=users," + testBaseDN},
+		},
+		{
+			name:   "groups of a member",
+			base:   testBaseDN,
+			scope:  scopeWholeSubtree,
+			filter: and(eq("objectClass", "groupOfNames"), eq("member", "UID=Alice@example.com, ou=users,"+testBaseDN)),
+			want:   []string{"cn=platform,ou=groups," + testBaseDN},
+		},
+		{
+			name:   "substrings",
+			base:   "ou=users," + testBaseDN,
+			scope:  scopeWholeSubtree,
+			filter: &filter{kind: filterSubstrings, attribute: "mail", initial: "bob", final: "example.com"},
+			want:   []string{`uid=bob\+ops@example.com,ou=users,` + testBaseDN},
+		},
+		{
+			name:   "not",
+			base:   "ou=groups," + testBaseDN,
+			scope:  scopeSingleLevel,
+			filter: &filter{kind: filterNot, children: []*filter{{kind: filterPresent, attribute: "member"}}},
+			want:   []string{"cn=sre,ou=groups," + testBaseDN},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+
+			for _, e := range d.search(tt.base, tt.scope, tt.filter) {
+				got = append(got, e.DN)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestServer(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := &Server{
+		BindDN:       "cn=reader," + testBaseDN,
+		BindPassword: "secret",
+		Load: func(_ context.Context) (*Directory, error) {
+			return testDirectory(), nil
+		},
+	}
+
+	go func() {
+		_ = s.Serve(ctx, lis)
+	}()
+
+	defer lis.Close()
+
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+
+	roundTrip := func(id int64, op *packet) []*packet {
+		_, err := conn.Write(newSequence(newInteger(id), op).bytes())
+		require.NoError(t, err)
+
+		var ops []*packet
+
+		for {
+			p, err := readPacket(r)
+			require.NoError(t, err)
+
+			got, err := p.children[0].int()
+			require.NoError(t, err)
+			assert.Equal(t, id, got)
+
+			ops = append(ops, p.children[1])
+
+			if p.children[1].tag != opSearchResultEntry {
+				return ops
+			}
+		}
+	}
+
+	resultCode := func(op *packet) int64 {
+		code, err := op.children[0].int()
+		require.NoError(t, err)
+
+		return code
+	}
+
+	bind := func(dn, password string) *packet {
+		return newApplication(opBindRequest, newInteger(3), newString(dn),
+			&packet{class: classContext, tag: 0, value: []byte(password)})
+	}
+
+	search := newApplication(opSearchRequest,
+		newString("ou=groups,"+testBaseDN),
+		newEnumerated(scopeSingleLevel),
+		newEnumerated(0),
+		newInteger(0),
+		newInteger(0),
+		&packet{class: classUniversal, tag: tagBoolean, value: []byte{0}},
+		&packet{class: classContext, constructed: true, tag: filterEqualityMatch, children: []*packet{newString("cn"), newString("PLATFORM")}},
+		newSequence(newString("cn"), newString("member")),
+	)
+
+	ops := roundTrip(1, search)
+	require.Len(t, ops, 1)
+	assert.Equal(t, int64(resultInsufficientAccessRights), resultCode(ops[0]), "searches require a bind")
+
+	ops = roundTrip(2, bind("cn=reader,"+testBaseDN, "wrong"))
+	assert.Equal(t, int64(resultInvalidCredentials), resultCode(ops[0]))
+
+	ops = roundTrip(3, bind("CN=reader, "+testBaseDN, "secret"))
+	assert.Equal(t, int64(resultSuccess), resultCode(ops[0]))
+
+	ops = roundTrip(4, search)
+	require.Len(t, ops, 2)
+
+	entry := ops[0]
+	assert.Equal(t, opSearchResultEntry, entry.tag)
+	assert.Equal(t, "cn=platform,ou=groups,"+testBaseDN, entry.children[0].str())
+	require.Len(t, entry.children[1].children, 2)
+	assert.Equal(t, "cn", entry.children[1].children[0].children[0].str())
+	assert.Equal(t, "member", entry.children[1].children[1].children[0].str())
+	assert.Len(t, entry.children[1].children[1].children[1].children, 2)
+
+	assert.Equal(t, opSearchResultDone, ops[1].tag)
+	assert.Equal(t, int64(resultSuccess), resultCode(ops[1]))
+
+	ops = roundTrip(5, &packet{class: classApplication, tag: opDelRequest, value: []byte("cn=platform,ou=groups," + testBaseDN)})
+	assert.Equal(t, opDelResponse, ops[0].tag)
+	assert.Equal(t, int64(resultUnwillingToPerform), resultCode(ops[0]))
+}
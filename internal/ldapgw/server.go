@@ -0,0 +1,480 @@
+package ldapgw
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// defaultIdleTimeout is how long a connection can stay idle when the server
+// doesn't set one
+const defaultIdleTimeout = 5 * time.Minute
+
+// ErrNoBindCredentials is returned when the gateway is started without the
+// credentials of its bind DN
+var ErrNoBindCredentials = errors.New("ldap gateway requires a bind dn and password")
+
+// protocol operations, RFC 4511 section 4.2 onwards
+const (
+	opBindRequest       = 0
+	opBindResponse      = 1
+	opUnbindRequest     = 2
+	opSearchRequest     = 3
+	opSearchResultEntry = 4
+	opSearchResultDone  = 5
+	opModifyRequest     = 6
+	opModifyResponse    = 7
+	opAddRequest        = 8
+	opAddResponse       = 9
+	opDelRequest        = 10
+	opDelResponse       = 11
+	opModifyDNRequest   = 12
+	opModifyDNResponse  = 13
+	opCompareRequest    = 14
+	opCompareResponse   = 15
+	opAbandonRequest    = 16
+	opExtendedRequest   = 23
+	opExtendedResponse  = 24
+)
+
+// result codes, RFC 4511 appendix A
+const (
+	resultSuccess                  = 0
+	resultOperationsError          = 1
+	resultProtocolError            = 2
+	resultSizeLimitExceeded        = 4
+	resultCompareFalse             = 5
+	resultCompareTrue              = 6
+	resultAuthMethodNotSupported   = 7
+	resultNoSuchObject             = 32
+	resultInvalidCredentials       = 49
+	resultInsufficientAccessRights = 50
+	resultUnwillingToPerform       = 53
+)
+
+// writeResponses maps the write operations to their responses, all of them
+// are refused by the read-only gateway
+var writeResponses = map[int]int{
+	opModifyRequest:   opModifyResponse,
+	opAddRequest:      opAddResponse,
+	opDelRequest:      opDelResponse,
+	opModifyDNRequest: opModifyDNResponse,
+}
+
+// Server is a read-only LDAP server. Clients bind with the configured DN and
+// password and can then search and compare the entries of the directory, the
+// directory is loaded again for every operation so changes are served right
+// away.
+type Server struct {
+	BindDN       string
+	BindPassword string
+	Listen       string
+	Load         Loader
+	Logger       *zap.Logger
+	// TLSConfig makes the server accept LDAPS connections only when set
+	TLSConfig *tls.Config
+	// IdleTimeout is how long a connection can stay idle before it is closed
+	IdleTimeout time.Duration
+	// ShutdownTimeout is how long the open connections are given to finish
+	// their operations on shutdown before they are closed, they are closed
+	// right away when it is 0
+	ShutdownTimeout time.Duration
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+	wg    sync.WaitGroup
+}
+
+// Run will start the server listening on the specified address, when the
+// context is canceled the server stops accepting connections and closes the
+// open ones once their current operation is done or the shutdown timeout is
+// reached
+func (s *Server) Run(ctx context.Context) error {
+	if s.BindDN == "" || s.BindPassword == "" {
+		return ErrNoBindCredentials
+	}
+
+	if s.Logger == nil {
+		s.Logger = zap.NewNop()
+	}
+
+	lis, err := net.Listen("tcp", s.Listen)
+	if err != nil {
+		return err
+	}
+
+	if s.TLSConfig != nil {
+		lis = tls.NewListener(lis, s.TLSConfig)
+	}
+
+	s.Logger.Info("starting ldap gateway", zap.String("address", s.Listen), zap.Bool("tls", s.TLSConfig != nil))
+
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- s.Serve(ctx, lis)
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	lis.Close()
+	s.shutdown()
+
+	s.Logger.Info("ldap gateway stopped")
+
+	return nil
+}
+
+// Serve accepts the connections on the listener until it is closed
+func (s *Server) Serve(ctx context.Context, lis net.Listener) error {
+	if s.Logger == nil {
+		s.Logger = zap.NewNop()
+	}
+
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+
+			return err
+		}
+
+		s.track(conn)
+
+		go func() {
+			defer s.untrack(conn)
+
+			s.serveConn(ctx, conn)
+		}()
+	}
+}
+
+func (s *Server) track(conn net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conns == nil {
+		s.conns = map[net.Conn]struct{}{}
+	}
+
+	s.conns[conn] = struct{}{}
+	s.wg.Add(1)
+}
+
+func (s *Server) untrack(conn net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	conn.Close()
+	delete(s.conns, conn)
+	s.wg.Done()
+}
+
+// shutdown waits for the open connections to finish for the shutdown timeout
+// and closes the remaining ones
+func (s *Server) shutdown() {
+	// wake up the connections waiting for their next operation, the ones
+	// running an operation stop once it is done
+	s.mu.Lock()
+	for conn := range s.conns {
+		_ = conn.SetReadDeadline(time.Now())
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	if s.ShutdownTimeout > 0 {
+		timer := time.NewTimer(s.ShutdownTimeout)
+		defer timer.Stop()
+
+		select {
+		case <-done:
+			return
+		case <-timer.C:
+			s.Logger.Warn("ldap connections didn't close before the shutdown timeout")
+		}
+	}
+
+	s.mu.Lock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+
+	<-done
+}
+
+// session is the state of a client connection
+type session struct {
+	conn   net.Conn
+	bound  bool
+	logger *zap.Logger
+}
+
+func (s *Server) serveConn(ctx context.Context, conn net.Conn) {
+	sess := &session{
+		conn:   conn,
+		logger: s.Logger.With(zap.String("remote_addr", conn.RemoteAddr().String())),
+	}
+
+	idle := s.IdleTimeout
+	if idle <= 0 {
+		idle = defaultIdleTimeout
+	}
+
+	r := bufio.NewReader(conn)
+
+	for ctx.Err() == nil {
+		if err := conn.SetReadDeadline(time.Now().Add(idle)); err != nil {
+			return
+		}
+
+		p, err := readPacket(r)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				sess.logger.Debug("closing ldap connection", zap.Error(err))
+			}
+
+			return
+		}
+
+		if !s.handle(ctx, sess, p) {
+			return
+		}
+	}
+}
+
+// handle runs a single LDAP operation, it returns false when the connection
+// must be closed
+func (s *Server) handle(ctx context.Context, sess *session, p *packet) bool {
+	if !p.is(classUniversal, tagSequence) || len(p.children) < 2 || p.children[1].class != classApplication {
+		sess.logger.Debug("invalid ldap message")
+		return false
+	}
+
+	id, err := p.children[0].int()
+	if err != nil {
+		return false
+	}
+
+	op := p.children[1]
+
+	switch op.tag {
+	case opBindRequest:
+		return s.bind(sess, id, op)
+	case opUnbindRequest:
+		return false
+	case opSearchRequest:
+		return s.search(ctx, sess, id, op)
+	case opCompareRequest:
+		return s.compare(ctx, sess, id, op)
+	case opAbandonRequest:
+		// operations run one at a time, there is never anything to abandon
+		return true
+	case opExtendedRequest:
+		return sess.send(id, result(opExtendedResponse, resultProtocolError, "extended operations aren't supported"))
+	}
+
+	if resp, ok := writeResponses[op.tag]; ok {
+		return sess.send(id, result(resp, resultUnwillingToPerform, "the governor ldap gateway is read-only"))
+	}
+
+	sess.logger.Debug("unknown ldap operation", zap.Int("tag", op.tag))
+
+	return false
+}
+
+func (s *Server) bind(sess *session, id int64, op *packet) bool {
+	sess.bound = false
+
+	if len(op.children) != 3 { //nolint:mnd
+		return sess.send(id, result(opBindResponse, resultProtocolError, "invalid bind request"))
+	}
+
+	if version, err := op.children[0].int(); err != nil || version != 3 { //nolint:mnd
+		return sess.send(id, result(opBindResponse, resultProtocolError, "only ldap v3 is supported"))
+	}
+
+	name, auth := op.children[1].str(), op.children[2]
+
+	if !auth.is(classContext, 0) || auth.constructed {
+		return sess.send(id, result(opBindResponse, resultAuthMethodNotSupported, "only simple binds are supported"))
+	}
+
+	if name == "" && len(auth.value) == 0 {
+		// anonymous binds succeed but can't read anything
+		return sess.send(id, result(opBindResponse, resultSuccess, ""))
+	}
+
+	if normalizeDN(name) != normalizeDN(s.BindDN) || subtle.ConstantTimeCompare(auth.value, []byte(s.BindPassword)) != 1 {
+		sess.logger.Warn("ldap bind failed", zap.String("bind_dn", name))
+		return sess.send(id, result(opBindResponse, resultInvalidCredentials, "invalid credentials"))
+	}
+
+	sess.bound = true
+
+	return sess.send(id, result(opBindResponse, resultSuccess, ""))
+}
+
+func (s *Server) search(ctx context.Context, sess *session, id int64, op *packet) bool {
+	if len(op.children) != 8 { //nolint:mnd
+		return sess.send(id, result(opSearchResultDone, resultProtocolError, "invalid search request"))
+	}
+
+	if !sess.bound {
+		return sess.send(id, result(opSearchResultDone, resultInsufficientAccessRights, "bind required"))
+	}
+
+	baseDN := op.children[0].str()
+
+	scope, err := op.children[1].int()
+	if err != nil || scope < scopeBaseObject || scope > scopeWholeSubtree {
+		return sess.send(id, result(opSearchResultDone, resultProtocolError, "invalid search scope"))
+	}
+
+	sizeLimit, err := op.children[3].int()
+	if err != nil {
+		return sess.send(id, result(opSearchResultDone, resultProtocolError, "invalid size limit"))
+	}
+
+	typesOnly := len(op.children[5].value) == 1 && op.children[5].value[0] != 0
+
+	f, err := decodeFilter(op.children[6])
+	if err != nil {
+		return sess.send(id, result(opSearchResultDone, resultProtocolError, err.Error()))
+	}
+
+	var attrs []string
+	for _, a := range op.children[7].children {
+		attrs = append(attrs, a.str())
+	}
+
+	dir, err := s.Load(ctx)
+	if err != nil {
+		sess.logger.Error("failed to load ldap directory", zap.Error(err))
+		return sess.send(id, result(opSearchResultDone, resultOperationsError, "failed to load directory"))
+	}
+
+	if dir.find(baseDN) == nil {
+		return sess.send(id, result(opSearchResultDone, resultNoSuchObject, "no such object"))
+	}
+
+	for i, e := range dir.search(baseDN, scope, f) {
+		if sizeLimit > 0 && int64(i) >= sizeLimit {
+			return sess.send(id, result(opSearchResultDone, resultSizeLimitExceeded, ""))
+		}
+
+		if !sess.send(id, searchResultEntry(e, attrs, typesOnly)) {
+			return false
+		}
+	}
+
+	return sess.send(id, result(opSearchResultDone, resultSuccess, ""))
+}
+
+func (s *Server) compare(ctx context.Context, sess *session, id int64, op *packet) bool {
+	if len(op.children) != 2 || len(op.children[1].children) != 2 { //nolint:mnd
+		return sess.send(id, result(opCompareResponse, resultProtocolError, "invalid compare request"))
+	}
+
+	if !sess.bound {
+		return sess.send(id, result(opCompareResponse, resultInsufficientAccessRights, "bind required"))
+	}
+
+	dir, err := s.Load(ctx)
+	if err != nil {
+		sess.logger.Error("failed to load ldap directory", zap.Error(err))
+		return sess.send(id, result(opCompareResponse, resultOperationsError, "failed to load directory"))
+	}
+
+	e := dir.find(op.children[0].str())
+	if e == nil {
+		return sess.send(id, result(opCompareResponse, resultNoSuchObject, "no such object"))
+	}
+
+	ava := op.children[1].children
+	f := &filter{kind: filterEqualityMatch, attribute: ava[0].str(), value: ava[1].str()}
+
+	if f.matches(e) {
+		return sess.send(id, result(opCompareResponse, resultCompareTrue, ""))
+	}
+
+	return sess.send(id, result(opCompareResponse, resultCompareFalse, ""))
+}
+
+// send writes a response to the client, it returns false when it couldn't
+func (sess *session) send(id int64, op *packet) bool {
+	if _, err := sess.conn.Write(newSequence(newInteger(id), op).bytes()); err != nil {
+		sess.logger.Debug("failed to write ldap response", zap.Error(err))
+		return false
+	}
+
+	return true
+}
+
+// result returns an LDAPResult response of the operation
+func result(op, code int, msg string) *packet {
+	return newApplication(op, newEnumerated(int64(code)), newString(""), newString(msg))
+}
+
+// searchResultEntry returns the entry with the requested attributes, all the
+// attributes are returned when none or "*" is requested and none when "1.1" is
+func searchResultEntry(e *Entry, requested []string, typesOnly bool) *packet {
+	all := len(requested) == 0
+
+	for _, r := range requested {
+		if r == "*" {
+			all = true
+		}
+	}
+
+	attrs := newSequence()
+
+	for _, a := range e.Attributes {
+		if !all && !containsFold(requested, a.Name) {
+			continue
+		}
+
+		values := newSet()
+
+		if !typesOnly {
+			for _, v := range a.Values {
+				values.children = append(values.children, newString(v))
+			}
+		}
+
+		attrs.children = append(attrs.children, newSequence(newString(a.Name), values))
+	}
+
+	return newApplication(opSearchResultEntry, newString(e.DN), attrs)
+}
+
+func containsFold(list []string, s string) bool {
+	for _, l := range list {
+		if strings.EqualFold(l, s) {
+			return true
+		}
+	}
+
+	return false
+}
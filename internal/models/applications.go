@@ -32,6 +32,7 @@ type Application struct {
 	DeletedAt       null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
 	ApproverGroupID null.String `boil:"approver_group_id" json:"approver_group_id,omitempty" toml:"approver_group_id" yaml:"approver_group_id,omitempty"`
 	TypeID          null.String `boil:"type_id" json:"type_id,omitempty" toml:"type_id" yaml:"type_id,omitempty"`
+	TenantID        null.String `boil:"tenant_id" json:"tenant_id,omitempty" toml:"tenant_id" yaml:"tenant_id,omitempty"`
 
 	R *applicationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L applicationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -46,6 +47,7 @@ var ApplicationColumns = struct {
 	DeletedAt       string
 	ApproverGroupID string
 	TypeID          string
+	TenantID        string
 }{
 	ID:              "id",
 	Name:            "name",
@@ -55,6 +57,7 @@ var ApplicationColumns = struct {
 	DeletedAt:       "deleted_at",
 	ApproverGroupID: "approver_group_id",
 	TypeID:          "type_id",
+	TenantID:        "tenant_id",
 }
 
 var ApplicationTableColumns = struct {
@@ -66,6 +69,7 @@ var ApplicationTableColumns = struct {
 	DeletedAt       string
 	ApproverGroupID string
 	TypeID          string
+	TenantID        string
 }{
 	ID:              "applications.id",
 	Name:            "applications.name",
@@ -75,6 +79,7 @@ var ApplicationTableColumns = struct {
 	DeletedAt:       "applications.deleted_at",
 	ApproverGroupID: "applications.approver_group_id",
 	TypeID:          "applications.type_id",
+	TenantID:        "applications.tenant_id",
 }
 
 // Generated where
@@ -88,6 +93,7 @@ var ApplicationWhere = struct {
 	DeletedAt       whereHelpernull_Time
 	ApproverGroupID whereHelpernull_String
 	TypeID          whereHelpernull_String
+	TenantID        whereHelpernull_String
 }{
 	ID:              whereHelperstring{field: "\"applications\".\"id\""},
 	Name:            whereHelperstring{field: "\"applications\".\"name\""},
@@ -97,18 +103,23 @@ var ApplicationWhere = struct {
 	DeletedAt:       whereHelpernull_Time{field: "\"applications\".\"deleted_at\""},
 	ApproverGroupID: whereHelpernull_String{field: "\"applications\".\"approver_group_id\""},
 	TypeID:          whereHelpernull_String{field: "\"applications\".\"type_id\""},
+	TenantID:        whereHelpernull_String{field: "\"applications\".\"tenant_id\""},
 }
 
 // ApplicationRels is where relationship names are stored.
 var ApplicationRels = struct {
 	Type                          string
 	ApproverGroup                 string
+	Tenant                        string
+	ArchivedRequests              string
 	SubjectApplicationAuditEvents string
 	GroupApplicationRequests      string
 	GroupApplications             string
 }{
 	Type:                          "Type",
 	ApproverGroup:                 "ApproverGroup",
+	Tenant:                        "Tenant",
+	ArchivedRequests:              "ArchivedRequests",
 	SubjectApplicationAuditEvents: "SubjectApplicationAuditEvents",
 	GroupApplicationRequests:      "GroupApplicationRequests",
 	GroupApplications:             "GroupApplications",
@@ -118,6 +129,8 @@ var ApplicationRels = struct {
 type applicationR struct {
 	Type                          *ApplicationType             `boil:"Type" json:"Type" toml:"Type" yaml:"Type"`
 	ApproverGroup                 *Group                       `boil:"ApproverGroup" json:"ApproverGroup" toml:"ApproverGroup" yaml:"ApproverGroup"`
+	Tenant                        *Organization                `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	ArchivedRequests              ArchivedRequestSlice         `boil:"ArchivedRequests" json:"ArchivedRequests" toml:"ArchivedRequests" yaml:"ArchivedRequests"`
 	SubjectApplicationAuditEvents AuditEventSlice              `boil:"SubjectApplicationAuditEvents" json:"SubjectApplicationAuditEvents" toml:"SubjectApplicationAuditEvents" yaml:"SubjectApplicationAuditEvents"`
 	GroupApplicationRequests      GroupApplicationRequestSlice `boil:"GroupApplicationRequests" json:"GroupApplicationRequests" toml:"GroupApplicationRequests" yaml:"GroupApplicationRequests"`
 	GroupApplications             GroupApplicationSlice        `boil:"GroupApplications" json:"GroupApplications" toml:"GroupApplications" yaml:"GroupApplications"`
@@ -142,6 +155,20 @@ func (r *applicationR) GetApproverGroup() *Group {
 	return r.ApproverGroup
 }
 
+func (r *applicationR) GetTenant() *Organization {
+	if r == nil {
+		return nil
+	}
+	return r.Tenant
+}
+
+func (r *applicationR) GetArchivedRequests() ArchivedRequestSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ArchivedRequests
+}
+
 func (r *applicationR) GetSubjectApplicationAuditEvents() AuditEventSlice {
 	if r == nil {
 		return nil
@@ -167,9 +194,9 @@ func (r *applicationR) GetGroupApplications() GroupApplicationSlice {
 type applicationL struct{}
 
 var (
-	applicationAllColumns            = []string{"id", "name", "slug", "created_at", "updated_at", "deleted_at", "approver_group_id", "type_id"}
+	applicationAllColumns            = []string{"id", "name", "slug", "created_at", "updated_at", "deleted_at", "approver_group_id", "type_id", "tenant_id"}
 	applicationColumnsWithoutDefault = []string{"name", "slug"}
-	applicationColumnsWithDefault    = []string{"id", "created_at", "updated_at", "deleted_at", "approver_group_id", "type_id"}
+	applicationColumnsWithDefault    = []string{"id", "created_at", "updated_at", "deleted_at", "approver_group_id", "type_id", "tenant_id"}
 	applicationPrimaryKeyColumns     = []string{"id"}
 	applicationGeneratedColumns      = []string{}
 )
@@ -501,6 +528,31 @@ func (o *Application) ApproverGroup(mods ...qm.QueryMod) groupQuery {
 	return Groups(queryMods...)
 }
 
+// Tenant pointed to by the foreign key.
+func (o *Application) Tenant(mods ...qm.QueryMod) organizationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Organizations(queryMods...)
+}
+
+// ArchivedRequests retrieves all the archived_request's ArchivedRequests with an executor.
+func (o *Application) ArchivedRequests(mods ...qm.QueryMod) archivedRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"archived_requests\".\"application_id\"=?", o.ID),
+	)
+
+	return ArchivedRequests(queryMods...)
+}
+
 // SubjectApplicationAuditEvents retrieves all the audit_event's AuditEvents with an executor via subject_application_id column.
 func (o *Application) SubjectApplicationAuditEvents(mods ...qm.QueryMod) auditEventQuery {
 	var queryMods []qm.QueryMod
@@ -793,6 +845,244 @@ func (applicationL) LoadApproverGroup(ctx context.Context, e boil.ContextExecuto
 	return nil
 }
 
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (applicationL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication interface{}, mods queries.Applicator) error {
+	var slice []*Application
+	var object *Application
+
+	if singular {
+		var ok bool
+		object, ok = maybeApplication.(*Application)
+		if !ok {
+			object = new(Application)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeApplication))
+			}
+		}
+	} else {
+		s, ok := maybeApplication.(*[]*Application)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeApplication))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &applicationR{}
+		}
+		if !queries.IsNil(object.TenantID) {
+			args[object.TenantID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &applicationR{}
+			}
+
+			if !queries.IsNil(obj.TenantID) {
+				args[obj.TenantID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organizations`),
+		qm.WhereIn(`organizations.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`organizations.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Organization")
+	}
+
+	var resultSlice []*Organization
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Organization")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for organizations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
+	}
+
+	if len(organizationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &organizationR{}
+		}
+		foreign.R.TenantApplications = append(foreign.R.TenantApplications, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TenantID, foreign.ID) {
+				local.R.Tenant = foreign
+				if foreign.R == nil {
+					foreign.R = &organizationR{}
+				}
+				foreign.R.TenantApplications = append(foreign.R.TenantApplications, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadArchivedRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (applicationL) LoadArchivedRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication interface{}, mods queries.Applicator) error {
+	var slice []*Application
+	var object *Application
+
+	if singular {
+		var ok bool
+		object, ok = maybeApplication.(*Application)
+		if !ok {
+			object = new(Application)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeApplication))
+			}
+		}
+	} else {
+		s, ok := maybeApplication.(*[]*Application)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeApplication)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeApplication))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &applicationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &applicationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`archived_requests`),
+		qm.WhereIn(`archived_requests.application_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load archived_requests")
+	}
+
+	var resultSlice []*ArchivedRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice archived_requests")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on archived_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for archived_requests")
+	}
+
+	if len(archivedRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ArchivedRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &archivedRequestR{}
+			}
+			foreign.R.Application = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ApplicationID) {
+				local.R.ArchivedRequests = append(local.R.ArchivedRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &archivedRequestR{}
+				}
+				foreign.R.Application = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadSubjectApplicationAuditEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (applicationL) LoadSubjectApplicationAuditEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeApplication interface{}, mods queries.Applicator) error {
@@ -1293,6 +1583,213 @@ func (o *Application) RemoveApproverGroup(ctx context.Context, exec boil.Context
 	return nil
 }
 
+// SetTenant of the application to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.TenantApplications.
+func (o *Application) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Organization) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"applications\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, applicationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TenantID, related.ID)
+	if o.R == nil {
+		o.R = &applicationR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &organizationR{
+			TenantApplications: ApplicationSlice{o},
+		}
+	} else {
+		related.R.TenantApplications = append(related.R.TenantApplications, o)
+	}
+
+	return nil
+}
+
+// RemoveTenant relationship.
+// Sets o.R.Tenant to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Application) RemoveTenant(ctx context.Context, exec boil.ContextExecutor, related *Organization) error {
+	var err error
+
+	queries.SetScanner(&o.TenantID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("tenant_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Tenant = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.TenantApplications {
+		if queries.Equal(o.TenantID, ri.TenantID) {
+			continue
+		}
+
+		ln := len(related.R.TenantApplications)
+		if ln > 1 && i < ln-1 {
+			related.R.TenantApplications[i] = related.R.TenantApplications[ln-1]
+		}
+		related.R.TenantApplications = related.R.TenantApplications[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddArchivedRequests adds the given related objects to the existing relationships
+// of the application, optionally inserting them as new records.
+// Appends related to o.R.ArchivedRequests.
+// Sets related.R.Application appropriately.
+func (o *Application) AddArchivedRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArchivedRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ApplicationID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"archived_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"application_id"}),
+				strmangle.WhereClause("\"", "\"", 2, archivedRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ApplicationID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &applicationR{
+			ArchivedRequests: related,
+		}
+	} else {
+		o.R.ArchivedRequests = append(o.R.ArchivedRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &archivedRequestR{
+				Application: o,
+			}
+		} else {
+			rel.R.Application = o
+		}
+	}
+	return nil
+}
+
+// SetArchivedRequests removes all previously related items of the
+// application replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Application's ArchivedRequests accordingly.
+// Replaces o.R.ArchivedRequests with related.
+// Sets related.R.Application's ArchivedRequests accordingly.
+func (o *Application) SetArchivedRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArchivedRequest) error {
+	query := "update \"archived_requests\" set \"application_id\" = null where \"application_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ArchivedRequests {
+			queries.SetScanner(&rel.ApplicationID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Application = nil
+		}
+		o.R.ArchivedRequests = nil
+	}
+
+	return o.AddArchivedRequests(ctx, exec, insert, related...)
+}
+
+// RemoveArchivedRequests relationships from objects passed in.
+// Removes related items from R.ArchivedRequests (uses pointer comparison, removal does not keep order)
+// Sets related.R.Application.
+func (o *Application) RemoveArchivedRequests(ctx context.Context, exec boil.ContextExecutor, related ...*ArchivedRequest) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ApplicationID, nil)
+		if rel.R != nil {
+			rel.R.Application = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("application_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ArchivedRequests {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ArchivedRequests)
+			if ln > 1 && i < ln-1 {
+				o.R.ArchivedRequests[i] = o.R.ArchivedRequests[ln-1]
+			}
+			o.R.ArchivedRequests = o.R.ArchivedRequests[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddSubjectApplicationAuditEvents adds the given related objects to the existing relationships
 // of the application, optionally inserting them as new records.
 // Appends related to o.R.SubjectApplicationAuditEvents.
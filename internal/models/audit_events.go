@@ -36,6 +36,7 @@ type AuditEvent struct {
 	SubjectOrganizationID null.String       `boil:"subject_organization_id" json:"subject_organization_id,omitempty" toml:"subject_organization_id" yaml:"subject_organization_id,omitempty"`
 	SubjectApplicationID  null.String       `boil:"subject_application_id" json:"subject_application_id,omitempty" toml:"subject_application_id" yaml:"subject_application_id,omitempty"`
 	ParentID              null.String       `boil:"parent_id" json:"parent_id,omitempty" toml:"parent_id" yaml:"parent_id,omitempty"`
+	SubjectExtensionID    null.String       `boil:"subject_extension_id" json:"subject_extension_id,omitempty" toml:"subject_extension_id" yaml:"subject_extension_id,omitempty"`
 
 	R *auditEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L auditEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -53,6 +54,7 @@ var AuditEventColumns = struct {
 	SubjectOrganizationID string
 	SubjectApplicationID  string
 	ParentID              string
+	SubjectExtensionID    string
 }{
 	ID:                    "id",
 	ActorID:               "actor_id",
@@ -65,6 +67,7 @@ var AuditEventColumns = struct {
 	SubjectOrganizationID: "subject_organization_id",
 	SubjectApplicationID:  "subject_application_id",
 	ParentID:              "parent_id",
+	SubjectExtensionID:    "subject_extension_id",
 }
 
 var AuditEventTableColumns = struct {
@@ -79,6 +82,7 @@ var AuditEventTableColumns = struct {
 	SubjectOrganizationID string
 	SubjectApplicationID  string
 	ParentID              string
+	SubjectExtensionID    string
 }{
 	ID:                    "audit_events.id",
 	ActorID:               "audit_events.actor_id",
@@ -91,6 +95,7 @@ var AuditEventTableColumns = struct {
 	SubjectOrganizationID: "audit_events.subject_organization_id",
 	SubjectApplicationID:  "audit_events.subject_application_id",
 	ParentID:              "audit_events.parent_id",
+	SubjectExtensionID:    "audit_events.subject_extension_id",
 }
 
 // Generated where
@@ -128,6 +133,7 @@ var AuditEventWhere = struct {
 	SubjectOrganizationID whereHelpernull_String
 	SubjectApplicationID  whereHelpernull_String
 	ParentID              whereHelpernull_String
+	SubjectExtensionID    whereHelpernull_String
 }{
 	ID:                    whereHelperstring{field: "\"audit_events\".\"id\""},
 	ActorID:               whereHelpernull_String{field: "\"audit_events\".\"actor_id\""},
@@ -140,6 +146,7 @@ var AuditEventWhere = struct {
 	SubjectOrganizationID: whereHelpernull_String{field: "\"audit_events\".\"subject_organization_id\""},
 	SubjectApplicationID:  whereHelpernull_String{field: "\"audit_events\".\"subject_application_id\""},
 	ParentID:              whereHelpernull_String{field: "\"audit_events\".\"parent_id\""},
+	SubjectExtensionID:    whereHelpernull_String{field: "\"audit_events\".\"subject_extension_id\""},
 }
 
 // AuditEventRels is where relationship names are stored.
@@ -210,9 +217,9 @@ func (r *auditEventR) GetActor() *User {
 type auditEventL struct{}
 
 var (
-	auditEventAllColumns            = []string{"id", "actor_id", "action", "message", "changeset", "subject_group_id", "subject_user_id", "created_at", "subject_organization_id", "subject_application_id", "parent_id"}
+	auditEventAllColumns            = []string{"id", "actor_id", "action", "message", "changeset", "subject_group_id", "subject_user_id", "created_at", "subject_organization_id", "subject_application_id", "parent_id", "subject_extension_id"}
 	auditEventColumnsWithoutDefault = []string{"action", "message", "created_at"}
-	auditEventColumnsWithDefault    = []string{"id", "actor_id", "changeset", "subject_group_id", "subject_user_id", "subject_organization_id", "subject_application_id", "parent_id"}
+	auditEventColumnsWithDefault    = []string{"id", "actor_id", "changeset", "subject_group_id", "subject_user_id", "subject_organization_id", "subject_application_id", "parent_id", "subject_extension_id"}
 	auditEventPrimaryKeyColumns     = []string{"id"}
 	auditEventGeneratedColumns      = []string{}
 )
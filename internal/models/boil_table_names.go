@@ -4,43 +4,93 @@
 package models
 
 var TableNames = struct {
-	ApplicationTypes             string
-	Applications                 string
-	AuditEvents                  string
-	ExtensionResourceDefinitions string
-	Extensions                   string
-	GroupApplicationRequests     string
-	GroupApplications            string
-	GroupHierarchies             string
-	GroupMembershipRequests      string
-	GroupMemberships             string
-	GroupOrganizations           string
-	Groups                       string
-	NotificationPreferences      string
-	NotificationTargets          string
-	NotificationTypes            string
-	Organizations                string
-	SystemExtensionResources     string
-	UserExtensionResources       string
-	Users                        string
+	ApplicationTypes                string
+	Applications                    string
+	ArchivedRequests                string
+	AuditCheckpoints                string
+	AuditEvents                     string
+	EventConsumers                  string
+	EventOutbox                     string
+	EventSubjectRules               string
+	ExtensionCredentials            string
+	ExtensionResourceDefinitions    string
+	ExtensionTokens                 string
+	Extensions                      string
+	FeatureFlags                    string
+	GithubTeamExports               string
+	GroupApplicationRequests        string
+	GroupApplications               string
+	GroupHierarchies                string
+	GroupMembershipRequests         string
+	GroupMemberships                string
+	GroupOrganizations              string
+	Groups                          string
+	Jobs                            string
+	MembershipDurationPolicies      string
+	NamingPolicies                  string
+	NetworkPolicies                 string
+	NotificationDispatches          string
+	NotificationFailoverPolicies    string
+	NotificationGroupPreferences    string
+	NotificationPreferences         string
+	NotificationTargetRankings      string
+	NotificationTargets             string
+	NotificationTypes               string
+	Organizations                   string
+	RelationshipTombstones          string
+	RequestComments                 string
+	ScheduledMembershipChanges      string
+	SessionTokens                   string
+	SystemExtensionResourceVersions string
+	SystemExtensionResources        string
+	UserEmailChanges                string
+	UserExtensionResourceVersions   string
+	UserExtensionResources          string
+	UserFieldSubscriptions          string
+	Users                           string
 }{
-	ApplicationTypes:             "application_types",
-	Applications:                 "applications",
-	AuditEvents:                  "audit_events",
-	ExtensionResourceDefinitions: "extension_resource_definitions",
-	Extensions:                   "extensions",
-	GroupApplicationRequests:     "group_application_requests",
-	GroupApplications:            "group_applications",
-	GroupHierarchies:             "group_hierarchies",
-	GroupMembershipRequests:      "group_membership_requests",
-	GroupMemberships:             "group_memberships",
-	GroupOrganizations:           "group_organizations",
-	Groups:                       "groups",
-	NotificationPreferences:      "notification_preferences",
-	NotificationTargets:          "notification_targets",
-	NotificationTypes:            "notification_types",
-	Organizations:                "organizations",
-	SystemExtensionResources:     "system_extension_resources",
-	UserExtensionResources:       "user_extension_resources",
-	Users:                        "users",
+	ApplicationTypes:                "application_types",
+	Applications:                    "applications",
+	ArchivedRequests:                "archived_requests",
+	AuditCheckpoints:                "audit_checkpoints",
+	AuditEvents:                     "audit_events",
+	EventConsumers:                  "event_consumers",
+	EventOutbox:                     "event_outbox",
+	EventSubjectRules:               "event_subject_rules",
+	ExtensionCredentials:            "extension_credentials",
+	ExtensionResourceDefinitions:    "extension_resource_definitions",
+	ExtensionTokens:                 "extension_tokens",
+	Extensions:                      "extensions",
+	FeatureFlags:                    "feature_flags",
+	GithubTeamExports:               "github_team_exports",
+	GroupApplicationRequests:        "group_application_requests",
+	GroupApplications:               "group_applications",
+	GroupHierarchies:                "group_hierarchies",
+	GroupMembershipRequests:         "group_membership_requests",
+	GroupMemberships:                "group_memberships",
+	GroupOrganizations:              "group_organizations",
+	Groups:                          "groups",
+	Jobs:                            "jobs",
+	MembershipDurationPolicies:      "membership_duration_policies",
+	NamingPolicies:                  "naming_policies",
+	NetworkPolicies:                 "network_policies",
+	NotificationDispatches:          "notification_dispatches",
+	NotificationFailoverPolicies:    "notification_failover_policies",
+	NotificationGroupPreferences:    "notification_group_preferences",
+	NotificationPreferences:         "notification_preferences",
+	NotificationTargetRankings:      "notification_target_rankings",
+	NotificationTargets:             "notification_targets",
+	NotificationTypes:               "notification_types",
+	Organizations:                   "organizations",
+	RelationshipTombstones:          "relationship_tombstones",
+	RequestComments:                 "request_comments",
+	ScheduledMembershipChanges:      "scheduled_membership_changes",
+	SessionTokens:                   "session_tokens",
+	SystemExtensionResourceVersions: "system_extension_resource_versions",
+	SystemExtensionResources:        "system_extension_resources",
+	UserEmailChanges:                "user_email_changes",
+	UserExtensionResourceVersions:   "user_extension_resource_versions",
+	UserExtensionResources:          "user_extension_resources",
+	UserFieldSubscriptions:          "user_field_subscriptions",
+	Users:                           "users",
 }
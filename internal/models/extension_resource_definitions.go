@@ -25,172 +25,210 @@ import (
 
 // ExtensionResourceDefinition is an object representing the database table.
 type ExtensionResourceDefinition struct {
-	ID           string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name         string      `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Description  string      `boil:"description" json:"description" toml:"description" yaml:"description"`
-	Enabled      bool        `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
-	SlugSingular string      `boil:"slug_singular" json:"slug_singular" toml:"slug_singular" yaml:"slug_singular"`
-	SlugPlural   string      `boil:"slug_plural" json:"slug_plural" toml:"slug_plural" yaml:"slug_plural"`
-	Version      string      `boil:"version" json:"version" toml:"version" yaml:"version"`
-	Scope        string      `boil:"scope" json:"scope" toml:"scope" yaml:"scope"`
-	Schema       types.JSON  `boil:"schema" json:"schema" toml:"schema" yaml:"schema"`
-	CreatedAt    time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt    time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedAt    null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
-	ExtensionID  string      `boil:"extension_id" json:"extension_id" toml:"extension_id" yaml:"extension_id"`
-	AdminGroup   null.String `boil:"admin_group" json:"admin_group,omitempty" toml:"admin_group" yaml:"admin_group,omitempty"`
+	ID                 string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name               string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Description        string      `boil:"description" json:"description" toml:"description" yaml:"description"`
+	Enabled            bool        `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	SlugSingular       string      `boil:"slug_singular" json:"slug_singular" toml:"slug_singular" yaml:"slug_singular"`
+	SlugPlural         string      `boil:"slug_plural" json:"slug_plural" toml:"slug_plural" yaml:"slug_plural"`
+	Version            string      `boil:"version" json:"version" toml:"version" yaml:"version"`
+	Scope              string      `boil:"scope" json:"scope" toml:"scope" yaml:"scope"`
+	Schema             types.JSON  `boil:"schema" json:"schema" toml:"schema" yaml:"schema"`
+	CreatedAt          time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt          time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt          null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ExtensionID        string      `boil:"extension_id" json:"extension_id" toml:"extension_id" yaml:"extension_id"`
+	AdminGroup         null.String `boil:"admin_group" json:"admin_group,omitempty" toml:"admin_group" yaml:"admin_group,omitempty"`
+	State              string      `boil:"state" json:"state" toml:"state" yaml:"state"`
+	DefaultOwnerPolicy string      `boil:"default_owner_policy" json:"default_owner_policy" toml:"default_owner_policy" yaml:"default_owner_policy"`
+	DefaultOwnerGroup  null.String `boil:"default_owner_group" json:"default_owner_group,omitempty" toml:"default_owner_group" yaml:"default_owner_group,omitempty"`
+	PreviousVersion    null.String `boil:"previous_version" json:"previous_version,omitempty" toml:"previous_version" yaml:"previous_version,omitempty"`
+	SchemaDiff         null.JSON   `boil:"schema_diff" json:"schema_diff,omitempty" toml:"schema_diff" yaml:"schema_diff,omitempty"`
 
 	R *extensionResourceDefinitionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L extensionResourceDefinitionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ExtensionResourceDefinitionColumns = struct {
-	ID           string
-	Name         string
-	Description  string
-	Enabled      string
-	SlugSingular string
-	SlugPlural   string
-	Version      string
-	Scope        string
-	Schema       string
-	CreatedAt    string
-	UpdatedAt    string
-	DeletedAt    string
-	ExtensionID  string
-	AdminGroup   string
+	ID                 string
+	Name               string
+	Description        string
+	Enabled            string
+	SlugSingular       string
+	SlugPlural         string
+	Version            string
+	Scope              string
+	Schema             string
+	CreatedAt          string
+	UpdatedAt          string
+	DeletedAt          string
+	ExtensionID        string
+	AdminGroup         string
+	State              string
+	DefaultOwnerPolicy string
+	DefaultOwnerGroup  string
+	PreviousVersion    string
+	SchemaDiff         string
 }{
-	ID:           "id",
-	Name:         "name",
-	Description:  "description",
-	Enabled:      "enabled",
-	SlugSingular: "slug_singular",
-	SlugPlural:   "slug_plural",
-	Version:      "version",
-	Scope:        "scope",
-	Schema:       "schema",
-	CreatedAt:    "created_at",
-	UpdatedAt:    "updated_at",
-	DeletedAt:    "deleted_at",
-	ExtensionID:  "extension_id",
-	AdminGroup:   "admin_group",
+	ID:                 "id",
+	Name:               "name",
+	Description:        "description",
+	Enabled:            "enabled",
+	SlugSingular:       "slug_singular",
+	SlugPlural:         "slug_plural",
+	Version:            "version",
+	Scope:              "scope",
+	Schema:             "schema",
+	CreatedAt:          "created_at",
+	UpdatedAt:          "updated_at",
+	DeletedAt:          "deleted_at",
+	ExtensionID:        "extension_id",
+	AdminGroup:         "admin_group",
+	State:              "state",
+	DefaultOwnerPolicy: "default_owner_policy",
+	DefaultOwnerGroup:  "default_owner_group",
+	PreviousVersion:    "previous_version",
+	SchemaDiff:         "schema_diff",
 }
 
 var ExtensionResourceDefinitionTableColumns = struct {
-	ID           string
-	Name         string
-	Description  string
-	Enabled      string
-	SlugSingular string
-	SlugPlural   string
-	Version      string
-	Scope        string
-	Schema       string
-	CreatedAt    string
-	UpdatedAt    string
-	DeletedAt    string
-	ExtensionID  string
-	AdminGroup   string
+	ID                 string
+	Name               string
+	Description        string
+	Enabled            string
+	SlugSingular       string
+	SlugPlural         string
+	Version            string
+	Scope              string
+	Schema             string
+	CreatedAt          string
+	UpdatedAt          string
+	DeletedAt          string
+	ExtensionID        string
+	AdminGroup         string
+	State              string
+	DefaultOwnerPolicy string
+	DefaultOwnerGroup  string
+	PreviousVersion    string
+	SchemaDiff         string
 }{
-	ID:           "extension_resource_definitions.id",
-	Name:         "extension_resource_definitions.name",
-	Description:  "extension_resource_definitions.description",
-	Enabled:      "extension_resource_definitions.enabled",
-	SlugSingular: "extension_resource_definitions.slug_singular",
-	SlugPlural:   "extension_resource_definitions.slug_plural",
-	Version:      "extension_resource_definitions.version",
-	Scope:        "extension_resource_definitions.scope",
-	Schema:       "extension_resource_definitions.schema",
-	CreatedAt:    "extension_resource_definitions.created_at",
-	UpdatedAt:    "extension_resource_definitions.updated_at",
-	DeletedAt:    "extension_resource_definitions.deleted_at",
-	ExtensionID:  "extension_resource_definitions.extension_id",
-	AdminGroup:   "extension_resource_definitions.admin_group",
+	ID:                 "extension_resource_definitions.id",
+	Name:               "extension_resource_definitions.name",
+	Description:        "extension_resource_definitions.description",
+	Enabled:            "extension_resource_definitions.enabled",
+	SlugSingular:       "extension_resource_definitions.slug_singular",
+	SlugPlural:         "extension_resource_definitions.slug_plural",
+	Version:            "extension_resource_definitions.version",
+	Scope:              "extension_resource_definitions.scope",
+	Schema:             "extension_resource_definitions.schema",
+	CreatedAt:          "extension_resource_definitions.created_at",
+	UpdatedAt:          "extension_resource_definitions.updated_at",
+	DeletedAt:          "extension_resource_definitions.deleted_at",
+	ExtensionID:        "extension_resource_definitions.extension_id",
+	AdminGroup:         "extension_resource_definitions.admin_group",
+	State:              "extension_resource_definitions.state",
+	DefaultOwnerPolicy: "extension_resource_definitions.default_owner_policy",
+	DefaultOwnerGroup:  "extension_resource_definitions.default_owner_group",
+	PreviousVersion:    "extension_resource_definitions.previous_version",
+	SchemaDiff:         "extension_resource_definitions.schema_diff",
 }
 
 // Generated where
 
-type whereHelperbool struct{ field string }
+type whereHelpernull_JSON struct{ field string }
 
-func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-
-type whereHelpertypes_JSON struct{ field string }
-
-func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
+func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+func (w whereHelpernull_JSON) NEQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+func (w whereHelpernull_JSON) LT(x null.JSON) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+func (w whereHelpernull_JSON) LTE(x null.JSON) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+func (w whereHelpernull_JSON) GT(x null.JSON) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
+func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var ExtensionResourceDefinitionWhere = struct {
-	ID           whereHelperstring
-	Name         whereHelperstring
-	Description  whereHelperstring
-	Enabled      whereHelperbool
-	SlugSingular whereHelperstring
-	SlugPlural   whereHelperstring
-	Version      whereHelperstring
-	Scope        whereHelperstring
-	Schema       whereHelpertypes_JSON
-	CreatedAt    whereHelpertime_Time
-	UpdatedAt    whereHelpertime_Time
-	DeletedAt    whereHelpernull_Time
-	ExtensionID  whereHelperstring
-	AdminGroup   whereHelpernull_String
+	ID                 whereHelperstring
+	Name               whereHelperstring
+	Description        whereHelperstring
+	Enabled            whereHelperbool
+	SlugSingular       whereHelperstring
+	SlugPlural         whereHelperstring
+	Version            whereHelperstring
+	Scope              whereHelperstring
+	Schema             whereHelpertypes_JSON
+	CreatedAt          whereHelpertime_Time
+	UpdatedAt          whereHelpertime_Time
+	DeletedAt          whereHelpernull_Time
+	ExtensionID        whereHelperstring
+	AdminGroup         whereHelpernull_String
+	State              whereHelperstring
+	DefaultOwnerPolicy whereHelperstring
+	DefaultOwnerGroup  whereHelpernull_String
+	PreviousVersion    whereHelpernull_String
+	SchemaDiff         whereHelpernull_JSON
 }{
-	ID:           whereHelperstring{field: "\"extension_resource_definitions\".\"id\""},
-	Name:         whereHelperstring{field: "\"extension_resource_definitions\".\"name\""},
-	Description:  whereHelperstring{field: "\"extension_resource_definitions\".\"description\""},
-	Enabled:      whereHelperbool{field: "\"extension_resource_definitions\".\"enabled\""},
-	SlugSingular: whereHelperstring{field: "\"extension_resource_definitions\".\"slug_singular\""},
-	SlugPlural:   whereHelperstring{field: "\"extension_resource_definitions\".\"slug_plural\""},
-	Version:      whereHelperstring{field: "\"extension_resource_definitions\".\"version\""},
-	Scope:        whereHelperstring{field: "\"extension_resource_definitions\".\"scope\""},
-	Schema:       whereHelpertypes_JSON{field: "\"extension_resource_definitions\".\"schema\""},
-	CreatedAt:    whereHelpertime_Time{field: "\"extension_resource_definitions\".\"created_at\""},
-	UpdatedAt:    whereHelpertime_Time{field: "\"extension_resource_definitions\".\"updated_at\""},
-	DeletedAt:    whereHelpernull_Time{field: "\"extension_resource_definitions\".\"deleted_at\""},
-	ExtensionID:  whereHelperstring{field: "\"extension_resource_definitions\".\"extension_id\""},
-	AdminGroup:   whereHelpernull_String{field: "\"extension_resource_definitions\".\"admin_group\""},
+	ID:                 whereHelperstring{field: "\"extension_resource_definitions\".\"id\""},
+	Name:               whereHelperstring{field: "\"extension_resource_definitions\".\"name\""},
+	Description:        whereHelperstring{field: "\"extension_resource_definitions\".\"description\""},
+	Enabled:            whereHelperbool{field: "\"extension_resource_definitions\".\"enabled\""},
+	SlugSingular:       whereHelperstring{field: "\"extension_resource_definitions\".\"slug_singular\""},
+	SlugPlural:         whereHelperstring{field: "\"extension_resource_definitions\".\"slug_plural\""},
+	Version:            whereHelperstring{field: "\"extension_resource_definitions\".\"version\""},
+	Scope:              whereHelperstring{field: "\"extension_resource_definitions\".\"scope\""},
+	Schema:             whereHelpertypes_JSON{field: "\"extension_resource_definitions\".\"schema\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"extension_resource_definitions\".\"created_at\""},
+	UpdatedAt:          whereHelpertime_Time{field: "\"extension_resource_definitions\".\"updated_at\""},
+	DeletedAt:          whereHelpernull_Time{field: "\"extension_resource_definitions\".\"deleted_at\""},
+	ExtensionID:        whereHelperstring{field: "\"extension_resource_definitions\".\"extension_id\""},
+	AdminGroup:         whereHelpernull_String{field: "\"extension_resource_definitions\".\"admin_group\""},
+	State:              whereHelperstring{field: "\"extension_resource_definitions\".\"state\""},
+	DefaultOwnerPolicy: whereHelperstring{field: "\"extension_resource_definitions\".\"default_owner_policy\""},
+	DefaultOwnerGroup:  whereHelpernull_String{field: "\"extension_resource_definitions\".\"default_owner_group\""},
+	PreviousVersion:    whereHelpernull_String{field: "\"extension_resource_definitions\".\"previous_version\""},
+	SchemaDiff:         whereHelpernull_JSON{field: "\"extension_resource_definitions\".\"schema_diff\""},
 }
 
 // ExtensionResourceDefinitionRels is where relationship names are stored.
 var ExtensionResourceDefinitionRels = struct {
-	Extension                string
-	AdminGroupGroup          string
-	SystemExtensionResources string
-	UserExtensionResources   string
+	Extension                       string
+	AdminGroupGroup                 string
+	DefaultOwnerGroupGroup          string
+	SystemExtensionResourceVersions string
+	SystemExtensionResources        string
+	UserExtensionResourceVersions   string
+	UserExtensionResources          string
 }{
-	Extension:                "Extension",
-	AdminGroupGroup:          "AdminGroupGroup",
-	SystemExtensionResources: "SystemExtensionResources",
-	UserExtensionResources:   "UserExtensionResources",
+	Extension:                       "Extension",
+	AdminGroupGroup:                 "AdminGroupGroup",
+	DefaultOwnerGroupGroup:          "DefaultOwnerGroupGroup",
+	SystemExtensionResourceVersions: "SystemExtensionResourceVersions",
+	SystemExtensionResources:        "SystemExtensionResources",
+	UserExtensionResourceVersions:   "UserExtensionResourceVersions",
+	UserExtensionResources:          "UserExtensionResources",
 }
 
 // extensionResourceDefinitionR is where relationships are stored.
 type extensionResourceDefinitionR struct {
-	Extension                *Extension                   `boil:"Extension" json:"Extension" toml:"Extension" yaml:"Extension"`
-	AdminGroupGroup          *Group                       `boil:"AdminGroupGroup" json:"AdminGroupGroup" toml:"AdminGroupGroup" yaml:"AdminGroupGroup"`
-	SystemExtensionResources SystemExtensionResourceSlice `boil:"SystemExtensionResources" json:"SystemExtensionResources" toml:"SystemExtensionResources" yaml:"SystemExtensionResources"`
-	UserExtensionResources   UserExtensionResourceSlice   `boil:"UserExtensionResources" json:"UserExtensionResources" toml:"UserExtensionResources" yaml:"UserExtensionResources"`
+	Extension                       *Extension                          `boil:"Extension" json:"Extension" toml:"Extension" yaml:"Extension"`
+	AdminGroupGroup                 *Group                              `boil:"AdminGroupGroup" json:"AdminGroupGroup" toml:"AdminGroupGroup" yaml:"AdminGroupGroup"`
+	DefaultOwnerGroupGroup          *Group                              `boil:"DefaultOwnerGroupGroup" json:"DefaultOwnerGroupGroup" toml:"DefaultOwnerGroupGroup" yaml:"DefaultOwnerGroupGroup"`
+	SystemExtensionResourceVersions SystemExtensionResourceVersionSlice `boil:"SystemExtensionResourceVersions" json:"SystemExtensionResourceVersions" toml:"SystemExtensionResourceVersions" yaml:"SystemExtensionResourceVersions"`
+	SystemExtensionResources        SystemExtensionResourceSlice        `boil:"SystemExtensionResources" json:"SystemExtensionResources" toml:"SystemExtensionResources" yaml:"SystemExtensionResources"`
+	UserExtensionResourceVersions   UserExtensionResourceVersionSlice   `boil:"UserExtensionResourceVersions" json:"UserExtensionResourceVersions" toml:"UserExtensionResourceVersions" yaml:"UserExtensionResourceVersions"`
+	UserExtensionResources          UserExtensionResourceSlice          `boil:"UserExtensionResources" json:"UserExtensionResources" toml:"UserExtensionResources" yaml:"UserExtensionResources"`
 }
 
 // NewStruct creates a new relationship struct
@@ -212,6 +250,20 @@ func (r *extensionResourceDefinitionR) GetAdminGroupGroup() *Group {
 	return r.AdminGroupGroup
 }
 
+func (r *extensionResourceDefinitionR) GetDefaultOwnerGroupGroup() *Group {
+	if r == nil {
+		return nil
+	}
+	return r.DefaultOwnerGroupGroup
+}
+
+func (r *extensionResourceDefinitionR) GetSystemExtensionResourceVersions() SystemExtensionResourceVersionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SystemExtensionResourceVersions
+}
+
 func (r *extensionResourceDefinitionR) GetSystemExtensionResources() SystemExtensionResourceSlice {
 	if r == nil {
 		return nil
@@ -219,6 +271,13 @@ func (r *extensionResourceDefinitionR) GetSystemExtensionResources() SystemExten
 	return r.SystemExtensionResources
 }
 
+func (r *extensionResourceDefinitionR) GetUserExtensionResourceVersions() UserExtensionResourceVersionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserExtensionResourceVersions
+}
+
 func (r *extensionResourceDefinitionR) GetUserExtensionResources() UserExtensionResourceSlice {
 	if r == nil {
 		return nil
@@ -230,9 +289,9 @@ func (r *extensionResourceDefinitionR) GetUserExtensionResources() UserExtension
 type extensionResourceDefinitionL struct{}
 
 var (
-	extensionResourceDefinitionAllColumns            = []string{"id", "name", "description", "enabled", "slug_singular", "slug_plural", "version", "scope", "schema", "created_at", "updated_at", "deleted_at", "extension_id", "admin_group"}
+	extensionResourceDefinitionAllColumns            = []string{"id", "name", "description", "enabled", "slug_singular", "slug_plural", "version", "scope", "schema", "created_at", "updated_at", "deleted_at", "extension_id", "admin_group", "state", "default_owner_policy", "default_owner_group", "previous_version", "schema_diff"}
 	extensionResourceDefinitionColumnsWithoutDefault = []string{"name", "description", "slug_singular", "slug_plural", "version", "scope", "schema", "extension_id"}
-	extensionResourceDefinitionColumnsWithDefault    = []string{"id", "enabled", "created_at", "updated_at", "deleted_at", "admin_group"}
+	extensionResourceDefinitionColumnsWithDefault    = []string{"id", "enabled", "created_at", "updated_at", "deleted_at", "admin_group", "state", "default_owner_policy", "default_owner_group", "previous_version", "schema_diff"}
 	extensionResourceDefinitionPrimaryKeyColumns     = []string{"id"}
 	extensionResourceDefinitionGeneratedColumns      = []string{}
 )
@@ -564,6 +623,31 @@ func (o *ExtensionResourceDefinition) AdminGroupGroup(mods ...qm.QueryMod) group
 	return Groups(queryMods...)
 }
 
+// DefaultOwnerGroupGroup pointed to by the foreign key.
+func (o *ExtensionResourceDefinition) DefaultOwnerGroupGroup(mods ...qm.QueryMod) groupQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DefaultOwnerGroup),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Groups(queryMods...)
+}
+
+// SystemExtensionResourceVersions retrieves all the system_extension_resource_version's SystemExtensionResourceVersions with an executor.
+func (o *ExtensionResourceDefinition) SystemExtensionResourceVersions(mods ...qm.QueryMod) systemExtensionResourceVersionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"system_extension_resource_versions\".\"extension_resource_definition_id\"=?", o.ID),
+	)
+
+	return SystemExtensionResourceVersions(queryMods...)
+}
+
 // SystemExtensionResources retrieves all the system_extension_resource's SystemExtensionResources with an executor.
 func (o *ExtensionResourceDefinition) SystemExtensionResources(mods ...qm.QueryMod) systemExtensionResourceQuery {
 	var queryMods []qm.QueryMod
@@ -578,6 +662,20 @@ func (o *ExtensionResourceDefinition) SystemExtensionResources(mods ...qm.QueryM
 	return SystemExtensionResources(queryMods...)
 }
 
+// UserExtensionResourceVersions retrieves all the user_extension_resource_version's UserExtensionResourceVersions with an executor.
+func (o *ExtensionResourceDefinition) UserExtensionResourceVersions(mods ...qm.QueryMod) userExtensionResourceVersionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_extension_resource_versions\".\"extension_resource_definition_id\"=?", o.ID),
+	)
+
+	return UserExtensionResourceVersions(queryMods...)
+}
+
 // UserExtensionResources retrieves all the user_extension_resource's UserExtensionResources with an executor.
 func (o *ExtensionResourceDefinition) UserExtensionResources(mods ...qm.QueryMod) userExtensionResourceQuery {
 	var queryMods []qm.QueryMod
@@ -838,6 +936,244 @@ func (extensionResourceDefinitionL) LoadAdminGroupGroup(ctx context.Context, e b
 	return nil
 }
 
+// LoadDefaultOwnerGroupGroup allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (extensionResourceDefinitionL) LoadDefaultOwnerGroupGroup(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExtensionResourceDefinition interface{}, mods queries.Applicator) error {
+	var slice []*ExtensionResourceDefinition
+	var object *ExtensionResourceDefinition
+
+	if singular {
+		var ok bool
+		object, ok = maybeExtensionResourceDefinition.(*ExtensionResourceDefinition)
+		if !ok {
+			object = new(ExtensionResourceDefinition)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeExtensionResourceDefinition)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeExtensionResourceDefinition))
+			}
+		}
+	} else {
+		s, ok := maybeExtensionResourceDefinition.(*[]*ExtensionResourceDefinition)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeExtensionResourceDefinition)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeExtensionResourceDefinition))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &extensionResourceDefinitionR{}
+		}
+		if !queries.IsNil(object.DefaultOwnerGroup) {
+			args[object.DefaultOwnerGroup] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &extensionResourceDefinitionR{}
+			}
+
+			if !queries.IsNil(obj.DefaultOwnerGroup) {
+				args[obj.DefaultOwnerGroup] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`groups`),
+		qm.WhereIn(`groups.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`groups.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Group")
+	}
+
+	var resultSlice []*Group
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Group")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for groups")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for groups")
+	}
+
+	if len(groupAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.DefaultOwnerGroupGroup = foreign
+		if foreign.R == nil {
+			foreign.R = &groupR{}
+		}
+		foreign.R.DefaultOwnerGroupExtensionResourceDefinitions = append(foreign.R.DefaultOwnerGroupExtensionResourceDefinitions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.DefaultOwnerGroup, foreign.ID) {
+				local.R.DefaultOwnerGroupGroup = foreign
+				if foreign.R == nil {
+					foreign.R = &groupR{}
+				}
+				foreign.R.DefaultOwnerGroupExtensionResourceDefinitions = append(foreign.R.DefaultOwnerGroupExtensionResourceDefinitions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSystemExtensionResourceVersions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (extensionResourceDefinitionL) LoadSystemExtensionResourceVersions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExtensionResourceDefinition interface{}, mods queries.Applicator) error {
+	var slice []*ExtensionResourceDefinition
+	var object *ExtensionResourceDefinition
+
+	if singular {
+		var ok bool
+		object, ok = maybeExtensionResourceDefinition.(*ExtensionResourceDefinition)
+		if !ok {
+			object = new(ExtensionResourceDefinition)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeExtensionResourceDefinition)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeExtensionResourceDefinition))
+			}
+		}
+	} else {
+		s, ok := maybeExtensionResourceDefinition.(*[]*ExtensionResourceDefinition)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeExtensionResourceDefinition)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeExtensionResourceDefinition))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &extensionResourceDefinitionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &extensionResourceDefinitionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`system_extension_resource_versions`),
+		qm.WhereIn(`system_extension_resource_versions.extension_resource_definition_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load system_extension_resource_versions")
+	}
+
+	var resultSlice []*SystemExtensionResourceVersion
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice system_extension_resource_versions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on system_extension_resource_versions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for system_extension_resource_versions")
+	}
+
+	if len(systemExtensionResourceVersionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SystemExtensionResourceVersions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &systemExtensionResourceVersionR{}
+			}
+			foreign.R.ExtensionResourceDefinition = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExtensionResourceDefinitionID {
+				local.R.SystemExtensionResourceVersions = append(local.R.SystemExtensionResourceVersions, foreign)
+				if foreign.R == nil {
+					foreign.R = &systemExtensionResourceVersionR{}
+				}
+				foreign.R.ExtensionResourceDefinition = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadSystemExtensionResources allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (extensionResourceDefinitionL) LoadSystemExtensionResources(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExtensionResourceDefinition interface{}, mods queries.Applicator) error {
@@ -952,6 +1288,119 @@ func (extensionResourceDefinitionL) LoadSystemExtensionResources(ctx context.Con
 	return nil
 }
 
+// LoadUserExtensionResourceVersions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (extensionResourceDefinitionL) LoadUserExtensionResourceVersions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExtensionResourceDefinition interface{}, mods queries.Applicator) error {
+	var slice []*ExtensionResourceDefinition
+	var object *ExtensionResourceDefinition
+
+	if singular {
+		var ok bool
+		object, ok = maybeExtensionResourceDefinition.(*ExtensionResourceDefinition)
+		if !ok {
+			object = new(ExtensionResourceDefinition)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeExtensionResourceDefinition)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeExtensionResourceDefinition))
+			}
+		}
+	} else {
+		s, ok := maybeExtensionResourceDefinition.(*[]*ExtensionResourceDefinition)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeExtensionResourceDefinition)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeExtensionResourceDefinition))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &extensionResourceDefinitionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &extensionResourceDefinitionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_extension_resource_versions`),
+		qm.WhereIn(`user_extension_resource_versions.extension_resource_definition_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_extension_resource_versions")
+	}
+
+	var resultSlice []*UserExtensionResourceVersion
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_extension_resource_versions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_extension_resource_versions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_extension_resource_versions")
+	}
+
+	if len(userExtensionResourceVersionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserExtensionResourceVersions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userExtensionResourceVersionR{}
+			}
+			foreign.R.ExtensionResourceDefinition = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExtensionResourceDefinitionID {
+				local.R.UserExtensionResourceVersions = append(local.R.UserExtensionResourceVersions, foreign)
+				if foreign.R == nil {
+					foreign.R = &userExtensionResourceVersionR{}
+				}
+				foreign.R.ExtensionResourceDefinition = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUserExtensionResources allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (extensionResourceDefinitionL) LoadUserExtensionResources(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExtensionResourceDefinition interface{}, mods queries.Applicator) error {
@@ -1193,6 +1642,139 @@ func (o *ExtensionResourceDefinition) RemoveAdminGroupGroup(ctx context.Context,
 	return nil
 }
 
+// SetDefaultOwnerGroupGroup of the extensionResourceDefinition to the related item.
+// Sets o.R.DefaultOwnerGroupGroup to related.
+// Adds o to related.R.DefaultOwnerGroupExtensionResourceDefinitions.
+func (o *ExtensionResourceDefinition) SetDefaultOwnerGroupGroup(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Group) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"extension_resource_definitions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"default_owner_group"}),
+		strmangle.WhereClause("\"", "\"", 2, extensionResourceDefinitionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.DefaultOwnerGroup, related.ID)
+	if o.R == nil {
+		o.R = &extensionResourceDefinitionR{
+			DefaultOwnerGroupGroup: related,
+		}
+	} else {
+		o.R.DefaultOwnerGroupGroup = related
+	}
+
+	if related.R == nil {
+		related.R = &groupR{
+			DefaultOwnerGroupExtensionResourceDefinitions: ExtensionResourceDefinitionSlice{o},
+		}
+	} else {
+		related.R.DefaultOwnerGroupExtensionResourceDefinitions = append(related.R.DefaultOwnerGroupExtensionResourceDefinitions, o)
+	}
+
+	return nil
+}
+
+// RemoveDefaultOwnerGroupGroup relationship.
+// Sets o.R.DefaultOwnerGroupGroup to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ExtensionResourceDefinition) RemoveDefaultOwnerGroupGroup(ctx context.Context, exec boil.ContextExecutor, related *Group) error {
+	var err error
+
+	queries.SetScanner(&o.DefaultOwnerGroup, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("default_owner_group")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.DefaultOwnerGroupGroup = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.DefaultOwnerGroupExtensionResourceDefinitions {
+		if queries.Equal(o.DefaultOwnerGroup, ri.DefaultOwnerGroup) {
+			continue
+		}
+
+		ln := len(related.R.DefaultOwnerGroupExtensionResourceDefinitions)
+		if ln > 1 && i < ln-1 {
+			related.R.DefaultOwnerGroupExtensionResourceDefinitions[i] = related.R.DefaultOwnerGroupExtensionResourceDefinitions[ln-1]
+		}
+		related.R.DefaultOwnerGroupExtensionResourceDefinitions = related.R.DefaultOwnerGroupExtensionResourceDefinitions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddSystemExtensionResourceVersions adds the given related objects to the existing relationships
+// of the extension_resource_definition, optionally inserting them as new records.
+// Appends related to o.R.SystemExtensionResourceVersions.
+// Sets related.R.ExtensionResourceDefinition appropriately.
+func (o *ExtensionResourceDefinition) AddSystemExtensionResourceVersions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SystemExtensionResourceVersion) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExtensionResourceDefinitionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"system_extension_resource_versions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"extension_resource_definition_id"}),
+				strmangle.WhereClause("\"", "\"", 2, systemExtensionResourceVersionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExtensionResourceDefinitionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &extensionResourceDefinitionR{
+			SystemExtensionResourceVersions: related,
+		}
+	} else {
+		o.R.SystemExtensionResourceVersions = append(o.R.SystemExtensionResourceVersions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &systemExtensionResourceVersionR{
+				ExtensionResourceDefinition: o,
+			}
+		} else {
+			rel.R.ExtensionResourceDefinition = o
+		}
+	}
+	return nil
+}
+
 // AddSystemExtensionResources adds the given related objects to the existing relationships
 // of the extension_resource_definition, optionally inserting them as new records.
 // Appends related to o.R.SystemExtensionResources.
@@ -1246,6 +1828,59 @@ func (o *ExtensionResourceDefinition) AddSystemExtensionResources(ctx context.Co
 	return nil
 }
 
+// AddUserExtensionResourceVersions adds the given related objects to the existing relationships
+// of the extension_resource_definition, optionally inserting them as new records.
+// Appends related to o.R.UserExtensionResourceVersions.
+// Sets related.R.ExtensionResourceDefinition appropriately.
+func (o *ExtensionResourceDefinition) AddUserExtensionResourceVersions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserExtensionResourceVersion) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExtensionResourceDefinitionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_extension_resource_versions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"extension_resource_definition_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userExtensionResourceVersionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExtensionResourceDefinitionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &extensionResourceDefinitionR{
+			UserExtensionResourceVersions: related,
+		}
+	} else {
+		o.R.UserExtensionResourceVersions = append(o.R.UserExtensionResourceVersions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userExtensionResourceVersionR{
+				ExtensionResourceDefinition: o,
+			}
+		} else {
+			rel.R.ExtensionResourceDefinition = o
+		}
+	}
+	return nil
+}
+
 // AddUserExtensionResources adds the given related objects to the existing relationships
 // of the extension_resource_definition, optionally inserting them as new records.
 // Appends related to o.R.UserExtensionResources.
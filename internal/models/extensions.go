@@ -108,13 +108,19 @@ var ExtensionWhere = struct {
 
 // ExtensionRels is where relationship names are stored.
 var ExtensionRels = struct {
+	EventSubjectRule             string
+	ExtensionCredentials         string
 	ExtensionResourceDefinitions string
 }{
+	EventSubjectRule:             "EventSubjectRule",
+	ExtensionCredentials:         "ExtensionCredentials",
 	ExtensionResourceDefinitions: "ExtensionResourceDefinitions",
 }
 
 // extensionR is where relationships are stored.
 type extensionR struct {
+	EventSubjectRule             *EventSubjectRule                `boil:"EventSubjectRule" json:"EventSubjectRule" toml:"EventSubjectRule" yaml:"EventSubjectRule"`
+	ExtensionCredentials         ExtensionCredentialSlice         `boil:"ExtensionCredentials" json:"ExtensionCredentials" toml:"ExtensionCredentials" yaml:"ExtensionCredentials"`
 	ExtensionResourceDefinitions ExtensionResourceDefinitionSlice `boil:"ExtensionResourceDefinitions" json:"ExtensionResourceDefinitions" toml:"ExtensionResourceDefinitions" yaml:"ExtensionResourceDefinitions"`
 }
 
@@ -123,6 +129,20 @@ func (*extensionR) NewStruct() *extensionR {
 	return &extensionR{}
 }
 
+func (r *extensionR) GetEventSubjectRule() *EventSubjectRule {
+	if r == nil {
+		return nil
+	}
+	return r.EventSubjectRule
+}
+
+func (r *extensionR) GetExtensionCredentials() ExtensionCredentialSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ExtensionCredentials
+}
+
 func (r *extensionR) GetExtensionResourceDefinitions() ExtensionResourceDefinitionSlice {
 	if r == nil {
 		return nil
@@ -446,6 +466,31 @@ func (q extensionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (
 	return count > 0, nil
 }
 
+// EventSubjectRule pointed to by the foreign key.
+func (o *Extension) EventSubjectRule(mods ...qm.QueryMod) eventSubjectRuleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"extension_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return EventSubjectRules(queryMods...)
+}
+
+// ExtensionCredentials retrieves all the extension_credential's ExtensionCredentials with an executor.
+func (o *Extension) ExtensionCredentials(mods ...qm.QueryMod) extensionCredentialQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"extension_credentials\".\"extension_id\"=?", o.ID),
+	)
+
+	return ExtensionCredentials(queryMods...)
+}
+
 // ExtensionResourceDefinitions retrieves all the extension_resource_definition's ExtensionResourceDefinitions with an executor.
 func (o *Extension) ExtensionResourceDefinitions(mods ...qm.QueryMod) extensionResourceDefinitionQuery {
 	var queryMods []qm.QueryMod
@@ -460,6 +505,236 @@ func (o *Extension) ExtensionResourceDefinitions(mods ...qm.QueryMod) extensionR
 	return ExtensionResourceDefinitions(queryMods...)
 }
 
+// LoadEventSubjectRule allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (extensionL) LoadEventSubjectRule(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExtension interface{}, mods queries.Applicator) error {
+	var slice []*Extension
+	var object *Extension
+
+	if singular {
+		var ok bool
+		object, ok = maybeExtension.(*Extension)
+		if !ok {
+			object = new(Extension)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeExtension)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeExtension))
+			}
+		}
+	} else {
+		s, ok := maybeExtension.(*[]*Extension)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeExtension)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeExtension))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &extensionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &extensionR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`event_subject_rules`),
+		qm.WhereIn(`event_subject_rules.extension_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load EventSubjectRule")
+	}
+
+	var resultSlice []*EventSubjectRule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice EventSubjectRule")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for event_subject_rules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for event_subject_rules")
+	}
+
+	if len(eventSubjectRuleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.EventSubjectRule = foreign
+		if foreign.R == nil {
+			foreign.R = &eventSubjectRuleR{}
+		}
+		foreign.R.Extension = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ID, foreign.ExtensionID) {
+				local.R.EventSubjectRule = foreign
+				if foreign.R == nil {
+					foreign.R = &eventSubjectRuleR{}
+				}
+				foreign.R.Extension = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExtensionCredentials allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (extensionL) LoadExtensionCredentials(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExtension interface{}, mods queries.Applicator) error {
+	var slice []*Extension
+	var object *Extension
+
+	if singular {
+		var ok bool
+		object, ok = maybeExtension.(*Extension)
+		if !ok {
+			object = new(Extension)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeExtension)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeExtension))
+			}
+		}
+	} else {
+		s, ok := maybeExtension.(*[]*Extension)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeExtension)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeExtension))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &extensionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &extensionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`extension_credentials`),
+		qm.WhereIn(`extension_credentials.extension_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load extension_credentials")
+	}
+
+	var resultSlice []*ExtensionCredential
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice extension_credentials")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on extension_credentials")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for extension_credentials")
+	}
+
+	if len(extensionCredentialAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExtensionCredentials = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &extensionCredentialR{}
+			}
+			foreign.R.Extension = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExtensionID {
+				local.R.ExtensionCredentials = append(local.R.ExtensionCredentials, foreign)
+				if foreign.R == nil {
+					foreign.R = &extensionCredentialR{}
+				}
+				foreign.R.Extension = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadExtensionResourceDefinitions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (extensionL) LoadExtensionResourceDefinitions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExtension interface{}, mods queries.Applicator) error {
@@ -574,6 +849,133 @@ func (extensionL) LoadExtensionResourceDefinitions(ctx context.Context, e boil.C
 	return nil
 }
 
+// SetEventSubjectRule of the extension to the related item.
+// Sets o.R.EventSubjectRule to related.
+// Adds o to related.R.Extension.
+func (o *Extension) SetEventSubjectRule(ctx context.Context, exec boil.ContextExecutor, insert bool, related *EventSubjectRule) error {
+	var err error
+
+	if insert {
+		queries.Assign(&related.ExtensionID, o.ID)
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"event_subject_rules\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"extension_id"}),
+			strmangle.WhereClause("\"", "\"", 2, eventSubjectRulePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		queries.Assign(&related.ExtensionID, o.ID)
+	}
+
+	if o.R == nil {
+		o.R = &extensionR{
+			EventSubjectRule: related,
+		}
+	} else {
+		o.R.EventSubjectRule = related
+	}
+
+	if related.R == nil {
+		related.R = &eventSubjectRuleR{
+			Extension: o,
+		}
+	} else {
+		related.R.Extension = o
+	}
+	return nil
+}
+
+// RemoveEventSubjectRule relationship.
+// Sets o.R.EventSubjectRule to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Extension) RemoveEventSubjectRule(ctx context.Context, exec boil.ContextExecutor, related *EventSubjectRule) error {
+	var err error
+
+	queries.SetScanner(&related.ExtensionID, nil)
+	if _, err = related.Update(ctx, exec, boil.Whitelist("extension_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.EventSubjectRule = nil
+	}
+
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.Extension = nil
+
+	return nil
+}
+
+// AddExtensionCredentials adds the given related objects to the existing relationships
+// of the extension, optionally inserting them as new records.
+// Appends related to o.R.ExtensionCredentials.
+// Sets related.R.Extension appropriately.
+func (o *Extension) AddExtensionCredentials(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ExtensionCredential) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExtensionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"extension_credentials\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"extension_id"}),
+				strmangle.WhereClause("\"", "\"", 2, extensionCredentialPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExtensionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &extensionR{
+			ExtensionCredentials: related,
+		}
+	} else {
+		o.R.ExtensionCredentials = append(o.R.ExtensionCredentials, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &extensionCredentialR{
+				Extension: o,
+			}
+		} else {
+			rel.R.Extension = o
+		}
+	}
+	return nil
+}
+
 // AddExtensionResourceDefinitions adds the given related objects to the existing relationships
 // of the extension, optionally inserting them as new records.
 // Appends related to o.R.ExtensionResourceDefinitions.
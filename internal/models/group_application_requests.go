@@ -32,6 +32,7 @@ type GroupApplicationRequest struct {
 	Note            null.String `boil:"note" json:"note,omitempty" toml:"note" yaml:"note,omitempty"`
 	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt       time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ExpiresAt       null.Time   `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
 
 	R *groupApplicationRequestR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L groupApplicationRequestL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -46,6 +47,7 @@ var GroupApplicationRequestColumns = struct {
 	Note            string
 	CreatedAt       string
 	UpdatedAt       string
+	ExpiresAt       string
 }{
 	ID:              "id",
 	GroupID:         "group_id",
@@ -55,6 +57,7 @@ var GroupApplicationRequestColumns = struct {
 	Note:            "note",
 	CreatedAt:       "created_at",
 	UpdatedAt:       "updated_at",
+	ExpiresAt:       "expires_at",
 }
 
 var GroupApplicationRequestTableColumns = struct {
@@ -66,6 +69,7 @@ var GroupApplicationRequestTableColumns = struct {
 	Note            string
 	CreatedAt       string
 	UpdatedAt       string
+	ExpiresAt       string
 }{
 	ID:              "group_application_requests.id",
 	GroupID:         "group_application_requests.group_id",
@@ -75,6 +79,7 @@ var GroupApplicationRequestTableColumns = struct {
 	Note:            "group_application_requests.note",
 	CreatedAt:       "group_application_requests.created_at",
 	UpdatedAt:       "group_application_requests.updated_at",
+	ExpiresAt:       "group_application_requests.expires_at",
 }
 
 // Generated where
@@ -88,6 +93,7 @@ var GroupApplicationRequestWhere = struct {
 	Note            whereHelpernull_String
 	CreatedAt       whereHelpertime_Time
 	UpdatedAt       whereHelpertime_Time
+	ExpiresAt       whereHelpernull_Time
 }{
 	ID:              whereHelperstring{field: "\"group_application_requests\".\"id\""},
 	GroupID:         whereHelperstring{field: "\"group_application_requests\".\"group_id\""},
@@ -97,27 +103,31 @@ var GroupApplicationRequestWhere = struct {
 	Note:            whereHelpernull_String{field: "\"group_application_requests\".\"note\""},
 	CreatedAt:       whereHelpertime_Time{field: "\"group_application_requests\".\"created_at\""},
 	UpdatedAt:       whereHelpertime_Time{field: "\"group_application_requests\".\"updated_at\""},
+	ExpiresAt:       whereHelpernull_Time{field: "\"group_application_requests\".\"expires_at\""},
 }
 
 // GroupApplicationRequestRels is where relationship names are stored.
 var GroupApplicationRequestRels = struct {
-	RequesterUser string
-	Group         string
-	ApproverGroup string
-	Application   string
+	RequesterUser   string
+	Group           string
+	ApproverGroup   string
+	Application     string
+	RequestComments string
 }{
-	RequesterUser: "RequesterUser",
-	Group:         "Group",
-	ApproverGroup: "ApproverGroup",
-	Application:   "Application",
+	RequesterUser:   "RequesterUser",
+	Group:           "Group",
+	ApproverGroup:   "ApproverGroup",
+	Application:     "Application",
+	RequestComments: "RequestComments",
 }
 
 // groupApplicationRequestR is where relationships are stored.
 type groupApplicationRequestR struct {
-	RequesterUser *User        `boil:"RequesterUser" json:"RequesterUser" toml:"RequesterUser" yaml:"RequesterUser"`
-	Group         *Group       `boil:"Group" json:"Group" toml:"Group" yaml:"Group"`
-	ApproverGroup *Group       `boil:"ApproverGroup" json:"ApproverGroup" toml:"ApproverGroup" yaml:"ApproverGroup"`
-	Application   *Application `boil:"Application" json:"Application" toml:"Application" yaml:"Application"`
+	RequesterUser   *User               `boil:"RequesterUser" json:"RequesterUser" toml:"RequesterUser" yaml:"RequesterUser"`
+	Group           *Group              `boil:"Group" json:"Group" toml:"Group" yaml:"Group"`
+	ApproverGroup   *Group              `boil:"ApproverGroup" json:"ApproverGroup" toml:"ApproverGroup" yaml:"ApproverGroup"`
+	Application     *Application        `boil:"Application" json:"Application" toml:"Application" yaml:"Application"`
+	RequestComments RequestCommentSlice `boil:"RequestComments" json:"RequestComments" toml:"RequestComments" yaml:"RequestComments"`
 }
 
 // NewStruct creates a new relationship struct
@@ -153,13 +163,20 @@ func (r *groupApplicationRequestR) GetApplication() *Application {
 	return r.Application
 }
 
+func (r *groupApplicationRequestR) GetRequestComments() RequestCommentSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RequestComments
+}
+
 // groupApplicationRequestL is where Load methods for each relationship are stored.
 type groupApplicationRequestL struct{}
 
 var (
-	groupApplicationRequestAllColumns            = []string{"id", "group_id", "application_id", "approver_group_id", "requester_user_id", "note", "created_at", "updated_at"}
+	groupApplicationRequestAllColumns            = []string{"id", "group_id", "application_id", "approver_group_id", "requester_user_id", "note", "created_at", "updated_at", "expires_at"}
 	groupApplicationRequestColumnsWithoutDefault = []string{"group_id", "application_id", "approver_group_id", "requester_user_id"}
-	groupApplicationRequestColumnsWithDefault    = []string{"id", "note", "created_at", "updated_at"}
+	groupApplicationRequestColumnsWithDefault    = []string{"id", "note", "created_at", "updated_at", "expires_at"}
 	groupApplicationRequestPrimaryKeyColumns     = []string{"id"}
 	groupApplicationRequestGeneratedColumns      = []string{}
 )
@@ -513,6 +530,20 @@ func (o *GroupApplicationRequest) Application(mods ...qm.QueryMod) applicationQu
 	return Applications(queryMods...)
 }
 
+// RequestComments retrieves all the request_comment's RequestComments with an executor.
+func (o *GroupApplicationRequest) RequestComments(mods ...qm.QueryMod) requestCommentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"request_comments\".\"group_application_request_id\"=?", o.ID),
+	)
+
+	return RequestComments(queryMods...)
+}
+
 // LoadRequesterUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (groupApplicationRequestL) LoadRequesterUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroupApplicationRequest interface{}, mods queries.Applicator) error {
@@ -997,6 +1028,119 @@ func (groupApplicationRequestL) LoadApplication(ctx context.Context, e boil.Cont
 	return nil
 }
 
+// LoadRequestComments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupApplicationRequestL) LoadRequestComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroupApplicationRequest interface{}, mods queries.Applicator) error {
+	var slice []*GroupApplicationRequest
+	var object *GroupApplicationRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroupApplicationRequest.(*GroupApplicationRequest)
+		if !ok {
+			object = new(GroupApplicationRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroupApplicationRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroupApplicationRequest))
+			}
+		}
+	} else {
+		s, ok := maybeGroupApplicationRequest.(*[]*GroupApplicationRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroupApplicationRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroupApplicationRequest))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupApplicationRequestR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupApplicationRequestR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`request_comments`),
+		qm.WhereIn(`request_comments.group_application_request_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load request_comments")
+	}
+
+	var resultSlice []*RequestComment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice request_comments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on request_comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for request_comments")
+	}
+
+	if len(requestCommentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RequestComments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &requestCommentR{}
+			}
+			foreign.R.GroupApplicationRequest = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.GroupApplicationRequestID) {
+				local.R.RequestComments = append(local.R.RequestComments, foreign)
+				if foreign.R == nil {
+					foreign.R = &requestCommentR{}
+				}
+				foreign.R.GroupApplicationRequest = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetRequesterUser of the groupApplicationRequest to the related item.
 // Sets o.R.RequesterUser to related.
 // Adds o to related.R.RequesterUserGroupApplicationRequests.
@@ -1185,6 +1329,133 @@ func (o *GroupApplicationRequest) SetApplication(ctx context.Context, exec boil.
 	return nil
 }
 
+// AddRequestComments adds the given related objects to the existing relationships
+// of the group_application_request, optionally inserting them as new records.
+// Appends related to o.R.RequestComments.
+// Sets related.R.GroupApplicationRequest appropriately.
+func (o *GroupApplicationRequest) AddRequestComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RequestComment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.GroupApplicationRequestID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"request_comments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"group_application_request_id"}),
+				strmangle.WhereClause("\"", "\"", 2, requestCommentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.GroupApplicationRequestID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &groupApplicationRequestR{
+			RequestComments: related,
+		}
+	} else {
+		o.R.RequestComments = append(o.R.RequestComments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &requestCommentR{
+				GroupApplicationRequest: o,
+			}
+		} else {
+			rel.R.GroupApplicationRequest = o
+		}
+	}
+	return nil
+}
+
+// SetRequestComments removes all previously related items of the
+// group_application_request replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.GroupApplicationRequest's RequestComments accordingly.
+// Replaces o.R.RequestComments with related.
+// Sets related.R.GroupApplicationRequest's RequestComments accordingly.
+func (o *GroupApplicationRequest) SetRequestComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RequestComment) error {
+	query := "update \"request_comments\" set \"group_application_request_id\" = null where \"group_application_request_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.RequestComments {
+			queries.SetScanner(&rel.GroupApplicationRequestID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.GroupApplicationRequest = nil
+		}
+		o.R.RequestComments = nil
+	}
+
+	return o.AddRequestComments(ctx, exec, insert, related...)
+}
+
+// RemoveRequestComments relationships from objects passed in.
+// Removes related items from R.RequestComments (uses pointer comparison, removal does not keep order)
+// Sets related.R.GroupApplicationRequest.
+func (o *GroupApplicationRequest) RemoveRequestComments(ctx context.Context, exec boil.ContextExecutor, related ...*RequestComment) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.GroupApplicationRequestID, nil)
+		if rel.R != nil {
+			rel.R.GroupApplicationRequest = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("group_application_request_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.RequestComments {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.RequestComments)
+			if ln > 1 && i < ln-1 {
+				o.R.RequestComments[i] = o.R.RequestComments[ln-1]
+			}
+			o.R.RequestComments = o.R.RequestComments[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // GroupApplicationRequests retrieves all the records using an executor.
 func GroupApplicationRequests(mods ...qm.QueryMod) groupApplicationRequestQuery {
 	mods = append(mods, qm.From("\"group_application_requests\""))
@@ -30,6 +30,7 @@ type GroupApplication struct {
 	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt     time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	DeletedAt     null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ExpiresAt     null.Time `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
 
 	R *groupApplicationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L groupApplicationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -42,6 +43,7 @@ var GroupApplicationColumns = struct {
 	CreatedAt     string
 	UpdatedAt     string
 	DeletedAt     string
+	ExpiresAt     string
 }{
 	ID:            "id",
 	GroupID:       "group_id",
@@ -49,6 +51,7 @@ var GroupApplicationColumns = struct {
 	CreatedAt:     "created_at",
 	UpdatedAt:     "updated_at",
 	DeletedAt:     "deleted_at",
+	ExpiresAt:     "expires_at",
 }
 
 var GroupApplicationTableColumns = struct {
@@ -58,6 +61,7 @@ var GroupApplicationTableColumns = struct {
 	CreatedAt     string
 	UpdatedAt     string
 	DeletedAt     string
+	ExpiresAt     string
 }{
 	ID:            "group_applications.id",
 	GroupID:       "group_applications.group_id",
@@ -65,6 +69,7 @@ var GroupApplicationTableColumns = struct {
 	CreatedAt:     "group_applications.created_at",
 	UpdatedAt:     "group_applications.updated_at",
 	DeletedAt:     "group_applications.deleted_at",
+	ExpiresAt:     "group_applications.expires_at",
 }
 
 // Generated where
@@ -76,6 +81,7 @@ var GroupApplicationWhere = struct {
 	CreatedAt     whereHelpertime_Time
 	UpdatedAt     whereHelpertime_Time
 	DeletedAt     whereHelpernull_Time
+	ExpiresAt     whereHelpernull_Time
 }{
 	ID:            whereHelperstring{field: "\"group_applications\".\"id\""},
 	GroupID:       whereHelperstring{field: "\"group_applications\".\"group_id\""},
@@ -83,6 +89,7 @@ var GroupApplicationWhere = struct {
 	CreatedAt:     whereHelpertime_Time{field: "\"group_applications\".\"created_at\""},
 	UpdatedAt:     whereHelpertime_Time{field: "\"group_applications\".\"updated_at\""},
 	DeletedAt:     whereHelpernull_Time{field: "\"group_applications\".\"deleted_at\""},
+	ExpiresAt:     whereHelpernull_Time{field: "\"group_applications\".\"expires_at\""},
 }
 
 // GroupApplicationRels is where relationship names are stored.
@@ -123,9 +130,9 @@ func (r *groupApplicationR) GetApplication() *Application {
 type groupApplicationL struct{}
 
 var (
-	groupApplicationAllColumns            = []string{"id", "group_id", "application_id", "created_at", "updated_at", "deleted_at"}
+	groupApplicationAllColumns            = []string{"id", "group_id", "application_id", "created_at", "updated_at", "deleted_at", "expires_at"}
 	groupApplicationColumnsWithoutDefault = []string{"group_id", "application_id"}
-	groupApplicationColumnsWithDefault    = []string{"id", "created_at", "updated_at", "deleted_at"}
+	groupApplicationColumnsWithDefault    = []string{"id", "created_at", "updated_at", "deleted_at", "expires_at"}
 	groupApplicationPrimaryKeyColumns     = []string{"id"}
 	groupApplicationGeneratedColumns      = []string{}
 )
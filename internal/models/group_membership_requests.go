@@ -115,17 +115,20 @@ var GroupMembershipRequestWhere = struct {
 
 // GroupMembershipRequestRels is where relationship names are stored.
 var GroupMembershipRequestRels = struct {
-	User  string
-	Group string
+	User            string
+	Group           string
+	RequestComments string
 }{
-	User:  "User",
-	Group: "Group",
+	User:            "User",
+	Group:           "Group",
+	RequestComments: "RequestComments",
 }
 
 // groupMembershipRequestR is where relationships are stored.
 type groupMembershipRequestR struct {
-	User  *User  `boil:"User" json:"User" toml:"User" yaml:"User"`
-	Group *Group `boil:"Group" json:"Group" toml:"Group" yaml:"Group"`
+	User            *User               `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Group           *Group              `boil:"Group" json:"Group" toml:"Group" yaml:"Group"`
+	RequestComments RequestCommentSlice `boil:"RequestComments" json:"RequestComments" toml:"RequestComments" yaml:"RequestComments"`
 }
 
 // NewStruct creates a new relationship struct
@@ -147,6 +150,13 @@ func (r *groupMembershipRequestR) GetGroup() *Group {
 	return r.Group
 }
 
+func (r *groupMembershipRequestR) GetRequestComments() RequestCommentSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RequestComments
+}
+
 // groupMembershipRequestL is where Load methods for each relationship are stored.
 type groupMembershipRequestL struct{}
 
@@ -485,6 +495,20 @@ func (o *GroupMembershipRequest) Group(mods ...qm.QueryMod) groupQuery {
 	return Groups(queryMods...)
 }
 
+// RequestComments retrieves all the request_comment's RequestComments with an executor.
+func (o *GroupMembershipRequest) RequestComments(mods ...qm.QueryMod) requestCommentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"request_comments\".\"group_membership_request_id\"=?", o.ID),
+	)
+
+	return RequestComments(queryMods...)
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (groupMembershipRequestL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroupMembershipRequest interface{}, mods queries.Applicator) error {
@@ -727,6 +751,119 @@ func (groupMembershipRequestL) LoadGroup(ctx context.Context, e boil.ContextExec
 	return nil
 }
 
+// LoadRequestComments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupMembershipRequestL) LoadRequestComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroupMembershipRequest interface{}, mods queries.Applicator) error {
+	var slice []*GroupMembershipRequest
+	var object *GroupMembershipRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroupMembershipRequest.(*GroupMembershipRequest)
+		if !ok {
+			object = new(GroupMembershipRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroupMembershipRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroupMembershipRequest))
+			}
+		}
+	} else {
+		s, ok := maybeGroupMembershipRequest.(*[]*GroupMembershipRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroupMembershipRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroupMembershipRequest))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupMembershipRequestR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupMembershipRequestR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`request_comments`),
+		qm.WhereIn(`request_comments.group_membership_request_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load request_comments")
+	}
+
+	var resultSlice []*RequestComment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice request_comments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on request_comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for request_comments")
+	}
+
+	if len(requestCommentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RequestComments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &requestCommentR{}
+			}
+			foreign.R.GroupMembershipRequest = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.GroupMembershipRequestID) {
+				local.R.RequestComments = append(local.R.RequestComments, foreign)
+				if foreign.R == nil {
+					foreign.R = &requestCommentR{}
+				}
+				foreign.R.GroupMembershipRequest = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetUser of the groupMembershipRequest to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.GroupMembershipRequests.
@@ -821,6 +958,133 @@ func (o *GroupMembershipRequest) SetGroup(ctx context.Context, exec boil.Context
 	return nil
 }
 
+// AddRequestComments adds the given related objects to the existing relationships
+// of the group_membership_request, optionally inserting them as new records.
+// Appends related to o.R.RequestComments.
+// Sets related.R.GroupMembershipRequest appropriately.
+func (o *GroupMembershipRequest) AddRequestComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RequestComment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.GroupMembershipRequestID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"request_comments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"group_membership_request_id"}),
+				strmangle.WhereClause("\"", "\"", 2, requestCommentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.GroupMembershipRequestID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &groupMembershipRequestR{
+			RequestComments: related,
+		}
+	} else {
+		o.R.RequestComments = append(o.R.RequestComments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &requestCommentR{
+				GroupMembershipRequest: o,
+			}
+		} else {
+			rel.R.GroupMembershipRequest = o
+		}
+	}
+	return nil
+}
+
+// SetRequestComments removes all previously related items of the
+// group_membership_request replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.GroupMembershipRequest's RequestComments accordingly.
+// Replaces o.R.RequestComments with related.
+// Sets related.R.GroupMembershipRequest's RequestComments accordingly.
+func (o *GroupMembershipRequest) SetRequestComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RequestComment) error {
+	query := "update \"request_comments\" set \"group_membership_request_id\" = null where \"group_membership_request_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.RequestComments {
+			queries.SetScanner(&rel.GroupMembershipRequestID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.GroupMembershipRequest = nil
+		}
+		o.R.RequestComments = nil
+	}
+
+	return o.AddRequestComments(ctx, exec, insert, related...)
+}
+
+// RemoveRequestComments relationships from objects passed in.
+// Removes related items from R.RequestComments (uses pointer comparison, removal does not keep order)
+// Sets related.R.GroupMembershipRequest.
+func (o *GroupMembershipRequest) RemoveRequestComments(ctx context.Context, exec boil.ContextExecutor, related ...*RequestComment) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.GroupMembershipRequestID, nil)
+		if rel.R != nil {
+			rel.R.GroupMembershipRequest = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("group_membership_request_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.RequestComments {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.RequestComments)
+			if ln > 1 && i < ln-1 {
+				o.R.RequestComments[i] = o.R.RequestComments[ln-1]
+			}
+			o.R.RequestComments = o.R.RequestComments[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // GroupMembershipRequests retrieves all the records using an executor.
 func GroupMembershipRequests(mods ...qm.QueryMod) groupMembershipRequestQuery {
 	mods = append(mods, qm.From("\"group_membership_requests\""))
@@ -24,79 +24,135 @@ import (
 
 // GroupMembership is an object representing the database table.
 type GroupMembership struct {
-	ID             string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	GroupID        string    `boil:"group_id" json:"group_id" toml:"group_id" yaml:"group_id"`
-	UserID         string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	IsAdmin        bool      `boil:"is_admin" json:"is_admin" toml:"is_admin" yaml:"is_admin"`
-	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	ExpiresAt      null.Time `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
-	AdminExpiresAt null.Time `boil:"admin_expires_at" json:"admin_expires_at,omitempty" toml:"admin_expires_at" yaml:"admin_expires_at,omitempty"`
+	ID                     string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	GroupID                string      `boil:"group_id" json:"group_id" toml:"group_id" yaml:"group_id"`
+	UserID                 string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	IsAdmin                bool        `boil:"is_admin" json:"is_admin" toml:"is_admin" yaml:"is_admin"`
+	CreatedAt              time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt              time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ExpiresAt              null.Time   `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
+	AdminExpiresAt         null.Time   `boil:"admin_expires_at" json:"admin_expires_at,omitempty" toml:"admin_expires_at" yaml:"admin_expires_at,omitempty"`
+	Source                 string      `boil:"source" json:"source" toml:"source" yaml:"source"`
+	SourceRef              null.String `boil:"source_ref" json:"source_ref,omitempty" toml:"source_ref" yaml:"source_ref,omitempty"`
+	ExemptedAt             null.Time   `boil:"exempted_at" json:"exempted_at,omitempty" toml:"exempted_at" yaml:"exempted_at,omitempty"`
+	ExemptionExpiresAt     null.Time   `boil:"exemption_expires_at" json:"exemption_expires_at,omitempty" toml:"exemption_expires_at" yaml:"exemption_expires_at,omitempty"`
+	ExemptionJustification null.String `boil:"exemption_justification" json:"exemption_justification,omitempty" toml:"exemption_justification" yaml:"exemption_justification,omitempty"`
+	ExemptionApprovedBy    null.String `boil:"exemption_approved_by" json:"exemption_approved_by,omitempty" toml:"exemption_approved_by" yaml:"exemption_approved_by,omitempty"`
+	Justification          string      `boil:"justification" json:"justification" toml:"justification" yaml:"justification"`
+	Note                   string      `boil:"note" json:"note" toml:"note" yaml:"note"`
 
 	R *groupMembershipR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L groupMembershipL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var GroupMembershipColumns = struct {
-	ID             string
-	GroupID        string
-	UserID         string
-	IsAdmin        string
-	CreatedAt      string
-	UpdatedAt      string
-	ExpiresAt      string
-	AdminExpiresAt string
+	ID                     string
+	GroupID                string
+	UserID                 string
+	IsAdmin                string
+	CreatedAt              string
+	UpdatedAt              string
+	ExpiresAt              string
+	AdminExpiresAt         string
+	Source                 string
+	SourceRef              string
+	ExemptedAt             string
+	ExemptionExpiresAt     string
+	ExemptionJustification string
+	ExemptionApprovedBy    string
+	Justification          string
+	Note                   string
 }{
-	ID:             "id",
-	GroupID:        "group_id",
-	UserID:         "user_id",
-	IsAdmin:        "is_admin",
-	CreatedAt:      "created_at",
-	UpdatedAt:      "updated_at",
-	ExpiresAt:      "expires_at",
-	AdminExpiresAt: "admin_expires_at",
+	ID:                     "id",
+	GroupID:                "group_id",
+	UserID:                 "user_id",
+	IsAdmin:                "is_admin",
+	CreatedAt:              "created_at",
+	UpdatedAt:              "updated_at",
+	ExpiresAt:              "expires_at",
+	AdminExpiresAt:         "admin_expires_at",
+	Source:                 "source",
+	SourceRef:              "source_ref",
+	ExemptedAt:             "exempted_at",
+	ExemptionExpiresAt:     "exemption_expires_at",
+	ExemptionJustification: "exemption_justification",
+	ExemptionApprovedBy:    "exemption_approved_by",
+	Justification:          "justification",
+	Note:                   "note",
 }
 
 var GroupMembershipTableColumns = struct {
-	ID             string
-	GroupID        string
-	UserID         string
-	IsAdmin        string
-	CreatedAt      string
-	UpdatedAt      string
-	ExpiresAt      string
-	AdminExpiresAt string
+	ID                     string
+	GroupID                string
+	UserID                 string
+	IsAdmin                string
+	CreatedAt              string
+	UpdatedAt              string
+	ExpiresAt              string
+	AdminExpiresAt         string
+	Source                 string
+	SourceRef              string
+	ExemptedAt             string
+	ExemptionExpiresAt     string
+	ExemptionJustification string
+	ExemptionApprovedBy    string
+	Justification          string
+	Note                   string
 }{
-	ID:             "group_memberships.id",
-	GroupID:        "group_memberships.group_id",
-	UserID:         "group_memberships.user_id",
-	IsAdmin:        "group_memberships.is_admin",
-	CreatedAt:      "group_memberships.created_at",
-	UpdatedAt:      "group_memberships.updated_at",
-	ExpiresAt:      "group_memberships.expires_at",
-	AdminExpiresAt: "group_memberships.admin_expires_at",
+	ID:                     "group_memberships.id",
+	GroupID:                "group_memberships.group_id",
+	UserID:                 "group_memberships.user_id",
+	IsAdmin:                "group_memberships.is_admin",
+	CreatedAt:              "group_memberships.created_at",
+	UpdatedAt:              "group_memberships.updated_at",
+	ExpiresAt:              "group_memberships.expires_at",
+	AdminExpiresAt:         "group_memberships.admin_expires_at",
+	Source:                 "group_memberships.source",
+	SourceRef:              "group_memberships.source_ref",
+	ExemptedAt:             "group_memberships.exempted_at",
+	ExemptionExpiresAt:     "group_memberships.exemption_expires_at",
+	ExemptionJustification: "group_memberships.exemption_justification",
+	ExemptionApprovedBy:    "group_memberships.exemption_approved_by",
+	Justification:          "group_memberships.justification",
+	Note:                   "group_memberships.note",
 }
 
 // Generated where
 
 var GroupMembershipWhere = struct {
-	ID             whereHelperstring
-	GroupID        whereHelperstring
-	UserID         whereHelperstring
-	IsAdmin        whereHelperbool
-	CreatedAt      whereHelpertime_Time
-	UpdatedAt      whereHelpertime_Time
-	ExpiresAt      whereHelpernull_Time
-	AdminExpiresAt whereHelpernull_Time
+	ID                     whereHelperstring
+	GroupID                whereHelperstring
+	UserID                 whereHelperstring
+	IsAdmin                whereHelperbool
+	CreatedAt              whereHelpertime_Time
+	UpdatedAt              whereHelpertime_Time
+	ExpiresAt              whereHelpernull_Time
+	AdminExpiresAt         whereHelpernull_Time
+	Source                 whereHelperstring
+	SourceRef              whereHelpernull_String
+	ExemptedAt             whereHelpernull_Time
+	ExemptionExpiresAt     whereHelpernull_Time
+	ExemptionJustification whereHelpernull_String
+	ExemptionApprovedBy    whereHelpernull_String
+	Justification          whereHelperstring
+	Note                   whereHelperstring
 }{
-	ID:             whereHelperstring{field: "\"group_memberships\".\"id\""},
-	GroupID:        whereHelperstring{field: "\"group_memberships\".\"group_id\""},
-	UserID:         whereHelperstring{field: "\"group_memberships\".\"user_id\""},
-	IsAdmin:        whereHelperbool{field: "\"group_memberships\".\"is_admin\""},
-	CreatedAt:      whereHelpertime_Time{field: "\"group_memberships\".\"created_at\""},
-	UpdatedAt:      whereHelpertime_Time{field: "\"group_memberships\".\"updated_at\""},
-	ExpiresAt:      whereHelpernull_Time{field: "\"group_memberships\".\"expires_at\""},
-	AdminExpiresAt: whereHelpernull_Time{field: "\"group_memberships\".\"admin_expires_at\""},
+	ID:                     whereHelperstring{field: "\"group_memberships\".\"id\""},
+	GroupID:                whereHelperstring{field: "\"group_memberships\".\"group_id\""},
+	UserID:                 whereHelperstring{field: "\"group_memberships\".\"user_id\""},
+	IsAdmin:                whereHelperbool{field: "\"group_memberships\".\"is_admin\""},
+	CreatedAt:              whereHelpertime_Time{field: "\"group_memberships\".\"created_at\""},
+	UpdatedAt:              whereHelpertime_Time{field: "\"group_memberships\".\"updated_at\""},
+	ExpiresAt:              whereHelpernull_Time{field: "\"group_memberships\".\"expires_at\""},
+	AdminExpiresAt:         whereHelpernull_Time{field: "\"group_memberships\".\"admin_expires_at\""},
+	Source:                 whereHelperstring{field: "\"group_memberships\".\"source\""},
+	SourceRef:              whereHelpernull_String{field: "\"group_memberships\".\"source_ref\""},
+	ExemptedAt:             whereHelpernull_Time{field: "\"group_memberships\".\"exempted_at\""},
+	ExemptionExpiresAt:     whereHelpernull_Time{field: "\"group_memberships\".\"exemption_expires_at\""},
+	ExemptionJustification: whereHelpernull_String{field: "\"group_memberships\".\"exemption_justification\""},
+	ExemptionApprovedBy:    whereHelpernull_String{field: "\"group_memberships\".\"exemption_approved_by\""},
+	Justification:          whereHelperstring{field: "\"group_memberships\".\"justification\""},
+	Note:                   whereHelperstring{field: "\"group_memberships\".\"note\""},
 }
 
 // GroupMembershipRels is where relationship names are stored.
@@ -137,9 +193,9 @@ func (r *groupMembershipR) GetGroup() *Group {
 type groupMembershipL struct{}
 
 var (
-	groupMembershipAllColumns            = []string{"id", "group_id", "user_id", "is_admin", "created_at", "updated_at", "expires_at", "admin_expires_at"}
+	groupMembershipAllColumns            = []string{"id", "group_id", "user_id", "is_admin", "created_at", "updated_at", "expires_at", "admin_expires_at", "source", "source_ref", "exempted_at", "exemption_expires_at", "exemption_justification", "exemption_approved_by", "justification", "note"}
 	groupMembershipColumnsWithoutDefault = []string{"group_id", "user_id", "created_at", "updated_at"}
-	groupMembershipColumnsWithDefault    = []string{"id", "is_admin", "expires_at", "admin_expires_at"}
+	groupMembershipColumnsWithDefault    = []string{"id", "is_admin", "expires_at", "admin_expires_at", "source", "source_ref", "exempted_at", "exemption_expires_at", "exemption_justification", "exemption_approved_by", "justification", "note"}
 	groupMembershipPrimaryKeyColumns     = []string{"id"}
 	groupMembershipGeneratedColumns      = []string{}
 )
@@ -24,134 +24,252 @@ import (
 
 // Group is an object representing the database table.
 type Group struct {
-	ID            string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name          string      `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Slug          string      `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
-	Description   string      `boil:"description" json:"description" toml:"description" yaml:"description"`
-	CreatedAt     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt     time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedAt     null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
-	Note          string      `boil:"note" json:"note" toml:"note" yaml:"note"`
-	ApproverGroup null.String `boil:"approver_group" json:"approver_group,omitempty" toml:"approver_group" yaml:"approver_group,omitempty"`
+	ID                       string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name                     string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Slug                     string      `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
+	Description              string      `boil:"description" json:"description" toml:"description" yaml:"description"`
+	CreatedAt                time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt                time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt                null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	Note                     string      `boil:"note" json:"note" toml:"note" yaml:"note"`
+	ApproverGroup            null.String `boil:"approver_group" json:"approver_group,omitempty" toml:"approver_group" yaml:"approver_group,omitempty"`
+	TenantID                 null.String `boil:"tenant_id" json:"tenant_id,omitempty" toml:"tenant_id" yaml:"tenant_id,omitempty"`
+	OwnerContact             string      `boil:"owner_contact" json:"owner_contact" toml:"owner_contact" yaml:"owner_contact"`
+	DocsURL                  string      `boil:"docs_url" json:"docs_url" toml:"docs_url" yaml:"docs_url"`
+	SlackChannel             string      `boil:"slack_channel" json:"slack_channel" toml:"slack_channel" yaml:"slack_channel"`
+	CostCenter               string      `boil:"cost_center" json:"cost_center" toml:"cost_center" yaml:"cost_center"`
+	DefaultMembershipTTLDays int64       `boil:"default_membership_ttl_days" json:"default_membership_ttl_days" toml:"default_membership_ttl_days" yaml:"default_membership_ttl_days"`
+	FrozenAt                 null.Time   `boil:"frozen_at" json:"frozen_at,omitempty" toml:"frozen_at" yaml:"frozen_at,omitempty"`
+	FrozenReason             string      `boil:"frozen_reason" json:"frozen_reason" toml:"frozen_reason" yaml:"frozen_reason"`
+	MemberSoftLimit          int64       `boil:"member_soft_limit" json:"member_soft_limit" toml:"member_soft_limit" yaml:"member_soft_limit"`
+	MemberHardLimit          int64       `boil:"member_hard_limit" json:"member_hard_limit" toml:"member_hard_limit" yaml:"member_hard_limit"`
+	JustificationMinLength   int64       `boil:"justification_min_length" json:"justification_min_length" toml:"justification_min_length" yaml:"justification_min_length"`
+	ProtectedAt              null.Time   `boil:"protected_at" json:"protected_at,omitempty" toml:"protected_at" yaml:"protected_at,omitempty"`
+	BreakGlassAt             null.Time   `boil:"break_glass_at" json:"break_glass_at,omitempty" toml:"break_glass_at" yaml:"break_glass_at,omitempty"`
 
 	R *groupR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L groupL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var GroupColumns = struct {
-	ID            string
-	Name          string
-	Slug          string
-	Description   string
-	CreatedAt     string
-	UpdatedAt     string
-	DeletedAt     string
-	Note          string
-	ApproverGroup string
+	ID                       string
+	Name                     string
+	Slug                     string
+	Description              string
+	CreatedAt                string
+	UpdatedAt                string
+	DeletedAt                string
+	Note                     string
+	ApproverGroup            string
+	TenantID                 string
+	OwnerContact             string
+	DocsURL                  string
+	SlackChannel             string
+	CostCenter               string
+	DefaultMembershipTTLDays string
+	FrozenAt                 string
+	FrozenReason             string
+	MemberSoftLimit          string
+	MemberHardLimit          string
+	JustificationMinLength   string
+	ProtectedAt              string
+	BreakGlassAt             string
 }{
-	ID:            "id",
-	Name:          "name",
-	Slug:          "slug",
-	Description:   "description",
-	CreatedAt:     "created_at",
-	UpdatedAt:     "updated_at",
-	DeletedAt:     "deleted_at",
-	Note:          "note",
-	ApproverGroup: "approver_group",
+	ID:                       "id",
+	Name:                     "name",
+	Slug:                     "slug",
+	Description:              "description",
+	CreatedAt:                "created_at",
+	UpdatedAt:                "updated_at",
+	DeletedAt:                "deleted_at",
+	Note:                     "note",
+	ApproverGroup:            "approver_group",
+	TenantID:                 "tenant_id",
+	OwnerContact:             "owner_contact",
+	DocsURL:                  "docs_url",
+	SlackChannel:             "slack_channel",
+	CostCenter:               "cost_center",
+	DefaultMembershipTTLDays: "default_membership_ttl_days",
+	FrozenAt:                 "frozen_at",
+	FrozenReason:             "frozen_reason",
+	MemberSoftLimit:          "member_soft_limit",
+	MemberHardLimit:          "member_hard_limit",
+	JustificationMinLength:   "justification_min_length",
+	ProtectedAt:              "protected_at",
+	BreakGlassAt:             "break_glass_at",
 }
 
 var GroupTableColumns = struct {
-	ID            string
-	Name          string
-	Slug          string
-	Description   string
-	CreatedAt     string
-	UpdatedAt     string
-	DeletedAt     string
-	Note          string
-	ApproverGroup string
+	ID                       string
+	Name                     string
+	Slug                     string
+	Description              string
+	CreatedAt                string
+	UpdatedAt                string
+	DeletedAt                string
+	Note                     string
+	ApproverGroup            string
+	TenantID                 string
+	OwnerContact             string
+	DocsURL                  string
+	SlackChannel             string
+	CostCenter               string
+	DefaultMembershipTTLDays string
+	FrozenAt                 string
+	FrozenReason             string
+	MemberSoftLimit          string
+	MemberHardLimit          string
+	JustificationMinLength   string
+	ProtectedAt              string
+	BreakGlassAt             string
 }{
-	ID:            "groups.id",
-	Name:          "groups.name",
-	Slug:          "groups.slug",
-	Description:   "groups.description",
-	CreatedAt:     "groups.created_at",
-	UpdatedAt:     "groups.updated_at",
-	DeletedAt:     "groups.deleted_at",
-	Note:          "groups.note",
-	ApproverGroup: "groups.approver_group",
+	ID:                       "groups.id",
+	Name:                     "groups.name",
+	Slug:                     "groups.slug",
+	Description:              "groups.description",
+	CreatedAt:                "groups.created_at",
+	UpdatedAt:                "groups.updated_at",
+	DeletedAt:                "groups.deleted_at",
+	Note:                     "groups.note",
+	ApproverGroup:            "groups.approver_group",
+	TenantID:                 "groups.tenant_id",
+	OwnerContact:             "groups.owner_contact",
+	DocsURL:                  "groups.docs_url",
+	SlackChannel:             "groups.slack_channel",
+	CostCenter:               "groups.cost_center",
+	DefaultMembershipTTLDays: "groups.default_membership_ttl_days",
+	FrozenAt:                 "groups.frozen_at",
+	FrozenReason:             "groups.frozen_reason",
+	MemberSoftLimit:          "groups.member_soft_limit",
+	MemberHardLimit:          "groups.member_hard_limit",
+	JustificationMinLength:   "groups.justification_min_length",
+	ProtectedAt:              "groups.protected_at",
+	BreakGlassAt:             "groups.break_glass_at",
 }
 
 // Generated where
 
 var GroupWhere = struct {
-	ID            whereHelperstring
-	Name          whereHelperstring
-	Slug          whereHelperstring
-	Description   whereHelperstring
-	CreatedAt     whereHelpertime_Time
-	UpdatedAt     whereHelpertime_Time
-	DeletedAt     whereHelpernull_Time
-	Note          whereHelperstring
-	ApproverGroup whereHelpernull_String
+	ID                       whereHelperstring
+	Name                     whereHelperstring
+	Slug                     whereHelperstring
+	Description              whereHelperstring
+	CreatedAt                whereHelpertime_Time
+	UpdatedAt                whereHelpertime_Time
+	DeletedAt                whereHelpernull_Time
+	Note                     whereHelperstring
+	ApproverGroup            whereHelpernull_String
+	TenantID                 whereHelpernull_String
+	OwnerContact             whereHelperstring
+	DocsURL                  whereHelperstring
+	SlackChannel             whereHelperstring
+	CostCenter               whereHelperstring
+	DefaultMembershipTTLDays whereHelperint64
+	FrozenAt                 whereHelpernull_Time
+	FrozenReason             whereHelperstring
+	MemberSoftLimit          whereHelperint64
+	MemberHardLimit          whereHelperint64
+	JustificationMinLength   whereHelperint64
+	ProtectedAt              whereHelpernull_Time
+	BreakGlassAt             whereHelpernull_Time
 }{
-	ID:            whereHelperstring{field: "\"groups\".\"id\""},
-	Name:          whereHelperstring{field: "\"groups\".\"name\""},
-	Slug:          whereHelperstring{field: "\"groups\".\"slug\""},
-	Description:   whereHelperstring{field: "\"groups\".\"description\""},
-	CreatedAt:     whereHelpertime_Time{field: "\"groups\".\"created_at\""},
-	UpdatedAt:     whereHelpertime_Time{field: "\"groups\".\"updated_at\""},
-	DeletedAt:     whereHelpernull_Time{field: "\"groups\".\"deleted_at\""},
-	Note:          whereHelperstring{field: "\"groups\".\"note\""},
-	ApproverGroup: whereHelpernull_String{field: "\"groups\".\"approver_group\""},
+	ID:                       whereHelperstring{field: "\"groups\".\"id\""},
+	Name:                     whereHelperstring{field: "\"groups\".\"name\""},
+	Slug:                     whereHelperstring{field: "\"groups\".\"slug\""},
+	Description:              whereHelperstring{field: "\"groups\".\"description\""},
+	CreatedAt:                whereHelpertime_Time{field: "\"groups\".\"created_at\""},
+	UpdatedAt:                whereHelpertime_Time{field: "\"groups\".\"updated_at\""},
+	DeletedAt:                whereHelpernull_Time{field: "\"groups\".\"deleted_at\""},
+	Note:                     whereHelperstring{field: "\"groups\".\"note\""},
+	ApproverGroup:            whereHelpernull_String{field: "\"groups\".\"approver_group\""},
+	TenantID:                 whereHelpernull_String{field: "\"groups\".\"tenant_id\""},
+	OwnerContact:             whereHelperstring{field: "\"groups\".\"owner_contact\""},
+	DocsURL:                  whereHelperstring{field: "\"groups\".\"docs_url\""},
+	SlackChannel:             whereHelperstring{field: "\"groups\".\"slack_channel\""},
+	CostCenter:               whereHelperstring{field: "\"groups\".\"cost_center\""},
+	DefaultMembershipTTLDays: whereHelperint64{field: "\"groups\".\"default_membership_ttl_days\""},
+	FrozenAt:                 whereHelpernull_Time{field: "\"groups\".\"frozen_at\""},
+	FrozenReason:             whereHelperstring{field: "\"groups\".\"frozen_reason\""},
+	MemberSoftLimit:          whereHelperint64{field: "\"groups\".\"member_soft_limit\""},
+	MemberHardLimit:          whereHelperint64{field: "\"groups\".\"member_hard_limit\""},
+	JustificationMinLength:   whereHelperint64{field: "\"groups\".\"justification_min_length\""},
+	ProtectedAt:              whereHelpernull_Time{field: "\"groups\".\"protected_at\""},
+	BreakGlassAt:             whereHelpernull_Time{field: "\"groups\".\"break_glass_at\""},
 }
 
 // GroupRels is where relationship names are stored.
 var GroupRels = struct {
-	ApproverGroupGroup                     string
-	ApproverGroupApplications              string
-	SubjectGroupAuditEvents                string
-	AdminGroupExtensionResourceDefinitions string
-	GroupApplicationRequests               string
-	ApproverGroupGroupApplicationRequests  string
-	GroupApplications                      string
-	ParentGroupGroupHierarchies            string
-	MemberGroupGroupHierarchies            string
-	GroupMembershipRequests                string
-	GroupMemberships                       string
-	GroupOrganizations                     string
-	ApproverGroupGroups                    string
+	ApproverGroupGroup                            string
+	Tenant                                        string
+	GithubTeamExport                              string
+	MembershipDurationPolicy                      string
+	ApproverGroupApplications                     string
+	ArchivedRequests                              string
+	ApproverGroupArchivedRequests                 string
+	SubjectGroupAuditEvents                       string
+	AdminGroupExtensionResourceDefinitions        string
+	DefaultOwnerGroupExtensionResourceDefinitions string
+	GroupApplicationRequests                      string
+	ApproverGroupGroupApplicationRequests         string
+	GroupApplications                             string
+	ParentGroupGroupHierarchies                   string
+	MemberGroupGroupHierarchies                   string
+	GroupMembershipRequests                       string
+	GroupMemberships                              string
+	GroupOrganizations                            string
+	ApproverGroupGroups                           string
+	NotificationDispatches                        string
+	NotificationGroupPreferences                  string
+	OwnerSystemExtensionResources                 string
 }{
-	ApproverGroupGroup:                     "ApproverGroupGroup",
-	ApproverGroupApplications:              "ApproverGroupApplications",
-	SubjectGroupAuditEvents:                "SubjectGroupAuditEvents",
-	AdminGroupExtensionResourceDefinitions: "AdminGroupExtensionResourceDefinitions",
-	GroupApplicationRequests:               "GroupApplicationRequests",
-	ApproverGroupGroupApplicationRequests:  "ApproverGroupGroupApplicationRequests",
-	GroupApplications:                      "GroupApplications",
-	ParentGroupGroupHierarchies:            "ParentGroupGroupHierarchies",
-	MemberGroupGroupHierarchies:            "MemberGroupGroupHierarchies",
-	GroupMembershipRequests:                "GroupMembershipRequests",
-	GroupMemberships:                       "GroupMemberships",
-	GroupOrganizations:                     "GroupOrganizations",
-	ApproverGroupGroups:                    "ApproverGroupGroups",
+	ApproverGroupGroup:                            "ApproverGroupGroup",
+	Tenant:                                        "Tenant",
+	GithubTeamExport:                              "GithubTeamExport",
+	MembershipDurationPolicy:                      "MembershipDurationPolicy",
+	ApproverGroupApplications:                     "ApproverGroupApplications",
+	ArchivedRequests:                              "ArchivedRequests",
+	ApproverGroupArchivedRequests:                 "ApproverGroupArchivedRequests",
+	SubjectGroupAuditEvents:                       "SubjectGroupAuditEvents",
+	AdminGroupExtensionResourceDefinitions:        "AdminGroupExtensionResourceDefinitions",
+	DefaultOwnerGroupExtensionResourceDefinitions: "DefaultOwnerGroupExtensionResourceDefinitions",
+	GroupApplicationRequests:                      "GroupApplicationRequests",
+	ApproverGroupGroupApplicationRequests:         "ApproverGroupGroupApplicationRequests",
+	GroupApplications:                             "GroupApplications",
+	ParentGroupGroupHierarchies:                   "ParentGroupGroupHierarchies",
+	MemberGroupGroupHierarchies:                   "MemberGroupGroupHierarchies",
+	GroupMembershipRequests:                       "GroupMembershipRequests",
+	GroupMemberships:                              "GroupMemberships",
+	GroupOrganizations:                            "GroupOrganizations",
+	ApproverGroupGroups:                           "ApproverGroupGroups",
+	NotificationDispatches:                        "NotificationDispatches",
+	NotificationGroupPreferences:                  "NotificationGroupPreferences",
+	OwnerSystemExtensionResources:                 "OwnerSystemExtensionResources",
 }
 
 // groupR is where relationships are stored.
 type groupR struct {
-	ApproverGroupGroup                     *Group                           `boil:"ApproverGroupGroup" json:"ApproverGroupGroup" toml:"ApproverGroupGroup" yaml:"ApproverGroupGroup"`
-	ApproverGroupApplications              ApplicationSlice                 `boil:"ApproverGroupApplications" json:"ApproverGroupApplications" toml:"ApproverGroupApplications" yaml:"ApproverGroupApplications"`
-	SubjectGroupAuditEvents                AuditEventSlice                  `boil:"SubjectGroupAuditEvents" json:"SubjectGroupAuditEvents" toml:"SubjectGroupAuditEvents" yaml:"SubjectGroupAuditEvents"`
-	AdminGroupExtensionResourceDefinitions ExtensionResourceDefinitionSlice `boil:"AdminGroupExtensionResourceDefinitions" json:"AdminGroupExtensionResourceDefinitions" toml:"AdminGroupExtensionResourceDefinitions" yaml:"AdminGroupExtensionResourceDefinitions"`
-	GroupApplicationRequests               GroupApplicationRequestSlice     `boil:"GroupApplicationRequests" json:"GroupApplicationRequests" toml:"GroupApplicationRequests" yaml:"GroupApplicationRequests"`
-	ApproverGroupGroupApplicationRequests  GroupApplicationRequestSlice     `boil:"ApproverGroupGroupApplicationRequests" json:"ApproverGroupGroupApplicationRequests" toml:"ApproverGroupGroupApplicationRequests" yaml:"ApproverGroupGroupApplicationRequests"`
-	GroupApplications                      GroupApplicationSlice            `boil:"GroupApplications" json:"GroupApplications" toml:"GroupApplications" yaml:"GroupApplications"`
-	ParentGroupGroupHierarchies            GroupHierarchySlice              `boil:"ParentGroupGroupHierarchies" json:"ParentGroupGroupHierarchies" toml:"ParentGroupGroupHierarchies" yaml:"ParentGroupGroupHierarchies"`
-	MemberGroupGroupHierarchies            GroupHierarchySlice              `boil:"MemberGroupGroupHierarchies" json:"MemberGroupGroupHierarchies" toml:"MemberGroupGroupHierarchies" yaml:"MemberGroupGroupHierarchies"`
-	GroupMembershipRequests                GroupMembershipRequestSlice      `boil:"GroupMembershipRequests" json:"GroupMembershipRequests" toml:"GroupMembershipRequests" yaml:"GroupMembershipRequests"`
-	GroupMemberships                       GroupMembershipSlice             `boil:"GroupMemberships" json:"GroupMemberships" toml:"GroupMemberships" yaml:"GroupMemberships"`
-	GroupOrganizations                     GroupOrganizationSlice           `boil:"GroupOrganizations" json:"GroupOrganizations" toml:"GroupOrganizations" yaml:"GroupOrganizations"`
-	ApproverGroupGroups                    GroupSlice                       `boil:"ApproverGroupGroups" json:"ApproverGroupGroups" toml:"ApproverGroupGroups" yaml:"ApproverGroupGroups"`
+	ApproverGroupGroup                            *Group                           `boil:"ApproverGroupGroup" json:"ApproverGroupGroup" toml:"ApproverGroupGroup" yaml:"ApproverGroupGroup"`
+	Tenant                                        *Organization                    `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	GithubTeamExport                              *GithubTeamExport                `boil:"GithubTeamExport" json:"GithubTeamExport" toml:"GithubTeamExport" yaml:"GithubTeamExport"`
+	MembershipDurationPolicy                      *MembershipDurationPolicy        `boil:"MembershipDurationPolicy" json:"MembershipDurationPolicy" toml:"MembershipDurationPolicy" yaml:"MembershipDurationPolicy"`
+	ApproverGroupApplications                     ApplicationSlice                 `boil:"ApproverGroupApplications" json:"ApproverGroupApplications" toml:"ApproverGroupApplications" yaml:"ApproverGroupApplications"`
+	ArchivedRequests                              ArchivedRequestSlice             `boil:"ArchivedRequests" json:"ArchivedRequests" toml:"ArchivedRequests" yaml:"ArchivedRequests"`
+	ApproverGroupArchivedRequests                 ArchivedRequestSlice             `boil:"ApproverGroupArchivedRequests" json:"ApproverGroupArchivedRequests" toml:"ApproverGroupArchivedRequests" yaml:"ApproverGroupArchivedRequests"`
+	SubjectGroupAuditEvents                       AuditEventSlice                  `boil:"SubjectGroupAuditEvents" json:"SubjectGroupAuditEvents" toml:"SubjectGroupAuditEvents" yaml:"SubjectGroupAuditEvents"`
+	AdminGroupExtensionResourceDefinitions        ExtensionResourceDefinitionSlice `boil:"AdminGroupExtensionResourceDefinitions" json:"AdminGroupExtensionResourceDefinitions" toml:"AdminGroupExtensionResourceDefinitions" yaml:"AdminGroupExtensionResourceDefinitions"`
+	DefaultOwnerGroupExtensionResourceDefinitions ExtensionResourceDefinitionSlice `boil:"DefaultOwnerGroupExtensionResourceDefinitions" json:"DefaultOwnerGroupExtensionResourceDefinitions" toml:"DefaultOwnerGroupExtensionResourceDefinitions" yaml:"DefaultOwnerGroupExtensionResourceDefinitions"`
+	GroupApplicationRequests                      GroupApplicationRequestSlice     `boil:"GroupApplicationRequests" json:"GroupApplicationRequests" toml:"GroupApplicationRequests" yaml:"GroupApplicationRequests"`
+	ApproverGroupGroupApplicationRequests         GroupApplicationRequestSlice     `boil:"ApproverGroupGroupApplicationRequests" json:"ApproverGroupGroupApplicationRequests" toml:"ApproverGroupGroupApplicationRequests" yaml:"ApproverGroupGroupApplicationRequests"`
+	GroupApplications                             GroupApplicationSlice            `boil:"GroupApplications" json:"GroupApplications" toml:"GroupApplications" yaml:"GroupApplications"`
+	ParentGroupGroupHierarchies                   GroupHierarchySlice              `boil:"ParentGroupGroupHierarchies" json:"ParentGroupGroupHierarchies" toml:"ParentGroupGroupHierarchies" yaml:"ParentGroupGroupHierarchies"`
+	MemberGroupGroupHierarchies                   GroupHierarchySlice              `boil:"MemberGroupGroupHierarchies" json:"MemberGroupGroupHierarchies" toml:"MemberGroupGroupHierarchies" yaml:"MemberGroupGroupHierarchies"`
+	GroupMembershipRequests                       GroupMembershipRequestSlice      `boil:"GroupMembershipRequests" json:"GroupMembershipRequests" toml:"GroupMembershipRequests" yaml:"GroupMembershipRequests"`
+	GroupMemberships                              GroupMembershipSlice             `boil:"GroupMemberships" json:"GroupMemberships" toml:"GroupMemberships" yaml:"GroupMemberships"`
+	GroupOrganizations                            GroupOrganizationSlice           `boil:"GroupOrganizations" json:"GroupOrganizations" toml:"GroupOrganizations" yaml:"GroupOrganizations"`
+	ApproverGroupGroups                           GroupSlice                       `boil:"ApproverGroupGroups" json:"ApproverGroupGroups" toml:"ApproverGroupGroups" yaml:"ApproverGroupGroups"`
+	NotificationDispatches                        NotificationDispatchSlice        `boil:"NotificationDispatches" json:"NotificationDispatches" toml:"NotificationDispatches" yaml:"NotificationDispatches"`
+	NotificationGroupPreferences                  NotificationGroupPreferenceSlice `boil:"NotificationGroupPreferences" json:"NotificationGroupPreferences" toml:"NotificationGroupPreferences" yaml:"NotificationGroupPreferences"`
+	OwnerSystemExtensionResources                 SystemExtensionResourceSlice     `boil:"OwnerSystemExtensionResources" json:"OwnerSystemExtensionResources" toml:"OwnerSystemExtensionResources" yaml:"OwnerSystemExtensionResources"`
 }
 
 // NewStruct creates a new relationship struct
@@ -166,6 +284,27 @@ func (r *groupR) GetApproverGroupGroup() *Group {
 	return r.ApproverGroupGroup
 }
 
+func (r *groupR) GetTenant() *Organization {
+	if r == nil {
+		return nil
+	}
+	return r.Tenant
+}
+
+func (r *groupR) GetGithubTeamExport() *GithubTeamExport {
+	if r == nil {
+		return nil
+	}
+	return r.GithubTeamExport
+}
+
+func (r *groupR) GetMembershipDurationPolicy() *MembershipDurationPolicy {
+	if r == nil {
+		return nil
+	}
+	return r.MembershipDurationPolicy
+}
+
 func (r *groupR) GetApproverGroupApplications() ApplicationSlice {
 	if r == nil {
 		return nil
@@ -173,6 +312,20 @@ func (r *groupR) GetApproverGroupApplications() ApplicationSlice {
 	return r.ApproverGroupApplications
 }
 
+func (r *groupR) GetArchivedRequests() ArchivedRequestSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ArchivedRequests
+}
+
+func (r *groupR) GetApproverGroupArchivedRequests() ArchivedRequestSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ApproverGroupArchivedRequests
+}
+
 func (r *groupR) GetSubjectGroupAuditEvents() AuditEventSlice {
 	if r == nil {
 		return nil
@@ -187,6 +340,13 @@ func (r *groupR) GetAdminGroupExtensionResourceDefinitions() ExtensionResourceDe
 	return r.AdminGroupExtensionResourceDefinitions
 }
 
+func (r *groupR) GetDefaultOwnerGroupExtensionResourceDefinitions() ExtensionResourceDefinitionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DefaultOwnerGroupExtensionResourceDefinitions
+}
+
 func (r *groupR) GetGroupApplicationRequests() GroupApplicationRequestSlice {
 	if r == nil {
 		return nil
@@ -250,13 +410,34 @@ func (r *groupR) GetApproverGroupGroups() GroupSlice {
 	return r.ApproverGroupGroups
 }
 
+func (r *groupR) GetNotificationDispatches() NotificationDispatchSlice {
+	if r == nil {
+		return nil
+	}
+	return r.NotificationDispatches
+}
+
+func (r *groupR) GetNotificationGroupPreferences() NotificationGroupPreferenceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.NotificationGroupPreferences
+}
+
+func (r *groupR) GetOwnerSystemExtensionResources() SystemExtensionResourceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OwnerSystemExtensionResources
+}
+
 // groupL is where Load methods for each relationship are stored.
 type groupL struct{}
 
 var (
-	groupAllColumns            = []string{"id", "name", "slug", "description", "created_at", "updated_at", "deleted_at", "note", "approver_group"}
+	groupAllColumns            = []string{"id", "name", "slug", "description", "created_at", "updated_at", "deleted_at", "note", "approver_group", "tenant_id", "owner_contact", "docs_url", "slack_channel", "cost_center", "default_membership_ttl_days", "frozen_at", "frozen_reason", "member_soft_limit", "member_hard_limit", "justification_min_length", "protected_at", "break_glass_at"}
 	groupColumnsWithoutDefault = []string{"name", "slug", "description", "created_at", "updated_at"}
-	groupColumnsWithDefault    = []string{"id", "deleted_at", "note", "approver_group"}
+	groupColumnsWithDefault    = []string{"id", "deleted_at", "note", "approver_group", "tenant_id", "owner_contact", "docs_url", "slack_channel", "cost_center", "default_membership_ttl_days", "frozen_at", "frozen_reason", "member_soft_limit", "member_hard_limit", "justification_min_length", "protected_at", "break_glass_at"}
 	groupPrimaryKeyColumns     = []string{"id"}
 	groupGeneratedColumns      = []string{}
 )
@@ -577,6 +758,39 @@ func (o *Group) ApproverGroupGroup(mods ...qm.QueryMod) groupQuery {
 	return Groups(queryMods...)
 }
 
+// Tenant pointed to by the foreign key.
+func (o *Group) Tenant(mods ...qm.QueryMod) organizationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Organizations(queryMods...)
+}
+
+// GithubTeamExport pointed to by the foreign key.
+func (o *Group) GithubTeamExport(mods ...qm.QueryMod) githubTeamExportQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"group_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return GithubTeamExports(queryMods...)
+}
+
+// MembershipDurationPolicy pointed to by the foreign key.
+func (o *Group) MembershipDurationPolicy(mods ...qm.QueryMod) membershipDurationPolicyQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"group_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return MembershipDurationPolicies(queryMods...)
+}
+
 // ApproverGroupApplications retrieves all the application's Applications with an executor via approver_group_id column.
 func (o *Group) ApproverGroupApplications(mods ...qm.QueryMod) applicationQuery {
 	var queryMods []qm.QueryMod
@@ -591,6 +805,34 @@ func (o *Group) ApproverGroupApplications(mods ...qm.QueryMod) applicationQuery
 	return Applications(queryMods...)
 }
 
+// ArchivedRequests retrieves all the archived_request's ArchivedRequests with an executor.
+func (o *Group) ArchivedRequests(mods ...qm.QueryMod) archivedRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"archived_requests\".\"group_id\"=?", o.ID),
+	)
+
+	return ArchivedRequests(queryMods...)
+}
+
+// ApproverGroupArchivedRequests retrieves all the archived_request's ArchivedRequests with an executor via approver_group_id column.
+func (o *Group) ApproverGroupArchivedRequests(mods ...qm.QueryMod) archivedRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"archived_requests\".\"approver_group_id\"=?", o.ID),
+	)
+
+	return ArchivedRequests(queryMods...)
+}
+
 // SubjectGroupAuditEvents retrieves all the audit_event's AuditEvents with an executor via subject_group_id column.
 func (o *Group) SubjectGroupAuditEvents(mods ...qm.QueryMod) auditEventQuery {
 	var queryMods []qm.QueryMod
@@ -619,6 +861,20 @@ func (o *Group) AdminGroupExtensionResourceDefinitions(mods ...qm.QueryMod) exte
 	return ExtensionResourceDefinitions(queryMods...)
 }
 
+// DefaultOwnerGroupExtensionResourceDefinitions retrieves all the extension_resource_definition's ExtensionResourceDefinitions with an executor via default_owner_group column.
+func (o *Group) DefaultOwnerGroupExtensionResourceDefinitions(mods ...qm.QueryMod) extensionResourceDefinitionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"extension_resource_definitions\".\"default_owner_group\"=?", o.ID),
+	)
+
+	return ExtensionResourceDefinitions(queryMods...)
+}
+
 // GroupApplicationRequests retrieves all the group_application_request's GroupApplicationRequests with an executor.
 func (o *Group) GroupApplicationRequests(mods ...qm.QueryMod) groupApplicationRequestQuery {
 	var queryMods []qm.QueryMod
@@ -745,6 +1001,48 @@ func (o *Group) ApproverGroupGroups(mods ...qm.QueryMod) groupQuery {
 	return Groups(queryMods...)
 }
 
+// NotificationDispatches retrieves all the notification_dispatch's NotificationDispatches with an executor.
+func (o *Group) NotificationDispatches(mods ...qm.QueryMod) notificationDispatchQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_dispatches\".\"group_id\"=?", o.ID),
+	)
+
+	return NotificationDispatches(queryMods...)
+}
+
+// NotificationGroupPreferences retrieves all the notification_group_preference's NotificationGroupPreferences with an executor.
+func (o *Group) NotificationGroupPreferences(mods ...qm.QueryMod) notificationGroupPreferenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_group_preferences\".\"group_id\"=?", o.ID),
+	)
+
+	return NotificationGroupPreferences(queryMods...)
+}
+
+// OwnerSystemExtensionResources retrieves all the system_extension_resource's SystemExtensionResources with an executor via owner_id column.
+func (o *Group) OwnerSystemExtensionResources(mods ...qm.QueryMod) systemExtensionResourceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"system_extension_resources\".\"owner_id\"=?", o.ID),
+	)
+
+	return SystemExtensionResources(queryMods...)
+}
+
 // LoadApproverGroupGroup allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (groupL) LoadApproverGroupGroup(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
@@ -870,9 +1168,9 @@ func (groupL) LoadApproverGroupGroup(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
-// LoadApproverGroupApplications allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadApproverGroupApplications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (groupL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -903,13 +1201,20 @@ func (groupL) LoadApproverGroupApplications(ctx context.Context, e boil.ContextE
 		if object.R == nil {
 			object.R = &groupR{}
 		}
-		args[object.ID] = struct{}{}
+		if !queries.IsNil(object.TenantID) {
+			args[object.TenantID] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &groupR{}
 			}
-			args[obj.ID] = struct{}{}
+
+			if !queries.IsNil(obj.TenantID) {
+				args[obj.TenantID] = struct{}{}
+			}
+
 		}
 	}
 
@@ -925,9 +1230,9 @@ func (groupL) LoadApproverGroupApplications(ctx context.Context, e boil.ContextE
 	}
 
 	query := NewQuery(
-		qm.From(`applications`),
-		qm.WhereIn(`applications.approver_group_id in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`applications.deleted_at`),
+		qm.From(`organizations`),
+		qm.WhereIn(`organizations.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`organizations.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -935,47 +1240,51 @@ func (groupL) LoadApproverGroupApplications(ctx context.Context, e boil.ContextE
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load applications")
+		return errors.Wrap(err, "failed to eager load Organization")
 	}
 
-	var resultSlice []*Application
+	var resultSlice []*Organization
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice applications")
+		return errors.Wrap(err, "failed to bind eager loaded slice Organization")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on applications")
+		return errors.Wrap(err, "failed to close results of eager load for organizations")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for applications")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
 	}
 
-	if len(applicationAfterSelectHooks) != 0 {
+	if len(organizationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.ApproverGroupApplications = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &applicationR{}
-			}
-			foreign.R.ApproverGroup = object
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &organizationR{}
 		}
+		foreign.R.TenantGroups = append(foreign.R.TenantGroups, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.ApproverGroupID) {
-				local.R.ApproverGroupApplications = append(local.R.ApproverGroupApplications, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TenantID, foreign.ID) {
+				local.R.Tenant = foreign
 				if foreign.R == nil {
-					foreign.R = &applicationR{}
+					foreign.R = &organizationR{}
 				}
-				foreign.R.ApproverGroup = local
+				foreign.R.TenantGroups = append(foreign.R.TenantGroups, local)
 				break
 			}
 		}
@@ -984,9 +1293,9 @@ func (groupL) LoadApproverGroupApplications(ctx context.Context, e boil.ContextE
 	return nil
 }
 
-// LoadSubjectGroupAuditEvents allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadSubjectGroupAuditEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+// LoadGithubTeamExport allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (groupL) LoadGithubTeamExport(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -1023,6 +1332,7 @@ func (groupL) LoadSubjectGroupAuditEvents(ctx context.Context, e boil.ContextExe
 			if obj.R == nil {
 				obj.R = &groupR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -1039,8 +1349,8 @@ func (groupL) LoadSubjectGroupAuditEvents(ctx context.Context, e boil.ContextExe
 	}
 
 	query := NewQuery(
-		qm.From(`audit_events`),
-		qm.WhereIn(`audit_events.subject_group_id in ?`, argsSlice...),
+		qm.From(`github_team_exports`),
+		qm.WhereIn(`github_team_exports.group_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1048,47 +1358,50 @@ func (groupL) LoadSubjectGroupAuditEvents(ctx context.Context, e boil.ContextExe
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load audit_events")
+		return errors.Wrap(err, "failed to eager load GithubTeamExport")
 	}
 
-	var resultSlice []*AuditEvent
+	var resultSlice []*GithubTeamExport
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice audit_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice GithubTeamExport")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on audit_events")
+		return errors.Wrap(err, "failed to close results of eager load for github_team_exports")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for github_team_exports")
 	}
 
-	if len(auditEventAfterSelectHooks) != 0 {
+	if len(githubTeamExportAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.SubjectGroupAuditEvents = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &auditEventR{}
-			}
-			foreign.R.SubjectGroup = object
+		foreign := resultSlice[0]
+		object.R.GithubTeamExport = foreign
+		if foreign.R == nil {
+			foreign.R = &githubTeamExportR{}
 		}
-		return nil
+		foreign.R.Group = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.SubjectGroupID) {
-				local.R.SubjectGroupAuditEvents = append(local.R.SubjectGroupAuditEvents, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.GroupID {
+				local.R.GithubTeamExport = foreign
 				if foreign.R == nil {
-					foreign.R = &auditEventR{}
+					foreign.R = &githubTeamExportR{}
 				}
-				foreign.R.SubjectGroup = local
+				foreign.R.Group = local
 				break
 			}
 		}
@@ -1097,9 +1410,9 @@ func (groupL) LoadSubjectGroupAuditEvents(ctx context.Context, e boil.ContextExe
 	return nil
 }
 
-// LoadAdminGroupExtensionResourceDefinitions allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadAdminGroupExtensionResourceDefinitions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+// LoadMembershipDurationPolicy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (groupL) LoadMembershipDurationPolicy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -1136,6 +1449,7 @@ func (groupL) LoadAdminGroupExtensionResourceDefinitions(ctx context.Context, e
 			if obj.R == nil {
 				obj.R = &groupR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -1152,9 +1466,8 @@ func (groupL) LoadAdminGroupExtensionResourceDefinitions(ctx context.Context, e
 	}
 
 	query := NewQuery(
-		qm.From(`extension_resource_definitions`),
-		qm.WhereIn(`extension_resource_definitions.admin_group in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`extension_resource_definitions.deleted_at`),
+		qm.From(`membership_duration_policies`),
+		qm.WhereIn(`membership_duration_policies.group_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1162,47 +1475,50 @@ func (groupL) LoadAdminGroupExtensionResourceDefinitions(ctx context.Context, e
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load extension_resource_definitions")
+		return errors.Wrap(err, "failed to eager load MembershipDurationPolicy")
 	}
 
-	var resultSlice []*ExtensionResourceDefinition
+	var resultSlice []*MembershipDurationPolicy
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice extension_resource_definitions")
+		return errors.Wrap(err, "failed to bind eager loaded slice MembershipDurationPolicy")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on extension_resource_definitions")
+		return errors.Wrap(err, "failed to close results of eager load for membership_duration_policies")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for extension_resource_definitions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for membership_duration_policies")
 	}
 
-	if len(extensionResourceDefinitionAfterSelectHooks) != 0 {
+	if len(membershipDurationPolicyAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.AdminGroupExtensionResourceDefinitions = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &extensionResourceDefinitionR{}
-			}
-			foreign.R.AdminGroupGroup = object
+		foreign := resultSlice[0]
+		object.R.MembershipDurationPolicy = foreign
+		if foreign.R == nil {
+			foreign.R = &membershipDurationPolicyR{}
 		}
-		return nil
+		foreign.R.Group = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.AdminGroup) {
-				local.R.AdminGroupExtensionResourceDefinitions = append(local.R.AdminGroupExtensionResourceDefinitions, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ID, foreign.GroupID) {
+				local.R.MembershipDurationPolicy = foreign
 				if foreign.R == nil {
-					foreign.R = &extensionResourceDefinitionR{}
+					foreign.R = &membershipDurationPolicyR{}
 				}
-				foreign.R.AdminGroupGroup = local
+				foreign.R.Group = local
 				break
 			}
 		}
@@ -1211,9 +1527,9 @@ func (groupL) LoadAdminGroupExtensionResourceDefinitions(ctx context.Context, e
 	return nil
 }
 
-// LoadGroupApplicationRequests allows an eager lookup of values, cached into the
+// LoadApproverGroupApplications allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadGroupApplicationRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+func (groupL) LoadApproverGroupApplications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -1266,8 +1582,9 @@ func (groupL) LoadGroupApplicationRequests(ctx context.Context, e boil.ContextEx
 	}
 
 	query := NewQuery(
-		qm.From(`group_application_requests`),
-		qm.WhereIn(`group_application_requests.group_id in ?`, argsSlice...),
+		qm.From(`applications`),
+		qm.WhereIn(`applications.approver_group_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`applications.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1275,22 +1592,22 @@ func (groupL) LoadGroupApplicationRequests(ctx context.Context, e boil.ContextEx
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load group_application_requests")
+		return errors.Wrap(err, "failed to eager load applications")
 	}
 
-	var resultSlice []*GroupApplicationRequest
+	var resultSlice []*Application
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice group_application_requests")
+		return errors.Wrap(err, "failed to bind eager loaded slice applications")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on group_application_requests")
+		return errors.Wrap(err, "failed to close results in eager load on applications")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_application_requests")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for applications")
 	}
 
-	if len(groupApplicationRequestAfterSelectHooks) != 0 {
+	if len(applicationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1298,24 +1615,24 @@ func (groupL) LoadGroupApplicationRequests(ctx context.Context, e boil.ContextEx
 		}
 	}
 	if singular {
-		object.R.GroupApplicationRequests = resultSlice
+		object.R.ApproverGroupApplications = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupApplicationRequestR{}
+				foreign.R = &applicationR{}
 			}
-			foreign.R.Group = object
+			foreign.R.ApproverGroup = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.GroupID {
-				local.R.GroupApplicationRequests = append(local.R.GroupApplicationRequests, foreign)
+			if queries.Equal(local.ID, foreign.ApproverGroupID) {
+				local.R.ApproverGroupApplications = append(local.R.ApproverGroupApplications, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupApplicationRequestR{}
+					foreign.R = &applicationR{}
 				}
-				foreign.R.Group = local
+				foreign.R.ApproverGroup = local
 				break
 			}
 		}
@@ -1324,9 +1641,9 @@ func (groupL) LoadGroupApplicationRequests(ctx context.Context, e boil.ContextEx
 	return nil
 }
 
-// LoadApproverGroupGroupApplicationRequests allows an eager lookup of values, cached into the
+// LoadArchivedRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadApproverGroupGroupApplicationRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+func (groupL) LoadArchivedRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -1379,8 +1696,8 @@ func (groupL) LoadApproverGroupGroupApplicationRequests(ctx context.Context, e b
 	}
 
 	query := NewQuery(
-		qm.From(`group_application_requests`),
-		qm.WhereIn(`group_application_requests.approver_group_id in ?`, argsSlice...),
+		qm.From(`archived_requests`),
+		qm.WhereIn(`archived_requests.group_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1388,22 +1705,22 @@ func (groupL) LoadApproverGroupGroupApplicationRequests(ctx context.Context, e b
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load group_application_requests")
+		return errors.Wrap(err, "failed to eager load archived_requests")
 	}
 
-	var resultSlice []*GroupApplicationRequest
+	var resultSlice []*ArchivedRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice group_application_requests")
+		return errors.Wrap(err, "failed to bind eager loaded slice archived_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on group_application_requests")
+		return errors.Wrap(err, "failed to close results in eager load on archived_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_application_requests")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for archived_requests")
 	}
 
-	if len(groupApplicationRequestAfterSelectHooks) != 0 {
+	if len(archivedRequestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1411,24 +1728,24 @@ func (groupL) LoadApproverGroupGroupApplicationRequests(ctx context.Context, e b
 		}
 	}
 	if singular {
-		object.R.ApproverGroupGroupApplicationRequests = resultSlice
+		object.R.ArchivedRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupApplicationRequestR{}
+				foreign.R = &archivedRequestR{}
 			}
-			foreign.R.ApproverGroup = object
+			foreign.R.Group = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.ApproverGroupID {
-				local.R.ApproverGroupGroupApplicationRequests = append(local.R.ApproverGroupGroupApplicationRequests, foreign)
+			if local.ID == foreign.GroupID {
+				local.R.ArchivedRequests = append(local.R.ArchivedRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupApplicationRequestR{}
+					foreign.R = &archivedRequestR{}
 				}
-				foreign.R.ApproverGroup = local
+				foreign.R.Group = local
 				break
 			}
 		}
@@ -1437,9 +1754,9 @@ func (groupL) LoadApproverGroupGroupApplicationRequests(ctx context.Context, e b
 	return nil
 }
 
-// LoadGroupApplications allows an eager lookup of values, cached into the
+// LoadApproverGroupArchivedRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadGroupApplications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+func (groupL) LoadApproverGroupArchivedRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -1492,9 +1809,8 @@ func (groupL) LoadGroupApplications(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`group_applications`),
-		qm.WhereIn(`group_applications.group_id in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`group_applications.deleted_at`),
+		qm.From(`archived_requests`),
+		qm.WhereIn(`archived_requests.approver_group_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1502,22 +1818,22 @@ func (groupL) LoadGroupApplications(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load group_applications")
+		return errors.Wrap(err, "failed to eager load archived_requests")
 	}
 
-	var resultSlice []*GroupApplication
+	var resultSlice []*ArchivedRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice group_applications")
+		return errors.Wrap(err, "failed to bind eager loaded slice archived_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on group_applications")
+		return errors.Wrap(err, "failed to close results in eager load on archived_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_applications")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for archived_requests")
 	}
 
-	if len(groupApplicationAfterSelectHooks) != 0 {
+	if len(archivedRequestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1525,24 +1841,24 @@ func (groupL) LoadGroupApplications(ctx context.Context, e boil.ContextExecutor,
 		}
 	}
 	if singular {
-		object.R.GroupApplications = resultSlice
+		object.R.ApproverGroupArchivedRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupApplicationR{}
+				foreign.R = &archivedRequestR{}
 			}
-			foreign.R.Group = object
+			foreign.R.ApproverGroup = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.GroupID {
-				local.R.GroupApplications = append(local.R.GroupApplications, foreign)
+			if queries.Equal(local.ID, foreign.ApproverGroupID) {
+				local.R.ApproverGroupArchivedRequests = append(local.R.ApproverGroupArchivedRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupApplicationR{}
+					foreign.R = &archivedRequestR{}
 				}
-				foreign.R.Group = local
+				foreign.R.ApproverGroup = local
 				break
 			}
 		}
@@ -1551,9 +1867,9 @@ func (groupL) LoadGroupApplications(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadParentGroupGroupHierarchies allows an eager lookup of values, cached into the
+// LoadSubjectGroupAuditEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadParentGroupGroupHierarchies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+func (groupL) LoadSubjectGroupAuditEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -1606,8 +1922,8 @@ func (groupL) LoadParentGroupGroupHierarchies(ctx context.Context, e boil.Contex
 	}
 
 	query := NewQuery(
-		qm.From(`group_hierarchies`),
-		qm.WhereIn(`group_hierarchies.parent_group_id in ?`, argsSlice...),
+		qm.From(`audit_events`),
+		qm.WhereIn(`audit_events.subject_group_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1615,22 +1931,22 @@ func (groupL) LoadParentGroupGroupHierarchies(ctx context.Context, e boil.Contex
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load group_hierarchies")
+		return errors.Wrap(err, "failed to eager load audit_events")
 	}
 
-	var resultSlice []*GroupHierarchy
+	var resultSlice []*AuditEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice group_hierarchies")
+		return errors.Wrap(err, "failed to bind eager loaded slice audit_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on group_hierarchies")
+		return errors.Wrap(err, "failed to close results in eager load on audit_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_hierarchies")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_events")
 	}
 
-	if len(groupHierarchyAfterSelectHooks) != 0 {
+	if len(auditEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1638,24 +1954,24 @@ func (groupL) LoadParentGroupGroupHierarchies(ctx context.Context, e boil.Contex
 		}
 	}
 	if singular {
-		object.R.ParentGroupGroupHierarchies = resultSlice
+		object.R.SubjectGroupAuditEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupHierarchyR{}
+				foreign.R = &auditEventR{}
 			}
-			foreign.R.ParentGroup = object
+			foreign.R.SubjectGroup = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.ParentGroupID {
-				local.R.ParentGroupGroupHierarchies = append(local.R.ParentGroupGroupHierarchies, foreign)
+			if queries.Equal(local.ID, foreign.SubjectGroupID) {
+				local.R.SubjectGroupAuditEvents = append(local.R.SubjectGroupAuditEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupHierarchyR{}
+					foreign.R = &auditEventR{}
 				}
-				foreign.R.ParentGroup = local
+				foreign.R.SubjectGroup = local
 				break
 			}
 		}
@@ -1664,9 +1980,9 @@ func (groupL) LoadParentGroupGroupHierarchies(ctx context.Context, e boil.Contex
 	return nil
 }
 
-// LoadMemberGroupGroupHierarchies allows an eager lookup of values, cached into the
+// LoadAdminGroupExtensionResourceDefinitions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadMemberGroupGroupHierarchies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+func (groupL) LoadAdminGroupExtensionResourceDefinitions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -1719,8 +2035,9 @@ func (groupL) LoadMemberGroupGroupHierarchies(ctx context.Context, e boil.Contex
 	}
 
 	query := NewQuery(
-		qm.From(`group_hierarchies`),
-		qm.WhereIn(`group_hierarchies.member_group_id in ?`, argsSlice...),
+		qm.From(`extension_resource_definitions`),
+		qm.WhereIn(`extension_resource_definitions.admin_group in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`extension_resource_definitions.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1728,22 +2045,22 @@ func (groupL) LoadMemberGroupGroupHierarchies(ctx context.Context, e boil.Contex
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load group_hierarchies")
+		return errors.Wrap(err, "failed to eager load extension_resource_definitions")
 	}
 
-	var resultSlice []*GroupHierarchy
+	var resultSlice []*ExtensionResourceDefinition
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice group_hierarchies")
+		return errors.Wrap(err, "failed to bind eager loaded slice extension_resource_definitions")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on group_hierarchies")
+		return errors.Wrap(err, "failed to close results in eager load on extension_resource_definitions")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_hierarchies")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for extension_resource_definitions")
 	}
 
-	if len(groupHierarchyAfterSelectHooks) != 0 {
+	if len(extensionResourceDefinitionAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1751,24 +2068,24 @@ func (groupL) LoadMemberGroupGroupHierarchies(ctx context.Context, e boil.Contex
 		}
 	}
 	if singular {
-		object.R.MemberGroupGroupHierarchies = resultSlice
+		object.R.AdminGroupExtensionResourceDefinitions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupHierarchyR{}
+				foreign.R = &extensionResourceDefinitionR{}
 			}
-			foreign.R.MemberGroup = object
+			foreign.R.AdminGroupGroup = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.MemberGroupID {
-				local.R.MemberGroupGroupHierarchies = append(local.R.MemberGroupGroupHierarchies, foreign)
+			if queries.Equal(local.ID, foreign.AdminGroup) {
+				local.R.AdminGroupExtensionResourceDefinitions = append(local.R.AdminGroupExtensionResourceDefinitions, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupHierarchyR{}
+					foreign.R = &extensionResourceDefinitionR{}
 				}
-				foreign.R.MemberGroup = local
+				foreign.R.AdminGroupGroup = local
 				break
 			}
 		}
@@ -1777,9 +2094,9 @@ func (groupL) LoadMemberGroupGroupHierarchies(ctx context.Context, e boil.Contex
 	return nil
 }
 
-// LoadGroupMembershipRequests allows an eager lookup of values, cached into the
+// LoadDefaultOwnerGroupExtensionResourceDefinitions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadGroupMembershipRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+func (groupL) LoadDefaultOwnerGroupExtensionResourceDefinitions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -1832,8 +2149,9 @@ func (groupL) LoadGroupMembershipRequests(ctx context.Context, e boil.ContextExe
 	}
 
 	query := NewQuery(
-		qm.From(`group_membership_requests`),
-		qm.WhereIn(`group_membership_requests.group_id in ?`, argsSlice...),
+		qm.From(`extension_resource_definitions`),
+		qm.WhereIn(`extension_resource_definitions.default_owner_group in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`extension_resource_definitions.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1841,22 +2159,22 @@ func (groupL) LoadGroupMembershipRequests(ctx context.Context, e boil.ContextExe
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load group_membership_requests")
+		return errors.Wrap(err, "failed to eager load extension_resource_definitions")
 	}
 
-	var resultSlice []*GroupMembershipRequest
+	var resultSlice []*ExtensionResourceDefinition
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice group_membership_requests")
+		return errors.Wrap(err, "failed to bind eager loaded slice extension_resource_definitions")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on group_membership_requests")
+		return errors.Wrap(err, "failed to close results in eager load on extension_resource_definitions")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_membership_requests")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for extension_resource_definitions")
 	}
 
-	if len(groupMembershipRequestAfterSelectHooks) != 0 {
+	if len(extensionResourceDefinitionAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1864,24 +2182,24 @@ func (groupL) LoadGroupMembershipRequests(ctx context.Context, e boil.ContextExe
 		}
 	}
 	if singular {
-		object.R.GroupMembershipRequests = resultSlice
+		object.R.DefaultOwnerGroupExtensionResourceDefinitions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupMembershipRequestR{}
+				foreign.R = &extensionResourceDefinitionR{}
 			}
-			foreign.R.Group = object
+			foreign.R.DefaultOwnerGroupGroup = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.GroupID {
-				local.R.GroupMembershipRequests = append(local.R.GroupMembershipRequests, foreign)
+			if queries.Equal(local.ID, foreign.DefaultOwnerGroup) {
+				local.R.DefaultOwnerGroupExtensionResourceDefinitions = append(local.R.DefaultOwnerGroupExtensionResourceDefinitions, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupMembershipRequestR{}
+					foreign.R = &extensionResourceDefinitionR{}
 				}
-				foreign.R.Group = local
+				foreign.R.DefaultOwnerGroupGroup = local
 				break
 			}
 		}
@@ -1890,9 +2208,9 @@ func (groupL) LoadGroupMembershipRequests(ctx context.Context, e boil.ContextExe
 	return nil
 }
 
-// LoadGroupMemberships allows an eager lookup of values, cached into the
+// LoadGroupApplicationRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadGroupMemberships(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+func (groupL) LoadGroupApplicationRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -1945,8 +2263,8 @@ func (groupL) LoadGroupMemberships(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`group_memberships`),
-		qm.WhereIn(`group_memberships.group_id in ?`, argsSlice...),
+		qm.From(`group_application_requests`),
+		qm.WhereIn(`group_application_requests.group_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1954,22 +2272,22 @@ func (groupL) LoadGroupMemberships(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load group_memberships")
+		return errors.Wrap(err, "failed to eager load group_application_requests")
 	}
 
-	var resultSlice []*GroupMembership
+	var resultSlice []*GroupApplicationRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice group_memberships")
+		return errors.Wrap(err, "failed to bind eager loaded slice group_application_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on group_memberships")
+		return errors.Wrap(err, "failed to close results in eager load on group_application_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_memberships")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_application_requests")
 	}
 
-	if len(groupMembershipAfterSelectHooks) != 0 {
+	if len(groupApplicationRequestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1977,10 +2295,10 @@ func (groupL) LoadGroupMemberships(ctx context.Context, e boil.ContextExecutor,
 		}
 	}
 	if singular {
-		object.R.GroupMemberships = resultSlice
+		object.R.GroupApplicationRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupMembershipR{}
+				foreign.R = &groupApplicationRequestR{}
 			}
 			foreign.R.Group = object
 		}
@@ -1990,9 +2308,9 @@ func (groupL) LoadGroupMemberships(ctx context.Context, e boil.ContextExecutor,
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.GroupID {
-				local.R.GroupMemberships = append(local.R.GroupMemberships, foreign)
+				local.R.GroupApplicationRequests = append(local.R.GroupApplicationRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupMembershipR{}
+					foreign.R = &groupApplicationRequestR{}
 				}
 				foreign.R.Group = local
 				break
@@ -2003,9 +2321,9 @@ func (groupL) LoadGroupMemberships(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadGroupOrganizations allows an eager lookup of values, cached into the
+// LoadApproverGroupGroupApplicationRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadGroupOrganizations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+func (groupL) LoadApproverGroupGroupApplicationRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -2058,8 +2376,8 @@ func (groupL) LoadGroupOrganizations(ctx context.Context, e boil.ContextExecutor
 	}
 
 	query := NewQuery(
-		qm.From(`group_organizations`),
-		qm.WhereIn(`group_organizations.group_id in ?`, argsSlice...),
+		qm.From(`group_application_requests`),
+		qm.WhereIn(`group_application_requests.approver_group_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2067,22 +2385,22 @@ func (groupL) LoadGroupOrganizations(ctx context.Context, e boil.ContextExecutor
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load group_organizations")
+		return errors.Wrap(err, "failed to eager load group_application_requests")
 	}
 
-	var resultSlice []*GroupOrganization
+	var resultSlice []*GroupApplicationRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice group_organizations")
+		return errors.Wrap(err, "failed to bind eager loaded slice group_application_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on group_organizations")
+		return errors.Wrap(err, "failed to close results in eager load on group_application_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_organizations")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_application_requests")
 	}
 
-	if len(groupOrganizationAfterSelectHooks) != 0 {
+	if len(groupApplicationRequestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -2090,24 +2408,24 @@ func (groupL) LoadGroupOrganizations(ctx context.Context, e boil.ContextExecutor
 		}
 	}
 	if singular {
-		object.R.GroupOrganizations = resultSlice
+		object.R.ApproverGroupGroupApplicationRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupOrganizationR{}
+				foreign.R = &groupApplicationRequestR{}
 			}
-			foreign.R.Group = object
+			foreign.R.ApproverGroup = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.GroupID {
-				local.R.GroupOrganizations = append(local.R.GroupOrganizations, foreign)
+			if local.ID == foreign.ApproverGroupID {
+				local.R.ApproverGroupGroupApplicationRequests = append(local.R.ApproverGroupGroupApplicationRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupOrganizationR{}
+					foreign.R = &groupApplicationRequestR{}
 				}
-				foreign.R.Group = local
+				foreign.R.ApproverGroup = local
 				break
 			}
 		}
@@ -2116,9 +2434,9 @@ func (groupL) LoadGroupOrganizations(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
-// LoadApproverGroupGroups allows an eager lookup of values, cached into the
+// LoadGroupApplications allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (groupL) LoadApproverGroupGroups(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+func (groupL) LoadGroupApplications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
 	var slice []*Group
 	var object *Group
 
@@ -2171,9 +2489,9 @@ func (groupL) LoadApproverGroupGroups(ctx context.Context, e boil.ContextExecuto
 	}
 
 	query := NewQuery(
-		qm.From(`groups`),
-		qm.WhereIn(`groups.approver_group in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`groups.deleted_at`),
+		qm.From(`group_applications`),
+		qm.WhereIn(`group_applications.group_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`group_applications.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2181,22 +2499,22 @@ func (groupL) LoadApproverGroupGroups(ctx context.Context, e boil.ContextExecuto
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load groups")
+		return errors.Wrap(err, "failed to eager load group_applications")
 	}
 
-	var resultSlice []*Group
+	var resultSlice []*GroupApplication
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice groups")
+		return errors.Wrap(err, "failed to bind eager loaded slice group_applications")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on groups")
+		return errors.Wrap(err, "failed to close results in eager load on group_applications")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for groups")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_applications")
 	}
 
-	if len(groupAfterSelectHooks) != 0 {
+	if len(groupApplicationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -2204,24 +2522,24 @@ func (groupL) LoadApproverGroupGroups(ctx context.Context, e boil.ContextExecuto
 		}
 	}
 	if singular {
-		object.R.ApproverGroupGroups = resultSlice
+		object.R.GroupApplications = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupR{}
+				foreign.R = &groupApplicationR{}
 			}
-			foreign.R.ApproverGroupGroup = object
+			foreign.R.Group = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.ApproverGroup) {
-				local.R.ApproverGroupGroups = append(local.R.ApproverGroupGroups, foreign)
+			if local.ID == foreign.GroupID {
+				local.R.GroupApplications = append(local.R.GroupApplications, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupR{}
+					foreign.R = &groupApplicationR{}
 				}
-				foreign.R.ApproverGroupGroup = local
+				foreign.R.Group = local
 				break
 			}
 		}
@@ -2230,8 +2548,1027 @@ func (groupL) LoadApproverGroupGroups(ctx context.Context, e boil.ContextExecuto
 	return nil
 }
 
-// SetApproverGroupGroup of the group to the related item.
-// Sets o.R.ApproverGroupGroup to related.
+// LoadParentGroupGroupHierarchies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadParentGroupGroupHierarchies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`group_hierarchies`),
+		qm.WhereIn(`group_hierarchies.parent_group_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load group_hierarchies")
+	}
+
+	var resultSlice []*GroupHierarchy
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice group_hierarchies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on group_hierarchies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_hierarchies")
+	}
+
+	if len(groupHierarchyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ParentGroupGroupHierarchies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupHierarchyR{}
+			}
+			foreign.R.ParentGroup = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ParentGroupID {
+				local.R.ParentGroupGroupHierarchies = append(local.R.ParentGroupGroupHierarchies, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupHierarchyR{}
+				}
+				foreign.R.ParentGroup = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMemberGroupGroupHierarchies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadMemberGroupGroupHierarchies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`group_hierarchies`),
+		qm.WhereIn(`group_hierarchies.member_group_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load group_hierarchies")
+	}
+
+	var resultSlice []*GroupHierarchy
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice group_hierarchies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on group_hierarchies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_hierarchies")
+	}
+
+	if len(groupHierarchyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MemberGroupGroupHierarchies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupHierarchyR{}
+			}
+			foreign.R.MemberGroup = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.MemberGroupID {
+				local.R.MemberGroupGroupHierarchies = append(local.R.MemberGroupGroupHierarchies, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupHierarchyR{}
+				}
+				foreign.R.MemberGroup = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGroupMembershipRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadGroupMembershipRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`group_membership_requests`),
+		qm.WhereIn(`group_membership_requests.group_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load group_membership_requests")
+	}
+
+	var resultSlice []*GroupMembershipRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice group_membership_requests")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on group_membership_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_membership_requests")
+	}
+
+	if len(groupMembershipRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GroupMembershipRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupMembershipRequestR{}
+			}
+			foreign.R.Group = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GroupID {
+				local.R.GroupMembershipRequests = append(local.R.GroupMembershipRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupMembershipRequestR{}
+				}
+				foreign.R.Group = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGroupMemberships allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadGroupMemberships(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`group_memberships`),
+		qm.WhereIn(`group_memberships.group_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load group_memberships")
+	}
+
+	var resultSlice []*GroupMembership
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice group_memberships")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on group_memberships")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_memberships")
+	}
+
+	if len(groupMembershipAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GroupMemberships = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupMembershipR{}
+			}
+			foreign.R.Group = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GroupID {
+				local.R.GroupMemberships = append(local.R.GroupMemberships, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupMembershipR{}
+				}
+				foreign.R.Group = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGroupOrganizations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadGroupOrganizations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`group_organizations`),
+		qm.WhereIn(`group_organizations.group_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load group_organizations")
+	}
+
+	var resultSlice []*GroupOrganization
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice group_organizations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on group_organizations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_organizations")
+	}
+
+	if len(groupOrganizationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GroupOrganizations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupOrganizationR{}
+			}
+			foreign.R.Group = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GroupID {
+				local.R.GroupOrganizations = append(local.R.GroupOrganizations, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupOrganizationR{}
+				}
+				foreign.R.Group = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadApproverGroupGroups allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadApproverGroupGroups(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`groups`),
+		qm.WhereIn(`groups.approver_group in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`groups.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load groups")
+	}
+
+	var resultSlice []*Group
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice groups")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on groups")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for groups")
+	}
+
+	if len(groupAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ApproverGroupGroups = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupR{}
+			}
+			foreign.R.ApproverGroupGroup = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ApproverGroup) {
+				local.R.ApproverGroupGroups = append(local.R.ApproverGroupGroups, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupR{}
+				}
+				foreign.R.ApproverGroupGroup = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationDispatches allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadNotificationDispatches(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_dispatches`),
+		qm.WhereIn(`notification_dispatches.group_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_dispatches")
+	}
+
+	var resultSlice []*NotificationDispatch
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_dispatches")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_dispatches")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_dispatches")
+	}
+
+	if len(notificationDispatchAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationDispatches = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationDispatchR{}
+			}
+			foreign.R.Group = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.GroupID) {
+				local.R.NotificationDispatches = append(local.R.NotificationDispatches, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationDispatchR{}
+				}
+				foreign.R.Group = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationGroupPreferences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadNotificationGroupPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_group_preferences`),
+		qm.WhereIn(`notification_group_preferences.group_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_group_preferences")
+	}
+
+	var resultSlice []*NotificationGroupPreference
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_group_preferences")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_group_preferences")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_group_preferences")
+	}
+
+	if len(notificationGroupPreferenceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationGroupPreferences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationGroupPreferenceR{}
+			}
+			foreign.R.Group = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GroupID {
+				local.R.NotificationGroupPreferences = append(local.R.NotificationGroupPreferences, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationGroupPreferenceR{}
+				}
+				foreign.R.Group = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerSystemExtensionResources allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (groupL) LoadOwnerSystemExtensionResources(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroup interface{}, mods queries.Applicator) error {
+	var slice []*Group
+	var object *Group
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroup.(*Group)
+		if !ok {
+			object = new(Group)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroup))
+			}
+		}
+	} else {
+		s, ok := maybeGroup.(*[]*Group)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &groupR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`system_extension_resources`),
+		qm.WhereIn(`system_extension_resources.owner_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`system_extension_resources.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load system_extension_resources")
+	}
+
+	var resultSlice []*SystemExtensionResource
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice system_extension_resources")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on system_extension_resources")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for system_extension_resources")
+	}
+
+	if len(systemExtensionResourceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerSystemExtensionResources = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &systemExtensionResourceR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.OwnerID) {
+				local.R.OwnerSystemExtensionResources = append(local.R.OwnerSystemExtensionResources, foreign)
+				if foreign.R == nil {
+					foreign.R = &systemExtensionResourceR{}
+				}
+				foreign.R.Owner = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetApproverGroupGroup of the group to the related item.
+// Sets o.R.ApproverGroupGroup to related.
 // Adds o to related.R.ApproverGroupGroups.
 func (o *Group) SetApproverGroupGroup(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Group) error {
 	var err error
@@ -2241,80 +3578,464 @@ func (o *Group) SetApproverGroupGroup(ctx context.Context, exec boil.ContextExec
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"groups\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"approver_group"}),
-		strmangle.WhereClause("\"", "\"", 2, groupPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"groups\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"approver_group"}),
+		strmangle.WhereClause("\"", "\"", 2, groupPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ApproverGroup, related.ID)
+	if o.R == nil {
+		o.R = &groupR{
+			ApproverGroupGroup: related,
+		}
+	} else {
+		o.R.ApproverGroupGroup = related
+	}
+
+	if related.R == nil {
+		related.R = &groupR{
+			ApproverGroupGroups: GroupSlice{o},
+		}
+	} else {
+		related.R.ApproverGroupGroups = append(related.R.ApproverGroupGroups, o)
+	}
+
+	return nil
+}
+
+// RemoveApproverGroupGroup relationship.
+// Sets o.R.ApproverGroupGroup to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Group) RemoveApproverGroupGroup(ctx context.Context, exec boil.ContextExecutor, related *Group) error {
+	var err error
+
+	queries.SetScanner(&o.ApproverGroup, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("approver_group")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ApproverGroupGroup = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ApproverGroupGroups {
+		if queries.Equal(o.ApproverGroup, ri.ApproverGroup) {
+			continue
+		}
+
+		ln := len(related.R.ApproverGroupGroups)
+		if ln > 1 && i < ln-1 {
+			related.R.ApproverGroupGroups[i] = related.R.ApproverGroupGroups[ln-1]
+		}
+		related.R.ApproverGroupGroups = related.R.ApproverGroupGroups[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetTenant of the group to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.TenantGroups.
+func (o *Group) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Organization) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"groups\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, groupPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TenantID, related.ID)
+	if o.R == nil {
+		o.R = &groupR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &organizationR{
+			TenantGroups: GroupSlice{o},
+		}
+	} else {
+		related.R.TenantGroups = append(related.R.TenantGroups, o)
+	}
+
+	return nil
+}
+
+// RemoveTenant relationship.
+// Sets o.R.Tenant to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Group) RemoveTenant(ctx context.Context, exec boil.ContextExecutor, related *Organization) error {
+	var err error
+
+	queries.SetScanner(&o.TenantID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("tenant_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Tenant = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.TenantGroups {
+		if queries.Equal(o.TenantID, ri.TenantID) {
+			continue
+		}
+
+		ln := len(related.R.TenantGroups)
+		if ln > 1 && i < ln-1 {
+			related.R.TenantGroups[i] = related.R.TenantGroups[ln-1]
+		}
+		related.R.TenantGroups = related.R.TenantGroups[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetGithubTeamExport of the group to the related item.
+// Sets o.R.GithubTeamExport to related.
+// Adds o to related.R.Group.
+func (o *Group) SetGithubTeamExport(ctx context.Context, exec boil.ContextExecutor, insert bool, related *GithubTeamExport) error {
+	var err error
+
+	if insert {
+		related.GroupID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"github_team_exports\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"group_id"}),
+			strmangle.WhereClause("\"", "\"", 2, githubTeamExportPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.GroupID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &groupR{
+			GithubTeamExport: related,
+		}
+	} else {
+		o.R.GithubTeamExport = related
+	}
+
+	if related.R == nil {
+		related.R = &githubTeamExportR{
+			Group: o,
+		}
+	} else {
+		related.R.Group = o
+	}
+	return nil
+}
+
+// SetMembershipDurationPolicy of the group to the related item.
+// Sets o.R.MembershipDurationPolicy to related.
+// Adds o to related.R.Group.
+func (o *Group) SetMembershipDurationPolicy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *MembershipDurationPolicy) error {
+	var err error
+
+	if insert {
+		queries.Assign(&related.GroupID, o.ID)
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"membership_duration_policies\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"group_id"}),
+			strmangle.WhereClause("\"", "\"", 2, membershipDurationPolicyPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		queries.Assign(&related.GroupID, o.ID)
+	}
+
+	if o.R == nil {
+		o.R = &groupR{
+			MembershipDurationPolicy: related,
+		}
+	} else {
+		o.R.MembershipDurationPolicy = related
+	}
+
+	if related.R == nil {
+		related.R = &membershipDurationPolicyR{
+			Group: o,
+		}
+	} else {
+		related.R.Group = o
+	}
+	return nil
+}
+
+// RemoveMembershipDurationPolicy relationship.
+// Sets o.R.MembershipDurationPolicy to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Group) RemoveMembershipDurationPolicy(ctx context.Context, exec boil.ContextExecutor, related *MembershipDurationPolicy) error {
+	var err error
+
+	queries.SetScanner(&related.GroupID, nil)
+	if _, err = related.Update(ctx, exec, boil.Whitelist("group_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.MembershipDurationPolicy = nil
+	}
+
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.Group = nil
+
+	return nil
+}
+
+// AddApproverGroupApplications adds the given related objects to the existing relationships
+// of the group, optionally inserting them as new records.
+// Appends related to o.R.ApproverGroupApplications.
+// Sets related.R.ApproverGroup appropriately.
+func (o *Group) AddApproverGroupApplications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Application) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ApproverGroupID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"applications\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"approver_group_id"}),
+				strmangle.WhereClause("\"", "\"", 2, applicationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ApproverGroupID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &groupR{
+			ApproverGroupApplications: related,
+		}
+	} else {
+		o.R.ApproverGroupApplications = append(o.R.ApproverGroupApplications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &applicationR{
+				ApproverGroup: o,
+			}
+		} else {
+			rel.R.ApproverGroup = o
+		}
+	}
+	return nil
+}
+
+// SetApproverGroupApplications removes all previously related items of the
+// group replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ApproverGroup's ApproverGroupApplications accordingly.
+// Replaces o.R.ApproverGroupApplications with related.
+// Sets related.R.ApproverGroup's ApproverGroupApplications accordingly.
+func (o *Group) SetApproverGroupApplications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Application) error {
+	query := "update \"applications\" set \"approver_group_id\" = null where \"approver_group_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ApproverGroupApplications {
+			queries.SetScanner(&rel.ApproverGroupID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ApproverGroup = nil
+		}
+		o.R.ApproverGroupApplications = nil
+	}
+
+	return o.AddApproverGroupApplications(ctx, exec, insert, related...)
+}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+// RemoveApproverGroupApplications relationships from objects passed in.
+// Removes related items from R.ApproverGroupApplications (uses pointer comparison, removal does not keep order)
+// Sets related.R.ApproverGroup.
+func (o *Group) RemoveApproverGroupApplications(ctx context.Context, exec boil.ContextExecutor, related ...*Application) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	queries.Assign(&o.ApproverGroup, related.ID)
-	if o.R == nil {
-		o.R = &groupR{
-			ApproverGroupGroup: related,
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ApproverGroupID, nil)
+		if rel.R != nil {
+			rel.R.ApproverGroup = nil
 		}
-	} else {
-		o.R.ApproverGroupGroup = related
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("approver_group_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
 	}
 
-	if related.R == nil {
-		related.R = &groupR{
-			ApproverGroupGroups: GroupSlice{o},
+	for _, rel := range related {
+		for i, ri := range o.R.ApproverGroupApplications {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ApproverGroupApplications)
+			if ln > 1 && i < ln-1 {
+				o.R.ApproverGroupApplications[i] = o.R.ApproverGroupApplications[ln-1]
+			}
+			o.R.ApproverGroupApplications = o.R.ApproverGroupApplications[:ln-1]
+			break
 		}
-	} else {
-		related.R.ApproverGroupGroups = append(related.R.ApproverGroupGroups, o)
 	}
 
 	return nil
 }
 
-// RemoveApproverGroupGroup relationship.
-// Sets o.R.ApproverGroupGroup to nil.
-// Removes o from all passed in related items' relationships struct.
-func (o *Group) RemoveApproverGroupGroup(ctx context.Context, exec boil.ContextExecutor, related *Group) error {
+// AddArchivedRequests adds the given related objects to the existing relationships
+// of the group, optionally inserting them as new records.
+// Appends related to o.R.ArchivedRequests.
+// Sets related.R.Group appropriately.
+func (o *Group) AddArchivedRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArchivedRequest) error {
 	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GroupID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"archived_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"group_id"}),
+				strmangle.WhereClause("\"", "\"", 2, archivedRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	queries.SetScanner(&o.ApproverGroup, nil)
-	if _, err = o.Update(ctx, exec, boil.Whitelist("approver_group")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if o.R != nil {
-		o.R.ApproverGroupGroup = nil
-	}
-	if related == nil || related.R == nil {
-		return nil
+			rel.GroupID = o.ID
+		}
 	}
 
-	for i, ri := range related.R.ApproverGroupGroups {
-		if queries.Equal(o.ApproverGroup, ri.ApproverGroup) {
-			continue
+	if o.R == nil {
+		o.R = &groupR{
+			ArchivedRequests: related,
 		}
+	} else {
+		o.R.ArchivedRequests = append(o.R.ArchivedRequests, related...)
+	}
 
-		ln := len(related.R.ApproverGroupGroups)
-		if ln > 1 && i < ln-1 {
-			related.R.ApproverGroupGroups[i] = related.R.ApproverGroupGroups[ln-1]
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &archivedRequestR{
+				Group: o,
+			}
+		} else {
+			rel.R.Group = o
 		}
-		related.R.ApproverGroupGroups = related.R.ApproverGroupGroups[:ln-1]
-		break
 	}
 	return nil
 }
 
-// AddApproverGroupApplications adds the given related objects to the existing relationships
+// AddApproverGroupArchivedRequests adds the given related objects to the existing relationships
 // of the group, optionally inserting them as new records.
-// Appends related to o.R.ApproverGroupApplications.
+// Appends related to o.R.ApproverGroupArchivedRequests.
 // Sets related.R.ApproverGroup appropriately.
-func (o *Group) AddApproverGroupApplications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Application) error {
+func (o *Group) AddApproverGroupArchivedRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArchivedRequest) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2324,9 +4045,9 @@ func (o *Group) AddApproverGroupApplications(ctx context.Context, exec boil.Cont
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"applications\" SET %s WHERE %s",
+				"UPDATE \"archived_requests\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"approver_group_id"}),
-				strmangle.WhereClause("\"", "\"", 2, applicationPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, archivedRequestPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2345,15 +4066,15 @@ func (o *Group) AddApproverGroupApplications(ctx context.Context, exec boil.Cont
 
 	if o.R == nil {
 		o.R = &groupR{
-			ApproverGroupApplications: related,
+			ApproverGroupArchivedRequests: related,
 		}
 	} else {
-		o.R.ApproverGroupApplications = append(o.R.ApproverGroupApplications, related...)
+		o.R.ApproverGroupArchivedRequests = append(o.R.ApproverGroupArchivedRequests, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &applicationR{
+			rel.R = &archivedRequestR{
 				ApproverGroup: o,
 			}
 		} else {
@@ -2363,14 +4084,14 @@ func (o *Group) AddApproverGroupApplications(ctx context.Context, exec boil.Cont
 	return nil
 }
 
-// SetApproverGroupApplications removes all previously related items of the
+// SetApproverGroupArchivedRequests removes all previously related items of the
 // group replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.ApproverGroup's ApproverGroupApplications accordingly.
-// Replaces o.R.ApproverGroupApplications with related.
-// Sets related.R.ApproverGroup's ApproverGroupApplications accordingly.
-func (o *Group) SetApproverGroupApplications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Application) error {
-	query := "update \"applications\" set \"approver_group_id\" = null where \"approver_group_id\" = $1"
+// Sets o.R.ApproverGroup's ApproverGroupArchivedRequests accordingly.
+// Replaces o.R.ApproverGroupArchivedRequests with related.
+// Sets related.R.ApproverGroup's ApproverGroupArchivedRequests accordingly.
+func (o *Group) SetApproverGroupArchivedRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArchivedRequest) error {
+	query := "update \"archived_requests\" set \"approver_group_id\" = null where \"approver_group_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -2383,7 +4104,7 @@ func (o *Group) SetApproverGroupApplications(ctx context.Context, exec boil.Cont
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.ApproverGroupApplications {
+		for _, rel := range o.R.ApproverGroupArchivedRequests {
 			queries.SetScanner(&rel.ApproverGroupID, nil)
 			if rel.R == nil {
 				continue
@@ -2391,16 +4112,16 @@ func (o *Group) SetApproverGroupApplications(ctx context.Context, exec boil.Cont
 
 			rel.R.ApproverGroup = nil
 		}
-		o.R.ApproverGroupApplications = nil
+		o.R.ApproverGroupArchivedRequests = nil
 	}
 
-	return o.AddApproverGroupApplications(ctx, exec, insert, related...)
+	return o.AddApproverGroupArchivedRequests(ctx, exec, insert, related...)
 }
 
-// RemoveApproverGroupApplications relationships from objects passed in.
-// Removes related items from R.ApproverGroupApplications (uses pointer comparison, removal does not keep order)
+// RemoveApproverGroupArchivedRequests relationships from objects passed in.
+// Removes related items from R.ApproverGroupArchivedRequests (uses pointer comparison, removal does not keep order)
 // Sets related.R.ApproverGroup.
-func (o *Group) RemoveApproverGroupApplications(ctx context.Context, exec boil.ContextExecutor, related ...*Application) error {
+func (o *Group) RemoveApproverGroupArchivedRequests(ctx context.Context, exec boil.ContextExecutor, related ...*ArchivedRequest) error {
 	if len(related) == 0 {
 		return nil
 	}
@@ -2420,16 +4141,16 @@ func (o *Group) RemoveApproverGroupApplications(ctx context.Context, exec boil.C
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.ApproverGroupApplications {
+		for i, ri := range o.R.ApproverGroupArchivedRequests {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.ApproverGroupApplications)
+			ln := len(o.R.ApproverGroupArchivedRequests)
 			if ln > 1 && i < ln-1 {
-				o.R.ApproverGroupApplications[i] = o.R.ApproverGroupApplications[ln-1]
+				o.R.ApproverGroupArchivedRequests[i] = o.R.ApproverGroupArchivedRequests[ln-1]
 			}
-			o.R.ApproverGroupApplications = o.R.ApproverGroupApplications[:ln-1]
+			o.R.ApproverGroupArchivedRequests = o.R.ApproverGroupArchivedRequests[:ln-1]
 			break
 		}
 	}
@@ -2602,29 +4323,156 @@ func (o *Group) AddAdminGroupExtensionResourceDefinitions(ctx context.Context, e
 			AdminGroupExtensionResourceDefinitions: related,
 		}
 	} else {
-		o.R.AdminGroupExtensionResourceDefinitions = append(o.R.AdminGroupExtensionResourceDefinitions, related...)
+		o.R.AdminGroupExtensionResourceDefinitions = append(o.R.AdminGroupExtensionResourceDefinitions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &extensionResourceDefinitionR{
+				AdminGroupGroup: o,
+			}
+		} else {
+			rel.R.AdminGroupGroup = o
+		}
+	}
+	return nil
+}
+
+// SetAdminGroupExtensionResourceDefinitions removes all previously related items of the
+// group replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.AdminGroupGroup's AdminGroupExtensionResourceDefinitions accordingly.
+// Replaces o.R.AdminGroupExtensionResourceDefinitions with related.
+// Sets related.R.AdminGroupGroup's AdminGroupExtensionResourceDefinitions accordingly.
+func (o *Group) SetAdminGroupExtensionResourceDefinitions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ExtensionResourceDefinition) error {
+	query := "update \"extension_resource_definitions\" set \"admin_group\" = null where \"admin_group\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AdminGroupExtensionResourceDefinitions {
+			queries.SetScanner(&rel.AdminGroup, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.AdminGroupGroup = nil
+		}
+		o.R.AdminGroupExtensionResourceDefinitions = nil
+	}
+
+	return o.AddAdminGroupExtensionResourceDefinitions(ctx, exec, insert, related...)
+}
+
+// RemoveAdminGroupExtensionResourceDefinitions relationships from objects passed in.
+// Removes related items from R.AdminGroupExtensionResourceDefinitions (uses pointer comparison, removal does not keep order)
+// Sets related.R.AdminGroupGroup.
+func (o *Group) RemoveAdminGroupExtensionResourceDefinitions(ctx context.Context, exec boil.ContextExecutor, related ...*ExtensionResourceDefinition) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AdminGroup, nil)
+		if rel.R != nil {
+			rel.R.AdminGroupGroup = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("admin_group")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AdminGroupExtensionResourceDefinitions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AdminGroupExtensionResourceDefinitions)
+			if ln > 1 && i < ln-1 {
+				o.R.AdminGroupExtensionResourceDefinitions[i] = o.R.AdminGroupExtensionResourceDefinitions[ln-1]
+			}
+			o.R.AdminGroupExtensionResourceDefinitions = o.R.AdminGroupExtensionResourceDefinitions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddDefaultOwnerGroupExtensionResourceDefinitions adds the given related objects to the existing relationships
+// of the group, optionally inserting them as new records.
+// Appends related to o.R.DefaultOwnerGroupExtensionResourceDefinitions.
+// Sets related.R.DefaultOwnerGroupGroup appropriately.
+func (o *Group) AddDefaultOwnerGroupExtensionResourceDefinitions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ExtensionResourceDefinition) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DefaultOwnerGroup, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"extension_resource_definitions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"default_owner_group"}),
+				strmangle.WhereClause("\"", "\"", 2, extensionResourceDefinitionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DefaultOwnerGroup, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &groupR{
+			DefaultOwnerGroupExtensionResourceDefinitions: related,
+		}
+	} else {
+		o.R.DefaultOwnerGroupExtensionResourceDefinitions = append(o.R.DefaultOwnerGroupExtensionResourceDefinitions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
 			rel.R = &extensionResourceDefinitionR{
-				AdminGroupGroup: o,
+				DefaultOwnerGroupGroup: o,
 			}
 		} else {
-			rel.R.AdminGroupGroup = o
+			rel.R.DefaultOwnerGroupGroup = o
 		}
 	}
 	return nil
 }
 
-// SetAdminGroupExtensionResourceDefinitions removes all previously related items of the
+// SetDefaultOwnerGroupExtensionResourceDefinitions removes all previously related items of the
 // group replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.AdminGroupGroup's AdminGroupExtensionResourceDefinitions accordingly.
-// Replaces o.R.AdminGroupExtensionResourceDefinitions with related.
-// Sets related.R.AdminGroupGroup's AdminGroupExtensionResourceDefinitions accordingly.
-func (o *Group) SetAdminGroupExtensionResourceDefinitions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ExtensionResourceDefinition) error {
-	query := "update \"extension_resource_definitions\" set \"admin_group\" = null where \"admin_group\" = $1"
+// Sets o.R.DefaultOwnerGroupGroup's DefaultOwnerGroupExtensionResourceDefinitions accordingly.
+// Replaces o.R.DefaultOwnerGroupExtensionResourceDefinitions with related.
+// Sets related.R.DefaultOwnerGroupGroup's DefaultOwnerGroupExtensionResourceDefinitions accordingly.
+func (o *Group) SetDefaultOwnerGroupExtensionResourceDefinitions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ExtensionResourceDefinition) error {
+	query := "update \"extension_resource_definitions\" set \"default_owner_group\" = null where \"default_owner_group\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -2637,35 +4485,35 @@ func (o *Group) SetAdminGroupExtensionResourceDefinitions(ctx context.Context, e
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.AdminGroupExtensionResourceDefinitions {
-			queries.SetScanner(&rel.AdminGroup, nil)
+		for _, rel := range o.R.DefaultOwnerGroupExtensionResourceDefinitions {
+			queries.SetScanner(&rel.DefaultOwnerGroup, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.AdminGroupGroup = nil
+			rel.R.DefaultOwnerGroupGroup = nil
 		}
-		o.R.AdminGroupExtensionResourceDefinitions = nil
+		o.R.DefaultOwnerGroupExtensionResourceDefinitions = nil
 	}
 
-	return o.AddAdminGroupExtensionResourceDefinitions(ctx, exec, insert, related...)
+	return o.AddDefaultOwnerGroupExtensionResourceDefinitions(ctx, exec, insert, related...)
 }
 
-// RemoveAdminGroupExtensionResourceDefinitions relationships from objects passed in.
-// Removes related items from R.AdminGroupExtensionResourceDefinitions (uses pointer comparison, removal does not keep order)
-// Sets related.R.AdminGroupGroup.
-func (o *Group) RemoveAdminGroupExtensionResourceDefinitions(ctx context.Context, exec boil.ContextExecutor, related ...*ExtensionResourceDefinition) error {
+// RemoveDefaultOwnerGroupExtensionResourceDefinitions relationships from objects passed in.
+// Removes related items from R.DefaultOwnerGroupExtensionResourceDefinitions (uses pointer comparison, removal does not keep order)
+// Sets related.R.DefaultOwnerGroupGroup.
+func (o *Group) RemoveDefaultOwnerGroupExtensionResourceDefinitions(ctx context.Context, exec boil.ContextExecutor, related ...*ExtensionResourceDefinition) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.AdminGroup, nil)
+		queries.SetScanner(&rel.DefaultOwnerGroup, nil)
 		if rel.R != nil {
-			rel.R.AdminGroupGroup = nil
+			rel.R.DefaultOwnerGroupGroup = nil
 		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("admin_group")); err != nil {
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("default_owner_group")); err != nil {
 			return err
 		}
 	}
@@ -2674,16 +4522,16 @@ func (o *Group) RemoveAdminGroupExtensionResourceDefinitions(ctx context.Context
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.AdminGroupExtensionResourceDefinitions {
+		for i, ri := range o.R.DefaultOwnerGroupExtensionResourceDefinitions {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.AdminGroupExtensionResourceDefinitions)
+			ln := len(o.R.DefaultOwnerGroupExtensionResourceDefinitions)
 			if ln > 1 && i < ln-1 {
-				o.R.AdminGroupExtensionResourceDefinitions[i] = o.R.AdminGroupExtensionResourceDefinitions[ln-1]
+				o.R.DefaultOwnerGroupExtensionResourceDefinitions[i] = o.R.DefaultOwnerGroupExtensionResourceDefinitions[ln-1]
 			}
-			o.R.AdminGroupExtensionResourceDefinitions = o.R.AdminGroupExtensionResourceDefinitions[:ln-1]
+			o.R.DefaultOwnerGroupExtensionResourceDefinitions = o.R.DefaultOwnerGroupExtensionResourceDefinitions[:ln-1]
 			break
 		}
 	}
@@ -3242,6 +5090,313 @@ func (o *Group) RemoveApproverGroupGroups(ctx context.Context, exec boil.Context
 	return nil
 }
 
+// AddNotificationDispatches adds the given related objects to the existing relationships
+// of the group, optionally inserting them as new records.
+// Appends related to o.R.NotificationDispatches.
+// Sets related.R.Group appropriately.
+func (o *Group) AddNotificationDispatches(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationDispatch) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.GroupID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification_dispatches\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"group_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationDispatchPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.GroupID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &groupR{
+			NotificationDispatches: related,
+		}
+	} else {
+		o.R.NotificationDispatches = append(o.R.NotificationDispatches, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationDispatchR{
+				Group: o,
+			}
+		} else {
+			rel.R.Group = o
+		}
+	}
+	return nil
+}
+
+// SetNotificationDispatches removes all previously related items of the
+// group replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Group's NotificationDispatches accordingly.
+// Replaces o.R.NotificationDispatches with related.
+// Sets related.R.Group's NotificationDispatches accordingly.
+func (o *Group) SetNotificationDispatches(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationDispatch) error {
+	query := "update \"notification_dispatches\" set \"group_id\" = null where \"group_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.NotificationDispatches {
+			queries.SetScanner(&rel.GroupID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Group = nil
+		}
+		o.R.NotificationDispatches = nil
+	}
+
+	return o.AddNotificationDispatches(ctx, exec, insert, related...)
+}
+
+// RemoveNotificationDispatches relationships from objects passed in.
+// Removes related items from R.NotificationDispatches (uses pointer comparison, removal does not keep order)
+// Sets related.R.Group.
+func (o *Group) RemoveNotificationDispatches(ctx context.Context, exec boil.ContextExecutor, related ...*NotificationDispatch) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.GroupID, nil)
+		if rel.R != nil {
+			rel.R.Group = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("group_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.NotificationDispatches {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.NotificationDispatches)
+			if ln > 1 && i < ln-1 {
+				o.R.NotificationDispatches[i] = o.R.NotificationDispatches[ln-1]
+			}
+			o.R.NotificationDispatches = o.R.NotificationDispatches[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddNotificationGroupPreferences adds the given related objects to the existing relationships
+// of the group, optionally inserting them as new records.
+// Appends related to o.R.NotificationGroupPreferences.
+// Sets related.R.Group appropriately.
+func (o *Group) AddNotificationGroupPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationGroupPreference) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GroupID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification_group_preferences\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"group_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationGroupPreferencePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GroupID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &groupR{
+			NotificationGroupPreferences: related,
+		}
+	} else {
+		o.R.NotificationGroupPreferences = append(o.R.NotificationGroupPreferences, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationGroupPreferenceR{
+				Group: o,
+			}
+		} else {
+			rel.R.Group = o
+		}
+	}
+	return nil
+}
+
+// AddOwnerSystemExtensionResources adds the given related objects to the existing relationships
+// of the group, optionally inserting them as new records.
+// Appends related to o.R.OwnerSystemExtensionResources.
+// Sets related.R.Owner appropriately.
+func (o *Group) AddOwnerSystemExtensionResources(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SystemExtensionResource) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.OwnerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"system_extension_resources\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, systemExtensionResourcePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.OwnerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &groupR{
+			OwnerSystemExtensionResources: related,
+		}
+	} else {
+		o.R.OwnerSystemExtensionResources = append(o.R.OwnerSystemExtensionResources, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &systemExtensionResourceR{
+				Owner: o,
+			}
+		} else {
+			rel.R.Owner = o
+		}
+	}
+	return nil
+}
+
+// SetOwnerSystemExtensionResources removes all previously related items of the
+// group replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Owner's OwnerSystemExtensionResources accordingly.
+// Replaces o.R.OwnerSystemExtensionResources with related.
+// Sets related.R.Owner's OwnerSystemExtensionResources accordingly.
+func (o *Group) SetOwnerSystemExtensionResources(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SystemExtensionResource) error {
+	query := "update \"system_extension_resources\" set \"owner_id\" = null where \"owner_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.OwnerSystemExtensionResources {
+			queries.SetScanner(&rel.OwnerID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Owner = nil
+		}
+		o.R.OwnerSystemExtensionResources = nil
+	}
+
+	return o.AddOwnerSystemExtensionResources(ctx, exec, insert, related...)
+}
+
+// RemoveOwnerSystemExtensionResources relationships from objects passed in.
+// Removes related items from R.OwnerSystemExtensionResources (uses pointer comparison, removal does not keep order)
+// Sets related.R.Owner.
+func (o *Group) RemoveOwnerSystemExtensionResources(ctx context.Context, exec boil.ContextExecutor, related ...*SystemExtensionResource) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.OwnerID, nil)
+		if rel.R != nil {
+			rel.R.Owner = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("owner_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.OwnerSystemExtensionResources {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.OwnerSystemExtensionResources)
+			if ln > 1 && i < ln-1 {
+				o.R.OwnerSystemExtensionResources[i] = o.R.OwnerSystemExtensionResources[ln-1]
+			}
+			o.R.OwnerSystemExtensionResources = o.R.OwnerSystemExtensionResources[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Groups retrieves all the records using an executor.
 func Groups(mods ...qm.QueryMod) groupQuery {
 	mods = append(mods, qm.From("\"groups\""), qmhelper.WhereIsNull("\"groups\".\"deleted_at\""))
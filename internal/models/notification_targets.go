@@ -101,14 +101,20 @@ var NotificationTargetWhere = struct {
 
 // NotificationTargetRels is where relationship names are stored.
 var NotificationTargetRels = struct {
-	NotificationPreferences string
+	DeliveredTargetNotificationDispatches string
+	NotificationPreferences               string
+	NotificationTargetRankings            string
 }{
-	NotificationPreferences: "NotificationPreferences",
+	DeliveredTargetNotificationDispatches: "DeliveredTargetNotificationDispatches",
+	NotificationPreferences:               "NotificationPreferences",
+	NotificationTargetRankings:            "NotificationTargetRankings",
 }
 
 // notificationTargetR is where relationships are stored.
 type notificationTargetR struct {
-	NotificationPreferences NotificationPreferenceSlice `boil:"NotificationPreferences" json:"NotificationPreferences" toml:"NotificationPreferences" yaml:"NotificationPreferences"`
+	DeliveredTargetNotificationDispatches NotificationDispatchSlice      `boil:"DeliveredTargetNotificationDispatches" json:"DeliveredTargetNotificationDispatches" toml:"DeliveredTargetNotificationDispatches" yaml:"DeliveredTargetNotificationDispatches"`
+	NotificationPreferences               NotificationPreferenceSlice    `boil:"NotificationPreferences" json:"NotificationPreferences" toml:"NotificationPreferences" yaml:"NotificationPreferences"`
+	NotificationTargetRankings            NotificationTargetRankingSlice `boil:"NotificationTargetRankings" json:"NotificationTargetRankings" toml:"NotificationTargetRankings" yaml:"NotificationTargetRankings"`
 }
 
 // NewStruct creates a new relationship struct
@@ -116,6 +122,13 @@ func (*notificationTargetR) NewStruct() *notificationTargetR {
 	return &notificationTargetR{}
 }
 
+func (r *notificationTargetR) GetDeliveredTargetNotificationDispatches() NotificationDispatchSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DeliveredTargetNotificationDispatches
+}
+
 func (r *notificationTargetR) GetNotificationPreferences() NotificationPreferenceSlice {
 	if r == nil {
 		return nil
@@ -123,6 +136,13 @@ func (r *notificationTargetR) GetNotificationPreferences() NotificationPreferenc
 	return r.NotificationPreferences
 }
 
+func (r *notificationTargetR) GetNotificationTargetRankings() NotificationTargetRankingSlice {
+	if r == nil {
+		return nil
+	}
+	return r.NotificationTargetRankings
+}
+
 // notificationTargetL is where Load methods for each relationship are stored.
 type notificationTargetL struct{}
 
@@ -439,6 +459,20 @@ func (q notificationTargetQuery) Exists(ctx context.Context, exec boil.ContextEx
 	return count > 0, nil
 }
 
+// DeliveredTargetNotificationDispatches retrieves all the notification_dispatch's NotificationDispatches with an executor via delivered_target_id column.
+func (o *NotificationTarget) DeliveredTargetNotificationDispatches(mods ...qm.QueryMod) notificationDispatchQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_dispatches\".\"delivered_target_id\"=?", o.ID),
+	)
+
+	return NotificationDispatches(queryMods...)
+}
+
 // NotificationPreferences retrieves all the notification_preference's NotificationPreferences with an executor.
 func (o *NotificationTarget) NotificationPreferences(mods ...qm.QueryMod) notificationPreferenceQuery {
 	var queryMods []qm.QueryMod
@@ -453,6 +487,133 @@ func (o *NotificationTarget) NotificationPreferences(mods ...qm.QueryMod) notifi
 	return NotificationPreferences(queryMods...)
 }
 
+// NotificationTargetRankings retrieves all the notification_target_ranking's NotificationTargetRankings with an executor.
+func (o *NotificationTarget) NotificationTargetRankings(mods ...qm.QueryMod) notificationTargetRankingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_target_rankings\".\"notification_target_id\"=?", o.ID),
+	)
+
+	return NotificationTargetRankings(queryMods...)
+}
+
+// LoadDeliveredTargetNotificationDispatches allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (notificationTargetL) LoadDeliveredTargetNotificationDispatches(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotificationTarget interface{}, mods queries.Applicator) error {
+	var slice []*NotificationTarget
+	var object *NotificationTarget
+
+	if singular {
+		var ok bool
+		object, ok = maybeNotificationTarget.(*NotificationTarget)
+		if !ok {
+			object = new(NotificationTarget)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNotificationTarget)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNotificationTarget))
+			}
+		}
+	} else {
+		s, ok := maybeNotificationTarget.(*[]*NotificationTarget)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNotificationTarget)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNotificationTarget))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &notificationTargetR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &notificationTargetR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_dispatches`),
+		qm.WhereIn(`notification_dispatches.delivered_target_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_dispatches")
+	}
+
+	var resultSlice []*NotificationDispatch
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_dispatches")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_dispatches")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_dispatches")
+	}
+
+	if len(notificationDispatchAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DeliveredTargetNotificationDispatches = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationDispatchR{}
+			}
+			foreign.R.DeliveredTarget = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.DeliveredTargetID) {
+				local.R.DeliveredTargetNotificationDispatches = append(local.R.DeliveredTargetNotificationDispatches, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationDispatchR{}
+				}
+				foreign.R.DeliveredTarget = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadNotificationPreferences allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (notificationTargetL) LoadNotificationPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotificationTarget interface{}, mods queries.Applicator) error {
@@ -566,6 +727,246 @@ func (notificationTargetL) LoadNotificationPreferences(ctx context.Context, e bo
 	return nil
 }
 
+// LoadNotificationTargetRankings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (notificationTargetL) LoadNotificationTargetRankings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotificationTarget interface{}, mods queries.Applicator) error {
+	var slice []*NotificationTarget
+	var object *NotificationTarget
+
+	if singular {
+		var ok bool
+		object, ok = maybeNotificationTarget.(*NotificationTarget)
+		if !ok {
+			object = new(NotificationTarget)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNotificationTarget)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNotificationTarget))
+			}
+		}
+	} else {
+		s, ok := maybeNotificationTarget.(*[]*NotificationTarget)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNotificationTarget)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNotificationTarget))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &notificationTargetR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &notificationTargetR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_target_rankings`),
+		qm.WhereIn(`notification_target_rankings.notification_target_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_target_rankings")
+	}
+
+	var resultSlice []*NotificationTargetRanking
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_target_rankings")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_target_rankings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_target_rankings")
+	}
+
+	if len(notificationTargetRankingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationTargetRankings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationTargetRankingR{}
+			}
+			foreign.R.NotificationTarget = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.NotificationTargetID {
+				local.R.NotificationTargetRankings = append(local.R.NotificationTargetRankings, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationTargetRankingR{}
+				}
+				foreign.R.NotificationTarget = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddDeliveredTargetNotificationDispatches adds the given related objects to the existing relationships
+// of the notification_target, optionally inserting them as new records.
+// Appends related to o.R.DeliveredTargetNotificationDispatches.
+// Sets related.R.DeliveredTarget appropriately.
+func (o *NotificationTarget) AddDeliveredTargetNotificationDispatches(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationDispatch) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DeliveredTargetID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification_dispatches\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"delivered_target_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationDispatchPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DeliveredTargetID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &notificationTargetR{
+			DeliveredTargetNotificationDispatches: related,
+		}
+	} else {
+		o.R.DeliveredTargetNotificationDispatches = append(o.R.DeliveredTargetNotificationDispatches, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationDispatchR{
+				DeliveredTarget: o,
+			}
+		} else {
+			rel.R.DeliveredTarget = o
+		}
+	}
+	return nil
+}
+
+// SetDeliveredTargetNotificationDispatches removes all previously related items of the
+// notification_target replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.DeliveredTarget's DeliveredTargetNotificationDispatches accordingly.
+// Replaces o.R.DeliveredTargetNotificationDispatches with related.
+// Sets related.R.DeliveredTarget's DeliveredTargetNotificationDispatches accordingly.
+func (o *NotificationTarget) SetDeliveredTargetNotificationDispatches(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationDispatch) error {
+	query := "update \"notification_dispatches\" set \"delivered_target_id\" = null where \"delivered_target_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.DeliveredTargetNotificationDispatches {
+			queries.SetScanner(&rel.DeliveredTargetID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.DeliveredTarget = nil
+		}
+		o.R.DeliveredTargetNotificationDispatches = nil
+	}
+
+	return o.AddDeliveredTargetNotificationDispatches(ctx, exec, insert, related...)
+}
+
+// RemoveDeliveredTargetNotificationDispatches relationships from objects passed in.
+// Removes related items from R.DeliveredTargetNotificationDispatches (uses pointer comparison, removal does not keep order)
+// Sets related.R.DeliveredTarget.
+func (o *NotificationTarget) RemoveDeliveredTargetNotificationDispatches(ctx context.Context, exec boil.ContextExecutor, related ...*NotificationDispatch) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DeliveredTargetID, nil)
+		if rel.R != nil {
+			rel.R.DeliveredTarget = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("delivered_target_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.DeliveredTargetNotificationDispatches {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.DeliveredTargetNotificationDispatches)
+			if ln > 1 && i < ln-1 {
+				o.R.DeliveredTargetNotificationDispatches[i] = o.R.DeliveredTargetNotificationDispatches[ln-1]
+			}
+			o.R.DeliveredTargetNotificationDispatches = o.R.DeliveredTargetNotificationDispatches[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddNotificationPreferences adds the given related objects to the existing relationships
 // of the notification_target, optionally inserting them as new records.
 // Appends related to o.R.NotificationPreferences.
@@ -693,6 +1094,59 @@ func (o *NotificationTarget) RemoveNotificationPreferences(ctx context.Context,
 	return nil
 }
 
+// AddNotificationTargetRankings adds the given related objects to the existing relationships
+// of the notification_target, optionally inserting them as new records.
+// Appends related to o.R.NotificationTargetRankings.
+// Sets related.R.NotificationTarget appropriately.
+func (o *NotificationTarget) AddNotificationTargetRankings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationTargetRanking) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.NotificationTargetID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification_target_rankings\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"notification_target_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationTargetRankingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.NotificationTargetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &notificationTargetR{
+			NotificationTargetRankings: related,
+		}
+	} else {
+		o.R.NotificationTargetRankings = append(o.R.NotificationTargetRankings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationTargetRankingR{
+				NotificationTarget: o,
+			}
+		} else {
+			rel.R.NotificationTarget = o
+		}
+	}
+	return nil
+}
+
 // NotificationTargets retrieves all the records using an executor.
 func NotificationTargets(mods ...qm.QueryMod) notificationTargetQuery {
 	mods = append(mods, qm.From("\"notification_targets\""), qmhelper.WhereIsNull("\"notification_targets\".\"deleted_at\""))
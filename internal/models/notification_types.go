@@ -101,14 +101,23 @@ var NotificationTypeWhere = struct {
 
 // NotificationTypeRels is where relationship names are stored.
 var NotificationTypeRels = struct {
-	NotificationPreferences string
+	NotificationDispatches       string
+	NotificationFailoverPolicies string
+	NotificationGroupPreferences string
+	NotificationPreferences      string
 }{
-	NotificationPreferences: "NotificationPreferences",
+	NotificationDispatches:       "NotificationDispatches",
+	NotificationFailoverPolicies: "NotificationFailoverPolicies",
+	NotificationGroupPreferences: "NotificationGroupPreferences",
+	NotificationPreferences:      "NotificationPreferences",
 }
 
 // notificationTypeR is where relationships are stored.
 type notificationTypeR struct {
-	NotificationPreferences NotificationPreferenceSlice `boil:"NotificationPreferences" json:"NotificationPreferences" toml:"NotificationPreferences" yaml:"NotificationPreferences"`
+	NotificationDispatches       NotificationDispatchSlice        `boil:"NotificationDispatches" json:"NotificationDispatches" toml:"NotificationDispatches" yaml:"NotificationDispatches"`
+	NotificationFailoverPolicies NotificationFailoverPolicySlice  `boil:"NotificationFailoverPolicies" json:"NotificationFailoverPolicies" toml:"NotificationFailoverPolicies" yaml:"NotificationFailoverPolicies"`
+	NotificationGroupPreferences NotificationGroupPreferenceSlice `boil:"NotificationGroupPreferences" json:"NotificationGroupPreferences" toml:"NotificationGroupPreferences" yaml:"NotificationGroupPreferences"`
+	NotificationPreferences      NotificationPreferenceSlice      `boil:"NotificationPreferences" json:"NotificationPreferences" toml:"NotificationPreferences" yaml:"NotificationPreferences"`
 }
 
 // NewStruct creates a new relationship struct
@@ -116,6 +125,27 @@ func (*notificationTypeR) NewStruct() *notificationTypeR {
 	return &notificationTypeR{}
 }
 
+func (r *notificationTypeR) GetNotificationDispatches() NotificationDispatchSlice {
+	if r == nil {
+		return nil
+	}
+	return r.NotificationDispatches
+}
+
+func (r *notificationTypeR) GetNotificationFailoverPolicies() NotificationFailoverPolicySlice {
+	if r == nil {
+		return nil
+	}
+	return r.NotificationFailoverPolicies
+}
+
+func (r *notificationTypeR) GetNotificationGroupPreferences() NotificationGroupPreferenceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.NotificationGroupPreferences
+}
+
 func (r *notificationTypeR) GetNotificationPreferences() NotificationPreferenceSlice {
 	if r == nil {
 		return nil
@@ -439,6 +469,48 @@ func (q notificationTypeQuery) Exists(ctx context.Context, exec boil.ContextExec
 	return count > 0, nil
 }
 
+// NotificationDispatches retrieves all the notification_dispatch's NotificationDispatches with an executor.
+func (o *NotificationType) NotificationDispatches(mods ...qm.QueryMod) notificationDispatchQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_dispatches\".\"notification_type_id\"=?", o.ID),
+	)
+
+	return NotificationDispatches(queryMods...)
+}
+
+// NotificationFailoverPolicies retrieves all the notification_failover_policy's NotificationFailoverPolicies with an executor.
+func (o *NotificationType) NotificationFailoverPolicies(mods ...qm.QueryMod) notificationFailoverPolicyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_failover_policies\".\"notification_type_id\"=?", o.ID),
+	)
+
+	return NotificationFailoverPolicies(queryMods...)
+}
+
+// NotificationGroupPreferences retrieves all the notification_group_preference's NotificationGroupPreferences with an executor.
+func (o *NotificationType) NotificationGroupPreferences(mods ...qm.QueryMod) notificationGroupPreferenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_group_preferences\".\"notification_type_id\"=?", o.ID),
+	)
+
+	return NotificationGroupPreferences(queryMods...)
+}
+
 // NotificationPreferences retrieves all the notification_preference's NotificationPreferences with an executor.
 func (o *NotificationType) NotificationPreferences(mods ...qm.QueryMod) notificationPreferenceQuery {
 	var queryMods []qm.QueryMod
@@ -453,6 +525,345 @@ func (o *NotificationType) NotificationPreferences(mods ...qm.QueryMod) notifica
 	return NotificationPreferences(queryMods...)
 }
 
+// LoadNotificationDispatches allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (notificationTypeL) LoadNotificationDispatches(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotificationType interface{}, mods queries.Applicator) error {
+	var slice []*NotificationType
+	var object *NotificationType
+
+	if singular {
+		var ok bool
+		object, ok = maybeNotificationType.(*NotificationType)
+		if !ok {
+			object = new(NotificationType)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNotificationType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNotificationType))
+			}
+		}
+	} else {
+		s, ok := maybeNotificationType.(*[]*NotificationType)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNotificationType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNotificationType))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &notificationTypeR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &notificationTypeR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_dispatches`),
+		qm.WhereIn(`notification_dispatches.notification_type_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_dispatches")
+	}
+
+	var resultSlice []*NotificationDispatch
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_dispatches")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_dispatches")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_dispatches")
+	}
+
+	if len(notificationDispatchAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationDispatches = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationDispatchR{}
+			}
+			foreign.R.NotificationType = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.NotificationTypeID {
+				local.R.NotificationDispatches = append(local.R.NotificationDispatches, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationDispatchR{}
+				}
+				foreign.R.NotificationType = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationFailoverPolicies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (notificationTypeL) LoadNotificationFailoverPolicies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotificationType interface{}, mods queries.Applicator) error {
+	var slice []*NotificationType
+	var object *NotificationType
+
+	if singular {
+		var ok bool
+		object, ok = maybeNotificationType.(*NotificationType)
+		if !ok {
+			object = new(NotificationType)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNotificationType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNotificationType))
+			}
+		}
+	} else {
+		s, ok := maybeNotificationType.(*[]*NotificationType)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNotificationType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNotificationType))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &notificationTypeR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &notificationTypeR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_failover_policies`),
+		qm.WhereIn(`notification_failover_policies.notification_type_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_failover_policies")
+	}
+
+	var resultSlice []*NotificationFailoverPolicy
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_failover_policies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_failover_policies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_failover_policies")
+	}
+
+	if len(notificationFailoverPolicyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationFailoverPolicies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationFailoverPolicyR{}
+			}
+			foreign.R.NotificationType = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.NotificationTypeID {
+				local.R.NotificationFailoverPolicies = append(local.R.NotificationFailoverPolicies, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationFailoverPolicyR{}
+				}
+				foreign.R.NotificationType = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationGroupPreferences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (notificationTypeL) LoadNotificationGroupPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotificationType interface{}, mods queries.Applicator) error {
+	var slice []*NotificationType
+	var object *NotificationType
+
+	if singular {
+		var ok bool
+		object, ok = maybeNotificationType.(*NotificationType)
+		if !ok {
+			object = new(NotificationType)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeNotificationType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeNotificationType))
+			}
+		}
+	} else {
+		s, ok := maybeNotificationType.(*[]*NotificationType)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeNotificationType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeNotificationType))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &notificationTypeR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &notificationTypeR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_group_preferences`),
+		qm.WhereIn(`notification_group_preferences.notification_type_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_group_preferences")
+	}
+
+	var resultSlice []*NotificationGroupPreference
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_group_preferences")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_group_preferences")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_group_preferences")
+	}
+
+	if len(notificationGroupPreferenceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationGroupPreferences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationGroupPreferenceR{}
+			}
+			foreign.R.NotificationType = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.NotificationTypeID) {
+				local.R.NotificationGroupPreferences = append(local.R.NotificationGroupPreferences, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationGroupPreferenceR{}
+				}
+				foreign.R.NotificationType = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadNotificationPreferences allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (notificationTypeL) LoadNotificationPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeNotificationType interface{}, mods queries.Applicator) error {
@@ -566,6 +977,239 @@ func (notificationTypeL) LoadNotificationPreferences(ctx context.Context, e boil
 	return nil
 }
 
+// AddNotificationDispatches adds the given related objects to the existing relationships
+// of the notification_type, optionally inserting them as new records.
+// Appends related to o.R.NotificationDispatches.
+// Sets related.R.NotificationType appropriately.
+func (o *NotificationType) AddNotificationDispatches(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationDispatch) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.NotificationTypeID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification_dispatches\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"notification_type_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationDispatchPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.NotificationTypeID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &notificationTypeR{
+			NotificationDispatches: related,
+		}
+	} else {
+		o.R.NotificationDispatches = append(o.R.NotificationDispatches, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationDispatchR{
+				NotificationType: o,
+			}
+		} else {
+			rel.R.NotificationType = o
+		}
+	}
+	return nil
+}
+
+// AddNotificationFailoverPolicies adds the given related objects to the existing relationships
+// of the notification_type, optionally inserting them as new records.
+// Appends related to o.R.NotificationFailoverPolicies.
+// Sets related.R.NotificationType appropriately.
+func (o *NotificationType) AddNotificationFailoverPolicies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationFailoverPolicy) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.NotificationTypeID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification_failover_policies\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"notification_type_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationFailoverPolicyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.NotificationTypeID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &notificationTypeR{
+			NotificationFailoverPolicies: related,
+		}
+	} else {
+		o.R.NotificationFailoverPolicies = append(o.R.NotificationFailoverPolicies, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationFailoverPolicyR{
+				NotificationType: o,
+			}
+		} else {
+			rel.R.NotificationType = o
+		}
+	}
+	return nil
+}
+
+// AddNotificationGroupPreferences adds the given related objects to the existing relationships
+// of the notification_type, optionally inserting them as new records.
+// Appends related to o.R.NotificationGroupPreferences.
+// Sets related.R.NotificationType appropriately.
+func (o *NotificationType) AddNotificationGroupPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationGroupPreference) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.NotificationTypeID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification_group_preferences\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"notification_type_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationGroupPreferencePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.NotificationTypeID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &notificationTypeR{
+			NotificationGroupPreferences: related,
+		}
+	} else {
+		o.R.NotificationGroupPreferences = append(o.R.NotificationGroupPreferences, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationGroupPreferenceR{
+				NotificationType: o,
+			}
+		} else {
+			rel.R.NotificationType = o
+		}
+	}
+	return nil
+}
+
+// SetNotificationGroupPreferences removes all previously related items of the
+// notification_type replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.NotificationType's NotificationGroupPreferences accordingly.
+// Replaces o.R.NotificationGroupPreferences with related.
+// Sets related.R.NotificationType's NotificationGroupPreferences accordingly.
+func (o *NotificationType) SetNotificationGroupPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationGroupPreference) error {
+	query := "update \"notification_group_preferences\" set \"notification_type_id\" = null where \"notification_type_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.NotificationGroupPreferences {
+			queries.SetScanner(&rel.NotificationTypeID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.NotificationType = nil
+		}
+		o.R.NotificationGroupPreferences = nil
+	}
+
+	return o.AddNotificationGroupPreferences(ctx, exec, insert, related...)
+}
+
+// RemoveNotificationGroupPreferences relationships from objects passed in.
+// Removes related items from R.NotificationGroupPreferences (uses pointer comparison, removal does not keep order)
+// Sets related.R.NotificationType.
+func (o *NotificationType) RemoveNotificationGroupPreferences(ctx context.Context, exec boil.ContextExecutor, related ...*NotificationGroupPreference) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.NotificationTypeID, nil)
+		if rel.R != nil {
+			rel.R.NotificationType = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("notification_type_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.NotificationGroupPreferences {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.NotificationGroupPreferences)
+			if ln > 1 && i < ln-1 {
+				o.R.NotificationGroupPreferences[i] = o.R.NotificationGroupPreferences[ln-1]
+			}
+			o.R.NotificationGroupPreferences = o.R.NotificationGroupPreferences[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddNotificationPreferences adds the given related objects to the existing relationships
 // of the notification_type, optionally inserting them as new records.
 // Appends related to o.R.NotificationPreferences.
@@ -87,17 +87,32 @@ var OrganizationWhere = struct {
 
 // OrganizationRels is where relationship names are stored.
 var OrganizationRels = struct {
+	MembershipDurationPolicy       string
+	NamingPolicy                   string
+	TenantApplications             string
 	SubjectOrganizationAuditEvents string
 	GroupOrganizations             string
+	TenantGroups                   string
+	TenantUsers                    string
 }{
+	MembershipDurationPolicy:       "MembershipDurationPolicy",
+	NamingPolicy:                   "NamingPolicy",
+	TenantApplications:             "TenantApplications",
 	SubjectOrganizationAuditEvents: "SubjectOrganizationAuditEvents",
 	GroupOrganizations:             "GroupOrganizations",
+	TenantGroups:                   "TenantGroups",
+	TenantUsers:                    "TenantUsers",
 }
 
 // organizationR is where relationships are stored.
 type organizationR struct {
-	SubjectOrganizationAuditEvents AuditEventSlice        `boil:"SubjectOrganizationAuditEvents" json:"SubjectOrganizationAuditEvents" toml:"SubjectOrganizationAuditEvents" yaml:"SubjectOrganizationAuditEvents"`
-	GroupOrganizations             GroupOrganizationSlice `boil:"GroupOrganizations" json:"GroupOrganizations" toml:"GroupOrganizations" yaml:"GroupOrganizations"`
+	MembershipDurationPolicy       *MembershipDurationPolicy `boil:"MembershipDurationPolicy" json:"MembershipDurationPolicy" toml:"MembershipDurationPolicy" yaml:"MembershipDurationPolicy"`
+	NamingPolicy                   *NamingPolicy             `boil:"NamingPolicy" json:"NamingPolicy" toml:"NamingPolicy" yaml:"NamingPolicy"`
+	TenantApplications             ApplicationSlice          `boil:"TenantApplications" json:"TenantApplications" toml:"TenantApplications" yaml:"TenantApplications"`
+	SubjectOrganizationAuditEvents AuditEventSlice           `boil:"SubjectOrganizationAuditEvents" json:"SubjectOrganizationAuditEvents" toml:"SubjectOrganizationAuditEvents" yaml:"SubjectOrganizationAuditEvents"`
+	GroupOrganizations             GroupOrganizationSlice    `boil:"GroupOrganizations" json:"GroupOrganizations" toml:"GroupOrganizations" yaml:"GroupOrganizations"`
+	TenantGroups                   GroupSlice                `boil:"TenantGroups" json:"TenantGroups" toml:"TenantGroups" yaml:"TenantGroups"`
+	TenantUsers                    UserSlice                 `boil:"TenantUsers" json:"TenantUsers" toml:"TenantUsers" yaml:"TenantUsers"`
 }
 
 // NewStruct creates a new relationship struct
@@ -105,6 +120,27 @@ func (*organizationR) NewStruct() *organizationR {
 	return &organizationR{}
 }
 
+func (r *organizationR) GetMembershipDurationPolicy() *MembershipDurationPolicy {
+	if r == nil {
+		return nil
+	}
+	return r.MembershipDurationPolicy
+}
+
+func (r *organizationR) GetNamingPolicy() *NamingPolicy {
+	if r == nil {
+		return nil
+	}
+	return r.NamingPolicy
+}
+
+func (r *organizationR) GetTenantApplications() ApplicationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TenantApplications
+}
+
 func (r *organizationR) GetSubjectOrganizationAuditEvents() AuditEventSlice {
 	if r == nil {
 		return nil
@@ -119,6 +155,20 @@ func (r *organizationR) GetGroupOrganizations() GroupOrganizationSlice {
 	return r.GroupOrganizations
 }
 
+func (r *organizationR) GetTenantGroups() GroupSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TenantGroups
+}
+
+func (r *organizationR) GetTenantUsers() UserSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TenantUsers
+}
+
 // organizationL is where Load methods for each relationship are stored.
 type organizationL struct{}
 
@@ -435,6 +485,42 @@ func (q organizationQuery) Exists(ctx context.Context, exec boil.ContextExecutor
 	return count > 0, nil
 }
 
+// MembershipDurationPolicy pointed to by the foreign key.
+func (o *Organization) MembershipDurationPolicy(mods ...qm.QueryMod) membershipDurationPolicyQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"organization_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return MembershipDurationPolicies(queryMods...)
+}
+
+// NamingPolicy pointed to by the foreign key.
+func (o *Organization) NamingPolicy(mods ...qm.QueryMod) namingPolicyQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"organization_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return NamingPolicies(queryMods...)
+}
+
+// TenantApplications retrieves all the application's Applications with an executor via tenant_id column.
+func (o *Organization) TenantApplications(mods ...qm.QueryMod) applicationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"applications\".\"tenant_id\"=?", o.ID),
+	)
+
+	return Applications(queryMods...)
+}
+
 // SubjectOrganizationAuditEvents retrieves all the audit_event's AuditEvents with an executor via subject_organization_id column.
 func (o *Organization) SubjectOrganizationAuditEvents(mods ...qm.QueryMod) auditEventQuery {
 	var queryMods []qm.QueryMod
@@ -463,9 +549,37 @@ func (o *Organization) GroupOrganizations(mods ...qm.QueryMod) groupOrganization
 	return GroupOrganizations(queryMods...)
 }
 
-// LoadSubjectOrganizationAuditEvents allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (organizationL) LoadSubjectOrganizationAuditEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+// TenantGroups retrieves all the group's Groups with an executor via tenant_id column.
+func (o *Organization) TenantGroups(mods ...qm.QueryMod) groupQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"groups\".\"tenant_id\"=?", o.ID),
+	)
+
+	return Groups(queryMods...)
+}
+
+// TenantUsers retrieves all the user's Users with an executor via tenant_id column.
+func (o *Organization) TenantUsers(mods ...qm.QueryMod) userQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"users\".\"tenant_id\"=?", o.ID),
+	)
+
+	return Users(queryMods...)
+}
+
+// LoadMembershipDurationPolicy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (organizationL) LoadMembershipDurationPolicy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
 	var slice []*Organization
 	var object *Organization
 
@@ -502,6 +616,7 @@ func (organizationL) LoadSubjectOrganizationAuditEvents(ctx context.Context, e b
 			if obj.R == nil {
 				obj.R = &organizationR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -518,8 +633,8 @@ func (organizationL) LoadSubjectOrganizationAuditEvents(ctx context.Context, e b
 	}
 
 	query := NewQuery(
-		qm.From(`audit_events`),
-		qm.WhereIn(`audit_events.subject_organization_id in ?`, argsSlice...),
+		qm.From(`membership_duration_policies`),
+		qm.WhereIn(`membership_duration_policies.organization_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -527,47 +642,50 @@ func (organizationL) LoadSubjectOrganizationAuditEvents(ctx context.Context, e b
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load audit_events")
+		return errors.Wrap(err, "failed to eager load MembershipDurationPolicy")
 	}
 
-	var resultSlice []*AuditEvent
+	var resultSlice []*MembershipDurationPolicy
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice audit_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice MembershipDurationPolicy")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on audit_events")
+		return errors.Wrap(err, "failed to close results of eager load for membership_duration_policies")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for membership_duration_policies")
 	}
 
-	if len(auditEventAfterSelectHooks) != 0 {
+	if len(membershipDurationPolicyAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.SubjectOrganizationAuditEvents = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &auditEventR{}
-			}
-			foreign.R.SubjectOrganization = object
+		foreign := resultSlice[0]
+		object.R.MembershipDurationPolicy = foreign
+		if foreign.R == nil {
+			foreign.R = &membershipDurationPolicyR{}
 		}
-		return nil
+		foreign.R.Organization = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.SubjectOrganizationID) {
-				local.R.SubjectOrganizationAuditEvents = append(local.R.SubjectOrganizationAuditEvents, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ID, foreign.OrganizationID) {
+				local.R.MembershipDurationPolicy = foreign
 				if foreign.R == nil {
-					foreign.R = &auditEventR{}
+					foreign.R = &membershipDurationPolicyR{}
 				}
-				foreign.R.SubjectOrganization = local
+				foreign.R.Organization = local
 				break
 			}
 		}
@@ -576,9 +694,9 @@ func (organizationL) LoadSubjectOrganizationAuditEvents(ctx context.Context, e b
 	return nil
 }
 
-// LoadGroupOrganizations allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (organizationL) LoadGroupOrganizations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+// LoadNamingPolicy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (organizationL) LoadNamingPolicy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
 	var slice []*Organization
 	var object *Organization
 
@@ -615,6 +733,7 @@ func (organizationL) LoadGroupOrganizations(ctx context.Context, e boil.ContextE
 			if obj.R == nil {
 				obj.R = &organizationR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -631,8 +750,8 @@ func (organizationL) LoadGroupOrganizations(ctx context.Context, e boil.ContextE
 	}
 
 	query := NewQuery(
-		qm.From(`group_organizations`),
-		qm.WhereIn(`group_organizations.organization_id in ?`, argsSlice...),
+		qm.From(`naming_policies`),
+		qm.WhereIn(`naming_policies.organization_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -640,45 +759,48 @@ func (organizationL) LoadGroupOrganizations(ctx context.Context, e boil.ContextE
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load group_organizations")
+		return errors.Wrap(err, "failed to eager load NamingPolicy")
 	}
 
-	var resultSlice []*GroupOrganization
+	var resultSlice []*NamingPolicy
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice group_organizations")
+		return errors.Wrap(err, "failed to bind eager loaded slice NamingPolicy")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on group_organizations")
+		return errors.Wrap(err, "failed to close results of eager load for naming_policies")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_organizations")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for naming_policies")
 	}
 
-	if len(groupOrganizationAfterSelectHooks) != 0 {
+	if len(namingPolicyAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.GroupOrganizations = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &groupOrganizationR{}
-			}
-			foreign.R.Organization = object
+		foreign := resultSlice[0]
+		object.R.NamingPolicy = foreign
+		if foreign.R == nil {
+			foreign.R = &namingPolicyR{}
 		}
-		return nil
+		foreign.R.Organization = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.OrganizationID {
-				local.R.GroupOrganizations = append(local.R.GroupOrganizations, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ID, foreign.OrganizationID) {
+				local.R.NamingPolicy = foreign
 				if foreign.R == nil {
-					foreign.R = &groupOrganizationR{}
+					foreign.R = &namingPolicyR{}
 				}
 				foreign.R.Organization = local
 				break
@@ -689,112 +811,955 @@ func (organizationL) LoadGroupOrganizations(ctx context.Context, e boil.ContextE
 	return nil
 }
 
-// AddSubjectOrganizationAuditEvents adds the given related objects to the existing relationships
-// of the organization, optionally inserting them as new records.
-// Appends related to o.R.SubjectOrganizationAuditEvents.
-// Sets related.R.SubjectOrganization appropriately.
-func (o *Organization) AddSubjectOrganizationAuditEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditEvent) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			queries.Assign(&rel.SubjectOrganizationID, o.ID)
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"audit_events\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"subject_organization_id"}),
-				strmangle.WhereClause("\"", "\"", 2, auditEventPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+// LoadTenantApplications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadTenantApplications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
 			}
-
-			queries.Assign(&rel.SubjectOrganizationID, o.ID)
 		}
 	}
 
-	if o.R == nil {
-		o.R = &organizationR{
-			SubjectOrganizationAuditEvents: related,
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
 		}
+		args[object.ID] = struct{}{}
 	} else {
-		o.R.SubjectOrganizationAuditEvents = append(o.R.SubjectOrganizationAuditEvents, related...)
-	}
-
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &auditEventR{
-				SubjectOrganization: o,
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
 			}
-		} else {
-			rel.R.SubjectOrganization = o
+			args[obj.ID] = struct{}{}
 		}
 	}
-	return nil
-}
 
-// SetSubjectOrganizationAuditEvents removes all previously related items of the
-// organization replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.SubjectOrganization's SubjectOrganizationAuditEvents accordingly.
-// Replaces o.R.SubjectOrganizationAuditEvents with related.
-// Sets related.R.SubjectOrganization's SubjectOrganizationAuditEvents accordingly.
-func (o *Organization) SetSubjectOrganizationAuditEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditEvent) error {
-	query := "update \"audit_events\" set \"subject_organization_id\" = null where \"subject_organization_id\" = $1"
-	values := []interface{}{o.ID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
+	if len(args) == 0 {
+		return nil
 	}
 
-	if o.R != nil {
-		for _, rel := range o.R.SubjectOrganizationAuditEvents {
-			queries.SetScanner(&rel.SubjectOrganizationID, nil)
-			if rel.R == nil {
-				continue
-			}
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
 
-			rel.R.SubjectOrganization = nil
-		}
-		o.R.SubjectOrganizationAuditEvents = nil
+	query := NewQuery(
+		qm.From(`applications`),
+		qm.WhereIn(`applications.tenant_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`applications.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	return o.AddSubjectOrganizationAuditEvents(ctx, exec, insert, related...)
-}
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load applications")
+	}
 
-// RemoveSubjectOrganizationAuditEvents relationships from objects passed in.
-// Removes related items from R.SubjectOrganizationAuditEvents (uses pointer comparison, removal does not keep order)
-// Sets related.R.SubjectOrganization.
-func (o *Organization) RemoveSubjectOrganizationAuditEvents(ctx context.Context, exec boil.ContextExecutor, related ...*AuditEvent) error {
-	if len(related) == 0 {
-		return nil
+	var resultSlice []*Application
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice applications")
 	}
 
-	var err error
-	for _, rel := range related {
-		queries.SetScanner(&rel.SubjectOrganizationID, nil)
-		if rel.R != nil {
-			rel.R.SubjectOrganization = nil
-		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("subject_organization_id")); err != nil {
-			return err
-		}
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on applications")
 	}
-	if o.R == nil {
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for applications")
+	}
+
+	if len(applicationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TenantApplications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &applicationR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TenantID) {
+				local.R.TenantApplications = append(local.R.TenantApplications, foreign)
+				if foreign.R == nil {
+					foreign.R = &applicationR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSubjectOrganizationAuditEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadSubjectOrganizationAuditEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`audit_events`),
+		qm.WhereIn(`audit_events.subject_organization_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load audit_events")
+	}
+
+	var resultSlice []*AuditEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice audit_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on audit_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_events")
+	}
+
+	if len(auditEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SubjectOrganizationAuditEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &auditEventR{}
+			}
+			foreign.R.SubjectOrganization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SubjectOrganizationID) {
+				local.R.SubjectOrganizationAuditEvents = append(local.R.SubjectOrganizationAuditEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &auditEventR{}
+				}
+				foreign.R.SubjectOrganization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGroupOrganizations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadGroupOrganizations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`group_organizations`),
+		qm.WhereIn(`group_organizations.organization_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load group_organizations")
+	}
+
+	var resultSlice []*GroupOrganization
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice group_organizations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on group_organizations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_organizations")
+	}
+
+	if len(groupOrganizationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GroupOrganizations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupOrganizationR{}
+			}
+			foreign.R.Organization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrganizationID {
+				local.R.GroupOrganizations = append(local.R.GroupOrganizations, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupOrganizationR{}
+				}
+				foreign.R.Organization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenantGroups allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadTenantGroups(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`groups`),
+		qm.WhereIn(`groups.tenant_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`groups.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load groups")
+	}
+
+	var resultSlice []*Group
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice groups")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on groups")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for groups")
+	}
+
+	if len(groupAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TenantGroups = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TenantID) {
+				local.R.TenantGroups = append(local.R.TenantGroups, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTenantUsers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadTenantUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.tenant_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load users")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice users")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TenantUsers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TenantID) {
+				local.R.TenantUsers = append(local.R.TenantUsers, foreign)
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetMembershipDurationPolicy of the organization to the related item.
+// Sets o.R.MembershipDurationPolicy to related.
+// Adds o to related.R.Organization.
+func (o *Organization) SetMembershipDurationPolicy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *MembershipDurationPolicy) error {
+	var err error
+
+	if insert {
+		queries.Assign(&related.OrganizationID, o.ID)
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"membership_duration_policies\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+			strmangle.WhereClause("\"", "\"", 2, membershipDurationPolicyPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		queries.Assign(&related.OrganizationID, o.ID)
+	}
+
+	if o.R == nil {
+		o.R = &organizationR{
+			MembershipDurationPolicy: related,
+		}
+	} else {
+		o.R.MembershipDurationPolicy = related
+	}
+
+	if related.R == nil {
+		related.R = &membershipDurationPolicyR{
+			Organization: o,
+		}
+	} else {
+		related.R.Organization = o
+	}
+	return nil
+}
+
+// RemoveMembershipDurationPolicy relationship.
+// Sets o.R.MembershipDurationPolicy to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Organization) RemoveMembershipDurationPolicy(ctx context.Context, exec boil.ContextExecutor, related *MembershipDurationPolicy) error {
+	var err error
+
+	queries.SetScanner(&related.OrganizationID, nil)
+	if _, err = related.Update(ctx, exec, boil.Whitelist("organization_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.MembershipDurationPolicy = nil
+	}
+
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.Organization = nil
+
+	return nil
+}
+
+// SetNamingPolicy of the organization to the related item.
+// Sets o.R.NamingPolicy to related.
+// Adds o to related.R.Organization.
+func (o *Organization) SetNamingPolicy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *NamingPolicy) error {
+	var err error
+
+	if insert {
+		queries.Assign(&related.OrganizationID, o.ID)
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"naming_policies\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+			strmangle.WhereClause("\"", "\"", 2, namingPolicyPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		queries.Assign(&related.OrganizationID, o.ID)
+	}
+
+	if o.R == nil {
+		o.R = &organizationR{
+			NamingPolicy: related,
+		}
+	} else {
+		o.R.NamingPolicy = related
+	}
+
+	if related.R == nil {
+		related.R = &namingPolicyR{
+			Organization: o,
+		}
+	} else {
+		related.R.Organization = o
+	}
+	return nil
+}
+
+// RemoveNamingPolicy relationship.
+// Sets o.R.NamingPolicy to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Organization) RemoveNamingPolicy(ctx context.Context, exec boil.ContextExecutor, related *NamingPolicy) error {
+	var err error
+
+	queries.SetScanner(&related.OrganizationID, nil)
+	if _, err = related.Update(ctx, exec, boil.Whitelist("organization_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.NamingPolicy = nil
+	}
+
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.Organization = nil
+
+	return nil
+}
+
+// AddTenantApplications adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.TenantApplications.
+// Sets related.R.Tenant appropriately.
+func (o *Organization) AddTenantApplications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Application) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TenantID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"applications\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, applicationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TenantID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &organizationR{
+			TenantApplications: related,
+		}
+	} else {
+		o.R.TenantApplications = append(o.R.TenantApplications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &applicationR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// SetTenantApplications removes all previously related items of the
+// organization replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Tenant's TenantApplications accordingly.
+// Replaces o.R.TenantApplications with related.
+// Sets related.R.Tenant's TenantApplications accordingly.
+func (o *Organization) SetTenantApplications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Application) error {
+	query := "update \"applications\" set \"tenant_id\" = null where \"tenant_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.TenantApplications {
+			queries.SetScanner(&rel.TenantID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Tenant = nil
+		}
+		o.R.TenantApplications = nil
+	}
+
+	return o.AddTenantApplications(ctx, exec, insert, related...)
+}
+
+// RemoveTenantApplications relationships from objects passed in.
+// Removes related items from R.TenantApplications (uses pointer comparison, removal does not keep order)
+// Sets related.R.Tenant.
+func (o *Organization) RemoveTenantApplications(ctx context.Context, exec boil.ContextExecutor, related ...*Application) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TenantID, nil)
+		if rel.R != nil {
+			rel.R.Tenant = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("tenant_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.TenantApplications {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.TenantApplications)
+			if ln > 1 && i < ln-1 {
+				o.R.TenantApplications[i] = o.R.TenantApplications[ln-1]
+			}
+			o.R.TenantApplications = o.R.TenantApplications[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSubjectOrganizationAuditEvents adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.SubjectOrganizationAuditEvents.
+// Sets related.R.SubjectOrganization appropriately.
+func (o *Organization) AddSubjectOrganizationAuditEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SubjectOrganizationID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"audit_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"subject_organization_id"}),
+				strmangle.WhereClause("\"", "\"", 2, auditEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SubjectOrganizationID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &organizationR{
+			SubjectOrganizationAuditEvents: related,
+		}
+	} else {
+		o.R.SubjectOrganizationAuditEvents = append(o.R.SubjectOrganizationAuditEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &auditEventR{
+				SubjectOrganization: o,
+			}
+		} else {
+			rel.R.SubjectOrganization = o
+		}
+	}
+	return nil
+}
+
+// SetSubjectOrganizationAuditEvents removes all previously related items of the
+// organization replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SubjectOrganization's SubjectOrganizationAuditEvents accordingly.
+// Replaces o.R.SubjectOrganizationAuditEvents with related.
+// Sets related.R.SubjectOrganization's SubjectOrganizationAuditEvents accordingly.
+func (o *Organization) SetSubjectOrganizationAuditEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditEvent) error {
+	query := "update \"audit_events\" set \"subject_organization_id\" = null where \"subject_organization_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SubjectOrganizationAuditEvents {
+			queries.SetScanner(&rel.SubjectOrganizationID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.SubjectOrganization = nil
+		}
+		o.R.SubjectOrganizationAuditEvents = nil
+	}
+
+	return o.AddSubjectOrganizationAuditEvents(ctx, exec, insert, related...)
+}
+
+// RemoveSubjectOrganizationAuditEvents relationships from objects passed in.
+// Removes related items from R.SubjectOrganizationAuditEvents (uses pointer comparison, removal does not keep order)
+// Sets related.R.SubjectOrganization.
+func (o *Organization) RemoveSubjectOrganizationAuditEvents(ctx context.Context, exec boil.ContextExecutor, related ...*AuditEvent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SubjectOrganizationID, nil)
+		if rel.R != nil {
+			rel.R.SubjectOrganization = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("subject_organization_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
 		return nil
 	}
 
@@ -869,6 +1834,260 @@ func (o *Organization) AddGroupOrganizations(ctx context.Context, exec boil.Cont
 	return nil
 }
 
+// AddTenantGroups adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.TenantGroups.
+// Sets related.R.Tenant appropriately.
+func (o *Organization) AddTenantGroups(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Group) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TenantID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"groups\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, groupPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TenantID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &organizationR{
+			TenantGroups: related,
+		}
+	} else {
+		o.R.TenantGroups = append(o.R.TenantGroups, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &groupR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// SetTenantGroups removes all previously related items of the
+// organization replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Tenant's TenantGroups accordingly.
+// Replaces o.R.TenantGroups with related.
+// Sets related.R.Tenant's TenantGroups accordingly.
+func (o *Organization) SetTenantGroups(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Group) error {
+	query := "update \"groups\" set \"tenant_id\" = null where \"tenant_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.TenantGroups {
+			queries.SetScanner(&rel.TenantID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Tenant = nil
+		}
+		o.R.TenantGroups = nil
+	}
+
+	return o.AddTenantGroups(ctx, exec, insert, related...)
+}
+
+// RemoveTenantGroups relationships from objects passed in.
+// Removes related items from R.TenantGroups (uses pointer comparison, removal does not keep order)
+// Sets related.R.Tenant.
+func (o *Organization) RemoveTenantGroups(ctx context.Context, exec boil.ContextExecutor, related ...*Group) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TenantID, nil)
+		if rel.R != nil {
+			rel.R.Tenant = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("tenant_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.TenantGroups {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.TenantGroups)
+			if ln > 1 && i < ln-1 {
+				o.R.TenantGroups[i] = o.R.TenantGroups[ln-1]
+			}
+			o.R.TenantGroups = o.R.TenantGroups[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddTenantUsers adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.TenantUsers.
+// Sets related.R.Tenant appropriately.
+func (o *Organization) AddTenantUsers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*User) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TenantID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"users\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TenantID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &organizationR{
+			TenantUsers: related,
+		}
+	} else {
+		o.R.TenantUsers = append(o.R.TenantUsers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// SetTenantUsers removes all previously related items of the
+// organization replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Tenant's TenantUsers accordingly.
+// Replaces o.R.TenantUsers with related.
+// Sets related.R.Tenant's TenantUsers accordingly.
+func (o *Organization) SetTenantUsers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*User) error {
+	query := "update \"users\" set \"tenant_id\" = null where \"tenant_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.TenantUsers {
+			queries.SetScanner(&rel.TenantID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Tenant = nil
+		}
+		o.R.TenantUsers = nil
+	}
+
+	return o.AddTenantUsers(ctx, exec, insert, related...)
+}
+
+// RemoveTenantUsers relationships from objects passed in.
+// Removes related items from R.TenantUsers (uses pointer comparison, removal does not keep order)
+// Sets related.R.Tenant.
+func (o *Organization) RemoveTenantUsers(ctx context.Context, exec boil.ContextExecutor, related ...*User) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TenantID, nil)
+		if rel.R != nil {
+			rel.R.Tenant = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("tenant_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.TenantUsers {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.TenantUsers)
+			if ln > 1 && i < ln-1 {
+				o.R.TenantUsers[i] = o.R.TenantUsers[ln-1]
+			}
+			o.R.TenantUsers = o.R.TenantUsers[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Organizations retrieves all the records using an executor.
 func Organizations(mods ...qm.QueryMod) organizationQuery {
 	mods = append(mods, qm.From("\"organizations\""), qmhelper.WhereIsNull("\"organizations\".\"deleted_at\""))
@@ -25,12 +25,14 @@ import (
 
 // SystemExtensionResource is an object representing the database table.
 type SystemExtensionResource struct {
-	ID                            string     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Resource                      types.JSON `boil:"resource" json:"resource" toml:"resource" yaml:"resource"`
-	CreatedAt                     time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt                     time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedAt                     null.Time  `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
-	ExtensionResourceDefinitionID string     `boil:"extension_resource_definition_id" json:"extension_resource_definition_id" toml:"extension_resource_definition_id" yaml:"extension_resource_definition_id"`
+	ID                            string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Resource                      types.JSON  `boil:"resource" json:"resource" toml:"resource" yaml:"resource"`
+	CreatedAt                     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt                     time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt                     null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ExtensionResourceDefinitionID string      `boil:"extension_resource_definition_id" json:"extension_resource_definition_id" toml:"extension_resource_definition_id" yaml:"extension_resource_definition_id"`
+	OwnerID                       null.String `boil:"owner_id" json:"owner_id,omitempty" toml:"owner_id" yaml:"owner_id,omitempty"`
+	Revision                      int64       `boil:"revision" json:"revision" toml:"revision" yaml:"revision"`
 
 	R *systemExtensionResourceR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L systemExtensionResourceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -43,6 +45,8 @@ var SystemExtensionResourceColumns = struct {
 	UpdatedAt                     string
 	DeletedAt                     string
 	ExtensionResourceDefinitionID string
+	OwnerID                       string
+	Revision                      string
 }{
 	ID:                            "id",
 	Resource:                      "resource",
@@ -50,6 +54,8 @@ var SystemExtensionResourceColumns = struct {
 	UpdatedAt:                     "updated_at",
 	DeletedAt:                     "deleted_at",
 	ExtensionResourceDefinitionID: "extension_resource_definition_id",
+	OwnerID:                       "owner_id",
+	Revision:                      "revision",
 }
 
 var SystemExtensionResourceTableColumns = struct {
@@ -59,6 +65,8 @@ var SystemExtensionResourceTableColumns = struct {
 	UpdatedAt                     string
 	DeletedAt                     string
 	ExtensionResourceDefinitionID string
+	OwnerID                       string
+	Revision                      string
 }{
 	ID:                            "system_extension_resources.id",
 	Resource:                      "system_extension_resources.resource",
@@ -66,6 +74,8 @@ var SystemExtensionResourceTableColumns = struct {
 	UpdatedAt:                     "system_extension_resources.updated_at",
 	DeletedAt:                     "system_extension_resources.deleted_at",
 	ExtensionResourceDefinitionID: "system_extension_resources.extension_resource_definition_id",
+	OwnerID:                       "system_extension_resources.owner_id",
+	Revision:                      "system_extension_resources.revision",
 }
 
 // Generated where
@@ -77,6 +87,8 @@ var SystemExtensionResourceWhere = struct {
 	UpdatedAt                     whereHelpertime_Time
 	DeletedAt                     whereHelpernull_Time
 	ExtensionResourceDefinitionID whereHelperstring
+	OwnerID                       whereHelpernull_String
+	Revision                      whereHelperint64
 }{
 	ID:                            whereHelperstring{field: "\"system_extension_resources\".\"id\""},
 	Resource:                      whereHelpertypes_JSON{field: "\"system_extension_resources\".\"resource\""},
@@ -84,18 +96,23 @@ var SystemExtensionResourceWhere = struct {
 	UpdatedAt:                     whereHelpertime_Time{field: "\"system_extension_resources\".\"updated_at\""},
 	DeletedAt:                     whereHelpernull_Time{field: "\"system_extension_resources\".\"deleted_at\""},
 	ExtensionResourceDefinitionID: whereHelperstring{field: "\"system_extension_resources\".\"extension_resource_definition_id\""},
+	OwnerID:                       whereHelpernull_String{field: "\"system_extension_resources\".\"owner_id\""},
+	Revision:                      whereHelperint64{field: "\"system_extension_resources\".\"revision\""},
 }
 
 // SystemExtensionResourceRels is where relationship names are stored.
 var SystemExtensionResourceRels = struct {
 	ExtensionResourceDefinition string
+	Owner                       string
 }{
 	ExtensionResourceDefinition: "ExtensionResourceDefinition",
+	Owner:                       "Owner",
 }
 
 // systemExtensionResourceR is where relationships are stored.
 type systemExtensionResourceR struct {
 	ExtensionResourceDefinition *ExtensionResourceDefinition `boil:"ExtensionResourceDefinition" json:"ExtensionResourceDefinition" toml:"ExtensionResourceDefinition" yaml:"ExtensionResourceDefinition"`
+	Owner                       *Group                       `boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
 }
 
 // NewStruct creates a new relationship struct
@@ -110,13 +127,20 @@ func (r *systemExtensionResourceR) GetExtensionResourceDefinition() *ExtensionRe
 	return r.ExtensionResourceDefinition
 }
 
+func (r *systemExtensionResourceR) GetOwner() *Group {
+	if r == nil {
+		return nil
+	}
+	return r.Owner
+}
+
 // systemExtensionResourceL is where Load methods for each relationship are stored.
 type systemExtensionResourceL struct{}
 
 var (
-	systemExtensionResourceAllColumns            = []string{"id", "resource", "created_at", "updated_at", "deleted_at", "extension_resource_definition_id"}
+	systemExtensionResourceAllColumns            = []string{"id", "resource", "created_at", "updated_at", "deleted_at", "extension_resource_definition_id", "owner_id", "revision"}
 	systemExtensionResourceColumnsWithoutDefault = []string{"resource", "extension_resource_definition_id"}
-	systemExtensionResourceColumnsWithDefault    = []string{"id", "created_at", "updated_at", "deleted_at"}
+	systemExtensionResourceColumnsWithDefault    = []string{"id", "created_at", "updated_at", "deleted_at", "owner_id", "revision"}
 	systemExtensionResourcePrimaryKeyColumns     = []string{"id"}
 	systemExtensionResourceGeneratedColumns      = []string{}
 )
@@ -437,6 +461,17 @@ func (o *SystemExtensionResource) ExtensionResourceDefinition(mods ...qm.QueryMo
 	return ExtensionResourceDefinitions(queryMods...)
 }
 
+// Owner pointed to by the foreign key.
+func (o *SystemExtensionResource) Owner(mods ...qm.QueryMod) groupQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OwnerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Groups(queryMods...)
+}
+
 // LoadExtensionResourceDefinition allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (systemExtensionResourceL) LoadExtensionResourceDefinition(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSystemExtensionResource interface{}, mods queries.Applicator) error {
@@ -558,6 +593,131 @@ func (systemExtensionResourceL) LoadExtensionResourceDefinition(ctx context.Cont
 	return nil
 }
 
+// LoadOwner allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (systemExtensionResourceL) LoadOwner(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSystemExtensionResource interface{}, mods queries.Applicator) error {
+	var slice []*SystemExtensionResource
+	var object *SystemExtensionResource
+
+	if singular {
+		var ok bool
+		object, ok = maybeSystemExtensionResource.(*SystemExtensionResource)
+		if !ok {
+			object = new(SystemExtensionResource)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSystemExtensionResource)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSystemExtensionResource))
+			}
+		}
+	} else {
+		s, ok := maybeSystemExtensionResource.(*[]*SystemExtensionResource)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSystemExtensionResource)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSystemExtensionResource))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &systemExtensionResourceR{}
+		}
+		if !queries.IsNil(object.OwnerID) {
+			args[object.OwnerID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &systemExtensionResourceR{}
+			}
+
+			if !queries.IsNil(obj.OwnerID) {
+				args[obj.OwnerID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`groups`),
+		qm.WhereIn(`groups.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`groups.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Group")
+	}
+
+	var resultSlice []*Group
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Group")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for groups")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for groups")
+	}
+
+	if len(groupAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Owner = foreign
+		if foreign.R == nil {
+			foreign.R = &groupR{}
+		}
+		foreign.R.OwnerSystemExtensionResources = append(foreign.R.OwnerSystemExtensionResources, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.OwnerID, foreign.ID) {
+				local.R.Owner = foreign
+				if foreign.R == nil {
+					foreign.R = &groupR{}
+				}
+				foreign.R.OwnerSystemExtensionResources = append(foreign.R.OwnerSystemExtensionResources, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetExtensionResourceDefinition of the systemExtensionResource to the related item.
 // Sets o.R.ExtensionResourceDefinition to related.
 // Adds o to related.R.SystemExtensionResources.
@@ -605,6 +765,86 @@ func (o *SystemExtensionResource) SetExtensionResourceDefinition(ctx context.Con
 	return nil
 }
 
+// SetOwner of the systemExtensionResource to the related item.
+// Sets o.R.Owner to related.
+// Adds o to related.R.OwnerSystemExtensionResources.
+func (o *SystemExtensionResource) SetOwner(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Group) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"system_extension_resources\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+		strmangle.WhereClause("\"", "\"", 2, systemExtensionResourcePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.OwnerID, related.ID)
+	if o.R == nil {
+		o.R = &systemExtensionResourceR{
+			Owner: related,
+		}
+	} else {
+		o.R.Owner = related
+	}
+
+	if related.R == nil {
+		related.R = &groupR{
+			OwnerSystemExtensionResources: SystemExtensionResourceSlice{o},
+		}
+	} else {
+		related.R.OwnerSystemExtensionResources = append(related.R.OwnerSystemExtensionResources, o)
+	}
+
+	return nil
+}
+
+// RemoveOwner relationship.
+// Sets o.R.Owner to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *SystemExtensionResource) RemoveOwner(ctx context.Context, exec boil.ContextExecutor, related *Group) error {
+	var err error
+
+	queries.SetScanner(&o.OwnerID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("owner_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Owner = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.OwnerSystemExtensionResources {
+		if queries.Equal(o.OwnerID, ri.OwnerID) {
+			continue
+		}
+
+		ln := len(related.R.OwnerSystemExtensionResources)
+		if ln > 1 && i < ln-1 {
+			related.R.OwnerSystemExtensionResources[i] = related.R.OwnerSystemExtensionResources[ln-1]
+		}
+		related.R.OwnerSystemExtensionResources = related.R.OwnerSystemExtensionResources[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SystemExtensionResources retrieves all the records using an executor.
 func SystemExtensionResources(mods ...qm.QueryMod) systemExtensionResourceQuery {
 	mods = append(mods, qm.From("\"system_extension_resources\""), qmhelper.WhereIsNull("\"system_extension_resources\".\"deleted_at\""))
@@ -32,6 +32,7 @@ type UserExtensionResource struct {
 	DeletedAt                     null.Time  `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
 	UserID                        string     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
 	ExtensionResourceDefinitionID string     `boil:"extension_resource_definition_id" json:"extension_resource_definition_id" toml:"extension_resource_definition_id" yaml:"extension_resource_definition_id"`
+	Revision                      int64      `boil:"revision" json:"revision" toml:"revision" yaml:"revision"`
 
 	R *userExtensionResourceR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userExtensionResourceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -45,6 +46,7 @@ var UserExtensionResourceColumns = struct {
 	DeletedAt                     string
 	UserID                        string
 	ExtensionResourceDefinitionID string
+	Revision                      string
 }{
 	ID:                            "id",
 	Resource:                      "resource",
@@ -53,6 +55,7 @@ var UserExtensionResourceColumns = struct {
 	DeletedAt:                     "deleted_at",
 	UserID:                        "user_id",
 	ExtensionResourceDefinitionID: "extension_resource_definition_id",
+	Revision:                      "revision",
 }
 
 var UserExtensionResourceTableColumns = struct {
@@ -63,6 +66,7 @@ var UserExtensionResourceTableColumns = struct {
 	DeletedAt                     string
 	UserID                        string
 	ExtensionResourceDefinitionID string
+	Revision                      string
 }{
 	ID:                            "user_extension_resources.id",
 	Resource:                      "user_extension_resources.resource",
@@ -71,6 +75,7 @@ var UserExtensionResourceTableColumns = struct {
 	DeletedAt:                     "user_extension_resources.deleted_at",
 	UserID:                        "user_extension_resources.user_id",
 	ExtensionResourceDefinitionID: "user_extension_resources.extension_resource_definition_id",
+	Revision:                      "user_extension_resources.revision",
 }
 
 // Generated where
@@ -83,6 +88,7 @@ var UserExtensionResourceWhere = struct {
 	DeletedAt                     whereHelpernull_Time
 	UserID                        whereHelperstring
 	ExtensionResourceDefinitionID whereHelperstring
+	Revision                      whereHelperint64
 }{
 	ID:                            whereHelperstring{field: "\"user_extension_resources\".\"id\""},
 	Resource:                      whereHelpertypes_JSON{field: "\"user_extension_resources\".\"resource\""},
@@ -91,6 +97,7 @@ var UserExtensionResourceWhere = struct {
 	DeletedAt:                     whereHelpernull_Time{field: "\"user_extension_resources\".\"deleted_at\""},
 	UserID:                        whereHelperstring{field: "\"user_extension_resources\".\"user_id\""},
 	ExtensionResourceDefinitionID: whereHelperstring{field: "\"user_extension_resources\".\"extension_resource_definition_id\""},
+	Revision:                      whereHelperint64{field: "\"user_extension_resources\".\"revision\""},
 }
 
 // UserExtensionResourceRels is where relationship names are stored.
@@ -131,9 +138,9 @@ func (r *userExtensionResourceR) GetExtensionResourceDefinition() *ExtensionReso
 type userExtensionResourceL struct{}
 
 var (
-	userExtensionResourceAllColumns            = []string{"id", "resource", "created_at", "updated_at", "deleted_at", "user_id", "extension_resource_definition_id"}
+	userExtensionResourceAllColumns            = []string{"id", "resource", "created_at", "updated_at", "deleted_at", "user_id", "extension_resource_definition_id", "revision"}
 	userExtensionResourceColumnsWithoutDefault = []string{"resource", "user_id", "extension_resource_definition_id"}
-	userExtensionResourceColumnsWithDefault    = []string{"id", "created_at", "updated_at", "deleted_at"}
+	userExtensionResourceColumnsWithDefault    = []string{"id", "created_at", "updated_at", "deleted_at", "revision"}
 	userExtensionResourcePrimaryKeyColumns     = []string{"id"}
 	userExtensionResourceGeneratedColumns      = []string{}
 )
@@ -37,6 +37,8 @@ type User struct {
 	GithubUsername null.String `boil:"github_username" json:"github_username,omitempty" toml:"github_username" yaml:"github_username,omitempty"`
 	DeletedAt      null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
 	Status         null.String `boil:"status" json:"status,omitempty" toml:"status" yaml:"status,omitempty"`
+	TenantID       null.String `boil:"tenant_id" json:"tenant_id,omitempty" toml:"tenant_id" yaml:"tenant_id,omitempty"`
+	AnonymizedAt   null.Time   `boil:"anonymized_at" json:"anonymized_at,omitempty" toml:"anonymized_at" yaml:"anonymized_at,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -56,6 +58,8 @@ var UserColumns = struct {
 	GithubUsername string
 	DeletedAt      string
 	Status         string
+	TenantID       string
+	AnonymizedAt   string
 }{
 	ID:             "id",
 	ExternalID:     "external_id",
@@ -70,6 +74,8 @@ var UserColumns = struct {
 	GithubUsername: "github_username",
 	DeletedAt:      "deleted_at",
 	Status:         "status",
+	TenantID:       "tenant_id",
+	AnonymizedAt:   "anonymized_at",
 }
 
 var UserTableColumns = struct {
@@ -86,6 +92,8 @@ var UserTableColumns = struct {
 	GithubUsername string
 	DeletedAt      string
 	Status         string
+	TenantID       string
+	AnonymizedAt   string
 }{
 	ID:             "users.id",
 	ExternalID:     "users.external_id",
@@ -100,33 +108,12 @@ var UserTableColumns = struct {
 	GithubUsername: "users.github_username",
 	DeletedAt:      "users.deleted_at",
 	Status:         "users.status",
+	TenantID:       "users.tenant_id",
+	AnonymizedAt:   "users.anonymized_at",
 }
 
 // Generated where
 
-type whereHelperint64 struct{ field string }
-
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpernull_Int64 struct{ field string }
 
 func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
@@ -179,6 +166,8 @@ var UserWhere = struct {
 	GithubUsername whereHelpernull_String
 	DeletedAt      whereHelpernull_Time
 	Status         whereHelpernull_String
+	TenantID       whereHelpernull_String
+	AnonymizedAt   whereHelpernull_Time
 }{
 	ID:             whereHelperstring{field: "\"users\".\"id\""},
 	ExternalID:     whereHelpernull_String{field: "\"users\".\"external_id\""},
@@ -193,36 +182,77 @@ var UserWhere = struct {
 	GithubUsername: whereHelpernull_String{field: "\"users\".\"github_username\""},
 	DeletedAt:      whereHelpernull_Time{field: "\"users\".\"deleted_at\""},
 	Status:         whereHelpernull_String{field: "\"users\".\"status\""},
+	TenantID:       whereHelpernull_String{field: "\"users\".\"tenant_id\""},
+	AnonymizedAt:   whereHelpernull_Time{field: "\"users\".\"anonymized_at\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
+	Tenant                                string
+	ArchivedRequests                      string
+	RequesterUserArchivedRequests         string
+	DecidedByUserArchivedRequests         string
 	SubjectUserAuditEvents                string
 	ActorAuditEvents                      string
 	RequesterUserGroupApplicationRequests string
 	GroupMembershipRequests               string
 	GroupMemberships                      string
+	CreatedByJobs                         string
+	NotificationDispatches                string
+	NotificationFailoverPolicies          string
+	NotificationGroupPreferences          string
 	NotificationPreferences               string
+	NotificationTargetRankings            string
+	RequestComments                       string
+	SessionTokens                         string
+	UserEmailChanges                      string
+	UserExtensionResourceVersions         string
 	UserExtensionResources                string
 }{
+	Tenant:                                "Tenant",
+	ArchivedRequests:                      "ArchivedRequests",
+	RequesterUserArchivedRequests:         "RequesterUserArchivedRequests",
+	DecidedByUserArchivedRequests:         "DecidedByUserArchivedRequests",
 	SubjectUserAuditEvents:                "SubjectUserAuditEvents",
 	ActorAuditEvents:                      "ActorAuditEvents",
 	RequesterUserGroupApplicationRequests: "RequesterUserGroupApplicationRequests",
 	GroupMembershipRequests:               "GroupMembershipRequests",
 	GroupMemberships:                      "GroupMemberships",
+	CreatedByJobs:                         "CreatedByJobs",
+	NotificationDispatches:                "NotificationDispatches",
+	NotificationFailoverPolicies:          "NotificationFailoverPolicies",
+	NotificationGroupPreferences:          "NotificationGroupPreferences",
 	NotificationPreferences:               "NotificationPreferences",
+	NotificationTargetRankings:            "NotificationTargetRankings",
+	RequestComments:                       "RequestComments",
+	SessionTokens:                         "SessionTokens",
+	UserEmailChanges:                      "UserEmailChanges",
+	UserExtensionResourceVersions:         "UserExtensionResourceVersions",
 	UserExtensionResources:                "UserExtensionResources",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	SubjectUserAuditEvents                AuditEventSlice              `boil:"SubjectUserAuditEvents" json:"SubjectUserAuditEvents" toml:"SubjectUserAuditEvents" yaml:"SubjectUserAuditEvents"`
-	ActorAuditEvents                      AuditEventSlice              `boil:"ActorAuditEvents" json:"ActorAuditEvents" toml:"ActorAuditEvents" yaml:"ActorAuditEvents"`
-	RequesterUserGroupApplicationRequests GroupApplicationRequestSlice `boil:"RequesterUserGroupApplicationRequests" json:"RequesterUserGroupApplicationRequests" toml:"RequesterUserGroupApplicationRequests" yaml:"RequesterUserGroupApplicationRequests"`
-	GroupMembershipRequests               GroupMembershipRequestSlice  `boil:"GroupMembershipRequests" json:"GroupMembershipRequests" toml:"GroupMembershipRequests" yaml:"GroupMembershipRequests"`
-	GroupMemberships                      GroupMembershipSlice         `boil:"GroupMemberships" json:"GroupMemberships" toml:"GroupMemberships" yaml:"GroupMemberships"`
-	NotificationPreferences               NotificationPreferenceSlice  `boil:"NotificationPreferences" json:"NotificationPreferences" toml:"NotificationPreferences" yaml:"NotificationPreferences"`
-	UserExtensionResources                UserExtensionResourceSlice   `boil:"UserExtensionResources" json:"UserExtensionResources" toml:"UserExtensionResources" yaml:"UserExtensionResources"`
+	Tenant                                *Organization                     `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	ArchivedRequests                      ArchivedRequestSlice              `boil:"ArchivedRequests" json:"ArchivedRequests" toml:"ArchivedRequests" yaml:"ArchivedRequests"`
+	RequesterUserArchivedRequests         ArchivedRequestSlice              `boil:"RequesterUserArchivedRequests" json:"RequesterUserArchivedRequests" toml:"RequesterUserArchivedRequests" yaml:"RequesterUserArchivedRequests"`
+	DecidedByUserArchivedRequests         ArchivedRequestSlice              `boil:"DecidedByUserArchivedRequests" json:"DecidedByUserArchivedRequests" toml:"DecidedByUserArchivedRequests" yaml:"DecidedByUserArchivedRequests"`
+	SubjectUserAuditEvents                AuditEventSlice                   `boil:"SubjectUserAuditEvents" json:"SubjectUserAuditEvents" toml:"SubjectUserAuditEvents" yaml:"SubjectUserAuditEvents"`
+	ActorAuditEvents                      AuditEventSlice                   `boil:"ActorAuditEvents" json:"ActorAuditEvents" toml:"ActorAuditEvents" yaml:"ActorAuditEvents"`
+	RequesterUserGroupApplicationRequests GroupApplicationRequestSlice      `boil:"RequesterUserGroupApplicationRequests" json:"RequesterUserGroupApplicationRequests" toml:"RequesterUserGroupApplicationRequests" yaml:"RequesterUserGroupApplicationRequests"`
+	GroupMembershipRequests               GroupMembershipRequestSlice       `boil:"GroupMembershipRequests" json:"GroupMembershipRequests" toml:"GroupMembershipRequests" yaml:"GroupMembershipRequests"`
+	GroupMemberships                      GroupMembershipSlice              `boil:"GroupMemberships" json:"GroupMemberships" toml:"GroupMemberships" yaml:"GroupMemberships"`
+	CreatedByJobs                         JobSlice                          `boil:"CreatedByJobs" json:"CreatedByJobs" toml:"CreatedByJobs" yaml:"CreatedByJobs"`
+	NotificationDispatches                NotificationDispatchSlice         `boil:"NotificationDispatches" json:"NotificationDispatches" toml:"NotificationDispatches" yaml:"NotificationDispatches"`
+	NotificationFailoverPolicies          NotificationFailoverPolicySlice   `boil:"NotificationFailoverPolicies" json:"NotificationFailoverPolicies" toml:"NotificationFailoverPolicies" yaml:"NotificationFailoverPolicies"`
+	NotificationGroupPreferences          NotificationGroupPreferenceSlice  `boil:"NotificationGroupPreferences" json:"NotificationGroupPreferences" toml:"NotificationGroupPreferences" yaml:"NotificationGroupPreferences"`
+	NotificationPreferences               NotificationPreferenceSlice       `boil:"NotificationPreferences" json:"NotificationPreferences" toml:"NotificationPreferences" yaml:"NotificationPreferences"`
+	NotificationTargetRankings            NotificationTargetRankingSlice    `boil:"NotificationTargetRankings" json:"NotificationTargetRankings" toml:"NotificationTargetRankings" yaml:"NotificationTargetRankings"`
+	RequestComments                       RequestCommentSlice               `boil:"RequestComments" json:"RequestComments" toml:"RequestComments" yaml:"RequestComments"`
+	SessionTokens                         SessionTokenSlice                 `boil:"SessionTokens" json:"SessionTokens" toml:"SessionTokens" yaml:"SessionTokens"`
+	UserEmailChanges                      UserEmailChangeSlice              `boil:"UserEmailChanges" json:"UserEmailChanges" toml:"UserEmailChanges" yaml:"UserEmailChanges"`
+	UserExtensionResourceVersions         UserExtensionResourceVersionSlice `boil:"UserExtensionResourceVersions" json:"UserExtensionResourceVersions" toml:"UserExtensionResourceVersions" yaml:"UserExtensionResourceVersions"`
+	UserExtensionResources                UserExtensionResourceSlice        `boil:"UserExtensionResources" json:"UserExtensionResources" toml:"UserExtensionResources" yaml:"UserExtensionResources"`
 }
 
 // NewStruct creates a new relationship struct
@@ -230,6 +260,34 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (r *userR) GetTenant() *Organization {
+	if r == nil {
+		return nil
+	}
+	return r.Tenant
+}
+
+func (r *userR) GetArchivedRequests() ArchivedRequestSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ArchivedRequests
+}
+
+func (r *userR) GetRequesterUserArchivedRequests() ArchivedRequestSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RequesterUserArchivedRequests
+}
+
+func (r *userR) GetDecidedByUserArchivedRequests() ArchivedRequestSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DecidedByUserArchivedRequests
+}
+
 func (r *userR) GetSubjectUserAuditEvents() AuditEventSlice {
 	if r == nil {
 		return nil
@@ -265,6 +323,34 @@ func (r *userR) GetGroupMemberships() GroupMembershipSlice {
 	return r.GroupMemberships
 }
 
+func (r *userR) GetCreatedByJobs() JobSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CreatedByJobs
+}
+
+func (r *userR) GetNotificationDispatches() NotificationDispatchSlice {
+	if r == nil {
+		return nil
+	}
+	return r.NotificationDispatches
+}
+
+func (r *userR) GetNotificationFailoverPolicies() NotificationFailoverPolicySlice {
+	if r == nil {
+		return nil
+	}
+	return r.NotificationFailoverPolicies
+}
+
+func (r *userR) GetNotificationGroupPreferences() NotificationGroupPreferenceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.NotificationGroupPreferences
+}
+
 func (r *userR) GetNotificationPreferences() NotificationPreferenceSlice {
 	if r == nil {
 		return nil
@@ -272,6 +358,41 @@ func (r *userR) GetNotificationPreferences() NotificationPreferenceSlice {
 	return r.NotificationPreferences
 }
 
+func (r *userR) GetNotificationTargetRankings() NotificationTargetRankingSlice {
+	if r == nil {
+		return nil
+	}
+	return r.NotificationTargetRankings
+}
+
+func (r *userR) GetRequestComments() RequestCommentSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RequestComments
+}
+
+func (r *userR) GetSessionTokens() SessionTokenSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SessionTokens
+}
+
+func (r *userR) GetUserEmailChanges() UserEmailChangeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserEmailChanges
+}
+
+func (r *userR) GetUserExtensionResourceVersions() UserExtensionResourceVersionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserExtensionResourceVersions
+}
+
 func (r *userR) GetUserExtensionResources() UserExtensionResourceSlice {
 	if r == nil {
 		return nil
@@ -283,9 +404,9 @@ func (r *userR) GetUserExtensionResources() UserExtensionResourceSlice {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "external_id", "name", "email", "login_count", "avatar_url", "last_login_at", "created_at", "updated_at", "github_id", "github_username", "deleted_at", "status"}
+	userAllColumns            = []string{"id", "external_id", "name", "email", "login_count", "avatar_url", "last_login_at", "created_at", "updated_at", "github_id", "github_username", "deleted_at", "status", "tenant_id", "anonymized_at"}
 	userColumnsWithoutDefault = []string{"name", "email", "created_at", "updated_at"}
-	userColumnsWithDefault    = []string{"id", "external_id", "login_count", "avatar_url", "last_login_at", "github_id", "github_username", "deleted_at", "status"}
+	userColumnsWithDefault    = []string{"id", "external_id", "login_count", "avatar_url", "last_login_at", "github_id", "github_username", "deleted_at", "status", "tenant_id", "anonymized_at"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -595,6 +716,59 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// Tenant pointed to by the foreign key.
+func (o *User) Tenant(mods ...qm.QueryMod) organizationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TenantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Organizations(queryMods...)
+}
+
+// ArchivedRequests retrieves all the archived_request's ArchivedRequests with an executor.
+func (o *User) ArchivedRequests(mods ...qm.QueryMod) archivedRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"archived_requests\".\"user_id\"=?", o.ID),
+	)
+
+	return ArchivedRequests(queryMods...)
+}
+
+// RequesterUserArchivedRequests retrieves all the archived_request's ArchivedRequests with an executor via requester_user_id column.
+func (o *User) RequesterUserArchivedRequests(mods ...qm.QueryMod) archivedRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"archived_requests\".\"requester_user_id\"=?", o.ID),
+	)
+
+	return ArchivedRequests(queryMods...)
+}
+
+// DecidedByUserArchivedRequests retrieves all the archived_request's ArchivedRequests with an executor via decided_by_user_id column.
+func (o *User) DecidedByUserArchivedRequests(mods ...qm.QueryMod) archivedRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"archived_requests\".\"decided_by_user_id\"=?", o.ID),
+	)
+
+	return ArchivedRequests(queryMods...)
+}
+
 // SubjectUserAuditEvents retrieves all the audit_event's AuditEvents with an executor via subject_user_id column.
 func (o *User) SubjectUserAuditEvents(mods ...qm.QueryMod) auditEventQuery {
 	var queryMods []qm.QueryMod
@@ -665,6 +839,62 @@ func (o *User) GroupMemberships(mods ...qm.QueryMod) groupMembershipQuery {
 	return GroupMemberships(queryMods...)
 }
 
+// CreatedByJobs retrieves all the job's Jobs with an executor via created_by column.
+func (o *User) CreatedByJobs(mods ...qm.QueryMod) jobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"jobs\".\"created_by\"=?", o.ID),
+	)
+
+	return Jobs(queryMods...)
+}
+
+// NotificationDispatches retrieves all the notification_dispatch's NotificationDispatches with an executor.
+func (o *User) NotificationDispatches(mods ...qm.QueryMod) notificationDispatchQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_dispatches\".\"user_id\"=?", o.ID),
+	)
+
+	return NotificationDispatches(queryMods...)
+}
+
+// NotificationFailoverPolicies retrieves all the notification_failover_policy's NotificationFailoverPolicies with an executor.
+func (o *User) NotificationFailoverPolicies(mods ...qm.QueryMod) notificationFailoverPolicyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_failover_policies\".\"user_id\"=?", o.ID),
+	)
+
+	return NotificationFailoverPolicies(queryMods...)
+}
+
+// NotificationGroupPreferences retrieves all the notification_group_preference's NotificationGroupPreferences with an executor.
+func (o *User) NotificationGroupPreferences(mods ...qm.QueryMod) notificationGroupPreferenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_group_preferences\".\"user_id\"=?", o.ID),
+	)
+
+	return NotificationGroupPreferences(queryMods...)
+}
+
 // NotificationPreferences retrieves all the notification_preference's NotificationPreferences with an executor.
 func (o *User) NotificationPreferences(mods ...qm.QueryMod) notificationPreferenceQuery {
 	var queryMods []qm.QueryMod
@@ -679,6 +909,76 @@ func (o *User) NotificationPreferences(mods ...qm.QueryMod) notificationPreferen
 	return NotificationPreferences(queryMods...)
 }
 
+// NotificationTargetRankings retrieves all the notification_target_ranking's NotificationTargetRankings with an executor.
+func (o *User) NotificationTargetRankings(mods ...qm.QueryMod) notificationTargetRankingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_target_rankings\".\"user_id\"=?", o.ID),
+	)
+
+	return NotificationTargetRankings(queryMods...)
+}
+
+// RequestComments retrieves all the request_comment's RequestComments with an executor.
+func (o *User) RequestComments(mods ...qm.QueryMod) requestCommentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"request_comments\".\"user_id\"=?", o.ID),
+	)
+
+	return RequestComments(queryMods...)
+}
+
+// SessionTokens retrieves all the session_token's SessionTokens with an executor.
+func (o *User) SessionTokens(mods ...qm.QueryMod) sessionTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"session_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return SessionTokens(queryMods...)
+}
+
+// UserEmailChanges retrieves all the user_email_change's UserEmailChanges with an executor.
+func (o *User) UserEmailChanges(mods ...qm.QueryMod) userEmailChangeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_email_changes\".\"user_id\"=?", o.ID),
+	)
+
+	return UserEmailChanges(queryMods...)
+}
+
+// UserExtensionResourceVersions retrieves all the user_extension_resource_version's UserExtensionResourceVersions with an executor.
+func (o *User) UserExtensionResourceVersions(mods ...qm.QueryMod) userExtensionResourceVersionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_extension_resource_versions\".\"user_id\"=?", o.ID),
+	)
+
+	return UserExtensionResourceVersions(queryMods...)
+}
+
 // UserExtensionResources retrieves all the user_extension_resource's UserExtensionResources with an executor.
 func (o *User) UserExtensionResources(mods ...qm.QueryMod) userExtensionResourceQuery {
 	var queryMods []qm.QueryMod
@@ -693,9 +993,9 @@ func (o *User) UserExtensionResources(mods ...qm.QueryMod) userExtensionResource
 	return UserExtensionResources(queryMods...)
 }
 
-// LoadSubjectUserAuditEvents allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadSubjectUserAuditEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// LoadTenant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userL) LoadTenant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -726,13 +1026,20 @@ func (userL) LoadSubjectUserAuditEvents(ctx context.Context, e boil.ContextExecu
 		if object.R == nil {
 			object.R = &userR{}
 		}
-		args[object.ID] = struct{}{}
+		if !queries.IsNil(object.TenantID) {
+			args[object.TenantID] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &userR{}
 			}
-			args[obj.ID] = struct{}{}
+
+			if !queries.IsNil(obj.TenantID) {
+				args[obj.TenantID] = struct{}{}
+			}
+
 		}
 	}
 
@@ -748,8 +1055,9 @@ func (userL) LoadSubjectUserAuditEvents(ctx context.Context, e boil.ContextExecu
 	}
 
 	query := NewQuery(
-		qm.From(`audit_events`),
-		qm.WhereIn(`audit_events.subject_user_id in ?`, argsSlice...),
+		qm.From(`organizations`),
+		qm.WhereIn(`organizations.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`organizations.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -757,47 +1065,51 @@ func (userL) LoadSubjectUserAuditEvents(ctx context.Context, e boil.ContextExecu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load audit_events")
+		return errors.Wrap(err, "failed to eager load Organization")
 	}
 
-	var resultSlice []*AuditEvent
+	var resultSlice []*Organization
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice audit_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice Organization")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on audit_events")
+		return errors.Wrap(err, "failed to close results of eager load for organizations")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
 	}
 
-	if len(auditEventAfterSelectHooks) != 0 {
+	if len(organizationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.SubjectUserAuditEvents = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &auditEventR{}
-			}
-			foreign.R.SubjectUser = object
+		foreign := resultSlice[0]
+		object.R.Tenant = foreign
+		if foreign.R == nil {
+			foreign.R = &organizationR{}
 		}
+		foreign.R.TenantUsers = append(foreign.R.TenantUsers, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.SubjectUserID) {
-				local.R.SubjectUserAuditEvents = append(local.R.SubjectUserAuditEvents, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TenantID, foreign.ID) {
+				local.R.Tenant = foreign
 				if foreign.R == nil {
-					foreign.R = &auditEventR{}
+					foreign.R = &organizationR{}
 				}
-				foreign.R.SubjectUser = local
+				foreign.R.TenantUsers = append(foreign.R.TenantUsers, local)
 				break
 			}
 		}
@@ -806,9 +1118,9 @@ func (userL) LoadSubjectUserAuditEvents(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
-// LoadActorAuditEvents allows an eager lookup of values, cached into the
+// LoadArchivedRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadActorAuditEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadArchivedRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -861,8 +1173,8 @@ func (userL) LoadActorAuditEvents(ctx context.Context, e boil.ContextExecutor, s
 	}
 
 	query := NewQuery(
-		qm.From(`audit_events`),
-		qm.WhereIn(`audit_events.actor_id in ?`, argsSlice...),
+		qm.From(`archived_requests`),
+		qm.WhereIn(`archived_requests.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -870,22 +1182,22 @@ func (userL) LoadActorAuditEvents(ctx context.Context, e boil.ContextExecutor, s
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load audit_events")
+		return errors.Wrap(err, "failed to eager load archived_requests")
 	}
 
-	var resultSlice []*AuditEvent
+	var resultSlice []*ArchivedRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice audit_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice archived_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on audit_events")
+		return errors.Wrap(err, "failed to close results in eager load on archived_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for archived_requests")
 	}
 
-	if len(auditEventAfterSelectHooks) != 0 {
+	if len(archivedRequestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -893,24 +1205,24 @@ func (userL) LoadActorAuditEvents(ctx context.Context, e boil.ContextExecutor, s
 		}
 	}
 	if singular {
-		object.R.ActorAuditEvents = resultSlice
+		object.R.ArchivedRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &auditEventR{}
+				foreign.R = &archivedRequestR{}
 			}
-			foreign.R.Actor = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.ActorID) {
-				local.R.ActorAuditEvents = append(local.R.ActorAuditEvents, foreign)
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.ArchivedRequests = append(local.R.ArchivedRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &auditEventR{}
+					foreign.R = &archivedRequestR{}
 				}
-				foreign.R.Actor = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -919,9 +1231,9 @@ func (userL) LoadActorAuditEvents(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
-// LoadRequesterUserGroupApplicationRequests allows an eager lookup of values, cached into the
+// LoadRequesterUserArchivedRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadRequesterUserGroupApplicationRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadRequesterUserArchivedRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -974,8 +1286,8 @@ func (userL) LoadRequesterUserGroupApplicationRequests(ctx context.Context, e bo
 	}
 
 	query := NewQuery(
-		qm.From(`group_application_requests`),
-		qm.WhereIn(`group_application_requests.requester_user_id in ?`, argsSlice...),
+		qm.From(`archived_requests`),
+		qm.WhereIn(`archived_requests.requester_user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -983,22 +1295,22 @@ func (userL) LoadRequesterUserGroupApplicationRequests(ctx context.Context, e bo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load group_application_requests")
+		return errors.Wrap(err, "failed to eager load archived_requests")
 	}
 
-	var resultSlice []*GroupApplicationRequest
+	var resultSlice []*ArchivedRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice group_application_requests")
+		return errors.Wrap(err, "failed to bind eager loaded slice archived_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on group_application_requests")
+		return errors.Wrap(err, "failed to close results in eager load on archived_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_application_requests")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for archived_requests")
 	}
 
-	if len(groupApplicationRequestAfterSelectHooks) != 0 {
+	if len(archivedRequestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1006,10 +1318,10 @@ func (userL) LoadRequesterUserGroupApplicationRequests(ctx context.Context, e bo
 		}
 	}
 	if singular {
-		object.R.RequesterUserGroupApplicationRequests = resultSlice
+		object.R.RequesterUserArchivedRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupApplicationRequestR{}
+				foreign.R = &archivedRequestR{}
 			}
 			foreign.R.RequesterUser = object
 		}
@@ -1019,9 +1331,9 @@ func (userL) LoadRequesterUserGroupApplicationRequests(ctx context.Context, e bo
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.RequesterUserID {
-				local.R.RequesterUserGroupApplicationRequests = append(local.R.RequesterUserGroupApplicationRequests, foreign)
+				local.R.RequesterUserArchivedRequests = append(local.R.RequesterUserArchivedRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupApplicationRequestR{}
+					foreign.R = &archivedRequestR{}
 				}
 				foreign.R.RequesterUser = local
 				break
@@ -1032,9 +1344,9 @@ func (userL) LoadRequesterUserGroupApplicationRequests(ctx context.Context, e bo
 	return nil
 }
 
-// LoadGroupMembershipRequests allows an eager lookup of values, cached into the
+// LoadDecidedByUserArchivedRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadGroupMembershipRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadDecidedByUserArchivedRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1087,8 +1399,8 @@ func (userL) LoadGroupMembershipRequests(ctx context.Context, e boil.ContextExec
 	}
 
 	query := NewQuery(
-		qm.From(`group_membership_requests`),
-		qm.WhereIn(`group_membership_requests.user_id in ?`, argsSlice...),
+		qm.From(`archived_requests`),
+		qm.WhereIn(`archived_requests.decided_by_user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1096,22 +1408,22 @@ func (userL) LoadGroupMembershipRequests(ctx context.Context, e boil.ContextExec
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load group_membership_requests")
+		return errors.Wrap(err, "failed to eager load archived_requests")
 	}
 
-	var resultSlice []*GroupMembershipRequest
+	var resultSlice []*ArchivedRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice group_membership_requests")
+		return errors.Wrap(err, "failed to bind eager loaded slice archived_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on group_membership_requests")
+		return errors.Wrap(err, "failed to close results in eager load on archived_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_membership_requests")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for archived_requests")
 	}
 
-	if len(groupMembershipRequestAfterSelectHooks) != 0 {
+	if len(archivedRequestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1119,24 +1431,24 @@ func (userL) LoadGroupMembershipRequests(ctx context.Context, e boil.ContextExec
 		}
 	}
 	if singular {
-		object.R.GroupMembershipRequests = resultSlice
+		object.R.DecidedByUserArchivedRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupMembershipRequestR{}
+				foreign.R = &archivedRequestR{}
 			}
-			foreign.R.User = object
+			foreign.R.DecidedByUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.GroupMembershipRequests = append(local.R.GroupMembershipRequests, foreign)
+			if queries.Equal(local.ID, foreign.DecidedByUserID) {
+				local.R.DecidedByUserArchivedRequests = append(local.R.DecidedByUserArchivedRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupMembershipRequestR{}
+					foreign.R = &archivedRequestR{}
 				}
-				foreign.R.User = local
+				foreign.R.DecidedByUser = local
 				break
 			}
 		}
@@ -1145,9 +1457,9 @@ func (userL) LoadGroupMembershipRequests(ctx context.Context, e boil.ContextExec
 	return nil
 }
 
-// LoadGroupMemberships allows an eager lookup of values, cached into the
+// LoadSubjectUserAuditEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadGroupMemberships(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadSubjectUserAuditEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1200,8 +1512,8 @@ func (userL) LoadGroupMemberships(ctx context.Context, e boil.ContextExecutor, s
 	}
 
 	query := NewQuery(
-		qm.From(`group_memberships`),
-		qm.WhereIn(`group_memberships.user_id in ?`, argsSlice...),
+		qm.From(`audit_events`),
+		qm.WhereIn(`audit_events.subject_user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1209,22 +1521,22 @@ func (userL) LoadGroupMemberships(ctx context.Context, e boil.ContextExecutor, s
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load group_memberships")
+		return errors.Wrap(err, "failed to eager load audit_events")
 	}
 
-	var resultSlice []*GroupMembership
+	var resultSlice []*AuditEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice group_memberships")
+		return errors.Wrap(err, "failed to bind eager loaded slice audit_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on group_memberships")
+		return errors.Wrap(err, "failed to close results in eager load on audit_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_memberships")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_events")
 	}
 
-	if len(groupMembershipAfterSelectHooks) != 0 {
+	if len(auditEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1232,24 +1544,24 @@ func (userL) LoadGroupMemberships(ctx context.Context, e boil.ContextExecutor, s
 		}
 	}
 	if singular {
-		object.R.GroupMemberships = resultSlice
+		object.R.SubjectUserAuditEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &groupMembershipR{}
+				foreign.R = &auditEventR{}
 			}
-			foreign.R.User = object
+			foreign.R.SubjectUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.GroupMemberships = append(local.R.GroupMemberships, foreign)
+			if queries.Equal(local.ID, foreign.SubjectUserID) {
+				local.R.SubjectUserAuditEvents = append(local.R.SubjectUserAuditEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &groupMembershipR{}
+					foreign.R = &auditEventR{}
 				}
-				foreign.R.User = local
+				foreign.R.SubjectUser = local
 				break
 			}
 		}
@@ -1258,9 +1570,9 @@ func (userL) LoadGroupMemberships(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
-// LoadNotificationPreferences allows an eager lookup of values, cached into the
+// LoadActorAuditEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadNotificationPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadActorAuditEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1313,8 +1625,8 @@ func (userL) LoadNotificationPreferences(ctx context.Context, e boil.ContextExec
 	}
 
 	query := NewQuery(
-		qm.From(`notification_preferences`),
-		qm.WhereIn(`notification_preferences.user_id in ?`, argsSlice...),
+		qm.From(`audit_events`),
+		qm.WhereIn(`audit_events.actor_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1322,22 +1634,22 @@ func (userL) LoadNotificationPreferences(ctx context.Context, e boil.ContextExec
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load notification_preferences")
+		return errors.Wrap(err, "failed to eager load audit_events")
 	}
 
-	var resultSlice []*NotificationPreference
+	var resultSlice []*AuditEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice notification_preferences")
+		return errors.Wrap(err, "failed to bind eager loaded slice audit_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on notification_preferences")
+		return errors.Wrap(err, "failed to close results in eager load on audit_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_preferences")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_events")
 	}
 
-	if len(notificationPreferenceAfterSelectHooks) != 0 {
+	if len(auditEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1345,24 +1657,24 @@ func (userL) LoadNotificationPreferences(ctx context.Context, e boil.ContextExec
 		}
 	}
 	if singular {
-		object.R.NotificationPreferences = resultSlice
+		object.R.ActorAuditEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &notificationPreferenceR{}
+				foreign.R = &auditEventR{}
 			}
-			foreign.R.User = object
+			foreign.R.Actor = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.NotificationPreferences = append(local.R.NotificationPreferences, foreign)
+			if queries.Equal(local.ID, foreign.ActorID) {
+				local.R.ActorAuditEvents = append(local.R.ActorAuditEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &notificationPreferenceR{}
+					foreign.R = &auditEventR{}
 				}
-				foreign.R.User = local
+				foreign.R.Actor = local
 				break
 			}
 		}
@@ -1371,9 +1683,9 @@ func (userL) LoadNotificationPreferences(ctx context.Context, e boil.ContextExec
 	return nil
 }
 
-// LoadUserExtensionResources allows an eager lookup of values, cached into the
+// LoadRequesterUserGroupApplicationRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserExtensionResources(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadRequesterUserGroupApplicationRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1426,9 +1738,8 @@ func (userL) LoadUserExtensionResources(ctx context.Context, e boil.ContextExecu
 	}
 
 	query := NewQuery(
-		qm.From(`user_extension_resources`),
-		qm.WhereIn(`user_extension_resources.user_id in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`user_extension_resources.deleted_at`),
+		qm.From(`group_application_requests`),
+		qm.WhereIn(`group_application_requests.requester_user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1436,22 +1747,22 @@ func (userL) LoadUserExtensionResources(ctx context.Context, e boil.ContextExecu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_extension_resources")
+		return errors.Wrap(err, "failed to eager load group_application_requests")
 	}
 
-	var resultSlice []*UserExtensionResource
+	var resultSlice []*GroupApplicationRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_extension_resources")
+		return errors.Wrap(err, "failed to bind eager loaded slice group_application_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_extension_resources")
+		return errors.Wrap(err, "failed to close results in eager load on group_application_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_extension_resources")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_application_requests")
 	}
 
-	if len(userExtensionResourceAfterSelectHooks) != 0 {
+	if len(groupApplicationRequestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1459,24 +1770,24 @@ func (userL) LoadUserExtensionResources(ctx context.Context, e boil.ContextExecu
 		}
 	}
 	if singular {
-		object.R.UserExtensionResources = resultSlice
+		object.R.RequesterUserGroupApplicationRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userExtensionResourceR{}
+				foreign.R = &groupApplicationRequestR{}
 			}
-			foreign.R.User = object
+			foreign.R.RequesterUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.UserExtensionResources = append(local.R.UserExtensionResources, foreign)
+			if local.ID == foreign.RequesterUserID {
+				local.R.RequesterUserGroupApplicationRequests = append(local.R.RequesterUserGroupApplicationRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &userExtensionResourceR{}
+					foreign.R = &groupApplicationRequestR{}
 				}
-				foreign.R.User = local
+				foreign.R.RequesterUser = local
 				break
 			}
 		}
@@ -1485,150 +1796,2526 @@ func (userL) LoadUserExtensionResources(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
-// AddSubjectUserAuditEvents adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.SubjectUserAuditEvents.
-// Sets related.R.SubjectUser appropriately.
-func (o *User) AddSubjectUserAuditEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditEvent) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			queries.Assign(&rel.SubjectUserID, o.ID)
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"audit_events\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"subject_user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, auditEventPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+// LoadGroupMembershipRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadGroupMembershipRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
 			}
-
-			queries.Assign(&rel.SubjectUserID, o.ID)
 		}
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			SubjectUserAuditEvents: related,
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
 		}
+		args[object.ID] = struct{}{}
 	} else {
-		o.R.SubjectUserAuditEvents = append(o.R.SubjectUserAuditEvents, related...)
-	}
-
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &auditEventR{
-				SubjectUser: o,
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
 			}
-		} else {
-			rel.R.SubjectUser = o
+			args[obj.ID] = struct{}{}
 		}
 	}
-	return nil
-}
 
-// SetSubjectUserAuditEvents removes all previously related items of the
-// user replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.SubjectUser's SubjectUserAuditEvents accordingly.
-// Replaces o.R.SubjectUserAuditEvents with related.
-// Sets related.R.SubjectUser's SubjectUserAuditEvents accordingly.
-func (o *User) SetSubjectUserAuditEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditEvent) error {
-	query := "update \"audit_events\" set \"subject_user_id\" = null where \"subject_user_id\" = $1"
-	values := []interface{}{o.ID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
+	if len(args) == 0 {
+		return nil
 	}
 
-	if o.R != nil {
-		for _, rel := range o.R.SubjectUserAuditEvents {
-			queries.SetScanner(&rel.SubjectUserID, nil)
-			if rel.R == nil {
-				continue
-			}
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
 
-			rel.R.SubjectUser = nil
-		}
-		o.R.SubjectUserAuditEvents = nil
+	query := NewQuery(
+		qm.From(`group_membership_requests`),
+		qm.WhereIn(`group_membership_requests.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	return o.AddSubjectUserAuditEvents(ctx, exec, insert, related...)
-}
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load group_membership_requests")
+	}
 
-// RemoveSubjectUserAuditEvents relationships from objects passed in.
-// Removes related items from R.SubjectUserAuditEvents (uses pointer comparison, removal does not keep order)
+	var resultSlice []*GroupMembershipRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice group_membership_requests")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on group_membership_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_membership_requests")
+	}
+
+	if len(groupMembershipRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GroupMembershipRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupMembershipRequestR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.GroupMembershipRequests = append(local.R.GroupMembershipRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupMembershipRequestR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGroupMemberships allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadGroupMemberships(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`group_memberships`),
+		qm.WhereIn(`group_memberships.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load group_memberships")
+	}
+
+	var resultSlice []*GroupMembership
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice group_memberships")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on group_memberships")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_memberships")
+	}
+
+	if len(groupMembershipAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GroupMemberships = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupMembershipR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.GroupMemberships = append(local.R.GroupMemberships, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupMembershipR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`jobs`),
+		qm.WhereIn(`jobs.created_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load jobs")
+	}
+
+	var resultSlice []*Job
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice jobs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for jobs")
+	}
+
+	if len(jobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &jobR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByJobs = append(local.R.CreatedByJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &jobR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationDispatches allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadNotificationDispatches(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_dispatches`),
+		qm.WhereIn(`notification_dispatches.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_dispatches")
+	}
+
+	var resultSlice []*NotificationDispatch
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_dispatches")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_dispatches")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_dispatches")
+	}
+
+	if len(notificationDispatchAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationDispatches = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationDispatchR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.NotificationDispatches = append(local.R.NotificationDispatches, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationDispatchR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationFailoverPolicies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadNotificationFailoverPolicies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_failover_policies`),
+		qm.WhereIn(`notification_failover_policies.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_failover_policies")
+	}
+
+	var resultSlice []*NotificationFailoverPolicy
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_failover_policies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_failover_policies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_failover_policies")
+	}
+
+	if len(notificationFailoverPolicyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationFailoverPolicies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationFailoverPolicyR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.NotificationFailoverPolicies = append(local.R.NotificationFailoverPolicies, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationFailoverPolicyR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationGroupPreferences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadNotificationGroupPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_group_preferences`),
+		qm.WhereIn(`notification_group_preferences.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_group_preferences")
+	}
+
+	var resultSlice []*NotificationGroupPreference
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_group_preferences")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_group_preferences")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_group_preferences")
+	}
+
+	if len(notificationGroupPreferenceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationGroupPreferences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationGroupPreferenceR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.NotificationGroupPreferences = append(local.R.NotificationGroupPreferences, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationGroupPreferenceR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationPreferences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadNotificationPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_preferences`),
+		qm.WhereIn(`notification_preferences.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_preferences")
+	}
+
+	var resultSlice []*NotificationPreference
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_preferences")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_preferences")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_preferences")
+	}
+
+	if len(notificationPreferenceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationPreferences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationPreferenceR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.NotificationPreferences = append(local.R.NotificationPreferences, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationPreferenceR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationTargetRankings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadNotificationTargetRankings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_target_rankings`),
+		qm.WhereIn(`notification_target_rankings.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_target_rankings")
+	}
+
+	var resultSlice []*NotificationTargetRanking
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_target_rankings")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_target_rankings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_target_rankings")
+	}
+
+	if len(notificationTargetRankingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationTargetRankings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationTargetRankingR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.NotificationTargetRankings = append(local.R.NotificationTargetRankings, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationTargetRankingR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRequestComments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRequestComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`request_comments`),
+		qm.WhereIn(`request_comments.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load request_comments")
+	}
+
+	var resultSlice []*RequestComment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice request_comments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on request_comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for request_comments")
+	}
+
+	if len(requestCommentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RequestComments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &requestCommentR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RequestComments = append(local.R.RequestComments, foreign)
+				if foreign.R == nil {
+					foreign.R = &requestCommentR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSessionTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSessionTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`session_tokens`),
+		qm.WhereIn(`session_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load session_tokens")
+	}
+
+	var resultSlice []*SessionToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice session_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on session_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for session_tokens")
+	}
+
+	if len(sessionTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SessionTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &sessionTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.SessionTokens = append(local.R.SessionTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &sessionTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserEmailChanges allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserEmailChanges(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_email_changes`),
+		qm.WhereIn(`user_email_changes.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_email_changes")
+	}
+
+	var resultSlice []*UserEmailChange
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_email_changes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_email_changes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_email_changes")
+	}
+
+	if len(userEmailChangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserEmailChanges = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userEmailChangeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserEmailChanges = append(local.R.UserEmailChanges, foreign)
+				if foreign.R == nil {
+					foreign.R = &userEmailChangeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserExtensionResourceVersions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserExtensionResourceVersions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_extension_resource_versions`),
+		qm.WhereIn(`user_extension_resource_versions.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_extension_resource_versions")
+	}
+
+	var resultSlice []*UserExtensionResourceVersion
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_extension_resource_versions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_extension_resource_versions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_extension_resource_versions")
+	}
+
+	if len(userExtensionResourceVersionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserExtensionResourceVersions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userExtensionResourceVersionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserExtensionResourceVersions = append(local.R.UserExtensionResourceVersions, foreign)
+				if foreign.R == nil {
+					foreign.R = &userExtensionResourceVersionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserExtensionResources allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserExtensionResources(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_extension_resources`),
+		qm.WhereIn(`user_extension_resources.user_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`user_extension_resources.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_extension_resources")
+	}
+
+	var resultSlice []*UserExtensionResource
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_extension_resources")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_extension_resources")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_extension_resources")
+	}
+
+	if len(userExtensionResourceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserExtensionResources = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userExtensionResourceR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserExtensionResources = append(local.R.UserExtensionResources, foreign)
+				if foreign.R == nil {
+					foreign.R = &userExtensionResourceR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTenant of the user to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.TenantUsers.
+func (o *User) SetTenant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Organization) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TenantID, related.ID)
+	if o.R == nil {
+		o.R = &userR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
+	if related.R == nil {
+		related.R = &organizationR{
+			TenantUsers: UserSlice{o},
+		}
+	} else {
+		related.R.TenantUsers = append(related.R.TenantUsers, o)
+	}
+
+	return nil
+}
+
+// RemoveTenant relationship.
+// Sets o.R.Tenant to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *User) RemoveTenant(ctx context.Context, exec boil.ContextExecutor, related *Organization) error {
+	var err error
+
+	queries.SetScanner(&o.TenantID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("tenant_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Tenant = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.TenantUsers {
+		if queries.Equal(o.TenantID, ri.TenantID) {
+			continue
+		}
+
+		ln := len(related.R.TenantUsers)
+		if ln > 1 && i < ln-1 {
+			related.R.TenantUsers[i] = related.R.TenantUsers[ln-1]
+		}
+		related.R.TenantUsers = related.R.TenantUsers[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddArchivedRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ArchivedRequests.
+// Sets related.R.User appropriately.
+func (o *User) AddArchivedRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArchivedRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"archived_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, archivedRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ArchivedRequests: related,
+		}
+	} else {
+		o.R.ArchivedRequests = append(o.R.ArchivedRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &archivedRequestR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetArchivedRequests removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's ArchivedRequests accordingly.
+// Replaces o.R.ArchivedRequests with related.
+// Sets related.R.User's ArchivedRequests accordingly.
+func (o *User) SetArchivedRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArchivedRequest) error {
+	query := "update \"archived_requests\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ArchivedRequests {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.ArchivedRequests = nil
+	}
+
+	return o.AddArchivedRequests(ctx, exec, insert, related...)
+}
+
+// RemoveArchivedRequests relationships from objects passed in.
+// Removes related items from R.ArchivedRequests (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveArchivedRequests(ctx context.Context, exec boil.ContextExecutor, related ...*ArchivedRequest) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ArchivedRequests {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ArchivedRequests)
+			if ln > 1 && i < ln-1 {
+				o.R.ArchivedRequests[i] = o.R.ArchivedRequests[ln-1]
+			}
+			o.R.ArchivedRequests = o.R.ArchivedRequests[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddRequesterUserArchivedRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RequesterUserArchivedRequests.
+// Sets related.R.RequesterUser appropriately.
+func (o *User) AddRequesterUserArchivedRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArchivedRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RequesterUserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"archived_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"requester_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, archivedRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RequesterUserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			RequesterUserArchivedRequests: related,
+		}
+	} else {
+		o.R.RequesterUserArchivedRequests = append(o.R.RequesterUserArchivedRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &archivedRequestR{
+				RequesterUser: o,
+			}
+		} else {
+			rel.R.RequesterUser = o
+		}
+	}
+	return nil
+}
+
+// AddDecidedByUserArchivedRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DecidedByUserArchivedRequests.
+// Sets related.R.DecidedByUser appropriately.
+func (o *User) AddDecidedByUserArchivedRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArchivedRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DecidedByUserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"archived_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"decided_by_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, archivedRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DecidedByUserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			DecidedByUserArchivedRequests: related,
+		}
+	} else {
+		o.R.DecidedByUserArchivedRequests = append(o.R.DecidedByUserArchivedRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &archivedRequestR{
+				DecidedByUser: o,
+			}
+		} else {
+			rel.R.DecidedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetDecidedByUserArchivedRequests removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.DecidedByUser's DecidedByUserArchivedRequests accordingly.
+// Replaces o.R.DecidedByUserArchivedRequests with related.
+// Sets related.R.DecidedByUser's DecidedByUserArchivedRequests accordingly.
+func (o *User) SetDecidedByUserArchivedRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArchivedRequest) error {
+	query := "update \"archived_requests\" set \"decided_by_user_id\" = null where \"decided_by_user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.DecidedByUserArchivedRequests {
+			queries.SetScanner(&rel.DecidedByUserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.DecidedByUser = nil
+		}
+		o.R.DecidedByUserArchivedRequests = nil
+	}
+
+	return o.AddDecidedByUserArchivedRequests(ctx, exec, insert, related...)
+}
+
+// RemoveDecidedByUserArchivedRequests relationships from objects passed in.
+// Removes related items from R.DecidedByUserArchivedRequests (uses pointer comparison, removal does not keep order)
+// Sets related.R.DecidedByUser.
+func (o *User) RemoveDecidedByUserArchivedRequests(ctx context.Context, exec boil.ContextExecutor, related ...*ArchivedRequest) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DecidedByUserID, nil)
+		if rel.R != nil {
+			rel.R.DecidedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("decided_by_user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.DecidedByUserArchivedRequests {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.DecidedByUserArchivedRequests)
+			if ln > 1 && i < ln-1 {
+				o.R.DecidedByUserArchivedRequests[i] = o.R.DecidedByUserArchivedRequests[ln-1]
+			}
+			o.R.DecidedByUserArchivedRequests = o.R.DecidedByUserArchivedRequests[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSubjectUserAuditEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SubjectUserAuditEvents.
+// Sets related.R.SubjectUser appropriately.
+func (o *User) AddSubjectUserAuditEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SubjectUserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"audit_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"subject_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, auditEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SubjectUserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			SubjectUserAuditEvents: related,
+		}
+	} else {
+		o.R.SubjectUserAuditEvents = append(o.R.SubjectUserAuditEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &auditEventR{
+				SubjectUser: o,
+			}
+		} else {
+			rel.R.SubjectUser = o
+		}
+	}
+	return nil
+}
+
+// SetSubjectUserAuditEvents removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SubjectUser's SubjectUserAuditEvents accordingly.
+// Replaces o.R.SubjectUserAuditEvents with related.
+// Sets related.R.SubjectUser's SubjectUserAuditEvents accordingly.
+func (o *User) SetSubjectUserAuditEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditEvent) error {
+	query := "update \"audit_events\" set \"subject_user_id\" = null where \"subject_user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SubjectUserAuditEvents {
+			queries.SetScanner(&rel.SubjectUserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.SubjectUser = nil
+		}
+		o.R.SubjectUserAuditEvents = nil
+	}
+
+	return o.AddSubjectUserAuditEvents(ctx, exec, insert, related...)
+}
+
+// RemoveSubjectUserAuditEvents relationships from objects passed in.
+// Removes related items from R.SubjectUserAuditEvents (uses pointer comparison, removal does not keep order)
 // Sets related.R.SubjectUser.
 func (o *User) RemoveSubjectUserAuditEvents(ctx context.Context, exec boil.ContextExecutor, related ...*AuditEvent) error {
 	if len(related) == 0 {
 		return nil
 	}
 
-	var err error
-	for _, rel := range related {
-		queries.SetScanner(&rel.SubjectUserID, nil)
-		if rel.R != nil {
-			rel.R.SubjectUser = nil
-		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("subject_user_id")); err != nil {
-			return err
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SubjectUserID, nil)
+		if rel.R != nil {
+			rel.R.SubjectUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("subject_user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SubjectUserAuditEvents {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SubjectUserAuditEvents)
+			if ln > 1 && i < ln-1 {
+				o.R.SubjectUserAuditEvents[i] = o.R.SubjectUserAuditEvents[ln-1]
+			}
+			o.R.SubjectUserAuditEvents = o.R.SubjectUserAuditEvents[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddActorAuditEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ActorAuditEvents.
+// Sets related.R.Actor appropriately.
+func (o *User) AddActorAuditEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ActorID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"audit_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"actor_id"}),
+				strmangle.WhereClause("\"", "\"", 2, auditEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ActorID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ActorAuditEvents: related,
+		}
+	} else {
+		o.R.ActorAuditEvents = append(o.R.ActorAuditEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &auditEventR{
+				Actor: o,
+			}
+		} else {
+			rel.R.Actor = o
+		}
+	}
+	return nil
+}
+
+// SetActorAuditEvents removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Actor's ActorAuditEvents accordingly.
+// Replaces o.R.ActorAuditEvents with related.
+// Sets related.R.Actor's ActorAuditEvents accordingly.
+func (o *User) SetActorAuditEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditEvent) error {
+	query := "update \"audit_events\" set \"actor_id\" = null where \"actor_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ActorAuditEvents {
+			queries.SetScanner(&rel.ActorID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Actor = nil
+		}
+		o.R.ActorAuditEvents = nil
+	}
+
+	return o.AddActorAuditEvents(ctx, exec, insert, related...)
+}
+
+// RemoveActorAuditEvents relationships from objects passed in.
+// Removes related items from R.ActorAuditEvents (uses pointer comparison, removal does not keep order)
+// Sets related.R.Actor.
+func (o *User) RemoveActorAuditEvents(ctx context.Context, exec boil.ContextExecutor, related ...*AuditEvent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ActorID, nil)
+		if rel.R != nil {
+			rel.R.Actor = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("actor_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ActorAuditEvents {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ActorAuditEvents)
+			if ln > 1 && i < ln-1 {
+				o.R.ActorAuditEvents[i] = o.R.ActorAuditEvents[ln-1]
+			}
+			o.R.ActorAuditEvents = o.R.ActorAuditEvents[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddRequesterUserGroupApplicationRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RequesterUserGroupApplicationRequests.
+// Sets related.R.RequesterUser appropriately.
+func (o *User) AddRequesterUserGroupApplicationRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*GroupApplicationRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RequesterUserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"group_application_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"requester_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, groupApplicationRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RequesterUserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			RequesterUserGroupApplicationRequests: related,
+		}
+	} else {
+		o.R.RequesterUserGroupApplicationRequests = append(o.R.RequesterUserGroupApplicationRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &groupApplicationRequestR{
+				RequesterUser: o,
+			}
+		} else {
+			rel.R.RequesterUser = o
+		}
+	}
+	return nil
+}
+
+// AddGroupMembershipRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.GroupMembershipRequests.
+// Sets related.R.User appropriately.
+func (o *User) AddGroupMembershipRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*GroupMembershipRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"group_membership_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, groupMembershipRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			GroupMembershipRequests: related,
+		}
+	} else {
+		o.R.GroupMembershipRequests = append(o.R.GroupMembershipRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &groupMembershipRequestR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddGroupMemberships adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.GroupMemberships.
+// Sets related.R.User appropriately.
+func (o *User) AddGroupMemberships(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*GroupMembership) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"group_memberships\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, groupMembershipPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			GroupMemberships: related,
+		}
+	} else {
+		o.R.GroupMemberships = append(o.R.GroupMemberships, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &groupMembershipR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddCreatedByJobs adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByJobs.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Job) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"jobs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, jobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByJobs: related,
+		}
+	} else {
+		o.R.CreatedByJobs = append(o.R.CreatedByJobs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &jobR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetCreatedByJobs removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedByUser's CreatedByJobs accordingly.
+// Replaces o.R.CreatedByJobs with related.
+// Sets related.R.CreatedByUser's CreatedByJobs accordingly.
+func (o *User) SetCreatedByJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Job) error {
+	query := "update \"jobs\" set \"created_by\" = null where \"created_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByJobs {
+			queries.SetScanner(&rel.CreatedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedByUser = nil
+		}
+		o.R.CreatedByJobs = nil
+	}
+
+	return o.AddCreatedByJobs(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByJobs relationships from objects passed in.
+// Removes related items from R.CreatedByJobs (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedByUser.
+func (o *User) RemoveCreatedByJobs(ctx context.Context, exec boil.ContextExecutor, related ...*Job) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByJobs {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByJobs)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByJobs[i] = o.R.CreatedByJobs[ln-1]
+			}
+			o.R.CreatedByJobs = o.R.CreatedByJobs[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddNotificationDispatches adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.NotificationDispatches.
+// Sets related.R.User appropriately.
+func (o *User) AddNotificationDispatches(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationDispatch) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification_dispatches\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationDispatchPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			NotificationDispatches: related,
 		}
+	} else {
+		o.R.NotificationDispatches = append(o.R.NotificationDispatches, related...)
 	}
-	if o.R == nil {
-		return nil
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationDispatchR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
 	}
+	return nil
+}
 
+// AddNotificationFailoverPolicies adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.NotificationFailoverPolicies.
+// Sets related.R.User appropriately.
+func (o *User) AddNotificationFailoverPolicies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationFailoverPolicy) error {
+	var err error
 	for _, rel := range related {
-		for i, ri := range o.R.SubjectUserAuditEvents {
-			if rel != ri {
-				continue
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification_failover_policies\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationFailoverPolicyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-			ln := len(o.R.SubjectUserAuditEvents)
-			if ln > 1 && i < ln-1 {
-				o.R.SubjectUserAuditEvents[i] = o.R.SubjectUserAuditEvents[ln-1]
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
 			}
-			o.R.SubjectUserAuditEvents = o.R.SubjectUserAuditEvents[:ln-1]
-			break
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			NotificationFailoverPolicies: related,
 		}
+	} else {
+		o.R.NotificationFailoverPolicies = append(o.R.NotificationFailoverPolicies, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationFailoverPolicyR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
 	return nil
 }
 
-// AddActorAuditEvents adds the given related objects to the existing relationships
+// AddNotificationGroupPreferences adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ActorAuditEvents.
-// Sets related.R.Actor appropriately.
-func (o *User) AddActorAuditEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditEvent) error {
+// Appends related to o.R.NotificationGroupPreferences.
+// Sets related.R.User appropriately.
+func (o *User) AddNotificationGroupPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationGroupPreference) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.ActorID, o.ID)
+			rel.UserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"audit_events\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"actor_id"}),
-				strmangle.WhereClause("\"", "\"", 2, auditEventPrimaryKeyColumns),
+				"UPDATE \"notification_group_preferences\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationGroupPreferencePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1641,121 +4328,153 @@ func (o *User) AddActorAuditEvents(ctx context.Context, exec boil.ContextExecuto
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.ActorID, o.ID)
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			ActorAuditEvents: related,
+			NotificationGroupPreferences: related,
 		}
 	} else {
-		o.R.ActorAuditEvents = append(o.R.ActorAuditEvents, related...)
+		o.R.NotificationGroupPreferences = append(o.R.NotificationGroupPreferences, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &auditEventR{
-				Actor: o,
+			rel.R = &notificationGroupPreferenceR{
+				User: o,
 			}
 		} else {
-			rel.R.Actor = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// SetActorAuditEvents removes all previously related items of the
-// user replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.Actor's ActorAuditEvents accordingly.
-// Replaces o.R.ActorAuditEvents with related.
-// Sets related.R.Actor's ActorAuditEvents accordingly.
-func (o *User) SetActorAuditEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditEvent) error {
-	query := "update \"audit_events\" set \"actor_id\" = null where \"actor_id\" = $1"
-	values := []interface{}{o.ID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
+// AddNotificationPreferences adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.NotificationPreferences.
+// Sets related.R.User appropriately.
+func (o *User) AddNotificationPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationPreference) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification_preferences\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationPreferencePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if o.R != nil {
-		for _, rel := range o.R.ActorAuditEvents {
-			queries.SetScanner(&rel.ActorID, nil)
-			if rel.R == nil {
-				continue
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.R.Actor = nil
+			rel.UserID = o.ID
 		}
-		o.R.ActorAuditEvents = nil
 	}
 
-	return o.AddActorAuditEvents(ctx, exec, insert, related...)
-}
+	if o.R == nil {
+		o.R = &userR{
+			NotificationPreferences: related,
+		}
+	} else {
+		o.R.NotificationPreferences = append(o.R.NotificationPreferences, related...)
+	}
 
-// RemoveActorAuditEvents relationships from objects passed in.
-// Removes related items from R.ActorAuditEvents (uses pointer comparison, removal does not keep order)
-// Sets related.R.Actor.
-func (o *User) RemoveActorAuditEvents(ctx context.Context, exec boil.ContextExecutor, related ...*AuditEvent) error {
-	if len(related) == 0 {
-		return nil
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationPreferenceR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
 	}
+	return nil
+}
 
+// AddNotificationTargetRankings adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.NotificationTargetRankings.
+// Sets related.R.User appropriately.
+func (o *User) AddNotificationTargetRankings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationTargetRanking) error {
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.ActorID, nil)
-		if rel.R != nil {
-			rel.R.Actor = nil
-		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("actor_id")); err != nil {
-			return err
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notification_target_rankings\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationTargetRankingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
 		}
 	}
+
 	if o.R == nil {
-		return nil
+		o.R = &userR{
+			NotificationTargetRankings: related,
+		}
+	} else {
+		o.R.NotificationTargetRankings = append(o.R.NotificationTargetRankings, related...)
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.ActorAuditEvents {
-			if rel != ri {
-				continue
-			}
-
-			ln := len(o.R.ActorAuditEvents)
-			if ln > 1 && i < ln-1 {
-				o.R.ActorAuditEvents[i] = o.R.ActorAuditEvents[ln-1]
+		if rel.R == nil {
+			rel.R = &notificationTargetRankingR{
+				User: o,
 			}
-			o.R.ActorAuditEvents = o.R.ActorAuditEvents[:ln-1]
-			break
+		} else {
+			rel.R.User = o
 		}
 	}
-
 	return nil
 }
 
-// AddRequesterUserGroupApplicationRequests adds the given related objects to the existing relationships
+// AddRequestComments adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.RequesterUserGroupApplicationRequests.
-// Sets related.R.RequesterUser appropriately.
-func (o *User) AddRequesterUserGroupApplicationRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*GroupApplicationRequest) error {
+// Appends related to o.R.RequestComments.
+// Sets related.R.User appropriately.
+func (o *User) AddRequestComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RequestComment) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.RequesterUserID = o.ID
+			rel.UserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"group_application_requests\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"requester_user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, groupApplicationRequestPrimaryKeyColumns),
+				"UPDATE \"request_comments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, requestCommentPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1768,35 +4487,35 @@ func (o *User) AddRequesterUserGroupApplicationRequests(ctx context.Context, exe
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.RequesterUserID = o.ID
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			RequesterUserGroupApplicationRequests: related,
+			RequestComments: related,
 		}
 	} else {
-		o.R.RequesterUserGroupApplicationRequests = append(o.R.RequesterUserGroupApplicationRequests, related...)
+		o.R.RequestComments = append(o.R.RequestComments, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &groupApplicationRequestR{
-				RequesterUser: o,
+			rel.R = &requestCommentR{
+				User: o,
 			}
 		} else {
-			rel.R.RequesterUser = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// AddGroupMembershipRequests adds the given related objects to the existing relationships
+// AddSessionTokens adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.GroupMembershipRequests.
+// Appends related to o.R.SessionTokens.
 // Sets related.R.User appropriately.
-func (o *User) AddGroupMembershipRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*GroupMembershipRequest) error {
+func (o *User) AddSessionTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SessionToken) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1806,9 +4525,9 @@ func (o *User) AddGroupMembershipRequests(ctx context.Context, exec boil.Context
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"group_membership_requests\" SET %s WHERE %s",
+				"UPDATE \"session_tokens\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, groupMembershipRequestPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, sessionTokenPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1827,15 +4546,15 @@ func (o *User) AddGroupMembershipRequests(ctx context.Context, exec boil.Context
 
 	if o.R == nil {
 		o.R = &userR{
-			GroupMembershipRequests: related,
+			SessionTokens: related,
 		}
 	} else {
-		o.R.GroupMembershipRequests = append(o.R.GroupMembershipRequests, related...)
+		o.R.SessionTokens = append(o.R.SessionTokens, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &groupMembershipRequestR{
+			rel.R = &sessionTokenR{
 				User: o,
 			}
 		} else {
@@ -1845,11 +4564,11 @@ func (o *User) AddGroupMembershipRequests(ctx context.Context, exec boil.Context
 	return nil
 }
 
-// AddGroupMemberships adds the given related objects to the existing relationships
+// AddUserEmailChanges adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.GroupMemberships.
+// Appends related to o.R.UserEmailChanges.
 // Sets related.R.User appropriately.
-func (o *User) AddGroupMemberships(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*GroupMembership) error {
+func (o *User) AddUserEmailChanges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserEmailChange) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1859,9 +4578,9 @@ func (o *User) AddGroupMemberships(ctx context.Context, exec boil.ContextExecuto
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"group_memberships\" SET %s WHERE %s",
+				"UPDATE \"user_email_changes\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, groupMembershipPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, userEmailChangePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1880,15 +4599,15 @@ func (o *User) AddGroupMemberships(ctx context.Context, exec boil.ContextExecuto
 
 	if o.R == nil {
 		o.R = &userR{
-			GroupMemberships: related,
+			UserEmailChanges: related,
 		}
 	} else {
-		o.R.GroupMemberships = append(o.R.GroupMemberships, related...)
+		o.R.UserEmailChanges = append(o.R.UserEmailChanges, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &groupMembershipR{
+			rel.R = &userEmailChangeR{
 				User: o,
 			}
 		} else {
@@ -1898,11 +4617,11 @@ func (o *User) AddGroupMemberships(ctx context.Context, exec boil.ContextExecuto
 	return nil
 }
 
-// AddNotificationPreferences adds the given related objects to the existing relationships
+// AddUserExtensionResourceVersions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.NotificationPreferences.
+// Appends related to o.R.UserExtensionResourceVersions.
 // Sets related.R.User appropriately.
-func (o *User) AddNotificationPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationPreference) error {
+func (o *User) AddUserExtensionResourceVersions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserExtensionResourceVersion) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1912,9 +4631,9 @@ func (o *User) AddNotificationPreferences(ctx context.Context, exec boil.Context
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"notification_preferences\" SET %s WHERE %s",
+				"UPDATE \"user_extension_resource_versions\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, notificationPreferencePrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, userExtensionResourceVersionPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1933,15 +4652,15 @@ func (o *User) AddNotificationPreferences(ctx context.Context, exec boil.Context
 
 	if o.R == nil {
 		o.R = &userR{
-			NotificationPreferences: related,
+			UserExtensionResourceVersions: related,
 		}
 	} else {
-		o.R.NotificationPreferences = append(o.R.NotificationPreferences, related...)
+		o.R.UserExtensionResourceVersions = append(o.R.UserExtensionResourceVersions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &notificationPreferenceR{
+			rel.R = &userExtensionResourceVersionR{
 				User: o,
 			}
 		} else {
@@ -0,0 +1,3 @@
+// Package namingpolicy checks group names and slugs against the naming rules
+// configured by governor admins, globally or for an organization.
+package namingpolicy
@@ -0,0 +1,91 @@
+package namingpolicy
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrInvalidPattern is returned when a naming rule isn't a valid regular expression
+var ErrInvalidPattern = errors.New("invalid naming rule pattern")
+
+// Policy is a set of naming rules for groups
+type Policy struct {
+	// ReservedSlugs can only be used by governor admins
+	ReservedSlugs []string
+	// NamePatterns are regular expressions the group name must match
+	NamePatterns []string
+	// RequiredPrefixes are the prefixes the group slug must start with, one of them is enough
+	RequiredPrefixes []string
+}
+
+// Violation is a naming rule a group name or slug breaks
+type Violation struct {
+	Field   string
+	Message string
+}
+
+// Validate checks that every naming rule pattern compiles
+func (p Policy) Validate() error {
+	for _, pattern := range p.NamePatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("%w: %q: %s", ErrInvalidPattern, pattern, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Check returns the rules of the policy broken by the group name and slug.
+// Reserved slugs are allowed when allowReserved is true.
+func (p Policy) Check(name, slug string, allowReserved bool) ([]Violation, error) {
+	violations := []Violation{}
+
+	if !allowReserved {
+		for _, reserved := range p.ReservedSlugs {
+			if strings.EqualFold(slug, reserved) {
+				violations = append(violations, Violation{
+					Field:   "name",
+					Message: fmt.Sprintf("slug %q is reserved, choose a different name", slug),
+				})
+
+				break
+			}
+		}
+	}
+
+	for _, pattern := range p.NamePatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %s", ErrInvalidPattern, pattern, err.Error())
+		}
+
+		if !re.MatchString(name) {
+			violations = append(violations, Violation{
+				Field:   "name",
+				Message: fmt.Sprintf("name %q must match the pattern %s", name, pattern),
+			})
+		}
+	}
+
+	if len(p.RequiredPrefixes) > 0 {
+		found := false
+
+		for _, prefix := range p.RequiredPrefixes {
+			if strings.HasPrefix(slug, prefix) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			violations = append(violations, Violation{
+				Field:   "name",
+				Message: fmt.Sprintf("slug %q must start with one of: %s", slug, strings.Join(p.RequiredPrefixes, ", ")),
+			})
+		}
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,73 @@
+package namingpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	policy := Policy{
+		ReservedSlugs:    []string{"governor-admins"},
+		NamePatterns:     []string{`^[A-Za-z0-9 -]+$`},
+		RequiredPrefixes: []string{"team-", "svc-"},
+	}
+
+	tests := []struct {
+		name          string
+		groupName     string
+		slug          string
+		allowReserved bool
+		want          []Violation
+	}{
+		{
+			name:      "valid",
+			groupName: "Team Gophers",
+			slug:      "team-gophers",
+			want:      []Violation{},
+		},
+		{
+			name:      "reserved",
+			groupName: "Governor Admins",
+			slug:      "governor-admins",
+			want: []Violation{
+				{Field: "name", Message: `slug "governor-admins" is reserved, choose a different name`},
+				{Field: "name", Message: `slug "governor-admins" must start with one of: team-, svc-`},
+			},
+		},
+		{
+			name:          "reserved allowed",
+			groupName:     "Governor Admins",
+			slug:          "governor-admins",
+			allowReserved: true,
+			want: []Violation{
+				{Field: "name", Message: `slug "governor-admins" must start with one of: team-, svc-`},
+			},
+		},
+		{
+			name:      "pattern",
+			groupName: "Team Gophers!",
+			slug:      "team-gophers",
+			want: []Violation{
+				{Field: "name", Message: `name "Team Gophers!" must match the pattern ^[A-Za-z0-9 -]+$`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := policy.Check(tt.groupName, tt.slug, tt.allowReserved)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	assert.NoError(t, Policy{NamePatterns: []string{`^team-`}}.Validate())
+	assert.ErrorIs(t, Policy{NamePatterns: []string{`^(team`}}.Validate(), ErrInvalidPattern)
+
+	_, err := Policy{NamePatterns: []string{`^(team`}}.Check("team", "team", false)
+	assert.ErrorIs(t, err, ErrInvalidPattern)
+}
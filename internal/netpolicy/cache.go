@@ -0,0 +1,140 @@
+package netpolicy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const defaultTTL = 30 * time.Second
+
+// Loader loads the network policies, keyed by subject
+type Loader func(ctx context.Context) (map[string]*Policy, error)
+
+// DBLoader returns a loader reading the policies from the network_policies
+// table. Policies with malformed ranges are skipped since they are validated
+// when they are written.
+func DBLoader(exec boil.ContextExecutor, logger *zap.Logger) Loader {
+	return func(ctx context.Context) (map[string]*Policy, error) {
+		policies, err := models.NetworkPolicies().All(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+
+		out := make(map[string]*Policy, len(policies))
+
+		for _, p := range policies {
+			policy, err := Parse(p.AllowedCidrs, p.DeniedCidrs)
+			if err != nil {
+				logger.Warn("skipping invalid network policy", zap.String("subject", p.Subject), zap.Error(err))
+				continue
+			}
+
+			out[p.Subject] = policy
+		}
+
+		return out, nil
+	}
+}
+
+// Cache is an in-memory cache of the network policies. It is refreshed when
+// the ttl expires, so policy changes made through another instance are
+// picked up within the ttl.
+type Cache struct {
+	mu       sync.RWMutex
+	policies map[string]*Policy
+	expires  time.Time
+	ttl      time.Duration
+	load     Loader
+	logger   *zap.Logger
+	now      func() time.Time
+}
+
+// Option is a functional configuration option for the policy cache
+type Option func(c *Cache)
+
+// New returns a new policy cache using the loader to read the policies
+func New(load Loader, opts ...Option) *Cache {
+	c := Cache{
+		policies: map[string]*Policy{},
+		ttl:      defaultTTL,
+		load:     load,
+		logger:   zap.NewNop(),
+		now:      time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return &c
+}
+
+// WithTTL sets how long the policies are cached before being reloaded
+func WithTTL(ttl time.Duration) Option {
+	return func(c *Cache) {
+		c.ttl = ttl
+	}
+}
+
+// WithLogger sets the cache logger
+func WithLogger(l *zap.Logger) Option {
+	return func(c *Cache) {
+		if l != nil {
+			c.logger = l
+		}
+	}
+}
+
+// Check returns ErrDenied if the subject has a policy that doesn't allow the
+// address. Subjects without a policy are allowed from anywhere. When the
+// policies can't be reloaded the previously loaded ones are used.
+func (c *Cache) Check(ctx context.Context, subject, addr string) error {
+	c.mu.RLock()
+	policy, ok := c.policies[subject]
+	stale := c.now().After(c.expires)
+	c.mu.RUnlock()
+
+	if stale {
+		policy, ok = c.refresh(ctx, subject)
+	}
+
+	if !ok {
+		return nil
+	}
+
+	return policy.Check(addr)
+}
+
+// Invalidate drops the cached policies so they are reloaded on the next check
+func (c *Cache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expires = time.Time{}
+}
+
+func (c *Cache) refresh(ctx context.Context, subject string) (*Policy, bool) {
+	policies, err := c.load(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err != nil {
+		c.logger.Warn("error loading network policies, using cached policies", zap.Error(err))
+	} else {
+		c.policies = policies
+	}
+
+	// don't retry a failed load on every check
+	c.expires = c.now().Add(c.ttl)
+
+	policy, ok := c.policies[subject]
+
+	return policy, ok
+}
@@ -0,0 +1,4 @@
+// Package netpolicy restricts the networks an identity, usually a service
+// account, can call the api from. Policies allow or deny CIDR ranges for a
+// token subject, so a leaked machine token can't be used from anywhere.
+package netpolicy
@@ -0,0 +1,96 @@
+package netpolicy
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+var (
+	// ErrInvalidCIDR is returned when a policy has a malformed CIDR range
+	ErrInvalidCIDR = errors.New("invalid CIDR")
+	// ErrDenied is returned when a policy doesn't allow the client address
+	ErrDenied = errors.New("address not allowed by network policy")
+)
+
+// Policy is the network policy of an identity. Addresses in one of the Denied
+// ranges are always rejected, and when Allowed isn't empty only addresses in
+// one of its ranges are accepted.
+type Policy struct {
+	Allowed []*net.IPNet
+	Denied  []*net.IPNet
+}
+
+// Parse builds a policy from the allowed and denied CIDR ranges. A single
+// address is accepted as a /32 or /128 range.
+func Parse(allowed, denied []string) (*Policy, error) {
+	p := &Policy{}
+
+	var err error
+
+	if p.Allowed, err = parseCIDRs(allowed); err != nil {
+		return nil, err
+	}
+
+	if p.Denied, err = parseCIDRs(denied); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Check returns ErrDenied if the address isn't allowed by the policy
+func (p *Policy) Check(addr string) error {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return fmt.Errorf("%w: unable to parse address %q", ErrDenied, addr)
+	}
+
+	for _, n := range p.Denied {
+		if n.Contains(ip) {
+			return fmt.Errorf("%w: %s is in denied range %s", ErrDenied, ip, n)
+		}
+	}
+
+	if len(p.Allowed) == 0 {
+		return nil
+	}
+
+	for _, n := range p.Allowed {
+		if n.Contains(ip) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s is not in an allowed range", ErrDenied, ip)
+}
+
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	out := make([]*net.IPNet, 0, len(cidrs))
+
+	for _, c := range cidrs {
+		if !strings.Contains(c, "/") {
+			ip := net.ParseIP(c)
+			if ip == nil {
+				return nil, fmt.Errorf("%w: %q", ErrInvalidCIDR, c)
+			}
+
+			bits := 128
+			if ip.To4() != nil {
+				bits = 32
+			}
+
+			c = fmt.Sprintf("%s/%d", c, bits)
+		}
+
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidCIDR, c)
+		}
+
+		out = append(out, n)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,115 @@
+package netpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	p, err := Parse([]string{"10.0.0.0/8", "192.0.2.10", "2001:db8::/32"}, []string{"10.1.0.0/16"})
+	require.NoError(t, err)
+	assert.Len(t, p.Allowed, 3)
+	assert.Equal(t, "192.0.2.10/32", p.Allowed[1].String())
+	assert.Len(t, p.Denied, 1)
+
+	_, err = Parse([]string{"10.0.0.0/33"}, nil)
+	assert.ErrorIs(t, err, ErrInvalidCIDR)
+
+	_, err = Parse(nil, []string{"not-an-ip"})
+	assert.ErrorIs(t, err, ErrInvalidCIDR)
+}
+
+func TestPolicyCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed []string
+		denied  []string
+		addr    string
+		wantErr bool
+	}{
+		{
+			name: "empty policy",
+			addr: "198.51.100.1",
+		},
+		{
+			name:    "allowed range",
+			allowed: []string{"10.0.0.0/8"},
+			addr:    "10.2.3.4",
+		},
+		{
+			name:    "outside allowed ranges",
+			allowed: []string{"10.0.0.0/8"},
+			addr:    "198.51.100.1",
+			wantErr: true,
+		},
+		{
+			name:    "denied range wins",
+			allowed: []string{"10.0.0.0/8"},
+			denied:  []string{"10.1.0.0/16"},
+			addr:    "10.1.2.3",
+			wantErr: true,
+		},
+		{
+			name:    "denied without allowed ranges",
+			denied:  []string{"198.51.100.0/24"},
+			addr:    "198.51.100.1",
+			wantErr: true,
+		},
+		{
+			name:    "ipv6",
+			allowed: []string{"2001:db8::/32"},
+			addr:    "2001:db8::1",
+		},
+		{
+			name:    "bad address",
+			allowed: []string{"10.0.0.0/8"},
+			addr:    "nope",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Parse(tt.allowed, tt.denied)
+			require.NoError(t, err)
+
+			err = p.Check(tt.addr)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrDenied)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCacheCheck(t *testing.T) {
+	loads := 0
+
+	c := New(func(_ context.Context) (map[string]*Policy, error) {
+		loads++
+
+		p, err := Parse([]string{"10.0.0.0/8"}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]*Policy{"svc-account": p}, nil
+	})
+
+	ctx := context.TODO()
+
+	assert.NoError(t, c.Check(ctx, "svc-account", "10.0.0.1"))
+	assert.ErrorIs(t, c.Check(ctx, "svc-account", "198.51.100.1"), ErrDenied)
+	assert.NoError(t, c.Check(ctx, "someone-else", "198.51.100.1"))
+	assert.Equal(t, 1, loads)
+
+	c.Invalidate()
+
+	assert.NoError(t, c.Check(ctx, "svc-account", "10.0.0.1"))
+	assert.Equal(t, 2, loads)
+}
@@ -0,0 +1,3 @@
+// Package outbox stores the events that couldn't be published on the event
+// bus in the database, and publishes them once the event bus is back.
+package outbox
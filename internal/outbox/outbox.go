@@ -0,0 +1,269 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const (
+	defaultBatchSize = 100
+	defaultLease     = time.Minute
+	maxBackoff       = 10 * time.Minute
+)
+
+// Publisher publishes the messages stored in the outbox
+type Publisher interface {
+	Redeliver(ctx context.Context, msg *nats.Msg) error
+}
+
+// Outbox is the database outbox of the events that couldn't be published
+type Outbox struct {
+	batchSize int
+	db        boil.ContextExecutor
+	lease     time.Duration
+	logger    *zap.Logger
+}
+
+// Option is a functional configuration option for the outbox
+type Option func(o *Outbox)
+
+// New returns an outbox storing the events in db
+func New(db boil.ContextExecutor, opts ...Option) *Outbox {
+	o := Outbox{
+		batchSize: defaultBatchSize,
+		db:        db,
+		lease:     defaultLease,
+		logger:    zap.NewNop(),
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &o
+}
+
+// WithBatchSize sets how many events are published at most on each relay pass
+func WithBatchSize(n int) Option {
+	return func(o *Outbox) {
+		if n > 0 {
+			o.batchSize = n
+		}
+	}
+}
+
+// WithLogger sets the outbox logger
+func WithLogger(l *zap.Logger) Option {
+	return func(o *Outbox) {
+		if l != nil {
+			o.logger = l
+		}
+	}
+}
+
+// Enqueue stores a message in the outbox
+func (o *Outbox) Enqueue(ctx context.Context, msg *nats.Msg) error {
+	_, err := o.insert(ctx, o.db, msg, time.Now())
+
+	return err
+}
+
+// Stage stores a message in the outbox within the transaction exec, and
+// returns the id of the stored event. The staged events are only relayed
+// after the lease, the caller publishes the event once the transaction is
+// committed and removes it. An event is still published when the caller
+// can't, for example when governor stops before.
+func (o *Outbox) Stage(ctx context.Context, exec boil.ContextExecutor, msg *nats.Msg) (string, error) {
+	return o.insert(ctx, exec, msg, time.Now().Add(o.lease))
+}
+
+// Remove deletes an event published by the caller that staged it
+func (o *Outbox) Remove(ctx context.Context, id string) error {
+	if _, err := models.EventOutboxes(qm.Where("id = ?", id)).DeleteAll(ctx, o.db); err != nil {
+		return fmt.Errorf("error deleting staged outbox event: %w", err)
+	}
+
+	return nil
+}
+
+// insert stores a message in the outbox, it is relayed from next
+func (o *Outbox) insert(ctx context.Context, exec boil.ContextExecutor, msg *nats.Msg, next time.Time) (string, error) {
+	headers, err := json.Marshal(msg.Header)
+	if err != nil {
+		return "", fmt.Errorf("error encoding event headers: %w", err)
+	}
+
+	event := models.EventOutbox{
+		Subject:       msg.Subject,
+		Payload:       types.JSON(msg.Data),
+		Headers:       types.JSON(headers),
+		NextAttemptAt: next,
+		CreatedAt:     time.Now(),
+	}
+
+	if err := event.Insert(ctx, exec, boil.Infer()); err != nil {
+		return "", err
+	}
+
+	return event.ID, nil
+}
+
+// Run publishes the stored events every interval until the context is canceled
+func (o *Outbox) Run(ctx context.Context, interval time.Duration, pub Publisher) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if n, err := o.Relay(ctx, pub); err != nil {
+			o.logger.Warn("error publishing outbox events", zap.Int("published", n), zap.Error(err))
+		} else if n > 0 {
+			o.logger.Info("published outbox events", zap.Int("published", n))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Relay publishes the stored events that are due, oldest first, and returns
+// how many were published. It stops at the first event that can't be
+// published, the event is tried again after a backoff.
+func (o *Outbox) Relay(ctx context.Context, pub Publisher) (int, error) {
+	due, err := models.EventOutboxes(
+		qm.Where("next_attempt_at <= ?", time.Now()),
+		qm.OrderBy("created_at"),
+		qm.Limit(o.batchSize),
+	).All(ctx, o.db)
+	if err != nil {
+		return 0, fmt.Errorf("error getting outbox events: %w", err)
+	}
+
+	published := 0
+
+	for _, event := range due {
+		claimed, err := o.claim(ctx, event)
+		if err != nil {
+			return published, err
+		}
+
+		// another instance is publishing the event
+		if !claimed {
+			continue
+		}
+
+		msg, err := message(event)
+		if err != nil {
+			return published, err
+		}
+
+		if err := pub.Redeliver(ctx, msg); err != nil {
+			return published, o.retryLater(ctx, event, err)
+		}
+
+		if _, err := event.Delete(ctx, o.db); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return published, fmt.Errorf("error deleting published outbox event: %w", err)
+		}
+
+		published++
+	}
+
+	return published, nil
+}
+
+// Flush relays the stored events that are due until none are left, it is
+// used on shutdown so the events queued by this instance aren't left behind
+func (o *Outbox) Flush(ctx context.Context, pub Publisher) (int, error) {
+	flushed := 0
+
+	for {
+		n, err := o.Relay(ctx, pub)
+		flushed += n
+
+		if err != nil || n == 0 {
+			return flushed, err
+		}
+	}
+}
+
+// claim leases the event so other instances don't publish it at the same
+// time, it returns false when another instance claimed it first
+func (o *Outbox) claim(ctx context.Context, event *models.EventOutbox) (bool, error) {
+	lease := time.Now().Add(o.lease)
+
+	n, err := models.EventOutboxes(
+		qm.Where("id = ?", event.ID),
+		qm.And("next_attempt_at = ?", event.NextAttemptAt),
+	).UpdateAll(ctx, o.db, models.M{models.EventOutboxColumns.NextAttemptAt: lease})
+	if err != nil {
+		return false, fmt.Errorf("error claiming outbox event: %w", err)
+	}
+
+	event.NextAttemptAt = lease
+
+	return n == 1, nil
+}
+
+// retryLater records a failed attempt and schedules the next one
+func (o *Outbox) retryLater(ctx context.Context, event *models.EventOutbox, perr error) error {
+	event.Attempts++
+	event.LastError = perr.Error()
+	event.NextAttemptAt = time.Now().Add(backoff(event.Attempts))
+
+	if _, err := event.Update(ctx, o.db, boil.Whitelist(
+		models.EventOutboxColumns.Attempts,
+		models.EventOutboxColumns.LastError,
+		models.EventOutboxColumns.NextAttemptAt,
+	)); err != nil {
+		return fmt.Errorf("error updating outbox event: %w", err)
+	}
+
+	return fmt.Errorf("error publishing outbox event: %w", perr)
+}
+
+// message returns the nats message of a stored event
+func message(event *models.EventOutbox) (*nats.Msg, error) {
+	headers := nats.Header{}
+
+	if len(event.Headers) > 0 {
+		if err := json.Unmarshal(event.Headers, &headers); err != nil {
+			return nil, fmt.Errorf("error decoding outbox event headers: %w", err)
+		}
+	}
+
+	return &nats.Msg{
+		Subject: event.Subject,
+		Data:    []byte(event.Payload),
+		Header:  headers,
+	}, nil
+}
+
+// backoff returns how long to wait before the next attempt after the given
+// number of failed attempts, it doubles from 5 seconds up to 10 minutes
+func backoff(attempts int64) time.Duration {
+	d := 5 * time.Second
+
+	for i := int64(1); i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+
+	if d > maxBackoff {
+		return maxBackoff
+	}
+
+	return d
+}
@@ -0,0 +1,36 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 5*time.Second, backoff(1))
+	assert.Equal(t, 10*time.Second, backoff(2))
+	assert.Equal(t, 40*time.Second, backoff(4))
+	assert.Equal(t, maxBackoff, backoff(20))
+}
+
+func TestMessage(t *testing.T) {
+	msg, err := message(&models.EventOutbox{
+		Subject: "governor.events.groups",
+		Payload: types.JSON(`{"action":"CREATE"}`),
+		Headers: types.JSON(`{"X-Governor-Correlation-Id":["abc"]}`),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "governor.events.groups", msg.Subject)
+	assert.JSONEq(t, `{"action":"CREATE"}`, string(msg.Data))
+	assert.Equal(t, nats.Header{"X-Governor-Correlation-Id": []string{"abc"}}, msg.Header)
+
+	_, err = message(&models.EventOutbox{Headers: types.JSON(`[`)})
+	assert.Error(t, err)
+}
@@ -0,0 +1,146 @@
+package respcache
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTTL        = 30 * time.Second
+	defaultMaxEntries = 10000
+)
+
+// Response is a cached api response
+type Response struct {
+	Status      int
+	ContentType string
+	Body        []byte
+}
+
+type entry struct {
+	response Response
+	expires  time.Time
+}
+
+// Cache is an in-memory response cache
+type Cache struct {
+	mu         sync.RWMutex
+	entries    map[string]entry
+	versions   map[string]uint64
+	maxEntries int
+	ttl        time.Duration
+	now        func() time.Time
+}
+
+// Option is a functional configuration option for the response cache
+type Option func(c *Cache)
+
+// New returns a new response cache
+func New(opts ...Option) *Cache {
+	c := Cache{
+		entries:    make(map[string]entry),
+		versions:   make(map[string]uint64),
+		maxEntries: defaultMaxEntries,
+		ttl:        defaultTTL,
+		now:        time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return &c
+}
+
+// WithTTL sets the maximum time a response is cached for
+func WithTTL(ttl time.Duration) Option {
+	return func(c *Cache) {
+		c.ttl = ttl
+	}
+}
+
+// WithMaxEntries sets the maximum number of cached responses
+func WithMaxEntries(n int) Option {
+	return func(c *Cache) {
+		c.maxEntries = n
+	}
+}
+
+// Key builds a cache key for the given request path that changes whenever
+// one of the subjects is invalidated
+func (c *Cache) Key(path string, subjects ...string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var b strings.Builder
+
+	for _, s := range subjects {
+		b.WriteString(s)
+		b.WriteByte('@')
+		b.WriteString(strconv.FormatUint(c.versions[s], 10))
+		b.WriteByte('|')
+	}
+
+	b.WriteString(path)
+
+	return b.String()
+}
+
+// Get returns the cached response for the key, if it exists and hasn't expired
+func (c *Cache) Get(key string) (Response, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	e, ok := c.entries[key]
+	if !ok || c.now().After(e.expires) {
+		return Response{}, false
+	}
+
+	return e.response, true
+}
+
+// Set stores a response in the cache
+func (c *Cache) Set(key string, resp Response) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= c.maxEntries {
+		c.evict()
+	}
+
+	c.entries[key] = entry{
+		response: resp,
+		expires:  c.now().Add(c.ttl),
+	}
+}
+
+// Invalidate bumps the version of the subject, making all the responses
+// that depend on it unreachable
+func (c *Cache) Invalidate(subject string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.versions[subject]++
+}
+
+// evict removes expired entries, and if the cache is still full removes
+// entries until there is room for a new one. Callers must hold the lock.
+func (c *Cache) evict() {
+	now := c.now()
+
+	for k, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+
+	for k := range c.entries {
+		if len(c.entries) < c.maxEntries {
+			return
+		}
+
+		delete(c.entries, k)
+	}
+}
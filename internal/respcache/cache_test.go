@@ -0,0 +1,72 @@
+package respcache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache_GetSet(t *testing.T) {
+	c := New()
+
+	key := c.Key("/api/v1alpha1/users/1", "users", "members")
+
+	_, ok := c.Get(key)
+	assert.False(t, ok)
+
+	c.Set(key, Response{Status: 200, ContentType: "application/json", Body: []byte(`{}`)})
+
+	resp, ok := c.Get(key)
+	assert.True(t, ok)
+	assert.Equal(t, 200, resp.Status)
+	assert.Equal(t, []byte(`{}`), resp.Body)
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	c := New()
+
+	key := c.Key("/api/v1alpha1/users/1", "users", "members")
+	c.Set(key, Response{Status: 200})
+
+	c.Invalidate("groups")
+	assert.Equal(t, key, c.Key("/api/v1alpha1/users/1", "users", "members"))
+
+	c.Invalidate("members")
+
+	newKey := c.Key("/api/v1alpha1/users/1", "users", "members")
+	assert.NotEqual(t, key, newKey)
+
+	_, ok := c.Get(newKey)
+	assert.False(t, ok)
+}
+
+func TestCache_Expiration(t *testing.T) {
+	now := time.Now()
+
+	c := New(WithTTL(time.Minute))
+	c.now = func() time.Time { return now }
+
+	c.Set("key", Response{Status: 200})
+
+	_, ok := c.Get("key")
+	assert.True(t, ok)
+
+	now = now.Add(2 * time.Minute)
+
+	_, ok = c.Get("key")
+	assert.False(t, ok)
+}
+
+func TestCache_MaxEntries(t *testing.T) {
+	c := New(WithMaxEntries(2))
+
+	c.Set("a", Response{})
+	c.Set("b", Response{})
+	c.Set("c", Response{})
+
+	assert.Len(t, c.entries, 2)
+
+	_, ok := c.Get("c")
+	assert.True(t, ok)
+}
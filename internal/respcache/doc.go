@@ -0,0 +1,4 @@
+// Package respcache provides an in-memory cache for api responses. Entries are
+// keyed by the version of the event subjects they depend on, so publishing an
+// event on one of those subjects invalidates every response built from it.
+package respcache
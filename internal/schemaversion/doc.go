@@ -0,0 +1,4 @@
+// Package schemaversion compares the version of the database schema with the
+// migrations embedded in the binary, so an instance doesn't run against a
+// schema it wasn't built for during mixed-version rollouts or rollbacks.
+package schemaversion
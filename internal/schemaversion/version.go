@@ -0,0 +1,133 @@
+package schemaversion
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/pressly/goose/v3"
+)
+
+const (
+	// StateOK is a schema version within the allowed skew
+	StateOK = "ok"
+	// StateAhead is a schema with more migrations than the binary knows about
+	StateAhead = "ahead"
+	// StateBehind is a schema missing migrations the binary expects
+	StateBehind = "behind"
+
+	// ActionRefuse refuses to start when the skew is exceeded
+	ActionRefuse = "refuse"
+	// ActionReadOnly starts in read-only maintenance mode when the skew is exceeded
+	ActionReadOnly = "read-only"
+)
+
+// ErrSkewExceeded is returned when the schema version is further from the
+// expected version than the allowed skew
+var ErrSkewExceeded = errors.New("database schema version skew exceeded")
+
+// Status is the result of a schema version check. Skew is the number of
+// versions the database is ahead of the binary, negative when it's behind.
+type Status struct {
+	Expected int64  `json:"expected_version"`
+	Current  int64  `json:"current_version"`
+	Skew     int64  `json:"skew"`
+	MaxSkew  int64  `json:"max_skew"`
+	State    string `json:"state"`
+}
+
+// NewStatus compares the current schema version with the expected one
+func NewStatus(expected, current, maxSkew int64) Status {
+	s := Status{
+		Expected: expected,
+		Current:  current,
+		Skew:     current - expected,
+		MaxSkew:  maxSkew,
+		State:    StateOK,
+	}
+
+	switch {
+	case s.Skew > maxSkew:
+		s.State = StateAhead
+	case -s.Skew > maxSkew:
+		s.State = StateBehind
+	}
+
+	return s
+}
+
+// Err returns ErrSkewExceeded with a diagnostic when the skew is exceeded
+func (s Status) Err() error {
+	switch s.State {
+	case StateAhead:
+		return fmt.Errorf("%w: database schema version %d is %d ahead of version %d expected by this binary (max skew %d), upgrade governor",
+			ErrSkewExceeded, s.Current, s.Skew, s.Expected, s.MaxSkew)
+	case StateBehind:
+		return fmt.Errorf("%w: database schema version %d is %d behind version %d expected by this binary (max skew %d), run the migrations",
+			ErrSkewExceeded, s.Current, -s.Skew, s.Expected, s.MaxSkew)
+	}
+
+	return nil
+}
+
+// Expected returns the version of the latest migration in the directory
+func Expected(fsys fs.FS, dir string) (int64, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return 0, err
+	}
+
+	var version int64
+
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".sql") {
+			continue
+		}
+
+		v, err := goose.NumericComponent(e.Name())
+		if err != nil {
+			return 0, fmt.Errorf("invalid migration %s: %w", e.Name(), err)
+		}
+
+		if v > version {
+			version = v
+		}
+	}
+
+	return version, nil
+}
+
+// Checker checks the database schema version against the embedded migrations
+type Checker struct {
+	db       *sql.DB
+	expected int64
+	maxSkew  int64
+}
+
+// NewChecker returns a checker expecting the latest migration in the
+// directory of fsys, allowing the schema to be up to maxSkew versions away
+func NewChecker(db *sql.DB, fsys fs.FS, dir string, maxSkew int64) (*Checker, error) {
+	expected, err := Expected(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the embedded migrations: %w", err)
+	}
+
+	return &Checker{
+		db:       db,
+		expected: expected,
+		maxSkew:  maxSkew,
+	}, nil
+}
+
+// Check reads the current schema version and compares it with the expected one
+func (c *Checker) Check(ctx context.Context) (Status, error) {
+	current, err := goose.GetDBVersionContext(ctx, c.db)
+	if err != nil {
+		return Status{}, fmt.Errorf("error getting the database schema version: %w", err)
+	}
+
+	return NewStatus(c.expected, current, c.maxSkew), nil
+}
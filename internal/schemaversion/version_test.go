@@ -0,0 +1,58 @@
+package schemaversion
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  int64
+		maxSkew  int64
+		want     string
+		wantSkew int64
+	}{
+		{"same version", 60, 0, StateOK, 0},
+		{"ahead", 62, 1, StateAhead, 2},
+		{"ahead within skew", 61, 1, StateOK, 1},
+		{"behind", 58, 1, StateBehind, -2},
+		{"behind within skew", 59, 1, StateOK, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStatus(60, tt.current, tt.maxSkew)
+
+			assert.Equal(t, tt.want, s.State)
+			assert.Equal(t, tt.wantSkew, s.Skew)
+
+			if tt.want == StateOK {
+				assert.NoError(t, s.Err())
+			} else {
+				assert.ErrorIs(t, s.Err(), ErrSkewExceeded)
+			}
+		})
+	}
+
+	assert.Contains(t, NewStatus(60, 58, 0).Err().Error(), "run the migrations")
+}
+
+func TestExpected(t *testing.T) {
+	fsys := fstest.MapFS{
+		"migrations/00001_init.sql":   {},
+		"migrations/00012_groups.sql": {},
+		"migrations/00003_users.sql":  {},
+		"migrations/README.md":        {},
+	}
+
+	v, err := Expected(fsys, "migrations")
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), v)
+
+	_, err = Expected(fstest.MapFS{"migrations/bad.sql": {}}, "migrations")
+	assert.Error(t, err)
+}
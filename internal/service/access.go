@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+)
+
+// UserAccess is the effective access of a user, the groups it is a member of
+// and the applications those groups grant
+type UserAccess struct {
+	User         *models.User
+	Groups       []*GroupAccess
+	Applications []*ApplicationGrant
+}
+
+// GroupAccess is a group a user is a member of, with every path of membership
+type GroupAccess struct {
+	Group  *models.Group
+	Direct bool
+	Paths  []dbtools.MembershipPath
+}
+
+// ApplicationGrant is an application a user has access to and the groups granting it
+type ApplicationGrant struct {
+	Application *models.Application
+	GrantedBy   []string
+}
+
+// GetUserAccess returns the groups a user is a direct or indirect member of,
+// how each membership comes about and the applications granted by them
+func (s *Service) GetUserAccess(ctx context.Context, id string) (*UserAccess, error) {
+	user, err := s.FindUser(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := dbtools.GetMembershipPathsForUser(ctx, s.db, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error enumerating group membership: %w", err)
+	}
+
+	access := &UserAccess{
+		User:         user,
+		Groups:       []*GroupAccess{},
+		Applications: []*ApplicationGrant{},
+	}
+
+	if len(paths) == 0 {
+		return access, nil
+	}
+
+	groupAccess := map[string]*GroupAccess{}
+	gids := []interface{}{}
+
+	for _, p := range paths {
+		ga, ok := groupAccess[p.GroupID]
+		if !ok {
+			ga = &GroupAccess{}
+			groupAccess[p.GroupID] = ga
+			gids = append(gids, p.GroupID)
+		}
+
+		ga.Direct = ga.Direct || p.Direct
+		ga.Paths = append(ga.Paths, p)
+	}
+
+	groups, err := models.Groups(
+		qm.WhereIn("id IN ?", gids...),
+		tenancy.Scope(ctx, models.TableNames.Groups),
+		qm.OrderBy("slug"),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting groups: %w", err)
+	}
+
+	for _, g := range groups {
+		ga := groupAccess[g.ID]
+		ga.Group = g
+		access.Groups = append(access.Groups, ga)
+	}
+
+	groupApps, err := models.GroupApplications(
+		qm.WhereIn("group_id IN ?", gids...),
+		dbtools.UnexpiredGroupApplications(),
+		qm.Load("Application", tenancy.Scope(ctx, models.TableNames.Applications)),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting group applications: %w", err)
+	}
+
+	grants := map[string]*ApplicationGrant{}
+
+	for _, ga := range groupApps {
+		// the application relationship isn't loaded for deleted applications
+		if ga.R == nil || ga.R.Application == nil {
+			continue
+		}
+
+		grant, ok := grants[ga.ApplicationID]
+		if !ok {
+			grant = &ApplicationGrant{Application: ga.R.Application}
+			grants[ga.ApplicationID] = grant
+			access.Applications = append(access.Applications, grant)
+		}
+
+		grant.GrantedBy = append(grant.GrantedBy, ga.GroupID)
+	}
+
+	sort.Slice(access.Applications, func(i, j int) bool {
+		return access.Applications[i].Application.Slug < access.Applications[j].Application.Slug
+	})
+
+	return access, nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// applicationLinkReaperBatchSize is how many expired links are removed at most on each reaper pass
+const applicationLinkReaperBatchSize = 100
+
+// ReapExpiredApplicationLinks removes the group application links past their
+// expiry. Each link is removed in its own transaction with an audit event, and
+// an application link delete event is published once it's committed. The audit
+// events of the removed links are returned, along with the first error.
+func (s *Service) ReapExpiredApplicationLinks(ctx context.Context) ([]*models.AuditEvent, error) {
+	expired, err := models.GroupApplications(
+		qm.Where("expires_at <= ?", time.Now()),
+		qm.OrderBy("expires_at"),
+		qm.Limit(applicationLinkReaperBatchSize),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting expired application links: %w", err)
+	}
+
+	auditEvents := []*models.AuditEvent{}
+
+	var perr publishErrors
+
+	for _, link := range expired {
+		var event *models.AuditEvent
+
+		if err := s.withTx(ctx, func(tx *sql.Tx) error {
+			if _, err := link.Delete(ctx, tx, false); err != nil {
+				return fmt.Errorf("error removing expired application link: %w", err)
+			}
+
+			event, err = dbtools.AuditGroupApplicationExpired(ctx, tx, link)
+			if err != nil {
+				return fmt.Errorf("error removing expired application link (audit): %w", err)
+			}
+
+			return nil
+		}); err != nil {
+			return auditEvents, err
+		}
+
+		auditEvents = append(auditEvents, event)
+
+		if err := s.publish(ctx, events.GovernorApplicationLinksEventSubject, &events.Event{
+			Version:       events.Version,
+			Action:        events.GovernorEventDelete,
+			AuditID:       event.ID,
+			GroupID:       link.GroupID,
+			ApplicationID: link.ApplicationID,
+		}); err != nil && !perr.add(fmt.Errorf("failed to publish application link delete event, downstream changes may be delayed: %w", err)) {
+			return auditEvents, perr.err
+		}
+	}
+
+	return auditEvents, perr.err
+}
+
+// RunApplicationLinkReaper removes the expired group application links every
+// interval until the context is canceled
+func (s *Service) RunApplicationLinkReaper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if removed, err := s.ReapExpiredApplicationLinks(ctx); err != nil {
+			s.logger.Warn("error removing expired application links", zap.Int("removed", len(removed)), zap.Error(err))
+		} else if len(removed) > 0 {
+			s.logger.Info("removed expired application links", zap.Int("removed", len(removed)))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+const (
+	// DefaultBreakGlassDuration is how long break-glass access lasts when no
+	// expiry is given
+	DefaultBreakGlassDuration = time.Hour
+	// MaxBreakGlassDuration is how long break-glass access can last at most
+	MaxBreakGlassDuration = 4 * time.Hour
+)
+
+// BreakGlassParams are the parameters of a break-glass access
+type BreakGlassParams struct {
+	// IncidentRef identifies the incident the access is needed for, it is
+	// required
+	IncidentRef string
+	// ExpiresAt is when the access is removed, DefaultBreakGlassDuration from
+	// now when it is null
+	ExpiresAt null.Time
+}
+
+// checkBreakGlass returns the expiry of a break-glass access with the params
+// granted at now, or ErrInvalidBreakGlassAccess when it can't be granted
+func checkBreakGlass(params BreakGlassParams, now time.Time) (time.Time, error) {
+	if strings.TrimSpace(params.IncidentRef) == "" {
+		return time.Time{}, fmt.Errorf("%w: an incident reference is required", ErrInvalidBreakGlassAccess)
+	}
+
+	if !params.ExpiresAt.Valid {
+		return now.Add(DefaultBreakGlassDuration), nil
+	}
+
+	if !params.ExpiresAt.Time.After(now) {
+		return time.Time{}, fmt.Errorf("%w: the access must expire in the future", ErrInvalidBreakGlassAccess)
+	}
+
+	if params.ExpiresAt.Time.Sub(now) > MaxBreakGlassDuration {
+		return time.Time{}, fmt.Errorf("%w: the access can't last more than %s", ErrInvalidBreakGlassAccess, MaxBreakGlassDuration)
+	}
+
+	return params.ExpiresAt.Time.UTC(), nil
+}
+
+// EnableGroupBreakGlass approves a group for break-glass access, the
+// responders can then grant themselves a short membership in it
+func (s *Service) EnableGroupBreakGlass(ctx context.Context, actor Actor, groupIDOrSlug string) (*models.Group, *models.AuditEvent, error) {
+	group, err := s.FindGroup(ctx, groupIDOrSlug, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if group.BreakGlassAt.Valid {
+		return nil, nil, ErrGroupAlreadyBreakGlass
+	}
+
+	original := *group
+	group.BreakGlassAt = null.TimeFrom(time.Now().UTC())
+
+	event, err := s.updateGroupState(ctx, actor, &original, group, dbtools.AuditGroupBreakGlassEnabled, models.GroupColumns.BreakGlassAt)
+
+	return group, event, err
+}
+
+// DisableGroupBreakGlass removes the break-glass approval of a group, the
+// break-glass memberships already granted still expire as scheduled
+func (s *Service) DisableGroupBreakGlass(ctx context.Context, actor Actor, groupIDOrSlug string) (*models.Group, *models.AuditEvent, error) {
+	group, err := s.FindGroup(ctx, groupIDOrSlug, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !group.BreakGlassAt.Valid {
+		return nil, nil, ErrGroupNotBreakGlass
+	}
+
+	original := *group
+	group.BreakGlassAt = null.Time{}
+
+	event, err := s.updateGroupState(ctx, actor, &original, group, dbtools.AuditGroupBreakGlassDisabled, models.GroupColumns.BreakGlassAt)
+
+	return group, event, err
+}
+
+// BreakGlass grants the actor a direct membership in a group approved for
+// break-glass access, when they are a direct or indirect member of the
+// responders group. The membership expires after a short duration, and its
+// removal is scheduled so the scheduled membership changes worker removes it
+// at expiry. The duration policies of the group don't apply, but frozen
+// groups and member limits still do. Denied attempts are recorded in the
+// audit events as well. The break-glass audit event is the parent of the
+// membership events, it is returned even when publishing fails since the
+// change is already committed.
+func (s *Service) BreakGlass(ctx context.Context, actor Actor, respondersGroup, groupIDOrSlug string, params BreakGlassParams) (*models.GroupMembership, *models.AuditEvent, error) {
+	expiresAt, err := checkBreakGlass(params, time.Now().UTC())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if actor.User == nil {
+		return nil, nil, ErrNotBreakGlassResponder
+	}
+
+	group, err := s.FindGroup(ctx, groupIDOrSlug, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !group.BreakGlassAt.Valid {
+		event, err := s.denyBreakGlass(ctx, actor, group, ErrGroupNotBreakGlass)
+		return nil, event, err
+	}
+
+	responder, err := s.isBreakGlassResponder(ctx, respondersGroup, actor.User.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !responder {
+		event, err := s.denyBreakGlass(ctx, actor, group, ErrNotBreakGlassResponder)
+		return nil, event, err
+	}
+
+	if event, err := s.CheckGroupChange(ctx, actor, group, "break-glass access"); err != nil {
+		return nil, event, err
+	}
+
+	incidentRef := strings.TrimSpace(params.IncidentRef)
+
+	membership := &models.GroupMembership{
+		GroupID:       group.ID,
+		UserID:        actor.User.ID,
+		ExpiresAt:     null.TimeFrom(expiresAt),
+		Source:        events.MembershipSourceBreakGlass,
+		SourceRef:     null.StringFrom(incidentRef),
+		Justification: incidentRef,
+	}
+
+	removal := &models.ScheduledMembershipChange{
+		GroupID:      group.ID,
+		UserID:       actor.User.ID,
+		Action:       ScheduledChangeActionRemove,
+		ScheduledFor: expiresAt,
+		Status:       ScheduledChangeStatusPending,
+		CreatedBy:    null.StringFrom(actor.User.ID),
+	}
+
+	var (
+		event                               *models.AuditEvent
+		membershipsBefore, membershipsAfter []dbtools.EnumeratedMembership
+	)
+
+	if err := s.withTx(ctx, func(tx *sql.Tx) error {
+		exists, err := models.GroupMemberships(
+			qm.Where("group_id = ?", group.ID),
+			qm.And("user_id = ?", actor.User.ID),
+		).Exists(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("error checking membership exists: %w", err)
+		}
+
+		if exists {
+			return ErrUserAlreadyMember
+		}
+
+		if _, err = checkMemberHardLimit(ctx, tx, group); err != nil {
+			return err
+		}
+
+		membershipsBefore, err = dbtools.GetMembershipsForUser(ctx, tx, actor.User.ID, false)
+		if err != nil {
+			return fmt.Errorf("failed to compute new effective memberships: %w", err)
+		}
+
+		if err := membership.Insert(ctx, tx, boil.Infer()); err != nil {
+			// a concurrent request added the membership first
+			if dbtools.IsUniqueViolation(err) {
+				return ErrUserAlreadyMember
+			}
+
+			return fmt.Errorf("error granting break-glass access: %w", err)
+		}
+
+		event, err = dbtools.AuditBreakGlassAccessGranted(ctx, tx, actor.AuditID, actor.User, membership, incidentRef)
+		if err != nil {
+			return fmt.Errorf("error granting break-glass access (audit): %w", err)
+		}
+
+		if _, err := dbtools.AuditGroupMembershipCreated(ctx, tx, event.ID, actor.User, membership, ""); err != nil {
+			return fmt.Errorf("error granting break-glass access (audit): %w", err)
+		}
+
+		// the removal is applied as the responder, with the break-glass
+		// event as the parent of the removal events
+		removal.AuditID = null.StringFrom(event.ID)
+
+		if err := removal.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("error scheduling break-glass access removal: %w", err)
+		}
+
+		membershipsAfter, err = dbtools.GetMembershipsForUser(ctx, tx, actor.User.ID, false)
+		if err != nil {
+			return fmt.Errorf("failed to compute new effective memberships: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	memberActor := actor
+	memberActor.AuditID = event.ID
+
+	if err := s.publishMembers(ctx, memberActor, events.GovernorEventCreate, dbtools.FindMemberDiff(membershipsBefore, membershipsAfter)); err != nil {
+		return membership, event, err
+	}
+
+	return membership, event, nil
+}
+
+// isBreakGlassResponder returns true when the user is a direct or indirect
+// member of the responders group, nobody is a responder when it is empty
+func (s *Service) isBreakGlassResponder(ctx context.Context, respondersGroup, userID string) (bool, error) {
+	if respondersGroup == "" {
+		return false, nil
+	}
+
+	group, err := s.FindGroup(ctx, respondersGroup, false)
+	if err != nil {
+		if errors.Is(err, ErrGroupNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	memberships, err := dbtools.GetMembershipsForUser(ctx, s.db, userID, false)
+	if err != nil {
+		return false, fmt.Errorf("error enumerating group membership: %w", err)
+	}
+
+	for _, m := range memberships {
+		if m.GroupID == group.ID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// denyBreakGlass records a denied break-glass access attempt, the audit event
+// is returned with the reason it was denied
+func (s *Service) denyBreakGlass(ctx context.Context, actor Actor, group *models.Group, reason error) (*models.AuditEvent, error) {
+	event, err := dbtools.AuditBreakGlassAccessDenied(ctx, s.db, actor.AuditID, actor.User, group, reason.Error())
+	if err != nil {
+		return nil, fmt.Errorf("error recording denied break-glass access (audit): %w", err)
+	}
+
+	return event, reason
+}
+
+// NotifyBreakGlass dispatches a notification of a break-glass access to every
+// direct and indirect member of the security group right away, the members
+// whose preferences don't accept the notification are skipped. The number of
+// notifications dispatched is returned, with the first error.
+func (s *Service) NotifyBreakGlass(ctx context.Context, securityGroup, notificationType string, membership *models.GroupMembership, event *models.AuditEvent) (int, error) {
+	group, err := s.FindGroup(ctx, securityGroup, false)
+	if err != nil {
+		return 0, err
+	}
+
+	audience, err := s.NotificationBroadcastAudience(ctx, []string{group.ID})
+	if err != nil {
+		return 0, err
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"subject":      "Break-glass access granted",
+		"message":      event.Message,
+		"audit_id":     event.ID,
+		"group_id":     membership.GroupID,
+		"user_id":      membership.UserID,
+		"incident_ref": membership.SourceRef.String,
+		"expires_at":   membership.ExpiresAt.Time.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		dispatched int
+		firstErr   error
+	)
+
+	for _, r := range audience {
+		_, err := s.CreateNotificationDispatch(ctx, NotificationDispatchParams{
+			UserID:           r.UserID,
+			NotificationType: notificationType,
+			Group:            r.GroupID,
+			Payload:          payload,
+		})
+
+		switch {
+		case err == nil, errors.Is(err, eventbus.ErrQueued):
+			dispatched++
+		case errors.Is(err, ErrNotificationNotDeliverable), errors.Is(err, ErrUserNotFound):
+		case firstErr == nil:
+			firstErr = fmt.Errorf("error notifying %s of break-glass access: %w", r.UserID, err)
+		}
+	}
+
+	return dispatched, firstErr
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach-go/v2/testserver"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	dbm "github.com/metal-toolbox/governor-api/db"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+func TestCheckBreakGlass(t *testing.T) {
+	now := time.Now().UTC()
+
+	expiresAt, err := checkBreakGlass(BreakGlassParams{IncidentRef: "INC-42"}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, now.Add(DefaultBreakGlassDuration), expiresAt)
+
+	expiresAt, err = checkBreakGlass(BreakGlassParams{IncidentRef: "INC-42", ExpiresAt: null.TimeFrom(now.Add(MaxBreakGlassDuration))}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, now.Add(MaxBreakGlassDuration), expiresAt)
+
+	_, err = checkBreakGlass(BreakGlassParams{IncidentRef: " "}, now)
+	assert.ErrorIs(t, err, ErrInvalidBreakGlassAccess)
+
+	_, err = checkBreakGlass(BreakGlassParams{IncidentRef: "INC-42", ExpiresAt: null.TimeFrom(now)}, now)
+	assert.ErrorIs(t, err, ErrInvalidBreakGlassAccess)
+
+	_, err = checkBreakGlass(BreakGlassParams{IncidentRef: "INC-42", ExpiresAt: null.TimeFrom(now.Add(MaxBreakGlassDuration + time.Second))}, now)
+	assert.ErrorIs(t, err, ErrInvalidBreakGlassAccess)
+}
+
+type BreakGlassTestSuite struct {
+	suite.Suite
+
+	db  *sql.DB
+	svc *Service
+
+	responder *models.User
+	outsider  *models.User
+}
+
+func (s *BreakGlassTestSuite) seedTestDB() error {
+	testData := []string{
+		// users
+		`INSERT INTO "users" ("id", "external_id", "name", "email", "login_count", "avatar_url", "last_login_at", "created_at", "updated_at", "github_id", "github_username", "deleted_at", "status") VALUES
+		('00000001-0000-0000-0000-000000000001', NULL, 'Responder', 'responder@email.com', 0, NULL, NULL, now(), now(), NULL, NULL, NULL, 'active');`,
+		`INSERT INTO "users" ("id", "external_id", "name", "email", "login_count", "avatar_url", "last_login_at", "created_at", "updated_at", "github_id", "github_username", "deleted_at", "status") VALUES
+		('00000001-0000-0000-0000-000000000002', NULL, 'Outsider', 'outsider@email.com', 0, NULL, NULL, now(), now(), NULL, NULL, NULL, 'active');`,
+		`INSERT INTO "users" ("id", "external_id", "name", "email", "login_count", "avatar_url", "last_login_at", "created_at", "updated_at", "github_id", "github_username", "deleted_at", "status") VALUES
+		('00000001-0000-0000-0000-000000000003', NULL, 'Security', 'security@email.com', 0, NULL, NULL, now(), now(), NULL, NULL, NULL, 'active');`,
+
+		// groups
+		`INSERT INTO groups (id, name, slug, description, note, created_at, updated_at)
+		VALUES ('00000002-0000-0000-0000-000000000001', 'Responders', 'responders', 'responders', '', now(), now());`,
+		`INSERT INTO groups (id, name, slug, description, note, created_at, updated_at)
+		VALUES ('00000002-0000-0000-0000-000000000002', 'On Call', 'on-call', 'on-call', '', now(), now());`,
+		`INSERT INTO groups (id, name, slug, description, note, created_at, updated_at, break_glass_at)
+		VALUES ('00000002-0000-0000-0000-000000000003', 'Database', 'database', 'database', '', now(), now(), now());`,
+		`INSERT INTO groups (id, name, slug, description, note, created_at, updated_at, break_glass_at)
+		VALUES ('00000002-0000-0000-0000-000000000004', 'Network', 'network', 'network', '', now(), now(), now());`,
+		`INSERT INTO groups (id, name, slug, description, note, created_at, updated_at)
+		VALUES ('00000002-0000-0000-0000-000000000005', 'Billing', 'billing', 'billing', '', now(), now());`,
+		`INSERT INTO groups (id, name, slug, description, note, created_at, updated_at)
+		VALUES ('00000002-0000-0000-0000-000000000006', 'Security', 'security', 'security', '', now(), now());`,
+
+		// group hierarchies
+		// 		on-call -> responders
+		`INSERT INTO group_hierarchies (id, parent_group_id, member_group_id, created_at, updated_at)
+		VALUES ('00000004-0000-0000-0000-000000000001', '00000002-0000-0000-0000-000000000001', '00000002-0000-0000-0000-000000000002', now(), now());`,
+
+		// group members
+		// 		responder -> on-call, an indirect member of responders
+		`INSERT INTO group_memberships (id, group_id, user_id, is_admin, created_at, updated_at)
+		VALUES ('00000003-0000-0000-0000-000000000001', '00000002-0000-0000-0000-000000000002', '00000001-0000-0000-0000-000000000001', false, now(), now());`,
+		// 		security -> security
+		`INSERT INTO group_memberships (id, group_id, user_id, is_admin, created_at, updated_at)
+		VALUES ('00000003-0000-0000-0000-000000000002', '00000002-0000-0000-0000-000000000006', '00000001-0000-0000-0000-000000000003', false, now(), now());`,
+
+		// notifications
+		`INSERT INTO notification_types (id, name, slug, description, default_enabled)
+		VALUES ('00000005-0000-0000-0000-000000000001', 'Break Glass', 'break-glass', 'break-glass', true);`,
+		`INSERT INTO notification_targets (id, name, slug, description, default_enabled)
+		VALUES ('00000006-0000-0000-0000-000000000001', 'Slack', 'slack', 'slack', true);`,
+		`REFRESH MATERIALIZED VIEW notification_defaults;`,
+	}
+
+	for _, q := range testData {
+		_, err := s.db.Query(q)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *BreakGlassTestSuite) SetupSuite() {
+	ts, err := testserver.NewTestServer()
+	if err != nil {
+		panic(err)
+	}
+
+	s.db, err = sql.Open("postgres", ts.PGURL().String())
+	if err != nil {
+		panic(err)
+	}
+
+	goose.SetBaseFS(dbm.Migrations)
+
+	if err := goose.Up(s.db, "migrations"); err != nil {
+		panic("migration failed - could not set up test db")
+	}
+
+	if err := s.seedTestDB(); err != nil {
+		panic("db setup failed - could not seed test db: " + err.Error())
+	}
+
+	s.svc = New(WithDB(sqlx.NewDb(s.db, "postgres")))
+
+	s.responder = &models.User{ID: "00000001-0000-0000-0000-000000000001", Name: "Responder", Email: "responder@email.com"}
+	s.outsider = &models.User{ID: "00000001-0000-0000-0000-000000000002", Name: "Outsider", Email: "outsider@email.com"}
+}
+
+func (s *BreakGlassTestSuite) actor(u *models.User) Actor {
+	return Actor{AuditID: uuid.New().String(), User: u}
+}
+
+func (s *BreakGlassTestSuite) TestBreakGlassDenied() {
+	tt := []struct {
+		name  string
+		user  *models.User
+		group string
+		err   error
+	}{
+		{
+			name:  "not a responder",
+			user:  s.outsider,
+			group: "database",
+			err:   ErrNotBreakGlassResponder,
+		},
+		{
+			name:  "group not approved",
+			user:  s.responder,
+			group: "billing",
+			err:   ErrGroupNotBreakGlass,
+		},
+	}
+
+	for _, tc := range tt {
+		s.T().Run(tc.name, func(_ *testing.T) {
+			ctx := context.Background()
+
+			membership, event, err := s.svc.BreakGlass(ctx, s.actor(tc.user), "responders", tc.group, BreakGlassParams{IncidentRef: "INC-1"})
+			s.Assert().ErrorIs(err, tc.err)
+			s.Assert().Nil(membership)
+
+			// the denied attempt is audited
+			if s.Assert().NotNil(event) {
+				stored, err := models.FindAuditEvent(ctx, s.db, event.ID)
+				s.Require().NoError(err)
+				s.Assert().Equal("group.member.break_glass.denied", stored.Action)
+				s.Assert().Equal(tc.user.ID, stored.SubjectUserID.String)
+			}
+
+			group, err := s.svc.FindGroup(ctx, tc.group, false)
+			s.Require().NoError(err)
+
+			exists, err := models.GroupMemberships(
+				qm.Where("group_id = ?", group.ID),
+				qm.And("user_id = ?", tc.user.ID),
+			).Exists(ctx, s.db)
+			s.Require().NoError(err)
+			s.Assert().False(exists)
+		})
+	}
+}
+
+func (s *BreakGlassTestSuite) TestBreakGlassAccess() {
+	ctx := context.Background()
+
+	membership, event, err := s.svc.BreakGlass(ctx, s.actor(s.responder), "responders", "database", BreakGlassParams{IncidentRef: " INC-42 "})
+	s.Require().NoError(err)
+	s.Require().NotNil(event)
+
+	s.Assert().Equal("group.member.break_glass", event.Action)
+	s.Assert().Equal(events.MembershipSourceBreakGlass, membership.Source)
+	s.Assert().Equal("INC-42", membership.SourceRef.String)
+	s.Assert().WithinDuration(time.Now().Add(DefaultBreakGlassDuration), membership.ExpiresAt.Time, time.Minute)
+
+	// the removal is scheduled at expiry, with the break-glass event as its parent
+	removal, err := models.ScheduledMembershipChanges(
+		qm.Where("group_id = ?", membership.GroupID),
+		qm.And("user_id = ?", s.responder.ID),
+	).One(ctx, s.db)
+	s.Require().NoError(err)
+	s.Assert().Equal(ScheduledChangeActionRemove, removal.Action)
+	s.Assert().Equal(ScheduledChangeStatusPending, removal.Status)
+	s.Assert().True(removal.ScheduledFor.Equal(membership.ExpiresAt.Time))
+	s.Assert().Equal(event.ID, removal.AuditID.String)
+
+	created, err := models.AuditEvents(
+		qm.Where("parent_id = ?", event.ID),
+		qm.And("action = ?", "group.member.added"),
+	).Exists(ctx, s.db)
+	s.Require().NoError(err)
+	s.Assert().True(created)
+
+	// the responder is already a member of the group
+	_, _, err = s.svc.BreakGlass(ctx, s.actor(s.responder), "responders", "database", BreakGlassParams{IncidentRef: "INC-43"})
+	s.Assert().ErrorIs(err, ErrUserAlreadyMember)
+}
+
+func (s *BreakGlassTestSuite) TestNotifyBreakGlass() {
+	ctx := context.Background()
+
+	membership, event, err := s.svc.BreakGlass(ctx, s.actor(s.responder), "responders", "network", BreakGlassParams{IncidentRef: "INC-44"})
+	s.Require().NoError(err)
+
+	notified, err := s.svc.NotifyBreakGlass(ctx, "security", "break-glass", membership, event)
+	s.Require().NoError(err)
+	s.Assert().Equal(1, notified)
+
+	dispatches, err := models.NotificationDispatches(qm.Where("user_id = ?", "00000001-0000-0000-0000-000000000003")).All(ctx, s.db)
+	s.Require().NoError(err)
+
+	if s.Assert().Len(dispatches, 1) {
+		s.Assert().Contains(string(dispatches[0].Payload.JSON), event.ID)
+		s.Assert().Contains(string(dispatches[0].Payload.JSON), "INC-44")
+	}
+}
+
+func TestBreakGlassTestSuite(t *testing.T) {
+	suite.Run(t, new(BreakGlassTestSuite))
+}
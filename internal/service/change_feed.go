@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// ChangeFeedSettleDelay is how old the audit events must be to be listed in
+// the change feed. The events are ordered by their creation time, which is
+// set before their transaction commits, so the latest ones could be followed
+// by an older one committed later and be skipped by a consumer moving past
+// them.
+const ChangeFeedSettleDelay = 5 * time.Second
+
+// changeFeedExcludedActions are the audit actions that don't change the
+// governor state, like the denied or rejected attempts, and aren't listed in
+// the change feed
+var changeFeedExcludedActions = []interface{}{
+	"backup.created",
+	"extension.credential.used",
+	"group.change.denied",
+	"group.protection.violated",
+	"network_policy.denied",
+	"notification.broadcast",
+}
+
+// ChangeCursor is the position of a consumer in the change feed, the last
+// audit event it got
+type ChangeCursor struct {
+	CreatedAt time.Time
+	AuditID   string
+}
+
+// Changes returns up to limit audit events of the governor state changes
+// after the cursor, or from the first one when it's nil, ordered by their
+// creation time and id. The events of the last ChangeFeedSettleDelay aren't
+// listed yet.
+func (s *Service) Changes(ctx context.Context, after *ChangeCursor, limit int) (models.AuditEventSlice, error) {
+	mods := []qm.QueryMod{
+		qm.WhereNotIn("action NOT IN ?", changeFeedExcludedActions...),
+		qm.And("created_at <= ?", time.Now().Add(-ChangeFeedSettleDelay)),
+		qm.OrderBy("created_at, id"),
+		qm.Limit(limit),
+	}
+
+	if after != nil {
+		mods = append(mods, qm.And("(created_at, id) > (?, ?)", after.CreatedAt, after.AuditID))
+	}
+
+	changes, err := models.AuditEvents(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error listing changes: %w", err)
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// ListDeletedRecords returns the soft deleted records of a kind, with who
+// deleted them when it was audited
+func (s *Service) ListDeletedRecords(ctx context.Context, kind string) ([]*dbtools.DeletedRecord, error) {
+	records, err := dbtools.GetDeletedRecords(ctx, s.db, kind)
+	if err != nil {
+		return nil, fmt.Errorf("error listing deleted %s: %w", kind, err)
+	}
+
+	return records, nil
+}
+
+// PurgeDeletedRecords permanently removes the soft deleted records of a kind
+// with the ids. Every record must have been deleted for longer than the
+// retention, otherwise nothing is purged. Each purge is audited and the audit
+// events keep the ids of the purged records.
+func (s *Service) PurgeDeletedRecords(
+	ctx context.Context, actor Actor, kind string, ids []string, retention time.Duration,
+) ([]*dbtools.DeletedRecord, []*models.AuditEvent, error) {
+	records, err := dbtools.GetDeletedRecords(ctx, s.db, kind, ids...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting deleted %s: %w", kind, err)
+	}
+
+	if err := checkPurgeable(records, ids, retention, time.Now()); err != nil {
+		return nil, nil, err
+	}
+
+	auditEvents := make([]*models.AuditEvent, 0, len(records))
+
+	if err := s.withTx(ctx, func(tx *sql.Tx) error {
+		for _, r := range records {
+			if err := dbtools.PurgeDeletedRecord(ctx, tx, kind, r.ID); err != nil {
+				return err
+			}
+
+			event, err := dbtools.AuditDeletedRecordPurged(ctx, tx, actor.AuditID, actor.User, kind, r)
+			if err != nil {
+				return fmt.Errorf("error purging deleted record (audit): %w", err)
+			}
+
+			auditEvents = append(auditEvents, event)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	// the indexes of the resource definitions of the purged extensions are
+	// dropped once they are removed, it is a schema change so it can't share
+	// the transaction
+	if kind == dbtools.DeletedExtensions {
+		s.SyncExtensionResourceIndexes(ctx)
+	}
+
+	return records, auditEvents, nil
+}
+
+// checkPurgeable returns an error unless a deleted record was found for each
+// id and all of them were deleted before the retention period
+func checkPurgeable(records []*dbtools.DeletedRecord, ids []string, retention time.Duration, now time.Time) error {
+	found := make(map[string]*dbtools.DeletedRecord, len(records))
+	for _, r := range records {
+		found[r.ID] = r
+	}
+
+	for _, id := range ids {
+		r, ok := found[id]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrDeletedRecordNotFound, id)
+		}
+
+		if r.DeletedAt.After(now.Add(-retention)) {
+			return fmt.Errorf("%w: %s can be purged after %s", ErrPurgeRetention, id, r.DeletedAt.Add(retention).UTC().Format(time.RFC3339))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+)
+
+func TestCheckPurgeable(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	retention := 30 * 24 * time.Hour
+
+	records := []*dbtools.DeletedRecord{
+		{ID: "old", DeletedAt: now.Add(-60 * 24 * time.Hour)},
+		{ID: "recent", DeletedAt: now.Add(-24 * time.Hour)},
+	}
+
+	tests := []struct {
+		name      string
+		ids       []string
+		retention time.Duration
+		wantErr   error
+	}{
+		{
+			name:      "past retention",
+			ids:       []string{"old"},
+			retention: retention,
+		},
+		{
+			name:      "duplicate ids",
+			ids:       []string{"old", "old"},
+			retention: retention,
+		},
+		{
+			name:      "within retention",
+			ids:       []string{"old", "recent"},
+			retention: retention,
+			wantErr:   ErrPurgeRetention,
+		},
+		{
+			name: "no retention",
+			ids:  []string{"old", "recent"},
+		},
+		{
+			name:      "not deleted",
+			ids:       []string{"old", "active"},
+			retention: retention,
+			wantErr:   ErrDeletedRecordNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkPurgeable(records, tt.ids, tt.retention, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// MembershipChanges are the direct memberships changed since a watermark
+type MembershipChanges struct {
+	// Changed are the memberships added or updated, with their group and user loaded
+	Changed models.GroupMembershipSlice
+	// Removed are the tombstones of the memberships removed, the memberships
+	// themselves are hard deleted
+	Removed models.RelationshipTombstoneSlice
+}
+
+// MembershipChangesSince returns the direct memberships added, updated or
+// removed after since. ErrSyncWatermarkExpired is returned when the
+// tombstones since then may already be purged with the retention.
+func (s *Service) MembershipChangesSince(ctx context.Context, since time.Time, retention time.Duration) (*MembershipChanges, error) {
+	removed, err := s.RelationshipTombstonesSince(ctx, dbtools.TombstoneKindGroupMembership, since, retention)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := models.GroupMemberships(
+		qm.Load(models.GroupMembershipRels.Group),
+		qm.Load(models.GroupMembershipRels.User),
+		qm.InnerJoin("groups ON groups.id = group_memberships.group_id AND groups.deleted_at IS NULL"),
+		qm.Where("group_memberships.updated_at > ?", since),
+		qm.OrderBy("group_memberships.updated_at"),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting changed memberships: %w", err)
+	}
+
+	return &MembershipChanges{Changed: changed, Removed: removed}, nil
+}
@@ -0,0 +1,4 @@
+// Package service implements governor's business logic independently of the
+// transport. The http api versions (and any other front-end) call into the
+// service rather than querying the database directly, so they stay in sync.
+package service
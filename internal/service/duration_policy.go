@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/durationpolicy"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// DurationPolicy returns the membership duration policy of a group. The
+// group's own policy overrides the policies of its organizations, otherwise
+// the strictest organization policy applies. It returns nil when the group
+// has no policy.
+func (s *Service) DurationPolicy(ctx context.Context, exec boil.ContextExecutor, group *models.Group) (*durationpolicy.Policy, error) {
+	policy, err := models.MembershipDurationPolicies(qm.Where("group_id = ?", group.ID)).One(ctx, exec)
+	if err == nil {
+		return &durationpolicy.Policy{Scope: "group", MaxDays: policy.MaxDays, Mode: policy.Mode}, nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("error getting group membership duration policy: %w", err)
+	}
+
+	orgPolicies, err := models.MembershipDurationPolicies(
+		qm.Where("organization_id IN (SELECT organization_id FROM group_organizations WHERE group_id = ?)", group.ID),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("error getting organization membership duration policies: %w", err)
+	}
+
+	policies := make([]durationpolicy.Policy, len(orgPolicies))
+	for i, p := range orgPolicies {
+		policies[i] = durationpolicy.Policy{Scope: "organization", MaxDays: p.MaxDays, Mode: p.Mode}
+	}
+
+	return durationpolicy.Strictest(policies...), nil
+}
+
+// CheckMembershipDuration returns ErrMembershipDurationExceeded when a new
+// membership of the group expiring at expiresAt breaks a reject membership
+// duration policy. Clamp policies are applied when the membership is created.
+func (s *Service) CheckMembershipDuration(ctx context.Context, group *models.Group, expiresAt null.Time) error {
+	_, _, err := s.newMembershipExpiresAt(ctx, s.db, group, expiresAt)
+
+	return err
+}
+
+// newMembershipExpiresAt returns the expiration of a new membership of the
+// group after applying the group's default membership ttl and membership
+// duration policy, and the policy decision for the audit events.
+func (s *Service) newMembershipExpiresAt(ctx context.Context, exec boil.ContextExecutor, group *models.Group, expiresAt null.Time) (null.Time, string, error) {
+	expiresAt = defaultExpiresAt(group, expiresAt)
+
+	policy, err := s.DurationPolicy(ctx, exec, group)
+	if err != nil {
+		return expiresAt, "", err
+	}
+
+	if policy == nil {
+		return expiresAt, "", nil
+	}
+
+	expiresAt, decision, err := policy.Apply(expiresAt, time.Now())
+	if err != nil {
+		return expiresAt, "", fmt.Errorf("%w: %s", ErrMembershipDurationExceeded, err.Error())
+	}
+
+	return expiresAt, decision, nil
+}
@@ -0,0 +1,108 @@
+package service
+
+import "errors"
+
+var (
+	// ErrGroupNotFound is returned when a group is not found
+	ErrGroupNotFound = errors.New("group does not exist")
+	// ErrUserNotFound is returned when a user is not found
+	ErrUserNotFound = errors.New("user does not exist")
+	// ErrUserAlreadyMember is returned when adding a user that is already a direct member of the group
+	ErrUserAlreadyMember = errors.New("user already in group")
+	// ErrUserAlreadyAdmin is returned when promoting a user that is already a group admin
+	ErrUserAlreadyAdmin = errors.New("user already an admin")
+	// ErrMembershipNotFound is returned when the user isn't a direct member of the group
+	ErrMembershipNotFound = errors.New("user not in group (or not a direct member)")
+	// ErrRequestNotFound is returned when a group membership request is not found
+	ErrRequestNotFound = errors.New("group request does not exist")
+	// ErrRequestGroupMismatch is returned when a request doesn't belong to the given group
+	ErrRequestGroupMismatch = errors.New("request not associated with this group")
+	// ErrRequestingUserNotFound is returned when the user that made a request no longer exists
+	ErrRequestingUserNotFound = errors.New("requesting user not found")
+	// ErrOwnRequest is returned when an actor tries to process their own request
+	ErrOwnRequest = errors.New("unable to approve/deny own request")
+	// ErrInvalidRequestAction is returned when a request action is neither approve nor deny
+	ErrInvalidRequestAction = errors.New("invalid action")
+	// ErrExtensionNotFound is returned when an extension is not found
+	ErrExtensionNotFound = errors.New("extension does not exist")
+	// ErrERDNotFound is returned when an extension resource definition is not found
+	ErrERDNotFound = errors.New("ERD does not exist")
+	// ErrExtensionDisabled is returned when the extension or the resource definition is disabled
+	ErrExtensionDisabled = errors.New("extension or ERD is disabled")
+	// ErrERDScopeMismatch is returned when a resource definition doesn't have the requested scope
+	ErrERDScopeMismatch = errors.New("ERD scope mismatch")
+	// ErrUserAlreadyAnonymized is returned when anonymizing a user that is already anonymized
+	ErrUserAlreadyAnonymized = errors.New("user is already anonymized")
+	// ErrUserActive is returned when anonymizing an active user
+	ErrUserActive = errors.New("active users must be suspended or deleted before they are anonymized")
+	// ErrMergeSameUser is returned when merging a user into itself
+	ErrMergeSameUser = errors.New("unable to merge a user into itself")
+	// ErrMembershipDurationExceeded is returned when a membership expires past the maximum duration policy of the group
+	ErrMembershipDurationExceeded = errors.New("membership expiration exceeds the maximum duration policy")
+	// ErrGroupFrozen is returned when changing the memberships of a frozen group
+	ErrGroupFrozen = errors.New("group is frozen, membership changes are blocked")
+	// ErrGroupMemberLimitReached is returned when adding a member to a group that reached its member hard limit
+	ErrGroupMemberLimitReached = errors.New("group member limit reached")
+	// ErrGroupAlreadyFrozen is returned when freezing a frozen group
+	ErrGroupAlreadyFrozen = errors.New("group is already frozen")
+	// ErrGroupNotFrozen is returned when unfreezing a group that isn't frozen
+	ErrGroupNotFrozen = errors.New("group is not frozen")
+	// ErrGroupProtected is returned when deleting a protected group or detaching it from a hierarchy
+	ErrGroupProtected = errors.New("group is protected, it can't be deleted or detached")
+	// ErrGroupAlreadyProtected is returned when protecting a protected group
+	ErrGroupAlreadyProtected = errors.New("group is already protected")
+	// ErrGroupNotProtected is returned when unprotecting a group that isn't protected
+	ErrGroupNotProtected = errors.New("group is not protected")
+	// ErrGroupAlreadyBreakGlass is returned when approving a group for break-glass access twice
+	ErrGroupAlreadyBreakGlass = errors.New("group is already approved for break-glass access")
+	// ErrGroupNotBreakGlass is returned when using or removing the break-glass access of a group that isn't approved for it
+	ErrGroupNotBreakGlass = errors.New("group is not approved for break-glass access")
+	// ErrNotBreakGlassResponder is returned when a user who isn't a break-glass responder requests break-glass access
+	ErrNotBreakGlassResponder = errors.New("user is not a break-glass responder")
+	// ErrInvalidBreakGlassAccess is returned when break-glass access has no incident reference or an invalid expiry
+	ErrInvalidBreakGlassAccess = errors.New("invalid break-glass access")
+	// ErrInvalidMembershipExemption is returned when a membership exemption has no justification or an invalid expiry
+	ErrInvalidMembershipExemption = errors.New("invalid membership exemption")
+	// ErrMembershipNotExempt is returned when removing the exemption of a membership that isn't exempt
+	ErrMembershipNotExempt = errors.New("membership is not exempt")
+	// ErrJustificationRequired is returned when adding or requesting a membership without the justification the group requires
+	ErrJustificationRequired = errors.New("membership justification required")
+	// ErrInvalidScheduledChange is returned when scheduling a membership change with invalid parameters
+	ErrInvalidScheduledChange = errors.New("invalid scheduled membership change")
+	// ErrScheduledChangeNotFound is returned when a scheduled membership change is not found
+	ErrScheduledChangeNotFound = errors.New("scheduled membership change does not exist")
+	// ErrScheduledChangeNotPending is returned when canceling a scheduled membership change that was applied or canceled
+	ErrScheduledChangeNotPending = errors.New("scheduled membership change is not pending")
+	// ErrNotificationTypeNotFound is returned when a notification type is not found
+	ErrNotificationTypeNotFound = errors.New("notification type does not exist")
+	// ErrNotificationNotDeliverable is returned when dispatching a notification none of the targets of the user accept
+	ErrNotificationNotDeliverable = errors.New("notification is muted or disabled on every target of the user")
+	// ErrNotificationDispatchNotFound is returned when a notification dispatch is not found
+	ErrNotificationDispatchNotFound = errors.New("notification dispatch does not exist")
+	// ErrNotificationDispatchClosed is returned when acknowledging a dispatch that was already delivered or failed
+	ErrNotificationDispatchClosed = errors.New("notification dispatch is already delivered or failed")
+	// ErrNotificationTargetNotAttempted is returned when acknowledging a dispatch on a target it wasn't sent to
+	ErrNotificationTargetNotAttempted = errors.New("notification dispatch was not sent to this target")
+	// ErrNotificationBroadcastThrottled is returned when an actor sends broadcasts too often
+	ErrNotificationBroadcastThrottled = errors.New("a notification broadcast was sent recently, try again later")
+	// ErrAdminPromotionRequestThrottled is returned when a user requests an admin promotion too soon after a denial
+	ErrAdminPromotionRequestThrottled = errors.New("an admin promotion request was denied recently, try again later")
+	// ErrRequestExists is returned when the user already has an identical pending request
+	ErrRequestExists = errors.New("user already requested access to the group")
+	// ErrAuditEventNotFound is returned when an audit event is not found
+	ErrAuditEventNotFound = errors.New("audit event does not exist")
+	// ErrEventConsumerNotFound is returned when an event consumer is not found
+	ErrEventConsumerNotFound = errors.New("event consumer does not exist")
+	// ErrDeletedRecordNotFound is returned when purging a record that doesn't exist or isn't soft deleted
+	ErrDeletedRecordNotFound = errors.New("deleted record does not exist")
+	// ErrPurgeRetention is returned when purging a record deleted within the retention period
+	ErrPurgeRetention = errors.New("deleted record is still within the retention period")
+	// ErrGetDeletedBySlug is returned when a deleted resource is requested by slug
+	ErrGetDeletedBySlug = errors.New("unable to get deleted resource by slug, use the id")
+	// ErrSyncWatermarkExpired is returned when the tombstones since a sync watermark may already be purged
+	ErrSyncWatermarkExpired = errors.New("updated_since is older than the tombstone retention, a full sync is required")
+	// ErrEventNotPublished is returned when a change was committed but its
+	// events couldn't be published right away, the events were queued in the
+	// outbox when the error is eventbus.ErrQueued as well
+	ErrEventNotPublished = errors.New("event not published")
+)
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// EventConsumerLag is how far an event consumer is behind the governor changes
+type EventConsumerLag struct {
+	Consumer *models.EventConsumer
+	// Behind is the number of audit events recorded after the last one the
+	// consumer processed, all of them when it never reported one
+	Behind int64
+	// Lag is how long after the last audit event the consumer processed the
+	// latest audit event was recorded, zero when it caught up
+	Lag time.Duration
+}
+
+// ReportEventConsumerPosition records the audit event a downstream consumer,
+// like the okta or github sync, processed last. The consumer is created on its
+// first report. No audit event is recorded for the report, the consumer
+// couldn't catch up otherwise.
+func (s *Service) ReportEventConsumerPosition(ctx context.Context, name, auditID string) (*models.EventConsumer, error) {
+	event, err := models.AuditEvents(
+		qm.Select(models.AuditEventColumns.ID, models.AuditEventColumns.CreatedAt),
+		qm.Where("id = ?", auditID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAuditEventNotFound
+		}
+
+		return nil, fmt.Errorf("error getting audit event: %w", err)
+	}
+
+	consumer := &models.EventConsumer{
+		Name:        name,
+		LastAuditID: null.StringFrom(event.ID),
+		LastAuditAt: null.TimeFrom(event.CreatedAt),
+		ReportedAt:  null.TimeFrom(time.Now().UTC()),
+	}
+
+	if err := consumer.Upsert(
+		ctx,
+		s.db,
+		true,
+		[]string{models.EventConsumerColumns.Name},
+		boil.Whitelist(
+			models.EventConsumerColumns.LastAuditID,
+			models.EventConsumerColumns.LastAuditAt,
+			models.EventConsumerColumns.ReportedAt,
+			models.EventConsumerColumns.UpdatedAt,
+		),
+		boil.Infer(),
+	); err != nil {
+		return nil, fmt.Errorf("error recording event consumer position: %w", err)
+	}
+
+	return consumer, nil
+}
+
+// DeleteEventConsumer stops tracking the lag of a decommissioned event consumer
+func (s *Service) DeleteEventConsumer(ctx context.Context, name string) error {
+	rows, err := models.EventConsumers(qm.Where("name = ?", name)).DeleteAll(ctx, s.db)
+	if err != nil {
+		return fmt.Errorf("error deleting event consumer: %w", err)
+	}
+
+	if rows == 0 {
+		return ErrEventConsumerNotFound
+	}
+
+	return nil
+}
+
+// EventConsumerLags returns the lag of every event consumer, ordered by name
+func (s *Service) EventConsumerLags(ctx context.Context) ([]EventConsumerLag, error) {
+	consumers, err := models.EventConsumers(qm.OrderBy("name")).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error listing event consumers: %w", err)
+	}
+
+	if len(consumers) == 0 {
+		return []EventConsumerLag{}, nil
+	}
+
+	latest, err := models.AuditEvents(
+		qm.Select(models.AuditEventColumns.CreatedAt),
+		qm.OrderBy("created_at DESC"),
+	).One(ctx, s.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("error getting latest audit event: %w", err)
+	}
+
+	lags := make([]EventConsumerLag, len(consumers))
+
+	for i, consumer := range consumers {
+		lags[i].Consumer = consumer
+
+		if latest == nil {
+			continue
+		}
+
+		mods := []qm.QueryMod{}
+		if consumer.LastAuditAt.Valid {
+			mods = append(mods, qm.Where("created_at > ?", consumer.LastAuditAt.Time))
+		}
+
+		if lags[i].Behind, err = models.AuditEvents(mods...).Count(ctx, s.db); err != nil {
+			return nil, fmt.Errorf("error counting audit events behind event consumer: %w", err)
+		}
+
+		lags[i].Lag = consumerLag(consumer, latest.CreatedAt)
+	}
+
+	return lags, nil
+}
+
+// consumerLag returns how long after the last audit event the consumer
+// processed the latest one was recorded
+func consumerLag(consumer *models.EventConsumer, latest time.Time) time.Duration {
+	if !consumer.LastAuditAt.Valid || !latest.After(consumer.LastAuditAt.Time) {
+		return 0
+	}
+
+	return latest.Sub(consumer.LastAuditAt.Time)
+}
+
+// eventConsumerMetricsTimeout is how long collecting the event consumer metrics can take
+const eventConsumerMetricsTimeout = 10 * time.Second
+
+// EventConsumerCollector exports the lag of the event consumers as prometheus metrics
+type EventConsumerCollector struct {
+	svc    *Service
+	lag    *prometheus.Desc
+	behind *prometheus.Desc
+	age    *prometheus.Desc
+}
+
+// NewEventConsumerCollector returns a prometheus collector of the event consumer lags
+func NewEventConsumerCollector(svc *Service) *EventConsumerCollector {
+	return &EventConsumerCollector{
+		svc: svc,
+		lag: prometheus.NewDesc(
+			"governor_event_consumer_lag_seconds",
+			"How long after the last audit event processed by the consumer the latest audit event was recorded.",
+			[]string{"consumer"}, nil,
+		),
+		behind: prometheus.NewDesc(
+			"governor_event_consumer_behind_events",
+			"Number of audit events recorded after the last one processed by the consumer.",
+			[]string{"consumer"}, nil,
+		),
+		age: prometheus.NewDesc(
+			"governor_event_consumer_last_report_age_seconds",
+			"Time since the consumer last reported its position.",
+			[]string{"consumer"}, nil,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *EventConsumerCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.lag
+	ch <- c.behind
+	ch <- c.age
+}
+
+// Collect implements prometheus.Collector
+func (c *EventConsumerCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), eventConsumerMetricsTimeout)
+	defer cancel()
+
+	lags, err := c.svc.EventConsumerLags(ctx)
+	if err != nil {
+		c.svc.logger.Warn("error collecting event consumer metrics", zap.Error(err))
+		return
+	}
+
+	for _, l := range lags {
+		ch <- prometheus.MustNewConstMetric(c.lag, prometheus.GaugeValue, l.Lag.Seconds(), l.Consumer.Name)
+		ch <- prometheus.MustNewConstMetric(c.behind, prometheus.GaugeValue, float64(l.Behind), l.Consumer.Name)
+
+		if l.Consumer.ReportedAt.Valid {
+			ch <- prometheus.MustNewConstMetric(c.age, prometheus.GaugeValue, time.Since(l.Consumer.ReportedAt.Time).Seconds(), l.Consumer.Name)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestConsumerLag(t *testing.T) {
+	now := time.Now()
+
+	assert.Equal(t, time.Duration(0), consumerLag(&models.EventConsumer{}, now))
+	assert.Equal(t, time.Duration(0), consumerLag(&models.EventConsumer{LastAuditAt: null.TimeFrom(now)}, now))
+	assert.Equal(t, time.Minute, consumerLag(&models.EventConsumer{LastAuditAt: null.TimeFrom(now.Add(-time.Minute))}, now))
+}
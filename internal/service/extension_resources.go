@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/erdstate"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const erdScopeSystem = "system"
+
+// FindERD returns an enabled extension resource definition by its extension
+// slug, plural slug and version
+func (s *Service) FindERD(ctx context.Context, extensionSlug, erdSlugPlural, erdVersion string) (*models.ExtensionResourceDefinition, error) {
+	extension, err := models.Extensions(
+		qm.Where("slug = ?", extensionSlug),
+		qm.Load(
+			models.ExtensionRels.ExtensionResourceDefinitions,
+			qm.Where("slug_plural = ?", erdSlugPlural),
+			qm.Where("version = ?", erdVersion),
+		),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrExtensionNotFound
+		}
+
+		return nil, fmt.Errorf("error finding extension: %w", err)
+	}
+
+	if len(extension.R.ExtensionResourceDefinitions) < 1 {
+		return nil, ErrERDNotFound
+	}
+
+	erd := extension.R.ExtensionResourceDefinitions[0]
+
+	if !extension.Enabled {
+		return nil, ErrExtensionDisabled
+	}
+
+	if err := erdstate.State(erd.State).Allows(erdstate.Read); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrExtensionDisabled, err)
+	}
+
+	return erd, nil
+}
+
+// ListSystemExtensionResources returns the resources of a system scoped
+// extension resource definition. The filters match top level fields of the
+// resources.
+func (s *Service) ListSystemExtensionResources(
+	ctx context.Context, extensionSlug, erdSlugPlural, erdVersion string, filters map[string]string, deleted bool,
+) (models.SystemExtensionResourceSlice, error) {
+	erd, err := s.FindERD(ctx, extensionSlug, erdSlugPlural, erdVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if erd.Scope != erdScopeSystem {
+		return nil, fmt.Errorf("%w: cannot list system resources for %s scoped %s/%s", ErrERDScopeMismatch, erd.Scope, erd.SlugSingular, erd.Version)
+	}
+
+	mods := dbtools.ExtensionResourceFieldFilters(erd, filters)
+
+	if deleted {
+		mods = append(mods, qm.WithDeleted())
+	}
+
+	resources, err := erd.SystemExtensionResources(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error finding extension resources: %w", err)
+	}
+
+	return resources, nil
+}
+
+// SyncExtensionResourceIndexes creates the missing indexes of the ERD
+// resources and drops the ones of the deleted and purged ERDs. The ERDs work
+// without their indexes, so the errors are only logged.
+func (s *Service) SyncExtensionResourceIndexes(ctx context.Context) {
+	dropped, err := dbtools.SyncExtensionResourceIndexes(ctx, s.db)
+	if err != nil {
+		s.logger.Warn("error syncing the extension resource indexes", zap.Int("dropped", dropped), zap.Error(err))
+		return
+	}
+
+	if dropped > 0 {
+		s.logger.Info("dropped extension resource indexes", zap.Int("dropped", dropped))
+	}
+}
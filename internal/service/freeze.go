@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// FreezeGroup freezes a group, blocking the membership changes and request
+// approvals of everyone but governor admins until the group is unfrozen. The
+// audit event is returned even when publishing fails since the change is
+// already committed.
+func (s *Service) FreezeGroup(ctx context.Context, actor Actor, groupIDOrSlug, reason string) (*models.Group, *models.AuditEvent, error) {
+	group, err := s.FindGroup(ctx, groupIDOrSlug, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if group.FrozenAt.Valid {
+		return nil, nil, ErrGroupAlreadyFrozen
+	}
+
+	original := *group
+	group.FrozenAt = null.TimeFrom(time.Now().UTC())
+	group.FrozenReason = reason
+
+	event, err := s.updateGroupState(ctx, actor, &original, group, dbtools.AuditGroupFrozen,
+		models.GroupColumns.FrozenAt, models.GroupColumns.FrozenReason)
+
+	return group, event, err
+}
+
+// UnfreezeGroup unfreezes a frozen group. The audit event is returned even
+// when publishing fails since the change is already committed.
+func (s *Service) UnfreezeGroup(ctx context.Context, actor Actor, groupIDOrSlug string) (*models.Group, *models.AuditEvent, error) {
+	group, err := s.FindGroup(ctx, groupIDOrSlug, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !group.FrozenAt.Valid {
+		return nil, nil, ErrGroupNotFrozen
+	}
+
+	original := *group
+	group.FrozenAt = null.Time{}
+	group.FrozenReason = ""
+
+	event, err := s.updateGroupState(ctx, actor, &original, group, dbtools.AuditGroupUnfrozen,
+		models.GroupColumns.FrozenAt, models.GroupColumns.FrozenReason)
+
+	return group, event, err
+}
+
+type groupAuditFunc func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, g *models.Group) (*models.AuditEvent, error)
+
+// updateGroupState saves the columns of the group holding its frozen or
+// protected state, records the audit event and publishes a group update event
+func (s *Service) updateGroupState(ctx context.Context, actor Actor, original, group *models.Group, audit groupAuditFunc, columns ...string) (*models.AuditEvent, error) {
+	var event *models.AuditEvent
+
+	if err := s.withTx(ctx, func(tx *sql.Tx) error {
+		if _, err := group.Update(ctx, tx, boil.Whitelist(append(columns, models.GroupColumns.UpdatedAt)...)); err != nil {
+			return fmt.Errorf("error updating group: %w", err)
+		}
+
+		var err error
+
+		event, err = audit(ctx, tx, actor.AuditID, actor.User, original, group)
+		if err != nil {
+			return fmt.Errorf("error updating group (audit): %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.publish(ctx, events.GovernorGroupsEventSubject, &events.Event{
+		Version: events.Version,
+		Action:  events.GovernorEventUpdate,
+		AuditID: actor.AuditID,
+		ActorID: actor.ID(),
+		GroupID: group.ID,
+		Before:  original,
+		After:   group,
+	}); err != nil {
+		return event, fmt.Errorf("failed to publish group update event, downstream changes may be delayed: %w", err)
+	}
+
+	return event, nil
+}
+
+// CheckGroupChange returns ErrGroupFrozen when the actor can't change the
+// memberships of the group because it is frozen, the attempted change is
+// recorded as a denied audit event which is returned with the error.
+// Governor admins and non-user api clients can change frozen groups.
+func (s *Service) CheckGroupChange(ctx context.Context, actor Actor, group *models.Group, change string) (*models.AuditEvent, error) {
+	if !group.FrozenAt.Valid || actor.User == nil || actor.Admin {
+		return nil, nil
+	}
+
+	event, err := dbtools.AuditGroupChangeDenied(ctx, s.db, actor.AuditID, actor.User, group, change)
+	if err != nil {
+		return nil, fmt.Errorf("error recording denied group change (audit): %w", err)
+	}
+
+	return event, fmt.Errorf("%w: %s", ErrGroupFrozen, change)
+}
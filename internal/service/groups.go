@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+)
+
+// GroupDetails is a group with the ids of its related resources
+type GroupDetails struct {
+	Group              *models.Group
+	Members            []string
+	MembersDirect      []string
+	MembershipRequests []string
+	Organizations      []string
+	Applications       []string
+}
+
+// groupIDOrSlug returns the query mod to find a group by id, or by slug when
+// the given value isn't a uuid
+func groupIDOrSlug(idOrSlug string) (qm.QueryMod, bool) {
+	if _, err := uuid.Parse(idOrSlug); err != nil {
+		return qm.Where("slug = ?", idOrSlug), false
+	}
+
+	return qm.Where("id = ?", idOrSlug), true
+}
+
+// FindGroup returns the group with the given id or slug. Deleted groups can
+// only be found by id.
+func (s *Service) FindGroup(ctx context.Context, idOrSlug string, deleted bool, mods ...qm.QueryMod) (*models.Group, error) {
+	q, isID := groupIDOrSlug(idOrSlug)
+
+	if deleted {
+		if !isID {
+			return nil, ErrGetDeletedBySlug
+		}
+
+		mods = append(mods, qm.WithDeleted())
+	}
+
+	group, err := models.Groups(append(mods, q, tenancy.Scope(ctx, models.TableNames.Groups))...).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrGroupNotFound
+		}
+
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// ListGroups returns all groups ordered by name
+func (s *Service) ListGroups(ctx context.Context, deleted bool, mods ...qm.QueryMod) (models.GroupSlice, error) {
+	if deleted {
+		mods = append(mods, qm.WithDeleted())
+	}
+
+	mods = append(mods, tenancy.Scope(ctx, models.TableNames.Groups))
+
+	return models.Groups(mods...).All(ctx, s.db)
+}
+
+// CountGroups returns the number of groups matching the query mods
+func (s *Service) CountGroups(ctx context.Context, deleted bool, mods ...qm.QueryMod) (int64, error) {
+	if deleted {
+		mods = append(mods, qm.WithDeleted())
+	}
+
+	mods = append(mods, tenancy.Scope(ctx, models.TableNames.Groups))
+
+	return models.Groups(mods...).Count(ctx, s.db)
+}
+
+// GetGroup returns a group with its members, membership requests, organizations and applications
+func (s *Service) GetGroup(ctx context.Context, idOrSlug string, deleted bool) (*GroupDetails, error) {
+	group, err := s.FindGroup(ctx, idOrSlug, deleted,
+		qm.Load("GroupMembershipRequests"),
+		qm.Load("GroupMembershipRequests.User"),
+		qm.Load("GroupOrganizations"),
+		qm.Load("GroupOrganizations.Organization"),
+		qm.Load("GroupApplications"),
+		qm.Load("GroupApplications.Application"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	enumeratedMembers, err := dbtools.GetMembersOfGroup(ctx, s.db.DB, group.ID, false)
+	if err != nil {
+		return nil, fmt.Errorf("error enumerating group membership: %w", err)
+	}
+
+	details := &GroupDetails{
+		Group:              group,
+		Members:            make([]string, len(enumeratedMembers)),
+		MembersDirect:      make([]string, 0),
+		MembershipRequests: make([]string, len(group.R.GroupMembershipRequests)),
+		Organizations:      make([]string, len(group.R.GroupOrganizations)),
+		Applications:       make([]string, len(group.R.GroupApplications)),
+	}
+
+	for i, m := range enumeratedMembers {
+		details.Members[i] = m.UserID
+
+		if m.Direct {
+			details.MembersDirect = append(details.MembersDirect, m.UserID)
+		}
+	}
+
+	for i, r := range group.R.GroupMembershipRequests {
+		details.MembershipRequests[i] = r.R.User.ID
+	}
+
+	for i, o := range group.R.GroupOrganizations {
+		details.Organizations[i] = o.R.Organization.ID
+	}
+
+	for i, a := range group.R.GroupApplications {
+		details.Applications[i] = a.R.Application.ID
+	}
+
+	return details, nil
+}
+
+// ListGroupMembers returns the direct and indirect members of a group with the user models populated
+func (s *Service) ListGroupMembers(ctx context.Context, idOrSlug string) (*models.Group, []dbtools.EnumeratedMembership, error) {
+	group, err := s.FindGroup(ctx, idOrSlug, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	members, err := dbtools.GetMembersOfGroup(ctx, s.db.DB, group.ID, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error enumerating group membership: %w", err)
+	}
+
+	return group, members, nil
+}
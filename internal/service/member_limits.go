@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// checkMemberHardLimit returns the number of direct members of the group, and
+// ErrGroupMemberLimitReached when the group can't get another member because
+// of its hard limit
+func checkMemberHardLimit(ctx context.Context, exec boil.ContextExecutor, group *models.Group) (int64, error) {
+	members, err := models.GroupMemberships(qm.Where("group_id = ?", group.ID)).Count(ctx, exec)
+	if err != nil {
+		return 0, fmt.Errorf("error counting group members: %w", err)
+	}
+
+	if group.MemberHardLimit > 0 && members >= group.MemberHardLimit {
+		return members, fmt.Errorf("%w: the group has %d members, its limit is %d", ErrGroupMemberLimitReached, members, group.MemberHardLimit)
+	}
+
+	return members, nil
+}
+
+// warnMemberSoftLimit publishes a warning for the group admins when the group
+// has more members than its soft limit, every new member past the limit is
+// warned about
+func (s *Service) warnMemberSoftLimit(ctx context.Context, actor Actor, group *models.Group, members int64) error {
+	if group.MemberSoftLimit <= 0 || members <= group.MemberSoftLimit {
+		return nil
+	}
+
+	admins, err := models.GroupMemberships(
+		qm.Where("group_id = ?", group.ID),
+		qm.And("is_admin = true"),
+		qm.And("(admin_expires_at IS NULL OR admin_expires_at > ?)", time.Now()),
+		qm.OrderBy("user_id"),
+	).All(ctx, s.db)
+	if err != nil {
+		return fmt.Errorf("error getting group admins: %w", err)
+	}
+
+	adminIDs := make([]string, len(admins))
+	for i, a := range admins {
+		adminIDs[i] = a.UserID
+	}
+
+	if err := s.publish(ctx, events.GovernorGroupMemberLimitsEventSubject, &events.Event{
+		Version: events.Version,
+		Action:  events.GovernorEventWarn,
+		AuditID: actor.AuditID,
+		GroupID: group.ID,
+		ActorID: actor.ID(),
+		MemberLimit: &events.MemberLimit{
+			Members:   members,
+			SoftLimit: group.MemberSoftLimit,
+			HardLimit: group.MemberHardLimit,
+			AdminIDs:  adminIDs,
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to publish group member limit warning, downstream changes may be delayed: %w", err)
+	}
+
+	return nil
+}
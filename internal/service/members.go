@@ -0,0 +1,631 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+const (
+	// RequestActionApprove approves a membership request
+	RequestActionApprove = "approve"
+	// RequestActionDeny denies a membership request
+	RequestActionDeny = "deny"
+
+	requestKindNewMember      = "new_member"
+	requestKindAdminPromotion = "admin_promotion"
+)
+
+// Actor is who performs an operation, it is recorded in the audit events and
+// the published events
+type Actor struct {
+	// AuditID is the id of the parent audit event, usually the request audit id
+	AuditID string
+	// User is the governor user performing the operation, it is nil for
+	// non-user api clients
+	User *models.User
+	// Admin is true when the user is a governor admin
+	Admin bool
+}
+
+// ID returns the actor's user id, or an empty string when the actor isn't a user
+func (a Actor) ID() string {
+	if a.User == nil {
+		return ""
+	}
+
+	return a.User.ID
+}
+
+// AddMemberParams are the parameters to add a user to a group
+type AddMemberParams struct {
+	IsAdmin        bool
+	ExpiresAt      null.Time
+	AdminExpiresAt null.Time
+	// Source is how the member is added, direct when it's empty, and
+	// SourceRef identifies what added it, like an import job or a rule
+	Source    string
+	SourceRef string
+	// Justification is why the member is added, the groups requiring one
+	// only check it for direct adds
+	Justification string
+}
+
+// memberSource returns the source of a membership added with the params
+func (p AddMemberParams) memberSource() string {
+	if p.Source == "" {
+		return events.MembershipSourceDirect
+	}
+
+	return p.Source
+}
+
+// defaultExpiresAt returns the expiration of a new membership of the group,
+// the group's default membership ttl applies when none was given
+func defaultExpiresAt(group *models.Group, expiresAt null.Time) null.Time {
+	if expiresAt.Valid || group.DefaultMembershipTTLDays <= 0 {
+		return expiresAt
+	}
+
+	return null.TimeFrom(time.Now().UTC().AddDate(0, 0, int(group.DefaultMembershipTTLDays)))
+}
+
+// AddMember adds a user as a direct member of a group, records the audit
+// event and publishes a member create event for each group the user
+// effectively joins.  The audit event is returned even when publishing fails
+// since the change is already committed.
+func (s *Service) AddMember(ctx context.Context, actor Actor, groupIDOrSlug, userID string, params AddMemberParams) (*models.AuditEvent, error) {
+	group, err := s.FindGroup(ctx, groupIDOrSlug, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if event, err := s.CheckGroupChange(ctx, actor, group, "adding a member"); err != nil {
+		return event, err
+	}
+
+	if params.memberSource() == events.MembershipSourceDirect {
+		if err := CheckJustification(group, params.Justification); err != nil {
+			return nil, err
+		}
+	}
+
+	user, err := s.FindUser(ctx, userID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := models.GroupMemberships(
+		qm.Where("group_id = ?", group.ID),
+		qm.And("user_id = ?", user.ID),
+	).Exists(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error checking membership exists: %w", err)
+	}
+
+	if exists {
+		return nil, ErrUserAlreadyMember
+	}
+
+	expiresAt, decision, err := s.newMembershipExpiresAt(ctx, s.db, group, params.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	groupMem := &models.GroupMembership{
+		GroupID:        group.ID,
+		UserID:         user.ID,
+		IsAdmin:        params.IsAdmin,
+		ExpiresAt:      expiresAt,
+		AdminExpiresAt: params.AdminExpiresAt,
+		Source:         params.memberSource(),
+		SourceRef:      null.NewString(params.SourceRef, params.SourceRef != ""),
+		Justification:  strings.TrimSpace(params.Justification),
+	}
+
+	var (
+		event                               *models.AuditEvent
+		members                             int64
+		membershipsBefore, membershipsAfter []dbtools.EnumeratedMembership
+	)
+
+	if err := s.withTx(ctx, func(tx *sql.Tx) error {
+		var err error
+
+		members, err = checkMemberHardLimit(ctx, tx, group)
+		if err != nil {
+			return err
+		}
+
+		membershipsBefore, err = dbtools.GetMembershipsForUser(ctx, tx, user.ID, false)
+		if err != nil {
+			return fmt.Errorf("failed to compute new effective memberships: %w", err)
+		}
+
+		if err := groupMem.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to update group membership: %w", err)
+		}
+
+		event, err = dbtools.AuditGroupMembershipCreated(ctx, tx, actor.AuditID, actor.User, groupMem, decision)
+		if err != nil {
+			return fmt.Errorf("error creating groups membership (audit): %w", err)
+		}
+
+		membershipsAfter, err = dbtools.GetMembershipsForUser(ctx, tx, user.ID, false)
+		if err != nil {
+			return fmt.Errorf("failed to compute new effective memberships: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var perr publishErrors
+
+	// only publish events for active users
+	if isActiveUser(user) && !perr.add(s.publishMembers(ctx, actor, events.GovernorEventCreate, dbtools.FindMemberDiff(membershipsBefore, membershipsAfter))) {
+		return event, perr.err
+	}
+
+	perr.add(s.warnMemberSoftLimit(ctx, actor, group, members+1))
+
+	return event, perr.err
+}
+
+// RemoveMember removes a user's direct membership from a group, records the
+// audit event and publishes a member delete event for each group the user
+// effectively leaves.  The audit event is returned even when publishing
+// fails since the change is already committed.
+func (s *Service) RemoveMember(ctx context.Context, actor Actor, groupIDOrSlug, userID string) (*models.AuditEvent, error) {
+	group, err := s.FindGroup(ctx, groupIDOrSlug, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if event, err := s.CheckGroupChange(ctx, actor, group, "removing a member"); err != nil {
+		return event, err
+	}
+
+	user, err := s.FindUser(ctx, userID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	membership, err := models.GroupMemberships(
+		qm.Where("group_id = ?", group.ID),
+		qm.And("user_id = ?", user.ID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrMembershipNotFound
+		}
+
+		return nil, fmt.Errorf("error checking membership exists: %w", err)
+	}
+
+	var (
+		event                               *models.AuditEvent
+		membershipsBefore, membershipsAfter []dbtools.EnumeratedMembership
+	)
+
+	if err := s.withTx(ctx, func(tx *sql.Tx) error {
+		var err error
+
+		membershipsBefore, err = dbtools.GetMembershipsForUser(ctx, tx, user.ID, false)
+		if err != nil {
+			return fmt.Errorf("failed to compute new effective memberships: %w", err)
+		}
+
+		if _, err := membership.Delete(ctx, tx); err != nil {
+			return fmt.Errorf("error removing membership: %w", err)
+		}
+
+		event, err = dbtools.AuditGroupMembershipDeleted(ctx, tx, actor.AuditID, actor.User, membership)
+		if err != nil {
+			return fmt.Errorf("error deleting groups membership (audit): %w", err)
+		}
+
+		membershipsAfter, err = dbtools.GetMembershipsForUser(ctx, tx, user.ID, false)
+		if err != nil {
+			return fmt.Errorf("failed to compute new effective memberships: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// only publish events for active users
+	if !isActiveUser(user) {
+		return event, nil
+	}
+
+	if err := s.publishMembers(ctx, actor, events.GovernorEventDelete, dbtools.FindMemberDiff(membershipsAfter, membershipsBefore)); err != nil {
+		return event, err
+	}
+
+	return event, nil
+}
+
+// RemoveMemberships removes a user's direct memberships from the given groups,
+// or from every group when all is true, in a single transaction. An audit
+// event is recorded for each removed membership and a member delete event is
+// published for each group the user effectively leaves. Nothing is removed
+// when the user isn't a direct member of one of the groups. The audit events
+// are returned even when publishing fails since the change is already
+// committed.
+func (s *Service) RemoveMemberships(ctx context.Context, actor Actor, userID string, groupIDs []string, all bool) ([]*models.AuditEvent, error) {
+	user, err := s.FindUser(ctx, userID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	queryMods := []qm.QueryMod{qm.Where("user_id = ?", user.ID)}
+
+	if !all {
+		if len(groupIDs) == 0 {
+			return nil, nil
+		}
+
+		ids := make([]interface{}, len(groupIDs))
+		for i, id := range groupIDs {
+			ids[i] = id
+		}
+
+		queryMods = append(queryMods, qm.WhereIn("group_id IN ?", ids...))
+	}
+
+	memberships, err := models.GroupMemberships(queryMods...).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting memberships: %w", err)
+	}
+
+	if !all {
+		found := make(map[string]bool, len(memberships))
+		for _, m := range memberships {
+			found[m.GroupID] = true
+		}
+
+		for _, id := range groupIDs {
+			if !found[id] {
+				return nil, fmt.Errorf("%w: %s", ErrMembershipNotFound, id)
+			}
+		}
+	}
+
+	if len(memberships) == 0 {
+		return nil, nil
+	}
+
+	var (
+		auditEvents                         []*models.AuditEvent
+		membershipsBefore, membershipsAfter []dbtools.EnumeratedMembership
+	)
+
+	if err := s.withTx(ctx, func(tx *sql.Tx) error {
+		var err error
+
+		membershipsBefore, err = dbtools.GetMembershipsForUser(ctx, tx, user.ID, false)
+		if err != nil {
+			return fmt.Errorf("failed to compute new effective memberships: %w", err)
+		}
+
+		for _, m := range memberships {
+			if _, err := m.Delete(ctx, tx); err != nil {
+				return fmt.Errorf("error removing membership: %w", err)
+			}
+
+			event, err := dbtools.AuditGroupMembershipDeleted(ctx, tx, actor.AuditID, actor.User, m)
+			if err != nil {
+				return fmt.Errorf("error deleting groups membership (audit): %w", err)
+			}
+
+			auditEvents = append(auditEvents, event)
+		}
+
+		membershipsAfter, err = dbtools.GetMembershipsForUser(ctx, tx, user.ID, false)
+		if err != nil {
+			return fmt.Errorf("failed to compute new effective memberships: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// only publish events for active users
+	if !isActiveUser(user) {
+		return auditEvents, nil
+	}
+
+	if err := s.publishMembers(ctx, actor, events.GovernorEventDelete, dbtools.FindMemberDiff(membershipsAfter, membershipsBefore)); err != nil {
+		return auditEvents, err
+	}
+
+	return auditEvents, nil
+}
+
+// ProcessRequest approves or denies a group membership request.  Approving a
+// new member request adds the membership, approving an admin promotion
+// request promotes the existing member; in both cases the request is deleted.
+// Denying a request only deletes it.  The recorded audit events are returned
+// even when publishing fails since the change is already committed.
+func (s *Service) ProcessRequest(ctx context.Context, actor Actor, groupIDOrSlug, requestID, action string) ([]*models.AuditEvent, error) {
+	group, err := s.FindGroup(ctx, groupIDOrSlug, false)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := models.GroupMembershipRequests(qm.Where("id = ?", requestID)).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRequestNotFound
+		}
+
+		return nil, fmt.Errorf("error getting group request: %w", err)
+	}
+
+	if request.GroupID != group.ID {
+		return nil, ErrRequestGroupMismatch
+	}
+
+	if request.UserID == actor.ID() {
+		return nil, ErrOwnRequest
+	}
+
+	switch action {
+	case RequestActionApprove:
+		if event, err := s.CheckGroupChange(ctx, actor, group, "approving a membership request"); err != nil {
+			if event != nil {
+				return []*models.AuditEvent{event}, err
+			}
+
+			return nil, err
+		}
+
+		return s.approveRequest(ctx, actor, group, request)
+	case RequestActionDeny:
+		return s.denyRequest(ctx, actor, request)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRequestAction, action)
+	}
+}
+
+// approveRequest looks up the action to be performed, runs checks, performs
+// the appropriate approval action and finally deletes the request
+func (s *Service) approveRequest(ctx context.Context, actor Actor, group *models.Group, request *models.GroupMembershipRequest) ([]*models.AuditEvent, error) {
+	user, err := s.FindUser(ctx, request.UserID, false)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, ErrRequestingUserNotFound
+		}
+
+		return nil, err
+	}
+
+	existingMembership, err := models.GroupMemberships(
+		qm.Where("group_id = ?", request.GroupID),
+		qm.And("user_id = ?", request.UserID),
+	).One(ctx, s.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("error checking membership exists: %w", err)
+	}
+
+	// type specific checks before processing the approval, requests that are
+	// already satisfied are deleted
+	switch request.Kind {
+	case requestKindNewMember:
+		if existingMembership != nil {
+			if _, err := request.Delete(ctx, s.db); err != nil {
+				return nil, fmt.Errorf("failed to delete group request: %w", err)
+			}
+
+			return nil, ErrUserAlreadyMember
+		}
+	case requestKindAdminPromotion:
+		if existingMembership == nil {
+			return nil, ErrMembershipNotFound
+		}
+
+		if existingMembership.IsAdmin {
+			if _, err := request.Delete(ctx, s.db); err != nil {
+				return nil, fmt.Errorf("failed to delete group request: %w", err)
+			}
+
+			return nil, ErrUserAlreadyAdmin
+		}
+	}
+
+	// the default membership ttl and the membership duration policy only
+	// apply to new members, not to the existing members promoted to admin
+	expiresAt := request.ExpiresAt
+
+	var decision string
+
+	if request.Kind == requestKindNewMember {
+		expiresAt, decision, err = s.newMembershipExpiresAt(ctx, s.db, group, expiresAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	groupMem := &models.GroupMembership{
+		GroupID:        request.GroupID,
+		UserID:         request.UserID,
+		IsAdmin:        request.IsAdmin,
+		ExpiresAt:      expiresAt,
+		AdminExpiresAt: request.AdminExpiresAt,
+		Source:         events.MembershipSourceRequest,
+		SourceRef:      null.StringFrom(request.ID),
+		// the note of the request is the justification of the membership
+		Justification: strings.TrimSpace(request.Note),
+	}
+
+	var (
+		auditEvents                         []*models.AuditEvent
+		members                             int64
+		membershipsBefore, membershipsAfter []dbtools.EnumeratedMembership
+	)
+
+	if err := s.withTx(ctx, func(tx *sql.Tx) error {
+		var err error
+
+		if request.Kind == requestKindNewMember {
+			if members, err = checkMemberHardLimit(ctx, tx, group); err != nil {
+				return err
+			}
+		}
+
+		membershipsBefore, err = dbtools.GetMembershipsForUser(ctx, tx, user.ID, false)
+		if err != nil {
+			return fmt.Errorf("failed to compute new effective memberships: %w", err)
+		}
+
+		switch request.Kind {
+		case requestKindNewMember:
+			if err := groupMem.Insert(ctx, tx, boil.Infer()); err != nil {
+				return fmt.Errorf("error approving group membership request: %w", err)
+			}
+		case requestKindAdminPromotion:
+			existingMembership.IsAdmin = true
+			existingMembership.AdminExpiresAt = request.AdminExpiresAt
+
+			if _, err := existingMembership.Update(ctx, tx, boil.Infer()); err != nil {
+				return fmt.Errorf("error approving admin promotion request: %w", err)
+			}
+		}
+
+		if _, err := request.Delete(ctx, tx); err != nil {
+			return fmt.Errorf("error deleting group request on approval: %w", err)
+		}
+
+		if _, err := dbtools.ArchiveGroupMembershipRequest(ctx, tx, request, dbtools.ArchivedRequestApproved, actor.User); err != nil {
+			return fmt.Errorf("error archiving group request on approval: %w", err)
+		}
+
+		auditEvents, err = dbtools.AuditGroupMembershipApproved(ctx, tx, actor.AuditID, actor.User, groupMem, request.Kind, decision)
+		if err != nil {
+			return fmt.Errorf("error approving group request (audit): %w", err)
+		}
+
+		membershipsAfter, err = dbtools.GetMembershipsForUser(ctx, tx, user.ID, false)
+		if err != nil {
+			return fmt.Errorf("failed to compute new effective memberships: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// only publish events for active users
+	if !isActiveUser(user) {
+		return auditEvents, nil
+	}
+
+	var perr publishErrors
+
+	if err := s.publish(ctx, events.GovernorMemberRequestsEventSubject, &events.Event{
+		Version: events.Version,
+		Action:  events.GovernorEventApprove,
+		AuditID: actor.AuditID,
+		GroupID: groupMem.GroupID,
+		UserID:  groupMem.UserID,
+		ActorID: actor.ID(),
+	}); err != nil && !perr.add(fmt.Errorf("failed to publish request approve event, downstream changes may be delayed: %w", err)) {
+		return auditEvents, perr.err
+	}
+
+	if !perr.add(s.publishMembers(ctx, actor, events.GovernorEventCreate, dbtools.FindMemberDiff(membershipsBefore, membershipsAfter))) {
+		return auditEvents, perr.err
+	}
+
+	if request.Kind == requestKindNewMember {
+		perr.add(s.warnMemberSoftLimit(ctx, actor, group, members+1))
+	}
+
+	return auditEvents, perr.err
+}
+
+// denyRequest deletes the request and records the denial
+func (s *Service) denyRequest(ctx context.Context, actor Actor, request *models.GroupMembershipRequest) ([]*models.AuditEvent, error) {
+	var event *models.AuditEvent
+
+	if err := s.withTx(ctx, func(tx *sql.Tx) error {
+		if _, err := request.Delete(ctx, tx); err != nil {
+			return fmt.Errorf("failed to delete group request: %w", err)
+		}
+
+		if _, err := dbtools.ArchiveGroupMembershipRequest(ctx, tx, request, dbtools.ArchivedRequestDenied, actor.User); err != nil {
+			return fmt.Errorf("error archiving group request on denial: %w", err)
+		}
+
+		var err error
+
+		event, err = dbtools.AuditGroupMembershipDenied(ctx, tx, actor.AuditID, actor.User, request)
+		if err != nil {
+			return fmt.Errorf("error denying group request (audit): %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	auditEvents := []*models.AuditEvent{event}
+
+	if err := s.publish(ctx, events.GovernorMemberRequestsEventSubject, &events.Event{
+		Version: events.Version,
+		Action:  events.GovernorEventDeny,
+		AuditID: actor.AuditID,
+		GroupID: request.GroupID,
+		UserID:  request.UserID,
+		ActorID: actor.ID(),
+	}); err != nil {
+		return auditEvents, fmt.Errorf("failed to publish request deny event, downstream changes may be delayed: %w", err)
+	}
+
+	return auditEvents, nil
+}
+
+// publishMembers publishes a members event for each of the changed memberships
+func (s *Service) publishMembers(ctx context.Context, actor Actor, action string, memberships []dbtools.EnumeratedMembership) error {
+	var perr publishErrors
+
+	for _, m := range memberships {
+		if err := s.publish(ctx, events.GovernorMembersEventSubject, &events.Event{
+			Version: events.MemberVersion,
+			Action:  action,
+			AuditID: actor.AuditID,
+			GroupID: m.GroupID,
+			UserID:  m.UserID,
+			ActorID: actor.ID(),
+			Member:  m.EventMember(),
+		}); err != nil && !perr.add(fmt.Errorf("failed to publish members %s event, downstream changes may be delayed: %w", strings.ToLower(action), err)) {
+			break
+		}
+	}
+
+	return perr.err
+}
+
+// isActiveUser returns true when events should be published for the user
+func isActiveUser(user *models.User) bool {
+	if user == nil {
+		return false
+	}
+
+	return user.Status.String == "active" || user.Status.String == "suspended"
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// failingBus records the published events like the memory event bus, and
+// fails to publish them with err
+type failingBus struct {
+	*eventbus.Memory
+	err error
+}
+
+func (b *failingBus) Publish(ctx context.Context, sub string, event *events.Event) error {
+	if err := b.Memory.Publish(ctx, sub, event); err != nil {
+		return err
+	}
+
+	return b.err
+}
+
+func TestDefaultExpiresAt(t *testing.T) {
+	given := null.TimeFrom(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, null.Time{}, defaultExpiresAt(&models.Group{}, null.Time{}))
+	assert.Equal(t, given, defaultExpiresAt(&models.Group{DefaultMembershipTTLDays: 90}, given))
+
+	got := defaultExpiresAt(&models.Group{DefaultMembershipTTLDays: 90}, null.Time{})
+	assert.True(t, got.Valid)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, 90), got.Time, time.Minute)
+}
+
+func TestAddMemberParamsSource(t *testing.T) {
+	assert.Equal(t, events.MembershipSourceDirect, AddMemberParams{}.memberSource())
+	assert.Equal(t, events.MembershipSourceImport, AddMemberParams{Source: events.MembershipSourceImport}.memberSource())
+}
+
+func TestCheckGroupChangeAllowed(t *testing.T) {
+	s := New()
+
+	actor := Actor{User: &models.User{ID: "user"}}
+
+	event, err := s.CheckGroupChange(context.TODO(), actor, &models.Group{}, "adding a member")
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+
+	frozen := &models.Group{FrozenAt: null.TimeFrom(time.Now())}
+
+	event, err = s.CheckGroupChange(context.TODO(), Actor{User: &models.User{ID: "admin"}, Admin: true}, frozen, "adding a member")
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+
+	event, err = s.CheckGroupChange(context.TODO(), Actor{}, frozen, "adding a member")
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+}
+
+func TestPublishMembersNotPublished(t *testing.T) {
+	memberships := []dbtools.EnumeratedMembership{
+		{GroupID: "group-1", UserID: "user"},
+		{GroupID: "group-2", UserID: "user"},
+	}
+
+	// the events queued in the outbox don't stop the others
+	bus := &failingBus{Memory: eventbus.NewMemory(), err: fmt.Errorf("%w: nats: connection closed", eventbus.ErrQueued)}
+	s := New(WithEventBus(bus))
+
+	err := s.publishMembers(context.TODO(), Actor{}, events.GovernorEventCreate, memberships)
+	require.ErrorIs(t, err, ErrEventNotPublished)
+	assert.ErrorIs(t, err, eventbus.ErrQueued)
+	assert.Len(t, bus.EventsOn(events.GovernorMembersEventSubject), 2)
+
+	// the first event that can't be queued stops the others
+	bus = &failingBus{Memory: eventbus.NewMemory(), err: eventbus.ErrEmptyEvent}
+	s = New(WithEventBus(bus))
+
+	err = s.publishMembers(context.TODO(), Actor{}, events.GovernorEventCreate, memberships)
+	require.ErrorIs(t, err, ErrEventNotPublished)
+	assert.NotErrorIs(t, err, eventbus.ErrQueued)
+	assert.Len(t, bus.EventsOn(events.GovernorMembersEventSubject), 1)
+}
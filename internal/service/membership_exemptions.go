@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// MaxMembershipExemption is how long a membership can be exempted from
+// expiration and review before the exemption has to be renewed
+const MaxMembershipExemption = 365 * 24 * time.Hour
+
+// checkExemption returns ErrInvalidMembershipExemption when an exemption with
+// the justification and expiry can't be granted at now
+func checkExemption(justification string, expiresAt, now time.Time) error {
+	if strings.TrimSpace(justification) == "" {
+		return fmt.Errorf("%w: a justification is required", ErrInvalidMembershipExemption)
+	}
+
+	if !expiresAt.After(now) {
+		return fmt.Errorf("%w: the exemption must expire in the future", ErrInvalidMembershipExemption)
+	}
+
+	if expiresAt.Sub(now) > MaxMembershipExemption {
+		return fmt.Errorf("%w: the exemption can't last more than %s", ErrInvalidMembershipExemption, MaxMembershipExemption)
+	}
+
+	return nil
+}
+
+// findDirectMembership returns the direct membership of the user in the group
+func (s *Service) findDirectMembership(ctx context.Context, groupIDOrSlug, userID string) (*models.GroupMembership, error) {
+	group, err := s.FindGroup(ctx, groupIDOrSlug, false)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.FindUser(ctx, userID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	membership, err := models.GroupMemberships(
+		qm.Where("group_id = ?", group.ID),
+		qm.And("user_id = ?", user.ID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrMembershipNotFound
+		}
+
+		return nil, fmt.Errorf("error getting membership: %w", err)
+	}
+
+	return membership, nil
+}
+
+// ExemptMember exempts the direct membership of a user in a group from
+// expiration and review until expiresAt, the actor is recorded as the
+// approver of the exemption. Granting an exemption to an exempt membership
+// replaces it.
+func (s *Service) ExemptMember(ctx context.Context, actor Actor, groupIDOrSlug, userID, justification string, expiresAt time.Time) (*models.GroupMembership, *models.AuditEvent, error) {
+	now := time.Now().UTC()
+
+	if err := checkExemption(justification, expiresAt, now); err != nil {
+		return nil, nil, err
+	}
+
+	membership, err := s.findDirectMembership(ctx, groupIDOrSlug, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	original := *membership
+	membership.ExemptedAt = null.TimeFrom(now)
+	membership.ExemptionExpiresAt = null.TimeFrom(expiresAt.UTC())
+	membership.ExemptionJustification = null.StringFrom(strings.TrimSpace(justification))
+	membership.ExemptionApprovedBy = null.NewString(actor.ID(), actor.ID() != "")
+
+	event, err := s.updateMembershipExemption(ctx, actor, &original, membership, dbtools.AuditGroupMembershipExempted)
+
+	return membership, event, err
+}
+
+// RemoveMemberExemption removes the exemption of the direct membership of a
+// user in a group, expired exemptions can be removed as well
+func (s *Service) RemoveMemberExemption(ctx context.Context, actor Actor, groupIDOrSlug, userID string) (*models.GroupMembership, *models.AuditEvent, error) {
+	membership, err := s.findDirectMembership(ctx, groupIDOrSlug, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !membership.ExemptedAt.Valid {
+		return nil, nil, ErrMembershipNotExempt
+	}
+
+	original := *membership
+	membership.ExemptedAt = null.Time{}
+	membership.ExemptionExpiresAt = null.Time{}
+	membership.ExemptionJustification = null.String{}
+	membership.ExemptionApprovedBy = null.String{}
+
+	event, err := s.updateMembershipExemption(ctx, actor, &original, membership, dbtools.AuditGroupMembershipExemptionRemoved)
+
+	return membership, event, err
+}
+
+type membershipAuditFunc func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, m *models.GroupMembership) (*models.AuditEvent, error)
+
+// updateMembershipExemption saves the exemption columns of the membership and
+// records the audit event. The effective memberships don't change, so no
+// members event is published.
+func (s *Service) updateMembershipExemption(ctx context.Context, actor Actor, original, membership *models.GroupMembership, audit membershipAuditFunc) (*models.AuditEvent, error) {
+	var event *models.AuditEvent
+
+	if err := s.withTx(ctx, func(tx *sql.Tx) error {
+		if _, err := membership.Update(ctx, tx, boil.Whitelist(
+			models.GroupMembershipColumns.ExemptedAt,
+			models.GroupMembershipColumns.ExemptionExpiresAt,
+			models.GroupMembershipColumns.ExemptionJustification,
+			models.GroupMembershipColumns.ExemptionApprovedBy,
+			models.GroupMembershipColumns.UpdatedAt,
+		)); err != nil {
+			return fmt.Errorf("error updating membership exemption: %w", err)
+		}
+
+		var err error
+
+		event, err = audit(ctx, tx, actor.AuditID, actor.User, original, membership)
+		if err != nil {
+			return fmt.Errorf("error updating membership exemption (audit): %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// ListMembershipExemptions returns the exempt direct memberships with their
+// group and user loaded, the ones whose exemption expired are only included
+// when expired is true
+func (s *Service) ListMembershipExemptions(ctx context.Context, expired bool, mods ...qm.QueryMod) (models.GroupMembershipSlice, error) {
+	queryMods := []qm.QueryMod{
+		qm.Load(models.GroupMembershipRels.Group),
+		qm.Load(models.GroupMembershipRels.User),
+		qm.InnerJoin("groups ON groups.id = group_memberships.group_id AND groups.deleted_at IS NULL"),
+		qm.OrderBy("group_memberships.exemption_expires_at, group_memberships.id"),
+	}
+
+	if expired {
+		queryMods = append(queryMods, qm.Where("group_memberships.exempted_at IS NOT NULL"))
+	} else {
+		queryMods = append(queryMods, dbtools.ExemptGroupMemberships())
+	}
+
+	memberships, err := models.GroupMemberships(append(queryMods, mods...)...).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error listing membership exemptions: %w", err)
+	}
+
+	return memberships, nil
+}
+
+// GroupMembershipExemptions returns the unexpired exemptions of the direct
+// members of a group, keyed by user id
+func (s *Service) GroupMembershipExemptions(ctx context.Context, groupID string) (map[string]*models.GroupMembership, error) {
+	memberships, err := models.GroupMemberships(
+		qm.Where("group_id = ?", groupID),
+		dbtools.ExemptGroupMemberships(),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting membership exemptions: %w", err)
+	}
+
+	exemptions := make(map[string]*models.GroupMembership, len(memberships))
+	for _, m := range memberships {
+		exemptions[m.UserID] = m
+	}
+
+	return exemptions, nil
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckExemption(t *testing.T) {
+	now := time.Now()
+
+	assert.NoError(t, checkExemption("service account", now.Add(24*time.Hour), now))
+	assert.NoError(t, checkExemption("service account", now.Add(MaxMembershipExemption), now))
+	assert.ErrorIs(t, checkExemption(" ", now.Add(24*time.Hour), now), ErrInvalidMembershipExemption)
+	assert.ErrorIs(t, checkExemption("service account", now, now), ErrInvalidMembershipExemption)
+	assert.ErrorIs(t, checkExemption("service account", now.Add(MaxMembershipExemption+time.Second), now), ErrInvalidMembershipExemption)
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// MaxJustificationLength is the maximum length of a membership justification
+const MaxJustificationLength = 1024
+
+// CheckJustification returns ErrJustificationRequired when the justification
+// of a new membership of the group is shorter than the group requires
+func CheckJustification(group *models.Group, justification string) error {
+	if group.JustificationMinLength <= 0 {
+		return nil
+	}
+
+	if int64(utf8.RuneCountInString(strings.TrimSpace(justification))) < group.JustificationMinLength {
+		return fmt.Errorf("%w: the group requires a justification of at least %d characters", ErrJustificationRequired, group.JustificationMinLength)
+	}
+
+	return nil
+}
+
+// MembershipJustifications returns the justifications of the direct memberships
+// matching the mods, keyed by group and user id. Memberships without a
+// justification are left out.
+func (s *Service) MembershipJustifications(ctx context.Context, mods ...qm.QueryMod) (map[[2]string]string, error) {
+	memberships, err := models.GroupMemberships(append(mods,
+		qm.Select(
+			models.GroupMembershipColumns.GroupID,
+			models.GroupMembershipColumns.UserID,
+			models.GroupMembershipColumns.Justification,
+		),
+		qm.Where("justification != ''"),
+	)...).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting membership justifications: %w", err)
+	}
+
+	justifications := make(map[[2]string]string, len(memberships))
+	for _, m := range memberships {
+		justifications[[2]string{m.GroupID, m.UserID}] = m.Justification
+	}
+
+	return justifications, nil
+}
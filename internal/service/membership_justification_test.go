@@ -0,0 +1,17 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestCheckJustification(t *testing.T) {
+	assert.NoError(t, CheckJustification(&models.Group{}, ""))
+	assert.NoError(t, CheckJustification(&models.Group{JustificationMinLength: 10}, "on-call rotation"))
+	assert.NoError(t, CheckJustification(&models.Group{JustificationMinLength: 5}, "équipe"))
+	assert.ErrorIs(t, CheckJustification(&models.Group{JustificationMinLength: 10}, ""), ErrJustificationRequired)
+	assert.ErrorIs(t, CheckJustification(&models.Group{JustificationMinLength: 10}, "   on-call   "), ErrJustificationRequired)
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// MembershipNotes returns the admin notes of the direct memberships matching
+// the mods, keyed by group and user id. Memberships without a note are left
+// out.
+func (s *Service) MembershipNotes(ctx context.Context, mods ...qm.QueryMod) (map[[2]string]string, error) {
+	memberships, err := models.GroupMemberships(append(mods,
+		qm.Select(
+			models.GroupMembershipColumns.GroupID,
+			models.GroupMembershipColumns.UserID,
+			models.GroupMembershipColumns.Note,
+		),
+		qm.Where("note != ''"),
+	)...).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting membership notes: %w", err)
+	}
+
+	notes := make(map[[2]string]string, len(memberships))
+	for _, m := range memberships {
+		notes[[2]string{m.GroupID, m.UserID}] = m.Note
+	}
+
+	return notes, nil
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// AdminPromotionRequestThrottled returns ErrAdminPromotionRequestThrottled
+// when the user had an admin promotion request on the group denied within
+// the cooldown, with how long is left until they can request it again. Other
+// request kinds are never throttled.
+func (s *Service) AdminPromotionRequestThrottled(ctx context.Context, userID, groupID, kind string, cooldown time.Duration) (time.Duration, error) {
+	if cooldown <= 0 || kind != requestKindAdminPromotion {
+		return 0, nil
+	}
+
+	last, err := models.ArchivedRequests(
+		models.ArchivedRequestWhere.RequestType.EQ(dbtools.ArchivedRequestTypeGroupMembership),
+		models.ArchivedRequestWhere.Kind.EQ(null.StringFrom(requestKindAdminPromotion)),
+		models.ArchivedRequestWhere.Decision.EQ(dbtools.ArchivedRequestDenied),
+		models.ArchivedRequestWhere.GroupID.EQ(groupID),
+		models.ArchivedRequestWhere.UserID.EQ(null.StringFrom(userID)),
+		qm.OrderBy("decided_at DESC"),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	if left := time.Until(last.DecidedAt.Add(cooldown)); left > 0 {
+		return left, fmt.Errorf("%w: retry in %s", ErrAdminPromotionRequestThrottled, left.Round(time.Second))
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminPromotionRequestThrottled(t *testing.T) {
+	s := New()
+
+	left, err := s.AdminPromotionRequestThrottled(context.Background(), "user-id", "group-id", requestKindAdminPromotion, 0)
+	require.NoError(t, err, "the cooldown is disabled")
+	assert.Zero(t, left)
+
+	left, err = s.AdminPromotionRequestThrottled(context.Background(), "user-id", "group-id", requestKindNewMember, time.Hour)
+	require.NoError(t, err, "new member requests aren't throttled")
+	assert.Zero(t, left)
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/jobs"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
+)
+
+const (
+	// JobKindNotificationBroadcast is the kind of the jobs sending a notification broadcast
+	JobKindNotificationBroadcast = "notification.broadcast"
+
+	// notificationBroadcastProgressEvery is how many recipients are processed between progress updates
+	notificationBroadcastProgressEvery = 50
+)
+
+// NotificationBroadcast is an announcement sent to all the direct and
+// indirect members of groups
+type NotificationBroadcast struct {
+	NotificationType string   `json:"notification_type"`
+	Subject          string   `json:"subject,omitempty"`
+	Message          string   `json:"message"`
+	GroupIDs         []string `json:"group_ids"`
+}
+
+// NotificationBroadcastRecipient is a user a broadcast is sent to, with the
+// first of the broadcast groups the user is a member of
+type NotificationBroadcastRecipient struct {
+	UserID  string
+	GroupID string
+}
+
+// NotificationBroadcastResult is the result of a notification broadcast job,
+// skipped recipients muted or disabled the notification on every target
+type NotificationBroadcastResult struct {
+	Recipients int64 `json:"recipients"`
+	Dispatched int64 `json:"dispatched"`
+	Skipped    int64 `json:"skipped"`
+	Failed     int64 `json:"failed"`
+}
+
+// NotificationBroadcastThrottled returns ErrNotificationBroadcastThrottled
+// when the actor started a broadcast within the cooldown, with how long is
+// left until the next one can be sent
+func (s *Service) NotificationBroadcastThrottled(ctx context.Context, actor Actor, cooldown time.Duration) (time.Duration, error) {
+	if cooldown <= 0 || actor.User == nil {
+		return 0, nil
+	}
+
+	last, err := models.Jobs(
+		models.JobWhere.Kind.EQ(JobKindNotificationBroadcast),
+		qm.Where("created_by = ?", actor.User.ID),
+		qm.OrderBy("created_at DESC"),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	if left := time.Until(last.CreatedAt.Add(cooldown)); left > 0 {
+		return left, ErrNotificationBroadcastThrottled
+	}
+
+	return 0, nil
+}
+
+// NotificationBroadcastAudience returns the direct and indirect members of
+// the groups, each user once
+func (s *Service) NotificationBroadcastAudience(ctx context.Context, groupIDs []string) ([]NotificationBroadcastRecipient, error) {
+	seen := map[string]bool{}
+	audience := []NotificationBroadcastRecipient{}
+
+	for _, groupID := range groupIDs {
+		members, err := dbtools.GetMembersOfGroup(ctx, s.db.DB, groupID, false)
+		if err != nil {
+			return nil, fmt.Errorf("error enumerating group membership: %w", err)
+		}
+
+		sort.Slice(members, func(i, j int) bool { return members[i].UserID < members[j].UserID })
+
+		for _, m := range members {
+			if seen[m.UserID] {
+				continue
+			}
+
+			seen[m.UserID] = true
+
+			audience = append(audience, NotificationBroadcastRecipient{UserID: m.UserID, GroupID: groupID})
+		}
+	}
+
+	return audience, nil
+}
+
+// NotificationBroadcastJob returns the job handler sending a notification
+// broadcast, dispatching the notification to one recipient every interval so
+// large audiences don't flood the dispatchers. The recipients' notification
+// preferences apply as for any other dispatch.
+func (s *Service) NotificationBroadcastJob(interval time.Duration) jobs.Handler {
+	return func(ctx context.Context, job *models.Job, progress jobs.Progress) (interface{}, error) {
+		b := NotificationBroadcast{}
+		if err := json.Unmarshal(job.Params, &b); err != nil {
+			return nil, fmt.Errorf("error decoding notification broadcast: %w", err)
+		}
+
+		audience, err := s.NotificationBroadcastAudience(ctx, b.GroupIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		payload, err := json.Marshal(map[string]string{
+			"subject":  b.Subject,
+			"message":  b.Message,
+			"job_id":   job.ID,
+			"actor_id": job.CreatedBy.String,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result := &NotificationBroadcastResult{Recipients: int64(len(audience))}
+
+		var tick <-chan time.Time
+
+		if interval > 0 {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			tick = ticker.C
+		}
+
+		for i, r := range audience {
+			if i > 0 && tick != nil {
+				select {
+				case <-ctx.Done():
+					return result, ctx.Err()
+				case <-tick:
+				}
+			}
+
+			_, err := s.CreateNotificationDispatch(ctx, NotificationDispatchParams{
+				UserID:           r.UserID,
+				NotificationType: b.NotificationType,
+				Group:            r.GroupID,
+				Payload:          payload,
+			})
+
+			switch {
+			case err == nil, errors.Is(err, eventbus.ErrQueued):
+				result.Dispatched++
+			case errors.Is(err, ErrNotificationNotDeliverable), errors.Is(err, ErrUserNotFound):
+				result.Skipped++
+			default:
+				result.Failed++
+
+				s.logger.Warn("error dispatching notification broadcast",
+					zap.String("job.id", job.ID), zap.String("user.id", r.UserID), zap.Error(err))
+			}
+
+			if processed := int64(i + 1); processed%notificationBroadcastProgressEvery == 0 || processed == result.Recipients {
+				if err := progress(ctx, processed, result.Recipients); err != nil {
+					return result, err
+				}
+			}
+		}
+
+		return result, nil
+	}
+}
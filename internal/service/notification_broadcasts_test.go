@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestNotificationBroadcastJob(t *testing.T) {
+	s := New()
+	h := s.NotificationBroadcastJob(time.Millisecond)
+
+	progressed := false
+	progress := func(_ context.Context, _, _ int64) error {
+		progressed = true
+		return nil
+	}
+
+	result, err := h(context.Background(), &models.Job{
+		ID:     "job-id",
+		Params: types.JSON(`{"notification_type":"announcements","message":"maintenance tonight","group_ids":[]}`),
+	}, progress)
+	require.NoError(t, err)
+	assert.Equal(t, &NotificationBroadcastResult{}, result)
+	assert.False(t, progressed, "progress isn't reported without recipients")
+
+	_, err = h(context.Background(), &models.Job{ID: "job-id", Params: types.JSON(`[`)}, progress)
+	assert.Error(t, err)
+}
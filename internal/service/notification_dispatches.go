@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+const (
+	// NotificationDispatchPending is the status of a dispatch waiting to be delivered
+	NotificationDispatchPending = "pending"
+	// NotificationDispatchDelivered is the status of a dispatch a dispatcher delivered
+	NotificationDispatchDelivered = "delivered"
+	// NotificationDispatchFailed is the status of a dispatch that wasn't delivered on any target
+	NotificationDispatchFailed = "failed"
+
+	// notificationFailoverBatchSize is how many dispatches fail over at most on each pass
+	notificationFailoverBatchSize = 100
+)
+
+// NotificationDispatchTarget is a target a notification dispatch is sent to
+type NotificationDispatchTarget struct {
+	ID   string `json:"id"`
+	Slug string `json:"slug"`
+}
+
+// NotificationDispatchParams are the parameters of a notification dispatch,
+// the group is optional and the payload is passed through to the dispatchers
+type NotificationDispatchParams struct {
+	UserID           string
+	NotificationType string
+	Group            string
+	Payload          json.RawMessage
+}
+
+// CreateNotificationDispatch dispatches a notification to a user on the
+// targets their preferences accept, ordered by their target ranking. When the
+// user has a failover policy for the notification type, the notification is
+// sent to the first target only and fails over to the next one when it isn't
+// delivered in time, otherwise it is sent to every target at once. A dispatch
+// event is published for each target the notification is sent to.
+func (s *Service) CreateNotificationDispatch(ctx context.Context, params NotificationDispatchParams) (*models.NotificationDispatch, error) {
+	user, err := s.FindUser(ctx, params.UserID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationType, err := models.NotificationTypes(qm.Where("slug = ?", params.NotificationType)).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotificationTypeNotFound
+		}
+
+		return nil, err
+	}
+
+	var group *models.Group
+
+	if params.Group != "" {
+		if group, err = s.FindGroup(ctx, params.Group, false); err != nil {
+			return nil, err
+		}
+	}
+
+	targets, err := s.notificationDispatchTargets(ctx, user.ID, notificationType.Slug, group)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(targets) == 0 {
+		return nil, ErrNotificationNotDeliverable
+	}
+
+	policy, err := models.NotificationFailoverPolicies(
+		qm.Where("user_id = ?", user.ID),
+		qm.And("notification_type_id = ?", notificationType.ID),
+	).One(ctx, s.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	rawTargets, err := json.Marshal(targets)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	dispatch := &models.NotificationDispatch{
+		UserID:             user.ID,
+		NotificationTypeID: notificationType.ID,
+		Targets:            types.JSON(rawTargets),
+		Status:             NotificationDispatchPending,
+		Attempt:            len(targets) - 1,
+	}
+
+	if group != nil {
+		dispatch.GroupID = null.StringFrom(group.ID)
+	}
+
+	if len(params.Payload) > 0 {
+		dispatch.Payload = null.JSONFrom(params.Payload)
+	}
+
+	if policy != nil && len(targets) > 1 {
+		dispatch.Attempt = 0
+		dispatch.FailoverAfterSeconds = null.IntFrom(policy.FailoverAfterSeconds)
+		dispatch.NextFailoverAt = null.TimeFrom(now.Add(time.Duration(policy.FailoverAfterSeconds) * time.Second))
+	}
+
+	if err := dispatch.Insert(ctx, s.db, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("error creating notification dispatch: %w", err)
+	}
+
+	var perr publishErrors
+
+	for _, t := range targets[:dispatch.Attempt+1] {
+		if !perr.add(s.publishNotificationDispatch(ctx, dispatch, t)) {
+			break
+		}
+	}
+
+	return dispatch, perr.err
+}
+
+// notificationDispatchTargets returns the targets a notification is
+// delivered on, ordered by the user's target ranking
+func (s *Service) notificationDispatchTargets(ctx context.Context, userID, notificationType string, group *models.Group) ([]NotificationDispatchTarget, error) {
+	targets, err := models.NotificationTargets(qm.OrderBy("slug")).All(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := dbtools.GetNotificationPreferences(ctx, userID, s.db, true)
+	if err != nil {
+		return nil, err
+	}
+
+	groupPreferences, err := dbtools.GetNotificationGroupPreferences(ctx, userID, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	failover, err := dbtools.GetNotificationFailover(ctx, userID, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	groupSlug := ""
+	if group != nil {
+		groupSlug = group.Slug
+	}
+
+	bySlug := map[string]string{}
+	enabled := []string{}
+
+	for _, t := range targets {
+		if dbtools.ResolveNotificationDelivery(preferences, groupPreferences, groupSlug, notificationType, t.Slug).Deliver {
+			bySlug[t.Slug] = t.ID
+			enabled = append(enabled, t.Slug)
+		}
+	}
+
+	ranked := dbtools.RankNotificationTargets(enabled, failover.TargetRanking)
+
+	dispatchTargets := make([]NotificationDispatchTarget, len(ranked))
+	for i, slug := range ranked {
+		dispatchTargets[i] = NotificationDispatchTarget{ID: bySlug[slug], Slug: slug}
+	}
+
+	return dispatchTargets, nil
+}
+
+// FindNotificationDispatch returns a notification dispatch by id
+func (s *Service) FindNotificationDispatch(ctx context.Context, id string) (*models.NotificationDispatch, error) {
+	dispatch, err := models.FindNotificationDispatch(ctx, s.db, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotificationDispatchNotFound
+		}
+
+		return nil, err
+	}
+
+	return dispatch, nil
+}
+
+// AckNotificationDispatch records that a dispatcher delivered the
+// notification on a target, which stops the failover. Only the targets the
+// notification was sent to can be acknowledged.
+func (s *Service) AckNotificationDispatch(ctx context.Context, id, target string) (*models.NotificationDispatch, error) {
+	dispatch, err := s.FindNotificationDispatch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if dispatch.Status != NotificationDispatchPending {
+		return nil, ErrNotificationDispatchClosed
+	}
+
+	targets, err := notificationDispatchTargets(dispatch)
+	if err != nil {
+		return nil, err
+	}
+
+	var delivered *NotificationDispatchTarget
+
+	for i := range targets {
+		if i <= dispatch.Attempt && targets[i].Slug == target {
+			delivered = &targets[i]
+			break
+		}
+	}
+
+	if delivered == nil {
+		return nil, ErrNotificationTargetNotAttempted
+	}
+
+	now := time.Now()
+
+	// the dispatch may fail over or be acknowledged on another target meanwhile
+	n, err := models.NotificationDispatches(
+		qm.Where("id = ?", dispatch.ID),
+		qm.And("status = ?", NotificationDispatchPending),
+	).UpdateAll(ctx, s.db, models.M{
+		models.NotificationDispatchColumns.Status:            NotificationDispatchDelivered,
+		models.NotificationDispatchColumns.DeliveredTargetID: null.StringFrom(delivered.ID),
+		models.NotificationDispatchColumns.DeliveredAt:       null.TimeFrom(now),
+		models.NotificationDispatchColumns.NextFailoverAt:    null.Time{},
+		models.NotificationDispatchColumns.UpdatedAt:         now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error acknowledging notification dispatch: %w", err)
+	}
+
+	if n == 0 {
+		return nil, ErrNotificationDispatchClosed
+	}
+
+	return s.FindNotificationDispatch(ctx, id)
+}
+
+// FailoverNotificationDispatches sends the pending dispatches that weren't
+// delivered in time to their next target, and marks the ones without targets
+// left as failed. It returns how many dispatches failed over.
+func (s *Service) FailoverNotificationDispatches(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	due, err := models.NotificationDispatches(
+		qm.Where("status = ?", NotificationDispatchPending),
+		qm.And("next_failover_at <= ?", now),
+		qm.OrderBy("next_failover_at"),
+		qm.Limit(notificationFailoverBatchSize),
+	).All(ctx, s.db)
+	if err != nil {
+		return 0, fmt.Errorf("error getting notification dispatches: %w", err)
+	}
+
+	failedOver := 0
+
+	for _, dispatch := range due {
+		targets, err := notificationDispatchTargets(dispatch)
+		if err != nil {
+			return failedOver, err
+		}
+
+		attempt := dispatch.Attempt
+
+		failoverNotificationDispatch(dispatch, len(targets), now)
+
+		// another instance may fail the dispatch over first
+		n, err := models.NotificationDispatches(
+			qm.Where("id = ?", dispatch.ID),
+			qm.And("status = ?", NotificationDispatchPending),
+			qm.And("attempt = ?", attempt),
+		).UpdateAll(ctx, s.db, models.M{
+			models.NotificationDispatchColumns.Status:         dispatch.Status,
+			models.NotificationDispatchColumns.Attempt:        dispatch.Attempt,
+			models.NotificationDispatchColumns.NextFailoverAt: dispatch.NextFailoverAt,
+			models.NotificationDispatchColumns.UpdatedAt:      now,
+		})
+		if err != nil {
+			return failedOver, fmt.Errorf("error failing over notification dispatch: %w", err)
+		}
+
+		if n == 0 || dispatch.Status != NotificationDispatchPending {
+			continue
+		}
+
+		// the dispatch failed over once its event is queued in the outbox
+		if err := s.publishNotificationDispatch(ctx, dispatch, targets[dispatch.Attempt]); err != nil && !errors.Is(err, eventbus.ErrQueued) {
+			return failedOver, err
+		}
+
+		failedOver++
+	}
+
+	return failedOver, nil
+}
+
+// RunNotificationFailover fails the notification dispatches over every
+// interval until the context is canceled
+func (s *Service) RunNotificationFailover(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if n, err := s.FailoverNotificationDispatches(ctx); err != nil {
+			s.logger.Warn("error failing over notification dispatches", zap.Int("failed_over", n), zap.Error(err))
+		} else if n > 0 {
+			s.logger.Info("failed over notification dispatches", zap.Int("failed_over", n))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// failoverNotificationDispatch moves the dispatch to its next target, or
+// marks it as failed when there is none left
+func failoverNotificationDispatch(dispatch *models.NotificationDispatch, targets int, now time.Time) {
+	if dispatch.Attempt+1 >= targets {
+		dispatch.Status = NotificationDispatchFailed
+		dispatch.NextFailoverAt = null.Time{}
+
+		return
+	}
+
+	dispatch.Attempt++
+	dispatch.NextFailoverAt = null.TimeFrom(now.Add(time.Duration(dispatch.FailoverAfterSeconds.Int) * time.Second))
+}
+
+// notificationDispatchTargets decodes the ordered targets of a dispatch
+func notificationDispatchTargets(dispatch *models.NotificationDispatch) ([]NotificationDispatchTarget, error) {
+	targets := []NotificationDispatchTarget{}
+
+	if err := dispatch.Targets.Unmarshal(&targets); err != nil {
+		return nil, fmt.Errorf("error decoding notification dispatch targets: %w", err)
+	}
+
+	return targets, nil
+}
+
+func (s *Service) publishNotificationDispatch(ctx context.Context, dispatch *models.NotificationDispatch, target NotificationDispatchTarget) error {
+	if err := s.publish(ctx, events.GovernorNotificationDispatchesEventSubject, &events.Event{
+		Version:                events.Version,
+		Action:                 events.GovernorEventCreate,
+		UserID:                 dispatch.UserID,
+		GroupID:                dispatch.GroupID.String,
+		NotificationTypeID:     dispatch.NotificationTypeID,
+		NotificationTargetID:   target.ID,
+		NotificationDispatchID: dispatch.ID,
+	}); err != nil {
+		return fmt.Errorf("failed to publish notification dispatch event, the notification may be delayed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestFailoverNotificationDispatch(t *testing.T) {
+	now := time.Now()
+
+	dispatch := &models.NotificationDispatch{
+		Status:               NotificationDispatchPending,
+		FailoverAfterSeconds: null.IntFrom(600),
+		NextFailoverAt:       null.TimeFrom(now),
+	}
+
+	failoverNotificationDispatch(dispatch, 3, now)
+	assert.Equal(t, NotificationDispatchPending, dispatch.Status)
+	assert.Equal(t, 1, dispatch.Attempt)
+	assert.Equal(t, now.Add(10*time.Minute), dispatch.NextFailoverAt.Time)
+
+	failoverNotificationDispatch(dispatch, 3, now)
+	assert.Equal(t, NotificationDispatchPending, dispatch.Status)
+	assert.Equal(t, 2, dispatch.Attempt)
+
+	failoverNotificationDispatch(dispatch, 3, now)
+	assert.Equal(t, NotificationDispatchFailed, dispatch.Status)
+	assert.Equal(t, 2, dispatch.Attempt, "the last attempted target is kept")
+	assert.False(t, dispatch.NextFailoverAt.Valid)
+}
+
+func TestNotificationDispatchTargets(t *testing.T) {
+	targets, err := notificationDispatchTargets(&models.NotificationDispatch{
+		Targets: types.JSON(`[{"id":"slack-id","slug":"slack"},{"id":"email-id","slug":"email"}]`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []NotificationDispatchTarget{
+		{ID: "slack-id", Slug: "slack"},
+		{ID: "email-id", Slug: "email"},
+	}, targets)
+
+	_, err = notificationDispatchTargets(&models.NotificationDispatch{Targets: types.JSON(`{`)})
+	assert.Error(t, err)
+}
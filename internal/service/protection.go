@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// ProtectGroup protects a group, blocking its deletion and its detachment
+// from its hierarchies until it is unprotected. The audit event is returned
+// even when publishing fails since the change is already committed.
+func (s *Service) ProtectGroup(ctx context.Context, actor Actor, groupIDOrSlug string) (*models.Group, *models.AuditEvent, error) {
+	group, err := s.FindGroup(ctx, groupIDOrSlug, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if group.ProtectedAt.Valid {
+		return nil, nil, ErrGroupAlreadyProtected
+	}
+
+	original := *group
+	group.ProtectedAt = null.TimeFrom(time.Now().UTC())
+
+	event, err := s.updateGroupState(ctx, actor, &original, group, dbtools.AuditGroupProtected, models.GroupColumns.ProtectedAt)
+
+	return group, event, err
+}
+
+// UnprotectGroup removes the protection of a group. The audit event is
+// returned even when publishing fails since the change is already committed.
+func (s *Service) UnprotectGroup(ctx context.Context, actor Actor, groupIDOrSlug string) (*models.Group, *models.AuditEvent, error) {
+	group, err := s.FindGroup(ctx, groupIDOrSlug, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !group.ProtectedAt.Valid {
+		return nil, nil, ErrGroupNotProtected
+	}
+
+	original := *group
+	group.ProtectedAt = null.Time{}
+
+	event, err := s.updateGroupState(ctx, actor, &original, group, dbtools.AuditGroupUnprotected, models.GroupColumns.ProtectedAt)
+
+	return group, event, err
+}
+
+// CheckGroupProtection returns ErrGroupProtected when the group is protected,
+// the attempted change is recorded as a violation audit event which is
+// returned with the error. Unlike frozen groups, protected groups can't be
+// deleted or detached by governor admins either, they have to unprotect them.
+func (s *Service) CheckGroupProtection(ctx context.Context, actor Actor, group *models.Group, change string) (*models.AuditEvent, error) {
+	if !group.ProtectedAt.Valid {
+		return nil, nil
+	}
+
+	event, err := dbtools.AuditGroupProtectionViolated(ctx, s.db, actor.AuditID, actor.User, group, change)
+	if err != nil {
+		return nil, fmt.Errorf("error recording group protection violation (audit): %w", err)
+	}
+
+	return event, fmt.Errorf("%w: %s", ErrGroupProtected, change)
+}
+
+// CheckGroupDeletion returns ErrGroupProtected when deleting or detaching the
+// group would change a protected group, either the group itself or one of the
+// groups it shares a hierarchy with
+func (s *Service) CheckGroupDeletion(ctx context.Context, actor Actor, group *models.Group, change string) (*models.AuditEvent, error) {
+	if event, err := s.CheckGroupProtection(ctx, actor, group, change); err != nil {
+		return event, err
+	}
+
+	linked, err := models.Groups(
+		qm.Where("protected_at IS NOT NULL"),
+		qm.Where(`id IN (
+			SELECT parent_group_id FROM group_hierarchies WHERE member_group_id = ?
+			UNION SELECT member_group_id FROM group_hierarchies WHERE parent_group_id = ?
+		)`, group.ID, group.ID),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, g := range linked {
+		if event, err := s.CheckGroupProtection(ctx, actor, g, change); err != nil {
+			return event, err
+		}
+	}
+
+	return nil, nil
+}
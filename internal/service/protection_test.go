@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestCheckGroupProtectionAllowed(t *testing.T) {
+	s := New()
+
+	for _, actor := range []Actor{
+		{User: &models.User{ID: "user"}},
+		{User: &models.User{ID: "admin"}, Admin: true},
+		{},
+	} {
+		event, err := s.CheckGroupProtection(context.TODO(), actor, &models.Group{}, "deleting group a-group")
+		assert.NoError(t, err)
+		assert.Nil(t, event)
+	}
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// CheckTombstoneWatermark returns ErrSyncWatermarkExpired when the
+// tombstones removed after since may already be purged with the retention,
+// the poller has to do a full sync then. A zero retention keeps the
+// tombstones forever.
+func CheckTombstoneWatermark(since time.Time, retention time.Duration, now time.Time) error {
+	if retention > 0 && since.Before(now.Add(-retention)) {
+		return ErrSyncWatermarkExpired
+	}
+
+	return nil
+}
+
+// RelationshipTombstonesSince returns the tombstones of the relationships
+// removed after since, oldest first, of the kind when it isn't empty
+func (s *Service) RelationshipTombstonesSince(ctx context.Context, kind string, since time.Time, retention time.Duration) (models.RelationshipTombstoneSlice, error) {
+	if err := CheckTombstoneWatermark(since, retention, time.Now()); err != nil {
+		return nil, err
+	}
+
+	mods := []qm.QueryMod{
+		qm.Where("removed_at > ?", since),
+		qm.OrderBy("removed_at"),
+	}
+
+	if kind != "" {
+		mods = append(mods, qm.Where("kind = ?", kind))
+	}
+
+	tombstones, err := models.RelationshipTombstones(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting relationship tombstones: %w", err)
+	}
+
+	return tombstones, nil
+}
+
+// PurgeRelationshipTombstones removes the tombstones older than the
+// retention and returns how many were removed
+func (s *Service) PurgeRelationshipTombstones(ctx context.Context, retention time.Duration) (int64, error) {
+	purged, err := models.RelationshipTombstones(
+		qm.Where("removed_at < ?", time.Now().Add(-retention)),
+	).DeleteAll(ctx, s.db)
+	if err != nil {
+		return 0, fmt.Errorf("error purging relationship tombstones: %w", err)
+	}
+
+	return purged, nil
+}
+
+// RunRelationshipTombstoneReaper removes the tombstones older than the
+// retention every interval until the context is canceled
+func (s *Service) RunRelationshipTombstoneReaper(ctx context.Context, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if purged, err := s.PurgeRelationshipTombstones(ctx, retention); err != nil {
+			s.logger.Warn("error purging relationship tombstones", zap.Error(err))
+		} else if purged > 0 {
+			s.logger.Info("purged relationship tombstones", zap.Int64("purged", purged))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckTombstoneWatermark(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		since     time.Time
+		retention time.Duration
+		wantErr   error
+	}{
+		{
+			name:      "within retention",
+			since:     now.Add(-time.Hour),
+			retention: 24 * time.Hour,
+		},
+		{
+			name:      "at retention",
+			since:     now.Add(-24 * time.Hour),
+			retention: 24 * time.Hour,
+		},
+		{
+			name:      "past retention",
+			since:     now.Add(-25 * time.Hour),
+			retention: 24 * time.Hour,
+			wantErr:   ErrSyncWatermarkExpired,
+		},
+		{
+			name:  "kept forever",
+			since: now.Add(-365 * 24 * time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, CheckTombstoneWatermark(tt.since, tt.retention, now), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,344 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const (
+	// ScheduledChangeActionAdd adds the user to the group at the scheduled time
+	ScheduledChangeActionAdd = "add"
+	// ScheduledChangeActionRemove removes the user from the group at the scheduled time
+	ScheduledChangeActionRemove = "remove"
+
+	// ScheduledChangeStatusPending is a change waiting for its scheduled time
+	ScheduledChangeStatusPending = "pending"
+	// ScheduledChangeStatusApplied is a change that was applied
+	ScheduledChangeStatusApplied = "applied"
+	// ScheduledChangeStatusFailed is a change that couldn't be applied, the
+	// error is recorded with it
+	ScheduledChangeStatusFailed = "failed"
+	// ScheduledChangeStatusCanceled is a change canceled before its scheduled time
+	ScheduledChangeStatusCanceled = "canceled"
+)
+
+// scheduledChangeBatchSize is how many due changes are applied at most on each pass
+const scheduledChangeBatchSize = 100
+
+// ScheduleMembershipChangeParams are the parameters to schedule a membership change
+type ScheduleMembershipChangeParams struct {
+	Action       string
+	ScheduledFor time.Time
+	// IsAdmin, ExpiresAt, AdminExpiresAt and Justification only apply to
+	// scheduled adds
+	IsAdmin        bool
+	ExpiresAt      null.Time
+	AdminExpiresAt null.Time
+	Justification  string
+}
+
+// checkScheduledChange returns ErrInvalidScheduledChange when a change with
+// the params can't be scheduled at now
+func checkScheduledChange(params ScheduleMembershipChangeParams, now time.Time) error {
+	switch params.Action {
+	case ScheduledChangeActionAdd:
+		if params.ExpiresAt.Valid && !params.ExpiresAt.Time.After(params.ScheduledFor) {
+			return fmt.Errorf("%w: the membership must expire after the scheduled time", ErrInvalidScheduledChange)
+		}
+	case ScheduledChangeActionRemove:
+		if params.IsAdmin || params.ExpiresAt.Valid || params.AdminExpiresAt.Valid || params.Justification != "" {
+			return fmt.Errorf("%w: admin, expiry and justification settings only apply to scheduled adds", ErrInvalidScheduledChange)
+		}
+	default:
+		return fmt.Errorf("%w: unknown action %q", ErrInvalidScheduledChange, params.Action)
+	}
+
+	if !params.ScheduledFor.After(now) {
+		return fmt.Errorf("%w: the change must be scheduled in the future", ErrInvalidScheduledChange)
+	}
+
+	return nil
+}
+
+// ScheduleMembershipChange schedules adding a user to a group, or removing
+// them, at a future time. The group change rules are checked now and again
+// when the change is applied.
+func (s *Service) ScheduleMembershipChange(ctx context.Context, actor Actor, groupIDOrSlug, userID string, params ScheduleMembershipChangeParams) (*models.ScheduledMembershipChange, *models.AuditEvent, error) {
+	if err := checkScheduledChange(params, time.Now()); err != nil {
+		return nil, nil, err
+	}
+
+	group, err := s.FindGroup(ctx, groupIDOrSlug, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if event, err := s.CheckGroupChange(ctx, actor, group, "scheduling a membership change"); err != nil {
+		return nil, event, err
+	}
+
+	if params.Action == ScheduledChangeActionAdd {
+		if err := CheckJustification(group, params.Justification); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	user, err := s.FindUser(ctx, userID, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	change := &models.ScheduledMembershipChange{
+		GroupID:        group.ID,
+		UserID:         user.ID,
+		Action:         params.Action,
+		IsAdmin:        params.IsAdmin,
+		ExpiresAt:      params.ExpiresAt,
+		AdminExpiresAt: params.AdminExpiresAt,
+		Justification:  strings.TrimSpace(params.Justification),
+		ScheduledFor:   params.ScheduledFor.UTC(),
+		Status:         ScheduledChangeStatusPending,
+		CreatedBy:      null.NewString(actor.ID(), actor.ID() != ""),
+	}
+
+	var event *models.AuditEvent
+
+	if err := s.withTx(ctx, func(tx *sql.Tx) error {
+		if err := change.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("error scheduling membership change: %w", err)
+		}
+
+		var err error
+
+		event, err = dbtools.AuditScheduledMembershipChangeCreated(ctx, tx, actor.AuditID, actor.User, change)
+		if err != nil {
+			return fmt.Errorf("error scheduling membership change (audit): %w", err)
+		}
+
+		// the scheduling event is the parent of the events recorded when
+		// the change is applied
+		change.AuditID = null.StringFrom(event.ID)
+
+		if _, err := change.Update(ctx, tx, boil.Whitelist(models.ScheduledMembershipChangeColumns.AuditID)); err != nil {
+			return fmt.Errorf("error scheduling membership change: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	return change, event, nil
+}
+
+// CancelScheduledMembershipChange cancels a pending scheduled change of a group
+func (s *Service) CancelScheduledMembershipChange(ctx context.Context, actor Actor, groupIDOrSlug, changeID string) (*models.ScheduledMembershipChange, *models.AuditEvent, error) {
+	group, err := s.FindGroup(ctx, groupIDOrSlug, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	change, err := models.ScheduledMembershipChanges(
+		qm.Where("id = ?", changeID),
+		qm.And("group_id = ?", group.ID),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrScheduledChangeNotFound
+		}
+
+		return nil, nil, fmt.Errorf("error getting scheduled membership change: %w", err)
+	}
+
+	if change.Status != ScheduledChangeStatusPending {
+		return nil, nil, ErrScheduledChangeNotPending
+	}
+
+	change.Status = ScheduledChangeStatusCanceled
+	change.CanceledAt = null.TimeFrom(time.Now().UTC())
+
+	var event *models.AuditEvent
+
+	if err := s.withTx(ctx, func(tx *sql.Tx) error {
+		// only cancel the change if the worker didn't pick it up meanwhile
+		rows, err := models.ScheduledMembershipChanges(
+			qm.Where("id = ?", change.ID),
+			qm.And("status = ?", ScheduledChangeStatusPending),
+		).UpdateAll(ctx, tx, models.M{
+			models.ScheduledMembershipChangeColumns.Status:     change.Status,
+			models.ScheduledMembershipChangeColumns.CanceledAt: change.CanceledAt,
+			models.ScheduledMembershipChangeColumns.UpdatedAt:  change.CanceledAt,
+		})
+		if err != nil {
+			return fmt.Errorf("error canceling scheduled membership change: %w", err)
+		}
+
+		if rows == 0 {
+			return ErrScheduledChangeNotPending
+		}
+
+		event, err = dbtools.AuditScheduledMembershipChangeCanceled(ctx, tx, actor.AuditID, actor.User, change)
+		if err != nil {
+			return fmt.Errorf("error canceling scheduled membership change (audit): %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	return change, event, nil
+}
+
+// ListScheduledMembershipChanges returns the scheduled membership changes
+// matching the mods, the next ones first
+func (s *Service) ListScheduledMembershipChanges(ctx context.Context, mods ...qm.QueryMod) (models.ScheduledMembershipChangeSlice, error) {
+	changes, err := models.ScheduledMembershipChanges(
+		append(mods, qm.OrderBy("scheduled_for, id"))...,
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error listing scheduled membership changes: %w", err)
+	}
+
+	return changes, nil
+}
+
+// ApplyScheduledMembershipChanges applies the pending membership changes whose
+// scheduled time passed. Each change is applied as the user who scheduled it,
+// with the scheduling audit event as the parent, so the membership audit and
+// member events are the same as for a direct change. Changes that can't be
+// applied, like when the user already left the group, are marked as failed
+// with the error. The number of changes applied is returned.
+func (s *Service) ApplyScheduledMembershipChanges(ctx context.Context) (int, error) {
+	due, err := models.ScheduledMembershipChanges(
+		qm.Where("status = ?", ScheduledChangeStatusPending),
+		qm.And("scheduled_for <= ?", time.Now()),
+		qm.OrderBy("scheduled_for"),
+		qm.Limit(scheduledChangeBatchSize),
+	).All(ctx, s.db)
+	if err != nil {
+		return 0, fmt.Errorf("error getting due scheduled membership changes: %w", err)
+	}
+
+	applied := 0
+
+	for _, change := range due {
+		actor, err := s.scheduledChangeActor(ctx, change)
+		if err != nil {
+			return applied, err
+		}
+
+		var event *models.AuditEvent
+
+		switch change.Action {
+		case ScheduledChangeActionAdd:
+			event, err = s.AddMember(ctx, actor, change.GroupID, change.UserID, AddMemberParams{
+				IsAdmin:        change.IsAdmin,
+				ExpiresAt:      change.ExpiresAt,
+				AdminExpiresAt: change.AdminExpiresAt,
+				Justification:  change.Justification,
+			})
+		case ScheduledChangeActionRemove:
+			event, err = s.RemoveMember(ctx, actor, change.GroupID, change.UserID)
+		}
+
+		// the membership change is committed when the audit event is
+		// returned, even if publishing the events failed, unless the
+		// event records the change was denied on a frozen group
+		if event != nil && !errors.Is(err, ErrGroupFrozen) {
+			change.Status = ScheduledChangeStatusApplied
+			change.AppliedAt = null.TimeFrom(time.Now().UTC())
+			applied++
+
+			if err != nil {
+				s.logger.Warn("scheduled membership change applied with errors", zap.String("change.id", change.ID), zap.Error(err))
+			}
+		} else {
+			change.Status = ScheduledChangeStatusFailed
+			change.Error = fmt.Sprintf("%v", err)
+		}
+
+		if err := s.finishScheduledChange(ctx, change); err != nil {
+			return applied, err
+		}
+	}
+
+	return applied, nil
+}
+
+// scheduledChangeActor returns the actor applying a scheduled change, the
+// user who scheduled it when they still exist
+func (s *Service) scheduledChangeActor(ctx context.Context, change *models.ScheduledMembershipChange) (Actor, error) {
+	actor := Actor{AuditID: change.AuditID.String}
+
+	if !change.CreatedBy.Valid {
+		return actor, nil
+	}
+
+	user, err := models.FindUser(ctx, s.db, change.CreatedBy.String)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return actor, fmt.Errorf("error getting scheduled membership change creator: %w", err)
+	}
+
+	actor.User = user
+
+	return actor, nil
+}
+
+// finishScheduledChange saves the outcome of an applied or failed scheduled
+// change, an audit event is recorded for the failed ones
+func (s *Service) finishScheduledChange(ctx context.Context, change *models.ScheduledMembershipChange) error {
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		if _, err := change.Update(ctx, tx, boil.Whitelist(
+			models.ScheduledMembershipChangeColumns.Status,
+			models.ScheduledMembershipChangeColumns.Error,
+			models.ScheduledMembershipChangeColumns.AppliedAt,
+			models.ScheduledMembershipChangeColumns.UpdatedAt,
+		)); err != nil {
+			return fmt.Errorf("error updating scheduled membership change: %w", err)
+		}
+
+		if change.Status != ScheduledChangeStatusFailed {
+			return nil
+		}
+
+		if _, err := dbtools.AuditScheduledMembershipChangeFailed(ctx, tx, change); err != nil {
+			return fmt.Errorf("error updating scheduled membership change (audit): %w", err)
+		}
+
+		return nil
+	})
+}
+
+// RunScheduledMembershipChanges applies the due scheduled membership changes
+// every interval until the context is canceled
+func (s *Service) RunScheduledMembershipChanges(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if applied, err := s.ApplyScheduledMembershipChanges(ctx); err != nil {
+			s.logger.Warn("error applying scheduled membership changes", zap.Int("applied", applied), zap.Error(err))
+		} else if applied > 0 {
+			s.logger.Info("applied scheduled membership changes", zap.Int("applied", applied))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestCheckScheduledChange(t *testing.T) {
+	now := time.Now()
+	later := now.Add(24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		params  ScheduleMembershipChangeParams
+		wantErr bool
+	}{
+		{
+			name:   "add",
+			params: ScheduleMembershipChangeParams{Action: ScheduledChangeActionAdd, ScheduledFor: later, IsAdmin: true, ExpiresAt: null.TimeFrom(later.Add(time.Hour))},
+		},
+		{
+			name:   "remove",
+			params: ScheduleMembershipChangeParams{Action: ScheduledChangeActionRemove, ScheduledFor: later},
+		},
+		{
+			name:    "unknown action",
+			params:  ScheduleMembershipChangeParams{Action: "promote", ScheduledFor: later},
+			wantErr: true,
+		},
+		{
+			name:    "in the past",
+			params:  ScheduleMembershipChangeParams{Action: ScheduledChangeActionAdd, ScheduledFor: now.Add(-time.Minute)},
+			wantErr: true,
+		},
+		{
+			name:    "expires before the scheduled time",
+			params:  ScheduleMembershipChangeParams{Action: ScheduledChangeActionAdd, ScheduledFor: later, ExpiresAt: null.TimeFrom(now.Add(time.Hour))},
+			wantErr: true,
+		},
+		{
+			name:    "remove with admin settings",
+			params:  ScheduleMembershipChangeParams{Action: ScheduledChangeActionRemove, ScheduledFor: later, IsAdmin: true},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkScheduledChange(tt.params, now)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidScheduledChange)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/auditchain"
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// Service provides governor operations backed by the database and event bus
+type Service struct {
+	db         *sqlx.DB
+	eventBus   eventbus.EventBus
+	logger     *zap.Logger
+	auditChain *auditchain.Chain
+}
+
+// Option is a functional configuration option for the service
+type Option func(s *Service)
+
+// New returns a new service
+func New(opts ...Option) *Service {
+	s := Service{
+		logger: zap.NewNop(),
+	}
+
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	return &s
+}
+
+// WithDB sets the service database
+func WithDB(db *sqlx.DB) Option {
+	return func(s *Service) {
+		s.db = db
+	}
+}
+
+// WithEventBus sets the event bus client used to publish events
+func WithEventBus(eb eventbus.EventBus) Option {
+	return func(s *Service) {
+		s.eventBus = eb
+	}
+}
+
+// WithLogger sets the service logger
+func WithLogger(l *zap.Logger) Option {
+	return func(s *Service) {
+		if l != nil {
+			s.logger = l
+		}
+	}
+}
+
+// WithAuditChain sets the audit chain, the redactions of the audit events
+// covered by its checkpoints are recorded with it
+func WithAuditChain(c *auditchain.Chain) Option {
+	return func(s *Service) {
+		s.auditChain = c
+	}
+}
+
+// withTx runs fn in a transaction, the transaction is committed when fn
+// succeeds and rolled back otherwise
+func (s *Service) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	return dbtools.WithTransaction(ctx, s.db, fn)
+}
+
+// publish publishes an event on the event bus, it is a no-op when the service
+// has no event bus. The errors are wrapped with ErrEventNotPublished.
+func (s *Service) publish(ctx context.Context, sub string, event *events.Event) error {
+	if s.eventBus == nil {
+		return nil
+	}
+
+	if err := s.eventBus.Publish(ctx, sub, event); err != nil {
+		return fmt.Errorf("%w: %w", ErrEventNotPublished, err)
+	}
+
+	return nil
+}
+
+// publishErrors collects the errors of publishing a sequence of events, the
+// sequence goes on after the events queued in the outbox since they are
+// published later
+type publishErrors struct {
+	err error
+}
+
+// add records the error of publishing an event, it returns false when the
+// sequence should stop. The errors of the events that weren't queued are kept
+// over the queued ones.
+func (p *publishErrors) add(err error) bool {
+	if err == nil {
+		return true
+	}
+
+	queued := errors.Is(err, eventbus.ErrQueued)
+
+	if p.err == nil || !queued {
+		p.err = err
+	}
+
+	return queued
+}
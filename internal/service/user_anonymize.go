@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// anonymizedEmailDomain is the domain of the emails of the anonymized users,
+// the .invalid tld is reserved so the addresses can never be delivered to
+const anonymizedEmailDomain = "anonymized.invalid"
+
+// personalUserFields are the user fields holding personal data, as named in
+// the audit changesets
+var personalUserFields = []string{"Name", "Email", "ExternalID", "AvatarURL", "GithubUsername", "GithubID"}
+
+// AnonymizeUser erases the personal data of a user. The name, email, avatar,
+// external id and github attributes are replaced by an opaque identifier
+// derived from the user id, so the memberships, requests and audit events of
+// the user stay linked to it. Pending email changes are removed and the
+// personal data is redacted from the changesets of the earlier audit events of
+// the user. Active users must be suspended or deleted first. The erasure is
+// audited without the scrubbed values and an erase event is published for every user, since
+// downstream systems may hold the data of deleted users too.
+func (s *Service) AnonymizeUser(ctx context.Context, actor Actor, id string) (*models.User, []*models.AuditEvent, error) {
+	user, err := s.FindUser(ctx, id, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if user.AnonymizedAt.Valid {
+		return nil, nil, ErrUserAlreadyAnonymized
+	}
+
+	if !user.DeletedAt.Valid && user.Status.String == "active" {
+		return nil, nil, ErrUserActive
+	}
+
+	var event *models.AuditEvent
+
+	if err := s.withTx(ctx, func(tx *sql.Tx) error {
+		if _, err := models.UserEmailChanges(qm.Where("user_id = ?", user.ID)).DeleteAll(ctx, tx); err != nil {
+			return fmt.Errorf("error removing email changes: %w", err)
+		}
+
+		if err := s.redactUserAuditEvents(ctx, tx, user.ID); err != nil {
+			return fmt.Errorf("error redacting audit events: %w", err)
+		}
+
+		scrubbed := anonymizeUser(user, time.Now())
+
+		if _, err := user.Update(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("error anonymizing user: %w", err)
+		}
+
+		event, err = dbtools.AuditUserAnonymized(ctx, tx, actor.AuditID, actor.User, user, scrubbed)
+		if err != nil {
+			return fmt.Errorf("error anonymizing user (audit): %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	auditEvents := []*models.AuditEvent{event}
+
+	if err := s.publish(ctx, events.GovernorUsersEventSubject, &events.Event{
+		Version: events.Version,
+		Action:  events.GovernorEventErase,
+		AuditID: actor.AuditID,
+		ActorID: actor.ID(),
+		UserID:  user.ID,
+	}); err != nil {
+		return user, auditEvents, fmt.Errorf("failed to publish user erase event, downstream changes may be delayed: %w", err)
+	}
+
+	return user, auditEvents, nil
+}
+
+// redactUserAuditEvents redacts the personal data from the changesets of the
+// audit events of a user. The redactions of the events already sealed by the
+// audit chain are recorded first, so the chain can still be verified.
+func (s *Service) redactUserAuditEvents(ctx context.Context, exec boil.ContextExecutor, userID string) error {
+	auditEvents, err := dbtools.UserAuditEventsWithFields(ctx, exec, userID, personalUserFields)
+	if err != nil {
+		return err
+	}
+
+	if len(auditEvents) == 0 {
+		return nil
+	}
+
+	if s.auditChain != nil {
+		if err := s.auditChain.Redact(ctx, exec, auditEvents); err != nil {
+			return err
+		}
+	}
+
+	return dbtools.RedactAuditEvents(ctx, exec, auditEvents, personalUserFields)
+}
+
+// anonymizeUser replaces the personal data of the user with an opaque
+// identifier and returns the names of the fields it scrubbed
+func anonymizeUser(user *models.User, now time.Time) []string {
+	opaque := "anonymized-" + user.ID
+
+	scrubbed := []string{"Name", "Email"}
+
+	user.Name = opaque
+	user.Email = opaque + "@" + anonymizedEmailDomain
+
+	for _, f := range []struct {
+		name  string
+		value *null.String
+	}{
+		{"ExternalID", &user.ExternalID},
+		{"AvatarURL", &user.AvatarURL},
+		{"GithubUsername", &user.GithubUsername},
+	} {
+		if f.value.Valid {
+			scrubbed = append(scrubbed, f.name)
+			*f.value = null.String{}
+		}
+	}
+
+	if user.GithubID.Valid {
+		scrubbed = append(scrubbed, "GithubID")
+		user.GithubID = null.Int64{}
+	}
+
+	user.AnonymizedAt = null.TimeFrom(now)
+
+	return scrubbed
+}
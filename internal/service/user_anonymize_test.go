@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestAnonymizeUser(t *testing.T) {
+	now := time.Now()
+
+	user := &models.User{
+		ID:             "9f4f7c2e-0d2a-4f0b-8d5b-3c2a1e0f9b7d",
+		Name:           "Jane Doe",
+		Email:          "jane@example.com",
+		ExternalID:     null.StringFrom("00u1"),
+		GithubID:       null.Int64From(42),
+		GithubUsername: null.StringFrom("jane"),
+		Status:         null.StringFrom("suspended"),
+	}
+
+	scrubbed := anonymizeUser(user, now)
+
+	assert.Equal(t, []string{"Name", "Email", "ExternalID", "GithubUsername", "GithubID"}, scrubbed)
+	assert.Equal(t, "anonymized-9f4f7c2e-0d2a-4f0b-8d5b-3c2a1e0f9b7d", user.Name)
+	assert.Equal(t, "anonymized-9f4f7c2e-0d2a-4f0b-8d5b-3c2a1e0f9b7d@anonymized.invalid", user.Email)
+	assert.False(t, user.ExternalID.Valid)
+	assert.False(t, user.AvatarURL.Valid)
+	assert.False(t, user.GithubID.Valid)
+	assert.False(t, user.GithubUsername.Valid)
+	assert.Equal(t, null.TimeFrom(now), user.AnonymizedAt)
+	assert.Equal(t, "suspended", user.Status.String, "the status is kept")
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// UserExport is everything governor stores about a user
+type UserExport struct {
+	User                         *models.User
+	GroupMemberships             models.GroupMembershipSlice
+	GroupMembershipRequests      models.GroupMembershipRequestSlice
+	GroupApplicationRequests     models.GroupApplicationRequestSlice
+	ArchivedRequests             models.ArchivedRequestSlice
+	RequestComments              models.RequestCommentSlice
+	NotificationPreferences      dbtools.UserNotificationPreferences
+	NotificationGroupPreferences dbtools.UserNotificationGroupPreferences
+	NotificationFailover         *dbtools.UserNotificationFailover
+	NotificationDispatches       models.NotificationDispatchSlice
+	ExtensionResources           models.UserExtensionResourceSlice
+	EmailChanges                 models.UserEmailChangeSlice
+	AuditEvents                  models.AuditEventSlice
+}
+
+// ExportUser collects everything governor stores about a user, including
+// deleted users and their deleted extension resources, for data-subject access
+// requests. Only the audit events the user is the subject of are included.
+func (s *Service) ExportUser(ctx context.Context, id string) (*UserExport, error) {
+	user, err := s.FindUser(ctx, id, true)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &UserExport{User: user}
+
+	if export.GroupMemberships, err = models.GroupMemberships(
+		qm.Where("user_id = ?", user.ID),
+		qm.OrderBy("created_at"),
+	).All(ctx, s.db); err != nil {
+		return nil, fmt.Errorf("error getting group memberships: %w", err)
+	}
+
+	if export.GroupMembershipRequests, err = models.GroupMembershipRequests(
+		qm.Where("user_id = ?", user.ID),
+		qm.OrderBy("created_at"),
+	).All(ctx, s.db); err != nil {
+		return nil, fmt.Errorf("error getting group membership requests: %w", err)
+	}
+
+	if export.GroupApplicationRequests, err = models.GroupApplicationRequests(
+		qm.Where("requester_user_id = ?", user.ID),
+		qm.OrderBy("created_at"),
+	).All(ctx, s.db); err != nil {
+		return nil, fmt.Errorf("error getting group application requests: %w", err)
+	}
+
+	if export.ArchivedRequests, err = models.ArchivedRequests(
+		qm.Where("user_id = ?", user.ID),
+		qm.Or("requester_user_id = ?", user.ID),
+		qm.Or("decided_by_user_id = ?", user.ID),
+		qm.OrderBy("decided_at"),
+	).All(ctx, s.db); err != nil {
+		return nil, fmt.Errorf("error getting archived requests: %w", err)
+	}
+
+	if export.RequestComments, err = models.RequestComments(
+		qm.Where("user_id = ?", user.ID),
+		qm.OrderBy("created_at"),
+	).All(ctx, s.db); err != nil {
+		return nil, fmt.Errorf("error getting request comments: %w", err)
+	}
+
+	if export.NotificationPreferences, err = dbtools.GetNotificationPreferences(ctx, user.ID, s.db, false); err != nil {
+		return nil, fmt.Errorf("error getting notification preferences: %w", err)
+	}
+
+	if export.NotificationGroupPreferences, err = dbtools.GetNotificationGroupPreferences(ctx, user.ID, s.db); err != nil {
+		return nil, fmt.Errorf("error getting notification group preferences: %w", err)
+	}
+
+	if export.NotificationFailover, err = dbtools.GetNotificationFailover(ctx, user.ID, s.db); err != nil {
+		return nil, fmt.Errorf("error getting notification failover: %w", err)
+	}
+
+	if export.NotificationDispatches, err = models.NotificationDispatches(
+		qm.Where("user_id = ?", user.ID),
+		qm.OrderBy("created_at"),
+	).All(ctx, s.db); err != nil {
+		return nil, fmt.Errorf("error getting notification dispatches: %w", err)
+	}
+
+	if export.ExtensionResources, err = models.UserExtensionResources(
+		qm.Where("user_id = ?", user.ID),
+		qm.WithDeleted(),
+		qm.OrderBy("created_at"),
+	).All(ctx, s.db); err != nil {
+		return nil, fmt.Errorf("error getting user extension resources: %w", err)
+	}
+
+	if export.EmailChanges, err = models.UserEmailChanges(
+		qm.Where("user_id = ?", user.ID),
+		qm.OrderBy("created_at"),
+	).All(ctx, s.db); err != nil {
+		return nil, fmt.Errorf("error getting email changes: %w", err)
+	}
+
+	if export.AuditEvents, err = models.AuditEvents(
+		qm.Where("subject_user_id = ?", user.ID),
+		qm.OrderBy("created_at"),
+	).All(ctx, s.db); err != nil {
+		return nil, fmt.Errorf("error getting audit events: %w", err)
+	}
+
+	return export, nil
+}
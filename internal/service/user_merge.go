@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// DuplicateUsers is a set of users that probably belong to the same person,
+// Reason is the attribute they share and Match its value
+type DuplicateUsers struct {
+	Reason string
+	Match  string
+	Users  models.UserSlice
+}
+
+// UserMerge is the outcome of merging a duplicate user into another user
+type UserMerge struct {
+	// User is the user the duplicate was merged into
+	User *models.User
+	// Merged is the duplicate user, which is soft deleted
+	Merged *models.User
+	// MovedMemberships are the ids of the groups whose membership was moved to the user
+	MovedMemberships []string
+	// CombinedMemberships are the ids of the groups both users were members of
+	CombinedMemberships []string
+	// Records counts the other records moved to the user
+	Records *dbtools.UserMergeCounts
+}
+
+// ListDuplicateUsers returns the sets of users sharing an email address or a github id
+func (s *Service) ListDuplicateUsers(ctx context.Context) ([]*DuplicateUsers, error) {
+	dups, err := dbtools.GetDuplicateUsers(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error finding duplicate users: %w", err)
+	}
+
+	out := make([]*DuplicateUsers, 0, len(dups))
+
+	for _, d := range dups {
+		users, err := models.Users(
+			qm.WhereIn("id IN ?", stringsToInterfaces(d.UserIDs)...),
+			tenancy.Scope(ctx, models.TableNames.Users),
+			qm.OrderBy("created_at, id"),
+		).All(ctx, s.db)
+		if err != nil {
+			return nil, fmt.Errorf("error getting duplicate users: %w", err)
+		}
+
+		out = append(out, &DuplicateUsers{
+			Reason: d.Reason,
+			Match:  d.Match,
+			Users:  users,
+		})
+	}
+
+	return out, nil
+}
+
+// MergeUsers merges the user otherID into the user id. Group memberships,
+// membership and application requests, user extension resources,
+// notification preferences, request comments and audit events of the other
+// user are moved onto the user, the profile attributes the user lacks are
+// copied over and the other user is soft deleted. Every change is audited and
+// the audit events are returned even when publishing fails since the merge is
+// already committed.
+func (s *Service) MergeUsers(ctx context.Context, actor Actor, id, otherID string) (*UserMerge, []*models.AuditEvent, error) {
+	user, err := s.FindUser(ctx, id, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	other, err := s.FindUser(ctx, otherID, false, qm.Load("GroupMemberships"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if user.ID == other.ID {
+		return nil, nil, ErrMergeSameUser
+	}
+
+	result := &UserMerge{
+		User:                user,
+		Merged:              other,
+		MovedMemberships:    []string{},
+		CombinedMemberships: []string{},
+	}
+
+	var (
+		auditEvents                         []*models.AuditEvent
+		membershipsBefore, membershipsAfter []dbtools.EnumeratedMembership
+	)
+
+	if err := s.withTx(ctx, func(tx *sql.Tx) error {
+		var err error
+
+		membershipsBefore, err = dbtools.GetMembershipsForUser(ctx, tx, user.ID, false)
+		if err != nil {
+			return fmt.Errorf("failed to compute new effective memberships: %w", err)
+		}
+
+		// move the other records first, so the audit events of the merge itself
+		// keep pointing at the merged user
+		result.Records, err = dbtools.MergeUserRecords(ctx, tx, user.ID, other.ID)
+		if err != nil {
+			return err
+		}
+
+		memEvents, err := s.mergeMemberships(ctx, tx, actor, user, other, result)
+		if err != nil {
+			return err
+		}
+
+		auditEvents = append(auditEvents, memEvents...)
+
+		originalOther := *other
+		if _, err := other.Delete(ctx, tx, false); err != nil {
+			return fmt.Errorf("error deleting merged user: %w", err)
+		}
+
+		event, err := dbtools.AuditUserDeleted(ctx, tx, actor.AuditID, actor.User, &originalOther, other)
+		if err != nil {
+			return fmt.Errorf("error deleting merged user (audit): %w", err)
+		}
+
+		auditEvents = append(auditEvents, event)
+
+		original := *user
+		mergeUserAttributes(user, other)
+
+		if _, err := user.Update(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("error updating user: %w", err)
+		}
+
+		event, err = dbtools.AuditUserMerged(ctx, tx, actor.AuditID, actor.User, &original, user, other)
+		if err != nil {
+			return fmt.Errorf("error merging users (audit): %w", err)
+		}
+
+		auditEvents = append(auditEvents, event)
+
+		membershipsAfter, err = dbtools.GetMembershipsForUser(ctx, tx, user.ID, false)
+		if err != nil {
+			return fmt.Errorf("failed to compute new effective memberships: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	var perr publishErrors
+
+	if isActiveUser(other) {
+		if err := s.publish(ctx, events.GovernorUsersEventSubject, &events.Event{
+			Version: events.Version,
+			Action:  events.GovernorEventDelete,
+			AuditID: actor.AuditID,
+			UserID:  other.ID,
+			ActorID: actor.ID(),
+		}); err != nil && !perr.add(fmt.Errorf("failed to publish user delete event, downstream changes may be delayed: %w", err)) {
+			return result, auditEvents, perr.err
+		}
+	}
+
+	// only publish events for active users
+	if !isActiveUser(user) {
+		return result, auditEvents, perr.err
+	}
+
+	if err := s.publish(ctx, events.GovernorUsersEventSubject, &events.Event{
+		Version: events.Version,
+		Action:  events.GovernorEventUpdate,
+		AuditID: actor.AuditID,
+		UserID:  user.ID,
+		ActorID: actor.ID(),
+	}); err != nil && !perr.add(fmt.Errorf("failed to publish user update event, downstream changes may be delayed: %w", err)) {
+		return result, auditEvents, perr.err
+	}
+
+	perr.add(s.publishMembers(ctx, actor, events.GovernorEventCreate, dbtools.FindMemberDiff(membershipsBefore, membershipsAfter)))
+
+	return result, auditEvents, perr.err
+}
+
+// mergeMemberships moves the group memberships of other to user. When both
+// are members of a group the memberships are combined into the user's one.
+func (s *Service) mergeMemberships(ctx context.Context, tx *sql.Tx, actor Actor, user, other *models.User, result *UserMerge) ([]*models.AuditEvent, error) {
+	userMems, err := models.GroupMemberships(qm.Where("user_id = ?", user.ID)).All(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting group memberships: %w", err)
+	}
+
+	byGroup := make(map[string]*models.GroupMembership, len(userMems))
+	for _, m := range userMems {
+		byGroup[m.GroupID] = m
+	}
+
+	auditEvents := []*models.AuditEvent{}
+
+	for _, m := range other.R.GetGroupMemberships() {
+		if existing, ok := byGroup[m.GroupID]; ok {
+			original := *existing
+
+			if combineMemberships(existing, m) {
+				if _, err := existing.Update(ctx, tx, boil.Infer()); err != nil {
+					return nil, fmt.Errorf("failed to update group membership: %w", err)
+				}
+
+				event, err := dbtools.AuditGroupMembershipUpdated(ctx, tx, actor.AuditID, actor.User, &original, existing)
+				if err != nil {
+					return nil, fmt.Errorf("error updating group membership (audit): %w", err)
+				}
+
+				auditEvents = append(auditEvents, event)
+			}
+
+			if _, err := m.Delete(ctx, tx); err != nil {
+				return nil, fmt.Errorf("failed to delete group membership: %w", err)
+			}
+
+			event, err := dbtools.AuditGroupMembershipDeleted(ctx, tx, actor.AuditID, actor.User, m)
+			if err != nil {
+				return nil, fmt.Errorf("error deleting group membership (audit): %w", err)
+			}
+
+			auditEvents = append(auditEvents, event)
+			result.CombinedMemberships = append(result.CombinedMemberships, m.GroupID)
+
+			continue
+		}
+
+		original := *m
+		m.UserID = user.ID
+
+		if _, err := m.Update(ctx, tx, boil.Infer()); err != nil {
+			return nil, fmt.Errorf("failed to move group membership: %w", err)
+		}
+
+		event, err := dbtools.AuditGroupMembershipDeleted(ctx, tx, actor.AuditID, actor.User, &original)
+		if err != nil {
+			return nil, fmt.Errorf("error moving group membership (audit): %w", err)
+		}
+
+		auditEvents = append(auditEvents, event)
+
+		event, err = dbtools.AuditGroupMembershipCreated(ctx, tx, actor.AuditID, actor.User, m, "")
+		if err != nil {
+			return nil, fmt.Errorf("error moving group membership (audit): %w", err)
+		}
+
+		auditEvents = append(auditEvents, event)
+		result.MovedMemberships = append(result.MovedMemberships, m.GroupID)
+	}
+
+	return auditEvents, nil
+}
+
+// combineMemberships folds the membership m into existing, keeping the admin
+// role if either has it and the later expiry, and returns true when existing
+// changed
+func combineMemberships(existing, m *models.GroupMembership) bool {
+	original := *existing
+
+	existing.ExpiresAt = laterExpiry(existing.ExpiresAt, m.ExpiresAt)
+
+	switch {
+	case existing.IsAdmin && m.IsAdmin:
+		existing.AdminExpiresAt = laterExpiry(existing.AdminExpiresAt, m.AdminExpiresAt)
+	case m.IsAdmin:
+		existing.IsAdmin = true
+		existing.AdminExpiresAt = m.AdminExpiresAt
+	}
+
+	return original.IsAdmin != existing.IsAdmin ||
+		!original.ExpiresAt.Time.Equal(existing.ExpiresAt.Time) || original.ExpiresAt.Valid != existing.ExpiresAt.Valid ||
+		!original.AdminExpiresAt.Time.Equal(existing.AdminExpiresAt.Time) || original.AdminExpiresAt.Valid != existing.AdminExpiresAt.Valid
+}
+
+// laterExpiry returns the later of two expiries, a null expiry never expires
+func laterExpiry(a, b null.Time) null.Time {
+	if !a.Valid || !b.Valid {
+		return null.Time{}
+	}
+
+	if b.Time.After(a.Time) {
+		return b
+	}
+
+	return a
+}
+
+// mergeUserAttributes copies the profile attributes user lacks from other and
+// adds up their logins
+func mergeUserAttributes(user, other *models.User) {
+	if !user.ExternalID.Valid {
+		user.ExternalID = other.ExternalID
+	}
+
+	if !user.GithubID.Valid {
+		user.GithubID = other.GithubID
+	}
+
+	if !user.GithubUsername.Valid {
+		user.GithubUsername = other.GithubUsername
+	}
+
+	if !user.AvatarURL.Valid {
+		user.AvatarURL = other.AvatarURL
+	}
+
+	if other.LastLoginAt.Valid && (!user.LastLoginAt.Valid || other.LastLoginAt.Time.After(user.LastLoginAt.Time)) {
+		user.LastLoginAt = other.LastLoginAt
+	}
+
+	user.LoginCount += other.LoginCount
+}
+
+func stringsToInterfaces(s []string) []interface{} {
+	out := make([]interface{}, len(s))
+	for i, v := range s {
+		out[i] = v
+	}
+
+	return out
+}
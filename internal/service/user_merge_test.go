@@ -0,0 +1,104 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestCombineMemberships(t *testing.T) {
+	early := null.TimeFrom(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	late := null.TimeFrom(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name        string
+		existing    models.GroupMembership
+		other       models.GroupMembership
+		want        models.GroupMembership
+		wantChanged bool
+	}{
+		{
+			name:     "same membership",
+			existing: models.GroupMembership{ExpiresAt: early},
+			other:    models.GroupMembership{ExpiresAt: early},
+			want:     models.GroupMembership{ExpiresAt: early},
+		},
+		{
+			name:        "later expiry wins",
+			existing:    models.GroupMembership{ExpiresAt: early},
+			other:       models.GroupMembership{ExpiresAt: late},
+			want:        models.GroupMembership{ExpiresAt: late},
+			wantChanged: true,
+		},
+		{
+			name:        "no expiry wins",
+			existing:    models.GroupMembership{ExpiresAt: late},
+			other:       models.GroupMembership{},
+			want:        models.GroupMembership{},
+			wantChanged: true,
+		},
+		{
+			name:        "admin is kept",
+			existing:    models.GroupMembership{},
+			other:       models.GroupMembership{IsAdmin: true, AdminExpiresAt: early},
+			want:        models.GroupMembership{IsAdmin: true, AdminExpiresAt: early},
+			wantChanged: true,
+		},
+		{
+			name:     "non admin other is ignored",
+			existing: models.GroupMembership{IsAdmin: true, AdminExpiresAt: late},
+			other:    models.GroupMembership{},
+			want:     models.GroupMembership{IsAdmin: true, AdminExpiresAt: late},
+		},
+		{
+			name:        "later admin expiry wins",
+			existing:    models.GroupMembership{IsAdmin: true, AdminExpiresAt: early},
+			other:       models.GroupMembership{IsAdmin: true, AdminExpiresAt: late},
+			want:        models.GroupMembership{IsAdmin: true, AdminExpiresAt: late},
+			wantChanged: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := tt.existing
+
+			changed := combineMemberships(&existing, &tt.other)
+			assert.Equal(t, tt.wantChanged, changed)
+			assert.Equal(t, tt.want, existing)
+		})
+	}
+}
+
+func TestMergeUserAttributes(t *testing.T) {
+	user := &models.User{
+		Email:       "hjass@example.com",
+		LoginCount:  2,
+		AvatarURL:   null.StringFrom("https://example.com/a.png"),
+		LastLoginAt: null.TimeFrom(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	other := &models.User{
+		Email:          "hjass+work@example.com",
+		LoginCount:     3,
+		AvatarURL:      null.StringFrom("https://example.com/b.png"),
+		GithubID:       null.Int64From(1234),
+		GithubUsername: null.StringFrom("hjass"),
+		LastLoginAt:    null.TimeFrom(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	mergeUserAttributes(user, other)
+
+	assert.Equal(t, &models.User{
+		Email:          "hjass@example.com",
+		LoginCount:     5,
+		AvatarURL:      null.StringFrom("https://example.com/a.png"),
+		GithubID:       null.Int64From(1234),
+		GithubUsername: null.StringFrom("hjass"),
+		LastLoginAt:    null.TimeFrom(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+	}, user)
+}
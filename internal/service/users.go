@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+)
+
+// UserDetails is a user with the ids of its groups and membership requests
+type UserDetails struct {
+	User                    *models.User
+	Memberships             []string
+	MembershipsDirect       []string
+	MembershipRequests      []string
+	NotificationPreferences dbtools.UserNotificationPreferences
+}
+
+// FindUser returns the user with the given id
+func (s *Service) FindUser(ctx context.Context, id string, deleted bool, mods ...qm.QueryMod) (*models.User, error) {
+	mods = append(mods, qm.Where("id = ?", id), tenancy.Scope(ctx, models.TableNames.Users))
+
+	if deleted {
+		mods = append(mods, qm.WithDeleted())
+	}
+
+	user, err := models.Users(mods...).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ListUsers returns the users matching the query mods
+func (s *Service) ListUsers(ctx context.Context, deleted bool, mods ...qm.QueryMod) (models.UserSlice, error) {
+	if deleted {
+		mods = append(mods, qm.WithDeleted())
+	}
+
+	mods = append(mods, tenancy.Scope(ctx, models.TableNames.Users))
+
+	return models.Users(mods...).All(ctx, s.db)
+}
+
+// GetUser returns a user with its group memberships, membership requests and notification preferences
+func (s *Service) GetUser(ctx context.Context, id string, deleted bool) (*UserDetails, error) {
+	user, err := s.FindUser(ctx, id, deleted, qm.Load("GroupMembershipRequests"))
+	if err != nil {
+		return nil, err
+	}
+
+	enumeratedMemberships, err := dbtools.GetMembershipsForUser(ctx, s.db.DB, user.ID, false)
+	if err != nil {
+		return nil, fmt.Errorf("error enumerating group membership: %w", err)
+	}
+
+	details := &UserDetails{
+		User:               user,
+		Memberships:        make([]string, len(enumeratedMemberships)),
+		MembershipsDirect:  make([]string, 0),
+		MembershipRequests: make([]string, len(user.R.GroupMembershipRequests)),
+	}
+
+	for i, m := range enumeratedMemberships {
+		details.Memberships[i] = m.GroupID
+
+		if m.Direct {
+			details.MembershipsDirect = append(details.MembershipsDirect, m.GroupID)
+		}
+	}
+
+	for i, r := range user.R.GroupMembershipRequests {
+		details.MembershipRequests[i] = r.GroupID
+	}
+
+	details.NotificationPreferences, err = dbtools.GetNotificationPreferences(ctx, user.ID, s.db, true)
+	if err != nil {
+		return nil, fmt.Errorf("error getting notification preferences: %w", err)
+	}
+
+	return details, nil
+}
@@ -0,0 +1,5 @@
+// Package sessiontokens provides the short-lived session tokens of the UI. A
+// user exchanges their OIDC token for a session token that is read-only,
+// scoped to a single group or both, so a token leaking from a browser can't
+// be used with the full permissions of the user.
+package sessiontokens
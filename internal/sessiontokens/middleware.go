@@ -0,0 +1,156 @@
+package sessiontokens
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.hollow.sh/toolbox/ginauth"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const (
+	// ContextKey is the gin context key of the session token authenticating
+	// the request
+	ContextKey = "gin-contextkey/session-token"
+
+	// oidcScope is the scope of the user tokens, the routes that don't
+	// accept it are reserved to the machine tokens
+	oidcScope = "openid"
+
+	bearerParts = 2
+)
+
+var (
+	// ErrNotSessionToken is returned when the bearer token isn't a session
+	// token, the other auth middlewares verify it
+	ErrNotSessionToken = errors.New("not a session token")
+	// ErrInvalidToken is returned when the session token is unknown, expired
+	// or its user or group was deleted
+	ErrInvalidToken = errors.New("invalid or expired session token")
+	// ErrScopeDenied is returned when the session token isn't allowed on
+	// the route
+	ErrScopeDenied = errors.New("session token is not allowed on this route")
+)
+
+// Middleware verifies the session tokens, it is added to the auth middleware
+// of the api next to the identity providers
+type Middleware struct {
+	db boil.ContextExecutor
+}
+
+var _ ginauth.GenericAuthMiddleware = (*Middleware)(nil)
+
+// NewMiddleware returns a middleware verifying the session tokens stored in db
+func NewMiddleware(db boil.ContextExecutor) *Middleware {
+	return &Middleware{db: db}
+}
+
+// VerifyTokenWithScopes verifies the session token of the request. The token
+// stands for its user, so it is accepted on the routes accepting the user
+// tokens within the restrictions of its scope.
+func (m *Middleware) VerifyTokenWithScopes(c *gin.Context, scopes []string) (ginauth.ClaimMetadata, error) {
+	header := strings.Split(c.Request.Header.Get("Authorization"), " ")
+	if len(header) != bearerParts || !IsToken(header[1]) {
+		return ginauth.ClaimMetadata{}, ErrNotSessionToken
+	}
+
+	token, scope, err := m.find(c.Request.Context(), header[1])
+	if err != nil {
+		return ginauth.ClaimMetadata{}, err
+	}
+
+	route, ok := RelativeRoute(c.FullPath())
+	if !ok || !contains(scopes, oidcScope) || !scope.Allows(c.Request.Method, route, c.Param("id")) {
+		return ginauth.ClaimMetadata{}, ErrScopeDenied
+	}
+
+	c.Set(ContextKey, token)
+
+	user := token.R.GetUser()
+
+	return ginauth.ClaimMetadata{
+		Subject: user.Email,
+		User:    user.ExternalID.String,
+		Roles:   []string{oidcScope},
+	}, nil
+}
+
+// SetMetadata sets the claims of a verified session token in the context,
+// with the keys the identity provider middlewares use
+func (m *Middleware) SetMetadata(c *gin.Context, cm ginauth.ClaimMetadata) {
+	c.Set("jwt.subject", cm.Subject)
+	c.Set("jwt.user", cm.User)
+	c.Set("jwt.roles", cm.Roles)
+}
+
+// find returns an unexpired session token and its scope
+func (m *Middleware) find(ctx context.Context, bearer string) (*models.SessionToken, Scope, error) {
+	token, err := models.SessionTokens(
+		qm.Where("token_hash = ?", Hash(bearer)),
+		qm.And("expires_at > ?", time.Now()),
+		qm.Load(models.SessionTokenRels.User),
+	).One(ctx, m.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, Scope{}, ErrInvalidToken
+		}
+
+		return nil, Scope{}, err
+	}
+
+	// deleted users aren't loaded, their session tokens stop working with them
+	if token.R.GetUser() == nil {
+		return nil, Scope{}, ErrInvalidToken
+	}
+
+	scope := Scope{ReadOnly: token.ReadOnly}
+
+	// deleted groups aren't found, the tokens scoped to them stop working with them
+	if token.GroupID.Valid {
+		group, err := models.Groups(qm.Where("id = ?", token.GroupID.String)).One(ctx, m.db)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, Scope{}, ErrInvalidToken
+			}
+
+			return nil, Scope{}, err
+		}
+
+		scope.GroupID, scope.GroupSlug = group.ID, group.Slug
+	}
+
+	return token, scope, nil
+}
+
+// FromContext returns the session token authenticating the request, it
+// returns nil when the request wasn't made with a session token
+func FromContext(c *gin.Context) *models.SessionToken {
+	val, ok := c.Get(ContextKey)
+	if !ok {
+		return nil
+	}
+
+	token, ok := val.(*models.SessionToken)
+	if !ok {
+		return nil
+	}
+
+	return token
+}
+
+func contains(list []string, item string) bool {
+	for _, i := range list {
+		if i == item {
+			return true
+		}
+	}
+
+	return false
+}
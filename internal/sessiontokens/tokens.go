@@ -0,0 +1,92 @@
+package sessiontokens
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+const (
+	// TokenPrefix is the prefix of the session tokens, it tells them apart
+	// from the tokens of the identity providers and the extension tokens
+	TokenPrefix = "gst_"
+
+	tokenBytes = 32
+
+	// versionedRouteParts is the number of parts of a versioned route
+	// template split on slashes, the api version prefix takes three
+	versionedRouteParts = 4
+
+	// groupRoute is the route template, relative to the api version, of a
+	// group, group scoped tokens are only accepted on it and its subroutes
+	groupRoute = "/groups/:id"
+	// userRoute is the route template of the authenticated user, every
+	// session token can get it so the UI can tell who is logged in
+	userRoute = "/user"
+)
+
+// Scope is what a session token is restricted to
+type Scope struct {
+	// ReadOnly tokens are only accepted on the GET and HEAD requests
+	ReadOnly bool
+	// GroupID and GroupSlug identify the group the token is restricted to,
+	// the token isn't restricted to a group when GroupID is empty
+	GroupID   string
+	GroupSlug string
+}
+
+// NewToken returns a new random session token
+func NewToken() (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return TokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Hash returns the hash stored in place of a token. The tokens are random so
+// they don't need a slow password hash.
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// IsToken returns whether a bearer token is a session token
+func IsToken(bearer string) bool {
+	return strings.HasPrefix(bearer, TokenPrefix)
+}
+
+// Allows returns whether the scope allows a request, tmpl is the route
+// template relative to the api version and groupParam the value of the
+// group route parameter
+func (s Scope) Allows(method, tmpl, groupParam string) bool {
+	if s.ReadOnly && method != http.MethodGet && method != http.MethodHead {
+		return false
+	}
+
+	if s.GroupID == "" || (tmpl == userRoute && method == http.MethodGet) {
+		return true
+	}
+
+	if tmpl != groupRoute && !strings.HasPrefix(tmpl, groupRoute+"/") {
+		return false
+	}
+
+	return groupParam == s.GroupID || (s.GroupSlug != "" && groupParam == s.GroupSlug)
+}
+
+// RelativeRoute strips the api version prefix, like /api/v1alpha1, from a
+// route template. It returns false when the route isn't versioned.
+func RelativeRoute(tmpl string) (string, bool) {
+	parts := strings.SplitN(tmpl, "/", versionedRouteParts)
+	if len(parts) != versionedRouteParts || parts[0] != "" || parts[1] != "api" {
+		return "", false
+	}
+
+	return "/" + parts[3], true
+}
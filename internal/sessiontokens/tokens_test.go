@@ -0,0 +1,68 @@
+package sessiontokens
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewToken(t *testing.T) {
+	token, err := NewToken()
+	require.NoError(t, err)
+	assert.True(t, IsToken(token))
+	assert.False(t, IsToken("gxt_extension"))
+
+	other, err := NewToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+	assert.NotEqual(t, Hash(token), Hash(other))
+}
+
+func TestScopeAllows(t *testing.T) {
+	readOnly := Scope{ReadOnly: true}
+	group := Scope{GroupID: "group-id", GroupSlug: "group-slug"}
+	readOnlyGroup := Scope{ReadOnly: true, GroupID: "group-id", GroupSlug: "group-slug"}
+
+	tests := []struct {
+		name   string
+		scope  Scope
+		method string
+		tmpl   string
+		param  string
+		want   bool
+	}{
+		{"read only get", readOnly, http.MethodGet, "/groups", "", true},
+		{"read only head", readOnly, http.MethodHead, "/users/:id", "", true},
+		{"read only write", readOnly, http.MethodPost, "/groups", "", false},
+		{"group by id", group, http.MethodPut, "/groups/:id", "group-id", true},
+		{"group by slug", group, http.MethodGet, "/groups/:id/members", "group-slug", true},
+		{"other group", group, http.MethodGet, "/groups/:id", "other-group", false},
+		{"group list", group, http.MethodGet, "/groups", "", false},
+		{"group prefix", group, http.MethodGet, "/groups/:id-suffix", "group-id", false},
+		{"group user", group, http.MethodGet, "/user", "", true},
+		{"group user update", group, http.MethodPut, "/user", "", false},
+		{"group other route", group, http.MethodGet, "/users/:id", "group-id", false},
+		{"read only group", readOnlyGroup, http.MethodGet, "/groups/:id/members", "group-id", true},
+		{"read only group write", readOnlyGroup, http.MethodPut, "/groups/:id/members/:uid", "group-id", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.scope.Allows(tt.method, tt.tmpl, tt.param))
+		})
+	}
+}
+
+func TestRelativeRoute(t *testing.T) {
+	route, ok := RelativeRoute("/api/v1alpha1/groups/:id")
+	assert.True(t, ok)
+	assert.Equal(t, "/groups/:id", route)
+
+	_, ok = RelativeRoute("/healthz")
+	assert.False(t, ok)
+
+	_, ok = RelativeRoute("/api/v1alpha1")
+	assert.False(t, ok)
+}
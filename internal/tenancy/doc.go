@@ -0,0 +1,6 @@
+// Package tenancy isolates the organizations sharing a governor deployment.
+// In tenancy mode every request is scoped to the organization in the org
+// claim of its token: the groups, users and applications it creates belong
+// to that organization, and the ones belonging to other organizations can't
+// be seen or changed. Rows without an organization are shared by all of them.
+package tenancy
@@ -0,0 +1,95 @@
+package tenancy
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+var registerOnce sync.Once
+
+// RegisterHooks registers the model hooks enforcing the isolation: rows
+// inserted in a scoped context belong to its organization, selecting a row of
+// another organization behaves as if it didn't exist, and changing one fails.
+// The hooks don't do anything for unscoped contexts.
+func RegisterHooks() {
+	registerOnce.Do(func() {
+		models.AddGroupHook(boil.BeforeInsertHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.Group) error {
+			assign(ctx, &o.TenantID)
+			return nil
+		})
+		models.AddGroupHook(boil.BeforeUpsertHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.Group) error {
+			assign(ctx, &o.TenantID)
+			return nil
+		})
+		models.AddGroupHook(boil.AfterSelectHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.Group) error {
+			return selected(ctx, o.TenantID)
+		})
+		models.AddGroupHook(boil.BeforeUpdateHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.Group) error {
+			return changed(ctx, o.TenantID)
+		})
+		models.AddGroupHook(boil.BeforeDeleteHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.Group) error {
+			return changed(ctx, o.TenantID)
+		})
+
+		models.AddUserHook(boil.BeforeInsertHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.User) error {
+			assign(ctx, &o.TenantID)
+			return nil
+		})
+		models.AddUserHook(boil.BeforeUpsertHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.User) error {
+			assign(ctx, &o.TenantID)
+			return nil
+		})
+		models.AddUserHook(boil.AfterSelectHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.User) error {
+			return selected(ctx, o.TenantID)
+		})
+		models.AddUserHook(boil.BeforeUpdateHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.User) error {
+			return changed(ctx, o.TenantID)
+		})
+		models.AddUserHook(boil.BeforeDeleteHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.User) error {
+			return changed(ctx, o.TenantID)
+		})
+
+		models.AddApplicationHook(boil.BeforeInsertHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.Application) error {
+			assign(ctx, &o.TenantID)
+			return nil
+		})
+		models.AddApplicationHook(boil.BeforeUpsertHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.Application) error {
+			assign(ctx, &o.TenantID)
+			return nil
+		})
+		models.AddApplicationHook(boil.AfterSelectHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.Application) error {
+			return selected(ctx, o.TenantID)
+		})
+		models.AddApplicationHook(boil.BeforeUpdateHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.Application) error {
+			return changed(ctx, o.TenantID)
+		})
+		models.AddApplicationHook(boil.BeforeDeleteHook, func(ctx context.Context, _ boil.ContextExecutor, o *models.Application) error {
+			return changed(ctx, o.TenantID)
+		})
+	})
+}
+
+// selected makes a row of another organization look like it doesn't exist,
+// queries listing rows must be limited with Scope
+func selected(ctx context.Context, tenantID null.String) error {
+	if !Visible(ctx, tenantID) {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// changed refuses changes to rows of another organization
+func changed(ctx context.Context, tenantID null.String) error {
+	if !Visible(ctx, tenantID) {
+		return ErrCrossTenant
+	}
+
+	return nil
+}
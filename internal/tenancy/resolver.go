@@ -0,0 +1,107 @@
+package tenancy
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const defaultClaim = "org"
+
+// Resolver finds the organization a request is scoped to from the org claim
+// of its bearer token. The token is parsed without verifying it, the auth
+// middleware of every route rejects tokens that aren't valid.
+type Resolver struct {
+	db     boil.ContextExecutor
+	claim  string
+	global map[string]struct{}
+}
+
+// Option is a functional configuration option for the tenant resolver
+type Option func(r *Resolver)
+
+// NewResolver returns a resolver looking up the organizations in db
+func NewResolver(db boil.ContextExecutor, opts ...Option) *Resolver {
+	r := Resolver{
+		db:     db,
+		claim:  defaultClaim,
+		global: map[string]struct{}{},
+	}
+
+	for _, opt := range opts {
+		opt(&r)
+	}
+
+	return &r
+}
+
+// WithClaim sets the token claim holding the organization id or slug
+func WithClaim(c string) Option {
+	return func(r *Resolver) {
+		if c != "" {
+			r.claim = c
+		}
+	}
+}
+
+// WithGlobalSubjects sets the token subjects that aren't scoped to an
+// organization, usually the service accounts of the addons
+func WithGlobalSubjects(subjects []string) Option {
+	return func(r *Resolver) {
+		for _, s := range subjects {
+			r.global[s] = struct{}{}
+		}
+	}
+}
+
+// Resolve returns the id of the organization in the claim of the bearer
+// token, and an empty id for the global subjects
+func (r *Resolver) Resolve(ctx context.Context, bearer string) (string, error) {
+	token, err := jwt.ParseSigned(bearer)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrTenantRequired, err)
+	}
+
+	claims := jwt.Claims{}
+	custom := map[string]interface{}{}
+
+	if err := token.UnsafeClaimsWithoutVerification(&claims, &custom); err != nil {
+		return "", fmt.Errorf("%w: %s", ErrTenantRequired, err)
+	}
+
+	org, _ := custom[r.claim].(string)
+	org = strings.TrimSpace(org)
+
+	if org == "" {
+		if _, ok := r.global[claims.Subject]; ok {
+			return "", nil
+		}
+
+		return "", ErrTenantRequired
+	}
+
+	q := qm.Where("slug = ?", org)
+	if _, err := uuid.Parse(org); err == nil {
+		q = qm.Where("id = ?", org)
+	}
+
+	o, err := models.Organizations(q, models.OrganizationWhere.DeletedAt.IsNull(), qm.Select("id")).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("%w: %s", ErrUnknownTenant, org)
+		}
+
+		return "", err
+	}
+
+	return o.ID, nil
+}
@@ -0,0 +1,63 @@
+package tenancy
+
+import (
+	"context"
+	"errors"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type tenantKey struct{}
+
+var (
+	// ErrTenantRequired is returned when a token has no org claim in tenancy mode
+	ErrTenantRequired = errors.New("token has no organization claim")
+	// ErrUnknownTenant is returned when the org claim of a token isn't an organization
+	ErrUnknownTenant = errors.New("unknown organization")
+	// ErrCrossTenant is returned when changing a row belonging to another organization
+	ErrCrossTenant = errors.New("row belongs to another organization")
+)
+
+// WithTenant returns a context scoped to the organization
+func WithTenant(ctx context.Context, orgID string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, orgID)
+}
+
+// FromContext returns the organization a context is scoped to, and false when
+// it isn't scoped
+func FromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(tenantKey{}).(string)
+
+	return id, ok && id != ""
+}
+
+// Scope returns the query mod limiting a query on the table to the rows
+// visible to the organization of the context, it doesn't change unscoped queries
+func Scope(ctx context.Context, table string) qm.QueryMod {
+	id, ok := FromContext(ctx)
+	if !ok {
+		return qm.QueryModFunc(func(_ *queries.Query) {})
+	}
+
+	return qm.Where("("+table+".tenant_id IS NULL OR "+table+".tenant_id = ?)", id)
+}
+
+// Visible returns true when a row with the tenant id is visible to the
+// organization of the context
+func Visible(ctx context.Context, tenantID null.String) bool {
+	id, ok := FromContext(ctx)
+	if !ok {
+		return true
+	}
+
+	return !tenantID.Valid || tenantID.String == id
+}
+
+// assign sets the tenant id of a new row to the organization of the context
+func assign(ctx context.Context, tenantID *null.String) {
+	if id, ok := FromContext(ctx); ok && !tenantID.Valid {
+		*tenantID = null.StringFrom(id)
+	}
+}
@@ -0,0 +1,92 @@
+package tenancy
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestFromContext(t *testing.T) {
+	_, ok := FromContext(context.Background())
+	assert.False(t, ok)
+
+	_, ok = FromContext(WithTenant(context.Background(), ""))
+	assert.False(t, ok)
+
+	id, ok := FromContext(WithTenant(context.Background(), "org-id"))
+	assert.True(t, ok)
+	assert.Equal(t, "org-id", id)
+}
+
+func TestScope(t *testing.T) {
+	q := models.Groups(Scope(context.Background(), "groups"))
+	query, args := queries.BuildQuery(q.Query)
+	assert.NotContains(t, query, "tenant_id")
+	assert.Empty(t, args)
+
+	q = models.Groups(Scope(WithTenant(context.Background(), "org-id"), "groups"), qm.Limit(1))
+	query, args = queries.BuildQuery(q.Query)
+	assert.Contains(t, query, "groups.tenant_id IS NULL OR groups.tenant_id = $1")
+	assert.Equal(t, []interface{}{"org-id"}, args)
+}
+
+func TestVisible(t *testing.T) {
+	ctx := WithTenant(context.Background(), "org-id")
+
+	assert.True(t, Visible(context.Background(), null.StringFrom("other-id")))
+	assert.True(t, Visible(ctx, null.String{}))
+	assert.True(t, Visible(ctx, null.StringFrom("org-id")))
+	assert.False(t, Visible(ctx, null.StringFrom("other-id")))
+
+	assert.ErrorIs(t, selected(ctx, null.StringFrom("other-id")), sql.ErrNoRows)
+	assert.ErrorIs(t, changed(ctx, null.StringFrom("other-id")), ErrCrossTenant)
+}
+
+func TestAssign(t *testing.T) {
+	id := null.String{}
+	assign(context.Background(), &id)
+	assert.False(t, id.Valid)
+
+	assign(WithTenant(context.Background(), "org-id"), &id)
+	assert.Equal(t, null.StringFrom("org-id"), id)
+
+	id = null.StringFrom("other-id")
+	assign(WithTenant(context.Background(), "org-id"), &id)
+	assert.Equal(t, null.StringFrom("other-id"), id)
+}
+
+func testToken(t *testing.T, claims map[string]interface{}) string {
+	t.Helper()
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte("0123456789abcdef0123456789abcdef")}, nil)
+	require.NoError(t, err)
+
+	token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestResolveWithoutClaim(t *testing.T) {
+	r := NewResolver(nil, WithGlobalSubjects([]string{"addon"}))
+
+	_, err := r.Resolve(context.Background(), "not-a-token")
+	assert.ErrorIs(t, err, ErrTenantRequired)
+
+	_, err = r.Resolve(context.Background(), testToken(t, map[string]interface{}{"sub": "user"}))
+	assert.ErrorIs(t, err, ErrTenantRequired)
+
+	id, err := r.Resolve(context.Background(), testToken(t, map[string]interface{}{"sub": "addon"}))
+	require.NoError(t, err)
+	assert.Empty(t, id)
+}
@@ -0,0 +1,63 @@
+package v1alpha1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
+)
+
+const (
+	// eventsHeader is set on the responses of the changes whose events weren't
+	// published right away, to queued when they are in the outbox and to
+	// failed otherwise
+	eventsHeader = "X-Governor-Events"
+
+	eventsQueued = "queued"
+	eventsFailed = "failed"
+
+	// warningHeader is the standard Warning header, 199 is the miscellaneous
+	// warning code
+	warningHeader = "Warning"
+)
+
+// acceptedWriter sends the successful responses as 202 Accepted
+type acceptedWriter struct {
+	gin.ResponseWriter
+}
+
+func (w *acceptedWriter) WriteHeader(code int) {
+	if code >= http.StatusOK && code < http.StatusMultipleChoices {
+		code = http.StatusAccepted
+	}
+
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// acceptChange marks the response of a change that was committed but whose
+// events couldn't be published, the handler sends its response as usual and
+// it goes out as 202 Accepted since the downstream changes may be delayed.
+// The events header tells whether the events were queued in the outbox.
+func acceptChange(c *gin.Context, err error) {
+	status := eventsFailed
+	if errors.Is(err, eventbus.ErrQueued) && c.Writer.Header().Get(eventsHeader) != eventsFailed {
+		status = eventsQueued
+	}
+
+	c.Header(eventsHeader, status)
+	_ = c.Error(err)
+
+	if _, ok := c.Writer.(*acceptedWriter); !ok {
+		c.Writer = &acceptedWriter{ResponseWriter: c.Writer}
+	}
+}
+
+// warnChange adds a warning to the response of a change that was committed
+// but whose follow up failed, like creating the indexes of an ERD. The handler
+// sends its response as usual.
+func warnChange(c *gin.Context, warning string, err error) {
+	c.Writer.Header().Add(warningHeader, `199 governor-api "`+warning+`"`)
+	_ = c.Error(err)
+}
@@ -0,0 +1,129 @@
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+var errTestPublish = errors.New("nats: connection closed")
+
+// failingBus records the published events like the memory event bus, and
+// fails to publish them with err
+type failingBus struct {
+	*eventbus.Memory
+	err error
+}
+
+func (b *failingBus) Publish(ctx context.Context, sub string, event *events.Event) error {
+	if err := b.Memory.Publish(ctx, sub, event); err != nil {
+		return err
+	}
+
+	return b.err
+}
+
+func TestHandleServiceResultNotPublished(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		events string
+	}{
+		{
+			name:   "published",
+			status: http.StatusCreated,
+		},
+		{
+			name:   "queued",
+			err:    fmt.Errorf("failed to publish members create event: %w: %w", service.ErrEventNotPublished, fmt.Errorf("%w: %w", eventbus.ErrQueued, errTestPublish)),
+			status: http.StatusAccepted,
+			events: eventsQueued,
+		},
+		{
+			name:   "failed",
+			err:    fmt.Errorf("failed to publish members create event: %w: %w", service.ErrEventNotPublished, errTestPublish),
+			status: http.StatusAccepted,
+			events: eventsFailed,
+		},
+		{
+			name:   "not committed",
+			err:    service.ErrUserAlreadyMember,
+			status: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := gin.New()
+			e.POST("/groups/:id/users/:uid", func(c *gin.Context) {
+				if !handleServiceResult(c, &models.AuditEvent{ID: "audit-id"}, tt.err) {
+					return
+				}
+
+				c.JSON(http.StatusCreated, gin.H{})
+			})
+
+			w := httptest.NewRecorder()
+			e.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/groups/group-id/users/user-id", nil))
+
+			assert.Equal(t, tt.status, w.Code)
+			assert.Equal(t, tt.events, w.Header().Get(eventsHeader))
+		})
+	}
+}
+
+func TestPublishMembershipDiffNotPublished(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	after := []dbtools.EnumeratedMembership{
+		{GroupID: "group-1", UserID: "user-id", Direct: true},
+		{GroupID: "group-2", UserID: "user-id"},
+	}
+
+	tests := []struct {
+		name   string
+		err    error
+		events string
+	}{
+		{"queued", fmt.Errorf("%w: %w", eventbus.ErrQueued, errTestPublish), eventsQueued},
+		{"failed", errTestPublish, eventsFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := &failingBus{Memory: eventbus.NewMemory(), err: tt.err}
+			r := &Router{EventBus: bus, Logger: zap.NewNop()}
+
+			e := gin.New()
+			e.POST("/groups/:id/hierarchies", func(c *gin.Context) {
+				r.publishMembershipDiff(c, nil, after)
+				c.JSON(http.StatusNoContent, nil)
+			})
+
+			w := httptest.NewRecorder()
+			e.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/groups/group-1/hierarchies", nil))
+
+			// the change was committed, every event is still attempted
+			assert.Equal(t, http.StatusAccepted, w.Code)
+			assert.Equal(t, tt.events, w.Header().Get(eventsHeader))
+			require.Len(t, bus.EventsOn(events.GovernorMembersEventSubject), 2)
+		})
+	}
+}
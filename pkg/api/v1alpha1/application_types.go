@@ -1,13 +1,13 @@
 package v1alpha1
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"github.com/metal-toolbox/auditevent/ginaudit"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -15,6 +15,7 @@ import (
 
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
 )
 
@@ -73,7 +74,7 @@ func (r *Router) listApplicationTypeApps(c *gin.Context) {
 		app, err := models.ApplicationTypes(qm.Where("slug = ?", id)).One(c.Request.Context(), r.DB)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
-				sendError(c, http.StatusNotFound, "application type not found: "+err.Error())
+				sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationTypeNotFound, "application type not found: "+err.Error())
 				return
 			}
 
@@ -85,7 +86,7 @@ func (r *Router) listApplicationTypeApps(c *gin.Context) {
 		tid = app.ID
 	}
 
-	queryMods = append(queryMods, qm.Where("type_id=?", tid))
+	queryMods = append(queryMods, qm.Where("type_id=?", tid), tenancy.Scope(c.Request.Context(), models.TableNames.Applications))
 
 	typeApps, err := models.Applications(queryMods...).All(c.Request.Context(), r.DB)
 	if err != nil {
@@ -100,7 +101,7 @@ func (r *Router) listApplicationTypeApps(c *gin.Context) {
 		aids[i] = a.ID
 	}
 
-	apps, err := models.Applications(qm.WhereIn("id IN ?", aids...)).All(c.Request.Context(), r.DB)
+	apps, err := models.Applications(qm.WhereIn("id IN ?", aids...), tenancy.Scope(c.Request.Context(), models.TableNames.Applications)).All(c.Request.Context(), r.DB)
 	if err != nil {
 		r.Logger.Error("error fetching applications", zap.Error(err))
 		sendError(c, http.StatusBadRequest, "error listing application: "+err.Error())
@@ -138,7 +139,7 @@ func (r *Router) getApplicationType(c *gin.Context) {
 	app, err := models.ApplicationTypes(queryMods...).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "application type not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationTypeNotFound, "application type not found: "+err.Error())
 			return
 		}
 
@@ -155,18 +156,14 @@ func (r *Router) getApplicationType(c *gin.Context) {
 // createApplicationType creates an application type in the database
 func (r *Router) createApplicationType(c *gin.Context) {
 	req := ApplicationTypeReq{}
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
 		return
 	}
 
-	if req.Name == "" {
-		sendError(c, http.StatusBadRequest, "application type name is required")
-		return
-	}
-
-	if req.Description == "" {
-		sendError(c, http.StatusBadRequest, "application type description is required")
+	if !validateFields(c,
+		fieldRule{"name", req.Name, "required"},
+		fieldRule{"description", req.Description, "required"},
+	) {
 		return
 	}
 
@@ -181,71 +178,26 @@ func (r *Router) createApplicationType(c *gin.Context) {
 		app.LogoURL = null.StringFrom(*req.LogoURL)
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting application type create transaction: "+err.Error())
-		return
-	}
-
-	if err := app.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := "error creating application type: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditApplicationTypeCreated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), app)
-	if err != nil {
-		msg := "error creating application type (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error creating application type (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := app.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating application type: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing application type create, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorApplicationTypesEventSubject, &events.Event{
+			Action:            events.GovernorEventCreate,
+			ApplicationTypeID: app.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditApplicationTypeCreated(ctx, exec, pID, actor, app)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating application type (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorApplicationTypesEventSubject, &events.Event{
-		Version:           events.Version,
-		Action:            events.GovernorEventCreate,
-		AuditID:           c.GetString(ginaudit.AuditIDContextKey),
-		ActorID:           getCtxActorID(c),
-		ApplicationTypeID: app.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish application type create event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, app)
 }
@@ -263,7 +215,7 @@ func (r *Router) deleteApplicationType(c *gin.Context) {
 	app, err := models.ApplicationTypes(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "application type not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationTypeNotFound, "application type not found: "+err.Error())
 			return
 		}
 
@@ -272,71 +224,26 @@ func (r *Router) deleteApplicationType(c *gin.Context) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting delete transaction: "+err.Error())
-		return
-	}
-
-	if _, err := app.Delete(c.Request.Context(), tx, false); err != nil {
-		msg := "error deleting application type, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := app.Delete(c.Request.Context(), tx, false); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting application type, rolling back: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditApplicationTypeDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), app)
-	if err != nil {
-		msg := "error deleting application type (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorApplicationTypesEventSubject, &events.Event{
+			Action:            events.GovernorEventDelete,
+			ApplicationTypeID: app.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditApplicationTypeDeleted(ctx, exec, pID, actor, app)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting application type (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error deleting application type (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing application type delete, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorApplicationTypesEventSubject, &events.Event{
-		Version:           events.Version,
-		Action:            events.GovernorEventDelete,
-		AuditID:           c.GetString(ginaudit.AuditIDContextKey),
-		ActorID:           getCtxActorID(c),
-		ApplicationTypeID: app.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish application type delete event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, app)
 }
@@ -354,7 +261,7 @@ func (r *Router) updateApplicationType(c *gin.Context) {
 	app, err := models.ApplicationTypes(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "application type not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationTypeNotFound, "application type not found: "+err.Error())
 			return
 		}
 
@@ -366,8 +273,7 @@ func (r *Router) updateApplicationType(c *gin.Context) {
 	original := *app
 
 	req := ApplicationTypeReq{}
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
 		return
 	}
 
@@ -381,71 +287,26 @@ func (r *Router) updateApplicationType(c *gin.Context) {
 		}
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting application type update transaction: "+err.Error())
-		return
-	}
-
-	if _, err := app.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := "error updating application type: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := app.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating application type: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditApplicationTypeUpdated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, app)
-	if err != nil {
-		msg := "error updating application type (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorApplicationTypesEventSubject, &events.Event{
+			Action:            events.GovernorEventUpdate,
+			ApplicationTypeID: app.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditApplicationTypeUpdated(ctx, exec, pID, actor, &original, app)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating application type (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error updating application type (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing application type update, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorApplicationTypesEventSubject, &events.Event{
-		Version:           events.Version,
-		Action:            events.GovernorEventUpdate,
-		AuditID:           c.GetString(ginaudit.AuditIDContextKey),
-		ActorID:           getCtxActorID(c),
-		ApplicationTypeID: app.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish application type update event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, app)
 }
@@ -1,13 +1,13 @@
 package v1alpha1
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"github.com/metal-toolbox/auditevent/ginaudit"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -15,6 +15,7 @@ import (
 
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
 )
 
@@ -24,6 +25,10 @@ type Application struct {
 	Type *models.ApplicationType `json:"type"`
 }
 
+// ApplicationAccess is a group granted access to an application, either
+// directly or through one of the groups listed in GrantedBy
+type ApplicationAccess = dbtools.ApplicationAccess
+
 // ApplicationReq is a request to create an application
 type ApplicationReq struct {
 	Name            string  `json:"name"`
@@ -34,6 +39,7 @@ type ApplicationReq struct {
 // listApplications lists the application as JSON
 func (r *Router) listApplications(c *gin.Context) {
 	queryMods := []qm.QueryMod{
+		tenancy.Scope(c.Request.Context(), models.TableNames.Applications),
 		qm.OrderBy("name"),
 	}
 
@@ -74,7 +80,7 @@ func (r *Router) listApplicationGroups(c *gin.Context) {
 		app, err := models.Applications(qm.Where("slug = ?", id)).One(c.Request.Context(), r.DB)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
-				sendError(c, http.StatusNotFound, "application not found: "+err.Error())
+				sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationNotFound, "application not found: "+err.Error())
 				return
 			}
 
@@ -86,9 +92,40 @@ func (r *Router) listApplicationGroups(c *gin.Context) {
 		aid = app.ID
 	}
 
-	queryMods = append(queryMods, qm.Where("application_id=?", aid))
+	var gids []interface{}
+
+	if _, inherited := c.GetQuery("inherited"); inherited {
+		// include the groups granted access through a parent group
+		access, err := dbtools.GetApplicationAccessForApplication(c.Request.Context(), r.DB, aid)
+		if err != nil {
+			r.Logger.Error("error fetching application access", zap.Error(err))
+			sendError(c, http.StatusInternalServerError, "error listing application groups: "+err.Error())
+
+			return
+		}
+
+		gids = make([]interface{}, len(access))
+		for i, a := range access {
+			gids[i] = a.GroupID
+		}
+	} else {
+		queryMods = append(queryMods, qm.Where("application_id=?", aid), dbtools.UnexpiredGroupApplications())
+
+		groupApps, err := models.GroupApplications(queryMods...).All(c.Request.Context(), r.DB)
+		if err != nil {
+			r.Logger.Error("error fetching application groups", zap.Error(err))
+			sendError(c, http.StatusBadRequest, "error listing application groups: "+err.Error())
+
+			return
+		}
+
+		gids = make([]interface{}, len(groupApps))
+		for i, g := range groupApps {
+			gids[i] = g.GroupID
+		}
+	}
 
-	groupApps, err := models.GroupApplications(queryMods...).All(c.Request.Context(), r.DB)
+	groups, err := models.Groups(qm.WhereIn("id IN ?", gids...), tenancy.Scope(c.Request.Context(), models.TableNames.Groups)).All(c.Request.Context(), r.DB)
 	if err != nil {
 		r.Logger.Error("error fetching application groups", zap.Error(err))
 		sendError(c, http.StatusBadRequest, "error listing application groups: "+err.Error())
@@ -96,20 +133,33 @@ func (r *Router) listApplicationGroups(c *gin.Context) {
 		return
 	}
 
-	gids := make([]interface{}, len(groupApps))
-	for i, g := range groupApps {
-		gids[i] = g.GroupID
-	}
+	c.JSON(http.StatusOK, groups)
+}
 
-	groups, err := models.Groups(qm.WhereIn("id IN ?", gids...)).All(c.Request.Context(), r.DB)
+// listApplicationAccess lists which groups are granted which applications,
+// directly or through the group hierarchy, as a flat access matrix
+func (r *Router) listApplicationAccess(c *gin.Context) {
+	access, err := dbtools.GetApplicationAccess(c.Request.Context(), r.DB)
 	if err != nil {
-		r.Logger.Error("error fetching application groups", zap.Error(err))
-		sendError(c, http.StatusBadRequest, "error listing application groups: "+err.Error())
+		r.Logger.Error("error fetching application access", zap.Error(err))
+		sendError(c, http.StatusInternalServerError, "error listing application access: "+err.Error())
 
 		return
 	}
 
-	c.JSON(http.StatusOK, groups)
+	if _, direct := c.GetQuery("direct"); direct {
+		filtered := make([]ApplicationAccess, 0, len(access))
+
+		for _, a := range access {
+			if a.Direct {
+				filtered = append(filtered, a)
+			}
+		}
+
+		access = filtered
+	}
+
+	c.JSON(http.StatusOK, access)
 }
 
 // getApplication gets an application and it's relationships
@@ -150,7 +200,7 @@ func (r *Router) getApplication(c *gin.Context) {
 	app, err := models.Applications(queryMods...).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "application not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationNotFound, "application not found: "+err.Error())
 			return
 		}
 
@@ -168,18 +218,14 @@ func (r *Router) getApplication(c *gin.Context) {
 // createApplication creates an application in the database
 func (r *Router) createApplication(c *gin.Context) {
 	req := ApplicationReq{}
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
-		return
-	}
-
-	if req.Name == "" {
-		sendError(c, http.StatusBadRequest, "application name is required")
+	if !bindRequest(c, &req) {
 		return
 	}
 
-	if req.TypeID == "" {
-		sendError(c, http.StatusBadRequest, "application type_id is required")
+	if !validateFields(c,
+		fieldRule{"name", req.Name, "required"},
+		fieldRule{"type_id", req.TypeID, "required"},
+	) {
 		return
 	}
 
@@ -210,71 +256,26 @@ func (r *Router) createApplication(c *gin.Context) {
 
 	dbtools.SetApplicationSlug(app)
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting application create transaction: "+err.Error())
-		return
-	}
-
-	if err := app.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := "error creating application: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditApplicationCreated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), app)
-	if err != nil {
-		msg := "error creating application (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error creating application (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := app.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating application: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing application create, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorApplicationsEventSubject, &events.Event{
+			Action:        events.GovernorEventCreate,
+			ApplicationID: app.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditApplicationCreated(ctx, exec, pID, actor, app)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating application (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorApplicationsEventSubject, &events.Event{
-		Version:       events.Version,
-		Action:        events.GovernorEventCreate,
-		AuditID:       c.GetString(ginaudit.AuditIDContextKey),
-		ActorID:       getCtxActorID(c),
-		ApplicationID: app.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish application create event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, app)
 }
@@ -303,7 +304,7 @@ func (r *Router) deleteApplication(c *gin.Context) {
 	app, err := models.Applications(q...).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "application not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationNotFound, "application not found: "+err.Error())
 			return
 		}
 
@@ -312,84 +313,31 @@ func (r *Router) deleteApplication(c *gin.Context) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting delete transaction: "+err.Error())
-		return
-	}
-
-	// delete all app links
-	if _, err := app.R.GroupApplications.DeleteAll(c.Request.Context(), tx, false); err != nil {
-		msg := "error deleting group app link, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if _, err := app.Delete(c.Request.Context(), tx, false); err != nil {
-		msg := "error deleting application, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditApplicationDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), app)
-	if err != nil {
-		msg := "error deleting application (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		// delete all app links
+		if _, err := app.R.GroupApplications.DeleteAll(c.Request.Context(), tx, false); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting group app link, rolling back: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error deleting application (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		if _, err := app.Delete(c.Request.Context(), tx, false); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting application, rolling back: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing application delete, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorApplicationsEventSubject, &events.Event{
+			Action:        events.GovernorEventDelete,
+			ApplicationID: app.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditApplicationDeleted(ctx, exec, pID, actor, app)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting application (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorApplicationsEventSubject, &events.Event{
-		Version:       events.Version,
-		Action:        events.GovernorEventDelete,
-		AuditID:       c.GetString(ginaudit.AuditIDContextKey),
-		ActorID:       getCtxActorID(c),
-		ApplicationID: app.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish application delete event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, app)
 }
@@ -416,7 +364,7 @@ func (r *Router) updateApplication(c *gin.Context) {
 	app, err := models.Applications(q...).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "application not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationNotFound, "application not found: "+err.Error())
 			return
 		}
 
@@ -428,8 +376,7 @@ func (r *Router) updateApplication(c *gin.Context) {
 	original := *app
 
 	req := ApplicationReq{}
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
 		return
 	}
 
@@ -453,71 +400,26 @@ func (r *Router) updateApplication(c *gin.Context) {
 		app.ApproverGroupID = null.StringFromPtr(req.ApproverGroupID)
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting application update transaction: "+err.Error())
-		return
-	}
-
-	if _, err := app.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := "error updating application: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditApplicationUpdated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, app)
-	if err != nil {
-		msg := "error updating application (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error updating application (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := app.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating application: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing application update, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorApplicationsEventSubject, &events.Event{
+			Action:        events.GovernorEventUpdate,
+			ApplicationID: app.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditApplicationUpdated(ctx, exec, pID, actor, &original, app)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating application (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorApplicationsEventSubject, &events.Event{
-		Version:       events.Version,
-		Action:        events.GovernorEventUpdate,
-		AuditID:       c.GetString(ginaudit.AuditIDContextKey),
-		ActorID:       getCtxActorID(c),
-		ApplicationID: app.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish application update event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, app)
 }
@@ -0,0 +1,206 @@
+package v1alpha1
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// ArchivedRequest is a processed group membership or group application request
+// with the decision taken on it
+type ArchivedRequest struct {
+	ID                 string    `json:"id"`
+	RequestID          string    `json:"request_id"`
+	RequestType        string    `json:"request_type"`
+	Kind               string    `json:"kind,omitempty"`
+	GroupID            string    `json:"group_id"`
+	GroupSlug          string    `json:"group_slug,omitempty"`
+	UserID             string    `json:"user_id,omitempty"`
+	ApplicationID      string    `json:"application_id,omitempty"`
+	ApplicationSlug    string    `json:"application_slug,omitempty"`
+	ApproverGroupID    string    `json:"approver_group_id,omitempty"`
+	RequesterUserID    string    `json:"requester_user_id"`
+	RequesterUserEmail string    `json:"requester_user_email,omitempty"`
+	Note               string    `json:"note"`
+	IsAdmin            bool      `json:"is_admin"`
+	ExpiresAt          null.Time `json:"expires_at"`
+	AdminExpiresAt     null.Time `json:"admin_expires_at"`
+	Decision           string    `json:"decision"`
+	DecidedByUserID    string    `json:"decided_by_user_id,omitempty"`
+	DecidedByUserEmail string    `json:"decided_by_user_email,omitempty"`
+	RequestedAt        time.Time `json:"requested_at"`
+	DecidedAt          time.Time `json:"decided_at"`
+}
+
+// ArchivedRequestsResponse is a page of the archived requests
+type ArchivedRequestsResponse struct {
+	PageSize         int               `json:"page_size,omitempty"`
+	Page             int               `json:"page,omitempty"`
+	PageCount        int               `json:"page_count,omitempty"`
+	TotalPages       int               `json:"total_pages,omitempty"`
+	TotalRecordCount int64             `json:"total_record_count,omitempty"`
+	Records          []ArchivedRequest `json:"records"`
+}
+
+// listGroupArchivedRequests returns the processed requests to join a group and
+// to link applications to it, along with the application requests the group
+// decided on as the approver group
+func (r *Router) listGroupArchivedRequests(c *gin.Context) {
+	group, err := r.svc().FindGroup(c.Request.Context(), c.Param("id"), false)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	r.sendArchivedRequests(c, qm.Expr(
+		qm.Where("group_id = ?", group.ID),
+		qm.Or("approver_group_id = ?", group.ID),
+	))
+}
+
+// listUserArchivedRequests returns the processed requests made by a user and
+// the ones the user decided on. Users can list their own requests, governor
+// admins can list the requests of any user.
+func (r *Router) listUserArchivedRequests(c *gin.Context) {
+	user, err := r.svc().FindUser(c.Request.Context(), c.Param("id"), true)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if ctxUser := getCtxUser(c); ctxUser != nil && ctxUser.ID != user.ID {
+		if isAdmin := getCtxAdmin(c); isAdmin == nil || !*isAdmin {
+			sendError(c, http.StatusForbidden, "user not allowed to list the requests of other users")
+			return
+		}
+	}
+
+	r.sendArchivedRequests(c, qm.Expr(
+		qm.Where("user_id = ?", user.ID),
+		qm.Or("requester_user_id = ?", user.ID),
+		qm.Or("decided_by_user_id = ?", user.ID),
+	))
+}
+
+// archivedRequestFilters returns the query mods filtering the archived requests
+// by the type and decision query params
+func archivedRequestFilters(c *gin.Context) []qm.QueryMod {
+	mods := []qm.QueryMod{}
+
+	if types := c.QueryArray("type"); len(types) > 0 {
+		mods = append(mods, qm.WhereIn("request_type IN ?", stringsToInterfaces(types)...))
+	}
+
+	if decision := c.Query("decision"); decision != "" {
+		mods = append(mods, qm.Where("decision = ?", decision))
+	}
+
+	return mods
+}
+
+// sendArchivedRequests responds with a page of the archived requests matching
+// the query mods and the filters in the query params, the latest decisions first
+func (r *Router) sendArchivedRequests(c *gin.Context, mods ...qm.QueryMod) {
+	p := parsePagination(c)
+
+	mods = append(mods, archivedRequestFilters(c)...)
+
+	count, err := models.ArchivedRequests(mods...).Count(c.Request.Context(), r.DB)
+	if err != nil {
+		r.Logger.Error("error fetching archived requests", zap.Error(err))
+		sendError(c, http.StatusInternalServerError, "error listing archived requests: "+err.Error())
+
+		return
+	}
+
+	mods = append(mods, qm.Limit(p.limitUsed()))
+
+	if p.Page != 0 {
+		mods = append(mods, qm.Offset(p.offset()))
+	}
+
+	mods = append(mods,
+		qm.OrderBy("decided_at DESC"),
+		qm.Load("Group", qm.WithDeleted()),
+		qm.Load("Application", qm.WithDeleted()),
+		qm.Load("RequesterUser", qm.WithDeleted()),
+		qm.Load("DecidedByUser", qm.WithDeleted()),
+	)
+
+	archived, err := models.ArchivedRequests(mods...).All(c.Request.Context(), r.DB)
+	if err != nil {
+		r.Logger.Error("error fetching archived requests", zap.Error(err))
+		sendError(c, http.StatusInternalServerError, "error listing archived requests: "+err.Error())
+
+		return
+	}
+
+	records := make([]ArchivedRequest, len(archived))
+	for i, a := range archived {
+		records[i] = newArchivedRequest(a)
+	}
+
+	d := float64(count) / float64(p.limitUsed())
+
+	c.JSON(http.StatusOK, &ArchivedRequestsResponse{
+		PageSize:         p.limitUsed(),
+		PageCount:        len(records),
+		TotalPages:       int(math.Ceil(d)),
+		Page:             p.Page,
+		TotalRecordCount: count,
+		Records:          records,
+	})
+}
+
+// newArchivedRequest returns the api representation of an archived request,
+// the slugs and emails are only set when the relationships are loaded
+func newArchivedRequest(a *models.ArchivedRequest) ArchivedRequest {
+	req := ArchivedRequest{
+		ID:              a.ID,
+		RequestID:       a.RequestID,
+		RequestType:     a.RequestType,
+		Kind:            a.Kind.String,
+		GroupID:         a.GroupID,
+		UserID:          a.UserID.String,
+		ApplicationID:   a.ApplicationID.String,
+		ApproverGroupID: a.ApproverGroupID.String,
+		RequesterUserID: a.RequesterUserID,
+		Note:            a.Note,
+		IsAdmin:         a.IsAdmin,
+		ExpiresAt:       a.ExpiresAt,
+		AdminExpiresAt:  a.AdminExpiresAt,
+		Decision:        a.Decision,
+		DecidedByUserID: a.DecidedByUserID.String,
+		RequestedAt:     a.RequestedAt,
+		DecidedAt:       a.DecidedAt,
+	}
+
+	if a.R == nil {
+		return req
+	}
+
+	if a.R.Group != nil {
+		req.GroupSlug = a.R.Group.Slug
+	}
+
+	if a.R.Application != nil {
+		req.ApplicationSlug = a.R.Application.Slug
+	}
+
+	if a.R.RequesterUser != nil {
+		req.RequesterUserEmail = a.R.RequesterUser.Email
+	}
+
+	if a.R.DecidedByUser != nil {
+		req.DecidedByUserEmail = a.R.DecidedByUser.Email
+	}
+
+	return req
+}
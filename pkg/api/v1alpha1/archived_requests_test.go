@@ -0,0 +1,56 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestNewArchivedRequest(t *testing.T) {
+	decidedAt := time.Date(2023, 7, 12, 12, 5, 0, 0, time.UTC)
+
+	archived := &models.ArchivedRequest{
+		ID:              "archived-id",
+		RequestID:       "request-id",
+		RequestType:     "group_application",
+		GroupID:         "group-id",
+		ApplicationID:   null.StringFrom("app-id"),
+		ApproverGroupID: null.StringFrom("approver-id"),
+		RequesterUserID: "requester-id",
+		Note:            "please",
+		Decision:        "denied",
+		RequestedAt:     decidedAt.Add(-time.Hour),
+		DecidedAt:       decidedAt,
+	}
+
+	want := ArchivedRequest{
+		ID:              "archived-id",
+		RequestID:       "request-id",
+		RequestType:     "group_application",
+		GroupID:         "group-id",
+		ApplicationID:   "app-id",
+		ApproverGroupID: "approver-id",
+		RequesterUserID: "requester-id",
+		Note:            "please",
+		Decision:        "denied",
+		RequestedAt:     decidedAt.Add(-time.Hour),
+		DecidedAt:       decidedAt,
+	}
+
+	assert.Equal(t, want, newArchivedRequest(archived), "relationships aren't loaded")
+
+	archived.R = archived.R.NewStruct()
+	archived.R.Group = &models.Group{Slug: "gophers"}
+	archived.R.Application = &models.Application{Slug: "gophers-app"}
+	archived.R.RequesterUser = &models.User{Email: "hjass@example.com"}
+
+	want.GroupSlug = "gophers"
+	want.ApplicationSlug = "gophers-app"
+	want.RequesterUserEmail = "hjass@example.com"
+
+	assert.Equal(t, want, newArchivedRequest(archived), "the loaded relationships add the slugs and emails")
+}
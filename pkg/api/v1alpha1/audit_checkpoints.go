@@ -0,0 +1,49 @@
+package v1alpha1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/auditchain"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const maxAuditCheckpointsListed = 100
+
+// listAuditCheckpoints lists the most recent signed checkpoints of the audit log
+func (r *Router) listAuditCheckpoints(c *gin.Context) {
+	checkpoints, err := models.AuditCheckpoints(
+		qm.OrderBy("sequence DESC"),
+		qm.Limit(maxAuditCheckpointsListed),
+	).All(c.Request.Context(), r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error listing audit checkpoints: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, checkpoints)
+}
+
+// verifyAuditLog starts a job verifying the audit log against its signed
+// checkpoints, the verification report is the result of the job
+func (r *Router) verifyAuditLog(c *gin.Context) {
+	if r.Jobs != nil {
+		enabled := false
+
+		for _, kind := range r.Jobs.Kinds() {
+			if kind == auditchain.JobKindVerify {
+				enabled = true
+				break
+			}
+		}
+
+		if !enabled {
+			sendError(c, http.StatusServiceUnavailable, "audit signing is not enabled")
+			return
+		}
+	}
+
+	r.enqueueJob(c, auditchain.JobKindVerify, nil)
+}
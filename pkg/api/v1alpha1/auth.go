@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -8,7 +9,6 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 	"github.com/metal-toolbox/auditevent/ginaudit"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -18,6 +18,7 @@ import (
 	"github.com/metal-toolbox/governor-api/internal/auth"
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
 )
 
@@ -83,17 +84,9 @@ func (r *Router) mwUserAuthRequired(authRole mwAuthRole) gin.HandlerFunc {
 			return
 		}
 
+		// the handlers load the relations of the user they need with reloadCtxUser
 		queryMods := []qm.QueryMod{
 			models.UserWhere.ExternalID.EQ(null.StringFrom(c.GetString("jwt.user"))),
-			qm.Load("GroupMemberships"),
-			qm.Load("GroupMembershipRequests"),
-			qm.Load("GroupMembershipRequests.User"),
-			qm.Load("GroupMembershipRequests.Group"),
-			qm.Load("RequesterUserGroupApplicationRequests"),
-			qm.Load("RequesterUserGroupApplicationRequests.Application"),
-			qm.Load("RequesterUserGroupApplicationRequests.Group"),
-			qm.Load("RequesterUserGroupApplicationRequests.ApproverGroup"),
-			qm.Load("RequesterUserGroupApplicationRequests.RequesterUser"),
 		}
 
 		isAdmin := false
@@ -150,65 +143,26 @@ func (r *Router) mwUserAuthRequired(authRole mwAuthRole) gin.HandlerFunc {
 				LastLoginAt: null.TimeFrom(time.Now()),
 			}
 
-			tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-			if err != nil {
-				sendError(c, http.StatusBadRequest, "error starting create user transaction: "+err.Error())
-				return
-			}
-
-			if err := newUser.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
-				sendError(c, http.StatusBadRequest, "error creating user: "+err.Error())
-				return
-			}
-
-			event, err := dbtools.AuditUserCreatedWithActor(c.Request.Context(), tx, getCtxAuditID(c), newUser, newUser)
-			if err != nil {
-				msg := "error creating user (audit): " + err.Error()
-
-				if err := tx.Rollback(); err != nil {
-					msg += "error rolling back transaction: " + err.Error()
-				}
-
-				sendError(c, http.StatusBadRequest, msg)
-
-				return
-			}
-
-			if err := updateContextWithAuditEventData(c, event); err != nil {
-				msg := "error creating user (audit): " + err.Error()
-
-				if err := tx.Rollback(); err != nil {
-					msg += "error rolling back transaction: " + err.Error()
+			if !r.withTx(c, func(tx *sql.Tx) error {
+				if err := newUser.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+					return abortTx(http.StatusBadRequest, "error creating user: "+err.Error(), err)
 				}
 
-				sendError(c, http.StatusBadRequest, msg)
-
-				return
-			}
-
-			if err := tx.Commit(); err != nil {
-				msg := "error committing user create, rolling back: " + err.Error()
-
-				if err := tx.Rollback(); err != nil {
-					msg = msg + "error rolling back transaction: " + err.Error()
+				if err := r.recordChange(c, tx, events.GovernorUsersEventSubject, &events.Event{
+					Action: events.GovernorEventCreate,
+					UserID: newUser.ID,
+				}, func(ctx context.Context, exec boil.ContextExecutor, pID string, _ *models.User) (*models.AuditEvent, error) {
+					return dbtools.AuditUserCreatedWithActor(ctx, exec, pID, newUser, newUser)
+				}); err != nil {
+					return abortTx(http.StatusBadRequest, "error creating user (audit): "+err.Error(), err)
 				}
 
-				sendError(c, http.StatusBadRequest, msg)
-
+				return nil
+			}) {
 				return
 			}
 
-			if err := r.EventBus.Publish(c.Request.Context(), events.GovernorUsersEventSubject, &events.Event{
-				Version: events.Version,
-				Action:  events.GovernorEventCreate,
-				AuditID: c.GetString(ginaudit.AuditIDContextKey),
-				ActorID: getCtxActorID(c),
-				GroupID: "",
-				UserID:  newUser.ID,
-			}); err != nil {
-				sendError(c, http.StatusBadRequest, "failed to publish user create event, downstream changes may be delayed "+err.Error())
-				return
-			}
+			r.publishChanges(c)
 
 			setCtxUser(c, newUser)
 			setCtxAdmin(c, &isAdmin)
@@ -224,29 +178,12 @@ func (r *Router) mwUserAuthRequired(authRole mwAuthRole) gin.HandlerFunc {
 			return
 		}
 
-		memberships := make([]string, len(enumeratedMemberships))
-		for i, m := range enumeratedMemberships {
-			memberships[i] = m.GroupID
-		}
-
-		ag := make([]interface{}, len(r.AdminGroups))
-		for i, a := range r.AdminGroups {
-			ag[i] = a
-		}
-
-		adminGroups, err := models.Groups(qm.WhereIn("slug IN ?", ag...)).All(c.Request.Context(), r.DB)
+		isAdmin, err = r.isGovernorAdmin(c.Request.Context(), enumeratedMemberships)
 		if err != nil {
 			sendError(c, http.StatusInternalServerError, "error getting admin groups: "+err.Error())
 			return
 		}
 
-		for _, g := range adminGroups {
-			if contains(memberships, g.ID) {
-				isAdmin = true
-				break
-			}
-		}
-
 		// add user to gin context
 		setCtxUser(c, user)
 		setCtxAdmin(c, &isAdmin)
@@ -328,151 +265,32 @@ func (r *Router) mwGroupAuthRequired(authRole mwAuthRole) gin.HandlerFunc {
 
 		r.Logger.Debug("got authenticated user", zap.Any("user", user))
 
-		isGroupMember := false
-		isGroupAdmin := false
-		isGroupApprover := false
-
-		idIsSlug := false
-		if _, err := uuid.Parse(id); err != nil {
-			// assume the group id is actually a slug
-			idIsSlug = true
-		}
-
-		enumeratedMemberships, err := dbtools.GetMembershipsForUser(c.Request.Context(), r.DB.DB, user.ID, true)
+		enumeratedMemberships, err := dbtools.GetMembershipsForUser(c.Request.Context(), r.DB.DB, user.ID, false)
 		if err != nil {
 			sendError(c, http.StatusInternalServerError, "error getting enumerated groups: "+err.Error())
 			return
 		}
 
-		for _, m := range enumeratedMemberships {
-			groupID := m.GroupID
-			if idIsSlug {
-				groupID = m.Group.Slug
-			}
-
-			if id == groupID {
-				isGroupMember = true
-
-				if m.AdminExpiresAt.Valid {
-					if time.Now().Before(m.AdminExpiresAt.Time) {
-						isGroupAdmin = m.IsAdmin
-					}
-				} else {
-					isGroupAdmin = m.IsAdmin
-				}
+		roles := userGroupRoles(group, enumeratedMemberships, time.Now())
 
-				break
-			}
-
-			if group.ApproverGroup.Valid && group.ApproverGroup.String == groupID {
-				isGroupApprover = true
-			}
+		// check if the user is a governor admin
+		isAdmin, err := r.isGovernorAdmin(c.Request.Context(), enumeratedMemberships)
+		if err != nil {
+			sendError(c, http.StatusInternalServerError, "error getting admin groups: "+err.Error())
+			return
 		}
 
 		// add user to gin context
 		setCtxUser(c, user)
-		setCtxGroupAdmin(c, &isGroupAdmin)
-		setCtxGroupMember(c, &isGroupMember)
-		setCtxGroupApprover(c, &isGroupApprover)
-
-		if authRole == AuthRoleGroupMember {
-			if !isGroupMember {
-				r.Logger.Debug("user is not group member", zap.String("group id", id))
-
-				sendError(c, http.StatusUnauthorized, "user not group member")
-
-				return
-			}
-
-			return
-		}
-
-		if authRole == AuthRoleGroupAdmin {
-			if !isGroupAdmin {
-				r.Logger.Debug("user is not group admin", zap.String("group id", id))
-
-				sendError(c, http.StatusUnauthorized, "user not group admin")
-
-				return
-			}
-
-			return
-		}
-
-		if authRole == AuthRoleAdminOrGroupAdmin {
-			isAdmin := false
-
-			memberships := make(map[string]struct{})
-			for _, m := range enumeratedMemberships {
-				memberships[m.GroupID] = struct{}{}
-			}
-
-			ag := make([]interface{}, len(r.AdminGroups))
-			for i, a := range r.AdminGroups {
-				ag[i] = a
-			}
-
-			adminGroups, err := models.Groups(qm.WhereIn("slug IN ?", ag...)).All(c.Request.Context(), r.DB)
-			if err != nil {
-				sendError(c, http.StatusInternalServerError, "error getting admin groups: "+err.Error())
-				return
-			}
-
-			for _, g := range adminGroups {
-				if _, found := memberships[g.ID]; found {
-					isAdmin = true
-				}
-			}
-
-			if !isGroupAdmin && !isAdmin {
-				r.Logger.Debug("user is not admin or group admin", zap.String("group id", id))
-
-				sendError(c, http.StatusUnauthorized, "user not admin or group admin")
-
-				return
-			}
-
-			return
-		}
-
-		if authRole == AuthRoleAdminOrGroupAdminOrGroupApprover {
-			isAdmin := false
-
-			memberships := make(map[string]struct{})
-			for _, m := range enumeratedMemberships {
-				memberships[m.GroupID] = struct{}{}
-			}
-
-			ag := make([]interface{}, len(r.AdminGroups))
-			for i, a := range r.AdminGroups {
-				ag[i] = a
-			}
-
-			adminGroups, err := models.Groups(qm.WhereIn("slug IN ?", ag...)).All(c.Request.Context(), r.DB)
-			if err != nil {
-				sendError(c, http.StatusInternalServerError, "error getting admin groups: "+err.Error())
-				return
-			}
-
-			for _, g := range adminGroups {
-				if _, found := memberships[g.ID]; found {
-					isAdmin = true
-				}
-			}
-
-			if !isGroupAdmin && !isAdmin && !isGroupApprover {
-				r.Logger.Debug("user is not admin or group admin", zap.String("group id", id))
-
-				sendError(c, http.StatusUnauthorized, "user not admin or group admin")
-
-				return
-			}
+		setCtxAdmin(c, &isAdmin)
+		setCtxGroupAdmin(c, &roles.admin)
+		setCtxGroupMember(c, &roles.member)
+		setCtxGroupApprover(c, &roles.approver)
 
-			return
+		if allowed, reason := groupAuthRoleAllowed(authRole, isAdmin, roles); !allowed {
+			r.Logger.Debug(reason, zap.String("role", authRole.String()), zap.String("group id", id))
+			sendError(c, http.StatusUnauthorized, reason)
 		}
-
-		r.Logger.Debug("unsupported auth role")
-		sendError(c, http.StatusUnauthorized, "unsupported auth role")
 	}
 }
 
@@ -538,6 +356,20 @@ func getCtxAuditID(c *gin.Context) string {
 	return id
 }
 
+// ctxActor returns the service actor for the request
+func ctxActor(c *gin.Context) service.Actor {
+	actor := service.Actor{
+		AuditID: getCtxAuditID(c),
+		User:    getCtxUser(c),
+	}
+
+	if isAdmin := getCtxAdmin(c); isAdmin != nil {
+		actor.Admin = *isAdmin
+	}
+
+	return actor
+}
+
 func getCtxActorID(c *gin.Context) string {
 	actorUser := getCtxUser(c)
 	if actorUser == nil {
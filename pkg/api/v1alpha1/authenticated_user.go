@@ -1,19 +1,23 @@
 package v1alpha1
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"net/http"
+	"sort"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"github.com/metal-toolbox/auditevent/ginaudit"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
 )
 
@@ -56,14 +60,70 @@ type AuthenticatedUserGroupMemberRequest struct {
 	Admin bool `json:"admin"`
 }
 
+// AuthenticatedUserPendingRequests is the list of outstanding requests made by
+// the authenticated user
+type AuthenticatedUserPendingRequests struct {
+	ApplicationRequests []AuthenticatedUserPendingApplicationRequest `json:"application_requests"`
+	MemberRequests      []AuthenticatedUserPendingMemberRequest      `json:"member_requests"`
+}
+
+// AuthenticatedUserPendingApplicationRequest is an outstanding group application
+// request of the authenticated user, with its age in seconds
+type AuthenticatedUserPendingApplicationRequest struct {
+	*GroupApplicationRequest
+	AgeSeconds int64 `json:"age_seconds"`
+}
+
+// AuthenticatedUserPendingMemberRequest is an outstanding group membership
+// request of the authenticated user, with its age in seconds
+type AuthenticatedUserPendingMemberRequest struct {
+	*GroupMemberRequest
+	AgeSeconds int64 `json:"age_seconds"`
+}
+
+// ApprovalKind is the kind of request in the approver inbox
+type ApprovalKind string
+
+const (
+	// ApprovalKindMembership is a group membership request
+	ApprovalKindMembership ApprovalKind = "membership"
+	// ApprovalKindApplication is a group application request
+	ApprovalKindApplication ApprovalKind = "application"
+)
+
+// ApprovalReason is why a user can approve a request in their inbox
+type ApprovalReason string
+
+const (
+	// ApprovalReasonGroupAdmin is given when the user is an admin of the group
+	ApprovalReasonGroupAdmin ApprovalReason = "group_admin"
+	// ApprovalReasonApproverGroup is given when the user is a member of the group
+	// approvals are delegated to, or of the application approver group
+	ApprovalReasonApproverGroup ApprovalReason = "approver_group"
+	// ApprovalReasonGovernorAdmin is given when the user is a governor admin and
+	// no one else can approve the request
+	ApprovalReasonGovernorAdmin ApprovalReason = "governor_admin"
+)
+
+// ApprovalInboxItem is a pending request the authenticated user can approve,
+// only the request matching the kind is set
+type ApprovalInboxItem struct {
+	Kind               ApprovalKind             `json:"kind"`
+	Reason             ApprovalReason           `json:"reason"`
+	CreatedAt          time.Time                `json:"created_at"`
+	AgeSeconds         int64                    `json:"age_seconds"`
+	MemberRequest      *GroupMemberRequest      `json:"member_request,omitempty"`
+	ApplicationRequest *GroupApplicationRequest `json:"application_request,omitempty"`
+}
+
 const expectedAuthzHeaderParts = 2
 
 // getAuthenticatedUser gets information about the currently authenticated OAuth user. If the
 // user is not found in the db they will be automatically added using details from oidc
 func (r *Router) getAuthenticatedUser(c *gin.Context) {
-	ctxUser := getCtxUser(c)
-	if ctxUser == nil {
-		sendError(c, http.StatusUnauthorized, "no user in context")
+	ctxUser, err := reloadCtxUser(c, r.DB, models.UserRels.GroupMembershipRequests)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -79,21 +139,6 @@ func (r *Router) getAuthenticatedUser(c *gin.Context) {
 		return
 	}
 
-	if ctxUser.R == nil {
-		c.JSON(http.StatusOK, AuthenticatedUser{
-			User: &User{
-				User:                    ctxUser,
-				Memberships:             []string{},
-				MembershipsDirect:       []string{},
-				MembershipRequests:      []string{},
-				NotificationPreferences: notificationPreferences,
-			},
-			Admin: *ctxAdmin,
-		})
-
-		return
-	}
-
 	enumeratedMemberships, err := dbtools.GetMembershipsForUser(c.Request.Context(), r.DB.DB, ctxUser.ID, false)
 	if err != nil {
 		sendError(c, http.StatusInternalServerError, "error enumerating group membership: "+err.Error())
@@ -162,10 +207,11 @@ func (r *Router) getAuthenticatedUserGroups(c *gin.Context) {
 
 	groups, err := models.Groups(
 		qm.WhereIn("id IN ?", gids...),
+		tenancy.Scope(c.Request.Context(), models.TableNames.Groups),
 		qm.Load("GroupOrganizations"),
 		qm.Load("GroupOrganizations.Organization"),
-		qm.Load("GroupApplications"),
-		qm.Load("GroupApplications.Application"),
+		qm.Load("GroupApplications", dbtools.UnexpiredGroupApplications()),
+		qm.Load("GroupApplications.Application", tenancy.Scope(c.Request.Context(), models.TableNames.Applications)),
 	).All(c.Request.Context(), r.DB)
 	if err != nil {
 		sendError(c, http.StatusBadRequest, "error getting user groups: "+err.Error())
@@ -212,14 +258,78 @@ func (r *Router) getAuthenticatedUserGroupApprovals(c *gin.Context) {
 		return
 	}
 
-	var userGroups, userAdminGroups []string
+	approvals, err := r.approvalsForUser(c.Request.Context(), ctxUser, *ctxAdmin)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var (
+		memberApprovals      []AuthenticatedUserGroupMemberRequest
+		applicationApprovals []AuthenticatedUserGroupApplicationRequest
+	)
+
+	for _, a := range approvals {
+		switch a.Kind {
+		case ApprovalKindMembership:
+			memberApprovals = append(memberApprovals, AuthenticatedUserGroupMemberRequest{
+				GroupMemberRequest: a.MemberRequest,
+				Admin:              a.Reason == ApprovalReasonGroupAdmin,
+			})
+		case ApprovalKindApplication:
+			applicationApprovals = append(applicationApprovals, AuthenticatedUserGroupApplicationRequest{
+				GroupApplicationRequest: a.ApplicationRequest,
+			})
+		}
+	}
 
-	enumeratedMemberships, err := dbtools.GetMembershipsForUser(c.Request.Context(), r.DB.DB, ctxUser.ID, false)
+	c.JSON(http.StatusOK, AuthenticatedUserRequests{
+		ApplicationRequests: applicationApprovals,
+		MemberRequests:      memberApprovals,
+	})
+}
+
+// getAuthenticatedUserApprovals returns the approver inbox of the authenticated
+// user, every pending request they can approve in a single list, oldest first
+func (r *Router) getAuthenticatedUserApprovals(c *gin.Context) {
+	ctxUser := getCtxUser(c)
+	if ctxUser == nil {
+		sendError(c, http.StatusUnauthorized, "no user in context")
+		return
+	}
+
+	ctxAdmin := getCtxAdmin(c)
+	if ctxAdmin == nil {
+		sendError(c, http.StatusUnauthorized, "no admin in context")
+		return
+	}
+
+	approvals, err := r.approvalsForUser(c.Request.Context(), ctxUser, *ctxAdmin)
 	if err != nil {
-		sendError(c, http.StatusInternalServerError, "error enumerating group membership: "+err.Error())
+		sendError(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
+	sort.SliceStable(approvals, func(i, j int) bool {
+		return approvals[i].CreatedAt.Before(approvals[j].CreatedAt)
+	})
+
+	c.JSON(http.StatusOK, approvals)
+}
+
+// approvalsForUser returns the pending requests a user can approve. Membership
+// requests can be approved by the group admins, the members of the group's
+// approver group and, when the group has no one else to approve them, governor
+// admins. Application requests can be approved by the members of their approver
+// group. Users can't approve their own requests.
+func (r *Router) approvalsForUser(ctx context.Context, user *models.User, isAdmin bool) ([]ApprovalInboxItem, error) {
+	var userGroups, userAdminGroups []string
+
+	enumeratedMemberships, err := dbtools.GetMembershipsForUser(ctx, r.DB.DB, user.ID, false)
+	if err != nil {
+		return nil, fmt.Errorf("error enumerating group membership: %w", err)
+	}
+
 	for _, g := range enumeratedMemberships {
 		userGroups = append(userGroups, g.GroupID)
 
@@ -233,10 +343,9 @@ func (r *Router) getAuthenticatedUserGroupApprovals(c *gin.Context) {
 		qm.Load("Group"),
 		qm.Load("Group.GroupMemberships"),
 		qm.Load("Group.ApproverGroupGroup.GroupMemberships"),
-	).All(c.Request.Context(), r.DB)
+	).All(ctx, r.DB)
 	if err != nil {
-		sendError(c, http.StatusInternalServerError, "error getting group membership requests: "+err.Error())
-		return
+		return nil, fmt.Errorf("error getting group membership requests: %w", err)
 	}
 
 	applicationRequests, err := models.GroupApplicationRequests(
@@ -244,16 +353,16 @@ func (r *Router) getAuthenticatedUserGroupApprovals(c *gin.Context) {
 		qm.Load("Group"),
 		qm.Load("ApproverGroup"),
 		qm.Load("RequesterUser"),
-	).All(c.Request.Context(), r.DB)
+	).All(ctx, r.DB)
 	if err != nil {
-		sendError(c, http.StatusInternalServerError, "error getting group application requests: "+err.Error())
-		return
+		return nil, fmt.Errorf("error getting group application requests: %w", err)
 	}
 
-	var memberApprovals []AuthenticatedUserGroupMemberRequest
+	now := time.Now()
+	approvals := []ApprovalInboxItem{}
 
 	for _, m := range membershipRequests {
-		if ctxUser.ID == m.UserID {
+		if user.ID == m.UserID || m.R.GetGroup() == nil || m.R.GetUser() == nil {
 			continue
 		}
 
@@ -269,12 +378,30 @@ func (r *Router) getAuthenticatedUserGroupApprovals(c *gin.Context) {
 			}
 		}
 
-		if groupHasNoAdmins && m.R.Group.ApproverGroup.Valid && len(m.R.Group.R.ApproverGroupGroup.R.GroupMemberships) > 0 {
+		if groupHasNoAdmins && m.R.Group.ApproverGroup.Valid && m.R.Group.R.ApproverGroupGroup != nil &&
+			len(m.R.Group.R.ApproverGroupGroup.R.GroupMemberships) > 0 {
 			groupHasNoAdmins = false
 		}
 
-		if (*ctxAdmin && groupHasNoAdmins) || isGroupAdmin || isInApproverGroup {
-			memberApprovals = append(memberApprovals, AuthenticatedUserGroupMemberRequest{&GroupMemberRequest{
+		var reason ApprovalReason
+
+		switch {
+		case isGroupAdmin:
+			reason = ApprovalReasonGroupAdmin
+		case isInApproverGroup:
+			reason = ApprovalReasonApproverGroup
+		case isAdmin && groupHasNoAdmins:
+			reason = ApprovalReasonGovernorAdmin
+		default:
+			continue
+		}
+
+		approvals = append(approvals, ApprovalInboxItem{
+			Kind:       ApprovalKindMembership,
+			Reason:     reason,
+			CreatedAt:  m.CreatedAt,
+			AgeSeconds: int64(now.Sub(m.CreatedAt).Seconds()),
+			MemberRequest: &GroupMemberRequest{
 				ID:            m.ID,
 				GroupID:       m.GroupID,
 				GroupName:     m.R.Group.Name,
@@ -287,14 +414,12 @@ func (r *Router) getAuthenticatedUserGroupApprovals(c *gin.Context) {
 				UpdatedAt:     m.UpdatedAt,
 				IsAdmin:       m.IsAdmin,
 				Kind:          m.Kind,
-			}, isGroupAdmin})
-		}
+			},
+		})
 	}
 
-	var applicationApprovals []AuthenticatedUserGroupApplicationRequest
-
 	for _, a := range applicationRequests {
-		if ctxUser.ID == a.RequesterUserID {
+		if user.ID == a.RequesterUserID {
 			continue
 		}
 
@@ -302,39 +427,55 @@ func (r *Router) getAuthenticatedUserGroupApprovals(c *gin.Context) {
 			continue
 		}
 
-		applicationApprovals = append(applicationApprovals, AuthenticatedUserGroupApplicationRequest{&GroupApplicationRequest{
-			ID:                     a.ID,
-			ApplicationID:          a.ApplicationID,
-			ApplicationName:        a.R.Application.Name,
-			ApplicationSlug:        a.R.Application.Slug,
-			ApproverGroupID:        a.ApproverGroupID,
-			ApproverGroupName:      a.R.ApproverGroup.Name,
-			ApproverGroupSlug:      a.R.ApproverGroup.Slug,
-			GroupID:                a.GroupID,
-			GroupName:              a.R.Group.Name,
-			GroupSlug:              a.R.Group.Slug,
-			RequesterUserID:        a.RequesterUserID,
-			RequesterUserName:      a.R.RequesterUser.Name,
-			RequesterUserEmail:     a.R.RequesterUser.Email,
-			RequesterUserAvatarURL: a.R.RequesterUser.AvatarURL.String,
-			Note:                   a.Note.String,
-			CreatedAt:              a.CreatedAt,
-			UpdatedAt:              a.UpdatedAt,
-		}})
+		if a.R.GetApplication() == nil || a.R.GetGroup() == nil || a.R.GetApproverGroup() == nil || a.R.GetRequesterUser() == nil {
+			continue
+		}
+
+		approvals = append(approvals, ApprovalInboxItem{
+			Kind:       ApprovalKindApplication,
+			Reason:     ApprovalReasonApproverGroup,
+			CreatedAt:  a.CreatedAt,
+			AgeSeconds: int64(now.Sub(a.CreatedAt).Seconds()),
+			ApplicationRequest: &GroupApplicationRequest{
+				ID:                     a.ID,
+				ApplicationID:          a.ApplicationID,
+				ApplicationName:        a.R.Application.Name,
+				ApplicationSlug:        a.R.Application.Slug,
+				ApproverGroupID:        a.ApproverGroupID,
+				ApproverGroupName:      a.R.ApproverGroup.Name,
+				ApproverGroupSlug:      a.R.ApproverGroup.Slug,
+				GroupID:                a.GroupID,
+				GroupName:              a.R.Group.Name,
+				GroupSlug:              a.R.Group.Slug,
+				RequesterUserID:        a.RequesterUserID,
+				RequesterUserName:      a.R.RequesterUser.Name,
+				RequesterUserEmail:     a.R.RequesterUser.Email,
+				RequesterUserAvatarURL: a.R.RequesterUser.AvatarURL.String,
+				Note:                   a.Note.String,
+				CreatedAt:              a.CreatedAt,
+				UpdatedAt:              a.UpdatedAt,
+			},
+		})
 	}
 
-	c.JSON(http.StatusOK, AuthenticatedUserRequests{
-		ApplicationRequests: applicationApprovals,
-		MemberRequests:      memberApprovals,
-	})
+	return approvals, nil
 }
 
 // getAuthenticatedUserGroupRequests returns a list of group member requests and
 // group application requests that the authenticated user has made
 func (r *Router) getAuthenticatedUserGroupRequests(c *gin.Context) {
-	ctxUser := getCtxUser(c)
-	if ctxUser == nil {
-		sendError(c, http.StatusUnauthorized, "no user in context")
+	ctxUser, err := reloadCtxUser(c, r.DB,
+		"GroupMembershipRequests",
+		"GroupMembershipRequests.User",
+		"GroupMembershipRequests.Group",
+		"RequesterUserGroupApplicationRequests",
+		"RequesterUserGroupApplicationRequests.Application",
+		"RequesterUserGroupApplicationRequests.Group",
+		"RequesterUserGroupApplicationRequests.ApproverGroup",
+		"RequesterUserGroupApplicationRequests.RequesterUser",
+	)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -397,6 +538,108 @@ func (r *Router) getAuthenticatedUserGroupRequests(c *gin.Context) {
 	})
 }
 
+// getAuthenticatedUserRequests returns the outstanding group membership and
+// group application requests of the authenticated user. Unlike
+// getAuthenticatedUserGroupRequests the requests are fetched when the request
+// is handled instead of being preloaded on the context user, and requests on
+// deleted groups or applications are left out.
+func (r *Router) getAuthenticatedUserRequests(c *gin.Context) {
+	ctxUser := getCtxUser(c)
+	if ctxUser == nil {
+		sendError(c, http.StatusUnauthorized, "no user in context")
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	memberRequests, err := models.GroupMembershipRequests(
+		models.GroupMembershipRequestWhere.UserID.EQ(ctxUser.ID),
+		qm.InnerJoin("groups ON groups.id = group_membership_requests.group_id AND groups.deleted_at IS NULL"),
+		qm.Load(models.GroupMembershipRequestRels.Group),
+		qm.OrderBy("group_membership_requests.created_at"),
+	).All(ctx, r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting membership requests: "+err.Error())
+		return
+	}
+
+	applicationRequests, err := models.GroupApplicationRequests(
+		models.GroupApplicationRequestWhere.RequesterUserID.EQ(ctxUser.ID),
+		qm.InnerJoin("groups ON groups.id = group_application_requests.group_id AND groups.deleted_at IS NULL"),
+		qm.InnerJoin("applications ON applications.id = group_application_requests.application_id AND applications.deleted_at IS NULL"),
+		qm.Load(models.GroupApplicationRequestRels.Application),
+		qm.Load(models.GroupApplicationRequestRels.Group),
+		qm.Load(models.GroupApplicationRequestRels.ApproverGroup, qm.WithDeleted()),
+		qm.OrderBy("group_application_requests.created_at"),
+	).All(ctx, r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting application requests: "+err.Error())
+		return
+	}
+
+	now := time.Now()
+
+	resp := AuthenticatedUserPendingRequests{
+		ApplicationRequests: make([]AuthenticatedUserPendingApplicationRequest, len(applicationRequests)),
+		MemberRequests:      make([]AuthenticatedUserPendingMemberRequest, len(memberRequests)),
+	}
+
+	for i, m := range memberRequests {
+		resp.MemberRequests[i] = AuthenticatedUserPendingMemberRequest{
+			GroupMemberRequest: &GroupMemberRequest{
+				ID:             m.ID,
+				GroupID:        m.GroupID,
+				GroupName:      m.R.GetGroup().Name,
+				GroupSlug:      m.R.GetGroup().Slug,
+				UserID:         ctxUser.ID,
+				UserName:       ctxUser.Name,
+				UserEmail:      ctxUser.Email,
+				UserAvatarURL:  ctxUser.AvatarURL.String,
+				CreatedAt:      m.CreatedAt,
+				UpdatedAt:      m.UpdatedAt,
+				IsAdmin:        m.IsAdmin,
+				Note:           m.Note,
+				ExpiresAt:      m.ExpiresAt,
+				AdminExpiresAt: m.AdminExpiresAt,
+				Kind:           m.Kind,
+			},
+			AgeSeconds: int64(now.Sub(m.CreatedAt).Seconds()),
+		}
+	}
+
+	for i, a := range applicationRequests {
+		gar := &GroupApplicationRequest{
+			ID:                     a.ID,
+			ApplicationID:          a.ApplicationID,
+			ApplicationName:        a.R.GetApplication().Name,
+			ApplicationSlug:        a.R.GetApplication().Slug,
+			ApproverGroupID:        a.ApproverGroupID,
+			GroupID:                a.GroupID,
+			GroupName:              a.R.GetGroup().Name,
+			GroupSlug:              a.R.GetGroup().Slug,
+			RequesterUserID:        ctxUser.ID,
+			RequesterUserName:      ctxUser.Name,
+			RequesterUserEmail:     ctxUser.Email,
+			RequesterUserAvatarURL: ctxUser.AvatarURL.String,
+			Note:                   a.Note.String,
+			CreatedAt:              a.CreatedAt,
+			UpdatedAt:              a.UpdatedAt,
+		}
+
+		if approver := a.R.GetApproverGroup(); approver != nil {
+			gar.ApproverGroupName = approver.Name
+			gar.ApproverGroupSlug = approver.Slug
+		}
+
+		resp.ApplicationRequests[i] = AuthenticatedUserPendingApplicationRequest{
+			GroupApplicationRequest: gar,
+			AgeSeconds:              int64(now.Sub(a.CreatedAt).Seconds()),
+		}
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // removeAuthenticatedUserGroup removes the authenticated user from the specified group
 func (r *Router) removeAuthenticatedUserGroup(c *gin.Context) {
 	ctxUser := getCtxUser(c)
@@ -415,7 +658,7 @@ func (r *Router) removeAuthenticatedUserGroup(c *gin.Context) {
 	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -439,58 +682,25 @@ func (r *Router) removeAuthenticatedUserGroup(c *gin.Context) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting group membership delete transaction: "+err.Error())
-		return
-	}
-
-	if _, err := membership.Delete(c.Request.Context(), tx); err != nil {
-		msg := "error removing membership: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := membership.Delete(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "error removing membership: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditGroupMembershipDeleted(c.Request.Context(), tx, getCtxAuditID(c), ctxUser, membership)
-	if err != nil {
-		msg := "error removing membership (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorMembersEventSubject, &events.Event{
+			Version: events.MemberVersion,
+			Action:  events.GovernorEventDelete,
+			GroupID: gid,
+			UserID:  ctxUser.ID,
+			Member:  dbtools.GroupMembershipEventMember(membership),
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupMembershipDeleted(ctx, exec, pID, actor, membership)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error removing membership (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error removing membership (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing remove membership, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
@@ -500,17 +710,7 @@ func (r *Router) removeAuthenticatedUserGroup(c *gin.Context) {
 		return
 	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorMembersEventSubject, &events.Event{
-		Version: events.Version,
-		Action:  events.GovernorEventDelete,
-		AuditID: c.GetString(ginaudit.AuditIDContextKey),
-		ActorID: getCtxActorID(c),
-		GroupID: gid,
-		UserID:  ctxUser.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish members delete event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusNoContent, nil)
 }
@@ -526,8 +726,7 @@ func (r *Router) updateAuthenticatedUser(c *gin.Context) {
 	original := *ctxUser
 
 	req := AuthenticatedUserReq{}
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
 		return
 	}
 
@@ -539,88 +738,35 @@ func (r *Router) updateAuthenticatedUser(c *gin.Context) {
 		ctxUser.GithubUsername = null.StringFrom(*req.GithubUsername)
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting update transaction: "+err.Error())
-		return
-	}
-
-	if _, err := ctxUser.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := "error updating user: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditUserUpdated(c.Request.Context(), tx, getCtxAuditID(c), ctxUser, &original, ctxUser)
-	if err != nil {
-		msg := "error updating user (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := ctxUser.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating user: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error updating user (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorUsersEventSubject, &events.Event{
+			Action:  events.GovernorEventUpdate,
+			GroupID: "",
+			UserID:  ctxUser.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditUserUpdated(ctx, exec, pID, actor, &original, ctxUser)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating user (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if len(req.NotificationPreferences) > 0 {
-		if _, status, err := handleUpdateNotificationPreferencesRequests(
-			c, tx, ctxUser, req.NotificationPreferences,
-		); err != nil {
-			msg := err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
+		if len(req.NotificationPreferences) > 0 {
+			if _, status, err := handleUpdateNotificationPreferencesRequests(
+				c, tx, ctxUser, req.NotificationPreferences,
+			); err != nil {
+				return abortTx(status, err.Error(), err)
 			}
-
-			sendError(c, status, msg)
-
-			return
-		}
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing user update, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorUsersEventSubject, &events.Event{
-		Version: events.Version,
-		Action:  events.GovernorEventUpdate,
-		AuditID: c.GetString(ginaudit.AuditIDContextKey),
-		ActorID: getCtxActorID(c),
-		GroupID: "",
-		UserID:  ctxUser.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish user update event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, ctxUser)
 }
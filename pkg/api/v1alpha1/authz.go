@@ -0,0 +1,261 @@
+package v1alpha1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+)
+
+const (
+	// AuthzActionAdmin checks whether the user is a governor admin
+	AuthzActionAdmin = "admin"
+	// AuthzActionGroupMember checks whether the user is a member of the group
+	AuthzActionGroupMember = "group.member"
+	// AuthzActionGroupAdmin checks whether the user is an admin of the group,
+	// like when adding or removing members
+	AuthzActionGroupAdmin = "group.admin"
+	// AuthzActionGroupUpdate checks whether the user is a governor admin or an
+	// admin of the group, like when updating the group
+	AuthzActionGroupUpdate = "group.update"
+	// AuthzActionGroupApprove checks whether the user is a governor admin, an
+	// admin of the group or a member of its approver group, like when
+	// processing the requests of the group
+	AuthzActionGroupApprove = "group.approve"
+	// AuthzActionSystemExtensionResourceUpdate checks whether the user can
+	// change the system extension resources of an ERD, or one of them
+	AuthzActionSystemExtensionResourceUpdate = "system_extension_resource.update"
+)
+
+// authzGroupActionRoles are the group auth roles checked for the group actions
+var authzGroupActionRoles = map[string]mwAuthRole{
+	AuthzActionGroupMember:  AuthRoleGroupMember,
+	AuthzActionGroupAdmin:   AuthRoleGroupAdmin,
+	AuthzActionGroupUpdate:  AuthRoleAdminOrGroupAdmin,
+	AuthzActionGroupApprove: AuthRoleAdminOrGroupAdminOrGroupApprover,
+}
+
+// AuthzCheckReq asks whether a user can perform an action on a resource
+type AuthzCheckReq struct {
+	// UserID is the user to check, the authenticated user when it's empty.
+	// Only governor admins and non-user api clients can check other users.
+	UserID   string             `json:"user_id" binding:"omitempty,uuid"`
+	Action   string             `json:"action" binding:"required,oneof=admin group.member group.admin group.update group.approve system_extension_resource.update"`
+	Resource AuthzCheckResource `json:"resource"`
+}
+
+// AuthzCheckResource is the resource of an authorization check, the group
+// actions need the GroupID and the extension resource actions need the
+// Extension, ERD and Version, and optionally the ResourceID
+type AuthzCheckResource struct {
+	GroupID    string `json:"group_id"`
+	Extension  string `json:"extension"`
+	ERD        string `json:"erd"`
+	Version    string `json:"version"`
+	ResourceID string `json:"resource_id"`
+}
+
+// AuthzCheckResponse is the answer to an authorization check, the reason
+// tells why the action is denied
+type AuthzCheckResponse struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// groupRoles are the roles of a user in a group
+type groupRoles struct {
+	member   bool
+	admin    bool
+	approver bool
+}
+
+// userGroupRoles returns the roles in the group of the user with the
+// enumerated memberships, expired admin privileges are ignored
+func userGroupRoles(group *models.Group, memberships []dbtools.EnumeratedMembership, now time.Time) groupRoles {
+	roles := groupRoles{}
+
+	for _, m := range memberships {
+		if m.GroupID == group.ID {
+			roles.member = true
+			roles.admin = m.IsAdmin && (!m.AdminExpiresAt.Valid || now.Before(m.AdminExpiresAt.Time))
+		}
+
+		if group.ApproverGroup.Valid && group.ApproverGroup.String == m.GroupID {
+			roles.approver = true
+		}
+	}
+
+	return roles
+}
+
+// groupAuthRoleAllowed returns whether a user with the roles in a group has
+// the group auth role, and why not when it doesn't
+func groupAuthRoleAllowed(authRole mwAuthRole, isAdmin bool, roles groupRoles) (bool, string) {
+	switch authRole {
+	case AuthRoleGroupMember:
+		return roles.member, "user not group member"
+	case AuthRoleGroupAdmin:
+		return roles.admin, "user not group admin"
+	case AuthRoleAdminOrGroupAdmin:
+		return roles.admin || isAdmin, "user not admin or group admin"
+	case AuthRoleAdminOrGroupAdminOrGroupApprover:
+		return roles.admin || isAdmin || roles.approver, "user not admin or group admin"
+	default:
+		return false, "unsupported auth role"
+	}
+}
+
+// isGovernorAdmin returns true when one of the enumerated memberships of a
+// user is in one of the governor admin groups
+func (r *Router) isGovernorAdmin(ctx context.Context, memberships []dbtools.EnumeratedMembership) (bool, error) {
+	ag := make([]interface{}, len(r.AdminGroups))
+	for i, a := range r.AdminGroups {
+		ag[i] = a
+	}
+
+	adminGroups, err := models.Groups(qm.WhereIn("slug IN ?", ag...), tenancy.Scope(ctx, models.TableNames.Groups)).All(ctx, r.DB)
+	if err != nil {
+		return false, err
+	}
+
+	for _, g := range adminGroups {
+		for _, m := range memberships {
+			if m.GroupID == g.ID {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// systemExtensionResourceGroups returns the groups whose members can change
+// the system extension resources of the ERD: the ERD admin group, and the
+// owner group of the resource when a resource id is given
+func (r *Router) systemExtensionResourceGroups(ctx context.Context, erd *models.ExtensionResourceDefinition, resourceID string) (map[string]bool, error) {
+	allowedGroups := map[string]bool{}
+
+	if erd.AdminGroup.Valid && erd.AdminGroup.String != "" {
+		allowedGroups[erd.AdminGroup.String] = true
+	}
+
+	if resourceID != "" && erd.Scope == ExtensionResourceDefinitionScopeSys.String() {
+		er, err := erd.SystemExtensionResources(
+			qm.Where("id = ?", resourceID),
+			qm.Select(models.SystemExtensionResourceColumns.OwnerID),
+		).One(ctx, r.DB)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		if er != nil && er.OwnerID.Valid {
+			allowedGroups[er.OwnerID.String] = true
+		}
+	}
+
+	return allowedGroups, nil
+}
+
+// checkAuthz answers whether a user can perform an action on a resource with
+// the same rules as the auth middlewares, so clients can check permissions
+// before making a request
+func (r *Router) checkAuthz(c *gin.Context) {
+	req := AuthzCheckReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	ctx := c.Request.Context()
+	actor := ctxActor(c)
+
+	userID := req.UserID
+
+	switch {
+	case userID == "" && actor.User == nil:
+		sendError(c, http.StatusBadRequest, "user_id is required for non-user api clients")
+		return
+	case userID == "":
+		userID = actor.User.ID
+	case actor.User != nil && actor.User.ID != userID && !actor.Admin:
+		sendError(c, http.StatusForbidden, "only governor admins can check the permissions of other users")
+		return
+	}
+
+	user, err := r.svc().FindUser(ctx, userID, false)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	memberships, err := dbtools.GetMembershipsForUser(ctx, r.DB.DB, user.ID, false)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting enumerated groups: "+err.Error())
+		return
+	}
+
+	isAdmin, err := r.isGovernorAdmin(ctx, memberships)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting admin groups: "+err.Error())
+		return
+	}
+
+	resp := AuthzCheckResponse{}
+
+	switch req.Action {
+	case AuthzActionAdmin:
+		resp.Allowed = isAdmin
+		resp.Reason = "user not admin"
+	case AuthzActionSystemExtensionResourceUpdate:
+		_, erd, err := findERDForExtensionResource(c, r.DB, req.Resource.Extension, req.Resource.ERD, req.Resource.Version)
+		if err != nil {
+			if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
+				sendErrorFromErr(c, http.StatusNotFound, err)
+				return
+			}
+
+			sendError(c, http.StatusBadRequest, err.Error())
+
+			return
+		}
+
+		resp.Allowed = isAdmin
+		resp.Reason = "user do not have permissions to access this resource"
+
+		if !isAdmin {
+			allowedGroups, err := r.systemExtensionResourceGroups(ctx, erd, req.Resource.ResourceID)
+			if err != nil {
+				sendError(c, http.StatusInternalServerError, "error finding extension resource: "+err.Error())
+				return
+			}
+
+			for _, m := range memberships {
+				if allowedGroups[m.GroupID] {
+					resp.Allowed = true
+					break
+				}
+			}
+		}
+	default:
+		group, err := r.svc().FindGroup(ctx, req.Resource.GroupID, false)
+		if err != nil {
+			sendServiceError(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		resp.Allowed, resp.Reason = groupAuthRoleAllowed(authzGroupActionRoles[req.Action], isAdmin, userGroupRoles(group, memberships, time.Now()))
+	}
+
+	if resp.Allowed {
+		resp.Reason = ""
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
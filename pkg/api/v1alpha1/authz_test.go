@@ -0,0 +1,69 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestUserGroupRoles(t *testing.T) {
+	now := time.Now()
+	group := &models.Group{ID: "group", ApproverGroup: null.StringFrom("approvers")}
+
+	tests := []struct {
+		name        string
+		memberships []dbtools.EnumeratedMembership
+		want        groupRoles
+	}{
+		{
+			name: "not a member",
+			want: groupRoles{},
+		},
+		{
+			name:        "member",
+			memberships: []dbtools.EnumeratedMembership{{GroupID: "group"}},
+			want:        groupRoles{member: true},
+		},
+		{
+			name:        "admin",
+			memberships: []dbtools.EnumeratedMembership{{GroupID: "group", IsAdmin: true}},
+			want:        groupRoles{member: true, admin: true},
+		},
+		{
+			name:        "expired admin",
+			memberships: []dbtools.EnumeratedMembership{{GroupID: "group", IsAdmin: true, AdminExpiresAt: null.TimeFrom(now.Add(-time.Hour))}},
+			want:        groupRoles{member: true},
+		},
+		{
+			name:        "approver after the group",
+			memberships: []dbtools.EnumeratedMembership{{GroupID: "group"}, {GroupID: "approvers"}},
+			want:        groupRoles{member: true, approver: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, userGroupRoles(group, tt.memberships, now))
+		})
+	}
+}
+
+func TestGroupAuthRoleAllowed(t *testing.T) {
+	allowed, _ := groupAuthRoleAllowed(AuthRoleGroupAdmin, true, groupRoles{member: true})
+	assert.False(t, allowed, "governor admins aren't group admins")
+
+	allowed, _ = groupAuthRoleAllowed(AuthRoleAdminOrGroupAdmin, true, groupRoles{})
+	assert.True(t, allowed)
+
+	allowed, _ = groupAuthRoleAllowed(AuthRoleAdminOrGroupAdminOrGroupApprover, false, groupRoles{approver: true})
+	assert.True(t, allowed)
+
+	allowed, reason := groupAuthRoleAllowed(AuthRoleUser, true, groupRoles{member: true, admin: true})
+	assert.False(t, allowed)
+	assert.Equal(t, "unsupported auth role", reason)
+}
@@ -0,0 +1,152 @@
+package v1alpha1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/metal-toolbox/governor-api/internal/backup"
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// Backup describes a backup of the governor entities in the backup store
+type Backup struct {
+	Name          string         `json:"name"`
+	SchemaVersion int64          `json:"schema_version"`
+	CreatedAt     time.Time      `json:"created_at"`
+	Records       map[string]int `json:"records"`
+}
+
+// BackupRestoreReq is the request to restore a backup, a dry run only
+// compares the backup with the database
+type BackupRestoreReq struct {
+	DryRun bool `json:"dry_run"`
+}
+
+// BackupRestoreResponse is the comparison of a backup with the database, and
+// whether the backup was restored
+type BackupRestoreResponse struct {
+	Name     string       `json:"name"`
+	DryRun   bool         `json:"dry_run"`
+	Restored bool         `json:"restored"`
+	Diff     *backup.Diff `json:"diff"`
+}
+
+// backupsConfigured sends a 501 and returns false when there is no backup store
+func (r *Router) backupsConfigured(c *gin.Context) bool {
+	if r.Backups == nil {
+		sendError(c, http.StatusNotImplemented, "backups aren't configured")
+		return false
+	}
+
+	return true
+}
+
+// backupManager returns the backup manager of the governor database
+func (r *Router) backupManager() *backup.Manager {
+	return backup.New(r.DB.DB, backup.WithLogger(r.Logger))
+}
+
+// listBackups lists the names of the backups in the backup store
+func (r *Router) listBackups(c *gin.Context) {
+	if !r.backupsConfigured(c) {
+		return
+	}
+
+	names, err := r.Backups.List(c.Request.Context())
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, names)
+}
+
+// createBackup exports a consistent backup of the governor entities to the
+// backup store
+func (r *Router) createBackup(c *gin.Context) {
+	if !r.backupsConfigured(c) {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	b, err := r.backupManager().Export(ctx)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := r.Backups.Put(ctx, b); err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	event, err := dbtools.AuditBackupCreated(ctx, r.DB, getCtxAuditID(c), getCtxUser(c), b.Name(), b.SchemaVersion)
+	if !handleServiceResult(c, event, err) {
+		return
+	}
+
+	c.JSON(http.StatusCreated, Backup{
+		Name:          b.Name(),
+		SchemaVersion: b.SchemaVersion,
+		CreatedAt:     b.CreatedAt,
+		Records:       b.Records(),
+	})
+}
+
+// restoreBackup restores a backup of the backup store into the database,
+// which must be empty and have the schema version of the backup. A dry run
+// only compares the backup with the database.
+func (r *Router) restoreBackup(c *gin.Context) {
+	if !r.backupsConfigured(c) {
+		return
+	}
+
+	req := BackupRestoreReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	b, err := r.Backups.Get(ctx, c.Param("name"))
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := BackupRestoreResponse{Name: c.Param("name"), DryRun: req.DryRun}
+
+	if req.DryRun {
+		if resp.Diff, err = r.backupManager().Diff(ctx, b); err != nil {
+			sendServiceError(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+
+		return
+	}
+
+	var event *models.AuditEvent
+
+	resp.Diff, err = r.backupManager().Restore(ctx, b, func(ctx context.Context, tx *sql.Tx, _ *backup.Diff) error {
+		var err error
+
+		event, err = dbtools.AuditBackupRestored(ctx, tx, getCtxAuditID(c), getCtxUser(c), resp.Name, b.SchemaVersion)
+
+		return err
+	})
+	if !handleServiceResult(c, event, err) {
+		return
+	}
+
+	resp.Restored = true
+
+	c.JSON(http.StatusOK, resp)
+}
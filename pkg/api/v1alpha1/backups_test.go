@@ -0,0 +1,82 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/metal-toolbox/governor-api/internal/backup"
+)
+
+func TestBackupsValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		store    backup.Store
+		method   string
+		path     string
+		body     string
+		wantCode int
+		wantErr  ErrorCode
+	}{
+		{
+			name:     "not configured",
+			method:   http.MethodGet,
+			path:     "/backups",
+			wantCode: http.StatusNotImplemented,
+			wantErr:  ErrCodeNotImplemented,
+		},
+		{
+			name:     "restore not configured",
+			method:   http.MethodPost,
+			path:     "/backups/governor-20240501T123000Z.json/restore",
+			body:     `{"dry_run": true}`,
+			wantCode: http.StatusNotImplemented,
+			wantErr:  ErrCodeNotImplemented,
+		},
+		{
+			name:     "backup not found",
+			store:    backup.NewDirStore(t.TempDir()),
+			method:   http.MethodPost,
+			path:     "/backups/governor-20240501T123000Z.json/restore",
+			body:     `{"dry_run": true}`,
+			wantCode: http.StatusNotFound,
+			wantErr:  ErrCodeNotFound,
+		},
+		{
+			name:     "invalid backup name",
+			store:    backup.NewDirStore(t.TempDir()),
+			method:   http.MethodPost,
+			path:     "/backups/governor.yaml/restore",
+			body:     `{"dry_run": true}`,
+			wantCode: http.StatusNotFound,
+			wantErr:  ErrCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Router{Backups: tt.store}
+
+			engine := gin.New()
+			engine.GET("/backups", r.listBackups)
+			engine.POST("/backups/:name/restore", r.restoreBackup)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+
+			resp := ErrorResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantErr, resp.Code)
+		})
+	}
+}
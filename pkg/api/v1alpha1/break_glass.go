@@ -0,0 +1,115 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+)
+
+// BreakGlassAccessReq is a request of a responder for break-glass access to a
+// group, the access expires an hour later when no expiry is given
+type BreakGlassAccessReq struct {
+	IncidentRef string    `json:"incident_ref" binding:"required,max=255"`
+	ExpiresAt   null.Time `json:"expires_at"`
+}
+
+// BreakGlassAccess is the break-glass access granted to a responder
+type BreakGlassAccess struct {
+	GroupID     string    `json:"group_id"`
+	UserID      string    `json:"user_id"`
+	IncidentRef string    `json:"incident_ref"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	AuditID     string    `json:"audit_id"`
+	// Notified is how many members of the security group were notified
+	Notified int `json:"notified"`
+}
+
+// enableGroupBreakGlass approves a group for break-glass access
+func (r *Router) enableGroupBreakGlass(c *gin.Context) {
+	group, event, err := r.svc().EnableGroupBreakGlass(c.Request.Context(), ctxActor(c), c.Param("id"))
+	if !handleServiceResult(c, event, err) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, group)
+}
+
+// disableGroupBreakGlass removes the break-glass approval of a group
+func (r *Router) disableGroupBreakGlass(c *gin.Context) {
+	group, event, err := r.svc().DisableGroupBreakGlass(c.Request.Context(), ctxActor(c), c.Param("id"))
+	if !handleServiceResult(c, event, err) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, group)
+}
+
+// breakGlassAccess grants the responder making the request a short membership
+// in a group approved for break-glass access, the security group is notified
+// right away
+func (r *Router) breakGlassAccess(c *gin.Context) {
+	if r.BreakGlassRespondersGroup == "" {
+		sendError(c, http.StatusNotImplemented, "break-glass access isn't configured")
+		return
+	}
+
+	req := BreakGlassAccessReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	membership, event, err := r.svc().BreakGlass(c.Request.Context(), ctxActor(c), r.BreakGlassRespondersGroup, c.Param("id"), service.BreakGlassParams{
+		IncidentRef: req.IncidentRef,
+		ExpiresAt:   req.ExpiresAt,
+	})
+
+	// the access is committed when the membership is returned, even if
+	// publishing the members events failed, so security is always notified
+	notified := 0
+	if membership != nil {
+		notified = r.notifyBreakGlass(c, membership, event)
+	}
+
+	if !handleServiceResult(c, event, err) {
+		return
+	}
+
+	c.JSON(http.StatusCreated, BreakGlassAccess{
+		GroupID:     membership.GroupID,
+		UserID:      membership.UserID,
+		IncidentRef: membership.SourceRef.String,
+		ExpiresAt:   membership.ExpiresAt.Time,
+		AuditID:     event.ID,
+		Notified:    notified,
+	})
+}
+
+// notifyBreakGlass logs a break-glass access and notifies the security group,
+// the number of notifications dispatched is returned
+func (r *Router) notifyBreakGlass(c *gin.Context, membership *models.GroupMembership, event *models.AuditEvent) int {
+	r.Logger.Warn("break-glass access granted",
+		zap.String("group.id", membership.GroupID),
+		zap.String("user.id", membership.UserID),
+		zap.String("incident_ref", membership.SourceRef.String),
+		zap.Time("expires_at", membership.ExpiresAt.Time),
+		zap.String("audit.id", event.ID),
+	)
+
+	if r.BreakGlassSecurityGroup == "" {
+		return 0
+	}
+
+	notified, err := r.svc().NotifyBreakGlass(c.Request.Context(), r.BreakGlassSecurityGroup, r.BreakGlassNotificationType, membership, event)
+	if err != nil {
+		r.Logger.Error("error notifying security of break-glass access",
+			zap.String("audit.id", event.ID), zap.Int("notified", notified), zap.Error(err))
+	}
+
+	return notified
+}
@@ -0,0 +1,55 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreakGlassAccess(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name            string
+		respondersGroup string
+		body            string
+		wantStatus      int
+	}{
+		{
+			name:       "not configured",
+			body:       `{"incident_ref": "INC-42"}`,
+			wantStatus: http.StatusNotImplemented,
+		},
+		{
+			name:            "missing incident reference",
+			respondersGroup: "responders",
+			body:            `{}`,
+			wantStatus:      http.StatusBadRequest,
+		},
+		{
+			name:            "incident reference too long",
+			respondersGroup: "responders",
+			body:            `{"incident_ref": "` + strings.Repeat("a", 256) + `"}`,
+			wantStatus:      http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Router{BreakGlassRespondersGroup: tt.respondersGroup}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/api/v1alpha1/groups/db/break-glass/access", strings.NewReader(tt.body))
+			c.Params = gin.Params{{Key: "id", Value: "db"}}
+
+			r.breakGlassAccess(c)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package v1alpha1
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+)
+
+// errInvalidChangeCursor is returned when a change feed cursor can't be decoded
+var errInvalidChangeCursor = errors.New("invalid change feed cursor")
+
+// Change is a change of the governor state in the change feed, the entity is
+// the kind of record changed, like group.member, and the operation what
+// happened to it, like added
+type Change struct {
+	AuditID               string      `json:"audit_id"`
+	Action                string      `json:"action"`
+	Entity                string      `json:"entity"`
+	Operation             string      `json:"operation"`
+	ActorID               null.String `json:"actor_id"`
+	SubjectGroupID        null.String `json:"subject_group_id"`
+	SubjectUserID         null.String `json:"subject_user_id"`
+	SubjectOrganizationID null.String `json:"subject_organization_id"`
+	SubjectApplicationID  null.String `json:"subject_application_id"`
+	SubjectExtensionID    null.String `json:"subject_extension_id"`
+	Changeset             []string    `json:"changeset"`
+	CreatedAt             time.Time   `json:"created_at"`
+}
+
+// ChangeFeed is a page of the change feed, the next cursor is the cursor to
+// pass to get the following changes, the same cursor when there are none yet
+type ChangeFeed struct {
+	Changes    []Change `json:"changes"`
+	NextCursor string   `json:"next_cursor"`
+}
+
+// encodeChangeCursor returns the opaque cursor of the position after an audit event
+func encodeChangeCursor(e *models.AuditEvent) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(e.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + e.ID))
+}
+
+// decodeChangeCursor returns the position of an opaque change feed cursor
+func decodeChangeCursor(cursor string) (*service.ChangeCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidChangeCursor
+	}
+
+	ts, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, errInvalidChangeCursor
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return nil, errInvalidChangeCursor
+	}
+
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, errInvalidChangeCursor
+	}
+
+	return &service.ChangeCursor{CreatedAt: createdAt, AuditID: id}, nil
+}
+
+// changeEntity splits an audit action into the entity changed and the operation
+func changeEntity(action string) (string, string) {
+	i := strings.LastIndex(action, ".")
+	if i < 0 {
+		return action, ""
+	}
+
+	return action[:i], action[i+1:]
+}
+
+// listChanges returns a page of the ordered feed of the governor state
+// changes after the cursor, so a single consumer can mirror the governor
+// state without subscribing to every event subject
+func (r *Router) listChanges(c *gin.Context) {
+	var after *service.ChangeCursor
+
+	cursor := c.Query("cursor")
+	if cursor != "" {
+		var err error
+
+		if after, err = decodeChangeCursor(cursor); err != nil {
+			sendValidationError(c, []ErrorDetail{{Field: "cursor", Message: err.Error()}})
+			return
+		}
+	}
+
+	limit := defaultPaginationSize
+
+	if v, ok := c.GetQuery("limit"); ok {
+		l, err := strconv.Atoi(v)
+		if err != nil || l <= 0 || l > maxPaginationSize {
+			sendValidationError(c, []ErrorDetail{{Field: "limit", Message: "limit must be between 1 and " + strconv.Itoa(maxPaginationSize)}})
+			return
+		}
+
+		limit = l
+	}
+
+	events, err := r.svc().Changes(c.Request.Context(), after, limit)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	feed := ChangeFeed{
+		Changes:    make([]Change, len(events)),
+		NextCursor: cursor,
+	}
+
+	for i, e := range events {
+		entity, operation := changeEntity(e.Action)
+
+		feed.Changes[i] = Change{
+			AuditID:               e.ID,
+			Action:                e.Action,
+			Entity:                entity,
+			Operation:             operation,
+			ActorID:               e.ActorID,
+			SubjectGroupID:        e.SubjectGroupID,
+			SubjectUserID:         e.SubjectUserID,
+			SubjectOrganizationID: e.SubjectOrganizationID,
+			SubjectApplicationID:  e.SubjectApplicationID,
+			SubjectExtensionID:    e.SubjectExtensionID,
+			Changeset:             e.Changeset,
+			CreatedAt:             e.CreatedAt,
+		}
+	}
+
+	if len(events) > 0 {
+		feed.NextCursor = encodeChangeCursor(events[len(events)-1])
+	}
+
+	c.JSON(http.StatusOK, feed)
+}
@@ -0,0 +1,72 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestChangeCursor(t *testing.T) {
+	event := &models.AuditEvent{
+		ID:        "c5f7dc43-36ac-4a4c-9a3d-0e4dfb6b1a2a",
+		CreatedAt: time.Date(2024, 5, 1, 12, 0, 0, 123456000, time.UTC),
+	}
+
+	cursor, err := decodeChangeCursor(encodeChangeCursor(event))
+	require.NoError(t, err)
+	assert.Equal(t, event.ID, cursor.AuditID)
+	assert.True(t, event.CreatedAt.Equal(cursor.CreatedAt))
+
+	for _, invalid := range []string{"not base64!", "bm8gc2VwYXJhdG9y", "eWVzdGVyZGF5fGlk"} {
+		_, err := decodeChangeCursor(invalid)
+		assert.ErrorIs(t, err, errInvalidChangeCursor, invalid)
+	}
+}
+
+func TestChangeEntity(t *testing.T) {
+	tests := []struct {
+		action        string
+		wantEntity    string
+		wantOperation string
+	}{
+		{action: "group.member.added", wantEntity: "group.member", wantOperation: "added"},
+		{action: "user.created", wantEntity: "user", wantOperation: "created"},
+		{action: "approve", wantEntity: "approve"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			entity, operation := changeEntity(tt.action)
+			assert.Equal(t, tt.wantEntity, entity)
+			assert.Equal(t, tt.wantOperation, operation)
+		})
+	}
+}
+
+func TestListChangesValidation(t *testing.T) {
+	r := &Router{}
+
+	engine := gin.New()
+	engine.GET("/changes", r.listChanges)
+
+	for _, path := range []string{"/changes?cursor=invalid", "/changes?limit=0", "/changes?limit=1001"} {
+		t.Run(path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			resp := ErrorResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, ErrCodeValidationFailed, resp.Code)
+		})
+	}
+}
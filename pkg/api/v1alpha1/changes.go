@@ -0,0 +1,127 @@
+package v1alpha1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/eventbus"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	pkgeventbus "github.com/metal-toolbox/governor-api/pkg/eventbus"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+const contextKeyChanges = "gin-contextkey/changes"
+
+// auditFunc writes the audit event of a change with the audit id and the
+// actor of the request
+type auditFunc func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error)
+
+// change is a change of governor's state made in a handler transaction, its
+// event is published with publishChanges once the transaction is committed
+type change struct {
+	subject string
+	event   *events.Event
+	// staged is the id of the event staged in the outbox
+	staged string
+}
+
+// recordChange writes the audit event of a change in tx, adds it to the audit
+// data of the request and stages the event of the change in the outbox in
+// the same transaction, so the three are committed or rolled back together.
+// The event has the action and the subjects of the change, its audit id and
+// actor are set from the request. audit is nil for the events of a change
+// that is audited with another one, like the link created by an approval.
+func (r *Router) recordChange(c *gin.Context, tx *sql.Tx, sub string, event *events.Event, audit auditFunc) error {
+	ctx := c.Request.Context()
+
+	if audit != nil {
+		auditEvent, err := audit(ctx, tx, getCtxAuditID(c), getCtxUser(c))
+		if err != nil {
+			return err
+		}
+
+		if err := updateContextWithAuditEventData(c, auditEvent); err != nil {
+			return err
+		}
+	}
+
+	if event.Version == "" {
+		event.Version = events.Version
+	}
+
+	event.AuditID = getCtxAuditID(c)
+	event.ActorID = getCtxActorID(c)
+
+	ch := change{subject: sub, event: event}
+
+	if r.EventOutbox != nil {
+		msg, err := eventbus.StagedMessage(sub, event)
+		if err != nil {
+			return err
+		}
+
+		if ch.staged, err = r.EventOutbox.Stage(ctx, tx, msg); err != nil {
+			return fmt.Errorf("error staging event: %w", err)
+		}
+	}
+
+	c.Set(contextKeyChanges, append(getCtxChanges(c), ch))
+
+	return nil
+}
+
+// publishChanges publishes the events of the changes recorded by the request
+// once their transaction is committed, and removes them from the outbox. The
+// changes are committed, so the response is sent as 202 Accepted when an
+// event can't be published. A staged event is left in the outbox then, it is
+// queued and the outbox publishes it later.
+func (r *Router) publishChanges(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changes := getCtxChanges(c)
+	c.Set(contextKeyChanges, nil)
+
+	for _, ch := range changes {
+		remove := ch.staged != ""
+
+		if err := r.EventBus.Publish(ctx, ch.subject, ch.event); err != nil {
+			// the staged event stays in the outbox, unless the event bus
+			// queued it again
+			if remove && !errors.Is(err, pkgeventbus.ErrQueued) {
+				err = fmt.Errorf("%w: %w", pkgeventbus.ErrQueued, err)
+				remove = false
+			}
+
+			acceptChange(c, fmt.Errorf("failed to publish %s %s event: %w", ch.subject, strings.ToLower(ch.event.Action), err))
+		}
+
+		if !remove {
+			continue
+		}
+
+		if err := r.EventOutbox.Remove(ctx, ch.staged); err != nil {
+			r.Logger.Warn("failed to remove published event from the outbox, it will be published again",
+				zap.String("subject", ch.subject), zap.Error(err))
+		}
+	}
+}
+
+// discardChanges drops the changes recorded in a transaction that was rolled
+// back, their staged events were rolled back with it
+func discardChanges(c *gin.Context) {
+	c.Set(contextKeyChanges, nil)
+}
+
+func getCtxChanges(c *gin.Context) []change {
+	v, _ := c.Get(contextKeyChanges)
+	changes, _ := v.([]change)
+
+	return changes
+}
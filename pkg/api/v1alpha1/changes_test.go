@@ -0,0 +1,114 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/auditevent/ginaudit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+func TestRecordChange(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	bus := eventbus.NewMemory()
+	r := &Router{EventBus: bus, Logger: zap.NewNop()}
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/v1alpha1/groups", nil)
+	c.Set(ginaudit.AuditIDContextKey, "audit-id")
+	setCtxUser(c, &models.User{ID: "actor-id"})
+
+	audit := func(_ context.Context, _ boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+		return &models.AuditEvent{ParentID: null.StringFrom(pID), ActorID: null.StringFrom(actor.ID), Action: "group.created"}, nil
+	}
+
+	require.NoError(t, r.recordChange(c, nil, events.GovernorGroupsEventSubject, &events.Event{
+		Action:  events.GovernorEventCreate,
+		GroupID: "group-id",
+	}, audit))
+
+	_, recorded := c.Get(ginaudit.AuditDataContextKey)
+	assert.True(t, recorded)
+
+	// nothing is published before the transaction is committed
+	assert.Empty(t, bus.Events())
+
+	r.publishChanges(c)
+
+	published := bus.EventsOn(events.GovernorGroupsEventSubject)
+	require.Len(t, published, 1)
+	assert.Equal(t, events.Version, published[0].Version)
+	assert.Equal(t, "audit-id", published[0].AuditID)
+	assert.Equal(t, "actor-id", published[0].ActorID)
+	assert.Equal(t, "group-id", published[0].GroupID)
+
+	// the changes are only published once
+	r.publishChanges(c)
+	assert.Len(t, bus.Events(), 1)
+
+	// the changes of a rolled back transaction are discarded
+	require.NoError(t, r.recordChange(c, nil, events.GovernorGroupsEventSubject, &events.Event{
+		Action:  events.GovernorEventDelete,
+		GroupID: "group-id",
+	}, audit))
+
+	discardChanges(c)
+
+	r.publishChanges(c)
+	assert.Len(t, bus.Events(), 1)
+}
+
+func TestPublishChangesNotPublished(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		err    error
+		staged string
+		events string
+	}{
+		{"failed", errTestPublish, "", eventsFailed},
+		{"queued", fmt.Errorf("%w: %w", eventbus.ErrQueued, errTestPublish), "", eventsQueued},
+		// the staged event is left in the outbox, the outbox publishes it
+		{"staged", errTestPublish, "outbox-id", eventsQueued},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := &failingBus{Memory: eventbus.NewMemory(), err: tt.err}
+			r := &Router{EventBus: bus, Logger: zap.NewNop()}
+
+			e := gin.New()
+			e.POST("/groups", func(c *gin.Context) {
+				c.Set(contextKeyChanges, []change{{
+					subject: events.GovernorGroupsEventSubject,
+					event:   &events.Event{Action: events.GovernorEventCreate, GroupID: "group-id"},
+					staged:  tt.staged,
+				}})
+
+				r.publishChanges(c)
+				c.JSON(http.StatusCreated, gin.H{})
+			})
+
+			w := httptest.NewRecorder()
+			e.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/groups", nil))
+
+			assert.Equal(t, http.StatusAccepted, w.Code)
+			assert.Equal(t, tt.events, w.Header().Get(eventsHeader))
+			assert.Len(t, bus.EventsOn(events.GovernorGroupsEventSubject), 1)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// reloadCtxUser loads the context user again with the given relations and
+// replaces the context user with it. The auth middleware only loads the user,
+// the handlers load the relations they need with exec, in their transaction
+// when they make decisions on them, so a concurrent change of the user's
+// memberships or requests isn't missed.
+func reloadCtxUser(c *gin.Context, exec boil.ContextExecutor, rels ...string) (*models.User, error) {
+	ctxUser := getCtxUser(c)
+	if ctxUser == nil {
+		return nil, ErrNoContextUser
+	}
+
+	mods := []qm.QueryMod{models.UserWhere.ID.EQ(ctxUser.ID)}
+
+	for _, rel := range rels {
+		mods = append(mods, qm.Load(rel))
+	}
+
+	user, err := models.Users(mods...).One(c.Request.Context(), exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoContextUser
+		}
+
+		return nil, fmt.Errorf("error loading user relations: %w", err)
+	}
+
+	setCtxUser(c, user)
+
+	return user, nil
+}
@@ -0,0 +1,26 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReloadCtxUserWithoutUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1alpha1/user", nil)
+
+	_, err := reloadCtxUser(c, nil, "GroupMemberships")
+	assert.ErrorIs(t, err, ErrNoContextUser)
+
+	sendServiceError(c, http.StatusInternalServerError, err)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), string(ErrCodeUnauthorized))
+}
@@ -0,0 +1,75 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+)
+
+// maxPurgeRecords is the maximum number of records purged by a request
+const maxPurgeRecords = 100
+
+// DeletedRecord is an alias export for the same struct in dbtools
+type DeletedRecord = dbtools.DeletedRecord
+
+// PurgeReq is a request to permanently remove soft deleted records
+type PurgeReq struct {
+	IDs []string `json:"ids"`
+}
+
+// PurgeResponse lists the records that were permanently removed
+type PurgeResponse struct {
+	Purged []*DeletedRecord `json:"purged"`
+}
+
+// listDeletedRecords lists the soft deleted users, groups, applications or
+// extensions, depending on the kind, with who deleted them
+func (r *Router) listDeletedRecords(c *gin.Context) {
+	kind := c.Param("kind")
+	if !dbtools.IsDeletedKind(kind) {
+		sendError(c, http.StatusNotFound, "unknown kind of deleted records: "+kind)
+		return
+	}
+
+	records, err := r.svc().ListDeletedRecords(c.Request.Context(), kind)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, records)
+}
+
+// purgeDeletedRecords permanently removes soft deleted records of a kind that
+// were deleted for longer than the purge retention
+func (r *Router) purgeDeletedRecords(c *gin.Context) {
+	kind := c.Param("kind")
+	if !dbtools.IsDeletedKind(kind) {
+		sendError(c, http.StatusNotFound, "unknown kind of deleted records: "+kind)
+		return
+	}
+
+	req := PurgeReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	if len(req.IDs) == 0 || len(req.IDs) > maxPurgeRecords {
+		sendValidationError(c, []ErrorDetail{{
+			Field:   "ids",
+			Message: "between 1 and " + strconv.Itoa(maxPurgeRecords) + " ids are required",
+		}})
+
+		return
+	}
+
+	purged, auditEvents, err := r.svc().PurgeDeletedRecords(c.Request.Context(), ctxActor(c), kind, req.IDs, r.PurgeRetention)
+	if !handleServiceResult(c, auditEvents, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, PurgeResponse{Purged: purged})
+}
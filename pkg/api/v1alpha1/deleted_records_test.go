@@ -0,0 +1,77 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeDeletedRecordsValidation(t *testing.T) {
+	r := &Router{}
+
+	engine := gin.New()
+	engine.GET("/deleted/:kind", r.listDeletedRecords)
+	engine.POST("/deleted/:kind/purge", r.purgeDeletedRecords)
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		wantCode int
+		wantErr  ErrorCode
+	}{
+		{
+			name:     "unknown kind listed",
+			method:   http.MethodGet,
+			path:     "/deleted/organizations",
+			wantCode: http.StatusNotFound,
+			wantErr:  ErrCodeNotFound,
+		},
+		{
+			name:     "unknown kind purged",
+			method:   http.MethodPost,
+			path:     "/deleted/organizations/purge",
+			body:     `{"ids": ["id"]}`,
+			wantCode: http.StatusNotFound,
+			wantErr:  ErrCodeNotFound,
+		},
+		{
+			name:     "no ids",
+			method:   http.MethodPost,
+			path:     "/deleted/groups/purge",
+			body:     `{"ids": []}`,
+			wantCode: http.StatusBadRequest,
+			wantErr:  ErrCodeValidationFailed,
+		},
+		{
+			name:     "too many ids",
+			method:   http.MethodPost,
+			path:     "/deleted/users/purge",
+			body:     `{"ids": [` + strings.Repeat(`"id",`, maxPurgeRecords) + `"id"]}`,
+			wantCode: http.StatusBadRequest,
+			wantErr:  ErrCodeValidationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+
+			resp := ErrorResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantErr, resp.Code)
+		})
+	}
+}
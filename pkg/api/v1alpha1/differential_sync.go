@@ -0,0 +1,83 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SyncWatermarkHeader is the response header of the listings requested with
+// updated_since holding the watermark to pass as the next updated_since
+const SyncWatermarkHeader = "X-Sync-Watermark"
+
+// updatedSinceParam returns the updated_since query param, it sends a
+// validation error and returns false when it isn't a RFC 3339 timestamp. The
+// watermark of the listing is set when it's given.
+func updatedSinceParam(c *gin.Context) (*time.Time, bool) {
+	v, ok := c.GetQuery("updated_since")
+	if !ok {
+		return nil, true
+	}
+
+	since, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		sendValidationError(c, []ErrorDetail{{Field: "updated_since", Message: "updated_since must be an RFC 3339 timestamp"}})
+		return nil, false
+	}
+
+	// the watermark is taken before listing the changes, so the ones made
+	// meanwhile are listed again by the next poll rather than missed
+	c.Header(SyncWatermarkHeader, time.Now().UTC().Format(time.RFC3339Nano))
+
+	return &since, true
+}
+
+// membershipChangesSince lists the direct memberships added or updated after
+// since, followed by the tombstones of the ones removed. The notes are only
+// listed for the groups whose notes the caller can read.
+func (r *Router) membershipChangesSince(c *gin.Context, since time.Time, canReadNote func(groupID string) bool) {
+	changes, err := r.svc().MembershipChangesSince(c.Request.Context(), since, r.TombstoneRetention)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := make([]GroupMembership, 0, len(changes.Changed)+len(changes.Removed))
+
+	for _, m := range changes.Changed {
+		membership := GroupMembership{
+			ID:             m.ID,
+			GroupID:        m.GroupID,
+			GroupSlug:      m.R.Group.Slug,
+			UserID:         m.UserID,
+			UserEmail:      m.R.User.Email,
+			ExpiresAt:      m.ExpiresAt,
+			IsAdmin:        m.IsAdmin,
+			AdminExpiresAt: m.AdminExpiresAt,
+			Source:         m.Source,
+			SourceRef:      m.SourceRef,
+			Justification:  m.Justification,
+			Exemption:      membershipExemption(m),
+		}
+
+		if canReadNote(m.GroupID) {
+			membership.Note = m.Note
+		}
+
+		response = append(response, membership)
+	}
+
+	for _, t := range changes.Removed {
+		removedAt := t.RemovedAt
+
+		response = append(response, GroupMembership{
+			ID:        t.RecordID,
+			GroupID:   t.GroupID,
+			UserID:    t.SubjectID,
+			DeletedAt: &removedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,73 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdatedSinceValidation(t *testing.T) {
+	r := &Router{}
+
+	engine := gin.New()
+	engine.GET("/users", r.listUsers)
+	engine.GET("/groups", r.listGroups)
+	engine.GET("/groups/memberships", r.getGroupMembershipsAll)
+
+	paths := []string{
+		"/users?updated_since=yesterday",
+		"/groups?updated_since=2024-03-01",
+		"/groups/memberships?updated_since=",
+	}
+
+	for _, p := range paths {
+		t.Run(p, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, p, nil))
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Empty(t, w.Header().Get(SyncWatermarkHeader))
+
+			resp := ErrorResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			assert.Equal(t, ErrCodeValidationFailed, resp.Code)
+			require.Len(t, resp.Details, 1)
+			assert.Equal(t, "updated_since", resp.Details[0].Field)
+		})
+	}
+}
+
+func TestUpdatedSinceParam(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/?updated_since=2024-03-01T12:30:00.5%2B02:00", nil)
+
+	before := time.Now()
+
+	since, ok := updatedSinceParam(c)
+	require.True(t, ok)
+	require.NotNil(t, since)
+	assert.Equal(t, "2024-03-01T10:30:00.5Z", since.UTC().Format("2006-01-02T15:04:05.999Z07:00"))
+
+	watermark, err := time.Parse(time.RFC3339Nano, w.Header().Get(SyncWatermarkHeader))
+	require.NoError(t, err)
+	assert.False(t, watermark.Before(before.Truncate(time.Microsecond)))
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/?deleted", nil)
+
+	since, ok = updatedSinceParam(c)
+	assert.True(t, ok)
+	assert.Nil(t, since)
+	assert.Empty(t, w.Header().Get(SyncWatermarkHeader))
+}
@@ -0,0 +1,230 @@
+package v1alpha1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/durationpolicy"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const (
+	// durationPolicyGroupKind is the kind param of the membership duration policy of a group
+	durationPolicyGroupKind = "groups"
+	// durationPolicyOrganizationKind is the kind param of the membership duration policy of an organization
+	durationPolicyOrganizationKind = "organizations"
+)
+
+// DurationPolicyReq is a request to create or update a membership duration policy
+type DurationPolicyReq struct {
+	MaxDays int64  `json:"max_days" binding:"required,min=1,max=3650"`
+	Mode    string `json:"mode" binding:"omitempty,oneof=reject clamp"`
+}
+
+// durationPolicyOwner is the organization or the group of a membership duration policy
+type durationPolicyOwner struct {
+	OrganizationID null.String
+	GroupID        null.String
+}
+
+// durationPolicyScope resolves the kind and id params, an organization or a
+// group id or slug, to the owner of the membership duration policy
+func (r *Router) durationPolicyScope(c *gin.Context) (durationPolicyOwner, bool) {
+	id := c.Param("id")
+
+	switch c.Param("kind") {
+	case durationPolicyGroupKind:
+		group, err := r.svc().FindGroup(c.Request.Context(), id, false)
+		if err != nil {
+			sendServiceError(c, http.StatusInternalServerError, err)
+			return durationPolicyOwner{}, false
+		}
+
+		return durationPolicyOwner{GroupID: null.StringFrom(group.ID)}, true
+	case durationPolicyOrganizationKind:
+		q := qm.Where("id = ?", id)
+		if _, err := uuid.Parse(id); err != nil {
+			q = qm.Where("slug = ?", id)
+		}
+
+		org, err := models.Organizations(q).One(c.Request.Context(), r.DB)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				sendErrorWithCode(c, http.StatusNotFound, ErrCodeOrganizationNotFound, "organization not found: "+err.Error())
+				return durationPolicyOwner{}, false
+			}
+
+			sendError(c, http.StatusInternalServerError, "error getting organization: "+err.Error())
+
+			return durationPolicyOwner{}, false
+		}
+
+		return durationPolicyOwner{OrganizationID: null.StringFrom(org.ID)}, true
+	default:
+		sendErrorWithCode(c, http.StatusNotFound, ErrCodeNotFound, "unknown membership duration policy kind: "+c.Param("kind"))
+		return durationPolicyOwner{}, false
+	}
+}
+
+// findDurationPolicy returns the membership duration policy of the group or the organization
+func findDurationPolicy(ctx context.Context, exec boil.ContextExecutor, owner durationPolicyOwner) (*models.MembershipDurationPolicy, error) {
+	q := qm.Where("organization_id = ?", owner.OrganizationID.String)
+	if owner.GroupID.Valid {
+		q = qm.Where("group_id = ?", owner.GroupID.String)
+	}
+
+	return models.MembershipDurationPolicies(q).One(ctx, exec)
+}
+
+// listDurationPolicies lists the organization and group membership duration policies
+func (r *Router) listDurationPolicies(c *gin.Context) {
+	policies, err := models.MembershipDurationPolicies(qm.OrderBy("organization_id, group_id")).All(c.Request.Context(), r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error listing membership duration policies: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, policies)
+}
+
+// getDurationPolicy gets the membership duration policy of an organization or a group
+func (r *Router) getDurationPolicy(c *gin.Context) {
+	owner, ok := r.durationPolicyScope(c)
+	if !ok {
+		return
+	}
+
+	policy, err := findDurationPolicy(c.Request.Context(), r.DB, owner)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeDurationPolicyNotFound, "membership duration policy not found: "+err.Error())
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting membership duration policy: "+err.Error())
+
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// updateDurationPolicy creates or replaces the membership duration policy of an organization or a group
+func (r *Router) updateDurationPolicy(c *gin.Context) {
+	owner, ok := r.durationPolicyScope(c)
+	if !ok {
+		return
+	}
+
+	req := DurationPolicyReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	if req.Mode == "" {
+		req.Mode = durationpolicy.ModeReject
+	}
+
+	if err := (durationpolicy.Policy{MaxDays: req.MaxDays, Mode: req.Mode}).Validate(); err != nil {
+		sendErrorFromErr(c, http.StatusBadRequest, err)
+		return
+	}
+
+	policy, err := findDurationPolicy(c.Request.Context(), r.DB, owner)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		sendError(c, http.StatusInternalServerError, "error getting membership duration policy: "+err.Error())
+		return
+	}
+
+	original := models.MembershipDurationPolicy{}
+	exists := policy != nil
+
+	if exists {
+		original = *policy
+	} else {
+		policy = &models.MembershipDurationPolicy{
+			OrganizationID: owner.OrganizationID,
+			GroupID:        owner.GroupID,
+		}
+	}
+
+	policy.MaxDays = req.MaxDays
+	policy.Mode = req.Mode
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if exists {
+			_, err = policy.Update(c.Request.Context(), tx, boil.Infer())
+		} else {
+			err = policy.Insert(c.Request.Context(), tx, boil.Infer())
+		}
+
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error updating membership duration policy: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditDurationPolicyUpdated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, policy)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error updating membership duration policy (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating membership duration policy (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, policy)
+}
+
+// deleteDurationPolicy deletes the membership duration policy of an organization or a group
+func (r *Router) deleteDurationPolicy(c *gin.Context) {
+	owner, ok := r.durationPolicyScope(c)
+	if !ok {
+		return
+	}
+
+	policy, err := findDurationPolicy(c.Request.Context(), r.DB, owner)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeDurationPolicyNotFound, "membership duration policy not found: "+err.Error())
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting membership duration policy: "+err.Error())
+
+		return
+	}
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := policy.Delete(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting membership duration policy: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditDurationPolicyDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), policy)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting membership duration policy (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting membership duration policy (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, policy)
+}
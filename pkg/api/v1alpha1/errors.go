@@ -2,8 +2,22 @@ package v1alpha1
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/auditevent/ginaudit"
+
+	"github.com/metal-toolbox/governor-api/internal/backup"
+	"github.com/metal-toolbox/governor-api/internal/durationpolicy"
+	"github.com/metal-toolbox/governor-api/internal/emailverify"
+	"github.com/metal-toolbox/governor-api/internal/erdstate"
+	"github.com/metal-toolbox/governor-api/internal/eventbus"
+	"github.com/metal-toolbox/governor-api/internal/eventrules"
+	"github.com/metal-toolbox/governor-api/internal/jobs"
+	"github.com/metal-toolbox/governor-api/internal/netpolicy"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
 )
 
 var (
@@ -24,23 +38,422 @@ var (
 	ErrNoUserProvided = errors.New("neither user-id nor context user were provided")
 	// ErrExtensionResourceNotFound is returned when an extension resource is not found
 	ErrExtensionResourceNotFound = errors.New("extension resource does not exist")
+	// ErrExtensionResourceRevisionConflict is returned when an extension resource was changed since the revision of an update
+	ErrExtensionResourceRevisionConflict = errors.New("extension resource was changed since the revision of the update")
+	// ErrExtensionResourceRevisionRequired is returned when an update of an extension resource doesn't have the revision it is based on
+	ErrExtensionResourceRevisionRequired = errors.New("extension resource revision is required")
+	// ErrExtensionResourcePatchFailed is returned when a patch doesn't apply to an extension resource
+	ErrExtensionResourcePatchFailed = errors.New("extension resource patch can't be applied")
+	// ErrExtensionResourceVersionNotFound is returned when a version of an extension resource is not found
+	ErrExtensionResourceVersionNotFound = errors.New("extension resource version does not exist")
 	// ErrUserNotFound is returned when a user is not found
 	ErrUserNotFound = errors.New("user does not exist")
+	// ErrEmailChangeNotPending is returned when confirming an email change that
+	// was already confirmed or superseded by a newer one
+	ErrEmailChangeNotPending = errors.New("email change is not pending")
+	// ErrEmailVerificationDisabled is returned when confirming an email change
+	// while email verification isn't enabled
+	ErrEmailVerificationDisabled = errors.New("email verification is not enabled")
+	// ErrInvalidMemberLimits is returned when the member soft limit of a group is above its hard limit
+	ErrInvalidMemberLimits = errors.New("member soft limit cannot be above the member hard limit")
+	// ErrNoContextUser is returned when the request has no authenticated user,
+	// or the user was deleted during the request
+	ErrNoContextUser = errors.New("no user in context")
+)
+
+// ErrorCode is a stable, machine-readable error code returned in error responses.
+// Clients should branch on the code rather than on the error message.
+type ErrorCode string
+
+const (
+	// ErrCodeBadRequest is returned when the request is invalid
+	ErrCodeBadRequest ErrorCode = "bad_request"
+	// ErrCodeValidationFailed is returned when the request payload fails validation, the
+	// invalid fields are listed in the error details
+	ErrCodeValidationFailed ErrorCode = "validation_failed"
+	// ErrCodeUnauthorized is returned when the request is not authenticated
+	ErrCodeUnauthorized ErrorCode = "unauthorized"
+	// ErrCodeForbidden is returned when the caller is not allowed to perform the request
+	ErrCodeForbidden ErrorCode = "forbidden"
+	// ErrCodeStepUpRequired is returned when a high-risk request needs a more recent or stronger
+	// authentication, the WWW-Authenticate header describes what's required
+	ErrCodeStepUpRequired ErrorCode = "step_up_required"
+	// ErrCodeNotFound is returned when the requested resource doesn't exist
+	ErrCodeNotFound ErrorCode = "not_found"
+	// ErrCodeConflict is returned when the request conflicts with the current state of a resource
+	ErrCodeConflict ErrorCode = "conflict"
+	// ErrCodeInternal is returned on unexpected server errors
+	ErrCodeInternal ErrorCode = "internal_error"
+	// ErrCodeNotImplemented is returned when the server doesn't support the request
+	ErrCodeNotImplemented ErrorCode = "not_implemented"
+	// ErrCodeUnknown is returned when the error can't be classified
+	ErrCodeUnknown ErrorCode = "unknown"
+
+	// ErrCodeUserNotFound is returned when a user is not found
+	ErrCodeUserNotFound ErrorCode = "user_not_found"
+	// ErrCodeUserAlreadyExists is returned when creating a user that already exists
+	ErrCodeUserAlreadyExists ErrorCode = "user_already_exists"
+	// ErrCodeUserAlreadyMember is returned when the user is already a member of the group
+	ErrCodeUserAlreadyMember ErrorCode = "user_already_member"
+	// ErrCodeUserAlreadyAdmin is returned when the user is already an admin of the group
+	ErrCodeUserAlreadyAdmin ErrorCode = "user_already_admin"
+	// ErrCodeMembershipRequestExists is returned when the user already requested access to the group
+	ErrCodeMembershipRequestExists ErrorCode = "membership_request_exists"
+	// ErrCodeGroupAlreadyMember is returned when the group is already a member of the parent group
+	ErrCodeGroupAlreadyMember ErrorCode = "group_already_member"
+	// ErrCodeInvalidGroupName is returned when the group name or description is invalid
+	ErrCodeInvalidGroupName ErrorCode = "invalid_group_name"
+	// ErrCodeApplicationAlreadyLinked is returned when the application is already linked to the group
+	ErrCodeApplicationAlreadyLinked ErrorCode = "application_already_linked"
+	// ErrCodeOrganizationAlreadyLinked is returned when the organization is already linked to the group
+	ErrCodeOrganizationAlreadyLinked ErrorCode = "organization_already_linked"
+	// ErrCodeGroupNotFound is returned when a group is not found
+	ErrCodeGroupNotFound ErrorCode = "group_not_found"
+	// ErrCodeMembershipRequestNotFound is returned when a group membership request is not found
+	ErrCodeMembershipRequestNotFound ErrorCode = "membership_request_not_found"
+	// ErrCodeOrganizationNotFound is returned when an organization is not found
+	ErrCodeOrganizationNotFound ErrorCode = "organization_not_found"
+	// ErrCodeApplicationNotFound is returned when an application is not found
+	ErrCodeApplicationNotFound ErrorCode = "application_not_found"
+	// ErrCodeApplicationTypeNotFound is returned when an application type is not found
+	ErrCodeApplicationTypeNotFound ErrorCode = "application_type_not_found"
+	// ErrCodeApplicationRequestNotFound is returned when a group application request is not found
+	ErrCodeApplicationRequestNotFound ErrorCode = "application_request_not_found"
+	// ErrCodeNotificationTypeNotFound is returned when a notification type is not found
+	ErrCodeNotificationTypeNotFound ErrorCode = "notification_type_not_found"
+	// ErrCodeNotificationTargetNotFound is returned when a notification target is not found
+	ErrCodeNotificationTargetNotFound ErrorCode = "notification_target_not_found"
+	// ErrCodeExtensionNotFound is returned when an extension is not found
+	ErrCodeExtensionNotFound ErrorCode = "extension_not_found"
+	// ErrCodeExtensionCredentialNotFound is returned when the client credentials of an extension are not found
+	ErrCodeExtensionCredentialNotFound ErrorCode = "extension_credential_not_found"
+	// ErrCodeExtensionTokenForbidden is returned when an extension token is used
+	// outside of the routes of its extension's resources and events
+	ErrCodeExtensionTokenForbidden ErrorCode = "extension_token_forbidden"
+	// ErrCodeSessionTokenForbidden is returned when a session token is exchanged
+	// for another session token
+	ErrCodeSessionTokenForbidden ErrorCode = "session_token_forbidden"
+	// ErrCodeERDNotFound is returned when an extension resource definition is not found
+	ErrCodeERDNotFound ErrorCode = "erd_not_found"
+	// ErrCodeERDInvalidTransition is returned when an extension resource definition
+	// can't move from its lifecycle state to the requested one
+	ErrCodeERDInvalidTransition ErrorCode = "erd_invalid_transition"
+	// ErrCodeERDOperationNotAllowed is returned when the lifecycle state of an
+	// extension resource definition doesn't allow the operation on its resources
+	ErrCodeERDOperationNotAllowed ErrorCode = "erd_operation_not_allowed"
+	// ErrCodeExtensionResourceNotFound is returned when an extension resource is not found
+	ErrCodeExtensionResourceNotFound ErrorCode = "extension_resource_not_found"
+	// ErrCodeExtensionResourceRevisionConflict is returned when an extension
+	// resource was changed since the revision an update is based on
+	ErrCodeExtensionResourceRevisionConflict ErrorCode = "extension_resource_revision_conflict"
+	// ErrCodeExtensionResourceRevisionRequired is returned when an update of an
+	// extension resource doesn't have the If-Match revision it is based on
+	ErrCodeExtensionResourceRevisionRequired ErrorCode = "extension_resource_revision_required"
+	// ErrCodeExtensionResourcePatchFailed is returned when a patch refers to a
+	// location missing from an extension resource, or one of its tests fails
+	ErrCodeExtensionResourcePatchFailed ErrorCode = "extension_resource_patch_failed"
+	// ErrCodeExtensionResourceVersionNotFound is returned when a version of an
+	// extension resource is not found
+	ErrCodeExtensionResourceVersionNotFound ErrorCode = "extension_resource_version_not_found"
+	// ErrCodeFeatureFlagNotFound is returned when a feature flag is unknown
+	ErrCodeFeatureFlagNotFound ErrorCode = "feature_flag_not_found"
+	// ErrCodeMaintenance is returned for the mutating requests while the api is
+	// in read-only maintenance mode
+	ErrCodeMaintenance ErrorCode = "maintenance"
+	// ErrCodeNamingPolicyNotFound is returned when a naming policy is not found
+	ErrCodeNamingPolicyNotFound ErrorCode = "naming_policy_not_found"
+	// ErrCodeNamingPolicyViolation is returned when a group name breaks a naming policy, the
+	// details list every rule that was broken
+	ErrCodeNamingPolicyViolation ErrorCode = "naming_policy_violation"
+	// ErrCodeDurationPolicyNotFound is returned when a group or organization
+	// has no membership duration policy
+	ErrCodeDurationPolicyNotFound ErrorCode = "duration_policy_not_found"
+	// ErrCodeDurationPolicyViolation is returned when a membership expires past
+	// the maximum duration policy of the group
+	ErrCodeDurationPolicyViolation ErrorCode = "duration_policy_violation"
+	// ErrCodeGroupFrozen is returned when changing the memberships of a frozen group
+	ErrCodeGroupFrozen ErrorCode = "group_frozen"
+	// ErrCodeGroupProtected is returned when deleting a protected group or detaching it from a hierarchy
+	ErrCodeGroupProtected ErrorCode = "group_protected"
+	// ErrCodeGroupMemberLimitReached is returned when adding a member to a
+	// group that reached its member hard limit
+	ErrCodeGroupMemberLimitReached ErrorCode = "group_member_limit_reached"
+	// ErrCodeNetworkPolicyNotFound is returned when a subject has no network policy
+	ErrCodeNetworkPolicyNotFound ErrorCode = "network_policy_not_found"
+	// ErrCodeNetworkPolicyDenied is returned when the network policy of the token
+	// subject doesn't allow the client address
+	ErrCodeNetworkPolicyDenied ErrorCode = "network_policy_denied"
+	// ErrCodeJobNotFound is returned when a job is not found
+	ErrCodeJobNotFound ErrorCode = "job_not_found"
+	// ErrCodeEventSubjectRuleNotFound is returned when an event subject or
+	// extension has no event subject rule
+	ErrCodeEventSubjectRuleNotFound ErrorCode = "event_subject_rule_not_found"
+	// ErrCodeUserFieldSubscriptionNotFound is returned when a user field subscription is not found
+	ErrCodeUserFieldSubscriptionNotFound ErrorCode = "user_field_subscription_not_found"
+	// ErrCodeTenantRequired is returned in tenancy mode when the token has no
+	// organization claim, or the claim isn't an organization
+	ErrCodeTenantRequired ErrorCode = "tenant_required"
+	// ErrCodeEmailVerificationInvalid is returned when an email verification
+	// token is invalid or expired, or its email change isn't pending anymore
+	ErrCodeEmailVerificationInvalid ErrorCode = "email_verification_invalid"
+	// ErrCodeRateLimited is returned when an action is repeated too often
+	ErrCodeRateLimited ErrorCode = "rate_limited"
+	// ErrCodeSyncWatermarkExpired is returned when the tombstones since an
+	// updated_since watermark may already be purged, a full sync is required
+	ErrCodeSyncWatermarkExpired ErrorCode = "sync_watermark_expired"
 )
 
+// errorCodes maps the package error values to their error codes
+var errorCodes = []struct {
+	err  error
+	code ErrorCode
+}{
+	{ErrInvalidChar, ErrCodeInvalidGroupName},
+	{ErrEmptyInput, ErrCodeInvalidGroupName},
+	{ErrUnknownRequestKind, ErrCodeBadRequest},
+	{ErrGetDeleteResourcedWithSlug, ErrCodeBadRequest},
+	{ErrExtensionNotFound, ErrCodeExtensionNotFound},
+	{ErrERDNotFound, ErrCodeERDNotFound},
+	{erdstate.ErrInvalidState, ErrCodeValidationFailed},
+	{erdstate.ErrInvalidTransition, ErrCodeERDInvalidTransition},
+	{erdstate.ErrOperationNotAllowed, ErrCodeERDOperationNotAllowed},
+	{ErrNoUserProvided, ErrCodeBadRequest},
+	{ErrExtensionResourceNotFound, ErrCodeExtensionResourceNotFound},
+	{ErrExtensionResourceVersionNotFound, ErrCodeExtensionResourceVersionNotFound},
+	{ErrExtensionResourceRevisionConflict, ErrCodeExtensionResourceRevisionConflict},
+	{ErrExtensionResourceRevisionRequired, ErrCodeExtensionResourceRevisionRequired},
+	{ErrExtensionResourcePatchFailed, ErrCodeExtensionResourcePatchFailed},
+	{ErrUserNotFound, ErrCodeUserNotFound},
+	{ErrNoContextUser, ErrCodeUnauthorized},
+	{netpolicy.ErrInvalidCIDR, ErrCodeBadRequest},
+	{jobs.ErrUnknownKind, ErrCodeBadRequest},
+	{eventrules.ErrInvalidSubject, ErrCodeBadRequest},
+	{eventbus.ErrUnknownUserField, ErrCodeBadRequest},
+	{tenancy.ErrTenantRequired, ErrCodeTenantRequired},
+	{tenancy.ErrUnknownTenant, ErrCodeTenantRequired},
+	{tenancy.ErrCrossTenant, ErrCodeForbidden},
+	{service.ErrGroupNotFound, ErrCodeGroupNotFound},
+	{service.ErrUserNotFound, ErrCodeUserNotFound},
+	{service.ErrUserAlreadyMember, ErrCodeUserAlreadyMember},
+	{service.ErrUserAlreadyAdmin, ErrCodeUserAlreadyAdmin},
+	{service.ErrMembershipNotFound, ErrCodeNotFound},
+	{service.ErrRequestNotFound, ErrCodeMembershipRequestNotFound},
+	{service.ErrGetDeletedBySlug, ErrCodeBadRequest},
+	{service.ErrMergeSameUser, ErrCodeBadRequest},
+	{service.ErrMembershipDurationExceeded, ErrCodeDurationPolicyViolation},
+	{service.ErrGroupFrozen, ErrCodeGroupFrozen},
+	{service.ErrGroupMemberLimitReached, ErrCodeGroupMemberLimitReached},
+	{service.ErrGroupAlreadyFrozen, ErrCodeConflict},
+	{service.ErrGroupNotFrozen, ErrCodeConflict},
+	{service.ErrGroupProtected, ErrCodeGroupProtected},
+	{service.ErrGroupAlreadyProtected, ErrCodeConflict},
+	{service.ErrGroupNotProtected, ErrCodeConflict},
+	{service.ErrGroupAlreadyBreakGlass, ErrCodeConflict},
+	{service.ErrGroupNotBreakGlass, ErrCodeConflict},
+	{service.ErrNotBreakGlassResponder, ErrCodeForbidden},
+	{service.ErrInvalidBreakGlassAccess, ErrCodeValidationFailed},
+	{service.ErrInvalidMembershipExemption, ErrCodeValidationFailed},
+	{service.ErrMembershipNotExempt, ErrCodeConflict},
+	{service.ErrJustificationRequired, ErrCodeValidationFailed},
+	{service.ErrInvalidScheduledChange, ErrCodeValidationFailed},
+	{service.ErrScheduledChangeNotFound, ErrCodeNotFound},
+	{service.ErrScheduledChangeNotPending, ErrCodeConflict},
+	{durationpolicy.ErrInvalidMode, ErrCodeValidationFailed},
+	{durationpolicy.ErrInvalidMaxDays, ErrCodeValidationFailed},
+	{emailverify.ErrInvalidToken, ErrCodeEmailVerificationInvalid},
+	{emailverify.ErrExpiredToken, ErrCodeEmailVerificationInvalid},
+	{ErrEmailChangeNotPending, ErrCodeEmailVerificationInvalid},
+	{service.ErrNotificationTypeNotFound, ErrCodeNotificationTypeNotFound},
+	{service.ErrNotificationBroadcastThrottled, ErrCodeRateLimited},
+	{service.ErrAdminPromotionRequestThrottled, ErrCodeRateLimited},
+	{service.ErrRequestExists, ErrCodeMembershipRequestExists},
+	{service.ErrAuditEventNotFound, ErrCodeNotFound},
+	{service.ErrEventConsumerNotFound, ErrCodeNotFound},
+	{service.ErrDeletedRecordNotFound, ErrCodeNotFound},
+	{service.ErrPurgeRetention, ErrCodeConflict},
+	{service.ErrSyncWatermarkExpired, ErrCodeSyncWatermarkExpired},
+	{backup.ErrNotFound, ErrCodeNotFound},
+	{backup.ErrInvalidName, ErrCodeNotFound},
+	{backup.ErrUnsupportedFormat, ErrCodeBadRequest},
+	{backup.ErrSchemaVersionMismatch, ErrCodeConflict},
+	{backup.ErrTargetNotEmpty, ErrCodeConflict},
+}
+
+// serviceErrorStatuses maps the service layer error values to http status codes
+var serviceErrorStatuses = []struct {
+	err    error
+	status int
+}{
+	{ErrNoContextUser, http.StatusUnauthorized},
+	{service.ErrGroupNotFound, http.StatusNotFound},
+	{service.ErrUserNotFound, http.StatusNotFound},
+	{service.ErrMembershipNotFound, http.StatusNotFound},
+	{service.ErrRequestNotFound, http.StatusNotFound},
+	{service.ErrUserAlreadyMember, http.StatusConflict},
+	{service.ErrUserAlreadyAdmin, http.StatusConflict},
+	{service.ErrGetDeletedBySlug, http.StatusBadRequest},
+	{service.ErrRequestGroupMismatch, http.StatusBadRequest},
+	{service.ErrRequestingUserNotFound, http.StatusBadRequest},
+	{service.ErrOwnRequest, http.StatusBadRequest},
+	{service.ErrInvalidRequestAction, http.StatusBadRequest},
+	{service.ErrMergeSameUser, http.StatusBadRequest},
+	{service.ErrUserAlreadyAnonymized, http.StatusConflict},
+	{service.ErrUserActive, http.StatusConflict},
+	{service.ErrMembershipDurationExceeded, http.StatusBadRequest},
+	{service.ErrGroupFrozen, http.StatusForbidden},
+	{service.ErrGroupMemberLimitReached, http.StatusConflict},
+	{service.ErrGroupAlreadyFrozen, http.StatusConflict},
+	{service.ErrGroupNotFrozen, http.StatusConflict},
+	{service.ErrGroupProtected, http.StatusForbidden},
+	{service.ErrGroupAlreadyProtected, http.StatusConflict},
+	{service.ErrGroupNotProtected, http.StatusConflict},
+	{service.ErrGroupAlreadyBreakGlass, http.StatusConflict},
+	{service.ErrGroupNotBreakGlass, http.StatusConflict},
+	{service.ErrNotBreakGlassResponder, http.StatusForbidden},
+	{service.ErrInvalidBreakGlassAccess, http.StatusBadRequest},
+	{service.ErrInvalidMembershipExemption, http.StatusBadRequest},
+	{service.ErrMembershipNotExempt, http.StatusConflict},
+	{service.ErrJustificationRequired, http.StatusBadRequest},
+	{service.ErrInvalidScheduledChange, http.StatusBadRequest},
+	{service.ErrScheduledChangeNotFound, http.StatusNotFound},
+	{service.ErrScheduledChangeNotPending, http.StatusConflict},
+	{service.ErrNotificationTypeNotFound, http.StatusNotFound},
+	{service.ErrNotificationNotDeliverable, http.StatusConflict},
+	{service.ErrNotificationDispatchNotFound, http.StatusNotFound},
+	{service.ErrNotificationDispatchClosed, http.StatusConflict},
+	{service.ErrNotificationTargetNotAttempted, http.StatusBadRequest},
+	{service.ErrNotificationBroadcastThrottled, http.StatusTooManyRequests},
+	{service.ErrAdminPromotionRequestThrottled, http.StatusTooManyRequests},
+	{service.ErrRequestExists, http.StatusConflict},
+	{service.ErrAuditEventNotFound, http.StatusNotFound},
+	{service.ErrEventConsumerNotFound, http.StatusNotFound},
+	{service.ErrDeletedRecordNotFound, http.StatusNotFound},
+	{service.ErrPurgeRetention, http.StatusConflict},
+	{service.ErrSyncWatermarkExpired, http.StatusGone},
+	{backup.ErrNotFound, http.StatusNotFound},
+	{backup.ErrInvalidName, http.StatusNotFound},
+	{backup.ErrUnsupportedFormat, http.StatusBadRequest},
+	{backup.ErrSchemaVersionMismatch, http.StatusConflict},
+	{backup.ErrTargetNotEmpty, http.StatusConflict},
+}
+
+// ErrorDetail describes a single problem with a request, for example an invalid field
+type ErrorDetail struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// ErrorResponse is the body of every error returned by the api
+type ErrorResponse struct {
+	Code           ErrorCode     `json:"code"`
+	Message        string        `json:"message"`
+	Details        []ErrorDetail `json:"details,omitempty"`
+	RequestID      string        `json:"request_id,omitempty"`
+	DisplayMessage string        `json:"displayMessage,omitempty"`
+
+	// Error duplicates Message for clients that predate the structured error response
+	Error string `json:"error,omitempty"`
+}
+
+// errorCodeForStatus returns the generic error code for an http status code
+func errorCodeForStatus(status int) ErrorCode {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrCodeBadRequest
+	case http.StatusUnauthorized:
+		return ErrCodeUnauthorized
+	case http.StatusForbidden:
+		return ErrCodeForbidden
+	case http.StatusNotFound:
+		return ErrCodeNotFound
+	case http.StatusConflict:
+		return ErrCodeConflict
+	case http.StatusNotImplemented:
+		return ErrCodeNotImplemented
+	}
+
+	if status >= http.StatusInternalServerError {
+		return ErrCodeInternal
+	}
+
+	return ErrCodeUnknown
+}
+
+// errorCodeFor returns the error code registered for err, or the generic
+// code for the http status if err isn't a known error
+func errorCodeFor(err error, status int) ErrorCode {
+	for _, ec := range errorCodes {
+		if errors.Is(err, ec.err) {
+			return ec.code
+		}
+	}
+
+	return errorCodeForStatus(status)
+}
+
+// requestID returns the id used to correlate an error response with the audit log
+func requestID(c *gin.Context) string {
+	if id := c.GetString(ginaudit.AuditIDContextKey); id != "" {
+		return id
+	}
+
+	return events.ExtractCorrelationID(c.Request.Context())
+}
+
+func abortWithError(c *gin.Context, status int, resp ErrorResponse) {
+	resp.Error = resp.Message
+	resp.RequestID = requestID(c)
+
+	c.AbortWithStatusJSON(status, resp)
+}
+
 func sendError(c *gin.Context, code int, msg string) {
-	payload := struct {
-		Error string `json:"error,omitempty"`
-	}{msg}
+	abortWithError(c, code, ErrorResponse{
+		Code:    errorCodeForStatus(code),
+		Message: msg,
+	})
+}
+
+func sendErrorWithCode(c *gin.Context, code int, errCode ErrorCode, msg string) {
+	abortWithError(c, code, ErrorResponse{
+		Code:    errCode,
+		Message: msg,
+	})
+}
 
-	c.AbortWithStatusJSON(code, payload)
+func sendErrorFromErr(c *gin.Context, code int, err error) {
+	abortWithError(c, code, ErrorResponse{
+		Code:    errorCodeFor(err, code),
+		Message: err.Error(),
+	})
 }
 
-func sendErrorWithDisplayMessage(c *gin.Context, code int, errorMessage, displayMessage string) {
-	payload := struct {
-		Error          string `json:"error,omitempty"`
-		DisplayMessage string `json:"displayMessage,omitempty"`
-	}{errorMessage, displayMessage}
+// sendServiceError sends the error response for an error returned by the
+// service layer, known errors get their own status code and any other error
+// is sent with the given status code
+func sendServiceError(c *gin.Context, code int, err error) {
+	for _, es := range serviceErrorStatuses {
+		if errors.Is(err, es.err) {
+			code = es.status
+			break
+		}
+	}
+
+	sendErrorFromErr(c, code, err)
+}
+
+func sendErrorWithDetails(c *gin.Context, code int, errCode ErrorCode, msg string, details []ErrorDetail) {
+	abortWithError(c, code, ErrorResponse{
+		Code:    errCode,
+		Message: msg,
+		Details: details,
+	})
+}
 
-	c.AbortWithStatusJSON(code, payload)
+func sendErrorWithDisplayMessage(c *gin.Context, code int, err error, displayMessage string) {
+	abortWithError(c, code, ErrorResponse{
+		Code:           errorCodeFor(err, code),
+		Message:        err.Error(),
+		DisplayMessage: displayMessage,
+	})
 }
@@ -0,0 +1,93 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/auditevent/ginaudit"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metal-toolbox/governor-api/internal/service"
+)
+
+func TestErrorCodeFor(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		want   ErrorCode
+	}{
+		{"registered error", ErrERDNotFound, http.StatusNotFound, ErrCodeERDNotFound},
+		{"wrapped registered error", fmt.Errorf("lookup failed: %w", ErrUserNotFound), http.StatusNotFound, ErrCodeUserNotFound},
+		{"unknown not found", assert.AnError, http.StatusNotFound, ErrCodeNotFound},
+		{"unknown server error", assert.AnError, http.StatusServiceUnavailable, ErrCodeInternal},
+		{"unknown status", assert.AnError, http.StatusTeapot, ErrCodeUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errorCodeFor(tt.err, tt.status))
+		})
+	}
+}
+
+func TestSendErrorWithCode(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	c.Set(ginaudit.AuditIDContextKey, "audit-id")
+
+	sendErrorWithCode(c, http.StatusConflict, ErrCodeUserAlreadyMember, "user already in group")
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.True(t, c.IsAborted())
+
+	resp := ErrorResponse{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, ErrorResponse{
+		Code:      ErrCodeUserAlreadyMember,
+		Message:   "user already in group",
+		RequestID: "audit-id",
+		Error:     "user already in group",
+	}, resp)
+}
+
+func TestSendServiceError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   ErrorCode
+	}{
+		{"group not found", service.ErrGroupNotFound, http.StatusNotFound, ErrCodeGroupNotFound},
+		{"already member", service.ErrUserAlreadyMember, http.StatusConflict, ErrCodeUserAlreadyMember},
+		{"own request", service.ErrOwnRequest, http.StatusBadRequest, ErrCodeBadRequest},
+		{"wrapped invalid action", fmt.Errorf("%w: promote", service.ErrInvalidRequestAction), http.StatusBadRequest, ErrCodeBadRequest},
+		{"unknown error", assert.AnError, http.StatusBadRequest, ErrCodeBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+			sendServiceError(c, http.StatusBadRequest, tt.err)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+
+			resp := ErrorResponse{}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantCode, resp.Code)
+			assert.Equal(t, tt.err.Error(), resp.Message)
+		})
+	}
+}
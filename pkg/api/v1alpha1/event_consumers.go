@@ -0,0 +1,90 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/service"
+)
+
+// EventConsumer is a downstream event consumer and how far it is behind the
+// governor changes
+type EventConsumer struct {
+	Name        string      `json:"name"`
+	LastAuditID null.String `json:"last_audit_id"`
+	LastAuditAt null.Time   `json:"last_audit_at"`
+	ReportedAt  null.Time   `json:"reported_at"`
+	// BehindEvents is the number of audit events recorded after the last one
+	// the consumer processed
+	BehindEvents int64 `json:"behind_events"`
+	// LagSeconds is how long after the last audit event the consumer
+	// processed the latest audit event was recorded
+	LagSeconds float64 `json:"lag_seconds"`
+}
+
+// EventConsumerPositionReq reports the last audit event a consumer processed
+type EventConsumerPositionReq struct {
+	AuditID string `json:"audit_id" binding:"required,uuid"`
+}
+
+// reportEventConsumerPosition records the last audit event processed by the
+// consumer, it's created on its first report
+func (r *Router) reportEventConsumerPosition(c *gin.Context) {
+	req := EventConsumerPositionReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	consumer, err := r.svc().ReportEventConsumerPosition(c.Request.Context(), c.Param("name"), req.AuditID)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, EventConsumer{
+		Name:        consumer.Name,
+		LastAuditID: consumer.LastAuditID,
+		LastAuditAt: consumer.LastAuditAt,
+		ReportedAt:  consumer.ReportedAt,
+	})
+}
+
+// listEventConsumers returns the event consumers with their lag
+func (r *Router) listEventConsumers(c *gin.Context) {
+	lags, err := r.svc().EventConsumerLags(c.Request.Context())
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := make([]EventConsumer, len(lags))
+	for i, l := range lags {
+		response[i] = eventConsumer(l)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// deleteEventConsumer stops tracking a decommissioned event consumer
+func (r *Router) deleteEventConsumer(c *gin.Context) {
+	if err := r.svc().DeleteEventConsumer(c.Request.Context(), c.Param("name")); err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func eventConsumer(l service.EventConsumerLag) EventConsumer {
+	return EventConsumer{
+		Name:         l.Consumer.Name,
+		LastAuditID:  l.Consumer.LastAuditID,
+		LastAuditAt:  l.Consumer.LastAuditAt,
+		ReportedAt:   l.Consumer.ReportedAt,
+		BehindEvents: l.Behind,
+		LagSeconds:   l.Lag.Round(time.Millisecond).Seconds(),
+	}
+}
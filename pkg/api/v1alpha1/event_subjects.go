@@ -0,0 +1,261 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/eventrules"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// EventSubjects is the event subject configuration, the stored rules take
+// precedence over the static configuration from the flags
+type EventSubjects struct {
+	MutedByConfig   []string                   `json:"muted_by_config"`
+	ExtensionPrefix string                     `json:"extension_prefix"`
+	Rules           []*models.EventSubjectRule `json:"rules"`
+}
+
+// EventSubjectReq is a request to mute or unmute an event subject
+type EventSubjectReq struct {
+	Muted *bool `json:"muted" binding:"required"`
+}
+
+// ExtensionEventSubjectReq is a request to set the subject prefix of an
+// extension's resource events or mute them
+type ExtensionEventSubjectReq struct {
+	Prefix string `json:"prefix"`
+	Muted  bool   `json:"muted"`
+}
+
+// listEventSubjects lists the event subject rules
+func (r *Router) listEventSubjects(c *gin.Context) {
+	rules, err := models.EventSubjectRules(qm.OrderBy("subject, extension_id")).All(c.Request.Context(), r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error listing event subject rules: "+err.Error())
+		return
+	}
+
+	resp := EventSubjects{
+		MutedByConfig: []string{},
+		Rules:         rules,
+	}
+
+	if r.EventRules != nil {
+		cfg := r.EventRules.Config()
+
+		resp.ExtensionPrefix = cfg.ExtensionPrefix
+
+		if cfg.Muted != nil {
+			resp.MutedByConfig = cfg.Muted
+		}
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// updateEventSubject mutes or unmutes an event subject, overriding the
+// static configuration
+func (r *Router) updateEventSubject(c *gin.Context) {
+	subject := c.Param("subject")
+
+	if err := eventrules.ValidateSubject(subject); err != nil {
+		sendErrorFromErr(c, http.StatusBadRequest, err)
+		return
+	}
+
+	req := EventSubjectReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	rule, err := models.EventSubjectRules(qm.Where("subject = ?", subject)).One(c.Request.Context(), r.DB)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		sendError(c, http.StatusInternalServerError, "error getting event subject rule: "+err.Error())
+		return
+	}
+
+	if rule == nil {
+		rule = &models.EventSubjectRule{Subject: null.StringFrom(subject)}
+	}
+
+	original := *rule
+	rule.Muted = *req.Muted
+
+	r.saveEventSubjectRule(c, &original, rule)
+}
+
+// deleteEventSubject deletes the rule of an event subject, the static
+// configuration applies to it again
+func (r *Router) deleteEventSubject(c *gin.Context) {
+	subject := c.Param("subject")
+
+	rule, err := models.EventSubjectRules(qm.Where("subject = ?", subject)).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeEventSubjectRuleNotFound, "event subject rule not found: "+subject)
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting event subject rule: "+err.Error())
+
+		return
+	}
+
+	r.deleteEventSubjectRule(c, rule)
+}
+
+// updateExtensionEventSubject sets the subject prefix of the resource events
+// of an extension, or mutes them
+func (r *Router) updateExtensionEventSubject(c *gin.Context) {
+	req := ExtensionEventSubjectReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	if req.Prefix != "" {
+		if err := eventrules.ValidateSubject(req.Prefix); err != nil {
+			sendErrorFromErr(c, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	extension, ok := r.findExtensionForEventSubject(c)
+	if !ok {
+		return
+	}
+
+	rule, err := models.EventSubjectRules(qm.Where("extension_id = ?", extension.ID)).One(c.Request.Context(), r.DB)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		sendError(c, http.StatusInternalServerError, "error getting event subject rule: "+err.Error())
+		return
+	}
+
+	if rule == nil {
+		rule = &models.EventSubjectRule{ExtensionID: null.StringFrom(extension.ID)}
+	}
+
+	original := *rule
+	rule.Prefix = req.Prefix
+	rule.Muted = req.Muted
+
+	r.saveEventSubjectRule(c, &original, rule)
+}
+
+// deleteExtensionEventSubject deletes the event subject rule of an extension
+func (r *Router) deleteExtensionEventSubject(c *gin.Context) {
+	extension, ok := r.findExtensionForEventSubject(c)
+	if !ok {
+		return
+	}
+
+	rule, err := models.EventSubjectRules(qm.Where("extension_id = ?", extension.ID)).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeEventSubjectRuleNotFound, "event subject rule not found for extension: "+extension.Slug)
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting event subject rule: "+err.Error())
+
+		return
+	}
+
+	r.deleteEventSubjectRule(c, rule)
+}
+
+// findExtensionForEventSubject gets the extension :eid by id or slug
+func (r *Router) findExtensionForEventSubject(c *gin.Context) (*models.Extension, bool) {
+	id := c.Param("eid")
+
+	q := qm.Where("id = ?", id)
+	if _, err := uuid.Parse(id); err != nil {
+		q = qm.Where("slug = ?", id)
+	}
+
+	extension, err := models.Extensions(q).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeExtensionNotFound, "extension not found: "+err.Error())
+			return nil, false
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting extension: "+err.Error())
+
+		return nil, false
+	}
+
+	return extension, true
+}
+
+// saveEventSubjectRule creates or updates an event subject rule and audits it
+func (r *Router) saveEventSubjectRule(c *gin.Context, original, rule *models.EventSubjectRule) {
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		var err error
+
+		if rule.ID == "" {
+			err = rule.Insert(c.Request.Context(), tx, boil.Infer())
+		} else {
+			_, err = rule.Update(c.Request.Context(), tx, boil.Infer())
+		}
+
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error updating event subject rule: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditEventSubjectRuleUpdated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), original, rule)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error updating event subject rule (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating event subject rule (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	if r.EventRules != nil {
+		r.EventRules.Invalidate()
+	}
+
+	c.JSON(http.StatusAccepted, rule)
+}
+
+// deleteEventSubjectRule deletes an event subject rule and audits it
+func (r *Router) deleteEventSubjectRule(c *gin.Context, rule *models.EventSubjectRule) {
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := rule.Delete(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting event subject rule: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditEventSubjectRuleDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), rule)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting event subject rule (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting event subject rule (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	if r.EventRules != nil {
+		r.EventRules.Invalidate()
+	}
+
+	c.JSON(http.StatusAccepted, rule)
+}
@@ -2,11 +2,13 @@ package v1alpha1
 
 import (
 	"encoding/json"
+	"errors"
 	"math"
 	"net/http"
 	"strconv"
 
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
 
 	"github.com/gin-gonic/gin"
 	"github.com/metal-toolbox/auditevent/ginaudit"
@@ -93,6 +95,42 @@ func updateContextWithAuditEventData[V SerializableEvents](c *gin.Context, event
 	return nil
 }
 
+// handleServiceResult adds the audit events recorded by a service operation
+// to the request context and sends the error response if the operation
+// failed.  Operations that fail after committing their changes, for example
+// when publishing events, still return their audit events.  It returns true
+// when the operation succeeded, or when only publishing its events failed,
+// the response is then sent as 202 Accepted.
+func handleServiceResult[V SerializableEvents](c *gin.Context, event V, err error) bool {
+	recorded := false
+
+	switch e := any(event).(type) {
+	case *models.AuditEvent:
+		recorded = e != nil
+	case []*models.AuditEvent:
+		recorded = len(e) > 0
+	}
+
+	if recorded {
+		if uerr := updateContextWithAuditEventData(c, event); uerr != nil {
+			sendError(c, http.StatusBadRequest, "error updating audit event data: "+uerr.Error())
+			return false
+		}
+	}
+
+	if errors.Is(err, service.ErrEventNotPublished) {
+		acceptChange(c, err)
+		return true
+	}
+
+	if err != nil {
+		sendServiceError(c, http.StatusBadRequest, err)
+		return false
+	}
+
+	return true
+}
+
 // EventsResponse is the response returned from a request for audit events
 type EventsResponse struct {
 	PageSize         int                    `json:"page_size,omitempty"`
@@ -105,67 +143,60 @@ type EventsResponse struct {
 
 // listEvents returns the audit events from the database as JSON
 func (r *Router) listEvents(c *gin.Context) {
-	p := parsePagination(c)
+	r.sendEvents(c)
+}
 
-	// TODO filtering
-	mods := []qm.QueryMod{}
+// listGroupEvents returns the audit events from the database for a group as JSON
+func (r *Router) listGroupEvents(c *gin.Context) {
+	r.sendEvents(c, qm.Where("subject_group_id = ?", c.Param("id")))
+}
 
-	count, err := models.AuditEvents(mods...).Count(c.Request.Context(), r.DB)
-	if err != nil {
-		r.Logger.Error("error fetching audit events", zap.Error(err))
-		sendError(c, http.StatusBadRequest, "error listing audit events: "+err.Error())
+// listUserEvents returns the audit events from the database where the user is
+// the subject as JSON. Users can list their own events, governor admins can
+// list the events of any user.
+func (r *Router) listUserEvents(c *gin.Context) {
+	ctxUser := getCtxUser(c)
 
+	// the route policy resolves the user of the user tokens, a user token
+	// without one is refused instead of being treated as a machine token
+	if ctxUser == nil && contains(c.GetStringSlice("jwt.roles"), oidcScope) {
+		sendError(c, http.StatusForbidden, "user not allowed to list the events of other users")
 		return
 	}
 
-	mods = append(mods, qm.Limit(p.limitUsed()))
-
-	if p.Page != 0 {
-		mods = append(mods, qm.Offset(p.offset()))
-	}
-
-	mods = append(mods, qm.OrderBy("created_at DESC"))
-
-	preloads := []qm.QueryMod{
-		qm.Load("Actor", qm.WithDeleted()),
-		qm.Load("SubjectGroup", qm.WithDeleted()),
-		qm.Load("SubjectUser", qm.WithDeleted()),
-		qm.Load("SubjectOrganization", qm.WithDeleted()),
-		qm.Load("SubjectApplication", qm.WithDeleted()),
+	if ctxUser != nil && ctxUser.ID != c.Param("id") {
+		if isAdmin := getCtxAdmin(c); isAdmin == nil || !*isAdmin {
+			sendError(c, http.StatusForbidden, "user not allowed to list the events of other users")
+			return
+		}
 	}
 
-	mods = append(mods, preloads...)
-
-	events, err := models.AuditEvents(mods...).All(c.Request.Context(), r.DB)
+	user, err := r.svc().FindUser(c.Request.Context(), c.Param("id"), true)
 	if err != nil {
-		r.Logger.Error("error fetching audit events", zap.Error(err))
-		sendError(c, http.StatusBadRequest, "error listing audit events: "+err.Error())
-
+		sendServiceError(c, http.StatusInternalServerError, err)
 		return
 	}
 
-	d := float64(count) / float64(p.limitUsed())
-	totalPages := int(math.Ceil(d))
+	r.sendEvents(c, qm.Where("subject_user_id = ?", user.ID))
+}
 
-	c.JSON(http.StatusOK, &EventsResponse{
-		PageSize:         p.limitUsed(),
-		PageCount:        len(events),
-		TotalPages:       totalPages,
-		Page:             p.Page,
-		TotalRecordCount: count,
-		Records:          events,
-	})
+// eventFilters returns the query mods filtering the audit events by the action
+// query params, events matching any of the actions are returned
+func eventFilters(c *gin.Context) []qm.QueryMod {
+	actions := c.QueryArray("action")
+	if len(actions) == 0 {
+		return nil
+	}
+
+	return []qm.QueryMod{qm.WhereIn("action IN ?", stringsToInterfaces(actions)...)}
 }
 
-// listGroupEvents returns the audit events from the database for a group as JSON
-func (r *Router) listGroupEvents(c *gin.Context) {
+// sendEvents responds with a page of the audit events matching the query mods
+// and the filters in the query params
+func (r *Router) sendEvents(c *gin.Context, mods ...qm.QueryMod) {
 	p := parsePagination(c)
-	id := c.Param("id")
 
-	// TODO filtering
-	mods := []qm.QueryMod{
-		qm.Where("subject_group_id = ?", id),
-	}
+	mods = append(mods, eventFilters(c)...)
 
 	count, err := models.AuditEvents(mods...).Count(c.Request.Context(), r.DB)
 	if err != nil {
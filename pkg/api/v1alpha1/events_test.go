@@ -0,0 +1,82 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestEventFilters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := map[string]struct {
+		query    string
+		wantSQL  string
+		wantArgs []interface{}
+	}{
+		"None":     {"/", "", nil},
+		"Single":   {"/?action=group.member.added", "\"action\" IN ($1)", []interface{}{"group.member.added"}},
+		"Multiple": {"/?action=group.member.added&action=group.member.removed", "\"action\" IN ($1,$2)", []interface{}{"group.member.added", "group.member.removed"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, tt.query, nil)
+
+			filters := eventFilters(c)
+			if tt.wantSQL == "" {
+				assert.Empty(t, filters)
+				return
+			}
+
+			sql, args := queries.BuildQuery(models.AuditEvents(append(filters, qm.Select("id"))...).Query)
+			assert.Contains(t, sql, tt.wantSQL)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestListUserEventsForbidden(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	user := &models.User{ID: "00000001-0000-0000-0000-000000000001"}
+
+	tests := []struct {
+		name string
+		user *models.User
+	}{
+		{name: "other user", user: user},
+		{name: "user token without user"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Router{}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/api/v1alpha1/users/00000001-0000-0000-0000-000000000002/events", nil)
+			c.Params = gin.Params{{Key: "id", Value: "00000001-0000-0000-0000-000000000002"}}
+			c.Set("jwt.roles", []string{oidcScope})
+
+			if tt.user != nil {
+				admin := false
+
+				setCtxUser(c, tt.user)
+				setCtxAdmin(c, &admin)
+			}
+
+			r.listUserEvents(c)
+
+			assert.Equal(t, http.StatusForbidden, w.Code)
+		})
+	}
+}
@@ -0,0 +1,564 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/extcreds"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const (
+	contextKeyExtensionCredential = "gin-contextkey/extension-credential"
+
+	// defaultExtensionTokenTTL is how long the extension tokens are valid when
+	// the router isn't given a token ttl
+	defaultExtensionTokenTTL = time.Hour
+
+	grantTypeClientCredentials = "client_credentials"
+)
+
+// ExtensionCredential is the client credentials of an extension, the secret
+// is only returned when the credentials are created
+type ExtensionCredential struct {
+	ID           string    `json:"id"`
+	ExtensionID  string    `json:"extension_id"`
+	ClientID     string    `json:"client_id"`
+	ClientSecret string    `json:"client_secret,omitempty"`
+	Description  string    `json:"description"`
+	CreatedAt    time.Time `json:"created_at"`
+	LastUsedAt   null.Time `json:"last_used_at"`
+	// ExpiresAt is when the credentials stop working, they don't expire when it is null
+	ExpiresAt null.Time `json:"expires_at"`
+	RotatedAt null.Time `json:"rotated_at"`
+	// PreviousSecretExpiresAt is when the secret replaced by the last rotation
+	// stops working, it is null when the previous secret was revoked
+	PreviousSecretExpiresAt null.Time `json:"previous_secret_expires_at"`
+}
+
+// ExtensionCredentialReq is a request to issue client credentials to an extension
+type ExtensionCredentialReq struct {
+	Description string `json:"description"`
+}
+
+// ExtensionCredentialRotateReq is a request to replace the secret of client
+// credentials, the previous secret keeps working for the rotation grace
+// period unless it is revoked, for example when it leaked
+type ExtensionCredentialRotateReq struct {
+	RevokePrevious bool `json:"revoke_previous"`
+}
+
+// ExtensionToken is the response of the token endpoint, it follows the OAuth
+// 2.0 access token response
+type ExtensionToken struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+func newExtensionCredential(cred *models.ExtensionCredential) ExtensionCredential {
+	return ExtensionCredential{
+		ID:          cred.ID,
+		ExtensionID: cred.ExtensionID,
+		ClientID:    cred.ClientID,
+		Description: cred.Description,
+		CreatedAt:   cred.CreatedAt,
+		LastUsedAt:  cred.LastUsedAt,
+		ExpiresAt:   cred.ExpiresAt,
+		RotatedAt:   cred.RotatedAt,
+		// the previous secret only matters within its grace period
+		PreviousSecretExpiresAt: activePreviousSecretExpiry(cred, time.Now()),
+	}
+}
+
+// activePreviousSecretExpiry returns when the previous secret of credentials
+// stops working, or null when it doesn't work anymore
+func activePreviousSecretExpiry(cred *models.ExtensionCredential, now time.Time) null.Time {
+	if !cred.PreviousSecretHash.Valid || !cred.PreviousSecretExpiresAt.Valid || !now.Before(cred.PreviousSecretExpiresAt.Time) {
+		return null.Time{}
+	}
+
+	return cred.PreviousSecretExpiresAt
+}
+
+// extensionCredentialExpiry returns when credentials issued or rotated at now
+// expire, they don't expire when the router has no max age
+func (r *Router) extensionCredentialExpiry(now time.Time) null.Time {
+	if r.ExtensionCredentialMaxAge <= 0 {
+		return null.Time{}
+	}
+
+	return null.TimeFrom(now.Add(r.ExtensionCredentialMaxAge))
+}
+
+func setCtxExtensionCredential(c *gin.Context, cred *models.ExtensionCredential) {
+	c.Set(contextKeyExtensionCredential, cred)
+}
+
+func getCtxExtensionCredential(c *gin.Context) *models.ExtensionCredential {
+	val, ok := c.Get(contextKeyExtensionCredential)
+	if !ok {
+		return nil
+	}
+
+	cred, ok := val.(*models.ExtensionCredential)
+	if !ok {
+		return nil
+	}
+
+	return cred
+}
+
+// mwExtensionCredentialAuth authenticates the requests made with a token
+// issued to an extension. The token is only accepted on the routes of the
+// extension's own resources and events, requests with other bearer tokens are
+// left to the auth middleware.
+func (r *Router) mwExtensionCredentialAuth(basePath string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authzHeader := strings.Split(c.Request.Header.Get("Authorization"), " ")
+		if len(authzHeader) != expectedAuthzHeaderParts || !extcreds.IsToken(authzHeader[expectedAuthzHeaderParts-1]) {
+			return
+		}
+
+		token, err := models.ExtensionTokens(
+			qm.Where("token_hash = ?", extcreds.Hash(authzHeader[expectedAuthzHeaderParts-1])),
+			qm.And("expires_at > ?", time.Now()),
+			qm.Load(qm.Rels(models.ExtensionTokenRels.Credential, models.ExtensionCredentialRels.Extension)),
+		).One(c.Request.Context(), r.DB)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				sendErrorWithCode(c, http.StatusUnauthorized, ErrCodeUnauthorized, "invalid or expired extension token")
+				return
+			}
+
+			sendError(c, http.StatusInternalServerError, "error getting extension token: "+err.Error())
+
+			return
+		}
+
+		// deleted extensions aren't loaded, their credentials stop working with them
+		cred := token.R.GetCredential()
+		if cred == nil || cred.R.GetExtension() == nil {
+			sendErrorWithCode(c, http.StatusUnauthorized, ErrCodeUnauthorized, "invalid or expired extension token")
+			return
+		}
+
+		ext := cred.R.GetExtension()
+
+		param, ok := extcreds.RouteParam(c.Request.Method, strings.TrimPrefix(c.FullPath(), basePath))
+		if !ok || (c.Param(param) != ext.ID && c.Param(param) != ext.Slug) {
+			r.Logger.Warn("extension token used outside of its extension",
+				zap.String("client_id", cred.ClientID),
+				zap.String("extension", ext.Slug),
+				zap.String("route", c.FullPath()),
+			)
+
+			sendErrorWithCode(c, http.StatusForbidden, ErrCodeExtensionTokenForbidden, "extension token is not allowed on this route")
+
+			return
+		}
+
+		setCtxExtensionCredential(c, cred)
+
+		// the token subject identifies the extension in the audit and access logs
+		c.Set("jwt.subject", cred.ClientID)
+	}
+}
+
+// mwExtensionAuthRequired is the auth middleware of the routes accepting the
+// extension tokens, requests authenticated with an extension token skip the
+// token scopes check
+func (r *Router) mwExtensionAuthRequired(scopes []string) gin.HandlerFunc {
+	authRequired := r.AuthMW.AuthRequired(scopes)
+
+	return func(c *gin.Context) {
+		if getCtxExtensionCredential(c) != nil {
+			return
+		}
+
+		authRequired(c)
+	}
+}
+
+// findExtension finds an extension by id or slug
+func (r *Router) findExtension(c *gin.Context, id string) (*models.Extension, bool) {
+	q := qm.Where("id = ?", id)
+	if _, err := uuid.Parse(id); err != nil {
+		q = qm.Where("slug = ?", id)
+	}
+
+	extension, err := models.Extensions(q).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeExtensionNotFound, "extension not found: "+err.Error())
+			return nil, false
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting extension: "+err.Error())
+
+		return nil, false
+	}
+
+	return extension, true
+}
+
+// listExtensionCredentials lists the client credentials of an extension
+func (r *Router) listExtensionCredentials(c *gin.Context) {
+	extension, ok := r.findExtension(c, c.Param("eid"))
+	if !ok {
+		return
+	}
+
+	creds, err := models.ExtensionCredentials(
+		qm.Where("extension_id = ?", extension.ID),
+		qm.OrderBy("created_at"),
+	).All(c.Request.Context(), r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error listing extension credentials: "+err.Error())
+		return
+	}
+
+	resp := make([]ExtensionCredential, len(creds))
+	for i, cred := range creds {
+		resp[i] = newExtensionCredential(cred)
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// createExtensionCredential issues new client credentials to an extension, the
+// secret is only returned in the response
+func (r *Router) createExtensionCredential(c *gin.Context) {
+	req := ExtensionCredentialReq{}
+	if c.Request.ContentLength != 0 && !bindRequest(c, &req) {
+		return
+	}
+
+	extension, ok := r.findExtension(c, c.Param("eid"))
+	if !ok {
+		return
+	}
+
+	clientID, err := extcreds.NewClientID()
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error generating client id: "+err.Error())
+		return
+	}
+
+	secret, err := extcreds.NewSecret()
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error generating client secret: "+err.Error())
+		return
+	}
+
+	now := time.Now()
+
+	cred := &models.ExtensionCredential{
+		ExtensionID: extension.ID,
+		ClientID:    clientID,
+		SecretHash:  extcreds.Hash(secret),
+		Description: req.Description,
+		CreatedAt:   now,
+		ExpiresAt:   r.extensionCredentialExpiry(now),
+	}
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := cred.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating extension credential: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditExtensionCredentialCreated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), extension, cred)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error creating extension credential (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating extension credential (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	resp := newExtensionCredential(cred)
+	resp.ClientSecret = secret
+
+	c.JSON(http.StatusAccepted, resp)
+}
+
+// deleteExtensionCredential revokes the client credentials of an extension
+// and the tokens issued with them
+func (r *Router) deleteExtensionCredential(c *gin.Context) {
+	extension, ok := r.findExtension(c, c.Param("eid"))
+	if !ok {
+		return
+	}
+
+	cred, ok := r.findExtensionCredential(c, extension, c.Param("id"))
+	if !ok {
+		return
+	}
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := cred.Delete(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting extension credential: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditExtensionCredentialDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), extension, cred)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting extension credential (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting extension credential (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, newExtensionCredential(cred))
+}
+
+// findExtensionCredential finds the client credentials of an extension by id
+func (r *Router) findExtensionCredential(c *gin.Context, extension *models.Extension, id string) (*models.ExtensionCredential, bool) {
+	cred, err := models.ExtensionCredentials(
+		qm.Where("id = ?", id),
+		qm.And("extension_id = ?", extension.ID),
+	).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeExtensionCredentialNotFound, "extension credential not found: "+id)
+			return nil, false
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting extension credential: "+err.Error())
+
+		return nil, false
+	}
+
+	return cred, true
+}
+
+// rotateExtensionCredential replaces the secret of client credentials and
+// renews their expiration, the new secret is only returned in the response.
+// The previous secret keeps working for the rotation grace period so the
+// extension can roll over, unless it is revoked along with the tokens issued
+// with the credentials.
+func (r *Router) rotateExtensionCredential(c *gin.Context) {
+	req := ExtensionCredentialRotateReq{}
+	if c.Request.ContentLength != 0 && !bindRequest(c, &req) {
+		return
+	}
+
+	extension, ok := r.findExtension(c, c.Param("eid"))
+	if !ok {
+		return
+	}
+
+	cred, ok := r.findExtensionCredential(c, extension, c.Param("id"))
+	if !ok {
+		return
+	}
+
+	secret, err := extcreds.NewSecret()
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error generating client secret: "+err.Error())
+		return
+	}
+
+	now := time.Now()
+	revoke := req.RevokePrevious || r.ExtensionCredentialRotationGrace <= 0
+
+	if revoke {
+		cred.PreviousSecretHash = null.String{}
+		cred.PreviousSecretExpiresAt = null.Time{}
+	} else {
+		cred.PreviousSecretHash = null.StringFrom(cred.SecretHash)
+		cred.PreviousSecretExpiresAt = null.TimeFrom(now.Add(r.ExtensionCredentialRotationGrace))
+	}
+
+	cred.SecretHash = extcreds.Hash(secret)
+	cred.RotatedAt = null.TimeFrom(now)
+	cred.ExpiresAt = r.extensionCredentialExpiry(now)
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := cred.Update(c.Request.Context(), tx, boil.Whitelist(
+			models.ExtensionCredentialColumns.SecretHash,
+			models.ExtensionCredentialColumns.PreviousSecretHash,
+			models.ExtensionCredentialColumns.PreviousSecretExpiresAt,
+			models.ExtensionCredentialColumns.RotatedAt,
+			models.ExtensionCredentialColumns.ExpiresAt,
+		)); err != nil {
+			return abortTx(http.StatusBadRequest, "error rotating extension credential: "+err.Error(), err)
+		}
+
+		// the tokens issued with a revoked secret are revoked with it
+		if revoke {
+			if _, err := models.ExtensionTokens(qm.Where("credential_id = ?", cred.ID)).DeleteAll(c.Request.Context(), tx); err != nil {
+				return abortTx(http.StatusBadRequest, "error revoking extension tokens: "+err.Error(), err)
+			}
+		}
+
+		event, err := dbtools.AuditExtensionCredentialRotated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), extension, cred)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error rotating extension credential (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error rotating extension credential (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	resp := newExtensionCredential(cred)
+	resp.ClientSecret = secret
+
+	c.JSON(http.StatusAccepted, resp)
+}
+
+// issueExtensionToken is the OAuth 2.0 token endpoint of the extensions, it
+// exchanges client credentials for a token with the client credentials grant.
+// The client authenticates with HTTP basic auth or the form parameters, and
+// errors are returned in the OAuth 2.0 format so OAuth clients can use it.
+func (r *Router) issueExtensionToken(c *gin.Context) {
+	if c.PostForm("grant_type") != grantTypeClientCredentials {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "unsupported_grant_type"})
+		return
+	}
+
+	clientID, secret, ok := c.Request.BasicAuth()
+	if !ok {
+		clientID, secret = c.PostForm("client_id"), c.PostForm("client_secret")
+	}
+
+	cred, err := models.ExtensionCredentials(
+		qm.Where("client_id = ?", clientID),
+		qm.Load(models.ExtensionCredentialRels.Extension),
+	).One(c.Request.Context(), r.DB)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		r.Logger.Error("error getting extension credential", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "server_error"})
+
+		return
+	}
+
+	now := time.Now()
+
+	// deleted extensions aren't loaded, their credentials stop working with them,
+	// and expired credentials or previous secrets past their grace period don't match
+	match := extcreds.NoMatch
+	if cred != nil && cred.R.GetExtension() != nil {
+		match = extensionCredentialSecrets(cred).Check(secret, now)
+	}
+
+	if match == extcreds.NoMatch {
+		c.Header("WWW-Authenticate", `Basic realm="governor"`)
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid_client"})
+
+		return
+	}
+
+	ttl := r.ExtensionTokenTTL
+	if ttl <= 0 {
+		ttl = defaultExtensionTokenTTL
+	}
+
+	// the tokens don't outlive the secret they were issued with
+	expiresAt := now.Add(ttl)
+
+	if cred.ExpiresAt.Valid && cred.ExpiresAt.Time.Before(expiresAt) {
+		expiresAt = cred.ExpiresAt.Time
+	}
+
+	if match == extcreds.PreviousSecret && cred.PreviousSecretExpiresAt.Time.Before(expiresAt) {
+		expiresAt = cred.PreviousSecretExpiresAt.Time
+	}
+
+	access, err := extcreds.NewToken()
+	if err != nil {
+		r.Logger.Error("error generating extension token", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "server_error"})
+
+		return
+	}
+
+	token := &models.ExtensionToken{
+		CredentialID: cred.ID,
+		TokenHash:    extcreds.Hash(access),
+		ExpiresAt:    expiresAt,
+		CreatedAt:    now,
+	}
+
+	if err := r.storeExtensionToken(c, cred, token, match == extcreds.PreviousSecret); err != nil {
+		r.Logger.Error("error storing extension token", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "server_error"})
+
+		return
+	}
+
+	cred.LastUsedAt = null.TimeFrom(now)
+
+	if _, err := cred.Update(c.Request.Context(), r.DB, boil.Whitelist(models.ExtensionCredentialColumns.LastUsedAt)); err != nil {
+		r.Logger.Warn("error updating extension credential last use", zap.Error(err))
+	}
+
+	// the expired tokens of the credentials are cleaned up as new ones are issued
+	if _, err := models.ExtensionTokens(
+		qm.Where("credential_id = ?", cred.ID),
+		qm.And("expires_at <= ?", now),
+	).DeleteAll(c.Request.Context(), r.DB); err != nil {
+		r.Logger.Warn("error deleting expired extension tokens", zap.Error(err))
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, ExtensionToken{
+		AccessToken: access,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(expiresAt.Sub(now).Seconds()),
+	})
+}
+
+// extensionCredentialSecrets returns the secrets the client secrets are
+// verified against
+func extensionCredentialSecrets(cred *models.ExtensionCredential) extcreds.Secrets {
+	return extcreds.Secrets{
+		Hash:                  cred.SecretHash,
+		ExpiresAt:             cred.ExpiresAt.Time,
+		PreviousHash:          cred.PreviousSecretHash.String,
+		PreviousHashExpiresAt: cred.PreviousSecretExpiresAt.Time,
+	}
+}
+
+// storeExtensionToken stores a token issued with client credentials and
+// audits the use of the credentials in the same transaction
+func (r *Router) storeExtensionToken(c *gin.Context, cred *models.ExtensionCredential, token *models.ExtensionToken, previousSecret bool) error {
+	// the token endpoint sends oauth errors rather than the withTx responses
+	return dbtools.WithTransaction(c.Request.Context(), r.DB, func(tx *sql.Tx) error {
+		if err := token.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return err
+		}
+
+		_, err := dbtools.AuditExtensionCredentialUsed(
+			c.Request.Context(), tx, getCtxAuditID(c), cred.R.GetExtension(), cred, token, previousSecret,
+		)
+
+		return err
+	})
+}
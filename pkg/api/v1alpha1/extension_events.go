@@ -0,0 +1,198 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/nats-io/nats.go"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/eventbus"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+const (
+	// extensionEventsBufferSize is the number of events buffered per websocket
+	// connection before new events are dropped
+	extensionEventsBufferSize = 256
+	// extensionEventsPingInterval is how often a ping is sent to keep the
+	// websocket connection alive
+	extensionEventsPingInterval = 30 * time.Second
+	// extensionEventsWriteTimeout is the deadline for a single websocket write
+	extensionEventsWriteTimeout = 10 * time.Second
+)
+
+var extensionEventsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// subscribeExtensionEvents upgrades the request to a websocket connection and
+// streams the events published for the extension's resource definitions.  The
+// payloads are the same ones published on the event bus.  The set of ERDs is
+// resolved when the connection is established, clients should reconnect to
+// pick up newly created ERDs. Only the extension itself, with a token of its
+// own credentials, and the governor admins can subscribe.
+func (r *Router) subscribeExtensionEvents(c *gin.Context) {
+	id := c.Param("eid")
+
+	q := qm.Where("id = ?", id)
+
+	if _, err := uuid.Parse(id); err != nil {
+		q = qm.Where("slug = ?", id)
+	}
+
+	extension, err := models.Extensions(
+		q,
+		qm.Load(models.ExtensionRels.ExtensionResourceDefinitions),
+	).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeExtensionNotFound, "extension not found: "+err.Error())
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting extension: "+err.Error())
+
+		return
+	}
+
+	if !canSubscribeExtensionEvents(c, extension) {
+		sendErrorWithCode(c, http.StatusForbidden, ErrCodeForbidden, "not allowed to subscribe to the extension events")
+		return
+	}
+
+	if !extension.Enabled {
+		sendError(c, http.StatusBadRequest, "extension is disabled")
+		return
+	}
+
+	msgs := make(chan []byte, extensionEventsBufferSize)
+
+	handler := func(m *nats.Msg) {
+		event := &events.Event{}
+		if err := json.Unmarshal(m.Data, event); err != nil {
+			r.Logger.Warn("failed to unmarshal extension event", zap.String("subject", m.Subject), zap.Error(err))
+			return
+		}
+
+		// ERDs from different extensions may share a plural slug, only forward
+		// the events that belong to this extension
+		if event.ExtensionID != extension.ID {
+			return
+		}
+
+		select {
+		case msgs <- m.Data:
+		default:
+			r.Logger.Warn("extension event buffer full, dropping event",
+				zap.String("extension", extension.Slug),
+				zap.String("subject", m.Subject),
+			)
+		}
+	}
+
+	unsubscribers := []func() error{}
+
+	defer func() {
+		for _, unsubscribe := range unsubscribers {
+			if err := unsubscribe(); err != nil {
+				r.Logger.Warn("failed to unsubscribe from extension events", zap.Error(err))
+			}
+		}
+	}()
+
+	subjects := map[string]struct{}{}
+
+	for _, erd := range extension.R.ExtensionResourceDefinitions {
+		subject := r.EventBus.ExtensionSubject(c.Request.Context(), extension.ID, erd.SlugPlural)
+
+		if _, ok := subjects[subject]; ok {
+			continue
+		}
+
+		subjects[subject] = struct{}{}
+
+		unsubscribe, err := r.EventBus.Subscribe(subject, handler)
+		if err != nil {
+			if errors.Is(err, eventbus.ErrSubscribeNotSupported) {
+				sendError(c, http.StatusNotImplemented, err.Error())
+				return
+			}
+
+			sendError(c, http.StatusInternalServerError, "error subscribing to extension events: "+err.Error())
+
+			return
+		}
+
+		unsubscribers = append(unsubscribers, unsubscribe)
+	}
+
+	// the upgrader writes an http error response on failure
+	conn, err := extensionEventsUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		r.Logger.Warn("failed to upgrade extension events connection", zap.Error(err))
+		return
+	}
+
+	defer conn.Close()
+
+	// the client isn't expected to send anything, reading is only used to
+	// process control frames and to detect when the connection is closed
+	closed := make(chan struct{})
+
+	go func() {
+		defer close(closed)
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(extensionEventsPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case <-c.Request.Context().Done():
+			return
+		case data := <-msgs:
+			if err := conn.SetWriteDeadline(time.Now().Add(extensionEventsWriteTimeout)); err != nil {
+				return
+			}
+
+			if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				r.Logger.Debug("failed to write extension event", zap.Error(err))
+				return
+			}
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(extensionEventsWriteTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// canSubscribeExtensionEvents returns true when the request was made with a
+// token of the extension's own credentials or by a governor admin
+func canSubscribeExtensionEvents(c *gin.Context, extension *models.Extension) bool {
+	if cred := getCtxExtensionCredential(c); cred != nil {
+		return cred.ExtensionID == extension.ID
+	}
+
+	admin := getCtxAdmin(c)
+
+	return admin != nil && *admin
+}
@@ -0,0 +1,205 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/cockroach-go/v2/testserver"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/jmoiron/sqlx"
+	dbm "github.com/metal-toolbox/governor-api/db"
+	"github.com/metal-toolbox/governor-api/internal/eventbus"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/nats-io/nats.go"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+// subscribingNATSConn is a mock nats connection recording the subscribed subjects
+type subscribingNATSConn struct {
+	mockNATSConn
+
+	mu       sync.Mutex
+	subjects []string
+}
+
+func (m *subscribingNATSConn) Subscribe(subject string, _ nats.MsgHandler) (*nats.Subscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.subjects = append(m.subjects, subject)
+
+	return &nats.Subscription{}, nil
+}
+
+type ExtensionEventsTestSuite struct {
+	suite.Suite
+
+	db   *sql.DB
+	conn *subscribingNATSConn
+
+	v1alpha1 *Router
+}
+
+func (s *ExtensionEventsTestSuite) seedTestDB() error {
+	testData := []string{
+		// extensions
+		`INSERT INTO extensions (id, name, description, enabled, slug, status, created_at, updated_at)
+		VALUES ('00000001-0000-0000-0000-000000000001', 'Test Extension 1', 'some extension', true, 'test-extension-1', 'online', now(), now());`,
+		`INSERT INTO extensions (id, name, description, enabled, slug, status, created_at, updated_at)
+		VALUES ('00000001-0000-0000-0000-000000000002', 'Test Extension 2', 'some other extension', true, 'test-extension-2', 'online', now(), now());`,
+
+		// ERDs
+		`INSERT INTO extension_resource_definitions (id, name, description, enabled, slug_singular, slug_plural, version, scope, schema, extension_id)
+		VALUES ('00000004-0000-0000-0000-000000000001', 'Some Resource', 'some-description', true, 'some-resource', 'some-resources', 'v1', 'system',
+		'{"$id": "v1.some-resource.test-ex-1","$schema": "https://json-schema.org/draft/2020-12/schema","title": "Some Resource","type": "object"}'::jsonb,
+		'00000001-0000-0000-0000-000000000001');`,
+	}
+
+	for _, q := range testData {
+		_, err := s.db.Query(q)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *ExtensionEventsTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+
+	s.conn = &subscribingNATSConn{}
+
+	ts, err := testserver.NewTestServer()
+	if err != nil {
+		panic(err)
+	}
+
+	s.db, err = sql.Open("postgres", ts.PGURL().String())
+	if err != nil {
+		panic(err)
+	}
+
+	goose.SetBaseFS(dbm.Migrations)
+
+	if err := goose.Up(s.db, "migrations"); err != nil {
+		panic("migration failed - could not set up test db")
+	}
+
+	if err := s.seedTestDB(); err != nil {
+		panic("db setup failed - could not seed test db: " + err.Error())
+	}
+
+	s.v1alpha1 = &Router{
+		AdminGroups: []string{"governor-admin"},
+		DB:          sqlx.NewDb(s.db, "postgres"),
+		EventBus:    eventbus.NewClient(eventbus.WithNATSConn(s.conn)),
+		Logger:      zap.NewNop(),
+	}
+}
+
+func (s *ExtensionEventsTestSuite) TestSubscribeExtensionEvents() {
+	user := &models.User{
+		ID:    "00000003-0000-0000-0000-000000000001",
+		Name:  "Some User",
+		Email: "suser@email.com",
+	}
+
+	tt := []struct {
+		name     string
+		forge    gin.HandlerFunc
+		respcode int
+	}{
+		{
+			name: "admin",
+			forge: func(c *gin.Context) {
+				admin := true
+
+				setCtxUser(c, user)
+				setCtxAdmin(c, &admin)
+			},
+			respcode: http.StatusSwitchingProtocols,
+		},
+		{
+			name: "extension credential",
+			forge: func(c *gin.Context) {
+				setCtxExtensionCredential(c, &models.ExtensionCredential{
+					ClientID:    "client-1",
+					ExtensionID: "00000001-0000-0000-0000-000000000001",
+				})
+			},
+			respcode: http.StatusSwitchingProtocols,
+		},
+		{
+			name: "non-admin user",
+			forge: func(c *gin.Context) {
+				admin := false
+
+				setCtxUser(c, user)
+				setCtxAdmin(c, &admin)
+			},
+			respcode: http.StatusForbidden,
+		},
+		{
+			name: "other extension credential",
+			forge: func(c *gin.Context) {
+				setCtxExtensionCredential(c, &models.ExtensionCredential{
+					ClientID:    "client-2",
+					ExtensionID: "00000001-0000-0000-0000-000000000002",
+				})
+			},
+			respcode: http.StatusForbidden,
+		},
+		{
+			name:     "token without user or credential",
+			forge:    func(_ *gin.Context) {},
+			respcode: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tt {
+		s.T().Run(tc.name, func(_ *testing.T) {
+			r := gin.New()
+			r.GET("/api/v1alpha1/extensions/:eid/events", tc.forge, s.v1alpha1.subscribeExtensionEvents)
+
+			srv := httptest.NewServer(r)
+			defer srv.Close()
+
+			url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/v1alpha1/extensions/test-extension-1/events"
+
+			conn, resp, err := websocket.DefaultDialer.Dial(url, nil)
+			if conn != nil {
+				defer conn.Close()
+			}
+
+			if tc.respcode != http.StatusSwitchingProtocols {
+				s.Assert().Error(err)
+			} else {
+				s.Assert().NoError(err)
+			}
+
+			if s.Assert().NotNil(resp) {
+				defer resp.Body.Close()
+
+				s.Assert().Equal(tc.respcode, resp.StatusCode)
+			}
+		})
+	}
+
+	s.conn.mu.Lock()
+	defer s.conn.mu.Unlock()
+
+	// only the allowed callers subscribed to the extension subjects
+	s.Assert().Len(s.conn.subjects, 2)
+}
+
+func TestExtensionEventsTestSuite(t *testing.T) {
+	suite.Run(t, new(ExtensionEventsTestSuite))
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/governor-api/internal/erdstate"
 	"github.com/metal-toolbox/governor-api/internal/models"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -121,7 +122,7 @@ func (r *Router) mwExtensionResourcesEnabledCheck(c *gin.Context) {
 		)
 		if err != nil {
 			if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
-				sendError(c, http.StatusNotFound, err.Error())
+				sendErrorFromErr(c, http.StatusNotFound, err)
 				return
 			}
 
@@ -139,8 +140,22 @@ func (r *Router) mwExtensionResourcesEnabledCheck(c *gin.Context) {
 		return
 	}
 
-	if !erd.Enabled {
-		sendError(c, http.StatusBadRequest, "extension resource definition is disabled")
+	if err := erdstate.State(erd.State).Allows(erdOperation(c.Request.Method)); err != nil {
+		sendErrorFromErr(c, http.StatusBadRequest, err)
 		return
 	}
 }
+
+// erdOperation returns the operation a request method performs on extension resources
+func erdOperation(method string) erdstate.Operation {
+	switch method {
+	case http.MethodPost:
+		return erdstate.Create
+	case http.MethodPatch, http.MethodPut:
+		return erdstate.Update
+	case http.MethodDelete:
+		return erdstate.Delete
+	default:
+		return erdstate.Read
+	}
+}
@@ -0,0 +1,403 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/pkg/jsonschema"
+)
+
+const (
+	// ExtensionDriftMissing is a definition of the snapshot missing from this
+	// instance
+	ExtensionDriftMissing = "missing"
+	// ExtensionDriftExtra is a definition of this instance that isn't in the
+	// snapshot
+	ExtensionDriftExtra = "extra"
+	// ExtensionDriftChanged is a definition of both whose fields differ
+	ExtensionDriftChanged = "changed"
+)
+
+// ExtensionSnapshot is the export of the extension definitions of a governor
+// instance, to compare them with another instance. The definitions are
+// matched by their slugs, the ids and groups differ between instances and
+// aren't part of it.
+type ExtensionSnapshot struct {
+	ExportedAt time.Time                `json:"exported_at"`
+	Extensions []ExtensionSnapshotEntry `json:"extensions"`
+}
+
+// ExtensionSnapshotEntry is an extension of a snapshot with its ERDs
+type ExtensionSnapshotEntry struct {
+	Slug        string                      `json:"slug"`
+	Name        string                      `json:"name"`
+	Description string                      `json:"description"`
+	Enabled     bool                        `json:"enabled"`
+	Status      string                      `json:"status"`
+	ERDs        []ExtensionSnapshotERDEntry `json:"erds"`
+}
+
+// ExtensionSnapshotERDEntry is an extension resource definition of a snapshot,
+// a definition is identified by its singular slug and version
+type ExtensionSnapshotERDEntry struct {
+	SlugSingular       string          `json:"slug_singular"`
+	SlugPlural         string          `json:"slug_plural"`
+	Version            string          `json:"version"`
+	Name               string          `json:"name"`
+	Description        string          `json:"description"`
+	Scope              string          `json:"scope"`
+	State              string          `json:"state"`
+	DefaultOwnerPolicy string          `json:"default_owner_policy"`
+	Schema             json.RawMessage `json:"schema"`
+}
+
+// ExtensionDrift is the comparison of the extension definitions of this
+// instance with a snapshot, only the definitions that differ are listed
+type ExtensionDrift struct {
+	InSync     bool                  `json:"in_sync"`
+	Extensions []ExtensionDriftEntry `json:"extensions"`
+}
+
+// ExtensionDriftEntry is an extension that differs from the snapshot, Drift is
+// empty when only its ERDs differ
+type ExtensionDriftEntry struct {
+	Slug   string                   `json:"slug"`
+	Drift  string                   `json:"drift,omitempty"`
+	Fields []string                 `json:"fields,omitempty"`
+	ERDs   []ExtensionDriftERDEntry `json:"erds,omitempty"`
+}
+
+// ExtensionDriftERDEntry is an extension resource definition that differs
+// from the snapshot, with the changes of its schema
+type ExtensionDriftERDEntry struct {
+	SlugSingular string                 `json:"slug_singular"`
+	Version      string                 `json:"version"`
+	Drift        string                 `json:"drift"`
+	Fields       []string               `json:"fields,omitempty"`
+	SchemaDiff   *jsonschema.SchemaDiff `json:"schema_diff,omitempty"`
+}
+
+// erdSnapshotKey identifies an ERD of a snapshot within its extension
+func erdSnapshotKey(e ExtensionSnapshotERDEntry) string {
+	return e.SlugSingular + "@" + e.Version
+}
+
+// exportExtensionSnapshot builds the snapshot of the extension definitions
+// of this instance, the deleted ones are left out
+func (r *Router) exportExtensionSnapshot(c *gin.Context) (*ExtensionSnapshot, error) {
+	extensions, err := models.Extensions(
+		qm.Load(models.ExtensionRels.ExtensionResourceDefinitions, qm.OrderBy("slug_singular, version")),
+		qm.OrderBy("slug"),
+	).All(c.Request.Context(), r.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &ExtensionSnapshot{
+		ExportedAt: time.Now().UTC(),
+		Extensions: make([]ExtensionSnapshotEntry, len(extensions)),
+	}
+
+	for i, ext := range extensions {
+		entry := ExtensionSnapshotEntry{
+			Slug:        ext.Slug,
+			Name:        ext.Name,
+			Description: ext.Description,
+			Enabled:     ext.Enabled,
+			Status:      ext.Status,
+			ERDs:        []ExtensionSnapshotERDEntry{},
+		}
+
+		if ext.R != nil {
+			for _, erd := range ext.R.ExtensionResourceDefinitions {
+				entry.ERDs = append(entry.ERDs, ExtensionSnapshotERDEntry{
+					SlugSingular:       erd.SlugSingular,
+					SlugPlural:         erd.SlugPlural,
+					Version:            erd.Version,
+					Name:               erd.Name,
+					Description:        erd.Description,
+					Scope:              erd.Scope,
+					State:              erd.State,
+					DefaultOwnerPolicy: erd.DefaultOwnerPolicy,
+					Schema:             json.RawMessage(erd.Schema),
+				})
+			}
+		}
+
+		snapshot.Extensions[i] = entry
+	}
+
+	return snapshot, nil
+}
+
+// getExtensionSnapshot exports the extension definitions of this instance
+func (r *Router) getExtensionSnapshot(c *gin.Context) {
+	snapshot, err := r.exportExtensionSnapshot(c)
+	if err != nil {
+		r.Logger.Error("error exporting extension snapshot", zap.Error(err))
+		sendError(c, http.StatusInternalServerError, "error exporting extension snapshot: "+err.Error())
+
+		return
+	}
+
+	c.JSON(http.StatusOK, snapshot)
+}
+
+// diffExtensionSnapshot compares the extension definitions of this instance
+// with a snapshot exported from another instance
+func (r *Router) diffExtensionSnapshot(c *gin.Context) {
+	req := &ExtensionSnapshot{}
+	if !bindRequest(c, req) {
+		return
+	}
+
+	if details := validateExtensionSnapshot(req); len(details) > 0 {
+		sendValidationError(c, details)
+		return
+	}
+
+	current, err := r.exportExtensionSnapshot(c)
+	if err != nil {
+		r.Logger.Error("error exporting extension snapshot", zap.Error(err))
+		sendError(c, http.StatusInternalServerError, "error exporting extension snapshot: "+err.Error())
+
+		return
+	}
+
+	drift, err := compareExtensionSnapshots(current, req)
+	if err != nil {
+		sendError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, drift)
+}
+
+// validateExtensionSnapshot checks that the definitions of a snapshot are
+// identified and unique
+func validateExtensionSnapshot(s *ExtensionSnapshot) []ErrorDetail {
+	details := []ErrorDetail{}
+	slugs := map[string]bool{}
+
+	for i, ext := range s.Extensions {
+		field := fmt.Sprintf("extensions[%d]", i)
+
+		switch {
+		case ext.Slug == "":
+			details = append(details, ErrorDetail{Field: field + ".slug", Message: "is required"})
+		case slugs[ext.Slug]:
+			details = append(details, ErrorDetail{Field: field + ".slug", Message: "is duplicated"})
+		}
+
+		slugs[ext.Slug] = true
+		erds := map[string]bool{}
+
+		for j, erd := range ext.ERDs {
+			erdField := fmt.Sprintf("%s.erds[%d]", field, j)
+
+			switch {
+			case erd.SlugSingular == "" || erd.Version == "":
+				details = append(details, ErrorDetail{Field: erdField, Message: "slug_singular and version are required"})
+			case erds[erdSnapshotKey(erd)]:
+				details = append(details, ErrorDetail{Field: erdField, Message: "is duplicated"})
+			case !json.Valid(erd.Schema):
+				details = append(details, ErrorDetail{Field: erdField + ".schema", Message: "must be a JSON schema"})
+			}
+
+			erds[erdSnapshotKey(erd)] = true
+		}
+	}
+
+	return details
+}
+
+// compareExtensionSnapshots reports the drift of the current extension
+// definitions from a snapshot. The schemas are compared semantically, so the
+// formatting and the order of their keys don't count.
+func compareExtensionSnapshots(current, snapshot *ExtensionSnapshot) (*ExtensionDrift, error) {
+	drift := &ExtensionDrift{Extensions: []ExtensionDriftEntry{}}
+
+	currentBySlug := make(map[string]ExtensionSnapshotEntry, len(current.Extensions))
+	for _, ext := range current.Extensions {
+		currentBySlug[ext.Slug] = ext
+	}
+
+	seen := map[string]bool{}
+
+	for _, want := range snapshot.Extensions {
+		seen[want.Slug] = true
+
+		have, ok := currentBySlug[want.Slug]
+		if !ok {
+			drift.Extensions = append(drift.Extensions, ExtensionDriftEntry{
+				Slug:  want.Slug,
+				Drift: ExtensionDriftMissing,
+				ERDs:  erdDriftEntries(want.ERDs, ExtensionDriftMissing),
+			})
+
+			continue
+		}
+
+		entry := ExtensionDriftEntry{Slug: want.Slug}
+
+		if fields := changedFields(
+			fieldPair{"name", have.Name, want.Name},
+			fieldPair{"description", have.Description, want.Description},
+			fieldPair{"enabled", have.Enabled, want.Enabled},
+			fieldPair{"status", have.Status, want.Status},
+		); len(fields) > 0 {
+			entry.Drift = ExtensionDriftChanged
+			entry.Fields = fields
+		}
+
+		erds, err := compareERDSnapshots(have.ERDs, want.ERDs)
+		if err != nil {
+			return nil, fmt.Errorf("extension %s: %w", want.Slug, err)
+		}
+
+		entry.ERDs = erds
+
+		if entry.Drift != "" || len(entry.ERDs) > 0 {
+			drift.Extensions = append(drift.Extensions, entry)
+		}
+	}
+
+	for _, have := range current.Extensions {
+		if !seen[have.Slug] {
+			drift.Extensions = append(drift.Extensions, ExtensionDriftEntry{
+				Slug:  have.Slug,
+				Drift: ExtensionDriftExtra,
+				ERDs:  erdDriftEntries(have.ERDs, ExtensionDriftExtra),
+			})
+		}
+	}
+
+	sort.Slice(drift.Extensions, func(i, j int) bool { return drift.Extensions[i].Slug < drift.Extensions[j].Slug })
+
+	drift.InSync = len(drift.Extensions) == 0
+
+	return drift, nil
+}
+
+// compareERDSnapshots reports the drift of the current ERDs of an extension
+// from the ERDs of the snapshot
+func compareERDSnapshots(current, snapshot []ExtensionSnapshotERDEntry) ([]ExtensionDriftERDEntry, error) {
+	entries := []ExtensionDriftERDEntry{}
+
+	currentByKey := make(map[string]ExtensionSnapshotERDEntry, len(current))
+	for _, erd := range current {
+		currentByKey[erdSnapshotKey(erd)] = erd
+	}
+
+	seen := map[string]bool{}
+
+	for _, want := range snapshot {
+		seen[erdSnapshotKey(want)] = true
+
+		have, ok := currentByKey[erdSnapshotKey(want)]
+		if !ok {
+			entries = append(entries, ExtensionDriftERDEntry{
+				SlugSingular: want.SlugSingular,
+				Version:      want.Version,
+				Drift:        ExtensionDriftMissing,
+			})
+
+			continue
+		}
+
+		fields := changedFields(
+			fieldPair{"slug_plural", have.SlugPlural, want.SlugPlural},
+			fieldPair{"name", have.Name, want.Name},
+			fieldPair{"description", have.Description, want.Description},
+			fieldPair{"scope", have.Scope, want.Scope},
+			fieldPair{"state", have.State, want.State},
+			fieldPair{"default_owner_policy", have.DefaultOwnerPolicy, want.DefaultOwnerPolicy},
+		)
+
+		// the diff is from the current schema to the snapshot one, the
+		// changes a promotion would make
+		schemaDiff, err := jsonschema.Diff(have.Schema, want.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("comparing the schema of %s: %w", erdSnapshotKey(want), err)
+		}
+
+		if !schemaDiff.Empty() {
+			fields = append(fields, "schema")
+		} else {
+			schemaDiff = nil
+		}
+
+		if len(fields) > 0 {
+			entries = append(entries, ExtensionDriftERDEntry{
+				SlugSingular: want.SlugSingular,
+				Version:      want.Version,
+				Drift:        ExtensionDriftChanged,
+				Fields:       fields,
+				SchemaDiff:   schemaDiff,
+			})
+		}
+	}
+
+	for _, have := range current {
+		if !seen[erdSnapshotKey(have)] {
+			entries = append(entries, ExtensionDriftERDEntry{
+				SlugSingular: have.SlugSingular,
+				Version:      have.Version,
+				Drift:        ExtensionDriftExtra,
+			})
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].SlugSingular != entries[j].SlugSingular {
+			return entries[i].SlugSingular < entries[j].SlugSingular
+		}
+
+		return entries[i].Version < entries[j].Version
+	})
+
+	return entries, nil
+}
+
+// erdDriftEntries lists every ERD of an extension missing from one side
+func erdDriftEntries(erds []ExtensionSnapshotERDEntry, drift string) []ExtensionDriftERDEntry {
+	entries := make([]ExtensionDriftERDEntry, len(erds))
+
+	for i, erd := range erds {
+		entries[i] = ExtensionDriftERDEntry{
+			SlugSingular: erd.SlugSingular,
+			Version:      erd.Version,
+			Drift:        drift,
+		}
+	}
+
+	return entries
+}
+
+// fieldPair is a field of a definition with its current and snapshot values
+type fieldPair struct {
+	name     string
+	current  interface{}
+	snapshot interface{}
+}
+
+// changedFields returns the names of the fields whose values differ
+func changedFields(pairs ...fieldPair) []string {
+	fields := []string{}
+
+	for _, p := range pairs {
+		if p.current != p.snapshot {
+			fields = append(fields, p.name)
+		}
+	}
+
+	return fields
+}
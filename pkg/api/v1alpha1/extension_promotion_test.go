@@ -0,0 +1,185 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testExtensionSnapshot() *ExtensionSnapshot {
+	return &ExtensionSnapshot{
+		Extensions: []ExtensionSnapshotEntry{
+			{
+				Slug:    "test-extension",
+				Name:    "Test Extension",
+				Enabled: true,
+				Status:  "online",
+				ERDs: []ExtensionSnapshotERDEntry{
+					{
+						SlugSingular: "thing",
+						SlugPlural:   "things",
+						Version:      "v1",
+						Name:         "Thing",
+						Scope:        "system",
+						State:        "active",
+						Schema:       json.RawMessage(`{"type": "object", "properties": {"name": {"type": "string"}}}`),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCompareExtensionSnapshots(t *testing.T) {
+	tests := []struct {
+		name     string
+		snapshot func(s *ExtensionSnapshot)
+		want     []ExtensionDriftEntry
+	}{
+		{
+			name:     "in sync",
+			snapshot: func(_ *ExtensionSnapshot) {},
+			want:     []ExtensionDriftEntry{},
+		},
+		{
+			name: "schema formatting doesn't drift",
+			snapshot: func(s *ExtensionSnapshot) {
+				s.Extensions[0].ERDs[0].Schema = json.RawMessage(`{"properties":{"name":{"type":"string"}},"type":"object"}`)
+			},
+			want: []ExtensionDriftEntry{},
+		},
+		{
+			name: "extension fields changed",
+			snapshot: func(s *ExtensionSnapshot) {
+				s.Extensions[0].Description = "promoted"
+				s.Extensions[0].Enabled = false
+			},
+			want: []ExtensionDriftEntry{
+				{Slug: "test-extension", Drift: ExtensionDriftChanged, Fields: []string{"description", "enabled"}, ERDs: []ExtensionDriftERDEntry{}},
+			},
+		},
+		{
+			name: "missing and extra ERD versions",
+			snapshot: func(s *ExtensionSnapshot) {
+				s.Extensions[0].ERDs[0].Version = "v2"
+			},
+			want: []ExtensionDriftEntry{
+				{Slug: "test-extension", ERDs: []ExtensionDriftERDEntry{
+					{SlugSingular: "thing", Version: "v1", Drift: ExtensionDriftExtra},
+					{SlugSingular: "thing", Version: "v2", Drift: ExtensionDriftMissing},
+				}},
+			},
+		},
+		{
+			name: "missing and extra extensions",
+			snapshot: func(s *ExtensionSnapshot) {
+				s.Extensions[0].Slug = "other-extension"
+			},
+			want: []ExtensionDriftEntry{
+				{Slug: "other-extension", Drift: ExtensionDriftMissing, ERDs: []ExtensionDriftERDEntry{
+					{SlugSingular: "thing", Version: "v1", Drift: ExtensionDriftMissing},
+				}},
+				{Slug: "test-extension", Drift: ExtensionDriftExtra, ERDs: []ExtensionDriftERDEntry{
+					{SlugSingular: "thing", Version: "v1", Drift: ExtensionDriftExtra},
+				}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot := testExtensionSnapshot()
+			tt.snapshot(snapshot)
+
+			drift, err := compareExtensionSnapshots(testExtensionSnapshot(), snapshot)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, drift.Extensions)
+			assert.Equal(t, len(tt.want) == 0, drift.InSync)
+		})
+	}
+}
+
+func TestCompareExtensionSnapshotsSchemaDrift(t *testing.T) {
+	snapshot := testExtensionSnapshot()
+	snapshot.Extensions[0].ERDs[0].Scope = "user"
+	snapshot.Extensions[0].ERDs[0].Schema = json.RawMessage(`{"type": "object", "properties": {"name": {"type": "string"}, "size": {"type": "integer"}}}`)
+
+	drift, err := compareExtensionSnapshots(testExtensionSnapshot(), snapshot)
+	require.NoError(t, err)
+	require.Len(t, drift.Extensions, 1)
+	require.Len(t, drift.Extensions[0].ERDs, 1)
+
+	erd := drift.Extensions[0].ERDs[0]
+	assert.Equal(t, ExtensionDriftChanged, erd.Drift)
+	assert.Equal(t, []string{"scope", "schema"}, erd.Fields)
+	require.NotNil(t, erd.SchemaDiff)
+	assert.Equal(t, []string{"size"}, erd.SchemaDiff.Added)
+}
+
+func TestDiffExtensionSnapshotValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		fields []string
+	}{
+		{
+			name:   "missing extension slug",
+			body:   `{"extensions": [{"name": "Test"}]}`,
+			fields: []string{"extensions[0].slug"},
+		},
+		{
+			name:   "duplicated extension",
+			body:   `{"extensions": [{"slug": "test"}, {"slug": "test"}]}`,
+			fields: []string{"extensions[1].slug"},
+		},
+		{
+			name:   "ERD without version",
+			body:   `{"extensions": [{"slug": "test", "erds": [{"slug_singular": "thing", "schema": {}}]}]}`,
+			fields: []string{"extensions[0].erds[0]"},
+		},
+		{
+			name:   "duplicated ERD",
+			body:   `{"extensions": [{"slug": "test", "erds": [{"slug_singular": "thing", "version": "v1", "schema": {}}, {"slug_singular": "thing", "version": "v1", "schema": {}}]}]}`,
+			fields: []string{"extensions[0].erds[1]"},
+		},
+		{
+			name:   "ERD without schema",
+			body:   `{"extensions": [{"slug": "test", "erds": [{"slug_singular": "thing", "version": "v1"}]}]}`,
+			fields: []string{"extensions[0].erds[0].schema"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Router{}
+
+			engine := gin.New()
+			engine.POST("/extensions/snapshot/diff", r.diffExtensionSnapshot)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/extensions/snapshot/diff", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			resp := ErrorResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, ErrCodeValidationFailed, resp.Code)
+
+			fields := []string{}
+			for _, d := range resp.Details {
+				fields = append(fields, d.Field)
+			}
+
+			assert.Equal(t, tt.fields, fields)
+		})
+	}
+}
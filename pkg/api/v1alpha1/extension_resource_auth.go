@@ -89,7 +89,7 @@ func (r *Router) mwSystemExtensionResourceGroupAuth(c *gin.Context) {
 	)
 	if err != nil {
 		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
-			sendError(c, http.StatusNotFound, err.Error())
+			sendErrorFromErr(c, http.StatusNotFound, err)
 			return
 		}
 
@@ -101,16 +101,22 @@ func (r *Router) mwSystemExtensionResourceGroupAuth(c *gin.Context) {
 	setCtxExtension(c, ext)
 	setCtxERD(c, erd)
 
-	// if user is not gov-admin and there's no admin group set for the ERD
-	if !erd.AdminGroup.Valid || erd.AdminGroup.String == "" {
+	// members of the ERD admin group can change every resource, members of the
+	// owner group of a resource can change that resource
+	allowedGroups, err := r.systemExtensionResourceGroups(c.Request.Context(), erd, c.Param("resource-id"))
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error finding extension resource: "+err.Error())
+		return
+	}
+
+	// if user is not gov-admin and there's no group allowed to change the resource
+	if len(allowedGroups) == 0 {
 		sendError(c, http.StatusForbidden, "user do not have permissions to access this resource")
 
 		return
 	}
 
-	adminGroupID := erd.AdminGroup.String
-
-	// check if user is part of the admin group
+	// check if user is part of an allowed group
 	enumeratedMemberships, err := dbtools.GetMembershipsForUser(c.Request.Context(), r.DB.DB, user.ID, false)
 	if err != nil {
 		sendError(c, http.StatusInternalServerError, "error getting enumerated groups: "+err.Error())
@@ -118,7 +124,7 @@ func (r *Router) mwSystemExtensionResourceGroupAuth(c *gin.Context) {
 	}
 
 	for _, m := range enumeratedMemberships {
-		if m.GroupID == adminGroupID {
+		if allowedGroups[m.GroupID] {
 			return
 		}
 	}
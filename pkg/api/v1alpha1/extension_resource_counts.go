@@ -0,0 +1,57 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+)
+
+// extensionResourcesQuery is the query of an extension resources listing, its
+// count and existence check
+type extensionResourcesQuery struct {
+	// asOf is the time of the listed resources, the current resources are
+	// listed when it is nil
+	asOf *time.Time
+	// qms filter the current resources
+	qms []qm.QueryMod
+	// filters filter the resources as of asOf
+	filters dbtools.ExtensionResourceFilters
+}
+
+// ExtensionResourceCount is the number of extension resources matching the
+// filters of a listing
+type ExtensionResourceCount struct {
+	Count int64 `json:"count"`
+}
+
+// sendExtensionResourcesCount responds with the number of extension resources
+// matching the filters of a listing
+func sendExtensionResourcesCount(c *gin.Context, count int64, err error) {
+	if err != nil {
+		sendError(c, http.StatusBadRequest, "error counting extension resources: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, ExtensionResourceCount{Count: count})
+}
+
+// sendExtensionResourcesExist responds to a HEAD request on a listing with a
+// 200 when an extension resource matches its filters, and a 204 otherwise, so
+// it isn't mistaken for a 404 of a missing extension or ERD
+func sendExtensionResourcesExist(c *gin.Context, exists bool, err error) {
+	if err != nil {
+		sendError(c, http.StatusBadRequest, "error finding extension resources: "+err.Error())
+		return
+	}
+
+	if !exists {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,51 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendExtensionResourcesExist(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		exists bool
+		err    error
+		want   int
+	}{
+		{name: "match", exists: true, want: http.StatusOK},
+		{name: "no match", want: http.StatusNoContent},
+		{name: "error", err: sql.ErrConnDone, want: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			engine := gin.New()
+			engine.HEAD("/resources", func(c *gin.Context) {
+				sendExtensionResourcesExist(c, tt.exists, tt.err)
+			})
+
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodHead, "/resources", nil))
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+}
+
+func TestSendExtensionResourcesCount(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	sendExtensionResourcesCount(c, 3, nil)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"count": 3}`, w.Body.String())
+}
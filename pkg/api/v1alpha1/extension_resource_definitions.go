@@ -1,6 +1,8 @@
 package v1alpha1
 
 import (
+	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,14 +11,16 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"github.com/metal-toolbox/auditevent/ginaudit"
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/erdstate"
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
 	"github.com/metal-toolbox/governor-api/pkg/jsonschema"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
 )
 
 // ExtensionResourceDefinition is the extension resource definition response
@@ -39,6 +43,19 @@ const (
 	ExtensionResourceDefinitionScopeSys ExtensionResourceDefinitionScope = "system"
 )
 
+// ExtensionResourceDefinitionOwnerPolicy is an enum type for the owner group
+// given to new system resources of an ERD
+type ExtensionResourceDefinitionOwnerPolicy string
+
+const (
+	// ExtensionResourceDefinitionOwnerPolicyNone leaves new resources unowned
+	ExtensionResourceDefinitionOwnerPolicyNone ExtensionResourceDefinitionOwnerPolicy = "none"
+	// ExtensionResourceDefinitionOwnerPolicyCreatorGroup gives new resources to a group of their creator
+	ExtensionResourceDefinitionOwnerPolicyCreatorGroup ExtensionResourceDefinitionOwnerPolicy = "creator_group"
+	// ExtensionResourceDefinitionOwnerPolicyGroup gives new resources to the default owner group of the ERD
+	ExtensionResourceDefinitionOwnerPolicyGroup ExtensionResourceDefinitionOwnerPolicy = "group"
+)
+
 // ExtensionResourceDefinitionReq is a request to create an extension resource definition
 type ExtensionResourceDefinitionReq struct {
 	Name         string                           `json:"name"`
@@ -48,8 +65,68 @@ type ExtensionResourceDefinitionReq struct {
 	Version      string                           `json:"version"`
 	Scope        ExtensionResourceDefinitionScope `json:"scope"`
 	Schema       json.RawMessage                  `json:"schema"`
-	Enabled      *bool                            `json:"enabled"`
-	AdminGroup   string                           `json:"admin_group"`
+	// Enabled is deprecated, use State. Enabling a definition activates it and
+	// disabling it disables it.
+	Enabled    *bool  `json:"enabled"`
+	State      string `json:"state,omitempty"`
+	AdminGroup string `json:"admin_group"`
+	// DefaultOwnerPolicy sets the owner group of new system resources, with the
+	// group policy DefaultOwnerGroup is the group id or slug
+	DefaultOwnerPolicy ExtensionResourceDefinitionOwnerPolicy `json:"default_owner_policy,omitempty"`
+	DefaultOwnerGroup  string                                 `json:"default_owner_group,omitempty"`
+}
+
+// ExtensionResourceDefinitionSchemaDiff is the difference between the schemas
+// of two versions of an extension resource definition
+type ExtensionResourceDefinitionSchemaDiff struct {
+	FromVersion string                 `json:"from_version"`
+	ToVersion   string                 `json:"to_version"`
+	Diff        *jsonschema.SchemaDiff `json:"diff"`
+}
+
+// ExtensionResourceDefinitionSchemaValidationReq is a request to check a
+// proposed ERD schema, optionally against an existing ERD and its resources.
+// ERD is the id of the existing ERD, or its singular slug with Version.
+type ExtensionResourceDefinitionSchemaValidationReq struct {
+	Schema  json.RawMessage `json:"schema" binding:"required"`
+	ERD     string          `json:"erd,omitempty"`
+	Version string          `json:"version,omitempty"`
+}
+
+// ExtensionResourceDefinitionSchemaValidation is the result of checking a
+// proposed ERD schema
+type ExtensionResourceDefinitionSchemaValidation struct {
+	Valid         bool                                            `json:"valid"`
+	Issues        []jsonschema.LintIssue                          `json:"issues"`
+	Compatibility *ExtensionResourceDefinitionSchemaCompatibility `json:"compatibility,omitempty"`
+}
+
+// ExtensionResourceDefinitionSchemaCompatibility reports whether the resources
+// stored for an ERD are still valid with a proposed schema
+type ExtensionResourceDefinitionSchemaCompatibility struct {
+	ERDID        string                             `json:"erd_id"`
+	Version      string                             `json:"version"`
+	Compatible   bool                               `json:"compatible"`
+	Checked      int                                `json:"checked"`
+	Incompatible []ExtensionResourceSchemaViolation `json:"incompatible"`
+	Diff         *jsonschema.SchemaDiff             `json:"diff,omitempty"`
+}
+
+// ExtensionResourceSchemaViolation is a stored resource the proposed schema
+// rejects
+type ExtensionResourceSchemaViolation struct {
+	ID    string `json:"id"`
+	Error string `json:"error"`
+}
+
+// maxSchemaViolations caps the incompatible resources listed in a schema
+// compatibility report, all of them are still counted in Checked
+const maxSchemaViolations = 100
+
+// ExtensionResourceDefinitionStateReq is a request to move an extension
+// resource definition to another lifecycle state
+type ExtensionResourceDefinitionStateReq struct {
+	State string `json:"state" binding:"required"`
 }
 
 func isValidSlug(s string) bool {
@@ -137,7 +214,7 @@ func (r *Router) listExtensionResourceDefinitions(c *gin.Context) {
 	))
 	if err != nil {
 		if errors.Is(err, ErrExtensionNotFound) {
-			sendError(c, http.StatusNotFound, "extension not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeExtensionNotFound, "extension not found: "+err.Error())
 			return
 		}
 
@@ -154,18 +231,21 @@ func (r *Router) createExtensionResourceDefinition(c *gin.Context) {
 	extensionID := c.Param("eid")
 
 	req := &ExtensionResourceDefinitionReq{}
-	if err := c.BindJSON(req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, req) {
 		return
 	}
 
-	if req.Name == "" {
-		sendError(c, http.StatusBadRequest, "ERD name is required")
+	if req.SlugSingular == "" || req.SlugPlural == "" {
+		sendError(c, http.StatusBadRequest, "ERD slugs are required")
 		return
 	}
 
-	if req.SlugSingular == "" || req.SlugPlural == "" {
-		sendError(c, http.StatusBadRequest, "ERD slugs are required")
+	if !validateFields(c,
+		fieldRule{"name", req.Name, "required"},
+		fieldRule{"version", req.Version, "required"},
+		fieldRule{"scope", req.Scope, "required"},
+		fieldRule{"schema", string(req.Schema), "required"},
+	) {
 		return
 	}
 
@@ -174,29 +254,30 @@ func (r *Router) createExtensionResourceDefinition(c *gin.Context) {
 		return
 	}
 
-	if req.Version == "" {
-		sendError(c, http.StatusBadRequest, "ERD version is required")
+	if req.Scope != ExtensionResourceDefinitionScopeUser && req.Scope != ExtensionResourceDefinitionScopeSys {
+		sendError(c, http.StatusBadRequest, `invalid ERD scope, "system" or "user"`)
 		return
 	}
 
-	if req.Enabled == nil {
-		sendError(c, http.StatusBadRequest, "ERD enabled is required")
-		return
-	}
+	// new definitions are drafts unless they are given a state, or enabled
+	// with the deprecated flag
+	state := erdstate.Draft
 
-	if req.Scope == "" {
-		sendError(c, http.StatusBadRequest, "ERD scope is required")
-		return
-	}
+	switch {
+	case req.State != "":
+		var err error
 
-	if req.Scope != ExtensionResourceDefinitionScopeUser && req.Scope != ExtensionResourceDefinitionScopeSys {
-		sendError(c, http.StatusBadRequest, `invalid ERD scope, "system" or "user"`)
-		return
-	}
+		if state, err = erdstate.Parse(req.State); err != nil {
+			sendErrorFromErr(c, http.StatusBadRequest, err)
+			return
+		}
 
-	if string(req.Schema) == "" {
-		sendError(c, http.StatusBadRequest, "ERD schema is required")
-		return
+		if !state.Initial() {
+			sendError(c, http.StatusBadRequest, "ERDs can't be created "+string(state))
+			return
+		}
+	case req.Enabled != nil:
+		state = erdstate.FromEnabled(*req.Enabled)
 	}
 
 	// user may choose to upload the schema as an escaped JSON string, here uses
@@ -223,6 +304,12 @@ func (r *Router) createExtensionResourceDefinition(c *gin.Context) {
 		return
 	}
 
+	indexed, err := jsonschema.IndexHints([]byte(schema))
+	if err != nil {
+		sendError(c, http.StatusBadRequest, "ERD schema is not valid: "+err.Error())
+		return
+	}
+
 	erd := &models.ExtensionResourceDefinition{
 		Name:         req.Name,
 		SlugSingular: req.SlugSingular,
@@ -231,10 +318,15 @@ func (r *Router) createExtensionResourceDefinition(c *gin.Context) {
 		Description:  req.Description,
 		Scope:        string(req.Scope),
 		Schema:       []byte(schema),
-		Enabled:      *req.Enabled,
+		Enabled:      state.Enabled(),
+		State:        string(state),
 		AdminGroup:   null.NewString(req.AdminGroup, req.AdminGroup != ""),
 	}
 
+	if !r.setERDDefaultOwner(c, erd, req) {
+		return
+	}
+
 	var extensionQM qm.QueryMod
 
 	if _, err := uuid.Parse(extensionID); err != nil {
@@ -246,7 +338,7 @@ func (r *Router) createExtensionResourceDefinition(c *gin.Context) {
 	extension, err := fetchExtension(c, r.DB, extensionQM)
 	if err != nil {
 		if errors.Is(err, ErrExtensionNotFound) {
-			sendError(c, http.StatusNotFound, "extension not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeExtensionNotFound, "extension not found: "+err.Error())
 			return
 		}
 
@@ -255,94 +347,268 @@ func (r *Router) createExtensionResourceDefinition(c *gin.Context) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting ERD create transaction: "+err.Error())
+	if !r.setERDSchemaDiff(c, extension, erd) {
 		return
 	}
 
-	if err := extension.AddExtensionResourceDefinitions(c.Request.Context(), tx, true, erd); err != nil {
-		msg := fmt.Sprintf("error creating ERD: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := extension.AddExtensionResourceDefinitions(c.Request.Context(), tx, true, erd); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error creating ERD: %s", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		if err := r.recordChange(c, tx, events.GovernorExtensionResourceDefinitionsEventSubject, &events.Event{
+			Action:                        events.GovernorEventCreate,
+			ExtensionID:                   extension.ID,
+			ExtensionResourceDefinitionID: erd.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditExtensionResourceDefinitionCreated(ctx, exec, pID, actor, erd)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error creating ERD (audit): %s", err.Error()), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
-	event, err := dbtools.AuditExtensionResourceDefinitionCreated(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		erd,
+	r.publishChanges(c)
+
+	// creating the indexes is a schema change, it can't share the transaction
+	// of the ERD. The ERD works without them and the missing indexes are
+	// created by the next index sync.
+	if err := dbtools.CreateExtensionResourceIndexes(c.Request.Context(), r.DB, erd, indexed); err != nil {
+		r.Logger.Error("error creating ERD resource indexes", zap.String("erd_id", erd.ID), zap.Error(err))
+		warnChange(c, "the resource indexes of the ERD weren't created yet", err)
+	}
+
+	c.JSON(http.StatusAccepted, erd)
+}
+
+// getExtensionResourceDefinitionSchemaDiff returns the schema diff of an ERD
+// with the version it replaced, or with the version in the from query param
+func (r *Router) getExtensionResourceDefinitionSchemaDiff(c *gin.Context) {
+	extension, erd, err := findERD(
+		c, r.DB,
+		c.Param("eid"), c.Param("erd-id-slug"), c.Param("erd-version"), false,
 	)
 	if err != nil {
-		msg := fmt.Sprintf("error creating ERD (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
+			sendErrorFromErr(c, http.StatusNotFound, err)
+			return
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		sendError(c, http.StatusBadRequest, err.Error())
 
 		return
 	}
 
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error creating ERD: %s", err.Error())
+	resp := ExtensionResourceDefinitionSchemaDiff{ToVersion: erd.Version}
+
+	from := c.Query("from")
+
+	switch {
+	case from != "":
+		previous, err := models.ExtensionResourceDefinitions(
+			qm.Where("extension_id = ?", extension.ID),
+			qm.Where("slug_singular = ?", erd.SlugSingular),
+			qm.Where("version = ?", from),
+		).One(c.Request.Context(), r.DB)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				sendErrorWithCode(c, http.StatusNotFound, ErrCodeERDNotFound, "ERD version not found: "+from)
+				return
+			}
+
+			sendError(c, http.StatusInternalServerError, "error getting ERD version: "+err.Error())
+
+			return
+		}
+
+		if resp.Diff, err = jsonschema.Diff(previous.Schema, erd.Schema); err != nil {
+			sendError(c, http.StatusInternalServerError, "error comparing ERD schemas: "+err.Error())
+			return
+		}
 
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+		resp.FromVersion = previous.Version
+	case erd.SchemaDiff.Valid:
+		resp.Diff = &jsonschema.SchemaDiff{}
+		if err := json.Unmarshal(erd.SchemaDiff.JSON, resp.Diff); err != nil {
+			sendError(c, http.StatusInternalServerError, "error decoding ERD schema diff: "+err.Error())
+			return
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		resp.FromVersion = erd.PreviousVersion.String
+	default:
+		sendErrorWithCode(c, http.StatusNotFound, ErrCodeERDNotFound, "ERD doesn't replace a previous version, compare it with another version with the from query param")
+		return
+	}
 
+	c.JSON(http.StatusOK, resp)
+}
+
+// validateExtensionResourceDefinitionSchema lints a proposed ERD schema and,
+// given an existing ERD, checks the ERD's resources against it
+func (r *Router) validateExtensionResourceDefinitionSchema(c *gin.Context) {
+	req := &ExtensionResourceDefinitionSchemaValidationReq{}
+	if !bindRequest(c, req) {
 		return
 	}
 
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing ERD create: %s", err.Error())
+	// the schema may be an escaped JSON string, like when creating an ERD
+	var schema string
+	if err := json.Unmarshal(req.Schema, &schema); err != nil {
+		schema = string(req.Schema)
+	}
+
+	lint := jsonschema.Lint([]byte(schema))
+
+	resp := ExtensionResourceDefinitionSchemaValidation{
+		Valid:  lint.Valid(),
+		Issues: lint.Issues,
+	}
+
+	if req.ERD == "" || !resp.Valid {
+		c.JSON(http.StatusOK, resp)
+		return
+	}
 
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	extension, erd, err := findERD(c, r.DB, c.Param("eid"), req.ERD, req.Version, false)
+	if err != nil {
+		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
+			sendErrorFromErr(c, http.StatusNotFound, err)
+			return
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		sendError(c, http.StatusBadRequest, err.Error())
 
 		return
 	}
 
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		events.GovernorExtensionResourceDefinitionsEventSubject,
-		&events.Event{
-			Version:                       events.Version,
-			Action:                        events.GovernorEventCreate,
-			AuditID:                       c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:                       getCtxActorID(c),
-			ExtensionID:                   extension.ID,
-			ExtensionResourceDefinitionID: erd.ID,
-		},
+	compat, ok := r.checkERDSchemaCompatibility(c, extension, erd, schema)
+	if !ok {
+		return
+	}
+
+	resp.Compatibility = compat
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// checkERDSchemaCompatibility validates the resources of an ERD with a
+// proposed schema. Unique constraints aren't checked, the resources would be
+// compared with themselves.
+func (r *Router) checkERDSchemaCompatibility(
+	c *gin.Context, extension *models.Extension, erd *models.ExtensionResourceDefinition, schema string,
+) (*ExtensionResourceDefinitionSchemaCompatibility, bool) {
+	compiler := jsonschema.NewCompiler(
+		extension.Slug, erd.SlugPlural, erd.Version,
+		jsonschema.WithUniqueConstraint(c.Request.Context(), erd, nil, nil),
 	)
+
+	compiled, err := compiler.Compile(schema)
 	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish extension create event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
+		sendError(c, http.StatusBadRequest, "ERD schema is not valid: "+err.Error())
+		return nil, false
+	}
 
-		return
+	type storedResource struct {
+		id       string
+		resource []byte
 	}
 
-	c.JSON(http.StatusAccepted, erd)
+	resources := []storedResource{}
+	byERD := qm.Where("extension_resource_definition_id = ?", erd.ID)
+
+	if erd.Scope == ExtensionResourceDefinitionScopeSys.String() {
+		sys, err := models.SystemExtensionResources(byERD).All(c.Request.Context(), r.DB)
+		if err != nil {
+			sendError(c, http.StatusInternalServerError, "error getting ERD resources: "+err.Error())
+			return nil, false
+		}
+
+		for _, res := range sys {
+			resources = append(resources, storedResource{res.ID, res.Resource})
+		}
+	} else {
+		usr, err := models.UserExtensionResources(byERD).All(c.Request.Context(), r.DB)
+		if err != nil {
+			sendError(c, http.StatusInternalServerError, "error getting ERD resources: "+err.Error())
+			return nil, false
+		}
+
+		for _, res := range usr {
+			resources = append(resources, storedResource{res.ID, res.Resource})
+		}
+	}
+
+	compat := &ExtensionResourceDefinitionSchemaCompatibility{
+		ERDID:        erd.ID,
+		Version:      erd.Version,
+		Compatible:   true,
+		Checked:      len(resources),
+		Incompatible: []ExtensionResourceSchemaViolation{},
+	}
+
+	for _, res := range resources {
+		var v interface{}
+		if err = json.Unmarshal(res.resource, &v); err == nil {
+			err = compiled.Validate(v)
+		}
+
+		if err == nil {
+			continue
+		}
+
+		compat.Compatible = false
+
+		if len(compat.Incompatible) < maxSchemaViolations {
+			compat.Incompatible = append(compat.Incompatible, ExtensionResourceSchemaViolation{ID: res.id, Error: err.Error()})
+		}
+	}
+
+	if compat.Diff, err = jsonschema.Diff(erd.Schema, []byte(schema)); err != nil {
+		sendError(c, http.StatusInternalServerError, "error comparing ERD schemas: "+err.Error())
+		return nil, false
+	}
+
+	return compat, true
+}
+
+// setERDSchemaDiff records the schema changes of a new version of an ERD,
+// compared to the latest previous version with the same slug in the extension
+func (r *Router) setERDSchemaDiff(c *gin.Context, extension *models.Extension, erd *models.ExtensionResourceDefinition) bool {
+	previous, err := models.ExtensionResourceDefinitions(
+		qm.Where("extension_id = ?", extension.ID),
+		qm.Where("slug_singular = ?", erd.SlugSingular),
+		qm.Where("version != ?", erd.Version),
+		qm.OrderBy("created_at DESC"),
+	).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return true
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting previous ERD version: "+err.Error())
+
+		return false
+	}
+
+	diff, err := jsonschema.Diff(previous.Schema, erd.Schema)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error comparing ERD schemas: "+err.Error())
+		return false
+	}
+
+	encoded, err := json.Marshal(diff)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error encoding ERD schema diff: "+err.Error())
+		return false
+	}
+
+	erd.PreviousVersion = null.StringFrom(previous.Version)
+	erd.SchemaDiff = null.JSONFrom(encoded)
+
+	return true
 }
 
 // getExtensionResourceDefinition fetch a extension from DB with given id
@@ -358,7 +624,7 @@ func (r *Router) getExtensionResourceDefinition(c *gin.Context) {
 	)
 	if err != nil {
 		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
-			sendError(c, http.StatusNotFound, err.Error())
+			sendErrorFromErr(c, http.StatusNotFound, err)
 			return
 		}
 
@@ -382,7 +648,7 @@ func (r *Router) deleteExtensionResourceDefinition(c *gin.Context) {
 	)
 	if err != nil {
 		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
-			sendError(c, http.StatusNotFound, err.Error())
+			sendErrorFromErr(c, http.StatusNotFound, err)
 			return
 		}
 
@@ -391,91 +657,34 @@ func (r *Router) deleteExtensionResourceDefinition(c *gin.Context) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting delete transaction: "+err.Error())
-		return
-	}
-
-	if _, err := erd.Delete(c.Request.Context(), tx, false); err != nil {
-		msg := fmt.Sprintf("error deleting ERD: %s. rolling back\n", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditExtensionResourceDefinitionDeleted(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		erd,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error deleting ERD (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error deleting ERD: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := erd.Delete(c.Request.Context(), tx, false); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error deleting ERD: %s. rolling back\n", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing ERD delete: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+		if err := r.recordChange(c, tx, events.GovernorExtensionResourceDefinitionsEventSubject, &events.Event{
+			Action:                        events.GovernorEventDelete,
+			ExtensionID:                   extension.ID,
+			ExtensionResourceDefinitionID: erd.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditExtensionResourceDefinitionDeleted(ctx, exec, pID, actor, erd)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error deleting ERD (audit): %s", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		events.GovernorExtensionResourceDefinitionsEventSubject,
-		&events.Event{
-			Version:                       events.Version,
-			Action:                        events.GovernorEventDelete,
-			AuditID:                       c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:                       getCtxActorID(c),
-			ExtensionID:                   extension.ID,
-			ExtensionResourceDefinitionID: erd.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish extension delete event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
+	r.publishChanges(c)
 
-		return
+	// the indexes are dropped once the ERD is deleted, the ones that can't be
+	// dropped are dropped by the next index sync
+	indexed, _ := jsonschema.IndexHints(erd.Schema)
+	if err := dbtools.DropExtensionResourceIndexes(c.Request.Context(), r.DB, erd, indexed); err != nil {
+		r.Logger.Error("error dropping ERD resource indexes", zap.String("erd_id", erd.ID), zap.Error(err))
+		warnChange(c, "the resource indexes of the ERD weren't dropped yet", err)
 	}
 
 	c.JSON(http.StatusAccepted, extension)
@@ -494,7 +703,7 @@ func (r *Router) updateExtensionResourceDefinition(c *gin.Context) {
 	)
 	if err != nil {
 		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
-			sendError(c, http.StatusNotFound, err.Error())
+			sendErrorFromErr(c, http.StatusNotFound, err)
 			return
 		}
 
@@ -504,8 +713,7 @@ func (r *Router) updateExtensionResourceDefinition(c *gin.Context) {
 	}
 
 	req := &ExtensionResourceDefinitionReq{}
-	if err := c.BindJSON(req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, req) {
 		return
 	}
 
@@ -539,99 +747,166 @@ func (r *Router) updateExtensionResourceDefinition(c *gin.Context) {
 		erd.Description = req.Description
 	}
 
-	if req.Enabled != nil {
-		erd.Enabled = *req.Enabled
+	state, ok := erdTargetState(c, erd, req)
+	if !ok {
+		return
 	}
 
+	erd.State = string(state)
+	erd.Enabled = state.Enabled()
+
 	erd.AdminGroup = null.NewString(req.AdminGroup, req.AdminGroup != "")
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting update transaction: "+err.Error())
+	if req.DefaultOwnerPolicy != "" && !r.setERDDefaultOwner(c, erd, req) {
 		return
 	}
 
-	if _, err := erd.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := fmt.Sprintf("error updating erd: %s. rolling back\n", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
+	r.saveExtensionResourceDefinition(c, extension, &original, erd)
+}
 
+// updateExtensionResourceDefinitionState moves an extension resource
+// definition to another lifecycle state
+func (r *Router) updateExtensionResourceDefinitionState(c *gin.Context) {
+	req := &ExtensionResourceDefinitionStateReq{}
+	if !bindRequest(c, req) {
 		return
 	}
 
-	event, err := dbtools.AuditExtensionResourceDefinitionUpdated(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		&original,
-		erd,
+	extension, erd, err := findERD(
+		c, r.DB,
+		c.Param("eid"), c.Param("erd-id-slug"), c.Param("erd-version"), false,
 	)
 	if err != nil {
-		msg := fmt.Sprintf("error updating ERD (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
+			sendErrorFromErr(c, http.StatusNotFound, err)
+			return
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		sendError(c, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	original := *erd
 
+	state, ok := erdTargetState(c, erd, &ExtensionResourceDefinitionReq{State: req.State})
+	if !ok {
 		return
 	}
 
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error updating ERD: %s", err.Error())
+	erd.State = string(state)
+	erd.Enabled = state.Enabled()
 
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	r.saveExtensionResourceDefinition(c, extension, &original, erd)
+}
+
+// setERDDefaultOwner validates the default owner policy of a request and sets
+// it on the ERD, only system resources have owners
+func (r *Router) setERDDefaultOwner(c *gin.Context, erd *models.ExtensionResourceDefinition, req *ExtensionResourceDefinitionReq) bool {
+	policy := req.DefaultOwnerPolicy
+	if policy == "" {
+		policy = ExtensionResourceDefinitionOwnerPolicyNone
+	}
+
+	switch policy {
+	case ExtensionResourceDefinitionOwnerPolicyNone, ExtensionResourceDefinitionOwnerPolicyCreatorGroup:
+		if req.DefaultOwnerGroup != "" {
+			sendError(c, http.StatusBadRequest, "default_owner_group is only allowed with the group owner policy")
+			return false
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		erd.DefaultOwnerGroup = null.String{}
+	case ExtensionResourceDefinitionOwnerPolicyGroup:
+		if !validateFields(c, fieldRule{"default_owner_group", req.DefaultOwnerGroup, "required"}) {
+			return false
+		}
 
-		return
+		group, err := r.svc().FindGroup(c.Request.Context(), req.DefaultOwnerGroup, false)
+		if err != nil {
+			if errors.Is(err, service.ErrGroupNotFound) {
+				sendErrorWithCode(c, http.StatusBadRequest, ErrCodeGroupNotFound, "default owner group not found: "+err.Error())
+				return false
+			}
+
+			sendError(c, http.StatusInternalServerError, "error getting default owner group: "+err.Error())
+
+			return false
+		}
+
+		erd.DefaultOwnerGroup = null.StringFrom(group.ID)
+	default:
+		sendError(c, http.StatusBadRequest, `invalid ERD default owner policy, "none", "creator_group" or "group"`)
+		return false
 	}
 
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing ERD update: %s", err.Error())
+	if policy != ExtensionResourceDefinitionOwnerPolicyNone && erd.Scope != ExtensionResourceDefinitionScopeSys.String() {
+		sendError(c, http.StatusBadRequest, "default owner policies are only allowed on system scoped ERDs")
+		return false
+	}
+
+	erd.DefaultOwnerPolicy = string(policy)
+
+	return true
+}
 
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+// erdTargetState returns the state an update request moves a definition to.
+// Without a state, the deprecated enabled flag activates or disables it.
+func erdTargetState(c *gin.Context, erd *models.ExtensionResourceDefinition, req *ExtensionResourceDefinitionReq) (erdstate.State, bool) {
+	current := erdstate.State(erd.State)
+	target := current
+
+	switch {
+	case req.State != "":
+		var err error
+
+		if target, err = erdstate.Parse(req.State); err != nil {
+			sendErrorFromErr(c, http.StatusBadRequest, err)
+			return "", false
 		}
+	case req.Enabled != nil && *req.Enabled != current.Enabled():
+		target = erdstate.FromEnabled(*req.Enabled)
+	}
 
-		sendError(c, http.StatusBadRequest, msg)
+	if err := erdstate.Transition(current, target); err != nil {
+		sendErrorFromErr(c, http.StatusConflict, err)
+		return "", false
+	}
 
-		return
+	return target, true
+}
+
+// saveExtensionResourceDefinition stores and audits the changes to an
+// extension resource definition, and publishes them. Lifecycle state changes
+// are published as transition events.
+func (r *Router) saveExtensionResourceDefinition(
+	c *gin.Context, extension *models.Extension, original, erd *models.ExtensionResourceDefinition,
+) {
+	action := events.GovernorEventUpdate
+	if erd.State != original.State {
+		action = events.GovernorEventTransition
 	}
 
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		events.GovernorExtensionResourceDefinitionsEventSubject,
-		&events.Event{
-			Version:                       events.Version,
-			Action:                        events.GovernorEventUpdate,
-			AuditID:                       c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:                       getCtxActorID(c),
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := erd.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error updating erd: %s. rolling back\n", err.Error()), err)
+		}
+
+		if err := r.recordChange(c, tx, events.GovernorExtensionResourceDefinitionsEventSubject, &events.Event{
+			Action:                        action,
 			ExtensionID:                   extension.ID,
 			ExtensionResourceDefinitionID: erd.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish extension update event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditExtensionResourceDefinitionUpdated(ctx, exec, pID, actor, original, erd)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error updating ERD (audit): %s", err.Error()), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
+	r.publishChanges(c)
+
 	c.JSON(http.StatusAccepted, erd)
 }
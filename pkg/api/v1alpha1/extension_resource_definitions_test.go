@@ -16,6 +16,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/metal-toolbox/auditevent/ginaudit"
 	dbm "github.com/metal-toolbox/governor-api/db"
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/eventbus"
 	"github.com/metal-toolbox/governor-api/internal/models"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
@@ -160,6 +161,8 @@ func (s *ExtensionResourceDefinitionsTestSuite) TestCreateExtensionResourceDefin
 		expectedErrMsg       string
 		expectedEventSubject string
 		expectedEventPayload *events.Event
+		// expectedIndexes are the fields of the ERD resources that are indexed
+		expectedIndexes []string
 	}{
 		{
 			name:                 "ok",
@@ -273,6 +276,70 @@ func (s *ExtensionResourceDefinitionsTestSuite) TestCreateExtensionResourceDefin
 				Enabled:      true,
 			}},
 		},
+		{
+			name:                 "index hints",
+			url:                  "/api/v1alpha1/extensions/test-extension-2",
+			expectedStatus:       http.StatusAccepted,
+			expectedEventSubject: "events.extension.erds",
+			params: gin.Params{
+				gin.Param{Key: "eid", Value: "test-extension-2"},
+			},
+			expectedEventPayload: &events.Event{
+				Action:      events.GovernorEventCreate,
+				ExtensionID: "00000001-0000-0000-0000-000000000002",
+			},
+			payload: `{
+				"name": "Indexed ERD",
+				"description": "some test",
+				"slug_singular": "indexed-resource",
+				"slug_plural": "indexed-resources",
+				"version": "v1",
+				"schema": {
+					"$schema": "https://json-schema.org/draft/2020-12/schema",
+					"type": "object",
+					"x-governor-index": ["lastName", "serial"],
+					"properties": {
+						"lastName": {"type": "string"},
+						"serial": {"type": "string"}
+					}
+				},
+				"enabled": true,
+				"scope": "system"
+			}`,
+			expectedResp: &ExtensionResourceDefinition{&models.ExtensionResourceDefinition{
+				Name:         "Indexed ERD",
+				Description:  "some test",
+				SlugSingular: "indexed-resource",
+				SlugPlural:   "indexed-resources",
+				Version:      "v1",
+				Scope:        "system",
+				Enabled:      true,
+			}},
+			expectedIndexes: []string{"lastName", "serial"},
+		},
+		{
+			name:           "invalid index hints",
+			url:            "/api/v1alpha1/extensions/test-extension-2",
+			expectedStatus: http.StatusBadRequest,
+			params: gin.Params{
+				gin.Param{Key: "eid", Value: "test-extension-2"},
+			},
+			payload: `{
+				"name": "Indexed ERD",
+				"description": "some test",
+				"slug_singular": "indexed-resource",
+				"slug_plural": "indexed-resources",
+				"version": "v2",
+				"schema": {
+					"type": "object",
+					"x-governor-index": ["nickname"],
+					"properties": {"lastName": {"type": "string"}}
+				},
+				"enabled": true,
+				"scope": "system"
+			}`,
+			expectedErrMsg: "x-governor-index",
+		},
 		{
 			name:           "bad schema",
 			url:            "/api/v1alpha1/extensions/test-extension-2",
@@ -432,7 +499,7 @@ func (s *ExtensionResourceDefinitionsTestSuite) TestCreateExtensionResourceDefin
 			expectedErrMsg: "schema is required",
 		},
 		{
-			name:           "missing enabled",
+			name:           "invalid state",
 			url:            "/api/v1alpha1/extensions/test-extension-2",
 			expectedStatus: http.StatusBadRequest,
 			params: gin.Params{
@@ -444,9 +511,101 @@ func (s *ExtensionResourceDefinitionsTestSuite) TestCreateExtensionResourceDefin
 					"slug_plural": "test-1-resources",
 					"version": "v2",
 					"schema": {},
+					"state": "archived",
 					"scope": "system"
 			}`,
-			expectedErrMsg: "enabled is required",
+			expectedErrMsg: "invalid ERD state",
+		},
+		{
+			name:           "invalid default owner policy",
+			url:            "/api/v1alpha1/extensions/test-extension-2",
+			expectedStatus: http.StatusBadRequest,
+			params: gin.Params{
+				gin.Param{Key: "eid", Value: "test-extension-2"},
+			},
+			payload: `{
+					"name": "Test ERD 1",
+					"slug_singular": "test-1-resource",
+					"slug_plural": "test-1-resources",
+					"version": "v2",
+					"schema": {},
+					"default_owner_policy": "anyone",
+					"scope": "system"
+			}`,
+			expectedErrMsg: "invalid ERD default owner policy",
+		},
+		{
+			name:           "group owner policy without group",
+			url:            "/api/v1alpha1/extensions/test-extension-2",
+			expectedStatus: http.StatusBadRequest,
+			params: gin.Params{
+				gin.Param{Key: "eid", Value: "test-extension-2"},
+			},
+			payload: `{
+					"name": "Test ERD 1",
+					"slug_singular": "test-1-resource",
+					"slug_plural": "test-1-resources",
+					"version": "v2",
+					"schema": {},
+					"default_owner_policy": "group",
+					"scope": "system"
+			}`,
+			expectedErrMsg: "default_owner_group is required",
+		},
+		{
+			name:           "default owner group without group policy",
+			url:            "/api/v1alpha1/extensions/test-extension-2",
+			expectedStatus: http.StatusBadRequest,
+			params: gin.Params{
+				gin.Param{Key: "eid", Value: "test-extension-2"},
+			},
+			payload: `{
+					"name": "Test ERD 1",
+					"slug_singular": "test-1-resource",
+					"slug_plural": "test-1-resources",
+					"version": "v2",
+					"schema": {},
+					"default_owner_policy": "creator_group",
+					"default_owner_group": "some-group",
+					"scope": "system"
+			}`,
+			expectedErrMsg: "default_owner_group is only allowed with the group owner policy",
+		},
+		{
+			name:           "default owner policy on user scope",
+			url:            "/api/v1alpha1/extensions/test-extension-2",
+			expectedStatus: http.StatusBadRequest,
+			params: gin.Params{
+				gin.Param{Key: "eid", Value: "test-extension-2"},
+			},
+			payload: `{
+					"name": "Test ERD 1",
+					"slug_singular": "test-1-resource",
+					"slug_plural": "test-1-resources",
+					"version": "v2",
+					"schema": {},
+					"default_owner_policy": "creator_group",
+					"scope": "user"
+			}`,
+			expectedErrMsg: "default owner policies are only allowed on system scoped ERDs",
+		},
+		{
+			name:           "deprecated initial state",
+			url:            "/api/v1alpha1/extensions/test-extension-2",
+			expectedStatus: http.StatusBadRequest,
+			params: gin.Params{
+				gin.Param{Key: "eid", Value: "test-extension-2"},
+			},
+			payload: `{
+					"name": "Test ERD 1",
+					"slug_singular": "test-1-resource",
+					"slug_plural": "test-1-resources",
+					"version": "v2",
+					"schema": {},
+					"state": "deprecated",
+					"scope": "system"
+			}`,
+			expectedErrMsg: "ERDs can't be created deprecated",
 		},
 		{
 			name:           "missing scope",
@@ -641,6 +800,18 @@ func (s *ExtensionResourceDefinitionsTestSuite) TestCreateExtensionResourceDefin
 				t, erd.ID, event.ExtensionResourceDefinitionID,
 				"Expected event ERD ID to match response ID",
 			)
+
+			for _, f := range tt.expectedIndexes {
+				var count int
+
+				err := s.db.QueryRowContext(
+					context.Background(),
+					"SELECT count(*) FROM [SHOW INDEXES FROM system_extension_resources] WHERE index_name = $1",
+					dbtools.ExtensionResourceIndexName(erd.ID, f),
+				).Scan(&count)
+				assert.Nil(t, err)
+				assert.NotZero(t, count, "Expected an index of %s", f)
+			}
 		})
 	}
 }
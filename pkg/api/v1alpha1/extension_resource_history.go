@@ -0,0 +1,305 @@
+package v1alpha1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// SystemExtensionResourceVersion is a recorded version of a system extension
+// resource, numbered from 1
+type SystemExtensionResourceVersion = models.SystemExtensionResourceVersion
+
+// UserExtensionResourceVersion is a recorded version of a user extension
+// resource, numbered from 1
+type UserExtensionResourceVersion = models.UserExtensionResourceVersion
+
+// asOfParam returns the time of the as_of query parameter, or nil when the
+// current extension resources are requested. It sends a validation error and
+// returns false when as_of isn't an RFC 3339 timestamp.
+func asOfParam(c *gin.Context) (*time.Time, bool) {
+	v, ok := c.GetQuery("as_of")
+	if !ok {
+		return nil, true
+	}
+
+	asOf, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		sendValidationError(c, []ErrorDetail{{Field: "as_of", Message: "as_of must be an RFC 3339 timestamp"}})
+		return nil, false
+	}
+
+	return &asOf, true
+}
+
+// systemExtensionResourceAuditFunc records the audit event of a change of a
+// system extension resource from o to a
+type systemExtensionResourceAuditFunc func(
+	ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, a *models.SystemExtensionResource,
+) (*models.AuditEvent, error)
+
+// userExtensionResourceAuditFunc records the audit event of a change of a user
+// extension resource from o to a
+type userExtensionResourceAuditFunc func(
+	ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, a *models.UserExtensionResource,
+) (*models.AuditEvent, error)
+
+// versionParam returns the version number of the path, it sends a validation
+// error and returns false when it isn't a positive integer
+func versionParam(c *gin.Context) (int64, bool) {
+	n, err := strconv.ParseInt(c.Param("version"), 10, 64)
+	if err != nil || n < 1 {
+		sendValidationError(c, []ErrorDetail{{Field: "version", Message: "version must be a positive integer"}})
+		return 0, false
+	}
+
+	return n, true
+}
+
+// listSystemExtensionResourceVersions lists the recorded versions of a system
+// extension resource, the latest first
+func (r *Router) listSystemExtensionResourceVersions(c *gin.Context) {
+	_, erd, err := findERDForExtensionResource(
+		c, r.DB,
+		c.Param("ex-slug"), c.Param("erd-slug-plural"), c.Param("erd-version"),
+	)
+	if err != nil {
+		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
+			sendErrorFromErr(c, http.StatusNotFound, err)
+			return
+		}
+
+		sendError(c, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	if erd.Scope != ExtensionResourceDefinitionScopeSys.String() {
+		sendError(
+			c, http.StatusBadRequest,
+			fmt.Sprintf(
+				"cannot list system resource versions for %s scoped %s/%s",
+				erd.Scope, erd.SlugSingular, erd.Version,
+			),
+		)
+
+		return
+	}
+
+	versions, err := dbtools.SystemExtensionResourceVersions(c.Request.Context(), r.DB, erd.ID, c.Param("resource-id"))
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error finding extension resource versions: "+err.Error())
+		return
+	}
+
+	if len(versions) == 0 {
+		sendErrorFromErr(c, http.StatusNotFound, ErrExtensionResourceNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, versions)
+}
+
+// rollbackSystemExtensionResource restores the payload of a previous version
+// of a system extension resource, the payload is validated against the current
+// schema of the ERD and is saved as a new version
+func (r *Router) rollbackSystemExtensionResource(c *gin.Context) {
+	version, ok := versionParam(c)
+	if !ok {
+		return
+	}
+
+	revision, ok := ifMatchRevision(c, false)
+	if !ok {
+		return
+	}
+
+	extension, erd, err := findERDForExtensionResource(
+		c, r.DB,
+		c.Param("ex-slug"), c.Param("erd-slug-plural"), c.Param("erd-version"),
+	)
+	if err != nil {
+		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
+			sendErrorFromErr(c, http.StatusNotFound, err)
+			return
+		}
+
+		sendError(c, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	if erd.Scope != ExtensionResourceDefinitionScopeSys.String() {
+		sendError(
+			c, http.StatusBadRequest,
+			fmt.Sprintf(
+				"cannot roll back system resource for %s scoped %s/%s",
+				erd.Scope, erd.SlugSingular, erd.Version,
+			),
+		)
+
+		return
+	}
+
+	er, err := erd.SystemExtensionResources(qm.Where("id = ?", c.Param("resource-id"))).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeExtensionResourceNotFound, "resource not found: "+err.Error())
+			return
+		}
+
+		sendError(c, http.StatusBadRequest, "error finding extension resources: "+err.Error())
+
+		return
+	}
+
+	v, err := dbtools.GetSystemExtensionResourceVersion(c.Request.Context(), r.DB, erd.ID, er.ID, version)
+	if err != nil {
+		if errors.Is(err, dbtools.ErrExtensionResourceVersionNotFound) {
+			sendErrorFromErr(c, http.StatusNotFound, ErrExtensionResourceVersionNotFound)
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error finding extension resource version: "+err.Error())
+
+		return
+	}
+
+	r.saveSystemExtensionResource(c, extension, erd, er, replaceExtensionResource(v.Resource), revision,
+		func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, a *models.SystemExtensionResource) (*models.AuditEvent, error) {
+			return dbtools.AuditSystemExtensionResourceRolledBack(ctx, exec, pID, actor, version, o, a)
+		},
+	)
+}
+
+// listUserExtensionResourceVersions lists the recorded versions of a user
+// extension resource, the latest first
+func (r *Router) listUserExtensionResourceVersions(c *gin.Context) {
+	user, _, erd, findUserErr, findERDErr := fetchUserAndERD(c, r.DB)
+	if !checkUserAndERD(c, user, erd, findUserErr, findERDErr, "list versions of") {
+		return
+	}
+
+	versions, err := dbtools.UserExtensionResourceVersions(c.Request.Context(), r.DB, erd.ID, user.ID, c.Param("resource-id"))
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error finding extension resource versions: "+err.Error())
+		return
+	}
+
+	if len(versions) == 0 {
+		sendErrorFromErr(c, http.StatusNotFound, ErrExtensionResourceNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, versions)
+}
+
+// rollbackUserExtensionResource restores the payload of a previous version of
+// a user extension resource, the payload is validated against the current
+// schema of the ERD and is saved as a new version
+func (r *Router) rollbackUserExtensionResource(c *gin.Context) {
+	version, ok := versionParam(c)
+	if !ok {
+		return
+	}
+
+	revision, ok := ifMatchRevision(c, false)
+	if !ok {
+		return
+	}
+
+	user, extension, erd, findUserErr, findERDErr := fetchUserAndERD(c, r.DB)
+	if !checkUserAndERD(c, user, erd, findUserErr, findERDErr, "roll back") {
+		return
+	}
+
+	er, err := erd.UserExtensionResources(
+		qm.Where("user_id = ?", user.ID),
+		qm.Where("id = ?", c.Param("resource-id")),
+	).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(
+				c, http.StatusNotFound, ErrCodeExtensionResourceNotFound,
+				fmt.Sprintf("%s: %s", ErrExtensionResourceNotFound.Error(), err.Error()),
+			)
+
+			return
+		}
+
+		sendError(c, http.StatusBadRequest, "error finding extension resources: "+err.Error())
+
+		return
+	}
+
+	v, err := dbtools.GetUserExtensionResourceVersion(c.Request.Context(), r.DB, erd.ID, user.ID, er.ID, version)
+	if err != nil {
+		if errors.Is(err, dbtools.ErrExtensionResourceVersionNotFound) {
+			sendErrorFromErr(c, http.StatusNotFound, ErrExtensionResourceVersionNotFound)
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error finding extension resource version: "+err.Error())
+
+		return
+	}
+
+	r.saveUserExtensionResource(c, user, extension, erd, er, replaceExtensionResource(v.Resource), revision,
+		func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User, o, a *models.UserExtensionResource) (*models.AuditEvent, error) {
+			return dbtools.AuditUserExtensionResourceRolledBack(ctx, exec, pID, actor, version, o, a)
+		},
+	)
+}
+
+// checkUserAndERD sends the error response for the errors of fetchUserAndERD
+// and for an ERD that isn't user scoped, it returns false when it did
+func checkUserAndERD(
+	c *gin.Context, user *models.User, erd *models.ExtensionResourceDefinition, findUserErr, findERDErr error, op string,
+) bool {
+	if findUserErr != nil {
+		if errors.Is(findUserErr, sql.ErrNoRows) {
+			sendErrorFromErr(c, http.StatusNotFound, ErrUserNotFound)
+			return false
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting user: "+findUserErr.Error())
+
+		return false
+	}
+
+	if findERDErr != nil {
+		if errors.Is(findERDErr, ErrExtensionNotFound) || errors.Is(findERDErr, ErrERDNotFound) {
+			sendErrorFromErr(c, http.StatusNotFound, findERDErr)
+			return false
+		}
+
+		sendError(c, http.StatusBadRequest, findERDErr.Error())
+
+		return false
+	}
+
+	if erd.Scope != ExtensionResourceDefinitionScopeUser.String() {
+		sendError(
+			c, http.StatusBadRequest,
+			fmt.Sprintf(
+				"cannot %s user resource for %s scoped %s/%s",
+				op, erd.Scope, erd.SlugSingular, erd.Version,
+			),
+		)
+
+		return false
+	}
+
+	return true
+}
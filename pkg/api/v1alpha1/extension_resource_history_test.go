@@ -0,0 +1,94 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtensionResourcesAsOfValidation(t *testing.T) {
+	r := &Router{}
+
+	engine := gin.New()
+	engine.GET("/extension-resources/:ex-slug/:erd-slug-plural/:erd-version", r.listSystemExtensionResources)
+	engine.GET("/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id", r.getSystemExtensionResource)
+	engine.GET("/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version", r.listUserExtensionResources)
+	engine.GET("/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id", r.getUserExtensionResource)
+
+	paths := []string{
+		"/extension-resources/test-extension/some-resources/v1?as_of=yesterday",
+		"/extension-resources/test-extension/some-resources/v1/00000001-0000-0000-0000-000000000001?as_of=2024-03-01",
+		"/users/00000001-0000-0000-0000-000000000001/extension-resources/test-extension/some-resources/v1?as_of=1709296200",
+		"/users/00000001-0000-0000-0000-000000000001/extension-resources/test-extension/some-resources/v1/00000001-0000-0000-0000-000000000002?as_of=",
+	}
+
+	for _, p := range paths {
+		t.Run(p, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, p, nil))
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+
+			resp := ErrorResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			assert.Equal(t, ErrCodeValidationFailed, resp.Code)
+			require.Len(t, resp.Details, 1)
+			assert.Equal(t, "as_of", resp.Details[0].Field)
+		})
+	}
+}
+
+func TestAsOfParam(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/?as_of=2024-03-01T12:30:00.5%2B02:00", nil)
+
+	asOf, ok := asOfParam(c)
+	require.True(t, ok)
+	require.NotNil(t, asOf)
+	assert.Equal(t, "2024-03-01T10:30:00.5Z", asOf.UTC().Format("2006-01-02T15:04:05.999Z07:00"))
+
+	c, _ = gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/?deleted", nil)
+
+	asOf, ok = asOfParam(c)
+	assert.True(t, ok)
+	assert.Nil(t, asOf)
+}
+
+func TestRollbackExtensionResourceVersionValidation(t *testing.T) {
+	r := &Router{}
+
+	engine := gin.New()
+	engine.POST("/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/versions/:version/rollback", r.rollbackSystemExtensionResource)
+	engine.POST("/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/versions/:version/rollback", r.rollbackUserExtensionResource)
+
+	paths := []string{
+		"/extension-resources/test-extension/some-resources/v1/00000001-0000-0000-0000-000000000001/versions/latest/rollback",
+		"/extension-resources/test-extension/some-resources/v1/00000001-0000-0000-0000-000000000001/versions/0/rollback",
+		"/users/00000001-0000-0000-0000-000000000001/extension-resources/test-extension/some-resources/v1/00000001-0000-0000-0000-000000000002/versions/-1/rollback",
+	}
+
+	for _, p := range paths {
+		t.Run(p, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodPost, p, nil))
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+
+			resp := ErrorResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			assert.Equal(t, ErrCodeValidationFailed, resp.Code)
+			require.Len(t, resp.Details, 1)
+			assert.Equal(t, "version", resp.Details[0].Field)
+		})
+	}
+}
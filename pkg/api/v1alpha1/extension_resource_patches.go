@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/metal-toolbox/governor-api/internal/jsonpatch"
+)
+
+// extensionResourceBuilder builds the new payload of an extension resource
+// from its current one
+type extensionResourceBuilder func(current []byte) ([]byte, error)
+
+// replaceExtensionResource returns the builder replacing the whole payload
+// of an extension resource
+func replaceExtensionResource(payload []byte) extensionResourceBuilder {
+	return func([]byte) ([]byte, error) {
+		return payload, nil
+	}
+}
+
+// isExtensionResourcePatch returns whether the body of an update request is
+// a JSON Patch or a JSON Merge Patch of the extension resource
+func isExtensionResourcePatch(c *gin.Context) bool {
+	switch c.ContentType() {
+	case jsonpatch.ContentTypePatch, jsonpatch.ContentTypeMergePatch:
+		return true
+	}
+
+	return false
+}
+
+// extensionResourceUpdate returns the builder of the update request body by
+// its content type: the patches are applied to the current payload of the
+// extension resource and any other body replaces it
+func extensionResourceUpdate(c *gin.Context, body []byte) extensionResourceBuilder {
+	switch c.ContentType() {
+	case jsonpatch.ContentTypePatch:
+		return func(current []byte) ([]byte, error) {
+			return jsonpatch.Patch(current, body)
+		}
+	case jsonpatch.ContentTypeMergePatch:
+		return func(current []byte) ([]byte, error) {
+			return jsonpatch.Merge(current, body)
+		}
+	}
+
+	return replaceExtensionResource(body)
+}
+
+// sendPatchError responds with the error of a patch, the malformed patches are
+// bad requests and the ones that don't apply to the resource are unprocessable
+func sendPatchError(c *gin.Context, err error) {
+	if errors.Is(err, jsonpatch.ErrPathNotFound) || errors.Is(err, jsonpatch.ErrTestFailed) {
+		sendErrorWithCode(
+			c, http.StatusUnprocessableEntity, ErrCodeExtensionResourcePatchFailed,
+			ErrExtensionResourcePatchFailed.Error()+": "+err.Error(),
+		)
+
+		return
+	}
+
+	sendError(c, http.StatusBadRequest, "invalid extension resource patch: "+err.Error())
+}
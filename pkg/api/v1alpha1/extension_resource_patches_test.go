@@ -0,0 +1,92 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metal-toolbox/governor-api/internal/jsonpatch"
+)
+
+func TestExtensionResourceUpdate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	current := `{"age": 10, "firstName": "test", "lastName": "1"}`
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		wantPatch   bool
+		want        string
+		wantStatus  int
+	}{
+		{
+			name:        "replace",
+			contentType: "application/json",
+			body:        `{"age": 11}`,
+			want:        `{"age": 11}`,
+		},
+		{
+			name: "replace without content type",
+			body: `{"age": 11}`,
+			want: `{"age": 11}`,
+		},
+		{
+			name:        "json patch",
+			contentType: jsonpatch.ContentTypePatch,
+			body:        `[{"op": "replace", "path": "/age", "value": 11}]`,
+			wantPatch:   true,
+			want:        `{"age": 11, "firstName": "test", "lastName": "1"}`,
+		},
+		{
+			name:        "merge patch with charset",
+			contentType: jsonpatch.ContentTypeMergePatch + "; charset=utf-8",
+			body:        `{"lastName": "2"}`,
+			wantPatch:   true,
+			want:        `{"age": 10, "firstName": "test", "lastName": "2"}`,
+		},
+		{
+			name:        "failed json patch test",
+			contentType: jsonpatch.ContentTypePatch,
+			body:        `[{"op": "test", "path": "/age", "value": 1}]`,
+			wantPatch:   true,
+			wantStatus:  http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "invalid json patch",
+			contentType: jsonpatch.ContentTypePatch,
+			body:        `{"op": "replace"}`,
+			wantPatch:   true,
+			wantStatus:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPatch, "/", nil)
+
+			if tt.contentType != "" {
+				c.Request.Header.Set("Content-Type", tt.contentType)
+			}
+
+			assert.Equal(t, tt.wantPatch, isExtensionResourcePatch(c))
+
+			got, err := extensionResourceUpdate(c, []byte(tt.body))([]byte(current))
+			if tt.wantStatus != 0 {
+				sendPatchError(c, err)
+				assert.Equal(t, tt.wantStatus, w.Code)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// fieldsParam returns the paths of the fields query parameter, the resource
+// fields a listing is projected on, like ?fields=firstName,address.city. It
+// returns nil when the parameter is missing. It sends the validation error and
+// returns false when a path isn't a property of the ERD schema.
+func fieldsParam(c *gin.Context, erd *models.ExtensionResourceDefinition) ([][]string, bool) {
+	v, ok := c.GetQuery("fields")
+	if !ok {
+		return nil, true
+	}
+
+	schema := map[string]interface{}{}
+	if err := json.Unmarshal(erd.Schema, &schema); err != nil {
+		sendError(c, http.StatusBadRequest, "ERD schema is not valid: "+err.Error())
+		return nil, false
+	}
+
+	paths := [][]string{}
+
+	for _, f := range strings.Split(v, ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+
+		path := strings.Split(f, ".")
+		if !schemaHasProperty(schema, path) {
+			sendValidationError(c, []ErrorDetail{{Field: "fields", Message: f + " is not a property of " + erd.SlugSingular}})
+			return nil, false
+		}
+
+		paths = append(paths, path)
+	}
+
+	if len(paths) == 0 {
+		sendValidationError(c, []ErrorDetail{{Field: "fields", Message: "fields must list properties of " + erd.SlugSingular}})
+		return nil, false
+	}
+
+	return paths, true
+}
+
+// schemaHasProperty returns whether a path of property names is declared in
+// the nested properties of a schema
+func schemaHasProperty(schema map[string]interface{}, path []string) bool {
+	for _, name := range path {
+		properties, ok := schema["properties"].(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		if schema, ok = properties[name].(map[string]interface{}); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// projectResource returns the payload of a resource with only the fields of
+// paths, the fields missing from the payload are left out
+func projectResource(resource types.JSON, paths [][]string) (types.JSON, error) {
+	doc := map[string]interface{}{}
+	if err := json.Unmarshal(resource, &doc); err != nil {
+		return nil, err
+	}
+
+	projection := map[string]interface{}{}
+
+	for _, path := range paths {
+		var (
+			value interface{} = doc
+			found             = true
+		)
+
+		for _, name := range path {
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				found = false
+				break
+			}
+
+			if value, found = m[name]; !found {
+				break
+			}
+		}
+
+		if !found {
+			continue
+		}
+
+		// the parents of nested fields are created in the projection
+		parent := projection
+
+		for _, name := range path[:len(path)-1] {
+			child, ok := parent[name].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				parent[name] = child
+			}
+
+			parent = child
+		}
+
+		parent[path[len(path)-1]] = value
+	}
+
+	return json.Marshal(projection)
+}
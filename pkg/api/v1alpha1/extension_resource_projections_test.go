@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestFieldsParam(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	erd := &models.ExtensionResourceDefinition{
+		SlugSingular: "person",
+		Schema: types.JSON(`{
+			"type": "object",
+			"properties": {
+				"age": {"type": "integer"},
+				"address": {"type": "object", "properties": {"city": {"type": "string"}}}
+			}
+		}`),
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		want       [][]string
+		wantStatus int
+	}{
+		{name: "missing"},
+		{name: "fields", query: "?fields=age,address.city", want: [][]string{{"age"}, {"address", "city"}}},
+		{name: "spaces and empty fields", query: "?fields=age,%20address,", want: [][]string{{"age"}, {"address"}}},
+		{name: "unknown property", query: "?fields=age,name", wantStatus: http.StatusBadRequest},
+		{name: "unknown nested property", query: "?fields=age.years", wantStatus: http.StatusBadRequest},
+		{name: "empty", query: "?fields=", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/"+tt.query, nil)
+
+			got, ok := fieldsParam(c, erd)
+			if tt.wantStatus != 0 {
+				require.False(t, ok)
+				assert.Equal(t, tt.wantStatus, w.Code)
+				assert.Contains(t, w.Body.String(), "fields")
+
+				return
+			}
+
+			require.True(t, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestProjectResource(t *testing.T) {
+	resource := types.JSON(`{"age": 10, "firstName": "test", "address": {"city": "Lisbon", "street": "x"}}`)
+
+	got, err := projectResource(resource, [][]string{{"age"}, {"address", "city"}, {"lastName"}, {"age", "years"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"age": 10, "address": {"city": "Lisbon"}}`, string(got))
+
+	got, err = projectResource(resource, [][]string{{"address"}, {"address", "city"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"address": {"city": "Lisbon", "street": "x"}}`, string(got))
+
+	_, err = projectResource(types.JSON(`[1]`), [][]string{{"age"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// revisionETag returns the entity tag of a revision of an extension resource
+func revisionETag(revision int64) string {
+	return `"` + strconv.FormatInt(revision, 10) + `"`
+}
+
+// ifMatchRevision returns the revision of the If-Match header, the revision
+// an update of an extension resource is based on. It returns nil when the
+// header is missing and isn't required. It sends the error response and
+// returns false when the header is required and missing, or isn't a revision.
+func ifMatchRevision(c *gin.Context, required bool) (*int64, bool) {
+	h := strings.TrimSpace(c.GetHeader("If-Match"))
+	if h == "" {
+		if !required {
+			return nil, true
+		}
+
+		sendErrorWithCode(
+			c, http.StatusPreconditionRequired, ErrCodeExtensionResourceRevisionRequired,
+			ErrExtensionResourceRevisionRequired.Error()+": set If-Match to the revision of the resource",
+		)
+
+		return nil, false
+	}
+
+	revision, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(h, "W/"), `"`), 10, 64)
+	if err != nil || revision < 1 {
+		sendValidationError(c, []ErrorDetail{{Field: "If-Match", Message: "If-Match must be the revision of the resource"}})
+		return nil, false
+	}
+
+	return &revision, true
+}
+
+// sendRevisionConflict responds that the extension resource was changed since
+// the revision of the update, with the current revision in the ETag header
+func sendRevisionConflict(c *gin.Context, current int64, err error) {
+	c.Header("ETag", revisionETag(current))
+	sendErrorWithDetails(
+		c, http.StatusConflict, ErrCodeExtensionResourceRevisionConflict, err.Error(),
+		[]ErrorDetail{{Field: "revision", Message: strconv.FormatInt(current, 10)}},
+	)
+}
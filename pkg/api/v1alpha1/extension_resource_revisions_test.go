@@ -0,0 +1,70 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIfMatchRevision(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		ifMatch    string
+		required   bool
+		want       *int64
+		wantOK     bool
+		wantStatus int
+	}{
+		{name: "quoted", ifMatch: `"3"`, required: true, want: int64Ptr(3), wantOK: true},
+		{name: "weak", ifMatch: `W/"3"`, required: true, want: int64Ptr(3), wantOK: true},
+		{name: "bare", ifMatch: "12", required: true, want: int64Ptr(12), wantOK: true},
+		{name: "missing and optional", wantOK: true},
+		{name: "missing and required", required: true, wantStatus: http.StatusPreconditionRequired},
+		{name: "not a revision", ifMatch: `"abc"`, wantStatus: http.StatusBadRequest},
+		{name: "zero", ifMatch: `"0"`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPatch, "/", nil)
+
+			if tt.ifMatch != "" {
+				c.Request.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			got, ok := ifMatchRevision(c, tt.required)
+			require.Equal(t, tt.wantOK, ok)
+
+			if !ok {
+				assert.Equal(t, tt.wantStatus, w.Code)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSendRevisionConflict(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPatch, "/", nil)
+
+	sendRevisionConflict(c, 4, ErrExtensionResourceRevisionConflict)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+	assert.Contains(t, w.Body.String(), string(ErrCodeExtensionResourceRevisionConflict))
+}
+
+func int64Ptr(n int64) *int64 {
+	return &n
+}
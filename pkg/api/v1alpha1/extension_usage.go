@@ -0,0 +1,59 @@
+package v1alpha1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+)
+
+// ExtensionUsage is the resource count and storage usage of an extension,
+// with the breakdown per resource definition
+type ExtensionUsage struct {
+	ExtensionID          string             `json:"extension_id"`
+	ExtensionSlug        string             `json:"extension_slug"`
+	ResourceCount        int64              `json:"resource_count"`
+	DeletedResourceCount int64              `json:"deleted_resource_count"`
+	PayloadBytes         int64              `json:"payload_bytes"`
+	LastActivityAt       null.Time          `json:"last_activity_at"`
+	ERDs                 []dbtools.ERDUsage `json:"erds"`
+}
+
+// getExtensionUsage returns the resource counts and storage usage of an extension
+func (r *Router) getExtensionUsage(c *gin.Context) {
+	extension, ok := r.findExtension(c, c.Param("eid"))
+	if !ok {
+		return
+	}
+
+	erds, err := dbtools.GetExtensionUsage(c.Request.Context(), r.DB, extension.ID)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting extension usage: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, newExtensionUsage(extension.ID, extension.Slug, erds))
+}
+
+// newExtensionUsage sums the usage of the resource definitions of an extension
+func newExtensionUsage(extensionID, extensionSlug string, erds []dbtools.ERDUsage) *ExtensionUsage {
+	usage := &ExtensionUsage{
+		ExtensionID:   extensionID,
+		ExtensionSlug: extensionSlug,
+		ERDs:          erds,
+	}
+
+	for _, erd := range erds {
+		usage.ResourceCount += erd.ResourceCount
+		usage.DeletedResourceCount += erd.DeletedResourceCount
+		usage.PayloadBytes += erd.PayloadBytes
+
+		if erd.LastActivityAt.Valid && (!usage.LastActivityAt.Valid || erd.LastActivityAt.Time.After(usage.LastActivityAt.Time)) {
+			usage.LastActivityAt = erd.LastActivityAt
+		}
+	}
+
+	return usage
+}
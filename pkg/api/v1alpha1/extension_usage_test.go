@@ -0,0 +1,34 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+)
+
+func TestNewExtensionUsage(t *testing.T) {
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+
+	usage := newExtensionUsage("ext-id", "ext", []dbtools.ERDUsage{
+		{ERDID: "erd-1", ResourceCount: 3, DeletedResourceCount: 1, PayloadBytes: 100, LastActivityAt: null.TimeFrom(older)},
+		{ERDID: "erd-2"},
+		{ERDID: "erd-3", ResourceCount: 2, PayloadBytes: 50, LastActivityAt: null.TimeFrom(newer)},
+	})
+
+	assert.Equal(t, "ext-id", usage.ExtensionID)
+	assert.Equal(t, "ext", usage.ExtensionSlug)
+	assert.Equal(t, int64(5), usage.ResourceCount)
+	assert.Equal(t, int64(1), usage.DeletedResourceCount)
+	assert.Equal(t, int64(150), usage.PayloadBytes)
+	assert.Equal(t, null.TimeFrom(newer), usage.LastActivityAt)
+	assert.Len(t, usage.ERDs, 3)
+
+	empty := newExtensionUsage("ext-id", "ext", []dbtools.ERDUsage{{ERDID: "erd-1"}})
+	assert.False(t, empty.LastActivityAt.Valid)
+	assert.Zero(t, empty.ResourceCount)
+}
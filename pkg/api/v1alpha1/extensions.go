@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -9,7 +10,6 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/gosimple/slug"
-	"github.com/metal-toolbox/auditevent/ginaudit"
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/models"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
@@ -54,23 +54,15 @@ func (r *Router) listExtensions(c *gin.Context) {
 // createExtension creates an extension in DB
 func (r *Router) createExtension(c *gin.Context) {
 	req := &ExtensionReq{}
-	if err := c.BindJSON(req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, req) {
 		return
 	}
 
-	if req.Name == "" {
-		sendError(c, http.StatusBadRequest, "extension name is required")
-		return
-	}
-
-	if req.Description == "" {
-		sendError(c, http.StatusBadRequest, "extension description is required")
-		return
-	}
-
-	if req.Enabled == nil {
-		sendError(c, http.StatusBadRequest, "extension enabled is required")
+	if !validateFields(c,
+		fieldRule{"name", req.Name, "required"},
+		fieldRule{"description", req.Description, "required"},
+		fieldRule{"enabled", req.Enabled, "required"},
+	) {
 		return
 	}
 
@@ -82,92 +74,27 @@ func (r *Router) createExtension(c *gin.Context) {
 
 	extension.Slug = slug.Make(extension.Name)
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting extension create transaction: "+err.Error())
-		return
-	}
-
-	if err := extension.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := fmt.Sprintf("error creating extension: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditExtensionCreated(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		extension,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error creating extension (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error creating extension: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing extension create: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := extension.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating extension: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		events.GovernorExtensionsEventSubject,
-		&events.Event{
-			Version:     events.Version,
+		if err := r.recordChange(c, tx, events.GovernorExtensionsEventSubject, &events.Event{
 			Action:      events.GovernorEventCreate,
-			AuditID:     c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:     getCtxActorID(c),
 			ExtensionID: extension.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish extension create event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditExtensionCreated(ctx, exec, pID, actor, extension)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating extension (audit): "+err.Error(), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
+	r.publishChanges(c)
+
 	c.JSON(http.StatusAccepted, extension)
 }
 
@@ -197,7 +124,7 @@ func (r *Router) getExtension(c *gin.Context) {
 	extension, err := models.Extensions(queryMods...).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "extension not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeExtensionNotFound, "extension not found: "+err.Error())
 			return
 		}
 
@@ -221,7 +148,7 @@ func (r *Router) deleteExtension(c *gin.Context) {
 	extension, err := models.Extensions(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "extension not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeExtensionNotFound, "extension not found: "+err.Error())
 			return
 		}
 
@@ -230,92 +157,27 @@ func (r *Router) deleteExtension(c *gin.Context) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting delete transaction: "+err.Error())
-		return
-	}
-
-	if _, err := extension.Delete(c.Request.Context(), tx, false); err != nil {
-		msg := fmt.Sprintf("error deleting extension: %s. rolling back\n", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditExtensionDeleted(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		extension,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error deleting extension (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error deleting extension: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing extension delete: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := extension.Delete(c.Request.Context(), tx, false); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error deleting extension: %s. rolling back\n", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		events.GovernorExtensionsEventSubject,
-		&events.Event{
-			Version:     events.Version,
+		if err := r.recordChange(c, tx, events.GovernorExtensionsEventSubject, &events.Event{
 			Action:      events.GovernorEventDelete,
-			AuditID:     c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:     getCtxActorID(c),
 			ExtensionID: extension.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish extension delete event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditExtensionDeleted(ctx, exec, pID, actor, extension)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error deleting extension (audit): %s", err.Error()), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
+	r.publishChanges(c)
+
 	c.JSON(http.StatusAccepted, extension)
 }
 
@@ -331,7 +193,7 @@ func (r *Router) updateExtension(c *gin.Context) {
 	extension, err := models.Extensions(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "extension not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeExtensionNotFound, "extension not found: "+err.Error())
 			return
 		}
 
@@ -343,8 +205,7 @@ func (r *Router) updateExtension(c *gin.Context) {
 	original := *extension
 
 	req := &ExtensionReq{}
-	if err := c.BindJSON(req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, req) {
 		return
 	}
 
@@ -361,92 +222,26 @@ func (r *Router) updateExtension(c *gin.Context) {
 		extension.Enabled = *req.Enabled
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting update transaction: "+err.Error())
-		return
-	}
-
-	if _, err := extension.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := fmt.Sprintf("error updating extension: %s. rolling back\n", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditExtensionUpdated(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		&original,
-		extension,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error updating extension (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error updating extension: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := extension.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error updating extension: %s. rolling back\n", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing extension update: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		events.GovernorExtensionsEventSubject,
-		&events.Event{
-			Version:     events.Version,
+		if err := r.recordChange(c, tx, events.GovernorExtensionsEventSubject, &events.Event{
 			Action:      events.GovernorEventUpdate,
-			AuditID:     c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:     getCtxActorID(c),
 			ExtensionID: extension.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish extension update event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditExtensionUpdated(ctx, exec, pID, actor, &original, extension)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error updating extension (audit): %s", err.Error()), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
+	r.publishChanges(c)
+
 	c.JSON(http.StatusAccepted, extension)
 }
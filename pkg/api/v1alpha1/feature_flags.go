@@ -0,0 +1,229 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/featureflags"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// FeatureFlag is a feature flag response. Set is false when the flag isn't
+// stored in the database and Enabled is its default. Forced is true when the
+// flag is forced on by the configuration, whatever is stored.
+type FeatureFlag struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Enabled     bool       `json:"enabled"`
+	Default     bool       `json:"default"`
+	Set         bool       `json:"set"`
+	Forced      bool       `json:"forced,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// FeatureFlagReq is a request to set a feature flag
+type FeatureFlagReq struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
+
+// featureEnabled returns the value of a feature flag, or its default when
+// the router doesn't have a flag cache
+func (r *Router) featureEnabled(c *gin.Context, name string) bool {
+	if r.FeatureFlags == nil {
+		return featureflags.Default(name)
+	}
+
+	return r.FeatureFlags.Enabled(c.Request.Context(), name)
+}
+
+// newFeatureFlag returns the feature flag response of the flag
+func (r *Router) newFeatureFlag(name string, stored *models.FeatureFlag) *FeatureFlag {
+	known := featureflags.Known[name]
+
+	flag := &FeatureFlag{
+		Name:        name,
+		Description: known.Description,
+		Enabled:     known.Default,
+		Default:     known.Default,
+	}
+
+	if stored != nil {
+		flag.Enabled = stored.Enabled
+		flag.Set = true
+		flag.UpdatedAt = &stored.UpdatedAt
+	}
+
+	if r.FeatureFlags != nil && r.FeatureFlags.Forced(name) {
+		flag.Enabled = true
+		flag.Forced = true
+	}
+
+	return flag
+}
+
+// featureFlagName gets the feature flag name from the request params and
+// sends a not found error when governor doesn't know about it
+func featureFlagName(c *gin.Context) (string, bool) {
+	name := c.Param("name")
+
+	if !featureflags.IsKnown(name) {
+		sendErrorWithCode(c, http.StatusNotFound, ErrCodeFeatureFlagNotFound, "feature flag not found: "+name)
+		return "", false
+	}
+
+	return name, true
+}
+
+// listFeatureFlags lists the known feature flags and their values
+func (r *Router) listFeatureFlags(c *gin.Context) {
+	stored, err := models.FeatureFlags().All(c.Request.Context(), r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error listing feature flags: "+err.Error())
+		return
+	}
+
+	byName := make(map[string]*models.FeatureFlag, len(stored))
+	for _, f := range stored {
+		byName[f.Name] = f
+	}
+
+	resp := make([]*FeatureFlag, 0, len(featureflags.Known))
+	for name := range featureflags.Known {
+		resp = append(resp, r.newFeatureFlag(name, byName[name]))
+	}
+
+	sort.Slice(resp, func(i, j int) bool { return resp[i].Name < resp[j].Name })
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// getFeatureFlag gets a feature flag and its value
+func (r *Router) getFeatureFlag(c *gin.Context) {
+	name, ok := featureFlagName(c)
+	if !ok {
+		return
+	}
+
+	stored, err := models.FeatureFlags(qm.Where("name = ?", name)).One(c.Request.Context(), r.DB)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		sendError(c, http.StatusInternalServerError, "error getting feature flag: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, r.newFeatureFlag(name, stored))
+}
+
+// updateFeatureFlag sets the value of a feature flag
+func (r *Router) updateFeatureFlag(c *gin.Context) {
+	name, ok := featureFlagName(c)
+	if !ok {
+		return
+	}
+
+	req := FeatureFlagReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	flag, err := models.FeatureFlags(qm.Where("name = ?", name)).One(c.Request.Context(), r.DB)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		sendError(c, http.StatusInternalServerError, "error getting feature flag: "+err.Error())
+		return
+	}
+
+	original := models.FeatureFlag{Name: name, Enabled: featureflags.Default(name)}
+	exists := flag != nil
+
+	if exists {
+		original = *flag
+	} else {
+		flag = &models.FeatureFlag{Name: name, Description: featureflags.Known[name].Description}
+	}
+
+	flag.Enabled = *req.Enabled
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if exists {
+			_, err = flag.Update(c.Request.Context(), tx, boil.Infer())
+		} else {
+			err = flag.Insert(c.Request.Context(), tx, boil.Infer())
+		}
+
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error updating feature flag: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditFeatureFlagUpdated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, flag)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error updating feature flag (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating feature flag (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	if r.FeatureFlags != nil {
+		r.FeatureFlags.Invalidate()
+	}
+
+	c.JSON(http.StatusAccepted, r.newFeatureFlag(name, flag))
+}
+
+// deleteFeatureFlag resets a feature flag to its default
+func (r *Router) deleteFeatureFlag(c *gin.Context) {
+	name, ok := featureFlagName(c)
+	if !ok {
+		return
+	}
+
+	flag, err := models.FeatureFlags(qm.Where("name = ?", name)).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// nothing to reset, the flag already has its default
+			c.JSON(http.StatusAccepted, r.newFeatureFlag(name, nil))
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting feature flag: "+err.Error())
+
+		return
+	}
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := flag.Delete(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting feature flag: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditFeatureFlagDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), flag)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting feature flag (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting feature flag (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	if r.FeatureFlags != nil {
+		r.FeatureFlags.Invalidate()
+	}
+
+	c.JSON(http.StatusAccepted, r.newFeatureFlag(name, nil))
+}
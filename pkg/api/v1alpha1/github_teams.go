@@ -0,0 +1,378 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/auditevent/ginaudit"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+const (
+	// GithubTeamRoleMaintainer is the github team role of the group admins
+	GithubTeamRoleMaintainer = "maintainer"
+	// GithubTeamRoleMember is the github team role of the other group members
+	GithubTeamRoleMember = "member"
+)
+
+// GithubTeam is the desired state of the github team a governor group is
+// exported as. Members without a github username can't be added to the team,
+// they are listed as unmapped.
+type GithubTeam struct {
+	GroupID     string                     `json:"group_id"`
+	GroupSlug   string                     `json:"group_slug"`
+	TeamSlug    string                     `json:"team_slug"`
+	Name        string                     `json:"name"`
+	Description string                     `json:"description"`
+	Members     []GithubTeamMember         `json:"members"`
+	Unmapped    []GithubTeamUnmappedMember `json:"unmapped"`
+}
+
+// GithubTeamMember is a member of a github team
+type GithubTeamMember struct {
+	Login  string `json:"login"`
+	Role   string `json:"role"`
+	UserID string `json:"user_id"`
+}
+
+// GithubTeamUnmappedMember is a group member without a github username
+type GithubTeamUnmappedMember struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+}
+
+// GithubTeamExportReq is the request to export a group as a github team, the
+// team slug defaults to the group slug
+type GithubTeamExportReq struct {
+	TeamSlug string `json:"team_slug" binding:"omitempty,max=100"`
+}
+
+// listGithubTeams returns the desired state of the github teams of all the exported groups
+func (r *Router) listGithubTeams(c *gin.Context) {
+	exports, err := models.GithubTeamExports(
+		qm.Load(models.GithubTeamExportRels.Group, tenancy.Scope(c.Request.Context(), models.TableNames.Groups)),
+		qm.OrderBy(models.GithubTeamExportColumns.TeamSlug),
+	).All(c.Request.Context(), r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting github team exports: "+err.Error())
+		return
+	}
+
+	teams := []*GithubTeam{}
+
+	for _, export := range exports {
+		// exports of deleted groups, or of groups of other organizations,
+		// aren't loaded with their group
+		group := export.R.GetGroup()
+		if group == nil {
+			continue
+		}
+
+		team, err := r.desiredGithubTeam(c, export, group)
+		if err != nil {
+			sendError(c, http.StatusInternalServerError, "error getting group members: "+err.Error())
+			return
+		}
+
+		teams = append(teams, team)
+	}
+
+	c.JSON(http.StatusOK, teams)
+}
+
+// getGithubTeam returns the desired state of the github team of an exported group
+func (r *Router) getGithubTeam(c *gin.Context) {
+	group, export, ok := r.findGithubTeamExport(c)
+	if !ok {
+		return
+	}
+
+	team, err := r.desiredGithubTeam(c, export, group)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting group members: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, team)
+}
+
+// exportGithubTeam exports a group as a github team, or changes the slug of
+// the team of an exported group
+func (r *Router) exportGithubTeam(c *gin.Context) {
+	req := &GithubTeamExportReq{}
+	if !bindRequest(c, req) {
+		return
+	}
+
+	group, err := r.svc().FindGroup(c.Request.Context(), c.Param("id"), false)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	teamSlug := req.TeamSlug
+	if teamSlug == "" {
+		teamSlug = group.Slug
+	}
+
+	taken, err := models.GithubTeamExports(
+		models.GithubTeamExportWhere.TeamSlug.EQ(teamSlug),
+		models.GithubTeamExportWhere.GroupID.NEQ(group.ID),
+	).Exists(c.Request.Context(), r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error checking github team slug: "+err.Error())
+		return
+	}
+
+	if taken {
+		sendError(c, http.StatusConflict, "github team slug already used by another group: "+teamSlug)
+		return
+	}
+
+	export, err := group.GithubTeamExport().One(c.Request.Context(), r.DB)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		sendError(c, http.StatusInternalServerError, "error getting github team export: "+err.Error())
+		return
+	}
+
+	created := export == nil
+	action := events.GovernorEventUpdate
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		var event *models.AuditEvent
+
+		if created {
+			action = events.GovernorEventCreate
+			export = &models.GithubTeamExport{GroupID: group.ID, TeamSlug: teamSlug}
+
+			if err := export.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+				return abortTx(http.StatusBadRequest, "error exporting github team: "+err.Error(), err)
+			}
+
+			event, err = dbtools.AuditGithubTeamExportCreated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), export)
+		} else {
+			original := *export
+			export.TeamSlug = teamSlug
+
+			if _, err := export.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+				return abortTx(http.StatusBadRequest, "error updating github team export: "+err.Error(), err)
+			}
+
+			event, err = dbtools.AuditGithubTeamExportUpdated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, export)
+		}
+
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error exporting github team (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error exporting github team (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	if !r.publishGithubTeamEvent(c, action, group.ID) {
+		return
+	}
+
+	team, err := r.desiredGithubTeam(c, export, group)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting group members: "+err.Error())
+		return
+	}
+
+	status := http.StatusAccepted
+	if created {
+		status = http.StatusCreated
+	}
+
+	c.JSON(status, team)
+}
+
+// deleteGithubTeamExport stops exporting a group as a github team, the sync
+// extension decides what happens to the existing team
+func (r *Router) deleteGithubTeamExport(c *gin.Context) {
+	group, export, ok := r.findGithubTeamExport(c)
+	if !ok {
+		return
+	}
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := export.Delete(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting github team export: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditGithubTeamExportDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), export)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting github team export (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting github team export (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	if !r.publishGithubTeamEvent(c, events.GovernorEventDelete, group.ID) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, export)
+}
+
+// syncGithubTeam asks the github sync extension to apply the desired state of
+// the team of an exported group
+func (r *Router) syncGithubTeam(c *gin.Context) {
+	group, export, ok := r.findGithubTeamExport(c)
+	if !ok {
+		return
+	}
+
+	if !r.publishGithubTeamEvent(c, events.GovernorEventSync, group.ID) {
+		return
+	}
+
+	team, err := r.desiredGithubTeam(c, export, group)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting group members: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusAccepted, team)
+}
+
+// findGithubTeamExport returns the group in the id param and its github team
+// export, it sends the error response when the group isn't exported
+func (r *Router) findGithubTeamExport(c *gin.Context) (*models.Group, *models.GithubTeamExport, bool) {
+	group, err := r.svc().FindGroup(c.Request.Context(), c.Param("id"), false)
+	if err != nil {
+		if errors.Is(err, service.ErrGroupNotFound) {
+			sendServiceError(c, http.StatusNotFound, err)
+			return nil, nil, false
+		}
+
+		sendServiceError(c, http.StatusInternalServerError, err)
+
+		return nil, nil, false
+	}
+
+	export, err := group.GithubTeamExport().One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendError(c, http.StatusNotFound, "group isn't exported as a github team")
+			return nil, nil, false
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting github team export: "+err.Error())
+
+		return nil, nil, false
+	}
+
+	return group, export, true
+}
+
+// desiredGithubTeam returns the desired state of the github team of an exported group
+func (r *Router) desiredGithubTeam(c *gin.Context, export *models.GithubTeamExport, group *models.Group) (*GithubTeam, error) {
+	members, err := dbtools.GetMembersOfGroup(c.Request.Context(), r.DB, group.ID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return newGithubTeam(export, group, members, time.Now()), nil
+}
+
+// publishGithubTeamEvent publishes an event for the github sync extension.
+// The export changes are already committed, so the response is sent as 202
+// Accepted when publishing fails. A sync changes nothing but its event, so the
+// error response is sent and false is returned unless the event was queued.
+func (r *Router) publishGithubTeamEvent(c *gin.Context, action, groupID string) bool {
+	err := r.EventBus.Publish(c.Request.Context(), events.GovernorGithubTeamsEventSubject, &events.Event{
+		Version: events.Version,
+		Action:  action,
+		AuditID: c.GetString(ginaudit.AuditIDContextKey),
+		ActorID: getCtxActorID(c),
+		GroupID: groupID,
+	})
+
+	switch {
+	case err == nil:
+	case action == events.GovernorEventSync && !errors.Is(err, eventbus.ErrQueued):
+		sendError(c, http.StatusBadRequest, "failed to publish github team sync event: "+err.Error())
+		return false
+	default:
+		acceptChange(c, fmt.Errorf("failed to publish github team event: %w", err))
+	}
+
+	return true
+}
+
+// newGithubTeam renders the members of a group as github team members. Only
+// active users with an unexpired membership are included, group admins are
+// team maintainers and members without a github username are unmapped.
+func newGithubTeam(export *models.GithubTeamExport, group *models.Group, members []dbtools.EnumeratedMembership, now time.Time) *GithubTeam {
+	team := &GithubTeam{
+		GroupID:     group.ID,
+		GroupSlug:   group.Slug,
+		TeamSlug:    export.TeamSlug,
+		Name:        group.Name,
+		Description: group.Description,
+		Members:     []GithubTeamMember{},
+		Unmapped:    []GithubTeamUnmappedMember{},
+	}
+
+	for _, m := range members {
+		if m.User == nil || m.User.Status.String != UserStatusActive {
+			continue
+		}
+
+		if m.ExpiresAt.Valid && !m.ExpiresAt.Time.After(now) {
+			continue
+		}
+
+		if !m.User.GithubUsername.Valid || m.User.GithubUsername.String == "" {
+			team.Unmapped = append(team.Unmapped, GithubTeamUnmappedMember{
+				UserID: m.User.ID,
+				Email:  m.User.Email,
+				Name:   m.User.Name,
+			})
+
+			continue
+		}
+
+		role := GithubTeamRoleMember
+		if m.IsAdmin && (!m.AdminExpiresAt.Valid || m.AdminExpiresAt.Time.After(now)) {
+			role = GithubTeamRoleMaintainer
+		}
+
+		team.Members = append(team.Members, GithubTeamMember{
+			Login:  m.User.GithubUsername.String,
+			Role:   role,
+			UserID: m.User.ID,
+		})
+	}
+
+	sort.Slice(team.Members, func(i, j int) bool { return team.Members[i].Login < team.Members[j].Login })
+	sort.Slice(team.Unmapped, func(i, j int) bool { return team.Unmapped[i].Email < team.Unmapped[j].Email })
+
+	return team
+}
@@ -0,0 +1,55 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestNewGithubTeam(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	user := func(id, email, github, status string) *models.User {
+		u := &models.User{ID: id, Email: email, Name: id, Status: null.StringFrom(status)}
+		if github != "" {
+			u.GithubUsername = null.StringFrom(github)
+		}
+
+		return u
+	}
+
+	group := &models.Group{ID: "g1", Slug: "platform", Name: "Platform", Description: "platform team"}
+	export := &models.GithubTeamExport{GroupID: "g1", TeamSlug: "platform-eng"}
+
+	members := []dbtools.EnumeratedMembership{
+		{UserID: "u1", User: user("u1", "zed@example.com", "zed", UserStatusActive), IsAdmin: true},
+		{UserID: "u2", User: user("u2", "amy@example.com", "amy", UserStatusActive)},
+		{UserID: "u3", User: user("u3", "bob@example.com", "", UserStatusActive)},
+		{UserID: "u4", User: user("u4", "old@example.com", "old", UserStatusActive), ExpiresAt: null.TimeFrom(now.Add(-time.Hour))},
+		{UserID: "u5", User: user("u5", "sus@example.com", "sus", UserStatusSuspended)},
+		{
+			UserID: "u6", User: user("u6", "ann@example.com", "ann", UserStatusActive),
+			IsAdmin: true, AdminExpiresAt: null.TimeFrom(now.Add(-time.Hour)),
+		},
+	}
+
+	team := newGithubTeam(export, group, members, now)
+
+	assert.Equal(t, "g1", team.GroupID)
+	assert.Equal(t, "platform", team.GroupSlug)
+	assert.Equal(t, "platform-eng", team.TeamSlug)
+	assert.Equal(t, "Platform", team.Name)
+	assert.Equal(t, []GithubTeamMember{
+		{Login: "amy", Role: GithubTeamRoleMember, UserID: "u2"},
+		{Login: "ann", Role: GithubTeamRoleMember, UserID: "u6"},
+		{Login: "zed", Role: GithubTeamRoleMaintainer, UserID: "u1"},
+	}, team.Members)
+	assert.Equal(t, []GithubTeamUnmappedMember{
+		{UserID: "u3", Email: "bob@example.com", Name: "u3"},
+	}, team.Unmapped)
+}
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -8,7 +9,6 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"github.com/metal-toolbox/auditevent/ginaudit"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -35,15 +35,30 @@ type GroupApplicationRequest struct {
 	RequesterUserEmail     string    `json:"requester_user_email"`
 	RequesterUserAvatarURL string    `json:"requester_user_avatar_url"`
 	Note                   string    `json:"note"`
+	ExpiresAt              null.Time `json:"expires_at"`
 	CreatedAt              time.Time `json:"created_at"`
 	UpdatedAt              time.Time `json:"updated_at"`
 }
 
-// addGroupApplication links an application to a group
+// addGroupApplication links an application to a group, the link can be
+// time-boxed with an optional expires_at in the request body
 func (r *Router) addGroupApplication(c *gin.Context) {
 	gid := c.Param("id")
 	oid := c.Param("oid")
 
+	req := struct {
+		ExpiresAt null.Time `json:"expires_at"`
+	}{}
+
+	// the request body is optional, links without one never expire
+	if c.Request.ContentLength != 0 && !bindRequest(c, &req) {
+		return
+	}
+
+	if !checkApplicationLinkExpiry(c, req.ExpiresAt) {
+		return
+	}
+
 	q := qm.Where("id = ?", gid)
 
 	if _, err := uuid.Parse(gid); err != nil {
@@ -53,7 +68,7 @@ func (r *Router) addGroupApplication(c *gin.Context) {
 	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -71,7 +86,7 @@ func (r *Router) addGroupApplication(c *gin.Context) {
 	app, err := models.Applications(qa).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "application not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationNotFound, "application not found: "+err.Error())
 			return
 		}
 
@@ -87,7 +102,7 @@ func (r *Router) addGroupApplication(c *gin.Context) {
 	}
 
 	if exists {
-		sendError(c, http.StatusConflict, "application already linked to group")
+		sendErrorWithCode(c, http.StatusConflict, ErrCodeApplicationAlreadyLinked, "application already linked to group")
 		return
 	}
 
@@ -101,67 +116,30 @@ func (r *Router) addGroupApplication(c *gin.Context) {
 	groupApp := &models.GroupApplication{
 		GroupID:       group.ID,
 		ApplicationID: app.ID,
+		ExpiresAt:     req.ExpiresAt,
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting group application update transaction: "+err.Error())
-		return
-	}
-
-	if err := group.AddGroupApplications(c.Request.Context(), tx, true, groupApp); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to update group application: "+err.Error())
-		return
-	}
-
-	event, err := dbtools.AuditGroupApplicationCreated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), groupApp)
-	if err != nil {
-		msg := "error updating group applications (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := group.AddGroupApplications(c.Request.Context(), tx, true, groupApp); err != nil {
+			return abortTx(http.StatusBadRequest, "failed to update group application: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error updating group applications (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing group application update, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorApplicationLinksEventSubject, &events.Event{
+			Action:        events.GovernorEventCreate,
+			GroupID:       group.ID,
+			ApplicationID: app.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupApplicationCreated(ctx, exec, pID, actor, groupApp)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating group applications (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorApplicationLinksEventSubject, &events.Event{
-		Version:       events.Version,
-		Action:        events.GovernorEventCreate,
-		AuditID:       c.GetString(ginaudit.AuditIDContextKey),
-		ActorID:       getCtxActorID(c),
-		GroupID:       group.ID,
-		ApplicationID: app.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish application link create event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusNoContent, nil)
 }
@@ -180,7 +158,7 @@ func (r *Router) removeGroupApplication(c *gin.Context) {
 	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -198,7 +176,7 @@ func (r *Router) removeGroupApplication(c *gin.Context) {
 	app, err := models.Applications(qo).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "application not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationNotFound, "application not found: "+err.Error())
 			return
 		}
 
@@ -222,77 +200,34 @@ func (r *Router) removeGroupApplication(c *gin.Context) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting group application delete transaction: "+err.Error())
-		return
-	}
-
-	if _, err := groupApp.Delete(c.Request.Context(), tx, false); err != nil {
-		msg := "failed to delete group application link: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := groupApp.Delete(c.Request.Context(), tx, false); err != nil {
+			return abortTx(http.StatusBadRequest, "failed to delete group application link: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditGroupApplicationDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), groupApp)
-	if err != nil {
-		msg := "error deleting group application (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error deleting group applications (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing group application delete, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorApplicationLinksEventSubject, &events.Event{
+			Action:        events.GovernorEventDelete,
+			GroupID:       group.ID,
+			ApplicationID: app.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupApplicationDeleted(ctx, exec, pID, actor, groupApp)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting group application (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorApplicationLinksEventSubject, &events.Event{
-		Version:       events.Version,
-		Action:        events.GovernorEventDelete,
-		AuditID:       c.GetString(ginaudit.AuditIDContextKey),
-		ActorID:       getCtxActorID(c),
-		GroupID:       group.ID,
-		ApplicationID: app.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish application link delete event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusNoContent, nil)
 }
 
-// createGroupAppRequest creates a request to link application to a group
+// createGroupAppRequest creates a request to link application to a group. Admins
+// of the group can request the application for another group they administer
+// with a target_group_id, governor admins can target any group.
 func (r *Router) createGroupAppRequest(c *gin.Context) {
 	ctxUser := getCtxUser(c)
 	if ctxUser == nil {
@@ -310,7 +245,7 @@ func (r *Router) createGroupAppRequest(c *gin.Context) {
 	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -320,17 +255,28 @@ func (r *Router) createGroupAppRequest(c *gin.Context) {
 	}
 
 	req := struct {
-		ApplicationID string `json:"application_id"`
-		Note          string `json:"note"`
+		ApplicationID string    `json:"application_id" binding:"required"`
+		Note          string    `json:"note"`
+		ExpiresAt     null.Time `json:"expires_at"`
+		// TargetGroupID is the id or slug of the group the application is
+		// requested for, it defaults to the group in the path
+		TargetGroupID string `json:"target_group_id"`
 	}{}
 
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
 		return
 	}
 
-	if req.ApplicationID == "" {
-		sendError(c, http.StatusBadRequest, "application_id is required")
+	if req.TargetGroupID != "" && req.TargetGroupID != group.ID && req.TargetGroupID != group.Slug {
+		target, ok := r.appRequestTargetGroup(c, ctxUser, req.TargetGroupID)
+		if !ok {
+			return
+		}
+
+		group = target
+	}
+
+	if !checkApplicationLinkExpiry(c, req.ExpiresAt) {
 		return
 	}
 
@@ -366,7 +312,7 @@ func (r *Router) createGroupAppRequest(c *gin.Context) {
 
 	for _, r := range app.R.GroupApplications {
 		if r.GroupID == group.ID {
-			sendError(c, http.StatusConflict, "application already linked to group")
+			sendErrorWithCode(c, http.StatusConflict, ErrCodeApplicationAlreadyLinked, "application already linked to group")
 			return
 		}
 	}
@@ -384,76 +330,85 @@ func (r *Router) createGroupAppRequest(c *gin.Context) {
 		ApproverGroupID: app.ApproverGroupID.String,
 		RequesterUserID: ctxUser.ID,
 		Note:            null.StringFrom(req.Note),
+		ExpiresAt:       req.ExpiresAt,
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting group application request transaction: "+err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := group.AddGroupApplicationRequests(c.Request.Context(), tx, true, groupAppReq); err != nil {
+			return abortTx(http.StatusBadRequest, "failed to create group application request: "+err.Error(), err)
+		}
+
+		if err := r.recordChange(c, tx, events.GovernorApplicationLinkRequestsEventSubject, &events.Event{
+			Action:        events.GovernorEventCreate,
+			GroupID:       groupAppReq.GroupID,
+			ApplicationID: groupAppReq.ApplicationID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupApplicationRequestCreated(ctx, exec, pID, actor, groupAppReq)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating group application request (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
 		return
 	}
 
-	if err := group.AddGroupApplicationRequests(c.Request.Context(), tx, true, groupAppReq); err != nil {
-		msg := "failed to create group application request: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
+	r.publishChanges(c)
 
-		sendError(c, http.StatusBadRequest, msg)
+	c.JSON(http.StatusNoContent, nil)
+}
 
-		return
+// appRequestTargetGroup returns the group an application request is made for
+// on behalf of another group, the user must be an admin of the target group
+// or a governor admin
+func (r *Router) appRequestTargetGroup(c *gin.Context, user *models.User, targetID string) (*models.Group, bool) {
+	q := qm.Where("id = ?", targetID)
+	if _, err := uuid.Parse(targetID); err != nil {
+		q = qm.Where("slug = ?", targetID)
 	}
 
-	event, err := dbtools.AuditGroupApplicationRequestCreated(c.Request.Context(), tx, getCtxAuditID(c), ctxUser, groupAppReq)
+	target, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
-		msg := "error creating group application request (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "target group not found: "+err.Error())
+			return nil, false
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		sendError(c, http.StatusInternalServerError, "error getting target group: "+err.Error())
 
-		return
+		return nil, false
 	}
 
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error group application request (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
+	if isAdmin := getCtxAdmin(c); isAdmin != nil && *isAdmin {
+		return target, true
+	}
 
-		sendError(c, http.StatusBadRequest, msg)
+	memberships, err := dbtools.GetMembershipsForUser(c.Request.Context(), r.DB.DB, user.ID, true)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error enumerating group membership: "+err.Error())
+		return nil, false
+	}
 
-		return
+	if !isActiveGroupAdmin(memberships, target.ID) {
+		sendError(c, http.StatusUnauthorized, "user not admin of target group")
+		return nil, false
 	}
 
-	if err := tx.Commit(); err != nil {
-		msg := "error committing group application request, rolling back: " + err.Error()
+	return target, true
+}
 
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+// isActiveGroupAdmin returns true when the memberships make the user an admin
+// of the group whose admin role hasn't expired
+func isActiveGroupAdmin(memberships []dbtools.EnumeratedMembership, groupID string) bool {
+	for _, m := range memberships {
+		if m.GroupID != groupID {
+			continue
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorApplicationLinkRequestsEventSubject, &events.Event{
-		Version:       events.Version,
-		Action:        events.GovernorEventCreate,
-		AuditID:       c.GetString(ginaudit.AuditIDContextKey),
-		ActorID:       getCtxActorID(c),
-		GroupID:       groupAppReq.GroupID,
-		ApplicationID: groupAppReq.ApplicationID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish application link request create event, downstream changes may be delayed "+err.Error())
-		return
+		return m.IsAdmin && (!m.AdminExpiresAt.Valid || time.Now().Before(m.AdminExpiresAt.Time))
 	}
 
-	c.JSON(http.StatusNoContent, nil)
+	return false
 }
 
 // deleteGroupAppRequest deletes/revokes a pending request to link application to a group.
@@ -476,7 +431,7 @@ func (r *Router) deleteGroupAppRequest(c *gin.Context) {
 	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -488,7 +443,7 @@ func (r *Router) deleteGroupAppRequest(c *gin.Context) {
 	request, err := models.GroupApplicationRequests(qm.Where("id = ?", rid)).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group application request not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationRequestNotFound, "group application request not found: "+err.Error())
 			return
 		}
 
@@ -509,72 +464,27 @@ func (r *Router) deleteGroupAppRequest(c *gin.Context) {
 
 	appID := request.ApplicationID
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting delete group application request transaction: "+err.Error())
-		return
-	}
-
-	if _, err := request.Delete(c.Request.Context(), tx); err != nil {
-		msg := "failed to delete group application request: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditGroupApplicationRequestRevoked(c.Request.Context(), tx, getCtxAuditID(c), ctxUser, request)
-	if err != nil {
-		msg := "error revoking group application request (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := request.Delete(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "failed to delete group application request: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error revoking group application request (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorApplicationLinkRequestsEventSubject, &events.Event{
+			Action:        events.GovernorEventRevoke,
+			GroupID:       group.ID,
+			ApplicationID: appID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupApplicationRequestRevoked(ctx, exec, pID, actor, request)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error revoking group application request (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	if err := tx.Commit(); err != nil {
-		msg := "error committing group application request delete, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorApplicationLinkRequestsEventSubject, &events.Event{
-		Version:       events.Version,
-		Action:        events.GovernorEventRevoke,
-		AuditID:       c.GetString(ginaudit.AuditIDContextKey),
-		ActorID:       getCtxActorID(c),
-		GroupID:       group.ID,
-		ApplicationID: appID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish application link request revoke event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusNoContent, nil)
 }
@@ -592,7 +502,7 @@ func (r *Router) getGroupAppRequests(c *gin.Context) {
 	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -616,7 +526,7 @@ func (r *Router) getGroupAppRequests(c *gin.Context) {
 	appRequests, err := models.GroupApplicationRequests(queryMods...).All(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group application request not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationRequestNotFound, "group application request not found: "+err.Error())
 			return
 		}
 
@@ -643,6 +553,7 @@ func (r *Router) getGroupAppRequests(c *gin.Context) {
 			RequesterUserEmail:     m.R.RequesterUser.Email,
 			RequesterUserAvatarURL: m.R.RequesterUser.AvatarURL.String,
 			Note:                   m.Note.String,
+			ExpiresAt:              m.ExpiresAt,
 			CreatedAt:              m.CreatedAt,
 			UpdatedAt:              m.UpdatedAt,
 		}
@@ -673,7 +584,7 @@ func (r *Router) processGroupAppRequest(c *gin.Context) {
 	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -685,7 +596,7 @@ func (r *Router) processGroupAppRequest(c *gin.Context) {
 	request, err := models.GroupApplicationRequests(qm.Where("id = ?", rid)).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group application request not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationRequestNotFound, "group application request not found: "+err.Error())
 			return
 		}
 
@@ -748,16 +659,12 @@ func (r *Router) processGroupAppRequest(c *gin.Context) {
 	}
 
 	req := struct {
-		Action string `json:"action"`
+		Action string `json:"action" binding:"required,oneof=approve deny"`
+		// ExpiresAt overrides the expiry of the link asked for in the request
+		ExpiresAt null.Time `json:"expires_at"`
 	}{}
 
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
-		return
-	}
-
-	if req.Action == "" {
-		sendError(c, http.StatusBadRequest, "missing action: expecting approve or deny")
+	if !bindRequest(c, &req) {
 		return
 	}
 
@@ -781,172 +688,103 @@ func (r *Router) processGroupAppRequest(c *gin.Context) {
 				return
 			}
 
-			sendError(c, http.StatusConflict, "application already linked to group")
+			sendErrorWithCode(c, http.StatusConflict, ErrCodeApplicationAlreadyLinked, "application already linked to group")
 
 			return
 		}
 
-		groupApp := &models.GroupApplication{
-			GroupID:       request.GroupID,
-			ApplicationID: request.ApplicationID,
+		expiresAt := request.ExpiresAt
+		if req.ExpiresAt.Valid {
+			expiresAt = req.ExpiresAt
 		}
 
-		tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-		if err != nil {
-			sendError(c, http.StatusBadRequest, "error starting group application approval transaction: "+err.Error())
+		if !checkApplicationLinkExpiry(c, expiresAt) {
 			return
 		}
 
-		if err := groupApp.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
-			msg := "error approving group application request, rolling back: " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
-			}
-
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
+		groupApp := &models.GroupApplication{
+			GroupID:       request.GroupID,
+			ApplicationID: request.ApplicationID,
+			ExpiresAt:     expiresAt,
 		}
 
-		if _, err := request.Delete(c.Request.Context(), tx); err != nil {
-			msg := "error deleting group application request on approval, rolling back: " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
+		if !r.withTx(c, func(tx *sql.Tx) error {
+			if err := groupApp.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+				return abortTx(http.StatusBadRequest, "error approving group application request, rolling back: "+err.Error(), err)
 			}
 
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
-		}
-
-		event, err := dbtools.AuditGroupApplicationApproved(c.Request.Context(), tx, getCtxAuditID(c), ctxUser, groupApp)
-		if err != nil {
-			msg := "error approving group application request (audit): " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
+			if _, err := request.Delete(c.Request.Context(), tx); err != nil {
+				return abortTx(http.StatusBadRequest, "error deleting group application request on approval, rolling back: "+err.Error(), err)
 			}
 
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
-		}
-
-		if err := updateContextWithAuditEventData(c, event); err != nil {
-			msg := "error approving group application request (audit): " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
+			if _, err := dbtools.ArchiveGroupApplicationRequest(c.Request.Context(), tx, request, dbtools.ArchivedRequestApproved, ctxUser); err != nil {
+				return abortTx(http.StatusBadRequest, "error archiving group application request on approval, rolling back: "+err.Error(), err)
 			}
 
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
-		}
-
-		if err := tx.Commit(); err != nil {
-			msg := "error committing group application approval, rolling back: " + err.Error()
+			// the approval and the link are audited together
+			event, err := dbtools.AuditGroupApplicationApproved(c.Request.Context(), tx, getCtxAuditID(c), ctxUser, groupApp)
+			if err != nil {
+				return abortTx(http.StatusBadRequest, "error approving group application request (audit): "+err.Error(), err)
+			}
 
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
+			if err := updateContextWithAuditEventData(c, event); err != nil {
+				return abortTx(http.StatusBadRequest, "error approving group application request (audit): "+err.Error(), err)
 			}
 
-			sendError(c, http.StatusBadRequest, msg)
+			if err := r.recordChange(c, tx, events.GovernorApplicationLinkRequestsEventSubject, &events.Event{
+				Action:        events.GovernorEventApprove,
+				GroupID:       groupApp.GroupID,
+				ApplicationID: groupApp.ApplicationID,
+			}, nil); err != nil {
+				return abortTx(http.StatusBadRequest, "error approving group application request: "+err.Error(), err)
+			}
 
-			return
-		}
+			if err := r.recordChange(c, tx, events.GovernorApplicationLinksEventSubject, &events.Event{
+				Action:        events.GovernorEventCreate,
+				GroupID:       groupApp.GroupID,
+				ApplicationID: groupApp.ApplicationID,
+			}, nil); err != nil {
+				return abortTx(http.StatusBadRequest, "error approving group application request: "+err.Error(), err)
+			}
 
-		if err := r.EventBus.Publish(c.Request.Context(), events.GovernorApplicationLinkRequestsEventSubject, &events.Event{
-			Version:       events.Version,
-			Action:        events.GovernorEventApprove,
-			AuditID:       c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:       getCtxActorID(c),
-			GroupID:       groupApp.GroupID,
-			ApplicationID: groupApp.ApplicationID,
-		}); err != nil {
-			sendError(c, http.StatusBadRequest, "failed to publish application link request approve event, downstream changes may be delayed "+err.Error())
+			return nil
+		}) {
 			return
 		}
 
-		if err := r.EventBus.Publish(c.Request.Context(), events.GovernorApplicationLinksEventSubject, &events.Event{
-			Version:       events.Version,
-			Action:        events.GovernorEventCreate,
-			AuditID:       c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:       getCtxActorID(c),
-			GroupID:       groupApp.GroupID,
-			ApplicationID: groupApp.ApplicationID,
-		}); err != nil {
-			sendError(c, http.StatusBadRequest, "failed to publish group application link create event, downstream changes may be delayed "+err.Error())
-			return
-		}
+		r.publishChanges(c)
 
 		c.JSON(http.StatusNoContent, nil)
 
 		return
 
 	case "deny":
-		tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-		if err != nil {
-			sendError(c, http.StatusBadRequest, "error starting group application denial transaction: "+err.Error())
-			return
-		}
-
-		// denying a request simply deletes it
-		if _, err := request.Delete(c.Request.Context(), tx); err != nil {
-			sendError(c, http.StatusBadRequest, "failed to delete group application request: "+err.Error())
-			return
-		}
-
-		event, err := dbtools.AuditGroupApplicationDenied(c.Request.Context(), tx, getCtxAuditID(c), ctxUser, request)
-		if err != nil {
-			msg := "error denying group application request (audit): " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
+		if !r.withTx(c, func(tx *sql.Tx) error {
+			// denying a request simply deletes it
+			if _, err := request.Delete(c.Request.Context(), tx); err != nil {
+				return abortTx(http.StatusBadRequest, "failed to delete group application request: "+err.Error(), err)
 			}
 
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
-		}
-
-		if err := tx.Commit(); err != nil {
-			msg := "error committing group application deny, rolling back: " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
+			if _, err := dbtools.ArchiveGroupApplicationRequest(c.Request.Context(), tx, request, dbtools.ArchivedRequestDenied, ctxUser); err != nil {
+				return abortTx(http.StatusBadRequest, "error archiving group application request on denial, rolling back: "+err.Error(), err)
 			}
 
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
-		}
-
-		if err := updateContextWithAuditEventData(c, event); err != nil {
-			msg := "error denying group application request (audit): " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
+			if err := r.recordChange(c, tx, events.GovernorApplicationLinkRequestsEventSubject, &events.Event{
+				Action:        events.GovernorEventDeny,
+				GroupID:       request.GroupID,
+				ApplicationID: request.ApplicationID,
+			}, func(ctx context.Context, exec boil.ContextExecutor, pID string, _ *models.User) (*models.AuditEvent, error) {
+				return dbtools.AuditGroupApplicationDenied(ctx, exec, pID, ctxUser, request)
+			}); err != nil {
+				return abortTx(http.StatusBadRequest, "error denying group application request (audit): "+err.Error(), err)
 			}
 
-			sendError(c, http.StatusBadRequest, msg)
-
+			return nil
+		}) {
 			return
 		}
 
-		if err := r.EventBus.Publish(c.Request.Context(), events.GovernorApplicationLinkRequestsEventSubject, &events.Event{
-			Version:       events.Version,
-			Action:        events.GovernorEventDeny,
-			AuditID:       c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:       getCtxActorID(c),
-			GroupID:       request.GroupID,
-			ApplicationID: request.ApplicationID,
-		}); err != nil {
-			sendError(c, http.StatusBadRequest, "failed to publish application link request deny event, downstream changes may be delayed "+err.Error())
-			return
-		}
+		r.publishChanges(c)
 
 		c.JSON(http.StatusNoContent, nil)
 
@@ -957,3 +795,14 @@ func (r *Router) processGroupAppRequest(c *gin.Context) {
 		return
 	}
 }
+
+// checkApplicationLinkExpiry sends a validation error when a group application
+// link would expire before it's created
+func checkApplicationLinkExpiry(c *gin.Context, expiresAt null.Time) bool {
+	if expiresAt.Valid && !expiresAt.Time.After(time.Now()) {
+		sendValidationError(c, []ErrorDetail{{Field: "expires_at", Message: "expires_at must be in the future"}})
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,72 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+)
+
+func TestCheckApplicationLinkExpiry(t *testing.T) {
+	tests := []struct {
+		name      string
+		expiresAt null.Time
+		wantOK    bool
+	}{
+		{
+			name:   "no expiry",
+			wantOK: true,
+		},
+		{
+			name:      "future expiry",
+			expiresAt: null.TimeFrom(time.Now().Add(time.Hour)),
+			wantOK:    true,
+		},
+		{
+			name:      "past expiry",
+			expiresAt: null.TimeFrom(time.Now().Add(-time.Hour)),
+			wantOK:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, w := newValidationTestContext("")
+
+			assert.Equal(t, tt.wantOK, checkApplicationLinkExpiry(c, tt.expiresAt))
+
+			if tt.wantOK {
+				return
+			}
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			var resp ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			assert.Equal(t, ErrCodeValidationFailed, resp.Code)
+			assert.Equal(t, []ErrorDetail{{Field: "expires_at", Message: "expires_at must be in the future"}}, resp.Details)
+		})
+	}
+}
+
+func TestIsActiveGroupAdmin(t *testing.T) {
+	memberships := []dbtools.EnumeratedMembership{
+		{GroupID: "member", IsAdmin: false},
+		{GroupID: "admin", IsAdmin: true},
+		{GroupID: "expired-admin", IsAdmin: true, AdminExpiresAt: null.TimeFrom(time.Now().Add(-time.Hour))},
+		{GroupID: "time-boxed-admin", IsAdmin: true, AdminExpiresAt: null.TimeFrom(time.Now().Add(time.Hour))},
+	}
+
+	assert.False(t, isActiveGroupAdmin(memberships, "member"))
+	assert.True(t, isActiveGroupAdmin(memberships, "admin"))
+	assert.False(t, isActiveGroupAdmin(memberships, "expired-admin"))
+	assert.True(t, isActiveGroupAdmin(memberships, "time-boxed-admin"))
+	assert.False(t, isActiveGroupAdmin(memberships, "other"))
+}
@@ -0,0 +1,42 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+)
+
+// GroupDeletionImpact is an alias export for the same struct in dbtools
+type GroupDeletionImpact = dbtools.GroupDeletionImpact
+
+// LostMembership is an alias export for the same struct in dbtools
+type LostMembership = dbtools.LostMembership
+
+// getGroupDeletionImpact returns what deleting a group would remove, so it
+// can be reviewed before the group is deleted. Nothing is changed, the impact
+// is computed in a transaction that is always rolled back.
+func (r *Router) getGroupDeletionImpact(c *gin.Context) {
+	group, err := r.svc().FindGroup(c.Request.Context(), c.Param("id"), false)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var impact *GroupDeletionImpact
+
+	if !r.withRollbackTx(c, func(tx *sql.Tx) error {
+		impact, err = dbtools.GetGroupDeletionImpact(c.Request.Context(), tx, group.ID)
+		if err != nil {
+			return abortTx(http.StatusInternalServerError, "error computing group deletion impact: "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	c.JSON(http.StatusOK, impact)
+}
@@ -0,0 +1,54 @@
+package v1alpha1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GroupFreezeReq is a request to freeze a group
+type GroupFreezeReq struct {
+	Reason string `json:"reason" binding:"max=1024"`
+}
+
+// freezeGroup freezes a group, only governor admins can change the
+// memberships of a frozen group until it is unfrozen
+func (r *Router) freezeGroup(c *gin.Context) {
+	// the request body is optional
+	req := GroupFreezeReq{}
+	if c.Request.ContentLength != 0 && !bindRequest(c, &req) {
+		return
+	}
+
+	group, event, err := r.svc().FreezeGroup(c.Request.Context(), ctxActor(c), c.Param("id"), req.Reason)
+	if !handleServiceResult(c, event, err) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, group)
+}
+
+// unfreezeGroup unfreezes a frozen group
+func (r *Router) unfreezeGroup(c *gin.Context) {
+	group, event, err := r.svc().UnfreezeGroup(c.Request.Context(), ctxActor(c), c.Param("id"))
+	if !handleServiceResult(c, event, err) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, group)
+}
+
+// checkGroupChange sends an error response and returns false when the user
+// can't change the memberships of the group because it is frozen, the denied
+// change is recorded in the audit events
+func (r *Router) checkGroupChange(c *gin.Context, groupIDOrSlug, change string) bool {
+	group, err := r.svc().FindGroup(c.Request.Context(), groupIDOrSlug, false)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return false
+	}
+
+	event, err := r.svc().CheckGroupChange(c.Request.Context(), ctxActor(c), group, change)
+
+	return handleServiceResult(c, event, err)
+}
@@ -1,19 +1,20 @@
 package v1alpha1
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"github.com/metal-toolbox/auditevent/ginaudit"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
 )
 
@@ -72,237 +73,182 @@ func (r *Router) listMemberGroups(c *gin.Context) {
 func (r *Router) addMemberGroup(c *gin.Context) {
 	parentGroupID := c.Param("id")
 
+	if !r.checkGroupChange(c, parentGroupID, "adding a member group") {
+		return
+	}
+
 	req := struct {
 		ExpiresAt     null.Time `json:"expires_at"`
-		MemberGroupID string    `json:"member_group_id"`
+		MemberGroupID string    `json:"member_group_id" binding:"required"`
 	}{}
 
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting add group hierarchy transaction: "+err.Error())
-		return
-	}
+	var membershipsBefore, membershipsAfter []dbtools.EnumeratedMembership
 
-	parentGroup, err := models.Groups(
-		qm.Where("id = ?", parentGroupID),
-		qm.For("UPDATE"),
-	).One(c.Request.Context(), tx)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			rollbackWithError(c, tx, err, http.StatusNotFound, "group not found")
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		parentGroup, err := models.Groups(
+			qm.Where("id = ?", parentGroupID),
+			qm.For("UPDATE"),
+		).One(c.Request.Context(), tx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return abortTx(http.StatusNotFound, "group not found: "+err.Error(), err)
+			}
 
-			return
+			return abortTx(http.StatusInternalServerError, "error getting group: "+err.Error(), err)
 		}
 
-		rollbackWithError(c, tx, err, http.StatusInternalServerError, "error getting group")
-
-		return
-	}
-
-	memberGroup, err := models.FindGroup(c.Request.Context(), tx, req.MemberGroupID)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			rollbackWithError(c, tx, err, http.StatusNotFound, "group not found")
+		memberGroup, err := models.FindGroup(c.Request.Context(), tx, req.MemberGroupID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return abortTx(http.StatusNotFound, "group not found: "+err.Error(), err)
+			}
 
-			return
+			return abortTx(http.StatusInternalServerError, "error getting group: "+err.Error(), err)
 		}
 
-		rollbackWithError(c, tx, err, http.StatusInternalServerError, "error getting group")
-
-		return
-	}
-
-	exists, err := models.GroupHierarchies(
-		qm.InnerJoin("groups ON groups.id = member_group_id AND groups.deleted_at IS NULL"),
-		qm.Where("parent_group_id = ?", parentGroup.ID),
-		qm.And("member_group_id = ?", memberGroup.ID),
-	).Exists(c.Request.Context(), tx)
-	if err != nil {
-		rollbackWithError(c, tx, err, http.StatusInternalServerError, "error checking group hierarchy exists")
-
-		return
-	}
-
-	if exists {
-		rollbackWithError(c, tx, err, http.StatusConflict, "group is already a member")
-
-		return
-	}
-
-	createsCycle, err := dbtools.HierarchyWouldCreateCycle(c.Request.Context(), tx, parentGroup.ID, memberGroup.ID)
-	if err != nil {
-		rollbackWithError(c, tx, err, http.StatusInternalServerError, "could not determine whether the desired hierarchy creates a cycle")
-
-		return
-	}
-
-	if createsCycle {
-		msg := "invalid relationship: hierarchy would create a cycle"
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		exists, err := models.GroupHierarchies(
+			qm.InnerJoin("groups ON groups.id = member_group_id AND groups.deleted_at IS NULL"),
+			qm.Where("parent_group_id = ?", parentGroup.ID),
+			qm.And("member_group_id = ?", memberGroup.ID),
+		).Exists(c.Request.Context(), tx)
+		if err != nil {
+			return abortTx(http.StatusInternalServerError, "error checking group hierarchy exists: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	groupHierarchy := &models.GroupHierarchy{
-		ParentGroupID: parentGroup.ID,
-		MemberGroupID: memberGroup.ID,
-		ExpiresAt:     req.ExpiresAt,
-	}
-
-	membershipsBefore, err := dbtools.GetAllGroupMemberships(c.Request.Context(), tx, false)
-	if err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "failed to compute new effective memberships")
-
-		return
-	}
-
-	if err := groupHierarchy.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "failed to update group hierarchy")
-
-		return
-	}
-
-	event, err := dbtools.AuditGroupHierarchyCreated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), groupHierarchy)
-	if err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "error creating groups hierarchy (audit)")
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "error creating groups hierarchy (audit)")
-
-		return
-	}
+		if exists {
+			return abortTxWithCode(http.StatusConflict, ErrCodeGroupAlreadyMember, "group is already a member", nil)
+		}
 
-	membershipsAfter, err := dbtools.GetAllGroupMemberships(c.Request.Context(), tx, false)
-	if err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "failed to compute new effective memberships")
+		createsCycle, err := dbtools.HierarchyWouldCreateCycle(c.Request.Context(), tx, parentGroup.ID, memberGroup.ID)
+		if err != nil {
+			return abortTx(http.StatusInternalServerError, "could not determine whether the desired hierarchy creates a cycle: "+err.Error(), err)
+		}
 
-		return
-	}
+		if createsCycle {
+			return abortTx(http.StatusBadRequest, "invalid relationship: hierarchy would create a cycle", nil)
+		}
 
-	if err := tx.Commit(); err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "error committing groups hierarchy, rolling back")
+		groupHierarchy := &models.GroupHierarchy{
+			ParentGroupID: parentGroup.ID,
+			MemberGroupID: memberGroup.ID,
+			ExpiresAt:     req.ExpiresAt,
+		}
 
-		return
-	}
+		membershipsBefore, err = dbtools.GetAllGroupMemberships(c.Request.Context(), tx, false)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "failed to compute new effective memberships: "+err.Error(), err)
+		}
 
-	membersAdded := dbtools.FindMemberDiff(membershipsBefore, membershipsAfter)
+		if err := groupHierarchy.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "failed to update group hierarchy: "+err.Error(), err)
+		}
 
-	for _, enumeratedMembership := range membersAdded {
-		if err := r.EventBus.Publish(c.Request.Context(), events.GovernorMembersEventSubject, &events.Event{
-			Version: events.Version,
-			Action:  events.GovernorEventCreate,
-			AuditID: c.GetString(ginaudit.AuditIDContextKey),
-			GroupID: enumeratedMembership.GroupID,
-			UserID:  enumeratedMembership.UserID,
-			ActorID: getCtxActorID(c),
+		if err := r.recordChange(c, tx, events.GovernorHierarchiesEventSubject, &events.Event{
+			Version:   events.HierarchyVersion,
+			Action:    events.GovernorEventCreate,
+			GroupID:   parentGroupID,
+			Hierarchy: dbtools.GroupHierarchyEventHierarchy(groupHierarchy),
+			After:     groupHierarchy,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupHierarchyCreated(ctx, exec, pID, actor, groupHierarchy)
 		}); err != nil {
-			sendError(c, http.StatusBadRequest, "failed to publish members create event, downstream changes may be delayed "+err.Error())
-			return
+			return abortTx(http.StatusBadRequest, "error creating groups hierarchy (audit): "+err.Error(), err)
 		}
-	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorHierarchiesEventSubject, &events.Event{
-		Version: events.Version,
-		Action:  events.GovernorEventCreate,
-		AuditID: c.GetString(ginaudit.AuditIDContextKey),
-		GroupID: parentGroupID,
-		ActorID: getCtxActorID(c),
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish hierarchy create event, downstream changes may be delayed "+err.Error())
+		membershipsAfter, err = dbtools.GetAllGroupMemberships(c.Request.Context(), tx, false)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "failed to compute new effective memberships: "+err.Error(), err)
+		}
+
+		return nil
+	}) {
 		return
 	}
 
+	r.publishMembershipDiff(c, membershipsBefore, membershipsAfter)
+	r.publishChanges(c)
+
 	c.JSON(http.StatusNoContent, nil)
 }
 
 // updateMemberGroup sets expiration on a group hierarchy
 func (r *Router) updateMemberGroup(c *gin.Context) {
 	parentGroupID := c.Param("id")
+
+	if !r.checkGroupChange(c, parentGroupID, "updating a member group") {
+		return
+	}
 	memberGroupID := c.Param("member_id")
 
 	req := struct {
 		ExpiresAt null.Time `json:"expires_at"`
 	}{}
 
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting update hierarchy transaction: "+err.Error())
-		return
-	}
-
-	hierarchy, err := models.GroupHierarchies(
-		qm.Where("parent_group_id = ?", parentGroupID),
-		qm.And("member_group_id = ?", memberGroupID),
-	).One(c.Request.Context(), tx)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			rollbackWithError(c, tx, err, http.StatusBadRequest, "hierarchy not found")
-
-			return
-		}
-
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "error getting hierarchy")
-
+	// an expiration detaches the groups once it's reached
+	if req.ExpiresAt.Valid && !r.checkHierarchyProtection(c, parentGroupID, memberGroupID, "setting the expiration of a group hierarchy") {
 		return
 	}
 
-	hierarchy.ExpiresAt = req.ExpiresAt
+	var membershipsBefore, membershipsAfter []dbtools.EnumeratedMembership
 
-	if _, err := hierarchy.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "failed to update hierarchy")
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		hierarchy, err := models.GroupHierarchies(
+			qm.Where("parent_group_id = ?", parentGroupID),
+			qm.And("member_group_id = ?", memberGroupID),
+		).One(c.Request.Context(), tx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return abortTx(http.StatusBadRequest, "hierarchy not found: "+err.Error(), err)
+			}
 
-		return
-	}
+			return abortTx(http.StatusBadRequest, "error getting hierarchy: "+err.Error(), err)
+		}
 
-	var event *models.AuditEvent
+		membershipsBefore, err = dbtools.GetAllGroupMemberships(c.Request.Context(), tx, false)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "failed to compute new effective memberships: "+err.Error(), err)
+		}
 
-	event, err = dbtools.AuditGroupHierarchyUpdated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), hierarchy)
-	if err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "error creating hierarchy (audit)")
+		original := *hierarchy
+		hierarchy.ExpiresAt = req.ExpiresAt
 
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "error creating hierarchy (audit)")
+		if _, err := hierarchy.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "failed to update hierarchy: "+err.Error(), err)
+		}
 
-		return
-	}
+		if err := r.recordChange(c, tx, events.GovernorHierarchiesEventSubject, &events.Event{
+			Version:   events.HierarchyVersion,
+			Action:    events.GovernorEventUpdate,
+			GroupID:   hierarchy.ParentGroupID,
+			Hierarchy: dbtools.GroupHierarchyEventHierarchy(hierarchy),
+			Before:    &original,
+			After:     hierarchy,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupHierarchyUpdated(ctx, exec, pID, actor, hierarchy)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating hierarchy (audit): "+err.Error(), err)
+		}
 
-	if err := tx.Commit(); err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "error committing hierarchy update, rolling back")
+		membershipsAfter, err = dbtools.GetAllGroupMemberships(c.Request.Context(), tx, false)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "failed to compute new effective memberships: "+err.Error(), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorHierarchiesEventSubject, &events.Event{
-		Version: events.Version,
-		Action:  events.GovernorEventUpdate,
-		AuditID: c.GetString(ginaudit.AuditIDContextKey),
-		GroupID: hierarchy.ParentGroupID,
-		ActorID: getCtxActorID(c),
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish hierarchy update event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishMembershipDiff(c, membershipsBefore, membershipsAfter)
+	r.publishChanges(c)
 
 	c.JSON(http.StatusNoContent, nil)
 }
@@ -310,102 +256,77 @@ func (r *Router) updateMemberGroup(c *gin.Context) {
 // removeGroupMember removes a user from a group
 func (r *Router) removeMemberGroup(c *gin.Context) {
 	parentGroupID := c.Param("id")
-	memberGroupID := c.Param("member_id")
-
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting delete group hierarchy transaction: "+err.Error())
-		return
-	}
-
-	hierarchy, err := models.GroupHierarchies(
-		qm.Where("parent_group_id = ?", parentGroupID),
-		qm.And("member_group_id = ?", memberGroupID),
-	).One(c.Request.Context(), tx)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			rollbackWithError(c, tx, err, http.StatusNotFound, "hierarchy not found")
-
-			return
-		}
-
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "error getting hierarchy")
-
-		return
-	}
-
-	membershipsBefore, err := dbtools.GetAllGroupMemberships(c.Request.Context(), tx, false)
-	if err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "failed to compute new effective memberships")
-
-		return
-	}
-
-	if _, err := hierarchy.Delete(c.Request.Context(), tx); err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "error removing hierarchy")
 
+	if !r.checkGroupChange(c, parentGroupID, "removing a member group") {
 		return
 	}
+	memberGroupID := c.Param("member_id")
 
-	event, err := dbtools.AuditGroupHierarchyDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), hierarchy)
-	if err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "error deleting groups hierarchy (audit)")
-
+	if !r.checkHierarchyProtection(c, parentGroupID, memberGroupID, "removing a group hierarchy") {
 		return
 	}
 
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "error deleting groups hierarchy (audit)")
+	var membershipsBefore, membershipsAfter []dbtools.EnumeratedMembership
 
-		return
-	}
-
-	membershipsAfter, err := dbtools.GetAllGroupMemberships(c.Request.Context(), tx, false)
-	if err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "failed to compute new effective memberships")
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		hierarchy, err := models.GroupHierarchies(
+			qm.Where("parent_group_id = ?", parentGroupID),
+			qm.And("member_group_id = ?", memberGroupID),
+		).One(c.Request.Context(), tx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return abortTx(http.StatusNotFound, "hierarchy not found: "+err.Error(), err)
+			}
 
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "error committing hierarchy delete, rolling back")
+			return abortTx(http.StatusBadRequest, "error getting hierarchy: "+err.Error(), err)
+		}
 
-		return
-	}
+		membershipsBefore, err = dbtools.GetAllGroupMemberships(c.Request.Context(), tx, false)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "failed to compute new effective memberships: "+err.Error(), err)
+		}
 
-	membersAdded := dbtools.FindMemberDiff(membershipsAfter, membershipsBefore)
+		if _, err := hierarchy.Delete(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "error removing hierarchy: "+err.Error(), err)
+		}
 
-	for _, enumeratedMembership := range membersAdded {
-		if err := r.EventBus.Publish(c.Request.Context(), events.GovernorMembersEventSubject, &events.Event{
-			Version: events.Version,
-			Action:  events.GovernorEventDelete,
-			AuditID: c.GetString(ginaudit.AuditIDContextKey),
-			GroupID: enumeratedMembership.GroupID,
-			UserID:  enumeratedMembership.UserID,
-			ActorID: getCtxActorID(c),
+		if err := r.recordChange(c, tx, events.GovernorHierarchiesEventSubject, &events.Event{
+			Version:   events.HierarchyVersion,
+			Action:    events.GovernorEventDelete,
+			GroupID:   parentGroupID,
+			Hierarchy: dbtools.GroupHierarchyEventHierarchy(hierarchy),
+			Before:    hierarchy,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupHierarchyDeleted(ctx, exec, pID, actor, hierarchy)
 		}); err != nil {
-			sendError(c, http.StatusBadRequest, "failed to publish members delete event, downstream changes may be delayed "+err.Error())
-			return
+			return abortTx(http.StatusBadRequest, "error deleting groups hierarchy (audit): "+err.Error(), err)
 		}
-	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorHierarchiesEventSubject, &events.Event{
-		Version: events.Version,
-		Action:  events.GovernorEventDelete,
-		AuditID: c.GetString(ginaudit.AuditIDContextKey),
-		GroupID: parentGroupID,
-		ActorID: getCtxActorID(c),
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish hierarchy delete event, downstream changes may be delayed "+err.Error())
+		membershipsAfter, err = dbtools.GetAllGroupMemberships(c.Request.Context(), tx, false)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "failed to compute new effective memberships: "+err.Error(), err)
+		}
+
+		return nil
+	}) {
 		return
 	}
 
+	r.publishMembershipDiff(c, membershipsBefore, membershipsAfter)
+	r.publishChanges(c)
+
 	c.JSON(http.StatusNoContent, nil)
 }
 
 // getGroupHierarchiesAll returns all group hierarchies for all groups
 func (r *Router) getGroupHierarchiesAll(c *gin.Context) {
+	// the hierarchies are limited to the groups of the organization instead of
+	// loading the ones of other organizations
 	queryMods := []qm.QueryMod{
+		qm.InnerJoin("groups AS parentgroup ON parentgroup.id = parent_group_id AND parentgroup.deleted_at IS NULL"),
+		qm.InnerJoin("groups AS membergroup ON membergroup.id = member_group_id AND membergroup.deleted_at IS NULL"),
+		tenancy.Scope(c.Request.Context(), "parentgroup"),
+		tenancy.Scope(c.Request.Context(), "membergroup"),
 		qm.Load("ParentGroup"),
 		qm.Load("MemberGroup"),
 	}
@@ -431,13 +352,3 @@ func (r *Router) getGroupHierarchiesAll(c *gin.Context) {
 
 	c.JSON(http.StatusOK, hierarchiesResponse)
 }
-
-func rollbackWithError(c *gin.Context, tx *sql.Tx, err error, code int, initialMsg string) {
-	msg := initialMsg + err.Error()
-
-	if err := tx.Rollback(); err != nil {
-		msg = msg + "error rolling back transaction: " + err.Error()
-	}
-
-	sendError(c, code, msg)
-}
@@ -1,9 +1,13 @@
 package v1alpha1
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -15,6 +19,8 @@ import (
 
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
 )
 
@@ -27,27 +33,45 @@ const (
 
 // GroupMember is a group member (user)
 type GroupMember struct {
-	ID             string    `json:"id"`
-	Name           string    `json:"name"`
-	Email          string    `json:"email"`
-	AvatarURL      string    `json:"avatar_url"`
-	Status         string    `json:"status"`
-	IsAdmin        bool      `json:"is_admin"`
-	ExpiresAt      null.Time `json:"expires_at"`
-	AdminExpiresAt null.Time `json:"admin_expires_at"`
-	Direct         bool      `json:"direct"`
+	ID             string      `json:"id"`
+	Name           string      `json:"name"`
+	Email          string      `json:"email"`
+	AvatarURL      string      `json:"avatar_url"`
+	Status         string      `json:"status"`
+	IsAdmin        bool        `json:"is_admin"`
+	ExpiresAt      null.Time   `json:"expires_at"`
+	AdminExpiresAt null.Time   `json:"admin_expires_at"`
+	Direct         bool        `json:"direct"`
+	Source         string      `json:"source"`
+	SourceRef      null.String `json:"source_ref"`
+	// Justification is why the direct membership was granted
+	Justification string `json:"justification,omitempty"`
+	// Note is the admin note of the direct membership
+	Note string `json:"note,omitempty"`
+	// Exemption is set when the direct membership is exempt from expiration and review
+	Exemption *MembershipExemption `json:"exemption,omitempty"`
 }
 
 // GroupMembership is the relationship between user and groups
 type GroupMembership struct {
-	ID             string    `json:"id"`
-	GroupID        string    `json:"group_id"`
-	GroupSlug      string    `json:"group_slug"`
-	UserID         string    `json:"user_id"`
-	UserEmail      string    `json:"user_email"`
-	ExpiresAt      null.Time `json:"expires_at"`
-	IsAdmin        bool      `json:"is_admin"`
-	AdminExpiresAt null.Time `json:"admin_expires_at"`
+	ID             string      `json:"id"`
+	GroupID        string      `json:"group_id"`
+	GroupSlug      string      `json:"group_slug"`
+	UserID         string      `json:"user_id"`
+	UserEmail      string      `json:"user_email"`
+	ExpiresAt      null.Time   `json:"expires_at"`
+	IsAdmin        bool        `json:"is_admin"`
+	AdminExpiresAt null.Time   `json:"admin_expires_at"`
+	Source         string      `json:"source"`
+	SourceRef      null.String `json:"source_ref"`
+	// Justification is why the direct membership was granted
+	Justification string `json:"justification,omitempty"`
+	// Note is the admin note of the direct membership
+	Note string `json:"note,omitempty"`
+	// Exemption is set when the direct membership is exempt from expiration and review
+	Exemption *MembershipExemption `json:"exemption,omitempty"`
+	// DeletedAt is set on the tombstones of the removed memberships listed with updated_since
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
 // GroupMemberRequest is a pending user request for group membership
@@ -74,38 +98,82 @@ type createGroupMemberReq struct {
 	Note           string    `json:"note"`
 	ExpiresAt      null.Time `json:"expires_at"`
 	AdminExpiresAt null.Time `json:"admin_expires_at"`
-	Kind           string    `json:"kind"`
+	Kind           string    `json:"kind" binding:"omitempty,oneof=new_member admin_promotion"`
 }
 
-// listGroupMembers returns a list of users in a group
-func (r *Router) listGroupMembers(c *gin.Context) {
-	gid := c.Param("id")
+// contextKeyMembershipNotes is set when the caller can read the membership
+// notes of the group of the request
+const contextKeyMembershipNotes = "membership_notes"
+
+// membershipNoteReader returns whether the caller can read the admin notes of
+// the memberships of a group. The notes are shown to the governor admins, the
+// admins of the group and the api clients that aren't users.
+func (r *Router) membershipNoteReader(c *gin.Context) (func(groupID string) bool, error) {
+	actor := ctxActor(c)
+	if actor.User == nil || actor.Admin {
+		return func(string) bool { return true }, nil
+	}
 
-	queryMods := []qm.QueryMod{}
+	memberships, err := dbtools.GetMembershipsForUser(c.Request.Context(), r.DB.DB, actor.User.ID, false)
+	if err != nil {
+		return nil, err
+	}
 
-	q := qm.Where("id = ?", gid)
+	return func(groupID string) bool { return isActiveGroupAdmin(memberships, groupID) }, nil
+}
 
-	if _, err := uuid.Parse(gid); err != nil {
-		q = qm.Where("slug = ?", gid)
+// mwMembershipNotes tells the group members listing whether the caller can
+// read the membership notes of the group. It runs before the response cache
+// so the listings with and without the notes are cached apart.
+func (r *Router) mwMembershipNotes(c *gin.Context) {
+	group, err := r.svc().FindGroup(c.Request.Context(), c.Param("id"), false)
+	if err != nil {
+		// the listing sends the error response
+		return
 	}
 
-	queryMods = append(queryMods, q)
+	canRead, err := r.membershipNoteReader(c)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting enumerated groups: "+err.Error())
+		return
+	}
 
-	group, err := models.Groups(queryMods...).One(c.Request.Context(), r.DB)
+	if canRead(group.ID) {
+		c.Set(contextKeyMembershipNotes, true)
+		c.Set(contextKeyResponseCacheVariant, "notes")
+	}
+}
+
+// listGroupMembers returns a list of users in a group, the membership notes
+// are only listed when the caller can read them
+func (r *Router) listGroupMembers(c *gin.Context) {
+	group, enumeratedMembers, err := r.svc().ListGroupMembers(c.Request.Context(), c.Param("id"))
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+		if errors.Is(err, service.ErrGroupNotFound) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
-		sendError(c, http.StatusInternalServerError, "error getting group"+err.Error())
+		sendError(c, http.StatusInternalServerError, "error getting group members: "+err.Error())
+
+		return
+	}
+
+	exemptions, err := r.svc().GroupMembershipExemptions(c.Request.Context(), group.ID)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting group members: "+err.Error())
+		return
+	}
 
+	justifications, err := r.svc().MembershipJustifications(c.Request.Context(), qm.Where("group_id = ?", group.ID))
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting group members: "+err.Error())
 		return
 	}
 
-	enumeratedMembers, err := dbtools.GetMembersOfGroup(c.Request.Context(), r.DB.DB, group.ID, true)
+	notes, err := r.svc().MembershipNotes(c.Request.Context(), qm.Where("group_id = ?", group.ID))
 	if err != nil {
-		sendError(c, http.StatusInternalServerError, "error enumerating group membership: "+err.Error())
+		sendError(c, http.StatusInternalServerError, "error getting group members: "+err.Error())
 		return
 	}
 
@@ -121,6 +189,17 @@ func (r *Router) listGroupMembers(c *gin.Context) {
 			ExpiresAt:      m.ExpiresAt,
 			AdminExpiresAt: m.AdminExpiresAt,
 			Direct:         m.Direct,
+			Source:         m.Source,
+			SourceRef:      m.SourceRef,
+		}
+
+		if m.Direct {
+			members[i].Justification = justifications[[2]string{m.GroupID, m.UserID}]
+			members[i].Exemption = membershipExemption(exemptions[m.UserID])
+
+			if c.GetBool(contextKeyMembershipNotes) {
+				members[i].Note = notes[[2]string{m.GroupID, m.UserID}]
+			}
 		}
 	}
 
@@ -129,9 +208,45 @@ func (r *Router) listGroupMembers(c *gin.Context) {
 
 // addGroupMember adds a user to a group
 func (r *Router) addGroupMember(c *gin.Context) {
+	req := struct {
+		IsAdmin        bool      `json:"is_admin"`
+		ExpiresAt      null.Time `json:"expires_at"`
+		AdminExpiresAt null.Time `json:"admin_expires_at"`
+		// Source lets automation, like bulk imports and dynamic membership
+		// rules, record how the member was added
+		Source        string `json:"source" binding:"omitempty,oneof=direct import rule"`
+		SourceRef     string `json:"source_ref" binding:"max=255"`
+		Justification string `json:"justification" binding:"max=1024"`
+	}{}
+
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	event, err := r.svc().AddMember(c.Request.Context(), ctxActor(c), c.Param("id"), c.Param("uid"), service.AddMemberParams{
+		IsAdmin:        req.IsAdmin,
+		ExpiresAt:      req.ExpiresAt,
+		AdminExpiresAt: req.AdminExpiresAt,
+		Source:         req.Source,
+		SourceRef:      req.SourceRef,
+		Justification:  req.Justification,
+	})
+	if !handleServiceResult(c, event, err) {
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// updateGroupMember promotes or demotes a user to a group admin
+func (r *Router) updateGroupMember(c *gin.Context) {
 	gid := c.Param("id")
 	uid := c.Param("uid")
 
+	if !r.checkGroupChange(c, gid, "updating a member") {
+		return
+	}
+
 	q := qm.Where("id = ?", gid)
 
 	if _, err := uuid.Parse(gid); err != nil {
@@ -141,7 +256,7 @@ func (r *Router) addGroupMember(c *gin.Context) {
 	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -153,7 +268,7 @@ func (r *Router) addGroupMember(c *gin.Context) {
 	user, err := models.FindUser(c.Request.Context(), r.DB, uid)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "user not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeUserNotFound, "user not found: "+err.Error())
 			return
 		}
 
@@ -163,151 +278,162 @@ func (r *Router) addGroupMember(c *gin.Context) {
 	}
 
 	req := struct {
-		IsAdmin        bool      `json:"is_admin"`
-		ExpiresAt      null.Time `json:"expires_at"`
+		// IsAdmin and AdminExpiresAt are left unchanged when is_admin is omitted
+		IsAdmin        *bool     `json:"is_admin"`
 		AdminExpiresAt null.Time `json:"admin_expires_at"`
+		// Note is the admin note of the membership, it is left unchanged when
+		// omitted and removed when empty
+		Note *string `json:"note" binding:"omitempty,max=1024"`
 	}{}
 
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
 		return
 	}
 
-	exists, err := models.GroupMemberships(
+	membership, err := models.GroupMemberships(
 		qm.Where("group_id = ?", group.ID),
 		qm.And("user_id = ?", user.ID),
-	).Exists(c.Request.Context(), r.DB)
+	).One(c.Request.Context(), r.DB)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendError(c, http.StatusNotFound, "user not in group (or not a direct member)")
+			return
+		}
+
 		sendError(c, http.StatusInternalServerError, "error checking membership exists: "+err.Error())
+
 		return
 	}
 
-	if exists {
-		sendError(c, http.StatusConflict, "user already in group")
-		return
+	original := *membership
+
+	if req.IsAdmin != nil {
+		membership.IsAdmin = *req.IsAdmin
+		membership.AdminExpiresAt = req.AdminExpiresAt
 	}
 
-	groupMem := &models.GroupMembership{
-		GroupID:        group.ID,
-		UserID:         user.ID,
-		IsAdmin:        req.IsAdmin,
-		ExpiresAt:      req.ExpiresAt,
-		AdminExpiresAt: req.AdminExpiresAt,
+	if req.Note != nil {
+		membership.Note = strings.TrimSpace(*req.Note)
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting add groups membership transaction: "+err.Error())
+	// if there is user in the context, check that they are not trying to promote themselves
+	// (but they are allowed to step down as admin)
+	ctxUser := getCtxUser(c)
+	if ctxUser != nil && ctxUser.ID == user.ID && !(original.IsAdmin && !membership.IsAdmin) {
+		sendError(c, http.StatusBadRequest, "unable to change own membership")
 		return
 	}
 
-	membershipsBefore, err := dbtools.GetMembershipsForUser(c.Request.Context(), tx, user.ID, false)
-	if err != nil {
-		msg := "failed to compute new effective memberships: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
+	// the admin flag changes are recorded as promotions and demotions, a note
+	// changed by the same request is recorded with its own audit event
+	audit := func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) ([]*models.AuditEvent, error) {
+		var (
+			event *models.AuditEvent
+			err   error
+		)
 
-		return
-	}
+		switch {
+		case membership.IsAdmin && !original.IsAdmin:
+			// user is promoted
+			event, err = dbtools.AuditGroupMemberPromoted(ctx, exec, pID, actor, membership)
+		case original.IsAdmin && !membership.IsAdmin:
+			// user is demoted
+			event, err = dbtools.AuditGroupMemberDemoted(ctx, exec, pID, actor, membership)
+		default:
+			// something else was updated
+			event, err = dbtools.AuditGroupMembershipUpdated(ctx, exec, pID, actor, &original, membership)
 
-	if err := groupMem.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := "failed to update group membership: " + err.Error()
+			return []*models.AuditEvent{event}, err
+		}
 
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		if err != nil || membership.Note == original.Note {
+			return []*models.AuditEvent{event}, err
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		// the changeset of the note audit event only has the note
+		noteBefore := *membership
+		noteBefore.Note = original.Note
 
-		return
-	}
+		noteEvent, err := dbtools.AuditGroupMembershipUpdated(ctx, exec, pID, actor, &noteBefore, membership)
 
-	event, err := dbtools.AuditGroupMembershipCreated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), groupMem)
-	if err != nil {
-		msg := "error creating groups membership (audit): " + err.Error()
+		return []*models.AuditEvent{event, noteEvent}, err
+	}
 
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := membership.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "failed to update group member admin flag: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error creating groups membership (audit): " + err.Error()
+		auditEvents, err := audit(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c))
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error updating groups membership (audit): "+err.Error(), err)
+		}
 
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		if err := updateContextWithAuditEventData(c, auditEvents); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating groups membership (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		// the change is audited above, with one or two audit events
+		if err := r.recordChange(c, tx, events.GovernorMembersEventSubject, &events.Event{
+			Version: events.MemberVersion,
+			Action:  events.GovernorEventUpdate,
+			GroupID: group.ID,
+			UserID:  user.ID,
+			Member:  dbtools.GroupMembershipEventMember(membership),
+			Before:  &original,
+		}, nil); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating groups membership (event): "+err.Error(), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
-	membershipsAfter, err := dbtools.GetMembershipsForUser(c.Request.Context(), tx, user.ID, false)
-	if err != nil {
-		msg := "failed to compute new effective memberships: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
+	r.publishChanges(c)
 
-		sendError(c, http.StatusBadRequest, msg)
+	c.JSON(http.StatusNoContent, nil)
+}
 
+// removeGroupMember removes a user from a group
+func (r *Router) removeGroupMember(c *gin.Context) {
+	event, err := r.svc().RemoveMember(c.Request.Context(), ctxActor(c), c.Param("id"), c.Param("uid"))
+	if !handleServiceResult(c, event, err) {
 		return
 	}
 
-	if err := tx.Commit(); err != nil {
-		msg := "error committing groups membership, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
+	c.JSON(http.StatusNoContent, nil)
+}
 
+// createGroupRequest creates a request to join a group
+func (r *Router) createGroupRequest(c *gin.Context) {
+	ctxUser := getCtxUser(c)
+	if ctxUser == nil {
+		sendError(c, http.StatusUnauthorized, "no user in context")
 		return
 	}
 
-	// only publish events for active users
-	if !isActiveUser(user) {
-		c.JSON(http.StatusNoContent, nil)
+	req := createGroupMemberReq{}
+	if !bindRequest(c, &req) {
 		return
 	}
 
-	groupsDiff := dbtools.FindMemberDiff(membershipsBefore, membershipsAfter)
-
-	for _, enumeratedMembership := range groupsDiff {
-		if err := r.EventBus.Publish(c.Request.Context(), events.GovernorMembersEventSubject, &events.Event{
-			Version: events.Version,
-			Action:  events.GovernorEventCreate,
-			AuditID: c.GetString(ginaudit.AuditIDContextKey),
-			GroupID: enumeratedMembership.GroupID,
-			UserID:  enumeratedMembership.UserID,
-			ActorID: getCtxActorID(c),
-		}); err != nil {
-			sendError(c, http.StatusBadRequest, "failed to publish members create event, downstream changes may be delayed "+err.Error())
-			return
-		}
+	// kind is not required but will be defaulted to new member if not set
+	if req.Kind == "" {
+		req.Kind = NewMemberRequest
 	}
 
-	c.JSON(http.StatusNoContent, nil)
-}
+	switch req.Kind {
+	case NewMemberRequest:
+	case AdminPromotionRequest:
+	default:
+		sendError(c, http.StatusBadRequest, "request kind is unrecognized: "+ErrUnknownRequestKind.Error())
+		return
+	}
 
-// updateGroupMember promotes or demotes a user to a group admin
-func (r *Router) updateGroupMember(c *gin.Context) {
 	gid := c.Param("id")
-	uid := c.Param("uid")
 
 	q := qm.Where("id = ?", gid)
-
 	if _, err := uuid.Parse(gid); err != nil {
 		q = qm.Where("slug = ?", gid)
 	}
@@ -315,7 +441,7 @@ func (r *Router) updateGroupMember(c *gin.Context) {
 	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -324,138 +450,112 @@ func (r *Router) updateGroupMember(c *gin.Context) {
 		return
 	}
 
-	user, err := models.FindUser(c.Request.Context(), r.DB, uid)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "user not found: "+err.Error())
-			return
+	if left, err := r.svc().AdminPromotionRequestThrottled(c.Request.Context(), ctxUser.ID, group.ID, req.Kind, r.AdminPromotionRequestCooldown); err != nil {
+		if errors.Is(err, service.ErrAdminPromotionRequestThrottled) {
+			c.Header("Retry-After", strconv.Itoa(int(left.Seconds())+1))
 		}
 
-		sendError(c, http.StatusInternalServerError, "error getting user "+err.Error())
-
-		return
-	}
-
-	req := struct {
-		IsAdmin        bool      `json:"is_admin"`
-		AdminExpiresAt null.Time `json:"admin_expires_at"`
-	}{}
+		sendServiceError(c, http.StatusInternalServerError, err)
 
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
 		return
 	}
 
-	membership, err := models.GroupMemberships(
-		qm.Where("group_id = ?", group.ID),
-		qm.And("user_id = ?", user.ID),
-	).One(c.Request.Context(), r.DB)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "user not in group (or not a direct member)")
+	// reject requests breaking the membership duration policy early, clamp
+	// policies are applied when the request is approved. The note of new
+	// member requests is the justification of the membership.
+	if req.Kind == NewMemberRequest {
+		if err := r.svc().CheckMembershipDuration(c.Request.Context(), group, req.ExpiresAt); err != nil {
+			sendServiceError(c, http.StatusInternalServerError, err)
 			return
 		}
 
-		sendError(c, http.StatusInternalServerError, "error checking membership exists: "+err.Error())
-
-		return
-	}
-
-	// if there is user in the context, check that they are not trying to promote themselves
-	// (but they are allowed to step down as admin)
-	ctxUser := getCtxUser(c)
-	if ctxUser != nil && ctxUser.ID == user.ID && !(membership.IsAdmin && !req.IsAdmin) {
-		sendError(c, http.StatusBadRequest, "unable to change own membership")
-		return
-	}
-
-	original := *membership
-
-	membership.IsAdmin = req.IsAdmin
-
-	membership.AdminExpiresAt = req.AdminExpiresAt
-
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting update groups membership transaction: "+err.Error())
-		return
+		if err := service.CheckJustification(group, req.Note); err != nil {
+			sendServiceError(c, http.StatusInternalServerError, err)
+			return
+		}
 	}
 
-	if _, err := membership.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "failed to update group member admin flag")
-
-		return
+	groupMembershipRequest := &models.GroupMembershipRequest{
+		GroupID:        group.ID,
+		UserID:         ctxUser.ID,
+		IsAdmin:        req.IsAdmin,
+		Note:           req.Note,
+		ExpiresAt:      req.ExpiresAt,
+		AdminExpiresAt: req.AdminExpiresAt,
+		Kind:           req.Kind,
 	}
 
-	var event *models.AuditEvent
-
-	switch {
-	case membership.IsAdmin && !original.IsAdmin:
-		var err error
-
-		// user is promoted
-		event, err = dbtools.AuditGroupMemberPromoted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), membership)
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		// the memberships and requests are loaded in the transaction, the
+		// ones loaded earlier in the request can be stale
+		user, err := reloadCtxUser(c, tx, models.UserRels.GroupMemberships, models.UserRels.GroupMembershipRequests)
 		if err != nil {
-			rollbackWithError(c, tx, err, http.StatusBadRequest, "error updating groups membership (audit)")
-
-			return
+			return err
 		}
-	case original.IsAdmin && !membership.IsAdmin:
-		var err error
 
-		// user is demoted
-		event, err = dbtools.AuditGroupMemberDemoted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), membership)
-		if err != nil {
-			rollbackWithError(c, tx, err, http.StatusBadRequest, "error updating groups membership (audit)")
+		foundExistingGroupMember := false
 
-			return
+		for _, m := range user.R.GroupMemberships {
+			if m.GroupID == group.ID {
+				foundExistingGroupMember = true
+			}
 		}
-	default:
-		var err error
 
-		// something else was updated
-		event, err = dbtools.AuditGroupMembershipUpdated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, membership)
-		if err != nil {
-			rollbackWithError(c, tx, err, http.StatusBadRequest, "error updating groups membership (audit)")
+		switch req.Kind {
+		case NewMemberRequest:
+			if foundExistingGroupMember {
+				return abortTxWithCode(http.StatusBadRequest, ErrCodeUserAlreadyMember, "user already member of the group", nil)
+			}
+		case AdminPromotionRequest:
+			if !foundExistingGroupMember {
+				return abortTx(http.StatusBadRequest, "user must be a member before making this request", nil)
+			}
+		}
 
-			return
+		for _, mr := range user.R.GroupMembershipRequests {
+			if mr.GroupID == group.ID {
+				return abortTxWithCode(http.StatusConflict, ErrCodeMembershipRequestExists, "user already requested access to the group", nil)
+			}
 		}
-	}
 
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "error updating groups membership (audit)")
+		if err := group.AddGroupMembershipRequests(c.Request.Context(), tx, true, groupMembershipRequest); err != nil {
+			// a concurrent identical request was created since the check above
+			if dbtools.IsUniqueViolation(err) {
+				return service.ErrRequestExists
+			}
 
-		return
-	}
+			return abortTx(http.StatusBadRequest, "failed to create group request: "+err.Error(), err)
+		}
 
-	if err := tx.Commit(); err != nil {
-		rollbackWithError(c, tx, err, http.StatusBadRequest, "error committing membership update, rolling back")
+		if err := r.recordChange(c, tx, events.GovernorMemberRequestsEventSubject, &events.Event{
+			Action:  events.GovernorEventCreate,
+			GroupID: groupMembershipRequest.GroupID,
+			UserID:  groupMembershipRequest.UserID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupMembershipRequestCreated(ctx, exec, pID, actor, groupMembershipRequest)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating group membership request (audit): "+err.Error(), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorMembersEventSubject, &events.Event{
-		Version: events.Version,
-		Action:  events.GovernorEventUpdate,
-		AuditID: c.GetString(ginaudit.AuditIDContextKey),
-		GroupID: group.ID,
-		UserID:  user.ID,
-		ActorID: getCtxActorID(c),
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish member update event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusNoContent, nil)
 }
 
-// removeGroupMember removes a user from a group
-func (r *Router) removeGroupMember(c *gin.Context) {
+// deleteGroupRequest deletes/revokes a pending request to join a group. This can only be done
+// by the user who created the request.
+func (r *Router) deleteGroupRequest(c *gin.Context) {
+	ctxUser := getCtxUser(c)
+
 	gid := c.Param("id")
-	uid := c.Param("uid")
+	rid := c.Param("rid")
 
 	q := qm.Where("id = ?", gid)
-
 	if _, err := uuid.Parse(gid); err != nil {
 		q = qm.Where("slug = ?", gid)
 	}
@@ -463,7 +563,7 @@ func (r *Router) removeGroupMember(c *gin.Context) {
 	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -472,411 +572,54 @@ func (r *Router) removeGroupMember(c *gin.Context) {
 		return
 	}
 
-	user, err := models.FindUser(c.Request.Context(), r.DB, uid)
+	request, err := models.GroupMembershipRequests(qm.Where("id = ?", rid)).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "user not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeMembershipRequestNotFound, "group request not found: "+err.Error())
 			return
 		}
 
-		sendError(c, http.StatusInternalServerError, "error getting user "+err.Error())
+		sendError(c, http.StatusInternalServerError, "error getting group request"+err.Error())
 
 		return
 	}
 
-	membership, err := models.GroupMemberships(
-		qm.Where("group_id = ?", group.ID),
-		qm.And("user_id = ?", user.ID),
-	).One(c.Request.Context(), r.DB)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "user not in group (or not a direct member)")
-			return
-		}
-
-		sendError(c, http.StatusInternalServerError, "error checking membership exists: "+err.Error())
-
+	if request.GroupID != group.ID {
+		sendError(c, http.StatusBadRequest, "request not associated with this group")
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting delete groups membership transaction: "+err.Error())
+	if ctxUser != nil && request.UserID != ctxUser.ID {
+		sendError(c, http.StatusBadRequest, "request not associated with this user")
 		return
 	}
 
-	membershipsBefore, err := dbtools.GetMembershipsForUser(c.Request.Context(), tx, user.ID, false)
-	if err != nil {
-		msg := "failed to compute new effective memberships: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if _, err := membership.Delete(c.Request.Context(), tx); err != nil {
-		msg := "error removing membership: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditGroupMembershipDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), membership)
-	if err != nil {
-		msg := "error deleting groups membership (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error deleting group membership (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	membershipsAfter, err := dbtools.GetMembershipsForUser(c.Request.Context(), tx, user.ID, false)
-	if err != nil {
-		msg := "failed to compute new effective memberships: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
+	userID := ""
+	if ctxUser != nil {
+		userID = ctxUser.ID
 	}
 
-	if err := tx.Commit(); err != nil {
-		msg := "error committing membership delete, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := request.Delete(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "failed to delete group request: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	// only publish events for active users
-	if !isActiveUser(user) {
-		c.JSON(http.StatusNoContent, nil)
-		return
-	}
-
-	groupsDiff := dbtools.FindMemberDiff(membershipsAfter, membershipsBefore)
-
-	for _, enumeratedMembership := range groupsDiff {
-		if err := r.EventBus.Publish(c.Request.Context(), events.GovernorMembersEventSubject, &events.Event{
-			Version: events.Version,
-			Action:  events.GovernorEventDelete,
-			AuditID: c.GetString(ginaudit.AuditIDContextKey),
-			GroupID: enumeratedMembership.GroupID,
-			UserID:  enumeratedMembership.UserID,
-			ActorID: getCtxActorID(c),
+		if err := r.recordChange(c, tx, events.GovernorMemberRequestsEventSubject, &events.Event{
+			Action:  events.GovernorEventRevoke,
+			GroupID: group.ID,
+			UserID:  userID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupMembershipRevoked(ctx, exec, pID, actor, request)
 		}); err != nil {
-			sendError(c, http.StatusBadRequest, "failed to publish members delete event, downstream changes may be delayed "+err.Error())
-			return
+			return abortTx(http.StatusBadRequest, "error deleting group membership request (audit): "+err.Error(), err)
 		}
-	}
-
-	c.JSON(http.StatusNoContent, nil)
-}
-
-// createGroupRequest creates a request to join a group
-func (r *Router) createGroupRequest(c *gin.Context) {
-	ctxUser := getCtxUser(c)
-	if ctxUser == nil {
-		sendError(c, http.StatusUnauthorized, "no user in context")
-		return
-	}
-
-	req := createGroupMemberReq{}
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
-		return
-	}
-
-	// kind is not required but will be defaulted to new member if not set
-	if req.Kind == "" {
-		req.Kind = NewMemberRequest
-	}
-
-	switch req.Kind {
-	case NewMemberRequest:
-	case AdminPromotionRequest:
-	default:
-		sendError(c, http.StatusBadRequest, "request kind is unrecognized: "+ErrUnknownRequestKind.Error())
-		return
-	}
-
-	gid := c.Param("id")
-
-	q := qm.Where("id = ?", gid)
-	if _, err := uuid.Parse(gid); err != nil {
-		q = qm.Where("slug = ?", gid)
-	}
-
-	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
-			return
-		}
-
-		sendError(c, http.StatusInternalServerError, "error getting group"+err.Error())
-
-		return
-	}
-
-	foundExistingGroupMember := false
-
-	for _, m := range ctxUser.R.GroupMemberships {
-		if m.GroupID == group.ID {
-			foundExistingGroupMember = true
-		}
-	}
-
-	switch req.Kind {
-	case NewMemberRequest:
-		if foundExistingGroupMember {
-			sendError(c, http.StatusBadRequest, "user already member of the group")
-			return
-		}
-	case AdminPromotionRequest:
-		if !foundExistingGroupMember {
-			sendError(c, http.StatusBadRequest, "user must be a member before making this request")
-			return
-		}
-	}
-
-	for _, r := range ctxUser.R.GroupMembershipRequests {
-		if r.GroupID == group.ID {
-			sendError(c, http.StatusConflict, "user already requested access to the group")
-			return
-		}
-	}
-
-	groupMembershipRequest := &models.GroupMembershipRequest{
-		GroupID:        group.ID,
-		UserID:         ctxUser.ID,
-		IsAdmin:        req.IsAdmin,
-		Note:           req.Note,
-		ExpiresAt:      req.ExpiresAt,
-		AdminExpiresAt: req.AdminExpiresAt,
-		Kind:           req.Kind,
-	}
-
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting groups membership request transaction: "+err.Error())
-		return
-	}
-
-	if err := group.AddGroupMembershipRequests(c.Request.Context(), tx, true, groupMembershipRequest); err != nil {
-		msg := "failed to create group request: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditGroupMembershipRequestCreated(c.Request.Context(), tx, getCtxAuditID(c), ctxUser, groupMembershipRequest)
-	if err != nil {
-		msg := "error creating group membership request (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error group membership request (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing group membership request, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorMemberRequestsEventSubject, &events.Event{
-		Version: events.Version,
-		Action:  events.GovernorEventCreate,
-		AuditID: c.GetString(ginaudit.AuditIDContextKey),
-		ActorID: getCtxActorID(c),
-		GroupID: groupMembershipRequest.GroupID,
-		UserID:  groupMembershipRequest.UserID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish member request create event, downstream changes may be delayed "+err.Error())
-		return
-	}
-
-	c.JSON(http.StatusNoContent, nil)
-}
-
-// deleteGroupRequest deletes/revokes a pending request to join a group. This can only be done
-// by the user who created the request.
-func (r *Router) deleteGroupRequest(c *gin.Context) {
-	ctxUser := getCtxUser(c)
-
-	gid := c.Param("id")
-	rid := c.Param("rid")
-
-	q := qm.Where("id = ?", gid)
-	if _, err := uuid.Parse(gid); err != nil {
-		q = qm.Where("slug = ?", gid)
-	}
-
-	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
-			return
-		}
-
-		sendError(c, http.StatusInternalServerError, "error getting group"+err.Error())
-
-		return
-	}
-
-	request, err := models.GroupMembershipRequests(qm.Where("id = ?", rid)).One(c.Request.Context(), r.DB)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group request not found: "+err.Error())
-			return
-		}
-
-		sendError(c, http.StatusInternalServerError, "error getting group request"+err.Error())
-
-		return
-	}
-
-	if request.GroupID != group.ID {
-		sendError(c, http.StatusBadRequest, "request not associated with this group")
-		return
-	}
-
-	if ctxUser != nil && request.UserID != ctxUser.ID {
-		sendError(c, http.StatusBadRequest, "request not associated with this user")
-		return
-	}
-
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting delete group request transaction: "+err.Error())
-		return
-	}
-
-	if _, err := request.Delete(c.Request.Context(), tx); err != nil {
-		msg := "failed to delete group request: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditGroupMembershipRevoked(c.Request.Context(), tx, getCtxAuditID(c), ctxUser, request)
-	if err != nil {
-		msg := "error deleting group membership request (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error group membership request (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing group request delete, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
 
+		return nil
+	}) {
 		return
 	}
 
-	userID := ""
-	if ctxUser != nil {
-		userID = ctxUser.ID
-	}
-
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorMemberRequestsEventSubject, &events.Event{
-		Version: events.Version,
-		Action:  events.GovernorEventRevoke,
-		AuditID: c.GetString(ginaudit.AuditIDContextKey),
-		ActorID: getCtxActorID(c),
-		GroupID: group.ID,
-		UserID:  userID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish member request revoke event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusNoContent, nil)
 }
@@ -901,7 +644,7 @@ func (r *Router) getGroupRequests(c *gin.Context) {
 	group, err := models.Groups(queryMods...).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -936,355 +679,63 @@ func (r *Router) getGroupRequests(c *gin.Context) {
 
 // processGroupRequest approves or denies a pending request to join a group. This can only be done
 // by an admin or a group admin.
-//
-//nolint:gocyclo
 func (r *Router) processGroupRequest(c *gin.Context) {
-	ctxUser := getCtxUser(c)
-	if ctxUser == nil {
+	if getCtxUser(c) == nil {
 		sendError(c, http.StatusUnauthorized, "no user in context")
 		return
 	}
 
-	gid := c.Param("id")
-	rid := c.Param("rid")
-
-	q := qm.Where("id = ?", gid)
-	if _, err := uuid.Parse(gid); err != nil {
-		q = qm.Where("slug = ?", gid)
-	}
-
-	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
-			return
-		}
-
-		sendError(c, http.StatusInternalServerError, "error getting group"+err.Error())
-
-		return
-	}
-
-	request, err := models.GroupMembershipRequests(qm.Where("id = ?", rid)).One(c.Request.Context(), r.DB)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group request not found: "+err.Error())
-			return
-		}
-
-		sendError(c, http.StatusInternalServerError, "error getting group request"+err.Error())
-
-		return
-	}
-
-	if request.GroupID != group.ID {
-		sendError(c, http.StatusBadRequest, "request not associated with this group")
-		return
-	}
-
-	if request.UserID == ctxUser.ID {
-		sendError(c, http.StatusBadRequest, "unable to approve/deny own request")
-		return
-	}
-
 	req := struct {
-		Action string `json:"action"`
+		Action string `json:"action" binding:"required,oneof=approve deny"`
 	}{}
 
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
 		return
 	}
 
-	switch req.Action {
-	case "approve":
-		// approving a request will lookup the action to be performed, run checks,
-		// perform the appropriate approval action, and finally delete the request
-		user, err := models.FindUser(c.Request.Context(), r.DB, request.UserID)
-		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				sendError(c, http.StatusBadRequest, "requesting user not found: "+err.Error())
-				return
-			}
-
-			sendError(c, http.StatusInternalServerError, "error getting user "+err.Error())
+	auditEvents, err := r.svc().ProcessRequest(c.Request.Context(), ctxActor(c), c.Param("id"), c.Param("rid"), req.Action)
 
+	// a denial records a single audit event
+	if req.Action == service.RequestActionDeny && len(auditEvents) == 1 {
+		if !handleServiceResult(c, auditEvents[0], err) {
 			return
 		}
-
-		existingMembership, err := models.GroupMemberships(
-			qm.Where("group_id = ?", request.GroupID),
-			qm.And("user_id = ?", request.UserID),
-		).One(c.Request.Context(), r.DB)
-		if err != nil {
-			if !errors.Is(err, sql.ErrNoRows) {
-				sendError(c, http.StatusInternalServerError, "error checking membership exists: "+err.Error())
-				return
-			}
-		}
-
-		// Type-specific checks before processing the approval
-		switch request.Kind {
-		case "new_member":
-			if existingMembership != nil {
-				// if the user is already a member of the group, we can just delete the request
-				if _, err := request.Delete(c.Request.Context(), r.DB); err != nil {
-					sendError(c, http.StatusBadRequest, "failed to delete group request: "+err.Error())
-					return
-				}
-
-				sendError(c, http.StatusConflict, "user already in group")
-
-				return
-			}
-		case "admin_promotion":
-			if existingMembership.IsAdmin {
-				// if the user is already an admin, we can just delete the request
-				if _, err := request.Delete(c.Request.Context(), r.DB); err != nil {
-					sendError(c, http.StatusBadRequest, "failed to delete group request: "+err.Error())
-					return
-				}
-
-				sendError(c, http.StatusConflict, "user already an admin")
-
-				return
-			}
-		}
-
-		tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-		if err != nil {
-			sendError(c, http.StatusBadRequest, "error starting group request approval transaction: "+err.Error())
-			return
-		}
-
-		membershipsBefore, err := dbtools.GetMembershipsForUser(c.Request.Context(), tx, user.ID, false)
-		if err != nil {
-			msg := "failed to compute new effective memberships: " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
-			}
-
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
-		}
-
-		groupMem := &models.GroupMembership{
-			GroupID:        request.GroupID,
-			UserID:         request.UserID,
-			IsAdmin:        request.IsAdmin,
-			ExpiresAt:      request.ExpiresAt,
-			AdminExpiresAt: request.AdminExpiresAt,
-		}
-
-		// Process the approval
-		switch request.Kind {
-		case "new_member":
-			if err := groupMem.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
-				msg := "error approving group membership request , rolling back: " + err.Error()
-
-				if err := tx.Rollback(); err != nil {
-					msg += "error rolling back transaction: " + err.Error()
-				}
-
-				sendError(c, http.StatusBadRequest, msg)
-
-				return
-			}
-		case "admin_promotion":
-			existingMembership.IsAdmin = true
-			existingMembership.AdminExpiresAt = request.AdminExpiresAt
-
-			if _, err := existingMembership.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
-				msg := "error approving admin promotion request , rolling back: " + err.Error()
-
-				if err := tx.Rollback(); err != nil {
-					msg += "error rolling back transaction: " + err.Error()
-				}
-
-				sendError(c, http.StatusBadRequest, msg)
-
-				return
-			}
-		}
-
-		if _, err := request.Delete(c.Request.Context(), tx); err != nil {
-			msg := "error deleting group request on approval, rolling back: " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
-			}
-
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
-		}
-
-		event, err := dbtools.AuditGroupMembershipApproved(c.Request.Context(), tx, getCtxAuditID(c), ctxUser, groupMem, request.Kind)
-		if err != nil {
-			msg := "error approving group request (audit): " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
-			}
-
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
-		}
-
-		if err := updateContextWithAuditEventData(c, event); err != nil {
-			msg := "error approving group request (audit): " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
-			}
-
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
-		}
-
-		membershipsAfter, err := dbtools.GetMembershipsForUser(c.Request.Context(), tx, user.ID, false)
-		if err != nil {
-			msg := "failed to compute new effective memberships: " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
-			}
-
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
-		}
-
-		if err := tx.Commit(); err != nil {
-			msg := "error committing group request approval, rolling back: " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
-			}
-
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
-		}
-
-		// only publish events for active users
-		if !isActiveUser(user) {
-			c.JSON(http.StatusNoContent, nil)
-			return
-		}
-
-		if err := r.EventBus.Publish(c.Request.Context(), events.GovernorMemberRequestsEventSubject, &events.Event{
-			Version: events.Version,
-			Action:  events.GovernorEventApprove,
-			AuditID: c.GetString(ginaudit.AuditIDContextKey),
-			GroupID: groupMem.GroupID,
-			UserID:  groupMem.UserID,
-			ActorID: getCtxActorID(c),
-		}); err != nil {
-			sendError(c, http.StatusBadRequest, "failed to publish request approve event, downstream changes may be delayed "+err.Error())
-			return
-		}
-
-		groupsDiff := dbtools.FindMemberDiff(membershipsBefore, membershipsAfter)
-
-		for _, enumeratedMembership := range groupsDiff {
-			if err := r.EventBus.Publish(c.Request.Context(), events.GovernorMembersEventSubject, &events.Event{
-				Version: events.Version,
-				Action:  events.GovernorEventCreate,
-				AuditID: c.GetString(ginaudit.AuditIDContextKey),
-				GroupID: enumeratedMembership.GroupID,
-				UserID:  enumeratedMembership.UserID,
-				ActorID: getCtxActorID(c),
-			}); err != nil {
-				sendError(c, http.StatusBadRequest, "failed to publish members create event, downstream changes may be delayed "+err.Error())
-				return
-			}
-		}
-
-		c.JSON(http.StatusNoContent, nil)
-
+	} else if !handleServiceResult(c, auditEvents, err) {
 		return
+	}
 
-	case "deny":
-		tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-		if err != nil {
-			sendError(c, http.StatusBadRequest, "error starting group request denial transaction: "+err.Error())
-			return
-		}
-
-		// denying a request simply deletes it
-		if _, err := request.Delete(c.Request.Context(), tx); err != nil {
-			sendError(c, http.StatusBadRequest, "failed to delete group request: "+err.Error())
-			return
-		}
-
-		event, err := dbtools.AuditGroupMembershipDenied(c.Request.Context(), tx, getCtxAuditID(c), ctxUser, request)
-		if err != nil {
-			msg := "error denying group request (audit): " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
-			}
-
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
-		}
-
-		if err := tx.Commit(); err != nil {
-			msg := "error committing group request deny, rolling back: " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
-			}
-
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
-		}
-
-		if err := updateContextWithAuditEventData(c, event); err != nil {
-			msg := "error denying group request (audit): " + err.Error()
-
-			if err := tx.Rollback(); err != nil {
-				msg += "error rolling back transaction: " + err.Error()
-			}
-
-			sendError(c, http.StatusBadRequest, msg)
-
-			return
-		}
+	c.JSON(http.StatusNoContent, nil)
+}
 
-		if err := r.EventBus.Publish(c.Request.Context(), events.GovernorMemberRequestsEventSubject, &events.Event{
-			Version: events.Version,
-			Action:  events.GovernorEventDeny,
-			AuditID: c.GetString(ginaudit.AuditIDContextKey),
-			GroupID: request.GroupID,
-			UserID:  request.UserID,
-			ActorID: getCtxActorID(c),
-		}); err != nil {
-			sendError(c, http.StatusBadRequest, "failed to publish request deny event, downstream changes may be delayed "+err.Error())
-			return
-		}
+// getGroupMembershipsAll returns all group memberships for all groups, the
+// membership notes are only listed for the groups whose notes the caller can
+// read
+func (r *Router) getGroupMembershipsAll(c *gin.Context) {
+	ctx := c.Request.Context()
 
-		c.JSON(http.StatusNoContent, nil)
+	since, ok := updatedSinceParam(c)
+	if !ok {
+		return
+	}
 
+	canReadNote, err := r.membershipNoteReader(c)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting group memberships"+err.Error())
 		return
+	}
 
-	default:
-		sendError(c, http.StatusBadRequest, "invalid action "+req.Action)
+	if since != nil {
+		r.membershipChangesSince(c, *since, canReadNote)
 		return
 	}
-}
 
-// getGroupMembershipsAll returns all group memberships for all groups
-func (r *Router) getGroupMembershipsAll(c *gin.Context) {
-	ctx := c.Request.Context()
+	// the memberships are limited to the groups and users of the organization
+	// instead of loading the ones of other organizations
 	queryMods := []qm.QueryMod{
+		qm.InnerJoin("groups ON groups.id = group_memberships.group_id AND groups.deleted_at IS NULL"),
+		qm.InnerJoin("users ON users.id = group_memberships.user_id AND users.deleted_at IS NULL"),
+		tenancy.Scope(ctx, models.TableNames.Groups),
+		tenancy.Scope(ctx, models.TableNames.Users),
 		qm.Load("User"),
 		qm.Load("Group"),
 	}
@@ -1292,7 +743,8 @@ func (r *Router) getGroupMembershipsAll(c *gin.Context) {
 	var response []GroupMembership
 
 	if _, ok := c.GetQuery("expired"); ok {
-		queryMods = append(queryMods, qm.Where("expires_at <= NOW()"))
+		// exempt memberships aren't flagged as expired until their exemption expires
+		queryMods = append(queryMods, qm.Where("group_memberships.expires_at <= NOW()"), dbtools.UnexemptGroupMemberships())
 
 		groupMemberships, err := models.GroupMemberships(queryMods...).All(ctx, r.DB)
 		if err != nil {
@@ -1313,6 +765,13 @@ func (r *Router) getGroupMembershipsAll(c *gin.Context) {
 				ExpiresAt:      m.ExpiresAt,
 				IsAdmin:        m.IsAdmin,
 				AdminExpiresAt: m.AdminExpiresAt,
+				Source:         m.Source,
+				SourceRef:      m.SourceRef,
+				Justification:  m.Justification,
+			}
+
+			if canReadNote(m.GroupID) {
+				response[i].Note = m.Note
 			}
 		}
 	} else {
@@ -1322,6 +781,29 @@ func (r *Router) getGroupMembershipsAll(c *gin.Context) {
 			return
 		}
 
+		exempt, err := r.svc().ListMembershipExemptions(ctx, false)
+		if err != nil {
+			sendError(c, http.StatusInternalServerError, "error getting group memberships"+err.Error())
+			return
+		}
+
+		exemptions := make(map[[2]string]*models.GroupMembership, len(exempt))
+		for _, m := range exempt {
+			exemptions[[2]string{m.GroupID, m.UserID}] = m
+		}
+
+		justifications, err := r.svc().MembershipJustifications(ctx)
+		if err != nil {
+			sendError(c, http.StatusInternalServerError, "error getting group memberships"+err.Error())
+			return
+		}
+
+		notes, err := r.svc().MembershipNotes(ctx)
+		if err != nil {
+			sendError(c, http.StatusInternalServerError, "error getting group memberships"+err.Error())
+			return
+		}
+
 		response = make([]GroupMembership, len(enumeratedMemberships))
 		for i, m := range enumeratedMemberships {
 			response[i] = GroupMembership{
@@ -1333,6 +815,17 @@ func (r *Router) getGroupMembershipsAll(c *gin.Context) {
 				ExpiresAt:      m.ExpiresAt,
 				IsAdmin:        m.IsAdmin,
 				AdminExpiresAt: m.AdminExpiresAt,
+				Source:         m.Source,
+				SourceRef:      m.SourceRef,
+			}
+
+			if m.Direct {
+				response[i].Justification = justifications[[2]string{m.GroupID, m.UserID}]
+				response[i].Exemption = membershipExemption(exemptions[[2]string{m.GroupID, m.UserID}])
+
+				if canReadNote(m.GroupID) {
+					response[i].Note = notes[[2]string{m.GroupID, m.UserID}]
+				}
 			}
 		}
 	}
@@ -1343,13 +836,19 @@ func (r *Router) getGroupMembershipsAll(c *gin.Context) {
 // getGroupRequests returns all pending requests to join any group
 func (r *Router) getGroupRequestsAll(c *gin.Context) {
 	ctx := c.Request.Context()
+	// the requests are limited to the groups and users of the organization
+	// instead of loading the ones of other organizations
 	queryMods := []qm.QueryMod{
+		qm.InnerJoin("groups ON groups.id = group_membership_requests.group_id AND groups.deleted_at IS NULL"),
+		qm.InnerJoin("users ON users.id = group_membership_requests.user_id AND users.deleted_at IS NULL"),
+		tenancy.Scope(ctx, models.TableNames.Groups),
+		tenancy.Scope(ctx, models.TableNames.Users),
 		qm.Load("User"),
 		qm.Load("Group"),
 	}
 
 	if _, ok := c.GetQuery("expired"); ok {
-		queryMods = append(queryMods, qm.Where("expires_at <= NOW()"))
+		queryMods = append(queryMods, qm.Where("group_membership_requests.expires_at <= NOW()"))
 	}
 
 	groupMembershipRequests, err := models.GroupMembershipRequests(queryMods...).All(ctx, r.DB)
@@ -1383,3 +882,32 @@ func (r *Router) getGroupRequestsAll(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+// publishMembershipDiff publishes the members events of the effective memberships a group or hierarchy
+// change added, removed or changed, the response is sent as 202 Accepted when publishing fails
+func (r *Router) publishMembershipDiff(c *gin.Context, before, after []dbtools.EnumeratedMembership) {
+	diffs := []struct {
+		action      string
+		memberships []dbtools.EnumeratedMembership
+	}{
+		{events.GovernorEventCreate, dbtools.FindMemberDiff(before, after)},
+		{events.GovernorEventUpdate, dbtools.FindChangedMembers(before, after)},
+		{events.GovernorEventDelete, dbtools.FindMemberDiff(after, before)},
+	}
+
+	for _, diff := range diffs {
+		for _, enumeratedMembership := range diff.memberships {
+			if err := r.EventBus.Publish(c.Request.Context(), events.GovernorMembersEventSubject, &events.Event{
+				Version: events.MemberVersion,
+				Action:  diff.action,
+				AuditID: c.GetString(ginaudit.AuditIDContextKey),
+				GroupID: enumeratedMembership.GroupID,
+				UserID:  enumeratedMembership.UserID,
+				ActorID: getCtxActorID(c),
+				Member:  enumeratedMembership.EventMember(),
+			}); err != nil {
+				acceptChange(c, fmt.Errorf("failed to publish members %s event: %w", strings.ToLower(diff.action), err))
+			}
+		}
+	}
+}
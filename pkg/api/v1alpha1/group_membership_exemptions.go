@@ -0,0 +1,117 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// MembershipExemption is the exemption of a direct membership from
+// expiration and review
+type MembershipExemption struct {
+	Justification string      `json:"justification"`
+	ApprovedBy    null.String `json:"approved_by"`
+	ExemptedAt    time.Time   `json:"exempted_at"`
+	ExpiresAt     time.Time   `json:"expires_at"`
+}
+
+// GroupMembershipExemption is an exempt direct membership, as listed in the
+// membership exemptions report
+type GroupMembershipExemption struct {
+	GroupID   string              `json:"group_id"`
+	GroupSlug string              `json:"group_slug"`
+	UserID    string              `json:"user_id"`
+	UserEmail string              `json:"user_email"`
+	ExpiresAt null.Time           `json:"expires_at"`
+	Exemption MembershipExemption `json:"exemption"`
+}
+
+// GroupMembershipExemptionReq is a request to exempt a membership from
+// expiration and review
+type GroupMembershipExemptionReq struct {
+	Justification string    `json:"justification" binding:"required,max=1024"`
+	ExpiresAt     time.Time `json:"expires_at" binding:"required"`
+}
+
+// membershipExemption returns the exemption of a membership, or nil when the
+// membership was never exempted
+func membershipExemption(m *models.GroupMembership) *MembershipExemption {
+	if m == nil || !m.ExemptedAt.Valid {
+		return nil
+	}
+
+	return &MembershipExemption{
+		Justification: m.ExemptionJustification.String,
+		ApprovedBy:    m.ExemptionApprovedBy,
+		ExemptedAt:    m.ExemptedAt.Time,
+		ExpiresAt:     m.ExemptionExpiresAt.Time,
+	}
+}
+
+// exemptGroupMember exempts a direct membership from expiration and review
+func (r *Router) exemptGroupMember(c *gin.Context) {
+	req := GroupMembershipExemptionReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	membership, event, err := r.svc().ExemptMember(c.Request.Context(), ctxActor(c), c.Param("id"), c.Param("uid"), req.Justification, req.ExpiresAt)
+	if !handleServiceResult(c, event, err) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, membershipExemption(membership))
+}
+
+// removeGroupMemberExemption removes the exemption of a direct membership
+func (r *Router) removeGroupMemberExemption(c *gin.Context) {
+	_, event, err := r.svc().RemoveMemberExemption(c.Request.Context(), ctxActor(c), c.Param("id"), c.Param("uid"))
+	if !handleServiceResult(c, event, err) {
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// listMembershipExemptions reports the exempt memberships of every group, or
+// of the group given with ?group, the expired exemptions are included with ?expired
+func (r *Router) listMembershipExemptions(c *gin.Context) {
+	queryMods := []qm.QueryMod{}
+
+	if gid := c.Query("group"); gid != "" {
+		group, err := r.svc().FindGroup(c.Request.Context(), gid, false)
+		if err != nil {
+			sendServiceError(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		queryMods = append(queryMods, qm.Where("group_memberships.group_id = ?", group.ID))
+	}
+
+	_, expired := c.GetQuery("expired")
+
+	memberships, err := r.svc().ListMembershipExemptions(c.Request.Context(), expired, queryMods...)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := make([]GroupMembershipExemption, len(memberships))
+	for i, m := range memberships {
+		response[i] = GroupMembershipExemption{
+			GroupID:   m.GroupID,
+			GroupSlug: m.R.Group.Slug,
+			UserID:    m.UserID,
+			UserEmail: m.R.User.Email,
+			ExpiresAt: m.ExpiresAt,
+			Exemption: *membershipExemption(m),
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
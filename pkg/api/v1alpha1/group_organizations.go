@@ -1,13 +1,14 @@
 package v1alpha1
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"github.com/metal-toolbox/auditevent/ginaudit"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
@@ -29,7 +30,7 @@ func (r *Router) addGroupOrganization(c *gin.Context) {
 	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -47,7 +48,7 @@ func (r *Router) addGroupOrganization(c *gin.Context) {
 	org, err := models.Organizations(qo).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "organization not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeOrganizationNotFound, "organization not found: "+err.Error())
 			return
 		}
 
@@ -56,6 +57,10 @@ func (r *Router) addGroupOrganization(c *gin.Context) {
 		return
 	}
 
+	if !r.checkGroupNamingPolicies(c, group.Name, group.Slug, false, org.ID) {
+		return
+	}
+
 	exists, err := models.GroupOrganizations(qm.Where("group_id=?", group.ID), qm.And("organization_id=?", org.ID)).Exists(c.Request.Context(), r.DB)
 	if err != nil {
 		sendError(c, http.StatusInternalServerError, "error checking group organization link exists "+err.Error())
@@ -63,7 +68,7 @@ func (r *Router) addGroupOrganization(c *gin.Context) {
 	}
 
 	if exists {
-		sendError(c, http.StatusConflict, "organization already linked with group")
+		sendErrorWithCode(c, http.StatusConflict, ErrCodeOrganizationAlreadyLinked, "organization already linked with group")
 		return
 	}
 
@@ -73,71 +78,26 @@ func (r *Router) addGroupOrganization(c *gin.Context) {
 		OrganizationID: org.ID,
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting group organization create transaction: "+err.Error())
-		return
-	}
-
-	if err := group.AddGroupOrganizations(c.Request.Context(), tx, true, groupOrg); err != nil {
-		msg := "failed to create group organization: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditGroupOrganizationCreated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), groupOrg)
-	if err != nil {
-		msg := "error creating group organization (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := group.AddGroupOrganizations(c.Request.Context(), tx, true, groupOrg); err != nil {
+			return abortTx(http.StatusBadRequest, "failed to create group organization: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error creating group organization (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorGroupsEventSubject, &events.Event{
+			Action:  events.GovernorEventUpdate,
+			GroupID: group.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupOrganizationCreated(ctx, exec, pID, actor, groupOrg)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating group organization (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	if err := tx.Commit(); err != nil {
-		msg := "error committing group organization create, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorGroupsEventSubject, &events.Event{
-		Version: events.Version,
-		Action:  events.GovernorEventUpdate,
-		AuditID: c.GetString(ginaudit.AuditIDContextKey),
-		ActorID: getCtxActorID(c),
-		GroupID: group.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish group update event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusNoContent, nil)
 }
@@ -156,7 +116,7 @@ func (r *Router) removeGroupOrganization(c *gin.Context) {
 	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -174,7 +134,7 @@ func (r *Router) removeGroupOrganization(c *gin.Context) {
 	org, err := models.Organizations(qo).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "organization not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeOrganizationNotFound, "organization not found: "+err.Error())
 			return
 		}
 
@@ -198,71 +158,26 @@ func (r *Router) removeGroupOrganization(c *gin.Context) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting group organization delete transaction: "+err.Error())
-		return
-	}
-
-	if _, err := groupOrg.Delete(c.Request.Context(), r.DB); err != nil {
-		msg := "failed to delete group organization link: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := groupOrg.Delete(c.Request.Context(), r.DB); err != nil {
+			return abortTx(http.StatusBadRequest, "failed to delete group organization link: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditGroupOrganizationDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), groupOrg)
-	if err != nil {
-		msg := "error deleting group organization (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorGroupsEventSubject, &events.Event{
+			Action:  events.GovernorEventUpdate,
+			GroupID: group.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupOrganizationDeleted(ctx, exec, pID, actor, groupOrg)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting group organization (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error deleting group organization (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing group organization delete, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorGroupsEventSubject, &events.Event{
-		Version: events.Version,
-		Action:  events.GovernorEventUpdate,
-		AuditID: c.GetString(ginaudit.AuditIDContextKey),
-		ActorID: getCtxActorID(c),
-		GroupID: group.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish group update event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusNoContent, nil)
 }
@@ -0,0 +1,48 @@
+package v1alpha1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// protectGroup protects a group, it can't be deleted or detached from its
+// hierarchies until it is unprotected
+func (r *Router) protectGroup(c *gin.Context) {
+	group, event, err := r.svc().ProtectGroup(c.Request.Context(), ctxActor(c), c.Param("id"))
+	if !handleServiceResult(c, event, err) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, group)
+}
+
+// unprotectGroup removes the protection of a group
+func (r *Router) unprotectGroup(c *gin.Context) {
+	group, event, err := r.svc().UnprotectGroup(c.Request.Context(), ctxActor(c), c.Param("id"))
+	if !handleServiceResult(c, event, err) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, group)
+}
+
+// checkHierarchyProtection sends an error response and returns false when the
+// parent or the member group of a hierarchy is protected, the denied change is
+// recorded in the audit events
+func (r *Router) checkHierarchyProtection(c *gin.Context, parentGroupIDOrSlug, memberGroupIDOrSlug, change string) bool {
+	for _, id := range []string{parentGroupIDOrSlug, memberGroupIDOrSlug} {
+		group, err := r.svc().FindGroup(c.Request.Context(), id, false)
+		if err != nil {
+			sendServiceError(c, http.StatusInternalServerError, err)
+			return false
+		}
+
+		event, err := r.svc().CheckGroupProtection(c.Request.Context(), ctxActor(c), group, change)
+		if !handleServiceResult(c, event, err) {
+			return false
+		}
+	}
+
+	return true
+}
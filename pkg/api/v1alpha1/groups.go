@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -17,6 +18,8 @@ import (
 
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
 )
 
@@ -35,117 +38,150 @@ type GroupReq struct {
 	Name            string `json:"name"`
 	Description     string `json:"description"`
 	Note            string `json:"note"`
-	ApproverGroupID string `json:"approver_group_id,omitempty"`
+	ApproverGroupID string `json:"approver_group_id,omitempty" binding:"omitempty,uuid"`
+
+	// The metadata fields and settings are left unchanged on update when
+	// omitted, an empty value clears them
+	OwnerContact *string `json:"owner_contact,omitempty"`
+	DocsURL      *string `json:"docs_url,omitempty"`
+	SlackChannel *string `json:"slack_channel,omitempty"`
+	CostCenter   *string `json:"cost_center,omitempty"`
+	// DefaultMembershipTTLDays is the number of days new memberships expire
+	// after when they are added or approved without an expiration, 0 disables it
+	DefaultMembershipTTLDays *int64 `json:"default_membership_ttl_days,omitempty" binding:"omitempty,min=0,max=3650"`
+	// MemberSoftLimit is the number of direct members after which the group
+	// admins are warned about new members, 0 disables it
+	MemberSoftLimit *int64 `json:"member_soft_limit,omitempty" binding:"omitempty,min=0"`
+	// MemberHardLimit is the maximum number of direct members of the group,
+	// new members are rejected once it's reached, 0 disables it
+	MemberHardLimit *int64 `json:"member_hard_limit,omitempty" binding:"omitempty,min=0"`
+	// JustificationMinLength is the minimum length of the justification
+	// required to add a member or request a membership, 0 disables it
+	JustificationMinLength *int64 `json:"justification_min_length,omitempty" binding:"omitempty,min=0,max=1024"`
 }
 
-// listGroups lists the groups as JSON
-func (r *Router) listGroups(c *gin.Context) {
-	queryMods := []qm.QueryMod{
-		qm.OrderBy("name"),
-		qm.Load("GroupOrganizations"),
-		qm.Load("GroupOrganizations.Organization"),
-		qm.Load("GroupApplications"),
-		qm.Load("GroupApplications.Application"),
+// groupMetadataRules are the validation rules of the group metadata fields
+func groupMetadataRules(req *GroupReq) []fieldRule {
+	value := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+
+		return *s
 	}
 
-	if _, ok := c.GetQuery("deleted"); ok {
-		queryMods = append(queryMods, qm.WithDeleted())
+	return []fieldRule{
+		{"owner_contact", value(req.OwnerContact), "omitempty,email,max=254"},
+		{"docs_url", value(req.DocsURL), "omitempty,http_url,max=2048"},
+		{"slack_channel", value(req.SlackChannel), "omitempty,startswith=#,min=2,max=81,lowercase,excludesall= .0x2C"},
+		{"cost_center", value(req.CostCenter), "omitempty,printascii,max=64"},
 	}
+}
 
-	groups, err := models.Groups(queryMods...).All(c.Request.Context(), r.DB)
-	if err != nil {
-		r.Logger.Error("error fetching groups", zap.Error(err))
-		sendError(c, http.StatusBadRequest, "error listing groups: "+err.Error())
+// setGroupMetadata sets the metadata fields and settings given in the request on the group
+func setGroupMetadata(group *models.Group, req *GroupReq) {
+	if req.OwnerContact != nil {
+		group.OwnerContact = *req.OwnerContact
+	}
 
-		return
+	if req.DocsURL != nil {
+		group.DocsURL = *req.DocsURL
 	}
 
-	c.JSON(http.StatusOK, groups)
-}
+	if req.SlackChannel != nil {
+		group.SlackChannel = *req.SlackChannel
+	}
 
-// getGroup gets a group and it's relationships
-func (r *Router) getGroup(c *gin.Context) {
-	queryMods := []qm.QueryMod{
-		qm.Load("GroupMembershipRequests"),
-		qm.Load("GroupMembershipRequests.User"),
-		qm.Load("GroupOrganizations"),
-		qm.Load("GroupOrganizations.Organization"),
-		qm.Load("GroupApplications"),
-		qm.Load("GroupApplications.Application"),
+	if req.CostCenter != nil {
+		group.CostCenter = *req.CostCenter
 	}
 
-	id := c.Param("id")
+	if req.DefaultMembershipTTLDays != nil {
+		group.DefaultMembershipTTLDays = *req.DefaultMembershipTTLDays
+	}
 
-	deleted := false
-	if _, deleted = c.GetQuery("deleted"); deleted {
-		queryMods = append(queryMods, qm.WithDeleted())
+	if req.MemberSoftLimit != nil {
+		group.MemberSoftLimit = *req.MemberSoftLimit
 	}
 
-	q := qm.Where("id = ?", id)
+	if req.MemberHardLimit != nil {
+		group.MemberHardLimit = *req.MemberHardLimit
+	}
 
-	if _, err := uuid.Parse(id); err != nil {
-		if deleted {
-			sendError(c, http.StatusBadRequest, "unable to get deleted group by slug, use the group id")
-			return
-		}
+	if req.JustificationMinLength != nil {
+		group.JustificationMinLength = *req.JustificationMinLength
+	}
+}
 
-		q = qm.Where("slug = ?", id)
+// validateMemberLimits checks the member soft limit of the group isn't above its hard limit
+func validateMemberLimits(group *models.Group) error {
+	if group.MemberSoftLimit > 0 && group.MemberHardLimit > 0 && group.MemberSoftLimit > group.MemberHardLimit {
+		return ErrInvalidMemberLimits
 	}
 
-	queryMods = append(queryMods, q)
+	return nil
+}
 
-	group, err := models.Groups(queryMods...).One(c.Request.Context(), r.DB)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
-			return
-		}
+// listGroups lists the groups as JSON
+func (r *Router) listGroups(c *gin.Context) {
+	queryMods := []qm.QueryMod{
+		tenancy.Scope(c.Request.Context(), models.TableNames.Groups),
+		qm.OrderBy("name"),
+		qm.Load("GroupOrganizations"),
+		qm.Load("GroupOrganizations.Organization"),
+		qm.Load("GroupApplications"),
+		qm.Load("GroupApplications.Application", tenancy.Scope(c.Request.Context(), models.TableNames.Applications)),
+	}
 
-		sendError(c, http.StatusInternalServerError, "error getting group"+err.Error())
+	if _, ok := c.GetQuery("deleted"); ok {
+		queryMods = append(queryMods, qm.WithDeleted())
+	}
 
+	since, ok := updatedSinceParam(c)
+	if !ok {
 		return
 	}
 
-	enumeratedMembers, err := dbtools.GetMembersOfGroup(c.Request.Context(), r.DB.DB, group.ID, false)
+	if since != nil {
+		queryMods = append(queryMods, dbtools.ChangedSince(models.TableNames.Groups, *since)...)
+	}
+
+	groups, err := models.Groups(queryMods...).All(c.Request.Context(), r.DB)
 	if err != nil {
-		sendError(c, http.StatusInternalServerError, "error enumerating group membership: "+err.Error())
+		r.Logger.Error("error fetching groups", zap.Error(err))
+		sendError(c, http.StatusBadRequest, "error listing groups: "+err.Error())
+
 		return
 	}
 
-	members := make([]string, len(enumeratedMembers))
-
-	membersDirect := make([]string, 0)
+	c.JSON(http.StatusOK, groups)
+}
 
-	for i, m := range enumeratedMembers {
-		members[i] = m.UserID
+// getGroup gets a group and it's relationships
+func (r *Router) getGroup(c *gin.Context) {
+	_, deleted := c.GetQuery("deleted")
 
-		if m.Direct {
-			membersDirect = append(membersDirect, m.UserID)
+	details, err := r.svc().GetGroup(c.Request.Context(), c.Param("id"), deleted)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrGetDeletedBySlug):
+			sendError(c, http.StatusBadRequest, "unable to get deleted group by slug, use the group id")
+		case errors.Is(err, service.ErrGroupNotFound):
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
+		default:
+			sendError(c, http.StatusInternalServerError, "error getting group: "+err.Error())
 		}
-	}
 
-	requests := make([]string, len(group.R.GroupMembershipRequests))
-	for i, r := range group.R.GroupMembershipRequests {
-		requests[i] = r.R.User.ID
-	}
-
-	organizations := make([]string, len(group.R.GroupOrganizations))
-	for i, o := range group.R.GroupOrganizations {
-		organizations[i] = o.R.Organization.ID
-	}
-
-	applications := make([]string, len(group.R.GroupApplications))
-	for i, o := range group.R.GroupApplications {
-		applications[i] = o.R.Application.ID
+		return
 	}
 
 	c.JSON(http.StatusOK, Group{
-		Group:              group,
-		Members:            members,
-		MembersDirect:      membersDirect,
-		MembershipRequests: requests,
-		Organizations:      organizations,
-		Applications:       applications,
+		Group:              details.Group,
+		Members:            details.Members,
+		MembersDirect:      details.MembersDirect,
+		MembershipRequests: details.MembershipRequests,
+		Organizations:      details.Organizations,
+		Applications:       details.Applications,
 	})
 }
 
@@ -166,8 +202,11 @@ func createGroupRequestValidator(group *models.Group) (string, error) {
 // createGroup creates a user in the database
 func (r *Router) createGroup(c *gin.Context) {
 	req := GroupReq{}
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	if !validateFields(c, groupMetadataRules(&req)...) {
 		return
 	}
 
@@ -187,79 +226,46 @@ func (r *Router) createGroup(c *gin.Context) {
 		ApproverGroup: approverGroupID,
 	}
 
+	setGroupMetadata(group, &req)
+
 	// Validation
 	if displayMessage, err := createGroupRequestValidator(group); err != nil {
-		sendErrorWithDisplayMessage(c, http.StatusBadRequest, err.Error(), displayMessage)
+		sendErrorWithDisplayMessage(c, http.StatusBadRequest, err, displayMessage)
 		return
 	}
 
-	dbtools.SetGroupSlug(group)
-
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting group create transaction: "+err.Error())
+	if err := validateMemberLimits(group); err != nil {
+		sendErrorFromErr(c, http.StatusBadRequest, err)
 		return
 	}
 
-	if err := group.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := "error creating group: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditGroupCreated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), group)
-	if err != nil {
-		msg := "error creating group (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
+	dbtools.SetGroupSlug(group)
 
+	if !r.checkGroupNamingPolicies(c, group.Name, group.Slug, true) {
 		return
 	}
 
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error creating group (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := group.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating group: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing group create, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorGroupsEventSubject, &events.Event{
+			Action:  events.GovernorEventCreate,
+			GroupID: group.ID,
+			After:   group,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupCreated(ctx, exec, pID, actor, group)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating group (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorGroupsEventSubject, &events.Event{
-		Version: events.Version,
-		Action:  events.GovernorEventCreate,
-		AuditID: c.GetString(ginaudit.AuditIDContextKey),
-		ActorID: getCtxActorID(c),
-		GroupID: group.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish group create event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, group)
 }
@@ -277,7 +283,7 @@ func (r *Router) updateGroup(c *gin.Context) {
 	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -289,8 +295,11 @@ func (r *Router) updateGroup(c *gin.Context) {
 	original := *group
 
 	req := GroupReq{}
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	if !validateFields(c, groupMetadataRules(&req)...) {
 		return
 	}
 
@@ -307,95 +316,57 @@ func (r *Router) updateGroup(c *gin.Context) {
 
 	group.Description = req.Description
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting group update transaction: "+err.Error())
-		return
-	}
-
-	if _, err := group.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := "error updating group: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditGroupUpdated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, group)
-	if err != nil {
-		msg := "error updating group (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
+	setGroupMetadata(group, &req)
 
+	if err := validateMemberLimits(group); err != nil {
+		sendErrorFromErr(c, http.StatusBadRequest, err)
 		return
 	}
 
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error updating group (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := group.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating group: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing group update, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorGroupsEventSubject, &events.Event{
+			Action:  events.GovernorEventUpdate,
+			GroupID: group.ID,
+			Before:  &original,
+			After:   group,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupUpdated(ctx, exec, pID, actor, &original, group)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating group (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorGroupsEventSubject, &events.Event{
-		Version: events.Version,
-		Action:  events.GovernorEventUpdate,
-		AuditID: c.GetString(ginaudit.AuditIDContextKey),
-		ActorID: getCtxActorID(c),
-		GroupID: group.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish group update event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, group)
 }
 
-// deleteGroup marks a group deleted in the database
+// deleteGroup marks a group deleted in the database, after removing its memberships, hierarchies, pending
+// requests, and organization and application links. With detach_only the group is only detached from all of
+// those and is kept.
 func (r *Router) deleteGroup(c *gin.Context) {
 	id := c.Param("id")
 
+	_, detachOnly := c.GetQuery("detach_only")
+
 	q := qm.Where("id = ?", id)
 
 	if _, err := uuid.Parse(id); err != nil {
 		q = qm.Where("slug = ?", id)
 	}
 
-	group, err := models.Groups(
-		q,
-		qm.Load("GroupMemberships"),
-		qm.Load("GroupMembershipRequests"),
-		qm.Load("GroupOrganizations"),
-		qm.Load("GroupApplications"),
-	).One(c.Request.Context(), r.DB)
+	group, err := models.Groups(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "group not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, "group not found: "+err.Error())
 			return
 		}
 
@@ -404,142 +375,126 @@ func (r *Router) deleteGroup(c *gin.Context) {
 		return
 	}
 
-	original := *group
-
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting delete transaction: "+err.Error())
-		return
+	change := "deleting group " + group.Slug
+	if detachOnly {
+		change = "detaching group " + group.Slug
 	}
 
-	// delete all group memberships
-	if _, err := group.R.GroupMemberships.DeleteAll(c.Request.Context(), tx); err != nil {
-		msg := "error deleting group membership, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
+	event, err := r.svc().CheckGroupDeletion(c.Request.Context(), ctxActor(c), group, change)
+	if !handleServiceResult(c, event, err) {
 		return
 	}
 
-	// delete all group membership requests
-	if _, err := group.R.GroupMembershipRequests.DeleteAll(c.Request.Context(), tx); err != nil {
-		msg := "error deleting group membership requests, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
+	original := *group
 
-	// delete all org links
-	if _, err := group.R.GroupOrganizations.DeleteAll(c.Request.Context(), tx); err != nil {
-		msg := "error deleting group org link, rolling back: " + err.Error()
+	var (
+		detached                            *dbtools.GroupDetachment
+		membershipsBefore, membershipsAfter []dbtools.EnumeratedMembership
+	)
 
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		membershipsBefore, err = dbtools.GetAllGroupMemberships(c.Request.Context(), tx, false)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "failed to compute effective memberships: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	appLinks := group.R.GroupApplications
-
-	// delete all app links
-	if _, err := group.R.GroupApplications.DeleteAll(c.Request.Context(), tx, false); err != nil {
-		msg := "error deleting group app link, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		detached, err = dbtools.DetachGroup(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), group.ID)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error detaching group, rolling back: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	// finally soft delete the db
-	if _, err := group.Delete(c.Request.Context(), tx, false); err != nil {
-		msg := "error deleting group, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		if detachOnly {
+			// the group is kept, only the detachment is audited
+			event, err := dbtools.AuditGroupDetached(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), group)
+			if err != nil {
+				return abortTx(http.StatusBadRequest, "error detaching group (audit): "+err.Error(), err)
+			}
+
+			if err := updateContextWithAuditEventData(c, event); err != nil {
+				return abortTx(http.StatusBadRequest, "error detaching group (audit): "+err.Error(), err)
+			}
+		} else {
+			// finally soft delete the db
+			if _, err := group.Delete(c.Request.Context(), tx, false); err != nil {
+				return abortTx(http.StatusBadRequest, "error deleting group, rolling back: "+err.Error(), err)
+			}
+
+			if err := r.recordChange(c, tx, events.GovernorGroupsEventSubject, &events.Event{
+				Action:  events.GovernorEventDelete,
+				GroupID: group.ID,
+				Before:  &original,
+			}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+				return dbtools.AuditGroupDeleted(ctx, exec, pID, actor, &original, group)
+			}); err != nil {
+				return abortTx(http.StatusBadRequest, "error deleting group (audit): "+err.Error(), err)
+			}
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		membershipsAfter, err = dbtools.GetAllGroupMemberships(c.Request.Context(), tx, false)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "failed to compute new effective memberships: "+err.Error(), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
-	event, err := dbtools.AuditGroupDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, group)
-	if err != nil {
-		msg := "error deleting group (audit: " + err.Error()
+	r.publishMembershipDiff(c, membershipsBefore, membershipsAfter)
 
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
+	r.publishGroupDetachment(c, detached)
 
-		sendError(c, http.StatusBadRequest, msg)
+	r.publishChanges(c)
 
-		return
-	}
+	c.JSON(http.StatusAccepted, group)
+}
 
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error deleting group (audit): " + err.Error()
+// publishGroupDetachment publishes the events of the requests, hierarchies and application links removed
+// from a group, failures are only logged since the group change was already committed
+func (r *Router) publishGroupDetachment(c *gin.Context, d *dbtools.GroupDetachment) {
+	publish := func(sub, kind string, e *events.Event) {
+		e.AuditID = c.GetString(ginaudit.AuditIDContextKey)
+		e.ActorID = getCtxActorID(c)
 
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		if err := r.EventBus.Publish(c.Request.Context(), sub, e); err != nil {
+			r.Logger.Warn("failed to publish "+kind+" event, downstream changes may be delayed", zap.Error(err))
 		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
 	}
 
-	if err := tx.Commit(); err != nil {
-		msg := "error committing group delete, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
-		}
+	for _, req := range d.MembershipRequests {
+		publish(events.GovernorMemberRequestsEventSubject, "member request revoke", &events.Event{
+			Version: events.Version,
+			Action:  events.GovernorEventRevoke,
+			GroupID: req.GroupID,
+			UserID:  req.UserID,
+		})
+	}
 
-		sendError(c, http.StatusBadRequest, msg)
+	for _, req := range d.ApplicationRequests {
+		publish(events.GovernorApplicationLinkRequestsEventSubject, "application link request revoke", &events.Event{
+			Version:       events.Version,
+			Action:        events.GovernorEventRevoke,
+			GroupID:       req.GroupID,
+			ApplicationID: req.ApplicationID,
+		})
+	}
 
-		return
+	for _, h := range d.Hierarchies {
+		publish(events.GovernorHierarchiesEventSubject, "hierarchy delete", &events.Event{
+			Version:   events.HierarchyVersion,
+			Action:    events.GovernorEventDelete,
+			GroupID:   h.ParentGroupID,
+			Hierarchy: dbtools.GroupHierarchyEventHierarchy(h),
+			Before:    h,
+		})
 	}
 
-	for _, app := range appLinks {
-		if err := r.EventBus.Publish(c.Request.Context(), events.GovernorApplicationsEventSubject, &events.Event{
+	for _, app := range d.Applications {
+		publish(events.GovernorApplicationsEventSubject, "application unlink", &events.Event{
 			Version:       events.Version,
 			Action:        events.GovernorEventDelete,
-			AuditID:       c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:       getCtxActorID(c),
 			GroupID:       app.GroupID,
 			ApplicationID: app.ApplicationID,
-		}); err != nil {
-			r.Logger.Warn("failed to publish application unlink event, downstream changes may be delayed", zap.Error(err))
-			continue
-		}
+		})
 	}
-
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorGroupsEventSubject, &events.Event{
-		Version: events.Version,
-		Action:  events.GovernorEventDelete,
-		AuditID: c.GetString(ginaudit.AuditIDContextKey),
-		ActorID: getCtxActorID(c),
-		GroupID: group.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish group delete event, downstream changes may be delayed "+err.Error())
-		return
-	}
-
-	c.JSON(http.StatusAccepted, group)
 }
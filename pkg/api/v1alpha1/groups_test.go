@@ -43,3 +43,89 @@ func TestCreateGroupRequestValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupMetadata(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	tests := map[string]struct {
+		req    GroupReq
+		wantOK bool
+	}{
+		"Omitted": {GroupReq{}, true},
+		"Cleared": {GroupReq{OwnerContact: str(""), DocsURL: str(""), SlackChannel: str(""), CostCenter: str("")}, true},
+		"Valid": {
+			GroupReq{
+				OwnerContact: str("team@example.com"),
+				DocsURL:      str("https://docs.example.com/team"),
+				SlackChannel: str("#team-infra"),
+				CostCenter:   str("CC-1234"),
+			},
+			true,
+		},
+		"InvalidOwnerContact": {GroupReq{OwnerContact: str("not an email")}, false},
+		"InvalidDocsURL":      {GroupReq{DocsURL: str("docs/team")}, false},
+		"SlackChannelNoHash":  {GroupReq{SlackChannel: str("team-infra")}, false},
+		"SlackChannelUpper":   {GroupReq{SlackChannel: str("#Team")}, false},
+		"SlackChannelSpace":   {GroupReq{SlackChannel: str("#team infra")}, false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, _ := newValidationTestContext("{}")
+			assert.Equal(t, test.wantOK, validateFields(c, groupMetadataRules(&test.req)...))
+		})
+	}
+
+	group := &models.Group{OwnerContact: "old@example.com", CostCenter: "CC-1"}
+	ttl := int64(90)
+	setGroupMetadata(group, &GroupReq{DocsURL: str("https://docs.example.com"), CostCenter: str(""), DefaultMembershipTTLDays: &ttl})
+
+	assert.Equal(t, "old@example.com", group.OwnerContact)
+	assert.Equal(t, "https://docs.example.com", group.DocsURL)
+	assert.Empty(t, group.CostCenter)
+	assert.Equal(t, int64(90), group.DefaultMembershipTTLDays)
+}
+
+func TestGroupReqDefaultMembershipTTL(t *testing.T) {
+	c, _ := newValidationTestContext(`{"name":"a","description":"b","default_membership_ttl_days":-1}`)
+	assert.False(t, bindRequest(c, &GroupReq{}))
+
+	c, _ = newValidationTestContext(`{"name":"a","description":"b","default_membership_ttl_days":90}`)
+	assert.True(t, bindRequest(c, &GroupReq{}))
+}
+
+func TestGroupMemberLimits(t *testing.T) {
+	c, _ := newValidationTestContext(`{"name":"a","description":"b","member_hard_limit":-1}`)
+	assert.False(t, bindRequest(c, &GroupReq{}))
+
+	req := GroupReq{}
+	c, _ = newValidationTestContext(`{"name":"a","description":"b","member_soft_limit":40,"member_hard_limit":50}`)
+	assert.True(t, bindRequest(c, &req))
+
+	group := &models.Group{MemberSoftLimit: 10}
+	setGroupMetadata(group, &req)
+
+	assert.Equal(t, int64(40), group.MemberSoftLimit)
+	assert.Equal(t, int64(50), group.MemberHardLimit)
+	assert.NoError(t, validateMemberLimits(group))
+
+	group.MemberSoftLimit = 60
+	assert.ErrorIs(t, validateMemberLimits(group), ErrInvalidMemberLimits)
+
+	group.MemberHardLimit = 0
+	assert.NoError(t, validateMemberLimits(group))
+}
+
+func TestGroupJustificationMinLength(t *testing.T) {
+	c, _ := newValidationTestContext(`{"name":"a","description":"b","justification_min_length":-1}`)
+	assert.False(t, bindRequest(c, &GroupReq{}))
+
+	req := GroupReq{}
+	c, _ = newValidationTestContext(`{"name":"a","description":"b","justification_min_length":20}`)
+	assert.True(t, bindRequest(c, &req))
+
+	group := &models.Group{}
+	setGroupMetadata(group, &req)
+
+	assert.Equal(t, int64(20), group.JustificationMinLength)
+}
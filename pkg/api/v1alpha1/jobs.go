@@ -0,0 +1,117 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const maxJobsListed = 100
+
+// jobAudit records an audit event about an enqueued job in the job transaction
+type jobAudit func(tx *sql.Tx, job *models.Job) (*models.AuditEvent, error)
+
+// enqueueJob stores a job of the kind with its params and responds with a 202
+// and the pending job, its progress and result can then be followed with
+// GET /jobs/:id. The audits record more events about the job along with its
+// creation.
+func (r *Router) enqueueJob(c *gin.Context, kind string, params interface{}, audits ...jobAudit) {
+	if r.Jobs == nil {
+		sendError(c, http.StatusServiceUnavailable, "jobs are not enabled")
+		return
+	}
+
+	var job *models.Job
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		var (
+			event *models.AuditEvent
+			err   error
+		)
+
+		job, event, err = r.Jobs.Enqueue(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), kind, params)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, err.Error(), err)
+		}
+
+		auditEvents := []*models.AuditEvent{event}
+
+		for _, audit := range audits {
+			event, err := audit(tx, job)
+			if err != nil {
+				return abortTx(http.StatusBadRequest, "error creating job (audit): "+err.Error(), err)
+			}
+
+			auditEvents = append(auditEvents, event)
+		}
+
+		if err := updateContextWithAuditEventData(c, auditEvents); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating job (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	r.Jobs.Wake()
+
+	c.Header("Location", "/api/v1alpha1/jobs/"+job.ID)
+	c.JSON(http.StatusAccepted, job)
+}
+
+// listJobs lists the most recent jobs, optionally filtered by kind and status
+func (r *Router) listJobs(c *gin.Context) {
+	queryMods := []qm.QueryMod{
+		qm.OrderBy("created_at DESC"),
+		qm.Limit(maxJobsListed),
+	}
+
+	if kind := c.Query("kind"); kind != "" {
+		queryMods = append(queryMods, models.JobWhere.Kind.EQ(kind))
+	}
+
+	if status := c.Query("status"); status != "" {
+		queryMods = append(queryMods, models.JobWhere.Status.EQ(status))
+	}
+
+	jobs, err := models.Jobs(queryMods...).All(c.Request.Context(), r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error listing jobs: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, jobs)
+}
+
+// getJob gets a job with its progress and, once finished, its result. Users
+// can only see the jobs they created unless they are governor admins.
+func (r *Router) getJob(c *gin.Context) {
+	id := c.Param("id")
+
+	job, err := models.FindJob(c.Request.Context(), r.DB, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeJobNotFound, "job not found: "+id)
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting job: "+err.Error())
+
+		return
+	}
+
+	if ctxUser := getCtxUser(c); ctxUser != nil && job.CreatedBy.String != ctxUser.ID {
+		if isAdmin := getCtxAdmin(c); isAdmin == nil || !*isAdmin {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeJobNotFound, "job not found: "+id)
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, job)
+}
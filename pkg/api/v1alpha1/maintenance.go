@@ -0,0 +1,35 @@
+package v1alpha1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/metal-toolbox/governor-api/internal/featureflags"
+)
+
+// readOnlyMessage is the error message of the requests rejected in read-only mode
+const readOnlyMessage = "governor is in read-only maintenance mode, try again later"
+
+// readOnlyAllowed returns true for the requests still served in read-only
+// mode: reads, and the feature flag changes so admins can turn it off
+func readOnlyAllowed(basePath string, c *gin.Context) bool {
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	return c.FullPath() == basePath+"/feature-flags/:name"
+}
+
+// mwReadOnly rejects the mutating requests with a 503 while the api is in
+// read-only maintenance mode
+func (r *Router) mwReadOnly(basePath string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if readOnlyAllowed(basePath, c) || !r.featureEnabled(c, featureflags.ReadOnly) {
+			return
+		}
+
+		sendErrorWithCode(c, http.StatusServiceUnavailable, ErrCodeMaintenance, readOnlyMessage)
+	}
+}
@@ -0,0 +1,50 @@
+package v1alpha1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metal-toolbox/governor-api/internal/featureflags"
+)
+
+func TestMWReadOnly(t *testing.T) {
+	readOnly := false
+
+	r := &Router{
+		FeatureFlags: featureflags.New(func(_ context.Context) (map[string]bool, error) {
+			return map[string]bool{featureflags.ReadOnly: readOnly}, nil
+		}, featureflags.WithTTL(0)),
+	}
+
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+
+	engine := gin.New()
+	rg := engine.Group("/api/v1alpha1")
+	rg.Use(r.mwReadOnly(rg.BasePath()))
+	rg.GET("/groups", ok)
+	rg.POST("/groups", ok)
+	rg.PUT("/feature-flags/:name", ok)
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/api/v1alpha1/groups").Code)
+
+	readOnly = true
+
+	w := serve(http.MethodPost, "/api/v1alpha1/groups")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Contains(t, w.Body.String(), string(ErrCodeMaintenance))
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/api/v1alpha1/groups").Code)
+	assert.Equal(t, http.StatusOK, serve(http.MethodPut, "/api/v1alpha1/feature-flags/read-only").Code, "admins can turn it off")
+}
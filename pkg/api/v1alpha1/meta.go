@@ -0,0 +1,80 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/metal-toolbox/governor-api/internal/featureflags"
+	"github.com/metal-toolbox/governor-api/pkg/jsonschema"
+)
+
+// EventSubjectPrefixes are the prefixes of the subjects governor publishes its
+// events on
+type EventSubjectPrefixes struct {
+	// Events prefixes the subjects of the v1alpha1 events
+	Events string `json:"events"`
+	// V2Events prefixes the subjects of the v2 events, it is empty when they
+	// aren't published
+	V2Events string `json:"v2_events,omitempty"`
+	// Extensions prefixes the subjects of the extension resource events of the
+	// extensions without their own prefix, it is empty when they are published
+	// under the events prefix
+	Extensions string `json:"extensions,omitempty"`
+}
+
+// Meta describes the deployment to the clients, so they can adapt to its
+// configuration
+type Meta struct {
+	APIVersions   []string             `json:"api_versions"`
+	AdminGroups   []string             `json:"admin_groups"`
+	Features      map[string]bool      `json:"features"`
+	FeatureFlags  map[string]bool      `json:"feature_flags"`
+	ERDSchema     MetaERDSchema        `json:"erd_schema"`
+	EventSubjects EventSubjectPrefixes `json:"event_subjects"`
+}
+
+// MetaERDSchema describes the JSON schema drafts supported by the extension
+// resource definitions
+type MetaERDSchema struct {
+	DefaultDraft    string   `json:"default_draft"`
+	SupportedDrafts []string `json:"supported_drafts"`
+}
+
+// getMeta returns the deployment configuration useful to the clients: the api
+// versions, admin groups, enabled features and flags, ERD schema drafts and
+// event subject prefixes
+func (r *Router) getMeta(c *gin.Context) {
+	versions := r.APIVersions
+	if len(versions) == 0 {
+		versions = []string{Version}
+	}
+
+	adminGroups := append([]string{}, r.AdminGroups...)
+	sort.Strings(adminGroups)
+
+	flags := make(map[string]bool, len(featureflags.Known))
+	for name := range featureflags.Known {
+		flags[name] = r.featureEnabled(c, name)
+	}
+
+	c.JSON(http.StatusOK, Meta{
+		APIVersions: versions,
+		AdminGroups: adminGroups,
+		Features: map[string]bool{
+			"avatars":                         r.Avatars != nil,
+			"backups":                         r.Backups != nil,
+			"email_verification":              r.EmailVerifier != nil,
+			"jobs":                            r.Jobs != nil,
+			"notification_broadcast_cooldown": r.NotificationBroadcastCooldown > 0,
+			"okta_event_hooks":                r.OktaEventHookSecret != "",
+		},
+		FeatureFlags: flags,
+		ERDSchema: MetaERDSchema{
+			DefaultDraft:    jsonschema.DefaultDraft,
+			SupportedDrafts: jsonschema.SupportedDrafts(),
+		},
+		EventSubjects: r.EventSubjectPrefixes,
+	})
+}
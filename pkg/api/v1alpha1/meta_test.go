@@ -0,0 +1,47 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/metal-toolbox/governor-api/internal/featureflags"
+	"github.com/metal-toolbox/governor-api/pkg/jsonschema"
+)
+
+func TestGetMeta(t *testing.T) {
+	r := &Router{
+		AdminGroups: []string{"governor-admins", "break-glass"},
+		EventSubjectPrefixes: EventSubjectPrefixes{
+			Events:   "governor.events",
+			V2Events: "governor.events.v2",
+		},
+		OktaEventHookSecret: "s3cr3t",
+	}
+
+	engine := gin.New()
+	engine.GET("/meta", r.getMeta)
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/meta", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	meta := Meta{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &meta))
+
+	assert.Equal(t, []string{Version}, meta.APIVersions)
+	assert.Equal(t, []string{"break-glass", "governor-admins"}, meta.AdminGroups)
+	assert.Equal(t, r.EventSubjectPrefixes, meta.EventSubjects)
+	assert.True(t, meta.Features["okta_event_hooks"])
+	assert.False(t, meta.Features["jobs"])
+	assert.Len(t, meta.FeatureFlags, len(featureflags.Known))
+	assert.Equal(t, featureflags.Default(featureflags.ReadOnly), meta.FeatureFlags[featureflags.ReadOnly])
+	assert.Equal(t, jsonschema.DefaultDraft, meta.ERDSchema.DefaultDraft)
+	assert.Contains(t, meta.ERDSchema.SupportedDrafts, jsonschema.DefaultDraft)
+}
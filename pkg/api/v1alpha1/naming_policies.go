@@ -0,0 +1,295 @@
+package v1alpha1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/featureflags"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/namingpolicy"
+)
+
+// namingPolicyGlobalScope is the scope param of the naming policy that applies to every group
+const namingPolicyGlobalScope = "global"
+
+// NamingPolicyReq is a request to create or update a naming policy
+type NamingPolicyReq struct {
+	ReservedSlugs    []string `json:"reserved_slugs"`
+	NamePatterns     []string `json:"name_patterns"`
+	RequiredPrefixes []string `json:"required_prefixes"`
+}
+
+// namingPolicyScope resolves the scope param, either global or an organization
+// id or slug, to the organization id of the naming policy
+func (r *Router) namingPolicyScope(c *gin.Context) (null.String, bool) {
+	scope := c.Param("scope")
+	if scope == namingPolicyGlobalScope {
+		return null.String{}, true
+	}
+
+	q := qm.Where("id = ?", scope)
+	if _, err := uuid.Parse(scope); err != nil {
+		q = qm.Where("slug = ?", scope)
+	}
+
+	org, err := models.Organizations(q).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeOrganizationNotFound, "organization not found: "+err.Error())
+			return null.String{}, false
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting organization: "+err.Error())
+
+		return null.String{}, false
+	}
+
+	return null.StringFrom(org.ID), true
+}
+
+// findNamingPolicy returns the naming policy of the organization, or the global one when orgID is null
+func findNamingPolicy(ctx context.Context, exec boil.ContextExecutor, orgID null.String) (*models.NamingPolicy, error) {
+	q := qm.Where("organization_id IS NULL")
+	if orgID.Valid {
+		q = qm.Where("organization_id = ?", orgID.String)
+	}
+
+	return models.NamingPolicies(q).One(ctx, exec)
+}
+
+// listNamingPolicies lists the global and organization naming policies
+func (r *Router) listNamingPolicies(c *gin.Context) {
+	policies, err := models.NamingPolicies(qm.OrderBy("organization_id")).All(c.Request.Context(), r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error listing naming policies: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, policies)
+}
+
+// getNamingPolicy gets the global naming policy or the naming policy of an organization
+func (r *Router) getNamingPolicy(c *gin.Context) {
+	orgID, ok := r.namingPolicyScope(c)
+	if !ok {
+		return
+	}
+
+	policy, err := findNamingPolicy(c.Request.Context(), r.DB, orgID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeNamingPolicyNotFound, "naming policy not found: "+err.Error())
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting naming policy: "+err.Error())
+
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// updateNamingPolicy creates or replaces the global naming policy or the naming policy of an organization
+func (r *Router) updateNamingPolicy(c *gin.Context) {
+	orgID, ok := r.namingPolicyScope(c)
+	if !ok {
+		return
+	}
+
+	req := NamingPolicyReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	if err := (namingpolicy.Policy{NamePatterns: req.NamePatterns}).Validate(); err != nil {
+		sendErrorWithDetails(c, http.StatusBadRequest, ErrCodeValidationFailed, "invalid request: "+err.Error(), []ErrorDetail{
+			{Field: "name_patterns", Message: err.Error()},
+		})
+
+		return
+	}
+
+	policy, err := findNamingPolicy(c.Request.Context(), r.DB, orgID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		sendError(c, http.StatusInternalServerError, "error getting naming policy: "+err.Error())
+		return
+	}
+
+	original := models.NamingPolicy{}
+	exists := policy != nil
+
+	if exists {
+		original = *policy
+	} else {
+		policy = &models.NamingPolicy{OrganizationID: orgID}
+	}
+
+	policy.ReservedSlugs = types.StringArray(nonNilStrings(req.ReservedSlugs))
+	policy.NamePatterns = types.StringArray(nonNilStrings(req.NamePatterns))
+	policy.RequiredPrefixes = types.StringArray(nonNilStrings(req.RequiredPrefixes))
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if exists {
+			_, err = policy.Update(c.Request.Context(), tx, boil.Infer())
+		} else {
+			err = policy.Insert(c.Request.Context(), tx, boil.Infer())
+		}
+
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error updating naming policy: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditNamingPolicyUpdated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, policy)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error updating naming policy (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating naming policy (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, policy)
+}
+
+// deleteNamingPolicy deletes the global naming policy or the naming policy of an organization
+func (r *Router) deleteNamingPolicy(c *gin.Context) {
+	orgID, ok := r.namingPolicyScope(c)
+	if !ok {
+		return
+	}
+
+	policy, err := findNamingPolicy(c.Request.Context(), r.DB, orgID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeNamingPolicyNotFound, "naming policy not found: "+err.Error())
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting naming policy: "+err.Error())
+
+		return
+	}
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := policy.Delete(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting naming policy: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditNamingPolicyDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), policy)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting naming policy (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting naming policy (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, policy)
+}
+
+// checkGroupNamingPolicies checks the group name and slug against the global
+// naming policy, when global is true, and the naming policies of the given
+// organizations. Every broken rule is listed in a single error response and
+// false is returned. Governor admins and clients without a user can use
+// reserved slugs. Nothing is checked when the enforce-naming-policy feature
+// flag is off.
+func (r *Router) checkGroupNamingPolicies(c *gin.Context, name, slug string, global bool, orgIDs ...string) bool {
+	if !r.featureEnabled(c, featureflags.EnforceNamingPolicy) {
+		return true
+	}
+
+	clauses := []qm.QueryMod{}
+
+	if global {
+		clauses = append(clauses, qm.Or("organization_id IS NULL"))
+	}
+
+	if len(orgIDs) > 0 {
+		clauses = append(clauses, qm.OrIn("organization_id IN ?", stringsToInterfaces(orgIDs)...))
+	}
+
+	if len(clauses) == 0 {
+		return true
+	}
+
+	policies, err := models.NamingPolicies(qm.Expr(clauses...)).All(c.Request.Context(), r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting naming policies: "+err.Error())
+		return false
+	}
+
+	allowReserved := getCtxUser(c) == nil
+	if isAdmin := getCtxAdmin(c); isAdmin != nil && *isAdmin {
+		allowReserved = true
+	}
+
+	details := []ErrorDetail{}
+
+	for _, p := range policies {
+		violations, err := namingpolicy.Policy{
+			ReservedSlugs:    p.ReservedSlugs,
+			NamePatterns:     p.NamePatterns,
+			RequiredPrefixes: p.RequiredPrefixes,
+		}.Check(name, slug, allowReserved)
+		if err != nil {
+			sendError(c, http.StatusInternalServerError, "error checking naming policy: "+err.Error())
+			return false
+		}
+
+		for _, v := range violations {
+			details = append(details, ErrorDetail{Field: v.Field, Message: v.Message})
+		}
+	}
+
+	if len(details) == 0 {
+		return true
+	}
+
+	msgs := make([]string, len(details))
+	for i, d := range details {
+		msgs[i] = d.Message
+	}
+
+	sendErrorWithDetails(c, http.StatusBadRequest, ErrCodeNamingPolicyViolation, "group name breaks the naming policy: "+strings.Join(msgs, "; "), details)
+
+	return false
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+
+	return s
+}
+
+func stringsToInterfaces(s []string) []interface{} {
+	out := make([]interface{}, len(s))
+	for i, v := range s {
+		out[i] = v
+	}
+
+	return out
+}
@@ -0,0 +1,208 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/netpolicy"
+)
+
+// NetworkPolicyReq is a request to set the network policy of a token subject
+type NetworkPolicyReq struct {
+	Description  string   `json:"description"`
+	AllowedCIDRs []string `json:"allowed_cidrs"`
+	DeniedCIDRs  []string `json:"denied_cidrs"`
+}
+
+// mwNetworkPolicy rejects requests whose bearer token subject has a network
+// policy that doesn't allow the client address. The subject is read without
+// verifying the token, that's left to the auth middleware which rejects any
+// request with a forged token. Denied requests are logged and audited.
+func (r *Router) mwNetworkPolicy(c *gin.Context) {
+	if r.NetworkPolicies == nil {
+		return
+	}
+
+	authzHeader := strings.Split(c.Request.Header.Get("Authorization"), " ")
+	if len(authzHeader) != expectedAuthzHeaderParts {
+		return
+	}
+
+	token, err := jwt.ParseSigned(authzHeader[expectedAuthzHeaderParts-1])
+	if err != nil {
+		return
+	}
+
+	claims := jwt.Claims{}
+	if err := token.UnsafeClaimsWithoutVerification(&claims); err != nil || claims.Subject == "" {
+		return
+	}
+
+	addr := c.ClientIP()
+
+	err = r.NetworkPolicies.Check(c.Request.Context(), claims.Subject, addr)
+	if err == nil {
+		return
+	}
+
+	r.Logger.Warn("request denied by network policy",
+		zap.String("subject", claims.Subject),
+		zap.String("client_ip", addr),
+		zap.Error(err),
+	)
+
+	if _, err := dbtools.AuditNetworkPolicyDenied(c.Request.Context(), r.DB, getCtxAuditID(c), claims.Subject, addr); err != nil {
+		r.Logger.Error("error auditing network policy denial", zap.Error(err))
+	}
+
+	sendErrorWithCode(c, http.StatusForbidden, ErrCodeNetworkPolicyDenied, "request denied by network policy")
+}
+
+// listNetworkPolicies lists the network policies
+func (r *Router) listNetworkPolicies(c *gin.Context) {
+	policies, err := models.NetworkPolicies(qm.OrderBy("subject")).All(c.Request.Context(), r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error listing network policies: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, policies)
+}
+
+// getNetworkPolicy gets the network policy of a token subject
+func (r *Router) getNetworkPolicy(c *gin.Context) {
+	subject := c.Param("subject")
+
+	policy, err := models.NetworkPolicies(qm.Where("subject = ?", subject)).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeNetworkPolicyNotFound, "network policy not found: "+subject)
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting network policy: "+err.Error())
+
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// updateNetworkPolicy creates or replaces the network policy of a token subject
+func (r *Router) updateNetworkPolicy(c *gin.Context) {
+	subject := c.Param("subject")
+
+	req := NetworkPolicyReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	if _, err := netpolicy.Parse(req.AllowedCIDRs, req.DeniedCIDRs); err != nil {
+		sendErrorFromErr(c, http.StatusBadRequest, err)
+		return
+	}
+
+	policy, err := models.NetworkPolicies(qm.Where("subject = ?", subject)).One(c.Request.Context(), r.DB)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		sendError(c, http.StatusInternalServerError, "error getting network policy: "+err.Error())
+		return
+	}
+
+	original := models.NetworkPolicy{}
+	exists := policy != nil
+
+	if exists {
+		original = *policy
+	} else {
+		policy = &models.NetworkPolicy{Subject: subject}
+	}
+
+	policy.Description = req.Description
+	policy.AllowedCidrs = append([]string{}, req.AllowedCIDRs...)
+	policy.DeniedCidrs = append([]string{}, req.DeniedCIDRs...)
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if exists {
+			_, err = policy.Update(c.Request.Context(), tx, boil.Infer())
+		} else {
+			err = policy.Insert(c.Request.Context(), tx, boil.Infer())
+		}
+
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error updating network policy: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditNetworkPolicyUpdated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, policy)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error updating network policy (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating network policy (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	if r.NetworkPolicies != nil {
+		r.NetworkPolicies.Invalidate()
+	}
+
+	c.JSON(http.StatusAccepted, policy)
+}
+
+// deleteNetworkPolicy deletes the network policy of a token subject, allowing
+// it from any network
+func (r *Router) deleteNetworkPolicy(c *gin.Context) {
+	subject := c.Param("subject")
+
+	policy, err := models.NetworkPolicies(qm.Where("subject = ?", subject)).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeNetworkPolicyNotFound, "network policy not found: "+subject)
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting network policy: "+err.Error())
+
+		return
+	}
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := policy.Delete(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting network policy: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditNetworkPolicyDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), policy)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting network policy (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting network policy (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	if r.NetworkPolicies != nil {
+		r.NetworkPolicies.Invalidate()
+	}
+
+	c.JSON(http.StatusAccepted, policy)
+}
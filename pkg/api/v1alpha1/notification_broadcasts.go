@@ -0,0 +1,142 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+)
+
+const (
+	// maxBroadcastGroups is the maximum number of groups a notification is broadcast to at once
+	maxBroadcastGroups = 50
+	// maxBroadcastMessageLength is the maximum length of a broadcast message
+	maxBroadcastMessageLength = 4000
+)
+
+// NotificationBroadcastReq is a request to broadcast a notification to all
+// the direct and indirect members of groups, given by id or slug
+type NotificationBroadcastReq struct {
+	NotificationType string   `json:"notification_type"`
+	Subject          string   `json:"subject,omitempty"`
+	Message          string   `json:"message"`
+	Groups           []string `json:"groups"`
+}
+
+// validate returns the problems with the broadcast request
+func (req NotificationBroadcastReq) validate() []ErrorDetail {
+	details := []ErrorDetail{}
+
+	if req.NotificationType == "" {
+		details = append(details, ErrorDetail{Field: "notification_type", Message: "notification_type is required"})
+	}
+
+	if req.Message == "" {
+		details = append(details, ErrorDetail{Field: "message", Message: "message is required"})
+	}
+
+	if len(req.Message) > maxBroadcastMessageLength {
+		details = append(details, ErrorDetail{
+			Field:   "message",
+			Message: "message can't be longer than " + strconv.Itoa(maxBroadcastMessageLength) + " characters",
+		})
+	}
+
+	if len(req.Groups) == 0 || len(req.Groups) > maxBroadcastGroups {
+		details = append(details, ErrorDetail{
+			Field:   "groups",
+			Message: "between 1 and " + strconv.Itoa(maxBroadcastGroups) + " groups are required",
+		})
+	}
+
+	return details
+}
+
+// broadcastNotification starts a job sending an announcement to all the
+// members of the groups through the notification dispatches, so the members'
+// preferences apply. Admins can only start one broadcast per cooldown.
+func (r *Router) broadcastNotification(c *gin.Context) {
+	if r.Jobs == nil {
+		sendError(c, http.StatusServiceUnavailable, "jobs are not enabled")
+		return
+	}
+
+	req := NotificationBroadcastReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	if details := req.validate(); len(details) > 0 {
+		sendValidationError(c, details)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if left, err := r.svc().NotificationBroadcastThrottled(ctx, ctxActor(c), r.NotificationBroadcastCooldown); err != nil {
+		if errors.Is(err, service.ErrNotificationBroadcastThrottled) {
+			c.Header("Retry-After", strconv.Itoa(int(left.Seconds())+1))
+		}
+
+		sendServiceError(c, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	if _, err := models.NotificationTypes(qm.Where("slug = ?", req.NotificationType)).One(ctx, r.DB); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = service.ErrNotificationTypeNotFound
+		}
+
+		sendServiceError(c, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	broadcast := service.NotificationBroadcast{
+		NotificationType: req.NotificationType,
+		Subject:          req.Subject,
+		Message:          req.Message,
+		GroupIDs:         []string{},
+	}
+
+	slugs := []string{}
+	seen := map[string]bool{}
+
+	for _, idOrSlug := range req.Groups {
+		group, err := r.svc().FindGroup(ctx, idOrSlug, false)
+		if err != nil {
+			sendServiceError(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		if seen[group.ID] {
+			continue
+		}
+
+		seen[group.ID] = true
+
+		broadcast.GroupIDs = append(broadcast.GroupIDs, group.ID)
+		slugs = append(slugs, group.Slug)
+	}
+
+	audience, err := r.svc().NotificationBroadcastAudience(ctx, broadcast.GroupIDs)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	r.enqueueJob(c, service.JobKindNotificationBroadcast, broadcast, func(tx *sql.Tx, job *models.Job) (*models.AuditEvent, error) {
+		return dbtools.AuditNotificationBroadcast(
+			ctx, tx, getCtxAuditID(c), getCtxUser(c), job,
+			broadcast.NotificationType, broadcast.Subject, broadcast.Message, slugs, len(audience),
+		)
+	})
+}
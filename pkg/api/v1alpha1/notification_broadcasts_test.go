@@ -0,0 +1,71 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationBroadcastReqValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        NotificationBroadcastReq
+		wantFields []string
+	}{
+		{
+			name: "valid",
+			req:  NotificationBroadcastReq{NotificationType: "announcements", Message: "maintenance tonight", Groups: []string{"eng"}},
+		},
+		{
+			name:       "empty",
+			wantFields: []string{"notification_type", "message", "groups"},
+		},
+		{
+			name: "message too long",
+			req: NotificationBroadcastReq{
+				NotificationType: "announcements",
+				Message:          strings.Repeat("a", maxBroadcastMessageLength+1),
+				Groups:           []string{"eng"},
+			},
+			wantFields: []string{"message"},
+		},
+		{
+			name: "too many groups",
+			req: NotificationBroadcastReq{
+				NotificationType: "announcements",
+				Message:          "maintenance tonight",
+				Groups:           make([]string, maxBroadcastGroups+1),
+			},
+			wantFields: []string{"groups"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := []string{}
+			for _, d := range tt.req.validate() {
+				fields = append(fields, d.Field)
+			}
+
+			assert.ElementsMatch(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestBroadcastNotificationJobsDisabled(t *testing.T) {
+	r := &Router{}
+
+	engine := gin.New()
+	engine.POST("/notifications/broadcast", r.broadcastNotification)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/notifications/broadcast", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	engine.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
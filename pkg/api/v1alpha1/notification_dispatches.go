@@ -0,0 +1,131 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+)
+
+// NotificationDispatch is the notification dispatch response
+type NotificationDispatch struct {
+	*models.NotificationDispatch
+}
+
+// NotificationDispatchReq is a request to dispatch a notification to a user,
+// the group is a group id or slug
+type NotificationDispatchReq struct {
+	UserID           string          `json:"user_id"`
+	NotificationType string          `json:"notification_type"`
+	Group            string          `json:"group,omitempty"`
+	Payload          json.RawMessage `json:"payload,omitempty"`
+}
+
+// NotificationDispatchAckReq is a request to acknowledge the delivery of a
+// notification dispatch on a target
+type NotificationDispatchAckReq struct {
+	Target string `json:"target"`
+}
+
+// refuseUserToken responds with a 403 and returns true when the request was
+// made with a user token, the notification dispatches are only for the
+// dispatchers
+func refuseUserToken(c *gin.Context) bool {
+	if !contains(c.GetStringSlice("jwt.roles"), oidcScope) {
+		return false
+	}
+
+	sendError(c, http.StatusForbidden, "notification dispatches aren't allowed with user tokens")
+
+	return true
+}
+
+// createNotificationDispatch dispatches a notification to a user, following
+// their notification preferences, target ranking and failover policies
+func (r *Router) createNotificationDispatch(c *gin.Context) {
+	if refuseUserToken(c) {
+		return
+	}
+
+	req := NotificationDispatchReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	details := []ErrorDetail{}
+
+	if req.UserID == "" {
+		details = append(details, ErrorDetail{Field: "user_id", Message: "user_id is required"})
+	}
+
+	if req.NotificationType == "" {
+		details = append(details, ErrorDetail{Field: "notification_type", Message: "notification_type is required"})
+	}
+
+	if len(details) > 0 {
+		sendValidationError(c, details)
+		return
+	}
+
+	dispatch, err := r.svc().CreateNotificationDispatch(c.Request.Context(), service.NotificationDispatchParams{
+		UserID:           req.UserID,
+		NotificationType: req.NotificationType,
+		Group:            req.Group,
+		Payload:          req.Payload,
+	})
+
+	switch {
+	case errors.Is(err, service.ErrEventNotPublished):
+		acceptChange(c, err)
+	case err != nil:
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, NotificationDispatch{dispatch})
+}
+
+// getNotificationDispatch returns a notification dispatch
+func (r *Router) getNotificationDispatch(c *gin.Context) {
+	if refuseUserToken(c) {
+		return
+	}
+
+	dispatch, err := r.svc().FindNotificationDispatch(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, NotificationDispatch{dispatch})
+}
+
+// ackNotificationDispatch records the target a notification dispatch was
+// delivered on, which stops its failover
+func (r *Router) ackNotificationDispatch(c *gin.Context) {
+	if refuseUserToken(c) {
+		return
+	}
+
+	req := NotificationDispatchAckReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	if req.Target == "" {
+		sendValidationError(c, []ErrorDetail{{Field: "target", Message: "target is required"}})
+		return
+	}
+
+	dispatch, err := r.svc().AckNotificationDispatch(c.Request.Context(), c.Param("id"), req.Target)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, NotificationDispatch{dispatch})
+}
@@ -0,0 +1,137 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestNotificationDispatchValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		body    string
+		wantErr string
+	}{
+		{
+			name:    "dispatch without user",
+			method:  http.MethodPost,
+			path:    "/notification-dispatches",
+			body:    `{"notification_type":"approvals"}`,
+			wantErr: "user_id is required",
+		},
+		{
+			name:    "dispatch without notification type",
+			method:  http.MethodPost,
+			path:    "/notification-dispatches",
+			body:    `{"user_id":"user-id"}`,
+			wantErr: "notification_type is required",
+		},
+		{
+			name:    "ack without target",
+			method:  http.MethodPost,
+			path:    "/notification-dispatches/dispatch-id/delivered",
+			body:    `{}`,
+			wantErr: "target is required",
+		},
+		{
+			name:    "failover policy without delay",
+			method:  http.MethodPut,
+			path:    "/user/notification-preferences/failover",
+			body:    `{"target_ranking":["slack","email"],"policies":[{"notification_type":"approvals"}]}`,
+			wantErr: "failover_after_seconds must be positive",
+		},
+		{
+			name:    "failover policy without type",
+			method:  http.MethodPut,
+			path:    "/user/notification-preferences/failover",
+			body:    `{"policies":[{"failover_after_seconds":600}]}`,
+			wantErr: "notification_type is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Router{}
+
+			engine := gin.New()
+			engine.Use(func(c *gin.Context) {
+				setCtxUser(c, &models.User{ID: "user-id"})
+			})
+			engine.POST("/notification-dispatches", r.createNotificationDispatch)
+			engine.POST("/notification-dispatches/:id/delivered", r.ackNotificationDispatch)
+			engine.PUT("/user/notification-preferences/failover", r.updateAuthenticatedUserNotificationFailover)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), tt.wantErr)
+		})
+	}
+}
+
+func TestNotificationDispatchUserToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+	}{
+		{
+			name:   "dispatch",
+			method: http.MethodPost,
+			path:   "/notification-dispatches",
+			body:   `{"user_id":"user-id","notification_type":"approvals"}`,
+		},
+		{
+			name:   "get",
+			method: http.MethodGet,
+			path:   "/notification-dispatches/dispatch-id",
+		},
+		{
+			name:   "ack",
+			method: http.MethodPost,
+			path:   "/notification-dispatches/dispatch-id/delivered",
+			body:   `{"target":"slack"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Router{}
+
+			engine := gin.New()
+			engine.Use(func(c *gin.Context) {
+				setCtxUser(c, &models.User{ID: "user-id"})
+				c.Set("jwt.roles", []string{oidcScope, "create:governor:notifications"})
+			})
+			engine.POST("/notification-dispatches", r.createNotificationDispatch)
+			engine.GET("/notification-dispatches/:id", r.getNotificationDispatch)
+			engine.POST("/notification-dispatches/:id/delivered", r.ackNotificationDispatch)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusForbidden, w.Code)
+		})
+	}
+
+	// the policies of the notification dispatches don't accept the user tokens
+	for _, p := range routePolicies {
+		if strings.HasPrefix(p.Path, "/notification-dispatches") {
+			assert.NotContains(t, p.Scopes, oidcScope, "user tokens accepted by %s %s", p.Method, p.Path)
+		}
+	}
+}
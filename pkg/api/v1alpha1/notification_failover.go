@@ -0,0 +1,100 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+)
+
+// UserNotificationFailover is an alias export for the same struct in dbtools
+type UserNotificationFailover = dbtools.UserNotificationFailover
+
+// UserNotificationFailoverPolicy is an alias export for the same struct in
+// dbtools
+type UserNotificationFailoverPolicy = dbtools.UserNotificationFailoverPolicy
+
+// getAuthenticatedUserNotificationFailover returns the authenticated user's
+// notification target ranking and failover policies
+func (r *Router) getAuthenticatedUserNotificationFailover(c *gin.Context) {
+	ctxUser := getCtxUser(c)
+	if ctxUser == nil {
+		sendError(c, http.StatusUnauthorized, "no user in context")
+		return
+	}
+
+	failover, err := dbtools.GetNotificationFailover(c.Request.Context(), ctxUser.ID, r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting notification failover: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, failover)
+}
+
+// updateAuthenticatedUserNotificationFailover is the http handler for
+// /user/notification-preferences/failover, the request replaces the
+// authenticated user's target ranking and failover policies
+func (r *Router) updateAuthenticatedUserNotificationFailover(c *gin.Context) {
+	ctxUser := getCtxUser(c)
+	if ctxUser == nil {
+		sendError(c, http.StatusUnauthorized, "no user in context")
+		return
+	}
+
+	req := UserNotificationFailover{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	details := []ErrorDetail{}
+
+	for _, p := range req.Policies {
+		if p.NotificationType == "" {
+			details = append(details, ErrorDetail{Field: "policies.notification_type", Message: "notification_type is required"})
+		}
+
+		if p.FailoverAfterSeconds <= 0 {
+			details = append(details, ErrorDetail{
+				Field:   "policies.failover_after_seconds",
+				Message: "failover_after_seconds must be positive",
+			})
+		}
+	}
+
+	if len(details) > 0 {
+		sendValidationError(c, details)
+		return
+	}
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		event, err := dbtools.ReplaceNotificationFailover(
+			c.Request.Context(),
+			ctxUser,
+			&req,
+			tx,
+			getCtxAuditID(c),
+			ctxUser,
+		)
+		if err == nil {
+			err = updateContextWithAuditEventData(c, event)
+		}
+
+		if err != nil {
+			return abortTx(http.StatusBadRequest, err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	failover, err := dbtools.GetNotificationFailover(c.Request.Context(), ctxUser.ID, r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting notification failover: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusAccepted, failover)
+}
@@ -0,0 +1,131 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+)
+
+// UserNotificationGroupPreferences is an alias export for the same struct in
+// dbtools
+type UserNotificationGroupPreferences = dbtools.UserNotificationGroupPreferences
+
+// UserNotificationGroupPreference is an alias export for the same struct in
+// dbtools
+type UserNotificationGroupPreference = dbtools.UserNotificationGroupPreference
+
+// NotificationDelivery is an alias export for the same struct in dbtools
+type NotificationDelivery = dbtools.NotificationDelivery
+
+const (
+	// NotificationLevelMuted drops the notifications about a group
+	NotificationLevelMuted = dbtools.NotificationLevelMuted
+	// NotificationLevelElevated delivers the notifications about a group even
+	// when their type or target is disabled
+	NotificationLevelElevated = dbtools.NotificationLevelElevated
+)
+
+// getAuthenticatedUserNotificationGroupPreferences returns the authenticated
+// user's notification group preferences
+func (r *Router) getAuthenticatedUserNotificationGroupPreferences(c *gin.Context) {
+	ctxUser := getCtxUser(c)
+	if ctxUser == nil {
+		sendError(c, http.StatusUnauthorized, "no user in context")
+		return
+	}
+
+	gp, err := dbtools.GetNotificationGroupPreferences(c.Request.Context(), ctxUser.ID, r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting notification group preferences: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gp)
+}
+
+// updateAuthenticatedUserNotificationGroupPreferences is the http handler for
+// /user/notification-preferences/groups, the request replaces all of the
+// authenticated user's notification group preferences
+func (r *Router) updateAuthenticatedUserNotificationGroupPreferences(c *gin.Context) {
+	ctxUser := getCtxUser(c)
+	if ctxUser == nil {
+		sendError(c, http.StatusUnauthorized, "no user in context")
+		return
+	}
+
+	req := UserNotificationGroupPreferences{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	for _, p := range req {
+		if p.Group == "" {
+			sendValidationError(c, []ErrorDetail{{Field: "group", Message: "group is required"}})
+			return
+		}
+	}
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		event, err := dbtools.ReplaceNotificationGroupPreferences(
+			c.Request.Context(),
+			ctxUser,
+			req,
+			tx,
+			getCtxAuditID(c),
+			ctxUser,
+		)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	gp, err := dbtools.GetNotificationGroupPreferences(c.Request.Context(), ctxUser.ID, r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting notification group preferences: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gp)
+}
+
+// getUserNotificationDelivery resolves how a notification should be delivered
+// to a user, so the dispatchers apply the notification type, target and group
+// preferences the same way. The notification_type query param is required,
+// group and target are optional.
+func (r *Router) getUserNotificationDelivery(c *gin.Context) {
+	notificationType := c.Query("notification_type")
+	if notificationType == "" {
+		sendValidationError(c, []ErrorDetail{{Field: "notification_type", Message: "notification_type is required"}})
+		return
+	}
+
+	user, err := r.svc().FindUser(c.Request.Context(), c.Param("id"), false)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	np, err := dbtools.GetNotificationPreferences(c.Request.Context(), user.ID, r.DB, true)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting notification preferences: "+err.Error())
+		return
+	}
+
+	gp, err := dbtools.GetNotificationGroupPreferences(c.Request.Context(), user.ID, r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting notification group preferences: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, dbtools.ResolveNotificationDelivery(np, gp, c.Query("group"), notificationType, c.Query("target")))
+}
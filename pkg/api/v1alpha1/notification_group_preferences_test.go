@@ -0,0 +1,64 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestUpdateAuthenticatedUserNotificationGroupPreferencesValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{
+			name: "not a list",
+			body: `{"group":"announce","level":"muted"}`,
+		},
+		{
+			name:    "missing group",
+			body:    `[{"level":"muted"}]`,
+			wantErr: "group is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Router{}
+
+			engine := gin.New()
+			engine.PUT("/user/notification-preferences/groups", func(c *gin.Context) {
+				setCtxUser(c, &models.User{ID: "user-id"})
+			}, r.updateAuthenticatedUserNotificationGroupPreferences)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, "/user/notification-preferences/groups", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), tt.wantErr)
+		})
+	}
+}
+
+func TestGetUserNotificationDeliveryValidation(t *testing.T) {
+	r := &Router{}
+
+	engine := gin.New()
+	engine.GET("/users/:id/notification-delivery", r.getUserNotificationDelivery)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/users/user-id/notification-delivery?group=announce", nil)
+	engine.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "notification_type is required")
+}
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"database/sql"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -77,39 +78,25 @@ func (r *Router) updateAuthenticatedUserNotificationPreferences(c *gin.Context)
 	}
 
 	req := UserNotificationPreferences{}
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting update transaction: "+err.Error())
-		return
-	}
-
-	np, status, err := handleUpdateNotificationPreferencesRequests(c, tx, ctxUser, req)
-	if err != nil {
-		msg := err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, status, msg)
-
-		return
-	}
+	var (
+		np     UserNotificationPreferences
+		status int
+	)
 
-	if err := tx.Commit(); err != nil {
-		msg := "error committing notification preferences update, rolling back: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		var err error
 
-		if err := tx.Rollback(); err != nil {
-			msg += ("error rolling back transaction: " + err.Error())
+		np, status, err = handleUpdateNotificationPreferencesRequests(c, tx, ctxUser, req)
+		if err != nil {
+			return abortTx(status, err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
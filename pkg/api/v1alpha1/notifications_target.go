@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -9,7 +10,6 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/gosimple/slug"
-	"github.com/metal-toolbox/auditevent/ginaudit"
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/models"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
@@ -54,23 +54,15 @@ func (r *Router) listNotificationTargets(c *gin.Context) {
 // createNotificationTarget creates a notification target in DB
 func (r *Router) createNotificationTarget(c *gin.Context) {
 	req := &NotificationTargetReq{}
-	if err := c.BindJSON(req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, req) {
 		return
 	}
 
-	if req.Name == "" {
-		sendError(c, http.StatusBadRequest, "notification target name is required")
-		return
-	}
-
-	if req.Description == "" {
-		sendError(c, http.StatusBadRequest, "notification target description is required")
-		return
-	}
-
-	if req.DefaultEnabled == nil {
-		sendError(c, http.StatusBadRequest, "notification target default enabled is required")
+	if !validateFields(c,
+		fieldRule{"name", req.Name, "required"},
+		fieldRule{"description", req.Description, "required"},
+		fieldRule{"default_enabled", req.DefaultEnabled, "required"},
+	) {
 		return
 	}
 
@@ -82,64 +74,22 @@ func (r *Router) createNotificationTarget(c *gin.Context) {
 
 	notificationTarget.Slug = slug.Make(notificationTarget.Name)
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting notification target create transaction: "+err.Error())
-		return
-	}
-
-	if err := notificationTarget.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := fmt.Sprintf("error creating notification target: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := notificationTarget.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error creating notification target: %s", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditNotificationTargetCreated(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		notificationTarget,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error creating notification target (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error creating notification target: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing notification target create: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+		if err := r.recordChange(c, tx, events.GovernorNotificationTargetsEventSubject, &events.Event{
+			Action:               events.GovernorEventCreate,
+			NotificationTargetID: notificationTarget.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditNotificationTargetCreated(ctx, exec, pID, actor, notificationTarget)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error creating notification target (audit): %s", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
@@ -150,30 +100,7 @@ func (r *Router) createNotificationTarget(c *gin.Context) {
 		return
 	}
 
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		events.GovernorNotificationTargetsEventSubject,
-		&events.Event{
-			Version:              events.Version,
-			Action:               events.GovernorEventCreate,
-			AuditID:              c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:              getCtxActorID(c),
-			NotificationTargetID: notificationTarget.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish notification target create event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
-
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, notificationTarget)
 }
@@ -204,7 +131,7 @@ func (r *Router) getNotificationTarget(c *gin.Context) {
 	notificationTarget, err := models.NotificationTargets(queryMods...).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "notification target not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeNotificationTargetNotFound, "notification target not found: "+err.Error())
 			return
 		}
 
@@ -228,7 +155,7 @@ func (r *Router) deleteNotificationTarget(c *gin.Context) {
 	n, err := models.NotificationTargets(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "notification target not found"+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeNotificationTargetNotFound, "notification target not found"+err.Error())
 			return
 		}
 
@@ -237,64 +164,22 @@ func (r *Router) deleteNotificationTarget(c *gin.Context) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting delete transaction: "+err.Error())
-		return
-	}
-
-	if _, err := n.Delete(c.Request.Context(), tx, false); err != nil {
-		msg := fmt.Sprintf("error deleting notification target: %s. rolling back\n", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditNotificationTargetDeleted(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		n,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error deleting notification target (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error deleting notification target: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := n.Delete(c.Request.Context(), tx, false); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error deleting notification target: %s. rolling back\n", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing notification target delete: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+		if err := r.recordChange(c, tx, events.GovernorNotificationTargetsEventSubject, &events.Event{
+			Action:               events.GovernorEventDelete,
+			NotificationTargetID: n.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditNotificationTargetDeleted(ctx, exec, pID, actor, n)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error deleting notification target (audit): %s", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
@@ -303,30 +188,7 @@ func (r *Router) deleteNotificationTarget(c *gin.Context) {
 		return
 	}
 
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		events.GovernorNotificationTargetsEventSubject,
-		&events.Event{
-			Version:              events.Version,
-			Action:               events.GovernorEventDelete,
-			AuditID:              c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:              getCtxActorID(c),
-			NotificationTargetID: n.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish notification target delete event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
-
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, n)
 }
@@ -343,7 +205,7 @@ func (r *Router) updateNotificationTarget(c *gin.Context) {
 	n, err := models.NotificationTargets(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "notification target not found"+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeNotificationTargetNotFound, "notification target not found"+err.Error())
 			return
 		}
 
@@ -355,8 +217,7 @@ func (r *Router) updateNotificationTarget(c *gin.Context) {
 	original := *n
 
 	req := &NotificationTargetReq{}
-	if err := c.BindJSON(req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, req) {
 		return
 	}
 
@@ -369,72 +230,28 @@ func (r *Router) updateNotificationTarget(c *gin.Context) {
 		n.Description = req.Description
 	}
 
-	if req.DefaultEnabled == nil {
-		sendError(c, http.StatusBadRequest, "notification target default enabled is required")
+	if !validateFields(c, fieldRule{"default_enabled", req.DefaultEnabled, "required"}) {
 		return
 	}
 
 	n.DefaultEnabled = *req.DefaultEnabled
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting update transaction: "+err.Error())
-		return
-	}
-
-	if _, err := n.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := fmt.Sprintf("error updating notification target: %s. rolling back\n", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditNotificationTargetUpdated(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		&original,
-		n,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error updating notification target (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error updating notification target: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := n.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error updating notification target: %s. rolling back\n", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing notification target update: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+		if err := r.recordChange(c, tx, events.GovernorNotificationTargetsEventSubject, &events.Event{
+			Action:               events.GovernorEventUpdate,
+			NotificationTargetID: n.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditNotificationTargetUpdated(ctx, exec, pID, actor, &original, n)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error updating notification target (audit): %s", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
@@ -443,30 +260,7 @@ func (r *Router) updateNotificationTarget(c *gin.Context) {
 		return
 	}
 
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		events.GovernorNotificationTargetsEventSubject,
-		&events.Event{
-			Version:              events.Version,
-			Action:               events.GovernorEventUpdate,
-			AuditID:              c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:              getCtxActorID(c),
-			NotificationTargetID: n.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish notification target update event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
-
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, n)
 }
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -9,7 +10,6 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/gosimple/slug"
-	"github.com/metal-toolbox/auditevent/ginaudit"
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/models"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
@@ -54,23 +54,15 @@ func (r *Router) listNotificationTypes(c *gin.Context) {
 // createNotificationType creates a notification type in DB
 func (r *Router) createNotificationType(c *gin.Context) {
 	req := &NotificationTypeReq{}
-	if err := c.BindJSON(req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, req) {
 		return
 	}
 
-	if req.Name == "" {
-		sendError(c, http.StatusBadRequest, "notification type name is required")
-		return
-	}
-
-	if req.Description == "" {
-		sendError(c, http.StatusBadRequest, "notification type description is required")
-		return
-	}
-
-	if req.DefaultEnabled == nil {
-		sendError(c, http.StatusBadRequest, "notification type default enabled is required")
+	if !validateFields(c,
+		fieldRule{"name", req.Name, "required"},
+		fieldRule{"description", req.Description, "required"},
+		fieldRule{"default_enabled", req.DefaultEnabled, "required"},
+	) {
 		return
 	}
 
@@ -82,64 +74,22 @@ func (r *Router) createNotificationType(c *gin.Context) {
 
 	notificationType.Slug = slug.Make(notificationType.Name)
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting notification type create transaction: "+err.Error())
-		return
-	}
-
-	if err := notificationType.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := fmt.Sprintf("error creating notification type: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := notificationType.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error creating notification type: %s", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditNotificationTypeCreated(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		notificationType,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error creating notification type (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error creating notification type: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing notification type create: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+		if err := r.recordChange(c, tx, events.GovernorNotificationTypesEventSubject, &events.Event{
+			Action:             events.GovernorEventCreate,
+			NotificationTypeID: notificationType.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditNotificationTypeCreated(ctx, exec, pID, actor, notificationType)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error creating notification type (audit): %s", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
@@ -150,30 +100,7 @@ func (r *Router) createNotificationType(c *gin.Context) {
 		return
 	}
 
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		events.GovernorNotificationTypesEventSubject,
-		&events.Event{
-			Version:            events.Version,
-			Action:             events.GovernorEventCreate,
-			AuditID:            c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:            getCtxActorID(c),
-			NotificationTypeID: notificationType.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish notification type create event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
-
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, notificationType)
 }
@@ -204,7 +131,7 @@ func (r *Router) getNotificationType(c *gin.Context) {
 	notificationType, err := models.NotificationTypes(queryMods...).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "notification type not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeNotificationTypeNotFound, "notification type not found: "+err.Error())
 			return
 		}
 
@@ -228,7 +155,7 @@ func (r *Router) deleteNotificationType(c *gin.Context) {
 	n, err := models.NotificationTypes(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "notification type not found"+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeNotificationTypeNotFound, "notification type not found"+err.Error())
 			return
 		}
 
@@ -237,64 +164,22 @@ func (r *Router) deleteNotificationType(c *gin.Context) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting delete transaction: "+err.Error())
-		return
-	}
-
-	if _, err := n.Delete(c.Request.Context(), tx, false); err != nil {
-		msg := fmt.Sprintf("error deleting notification type: %s. rolling back\n", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditNotificationTypeDeleted(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		n,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error deleting notification type (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error deleting notification type: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := n.Delete(c.Request.Context(), tx, false); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error deleting notification type: %s. rolling back\n", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing notification type delete: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+		if err := r.recordChange(c, tx, events.GovernorNotificationTypesEventSubject, &events.Event{
+			Action:             events.GovernorEventDelete,
+			NotificationTypeID: n.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditNotificationTypeDeleted(ctx, exec, pID, actor, n)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error deleting notification type (audit): %s", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
@@ -303,30 +188,7 @@ func (r *Router) deleteNotificationType(c *gin.Context) {
 		return
 	}
 
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		events.GovernorNotificationTypesEventSubject,
-		&events.Event{
-			Version:            events.Version,
-			Action:             events.GovernorEventDelete,
-			AuditID:            c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:            getCtxActorID(c),
-			NotificationTypeID: n.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish notification type delete event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
-
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, n)
 }
@@ -343,7 +205,7 @@ func (r *Router) updateNotificationType(c *gin.Context) {
 	n, err := models.NotificationTypes(q).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "notification type not found"+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeNotificationTypeNotFound, "notification type not found"+err.Error())
 			return
 		}
 
@@ -355,8 +217,7 @@ func (r *Router) updateNotificationType(c *gin.Context) {
 	original := *n
 
 	req := &NotificationTypeReq{}
-	if err := c.BindJSON(req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, req) {
 		return
 	}
 
@@ -369,72 +230,28 @@ func (r *Router) updateNotificationType(c *gin.Context) {
 		n.Description = req.Description
 	}
 
-	if req.DefaultEnabled == nil {
-		sendError(c, http.StatusBadRequest, "notification type default enabled is required")
+	if !validateFields(c, fieldRule{"default_enabled", req.DefaultEnabled, "required"}) {
 		return
 	}
 
 	n.DefaultEnabled = *req.DefaultEnabled
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting update transaction: "+err.Error())
-		return
-	}
-
-	if _, err := n.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := fmt.Sprintf("error updating notification type: %s. rolling back\n", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditNotificationTypeUpdated(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		&original,
-		n,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error updating notification type (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error updating notification type: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := n.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error updating notification type: %s. rolling back\n", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing notification type update: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+		if err := r.recordChange(c, tx, events.GovernorNotificationTypesEventSubject, &events.Event{
+			Action:             events.GovernorEventUpdate,
+			NotificationTypeID: n.ID,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditNotificationTypeUpdated(ctx, exec, pID, actor, &original, n)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error updating notification type (audit): %s", err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
@@ -443,30 +260,7 @@ func (r *Router) updateNotificationType(c *gin.Context) {
 		return
 	}
 
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		events.GovernorNotificationTypesEventSubject,
-		&events.Event{
-			Version:            events.Version,
-			Action:             events.GovernorEventUpdate,
-			AuditID:            c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:            getCtxActorID(c),
-			NotificationTypeID: n.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish notification type update event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
-
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, n)
 }
@@ -0,0 +1,296 @@
+package v1alpha1
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/auditevent/ginaudit"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+const (
+	// OktaEventHookSecretHeader is the header holding the shared secret Okta
+	// sends with every event hook request
+	OktaEventHookSecretHeader = "X-Governor-Hook-Secret"
+
+	// oktaVerificationHeader holds the challenge of the one-time event hook verification
+	oktaVerificationHeader = "X-Okta-Verification-Challenge"
+
+	oktaEventSource = "okta"
+)
+
+// oktaUserStatuses maps the Okta user lifecycle events to the governor user status they lead to
+var oktaUserStatuses = map[string]string{
+	"user.lifecycle.deactivate": UserStatusSuspended,
+	"user.lifecycle.suspend":    UserStatusSuspended,
+	"user.lifecycle.reactivate": UserStatusActive,
+	"user.lifecycle.unsuspend":  UserStatusActive,
+}
+
+// OktaEventHookReq is an Okta event hook delivery, only the fields used by
+// governor are decoded
+type OktaEventHookReq struct {
+	EventType string `json:"eventType"`
+	Data      struct {
+		Events []OktaEvent `json:"events"`
+	} `json:"data"`
+}
+
+// OktaEvent is a single Okta system log event
+type OktaEvent struct {
+	UUID      string            `json:"uuid"`
+	EventType string            `json:"eventType"`
+	Target    []OktaEventTarget `json:"target"`
+}
+
+// OktaEventTarget is an entity an Okta event acted on
+type OktaEventTarget struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	AlternateID string `json:"alternateId"`
+}
+
+// OktaEventResult is the outcome of a single Okta event
+type OktaEventResult struct {
+	EventID   string `json:"event_id"`
+	EventType string `json:"event_type"`
+	UserID    string `json:"user_id,omitempty"`
+	Status    string `json:"status,omitempty"`
+	Result    string `json:"result"`
+}
+
+// Okta event results
+const (
+	OktaEventResultUpdated   = "updated"
+	OktaEventResultUnchanged = "unchanged"
+	OktaEventResultIgnored   = "ignored"
+)
+
+// mwOktaEventHookAuth checks the shared secret of the Okta event hook requests
+func (r *Router) mwOktaEventHookAuth(c *gin.Context) {
+	if r.OktaEventHookSecret == "" {
+		sendError(c, http.StatusNotImplemented, "okta event hooks aren't configured")
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(c.GetHeader(OktaEventHookSecretHeader)), []byte(r.OktaEventHookSecret)) != 1 {
+		sendError(c, http.StatusUnauthorized, "invalid okta event hook secret")
+		return
+	}
+}
+
+// verifyOktaEventHook answers the one-time verification Okta runs when an
+// event hook is registered
+func (r *Router) verifyOktaEventHook(c *gin.Context) {
+	challenge := c.GetHeader(oktaVerificationHeader)
+	if challenge == "" {
+		sendError(c, http.StatusBadRequest, "missing "+oktaVerificationHeader+" header")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"verification": challenge})
+}
+
+// processOktaEventHook updates the status of the governor users deactivated,
+// suspended, reactivated or unsuspended in Okta. Users are matched on their
+// external id, then on their email. Active users are suspended and suspended
+// users are activated, pending users and events about unknown users are
+// ignored so redelivered events don't change anything.
+func (r *Router) processOktaEventHook(c *gin.Context) {
+	req := &OktaEventHookReq{}
+	if !bindRequest(c, req) {
+		return
+	}
+
+	results := []OktaEventResult{}
+	auditEvents := []*models.AuditEvent{}
+
+	for _, e := range req.Data.Events {
+		result, event, err := r.processOktaEvent(c, e)
+		if err != nil {
+			if len(auditEvents) > 0 {
+				_ = updateContextWithAuditEventData(c, auditEvents)
+			}
+
+			sendError(c, http.StatusInternalServerError, "error processing okta event "+e.UUID+": "+err.Error())
+
+			return
+		}
+
+		if event != nil {
+			auditEvents = append(auditEvents, event)
+		}
+
+		results = append(results, result)
+	}
+
+	if len(auditEvents) > 0 {
+		if err := updateContextWithAuditEventData(c, auditEvents); err != nil {
+			sendError(c, http.StatusBadRequest, "error updating audit event data: "+err.Error())
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"events": results})
+}
+
+// processOktaEvent applies a single Okta event, it returns the audit event
+// when the user status changed
+func (r *Router) processOktaEvent(c *gin.Context, e OktaEvent) (OktaEventResult, *models.AuditEvent, error) {
+	result := OktaEventResult{
+		EventID:   e.UUID,
+		EventType: e.EventType,
+		Result:    OktaEventResultIgnored,
+	}
+
+	status, ok := oktaUserStatuses[e.EventType]
+	if !ok {
+		return result, nil, nil
+	}
+
+	var target *OktaEventTarget
+
+	for i := range e.Target {
+		if e.Target[i].Type == "User" {
+			target = &e.Target[i]
+			break
+		}
+	}
+
+	if target == nil {
+		return result, nil, nil
+	}
+
+	user, err := r.findOktaEventUser(c, target)
+	if err != nil {
+		return result, nil, err
+	}
+
+	if user == nil {
+		r.Logger.Debug("okta event user not found", zap.String("okta_user_id", target.ID), zap.String("event_id", e.UUID))
+		return result, nil, nil
+	}
+
+	result.UserID = user.ID
+	result.Status = user.Status.String
+
+	// only active users are suspended and only suspended users are reactivated,
+	// pending users are activated by governor
+	if user.Status.String == status ||
+		(status == UserStatusSuspended && user.Status.String != UserStatusActive) ||
+		(status == UserStatusActive && user.Status.String != UserStatusSuspended) {
+		result.Result = OktaEventResultUnchanged
+		return result, nil, nil
+	}
+
+	original := *user
+	user.Status = null.StringFrom(status)
+
+	var event *models.AuditEvent
+
+	// the events of a hook are processed one by one and their errors are
+	// reported in the hook response, not with the withTx responses
+	if err := dbtools.WithTransaction(c.Request.Context(), r.DB, func(tx *sql.Tx) error {
+		if _, err := user.Update(c.Request.Context(), tx, boil.Whitelist(models.UserColumns.Status, models.UserColumns.UpdatedAt)); err != nil {
+			return err
+		}
+
+		event, err = dbtools.AuditUserStatusSynced(c.Request.Context(), tx, getCtxAuditID(c), oktaEventSource, e.UUID, &original, user)
+
+		return err
+	}); err != nil {
+		return result, nil, err
+	}
+
+	result.Status = status
+	result.Result = OktaEventResultUpdated
+
+	r.publishUserStatusSynced(c, &original, user)
+
+	return result, event, nil
+}
+
+// findOktaEventUser returns the governor user targeted by an Okta event, or
+// nil when there is none
+func (r *Router) findOktaEventUser(c *gin.Context, target *OktaEventTarget) (*models.User, error) {
+	var where qm.QueryMod
+
+	switch {
+	case target.ID != "" && target.AlternateID != "":
+		where = qm.Where("external_id = ? OR lower(email) = lower(?)", target.ID, target.AlternateID)
+	case target.ID != "":
+		where = models.UserWhere.ExternalID.EQ(null.StringFrom(target.ID))
+	case target.AlternateID != "":
+		where = qm.Where("lower(email) = lower(?)", target.AlternateID)
+	default:
+		return nil, nil
+	}
+
+	users, err := models.Users(where).All(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	// the external id match wins over the email one
+	for _, u := range users {
+		if u.ExternalID.Valid && u.ExternalID.String == target.ID {
+			return u, nil
+		}
+	}
+
+	if len(users) == 0 {
+		return nil, nil
+	}
+
+	return users[0], nil
+}
+
+// publishUserStatusSynced publishes the user update event and a members
+// update event for each of the user's direct memberships so the addons can
+// apply the new status. Publishing failures are only logged since Okta
+// doesn't need to redeliver the event.
+func (r *Router) publishUserStatusSynced(c *gin.Context, original, user *models.User) {
+	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorUsersEventSubject, &events.Event{
+		Version: events.Version,
+		Action:  events.GovernorEventUpdate,
+		AuditID: c.GetString(ginaudit.AuditIDContextKey),
+		UserID:  user.ID,
+		Before:  original,
+		After:   user,
+	}); err != nil {
+		r.Logger.Warn("failed to publish user update event, downstream changes may be delayed", zap.Error(err))
+	}
+
+	memberships, err := user.GroupMemberships().All(c.Request.Context(), r.DB)
+	if err != nil {
+		r.Logger.Warn("failed to get user memberships, members events not published", zap.Error(err))
+		return
+	}
+
+	for _, m := range memberships {
+		if err := r.EventBus.Publish(c.Request.Context(), events.GovernorMembersEventSubject, &events.Event{
+			Version: events.MemberVersion,
+			Action:  events.GovernorEventUpdate,
+			AuditID: c.GetString(ginaudit.AuditIDContextKey),
+			GroupID: m.GroupID,
+			UserID:  user.ID,
+			Member:  dbtools.GroupMembershipEventMember(m),
+		}); err != nil {
+			r.Logger.Warn("failed to publish members update event, downstream changes may be delayed", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMWOktaEventHookAuth(t *testing.T) {
+	tests := []struct {
+		name       string
+		secret     string
+		header     string
+		wantStatus int
+		wantAbort  bool
+	}{
+		{name: "not configured", header: "s3cret", wantStatus: http.StatusNotImplemented, wantAbort: true},
+		{name: "missing secret", secret: "s3cret", wantStatus: http.StatusUnauthorized, wantAbort: true},
+		{name: "wrong secret", secret: "s3cret", header: "nope", wantStatus: http.StatusUnauthorized, wantAbort: true},
+		{name: "valid secret", secret: "s3cret", header: "s3cret", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, w := newValidationTestContext("")
+			c.Request.Header.Set(OktaEventHookSecretHeader, tt.header)
+
+			r := &Router{OktaEventHookSecret: tt.secret}
+			r.mwOktaEventHookAuth(c)
+
+			assert.Equal(t, tt.wantAbort, c.IsAborted())
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestVerifyOktaEventHook(t *testing.T) {
+	c, w := newValidationTestContext("")
+	c.Request.Header.Set(oktaVerificationHeader, "challenge-value")
+
+	(&Router{}).verifyOktaEventHook(c)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	resp := map[string]string{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "challenge-value", resp["verification"])
+
+	c, w = newValidationTestContext("")
+
+	(&Router{}).verifyOktaEventHook(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
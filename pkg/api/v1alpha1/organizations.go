@@ -13,6 +13,7 @@ import (
 
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
 )
 
 // Organization is the organization response
@@ -68,7 +69,7 @@ func (r *Router) listOrganizationGroups(c *gin.Context) {
 		org, err := models.Organizations(qm.Where("slug = ?", id)).One(c.Request.Context(), r.DB)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
-				sendError(c, http.StatusNotFound, "organization not found: "+err.Error())
+				sendErrorWithCode(c, http.StatusNotFound, ErrCodeOrganizationNotFound, "organization not found: "+err.Error())
 				return
 			}
 
@@ -95,7 +96,7 @@ func (r *Router) listOrganizationGroups(c *gin.Context) {
 		gids[i] = g.GroupID
 	}
 
-	groups, err := models.Groups(qm.WhereIn("id IN ?", gids...)).All(c.Request.Context(), r.DB)
+	groups, err := models.Groups(qm.WhereIn("id IN ?", gids...), tenancy.Scope(c.Request.Context(), models.TableNames.Groups)).All(c.Request.Context(), r.DB)
 	if err != nil {
 		r.Logger.Error("error fetching organization groups", zap.Error(err))
 		sendError(c, http.StatusBadRequest, "error listing organization groups: "+err.Error())
@@ -133,7 +134,7 @@ func (r *Router) getOrganization(c *gin.Context) {
 	org, err := models.Organizations(queryMods...).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "organization not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeOrganizationNotFound, "organization not found: "+err.Error())
 			return
 		}
 
@@ -150,8 +151,7 @@ func (r *Router) getOrganization(c *gin.Context) {
 // createOrganization creates an org in the database
 func (r *Router) createOrganization(c *gin.Context) {
 	req := OrganizationReq{}
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
 		return
 	}
 
@@ -161,58 +161,22 @@ func (r *Router) createOrganization(c *gin.Context) {
 
 	dbtools.SetOrganizationSlug(org)
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting organization create transaction: "+err.Error())
-		return
-	}
-
-	if err := org.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := "error creating organization: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := org.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating organization: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditOrganizationCreated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), org)
-	if err != nil {
-		msg := "error creating organization (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error creating organization (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		event, err := dbtools.AuditOrganizationCreated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), org)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error creating organization (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing organization create, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating organization (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
@@ -235,7 +199,7 @@ func (r *Router) deleteOrganization(c *gin.Context) {
 	).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "organization not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeOrganizationNotFound, "organization not found: "+err.Error())
 			return
 		}
 
@@ -244,79 +208,31 @@ func (r *Router) deleteOrganization(c *gin.Context) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting delete transaction: "+err.Error())
-		return
-	}
-
-	r.Logger.Debug("transaction started")
-
-	// delete all org links
-	if _, err := org.R.GroupOrganizations.DeleteAll(c.Request.Context(), tx); err != nil {
-		msg := "error deleting group org link, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	r.Logger.Debug("deleted org links started")
-
-	if _, err := org.Delete(c.Request.Context(), tx, false); err != nil {
-		msg := "error deleting organization, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		// delete all org links
+		if _, err := org.R.GroupOrganizations.DeleteAll(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting group org link, rolling back: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	r.Logger.Debug("deleted org")
+		r.Logger.Debug("deleted org links started")
 
-	event, err := dbtools.AuditOrganizationDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), org)
-	if err != nil {
-		msg := "error deleting organization (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		if _, err := org.Delete(c.Request.Context(), tx, false); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting organization, rolling back: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		r.Logger.Debug("deleted org")
 
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error deleting organization (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		event, err := dbtools.AuditOrganizationDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), org)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting organization (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	r.Logger.Debug("committing started")
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing organization delete, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting organization (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
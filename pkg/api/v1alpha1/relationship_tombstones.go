@@ -0,0 +1,69 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+)
+
+// RelationshipTombstone records the removal of a hard deleted relationship,
+// a group membership, hierarchy or organization link. The group id is the
+// group of the membership or organization link, or the parent group of the
+// hierarchy, and the subject id is the user, the member group or the
+// organization.
+type RelationshipTombstone struct {
+	ID        string          `json:"id"`
+	Kind      string          `json:"kind"`
+	RecordID  string          `json:"record_id"`
+	GroupID   string          `json:"group_id"`
+	SubjectID string          `json:"subject_id"`
+	Record    json.RawMessage `json:"record"`
+	RemovedAt time.Time       `json:"removed_at"`
+}
+
+// listRelationshipTombstones lists the tombstones of the relationships of a
+// kind removed after the updated_since watermark, or all the retained ones
+func (r *Router) listRelationshipTombstones(c *gin.Context) {
+	kind := c.Param("kind")
+	if !slices.Contains(dbtools.TombstoneKinds, kind) {
+		sendError(c, http.StatusNotFound, "unknown kind of tombstones: "+kind)
+		return
+	}
+
+	since, ok := updatedSinceParam(c)
+	if !ok {
+		return
+	}
+
+	after, retention := time.Time{}, time.Duration(0)
+	if since != nil {
+		after, retention = *since, r.TombstoneRetention
+	}
+
+	tombstones, err := r.svc().RelationshipTombstonesSince(c.Request.Context(), kind, after, retention)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := make([]RelationshipTombstone, len(tombstones))
+
+	for i, t := range tombstones {
+		response[i] = RelationshipTombstone{
+			ID:        t.ID,
+			Kind:      t.Kind,
+			RecordID:  t.RecordID,
+			GroupID:   t.GroupID,
+			SubjectID: t.SubjectID,
+			Record:    json.RawMessage(t.Record),
+			RemovedAt: t.RemovedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
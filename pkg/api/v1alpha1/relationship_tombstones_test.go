@@ -0,0 +1,52 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRelationshipTombstonesValidation(t *testing.T) {
+	r := &Router{}
+
+	engine := gin.New()
+	engine.GET("/tombstones/:kind", r.listRelationshipTombstones)
+
+	tests := []struct {
+		name     string
+		path     string
+		wantCode int
+		wantErr  ErrorCode
+	}{
+		{
+			name:     "unknown kind",
+			path:     "/tombstones/group_application",
+			wantCode: http.StatusNotFound,
+			wantErr:  ErrCodeNotFound,
+		},
+		{
+			name:     "invalid updated_since",
+			path:     "/tombstones/group_membership?updated_since=yesterday",
+			wantCode: http.StatusBadRequest,
+			wantErr:  ErrCodeValidationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.wantCode, w.Code)
+
+			resp := ErrorResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantErr, resp.Code)
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/auditevent/ginaudit"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// RequestComment is a comment on a group membership or application request
+type RequestComment struct {
+	ID        string    `json:"id"`
+	RequestID string    `json:"request_id"`
+	UserID    string    `json:"user_id"`
+	UserName  string    `json:"user_name"`
+	UserEmail string    `json:"user_email"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RequestCommentReq is a request to comment on a group membership or application request
+type RequestCommentReq struct {
+	Body string `json:"body" binding:"required,max=4000"`
+}
+
+// requestParticipants are the users allowed to take part in the comment
+// thread of a request, the requester and whoever can approve the request
+type requestParticipants struct {
+	requesterID      string
+	adminGroupID     string
+	approverGroupIDs []string
+}
+
+// isRequestParticipant checks if the authenticated user is a governor admin,
+// the requester, an admin of the group or a member of one of the approver groups
+func (r *Router) isRequestParticipant(c *gin.Context, p requestParticipants) (bool, error) {
+	ctxUser := getCtxUser(c)
+	if ctxUser == nil {
+		return false, nil
+	}
+
+	if ctxUser.ID == p.requesterID {
+		return true, nil
+	}
+
+	if isAdmin := getCtxAdmin(c); isAdmin != nil && *isAdmin {
+		return true, nil
+	}
+
+	enumeratedMemberships, err := dbtools.GetMembershipsForUser(c.Request.Context(), r.DB.DB, ctxUser.ID, false)
+	if err != nil {
+		return false, err
+	}
+
+	for _, m := range enumeratedMemberships {
+		if m.GroupID == p.adminGroupID && m.IsAdmin && (!m.AdminExpiresAt.Valid || time.Now().Before(m.AdminExpiresAt.Time)) {
+			return true, nil
+		}
+
+		if contains(p.approverGroupIDs, m.GroupID) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// checkRequestParticipant sends an error response and returns false if the
+// authenticated user can't take part in the comment thread. Clients without
+// the oidc scope don't have a user and are only allowed to read the thread.
+func (r *Router) checkRequestParticipant(c *gin.Context, p requestParticipants, write bool) bool {
+	if getCtxUser(c) == nil {
+		if write {
+			sendError(c, http.StatusUnauthorized, "no user in context")
+			return false
+		}
+
+		return true
+	}
+
+	ok, err := r.isRequestParticipant(c, p)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error enumerating group membership: "+err.Error())
+		return false
+	}
+
+	if !ok {
+		sendError(c, http.StatusUnauthorized, "user is not the requester or an approver of the request")
+		return false
+	}
+
+	return true
+}
+
+// findGroupRequestForComments gets the group and membership request from the
+// request params and checks that the authenticated user can comment on it
+func (r *Router) findGroupRequestForComments(c *gin.Context, write bool) (*models.GroupMembershipRequest, bool) {
+	group, err := r.svc().FindGroup(c.Request.Context(), c.Param("id"), false)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return nil, false
+	}
+
+	request, err := models.GroupMembershipRequests(
+		qm.Where("id = ?", c.Param("rid")),
+		qm.Where("group_id = ?", group.ID),
+	).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeMembershipRequestNotFound, "group membership request not found: "+err.Error())
+			return nil, false
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting group membership request: "+err.Error())
+
+		return nil, false
+	}
+
+	p := requestParticipants{
+		requesterID:  request.UserID,
+		adminGroupID: group.ID,
+	}
+
+	if group.ApproverGroup.Valid {
+		p.approverGroupIDs = []string{group.ApproverGroup.String}
+	}
+
+	if !r.checkRequestParticipant(c, p, write) {
+		return nil, false
+	}
+
+	return request, true
+}
+
+// findGroupAppRequestForComments gets the group application request from the
+// request params and checks that the authenticated user can comment on it
+func (r *Router) findGroupAppRequestForComments(c *gin.Context, write bool) (*models.GroupApplicationRequest, bool) {
+	group, err := r.svc().FindGroup(c.Request.Context(), c.Param("id"), false)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return nil, false
+	}
+
+	request, err := models.GroupApplicationRequests(qm.Where("id = ?", c.Param("rid"))).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationRequestNotFound, "group application request not found: "+err.Error())
+			return nil, false
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting group application request: "+err.Error())
+
+		return nil, false
+	}
+
+	if request.GroupID != group.ID && request.ApproverGroupID != group.ID {
+		sendError(c, http.StatusBadRequest, "application request not associated with this group")
+		return nil, false
+	}
+
+	if !r.checkRequestParticipant(c, requestParticipants{
+		requesterID:      request.RequesterUserID,
+		adminGroupID:     request.GroupID,
+		approverGroupIDs: []string{request.ApproverGroupID},
+	}, write) {
+		return nil, false
+	}
+
+	return request, true
+}
+
+// sendRequestComments responds with the comments matching the query mods, oldest first
+func (r *Router) sendRequestComments(c *gin.Context, requestID string, mods ...qm.QueryMod) {
+	mods = append(mods, qm.Load("User"), qm.OrderBy("created_at"))
+
+	comments, err := models.RequestComments(mods...).All(c.Request.Context(), r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error listing request comments: "+err.Error())
+		return
+	}
+
+	resp := make([]*RequestComment, len(comments))
+	for i, rc := range comments {
+		resp[i] = newRequestComment(requestID, rc)
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func newRequestComment(requestID string, rc *models.RequestComment) *RequestComment {
+	comment := &RequestComment{
+		ID:        rc.ID,
+		RequestID: requestID,
+		UserID:    rc.UserID,
+		Body:      rc.Body,
+		CreatedAt: rc.CreatedAt,
+	}
+
+	if rc.R != nil && rc.R.User != nil {
+		comment.UserName = rc.R.User.Name
+		comment.UserEmail = rc.R.User.Email
+	}
+
+	return comment
+}
+
+// listGroupRequestComments lists the comments on a group membership request
+func (r *Router) listGroupRequestComments(c *gin.Context) {
+	request, ok := r.findGroupRequestForComments(c, false)
+	if !ok {
+		return
+	}
+
+	r.sendRequestComments(c, request.ID, models.RequestCommentWhere.GroupMembershipRequestID.EQ(null.StringFrom(request.ID)))
+}
+
+// listGroupAppRequestComments lists the comments on a group application request
+func (r *Router) listGroupAppRequestComments(c *gin.Context) {
+	request, ok := r.findGroupAppRequestForComments(c, false)
+	if !ok {
+		return
+	}
+
+	r.sendRequestComments(c, request.ID, models.RequestCommentWhere.GroupApplicationRequestID.EQ(null.StringFrom(request.ID)))
+}
+
+// createRequestComment inserts the comment and its audit event in a single
+// transaction and publishes an event on the given subject
+func (r *Router) createRequestComment(
+	c *gin.Context,
+	comment *models.RequestComment,
+	audit func(tx boil.ContextExecutor) (*models.AuditEvent, error),
+	subject string,
+	event *events.Event,
+) {
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := comment.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "failed to create request comment: "+err.Error(), err)
+		}
+
+		auditEvent, err := audit(tx)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error creating request comment (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, auditEvent); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating request comment (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	event.Version = events.Version
+	event.Action = events.GovernorEventComment
+	event.AuditID = c.GetString(ginaudit.AuditIDContextKey)
+	event.ActorID = getCtxActorID(c)
+	event.RequestCommentID = comment.ID
+
+	if err := r.EventBus.Publish(c.Request.Context(), subject, event); err != nil {
+		acceptChange(c, fmt.Errorf("failed to publish request comment event: %w", err))
+	}
+
+	ctxUser := getCtxUser(c)
+	comment.R = comment.R.NewStruct()
+	comment.R.User = ctxUser
+
+	c.JSON(http.StatusAccepted, newRequestComment(event.RequestID, comment))
+}
+
+// createGroupRequestComment adds a comment to a group membership request
+func (r *Router) createGroupRequestComment(c *gin.Context) {
+	request, ok := r.findGroupRequestForComments(c, true)
+	if !ok {
+		return
+	}
+
+	req := RequestCommentReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	ctxUser := getCtxUser(c)
+
+	comment := &models.RequestComment{
+		GroupMembershipRequestID: null.StringFrom(request.ID),
+		UserID:                   ctxUser.ID,
+		Body:                     req.Body,
+	}
+
+	r.createRequestComment(c, comment,
+		func(tx boil.ContextExecutor) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupMembershipRequestCommented(c.Request.Context(), tx, getCtxAuditID(c), ctxUser, request, comment)
+		},
+		events.GovernorMemberRequestsEventSubject,
+		&events.Event{
+			GroupID:   request.GroupID,
+			UserID:    request.UserID,
+			RequestID: request.ID,
+		},
+	)
+}
+
+// createGroupAppRequestComment adds a comment to a group application request
+func (r *Router) createGroupAppRequestComment(c *gin.Context) {
+	request, ok := r.findGroupAppRequestForComments(c, true)
+	if !ok {
+		return
+	}
+
+	req := RequestCommentReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	ctxUser := getCtxUser(c)
+
+	comment := &models.RequestComment{
+		GroupApplicationRequestID: null.StringFrom(request.ID),
+		UserID:                    ctxUser.ID,
+		Body:                      req.Body,
+	}
+
+	r.createRequestComment(c, comment,
+		func(tx boil.ContextExecutor) (*models.AuditEvent, error) {
+			return dbtools.AuditGroupApplicationRequestCommented(c.Request.Context(), tx, getCtxAuditID(c), ctxUser, request, comment)
+		},
+		events.GovernorApplicationLinkRequestsEventSubject,
+		&events.Event{
+			GroupID:       request.GroupID,
+			ApplicationID: request.ApplicationID,
+			RequestID:     request.ID,
+		},
+	)
+}
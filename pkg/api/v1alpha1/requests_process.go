@@ -0,0 +1,220 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/metal-toolbox/auditevent/ginaudit"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+)
+
+// maxProcessRequestsItems is the maximum number of requests that can be
+// processed in a single batch
+const maxProcessRequestsItems = 100
+
+// ProcessRequestsItem is a single request to approve or deny in a batch
+type ProcessRequestsItem struct {
+	RequestID string `json:"request_id"`
+	Action    string `json:"action"`
+}
+
+// ProcessRequestsReq is the payload to approve or deny a batch of group
+// membership and group application requests
+type ProcessRequestsReq struct {
+	Requests []ProcessRequestsItem `json:"requests" binding:"required"`
+}
+
+// ProcessRequestsResult is the result of processing a single request of a
+// batch, the error is only set when processing the request failed
+type ProcessRequestsResult struct {
+	RequestID string         `json:"request_id"`
+	Kind      ApprovalKind   `json:"kind,omitempty"`
+	Action    string         `json:"action"`
+	Status    int            `json:"status"`
+	Error     *ErrorResponse `json:"error,omitempty"`
+}
+
+// processRequests approves or denies a batch of group membership and group
+// application requests on behalf of the authenticated user. Every request is
+// validated and processed independently, in its own transaction, exactly as
+// if it was processed on its own, so a failing request doesn't affect the rest
+// of the batch. The response lists the result of each request in order.
+func (r *Router) processRequests(c *gin.Context) {
+	ctxUser := getCtxUser(c)
+	if ctxUser == nil {
+		sendError(c, http.StatusUnauthorized, "no user in context")
+		return
+	}
+
+	ctxAdmin := getCtxAdmin(c)
+	if ctxAdmin == nil {
+		sendError(c, http.StatusUnauthorized, "no admin in context")
+		return
+	}
+
+	req := &ProcessRequestsReq{}
+	if !bindRequest(c, req) {
+		return
+	}
+
+	if len(req.Requests) == 0 {
+		sendError(c, http.StatusBadRequest, "at least one request is required")
+		return
+	}
+
+	if len(req.Requests) > maxProcessRequestsItems {
+		sendError(c, http.StatusBadRequest, fmt.Sprintf("at most %d requests can be processed at once", maxProcessRequestsItems))
+		return
+	}
+
+	approvals, err := r.approvalsForUser(c.Request.Context(), ctxUser, *ctxAdmin)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	approvable := make(map[string]bool, len(approvals))
+
+	for _, a := range approvals {
+		if a.MemberRequest != nil {
+			approvable[a.MemberRequest.ID] = true
+		}
+	}
+
+	results := make([]ProcessRequestsResult, len(req.Requests))
+	auditData := []*json.RawMessage{}
+
+	for i, item := range req.Requests {
+		results[i] = r.processRequestsItem(c, item, approvable, *ctxAdmin)
+
+		if data, ok := c.Get(ginaudit.AuditDataContextKey); ok {
+			if ed, ok := data.(*json.RawMessage); ok && ed != nil {
+				auditData = append(auditData, ed)
+			}
+
+			c.Set(ginaudit.AuditDataContextKey, nil)
+		}
+	}
+
+	if len(auditData) > 0 {
+		ev, err := json.Marshal(auditData)
+		if err != nil {
+			sendError(c, http.StatusBadRequest, "error updating audit event data: "+err.Error())
+			return
+		}
+
+		j := json.RawMessage(ev)
+
+		c.Set(ginaudit.AuditDataContextKey, &j)
+	}
+
+	c.JSON(http.StatusOK, results)
+}
+
+// processRequestsItem validates and processes a single request of a batch.
+// Membership requests can be processed by the users allowed to approve them
+// and by governor admins, application requests are authorized by the handler
+// processing them.
+func (r *Router) processRequestsItem(c *gin.Context, item ProcessRequestsItem, approvable map[string]bool, isAdmin bool) ProcessRequestsResult {
+	result := ProcessRequestsResult{
+		RequestID: item.RequestID,
+		Action:    item.Action,
+	}
+
+	if item.Action != service.RequestActionApprove && item.Action != service.RequestActionDeny {
+		return result.withError(http.StatusBadRequest, ErrCodeValidationFailed, "invalid action "+item.Action)
+	}
+
+	if _, err := uuid.Parse(item.RequestID); err != nil {
+		return result.withError(http.StatusBadRequest, ErrCodeValidationFailed, "invalid request id "+item.RequestID)
+	}
+
+	var (
+		groupID string
+		handler gin.HandlerFunc
+	)
+
+	memberRequest, err := models.GroupMembershipRequests(qm.Where("id = ?", item.RequestID)).One(c.Request.Context(), r.DB)
+
+	switch {
+	case err == nil:
+		result.Kind = ApprovalKindMembership
+
+		if !isAdmin && !approvable[memberRequest.ID] {
+			return result.withError(http.StatusForbidden, ErrCodeForbidden, "user not allowed to process this request")
+		}
+
+		groupID, handler = memberRequest.GroupID, r.processGroupRequest
+	case errors.Is(err, sql.ErrNoRows):
+		appRequest, err := models.GroupApplicationRequests(qm.Where("id = ?", item.RequestID)).One(c.Request.Context(), r.DB)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return result.withError(http.StatusNotFound, ErrCodeNotFound, "request not found")
+			}
+
+			return result.withError(http.StatusInternalServerError, ErrCodeInternal, "error getting group application request: "+err.Error())
+		}
+
+		result.Kind = ApprovalKindApplication
+		groupID, handler = appRequest.ApproverGroupID, r.processGroupAppRequest
+	default:
+		return result.withError(http.StatusInternalServerError, ErrCodeInternal, "error getting group membership request: "+err.Error())
+	}
+
+	result.Status, result.Error = delegateProcessRequest(c, handler, groupID, item)
+
+	return result
+}
+
+// delegateProcessRequest runs the handler processing a single request with
+// the group and request ids as path parameters and the action as the request
+// body. It returns the status of the handler response and its error, if any.
+func delegateProcessRequest(c *gin.Context, handler gin.HandlerFunc, groupID string, item ProcessRequestsItem) (int, *ErrorResponse) {
+	b, err := json.Marshal(struct {
+		Action string `json:"action"`
+	}{item.Action})
+	if err != nil {
+		return http.StatusBadRequest, &ErrorResponse{Code: ErrCodeBadRequest, Message: "error encoding request: " + err.Error()}
+	}
+
+	origBody, origParams, origWriter := c.Request.Body, c.Params, c.Writer
+
+	c.Request.Body = io.NopCloser(bytes.NewReader(b))
+	c.Params = gin.Params{{Key: "id", Value: groupID}, {Key: "rid", Value: item.RequestID}}
+
+	w := &upsertResponseWriter{ResponseWriter: origWriter, status: http.StatusOK}
+	c.Writer = w
+
+	handler(c)
+
+	c.Request.Body, c.Params, c.Writer = origBody, origParams, origWriter
+
+	if w.status < http.StatusMultipleChoices {
+		return w.status, nil
+	}
+
+	resp := &ErrorResponse{}
+	if err := json.Unmarshal(w.body.Bytes(), resp); err != nil {
+		resp = &ErrorResponse{Code: errorCodeForStatus(w.status), Message: w.body.String()}
+	}
+
+	return w.status, resp
+}
+
+// withError returns the result with the given error
+func (p ProcessRequestsResult) withError(status int, code ErrorCode, msg string) ProcessRequestsResult {
+	p.Status = status
+	p.Error = &ErrorResponse{Code: code, Message: msg, Error: msg}
+
+	return p
+}
@@ -0,0 +1,67 @@
+package v1alpha1
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelegateProcessRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		handler    gin.HandlerFunc
+		wantStatus int
+		wantCode   ErrorCode
+	}{
+		{
+			name: "processed",
+			handler: func(c *gin.Context) {
+				body, _ := io.ReadAll(c.Request.Body)
+				if c.Param("id") != "group-id" || c.Param("rid") != "request-id" || string(body) != `{"action":"approve"}` {
+					sendError(c, http.StatusBadRequest, "unexpected request")
+					return
+				}
+
+				c.JSON(http.StatusNoContent, nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name: "error",
+			handler: func(c *gin.Context) {
+				sendErrorWithCode(c, http.StatusNotFound, ErrCodeApplicationRequestNotFound, "nope")
+			},
+			wantStatus: http.StatusNotFound,
+			wantCode:   ErrCodeApplicationRequestNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, w := newValidationTestContext("")
+			c.Params = gin.Params{{Key: "eid", Value: "other"}}
+
+			status, resp := delegateProcessRequest(c, tt.handler, "group-id", ProcessRequestsItem{
+				RequestID: "request-id",
+				Action:    "approve",
+			})
+
+			assert.Equal(t, tt.wantStatus, status)
+			assert.Equal(t, gin.Params{{Key: "eid", Value: "other"}}, c.Params)
+			assert.Equal(t, 0, w.Body.Len())
+
+			if tt.wantCode == "" {
+				assert.Nil(t, resp)
+				return
+			}
+
+			if assert.NotNil(t, resp) {
+				assert.Equal(t, tt.wantCode, resp.Code)
+				assert.Equal(t, "nope", resp.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/metal-toolbox/governor-api/internal/respcache"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+)
+
+const (
+	// responseCacheHeader is set on cached routes to indicate whether the response was served from the cache
+	responseCacheHeader = "X-Governor-Cache"
+
+	// contextKeyResponseCacheVariant is set by the middlewares before the
+	// response cache when the response of a path depends on the caller
+	contextKeyResponseCacheVariant = "response_cache_variant"
+)
+
+// responseCacheWriter captures the response body so it can be stored in the cache
+type responseCacheWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseCacheWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseCacheWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// mwResponseCache serves successful responses from the response cache. The
+// cached responses are invalidated whenever an event is published on any of
+// the given subjects, and are kept apart for every organization since the
+// same path returns a different response for each of them, and for every
+// variant of the response set in the context. Caching is disabled when the
+// router has no cache.
+func (r *Router) mwResponseCache(subjects ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if r.Cache == nil {
+			return
+		}
+
+		path := c.Request.URL.RequestURI()
+		if orgID, ok := tenancy.FromContext(c.Request.Context()); ok {
+			path = orgID + path
+		}
+
+		if variant := c.GetString(contextKeyResponseCacheVariant); variant != "" {
+			path = variant + ":" + path
+		}
+
+		key := r.Cache.Key(path, subjects...)
+
+		if resp, ok := r.Cache.Get(key); ok {
+			c.Header(responseCacheHeader, "HIT")
+			c.Data(resp.Status, resp.ContentType, resp.Body)
+			c.Abort()
+
+			return
+		}
+
+		c.Header(responseCacheHeader, "MISS")
+
+		w := &responseCacheWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+
+		c.Next()
+
+		if c.IsAborted() || w.Status() != http.StatusOK {
+			return
+		}
+
+		r.Cache.Set(key, respcache.Response{
+			Status:      w.Status(),
+			ContentType: w.Header().Get("Content-Type"),
+			Body:        w.body.Bytes(),
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metal-toolbox/governor-api/internal/respcache"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+func TestResponseCacheTenants(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := &Router{Cache: respcache.New()}
+
+	e := gin.New()
+	e.GET("/users/:id",
+		func(c *gin.Context) {
+			if orgID := c.GetHeader("X-Test-Org"); orgID != "" {
+				c.Request = c.Request.WithContext(tenancy.WithTenant(c.Request.Context(), orgID))
+			}
+		},
+		r.mwResponseCache(events.GovernorUsersEventSubject),
+		func(c *gin.Context) {
+			orgID, _ := tenancy.FromContext(c.Request.Context())
+			c.JSON(http.StatusOK, gin.H{"org": orgID})
+		},
+	)
+
+	get := func(orgID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/users/user-id", nil)
+		if orgID != "" {
+			req.Header.Set("X-Test-Org", orgID)
+		}
+
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+
+		return w
+	}
+
+	w := get("org-a")
+	assert.Equal(t, "MISS", w.Header().Get(responseCacheHeader))
+	assert.JSONEq(t, `{"org":"org-a"}`, w.Body.String())
+
+	w = get("org-a")
+	assert.Equal(t, "HIT", w.Header().Get(responseCacheHeader))
+	assert.JSONEq(t, `{"org":"org-a"}`, w.Body.String())
+
+	// the other organization doesn't get the response cached for the first one
+	w = get("org-b")
+	assert.Equal(t, "MISS", w.Header().Get(responseCacheHeader))
+	assert.JSONEq(t, `{"org":"org-b"}`, w.Body.String())
+
+	w = get("")
+	assert.Equal(t, "MISS", w.Header().Get(responseCacheHeader))
+	assert.JSONEq(t, `{"org":""}`, w.Body.String())
+}
+
+func TestResponseCacheVariants(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := &Router{Cache: respcache.New()}
+
+	e := gin.New()
+	e.GET("/groups/:id/users",
+		func(c *gin.Context) {
+			if c.GetHeader("X-Test-Notes") != "" {
+				c.Set(contextKeyMembershipNotes, true)
+				c.Set(contextKeyResponseCacheVariant, "notes")
+			}
+		},
+		r.mwResponseCache(events.GovernorMembersEventSubject),
+		func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"notes": c.GetBool(contextKeyMembershipNotes)})
+		},
+	)
+
+	get := func(notes bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/groups/group-id/users", nil)
+		if notes {
+			req.Header.Set("X-Test-Notes", "1")
+		}
+
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+
+		return w
+	}
+
+	w := get(true)
+	assert.Equal(t, "MISS", w.Header().Get(responseCacheHeader))
+	assert.JSONEq(t, `{"notes":true}`, w.Body.String())
+
+	// the callers that can't read the notes don't get the listing with them
+	w = get(false)
+	assert.Equal(t, "MISS", w.Header().Get(responseCacheHeader))
+	assert.JSONEq(t, `{"notes":false}`, w.Body.String())
+
+	w = get(true)
+	assert.Equal(t, "HIT", w.Header().Get(responseCacheHeader))
+	assert.JSONEq(t, `{"notes":true}`, w.Body.String())
+}
@@ -0,0 +1,1541 @@
+package v1alpha1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.hollow.sh/toolbox/ginjwt"
+
+	"github.com/metal-toolbox/governor-api/internal/featureflags"
+)
+
+// routeAuth is how the requests of a route are authenticated
+type routeAuth int
+
+const (
+	// routeAuthToken requires a token with one of the scopes of the route
+	routeAuthToken routeAuth = iota
+	// routeAuthExtensionToken also accepts the tokens issued to the extensions
+	// with their client credentials, without checking their scopes
+	routeAuthExtensionToken
+	// routeAuthOktaEventHook requires the shared secret of the Okta event hooks
+	routeAuthOktaEventHook
+	// routeAuthNone doesn't authenticate the requests, the handler does
+	routeAuthNone
+)
+
+func (a routeAuth) String() string {
+	return [...]string{
+		"token",
+		"extension_token",
+		"okta_event_hook",
+		"none",
+	}[a]
+}
+
+// erdAuth is how the requests on the extension resources are authorized
+type erdAuth int
+
+const (
+	// erdAuthNone doesn't check the groups of the user
+	erdAuthNone erdAuth = iota
+	// erdAuthResourceGroups requires the user to be a governor admin, or a
+	// member of the ERD admin group or of the owner group of the resource
+	erdAuthResourceGroups
+)
+
+func (a erdAuth) String() string {
+	return [...]string{
+		"none",
+		"resource_groups",
+	}[a]
+}
+
+// routePolicy declares the authorization of a route, the router builds the
+// auth middlewares of the route from it
+type routePolicy struct {
+	Method string
+	Path   string
+	// Auth is how the requests are authenticated, a token with one of the
+	// scopes is required by default
+	Auth   routeAuth
+	Scopes []string
+	// UserRole is the role required from the user, it isn't checked when it is nil
+	UserRole *mwAuthRole
+	// GroupRole is the role required from the user in the group of the route,
+	// it isn't checked when it is nil
+	GroupRole *mwAuthRole
+	ERDAuth   erdAuth
+	// StepUp is the step-up route group of the route, no step-up is required
+	// when it is empty
+	StepUp string
+}
+
+func authRole(role mwAuthRole) *mwAuthRole {
+	return &role
+}
+
+// routePolicies are the authorization policies of the routes, a route can't
+// be registered without one
+var routePolicies = []routePolicy{
+	{
+		Method:   http.MethodGet,
+		Path:     "/user",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/user",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/user/groups",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/user/groups/:id",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/user/groups/requests",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/user/session-tokens",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/user/session-tokens",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/user/requests",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/user/groups/approvals",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/user/approvals",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/requests/process",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/user/notification-preferences",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/user/notification-preferences",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/user/notification-preferences/groups",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/user/notification-preferences/groups",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/user/notification-preferences/failover",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/user/notification-preferences/failover",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/user/email-verification",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/authz/check",
+		Scopes:   readScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/users",
+		Scopes: readScopesWithOpenID("governor:users"),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/users",
+		Scopes:   createScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/users/by-identity",
+		Scopes:   updateScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/users/import",
+		Scopes:   updateScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/users/duplicates",
+		Scopes:   readScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/users/:id",
+		Scopes: readScopesWithOpenID("governor:users"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/users/:id/avatar",
+		Scopes: readScopesWithOpenID("governor:users"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/users/:id/notification-delivery",
+		Scopes: readScopesWithOpenID("governor:users"),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/users/:id/events",
+		Scopes:   readScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/users/:id/archived-requests",
+		Scopes:   readScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/users/:id/export",
+		Scopes:   readScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/users/:id/access",
+		Scopes: readScopesWithOpenID("governor:users"),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/users/:id",
+		Scopes:   updateScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/users/:id/merge/:other",
+		Scopes:   updateScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+		StepUp:   StepUpRouteGroupUsers,
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/users/:id/anonymize",
+		Scopes:   deleteScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+		StepUp:   StepUpRouteGroupUsers,
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/users/:id/groups",
+		Scopes:   deleteScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+		StepUp:   StepUpRouteGroupUsers,
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/users/:id",
+		Scopes:   deleteScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+		StepUp:   StepUpRouteGroupUsers,
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/groups",
+		Scopes: readScopesWithOpenID("governor:groups"),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/groups",
+		Scopes:   createScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/groups/by-slug/:slug",
+		Scopes:   updateScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/groups/requests",
+		Scopes: readScopesWithOpenID("governor:groups"),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/groups/memberships",
+		Scopes:   readScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/groups/membership-exemptions",
+		Scopes: readScopesWithOpenID("governor:groups"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/groups/hierarchies",
+		Scopes: readScopesWithOpenID("governor:groups"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/groups/:id",
+		Scopes: readScopesWithOpenID("governor:groups"),
+	},
+	{
+		Method:    http.MethodPut,
+		Path:      "/groups/:id",
+		Scopes:    updateScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleGroupAdmin),
+	},
+	{
+		Method:    http.MethodDelete,
+		Path:      "/groups/:id",
+		Scopes:    deleteScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleAdminOrGroupAdmin),
+		StepUp:    StepUpRouteGroupGroups,
+	},
+	{
+		Method:    http.MethodGet,
+		Path:      "/groups/:id/deletion-impact",
+		Scopes:    readScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleAdminOrGroupAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/groups/:id/freeze",
+		Scopes:   updateScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/groups/:id/freeze",
+		Scopes:   updateScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/groups/:id/protection",
+		Scopes:   updateScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/groups/:id/protection",
+		Scopes:   updateScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/groups/:id/break-glass",
+		Scopes:   updateScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/groups/:id/break-glass",
+		Scopes:   updateScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/groups/:id/break-glass/access",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+		StepUp:   StepUpRouteGroupMembers,
+	},
+	{
+		Method:    http.MethodGet,
+		Path:      "/groups/:id/events",
+		Scopes:    readScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleGroupMember),
+	},
+	{
+		Method:    http.MethodGet,
+		Path:      "/groups/:id/archived-requests",
+		Scopes:    readScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleGroupMember),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/groups/:id/requests",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/groups/:id/requests",
+		Scopes: readScopesWithOpenID("governor:groups"),
+	},
+	{
+		Method:    http.MethodPut,
+		Path:      "/groups/:id/requests/:rid",
+		Scopes:    []string{oidcScope},
+		GroupRole: authRole(AuthRoleAdminOrGroupAdminOrGroupApprover),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/groups/:id/requests/:rid",
+		Scopes:   updateScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/groups/:id/requests/:rid/comments",
+		Scopes:   readScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/groups/:id/requests/:rid/comments",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/groups/:id/users",
+		Scopes:   readScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:    http.MethodPut,
+		Path:      "/groups/:id/users/:uid",
+		Scopes:    updateScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleGroupAdmin),
+	},
+	{
+		Method:    http.MethodPatch,
+		Path:      "/groups/:id/users/:uid",
+		Scopes:    updateScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleAdminOrGroupAdmin),
+	},
+	{
+		Method:    http.MethodDelete,
+		Path:      "/groups/:id/users/:uid",
+		Scopes:    updateScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleGroupAdmin),
+		StepUp:    StepUpRouteGroupMembers,
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/groups/:id/users/:uid/exemption",
+		Scopes:   updateScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/groups/:id/users/:uid/exemption",
+		Scopes:   updateScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:    http.MethodPost,
+		Path:      "/groups/:id/users/:uid/scheduled-changes",
+		Scopes:    updateScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleGroupAdmin),
+		StepUp:    StepUpRouteGroupMembers,
+	},
+	{
+		Method:    http.MethodGet,
+		Path:      "/groups/:id/scheduled-changes",
+		Scopes:    readScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleAdminOrGroupAdmin),
+	},
+	{
+		Method:    http.MethodDelete,
+		Path:      "/groups/:id/scheduled-changes/:cid",
+		Scopes:    updateScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleAdminOrGroupAdmin),
+	},
+	{
+		Method:    http.MethodPut,
+		Path:      "/groups/:id/applications/:oid",
+		Scopes:    updateScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleGroupAdmin),
+	},
+	{
+		Method:    http.MethodDelete,
+		Path:      "/groups/:id/applications/:oid",
+		Scopes:    updateScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleGroupAdmin),
+	},
+	{
+		Method:    http.MethodPost,
+		Path:      "/groups/:id/apprequests",
+		Scopes:    []string{oidcScope},
+		GroupRole: authRole(AuthRoleGroupAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/groups/:id/apprequests",
+		Scopes: readScopesWithOpenID("governor:groups"),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/groups/:id/apprequests/:rid",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/groups/:id/apprequests/:rid",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/groups/:id/apprequests/:rid/comments",
+		Scopes:   readScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/groups/:id/apprequests/:rid/comments",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:    http.MethodPut,
+		Path:      "/groups/:id/organizations/:oid",
+		Scopes:    updateScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleGroupAdmin),
+	},
+	{
+		Method:    http.MethodDelete,
+		Path:      "/groups/:id/organizations/:oid",
+		Scopes:    updateScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleGroupAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/groups/:id/hierarchies",
+		Scopes: readScopesWithOpenID("governor:groups"),
+	},
+	{
+		Method:    http.MethodPost,
+		Path:      "/groups/:id/hierarchies",
+		Scopes:    readScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleGroupAdmin),
+	},
+	{
+		Method:    http.MethodPatch,
+		Path:      "/groups/:id/hierarchies/:member_id",
+		Scopes:    readScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleGroupAdmin),
+	},
+	{
+		Method:    http.MethodDelete,
+		Path:      "/groups/:id/hierarchies/:member_id",
+		Scopes:    readScopesWithOpenID("governor:groups"),
+		GroupRole: authRole(AuthRoleGroupAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/changes",
+		Scopes:   readScopesWithOpenID("governor:events"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/events",
+		Scopes:   readScopesWithOpenID("governor:events"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/event-consumers",
+		Scopes:   readScopesWithOpenID("governor:events"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/event-consumers/:name",
+		Scopes:   updateScopesWithOpenID("governor:events"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/event-consumers/:name",
+		Scopes:   deleteScopesWithOpenID("governor:events"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/audit/checkpoints",
+		Scopes:   readScopesWithOpenID("governor:audit"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/audit/verify",
+		Scopes:   createScopesWithOpenID("governor:audit"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/jobs",
+		Scopes:   readScopesWithOpenID("governor:jobs"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/jobs/:id",
+		Scopes:   readScopesWithOpenID("governor:jobs"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/event-subjects",
+		Scopes:   readScopesWithOpenID("governor:eventsubjects"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/event-subjects/:subject",
+		Scopes:   updateScopesWithOpenID("governor:eventsubjects"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/event-subjects/:subject",
+		Scopes:   deleteScopesWithOpenID("governor:eventsubjects"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/extensions/:eid/event-subject",
+		Scopes:   updateScopesWithOpenID("governor:eventsubjects"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/extensions/:eid/event-subject",
+		Scopes:   deleteScopesWithOpenID("governor:eventsubjects"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/user-field-subscriptions",
+		Scopes:   readScopesWithOpenID("governor:eventsubjects"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/user-field-subscriptions/:name",
+		Scopes:   updateScopesWithOpenID("governor:eventsubjects"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/user-field-subscriptions/:name",
+		Scopes:   deleteScopesWithOpenID("governor:eventsubjects"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/deleted/:kind",
+		Scopes:   readScopesWithOpenID("governor:deleted"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/tombstones/:kind",
+		Scopes:   readScopesWithOpenID("governor:deleted"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/deleted/:kind/purge",
+		Scopes:   deleteScopesWithOpenID("governor:deleted"),
+		UserRole: authRole(AuthRoleAdmin),
+		StepUp:   StepUpRouteGroupPurge,
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/backups",
+		Scopes:   readScopesWithOpenID("governor:backups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/backups",
+		Scopes:   createScopesWithOpenID("governor:backups"),
+		UserRole: authRole(AuthRoleAdmin),
+		StepUp:   StepUpRouteGroupBackup,
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/backups/:name/restore",
+		Scopes:   updateScopesWithOpenID("governor:backups"),
+		UserRole: authRole(AuthRoleAdmin),
+		StepUp:   StepUpRouteGroupBackup,
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/meta",
+		Scopes: readScopesWithOpenID("governor:meta"),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/route-policies",
+		Scopes:   readScopesWithOpenID("governor:meta"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/feature-flags",
+		Scopes: readScopesWithOpenID("governor:featureflags"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/feature-flags/:name",
+		Scopes: readScopesWithOpenID("governor:featureflags"),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/feature-flags/:name",
+		Scopes:   updateScopesWithOpenID("governor:featureflags"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/feature-flags/:name",
+		Scopes:   deleteScopesWithOpenID("governor:featureflags"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/network-policies",
+		Scopes:   readScopesWithOpenID("governor:networkpolicies"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/network-policies/:subject",
+		Scopes:   readScopesWithOpenID("governor:networkpolicies"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/network-policies/:subject",
+		Scopes:   updateScopesWithOpenID("governor:networkpolicies"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/network-policies/:subject",
+		Scopes:   deleteScopesWithOpenID("governor:networkpolicies"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/membership-duration-policies",
+		Scopes: readScopesWithOpenID("governor:groups"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/membership-duration-policies/:kind/:id",
+		Scopes: readScopesWithOpenID("governor:groups"),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/membership-duration-policies/:kind/:id",
+		Scopes:   updateScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/membership-duration-policies/:kind/:id",
+		Scopes:   deleteScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/naming-policies",
+		Scopes: readScopesWithOpenID("governor:groups"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/naming-policies/:scope",
+		Scopes: readScopesWithOpenID("governor:groups"),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/naming-policies/:scope",
+		Scopes:   updateScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/naming-policies/:scope",
+		Scopes:   deleteScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/organizations",
+		Scopes: readScopesWithOpenID("governor:organizations"),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/organizations",
+		Scopes:   createScopesWithOpenID("governor:organizations"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/organizations/:id",
+		Scopes: readScopesWithOpenID("governor:organizations"),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/organizations/:id",
+		Scopes:   deleteScopesWithOpenID("governor:organizations"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/organizations/:id/groups",
+		Scopes: readScopesWithOpenID("governor:organizations"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/applications",
+		Scopes: readScopesWithOpenID("governor:applications"),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/applications",
+		Scopes:   createScopesWithOpenID("governor:applications"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/applications/by-slug/:slug",
+		Scopes:   updateScopesWithOpenID("governor:applications"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/applications/access",
+		Scopes: readScopesWithOpenID("governor:applications"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/applications/:id",
+		Scopes: readScopesWithOpenID("governor:applications"),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/applications/:id",
+		Scopes:   updateScopesWithOpenID("governor:applications"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/applications/:id",
+		Scopes:   deleteScopesWithOpenID("governor:applications"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/applications/:id/groups",
+		Scopes: readScopesWithOpenID("governor:applications"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/application-types",
+		Scopes: readScopesWithOpenID("governor:applications"),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/application-types",
+		Scopes:   createScopesWithOpenID("governor:applications"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/application-types/:id",
+		Scopes: readScopesWithOpenID("governor:applications"),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/application-types/:id",
+		Scopes:   updateScopesWithOpenID("governor:applications"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/application-types/:id",
+		Scopes:   deleteScopesWithOpenID("governor:applications"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/application-types/:id/applications",
+		Scopes: readScopesWithOpenID("governor:applications"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/notification-types",
+		Scopes: readScopesWithOpenID("governor:notifications"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/notification-types/:id",
+		Scopes: readScopesWithOpenID("governor:notifications"),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/notification-types",
+		Scopes:   createScopesWithOpenID("governor:notifications"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodPut,
+		Path:   "/notification-types/:id",
+		Scopes: updateScopesWithOpenID("governor:notifications"),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/notification-types/:id",
+		Scopes:   deleteScopesWithOpenID("governor:notifications"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/notification-targets",
+		Scopes: readScopesWithOpenID("governor:notifications"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/notification-targets/:id",
+		Scopes: readScopesWithOpenID("governor:notifications"),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/notification-targets",
+		Scopes:   createScopesWithOpenID("governor:notifications"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodPut,
+		Path:   "/notification-targets/:id",
+		Scopes: updateScopesWithOpenID("governor:notifications"),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/notification-targets/:id",
+		Scopes:   deleteScopesWithOpenID("governor:notifications"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/notifications/broadcast",
+		Scopes:   createScopesWithOpenID("governor:notifications"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	// the notification dispatches are for the machine tokens of the
+	// dispatchers, the user tokens aren't accepted
+	{
+		Method: http.MethodPost,
+		Path:   "/notification-dispatches",
+		Scopes: ginjwt.CreateScopes("governor:notifications"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/notification-dispatches/:id",
+		Scopes: ginjwt.ReadScopes("governor:notifications"),
+	},
+	{
+		Method: http.MethodPost,
+		Path:   "/notification-dispatches/:id/delivered",
+		Scopes: ginjwt.UpdateScopes("governor:notifications"),
+	},
+
+	// extensions
+	{
+		Method: http.MethodGet,
+		Path:   "/extensions",
+		Scopes: readScopesWithOpenID("governor:extensions"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/extensions/snapshot",
+		Scopes: readScopesWithOpenID("governor:extensions"),
+	},
+	{
+		Method: http.MethodPost,
+		Path:   "/extensions/snapshot/diff",
+		Scopes: readScopesWithOpenID("governor:extensions"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/extensions/:eid",
+		Auth:   routeAuthExtensionToken,
+		Scopes: readScopesWithOpenID("governor:extensions"),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/extensions",
+		Scopes:   createScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/extensions/by-slug/:slug",
+		Scopes:   updateScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPatch,
+		Path:     "/extensions/:eid",
+		Scopes:   updateScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/extensions/:eid",
+		Scopes:   deleteScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+		StepUp:   StepUpRouteGroupExtensions,
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/extensions/:eid/usage",
+		Scopes:   readScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/extensions/:eid/events",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   readScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+
+	// extension client credentials
+	{
+		Method:   http.MethodGet,
+		Path:     "/extensions/:eid/credentials",
+		Scopes:   readScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/extensions/:eid/credentials",
+		Scopes:   createScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+		StepUp:   StepUpRouteGroupExtensions,
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/extensions/:eid/credentials/:id/rotate",
+		Scopes:   updateScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+		StepUp:   StepUpRouteGroupExtensions,
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/extensions/:eid/credentials/:id",
+		Scopes:   deleteScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+
+	// github team exports
+	{
+		Method: http.MethodGet,
+		Path:   "/integrations/github/teams",
+		Scopes: readScopesWithOpenID("governor:groups"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/integrations/github/teams/:id",
+		Scopes: readScopesWithOpenID("governor:groups"),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/integrations/github/teams/:id",
+		Scopes:   updateScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/integrations/github/teams/:id",
+		Scopes:   deleteScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/integrations/github/teams/:id/sync",
+		Scopes:   updateScopesWithOpenID("governor:groups"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+
+	// okta event hooks authenticate with a shared secret
+	{
+		Method: http.MethodGet,
+		Path:   "/okta/events",
+		Auth:   routeAuthOktaEventHook,
+	},
+	{
+		Method: http.MethodPost,
+		Path:   "/okta/events",
+		Auth:   routeAuthOktaEventHook,
+	},
+
+	// the extensions authenticate with their client credentials
+	{
+		Method: http.MethodPost,
+		Path:   "/oauth/token",
+		Auth:   routeAuthNone,
+	},
+
+	// extension resource definitions
+	{
+		Method: http.MethodGet,
+		Path:   "/extensions/:eid/erds",
+		Auth:   routeAuthExtensionToken,
+		Scopes: readScopesWithOpenID("governor:extensions"),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/extensions/:eid/erds",
+		Scopes:   createScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/extensions/:eid/erds/validate-schema",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   readScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/extensions/:eid/erds/:erd-id-slug",
+		Auth:   routeAuthExtensionToken,
+		Scopes: readScopesWithOpenID("governor:extensions"),
+	},
+	{
+		Method: http.MethodGet,
+		Path:   "/extensions/:eid/erds/:erd-id-slug/:erd-version",
+		Auth:   routeAuthExtensionToken,
+		Scopes: readScopesWithOpenID("governor:extensions"),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/extensions/:eid/erds/:erd-id-slug/schema-diff",
+		Scopes:   readScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/extensions/:eid/erds/:erd-id-slug/:erd-version/schema-diff",
+		Scopes:   readScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPatch,
+		Path:     "/extensions/:eid/erds/:erd-id-slug",
+		Scopes:   updateScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPatch,
+		Path:     "/extensions/:eid/erds/:erd-id-slug/:erd-version",
+		Scopes:   updateScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/extensions/:eid/erds/:erd-id-slug/state",
+		Scopes:   updateScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/extensions/:eid/erds/:erd-id-slug/:erd-version/state",
+		Scopes:   updateScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPut,
+		Path:     "/extensions/:eid/erds/:erd-id-slug/:erd-version",
+		Scopes:   updateScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/extensions/:eid/erds/:erd-id-slug",
+		Scopes:   deleteScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+		StepUp:   StepUpRouteGroupExtensions,
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/extensions/:eid/erds/:erd-id-slug/:erd-version",
+		Scopes:   deleteScopesWithOpenID("governor:extensions"),
+		UserRole: authRole(AuthRoleAdmin),
+		StepUp:   StepUpRouteGroupExtensions,
+	},
+
+	// system-wise extension resources
+	{
+		Method:   http.MethodPost,
+		Path:     "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   createScopesWithOpenID("governor:extensionresources"),
+		UserRole: authRole(AuthRoleUser),
+		ERDAuth:  erdAuthResourceGroups,
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   createScopesWithOpenID("governor:extensionresources"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodHead,
+		Path:     "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   createScopesWithOpenID("governor:extensionresources"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/count",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   createScopesWithOpenID("governor:extensionresources"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   createScopesWithOpenID("governor:extensionresources"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPatch,
+		Path:     "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   createScopesWithOpenID("governor:extensionresources"),
+		UserRole: authRole(AuthRoleUser),
+		ERDAuth:  erdAuthResourceGroups,
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/versions",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   createScopesWithOpenID("governor:extensionresources"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/versions/:version/rollback",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   createScopesWithOpenID("governor:extensionresources"),
+		UserRole: authRole(AuthRoleUser),
+		ERDAuth:  erdAuthResourceGroups,
+	},
+	{
+		Method:   http.MethodPatch,
+		Path:     "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/owner",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   updateScopesWithOpenID("governor:extensionresources"),
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   createScopesWithOpenID("governor:extensionresources"),
+		UserRole: authRole(AuthRoleUser),
+		ERDAuth:  erdAuthResourceGroups,
+	},
+
+	// user extension resources
+	{
+		Method:   http.MethodPost,
+		Path:     "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   createScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   readScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodHead,
+		Path:     "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   readScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/count",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   readScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodHead,
+		Path:     "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/count",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   readScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPatch,
+		Path:     "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   updateScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPatch,
+		Path:     "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/versions",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   readScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/versions",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/versions/:version/rollback",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   updateScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/versions/:version/rollback",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
+		Auth:     routeAuthExtensionToken,
+		Scopes:   deleteScopesWithOpenID("governor:users"),
+		UserRole: authRole(AuthRoleAdmin),
+	},
+	{
+		Method:   http.MethodDelete,
+		Path:     "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
+		Scopes:   []string{oidcScope},
+		UserRole: authRole(AuthRoleUser),
+	},
+}
+
+// findRoutePolicy returns the policy of a route
+func findRoutePolicy(method, path string) (routePolicy, bool) {
+	for _, p := range routePolicies {
+		if p.Method == method && p.Path == path {
+			return p, true
+		}
+	}
+
+	return routePolicy{}, false
+}
+
+// handle registers a route with its audit middleware and the auth middlewares
+// of its policy before the handlers. Like gin on invalid routes, it panics
+// when the route has no policy.
+func (r *Router) handle(rg *gin.RouterGroup, method, path, auditType string, handlers ...gin.HandlerFunc) {
+	policy, ok := findRoutePolicy(method, path)
+	if !ok {
+		panic("no authorization policy for route " + method + " " + path)
+	}
+
+	chain := append([]gin.HandlerFunc{r.AuditMW.AuditWithType(auditType)}, r.policyMiddlewares(policy)...)
+
+	rg.Handle(method, path, append(chain, handlers...)...)
+}
+
+// policyMiddlewares returns the auth middlewares of a route policy
+func (r *Router) policyMiddlewares(p routePolicy) []gin.HandlerFunc {
+	var mws []gin.HandlerFunc
+
+	switch p.Auth {
+	case routeAuthToken:
+		mws = append(mws, r.AuthMW.AuthRequired(p.Scopes))
+	case routeAuthExtensionToken:
+		mws = append(mws, r.mwExtensionAuthRequired(p.Scopes))
+	case routeAuthOktaEventHook:
+		mws = append(mws, r.mwOktaEventHookAuth)
+	case routeAuthNone:
+	}
+
+	if p.UserRole != nil {
+		mws = append(mws, r.mwUserAuthRequired(*p.UserRole))
+	}
+
+	if p.GroupRole != nil {
+		mws = append(mws, r.mwGroupAuthRequired(*p.GroupRole))
+	}
+
+	if p.ERDAuth == erdAuthResourceGroups {
+		mws = append(mws, r.mwSystemExtensionResourceGroupAuth)
+	}
+
+	if p.StepUp != "" {
+		mws = append(mws, r.mwStepUpRequired(p.StepUp))
+	}
+
+	return mws
+}
+
+// RoutePolicy is the effective authorization policy of a route
+type RoutePolicy struct {
+	Method string `json:"method"`
+	// Path is the path of the route under the api version
+	Path      string   `json:"path"`
+	Auth      string   `json:"auth"`
+	Scopes    []string `json:"scopes,omitempty"`
+	UserRole  string   `json:"user_role,omitempty"`
+	GroupRole string   `json:"group_role,omitempty"`
+	ERDAuth   string   `json:"erd_auth"`
+	StepUp    string   `json:"step_up,omitempty"`
+	// StepUpEnforced tells whether the step-up route group has a policy and the
+	// require-step-up feature flag is on
+	StepUpEnforced bool `json:"step_up_enforced"`
+}
+
+// listRoutePolicies returns the effective authorization policies of the routes
+// for security reviews
+func (r *Router) listRoutePolicies(c *gin.Context) {
+	requireStepUp := r.featureEnabled(c, featureflags.RequireStepUp)
+
+	policies := make([]RoutePolicy, 0, len(routePolicies))
+
+	for _, p := range routePolicies {
+		policy := RoutePolicy{
+			Method:  p.Method,
+			Path:    p.Path,
+			Auth:    p.Auth.String(),
+			Scopes:  p.Scopes,
+			ERDAuth: p.ERDAuth.String(),
+			StepUp:  p.StepUp,
+		}
+
+		if p.UserRole != nil {
+			policy.UserRole = p.UserRole.String()
+		}
+
+		if p.GroupRole != nil {
+			policy.GroupRole = p.GroupRole.String()
+		}
+
+		if p.StepUp != "" {
+			_, ok := r.StepUpPolicies[p.StepUp]
+			policy.StepUpEnforced = ok && requireStepUp
+		}
+
+		policies = append(policies, policy)
+	}
+
+	c.JSON(http.StatusOK, policies)
+}
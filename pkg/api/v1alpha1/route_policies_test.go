@@ -0,0 +1,109 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/auditevent/ginaudit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.hollow.sh/toolbox/ginauth"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/auth"
+)
+
+func TestRoutePolicies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	seen := map[string]bool{}
+
+	for _, p := range routePolicies {
+		key := p.Method + " " + p.Path
+
+		assert.False(t, seen[key], "duplicate policy for %s", key)
+		seen[key] = true
+
+		switch p.Auth {
+		case routeAuthToken, routeAuthExtensionToken:
+			assert.NotEmpty(t, p.Scopes, "no scopes for %s", key)
+		default:
+			assert.Empty(t, p.Scopes, "scopes aren't checked for %s", key)
+		}
+	}
+
+	r := &Router{
+		AuthMW:  &ginauth.MultiTokenMiddleware{},
+		AuditMW: ginaudit.NewJSONMiddleware("governor-api", io.Discard),
+		Logger:  zap.NewNop(),
+	}
+
+	engine := gin.New()
+	r.Routes(engine.Group("/api/v1alpha1"))
+
+	// every policy belongs to a registered route
+	registered := map[string]bool{}
+	for _, route := range engine.Routes() {
+		registered[route.Method+" "+strings.TrimPrefix(route.Path, "/api/v1alpha1")] = true
+	}
+
+	for key := range seen {
+		assert.True(t, registered[key], "policy for unregistered route %s", key)
+	}
+
+	assert.Len(t, registered, len(routePolicies))
+
+	assert.Panics(t, func() {
+		r.handle(engine.Group("/api/v1alpha1"), http.MethodGet, "/unknown", "Unknown", func(*gin.Context) {})
+	})
+}
+
+func TestListRoutePolicies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := &Router{
+		StepUpPolicies: map[string]auth.StepUpPolicy{
+			StepUpRouteGroupGroups: {AMR: []string{"mfa"}},
+		},
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1alpha1/route-policies", nil)
+
+	r.listRoutePolicies(c)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var policies []RoutePolicy
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &policies))
+	require.Len(t, policies, len(routePolicies))
+
+	byRoute := map[string]RoutePolicy{}
+	for _, p := range policies {
+		byRoute[p.Method+" "+p.Path] = p
+	}
+
+	deleteGroup := byRoute["DELETE /groups/:id"]
+	assert.Equal(t, "token", deleteGroup.Auth)
+	assert.Equal(t, AuthRoleAdminOrGroupAdmin.String(), deleteGroup.GroupRole)
+	assert.Equal(t, StepUpRouteGroupGroups, deleteGroup.StepUp)
+	assert.True(t, deleteGroup.StepUpEnforced)
+
+	deleteUser := byRoute["DELETE /users/:id"]
+	assert.Equal(t, StepUpRouteGroupUsers, deleteUser.StepUp)
+	assert.False(t, deleteUser.StepUpEnforced, "the users route group has no step-up policy")
+
+	updateResource := byRoute["PATCH /extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id"]
+	assert.Equal(t, "extension_token", updateResource.Auth)
+	assert.Equal(t, "resource_groups", updateResource.ERDAuth)
+
+	assert.Equal(t, "none", byRoute["POST /oauth/token"].Auth)
+	assert.Equal(t, "okta_event_hook", byRoute["POST /okta/events"].Auth)
+}
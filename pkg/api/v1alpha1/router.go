@@ -2,6 +2,8 @@ package v1alpha1
 
 import (
 	"io"
+	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jmoiron/sqlx"
@@ -11,7 +13,21 @@ import (
 	"go.hollow.sh/toolbox/ginauth"
 	"go.hollow.sh/toolbox/ginjwt"
 
-	"github.com/metal-toolbox/governor-api/internal/eventbus"
+	"github.com/metal-toolbox/governor-api/internal/auth"
+	"github.com/metal-toolbox/governor-api/internal/avatar"
+	"github.com/metal-toolbox/governor-api/internal/backup"
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/emailverify"
+	"github.com/metal-toolbox/governor-api/internal/eventrules"
+	"github.com/metal-toolbox/governor-api/internal/featureflags"
+	"github.com/metal-toolbox/governor-api/internal/jobs"
+	"github.com/metal-toolbox/governor-api/internal/netpolicy"
+	"github.com/metal-toolbox/governor-api/internal/outbox"
+	"github.com/metal-toolbox/governor-api/internal/respcache"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
 )
 
 const (
@@ -21,790 +37,1059 @@ const (
 
 // Router is the API router
 type Router struct {
-	AdminGroups    []string
+	AdminGroups []string
+	// APIVersions are the api versions served alongside this one
+	APIVersions    []string
 	AuditLogWriter io.Writer
 	AuditMW        *ginaudit.Middleware
 	AuthMW         *ginauth.MultiTokenMiddleware
 	AuthConf       []ginjwt.AuthConfig
+	Avatars        *avatar.Proxy
+	Cache          *respcache.Cache
 	DB             *sqlx.DB
-	EventBus       *eventbus.Client
-	Logger         *zap.Logger
+	EventBus       eventbus.EventBus
+	EventRules     *eventrules.Cache
+	// EventOutbox stages the events of the handlers' changes in their
+	// transactions, the events are only published once the changes are
+	// committed when it is nil
+	EventOutbox *outbox.Outbox
+	// EventSubjectPrefixes are the prefixes of the subjects the events are
+	// published on
+	EventSubjectPrefixes EventSubjectPrefixes
+	// Backups stores the backups of the governor entities, the backup
+	// endpoints are disabled when it is nil
+	Backups backup.Store
+	// BreakGlassRespondersGroup is the group whose direct and indirect members
+	// can grant themselves break-glass access, it is disabled when it is empty
+	BreakGlassRespondersGroup string
+	// BreakGlassSecurityGroup is the group whose members are notified of the
+	// break-glass accesses, nobody is notified when it is empty
+	BreakGlassSecurityGroup string
+	// BreakGlassNotificationType is the notification type of the break-glass
+	// notifications
+	BreakGlassNotificationType string
+	// EmailVerifier signs the tokens confirming the email changes, the email
+	// changes are applied right away when it is nil
+	EmailVerifier *emailverify.Signer
+	// AdminPromotionRequestCooldown is how long a user waits before requesting
+	// an admin promotion again after a denial, it is disabled when it is 0
+	AdminPromotionRequestCooldown time.Duration
+	// ExtensionCredentialMaxAge is how long the extension client credentials
+	// are valid after they are issued or rotated, they don't expire when it is 0
+	ExtensionCredentialMaxAge time.Duration
+	// ExtensionCredentialRotationGrace is how long the previous secret of
+	// rotated client credentials keeps working
+	ExtensionCredentialRotationGrace time.Duration
+	// ExtensionTokenTTL is how long the tokens issued to the extensions are valid
+	ExtensionTokenTTL time.Duration
+	FeatureFlags      *featureflags.Cache
+	Jobs              *jobs.Pool
+	Logger            *zap.Logger
+	NetworkPolicies   *netpolicy.Cache
+	// NotificationBroadcastCooldown is how long an admin waits between two
+	// notification broadcasts, broadcasts aren't throttled when it is 0
+	NotificationBroadcastCooldown time.Duration
+	// OktaEventHookSecret is the shared secret of the Okta event hooks, they
+	// are disabled when it is empty
+	OktaEventHookSecret string
+	// PurgeRetention is how long soft deleted records are kept before they
+	// can be purged
+	PurgeRetention time.Duration
+	// SessionTokenTTL is how long the session tokens issued to the UI are valid
+	SessionTokenTTL time.Duration
+	Service         *service.Service
+	StepUpPolicies  map[string]auth.StepUpPolicy
+	Tenancy         *tenancy.Resolver
+	// TombstoneRetention is how long the tombstones of the removed
+	// relationships are kept, they are kept forever when it is zero
+	TombstoneRetention time.Duration
+	// UserMatcher matches inbound user records to the existing users, the
+	// default matcher is used when it is nil
+	UserMatcher *dbtools.UserMatcher
 }
 
-// Routes sets up protected routes and sets the scopes for said routes
+// Routes sets up protected routes, their scopes and auth middlewares come
+// from routePolicies
 func (r *Router) Routes(rg *gin.RouterGroup) {
 	rg.Use(r.mwContextInjectCorrelationID)
+	rg.Use(r.mwExtensionCredentialAuth(rg.BasePath()))
+	rg.Use(r.mwNetworkPolicy)
+	rg.Use(r.mwReadOnly(rg.BasePath()))
+	rg.Use(r.mwTenancy)
 
-	rg.GET(
-		"/user",
-		r.AuditMW.AuditWithType("GetUser"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodGet, "/user", "GetUser",
 		r.getAuthenticatedUser,
 	)
 
-	rg.PUT(
-		"/user",
-		r.AuditMW.AuditWithType("UpdateUser"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodPut, "/user", "UpdateUser",
 		r.updateAuthenticatedUser,
 	)
 
-	rg.GET(
-		"/user/groups",
-		r.AuditMW.AuditWithType("GetUserGroups"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodGet, "/user/groups", "GetUserGroups",
 		r.getAuthenticatedUserGroups,
 	)
 
-	rg.DELETE(
-		"/user/groups/:id",
-		r.AuditMW.AuditWithType("RemoveUserGroup"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodDelete, "/user/groups/:id", "RemoveUserGroup",
 		r.removeAuthenticatedUserGroup,
 	)
 
-	rg.GET(
-		"/user/groups/requests",
-		r.AuditMW.AuditWithType("GetUserGroupRequests"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodGet, "/user/groups/requests", "GetUserGroupRequests",
 		r.getAuthenticatedUserGroupRequests,
 	)
 
-	rg.GET(
-		"/user/groups/approvals",
-		r.AuditMW.AuditWithType("GetUserGroupApprovals"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodPost, "/user/session-tokens", "CreateSessionToken",
+		r.createSessionToken,
+	)
+
+	r.handle(rg, http.MethodDelete, "/user/session-tokens", "RevokeSessionTokens",
+		r.revokeSessionTokens,
+	)
+
+	r.handle(rg, http.MethodGet, "/user/requests", "GetUserRequests",
+		r.getAuthenticatedUserRequests,
+	)
+
+	r.handle(rg, http.MethodGet, "/user/groups/approvals", "GetUserGroupApprovals",
 		r.getAuthenticatedUserGroupApprovals,
 	)
 
-	rg.GET(
-		"/user/notification-preferences",
-		r.AuditMW.AuditWithType("GetUserNotificationPreferences"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodGet, "/user/approvals", "GetUserApprovals",
+		r.getAuthenticatedUserApprovals,
+	)
+
+	r.handle(rg, http.MethodPost, "/requests/process", "ProcessRequests",
+		r.processRequests,
+	)
+
+	r.handle(rg, http.MethodGet, "/user/notification-preferences", "GetUserNotificationPreferences",
 		r.getAuthenticatedUserNotificationPreferences,
 	)
 
-	rg.PUT(
-		"/user/notification-preferences",
-		r.AuditMW.AuditWithType("UpdateUserNotificationPreferences"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodPut, "/user/notification-preferences", "UpdateUserNotificationPreferences",
 		r.updateAuthenticatedUserNotificationPreferences,
 	)
 
-	rg.GET(
-		"/users",
-		r.AuditMW.AuditWithType("ListUsers"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:users")),
+	r.handle(rg, http.MethodGet, "/user/notification-preferences/groups", "GetUserNotificationGroupPreferences",
+		r.getAuthenticatedUserNotificationGroupPreferences,
+	)
+
+	r.handle(rg, http.MethodPut, "/user/notification-preferences/groups", "UpdateUserNotificationGroupPreferences",
+		r.updateAuthenticatedUserNotificationGroupPreferences,
+	)
+
+	r.handle(rg, http.MethodGet, "/user/notification-preferences/failover", "GetUserNotificationFailover",
+		r.getAuthenticatedUserNotificationFailover,
+	)
+
+	r.handle(rg, http.MethodPut, "/user/notification-preferences/failover", "UpdateUserNotificationFailover",
+		r.updateAuthenticatedUserNotificationFailover,
+	)
+
+	r.handle(rg, http.MethodPost, "/user/email-verification", "ConfirmUserEmailChange",
+		r.confirmEmailChange,
+	)
+
+	r.handle(rg, http.MethodPost, "/authz/check", "CheckAuthz",
+		r.checkAuthz,
+	)
+
+	r.handle(rg, http.MethodGet, "/users", "ListUsers",
 		r.listUsers,
 	)
 
-	rg.POST(
-		"/users",
-		r.AuditMW.AuditWithType("CreateUser"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:users")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPost, "/users", "CreateUser",
 		r.createUser,
 	)
 
-	rg.GET(
-		"/users/:id",
-		r.AuditMW.AuditWithType("GetUser"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:users")),
+	r.handle(rg, http.MethodPut, "/users/by-identity", "UpsertUser",
+		r.upsertUser,
+	)
+
+	r.handle(rg, http.MethodPost, "/users/import", "ImportUsers",
+		r.importUsers,
+	)
+
+	r.handle(rg, http.MethodGet, "/users/duplicates", "ListDuplicateUsers",
+		r.listDuplicateUsers,
+	)
+
+	r.handle(rg, http.MethodGet, "/users/:id", "GetUser",
+		r.mwResponseCache(
+			events.GovernorUsersEventSubject,
+			events.GovernorGroupsEventSubject,
+			events.GovernorMembersEventSubject,
+			events.GovernorMemberRequestsEventSubject,
+			events.GovernorHierarchiesEventSubject,
+		),
 		r.getUser,
 	)
 
-	rg.PUT(
-		"/users/:id",
-		r.AuditMW.AuditWithType("UpdateUser"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:users")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodGet, "/users/:id/avatar", "GetUserAvatar",
+		r.getUserAvatar,
+	)
+
+	r.handle(rg, http.MethodGet, "/users/:id/notification-delivery", "GetUserNotificationDelivery",
+		r.getUserNotificationDelivery,
+	)
+
+	r.handle(rg, http.MethodGet, "/users/:id/events", "GetUserEvents",
+		r.listUserEvents,
+	)
+
+	r.handle(rg, http.MethodGet, "/users/:id/archived-requests", "GetUserArchivedRequests",
+		r.listUserArchivedRequests,
+	)
+
+	r.handle(rg, http.MethodGet, "/users/:id/export", "ExportUser",
+		r.exportUser,
+	)
+
+	r.handle(rg, http.MethodGet, "/users/:id/access", "GetUserAccess",
+		r.mwResponseCache(
+			events.GovernorUsersEventSubject,
+			events.GovernorGroupsEventSubject,
+			events.GovernorMembersEventSubject,
+			events.GovernorHierarchiesEventSubject,
+			events.GovernorApplicationsEventSubject,
+			events.GovernorApplicationLinksEventSubject,
+		),
+		r.getUserAccess,
+	)
+
+	r.handle(rg, http.MethodPut, "/users/:id", "UpdateUser",
 		r.updateUser,
 	)
 
-	rg.DELETE(
-		"/users/:id",
-		r.AuditMW.AuditWithType("DeleteUser"),
-		r.AuthMW.AuthRequired(deleteScopesWithOpenID("governor:users")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPost, "/users/:id/merge/:other", "MergeUsers",
+		r.mergeUsers,
+	)
+
+	r.handle(rg, http.MethodPost, "/users/:id/anonymize", "AnonymizeUser",
+		r.anonymizeUser,
+	)
+
+	r.handle(rg, http.MethodDelete, "/users/:id/groups", "RemoveUserGroups",
+		r.removeUserGroups,
+	)
+
+	r.handle(rg, http.MethodDelete, "/users/:id", "DeleteUser",
 		r.deleteUser,
 	)
 
-	rg.GET(
-		"/groups",
-		r.AuditMW.AuditWithType("ListGroups"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
+	r.handle(rg, http.MethodGet, "/groups", "ListGroups",
 		r.listGroups,
 	)
 
-	rg.POST(
-		"/groups",
-		r.AuditMW.AuditWithType("CreateGroup"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:groups")),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodPost, "/groups", "CreateGroup",
 		r.createGroup,
 	)
 
-	rg.GET(
-		"/groups/requests",
-		r.AuditMW.AuditWithType("GetGroupRequestsAll"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
+	r.handle(rg, http.MethodPut, "/groups/by-slug/:slug", "UpsertGroup",
+		r.upsertGroup,
+	)
+
+	r.handle(rg, http.MethodGet, "/groups/requests", "GetGroupRequestsAll",
 		r.getGroupRequestsAll,
 	)
 
-	rg.GET(
-		"/groups/memberships",
-		r.AuditMW.AuditWithType("GetGroupMembersipsAll"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
+	r.handle(rg, http.MethodGet, "/groups/memberships", "GetGroupMembersipsAll",
 		r.getGroupMembershipsAll,
 	)
 
-	rg.GET(
-		"/groups/hierarchies",
-		r.AuditMW.AuditWithType("GetGroupHierarchiesAll"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
+	r.handle(rg, http.MethodGet, "/groups/membership-exemptions", "ListMembershipExemptions",
+		r.listMembershipExemptions,
+	)
+
+	r.handle(rg, http.MethodGet, "/groups/hierarchies", "GetGroupHierarchiesAll",
 		r.getGroupHierarchiesAll,
 	)
 
-	rg.GET(
-		"/groups/:id",
-		r.AuditMW.AuditWithType("GetGroup"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
+	r.handle(rg, http.MethodGet, "/groups/:id", "GetGroup",
+		r.mwResponseCache(
+			events.GovernorUsersEventSubject,
+			events.GovernorGroupsEventSubject,
+			events.GovernorMembersEventSubject,
+			events.GovernorMemberRequestsEventSubject,
+			events.GovernorHierarchiesEventSubject,
+			events.GovernorApplicationLinksEventSubject,
+		),
 		r.getGroup,
 	)
 
-	rg.PUT(
-		"/groups/:id",
-		r.AuditMW.AuditWithType("UpdateGroup"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:groups")),
-		r.mwGroupAuthRequired(AuthRoleGroupAdmin),
+	r.handle(rg, http.MethodPut, "/groups/:id", "UpdateGroup",
 		r.updateGroup,
 	)
 
-	rg.DELETE(
-		"/groups/:id",
-		r.AuditMW.AuditWithType("DeleteGroup"),
-		r.AuthMW.AuthRequired(deleteScopesWithOpenID("governor:groups")),
-		r.mwGroupAuthRequired(AuthRoleAdminOrGroupAdmin),
+	r.handle(rg, http.MethodDelete, "/groups/:id", "DeleteGroup",
 		r.deleteGroup,
 	)
 
-	rg.GET(
-		"/groups/:id/events",
-		r.AuditMW.AuditWithType("GetGroupEvents"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
-		r.mwGroupAuthRequired(AuthRoleGroupMember),
+	r.handle(rg, http.MethodGet, "/groups/:id/deletion-impact", "GetGroupDeletionImpact",
+		r.getGroupDeletionImpact,
+	)
+
+	r.handle(rg, http.MethodPost, "/groups/:id/freeze", "FreezeGroup",
+		r.freezeGroup,
+	)
+
+	r.handle(rg, http.MethodDelete, "/groups/:id/freeze", "UnfreezeGroup",
+		r.unfreezeGroup,
+	)
+
+	r.handle(rg, http.MethodPost, "/groups/:id/protection", "ProtectGroup",
+		r.protectGroup,
+	)
+
+	r.handle(rg, http.MethodDelete, "/groups/:id/protection", "UnprotectGroup",
+		r.unprotectGroup,
+	)
+
+	r.handle(rg, http.MethodPost, "/groups/:id/break-glass", "EnableGroupBreakGlass",
+		r.enableGroupBreakGlass,
+	)
+
+	r.handle(rg, http.MethodDelete, "/groups/:id/break-glass", "DisableGroupBreakGlass",
+		r.disableGroupBreakGlass,
+	)
+
+	r.handle(rg, http.MethodPost, "/groups/:id/break-glass/access", "BreakGlassAccess",
+		r.breakGlassAccess,
+	)
+
+	r.handle(rg, http.MethodGet, "/groups/:id/events", "GetGroupEvents",
 		r.listGroupEvents,
 	)
 
-	rg.POST(
-		"/groups/:id/requests",
-		r.AuditMW.AuditWithType("CreateGroupRequest"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodGet, "/groups/:id/archived-requests", "GetGroupArchivedRequests",
+		r.listGroupArchivedRequests,
+	)
+
+	r.handle(rg, http.MethodPost, "/groups/:id/requests", "CreateGroupRequest",
 		r.createGroupRequest,
 	)
 
-	rg.GET(
-		"/groups/:id/requests",
-		r.AuditMW.AuditWithType("GetGroupRequests"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
+	r.handle(rg, http.MethodGet, "/groups/:id/requests", "GetGroupRequests",
 		r.getGroupRequests,
 	)
 
-	rg.PUT(
-		"/groups/:id/requests/:rid",
-		r.AuditMW.AuditWithType("ProcessGroupRequest"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwGroupAuthRequired(AuthRoleAdminOrGroupAdminOrGroupApprover),
+	r.handle(rg, http.MethodPut, "/groups/:id/requests/:rid", "ProcessGroupRequest",
 		r.processGroupRequest,
 	)
 
-	rg.DELETE(
-		"/groups/:id/requests/:rid",
-		r.AuditMW.AuditWithType("DeleteGroupRequest"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:groups")),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodDelete, "/groups/:id/requests/:rid", "DeleteGroupRequest",
 		r.deleteGroupRequest,
 	)
 
-	rg.GET(
-		"/groups/:id/users",
-		r.AuditMW.AuditWithType("GetGroupMembers"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
+	r.handle(rg, http.MethodGet, "/groups/:id/requests/:rid/comments", "ListGroupRequestComments",
+		r.listGroupRequestComments,
+	)
+
+	r.handle(rg, http.MethodPost, "/groups/:id/requests/:rid/comments", "CreateGroupRequestComment",
+		r.createGroupRequestComment,
+	)
+
+	r.handle(rg, http.MethodGet, "/groups/:id/users", "GetGroupMembers",
+		r.mwMembershipNotes,
+		r.mwResponseCache(
+			events.GovernorUsersEventSubject,
+			events.GovernorGroupsEventSubject,
+			events.GovernorMembersEventSubject,
+			events.GovernorHierarchiesEventSubject,
+		),
 		r.listGroupMembers,
 	)
 
-	rg.PUT(
-		"/groups/:id/users/:uid",
-		r.AuditMW.AuditWithType("AddGroupMember"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:groups")),
-		r.mwGroupAuthRequired(AuthRoleGroupAdmin),
+	r.handle(rg, http.MethodPut, "/groups/:id/users/:uid", "AddGroupMember",
 		r.addGroupMember,
 	)
 
-	rg.PATCH(
-		"/groups/:id/users/:uid",
-		r.AuditMW.AuditWithType("UpdateGroupMember"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:groups")),
-		r.mwGroupAuthRequired(AuthRoleAdminOrGroupAdmin),
+	r.handle(rg, http.MethodPatch, "/groups/:id/users/:uid", "UpdateGroupMember",
 		r.updateGroupMember,
 	)
 
-	rg.DELETE(
-		"/groups/:id/users/:uid",
-		r.AuditMW.AuditWithType("RemoveGroupMember"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:groups")),
-		r.mwGroupAuthRequired(AuthRoleGroupAdmin),
+	r.handle(rg, http.MethodDelete, "/groups/:id/users/:uid", "RemoveGroupMember",
 		r.removeGroupMember,
 	)
 
-	rg.PUT(
-		"/groups/:id/applications/:oid",
-		r.AuditMW.AuditWithType("AddGroupApplication"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:groups")),
-		r.mwGroupAuthRequired(AuthRoleGroupAdmin),
+	r.handle(rg, http.MethodPut, "/groups/:id/users/:uid/exemption", "ExemptGroupMember",
+		r.exemptGroupMember,
+	)
+
+	r.handle(rg, http.MethodDelete, "/groups/:id/users/:uid/exemption", "RemoveGroupMemberExemption",
+		r.removeGroupMemberExemption,
+	)
+
+	r.handle(rg, http.MethodPost, "/groups/:id/users/:uid/scheduled-changes", "ScheduleMembershipChange",
+		r.scheduleMembershipChange,
+	)
+
+	r.handle(rg, http.MethodGet, "/groups/:id/scheduled-changes", "ListScheduledMembershipChanges",
+		r.listScheduledMembershipChanges,
+	)
+
+	r.handle(rg, http.MethodDelete, "/groups/:id/scheduled-changes/:cid", "CancelScheduledMembershipChange",
+		r.cancelScheduledMembershipChange,
+	)
+
+	r.handle(rg, http.MethodPut, "/groups/:id/applications/:oid", "AddGroupApplication",
 		r.addGroupApplication,
 	)
 
-	rg.DELETE(
-		"/groups/:id/applications/:oid",
-		r.AuditMW.AuditWithType("RemoveGroupApplication"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:groups")),
-		r.mwGroupAuthRequired(AuthRoleGroupAdmin),
+	r.handle(rg, http.MethodDelete, "/groups/:id/applications/:oid", "RemoveGroupApplication",
 		r.removeGroupApplication,
 	)
 
-	rg.POST(
-		"/groups/:id/apprequests",
-		r.AuditMW.AuditWithType("CreateGroupAppRequest"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwGroupAuthRequired(AuthRoleGroupAdmin),
+	r.handle(rg, http.MethodPost, "/groups/:id/apprequests", "CreateGroupAppRequest",
 		r.createGroupAppRequest,
 	)
 
-	rg.GET(
-		"/groups/:id/apprequests",
-		r.AuditMW.AuditWithType("GetGroupAppRequests"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
+	r.handle(rg, http.MethodGet, "/groups/:id/apprequests", "GetGroupAppRequests",
 		r.getGroupAppRequests,
 	)
 
-	rg.PUT(
-		"/groups/:id/apprequests/:rid",
-		r.AuditMW.AuditWithType("ProcessGroupAppRequest"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodPut, "/groups/:id/apprequests/:rid", "ProcessGroupAppRequest",
 		r.processGroupAppRequest,
 	)
 
-	rg.DELETE(
-		"/groups/:id/apprequests/:rid",
-		r.AuditMW.AuditWithType("DeleteGroupAppRequest"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodDelete, "/groups/:id/apprequests/:rid", "DeleteGroupAppRequest",
 		r.deleteGroupAppRequest,
 	)
 
-	rg.PUT(
-		"/groups/:id/organizations/:oid",
-		r.AuditMW.AuditWithType("AddGroupOrganization"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:groups")),
-		r.mwGroupAuthRequired(AuthRoleGroupAdmin),
+	r.handle(rg, http.MethodGet, "/groups/:id/apprequests/:rid/comments", "ListGroupAppRequestComments",
+		r.listGroupAppRequestComments,
+	)
+
+	r.handle(rg, http.MethodPost, "/groups/:id/apprequests/:rid/comments", "CreateGroupAppRequestComment",
+		r.createGroupAppRequestComment,
+	)
+
+	r.handle(rg, http.MethodPut, "/groups/:id/organizations/:oid", "AddGroupOrganization",
 		r.addGroupOrganization,
 	)
 
-	rg.DELETE(
-		"/groups/:id/organizations/:oid",
-		r.AuditMW.AuditWithType("RemoveGroupOrganization"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:groups")),
-		r.mwGroupAuthRequired(AuthRoleGroupAdmin),
+	r.handle(rg, http.MethodDelete, "/groups/:id/organizations/:oid", "RemoveGroupOrganization",
 		r.removeGroupOrganization,
 	)
 
-	rg.GET(
-		"/groups/:id/hierarchies",
-		r.AuditMW.AuditWithType("GetGroupHierarchies"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
+	r.handle(rg, http.MethodGet, "/groups/:id/hierarchies", "GetGroupHierarchies",
 		r.listMemberGroups,
 	)
 
-	rg.POST(
-		"/groups/:id/hierarchies",
-		r.AuditMW.AuditWithType("CreateGroupHierarchy"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
-		r.mwGroupAuthRequired(AuthRoleGroupAdmin),
+	r.handle(rg, http.MethodPost, "/groups/:id/hierarchies", "CreateGroupHierarchy",
 		r.addMemberGroup,
 	)
 
-	rg.PATCH(
-		"/groups/:id/hierarchies/:member_id",
-		r.AuditMW.AuditWithType("UpdateGroupHierarchy"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
-		r.mwGroupAuthRequired(AuthRoleGroupAdmin),
+	r.handle(rg, http.MethodPatch, "/groups/:id/hierarchies/:member_id", "UpdateGroupHierarchy",
 		r.updateMemberGroup,
 	)
 
-	rg.DELETE(
-		"/groups/:id/hierarchies/:member_id",
-		r.AuditMW.AuditWithType("DeleteGroupHierarchy"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
-		r.mwGroupAuthRequired(AuthRoleGroupAdmin),
+	r.handle(rg, http.MethodDelete, "/groups/:id/hierarchies/:member_id", "DeleteGroupHierarchy",
 		r.removeMemberGroup,
 	)
 
-	rg.GET(
-		"/events",
-		r.AuditMW.AuditWithType("ListEvents"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:events")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodGet, "/changes", "ListChanges",
+		r.listChanges,
+	)
+
+	r.handle(rg, http.MethodGet, "/events", "ListEvents",
 		r.listEvents,
 	)
 
-	rg.GET(
-		"/organizations",
-		r.AuditMW.AuditWithType("ListOrganizations"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:organizations")),
+	r.handle(rg, http.MethodGet, "/event-consumers", "ListEventConsumers",
+		r.listEventConsumers,
+	)
+
+	r.handle(rg, http.MethodPut, "/event-consumers/:name", "ReportEventConsumerPosition",
+		r.reportEventConsumerPosition,
+	)
+
+	r.handle(rg, http.MethodDelete, "/event-consumers/:name", "DeleteEventConsumer",
+		r.deleteEventConsumer,
+	)
+
+	r.handle(rg, http.MethodGet, "/audit/checkpoints", "ListAuditCheckpoints",
+		r.listAuditCheckpoints,
+	)
+
+	r.handle(rg, http.MethodPost, "/audit/verify", "VerifyAuditLog",
+		r.verifyAuditLog,
+	)
+
+	r.handle(rg, http.MethodGet, "/jobs", "ListJobs",
+		r.listJobs,
+	)
+
+	r.handle(rg, http.MethodGet, "/jobs/:id", "GetJob",
+		r.getJob,
+	)
+
+	r.handle(rg, http.MethodGet, "/event-subjects", "ListEventSubjects",
+		r.listEventSubjects,
+	)
+
+	r.handle(rg, http.MethodPut, "/event-subjects/:subject", "UpdateEventSubject",
+		r.updateEventSubject,
+	)
+
+	r.handle(rg, http.MethodDelete, "/event-subjects/:subject", "DeleteEventSubject",
+		r.deleteEventSubject,
+	)
+
+	r.handle(rg, http.MethodPut, "/extensions/:eid/event-subject", "UpdateExtensionEventSubject",
+		r.updateExtensionEventSubject,
+	)
+
+	r.handle(rg, http.MethodDelete, "/extensions/:eid/event-subject", "DeleteExtensionEventSubject",
+		r.deleteExtensionEventSubject,
+	)
+
+	r.handle(rg, http.MethodGet, "/user-field-subscriptions", "ListUserFieldSubscriptions",
+		r.listUserFieldSubscriptions,
+	)
+
+	r.handle(rg, http.MethodPut, "/user-field-subscriptions/:name", "UpdateUserFieldSubscription",
+		r.updateUserFieldSubscription,
+	)
+
+	r.handle(rg, http.MethodDelete, "/user-field-subscriptions/:name", "DeleteUserFieldSubscription",
+		r.deleteUserFieldSubscription,
+	)
+
+	r.handle(rg, http.MethodGet, "/deleted/:kind", "ListDeletedRecords",
+		r.listDeletedRecords,
+	)
+
+	r.handle(rg, http.MethodGet, "/tombstones/:kind", "ListRelationshipTombstones",
+		r.listRelationshipTombstones,
+	)
+
+	r.handle(rg, http.MethodPost, "/deleted/:kind/purge", "PurgeDeletedRecords",
+		r.purgeDeletedRecords,
+	)
+
+	r.handle(rg, http.MethodGet, "/backups", "ListBackups",
+		r.listBackups,
+	)
+
+	r.handle(rg, http.MethodPost, "/backups", "CreateBackup",
+		r.createBackup,
+	)
+
+	r.handle(rg, http.MethodPost, "/backups/:name/restore", "RestoreBackup",
+		r.restoreBackup,
+	)
+
+	r.handle(rg, http.MethodGet, "/meta", "GetMeta",
+		r.getMeta,
+	)
+
+	r.handle(rg, http.MethodGet, "/route-policies", "ListRoutePolicies",
+		r.listRoutePolicies,
+	)
+
+	r.handle(rg, http.MethodGet, "/feature-flags", "ListFeatureFlags",
+		r.listFeatureFlags,
+	)
+
+	r.handle(rg, http.MethodGet, "/feature-flags/:name", "GetFeatureFlag",
+		r.getFeatureFlag,
+	)
+
+	r.handle(rg, http.MethodPut, "/feature-flags/:name", "UpdateFeatureFlag",
+		r.updateFeatureFlag,
+	)
+
+	r.handle(rg, http.MethodDelete, "/feature-flags/:name", "DeleteFeatureFlag",
+		r.deleteFeatureFlag,
+	)
+
+	r.handle(rg, http.MethodGet, "/network-policies", "ListNetworkPolicies",
+		r.listNetworkPolicies,
+	)
+
+	r.handle(rg, http.MethodGet, "/network-policies/:subject", "GetNetworkPolicy",
+		r.getNetworkPolicy,
+	)
+
+	r.handle(rg, http.MethodPut, "/network-policies/:subject", "UpdateNetworkPolicy",
+		r.updateNetworkPolicy,
+	)
+
+	r.handle(rg, http.MethodDelete, "/network-policies/:subject", "DeleteNetworkPolicy",
+		r.deleteNetworkPolicy,
+	)
+
+	r.handle(rg, http.MethodGet, "/membership-duration-policies", "ListDurationPolicies",
+		r.listDurationPolicies,
+	)
+
+	r.handle(rg, http.MethodGet, "/membership-duration-policies/:kind/:id", "GetDurationPolicy",
+		r.getDurationPolicy,
+	)
+
+	r.handle(rg, http.MethodPut, "/membership-duration-policies/:kind/:id", "UpdateDurationPolicy",
+		r.updateDurationPolicy,
+	)
+
+	r.handle(rg, http.MethodDelete, "/membership-duration-policies/:kind/:id", "DeleteDurationPolicy",
+		r.deleteDurationPolicy,
+	)
+
+	r.handle(rg, http.MethodGet, "/naming-policies", "ListNamingPolicies",
+		r.listNamingPolicies,
+	)
+
+	r.handle(rg, http.MethodGet, "/naming-policies/:scope", "GetNamingPolicy",
+		r.getNamingPolicy,
+	)
+
+	r.handle(rg, http.MethodPut, "/naming-policies/:scope", "UpdateNamingPolicy",
+		r.updateNamingPolicy,
+	)
+
+	r.handle(rg, http.MethodDelete, "/naming-policies/:scope", "DeleteNamingPolicy",
+		r.deleteNamingPolicy,
+	)
+
+	r.handle(rg, http.MethodGet, "/organizations", "ListOrganizations",
 		r.listOrganizations,
 	)
 
-	rg.POST(
-		"/organizations",
-		r.AuditMW.AuditWithType("CreateOrganization"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:organizations")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPost, "/organizations", "CreateOrganization",
 		r.createOrganization,
 	)
 
-	rg.GET(
-		"/organizations/:id",
-		r.AuditMW.AuditWithType("GetOrganizations"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:organizations")),
+	r.handle(rg, http.MethodGet, "/organizations/:id", "GetOrganizations",
 		r.getOrganization,
 	)
 
-	rg.DELETE(
-		"/organizations/:id",
-		r.AuditMW.AuditWithType("DeleteOrganization"),
-		r.AuthMW.AuthRequired(deleteScopesWithOpenID("governor:organizations")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodDelete, "/organizations/:id", "DeleteOrganization",
 		r.deleteOrganization,
 	)
 
-	rg.GET(
-		"/organizations/:id/groups",
-		r.AuditMW.AuditWithType("GetOrganizationGroups"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:organizations")),
+	r.handle(rg, http.MethodGet, "/organizations/:id/groups", "GetOrganizationGroups",
 		r.listOrganizationGroups,
 	)
 
-	rg.GET(
-		"/applications",
-		r.AuditMW.AuditWithType("ListApplciations"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:applications")),
+	r.handle(rg, http.MethodGet, "/applications", "ListApplciations",
 		r.listApplications,
 	)
 
-	rg.POST(
-		"/applications",
-		r.AuditMW.AuditWithType("CreateApplications"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:applications")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPost, "/applications", "CreateApplications",
 		r.createApplication,
 	)
 
-	rg.GET(
-		"/applications/:id",
-		r.AuditMW.AuditWithType("GetApplication"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:applications")),
+	r.handle(rg, http.MethodPut, "/applications/by-slug/:slug", "UpsertApplication",
+		r.upsertApplication,
+	)
+
+	r.handle(rg, http.MethodGet, "/applications/access", "ListApplicationAccess",
+		r.listApplicationAccess,
+	)
+
+	r.handle(rg, http.MethodGet, "/applications/:id", "GetApplication",
 		r.getApplication,
 	)
 
-	rg.PUT(
-		"/applications/:id",
-		r.AuditMW.AuditWithType("UpdateApplication"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:applications")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPut, "/applications/:id", "UpdateApplication",
 		r.updateApplication,
 	)
 
-	rg.DELETE(
-		"/applications/:id",
-		r.AuditMW.AuditWithType("DeleteApplication"),
-		r.AuthMW.AuthRequired(deleteScopesWithOpenID("governor:applications")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodDelete, "/applications/:id", "DeleteApplication",
 		r.deleteApplication,
 	)
 
-	rg.GET(
-		"/applications/:id/groups",
-		r.AuditMW.AuditWithType("GetApplicationGroups"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:applications")),
+	r.handle(rg, http.MethodGet, "/applications/:id/groups", "GetApplicationGroups",
 		r.listApplicationGroups,
 	)
 
-	rg.GET(
-		"/application-types",
-		r.AuditMW.AuditWithType("ListApplciationTypes"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:applications")),
+	r.handle(rg, http.MethodGet, "/application-types", "ListApplciationTypes",
 		r.listApplicationTypes,
 	)
 
-	rg.POST(
-		"/application-types",
-		r.AuditMW.AuditWithType("CreateApplicationType"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:applications")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPost, "/application-types", "CreateApplicationType",
 		r.createApplicationType,
 	)
 
-	rg.GET(
-		"/application-types/:id",
-		r.AuditMW.AuditWithType("GetApplicationType"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:applications")),
+	r.handle(rg, http.MethodGet, "/application-types/:id", "GetApplicationType",
 		r.getApplicationType,
 	)
 
-	rg.PUT(
-		"/application-types/:id",
-		r.AuditMW.AuditWithType("UpdateApplicationType"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:applications")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPut, "/application-types/:id", "UpdateApplicationType",
 		r.updateApplicationType,
 	)
 
-	rg.DELETE(
-		"/application-types/:id",
-		r.AuditMW.AuditWithType("DeleteApplicationType"),
-		r.AuthMW.AuthRequired(deleteScopesWithOpenID("governor:applications")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodDelete, "/application-types/:id", "DeleteApplicationType",
 		r.deleteApplicationType,
 	)
 
-	rg.GET(
-		"/application-types/:id/applications",
-		r.AuditMW.AuditWithType("GetApplicationTypeApps"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:applications")),
+	r.handle(rg, http.MethodGet, "/application-types/:id/applications", "GetApplicationTypeApps",
 		r.listApplicationTypeApps,
 	)
 
-	rg.GET(
-		"/notification-types",
-		r.AuditMW.AuditWithType("ListNotificationTypes"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:notifications")),
+	r.handle(rg, http.MethodGet, "/notification-types", "ListNotificationTypes",
 		r.listNotificationTypes,
 	)
 
-	rg.GET(
-		"/notification-types/:id",
-		r.AuditMW.AuditWithType("GetNotificationType"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:notifications")),
+	r.handle(rg, http.MethodGet, "/notification-types/:id", "GetNotificationType",
 		r.getNotificationType,
 	)
 
-	rg.POST(
-		"/notification-types",
-		r.AuditMW.AuditWithType("CreateNotificationType"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:notifications")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPost, "/notification-types", "CreateNotificationType",
 		r.createNotificationType,
 	)
 
-	rg.PUT(
-		"/notification-types/:id",
-		r.AuditMW.AuditWithType("UpdateNotificationType"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:notifications")),
+	r.handle(rg, http.MethodPut, "/notification-types/:id", "UpdateNotificationType",
 		r.updateNotificationType,
 	)
 
-	rg.DELETE(
-		"/notification-types/:id",
-		r.AuditMW.AuditWithType("DeleteNotificationType"),
-		r.AuthMW.AuthRequired(deleteScopesWithOpenID("governor:notifications")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodDelete, "/notification-types/:id", "DeleteNotificationType",
 		r.deleteNotificationType,
 	)
 
-	rg.GET(
-		"/notification-targets",
-		r.AuditMW.AuditWithType("ListNotificationTargets"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:notifications")),
+	r.handle(rg, http.MethodGet, "/notification-targets", "ListNotificationTargets",
 		r.listNotificationTargets,
 	)
 
-	rg.GET(
-		"/notification-targets/:id",
-		r.AuditMW.AuditWithType("GetNotificationTarget"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:notifications")),
+	r.handle(rg, http.MethodGet, "/notification-targets/:id", "GetNotificationTarget",
 		r.getNotificationTarget,
 	)
 
-	rg.POST(
-		"/notification-targets",
-		r.AuditMW.AuditWithType("CreateNotificationTarget"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:notifications")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPost, "/notification-targets", "CreateNotificationTarget",
 		r.createNotificationTarget,
 	)
 
-	rg.PUT(
-		"/notification-targets/:id",
-		r.AuditMW.AuditWithType("UpdateNotificationTarget"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:notifications")),
+	r.handle(rg, http.MethodPut, "/notification-targets/:id", "UpdateNotificationTarget",
 		r.updateNotificationTarget,
 	)
 
-	rg.DELETE(
-		"/notification-targets/:id",
-		r.AuditMW.AuditWithType("DeleteNotificationTarget"),
-		r.AuthMW.AuthRequired(deleteScopesWithOpenID("governor:notifications")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodDelete, "/notification-targets/:id", "DeleteNotificationTarget",
 		r.deleteNotificationTarget,
 	)
 
+	r.handle(rg, http.MethodPost, "/notifications/broadcast", "BroadcastNotification",
+		r.broadcastNotification,
+	)
+
+	r.handle(rg, http.MethodPost, "/notification-dispatches", "CreateNotificationDispatch",
+		r.createNotificationDispatch,
+	)
+
+	r.handle(rg, http.MethodGet, "/notification-dispatches/:id", "GetNotificationDispatch",
+		r.getNotificationDispatch,
+	)
+
+	r.handle(rg, http.MethodPost, "/notification-dispatches/:id/delivered", "AckNotificationDispatch",
+		r.ackNotificationDispatch,
+	)
+
 	// extensions
-	rg.GET(
-		"/extensions",
-		r.AuditMW.AuditWithType("ListExtensions"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:extensions")),
+	r.handle(rg, http.MethodGet, "/extensions", "ListExtensions",
 		r.listExtensions,
 	)
 
-	rg.GET(
-		"/extensions/:eid",
-		r.AuditMW.AuditWithType("GetExtension"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:extensions")),
+	r.handle(rg, http.MethodGet, "/extensions/snapshot", "GetExtensionSnapshot",
+		r.getExtensionSnapshot,
+	)
+
+	r.handle(rg, http.MethodPost, "/extensions/snapshot/diff", "DiffExtensionSnapshot",
+		r.diffExtensionSnapshot,
+	)
+
+	r.handle(rg, http.MethodGet, "/extensions/:eid", "GetExtension",
 		r.getExtension,
 	)
 
-	rg.POST(
-		"/extensions",
-		r.AuditMW.AuditWithType("CreateExtension"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:extensions")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPost, "/extensions", "CreateExtension",
 		r.createExtension,
 	)
 
-	rg.PATCH(
-		"/extensions/:eid",
-		r.AuditMW.AuditWithType("UpdateExtension"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:extensions")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPut, "/extensions/by-slug/:slug", "UpsertExtension",
+		r.upsertExtension,
+	)
+
+	r.handle(rg, http.MethodPatch, "/extensions/:eid", "UpdateExtension",
 		r.updateExtension,
 	)
 
-	rg.DELETE(
-		"/extensions/:eid",
-		r.AuditMW.AuditWithType("DeleteExtension"),
-		r.AuthMW.AuthRequired(deleteScopesWithOpenID("governor:extensions")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodDelete, "/extensions/:eid", "DeleteExtension",
 		r.deleteExtension,
 	)
 
+	r.handle(rg, http.MethodGet, "/extensions/:eid/usage", "GetExtensionUsage",
+		r.getExtensionUsage,
+	)
+
+	r.handle(rg, http.MethodGet, "/extensions/:eid/events", "SubscribeExtensionEvents",
+		r.subscribeExtensionEvents,
+	)
+
+	// extension client credentials
+	r.handle(rg, http.MethodGet, "/extensions/:eid/credentials", "ListExtensionCredentials",
+		r.listExtensionCredentials,
+	)
+
+	r.handle(rg, http.MethodPost, "/extensions/:eid/credentials", "CreateExtensionCredential",
+		r.createExtensionCredential,
+	)
+
+	r.handle(rg, http.MethodPost, "/extensions/:eid/credentials/:id/rotate", "RotateExtensionCredential",
+		r.rotateExtensionCredential,
+	)
+
+	r.handle(rg, http.MethodDelete, "/extensions/:eid/credentials/:id", "DeleteExtensionCredential",
+		r.deleteExtensionCredential,
+	)
+
+	// github team exports
+	r.handle(rg, http.MethodGet, "/integrations/github/teams", "ListGithubTeams",
+		r.listGithubTeams,
+	)
+
+	r.handle(rg, http.MethodGet, "/integrations/github/teams/:id", "GetGithubTeam",
+		r.getGithubTeam,
+	)
+
+	r.handle(rg, http.MethodPut, "/integrations/github/teams/:id", "ExportGithubTeam",
+		r.exportGithubTeam,
+	)
+
+	r.handle(rg, http.MethodDelete, "/integrations/github/teams/:id", "DeleteGithubTeamExport",
+		r.deleteGithubTeamExport,
+	)
+
+	r.handle(rg, http.MethodPost, "/integrations/github/teams/:id/sync", "SyncGithubTeam",
+		r.syncGithubTeam,
+	)
+
+	// okta event hooks authenticate with a shared secret
+	r.handle(rg, http.MethodGet, "/okta/events", "VerifyOktaEventHook",
+		r.verifyOktaEventHook,
+	)
+
+	r.handle(rg, http.MethodPost, "/okta/events", "ProcessOktaEventHook",
+		r.processOktaEventHook,
+	)
+
+	// the extensions authenticate with their client credentials
+	r.handle(rg, http.MethodPost, "/oauth/token", "IssueExtensionToken",
+		r.issueExtensionToken,
+	)
+
 	// extension resource definitions
-	rg.GET(
-		"/extensions/:eid/erds",
-		r.AuditMW.AuditWithType("ListExtensionResourceDefinitions"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:extensions")),
+	r.handle(rg, http.MethodGet, "/extensions/:eid/erds", "ListExtensionResourceDefinitions",
 		r.listExtensionResourceDefinitions,
 	)
 
-	rg.POST(
-		"/extensions/:eid/erds",
-		r.AuditMW.AuditWithType("CreateExtensionResourceDefinition"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:extensions")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPost, "/extensions/:eid/erds", "CreateExtensionResourceDefinition",
 		r.createExtensionResourceDefinition,
 	)
 
-	rg.GET(
-		"/extensions/:eid/erds/:erd-id-slug",
-		r.AuditMW.AuditWithType("GetExtensionResourceDefinitionByID"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:extensions")),
+	r.handle(rg, http.MethodPost, "/extensions/:eid/erds/validate-schema", "ValidateExtensionResourceDefinitionSchema",
+		r.validateExtensionResourceDefinitionSchema,
+	)
+
+	r.handle(rg, http.MethodGet, "/extensions/:eid/erds/:erd-id-slug", "GetExtensionResourceDefinitionByID",
 		r.getExtensionResourceDefinition,
 	)
 
-	rg.GET(
-		"/extensions/:eid/erds/:erd-id-slug/:erd-version",
-		r.AuditMW.AuditWithType("GetExtensionResourceDefinitionBySlug"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:extensions")),
+	r.handle(rg, http.MethodGet, "/extensions/:eid/erds/:erd-id-slug/:erd-version", "GetExtensionResourceDefinitionBySlug",
 		r.getExtensionResourceDefinition,
 	)
 
-	rg.PATCH(
-		"/extensions/:eid/erds/:erd-id-slug",
-		r.AuditMW.AuditWithType("UpdateExtensionResourceDefinitionByID"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:extensions")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodGet, "/extensions/:eid/erds/:erd-id-slug/schema-diff", "GetExtensionResourceDefinitionSchemaDiffByID",
+		r.getExtensionResourceDefinitionSchemaDiff,
+	)
+
+	r.handle(rg, http.MethodGet, "/extensions/:eid/erds/:erd-id-slug/:erd-version/schema-diff", "GetExtensionResourceDefinitionSchemaDiffBySlug",
+		r.getExtensionResourceDefinitionSchemaDiff,
+	)
+
+	r.handle(rg, http.MethodPatch, "/extensions/:eid/erds/:erd-id-slug", "UpdateExtensionResourceDefinitionByID",
 		r.updateExtensionResourceDefinition,
 	)
 
-	rg.PATCH(
-		"/extensions/:eid/erds/:erd-id-slug/:erd-version",
-		r.AuditMW.AuditWithType("UpdateExtensionResourceDefinitionBySlug"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:extensions")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPatch, "/extensions/:eid/erds/:erd-id-slug/:erd-version", "UpdateExtensionResourceDefinitionBySlug",
 		r.updateExtensionResourceDefinition,
 	)
 
-	rg.DELETE(
-		"/extensions/:eid/erds/:erd-id-slug",
-		r.AuditMW.AuditWithType("DeleteExtensionResourceDefinitionByID"),
-		r.AuthMW.AuthRequired(deleteScopesWithOpenID("governor:extensions")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPut, "/extensions/:eid/erds/:erd-id-slug/state", "UpdateExtensionResourceDefinitionStateByID",
+		r.updateExtensionResourceDefinitionState,
+	)
+
+	r.handle(rg, http.MethodPut, "/extensions/:eid/erds/:erd-id-slug/:erd-version/state", "UpdateExtensionResourceDefinitionStateBySlug",
+		r.updateExtensionResourceDefinitionState,
+	)
+
+	r.handle(rg, http.MethodPut, "/extensions/:eid/erds/:erd-id-slug/:erd-version", "UpsertExtensionResourceDefinition",
+		r.upsertExtensionResourceDefinition,
+	)
+
+	r.handle(rg, http.MethodDelete, "/extensions/:eid/erds/:erd-id-slug", "DeleteExtensionResourceDefinitionByID",
 		r.deleteExtensionResourceDefinition,
 	)
 
-	rg.DELETE(
-		"/extensions/:eid/erds/:erd-id-slug/:erd-version",
-		r.AuditMW.AuditWithType("DeleteExtensionResourceDefinitionBySlug"),
-		r.AuthMW.AuthRequired(deleteScopesWithOpenID("governor:extensions")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodDelete, "/extensions/:eid/erds/:erd-id-slug/:erd-version", "DeleteExtensionResourceDefinitionBySlug",
 		r.deleteExtensionResourceDefinition,
 	)
 
 	// system-wise extension resources
-	rg.POST(
-		"/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
-		r.AuditMW.AuditWithType("CreateSystemExtensionResource"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:extensionresources")),
-		r.mwUserAuthRequired(AuthRoleUser),
-		r.mwSystemExtensionResourceGroupAuth,
+	r.handle(rg, http.MethodPost, "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version", "CreateSystemExtensionResource",
 		r.mwExtensionResourcesEnabledCheck,
 		r.createSystemExtensionResource,
 	)
 
-	rg.GET(
-		"/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
-		r.AuditMW.AuditWithType("ListSystemExtensionResources"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:extensionresources")),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodGet, "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version", "ListSystemExtensionResources",
+		r.mwExtensionResourcesEnabledCheck,
 		r.listSystemExtensionResources,
 	)
 
-	rg.GET(
-		"/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
-		r.AuditMW.AuditWithType("GetSystemExtensionResource"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:extensionresources")),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodHead, "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version", "CheckSystemExtensionResources",
+		r.mwExtensionResourcesEnabledCheck,
+		r.systemExtensionResourcesExist,
+	)
+
+	r.handle(rg, http.MethodGet, "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/count", "CountSystemExtensionResources",
+		r.mwExtensionResourcesEnabledCheck,
+		r.countSystemExtensionResources,
+	)
+
+	r.handle(rg, http.MethodGet, "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id", "GetSystemExtensionResource",
+		r.mwExtensionResourcesEnabledCheck,
 		r.getSystemExtensionResource,
 	)
 
-	rg.PATCH(
-		"/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
-		r.AuditMW.AuditWithType("UpdateSystemExtensionResource"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:extensionresources")),
-		r.mwUserAuthRequired(AuthRoleUser),
-		r.mwSystemExtensionResourceGroupAuth,
+	r.handle(rg, http.MethodPatch, "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id", "UpdateSystemExtensionResource",
 		r.mwExtensionResourcesEnabledCheck,
 		r.updateSystemExtensionResource,
 	)
 
-	rg.DELETE(
-		"/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
-		r.AuditMW.AuditWithType("DeleteSystemExtensionResource"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:extensionresources")),
-		r.mwUserAuthRequired(AuthRoleUser),
-		r.mwSystemExtensionResourceGroupAuth,
+	r.handle(rg, http.MethodGet, "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/versions", "ListSystemExtensionResourceVersions",
+		r.mwExtensionResourcesEnabledCheck,
+		r.listSystemExtensionResourceVersions,
+	)
+
+	r.handle(rg, http.MethodPost, "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/versions/:version/rollback", "RollbackSystemExtensionResource",
+		r.mwExtensionResourcesEnabledCheck,
+		r.rollbackSystemExtensionResource,
+	)
+
+	r.handle(rg, http.MethodPatch, "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/owner", "TransferSystemExtensionResourceOwner",
+		r.mwExtensionResourcesEnabledCheck,
+		r.transferSystemExtensionResourceOwner,
+	)
+
+	r.handle(rg, http.MethodDelete, "/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id", "DeleteSystemExtensionResource",
 		r.mwExtensionResourcesEnabledCheck,
 		r.deleteSystemExtensionResource,
 	)
 
 	// user extension resources
-	rg.POST(
-		"/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
-		r.AuditMW.AuditWithType("CreateUserExtensionResource"),
-		r.AuthMW.AuthRequired(createScopesWithOpenID("governor:users")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPost, "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version", "CreateUserExtensionResource",
 		r.mwExtensionResourcesEnabledCheck,
 		r.createUserExtensionResource,
 	)
 
-	rg.POST(
-		"/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
-		r.AuditMW.AuditWithType("CreateAuthenticatedUserExtensionResource"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodPost, "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version", "CreateAuthenticatedUserExtensionResource",
 		r.mwExtensionResourcesEnabledCheck,
 		r.createUserExtensionResource,
 	)
 
-	rg.GET(
-		"/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
-		r.AuditMW.AuditWithType("ListUserExtensionResources"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:users")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodGet, "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version", "ListUserExtensionResources",
+		r.mwExtensionResourcesEnabledCheck,
 		r.listUserExtensionResources,
 	)
 
-	rg.GET(
-		"/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version",
-		r.AuditMW.AuditWithType("ListAuthenticatedUserExtensionResources"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodHead, "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version", "CheckUserExtensionResources",
+		r.mwExtensionResourcesEnabledCheck,
+		r.userExtensionResourcesExist,
+	)
+
+	r.handle(rg, http.MethodGet, "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/count", "CountUserExtensionResources",
+		r.mwExtensionResourcesEnabledCheck,
+		r.countUserExtensionResources,
+	)
+
+	r.handle(rg, http.MethodGet, "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version", "ListAuthenticatedUserExtensionResources",
+		r.mwExtensionResourcesEnabledCheck,
 		r.listUserExtensionResources,
 	)
 
-	rg.GET(
-		"/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
-		r.AuditMW.AuditWithType("GetUserExtensionResource"),
-		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:users")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodHead, "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version", "CheckAuthenticatedUserExtensionResources",
+		r.mwExtensionResourcesEnabledCheck,
+		r.userExtensionResourcesExist,
+	)
+
+	r.handle(rg, http.MethodGet, "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/count", "CountAuthenticatedUserExtensionResources",
+		r.mwExtensionResourcesEnabledCheck,
+		r.countUserExtensionResources,
+	)
+
+	r.handle(rg, http.MethodGet, "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id", "GetUserExtensionResource",
+		r.mwExtensionResourcesEnabledCheck,
 		r.getUserExtensionResource,
 	)
 
-	rg.GET(
-		"/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
-		r.AuditMW.AuditWithType("GetAuthenticatedUserExtensionResources"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodGet, "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id", "GetAuthenticatedUserExtensionResources",
+		r.mwExtensionResourcesEnabledCheck,
 		r.getUserExtensionResource,
 	)
 
-	rg.PATCH(
-		"/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
-		r.AuditMW.AuditWithType("UpdateUserExtensionResource"),
-		r.AuthMW.AuthRequired(updateScopesWithOpenID("governor:users")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodPatch, "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id", "UpdateUserExtensionResource",
 		r.mwExtensionResourcesEnabledCheck,
 		r.updateUserExtensionResource,
 	)
 
-	rg.PATCH(
-		"/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
-		r.AuditMW.AuditWithType("UpdateAuthenticatedUserExtensionResources"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodPatch, "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id", "UpdateAuthenticatedUserExtensionResources",
 		r.mwExtensionResourcesEnabledCheck,
 		r.updateUserExtensionResource,
 	)
 
-	rg.DELETE(
-		"/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
-		r.AuditMW.AuditWithType("DeleteUserExtensionResource"),
-		r.AuthMW.AuthRequired(deleteScopesWithOpenID("governor:users")),
-		r.mwUserAuthRequired(AuthRoleAdmin),
+	r.handle(rg, http.MethodGet, "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/versions", "ListUserExtensionResourceVersions",
+		r.mwExtensionResourcesEnabledCheck,
+		r.listUserExtensionResourceVersions,
+	)
+
+	r.handle(rg, http.MethodGet, "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/versions", "ListAuthenticatedUserExtensionResourceVersions",
+		r.mwExtensionResourcesEnabledCheck,
+		r.listUserExtensionResourceVersions,
+	)
+
+	r.handle(rg, http.MethodPost, "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/versions/:version/rollback", "RollbackUserExtensionResource",
+		r.mwExtensionResourcesEnabledCheck,
+		r.rollbackUserExtensionResource,
+	)
+
+	r.handle(rg, http.MethodPost, "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id/versions/:version/rollback", "RollbackAuthenticatedUserExtensionResource",
+		r.mwExtensionResourcesEnabledCheck,
+		r.rollbackUserExtensionResource,
+	)
+
+	r.handle(rg, http.MethodDelete, "/users/:id/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id", "DeleteUserExtensionResource",
 		r.mwExtensionResourcesEnabledCheck,
 		r.deleteUserExtensionResource,
 	)
 
-	rg.DELETE(
-		"/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id",
-		r.AuditMW.AuditWithType("DeleteAuthenticatedUserExtensionResources"),
-		r.AuthMW.AuthRequired([]string{oidcScope}),
-		r.mwUserAuthRequired(AuthRoleUser),
+	r.handle(rg, http.MethodDelete, "/user/extension-resources/:ex-slug/:erd-slug-plural/:erd-version/:resource-id", "DeleteAuthenticatedUserExtensionResources",
 		r.mwExtensionResourcesEnabledCheck,
 		r.deleteUserExtensionResource,
 	)
 }
 
+// svc returns the service layer, when the router wasn't given one it is built
+// from the router's own dependencies
+func (r *Router) svc() *service.Service {
+	if r.Service != nil {
+		return r.Service
+	}
+
+	return service.New(
+		service.WithDB(r.DB),
+		service.WithEventBus(r.EventBus),
+		service.WithLogger(r.Logger),
+	)
+}
+
 func contains(list []string, item string) bool {
 	for _, i := range list {
 		if i == item {
@@ -0,0 +1,126 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+)
+
+// ScheduledMembershipChange is a membership add or removal scheduled for a
+// future time
+type ScheduledMembershipChange struct {
+	ID             string      `json:"id"`
+	GroupID        string      `json:"group_id"`
+	UserID         string      `json:"user_id"`
+	Action         string      `json:"action"`
+	IsAdmin        bool        `json:"is_admin"`
+	ExpiresAt      null.Time   `json:"expires_at"`
+	AdminExpiresAt null.Time   `json:"admin_expires_at"`
+	ScheduledFor   time.Time   `json:"scheduled_for"`
+	Status         string      `json:"status"`
+	Error          string      `json:"error,omitempty"`
+	Justification  string      `json:"justification,omitempty"`
+	CreatedBy      null.String `json:"created_by"`
+	AppliedAt      null.Time   `json:"applied_at"`
+	CanceledAt     null.Time   `json:"canceled_at"`
+	CreatedAt      time.Time   `json:"created_at"`
+}
+
+// ScheduledMembershipChangeReq is a request to schedule a membership change,
+// is_admin, the expiries and the justification only apply to scheduled adds
+type ScheduledMembershipChangeReq struct {
+	Action         string    `json:"action" binding:"required,oneof=add remove"`
+	ScheduledFor   time.Time `json:"scheduled_for" binding:"required"`
+	IsAdmin        bool      `json:"is_admin"`
+	ExpiresAt      null.Time `json:"expires_at"`
+	AdminExpiresAt null.Time `json:"admin_expires_at"`
+	Justification  string    `json:"justification" binding:"max=1024"`
+}
+
+func scheduledMembershipChange(c *models.ScheduledMembershipChange) ScheduledMembershipChange {
+	return ScheduledMembershipChange{
+		ID:             c.ID,
+		GroupID:        c.GroupID,
+		UserID:         c.UserID,
+		Action:         c.Action,
+		IsAdmin:        c.IsAdmin,
+		ExpiresAt:      c.ExpiresAt,
+		AdminExpiresAt: c.AdminExpiresAt,
+		ScheduledFor:   c.ScheduledFor,
+		Status:         c.Status,
+		Error:          c.Error,
+		Justification:  c.Justification,
+		CreatedBy:      c.CreatedBy,
+		AppliedAt:      c.AppliedAt,
+		CanceledAt:     c.CanceledAt,
+		CreatedAt:      c.CreatedAt,
+	}
+}
+
+// scheduleMembershipChange schedules adding a user to a group, or removing
+// them, at a future time
+func (r *Router) scheduleMembershipChange(c *gin.Context) {
+	req := ScheduledMembershipChangeReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	change, event, err := r.svc().ScheduleMembershipChange(c.Request.Context(), ctxActor(c), c.Param("id"), c.Param("uid"), service.ScheduleMembershipChangeParams{
+		Action:         req.Action,
+		ScheduledFor:   req.ScheduledFor,
+		IsAdmin:        req.IsAdmin,
+		ExpiresAt:      req.ExpiresAt,
+		AdminExpiresAt: req.AdminExpiresAt,
+		Justification:  req.Justification,
+	})
+	if !handleServiceResult(c, event, err) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, scheduledMembershipChange(change))
+}
+
+// listScheduledMembershipChanges returns the scheduled membership changes of
+// a group, they can be filtered with ?status
+func (r *Router) listScheduledMembershipChanges(c *gin.Context) {
+	group, err := r.svc().FindGroup(c.Request.Context(), c.Param("id"), false)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	queryMods := []qm.QueryMod{qm.Where("group_id = ?", group.ID)}
+
+	if status := c.Query("status"); status != "" {
+		queryMods = append(queryMods, qm.And("status = ?", status))
+	}
+
+	changes, err := r.svc().ListScheduledMembershipChanges(c.Request.Context(), queryMods...)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := make([]ScheduledMembershipChange, len(changes))
+	for i, change := range changes {
+		response[i] = scheduledMembershipChange(change)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// cancelScheduledMembershipChange cancels a pending scheduled membership change
+func (r *Router) cancelScheduledMembershipChange(c *gin.Context) {
+	change, event, err := r.svc().CancelScheduledMembershipChange(c.Request.Context(), ctxActor(c), c.Param("id"), c.Param("cid"))
+	if !handleServiceResult(c, event, err) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, scheduledMembershipChange(change))
+}
@@ -0,0 +1,139 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/sessiontokens"
+)
+
+// defaultSessionTokenTTL is how long the session tokens are valid when the
+// router isn't given a token ttl
+const defaultSessionTokenTTL = 15 * time.Minute
+
+// SessionTokenReq is a request to exchange the OIDC token of the user for a
+// session token, the session token must be read-only, scoped to a group or both
+type SessionTokenReq struct {
+	ReadOnly bool   `json:"read_only"`
+	GroupID  string `json:"group_id"`
+}
+
+// SessionToken is a session token issued to the UI, it follows the OAuth 2.0
+// access token response
+type SessionToken struct {
+	AccessToken string      `json:"access_token"`
+	TokenType   string      `json:"token_type"`
+	ExpiresIn   int64       `json:"expires_in"`
+	ExpiresAt   time.Time   `json:"expires_at"`
+	ReadOnly    bool        `json:"read_only"`
+	GroupID     null.String `json:"group_id"`
+}
+
+// createSessionToken exchanges the OIDC token of the authenticated user for a
+// short-lived session token restricted to read-only requests, to a group or
+// both. Session tokens can't be exchanged for other session tokens.
+func (r *Router) createSessionToken(c *gin.Context) {
+	ctxUser := getCtxUser(c)
+	if ctxUser == nil {
+		sendError(c, http.StatusUnauthorized, "no user in context")
+		return
+	}
+
+	if sessiontokens.FromContext(c) != nil {
+		sendErrorWithCode(c, http.StatusForbidden, ErrCodeSessionTokenForbidden, "session tokens can't be exchanged for other session tokens")
+		return
+	}
+
+	req := SessionTokenReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	if !req.ReadOnly && req.GroupID == "" {
+		sendValidationError(c, []ErrorDetail{{
+			Field:   "read_only",
+			Message: "session tokens must be read_only, scoped to a group_id or both",
+		}})
+
+		return
+	}
+
+	token := &models.SessionToken{
+		UserID:   ctxUser.ID,
+		ReadOnly: req.ReadOnly,
+	}
+
+	if req.GroupID != "" {
+		group, err := r.svc().FindGroup(c.Request.Context(), req.GroupID, false)
+		if err != nil {
+			sendServiceError(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		token.GroupID = null.StringFrom(group.ID)
+	}
+
+	ttl := r.SessionTokenTTL
+	if ttl <= 0 {
+		ttl = defaultSessionTokenTTL
+	}
+
+	access, err := sessiontokens.NewToken()
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error generating session token: "+err.Error())
+		return
+	}
+
+	now := time.Now()
+
+	token.TokenHash = sessiontokens.Hash(access)
+	token.ExpiresAt = now.Add(ttl)
+	token.CreatedAt = now
+
+	if err := token.Insert(c.Request.Context(), r.DB, boil.Infer()); err != nil {
+		sendError(c, http.StatusInternalServerError, "error storing session token: "+err.Error())
+		return
+	}
+
+	// the expired tokens of the user are cleaned up as new ones are issued
+	if _, err := models.SessionTokens(
+		qm.Where("user_id = ?", ctxUser.ID),
+		qm.And("expires_at <= ?", now),
+	).DeleteAll(c.Request.Context(), r.DB); err != nil {
+		r.Logger.Warn("error deleting expired session tokens", zap.Error(err))
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, SessionToken{
+		AccessToken: access,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(ttl.Seconds()),
+		ExpiresAt:   token.ExpiresAt,
+		ReadOnly:    token.ReadOnly,
+		GroupID:     token.GroupID,
+	})
+}
+
+// revokeSessionTokens revokes all the session tokens of the authenticated
+// user, for example when the user logs out of the UI
+func (r *Router) revokeSessionTokens(c *gin.Context) {
+	ctxUser := getCtxUser(c)
+	if ctxUser == nil {
+		sendError(c, http.StatusUnauthorized, "no user in context")
+		return
+	}
+
+	if _, err := models.SessionTokens(qm.Where("user_id = ?", ctxUser.ID)).DeleteAll(c.Request.Context(), r.DB); err != nil {
+		sendError(c, http.StatusInternalServerError, "error revoking session tokens: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
@@ -0,0 +1,71 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/auth"
+	"github.com/metal-toolbox/governor-api/internal/featureflags"
+)
+
+const (
+	// StepUpRouteGroupGroups is the step-up policy name for high-risk group operations, like deleting a group
+	StepUpRouteGroupGroups = "groups"
+	// StepUpRouteGroupMembers is the step-up policy name for removing group members
+	StepUpRouteGroupMembers = "members"
+	// StepUpRouteGroupUsers is the step-up policy name for high-risk user operations, like deleting or merging users
+	StepUpRouteGroupUsers = "users"
+	// StepUpRouteGroupExtensions is the step-up policy name for deleting extensions and extension resource definitions,
+	// and for issuing extension client credentials
+	StepUpRouteGroupExtensions = "extensions"
+	// StepUpRouteGroupPurge is the step-up policy name for permanently removing soft deleted records
+	StepUpRouteGroupPurge = "purge"
+	// StepUpRouteGroupBackup is the step-up policy name for creating and restoring backups
+	StepUpRouteGroupBackup = "backup"
+)
+
+// mwStepUpRequired checks that the authenticated user recently went through
+// the authentication required by the step-up policy of the route group. The
+// request is allowed when the route group has no policy, the require-step-up
+// feature flag is off, or the client has no user. Otherwise a 403 is returned
+// with a WWW-Authenticate challenge describing the authentication needed.
+func (r *Router) mwStepUpRequired(routeGroup string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		policy, ok := r.StepUpPolicies[routeGroup]
+		if !ok {
+			return
+		}
+
+		// step-up only applies to users, other api clients can't re-authenticate
+		if !contains(c.GetStringSlice("jwt.roles"), oidcScope) {
+			return
+		}
+
+		if !r.featureEnabled(c, featureflags.RequireStepUp) {
+			return
+		}
+
+		var claims *auth.StepUpClaims
+
+		authzHeader := strings.Split(c.Request.Header.Get("Authorization"), " ")
+		if len(authzHeader) == expectedAuthzHeaderParts {
+			var err error
+
+			claims, err = auth.StepUpClaimsFromJWT(authzHeader[expectedAuthzHeaderParts-1])
+			if err != nil {
+				r.Logger.Debug("error reading step-up claims", zap.Error(err))
+			}
+		}
+
+		if err := policy.Check(claims, time.Now()); err != nil {
+			c.Header("WWW-Authenticate", policy.Challenge())
+			sendErrorWithCode(c, http.StatusForbidden, ErrCodeStepUpRequired, err.Error())
+
+			return
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/metal-toolbox/governor-api/internal/auth"
+)
+
+func TestMWStepUpRequired(t *testing.T) {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte("0123456789abcdef0123456789abcdef")}, nil)
+	require.NoError(t, err)
+
+	token := func(claims map[string]interface{}) string {
+		raw, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		require.NoError(t, err)
+
+		return raw
+	}
+
+	r := &Router{
+		Logger: zap.NewNop(),
+		StepUpPolicies: map[string]auth.StepUpPolicy{
+			StepUpRouteGroupGroups: {MaxAge: 15 * time.Minute, AMR: []string{"mfa"}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		routeGroup string
+		roles      []string
+		token      string
+		wantStatus int
+	}{
+		{
+			name:       "route group without policy",
+			routeGroup: StepUpRouteGroupMembers,
+			roles:      []string{oidcScope},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "client without user",
+			routeGroup: StepUpRouteGroupGroups,
+			roles:      []string{"delete:governor:groups"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "recent mfa",
+			routeGroup: StepUpRouteGroupGroups,
+			roles:      []string{oidcScope},
+			token:      token(map[string]interface{}{"auth_time": time.Now().Add(-time.Minute).Unix(), "amr": []string{"pwd", "mfa"}}),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "stale mfa",
+			routeGroup: StepUpRouteGroupGroups,
+			roles:      []string{oidcScope},
+			token:      token(map[string]interface{}{"auth_time": time.Now().Add(-time.Hour).Unix(), "amr": []string{"mfa"}}),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "missing token",
+			routeGroup: StepUpRouteGroupGroups,
+			roles:      []string{oidcScope},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, w := newValidationTestContext("")
+			c.Set("jwt.roles", tt.roles)
+
+			if tt.token != "" {
+				c.Request.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+
+			r.mwStepUpRequired(tt.routeGroup)(c)
+
+			if tt.wantStatus == http.StatusOK {
+				assert.False(t, c.IsAborted())
+				return
+			}
+
+			assert.True(t, c.IsAborted())
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Contains(t, w.Header().Get("WWW-Authenticate"), `error="insufficient_user_authentication"`)
+			assert.Contains(t, w.Body.String(), string(ErrCodeStepUpRequired))
+		})
+	}
+}
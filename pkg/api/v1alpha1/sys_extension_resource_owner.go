@@ -0,0 +1,270 @@
+package v1alpha1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// SystemExtensionResourceOwnerReq is the request to transfer a system
+// extension resource to another group
+type SystemExtensionResourceOwnerReq struct {
+	OwnerID string `json:"owner_id" binding:"required"`
+}
+
+// transferSystemExtensionResourceOwner changes the owner group of a system
+// extension resource. Only the members of the current owner group, the ERD
+// admins and governor admins can transfer a resource.
+func (r *Router) transferSystemExtensionResourceOwner(c *gin.Context) {
+	req := &SystemExtensionResourceOwnerReq{}
+	if !bindRequest(c, req) {
+		return
+	}
+
+	extension, erd, err := findERDForExtensionResource(
+		c, r.DB,
+		c.Param("ex-slug"), c.Param("erd-slug-plural"), c.Param("erd-version"),
+	)
+	if err != nil {
+		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
+			sendErrorFromErr(c, http.StatusNotFound, err)
+			return
+		}
+
+		sendError(c, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	if erd.Scope != ExtensionResourceDefinitionScopeSys.String() {
+		sendError(
+			c, http.StatusBadRequest,
+			fmt.Sprintf(
+				"cannot transfer system resource for %s scoped %s/%s",
+				erd.Scope, erd.SlugSingular, erd.Version,
+			),
+		)
+
+		return
+	}
+
+	er, err := erd.SystemExtensionResources(qm.Where("id = ?", c.Param("resource-id"))).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeExtensionResourceNotFound, "resource not found: "+err.Error())
+			return
+		}
+
+		sendError(c, http.StatusBadRequest, "error finding extension resources: "+err.Error())
+
+		return
+	}
+
+	allowed, err := r.canTransferSystemExtensionResource(c, erd, er)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting enumerated groups: "+err.Error())
+		return
+	}
+
+	if !allowed {
+		sendError(c, http.StatusForbidden, "user do not have permissions to transfer this resource")
+		return
+	}
+
+	owner, err := r.svc().FindGroup(c.Request.Context(), req.OwnerID, false)
+	if err != nil {
+		if errors.Is(err, service.ErrGroupNotFound) {
+			sendErrorWithCode(c, http.StatusBadRequest, ErrCodeGroupNotFound, "owner group not found: "+err.Error())
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting owner group: "+err.Error())
+
+		return
+	}
+
+	original := *er
+	er.OwnerID = null.StringFrom(owner.ID)
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := er.Update(c.Request.Context(), tx, boil.Whitelist(
+			models.SystemExtensionResourceColumns.OwnerID,
+			models.SystemExtensionResourceColumns.UpdatedAt,
+		)); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error transferring %s: %s", erd.Name, err.Error()), err)
+		}
+
+		if err := r.recordChange(c, tx, erd.SlugPlural, &events.Event{
+			Version:                       erd.Version,
+			Action:                        events.GovernorEventUpdate,
+			GroupID:                       owner.ID,
+			ExtensionID:                   extension.ID,
+			ExtensionResourceID:           er.ID,
+			ExtensionResourceDefinitionID: erd.ID,
+			Before:                        &original,
+			After:                         er,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditSystemExtensionResourceOwnerTransferred(ctx, exec, pID, actor, &original, er)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error transferring extension resource (audit): %s", err.Error()), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	r.publishChanges(c)
+
+	c.JSON(http.StatusAccepted, &SystemExtensionResource{
+		SystemExtensionResource: er,
+		ERD:                     erd.SlugSingular,
+		Version:                 erd.Version,
+	})
+}
+
+// canTransferSystemExtensionResource returns whether the user can transfer a
+// system extension resource, requests without a user token are authorized by
+// their scopes alone
+func (r *Router) canTransferSystemExtensionResource(
+	c *gin.Context, erd *models.ExtensionResourceDefinition, er *models.SystemExtensionResource,
+) (bool, error) {
+	if !contains(c.GetStringSlice("jwt.roles"), oidcScope) {
+		r.Logger.Debug("oidc scope not found, skipping user authorization check", zap.String("oidcScope", oidcScope))
+		return true, nil
+	}
+
+	user := getCtxUser(c)
+	if user == nil {
+		return false, nil
+	}
+
+	if isGovAdmin := getCtxAdmin(c); isGovAdmin != nil && *isGovAdmin {
+		return true, nil
+	}
+
+	groups := map[string]bool{}
+
+	if er.OwnerID.Valid {
+		groups[er.OwnerID.String] = true
+	}
+
+	if erd.AdminGroup.Valid && erd.AdminGroup.String != "" {
+		groups[erd.AdminGroup.String] = true
+	}
+
+	if len(groups) == 0 {
+		return false, nil
+	}
+
+	memberships, err := dbtools.GetMembershipsForUser(c.Request.Context(), r.DB.DB, user.ID, false)
+	if err != nil {
+		return false, err
+	}
+
+	for _, m := range memberships {
+		if groups[m.GroupID] {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// defaultSystemExtensionResourceOwner returns the owner group of a new system
+// extension resource following the default owner policy of its ERD. With the
+// creator group policy the creator picks one of their groups with the owner
+// query parameter, it can be left out when they are a direct member of a single
+// group. Requests without a user token can give any group, or none.
+func (r *Router) defaultSystemExtensionResourceOwner(c *gin.Context, erd *models.ExtensionResourceDefinition) (null.String, bool) {
+	switch ExtensionResourceDefinitionOwnerPolicy(erd.DefaultOwnerPolicy) {
+	case ExtensionResourceDefinitionOwnerPolicyGroup:
+		return erd.DefaultOwnerGroup, true
+	case ExtensionResourceDefinitionOwnerPolicyCreatorGroup:
+		return r.creatorGroupOwner(c)
+	default:
+		return null.String{}, true
+	}
+}
+
+// creatorGroupOwner returns the group of the creator that owns a new resource
+func (r *Router) creatorGroupOwner(c *gin.Context) (null.String, bool) {
+	ownerParam := c.Query("owner")
+
+	var owner *models.Group
+
+	if ownerParam != "" {
+		var err error
+
+		owner, err = r.svc().FindGroup(c.Request.Context(), ownerParam, false)
+		if err != nil {
+			if errors.Is(err, service.ErrGroupNotFound) {
+				sendErrorWithCode(c, http.StatusBadRequest, ErrCodeGroupNotFound, "owner group not found: "+err.Error())
+				return null.String{}, false
+			}
+
+			sendError(c, http.StatusInternalServerError, "error getting owner group: "+err.Error())
+
+			return null.String{}, false
+		}
+	}
+
+	user := getCtxUser(c)
+	if user == nil || !contains(c.GetStringSlice("jwt.roles"), oidcScope) {
+		if owner == nil {
+			return null.String{}, true
+		}
+
+		return null.StringFrom(owner.ID), true
+	}
+
+	if isGovAdmin := getCtxAdmin(c); owner != nil && isGovAdmin != nil && *isGovAdmin {
+		return null.StringFrom(owner.ID), true
+	}
+
+	memberships, err := dbtools.GetMembershipsForUser(c.Request.Context(), r.DB.DB, user.ID, false)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting enumerated groups: "+err.Error())
+		return null.String{}, false
+	}
+
+	if owner != nil {
+		for _, m := range memberships {
+			if m.GroupID == owner.ID {
+				return null.StringFrom(owner.ID), true
+			}
+		}
+
+		sendError(c, http.StatusForbidden, "owner group must be one of the creator's groups")
+
+		return null.String{}, false
+	}
+
+	var direct []string
+
+	for _, m := range memberships {
+		if m.Direct {
+			direct = append(direct, m.GroupID)
+		}
+	}
+
+	if len(direct) != 1 {
+		sendError(c, http.StatusBadRequest, "owner query parameter is required to pick one of the creator's groups")
+		return null.String{}, false
+	}
+
+	return null.StringFrom(direct[0]), true
+}
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -9,11 +10,12 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/metal-toolbox/auditevent/ginaudit"
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
 	"github.com/metal-toolbox/governor-api/pkg/jsonschema"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
@@ -46,7 +48,7 @@ func (r *Router) createSystemExtensionResource(c *gin.Context) {
 	)
 	if err != nil {
 		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
-			sendError(c, http.StatusNotFound, err.Error())
+			sendErrorFromErr(c, http.StatusNotFound, err)
 			return
 		}
 
@@ -91,97 +93,39 @@ func (r *Router) createSystemExtensionResource(c *gin.Context) {
 		return
 	}
 
-	// insert
-	er := &models.SystemExtensionResource{Resource: requestBody}
-
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting extension resource create transaction: "+err.Error())
-		return
-	}
-
-	if err := erd.AddSystemExtensionResources(c.Request.Context(), tx, true, er); err != nil {
-		msg := fmt.Sprintf("error creating %s: %s", erd.Name, err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
+	owner, ok := r.defaultSystemExtensionResourceOwner(c, erd)
+	if !ok {
 		return
 	}
 
-	event, err := dbtools.AuditSystemExtensionResourceCreated(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		er,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error creating extension resource (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error creating extension resource: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing extension resource create: %s", err.Error())
+	// insert
+	er := &models.SystemExtensionResource{Resource: requestBody, OwnerID: owner}
 
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := erd.AddSystemExtensionResources(c.Request.Context(), tx, true, er); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error creating %s: %s", erd.Name, err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		erd.SlugPlural,
-		&events.Event{
+		if err := r.recordChange(c, tx, erd.SlugPlural, &events.Event{
 			Version:                       erd.Version,
 			Action:                        events.GovernorEventCreate,
-			AuditID:                       c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:                       getCtxActorID(c),
 			ExtensionID:                   extension.ID,
 			ExtensionResourceID:           er.ID,
 			ExtensionResourceDefinitionID: erd.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish extension resource create event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
+			After:                         er,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditSystemExtensionResourceCreated(ctx, exec, pID, actor, er)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error creating extension resource (audit): %s", err.Error()), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
+	r.publishChanges(c)
+
 	resp := &SystemExtensionResource{
 		SystemExtensionResource: er,
 		ERD:                     erd.SlugSingular,
@@ -191,12 +135,19 @@ func (r *Router) createSystemExtensionResource(c *gin.Context) {
 	c.JSON(http.StatusCreated, resp)
 }
 
-// listSystemExtensionResource lists system extension resources for an ERD
-func (r *Router) listSystemExtensionResources(c *gin.Context) {
+// systemExtensionResourcesQuery reads the ERD and the filters of a system
+// extension resources listing from the request, it sends the error response
+// and returns false when they are invalid
+func (r *Router) systemExtensionResourcesQuery(c *gin.Context) (*models.ExtensionResourceDefinition, *extensionResourcesQuery, bool) {
 	extensionSlug := c.Param("ex-slug")
 	erdSlugPlural := c.Param("erd-slug-plural")
 	erdVersion := c.Param("erd-version")
 
+	asOf, ok := asOfParam(c)
+	if !ok {
+		return nil, nil, false
+	}
+
 	// find ERD
 	_, erd, err := findERDForExtensionResource(
 		c, r.DB,
@@ -204,13 +155,13 @@ func (r *Router) listSystemExtensionResources(c *gin.Context) {
 	)
 	if err != nil {
 		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
-			sendError(c, http.StatusNotFound, err.Error())
-			return
+			sendErrorFromErr(c, http.StatusNotFound, err)
+			return nil, nil, false
 		}
 
 		sendError(c, http.StatusBadRequest, err.Error())
 
-		return
+		return nil, nil, false
 	}
 
 	if erd.Scope != ExtensionResourceDefinitionScopeSys.String() {
@@ -222,7 +173,17 @@ func (r *Router) listSystemExtensionResources(c *gin.Context) {
 			),
 		)
 
-		return
+		return nil, nil, false
+	}
+
+	since, ok := updatedSinceParam(c)
+	if !ok {
+		return nil, nil, false
+	}
+
+	if since != nil && asOf != nil {
+		sendValidationError(c, []ErrorDetail{{Field: "updated_since", Message: "updated_since can't be used with as_of"}})
+		return nil, nil, false
 	}
 
 	uriQueries := map[string]string{}
@@ -232,21 +193,79 @@ func (r *Router) listSystemExtensionResources(c *gin.Context) {
 			fmt.Sprintf("error binding uri queries: %s", err.Error()),
 		)
 
-		return
+		return nil, nil, false
 	}
 
 	qms := make([]qm.QueryMod, 0, len(uriQueries))
+	filters := dbtools.ExtensionResourceFilters{Fields: map[string]string{}}
 
 	for k, v := range uriQueries {
+		if k == "as_of" || k == "fields" || k == "updated_since" {
+			continue
+		}
+
 		if k == "deleted" {
 			qms = append(qms, qm.WithDeleted())
+			filters.Deleted = true
+
+			continue
+		}
+
+		// owner filters on the owner group instead of a resource field
+		if k == "owner" {
+			owner, err := r.svc().FindGroup(c.Request.Context(), v, false)
+			if err != nil {
+				if errors.Is(err, service.ErrGroupNotFound) {
+					sendErrorWithCode(c, http.StatusBadRequest, ErrCodeGroupNotFound, "owner group not found: "+err.Error())
+					return nil, nil, false
+				}
+
+				sendError(c, http.StatusInternalServerError, "error getting owner group: "+err.Error())
+
+				return nil, nil, false
+			}
+
+			qms = append(qms, models.SystemExtensionResourceWhere.OwnerID.EQ(null.StringFrom(owner.ID)))
+			filters.OwnerID = owner.ID
+
 			continue
 		}
 
-		qms = append(qms, qm.Where("resource->>? = ?", k, v))
+		filters.Fields[k] = v
+	}
+
+	qms = append(qms, dbtools.ExtensionResourceFieldFilters(erd, filters.Fields)...)
+
+	if since != nil {
+		qms = append(qms, dbtools.ChangedSince(models.TableNames.SystemExtensionResources, *since)...)
+	}
+
+	return erd, &extensionResourcesQuery{asOf: asOf, qms: qms, filters: filters}, true
+}
+
+// listSystemExtensionResource lists system extension resources for an ERD
+func (r *Router) listSystemExtensionResources(c *gin.Context) {
+	erd, q, ok := r.systemExtensionResourcesQuery(c)
+	if !ok {
+		return
+	}
+
+	fields, ok := fieldsParam(c, erd)
+	if !ok {
+		return
+	}
+
+	var (
+		ers models.SystemExtensionResourceSlice
+		err error
+	)
+
+	if q.asOf != nil {
+		ers, err = dbtools.SystemExtensionResourcesAsOf(c.Request.Context(), r.DB, erd.ID, *q.asOf, q.filters)
+	} else {
+		ers, err = erd.SystemExtensionResources(q.qms...).All(c.Request.Context(), r.DB)
 	}
 
-	ers, err := erd.SystemExtensionResources(qms...).All(c.Request.Context(), r.DB)
 	if err != nil {
 		sendError(
 			c, http.StatusBadRequest,
@@ -256,9 +275,68 @@ func (r *Router) listSystemExtensionResources(c *gin.Context) {
 		return
 	}
 
+	if fields != nil {
+		for _, er := range ers {
+			if er.Resource, err = projectResource(er.Resource, fields); err != nil {
+				sendError(c, http.StatusInternalServerError, "error projecting extension resources: "+err.Error())
+				return
+			}
+		}
+	}
+
 	c.JSON(http.StatusOK, ers)
 }
 
+// countSystemExtensionResources counts the system extension resources matching
+// the filters of the listing
+func (r *Router) countSystemExtensionResources(c *gin.Context) {
+	erd, q, ok := r.systemExtensionResourcesQuery(c)
+	if !ok {
+		return
+	}
+
+	var (
+		count int64
+		err   error
+	)
+
+	if q.asOf != nil {
+		var ers models.SystemExtensionResourceSlice
+
+		ers, err = dbtools.SystemExtensionResourcesAsOf(c.Request.Context(), r.DB, erd.ID, *q.asOf, q.filters)
+		count = int64(len(ers))
+	} else {
+		count, err = erd.SystemExtensionResources(q.qms...).Count(c.Request.Context(), r.DB)
+	}
+
+	sendExtensionResourcesCount(c, count, err)
+}
+
+// systemExtensionResourcesExist tells whether a system extension resource
+// matches the filters of the listing
+func (r *Router) systemExtensionResourcesExist(c *gin.Context) {
+	erd, q, ok := r.systemExtensionResourcesQuery(c)
+	if !ok {
+		return
+	}
+
+	var (
+		exists bool
+		err    error
+	)
+
+	if q.asOf != nil {
+		var ers models.SystemExtensionResourceSlice
+
+		ers, err = dbtools.SystemExtensionResourcesAsOf(c.Request.Context(), r.DB, erd.ID, *q.asOf, q.filters)
+		exists = len(ers) > 0
+	} else {
+		exists, err = erd.SystemExtensionResources(q.qms...).Exists(c.Request.Context(), r.DB)
+	}
+
+	sendExtensionResourcesExist(c, exists, err)
+}
+
 // getSystemExtensionResource fetches a system extension resources
 func (r *Router) getSystemExtensionResource(c *gin.Context) {
 	extensionSlug := c.Param("ex-slug")
@@ -267,6 +345,11 @@ func (r *Router) getSystemExtensionResource(c *gin.Context) {
 	resourceID := c.Param("resource-id")
 	_, deleted := c.GetQuery("deleted")
 
+	asOf, ok := asOfParam(c)
+	if !ok {
+		return
+	}
+
 	// find ERD
 	_, erd, err := findERDForExtensionResource(
 		c, r.DB,
@@ -274,7 +357,7 @@ func (r *Router) getSystemExtensionResource(c *gin.Context) {
 	)
 	if err != nil {
 		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
-			sendError(c, http.StatusNotFound, err.Error())
+			sendErrorFromErr(c, http.StatusNotFound, err)
 			return
 		}
 
@@ -303,10 +386,17 @@ func (r *Router) getSystemExtensionResource(c *gin.Context) {
 		qms = append(qms, qm.WithDeleted())
 	}
 
-	er, err := erd.SystemExtensionResources(qms...).One(c.Request.Context(), r.DB)
+	var er *models.SystemExtensionResource
+
+	if asOf != nil {
+		er, err = dbtools.SystemExtensionResourceAsOf(c.Request.Context(), r.DB, erd.ID, resourceID, *asOf, deleted)
+	} else {
+		er, err = erd.SystemExtensionResources(qms...).One(c.Request.Context(), r.DB)
+	}
+
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "resource not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeExtensionResourceNotFound, "resource not found: "+err.Error())
 			return
 		}
 
@@ -318,13 +408,24 @@ func (r *Router) getSystemExtensionResource(c *gin.Context) {
 		return
 	}
 
+	// the resources read as of a time don't have a revision
+	if asOf == nil {
+		c.Header("ETag", revisionETag(er.Revision))
+	}
+
 	c.JSON(http.StatusOK, er)
 }
 
-// updateSystemExtensionResource updates a system extension resources
+// updateSystemExtensionResource updates a system extension resources, the
+// JSON Patch and JSON Merge Patch bodies don't require the If-Match revision
 func (r *Router) updateSystemExtensionResource(c *gin.Context) {
 	defer c.Request.Body.Close()
 
+	revision, ok := ifMatchRevision(c, !isExtensionResourcePatch(c))
+	if !ok {
+		return
+	}
+
 	extensionSlug := c.Param("ex-slug")
 	erdSlugPlural := c.Param("erd-slug-plural")
 	erdVersion := c.Param("erd-version")
@@ -343,7 +444,7 @@ func (r *Router) updateSystemExtensionResource(c *gin.Context) {
 	)
 	if err != nil {
 		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
-			sendError(c, http.StatusNotFound, err.Error())
+			sendErrorFromErr(c, http.StatusNotFound, err)
 			return
 		}
 
@@ -372,7 +473,7 @@ func (r *Router) updateSystemExtensionResource(c *gin.Context) {
 	er, err := erd.SystemExtensionResources(qms...).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "resource not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeExtensionResourceNotFound, "resource not found: "+err.Error())
 			return
 		}
 
@@ -384,130 +485,7 @@ func (r *Router) updateSystemExtensionResource(c *gin.Context) {
 		return
 	}
 
-	// schema validator
-	compiler := jsonschema.NewCompiler(
-		extension.Slug, erd.SlugPlural, erd.Version,
-		jsonschema.WithUniqueConstraint(c.Request.Context(), erd, &er.ID, r.DB),
-	)
-
-	schema, err := compiler.Compile(erd.Schema.String())
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "ERD schema is not valid: "+err.Error())
-		return
-	}
-
-	// validate payload
-	var v interface{}
-	if err := json.Unmarshal(requestBody, &v); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
-		return
-	}
-
-	if err := schema.Validate(v); err != nil {
-		sendError(c, http.StatusBadRequest, err.Error())
-		return
-	}
-
-	// update
-	original := *er
-	er.Resource = requestBody
-
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting extension resource update transaction: "+err.Error())
-		return
-	}
-
-	if _, err := er.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := fmt.Sprintf("error updating %s: %s", erd.Name, err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditSystemExtensionResourceUpdated(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		&original,
-		er,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error updating extension resource (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error updating extension resource: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing extension resource update: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		erd.SlugPlural,
-		&events.Event{
-			Version:                       erd.Version,
-			Action:                        events.GovernorEventUpdate,
-			AuditID:                       c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:                       getCtxActorID(c),
-			ExtensionID:                   extension.ID,
-			ExtensionResourceID:           er.ID,
-			ExtensionResourceDefinitionID: erd.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish extension resource update event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
-
-		return
-	}
-
-	resp := &SystemExtensionResource{
-		SystemExtensionResource: er,
-		ERD:                     erd.SlugSingular,
-		Version:                 erd.Version,
-	}
-
-	c.JSON(http.StatusAccepted, resp)
+	r.saveSystemExtensionResource(c, extension, erd, er, extensionResourceUpdate(c, requestBody), revision, dbtools.AuditSystemExtensionResourceUpdated)
 }
 
 // deleteSystemExtensionResource deletes a system extension resources
@@ -524,7 +502,7 @@ func (r *Router) deleteSystemExtensionResource(c *gin.Context) {
 	)
 	if err != nil {
 		if errors.Is(err, ErrExtensionNotFound) || errors.Is(err, ErrERDNotFound) {
-			sendError(c, http.StatusNotFound, err.Error())
+			sendErrorFromErr(c, http.StatusNotFound, err)
 			return
 		}
 
@@ -553,7 +531,7 @@ func (r *Router) deleteSystemExtensionResource(c *gin.Context) {
 	er, err := erd.SystemExtensionResources(qms...).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "resource not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeExtensionResourceNotFound, "resource not found: "+err.Error())
 			return
 		}
 
@@ -566,99 +544,128 @@ func (r *Router) deleteSystemExtensionResource(c *gin.Context) {
 	}
 
 	// delete
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting extension resource delete transaction: "+err.Error())
-		return
-	}
-
-	if _, err := er.Delete(c.Request.Context(), tx, false); err != nil {
-		msg := fmt.Sprintf("error deleting %s: %s", erd.Name, err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := er.Delete(c.Request.Context(), tx, false); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error deleting %s: %s", erd.Name, err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		if err := r.recordChange(c, tx, erd.SlugPlural, &events.Event{
+			Version:                       erd.Version,
+			Action:                        events.GovernorEventDelete,
+			ExtensionID:                   extension.ID,
+			ExtensionResourceID:           er.ID,
+			ExtensionResourceDefinitionID: erd.ID,
+			Before:                        er,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditSystemExtensionResourceDeleted(ctx, exec, pID, actor, er)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error deleting extension resource (audit): %s", err.Error()), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
-	event, err := dbtools.AuditSystemExtensionResourceDeleted(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		er,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error deleting extension resource (audit): %s", err.Error())
+	r.publishChanges(c)
 
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
+	resp := &SystemExtensionResource{
+		SystemExtensionResource: er,
+		ERD:                     erd.SlugSingular,
+		Version:                 erd.Version,
+	}
 
-		sendError(c, http.StatusBadRequest, msg)
+	c.JSON(http.StatusAccepted, resp)
+}
 
+// saveSystemExtensionResource builds the payload of a system extension
+// resource from its locked current one, validates it against the schema of
+// its ERD, saves it and records the audit event and publishes the update event
+func (r *Router) saveSystemExtensionResource(
+	c *gin.Context, extension *models.Extension, erd *models.ExtensionResourceDefinition,
+	er *models.SystemExtensionResource, build extensionResourceBuilder, revision *int64, audit systemExtensionResourceAuditFunc,
+) {
+	// schema validator
+	compiler := jsonschema.NewCompiler(
+		extension.Slug, erd.SlugPlural, erd.Version,
+		jsonschema.WithUniqueConstraint(c.Request.Context(), erd, &er.ID, r.DB),
+	)
+
+	schema, err := compiler.Compile(erd.Schema.String())
+	if err != nil {
+		sendError(c, http.StatusBadRequest, "ERD schema is not valid: "+err.Error())
 		return
 	}
 
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error deleting extension resource: %s", err.Error())
+	var original models.SystemExtensionResource
 
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		// the resource is reloaded, so the update and its events start from the
+		// current state of the resource
+		if err := dbtools.LockSystemExtensionResource(c.Request.Context(), tx, er, revision); err != nil {
+			switch {
+			case errors.Is(err, dbtools.ErrExtensionResourceRevisionConflict):
+				current := er.Revision
+				return abortTxWithResponse(func(c *gin.Context) { sendRevisionConflict(c, current, err) }, err)
+			case errors.Is(err, sql.ErrNoRows):
+				return abortTxWithResponse(func(c *gin.Context) { sendErrorFromErr(c, http.StatusNotFound, ErrExtensionResourceNotFound) }, err)
+			default:
+				return abortTx(http.StatusBadRequest, fmt.Sprintf("error locking extension resource: %s", err.Error()), err)
+			}
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
+		// the payload is built from the locked resource, so the patches apply to
+		// its current state
+		payload, err := build(er.Resource)
+		if err != nil {
+			return abortTxWithResponse(func(c *gin.Context) { sendPatchError(c, err) }, err)
+		}
 
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing extension resource delete: %s", err.Error())
+		// validate payload
+		var v interface{}
+		if err := json.Unmarshal(payload, &v); err != nil {
+			return abortTx(http.StatusBadRequest, "unable to bind request: "+err.Error(), err)
+		}
 
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+		if err := schema.Validate(v); err != nil {
+			return abortTx(http.StatusBadRequest, err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		// update
+		original = *er
+		er.Resource = payload
 
-		return
-	}
+		if _, err := er.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error updating %s: %s", erd.Name, err.Error()), err)
+		}
 
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		erd.SlugPlural,
-		&events.Event{
+		if err := r.recordChange(c, tx, erd.SlugPlural, &events.Event{
 			Version:                       erd.Version,
-			Action:                        events.GovernorEventDelete,
-			AuditID:                       c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:                       getCtxActorID(c),
+			Action:                        events.GovernorEventUpdate,
 			ExtensionID:                   extension.ID,
 			ExtensionResourceID:           er.ID,
 			ExtensionResourceDefinitionID: erd.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish extension resource delete event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
+			Before:                        &original,
+			After:                         er,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return audit(ctx, exec, pID, actor, &original, er)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error updating extension resource (audit): %s", err.Error()), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
+	r.publishChanges(c)
+
 	resp := &SystemExtensionResource{
 		SystemExtensionResource: er,
 		ERD:                     erd.SlugSingular,
 		Version:                 erd.Version,
 	}
 
+	c.Header("ETag", revisionETag(er.Revision))
 	c.JSON(http.StatusAccepted, resp)
 }
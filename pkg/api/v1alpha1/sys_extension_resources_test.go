@@ -20,6 +20,7 @@ import (
 	"github.com/metal-toolbox/auditevent/ginaudit"
 	dbm "github.com/metal-toolbox/governor-api/db"
 	"github.com/metal-toolbox/governor-api/internal/eventbus"
+	"github.com/metal-toolbox/governor-api/internal/models"
 	"github.com/pressly/goose/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -65,6 +66,18 @@ func (s *SystemExtensionResourceTestSuite) seedTestDB() error {
 		`,
 		`INSERT INTO system_extension_resources (id, resource, extension_resource_definition_id)
 		VALUES ( '00000001-0000-0000-0000-000000000009', '{"age": 10, "firstName": "Hello", "lastName": "World"}'::jsonb, '00000001-0000-0000-0000-000000000008');`,
+		`INSERT INTO groups (id, name, slug, description, note, created_at, updated_at)
+		VALUES ('00000001-0000-0000-0000-000000000010', 'Test Owners', 'test-owners', 'owners of test resources', 'some note', now(), now());`,
+		`
+		INSERT INTO extension_resource_definitions (id, name, description, enabled, slug_singular, slug_plural, version, scope, schema, extension_id) 
+		VALUES ('00000001-0000-0000-0000-000000000012', 'Owned Resource', 'some-description', true, 'owned-resource', 'owned-resources', 'v1', 'system',
+		'{"$id": "v1.person.test-ex-1","$schema": "https://json-schema.org/draft/2020-12/schema","title": "Person","type": "object","required": ["firstName", "lastName"],"properties": {"firstName": {"type": "string","description": "The person''s first name."},"lastName": {"type": "string","description": "The person''s last name."}}}'::jsonb,
+		'00000001-0000-0000-0000-000000000001');
+		`,
+		`INSERT INTO system_extension_resources (id, resource, extension_resource_definition_id, owner_id)
+		VALUES ( '00000001-0000-0000-0000-000000000011', '{"firstName": "Hello3", "lastName": "World3"}'::jsonb, '00000001-0000-0000-0000-000000000012', '00000001-0000-0000-0000-000000000010');`,
+		`INSERT INTO system_extension_resources (id, resource, extension_resource_definition_id)
+		VALUES ( '00000001-0000-0000-0000-000000000013', '{"firstName": "Hello4", "lastName": "World4"}'::jsonb, '00000001-0000-0000-0000-000000000012');`,
 	}
 
 	for _, q := range testData {
@@ -193,6 +206,8 @@ func (s *SystemExtensionResourceTestSuite) TestListSystemExtensionResources() {
 		expectedErrMsg string
 		expectedCount  int
 		params         gin.Params
+		// expectedFields are the fields of the projected resources
+		expectedFields []string
 	}{
 		{
 			name:           "ok",
@@ -293,6 +308,39 @@ func (s *SystemExtensionResourceTestSuite) TestListSystemExtensionResources() {
 				gin.Param{Key: "erd-version", Value: "v1"},
 			},
 		},
+		{
+			name:           "owner query",
+			url:            "/api/v1alpha1/extension-resources/test-extension/owned-resources/v1?owner=test-owners",
+			expectedStatus: http.StatusOK,
+			expectedCount:  1,
+			params: gin.Params{
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "erd-slug-plural", Value: "owned-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+			},
+		},
+		{
+			name:           "owner query by id",
+			url:            "/api/v1alpha1/extension-resources/test-extension/owned-resources/v1?owner=00000001-0000-0000-0000-000000000010",
+			expectedStatus: http.StatusOK,
+			expectedCount:  1,
+			params: gin.Params{
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "erd-slug-plural", Value: "owned-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+			},
+		},
+		{
+			name:           "owner query group not found",
+			url:            "/api/v1alpha1/extension-resources/test-extension/owned-resources/v1?owner=nonexistent-group",
+			expectedStatus: http.StatusBadRequest,
+			expectedErrMsg: "owner group not found",
+			params: gin.Params{
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "erd-slug-plural", Value: "owned-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+			},
+		},
 		{
 			name:           "string URI queries w/o results",
 			url:            `/api/v1alpha1/extension-resources/test-extension/test-resources/v1?firstName=World`,
@@ -304,6 +352,41 @@ func (s *SystemExtensionResourceTestSuite) TestListSystemExtensionResources() {
 				gin.Param{Key: "erd-version", Value: "v1"},
 			},
 		},
+		{
+			name:           "fields projection",
+			url:            "/api/v1alpha1/extension-resources/test-extension/test-resources/v1?fields=age,firstName",
+			expectedStatus: http.StatusOK,
+			expectedCount:  3,
+			expectedFields: []string{"age", "firstName"},
+			params: gin.Params{
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "erd-slug-plural", Value: "test-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+			},
+		},
+		{
+			name:           "fields projection with filter",
+			url:            "/api/v1alpha1/extension-resources/test-extension/test-resources/v1?age=10&fields=lastName",
+			expectedStatus: http.StatusOK,
+			expectedCount:  1,
+			expectedFields: []string{"lastName"},
+			params: gin.Params{
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "erd-slug-plural", Value: "test-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+			},
+		},
+		{
+			name:           "fields projection on unknown property",
+			url:            "/api/v1alpha1/extension-resources/test-extension/test-resources/v1?fields=age,nickname",
+			expectedStatus: http.StatusBadRequest,
+			expectedErrMsg: "nickname is not a property of",
+			params: gin.Params{
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "erd-slug-plural", Value: "test-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -338,6 +421,23 @@ func (s *SystemExtensionResourceTestSuite) TestListSystemExtensionResources() {
 
 			assert.Nil(t, err, "expecting unmarshal err to be nil")
 			assert.Equal(t, tt.expectedCount, len(resp))
+
+			if tt.expectedFields != nil {
+				ers := []*models.SystemExtensionResource{}
+				assert.Nil(t, json.Unmarshal([]byte(body), &ers))
+
+				for _, er := range ers {
+					projected := map[string]interface{}{}
+					assert.Nil(t, json.Unmarshal(er.Resource, &projected))
+
+					fields := []string{}
+					for k := range projected {
+						fields = append(fields, k)
+					}
+
+					assert.ElementsMatch(t, tt.expectedFields, fields)
+				}
+			}
 		})
 	}
 }
@@ -632,7 +732,39 @@ func (s *SystemExtensionResourceTestSuite) TestUpdateSystemExtensionResource() {
 		expectedEventSubject string
 		expectedEventPayload *events.Event
 		params               gin.Params
+		// ifMatch is the current revision of the resource when it is empty
+		ifMatch        string
+		withoutIfMatch bool
+		contentType    string
 	}{
+		{
+			name:           "stale revision",
+			url:            "/api/v1alpha1/extension-resources/test-extension/test-resources/v1/00000001-0000-0000-0000-000000000004",
+			payload:        `{ "age": 10, "firstName": "Hello", "lastName": "World" }`,
+			ifMatch:        `"100"`,
+			expectedStatus: http.StatusConflict,
+			expectedErrMsg: "extension_resource_revision_conflict",
+			params: gin.Params{
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "erd-slug-plural", Value: "test-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+				gin.Param{Key: "resource-id", Value: "00000001-0000-0000-0000-000000000004"},
+			},
+		},
+		{
+			name:           "missing revision",
+			url:            "/api/v1alpha1/extension-resources/test-extension/test-resources/v1/00000001-0000-0000-0000-000000000004",
+			payload:        `{ "age": 10, "firstName": "Hello", "lastName": "World" }`,
+			withoutIfMatch: true,
+			expectedStatus: http.StatusPreconditionRequired,
+			expectedErrMsg: "extension_resource_revision_required",
+			params: gin.Params{
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "erd-slug-plural", Value: "test-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+				gin.Param{Key: "resource-id", Value: "00000001-0000-0000-0000-000000000004"},
+			},
+		},
 		{
 			name:                 "ok",
 			url:                  "/api/v1alpha1/extension-resources/test-extension/test-resources/v1/00000001-0000-0000-0000-000000000004",
@@ -678,6 +810,92 @@ func (s *SystemExtensionResourceTestSuite) TestUpdateSystemExtensionResource() {
 				gin.Param{Key: "resource-id", Value: "00000001-0000-0000-0000-000000000004"},
 			},
 		},
+		{
+			name:                 "json patch without revision",
+			url:                  "/api/v1alpha1/extension-resources/test-extension/test-resources/v1/00000001-0000-0000-0000-000000000004",
+			payload:              `[{"op": "test", "path": "/firstName", "value": "Hello"}, {"op": "replace", "path": "/age", "value": 11}]`,
+			contentType:          "application/json-patch+json",
+			withoutIfMatch:       true,
+			expectedStatus:       http.StatusAccepted,
+			expectedEventSubject: "events.test-resources",
+			expectedEventPayload: &events.Event{
+				Version:                       "v1",
+				Action:                        events.GovernorEventUpdate,
+				ExtensionID:                   "00000001-0000-0000-0000-000000000001",
+				ExtensionResourceDefinitionID: "00000001-0000-0000-0000-000000000002",
+			},
+			params: gin.Params{
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "erd-slug-plural", Value: "test-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+				gin.Param{Key: "resource-id", Value: "00000001-0000-0000-0000-000000000004"},
+			},
+		},
+		{
+			name:                 "merge patch",
+			url:                  "/api/v1alpha1/extension-resources/test-extension/test-resources/v1/00000001-0000-0000-0000-000000000004",
+			payload:              `{"age": 12}`,
+			contentType:          "application/merge-patch+json",
+			expectedStatus:       http.StatusAccepted,
+			expectedEventSubject: "events.test-resources",
+			expectedEventPayload: &events.Event{
+				Version:                       "v1",
+				Action:                        events.GovernorEventUpdate,
+				ExtensionID:                   "00000001-0000-0000-0000-000000000001",
+				ExtensionResourceDefinitionID: "00000001-0000-0000-0000-000000000002",
+			},
+			params: gin.Params{
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "erd-slug-plural", Value: "test-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+				gin.Param{Key: "resource-id", Value: "00000001-0000-0000-0000-000000000004"},
+			},
+		},
+		{
+			name:           "json patch test failed",
+			url:            "/api/v1alpha1/extension-resources/test-extension/test-resources/v1/00000001-0000-0000-0000-000000000004",
+			payload:        `[{"op": "test", "path": "/age", "value": 1}, {"op": "replace", "path": "/age", "value": 13}]`,
+			contentType:    "application/json-patch+json",
+			withoutIfMatch: true,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedErrMsg: "extension_resource_patch_failed",
+			params: gin.Params{
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "erd-slug-plural", Value: "test-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+				gin.Param{Key: "resource-id", Value: "00000001-0000-0000-0000-000000000004"},
+			},
+		},
+		{
+			name:           "invalid json patch",
+			url:            "/api/v1alpha1/extension-resources/test-extension/test-resources/v1/00000001-0000-0000-0000-000000000004",
+			payload:        `[{"op": "increment", "path": "/age"}]`,
+			contentType:    "application/json-patch+json",
+			withoutIfMatch: true,
+			expectedStatus: http.StatusBadRequest,
+			expectedErrMsg: "invalid extension resource patch",
+			params: gin.Params{
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "erd-slug-plural", Value: "test-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+				gin.Param{Key: "resource-id", Value: "00000001-0000-0000-0000-000000000004"},
+			},
+		},
+		{
+			name:           "merge patch schema validation failed",
+			url:            "/api/v1alpha1/extension-resources/test-extension/test-resources/v1/00000001-0000-0000-0000-000000000004",
+			payload:        `{"age": -1}`,
+			contentType:    "application/merge-patch+json",
+			withoutIfMatch: true,
+			expectedStatus: http.StatusBadRequest,
+			expectedErrMsg: "'/age' does not validate with",
+			params: gin.Params{
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "erd-slug-plural", Value: "test-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+				gin.Param{Key: "resource-id", Value: "00000001-0000-0000-0000-000000000004"},
+			},
+		},
 		{
 			name:           "extension not found",
 			url:            "/api/v1alpha1/extension-resources/nonexistent-extension/test-resources/v1/00000001-0000-0000-0000-000000000004",
@@ -726,6 +944,24 @@ func (s *SystemExtensionResourceTestSuite) TestUpdateSystemExtensionResource() {
 			req, _ := http.NewRequest("PATCH", tt.url, nil)
 			req = req.WithContext(context.Background())
 			req.Body = io.NopCloser(bytes.NewBufferString(tt.payload))
+
+			if !tt.withoutIfMatch {
+				ifMatch := tt.ifMatch
+				if ifMatch == "" {
+					ifMatch = `"1"`
+
+					if er, err := models.FindSystemExtensionResource(context.Background(), s.db, tt.params.ByName("resource-id")); err == nil {
+						ifMatch = revisionETag(er.Revision)
+					}
+				}
+
+				req.Header.Set("If-Match", ifMatch)
+			}
+
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
 			c.Request = req
 			c.Params = tt.params
 			c.Set(ginaudit.AuditIDContextKey, auditID)
@@ -934,6 +1170,100 @@ func (s *SystemExtensionResourceTestSuite) TestDeleteSystemExtensionResource() {
 	}
 }
 
+func (s *SystemExtensionResourceTestSuite) TestTransferSystemExtensionResourceOwner() {
+	r := s.v1alpha1()
+
+	params := func(erdSlugPlural, resourceID string) gin.Params {
+		return gin.Params{
+			gin.Param{Key: "ex-slug", Value: "test-extension"},
+			gin.Param{Key: "erd-slug-plural", Value: erdSlugPlural},
+			gin.Param{Key: "erd-version", Value: "v1"},
+			gin.Param{Key: "resource-id", Value: resourceID},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		payload        string
+		expectedStatus int
+		expectedErrMsg string
+		params         gin.Params
+	}{
+		{
+			name:           "ok by id",
+			payload:        `{"owner_id": "00000001-0000-0000-0000-000000000010"}`,
+			expectedStatus: http.StatusAccepted,
+			params:         params("owned-resources", "00000001-0000-0000-0000-000000000013"),
+		},
+		{
+			name:           "ok by slug",
+			payload:        `{"owner_id": "test-owners"}`,
+			expectedStatus: http.StatusAccepted,
+			params:         params("owned-resources", "00000001-0000-0000-0000-000000000013"),
+		},
+		{
+			name:           "missing owner",
+			payload:        `{}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErrMsg: "owner_id",
+			params:         params("owned-resources", "00000001-0000-0000-0000-000000000013"),
+		},
+		{
+			name:           "owner group not found",
+			payload:        `{"owner_id": "nonexistent-group"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErrMsg: "owner group not found",
+			params:         params("owned-resources", "00000001-0000-0000-0000-000000000013"),
+		},
+		{
+			name:           "resource not found",
+			payload:        `{"owner_id": "test-owners"}`,
+			expectedStatus: http.StatusNotFound,
+			expectedErrMsg: "resource not found",
+			params:         params("owned-resources", "00000001-0000-0000-0000-000000000001"),
+		},
+		{
+			name:           "incorrect ERD scope",
+			payload:        `{"owner_id": "test-owners"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErrMsg: "cannot transfer system resource for user scoped user-resource/v1",
+			params:         params("user-resources", "00000001-0000-0000-0000-000000000013"),
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			req, _ := http.NewRequest("PATCH", "/api/v1alpha1/extension-resources/owner", nil)
+			req = req.WithContext(context.Background())
+			req.Body = io.NopCloser(bytes.NewBufferString(tt.payload))
+			c.Request = req
+			c.Params = tt.params
+			c.Set(ginaudit.AuditIDContextKey, uuid.New().String())
+
+			r.transferSystemExtensionResourceOwner(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code, "Expected status %d, got %d", tt.expectedStatus, w.Code)
+
+			if tt.expectedErrMsg != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedErrMsg)
+				return
+			}
+
+			sr := &SystemExtensionResource{}
+			assert.Nil(t, json.Unmarshal(w.Body.Bytes(), sr))
+			assert.Equal(t, "00000001-0000-0000-0000-000000000010", sr.OwnerID.String)
+
+			event := &events.Event{}
+			assert.Nil(t, json.Unmarshal(s.conn.Payload, event))
+			assert.Equal(t, events.GovernorEventUpdate, event.Action)
+			assert.Equal(t, "00000001-0000-0000-0000-000000000010", event.GroupID)
+		})
+	}
+}
+
 func TestSystemExtensionResourceSuite(t *testing.T) {
 	suite.Run(t, new(SystemExtensionResourceTestSuite))
 }
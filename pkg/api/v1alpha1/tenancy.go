@@ -0,0 +1,44 @@
+package v1alpha1
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+)
+
+// mwTenancy scopes the request to the organization in the org claim of its
+// bearer token when tenancy is enabled. Requests without a token are left to
+// the auth middleware, tokens without the claim are rejected unless their
+// subject is global. Extension tokens aren't scoped.
+func (r *Router) mwTenancy(c *gin.Context) {
+	if r.Tenancy == nil || getCtxExtensionCredential(c) != nil {
+		return
+	}
+
+	authzHeader := strings.Split(c.Request.Header.Get("Authorization"), " ")
+	if len(authzHeader) != expectedAuthzHeaderParts {
+		return
+	}
+
+	orgID, err := r.Tenancy.Resolve(c.Request.Context(), authzHeader[expectedAuthzHeaderParts-1])
+	if err != nil {
+		if errors.Is(err, tenancy.ErrTenantRequired) || errors.Is(err, tenancy.ErrUnknownTenant) {
+			sendErrorFromErr(c, http.StatusForbidden, err)
+			return
+		}
+
+		r.Logger.Error("error resolving request organization", zap.Error(err))
+		sendError(c, http.StatusInternalServerError, "error resolving request organization: "+err.Error())
+
+		return
+	}
+
+	if orgID != "" {
+		c.Request = c.Request.WithContext(tenancy.WithTenant(c.Request.Context(), orgID))
+	}
+}
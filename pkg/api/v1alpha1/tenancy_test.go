@@ -0,0 +1,214 @@
+package v1alpha1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/cockroach-go/v2/testserver"
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	dbm "github.com/metal-toolbox/governor-api/db"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+)
+
+const (
+	testTenantA = "00000009-0000-0000-0000-00000000000a"
+	testTenantB = "00000009-0000-0000-0000-00000000000b"
+)
+
+type TenancyTestSuite struct {
+	suite.Suite
+
+	db *sql.DB
+
+	v1alpha1 *Router
+
+	// the tenant of the seeded groups and users by id
+	tenants map[string]string
+}
+
+func (s *TenancyTestSuite) seedTestDB() error {
+	testData := []string{
+		// organizations
+		`INSERT INTO organizations (id, name, slug) VALUES
+		('00000009-0000-0000-0000-00000000000a', 'Tenant A', 'tenant-a'),
+		('00000009-0000-0000-0000-00000000000b', 'Tenant B', 'tenant-b');`,
+
+		// groups
+		`INSERT INTO groups (id, name, slug, description, note, tenant_id, created_at, updated_at) VALUES
+		('00000002-0000-0000-0000-0000000000a1', 'Group A1', 'group-a1', 'group-a1', 'some note', '00000009-0000-0000-0000-00000000000a', now(), now()),
+		('00000002-0000-0000-0000-0000000000a2', 'Group A2', 'group-a2', 'group-a2', 'some note', '00000009-0000-0000-0000-00000000000a', now(), now()),
+		('00000002-0000-0000-0000-0000000000b1', 'Group B1', 'group-b1', 'group-b1', 'some note', '00000009-0000-0000-0000-00000000000b', now(), now()),
+		('00000002-0000-0000-0000-0000000000b2', 'Group B2', 'group-b2', 'group-b2', 'some note', '00000009-0000-0000-0000-00000000000b', now(), now());`,
+
+		// users
+		`INSERT INTO "users" ("id", "name", "email", "login_count", "tenant_id", "created_at", "updated_at", "status") VALUES
+		('00000003-0000-0000-0000-0000000000a1', 'User A', 'usera@email.com', 0, '00000009-0000-0000-0000-00000000000a', now(), now(), 'active'),
+		('00000003-0000-0000-0000-0000000000b1', 'User B', 'userb@email.com', 0, '00000009-0000-0000-0000-00000000000b', now(), now(), 'active');`,
+
+		// group members, the user of tenant B is also a member of a group of
+		// tenant A
+		`INSERT INTO group_memberships (id, group_id, user_id, created_at, updated_at, expires_at) VALUES
+		('00000004-0000-0000-0000-0000000000a1', '00000002-0000-0000-0000-0000000000a2', '00000003-0000-0000-0000-0000000000a1', now(), now(), NULL),
+		('00000004-0000-0000-0000-0000000000a2', '00000002-0000-0000-0000-0000000000a1', '00000003-0000-0000-0000-0000000000a1', now(), now(), now() - INTERVAL '1 day'),
+		('00000004-0000-0000-0000-0000000000b1', '00000002-0000-0000-0000-0000000000b2', '00000003-0000-0000-0000-0000000000b1', now(), now(), NULL),
+		('00000004-0000-0000-0000-0000000000b2', '00000002-0000-0000-0000-0000000000b1', '00000003-0000-0000-0000-0000000000b1', now(), now(), now() - INTERVAL '1 day'),
+		('00000004-0000-0000-0000-0000000000c1', '00000002-0000-0000-0000-0000000000a2', '00000003-0000-0000-0000-0000000000b1', now(), now(), now() - INTERVAL '1 day');`,
+
+		// group hierarchies, a group of tenant B is also a member of a group
+		// of tenant A
+		`INSERT INTO group_hierarchies (id, parent_group_id, member_group_id, created_at, updated_at) VALUES
+		('00000005-0000-0000-0000-0000000000a1', '00000002-0000-0000-0000-0000000000a1', '00000002-0000-0000-0000-0000000000a2', now(), now()),
+		('00000005-0000-0000-0000-0000000000b1', '00000002-0000-0000-0000-0000000000b1', '00000002-0000-0000-0000-0000000000b2', now(), now()),
+		('00000005-0000-0000-0000-0000000000c1', '00000002-0000-0000-0000-0000000000a1', '00000002-0000-0000-0000-0000000000b1', now(), now());`,
+
+		// group membership requests, the user of tenant B also requested to
+		// join a group of tenant A
+		`INSERT INTO group_membership_requests (id, group_id, user_id, created_at, updated_at, is_admin, note, expires_at) VALUES
+		('00000006-0000-0000-0000-0000000000a1', '00000002-0000-0000-0000-0000000000a1', '00000003-0000-0000-0000-0000000000a1', now(), now(), false, 'some note', now() - INTERVAL '1 day'),
+		('00000006-0000-0000-0000-0000000000b1', '00000002-0000-0000-0000-0000000000b1', '00000003-0000-0000-0000-0000000000b1', now(), now(), false, 'some note', now() - INTERVAL '1 day'),
+		('00000006-0000-0000-0000-0000000000c1', '00000002-0000-0000-0000-0000000000a1', '00000003-0000-0000-0000-0000000000b1', now(), now(), false, 'some note', now() - INTERVAL '1 day');`,
+	}
+
+	for _, q := range testData {
+		_, err := s.db.Query(q)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *TenancyTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+
+	tenancy.RegisterHooks()
+
+	ts, err := testserver.NewTestServer()
+	if err != nil {
+		panic(err)
+	}
+
+	s.db, err = sql.Open("postgres", ts.PGURL().String())
+	if err != nil {
+		panic(err)
+	}
+
+	goose.SetBaseFS(dbm.Migrations)
+
+	if err := goose.Up(s.db, "migrations"); err != nil {
+		panic("migration failed - could not set up test db")
+	}
+
+	if err := s.seedTestDB(); err != nil {
+		panic("db setup failed - could not seed test db: " + err.Error())
+	}
+
+	s.tenants = map[string]string{
+		"00000002-0000-0000-0000-0000000000a1": testTenantA,
+		"00000002-0000-0000-0000-0000000000a2": testTenantA,
+		"00000002-0000-0000-0000-0000000000b1": testTenantB,
+		"00000002-0000-0000-0000-0000000000b2": testTenantB,
+		"00000003-0000-0000-0000-0000000000a1": testTenantA,
+		"00000003-0000-0000-0000-0000000000b1": testTenantB,
+	}
+
+	s.v1alpha1 = &Router{
+		AdminGroups: []string{"governor-admin"},
+		DB:          sqlx.NewDb(s.db, "postgres"),
+		Logger:      zap.NewNop(),
+	}
+}
+
+// list calls the listing handler in the organization and returns the
+// response code and body
+func (s *TenancyTestSuite) list(tenantID, path string, h gin.HandlerFunc) (int, []byte) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	c.Request = httptest.NewRequest(http.MethodGet, path, nil).
+		WithContext(tenancy.WithTenant(context.Background(), tenantID))
+
+	h(c)
+
+	return w.Code, w.Body.Bytes()
+}
+
+func (s *TenancyTestSuite) TestGroupRequestsAll() {
+	for _, tenantID := range []string{testTenantA, testTenantB} {
+		code, body := s.list(tenantID, "/api/v1alpha1/groups/requests", s.v1alpha1.getGroupRequestsAll)
+		s.Require().Equal(http.StatusOK, code, string(body))
+
+		requests := []GroupMemberRequest{}
+		s.Require().NoError(json.Unmarshal(body, &requests))
+
+		// only the request of the user and group of the organization
+		if s.Assert().Len(requests, 1) {
+			s.Assert().Equal(tenantID, s.tenants[requests[0].GroupID])
+			s.Assert().Equal(tenantID, s.tenants[requests[0].UserID])
+		}
+
+		code, body = s.list(tenantID, "/api/v1alpha1/groups/requests?expired", s.v1alpha1.getGroupRequestsAll)
+		s.Require().Equal(http.StatusOK, code, string(body))
+
+		s.Require().NoError(json.Unmarshal(body, &requests))
+		s.Assert().Len(requests, 1)
+	}
+}
+
+func (s *TenancyTestSuite) TestGroupHierarchiesAll() {
+	for _, tenantID := range []string{testTenantA, testTenantB} {
+		code, body := s.list(tenantID, "/api/v1alpha1/groups/hierarchies", s.v1alpha1.getGroupHierarchiesAll)
+		s.Require().Equal(http.StatusOK, code, string(body))
+
+		hierarchies := []GroupHierarchy{}
+		s.Require().NoError(json.Unmarshal(body, &hierarchies))
+
+		// the hierarchy across the organizations isn't listed
+		if s.Assert().Len(hierarchies, 1) {
+			s.Assert().Equal(tenantID, s.tenants[hierarchies[0].ParentGroupID])
+			s.Assert().Equal(tenantID, s.tenants[hierarchies[0].MemberGroupID])
+		}
+	}
+}
+
+func (s *TenancyTestSuite) TestGroupMembershipsAll() {
+	for _, tenantID := range []string{testTenantA, testTenantB} {
+		code, body := s.list(tenantID, "/api/v1alpha1/groups/memberships", s.v1alpha1.getGroupMembershipsAll)
+		s.Require().Equal(http.StatusOK, code, string(body))
+
+		memberships := []GroupMembership{}
+		s.Require().NoError(json.Unmarshal(body, &memberships))
+
+		s.Assert().NotEmpty(memberships)
+
+		for _, m := range memberships {
+			s.Assert().Equal(tenantID, s.tenants[m.GroupID])
+			s.Assert().Equal(tenantID, s.tenants[m.UserID])
+		}
+
+		code, body = s.list(tenantID, "/api/v1alpha1/groups/memberships?expired", s.v1alpha1.getGroupMembershipsAll)
+		s.Require().Equal(http.StatusOK, code, string(body))
+
+		s.Require().NoError(json.Unmarshal(body, &memberships))
+
+		// only the expired membership of the user and group of the organization
+		if s.Assert().Len(memberships, 1) {
+			s.Assert().Equal(tenantID, s.tenants[memberships[0].GroupID])
+			s.Assert().Equal(tenantID, s.tenants[memberships[0].UserID])
+		}
+	}
+}
+
+func TestTenancyTestSuite(t *testing.T) {
+	suite.Run(t, new(TenancyTestSuite))
+}
@@ -0,0 +1,109 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+)
+
+// txError is an error returned by a handler transaction, sent with its
+// status and error code once the transaction is rolled back
+type txError struct {
+	status int
+	code   ErrorCode
+	msg    string
+	err    error
+
+	// send sends the error response instead of the status and message
+	send func(c *gin.Context)
+}
+
+func (e *txError) Error() string { return e.msg }
+
+func (e *txError) Unwrap() error { return e.err }
+
+// abortTx returns the error rolling back a handler transaction, the error
+// response is sent with the status and message
+func abortTx(status int, msg string, err error) error {
+	return &txError{status: status, msg: msg, err: err}
+}
+
+// abortTxWithCode returns the error rolling back a handler transaction, the
+// error response is sent with the status, error code and message
+func abortTxWithCode(status int, code ErrorCode, msg string, err error) error {
+	return &txError{status: status, code: code, msg: msg, err: err}
+}
+
+// abortTxWithResponse returns the error rolling back a handler transaction,
+// the error response is sent by send, for the responses carrying more than a
+// status and message
+func abortTxWithResponse(send func(c *gin.Context), err error) error {
+	return &txError{msg: err.Error(), err: err, send: send}
+}
+
+// withTx runs fn in a transaction of the governor database and sends the
+// error response when the transaction fails, with the status of the error
+// returned by fn. Other errors are sent like the service errors, with a 400
+// when they aren't known. It returns whether the transaction was committed,
+// the changes recorded in a failed transaction are discarded.
+func (r *Router) withTx(c *gin.Context, fn func(tx *sql.Tx) error) bool {
+	err := dbtools.WithTransaction(c.Request.Context(), r.DB, fn)
+	if err == nil {
+		return true
+	}
+
+	discardChanges(c)
+	sendTxError(c, err)
+
+	return false
+}
+
+// withRollbackTx runs fn in a transaction of the governor database that is
+// always rolled back, for the handlers computing the effect of a change
+// without making it. The error response is sent like withTx when fn fails, it
+// returns whether fn succeeded.
+func (r *Router) withRollbackTx(c *gin.Context, fn func(tx *sql.Tx) error) bool {
+	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error starting transaction: "+err.Error())
+		return false
+	}
+
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: error rolling back transaction: %s", err, rerr.Error())
+		}
+
+		sendTxError(c, err)
+
+		return false
+	}
+
+	if err := tx.Rollback(); err != nil {
+		sendError(c, http.StatusInternalServerError, "error rolling back transaction: "+err.Error())
+		return false
+	}
+
+	return true
+}
+
+// sendTxError sends the error response of a failed handler transaction
+func sendTxError(c *gin.Context, err error) {
+	var terr *txError
+
+	switch {
+	case !errors.As(err, &terr):
+		sendServiceError(c, http.StatusBadRequest, err)
+	case terr.send != nil:
+		terr.send(c)
+	case terr.code != "":
+		sendErrorWithCode(c, terr.status, terr.code, err.Error())
+	default:
+		sendError(c, terr.status, err.Error())
+	}
+}
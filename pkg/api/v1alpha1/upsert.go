@@ -0,0 +1,330 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gosimple/slug"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// UpsertResponse is returned by the create-or-update endpoints. ID is stable
+// across calls for the same slug, Created is true when the resource didn't
+// exist and Changed is true when anything was written.
+type UpsertResponse struct {
+	ID       string          `json:"id"`
+	Created  bool            `json:"created"`
+	Changed  bool            `json:"changed"`
+	Resource json.RawMessage `json:"resource"`
+}
+
+// upsertResponseWriter holds back the response of the create or update
+// handler so it can be wrapped in an UpsertResponse
+type upsertResponseWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *upsertResponseWriter) WriteHeader(code int) { w.status = code }
+
+func (w *upsertResponseWriter) WriteHeaderNow() {}
+
+func (w *upsertResponseWriter) Write(b []byte) (int, error) { return w.body.Write(b) }
+
+func (w *upsertResponseWriter) WriteString(s string) (int, error) { return w.body.WriteString(s) }
+
+func (w *upsertResponseWriter) Status() int { return w.status }
+
+func (w *upsertResponseWriter) Size() int { return w.body.Len() }
+
+func (w *upsertResponseWriter) Written() bool { return w.status != 0 }
+
+// delegateUpsert runs the create or update handler with body as the request
+// body and responds with its result wrapped in an UpsertResponse. Error
+// responses from the handler are passed through unchanged.
+func delegateUpsert(c *gin.Context, handler gin.HandlerFunc, body any, created bool) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		sendError(c, http.StatusBadRequest, "error encoding request: "+err.Error())
+		return
+	}
+
+	c.Request.Body = io.NopCloser(bytes.NewReader(b))
+
+	orig := c.Writer
+	w := &upsertResponseWriter{ResponseWriter: orig, status: http.StatusOK}
+	c.Writer = w
+
+	handler(c)
+
+	c.Writer = orig
+
+	if c.IsAborted() || w.status >= http.StatusMultipleChoices {
+		c.Data(w.status, "application/json; charset=utf-8", w.body.Bytes())
+		return
+	}
+
+	resource := struct {
+		ID string `json:"id"`
+	}{}
+
+	if err := json.Unmarshal(w.body.Bytes(), &resource); err != nil {
+		sendError(c, http.StatusInternalServerError, "error decoding response: "+err.Error())
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+
+	c.JSON(status, UpsertResponse{
+		ID:       resource.ID,
+		Created:  created,
+		Changed:  true,
+		Resource: w.body.Bytes(),
+	})
+}
+
+// sendUpsertUnchanged responds with the existing resource when there is nothing to update
+func sendUpsertUnchanged(c *gin.Context, id string, resource any) {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error encoding response: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, UpsertResponse{
+		ID:       id,
+		Resource: b,
+	})
+}
+
+// checkUpsertSlug verifies that the name in the request results in the slug of the path
+func checkUpsertSlug(c *gin.Context, name string) bool {
+	if s := c.Param("slug"); slug.Make(name) != s {
+		sendError(c, http.StatusBadRequest, "name "+name+" does not match slug "+s)
+		return false
+	}
+
+	return true
+}
+
+// upsertGroup creates the group with the slug in the path, or updates it when it exists
+func (r *Router) upsertGroup(c *gin.Context) {
+	req := GroupReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	group, err := models.Groups(qm.Where("slug = ?", c.Param("slug"))).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			sendError(c, http.StatusInternalServerError, "error getting group: "+err.Error())
+			return
+		}
+
+		if !checkUpsertSlug(c, req.Name) {
+			return
+		}
+
+		delegateUpsert(c, r.createGroup, req, true)
+
+		return
+	}
+
+	updated := *group
+	setGroupMetadata(&updated, &req)
+
+	if group.Description == req.Description && group.ApproverGroup.String == req.ApproverGroupID &&
+		updated.OwnerContact == group.OwnerContact && updated.DocsURL == group.DocsURL &&
+		updated.SlackChannel == group.SlackChannel && updated.CostCenter == group.CostCenter &&
+		updated.DefaultMembershipTTLDays == group.DefaultMembershipTTLDays &&
+		updated.MemberSoftLimit == group.MemberSoftLimit && updated.MemberHardLimit == group.MemberHardLimit &&
+		updated.JustificationMinLength == group.JustificationMinLength {
+		sendUpsertUnchanged(c, group.ID, group)
+		return
+	}
+
+	c.AddParam("id", group.ID)
+	delegateUpsert(c, r.updateGroup, req, false)
+}
+
+// upsertApplication creates the application with the slug in the path and the
+// type in the request, or updates it when it exists
+func (r *Router) upsertApplication(c *gin.Context) {
+	req := ApplicationReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	if !validateFields(c, fieldRule{"type_id", req.TypeID, "required,uuid"}) {
+		return
+	}
+
+	// an omitted approver clears it, the same way as the update endpoint
+	if req.ApproverGroupID == nil {
+		req.ApproverGroupID = new(string)
+	}
+
+	app, err := models.Applications(
+		qm.Where("slug = ?", c.Param("slug")),
+		qm.Where("type_id = ?", req.TypeID),
+	).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			sendError(c, http.StatusInternalServerError, "error getting application: "+err.Error())
+			return
+		}
+
+		if !checkUpsertSlug(c, req.Name) {
+			return
+		}
+
+		delegateUpsert(c, r.createApplication, req, true)
+
+		return
+	}
+
+	if app.ApproverGroupID.String == *req.ApproverGroupID {
+		sendUpsertUnchanged(c, app.ID, app)
+		return
+	}
+
+	c.AddParam("id", app.ID)
+	delegateUpsert(c, r.updateApplication, req, false)
+}
+
+// upsertExtension creates the extension with the slug in the path, or updates it when it exists
+func (r *Router) upsertExtension(c *gin.Context) {
+	req := ExtensionReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	extension, err := models.Extensions(qm.Where("slug = ?", c.Param("slug"))).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			sendError(c, http.StatusInternalServerError, "error getting extension: "+err.Error())
+			return
+		}
+
+		if !checkUpsertSlug(c, req.Name) {
+			return
+		}
+
+		delegateUpsert(c, r.createExtension, req, true)
+
+		return
+	}
+
+	if (req.Description == "" || req.Description == extension.Description) &&
+		(req.Enabled == nil || *req.Enabled == extension.Enabled) {
+		sendUpsertUnchanged(c, extension.ID, extension)
+		return
+	}
+
+	c.AddParam("eid", extension.ID)
+	delegateUpsert(c, r.updateExtension, req, false)
+}
+
+// upsertExtensionResourceDefinition creates the ERD with the plural slug and
+// version in the path, or updates it when it exists. The schema, slugs and
+// scope of an existing ERD can't be changed.
+func (r *Router) upsertExtensionResourceDefinition(c *gin.Context) {
+	req := ExtensionResourceDefinitionReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	slugPlural := c.Param("erd-id-slug")
+	version := c.Param("erd-version")
+
+	if req.SlugPlural == "" {
+		req.SlugPlural = slugPlural
+	}
+
+	if req.Version == "" {
+		req.Version = version
+	}
+
+	if req.SlugPlural != slugPlural || req.Version != version {
+		sendError(c, http.StatusBadRequest, "slug_plural and version must match the path")
+		return
+	}
+
+	_, erd, err := findERD(c, r.DB, c.Param("eid"), slugPlural, version, false)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrExtensionNotFound):
+			sendErrorFromErr(c, http.StatusNotFound, err)
+		case errors.Is(err, ErrERDNotFound):
+			delegateUpsert(c, r.createExtensionResourceDefinition, req, true)
+		default:
+			sendError(c, http.StatusBadRequest, err.Error())
+		}
+
+		return
+	}
+
+	if len(req.Schema) > 0 {
+		equal, err := jsonSchemasEqual(req.Schema, erd.Schema)
+		if err != nil {
+			sendError(c, http.StatusBadRequest, "invalid schema: "+err.Error())
+			return
+		}
+
+		if !equal {
+			sendError(c, http.StatusBadRequest, "ERD schema is immutable")
+			return
+		}
+
+		// the update endpoint rejects any schema
+		req.Schema = nil
+	}
+
+	if (req.Name == "" || req.Name == erd.Name) &&
+		(req.Description == "" || req.Description == erd.Description) &&
+		(req.Enabled == nil || *req.Enabled == erd.Enabled) &&
+		(req.State == "" || req.State == erd.State) &&
+		req.AdminGroup == erd.AdminGroup.String &&
+		(req.DefaultOwnerPolicy == "" || string(req.DefaultOwnerPolicy) == erd.DefaultOwnerPolicy) &&
+		(req.DefaultOwnerGroup == "" || req.DefaultOwnerGroup == erd.DefaultOwnerGroup.String) &&
+		(req.SlugSingular == "" || req.SlugSingular == erd.SlugSingular) &&
+		(req.Scope == "" || req.Scope.String() == erd.Scope) {
+		sendUpsertUnchanged(c, erd.ID, erd)
+		return
+	}
+
+	delegateUpsert(c, r.updateExtensionResourceDefinition, req, false)
+}
+
+// jsonSchemasEqual compares a schema from a request, that may be sent as an
+// escaped JSON string, with a stored schema
+func jsonSchemasEqual(req, stored []byte) (bool, error) {
+	var s string
+	if err := json.Unmarshal(req, &s); err == nil {
+		req = []byte(s)
+	}
+
+	var a, b any
+
+	if err := json.Unmarshal(req, &a); err != nil {
+		return false, err
+	}
+
+	if err := json.Unmarshal(stored, &b); err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(a, b), nil
+}
@@ -0,0 +1,108 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegateUpsert(t *testing.T) {
+	tests := []struct {
+		name        string
+		handler     gin.HandlerFunc
+		created     bool
+		wantStatus  int
+		wantUpsert  bool
+		wantID      string
+		wantCreated bool
+	}{
+		{
+			name: "created",
+			handler: func(c *gin.Context) {
+				body, _ := io.ReadAll(c.Request.Body)
+				c.Data(http.StatusAccepted, "application/json", []byte(`{"id":"abc","req":`+string(body)+`}`))
+			},
+			created:     true,
+			wantStatus:  http.StatusCreated,
+			wantUpsert:  true,
+			wantID:      "abc",
+			wantCreated: true,
+		},
+		{
+			name: "updated",
+			handler: func(c *gin.Context) {
+				c.JSON(http.StatusAccepted, map[string]string{"id": "def"})
+			},
+			wantStatus: http.StatusOK,
+			wantUpsert: true,
+			wantID:     "def",
+		},
+		{
+			name: "error passed through",
+			handler: func(c *gin.Context) {
+				sendError(c, http.StatusBadRequest, "nope")
+			},
+			created:    true,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, w := newValidationTestContext("")
+
+			delegateUpsert(c, tt.handler, map[string]string{"name": "test"}, tt.created)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+
+			if !tt.wantUpsert {
+				resp := ErrorResponse{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, "nope", resp.Message)
+
+				return
+			}
+
+			resp := UpsertResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantID, resp.ID)
+			assert.Equal(t, tt.wantCreated, resp.Created)
+			assert.True(t, resp.Changed)
+			assert.NotEmpty(t, resp.Resource)
+		})
+	}
+}
+
+func TestJSONSchemasEqual(t *testing.T) {
+	stored := []byte(`{"type":"object","properties":{"a":{"type":"string"}}}`)
+
+	tests := []struct {
+		name    string
+		req     string
+		want    bool
+		wantErr bool
+	}{
+		{name: "same", req: `{"properties":{"a":{"type":"string"}},"type":"object"}`, want: true},
+		{name: "escaped string", req: `"{\"type\":\"object\",\"properties\":{\"a\":{\"type\":\"string\"}}}"`, want: true},
+		{name: "different", req: `{"type":"object"}`, want: false},
+		{name: "invalid", req: `"{not json"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := jsonSchemasEqual([]byte(tt.req), stored)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
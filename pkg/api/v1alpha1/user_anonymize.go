@@ -0,0 +1,18 @@
+package v1alpha1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// anonymizeUser erases the personal data of a user, keeping the user id so
+// its memberships, requests and audit events stay linked
+func (r *Router) anonymizeUser(c *gin.Context) {
+	user, auditEvents, err := r.svc().AnonymizeUser(c.Request.Context(), ctxActor(c), c.Param("id"))
+	if !handleServiceResult(c, auditEvents, err) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, user)
+}
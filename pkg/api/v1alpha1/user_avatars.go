@@ -0,0 +1,68 @@
+package v1alpha1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/avatar"
+)
+
+const (
+	// avatarMaxAge is how long clients can cache a proxied avatar
+	avatarMaxAge = time.Hour
+	// avatarFallbackMaxAge is how long clients can cache a placeholder, so a
+	// fixed avatar url shows up soon
+	avatarFallbackMaxAge = 5 * time.Minute
+)
+
+// getUserAvatar responds with the avatar of a user, fetched through the
+// avatar proxy and scaled to the size query param. Users without an avatar, or
+// with one that can't be fetched, get a placeholder.
+func (r *Router) getUserAvatar(c *gin.Context) {
+	size, err := avatar.ParseSize(c.Query("size"))
+	if err != nil {
+		sendValidationError(c, []ErrorDetail{{Field: "size", Message: err.Error()}})
+		return
+	}
+
+	user, err := r.svc().FindUser(c.Request.Context(), c.Param("id"), false)
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	a, err := r.Avatars.Get(c.Request.Context(), user.AvatarURL.String, user.ID, size)
+	if err != nil && user.AvatarURL.String != "" {
+		r.Logger.Debug("serving avatar placeholder", zap.String("user.id", user.ID), zap.Error(err))
+	}
+
+	sendAvatar(c, a)
+}
+
+// sendAvatar responds with the avatar and its cache headers, or with not
+// modified when the client already has it
+func sendAvatar(c *gin.Context, a *avatar.Avatar) {
+	sum := sha256.Sum256(a.Body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	maxAge := avatarMaxAge
+	if a.Fallback {
+		maxAge = avatarFallbackMaxAge
+	}
+
+	c.Header("Cache-Control", "private, max-age="+strconv.Itoa(int(maxAge.Seconds())))
+	c.Header("ETag", etag)
+
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, a.ContentType, a.Body)
+}
@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metal-toolbox/governor-api/internal/avatar"
+)
+
+func TestGetUserAvatarInvalidSize(t *testing.T) {
+	r := &Router{}
+
+	engine := gin.New()
+	engine.GET("/users/:id/avatar", r.getUserAvatar)
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users/1/avatar?size=1000", nil))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), string(ErrCodeValidationFailed))
+}
+
+func TestSendAvatar(t *testing.T) {
+	engine := gin.New()
+	engine.GET("/avatar", func(c *gin.Context) {
+		sendAvatar(c, &avatar.Avatar{ContentType: "image/png", Body: []byte("png"), Fallback: c.Query("fallback") != ""})
+	})
+
+	serve := func(path, etag string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+
+		return w
+	}
+
+	w := serve("/avatar", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+	assert.Equal(t, "private, max-age=3600", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "png", w.Body.String())
+
+	etag := w.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	w = serve("/avatar", etag)
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+
+	w = serve("/avatar?fallback=1", "")
+	assert.Equal(t, "private, max-age=300", w.Header().Get("Cache-Control"), "placeholders are cached for less time")
+}
@@ -0,0 +1,185 @@
+package v1alpha1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/auditevent/ginaudit"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/emailverify"
+	"github.com/metal-toolbox/governor-api/internal/models"
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// EmailVerificationReq is a request to confirm a pending email change
+type EmailVerificationReq struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// createEmailChange records a pending change of the user email, replacing the
+// changes the user hasn't confirmed yet. It must run in the transaction
+// updating the user.
+func (r *Router) createEmailChange(c *gin.Context, tx *sql.Tx, user *models.User, email string) (*models.UserEmailChange, *models.AuditEvent, error) {
+	ctx := c.Request.Context()
+
+	if _, err := models.UserEmailChanges(
+		qm.Where("user_id = ?", user.ID),
+		qm.And("confirmed_at IS NULL"),
+	).DeleteAll(ctx, tx); err != nil {
+		return nil, nil, fmt.Errorf("error removing pending email changes: %w", err)
+	}
+
+	change := &models.UserEmailChange{
+		UserID:    user.ID,
+		Email:     email,
+		ExpiresAt: r.EmailVerifier.ExpiresAt(),
+	}
+
+	if err := change.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, nil, fmt.Errorf("error creating email change: %w", err)
+	}
+
+	event, err := dbtools.AuditUserEmailChangeRequested(ctx, tx, getCtxAuditID(c), getCtxUser(c), user, change)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating email change (audit): %w", err)
+	}
+
+	return change, event, nil
+}
+
+// publishEmailVerification publishes the signed token confirming a pending
+// email change, downstream notifiers send it to the new address
+func (r *Router) publishEmailVerification(ctx context.Context, c *gin.Context, change *models.UserEmailChange) error {
+	token, err := r.EmailVerifier.Sign(emailverify.Claims{
+		ChangeID:  change.ID,
+		UserID:    change.UserID,
+		Email:     change.Email,
+		ExpiresAt: change.ExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return r.EventBus.Publish(ctx, events.GovernorUserEmailVerificationsEventSubject, &events.Event{
+		Version: events.Version,
+		Action:  events.GovernorEventCreate,
+		AuditID: c.GetString(ginaudit.AuditIDContextKey),
+		ActorID: getCtxActorID(c),
+		UserID:  change.UserID,
+		EmailVerification: &events.EmailVerification{
+			ChangeID:  change.ID,
+			Email:     change.Email,
+			Token:     token,
+			ExpiresAt: change.ExpiresAt,
+		},
+	})
+}
+
+// confirmEmailChange sets the email of the authenticated user to the pending
+// email confirmed by a verification token. Only the latest pending change of
+// the user can be confirmed, and only by the user.
+func (r *Router) confirmEmailChange(c *gin.Context) {
+	ctxUser := getCtxUser(c)
+	if ctxUser == nil {
+		sendError(c, http.StatusUnauthorized, "no user in context")
+		return
+	}
+
+	if r.EmailVerifier == nil {
+		sendErrorFromErr(c, http.StatusNotFound, ErrEmailVerificationDisabled)
+		return
+	}
+
+	req := EmailVerificationReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	claims, err := r.EmailVerifier.Verify(req.Token)
+	if err != nil {
+		sendErrorFromErr(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if claims.UserID != ctxUser.ID {
+		sendError(c, http.StatusForbidden, "email verification token belongs to another user")
+		return
+	}
+
+	change, err := models.UserEmailChanges(
+		qm.Where("id = ?", claims.ChangeID),
+		qm.And("user_id = ?", ctxUser.ID),
+		qm.And("confirmed_at IS NULL"),
+	).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorFromErr(c, http.StatusConflict, ErrEmailChangeNotPending)
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting email change: "+err.Error())
+
+		return
+	}
+
+	if change.Email != claims.Email || !time.Now().Before(change.ExpiresAt) {
+		sendErrorFromErr(c, http.StatusBadRequest, emailverify.ErrInvalidToken)
+		return
+	}
+
+	original := *ctxUser
+	ctxUser.Email = change.Email
+	change.ConfirmedAt = null.TimeFrom(time.Now())
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := ctxUser.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating user email: "+err.Error(), err)
+		}
+
+		if _, err := change.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "error confirming email change: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditUserEmailChangeConfirmed(c.Request.Context(), tx, getCtxAuditID(c), &original, ctxUser)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error confirming email change (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error confirming email change (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	// only publish events for active users
+	if !isActiveUser(ctxUser) {
+		c.JSON(http.StatusAccepted, ctxUser)
+		return
+	}
+
+	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorUsersEventSubject, &events.Event{
+		Version: events.Version,
+		Action:  events.GovernorEventUpdate,
+		AuditID: c.GetString(ginaudit.AuditIDContextKey),
+		ActorID: ctxUser.ID,
+		UserID:  ctxUser.ID,
+		Before:  &original,
+		After:   ctxUser,
+	}); err != nil {
+		acceptChange(c, fmt.Errorf("failed to publish user update event: %w", err))
+	}
+
+	c.JSON(http.StatusAccepted, ctxUser)
+}
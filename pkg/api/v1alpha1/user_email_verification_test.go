@@ -0,0 +1,96 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/metal-toolbox/governor-api/internal/emailverify"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestConfirmEmailChange(t *testing.T) {
+	signer, err := emailverify.New([]byte("s3cr3t"))
+	require.NoError(t, err)
+
+	other, err := emailverify.New([]byte("other"))
+	require.NoError(t, err)
+
+	sign := func(s *emailverify.Signer, userID string) string {
+		token, err := s.Sign(emailverify.Claims{
+			ChangeID:  "change-id",
+			UserID:    userID,
+			Email:     "new@example.com",
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		return token
+	}
+
+	tests := []struct {
+		name     string
+		verifier *emailverify.Signer
+		body     string
+		wantCode int
+		wantErr  string
+	}{
+		{
+			name:     "disabled",
+			body:     `{"token":"abc"}`,
+			wantCode: http.StatusNotFound,
+			wantErr:  ErrEmailVerificationDisabled.Error(),
+		},
+		{
+			name:     "missing token",
+			verifier: signer,
+			body:     `{}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "malformed token",
+			verifier: signer,
+			body:     `{"token":"abc"}`,
+			wantCode: http.StatusBadRequest,
+			wantErr:  string(ErrCodeEmailVerificationInvalid),
+		},
+		{
+			name:     "token signed with another secret",
+			verifier: signer,
+			body:     `{"token":"` + sign(other, "user-id") + `"}`,
+			wantCode: http.StatusBadRequest,
+			wantErr:  string(ErrCodeEmailVerificationInvalid),
+		},
+		{
+			name:     "token of another user",
+			verifier: signer,
+			body:     `{"token":"` + sign(signer, "other-user-id") + `"}`,
+			wantCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Router{EmailVerifier: tt.verifier}
+
+			engine := gin.New()
+			engine.POST("/user/email-verification", func(c *gin.Context) {
+				setCtxUser(c, &models.User{ID: "user-id", Email: "old@example.com"})
+			}, r.confirmEmailChange)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/user/email-verification", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package v1alpha1
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/metal-toolbox/governor-api/internal/service"
+)
+
+// exportUser responds with a zip archive of everything governor stores about
+// a user, one JSON file per kind of record. Users can export their own data,
+// governor admins can export the data of any user.
+func (r *Router) exportUser(c *gin.Context) {
+	if ctxUser := getCtxUser(c); ctxUser != nil && ctxUser.ID != c.Param("id") {
+		if isAdmin := getCtxAdmin(c); isAdmin == nil || !*isAdmin {
+			sendError(c, http.StatusForbidden, "user not allowed to export the data of other users")
+			return
+		}
+	}
+
+	export, err := r.svc().ExportUser(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		sendServiceError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var buf bytes.Buffer
+
+	if err := writeUserExport(&buf, export, time.Now()); err != nil {
+		sendError(c, http.StatusInternalServerError, "error writing user export: "+err.Error())
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="governor-user-`+export.User.ID+`.zip"`)
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, "application/zip", buf.Bytes())
+}
+
+// writeUserExport writes the user export as a zip archive
+func writeUserExport(w io.Writer, export *service.UserExport, now time.Time) error {
+	zw := zip.NewWriter(w)
+
+	for _, f := range []struct {
+		name string
+		data any
+	}{
+		{"user.json", export.User},
+		{"group_memberships.json", export.GroupMemberships},
+		{"group_membership_requests.json", export.GroupMembershipRequests},
+		{"group_application_requests.json", export.GroupApplicationRequests},
+		{"archived_requests.json", export.ArchivedRequests},
+		{"request_comments.json", export.RequestComments},
+		{"notification_preferences.json", export.NotificationPreferences},
+		{"notification_group_preferences.json", export.NotificationGroupPreferences},
+		{"notification_failover.json", export.NotificationFailover},
+		{"notification_dispatches.json", export.NotificationDispatches},
+		{"extension_resources.json", export.ExtensionResources},
+		{"email_changes.json", export.EmailChanges},
+		{"audit_events.json", export.AuditEvents},
+	} {
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     f.name,
+			Method:   zip.Deflate,
+			Modified: now,
+		})
+		if err != nil {
+			return err
+		}
+
+		enc := json.NewEncoder(fw)
+		enc.SetIndent("", "  ")
+
+		if err := enc.Encode(f.data); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
@@ -0,0 +1,74 @@
+package v1alpha1
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+)
+
+func TestWriteUserExport(t *testing.T) {
+	export := &service.UserExport{
+		User: &models.User{ID: "user-id", Email: "jane@example.com"},
+		GroupMemberships: models.GroupMembershipSlice{
+			{ID: "membership-id", GroupID: "group-id", UserID: "user-id"},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeUserExport(&buf, export, time.Now()))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := map[string][]byte{}
+
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+
+		b, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+
+		files[f.Name] = b
+	}
+
+	assert.Len(t, files, 13)
+
+	user := models.User{}
+	require.NoError(t, json.Unmarshal(files["user.json"], &user))
+	assert.Equal(t, "jane@example.com", user.Email)
+
+	memberships := models.GroupMembershipSlice{}
+	require.NoError(t, json.Unmarshal(files["group_memberships.json"], &memberships))
+	assert.Len(t, memberships, 1)
+
+	assert.JSONEq(t, "null", string(files["audit_events.json"]), "missing records are null")
+}
+
+func TestExportUserForbidden(t *testing.T) {
+	r := &Router{}
+
+	engine := gin.New()
+	engine.GET("/users/:id/export", func(c *gin.Context) {
+		setCtxUser(c, &models.User{ID: "user-id"})
+		c.Set(contextKeyAdmin, new(bool))
+	}, r.exportUser)
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users/other-user-id/export", nil))
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
@@ -11,7 +11,6 @@ import (
 	"sync"
 
 	"github.com/gin-gonic/gin"
-	"github.com/metal-toolbox/auditevent/ginaudit"
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/models"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
@@ -92,7 +91,7 @@ func (r *Router) createUserExtensionResource(c *gin.Context) {
 
 	if findUserErr != nil {
 		if errors.Is(findUserErr, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, ErrUserNotFound.Error())
+			sendErrorFromErr(c, http.StatusNotFound, ErrUserNotFound)
 			return
 		}
 
@@ -103,7 +102,7 @@ func (r *Router) createUserExtensionResource(c *gin.Context) {
 
 	if findERDErr != nil {
 		if errors.Is(findERDErr, ErrExtensionNotFound) || errors.Is(findERDErr, ErrERDNotFound) {
-			sendError(c, http.StatusNotFound, findERDErr.Error())
+			sendErrorFromErr(c, http.StatusNotFound, findERDErr)
 			return
 		}
 
@@ -151,95 +150,32 @@ func (r *Router) createUserExtensionResource(c *gin.Context) {
 	// insert
 	er := &models.UserExtensionResource{Resource: requestBody, UserID: user.ID}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting extension resource create transaction: "+err.Error())
-		return
-	}
-
-	if err := erd.AddUserExtensionResources(c.Request.Context(), tx, true, er); err != nil {
-		msg := fmt.Sprintf("error creating %s: %s", erd.Name, err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditUserExtensionResourceCreated(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		er,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error creating extension resource (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error creating extension resource: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing extension resource create: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := erd.AddUserExtensionResources(c.Request.Context(), tx, true, er); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error creating %s: %s", erd.Name, err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		erd.SlugPlural,
-		&events.Event{
+		if err := r.recordChange(c, tx, erd.SlugPlural, &events.Event{
 			Version:                       erd.Version,
 			Action:                        events.GovernorEventCreate,
-			AuditID:                       c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:                       getCtxActorID(c),
 			UserID:                        user.ID,
 			ExtensionID:                   extension.ID,
 			ExtensionResourceID:           er.ID,
 			ExtensionResourceDefinitionID: erd.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish extension resource create event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
+			After:                         er,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditUserExtensionResourceCreated(ctx, exec, pID, actor, er)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error creating extension resource (audit): %s", err.Error()), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
+	r.publishChanges(c)
+
 	resp := &UserExtensionResource{
 		UserExtensionResource: er,
 		ERD:                   erd.SlugSingular,
@@ -249,30 +185,39 @@ func (r *Router) createUserExtensionResource(c *gin.Context) {
 	c.JSON(http.StatusCreated, resp)
 }
 
-// listUserExtensionResources lists user extension resources from a given user
-func (r *Router) listUserExtensionResources(c *gin.Context) {
+// userExtensionResourcesQuery reads the user, the ERD and the filters of a
+// user extension resources listing from the request, it sends the error
+// response and returns false when they are invalid
+func (r *Router) userExtensionResourcesQuery(c *gin.Context) (
+	*models.User, *models.ExtensionResourceDefinition, *extensionResourcesQuery, bool,
+) {
+	asOf, ok := asOfParam(c)
+	if !ok {
+		return nil, nil, nil, false
+	}
+
 	user, _, erd, findUserErr, findERDErr := fetchUserAndERD(c, r.DB)
 
 	if findUserErr != nil {
 		if errors.Is(findUserErr, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, ErrUserNotFound.Error())
-			return
+			sendErrorFromErr(c, http.StatusNotFound, ErrUserNotFound)
+			return nil, nil, nil, false
 		}
 
 		sendError(c, http.StatusInternalServerError, "error getting user: "+findUserErr.Error())
 
-		return
+		return nil, nil, nil, false
 	}
 
 	if findERDErr != nil {
 		if errors.Is(findERDErr, ErrExtensionNotFound) || errors.Is(findERDErr, ErrERDNotFound) {
-			sendError(c, http.StatusNotFound, findERDErr.Error())
-			return
+			sendErrorFromErr(c, http.StatusNotFound, findERDErr)
+			return nil, nil, nil, false
 		}
 
 		sendError(c, http.StatusBadRequest, findERDErr.Error())
 
-		return
+		return nil, nil, nil, false
 	}
 
 	if erd.Scope != ExtensionResourceDefinitionScopeUser.String() {
@@ -284,7 +229,17 @@ func (r *Router) listUserExtensionResources(c *gin.Context) {
 			),
 		)
 
-		return
+		return nil, nil, nil, false
+	}
+
+	since, ok := updatedSinceParam(c)
+	if !ok {
+		return nil, nil, nil, false
+	}
+
+	if since != nil && asOf != nil {
+		sendValidationError(c, []ErrorDetail{{Field: "updated_since", Message: "updated_since can't be used with as_of"}})
+		return nil, nil, nil, false
 	}
 
 	uriQueries := map[string]string{}
@@ -294,24 +249,62 @@ func (r *Router) listUserExtensionResources(c *gin.Context) {
 			fmt.Sprintf("error binding uri queries: %s", err.Error()),
 		)
 
-		return
+		return nil, nil, nil, false
 	}
 
 	extraCapacityForDeletedAndUserID := 2
 	qms := make([]qm.QueryMod, 0, len(uriQueries)+extraCapacityForDeletedAndUserID)
+	filters := dbtools.ExtensionResourceFilters{Fields: map[string]string{}}
 
 	for k, v := range uriQueries {
+		if k == "as_of" || k == "fields" || k == "updated_since" {
+			continue
+		}
+
 		if k == "deleted" {
 			qms = append(qms, qm.WithDeleted())
+			filters.Deleted = true
+
 			continue
 		}
 
-		qms = append(qms, qm.Where("resource->>? = ?", k, v))
+		filters.Fields[k] = v
+	}
+
+	qms = append(qms, dbtools.ExtensionResourceFieldFilters(erd, filters.Fields)...)
+
+	if since != nil {
+		qms = append(qms, dbtools.ChangedSince(models.TableNames.UserExtensionResources, *since)...)
 	}
 
 	qms = append(qms, qm.Where("user_id = ?", user.ID))
 
-	ers, err := erd.UserExtensionResources(qms...).All(c.Request.Context(), r.DB)
+	return user, erd, &extensionResourcesQuery{asOf: asOf, qms: qms, filters: filters}, true
+}
+
+// listUserExtensionResources lists user extension resources from a given user
+func (r *Router) listUserExtensionResources(c *gin.Context) {
+	user, erd, q, ok := r.userExtensionResourcesQuery(c)
+	if !ok {
+		return
+	}
+
+	fields, ok := fieldsParam(c, erd)
+	if !ok {
+		return
+	}
+
+	var (
+		ers models.UserExtensionResourceSlice
+		err error
+	)
+
+	if q.asOf != nil {
+		ers, err = dbtools.UserExtensionResourcesAsOf(c.Request.Context(), r.DB, erd.ID, user.ID, *q.asOf, q.filters)
+	} else {
+		ers, err = erd.UserExtensionResources(q.qms...).All(c.Request.Context(), r.DB)
+	}
+
 	if err != nil {
 		sendError(
 			c, http.StatusBadRequest,
@@ -321,6 +314,15 @@ func (r *Router) listUserExtensionResources(c *gin.Context) {
 		return
 	}
 
+	if fields != nil {
+		for _, er := range ers {
+			if er.Resource, err = projectResource(er.Resource, fields); err != nil {
+				sendError(c, http.StatusInternalServerError, "error projecting extension resources: "+err.Error())
+				return
+			}
+		}
+	}
+
 	resp := make([]*UserExtensionResource, len(ers))
 	for i, er := range ers {
 		resp[i] = &UserExtensionResource{
@@ -333,13 +335,68 @@ func (r *Router) listUserExtensionResources(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// countUserExtensionResources counts the user extension resources of a user
+// matching the filters of the listing
+func (r *Router) countUserExtensionResources(c *gin.Context) {
+	user, erd, q, ok := r.userExtensionResourcesQuery(c)
+	if !ok {
+		return
+	}
+
+	var (
+		count int64
+		err   error
+	)
+
+	if q.asOf != nil {
+		var ers models.UserExtensionResourceSlice
+
+		ers, err = dbtools.UserExtensionResourcesAsOf(c.Request.Context(), r.DB, erd.ID, user.ID, *q.asOf, q.filters)
+		count = int64(len(ers))
+	} else {
+		count, err = erd.UserExtensionResources(q.qms...).Count(c.Request.Context(), r.DB)
+	}
+
+	sendExtensionResourcesCount(c, count, err)
+}
+
+// userExtensionResourcesExist tells whether a user extension resource of a
+// user matches the filters of the listing
+func (r *Router) userExtensionResourcesExist(c *gin.Context) {
+	user, erd, q, ok := r.userExtensionResourcesQuery(c)
+	if !ok {
+		return
+	}
+
+	var (
+		exists bool
+		err    error
+	)
+
+	if q.asOf != nil {
+		var ers models.UserExtensionResourceSlice
+
+		ers, err = dbtools.UserExtensionResourcesAsOf(c.Request.Context(), r.DB, erd.ID, user.ID, *q.asOf, q.filters)
+		exists = len(ers) > 0
+	} else {
+		exists, err = erd.UserExtensionResources(q.qms...).Exists(c.Request.Context(), r.DB)
+	}
+
+	sendExtensionResourcesExist(c, exists, err)
+}
+
 // getUserExtensionResource fetches a user extension resources from a given user
 func (r *Router) getUserExtensionResource(c *gin.Context) {
+	asOf, ok := asOfParam(c)
+	if !ok {
+		return
+	}
+
 	user, _, erd, findUserErr, findERDErr := fetchUserAndERD(c, r.DB)
 
 	if findUserErr != nil {
 		if errors.Is(findUserErr, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, ErrUserNotFound.Error())
+			sendErrorFromErr(c, http.StatusNotFound, ErrUserNotFound)
 			return
 		}
 
@@ -350,7 +407,7 @@ func (r *Router) getUserExtensionResource(c *gin.Context) {
 
 	if findERDErr != nil {
 		if errors.Is(findERDErr, ErrExtensionNotFound) || errors.Is(findERDErr, ErrERDNotFound) {
-			sendError(c, http.StatusNotFound, findERDErr.Error())
+			sendErrorFromErr(c, http.StatusNotFound, findERDErr)
 			return
 		}
 
@@ -382,11 +439,21 @@ func (r *Router) getUserExtensionResource(c *gin.Context) {
 		qms = append(qms, qm.WithDeleted())
 	}
 
-	er, err := erd.UserExtensionResources(qms...).One(c.Request.Context(), r.DB)
+	var (
+		er  *models.UserExtensionResource
+		err error
+	)
+
+	if asOf != nil {
+		er, err = dbtools.UserExtensionResourceAsOf(c.Request.Context(), r.DB, erd.ID, user.ID, resourceID, *asOf, deleted)
+	} else {
+		er, err = erd.UserExtensionResources(qms...).One(c.Request.Context(), r.DB)
+	}
+
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(
-				c, http.StatusNotFound,
+			sendErrorWithCode(
+				c, http.StatusNotFound, ErrCodeExtensionResourceNotFound,
 				fmt.Sprintf("%s: %s", ErrExtensionResourceNotFound.Error(), err.Error()),
 			)
 		} else {
@@ -405,13 +472,24 @@ func (r *Router) getUserExtensionResource(c *gin.Context) {
 		Version:               erd.Version,
 	}
 
+	// the resources read as of a time don't have a revision
+	if asOf == nil {
+		c.Header("ETag", revisionETag(er.Revision))
+	}
+
 	c.JSON(http.StatusOK, resp)
 }
 
-// updateUserExtensionResource updates a user extension resources from a given user
+// updateUserExtensionResource updates a user extension resources from a given user,
+// the JSON Patch and JSON Merge Patch bodies don't require the If-Match revision
 func (r *Router) updateUserExtensionResource(c *gin.Context) {
 	defer c.Request.Body.Close()
 
+	revision, ok := ifMatchRevision(c, !isExtensionResourcePatch(c))
+	if !ok {
+		return
+	}
+
 	requestBody, err := io.ReadAll(c.Request.Body)
 	if err != nil {
 		sendError(c, http.StatusBadRequest, err.Error())
@@ -422,7 +500,7 @@ func (r *Router) updateUserExtensionResource(c *gin.Context) {
 
 	if findUserErr != nil {
 		if errors.Is(findUserErr, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, ErrUserNotFound.Error())
+			sendErrorFromErr(c, http.StatusNotFound, ErrUserNotFound)
 			return
 		}
 
@@ -433,7 +511,7 @@ func (r *Router) updateUserExtensionResource(c *gin.Context) {
 
 	if findERDErr != nil {
 		if errors.Is(findERDErr, ErrExtensionNotFound) || errors.Is(findERDErr, ErrERDNotFound) {
-			sendError(c, http.StatusNotFound, findERDErr.Error())
+			sendErrorFromErr(c, http.StatusNotFound, findERDErr)
 			return
 		}
 
@@ -463,8 +541,8 @@ func (r *Router) updateUserExtensionResource(c *gin.Context) {
 	er, err := erd.UserExtensionResources(qms...).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(
-				c, http.StatusNotFound,
+			sendErrorWithCode(
+				c, http.StatusNotFound, ErrCodeExtensionResourceNotFound,
 				fmt.Sprintf("%s: %s", ErrExtensionResourceNotFound.Error(), err.Error()),
 			)
 		} else {
@@ -477,131 +555,7 @@ func (r *Router) updateUserExtensionResource(c *gin.Context) {
 		return
 	}
 
-	// schema validator
-	compiler := jsonschema.NewCompiler(
-		extension.Slug, erd.SlugPlural, erd.Version,
-		jsonschema.WithUniqueConstraint(c.Request.Context(), erd, &resourceID, r.DB),
-	)
-
-	schema, err := compiler.Compile(erd.Schema.String())
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "ERD schema is not valid: "+err.Error())
-		return
-	}
-
-	// validate payload
-	var v interface{}
-	if err := json.Unmarshal(requestBody, &v); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
-		return
-	}
-
-	if err := schema.Validate(v); err != nil {
-		sendError(c, http.StatusBadRequest, err.Error())
-		return
-	}
-
-	// update
-	original := *er
-	er.Resource = requestBody
-
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting extension resource update transaction: "+err.Error())
-		return
-	}
-
-	if _, err := er.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := fmt.Sprintf("error updating %s: %s", erd.Name, err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditUserExtensionResourceUpdated(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		&original,
-		er,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error updating extension resource (audit): %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error updating extension resource: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing extension resource update: %s", err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		erd.SlugPlural,
-		&events.Event{
-			Version:                       erd.Version,
-			Action:                        events.GovernorEventUpdate,
-			AuditID:                       c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:                       getCtxActorID(c),
-			UserID:                        user.ID,
-			ExtensionID:                   extension.ID,
-			ExtensionResourceID:           er.ID,
-			ExtensionResourceDefinitionID: erd.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish extension resource update event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
-
-		return
-	}
-
-	resp := &UserExtensionResource{
-		UserExtensionResource: er,
-		ERD:                   erd.SlugSingular,
-		Version:               erd.Version,
-	}
-
-	c.JSON(http.StatusAccepted, resp)
+	r.saveUserExtensionResource(c, user, extension, erd, er, extensionResourceUpdate(c, requestBody), revision, dbtools.AuditUserExtensionResourceUpdated)
 }
 
 // deleteUserExtensionResource fetches a user extension resources from a given user
@@ -610,7 +564,7 @@ func (r *Router) deleteUserExtensionResource(c *gin.Context) {
 
 	if findUserErr != nil {
 		if errors.Is(findUserErr, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, ErrUserNotFound.Error())
+			sendErrorFromErr(c, http.StatusNotFound, ErrUserNotFound)
 			return
 		}
 
@@ -621,7 +575,7 @@ func (r *Router) deleteUserExtensionResource(c *gin.Context) {
 
 	if findERDErr != nil {
 		if errors.Is(findERDErr, ErrExtensionNotFound) || errors.Is(findERDErr, ErrERDNotFound) {
-			sendError(c, http.StatusNotFound, findERDErr.Error())
+			sendErrorFromErr(c, http.StatusNotFound, findERDErr)
 			return
 		}
 
@@ -651,8 +605,8 @@ func (r *Router) deleteUserExtensionResource(c *gin.Context) {
 	er, err := erd.UserExtensionResources(qms...).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(
-				c, http.StatusNotFound,
+			sendErrorWithCode(
+				c, http.StatusNotFound, ErrCodeExtensionResourceNotFound,
 				fmt.Sprintf("%s: %s", ErrExtensionResourceNotFound.Error(), err.Error()),
 			)
 		} else {
@@ -665,100 +619,130 @@ func (r *Router) deleteUserExtensionResource(c *gin.Context) {
 		return
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting extension resource delete transaction: "+err.Error())
-		return
-	}
-
-	if _, err := er.Delete(c.Request.Context(), tx, false); err != nil {
-		msg := fmt.Sprintf("error deleting %s: %s", erd.Name, err.Error())
-
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := er.Delete(c.Request.Context(), tx, false); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error deleting %s: %s", erd.Name, err.Error()), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		if err := r.recordChange(c, tx, erd.SlugPlural, &events.Event{
+			Version:                       erd.Version,
+			Action:                        events.GovernorEventDelete,
+			UserID:                        user.ID,
+			ExtensionID:                   extension.ID,
+			ExtensionResourceID:           er.ID,
+			ExtensionResourceDefinitionID: erd.ID,
+			Before:                        er,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditUserExtensionResourceDeleted(ctx, exec, pID, actor, er)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error deleting extension resource (audit): %s", err.Error()), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
-	event, err := dbtools.AuditUserExtensionResourceDeleted(
-		c.Request.Context(),
-		tx,
-		getCtxAuditID(c),
-		getCtxUser(c),
-		er,
-	)
-	if err != nil {
-		msg := fmt.Sprintf("error deleting extension resource (audit): %s", err.Error())
+	r.publishChanges(c)
 
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
-		}
+	resp := &UserExtensionResource{
+		UserExtensionResource: er,
+		ERD:                   erd.SlugSingular,
+		Version:               erd.Version,
+	}
 
-		sendError(c, http.StatusBadRequest, msg)
+	c.JSON(http.StatusOK, resp)
+}
+
+// saveUserExtensionResource builds the payload of a user extension resource
+// from its locked current one, validates it against the schema of its ERD,
+// saves it and records the audit event and publishes the update event
+func (r *Router) saveUserExtensionResource(
+	c *gin.Context, user *models.User, extension *models.Extension, erd *models.ExtensionResourceDefinition,
+	er *models.UserExtensionResource, build extensionResourceBuilder, revision *int64, audit userExtensionResourceAuditFunc,
+) {
+	// schema validator
+	compiler := jsonschema.NewCompiler(
+		extension.Slug, erd.SlugPlural, erd.Version,
+		jsonschema.WithUniqueConstraint(c.Request.Context(), erd, &er.ID, r.DB),
+	)
 
+	schema, err := compiler.Compile(erd.Schema.String())
+	if err != nil {
+		sendError(c, http.StatusBadRequest, "ERD schema is not valid: "+err.Error())
 		return
 	}
 
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := fmt.Sprintf("error deleting extension resource: %s", err.Error())
+	var original models.UserExtensionResource
 
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		// the resource is reloaded, so the update and its events start from the
+		// current state of the resource
+		if err := dbtools.LockUserExtensionResource(c.Request.Context(), tx, er, revision); err != nil {
+			switch {
+			case errors.Is(err, dbtools.ErrExtensionResourceRevisionConflict):
+				current := er.Revision
+				return abortTxWithResponse(func(c *gin.Context) { sendRevisionConflict(c, current, err) }, err)
+			case errors.Is(err, sql.ErrNoRows):
+				return abortTxWithResponse(func(c *gin.Context) { sendErrorFromErr(c, http.StatusNotFound, ErrExtensionResourceNotFound) }, err)
+			default:
+				return abortTx(http.StatusBadRequest, fmt.Sprintf("error locking extension resource: %s", err.Error()), err)
+			}
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
+		// the payload is built from the locked resource, so the patches apply to
+		// its current state
+		payload, err := build(er.Resource)
+		if err != nil {
+			return abortTxWithResponse(func(c *gin.Context) { sendPatchError(c, err) }, err)
+		}
 
-	if err := tx.Commit(); err != nil {
-		msg := fmt.Sprintf("error committing extension resource delete: %s", err.Error())
+		// validate payload
+		var v interface{}
+		if err := json.Unmarshal(payload, &v); err != nil {
+			return abortTx(http.StatusBadRequest, "unable to bind request: "+err.Error(), err)
+		}
 
-		if err := tx.Rollback(); err != nil {
-			msg += fmt.Sprintf("error rolling back transaction: %s", err.Error())
+		if err := schema.Validate(v); err != nil {
+			return abortTx(http.StatusBadRequest, err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		// update
+		original = *er
+		er.Resource = payload
 
-		return
-	}
+		if _, err := er.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error updating %s: %s", erd.Name, err.Error()), err)
+		}
 
-	err = r.EventBus.Publish(
-		c.Request.Context(),
-		erd.SlugPlural,
-		&events.Event{
+		if err := r.recordChange(c, tx, erd.SlugPlural, &events.Event{
 			Version:                       erd.Version,
-			Action:                        events.GovernorEventDelete,
-			AuditID:                       c.GetString(ginaudit.AuditIDContextKey),
-			ActorID:                       getCtxActorID(c),
+			Action:                        events.GovernorEventUpdate,
 			UserID:                        user.ID,
 			ExtensionID:                   extension.ID,
 			ExtensionResourceID:           er.ID,
 			ExtensionResourceDefinitionID: erd.ID,
-		},
-	)
-	if err != nil {
-		sendError(
-			c,
-			http.StatusBadRequest,
-			fmt.Sprintf(
-				"failed to publish extension resource delete event: %s\n%s",
-				err.Error(),
-				"downstream changes may be delayed",
-			),
-		)
+			Before:                        &original,
+			After:                         er,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return audit(ctx, exec, pID, actor, &original, er)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, fmt.Sprintf("error updating extension resource (audit): %s", err.Error()), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
+	r.publishChanges(c)
+
 	resp := &UserExtensionResource{
 		UserExtensionResource: er,
 		ERD:                   erd.SlugSingular,
 		Version:               erd.Version,
 	}
 
-	c.JSON(http.StatusOK, resp)
+	c.Header("ETag", revisionETag(er.Revision))
+	c.JSON(http.StatusAccepted, resp)
 }
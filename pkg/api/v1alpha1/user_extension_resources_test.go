@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -788,12 +789,44 @@ func (s *UserExtensionResourceTestSuite) TestUpdateUserExtensionResource() {
 		params      gin.Params
 		contextUser *models.User
 		payload     string
+		// ifMatch is the current revision of the resource when it is empty
+		ifMatch        string
+		withoutIfMatch bool
+		contentType    string
 
 		expectedStatus       int
 		expectedEventSubject string
 		expectedEventPayload *events.Event
 		expectedErrMsg       string
 	}{
+		{
+			name: "stale revision",
+			params: gin.Params{
+				gin.Param{Key: "id", Value: "00000003-0000-0000-0000-000000000002"},
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "resource-id", Value: "00000004-0001-0000-0000-000000000001"},
+				gin.Param{Key: "erd-slug-plural", Value: "user-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+			},
+			payload:        `{"age": 10, "firstName": "Hello", "lastName": "World-11"}`,
+			ifMatch:        `"100"`,
+			expectedStatus: http.StatusConflict,
+			expectedErrMsg: "extension_resource_revision_conflict",
+		},
+		{
+			name: "missing revision",
+			params: gin.Params{
+				gin.Param{Key: "id", Value: "00000003-0000-0000-0000-000000000002"},
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "resource-id", Value: "00000004-0001-0000-0000-000000000001"},
+				gin.Param{Key: "erd-slug-plural", Value: "user-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+			},
+			payload:        `{"age": 10, "firstName": "Hello", "lastName": "World-11"}`,
+			withoutIfMatch: true,
+			expectedStatus: http.StatusPreconditionRequired,
+			expectedErrMsg: "extension_resource_revision_required",
+		},
 		{
 			name: "update resource with user-id ok",
 			params: gin.Params{
@@ -840,6 +873,81 @@ func (s *UserExtensionResourceTestSuite) TestUpdateUserExtensionResource() {
 				ExtensionResourceID:           "00000004-0001-0000-0000-000000000001",
 			},
 		},
+		{
+			name: "json patch without revision",
+			params: gin.Params{
+				gin.Param{Key: "id", Value: "00000003-0000-0000-0000-000000000002"},
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "resource-id", Value: "00000004-0001-0000-0000-000000000001"},
+				gin.Param{Key: "erd-slug-plural", Value: "user-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+			},
+			payload:              `[{"op": "test", "path": "/lastName", "value": "World-22"}, {"op": "replace", "path": "/age", "value": 11}]`,
+			contentType:          "application/json-patch+json",
+			withoutIfMatch:       true,
+			expectedStatus:       http.StatusAccepted,
+			expectedEventSubject: "events.user-resources",
+			expectedEventPayload: &events.Event{
+				Version:                       "v1",
+				Action:                        events.GovernorEventUpdate,
+				ExtensionID:                   "00000001-0000-0000-0000-000000000001",
+				ExtensionResourceDefinitionID: "00000002-0000-0000-0000-000000000001",
+				UserID:                        "00000003-0000-0000-0000-000000000002",
+				ExtensionResourceID:           "00000004-0001-0000-0000-000000000001",
+			},
+		},
+		{
+			name: "merge patch",
+			params: gin.Params{
+				gin.Param{Key: "id", Value: "00000003-0000-0000-0000-000000000002"},
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "resource-id", Value: "00000004-0001-0000-0000-000000000001"},
+				gin.Param{Key: "erd-slug-plural", Value: "user-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+			},
+			payload:              `{"age": 12}`,
+			contentType:          "application/merge-patch+json",
+			expectedStatus:       http.StatusAccepted,
+			expectedEventSubject: "events.user-resources",
+			expectedEventPayload: &events.Event{
+				Version:                       "v1",
+				Action:                        events.GovernorEventUpdate,
+				ExtensionID:                   "00000001-0000-0000-0000-000000000001",
+				ExtensionResourceDefinitionID: "00000002-0000-0000-0000-000000000001",
+				UserID:                        "00000003-0000-0000-0000-000000000002",
+				ExtensionResourceID:           "00000004-0001-0000-0000-000000000001",
+			},
+		},
+		{
+			name: "json patch test failed",
+			params: gin.Params{
+				gin.Param{Key: "id", Value: "00000003-0000-0000-0000-000000000002"},
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "resource-id", Value: "00000004-0001-0000-0000-000000000001"},
+				gin.Param{Key: "erd-slug-plural", Value: "user-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+			},
+			payload:        `[{"op": "test", "path": "/age", "value": 1}]`,
+			contentType:    "application/json-patch+json",
+			withoutIfMatch: true,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedErrMsg: "extension_resource_patch_failed",
+		},
+		{
+			name: "merge patch json schema violation",
+			params: gin.Params{
+				gin.Param{Key: "id", Value: "00000003-0000-0000-0000-000000000002"},
+				gin.Param{Key: "ex-slug", Value: "test-extension"},
+				gin.Param{Key: "resource-id", Value: "00000004-0001-0000-0000-000000000001"},
+				gin.Param{Key: "erd-slug-plural", Value: "user-resources"},
+				gin.Param{Key: "erd-version", Value: "v1"},
+			},
+			payload:        `{"lastName": null}`,
+			contentType:    "application/merge-patch+json",
+			withoutIfMatch: true,
+			expectedStatus: http.StatusBadRequest,
+			expectedErrMsg: "missing properties: 'lastName'",
+		},
 		{
 			name: "json schema violation (missing required field)",
 			params: gin.Params{
@@ -943,6 +1051,24 @@ func (s *UserExtensionResourceTestSuite) TestUpdateUserExtensionResource() {
 
 			req, _ := http.NewRequest("PATCH", url, nil)
 			req.Body = io.NopCloser(bytes.NewBufferString(tt.payload))
+
+			if !tt.withoutIfMatch {
+				ifMatch := tt.ifMatch
+				if ifMatch == "" {
+					ifMatch = `"1"`
+
+					if er, err := models.FindUserExtensionResource(context.Background(), s.db, tt.params.ByName("resource-id")); err == nil {
+						ifMatch = revisionETag(er.Revision)
+					}
+				}
+
+				req.Header.Set("If-Match", ifMatch)
+			}
+
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
 			c.Request = req
 			c.Params = tt.params
 			c.Set(ginaudit.AuditIDContextKey, auditID)
@@ -0,0 +1,161 @@
+package v1alpha1
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"regexp"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/eventbus"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// userFieldSubscriptionNameRegexp matches the names of the user field
+// subscriptions, they are a single subject token
+var userFieldSubscriptionNameRegexp = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// UserFieldSubscriptions are the user field subscriptions and the user fields
+// they can register interest in
+type UserFieldSubscriptions struct {
+	Fields        []string                        `json:"fields"`
+	Subscriptions []*models.UserFieldSubscription `json:"subscriptions"`
+}
+
+// UserFieldSubscriptionReq is a request to create or update a user field subscription
+type UserFieldSubscriptionReq struct {
+	Fields      []string `json:"fields" binding:"required,min=1"`
+	Description string   `json:"description"`
+}
+
+// listUserFieldSubscriptions lists the user field subscriptions
+func (r *Router) listUserFieldSubscriptions(c *gin.Context) {
+	subscriptions, err := models.UserFieldSubscriptions(qm.OrderBy("name")).All(c.Request.Context(), r.DB)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error listing user field subscriptions: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, UserFieldSubscriptions{
+		Fields:        eventbus.UserFields,
+		Subscriptions: subscriptions,
+	})
+}
+
+// updateUserFieldSubscription creates or updates a user field subscription,
+// its events are published on the users.fields.<name> subject
+func (r *Router) updateUserFieldSubscription(c *gin.Context) {
+	name := c.Param("name")
+
+	if !userFieldSubscriptionNameRegexp.MatchString(name) {
+		sendError(c, http.StatusBadRequest, "invalid user field subscription name, only lowercase letters, digits, '-' and '_' are allowed: "+name)
+		return
+	}
+
+	req := UserFieldSubscriptionReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	if err := eventbus.ValidateUserFields(req.Fields); err != nil {
+		sendErrorFromErr(c, http.StatusBadRequest, err)
+		return
+	}
+
+	subscription, err := models.UserFieldSubscriptions(qm.Where("name = ?", name)).One(c.Request.Context(), r.DB)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		sendError(c, http.StatusInternalServerError, "error getting user field subscription: "+err.Error())
+		return
+	}
+
+	if subscription == nil {
+		subscription = &models.UserFieldSubscription{Name: name}
+	}
+
+	original := *subscription
+
+	fields := slices.Clone(req.Fields)
+	slices.Sort(fields)
+
+	subscription.Fields = slices.Compact(fields)
+	subscription.Description = req.Description
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if subscription.ID == "" {
+			err = subscription.Insert(c.Request.Context(), tx, boil.Infer())
+		} else {
+			_, err = subscription.Update(c.Request.Context(), tx, boil.Infer())
+		}
+
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error updating user field subscription: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditUserFieldSubscriptionUpdated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, subscription)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error updating user field subscription (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating user field subscription (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	if r.EventRules != nil {
+		r.EventRules.Invalidate()
+	}
+
+	c.JSON(http.StatusAccepted, subscription)
+}
+
+// deleteUserFieldSubscription deletes a user field subscription, its events
+// stop being published
+func (r *Router) deleteUserFieldSubscription(c *gin.Context) {
+	name := c.Param("name")
+
+	subscription, err := models.UserFieldSubscriptions(qm.Where("name = ?", name)).One(c.Request.Context(), r.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeUserFieldSubscriptionNotFound, "user field subscription not found: "+name)
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting user field subscription: "+err.Error())
+
+		return
+	}
+
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := subscription.Delete(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting user field subscription: "+err.Error(), err)
+		}
+
+		event, err := dbtools.AuditUserFieldSubscriptionDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), subscription)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting user field subscription (audit): "+err.Error(), err)
+		}
+
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting user field subscription (audit): "+err.Error(), err)
+		}
+
+		return nil
+	}) {
+		return
+	}
+
+	if r.EventRules != nil {
+		r.EventRules.Invalidate()
+	}
+
+	c.JSON(http.StatusAccepted, subscription)
+}
@@ -0,0 +1,279 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/auditevent/ginaudit"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// maxImportUsersItems is the maximum number of users that can be imported in a single batch
+const maxImportUsersItems = 100
+
+// ImportUsersReq is the payload to create or update a batch of users
+type ImportUsersReq struct {
+	Users []UserReq `json:"users" binding:"required"`
+}
+
+// ImportUsersResult is the result of importing a single user of a batch.
+// MatchedBy is the identifier that matched an existing user, it is empty when
+// the user was created. The error is only set when the import failed.
+type ImportUsersResult struct {
+	Index     int            `json:"index"`
+	UserID    string         `json:"user_id,omitempty"`
+	MatchedBy string         `json:"matched_by,omitempty"`
+	Created   bool           `json:"created"`
+	Changed   bool           `json:"changed"`
+	Status    int            `json:"status"`
+	Error     *ErrorResponse `json:"error,omitempty"`
+}
+
+// userMatcher returns the matcher of the inbound user records
+func (r *Router) userMatcher() *dbtools.UserMatcher {
+	if r.UserMatcher == nil {
+		return dbtools.DefaultUserMatcher()
+	}
+
+	return r.UserMatcher
+}
+
+// userReqIdentity returns the identifiers of a user request
+func userReqIdentity(req *UserReq) (dbtools.UserIdentity, error) {
+	identity := dbtools.UserIdentity{
+		ExternalID: req.ExternalID,
+		Email:      req.Email,
+	}
+
+	if req.GithubID != "" {
+		ghID, err := strconv.ParseInt(req.GithubID, 10, 64) //nolint:gomnd
+		if err != nil {
+			return identity, err
+		}
+
+		identity.GithubID = ghID
+	}
+
+	return identity, nil
+}
+
+// changedUserIdentity returns the identifiers changed by a user update, the
+// pending email is used instead of the user email when it is set
+func changedUserIdentity(original, user *models.User, pendingEmail string) dbtools.UserIdentity {
+	identity := dbtools.UserIdentity{}
+
+	if user.ExternalID.String != original.ExternalID.String {
+		identity.ExternalID = user.ExternalID.String
+	}
+
+	switch {
+	case pendingEmail != "":
+		identity.Email = pendingEmail
+	case user.Email != original.Email:
+		identity.Email = user.Email
+	}
+
+	if user.GithubID.Int64 != original.GithubID.Int64 {
+		identity.GithubID = user.GithubID.Int64
+	}
+
+	return identity
+}
+
+// userReqUnchanged returns true when updating the user with the request wouldn't change anything
+func userReqUnchanged(user *models.User, req *UserReq) bool {
+	return (req.AvatarURL == "" || req.AvatarURL == user.AvatarURL.String) &&
+		(req.Email == "" || req.Email == user.Email) &&
+		(req.ExternalID == "" || req.ExternalID == user.ExternalID.String) &&
+		(req.GithubID == "" || (user.GithubID.Valid && req.GithubID == strconv.FormatInt(user.GithubID.Int64, 10))) &&
+		(req.GithubUsername == "" || req.GithubUsername == user.GithubUsername.String) &&
+		(req.Name == "" || req.Name == user.Name) &&
+		(req.Status == "" || req.Status == user.Status.String)
+}
+
+// matchUserReq returns the existing user matching the request and the
+// identifier it matched on. The pending status of the request is dropped for
+// existing users, it only applies to the users created by the request.
+func (r *Router) matchUserReq(c *gin.Context, req *UserReq) (*models.User, string, error) {
+	identity, err := userReqIdentity(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("error parsing github id string as int: %w", err)
+	}
+
+	user, matchedBy, err := r.userMatcher().Match(c.Request.Context(), r.DB, identity)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if user != nil && req.Status == UserStatusPending {
+		req.Status = ""
+	}
+
+	return user, matchedBy, nil
+}
+
+// upsertUser creates the user in the request, or updates the existing user
+// it matches. Users are matched with the configured identity matching
+// strategy, the same way as the user create and update endpoints.
+func (r *Router) upsertUser(c *gin.Context) {
+	req := UserReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	user, _, err := r.matchUserReq(c, &req)
+	if err != nil {
+		sendError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if user == nil {
+		delegateUpsert(c, r.createUser, req, true)
+		return
+	}
+
+	if userReqUnchanged(user, &req) {
+		sendUpsertUnchanged(c, user.ID, user)
+		return
+	}
+
+	c.AddParam("id", user.ID)
+	delegateUpsert(c, r.updateUser, req, false)
+}
+
+// importUsers creates or updates a batch of users. Every user is matched and
+// imported independently, exactly as if it was upserted on its own, so a
+// failing user doesn't affect the rest of the batch. The response lists the
+// result of each user in order.
+func (r *Router) importUsers(c *gin.Context) {
+	req := &ImportUsersReq{}
+	if !bindRequest(c, req) {
+		return
+	}
+
+	if len(req.Users) == 0 {
+		sendError(c, http.StatusBadRequest, "at least one user is required")
+		return
+	}
+
+	if len(req.Users) > maxImportUsersItems {
+		sendError(c, http.StatusBadRequest, fmt.Sprintf("at most %d users can be imported at once", maxImportUsersItems))
+		return
+	}
+
+	results := make([]ImportUsersResult, len(req.Users))
+	auditData := []*json.RawMessage{}
+
+	for i := range req.Users {
+		results[i] = r.importUsersItem(c, i, &req.Users[i])
+
+		if data, ok := c.Get(ginaudit.AuditDataContextKey); ok {
+			if ed, ok := data.(*json.RawMessage); ok && ed != nil {
+				auditData = append(auditData, ed)
+			}
+
+			c.Set(ginaudit.AuditDataContextKey, nil)
+		}
+	}
+
+	if len(auditData) > 0 {
+		ev, err := json.Marshal(auditData)
+		if err != nil {
+			sendError(c, http.StatusBadRequest, "error updating audit event data: "+err.Error())
+			return
+		}
+
+		j := json.RawMessage(ev)
+
+		c.Set(ginaudit.AuditDataContextKey, &j)
+	}
+
+	c.JSON(http.StatusOK, results)
+}
+
+// importUsersItem matches and imports a single user of a batch
+func (r *Router) importUsersItem(c *gin.Context, i int, req *UserReq) ImportUsersResult {
+	result := ImportUsersResult{Index: i}
+
+	user, matchedBy, err := r.matchUserReq(c, req)
+	if err != nil {
+		return result.withError(http.StatusBadRequest, ErrCodeValidationFailed, err.Error())
+	}
+
+	if user == nil {
+		result.Created = true
+		result.Changed = true
+		result.Status, result.UserID, result.Error = delegateImportUser(c, r.createUser, "", req)
+
+		return result
+	}
+
+	result.UserID = user.ID
+	result.MatchedBy = matchedBy
+
+	if userReqUnchanged(user, req) {
+		result.Status = http.StatusOK
+		return result
+	}
+
+	result.Changed = true
+	result.Status, _, result.Error = delegateImportUser(c, r.updateUser, user.ID, req)
+
+	return result
+}
+
+// delegateImportUser runs the handler creating or updating a single user with
+// the user id as path parameter and the user as the request body. It returns
+// the status of the handler response, the id of the user and its error, if any.
+func delegateImportUser(c *gin.Context, handler gin.HandlerFunc, id string, req *UserReq) (int, string, *ErrorResponse) {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return http.StatusBadRequest, "", &ErrorResponse{Code: ErrCodeBadRequest, Message: "error encoding request: " + err.Error()}
+	}
+
+	origBody, origParams, origWriter := c.Request.Body, c.Params, c.Writer
+
+	c.Request.Body = io.NopCloser(bytes.NewReader(b))
+	c.Params = gin.Params{{Key: "id", Value: id}}
+
+	w := &upsertResponseWriter{ResponseWriter: origWriter, status: http.StatusOK}
+	c.Writer = w
+
+	handler(c)
+
+	c.Request.Body, c.Params, c.Writer = origBody, origParams, origWriter
+
+	if w.status < http.StatusMultipleChoices {
+		user := struct {
+			ID string `json:"id"`
+		}{}
+
+		if err := json.Unmarshal(w.body.Bytes(), &user); err != nil {
+			return w.status, id, nil
+		}
+
+		return w.status, user.ID, nil
+	}
+
+	resp := &ErrorResponse{}
+	if err := json.Unmarshal(w.body.Bytes(), resp); err != nil {
+		resp = &ErrorResponse{Code: errorCodeForStatus(w.status), Message: w.body.String()}
+	}
+
+	return w.status, id, resp
+}
+
+// withError returns the result with the given error
+func (p ImportUsersResult) withError(status int, code ErrorCode, msg string) ImportUsersResult {
+	p.Status = status
+	p.Error = &ErrorResponse{Code: code, Message: msg, Error: msg}
+
+	return p
+}
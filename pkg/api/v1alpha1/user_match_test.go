@@ -0,0 +1,54 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+func TestUserReqIdentity(t *testing.T) {
+	identity, err := userReqIdentity(&UserReq{ExternalID: "00u1", Email: "a@example.com", GithubID: "42"})
+	require.NoError(t, err)
+	assert.Equal(t, dbtools.UserIdentity{ExternalID: "00u1", Email: "a@example.com", GithubID: 42}, identity)
+
+	_, err = userReqIdentity(&UserReq{GithubID: "octocat"})
+	assert.Error(t, err)
+}
+
+func TestChangedUserIdentity(t *testing.T) {
+	original := &models.User{
+		Email:      "a@example.com",
+		ExternalID: null.StringFrom("00u1"),
+		GithubID:   null.Int64From(42),
+	}
+
+	user := *original
+	assert.Equal(t, dbtools.UserIdentity{}, changedUserIdentity(original, &user, ""))
+
+	user.ExternalID = null.StringFrom("00u2")
+	user.GithubID = null.Int64From(43)
+	assert.Equal(t, dbtools.UserIdentity{ExternalID: "00u2", GithubID: 43}, changedUserIdentity(original, &user, ""))
+
+	assert.Equal(t, "b@example.com", changedUserIdentity(original, &user, "b@example.com").Email, "pending emails are checked")
+}
+
+func TestUserReqUnchanged(t *testing.T) {
+	user := &models.User{
+		Email:    "a@example.com",
+		Name:     "A",
+		GithubID: null.Int64From(42),
+		Status:   null.StringFrom(UserStatusActive),
+	}
+
+	assert.True(t, userReqUnchanged(user, &UserReq{}))
+	assert.True(t, userReqUnchanged(user, &UserReq{Email: "a@example.com", Name: "A", GithubID: "42", Status: UserStatusActive}))
+	assert.False(t, userReqUnchanged(user, &UserReq{Name: "B"}))
+	assert.False(t, userReqUnchanged(user, &UserReq{GithubID: "43"}))
+	assert.False(t, userReqUnchanged(user, &UserReq{ExternalID: "00u1"}))
+	assert.False(t, userReqUnchanged(user, &UserReq{Status: UserStatusSuspended}))
+}
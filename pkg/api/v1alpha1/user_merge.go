@@ -0,0 +1,68 @@
+package v1alpha1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/metal-toolbox/governor-api/internal/dbtools"
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+// DuplicateUsers is a set of users that probably belong to the same person,
+// Reason is the attribute they share, either email or github_id, and Match
+// its value
+type DuplicateUsers struct {
+	Reason string         `json:"reason"`
+	Match  string         `json:"match"`
+	Users  []*models.User `json:"users"`
+}
+
+// UserMergeRecords is the number of records moved to a user by a merge
+type UserMergeRecords = dbtools.UserMergeCounts
+
+// UserMerge is the response to merging a duplicate user into another user
+type UserMerge struct {
+	User                *models.User      `json:"user"`
+	MergedUser          *models.User      `json:"merged_user"`
+	MovedMemberships    []string          `json:"moved_memberships"`
+	CombinedMemberships []string          `json:"combined_memberships"`
+	Records             *UserMergeRecords `json:"records"`
+}
+
+// listDuplicateUsers lists the users that share an email address, ignoring
+// case and any +suffix, or a github id
+func (r *Router) listDuplicateUsers(c *gin.Context) {
+	dups, err := r.svc().ListDuplicateUsers(c.Request.Context())
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error listing duplicate users: "+err.Error())
+		return
+	}
+
+	resp := make([]*DuplicateUsers, len(dups))
+	for i, d := range dups {
+		resp[i] = &DuplicateUsers{
+			Reason: d.Reason,
+			Match:  d.Match,
+			Users:  d.Users,
+		}
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// mergeUsers merges the user :other into the user :id and soft deletes it
+func (r *Router) mergeUsers(c *gin.Context) {
+	merge, auditEvents, err := r.svc().MergeUsers(c.Request.Context(), ctxActor(c), c.Param("id"), c.Param("other"))
+	if !handleServiceResult(c, auditEvents, err) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, UserMerge{
+		User:                merge.User,
+		MergedUser:          merge.Merged,
+		MovedMemberships:    merge.MovedMemberships,
+		CombinedMemberships: merge.CombinedMemberships,
+		Records:             merge.Records,
+	})
+}
@@ -1,8 +1,10 @@
 package v1alpha1
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 
@@ -15,6 +17,9 @@ import (
 
 	"github.com/metal-toolbox/governor-api/internal/dbtools"
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
 	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
 )
 
@@ -40,29 +45,86 @@ type User struct {
 	NotificationPreferences dbtools.UserNotificationPreferences `json:"notification_preferences,omitempty"`
 }
 
+// UserAccess is the effective access of a user, with the groups it is a
+// direct or indirect member of and the applications those groups grant
+type UserAccess struct {
+	UserID       string                   `json:"user_id"`
+	Groups       []*UserAccessGroup       `json:"groups"`
+	Applications []*UserAccessApplication `json:"applications"`
+}
+
+// UserAccessGroup is a group a user is a member of and every path through
+// which the membership comes about
+type UserAccessGroup struct {
+	ID     string           `json:"id"`
+	Name   string           `json:"name"`
+	Slug   string           `json:"slug"`
+	Direct bool             `json:"direct"`
+	Paths  []UserAccessPath `json:"paths"`
+}
+
+// UserAccessPath is a path of membership, Groups lists the group ids from the
+// user's direct membership to the group and Hierarchies lists the ids of the
+// group hierarchies linking them
+type UserAccessPath struct {
+	Groups      []string `json:"groups"`
+	Hierarchies []string `json:"hierarchies"`
+}
+
+// UserAccessApplication is an application a user has access to and the ids of
+// the groups granting it
+type UserAccessApplication struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Slug      string   `json:"slug"`
+	GrantedBy []string `json:"granted_by"`
+}
+
 // UserReq is a user request payload
 type UserReq struct {
 	AvatarURL      string `json:"avatar_url,omitempty"`
-	Email          string `json:"email"`
+	Email          string `json:"email" binding:"omitempty,email"`
 	ExternalID     string `json:"external_id"`
 	GithubID       string `json:"github_id,omitempty"`
 	GithubUsername string `json:"github_username,omitempty"`
 	Name           string `json:"name"`
-	Status         string `json:"status,omitempty"`
+	Status         string `json:"status,omitempty" binding:"omitempty,oneof=active pending suspended"`
+}
+
+// RemoveUserGroupsReq is a request to remove a user from the listed groups, or from every group
+type RemoveUserGroupsReq struct {
+	GroupIDs []string `json:"group_ids" binding:"required_without=All,excluded_with=All,omitempty,dive,uuid"`
+	All      bool     `json:"all"`
+}
+
+// RemovedUserGroups lists the groups a user was removed from
+type RemovedUserGroups struct {
+	UserID   string   `json:"user_id"`
+	GroupIDs []string `json:"group_ids"`
 }
 
 // listUsers responds with the list of all users
 func (r *Router) listUsers(c *gin.Context) {
-	queryMods := []qm.QueryMod{}
+	queryMods := []qm.QueryMod{tenancy.Scope(c.Request.Context(), models.TableNames.Users)}
+	filters := []qm.QueryMod{}
 
 	if _, ok := c.GetQuery("deleted"); ok {
 		queryMods = append(queryMods, qm.WithDeleted())
 	}
 
+	since, ok := updatedSinceParam(c)
+	if !ok {
+		return
+	}
+
+	if since != nil {
+		queryMods = append(queryMods, dbtools.ChangedSince(models.TableNames.Users, *since)...)
+	}
+
 	for k, val := range c.Request.URL.Query() {
 		r.Logger.Debug("checking query", zap.String("url.query.key", k), zap.Strings("url.query.value", val))
 
-		if k == "deleted" {
+		if k == "deleted" || k == "updated_since" {
 			continue
 		}
 
@@ -87,13 +149,18 @@ func (r *Router) listUsers(c *gin.Context) {
 			// index if performance is an issue: CREATE INDEX ON users (LOWER(email));
 			// alternatives are to use ILIKE which is postgres specific, and require sanitizing '%' if we want exact matches
 			for _, v := range convertedVals {
-				queryMods = append(queryMods, qm.Or("LOWER(email) = LOWER(?)", v))
+				filters = append(filters, qm.Or("LOWER(email) = LOWER(?)", v))
 			}
 		default:
-			queryMods = append(queryMods, qm.Or2(qm.WhereIn(k+" IN ?", convertedVals...)))
+			filters = append(filters, qm.Or2(qm.WhereIn(k+" IN ?", convertedVals...)))
 		}
 	}
 
+	// the filters are or'ed together, they are grouped so they don't widen the scope
+	if len(filters) > 0 {
+		queryMods = append(queryMods, qm.Expr(filters...))
+	}
+
 	users, err := models.Users(queryMods...).All(c.Request.Context(), r.DB)
 	if err != nil {
 		r.Logger.Error("error fetching users", zap.Error(err))
@@ -107,21 +174,12 @@ func (r *Router) listUsers(c *gin.Context) {
 
 // getUser gets a user
 func (r *Router) getUser(c *gin.Context) {
-	id := c.Param("id")
+	_, deleted := c.GetQuery("deleted")
 
-	queryMods := []qm.QueryMod{
-		qm.Where("id = ?", id),
-		qm.Load("GroupMembershipRequests"),
-	}
-
-	if _, ok := c.GetQuery("deleted"); ok {
-		queryMods = append(queryMods, qm.WithDeleted())
-	}
-
-	user, err := models.Users(queryMods...).One(c.Request.Context(), r.DB)
+	details, err := r.svc().GetUser(c.Request.Context(), c.Param("id"), deleted)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "user not found: "+err.Error())
+		if errors.Is(err, service.ErrUserNotFound) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeUserNotFound, "user not found: "+err.Error())
 			return
 		}
 
@@ -130,60 +188,78 @@ func (r *Router) getUser(c *gin.Context) {
 		return
 	}
 
-	enumeratedMemberships, err := dbtools.GetMembershipsForUser(c.Request.Context(), r.DB.DB, user.ID, false)
+	c.JSON(http.StatusOK, User{
+		User:                    details.User,
+		Memberships:             details.Memberships,
+		MembershipsDirect:       details.MembershipsDirect,
+		MembershipRequests:      details.MembershipRequests,
+		NotificationPreferences: details.NotificationPreferences,
+	})
+}
+
+// getUserAccess responds with the effective access of a user, including
+// how each group membership and application grant comes about
+func (r *Router) getUserAccess(c *gin.Context) {
+	access, err := r.svc().GetUserAccess(c.Request.Context(), c.Param("id"))
 	if err != nil {
-		sendError(c, http.StatusInternalServerError, "error enumerating group membership: "+err.Error())
-		return
-	}
+		if errors.Is(err, service.ErrUserNotFound) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeUserNotFound, "user not found: "+err.Error())
+			return
+		}
 
-	memberships := make([]string, len(enumeratedMemberships))
+		sendError(c, http.StatusInternalServerError, "error getting user access: "+err.Error())
 
-	membershipsDirect := make([]string, 0)
+		return
+	}
 
-	for i, m := range enumeratedMemberships {
-		memberships[i] = m.GroupID
+	resp := UserAccess{
+		UserID:       access.User.ID,
+		Groups:       make([]*UserAccessGroup, len(access.Groups)),
+		Applications: make([]*UserAccessApplication, len(access.Applications)),
+	}
 
-		if m.Direct {
-			membershipsDirect = append(membershipsDirect, m.GroupID)
+	for i, g := range access.Groups {
+		paths := make([]UserAccessPath, len(g.Paths))
+		for j, p := range g.Paths {
+			paths[j] = UserAccessPath{
+				Groups:      p.Path,
+				Hierarchies: p.HierarchyIDs,
+			}
 		}
-	}
 
-	requests := make([]string, len(user.R.GroupMembershipRequests))
-	for i, r := range user.R.GroupMembershipRequests {
-		requests[i] = r.GroupID
+		resp.Groups[i] = &UserAccessGroup{
+			ID:     g.Group.ID,
+			Name:   g.Group.Name,
+			Slug:   g.Group.Slug,
+			Direct: g.Direct,
+			Paths:  paths,
+		}
 	}
 
-	notificationPreferences, err := dbtools.GetNotificationPreferences(c.Request.Context(), id, r.DB, true)
-	if err != nil {
-		sendError(c, http.StatusInternalServerError, "error getting notification preferences: "+err.Error())
-		return
+	for i, a := range access.Applications {
+		resp.Applications[i] = &UserAccessApplication{
+			ID:        a.Application.ID,
+			Name:      a.Application.Name,
+			Slug:      a.Application.Slug,
+			GrantedBy: a.GrantedBy,
+		}
 	}
 
-	c.JSON(http.StatusOK, User{
-		User:                    user,
-		Memberships:             memberships,
-		MembershipsDirect:       membershipsDirect,
-		MembershipRequests:      requests,
-		NotificationPreferences: notificationPreferences,
-	})
+	c.JSON(http.StatusOK, resp)
 }
 
 // createUser creates a user in the database
 func (r *Router) createUser(c *gin.Context) {
 	req := UserReq{}
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
 		return
 	}
 
 	// check for required parameters
-	if req.Email == "" {
-		sendError(c, http.StatusBadRequest, "missing email in user request")
-		return
-	}
-
-	if req.Name == "" {
-		sendError(c, http.StatusBadRequest, "missing name in user request")
+	if !validateFields(c,
+		fieldRule{"email", req.Email, "required"},
+		fieldRule{"name", req.Name, "required"},
+	) {
 		return
 	}
 
@@ -192,17 +268,21 @@ func (r *Router) createUser(c *gin.Context) {
 		Name:  req.Name,
 	}
 
+	identity, err := userReqIdentity(&req)
+	if err != nil {
+		sendError(c, http.StatusBadRequest, "error parsing github id string as int")
+		return
+	}
+
 	// check if user already exists
-	exists, err := models.Users(
-		qm.Where("LOWER(email) = LOWER(?)", req.Email),
-	).Exists(c.Request.Context(), r.DB)
+	existing, matchedBy, err := r.userMatcher().Match(c.Request.Context(), r.DB, identity)
 	if err != nil {
 		sendError(c, http.StatusInternalServerError, "error checking user exists: "+err.Error())
 		return
 	}
 
-	if exists {
-		sendError(c, http.StatusConflict, "user already exists")
+	if existing != nil {
+		sendErrorWithCode(c, http.StatusConflict, ErrCodeUserAlreadyExists, "user already exists, matched on "+matchedBy)
 		return
 	}
 
@@ -238,58 +318,24 @@ func (r *Router) createUser(c *gin.Context) {
 		user.Status = null.StringFrom(UserStatusPending)
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting create user transaction: "+err.Error())
-		return
-	}
-
-	if err := user.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := "error creating user: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditUserCreatedWithActor(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), user)
-	if err != nil {
-		msg := "error creating user (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error creating user (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if err := user.Insert(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating user: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing user create, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		if err := r.recordChange(c, tx, events.GovernorUsersEventSubject, &events.Event{
+			Action:  events.GovernorEventCreate,
+			GroupID: "",
+			UserID:  user.ID,
+			After:   user,
+		}, func(ctx context.Context, exec boil.ContextExecutor, pID string, actor *models.User) (*models.AuditEvent, error) {
+			return dbtools.AuditUserCreatedWithActor(ctx, exec, pID, actor, user)
+		}); err != nil {
+			return abortTx(http.StatusBadRequest, "error creating user (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
@@ -299,17 +345,7 @@ func (r *Router) createUser(c *gin.Context) {
 		return
 	}
 
-	if err := r.EventBus.Publish(c.Request.Context(), events.GovernorUsersEventSubject, &events.Event{
-		Version: events.Version,
-		Action:  events.GovernorEventCreate,
-		AuditID: c.GetString(ginaudit.AuditIDContextKey),
-		ActorID: getCtxActorID(c),
-		GroupID: "",
-		UserID:  user.ID,
-	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish user create event, downstream changes may be delayed "+err.Error())
-		return
-	}
+	r.publishChanges(c)
 
 	c.JSON(http.StatusAccepted, user)
 }
@@ -324,7 +360,7 @@ func (r *Router) updateUser(c *gin.Context) {
 	user, err := models.FindUser(c.Request.Context(), r.DB, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "user not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeUserNotFound, "user not found: "+err.Error())
 			return
 		}
 
@@ -338,8 +374,7 @@ func (r *Router) updateUser(c *gin.Context) {
 	userActivated := false
 
 	req := UserReq{}
-	if err := c.BindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, "unable to bind request: "+err.Error())
+	if !bindRequest(c, &req) {
 		return
 	}
 
@@ -352,8 +387,15 @@ func (r *Router) updateUser(c *gin.Context) {
 		user.AvatarURL = null.StringFrom(req.AvatarURL)
 	}
 
+	// with email verification the new email is only set once confirmed
+	pendingEmail := ""
+
 	if req.Email != "" {
-		user.Email = req.Email
+		if r.EmailVerifier != nil && req.Email != user.Email {
+			pendingEmail = req.Email
+		} else {
+			user.Email = req.Email
+		}
 	}
 
 	if req.ExternalID != "" {
@@ -396,59 +438,63 @@ func (r *Router) updateUser(c *gin.Context) {
 		user.GithubUsername = null.StringFrom(req.GithubUsername)
 	}
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting update transaction: "+err.Error())
-		return
-	}
-
-	if _, err := user.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
-		msg := "error updating user: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	// changed identifiers can't match another user
+	if identity := changedUserIdentity(&original, user, pendingEmail); identity != (dbtools.UserIdentity{}) {
+		existing, matchedBy, err := r.userMatcher().Match(c.Request.Context(), r.DB, identity, qm.Where("id != ?", user.ID))
+		if err != nil {
+			sendError(c, http.StatusInternalServerError, "error checking user exists: "+err.Error())
+			return
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
+		if existing != nil {
+			sendErrorWithCode(c, http.StatusConflict, ErrCodeUserAlreadyExists, "another user already exists, matched on "+matchedBy)
+			return
+		}
 	}
 
-	event, err := dbtools.AuditUserUpdated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, user)
-	if err != nil {
-		msg := "error updating user (audit): " + err.Error()
+	var emailChange *models.UserEmailChange
 
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		if _, err := user.Update(c.Request.Context(), tx, boil.Infer()); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating user: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		event, err := dbtools.AuditUserUpdated(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, user)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error updating user (audit): "+err.Error(), err)
+		}
 
-		return
-	}
+		auditEvents := []*models.AuditEvent{event}
 
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error updating user (audit): " + err.Error()
+		if pendingEmail != "" {
+			var emailEvent *models.AuditEvent
 
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+			emailChange, emailEvent, err = r.createEmailChange(c, tx, user, pendingEmail)
+			if err != nil {
+				return abortTx(http.StatusBadRequest, err.Error(), err)
+			}
+
+			auditEvents = append(auditEvents, emailEvent)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
+		if err := updateContextWithAuditEventData(c, auditEvents); err != nil {
+			return abortTx(http.StatusBadRequest, "error updating user (audit): "+err.Error(), err)
+		}
 
+		return nil
+	}) {
 		return
 	}
 
-	if err := tx.Commit(); err != nil {
-		msg := "error committing user update, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+	if emailChange != nil {
+		// the verification is still sent when its event is queued in the outbox
+		switch err := r.publishEmailVerification(c.Request.Context(), c, emailChange); {
+		case errors.Is(err, eventbus.ErrQueued):
+			acceptChange(c, fmt.Errorf("failed to publish email verification event: %w", err))
+		case err != nil:
+			sendError(c, http.StatusBadRequest, "failed to publish email verification event, the email change must be requested again "+err.Error())
+			return
 		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
 	}
 
 	// only publish events for active users
@@ -469,6 +515,7 @@ func (r *Router) updateUser(c *gin.Context) {
 			ActorID: getCtxActorID(c),
 			GroupID: "",
 			UserID:  user.ID,
+			After:   user,
 		}); err != nil {
 			r.Logger.Warn("failed to publish user create event, downstream changes may be delayed", zap.Error(err))
 		}
@@ -483,12 +530,13 @@ func (r *Router) updateUser(c *gin.Context) {
 
 		for _, m := range memberships {
 			if err := r.EventBus.Publish(c.Request.Context(), events.GovernorMembersEventSubject, &events.Event{
-				Version: events.Version,
+				Version: events.MemberVersion,
 				Action:  events.GovernorEventCreate,
 				AuditID: c.GetString(ginaudit.AuditIDContextKey),
 				ActorID: getCtxActorID(c),
 				GroupID: m.GroupID,
 				UserID:  user.ID,
+				Member:  dbtools.GroupMembershipEventMember(m),
 			}); err != nil {
 				r.Logger.Warn("failed to publish members create event, downstream changes may be delayed", zap.Error(err))
 			}
@@ -506,9 +554,10 @@ func (r *Router) updateUser(c *gin.Context) {
 		ActorID: getCtxActorID(c),
 		GroupID: "",
 		UserID:  user.ID,
+		Before:  &original,
+		After:   user,
 	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish user update event, downstream changes may be delayed "+err.Error())
-		return
+		acceptChange(c, fmt.Errorf("failed to publish user update event: %w", err))
 	}
 
 	c.JSON(http.StatusAccepted, user)
@@ -525,7 +574,7 @@ func (r *Router) deleteUser(c *gin.Context) {
 	).One(c.Request.Context(), r.DB)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			sendError(c, http.StatusNotFound, "user not found: "+err.Error())
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeUserNotFound, "user not found: "+err.Error())
 			return
 		}
 
@@ -536,85 +585,33 @@ func (r *Router) deleteUser(c *gin.Context) {
 
 	original := *user
 
-	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
-	if err != nil {
-		sendError(c, http.StatusBadRequest, "error starting delete transaction: "+err.Error())
-		return
-	}
-
-	// delete all group memberships
-	if _, err := user.R.GroupMemberships.DeleteAll(c.Request.Context(), tx); err != nil {
-		msg := "error deleting group membership, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+	if !r.withTx(c, func(tx *sql.Tx) error {
+		// delete all group memberships
+		if _, err := user.R.GroupMemberships.DeleteAll(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting group membership, rolling back: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	// delete all group membership requests
-	if _, err := user.R.GroupMembershipRequests.DeleteAll(c.Request.Context(), tx); err != nil {
-		msg := "error deleting group membership requests, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		// delete all group membership requests
+		if _, err := user.R.GroupMembershipRequests.DeleteAll(c.Request.Context(), tx); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting group membership requests, rolling back: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	// soft delete the user
-	if _, err := user.Delete(c.Request.Context(), tx, false); err != nil {
-		msg := "error deleting user, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		// soft delete the user
+		if _, err := user.Delete(c.Request.Context(), tx, false); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting user, rolling back: "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	event, err := dbtools.AuditUserDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, user)
-	if err != nil {
-		msg := "error deleting user (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
-		}
-
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := updateContextWithAuditEventData(c, event); err != nil {
-		msg := "error deleting user (audit): " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg += "error rolling back transaction: " + err.Error()
+		event, err := dbtools.AuditUserDeleted(c.Request.Context(), tx, getCtxAuditID(c), getCtxUser(c), &original, user)
+		if err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting user (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
-		return
-	}
-
-	if err := tx.Commit(); err != nil {
-		msg := "error committing user delete, rolling back: " + err.Error()
-
-		if err := tx.Rollback(); err != nil {
-			msg = msg + "error rolling back transaction: " + err.Error()
+		if err := updateContextWithAuditEventData(c, event); err != nil {
+			return abortTx(http.StatusBadRequest, "error deleting user (audit): "+err.Error(), err)
 		}
 
-		sendError(c, http.StatusBadRequest, msg)
-
+		return nil
+	}) {
 		return
 	}
 
@@ -631,9 +628,9 @@ func (r *Router) deleteUser(c *gin.Context) {
 		ActorID: getCtxActorID(c),
 		GroupID: "",
 		UserID:  user.ID,
+		Before:  &original,
 	}); err != nil {
-		sendError(c, http.StatusBadRequest, "failed to publish user delete event, downstream changes may be delayed "+err.Error())
-		return
+		acceptChange(c, fmt.Errorf("failed to publish user delete event: %w", err))
 	}
 
 	c.JSON(http.StatusAccepted, user)
@@ -648,3 +645,28 @@ func isActiveUser(user *models.User) bool {
 
 	return user.Status.String == UserStatusActive || user.Status.String == UserStatusSuspended
 }
+
+// removeUserGroups removes a user's direct memberships from the listed groups,
+// or from every group, at once
+func (r *Router) removeUserGroups(c *gin.Context) {
+	req := RemoveUserGroupsReq{}
+	if !bindRequest(c, &req) {
+		return
+	}
+
+	if !req.All && !validateFields(c, fieldRule{"group_ids", req.GroupIDs, "min=1"}) {
+		return
+	}
+
+	auditEvents, err := r.svc().RemoveMemberships(c.Request.Context(), ctxActor(c), c.Param("id"), req.GroupIDs, req.All)
+	if !handleServiceResult(c, auditEvents, err) {
+		return
+	}
+
+	resp := RemovedUserGroups{UserID: c.Param("id"), GroupIDs: make([]string, len(auditEvents))}
+	for i, e := range auditEvents {
+		resp.GroupIDs[i] = e.SubjectGroupID.String
+	}
+
+	c.JSON(http.StatusAccepted, resp)
+}
@@ -0,0 +1,176 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+var registerJSONFieldNamesOnce sync.Once
+
+// registerJSONFieldNames makes the validator report fields by their json name
+// rather than their go struct field name
+func registerJSONFieldNames() {
+	registerJSONFieldNamesOnce.Do(func() {
+		v, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			return
+		}
+
+		v.RegisterTagNameFunc(func(f reflect.StructField) string {
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if name == "-" {
+				return ""
+			}
+
+			return name
+		})
+	})
+}
+
+// fieldRule is a validation rule for a single request field, the rule uses
+// the same syntax as the `binding` struct tags
+type fieldRule struct {
+	field string
+	value any
+	rule  string
+}
+
+// bindRequest binds the JSON request body to obj and validates it using the
+// `binding` struct tags. If binding fails, an error response listing the
+// invalid fields is sent and false is returned.
+func bindRequest(c *gin.Context, obj any) bool {
+	registerJSONFieldNames()
+
+	if err := c.ShouldBindJSON(obj); err != nil {
+		sendValidationError(c, validationErrorDetails(err))
+		return false
+	}
+
+	return true
+}
+
+// validateFields validates request fields against rules that only apply to
+// a specific handler, for example fields that are required on create but
+// optional on update. All the failed rules are reported in a single error
+// response and false is returned.
+func validateFields(c *gin.Context, rules ...fieldRule) bool {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return true
+	}
+
+	details := []ErrorDetail{}
+
+	for _, r := range rules {
+		if err := v.Var(r.value, r.rule); err != nil {
+			var verrs validator.ValidationErrors
+			if errors.As(err, &verrs) {
+				for _, fe := range verrs {
+					details = append(details, ErrorDetail{Field: r.field, Message: validationMessage(r.field, fe)})
+				}
+
+				continue
+			}
+
+			details = append(details, ErrorDetail{Field: r.field, Message: err.Error()})
+		}
+	}
+
+	if len(details) > 0 {
+		sendValidationError(c, details)
+		return false
+	}
+
+	return true
+}
+
+// validationErrorDetails converts binding and validation errors into error details
+func validationErrorDetails(err error) []ErrorDetail {
+	var (
+		verrs     validator.ValidationErrors
+		typeErr   *json.UnmarshalTypeError
+		syntaxErr *json.SyntaxError
+	)
+
+	switch {
+	case errors.As(err, &verrs):
+		details := make([]ErrorDetail, 0, len(verrs))
+
+		for _, fe := range verrs {
+			field := fieldPath(fe)
+
+			details = append(details, ErrorDetail{
+				Field:   field,
+				Message: validationMessage(field, fe),
+			})
+		}
+
+		return details
+	case errors.As(err, &typeErr):
+		return []ErrorDetail{{
+			Field:   typeErr.Field,
+			Message: fmt.Sprintf("%s must be of type %s", typeErr.Field, typeErr.Type.String()),
+		}}
+	case errors.As(err, &syntaxErr):
+		return []ErrorDetail{{
+			Message: fmt.Sprintf("malformed JSON at offset %d: %s", syntaxErr.Offset, syntaxErr.Error()),
+		}}
+	default:
+		return []ErrorDetail{{Message: err.Error()}}
+	}
+}
+
+// fieldPath returns the json path of the field without the top level struct name
+func fieldPath(fe validator.FieldError) string {
+	ns := fe.Namespace()
+
+	if _, path, ok := strings.Cut(ns, "."); ok {
+		return path
+	}
+
+	return fe.Field()
+}
+
+// validationMessage returns a human friendly message for a failed validation rule
+func validationMessage(field string, fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return field + " is required"
+	case "oneof":
+		return field + " must be one of: " + strings.ReplaceAll(fe.Param(), " ", ", ")
+	case "email":
+		return field + " must be a valid email address"
+	case "uuid", "uuid4":
+		return field + " must be a valid UUID"
+	case "url", "http_url":
+		return field + " must be a valid URL"
+	case "min":
+		return field + " must be at least " + fe.Param() + " long"
+	case "max":
+		return field + " must be at most " + fe.Param() + " long"
+	default:
+		return fmt.Sprintf("%s failed the %q validation", field, fe.Tag())
+	}
+}
+
+// sendValidationError sends a validation error response, the message
+// summarizes all the details so that clients only reading the message still
+// get every problem with the request
+func sendValidationError(c *gin.Context, details []ErrorDetail) {
+	msgs := make([]string, len(details))
+
+	for i, d := range details {
+		msgs[i] = d.Message
+	}
+
+	sendErrorWithDetails(c, http.StatusBadRequest, ErrCodeValidationFailed, "invalid request: "+strings.Join(msgs, "; "), details)
+}
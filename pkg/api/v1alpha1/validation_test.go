@@ -0,0 +1,135 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newValidationTestContext(body string) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	return c, w
+}
+
+func TestBindRequest(t *testing.T) {
+	type testReq struct {
+		Action string `json:"action" binding:"required,oneof=approve deny"`
+		Email  string `json:"email" binding:"omitempty,email"`
+		Count  int    `json:"count"`
+	}
+
+	tests := []struct {
+		name        string
+		body        string
+		wantOK      bool
+		wantDetails []ErrorDetail
+	}{
+		{
+			name:   "valid",
+			body:   `{"action":"approve","email":"user@example.com"}`,
+			wantOK: true,
+		},
+		{
+			name:   "multiple invalid fields",
+			body:   `{"email":"nope"}`,
+			wantOK: false,
+			wantDetails: []ErrorDetail{
+				{Field: "action", Message: "action is required"},
+				{Field: "email", Message: "email must be a valid email address"},
+			},
+		},
+		{
+			name:   "invalid enum",
+			body:   `{"action":"maybe"}`,
+			wantOK: false,
+			wantDetails: []ErrorDetail{
+				{Field: "action", Message: "action must be one of: approve, deny"},
+			},
+		},
+		{
+			name:   "wrong type",
+			body:   `{"action":"approve","count":"one"}`,
+			wantOK: false,
+			wantDetails: []ErrorDetail{
+				{Field: "count", Message: "count must be of type int"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, w := newValidationTestContext(tt.body)
+
+			ok := bindRequest(c, &testReq{})
+			assert.Equal(t, tt.wantOK, ok)
+
+			if tt.wantOK {
+				return
+			}
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			resp := ErrorResponse{}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, ErrCodeValidationFailed, resp.Code)
+			assert.Equal(t, tt.wantDetails, resp.Details)
+		})
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	var enabled *bool
+
+	c, w := newValidationTestContext("")
+
+	ok := validateFields(c,
+		fieldRule{"name", "", "required"},
+		fieldRule{"description", "some description", "required"},
+		fieldRule{"enabled", enabled, "required"},
+	)
+
+	assert.False(t, ok)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	resp := ErrorResponse{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "invalid request: name is required; enabled is required", resp.Message)
+	assert.Equal(t, []ErrorDetail{
+		{Field: "name", Message: "name is required"},
+		{Field: "enabled", Message: "enabled is required"},
+	}, resp.Details)
+}
+
+func TestRemoveUserGroupsReq(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		wantOK bool
+	}{
+		"Empty":       {`{}`, false},
+		"All":         {`{"all":true}`, true},
+		"GroupIDs":    {`{"group_ids":["0b1a2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"]}`, true},
+		"Both":        {`{"all":true,"group_ids":["0b1a2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"]}`, false},
+		"InvalidID":   {`{"group_ids":["not-a-uuid"]}`, false},
+		"EmptyGroups": {`{"group_ids":[]}`, false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, _ := newValidationTestContext(tt.body)
+			req := RemoveUserGroupsReq{}
+			ok := bindRequest(c, &req) && (req.All || validateFields(c, fieldRule{"group_ids", req.GroupIDs, "min=1"}))
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
@@ -3,8 +3,12 @@ package v1beta1
 import (
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/metal-toolbox/auditevent/ginaudit"
+
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
 )
 
 // ErrInvalidQueryParameterValue is returned when the query parameter value is invalid
@@ -13,12 +17,90 @@ var ErrInvalidQueryParameterValue = errors.New("InvalidQueryParameterValue")
 // ErrInvalidFunctionParameter is returned when a function parameter fails an assertion
 var ErrInvalidFunctionParameter = errors.New("InvalidFunctionParameter")
 
+// ErrorCode is a stable, machine-readable error code returned in error responses
+type ErrorCode string
+
+const (
+	// ErrCodeBadRequest is returned when the request is invalid
+	ErrCodeBadRequest ErrorCode = "bad_request"
+	// ErrCodeInvalidQueryParameter is returned when a query parameter is unknown or has an invalid value
+	ErrCodeInvalidQueryParameter ErrorCode = "invalid_query_parameter"
+	// ErrCodeUnauthorized is returned when the request is not authenticated
+	ErrCodeUnauthorized ErrorCode = "unauthorized"
+	// ErrCodeForbidden is returned when the caller is not allowed to perform the request
+	ErrCodeForbidden ErrorCode = "forbidden"
+	// ErrCodeNotFound is returned when the requested resource doesn't exist
+	ErrCodeNotFound ErrorCode = "not_found"
+	// ErrCodeConflict is returned when the request conflicts with the current state of a resource
+	ErrCodeConflict ErrorCode = "conflict"
+	// ErrCodeInternal is returned on unexpected server errors
+	ErrCodeInternal ErrorCode = "internal_error"
+	// ErrCodeGroupNotFound is returned when the group doesn't exist
+	ErrCodeGroupNotFound ErrorCode = "group_not_found"
+	// ErrCodeUserNotFound is returned when the user doesn't exist
+	ErrCodeUserNotFound ErrorCode = "user_not_found"
+	// ErrCodeTenantRequired is returned in tenancy mode when the token has no
+	// organization claim, or the claim isn't an organization
+	ErrCodeTenantRequired ErrorCode = "tenant_required"
+	// ErrCodeUnknown is returned when the error can't be classified
+	ErrCodeUnknown ErrorCode = "unknown"
+)
+
+// ErrorDetail describes a single problem with a request, for example an invalid field
+type ErrorDetail struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// ErrorResponse is the body of every error returned by the api
+type ErrorResponse struct {
+	Code      ErrorCode     `json:"code"`
+	Message   string        `json:"message"`
+	Details   []ErrorDetail `json:"details,omitempty"`
+	RequestID string        `json:"request_id,omitempty"`
+
+	// Error duplicates Message for clients that predate the structured error response
+	Error string `json:"error,omitempty"`
+}
+
+// errorCodeForStatus returns the generic error code for an http status code
+func errorCodeForStatus(status int) ErrorCode {
+	switch status {
+	case http.StatusBadRequest:
+		return ErrCodeBadRequest
+	case http.StatusUnauthorized:
+		return ErrCodeUnauthorized
+	case http.StatusForbidden:
+		return ErrCodeForbidden
+	case http.StatusNotFound:
+		return ErrCodeNotFound
+	case http.StatusConflict:
+		return ErrCodeConflict
+	}
+
+	if status >= http.StatusInternalServerError {
+		return ErrCodeInternal
+	}
+
+	return ErrCodeUnknown
+}
+
 func sendError(c *gin.Context, code int, msg string) {
-	payload := struct {
-		Error string `json:"error,omitempty"`
-	}{msg}
+	sendErrorWithCode(c, code, errorCodeForStatus(code), msg)
+}
+
+func sendErrorWithCode(c *gin.Context, code int, errCode ErrorCode, msg string) {
+	requestID := c.GetString(ginaudit.AuditIDContextKey)
+	if requestID == "" {
+		requestID = events.ExtractCorrelationID(c.Request.Context())
+	}
 
-	c.AbortWithStatusJSON(code, payload)
+	c.AbortWithStatusJSON(code, ErrorResponse{
+		Code:      errCode,
+		Message:   msg,
+		RequestID: requestID,
+		Error:     msg,
+	})
 }
 
 func invalidQueryParameterValue(msg string) error {
@@ -0,0 +1,265 @@
+package v1beta1
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+)
+
+var (
+	permittedListGroupsParams = []string{"deleted", "limit", "sort_by", "sort_order", "search", "next_cursor", "prev_cursor", "last"}
+	allowedGroupSortCols      = []string{"name", "slug", "id"}
+)
+
+// Group is a group response
+type Group struct {
+	*models.Group
+	Members            []string `json:"members"`
+	MembersDirect      []string `json:"members_direct"`
+	MembershipRequests []string `json:"membership_requests"`
+	Organizations      []string `json:"organizations"`
+	Applications       []string `json:"applications"`
+}
+
+// GroupMember is a user that belongs to a group
+type GroupMember struct {
+	ID             string      `json:"id"`
+	Name           string      `json:"name"`
+	Email          string      `json:"email"`
+	AvatarURL      string      `json:"avatar_url"`
+	Status         string      `json:"status"`
+	IsAdmin        bool        `json:"is_admin"`
+	ExpiresAt      null.Time   `json:"expires_at"`
+	AdminExpiresAt null.Time   `json:"admin_expires_at"`
+	Direct         bool        `json:"direct"`
+	Source         string      `json:"source"`
+	SourceRef      null.String `json:"source_ref"`
+}
+
+// listGroups responds with a page of groups
+func (r *Router) listGroups(c *gin.Context) {
+	ctx := c.Request.Context()
+	queryMods := []qm.QueryMod{}
+
+	for k := range c.Request.URL.Query() {
+		if !contains(permittedListGroupsParams, k) {
+			r.Logger.Warn("found illegal parameter in request", zap.String("parameter", k))
+			sendErrorWithCode(c, http.StatusBadRequest, ErrCodeInvalidQueryParameter, "illegal parameter: "+k)
+
+			return
+		}
+	}
+
+	p, err := parsePagination(c)
+	if err != nil {
+		sendErrorWithCode(c, http.StatusBadRequest, ErrCodeInvalidQueryParameter, err.Error())
+		return
+	}
+
+	_, deleted := c.GetQuery("deleted")
+
+	if search, ok := c.GetQuery("search"); ok {
+		search = "%" + strings.ToLower(search) + "%"
+		queryMods = append(queryMods, qm.Expr(
+			qm.Where("LOWER(name) like ?", search),
+			qm.Or("LOWER(slug) like ?", search),
+		))
+	}
+
+	if !contains(allowedGroupSortCols, strings.ToLower(p.SortBy)) {
+		sendErrorWithCode(c, http.StatusBadRequest, ErrCodeInvalidQueryParameter, invalidQueryParameterValue("sort_by, "+p.SortBy).Error())
+		return
+	}
+
+	// get count before orderby, limit and cursor are set
+	count, err := r.svc().CountGroups(ctx, deleted, queryMods...)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error fetching group count: "+err.Error())
+		return
+	}
+
+	// retrieve limit used + 1 so we can check if there are more records
+	queryMods = append(queryMods,
+		qm.Limit(p.Limit+1),
+		qm.OrderBy(p.SortBy+" "+p.SortOrder),
+	)
+
+	if query, param, ok := p.getCursorClause(""); ok {
+		queryMods = append(queryMods, qm.Where(query, param))
+	}
+
+	groups, err := r.svc().ListGroups(ctx, deleted, queryMods...)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error fetching groups: "+err.Error())
+		return
+	}
+
+	hasMoreRecords := len(groups) == p.Limit+1
+	if hasMoreRecords {
+		groups = groups[:len(groups)-1]
+	}
+
+	// reverse slice because we reversed ordering to get the last N records
+	if p.Last || p.PrevCursor != "" {
+		for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
+			groups[i], groups[j] = groups[j], groups[i]
+		}
+	}
+
+	prevCursorResp, err := getPrevCursor(groups, &p, hasMoreRecords)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting prev_cursor: "+err.Error())
+		return
+	}
+
+	nextCursorResp, err := getNextCursor(groups, &p, hasMoreRecords)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting next_cursor: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, &PaginationResponse[*models.Group]{
+		TotalRecordCount: count,
+		Records:          groups,
+		PrevCursor:       prevCursorResp,
+		NextCursor:       nextCursorResp,
+	})
+}
+
+// getGroup gets a group by id or slug and responds with its members,
+// membership requests, organizations and applications
+func (r *Router) getGroup(c *gin.Context) {
+	_, deleted := c.GetQuery("deleted")
+
+	details, err := r.svc().GetGroup(c.Request.Context(), c.Param("id"), deleted)
+	if err != nil {
+		sendGroupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, Group{
+		Group:              details.Group,
+		Members:            details.Members,
+		MembersDirect:      details.MembersDirect,
+		MembershipRequests: details.MembershipRequests,
+		Organizations:      details.Organizations,
+		Applications:       details.Applications,
+	})
+}
+
+// listGroupMembers responds with a page of the direct and indirect members of a group
+func (r *Router) listGroupMembers(c *gin.Context) {
+	p, err := parsePagination(c)
+	if err != nil {
+		sendErrorWithCode(c, http.StatusBadRequest, ErrCodeInvalidQueryParameter, err.Error())
+		return
+	}
+
+	if !strings.EqualFold(p.SortBy, "id") {
+		sendErrorWithCode(c, http.StatusBadRequest, ErrCodeInvalidQueryParameter, invalidQueryParameterValue("sort_by, "+p.SortBy).Error())
+		return
+	}
+
+	_, enumeratedMembers, err := r.svc().ListGroupMembers(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		sendGroupError(c, err)
+		return
+	}
+
+	members := make([]*GroupMember, len(enumeratedMembers))
+	for i, m := range enumeratedMembers {
+		members[i] = &GroupMember{
+			ID:             m.User.ID,
+			Name:           m.User.Name,
+			Email:          m.User.Email,
+			AvatarURL:      m.User.AvatarURL.String,
+			Status:         m.User.Status.String,
+			IsAdmin:        m.IsAdmin,
+			ExpiresAt:      m.ExpiresAt,
+			AdminExpiresAt: m.AdminExpiresAt,
+			Direct:         m.Direct,
+			Source:         m.Source,
+			SourceRef:      m.SourceRef,
+		}
+	}
+
+	// membership is enumerated recursively so the page is cut in memory,
+	// ordering by id the same way the database would
+	sort.Slice(members, func(i, j int) bool {
+		if strings.EqualFold(p.SortOrder, desc) {
+			return members[i].ID > members[j].ID
+		}
+
+		return members[i].ID < members[j].ID
+	})
+
+	page := make([]*GroupMember, 0, p.Limit+1)
+
+	for _, m := range members {
+		switch {
+		case p.NextCursor != "" && strings.EqualFold(p.SortOrder, asc) && m.ID <= p.NextCursor,
+			p.NextCursor != "" && strings.EqualFold(p.SortOrder, desc) && m.ID >= p.NextCursor,
+			p.PrevCursor != "" && strings.EqualFold(p.SortOrder, asc) && m.ID <= p.PrevCursor,
+			p.PrevCursor != "" && strings.EqualFold(p.SortOrder, desc) && m.ID >= p.PrevCursor:
+			continue
+		}
+
+		page = append(page, m)
+
+		if len(page) == p.Limit+1 {
+			break
+		}
+	}
+
+	hasMoreRecords := len(page) == p.Limit+1
+	if hasMoreRecords {
+		page = page[:len(page)-1]
+	}
+
+	// reverse slice because we reversed ordering to get the last N records
+	if p.Last || p.PrevCursor != "" {
+		for i, j := 0, len(page)-1; i < j; i, j = i+1, j-1 {
+			page[i], page[j] = page[j], page[i]
+		}
+	}
+
+	prevCursorResp, err := getPrevCursor(page, &p, hasMoreRecords)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting prev_cursor: "+err.Error())
+		return
+	}
+
+	nextCursorResp, err := getNextCursor(page, &p, hasMoreRecords)
+	if err != nil {
+		sendError(c, http.StatusInternalServerError, "error getting next_cursor: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, &PaginationResponse[*GroupMember]{
+		TotalRecordCount: int64(len(members)),
+		Records:          page,
+		PrevCursor:       prevCursorResp,
+		NextCursor:       nextCursorResp,
+	})
+}
+
+// sendGroupError sends the error response for an error returned when looking up a group
+func sendGroupError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrGetDeletedBySlug):
+		sendError(c, http.StatusBadRequest, "unable to get deleted group by slug, use the group id")
+	case errors.Is(err, service.ErrGroupNotFound):
+		sendErrorWithCode(c, http.StatusNotFound, ErrCodeGroupNotFound, err.Error())
+	default:
+		sendError(c, http.StatusInternalServerError, "error getting group: "+err.Error())
+	}
+}
@@ -11,7 +11,9 @@ import (
 	"go.hollow.sh/toolbox/ginauth"
 	"go.hollow.sh/toolbox/ginjwt"
 
-	"github.com/metal-toolbox/governor-api/internal/eventbus"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+	"github.com/metal-toolbox/governor-api/pkg/eventbus"
 )
 
 const (
@@ -27,18 +29,64 @@ type Router struct {
 	AuthMW         *ginauth.MultiTokenMiddleware
 	AuthConf       []ginjwt.AuthConfig
 	DB             *sqlx.DB
-	EventBus       *eventbus.Client
+	EventBus       eventbus.EventBus
 	Logger         *zap.Logger
+	Service        *service.Service
+	Tenancy        *tenancy.Resolver
 }
 
 // Routes sets up protected routes and sets the scopes for said routes
 func (r *Router) Routes(rg *gin.RouterGroup) {
+	rg.Use(r.mwTenancy)
+
 	rg.GET(
 		"/users",
 		r.AuditMW.AuditWithType("ListUsers"),
 		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:users")),
 		r.listUsers,
 	)
+
+	rg.GET(
+		"/users/:id",
+		r.AuditMW.AuditWithType("GetUser"),
+		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:users")),
+		r.getUser,
+	)
+
+	rg.GET(
+		"/groups",
+		r.AuditMW.AuditWithType("ListGroups"),
+		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
+		r.listGroups,
+	)
+
+	rg.GET(
+		"/groups/:id",
+		r.AuditMW.AuditWithType("GetGroup"),
+		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
+		r.getGroup,
+	)
+
+	rg.GET(
+		"/groups/:id/members",
+		r.AuditMW.AuditWithType("ListGroupMembers"),
+		r.AuthMW.AuthRequired(readScopesWithOpenID("governor:groups")),
+		r.listGroupMembers,
+	)
+}
+
+// svc returns the service layer, when the router wasn't given one it is built
+// from the router's own dependencies
+func (r *Router) svc() *service.Service {
+	if r.Service != nil {
+		return r.Service
+	}
+
+	return service.New(
+		service.WithDB(r.DB),
+		service.WithEventBus(r.EventBus),
+		service.WithLogger(r.Logger),
+	)
 }
 
 func contains(list []string, item string) bool {
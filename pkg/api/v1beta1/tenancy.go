@@ -0,0 +1,42 @@
+package v1beta1
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
+)
+
+// mwTenancy scopes the request to the organization in the org claim of its
+// bearer token when tenancy is enabled, see the v1alpha1 middleware
+func (r *Router) mwTenancy(c *gin.Context) {
+	if r.Tenancy == nil {
+		return
+	}
+
+	bearer, ok := strings.CutPrefix(c.Request.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return
+	}
+
+	orgID, err := r.Tenancy.Resolve(c.Request.Context(), bearer)
+	if err != nil {
+		if errors.Is(err, tenancy.ErrTenantRequired) || errors.Is(err, tenancy.ErrUnknownTenant) {
+			sendErrorWithCode(c, http.StatusForbidden, ErrCodeTenantRequired, err.Error())
+			return
+		}
+
+		r.Logger.Error("error resolving request organization", zap.Error(err))
+		sendError(c, http.StatusInternalServerError, "error resolving request organization: "+err.Error())
+
+		return
+	}
+
+	if orgID != "" {
+		c.Request = c.Request.WithContext(tenancy.WithTenant(c.Request.Context(), orgID))
+	}
+}
@@ -1,6 +1,7 @@
 package v1beta1
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
@@ -9,6 +10,8 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/metal-toolbox/governor-api/internal/models"
+	"github.com/metal-toolbox/governor-api/internal/service"
+	"github.com/metal-toolbox/governor-api/internal/tenancy"
 )
 
 const (
@@ -39,7 +42,8 @@ type User struct {
 // listUsers responds with the list of all users
 func (r *Router) listUsers(c *gin.Context) {
 	ctx := c.Request.Context()
-	queryMods := []qm.QueryMod{}
+	queryMods := []qm.QueryMod{tenancy.Scope(ctx, models.TableNames.Users)}
+	filters := []qm.QueryMod{}
 
 	for k, val := range c.Request.URL.Query() {
 		r.Logger.Debug("checking query", zap.String("url.query.key", k), zap.Strings("url.query.value", val))
@@ -47,7 +51,7 @@ func (r *Router) listUsers(c *gin.Context) {
 		// check for allowed parameters
 		if !contains(permittedListUsersParams, k) {
 			r.Logger.Warn("found illegal parameter in request", zap.String("parameter", k))
-			sendError(c, http.StatusBadRequest, "illegal parameter: "+k)
+			sendErrorWithCode(c, http.StatusBadRequest, ErrCodeInvalidQueryParameter, "illegal parameter: "+k)
 
 			return
 		}
@@ -64,13 +68,18 @@ func (r *Router) listUsers(c *gin.Context) {
 			// index if performance is an issue: CREATE INDEX ON users (LOWER(email));
 			// alternatives are to use ILIKE which is postgres specific, and require sanitizing '%' if we want exact matches
 			for _, v := range val {
-				queryMods = append(queryMods, qm.Or("LOWER(email) = LOWER(?)", v))
+				filters = append(filters, qm.Or("LOWER(email) = LOWER(?)", v))
 			}
 		case "external_id":
-			queryMods = append(queryMods, qm.Or2(qm.WhereIn(k+" IN ?", convertedVals...)))
+			filters = append(filters, qm.Or2(qm.WhereIn(k+" IN ?", convertedVals...)))
 		}
 	}
 
+	// the filters are or'ed together, they are grouped so they don't widen the scope
+	if len(filters) > 0 {
+		queryMods = append(queryMods, qm.Expr(filters...))
+	}
+
 	p, err := parsePagination(c)
 	if err != nil {
 		sendError(c, http.StatusBadRequest, "error parsing query parameters")
@@ -94,7 +103,7 @@ func (r *Router) listUsers(c *gin.Context) {
 
 		for i, v := range statuses {
 			if !contains(validStatuses, v) {
-				sendError(c, http.StatusBadRequest, invalidQueryParameterValue("status, "+v).Error())
+				sendErrorWithCode(c, http.StatusBadRequest, ErrCodeInvalidQueryParameter, invalidQueryParameterValue("status, "+v).Error())
 				return
 			}
 
@@ -119,7 +128,7 @@ func (r *Router) listUsers(c *gin.Context) {
 	if contains(allowedSortCols, strings.ToLower(p.SortBy)) {
 		queryMods = append(queryMods, qm.OrderBy(p.SortBy+" "+p.SortOrder))
 	} else {
-		sendError(c, http.StatusBadRequest, invalidQueryParameterValue("sortBy, "+p.SortBy).Error())
+		sendErrorWithCode(c, http.StatusBadRequest, ErrCodeInvalidQueryParameter, invalidQueryParameterValue("sortBy, "+p.SortBy).Error())
 		return
 	}
 
@@ -173,3 +182,27 @@ func (r *Router) listUsers(c *gin.Context) {
 		NextCursor:       nextCursorResp,
 	})
 }
+
+// getUser gets a user and their group memberships and membership requests
+func (r *Router) getUser(c *gin.Context) {
+	_, deleted := c.GetQuery("deleted")
+
+	details, err := r.svc().GetUser(c.Request.Context(), c.Param("id"), deleted)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			sendErrorWithCode(c, http.StatusNotFound, ErrCodeUserNotFound, err.Error())
+			return
+		}
+
+		sendError(c, http.StatusInternalServerError, "error getting user: "+err.Error())
+
+		return
+	}
+
+	c.JSON(http.StatusOK, User{
+		User:               details.User,
+		Memberships:        details.Memberships,
+		MembershipsDirect:  details.MembershipsDirect,
+		MembershipRequests: details.MembershipRequests,
+	})
+}
@@ -158,6 +158,33 @@ func (c *Client) ApplicationGroups(ctx context.Context, id string) ([]*v1alpha1.
 	return out, nil
 }
 
+// ApplicationAccess gets the matrix of which groups are granted which
+// applications, including access inherited through the group hierarchy
+func (c *Client) ApplicationAccess(ctx context.Context) ([]*v1alpha1.ApplicationAccess, error) {
+	req, err := c.newGovernorRequest(ctx, http.MethodGet, fmt.Sprintf("%s/api/%s/applications/access", c.url, governorAPIVersionAlpha))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := []*v1alpha1.ApplicationAccess{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
 // ApplicationTypes gets the list of application types from governor
 func (c *Client) ApplicationTypes(ctx context.Context) ([]*v1alpha1.ApplicationType, error) {
 	req, err := c.newGovernorRequest(ctx, http.MethodGet, fmt.Sprintf("%s/api/%s/application-types", c.url, governorAPIVersionAlpha))
@@ -76,6 +76,31 @@ var (
 		"deleted_at": null
 	}
 ]
+`)
+
+	testApplicationAccessResponse = []byte(`
+[
+	{
+		"application_id": "9262f9ea-bdd5-4ecf-b1e8-6cb7bfbf3d61",
+		"application_slug": "gophers",
+		"application_name": "Gophers",
+		"group_id": "31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4",
+		"group_slug": "gopher-admins",
+		"group_name": "Gopher Admins",
+		"direct": true,
+		"granted_by": ["31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4"]
+	},
+	{
+		"application_id": "9262f9ea-bdd5-4ecf-b1e8-6cb7bfbf3d61",
+		"application_slug": "gophers",
+		"application_name": "Gophers",
+		"group_id": "d1e0a1a8-6a4e-4b57-9c43-2f0d1cd0c0a2",
+		"group_slug": "gopher-sre",
+		"group_name": "Gopher SRE",
+		"direct": false,
+		"granted_by": ["31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4"]
+	}
+]
 `)
 
 	testApplicationTypeResponse = []byte(`
@@ -167,6 +192,72 @@ func TestClient_Applications(t *testing.T) {
 	}
 }
 
+func TestClient_ApplicationAccess(t *testing.T) {
+	testResp := func(r []byte) []*v1alpha1.ApplicationAccess {
+		resp := []*v1alpha1.ApplicationAccess{}
+		if err := json.Unmarshal(r, &resp); err != nil {
+			t.Error(err)
+		}
+
+		return resp
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		want       []*v1alpha1.ApplicationAccess
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testApplicationAccessResponse,
+				statusCode: http.StatusOK,
+			},
+			want: testResp(testApplicationAccessResponse),
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusInternalServerError,
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+				resp:       []byte(`{`),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+
+			got, err := c.ApplicationAccess(context.TODO())
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestClient_Application(t *testing.T) {
 	testResp := func(r []byte) *v1alpha1.Application {
 		resp := v1alpha1.Application{}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// GroupArchivedRequests gets a page of the processed requests of a group, the
+// requests to join it or link applications to it and the application requests
+// it decided on as the approver group
+func (c *Client) GroupArchivedRequests(ctx context.Context, groupID string, page int) (*v1alpha1.ArchivedRequestsResponse, error) {
+	if groupID == "" {
+		return nil, ErrMissingGroupID
+	}
+
+	return c.archivedRequests(ctx, "groups", groupID, page)
+}
+
+// UserArchivedRequests gets a page of the processed requests made by a user
+// and the ones the user decided on
+func (c *Client) UserArchivedRequests(ctx context.Context, userID string, page int) (*v1alpha1.ArchivedRequestsResponse, error) {
+	if userID == "" {
+		return nil, ErrMissingUserID
+	}
+
+	return c.archivedRequests(ctx, "users", userID, page)
+}
+
+func (c *Client) archivedRequests(ctx context.Context, kind, id string, page int) (*v1alpha1.ArchivedRequestsResponse, error) {
+	u := fmt.Sprintf("%s/api/%s/%s/%s/archived-requests", c.url, governorAPIVersionAlpha, kind, id)
+
+	if page > 0 {
+		u += "?" + url.Values{"page": []string{strconv.Itoa(page)}}.Encode()
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := &v1alpha1.ArchivedRequestsResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
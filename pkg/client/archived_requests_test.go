@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+var testArchivedRequestsResponse = []byte(`
+{
+	"page_size": 100,
+	"page": 1,
+	"page_count": 1,
+	"total_pages": 1,
+	"total_record_count": 1,
+	"records": [
+		{
+			"id": "8f0e6c4a-2b1d-4e3f-9a8b-7c6d5e4f3a21",
+			"request_id": "0c0cbd37-5b8e-4a4e-9a9a-7f3d0a3c2b11",
+			"request_type": "group_membership",
+			"kind": "new_member",
+			"group_id": "31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4",
+			"group_slug": "gophers",
+			"user_id": "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			"requester_user_id": "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			"requester_user_email": "hjass@example.com",
+			"note": "Deploying the gophers service.",
+			"is_admin": false,
+			"expires_at": null,
+			"admin_expires_at": null,
+			"decision": "approved",
+			"decided_by_user_id": "6e2b0e5c-0f1a-4c32-9a4b-6a0f7b6f3e21",
+			"decided_by_user_email": "evator@example.com",
+			"requested_at": "2023-07-12T12:00:00Z",
+			"decided_at": "2023-07-12T12:05:00Z"
+		}
+	]
+}
+`)
+
+func TestClient_ArchivedRequests(t *testing.T) {
+	testResp := func(r []byte) *v1alpha1.ArchivedRequestsResponse {
+		resp := &v1alpha1.ArchivedRequestsResponse{}
+		if err := json.Unmarshal(r, resp); err != nil {
+			t.Error(err)
+		}
+
+		return resp
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		id         string
+		user       bool
+		want       *v1alpha1.ArchivedRequestsResponse
+		wantErr    bool
+	}{
+		{
+			name: "group",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testArchivedRequestsResponse,
+				statusCode: http.StatusOK,
+			},
+			id:   "31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4",
+			want: testResp(testArchivedRequestsResponse),
+		},
+		{
+			name: "user",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testArchivedRequestsResponse,
+				statusCode: http.StatusOK,
+			},
+			id:   "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			user: true,
+			want: testResp(testArchivedRequestsResponse),
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusInternalServerError,
+			},
+			id:      "31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4",
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+				resp:       []byte(`{`),
+			},
+			id:      "31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4",
+			wantErr: true,
+		},
+		{
+			name: "missing group id",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing user id",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+			},
+			user:    true,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+
+			get := c.GroupArchivedRequests
+			if tt.user {
+				get = c.UserArchivedRequests
+			}
+
+			got, err := get(context.TODO(), tt.id, 1)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// CheckAuthz asks governor whether a user can perform an action on a resource,
+// without performing it
+func (c *Client) CheckAuthz(ctx context.Context, check *v1alpha1.AuthzCheckReq) (*v1alpha1.AuthzCheckResponse, error) {
+	if check == nil {
+		return nil, ErrNilAuthzCheckRequest
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodPost, fmt.Sprintf("%s/api/%s/authz/check", c.url, governorAPIVersionAlpha))
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(check)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewBuffer(b))
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := v1alpha1.AuthzCheckResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+func TestClient_CheckAuthz(t *testing.T) {
+	check := &v1alpha1.AuthzCheckReq{
+		UserID:   "186c5a52-4421-4573-8bbf-78d85d3c277e",
+		Action:   v1alpha1.AuthzActionGroupAdmin,
+		Resource: v1alpha1.AuthzCheckResource{GroupID: "8923e54d-0df6-407a-832d-2917915a3ff7"},
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		check      *v1alpha1.AuthzCheckReq
+		want       *v1alpha1.AuthzCheckResponse
+		wantErr    error
+	}{
+		{
+			name: "allowed",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       []byte(`{"allowed": true}`),
+				statusCode: http.StatusOK,
+			},
+			check: check,
+			want:  &v1alpha1.AuthzCheckResponse{Allowed: true},
+		},
+		{
+			name: "denied",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       []byte(`{"allowed": false, "reason": "user not group admin"}`),
+				statusCode: http.StatusOK,
+			},
+			check: check,
+			want:  &v1alpha1.AuthzCheckResponse{Reason: "user not group admin"},
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusForbidden,
+			},
+			check:   check,
+			wantErr: ErrRequestNonSuccess,
+		},
+		{
+			name:    "nil request",
+			wantErr: ErrNilAuthzCheckRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+
+			got, err := c.CheckAuthz(context.TODO(), tt.check)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
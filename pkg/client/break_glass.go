@@ -0,0 +1,50 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// BreakGlass grants the user of the client token a short membership in a group
+// approved for break-glass access, for the incident of the request
+func (c *Client) BreakGlass(ctx context.Context, groupID string, access *v1alpha1.BreakGlassAccessReq) (*v1alpha1.BreakGlassAccess, error) {
+	if groupID == "" {
+		return nil, ErrMissingGroupID
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodPost, fmt.Sprintf("%s/api/%s/groups/%s/break-glass/access", c.url, governorAPIVersionAlpha, groupID))
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(access)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewBuffer(b))
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := v1alpha1.BreakGlassAccess{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+func TestClient_BreakGlass(t *testing.T) {
+	ctx := context.TODO()
+
+	doer := &mockHTTPDoer{
+		t:          t,
+		statusCode: http.StatusCreated,
+		resp:       []byte(`{"group_id": "db", "user_id": "responder", "incident_ref": "INC-42", "expires_at": "2026-10-17T21:00:00Z", "audit_id": "audit", "notified": 2}`),
+	}
+	c := testTypedClient(t, doer)
+
+	access, err := c.BreakGlass(ctx, "db", &v1alpha1.BreakGlassAccessReq{IncidentRef: "INC-42"})
+	require.NoError(t, err)
+	assert.Equal(t, "INC-42", access.IncidentRef)
+	assert.Equal(t, 2, access.Notified)
+	assert.Equal(t, http.MethodPost, doer.request.Method)
+	assert.Contains(t, doer.request.URL.Path, "/api/v1alpha1/groups/db/break-glass/access")
+
+	body, err := io.ReadAll(doer.request.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"incident_ref": "INC-42", "expires_at": null}`, string(body))
+
+	doer.statusCode = http.StatusForbidden
+
+	_, err = c.BreakGlass(ctx, "db", &v1alpha1.BreakGlassAccessReq{IncidentRef: "INC-42"})
+	assert.ErrorIs(t, err, ErrRequestNonSuccess)
+
+	_, err = c.BreakGlass(ctx, "", &v1alpha1.BreakGlassAccessReq{IncidentRef: "INC-42"})
+	assert.ErrorIs(t, err, ErrMissingGroupID)
+}
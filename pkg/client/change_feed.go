@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// Changes gets a page of the governor change feed after the cursor, from the
+// first change when it's empty. Passing the next cursor of the page gets the
+// following changes, a limit of 0 uses the default page size.
+func (c *Client) Changes(ctx context.Context, cursor string, limit int) (*v1alpha1.ChangeFeed, error) {
+	q := url.Values{}
+
+	if cursor != "" {
+		q.Set("cursor", cursor)
+	}
+
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+
+	u := fmt.Sprintf("%s/api/%s/changes", c.url, governorAPIVersionAlpha)
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := &v1alpha1.ChangeFeed{}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+var testChangeFeedResponse = []byte(`
+{
+	"changes": [
+		{
+			"audit_id": "c5f7dc43-36ac-4a4c-9a3d-0e4dfb6b1a2a",
+			"action": "group.member.added",
+			"entity": "group.member",
+			"operation": "added",
+			"actor_id": "a9c8d84e-0cc2-4c3b-a4f8-1a4a0a4b0a3f",
+			"subject_group_id": "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			"subject_user_id": "5b1a6f0e-2c3d-4e5f-8a9b-0c1d2e3f4a5b",
+			"subject_organization_id": null,
+			"subject_application_id": null,
+			"subject_extension_id": null,
+			"changeset": ["is_admin: false"],
+			"created_at": "2023-07-12T12:00:00Z"
+		}
+	],
+	"next_cursor": "MjAyMy0wNy0xMlQxMjowMDowMFp8YzVmN2RjNDMtMzZhYy00YTRjLTlhM2QtMGU0ZGZiNmIxYTJh"
+}
+`)
+
+func TestClient_Changes(t *testing.T) {
+	want := &v1alpha1.ChangeFeed{}
+	if err := json.Unmarshal(testChangeFeedResponse, want); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		cursor     string
+		limit      int
+		want       *v1alpha1.ChangeFeed
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testChangeFeedResponse,
+				statusCode: http.StatusOK,
+			},
+			want: want,
+		},
+		{
+			name: "example request with cursor",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testChangeFeedResponse,
+				statusCode: http.StatusOK,
+			},
+			cursor: "MjAyMy0wNy0xMlQxMjowMDowMFp8YzVmN2RjNDMtMzZhYy00YTRjLTlhM2QtMGU0ZGZiNmIxYTJh",
+			limit:  10,
+			want:   want,
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusBadRequest,
+			},
+			cursor:  "invalid",
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+				resp:       []byte(`{`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.Changes(context.TODO(), tt.cursor, tt.limit)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
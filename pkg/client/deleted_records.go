@@ -0,0 +1,85 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// DeletedRecords gets the soft deleted records of a kind, one of users,
+// groups, applications or extensions
+func (c *Client) DeletedRecords(ctx context.Context, kind string) ([]*v1alpha1.DeletedRecord, error) {
+	if kind == "" {
+		return nil, ErrMissingDeletedKind
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodGet, fmt.Sprintf("%s/api/%s/deleted/%s", c.url, governorAPIVersionAlpha, kind))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := []*v1alpha1.DeletedRecord{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// PurgeDeletedRecords permanently removes the soft deleted records of a kind
+// with the ids and returns the purged records
+func (c *Client) PurgeDeletedRecords(ctx context.Context, kind string, ids []string) ([]*v1alpha1.DeletedRecord, error) {
+	if kind == "" {
+		return nil, ErrMissingDeletedKind
+	}
+
+	if len(ids) == 0 {
+		return nil, ErrMissingPurgeIDs
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodPost, fmt.Sprintf("%s/api/%s/deleted/%s/purge", c.url, governorAPIVersionAlpha, kind))
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(&v1alpha1.PurgeReq{IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewBuffer(b))
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := v1alpha1.PurgeResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return out.Purged, nil
+}
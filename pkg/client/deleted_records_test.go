@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+var testDeletedRecordsResponse = []byte(`
+[
+	{
+		"id": "186c5a52-4421-4573-8bbf-78d85d3c277e",
+		"name": "Old Group",
+		"slug": "old-group",
+		"deleted_at": "2023-07-12T12:00:00Z",
+		"deleted_by_id": "a9c8d84e-0cc2-4c3b-a4f8-1a4a0a4b0a3f",
+		"deleted_by_name": "Admin",
+		"deletion_audit_id": "c5f7dc43-36ac-4a4c-9a3d-0e4dfb6b1a2a"
+	}
+]
+`)
+
+var testPurgeResponse = []byte(`
+{
+	"purged": [
+		{
+			"id": "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			"name": "Old Group",
+			"slug": "old-group",
+			"deleted_at": "2023-07-12T12:00:00Z"
+		}
+	]
+}
+`)
+
+func TestClient_DeletedRecords(t *testing.T) {
+	want := []*v1alpha1.DeletedRecord{}
+	if err := json.Unmarshal(testDeletedRecordsResponse, &want); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		kind       string
+		want       []*v1alpha1.DeletedRecord
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testDeletedRecordsResponse,
+				statusCode: http.StatusOK,
+			},
+			kind: "groups",
+			want: want,
+		},
+		{
+			name:       "missing kind",
+			httpClient: &mockHTTPDoer{t: t},
+			wantErr:    true,
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusNotFound,
+			},
+			kind:    "organizations",
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+				resp:       []byte(`{`),
+			},
+			kind:    "groups",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.DeletedRecords(context.TODO(), tt.kind)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_PurgeDeletedRecords(t *testing.T) {
+	want := v1alpha1.PurgeResponse{}
+	if err := json.Unmarshal(testPurgeResponse, &want); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		kind       string
+		ids        []string
+		want       []*v1alpha1.DeletedRecord
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testPurgeResponse,
+				statusCode: http.StatusOK,
+			},
+			kind: "groups",
+			ids:  []string{"186c5a52-4421-4573-8bbf-78d85d3c277e"},
+			want: want.Purged,
+		},
+		{
+			name:       "missing kind",
+			httpClient: &mockHTTPDoer{t: t},
+			ids:        []string{"186c5a52-4421-4573-8bbf-78d85d3c277e"},
+			wantErr:    true,
+		},
+		{
+			name:       "missing ids",
+			httpClient: &mockHTTPDoer{t: t},
+			kind:       "groups",
+			wantErr:    true,
+		},
+		{
+			name: "within retention",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusConflict,
+			},
+			kind:    "groups",
+			ids:     []string{"186c5a52-4421-4573-8bbf-78d85d3c277e"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.PurgeDeletedRecords(context.TODO(), tt.kind, tt.ids)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,2 +1,13 @@
 // Package client is the client for interacting with the governor API
+//
+// The client gets its tokens from an oauth2 client credentials config, any
+// other token source with WithTokener, or a static token with WithToken.
+// WithRetries retries the requests failing with transient errors.
+//
+// Extension resources can be handled with their own go type, matching the
+// schema of their ERD:
+//
+//	people := client.NewSystemResources[Person](c, "my-extension", "people", "v1")
+//
+//	p, err := people.Create(ctx, Person{Name: "Jane"})
 package client
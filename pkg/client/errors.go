@@ -3,6 +3,9 @@ package client
 import "errors"
 
 var (
+	// ErrMissingCredentials is returned when the client is created without a way to get tokens
+	ErrMissingCredentials = errors.New("missing client credentials, a token or a token source")
+
 	// ErrRequestNonSuccess is returned when a call to the governor API returns a non-success status
 	ErrRequestNonSuccess = errors.New("got a non-success response from governor")
 
@@ -48,12 +51,45 @@ var (
 	// ErrMissingNotificationTargetID is returned when a a missing or bad notification target ID is passed to a request
 	ErrMissingNotificationTargetID = errors.New("missing notification target id in request")
 
+	// ErrMissingNotificationDispatchID is returned when a missing notification dispatch ID is passed to a request
+	ErrMissingNotificationDispatchID = errors.New("missing notification dispatch id in request")
+
+	// ErrNilNotificationDispatchRequest is returned when a nil notification dispatch body is passed to a request
+	ErrNilNotificationDispatchRequest = errors.New("nil notification dispatch request")
+
+	// ErrNilAuthzCheckRequest is returned when a nil authorization check body is passed to a request
+	ErrNilAuthzCheckRequest = errors.New("nil authorization check request")
+
 	// ErrMissingExtensionIDOrSlug is returned when a missing or bad extension ID is passed to a request
 	ErrMissingExtensionIDOrSlug = errors.New("missing extension id or slug in request")
 
+	// ErrMissingExtensionCredentialID is returned when a missing extension credential ID is passed to a request
+	ErrMissingExtensionCredentialID = errors.New("missing extension credential id in request")
+
 	// ErrMissingERDIDOrSlug is returned when a a missing or bad extension resource definition ID is passed to a request
 	ErrMissingERDIDOrSlug = errors.New("missing ERD id or slug in request")
 
 	// ErrMissingResourceID is returned when a a missing or bad resource ID is passed to a request
 	ErrMissingResourceID = errors.New("missing resource id in request")
+
+	// ErrMissingFeatureFlagName is returned when a missing feature flag name is passed to a request
+	ErrMissingFeatureFlagName = errors.New("missing feature flag name in request")
+
+	// ErrMissingDeletedKind is returned when a missing kind of deleted records is passed to a request
+	ErrMissingDeletedKind = errors.New("missing kind of deleted records in request")
+
+	// ErrMissingPurgeIDs is returned when no record ids are passed to a purge request
+	ErrMissingPurgeIDs = errors.New("missing record ids in purge request")
+
+	// ErrMissingResourceRevision is returned when a missing revision is passed to an extension resource update
+	ErrMissingResourceRevision = errors.New("missing resource revision in request")
+
+	// ErrUnsupportedPatchContentType is returned when an extension resource patch isn't a JSON Patch or a JSON Merge Patch
+	ErrUnsupportedPatchContentType = errors.New("unsupported patch content type")
+
+	// ErrMissingEventConsumer is returned when a missing event consumer name or audit id is passed to a request
+	ErrMissingEventConsumer = errors.New("missing event consumer name or audit id in request")
+
+	// ErrMissingTombstoneKind is returned when a missing kind of relationship tombstones is passed to a request
+	ErrMissingTombstoneKind = errors.New("missing kind of relationship tombstones in request")
 )
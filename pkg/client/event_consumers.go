@@ -0,0 +1,46 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// ReportEventConsumerPosition reports the id of the last audit event the
+// consumer processed, so governor can tell how far behind the consumer is
+func (c *Client) ReportEventConsumerPosition(ctx context.Context, name, auditID string) error {
+	if name == "" || auditID == "" {
+		return ErrMissingEventConsumer
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodPut, fmt.Sprintf("%s/api/%s/event-consumers/%s", c.url, governorAPIVersionAlpha, url.PathEscape(name)))
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(&v1alpha1.EventConsumerPositionReq{AuditID: auditID})
+	if err != nil {
+		return err
+	}
+
+	req.Body = io.NopCloser(bytes.NewBuffer(b))
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		return ErrRequestNonSuccess
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+func TestClient_ReportEventConsumerPosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		consumer   string
+		auditID    string
+		wantErr    error
+	}{
+		{
+			name: "reported",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       []byte(`{"name": "okta-sync", "last_audit_id": "186c5a52-4421-4573-8bbf-78d85d3c277e"}`),
+				statusCode: http.StatusAccepted,
+			},
+			consumer: "okta-sync",
+			auditID:  "186c5a52-4421-4573-8bbf-78d85d3c277e",
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusNotFound,
+			},
+			consumer: "okta-sync",
+			auditID:  "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			wantErr:  ErrRequestNonSuccess,
+		},
+		{
+			name:    "missing consumer",
+			auditID: "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			wantErr: ErrMissingEventConsumer,
+		},
+		{
+			name:     "missing audit id",
+			consumer: "okta-sync",
+			wantErr:  ErrMissingEventConsumer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+
+			err := c.ReportEventConsumerPosition(context.TODO(), tt.consumer, tt.auditID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// ExtensionCredentials lists the client credentials of an extension
+func (c *Client) ExtensionCredentials(ctx context.Context, idOrSlug string) ([]*v1alpha1.ExtensionCredential, error) {
+	if idOrSlug == "" {
+		return nil, ErrMissingExtensionIDOrSlug
+	}
+
+	req, err := c.newGovernorRequest(
+		ctx, http.MethodGet,
+		fmt.Sprintf("%s/api/%s/extensions/%s/credentials", c.url, governorAPIVersionAlpha, idOrSlug),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, v1alpha1.ErrExtensionNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := []*v1alpha1.ExtensionCredential{}
+	if err := json.Unmarshal(respBody, &creds); err != nil {
+		return nil, err
+	}
+
+	return creds, nil
+}
+
+// CreateExtensionCredential issues new client credentials to an extension, the
+// client secret is only returned by this call
+func (c *Client) CreateExtensionCredential(
+	ctx context.Context, idOrSlug string, credReq *v1alpha1.ExtensionCredentialReq,
+) (*v1alpha1.ExtensionCredential, error) {
+	if idOrSlug == "" {
+		return nil, ErrMissingExtensionIDOrSlug
+	}
+
+	req, err := c.newGovernorRequest(
+		ctx, http.MethodPost,
+		fmt.Sprintf("%s/api/%s/extensions/%s/credentials", c.url, governorAPIVersionAlpha, idOrSlug),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	credReqJSON, err := json.Marshal(credReq)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(credReqJSON))
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, v1alpha1.ErrExtensionNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusAccepted {
+		return nil, ErrRequestNonSuccess
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	cred := &v1alpha1.ExtensionCredential{}
+	if err := json.Unmarshal(respBody, cred); err != nil {
+		return nil, err
+	}
+
+	return cred, nil
+}
+
+// DeleteExtensionCredential revokes the client credentials of an extension
+func (c *Client) DeleteExtensionCredential(ctx context.Context, idOrSlug, credentialID string) error {
+	if idOrSlug == "" {
+		return ErrMissingExtensionIDOrSlug
+	}
+
+	if credentialID == "" {
+		return ErrMissingExtensionCredentialID
+	}
+
+	req, err := c.newGovernorRequest(
+		ctx, http.MethodDelete,
+		fmt.Sprintf("%s/api/%s/extensions/%s/credentials/%s", c.url, governorAPIVersionAlpha, idOrSlug, credentialID),
+	)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusAccepted &&
+		resp.StatusCode != http.StatusNoContent {
+		return ErrRequestNonSuccess
+	}
+
+	return nil
+}
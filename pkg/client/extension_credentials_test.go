@@ -0,0 +1,223 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+const (
+	testExtensionCredentialsResponse = `[
+		{
+			"id": "9a0f1c52-3a4e-4f3a-9df4-1f5d7a3f6f10",
+			"extension_id": "35b9861f-83b5-49df-95b0-321cfe5c1532",
+			"client_id": "gxc_4f1c2b7a9e0d3c5b8a6f2e1d",
+			"description": "production",
+			"created_at": "2023-09-26T20:04:19.190374Z",
+			"last_used_at": null
+		}
+	]`
+
+	testExtensionCredentialResponse = `{
+		"id": "9a0f1c52-3a4e-4f3a-9df4-1f5d7a3f6f10",
+		"extension_id": "35b9861f-83b5-49df-95b0-321cfe5c1532",
+		"client_id": "gxc_4f1c2b7a9e0d3c5b8a6f2e1d",
+		"client_secret": "gxs_c2VjcmV0",
+		"description": "production",
+		"created_at": "2023-09-26T20:04:19.190374Z",
+		"last_used_at": null
+	}`
+)
+
+func TestClient_ExtensionCredentials(t *testing.T) {
+	testResp := func(r []byte) []*v1alpha1.ExtensionCredential {
+		resp := []*v1alpha1.ExtensionCredential{}
+		if err := json.Unmarshal(r, &resp); err != nil {
+			t.Error(err)
+		}
+
+		return resp
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		id         string
+		want       []*v1alpha1.ExtensionCredential
+		wantErr    error
+	}{
+		{
+			name: "example request",
+			id:   "test-extension-1",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       []byte(testExtensionCredentialsResponse),
+				statusCode: http.StatusOK,
+			},
+			want: testResp([]byte(testExtensionCredentialsResponse)),
+		},
+		{
+			name: "not found",
+			id:   "test-extension-1",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusNotFound,
+			},
+			wantErr: v1alpha1.ErrExtensionNotFound,
+		},
+		{
+			name: "missing id",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+			},
+			wantErr: ErrMissingExtensionIDOrSlug,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+
+			got, err := c.ExtensionCredentials(context.TODO(), tt.id)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_CreateExtensionCredential(t *testing.T) {
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		id         string
+		wantSecret string
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			id:   "test-extension-1",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       []byte(testExtensionCredentialResponse),
+				statusCode: http.StatusAccepted,
+			},
+			wantSecret: "gxs_c2VjcmV0",
+		},
+		{
+			name: "non-success",
+			id:   "test-extension-1",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusInternalServerError,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing id",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusAccepted,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+
+			got, err := c.CreateExtensionCredential(context.TODO(), tt.id, &v1alpha1.ExtensionCredentialReq{Description: "production"})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSecret, got.ClientSecret)
+		})
+	}
+}
+
+func TestClient_DeleteExtensionCredential(t *testing.T) {
+	tests := []struct {
+		name         string
+		httpClient   HTTPDoer
+		id           string
+		credentialID string
+		wantErr      bool
+	}{
+		{
+			name: "example request",
+			id:   "test-extension-1",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       []byte(testExtensionCredentialResponse),
+				statusCode: http.StatusAccepted,
+			},
+			credentialID: "9a0f1c52-3a4e-4f3a-9df4-1f5d7a3f6f10",
+		},
+		{
+			name: "non-success",
+			id:   "test-extension-1",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusInternalServerError,
+			},
+			credentialID: "9a0f1c52-3a4e-4f3a-9df4-1f5d7a3f6f10",
+			wantErr:      true,
+		},
+		{
+			name: "missing credential id",
+			id:   "test-extension-1",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusAccepted,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+
+			err := c.DeleteExtensionCredential(context.TODO(), tt.id, tt.credentialID)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// ExtensionSnapshot exports the extension definitions of the governor
+// instance, to compare them with another instance
+func (c *Client) ExtensionSnapshot(ctx context.Context) (*v1alpha1.ExtensionSnapshot, error) {
+	req, err := c.newGovernorRequest(
+		ctx, http.MethodGet,
+		fmt.Sprintf("%s/api/%s/extensions/snapshot", c.url, governorAPIVersionAlpha),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := &v1alpha1.ExtensionSnapshot{}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// DiffExtensionSnapshot compares the extension definitions of the governor
+// instance with a snapshot exported from another instance
+func (c *Client) DiffExtensionSnapshot(
+	ctx context.Context, snapshot *v1alpha1.ExtensionSnapshot,
+) (*v1alpha1.ExtensionDrift, error) {
+	req, err := c.newGovernorRequest(
+		ctx, http.MethodPost,
+		fmt.Sprintf("%s/api/%s/extensions/snapshot/diff", c.url, governorAPIVersionAlpha),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := &v1alpha1.ExtensionDrift{}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
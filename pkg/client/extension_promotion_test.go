@@ -0,0 +1,189 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+var testExtensionSnapshotResponse = []byte(`
+{
+	"exported_at": "2023-07-12T12:00:00Z",
+	"extensions": [
+		{
+			"slug": "test-extension",
+			"name": "Test Extension",
+			"description": "some test",
+			"enabled": true,
+			"status": "online",
+			"erds": [
+				{
+					"slug_singular": "thing",
+					"slug_plural": "things",
+					"version": "v1",
+					"name": "Thing",
+					"description": "some thing",
+					"scope": "system",
+					"state": "active",
+					"default_owner_policy": "none",
+					"schema": {"type": "object"}
+				}
+			]
+		}
+	]
+}
+`)
+
+var testExtensionDriftResponse = []byte(`
+{
+	"in_sync": false,
+	"extensions": [
+		{
+			"slug": "test-extension",
+			"erds": [
+				{
+					"slug_singular": "thing",
+					"version": "v1",
+					"drift": "changed",
+					"fields": ["schema"],
+					"schema_diff": {"added": ["size"], "removed": [], "changed": []}
+				}
+			]
+		}
+	]
+}
+`)
+
+func TestClient_ExtensionSnapshot(t *testing.T) {
+	want := &v1alpha1.ExtensionSnapshot{}
+	if err := json.Unmarshal(testExtensionSnapshotResponse, want); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		want       *v1alpha1.ExtensionSnapshot
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testExtensionSnapshotResponse,
+				statusCode: http.StatusOK,
+			},
+			want: want,
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusInternalServerError,
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+				resp:       []byte(`{`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.ExtensionSnapshot(context.TODO())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_DiffExtensionSnapshot(t *testing.T) {
+	snapshot := &v1alpha1.ExtensionSnapshot{}
+	if err := json.Unmarshal(testExtensionSnapshotResponse, snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &v1alpha1.ExtensionDrift{}
+	if err := json.Unmarshal(testExtensionDriftResponse, want); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		want       *v1alpha1.ExtensionDrift
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testExtensionDriftResponse,
+				statusCode: http.StatusOK,
+			},
+			want: want,
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusBadRequest,
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+				resp:       []byte(`{`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.DiffExtensionSnapshot(context.TODO(), snapshot)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
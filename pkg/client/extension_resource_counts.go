@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// SystemExtensionResourcesCount counts the system resources matching the
+// queries, without listing them
+func (c *Client) SystemExtensionResourcesCount(
+	ctx context.Context, extensionSlug, erdSlugPlural, erdVersion string, queries map[string]string,
+) (int64, error) {
+	u, err := c.systemExtensionResourcesURL(extensionSlug, erdSlugPlural, erdVersion)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.countExtensionResources(ctx, u+"/count", queries)
+}
+
+// SystemExtensionResourcesExist tells whether a system resource matches the
+// queries, without listing them
+func (c *Client) SystemExtensionResourcesExist(
+	ctx context.Context, extensionSlug, erdSlugPlural, erdVersion string, queries map[string]string,
+) (bool, error) {
+	u, err := c.systemExtensionResourcesURL(extensionSlug, erdSlugPlural, erdVersion)
+	if err != nil {
+		return false, err
+	}
+
+	return c.extensionResourcesExist(ctx, u, queries)
+}
+
+// UserExtensionResourcesCount counts the user resources of a user matching
+// the queries, without listing them
+func (c *Client) UserExtensionResourcesCount(
+	ctx context.Context, userID, extensionSlug, erdSlugPlural, erdVersion string, queries map[string]string,
+) (int64, error) {
+	u, err := c.userExtensionResourcesURL(userID, extensionSlug, erdSlugPlural, erdVersion)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.countExtensionResources(ctx, u+"/count", queries)
+}
+
+// UserExtensionResourcesExist tells whether a user resource of a user matches
+// the queries, without listing them
+func (c *Client) UserExtensionResourcesExist(
+	ctx context.Context, userID, extensionSlug, erdSlugPlural, erdVersion string, queries map[string]string,
+) (bool, error) {
+	u, err := c.userExtensionResourcesURL(userID, extensionSlug, erdSlugPlural, erdVersion)
+	if err != nil {
+		return false, err
+	}
+
+	return c.extensionResourcesExist(ctx, u, queries)
+}
+
+func (c *Client) systemExtensionResourcesURL(extensionSlug, erdSlugPlural, erdVersion string) (string, error) {
+	if extensionSlug == "" {
+		return "", ErrMissingExtensionIDOrSlug
+	}
+
+	if erdSlugPlural == "" {
+		return "", ErrMissingERDIDOrSlug
+	}
+
+	return fmt.Sprintf(
+		"%s/api/%s/extension-resources/%s/%s/%s",
+		c.url,
+		governorAPIVersionAlpha,
+		extensionSlug,
+		erdSlugPlural,
+		erdVersion,
+	), nil
+}
+
+func (c *Client) userExtensionResourcesURL(userID, extensionSlug, erdSlugPlural, erdVersion string) (string, error) {
+	if userID == "" {
+		return "", ErrMissingUserID
+	}
+
+	if extensionSlug == "" {
+		return "", ErrMissingExtensionIDOrSlug
+	}
+
+	if erdSlugPlural == "" {
+		return "", ErrMissingERDIDOrSlug
+	}
+
+	return fmt.Sprintf(
+		"%s/api/%s/users/%s/extension-resources/%s/%s/%s",
+		c.url,
+		governorAPIVersionAlpha,
+		userID,
+		extensionSlug,
+		erdSlugPlural,
+		erdVersion,
+	), nil
+}
+
+func (c *Client) countExtensionResources(ctx context.Context, u string, queries map[string]string) (int64, error) {
+	resp, err := c.extensionResourcesQuery(ctx, http.MethodGet, u, queries)
+	if err != nil {
+		return 0, err
+	}
+
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return 0, handleResourceStatusNotFound(respBody)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, ErrRequestNonSuccess
+	}
+
+	out := v1alpha1.ExtensionResourceCount{}
+	if err := json.Unmarshal(respBody, &out); err != nil {
+		return 0, err
+	}
+
+	return out.Count, nil
+}
+
+func (c *Client) extensionResourcesExist(ctx context.Context, u string, queries map[string]string) (bool, error) {
+	resp, err := c.extensionResourcesQuery(ctx, http.MethodHead, u, queries)
+	if err != nil {
+		return false, err
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNoContent:
+		return false, nil
+	default:
+		return false, ErrRequestNonSuccess
+	}
+}
+
+func (c *Client) extensionResourcesQuery(ctx context.Context, method, u string, queries map[string]string) (*http.Response, error) {
+	if len(queries) != 0 {
+		q := url.Values{}
+		for k, v := range queries {
+			q.Set(k, v)
+		}
+
+		u += "?" + q.Encode()
+	}
+
+	req, err := c.newGovernorRequest(ctx, method, u)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.httpClient.Do(req.WithContext(ctx))
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+func TestClient_SystemExtensionResourcesCount(t *testing.T) {
+	ctx := context.TODO()
+
+	doer := &mockHTTPDoer{t: t, statusCode: http.StatusOK, resp: []byte(`{"count": 3}`)}
+	c := testTypedClient(t, doer)
+
+	count, err := c.SystemExtensionResourcesCount(ctx, "test-extension", "people", "v1", map[string]string{"lastName": "2"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	assert.Equal(t, http.MethodGet, doer.request.Method)
+	assert.Contains(t, doer.request.URL.Path, "/api/v1alpha1/extension-resources/test-extension/people/v1/count")
+	assert.Equal(t, "2", doer.request.URL.Query().Get("lastName"))
+
+	doer.statusCode = http.StatusNotFound
+	doer.resp = []byte(`{"error": "erd not found", "code": "` + string(v1alpha1.ErrCodeERDNotFound) + `"}`)
+
+	_, err = c.SystemExtensionResourcesCount(ctx, "test-extension", "people", "v1", nil)
+	assert.ErrorIs(t, err, v1alpha1.ErrERDNotFound)
+
+	_, err = c.SystemExtensionResourcesCount(ctx, "", "people", "v1", nil)
+	assert.ErrorIs(t, err, ErrMissingExtensionIDOrSlug)
+}
+
+func TestClient_UserExtensionResourcesExist(t *testing.T) {
+	ctx := context.TODO()
+
+	doer := &mockHTTPDoer{t: t, statusCode: http.StatusOK}
+	c := testTypedClient(t, doer)
+
+	exists, err := c.UserExtensionResourcesExist(ctx, "user-id", "test-extension", "people", "v1", map[string]string{"age": "10"})
+	require.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, http.MethodHead, doer.request.Method)
+	assert.Contains(t, doer.request.URL.Path, "/api/v1alpha1/users/user-id/extension-resources/test-extension/people/v1")
+
+	doer.statusCode = http.StatusNoContent
+
+	exists, err = c.UserExtensionResourcesExist(ctx, "user-id", "test-extension", "people", "v1", nil)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	doer.statusCode = http.StatusNotFound
+
+	_, err = c.UserExtensionResourcesExist(ctx, "user-id", "test-extension", "people", "v1", nil)
+	assert.ErrorIs(t, err, ErrRequestNonSuccess)
+
+	_, err = c.UserExtensionResourcesExist(ctx, "", "test-extension", "people", "v1", nil)
+	assert.ErrorIs(t, err, ErrMissingUserID)
+}
@@ -13,12 +13,17 @@ import (
 )
 
 func handleERDStatusNotFound(respBody []byte) error {
-	respErr := map[string]string{}
+	respErr := v1alpha1.ErrorResponse{}
 	if err := json.Unmarshal(respBody, &respErr); err != nil {
 		return err
 	}
 
-	if errMsg, ok := respErr["error"]; ok && strings.Contains(errMsg, "extension does not exist") {
+	if respErr.Code == v1alpha1.ErrCodeExtensionNotFound {
+		return v1alpha1.ErrExtensionNotFound
+	}
+
+	// fall back to matching the message for servers that don't return error codes
+	if strings.Contains(respErr.Error, "extension does not exist") {
 		return v1alpha1.ErrExtensionNotFound
 	}
 
@@ -198,6 +203,61 @@ func (c *Client) CreateExtensionResourceDefinition(
 	return erd, nil
 }
 
+// ValidateExtensionResourceDefinitionSchema lints a proposed ERD schema, and
+// checks the resources of an existing ERD with it when the request names one
+func (c *Client) ValidateExtensionResourceDefinitionSchema(
+	ctx context.Context, extensionIDOrSlug string, validationReq *v1alpha1.ExtensionResourceDefinitionSchemaValidationReq,
+) (*v1alpha1.ExtensionResourceDefinitionSchemaValidation, error) {
+	if extensionIDOrSlug == "" {
+		return nil, ErrMissingExtensionIDOrSlug
+	}
+
+	req, err := c.newGovernorRequest(
+		ctx, http.MethodPost,
+		fmt.Sprintf(
+			"%s/api/%s/extensions/%s/erds/validate-schema",
+			c.url, governorAPIVersionAlpha, extensionIDOrSlug,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	validationReqJSON, err := json.Marshal(validationReq)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(validationReqJSON))
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, handleERDStatusNotFound(respBody)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	validation := &v1alpha1.ExtensionResourceDefinitionSchemaValidation{}
+	if err := json.Unmarshal(respBody, validation); err != nil {
+		return nil, err
+	}
+
+	return validation, nil
+}
+
 // UpdateExtensionResourceDefinition updates an ERD, erd version must be provided
 // when using erd slug
 func (c *Client) UpdateExtensionResourceDefinition(
@@ -317,3 +377,71 @@ func (c *Client) DeleteExtensionResourceDefinition(
 
 	return nil
 }
+
+// UpdateExtensionResourceDefinitionState moves an ERD to another lifecycle
+// state, erd version must be provided when using erd slug
+func (c *Client) UpdateExtensionResourceDefinitionState(
+	ctx context.Context, extensionIDOrSlug, erdIDOrSlug, erdVersion, state string,
+) (*v1alpha1.ExtensionResourceDefinition, error) {
+	if extensionIDOrSlug == "" {
+		return nil, ErrMissingExtensionIDOrSlug
+	}
+
+	if erdIDOrSlug == "" {
+		return nil, ErrMissingERDIDOrSlug
+	}
+
+	u := fmt.Sprintf(
+		"%s/api/%s/extensions/%s/erds/%s",
+		c.url,
+		governorAPIVersionAlpha,
+		extensionIDOrSlug,
+		erdIDOrSlug,
+	)
+	if erdVersion != "" {
+		u += fmt.Sprintf("/%s", erdVersion)
+	}
+
+	u += "/state"
+
+	req, err := c.newGovernorRequest(ctx, http.MethodPut, u)
+	if err != nil {
+		return nil, err
+	}
+
+	stateReqJSON, err := json.Marshal(&v1alpha1.ExtensionResourceDefinitionStateReq{State: state})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(stateReqJSON))
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, handleERDStatusNotFound(respBody)
+	}
+
+	if resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusAccepted &&
+		resp.StatusCode != http.StatusNoContent {
+		return nil, ErrRequestNonSuccess
+	}
+
+	erd := &v1alpha1.ExtensionResourceDefinition{}
+	if err := json.Unmarshal(respBody, erd); err != nil {
+		return nil, err
+	}
+
+	return erd, nil
+}
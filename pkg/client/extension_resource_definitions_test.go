@@ -863,3 +863,116 @@ func TestClient_DeleteExtensionResourceDefinition(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_UpdateExtensionResourceDefinitionState(t *testing.T) {
+	type fields struct {
+		httpClient *mockHTTPDoer
+	}
+
+	tests := []struct {
+		name         string
+		extensionID  string
+		erdID        string
+		erdVersion   string
+		fields       fields
+		expectedPath string
+		expectedErr  error
+		expectErr    bool
+	}{
+		{
+			name:        "example request with slug",
+			extensionID: "test-extension-1",
+			erdID:       "erd-1",
+			erdVersion:  "v1alpha1",
+			fields: fields{
+				httpClient: &mockHTTPDoer{
+					t:          t,
+					resp:       []byte(testERDResponse),
+					statusCode: http.StatusAccepted,
+				},
+			},
+			expectedPath: "/api/v1alpha1/extensions/test-extension-1/erds/erd-1/v1alpha1/state",
+		},
+		{
+			name:        "example request with id",
+			extensionID: "test-extension-1",
+			erdID:       "a82a34a5-db1f-464f-af9c-76086e79f715",
+			fields: fields{
+				httpClient: &mockHTTPDoer{
+					t:          t,
+					resp:       []byte(testERDResponse),
+					statusCode: http.StatusAccepted,
+				},
+			},
+			expectedPath: "/api/v1alpha1/extensions/test-extension-1/erds/a82a34a5-db1f-464f-af9c-76086e79f715/state",
+		},
+		{
+			name:        "invalid transition",
+			extensionID: "test-extension-1",
+			erdID:       "erd-1",
+			erdVersion:  "v1alpha1",
+			fields: fields{
+				httpClient: &mockHTTPDoer{
+					t:          t,
+					statusCode: http.StatusConflict,
+				},
+			},
+			expectErr:   true,
+			expectedErr: ErrRequestNonSuccess,
+		},
+		{
+			name:        "erd ID missing",
+			extensionID: "test-extension-1",
+			fields: fields{
+				httpClient: &mockHTTPDoer{
+					t: t,
+				},
+			},
+			expectErr:   true,
+			expectedErr: ErrMissingERDIDOrSlug,
+		},
+		{
+			name:        "erd not found",
+			extensionID: "test-extension-1",
+			erdID:       "erd-1",
+			erdVersion:  "v1alpha1",
+			fields: fields{
+				httpClient: &mockHTTPDoer{
+					t:          t,
+					statusCode: http.StatusNotFound,
+					resp:       []byte(`{"error":"ERD does not exist"}`),
+				},
+			},
+			expectErr:   true,
+			expectedErr: v1alpha1.ErrERDNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.fields.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			erd, err := c.UpdateExtensionResourceDefinitionState(
+				context.TODO(), tt.extensionID, tt.erdID, tt.erdVersion, "deprecated",
+			)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+				return
+			} else if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, erd)
+			assert.Equal(t, http.MethodPut, tt.fields.httpClient.Request().Method)
+			assert.Equal(t, tt.expectedPath, tt.fields.httpClient.Request().URL.Path)
+		})
+	}
+}
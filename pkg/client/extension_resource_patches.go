@@ -0,0 +1,138 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+const (
+	// ContentTypeJSONPatch is the content type of the RFC 6902 JSON Patch
+	// documents, a list of operations applied in order
+	ContentTypeJSONPatch = "application/json-patch+json"
+	// ContentTypeMergePatch is the content type of the RFC 7386 JSON Merge
+	// Patch documents, merged into the resource with the null members removed
+	ContentTypeMergePatch = "application/merge-patch+json"
+)
+
+// PatchSystemExtensionResource applies a patch of contentType, either
+// ContentTypeJSONPatch or ContentTypeMergePatch, to the current payload of a
+// system extension resource. The patch doesn't need the revision of the
+// resource, the test operations of a JSON Patch can guard it instead.
+func (c *Client) PatchSystemExtensionResource(
+	ctx context.Context, extensionSlug, erdSlugPlural, erdVersion, resourceID, contentType string, patch []byte,
+) (*v1alpha1.SystemExtensionResource, error) {
+	if extensionSlug == "" {
+		return nil, ErrMissingExtensionIDOrSlug
+	}
+
+	if erdSlugPlural == "" {
+		return nil, ErrMissingERDIDOrSlug
+	}
+
+	if resourceID == "" {
+		return nil, ErrMissingResourceID
+	}
+
+	u := fmt.Sprintf(
+		"%s/api/%s/extension-resources/%s/%s/%s/%s",
+		c.url,
+		governorAPIVersionAlpha,
+		extensionSlug,
+		erdSlugPlural,
+		erdVersion,
+		resourceID,
+	)
+
+	ser := &v1alpha1.SystemExtensionResource{}
+	if err := c.doExtensionResourcePatch(ctx, u, contentType, patch, ser); err != nil {
+		return nil, err
+	}
+
+	return ser, nil
+}
+
+// PatchUserExtensionResource applies a patch of contentType, either
+// ContentTypeJSONPatch or ContentTypeMergePatch, to the current payload of a
+// user extension resource
+func (c *Client) PatchUserExtensionResource(
+	ctx context.Context, userID, extensionSlug, erdSlugPlural, erdVersion, resourceID, contentType string, patch []byte,
+) (*v1alpha1.UserExtensionResource, error) {
+	if userID == "" {
+		return nil, ErrMissingUserID
+	}
+
+	if extensionSlug == "" {
+		return nil, ErrMissingExtensionIDOrSlug
+	}
+
+	if erdSlugPlural == "" {
+		return nil, ErrMissingERDIDOrSlug
+	}
+
+	if resourceID == "" {
+		return nil, ErrMissingResourceID
+	}
+
+	u := fmt.Sprintf(
+		"%s/api/%s/users/%s/extension-resources/%s/%s/%s/%s",
+		c.url,
+		governorAPIVersionAlpha,
+		userID,
+		extensionSlug,
+		erdSlugPlural,
+		erdVersion,
+		resourceID,
+	)
+
+	uer := &v1alpha1.UserExtensionResource{}
+	if err := c.doExtensionResourcePatch(ctx, u, contentType, patch, uer); err != nil {
+		return nil, err
+	}
+
+	return uer, nil
+}
+
+// doExtensionResourcePatch sends a patch to an extension resource endpoint and
+// decodes the response into out
+func (c *Client) doExtensionResourcePatch(ctx context.Context, u, contentType string, patch []byte, out interface{}) error {
+	if contentType != ContentTypeJSONPatch && contentType != ContentTypeMergePatch {
+		return ErrUnsupportedPatchContentType
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodPatch, u)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	req.Body = io.NopCloser(bytes.NewReader(patch))
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted:
+		return json.Unmarshal(respBody, out)
+	case http.StatusNotFound:
+		return handleResourceStatusNotFound(respBody)
+	case http.StatusUnprocessableEntity:
+		return v1alpha1.ErrExtensionResourcePatchFailed
+	}
+
+	return ErrRequestNonSuccess
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+func TestClient_PatchSystemExtensionResource(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		statusCode  int
+		resp        string
+		expectedErr error
+	}{
+		{
+			name:        "json patch",
+			contentType: ContentTypeJSONPatch,
+			statusCode:  http.StatusAccepted,
+			resp:        testExtensionResourceResponse,
+		},
+		{
+			name:        "merge patch",
+			contentType: ContentTypeMergePatch,
+			statusCode:  http.StatusAccepted,
+			resp:        testExtensionResourceResponse,
+		},
+		{
+			name:        "unsupported content type",
+			contentType: "application/json",
+			expectedErr: ErrUnsupportedPatchContentType,
+		},
+		{
+			name:        "patch failed",
+			contentType: ContentTypeJSONPatch,
+			statusCode:  http.StatusUnprocessableEntity,
+			resp:        `{"code":"extension_resource_patch_failed","message":"extension resource patch can't be applied"}`,
+			expectedErr: v1alpha1.ErrExtensionResourcePatchFailed,
+		},
+		{
+			name:        "resource not found",
+			contentType: ContentTypeJSONPatch,
+			statusCode:  http.StatusNotFound,
+			resp:        `{"code":"extension_resource_not_found","message":"extension resource does not exist"}`,
+			expectedErr: v1alpha1.ErrExtensionResourceNotFound,
+		},
+		{
+			name:        "invalid patch",
+			contentType: ContentTypeJSONPatch,
+			statusCode:  http.StatusBadRequest,
+			resp:        `{"code":"bad_request","message":"invalid extension resource patch"}`,
+			expectedErr: ErrRequestNonSuccess,
+		},
+	}
+
+	patch := []byte(`[{"op": "replace", "path": "/age", "value": 10}]`)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doer := &mockHTTPDoer{t: t, statusCode: tt.statusCode, resp: []byte(tt.resp)}
+
+			c := &Client{
+				url:                    "https://the.gov",
+				logger:                 zap.NewNop(),
+				httpClient:             doer,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+
+			got, err := c.PatchSystemExtensionResource(
+				context.TODO(), "test-extension-1", "erd-1", "v1alpha1", "673ccd3a-1381-4e68-bc90-04e5f6745b9c",
+				tt.contentType, patch,
+			)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "673ccd3a-1381-4e68-bc90-04e5f6745b9c", got.ID)
+			assert.Equal(t, http.MethodPatch, doer.request.Method)
+			assert.Equal(t, tt.contentType, doer.request.Header.Get("Content-Type"))
+			assert.Empty(t, doer.request.Header.Get("If-Match"))
+
+			body, err := io.ReadAll(doer.request.Body)
+			require.NoError(t, err)
+			assert.Equal(t, patch, body)
+		})
+	}
+}
+
+func TestClient_PatchUserExtensionResource(t *testing.T) {
+	doer := &mockHTTPDoer{t: t, statusCode: http.StatusAccepted, resp: []byte(testExtensionResourceResponse)}
+
+	c := &Client{
+		url:                    "https://the.gov",
+		logger:                 zap.NewNop(),
+		httpClient:             doer,
+		clientCredentialConfig: &mockTokener{t: t},
+		token:                  &oauth2.Token{AccessToken: "topSekret"},
+	}
+
+	got, err := c.PatchUserExtensionResource(
+		context.TODO(), "user-id", "test-extension-1", "erd-1", "v1alpha1", "673ccd3a-1381-4e68-bc90-04e5f6745b9c",
+		ContentTypeMergePatch, []byte(`{"age": 10}`),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "673ccd3a-1381-4e68-bc90-04e5f6745b9c", got.ID)
+	assert.Equal(t, "/api/v1alpha1/users/user-id/extension-resources/test-extension-1/erd-1/v1alpha1/673ccd3a-1381-4e68-bc90-04e5f6745b9c", doer.request.URL.Path)
+
+	_, err = c.PatchUserExtensionResource(
+		context.TODO(), "", "test-extension-1", "erd-1", "v1alpha1", "673ccd3a-1381-4e68-bc90-04e5f6745b9c",
+		ContentTypeMergePatch, []byte(`{"age": 10}`),
+	)
+	assert.ErrorIs(t, err, ErrMissingUserID)
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// RevisionConflictError is returned when an extension resource was changed
+// since the revision of an update, Revision is its current revision
+type RevisionConflictError struct {
+	Revision int64
+}
+
+func (e *RevisionConflictError) Error() string {
+	return fmt.Sprintf("%s: current revision is %d", v1alpha1.ErrExtensionResourceRevisionConflict, e.Revision)
+}
+
+// Unwrap returns v1alpha1.ErrExtensionResourceRevisionConflict
+func (e *RevisionConflictError) Unwrap() error {
+	return v1alpha1.ErrExtensionResourceRevisionConflict
+}
+
+// revisionConflict returns the RevisionConflictError of a conflict response,
+// with the revision of its ETag header
+func revisionConflict(resp *http.Response) error {
+	revision, err := strconv.ParseInt(strings.Trim(resp.Header.Get("ETag"), `"`), 10, 64)
+	if err != nil {
+		return v1alpha1.ErrExtensionResourceRevisionConflict
+	}
+
+	return &RevisionConflictError{Revision: revision}
+}
@@ -0,0 +1,194 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// SystemExtensionResourceVersions lists the recorded versions of a system
+// extension resource, the latest first
+func (c *Client) SystemExtensionResourceVersions(
+	ctx context.Context, extensionSlug, erdSlugPlural, erdVersion, resourceID string,
+) ([]*v1alpha1.SystemExtensionResourceVersion, error) {
+	if extensionSlug == "" {
+		return nil, ErrMissingExtensionIDOrSlug
+	}
+
+	if erdSlugPlural == "" {
+		return nil, ErrMissingERDIDOrSlug
+	}
+
+	if resourceID == "" {
+		return nil, ErrMissingResourceID
+	}
+
+	u := fmt.Sprintf(
+		"%s/api/%s/extension-resources/%s/%s/%s/%s/versions",
+		c.url,
+		governorAPIVersionAlpha,
+		extensionSlug,
+		erdSlugPlural,
+		erdVersion,
+		resourceID,
+	)
+
+	versions := []*v1alpha1.SystemExtensionResourceVersion{}
+	if err := c.doExtensionResourceRequest(ctx, http.MethodGet, u, &versions); err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// RollbackSystemExtensionResource restores the payload of a version of a
+// system extension resource, the restored resource is returned
+func (c *Client) RollbackSystemExtensionResource(
+	ctx context.Context, extensionSlug, erdSlugPlural, erdVersion, resourceID string, version int64,
+) (*v1alpha1.SystemExtensionResource, error) {
+	if extensionSlug == "" {
+		return nil, ErrMissingExtensionIDOrSlug
+	}
+
+	if erdSlugPlural == "" {
+		return nil, ErrMissingERDIDOrSlug
+	}
+
+	if resourceID == "" {
+		return nil, ErrMissingResourceID
+	}
+
+	u := fmt.Sprintf(
+		"%s/api/%s/extension-resources/%s/%s/%s/%s/versions/%d/rollback",
+		c.url,
+		governorAPIVersionAlpha,
+		extensionSlug,
+		erdSlugPlural,
+		erdVersion,
+		resourceID,
+		version,
+	)
+
+	ser := &v1alpha1.SystemExtensionResource{}
+	if err := c.doExtensionResourceRequest(ctx, http.MethodPost, u, ser); err != nil {
+		return nil, err
+	}
+
+	return ser, nil
+}
+
+// UserExtensionResourceVersions lists the recorded versions of a user
+// extension resource, the latest first
+func (c *Client) UserExtensionResourceVersions(
+	ctx context.Context, userID, extensionSlug, erdSlugPlural, erdVersion, resourceID string,
+) ([]*v1alpha1.UserExtensionResourceVersion, error) {
+	if userID == "" {
+		return nil, ErrMissingUserID
+	}
+
+	if extensionSlug == "" {
+		return nil, ErrMissingExtensionIDOrSlug
+	}
+
+	if erdSlugPlural == "" {
+		return nil, ErrMissingERDIDOrSlug
+	}
+
+	if resourceID == "" {
+		return nil, ErrMissingResourceID
+	}
+
+	u := fmt.Sprintf(
+		"%s/api/%s/users/%s/extension-resources/%s/%s/%s/%s/versions",
+		c.url,
+		governorAPIVersionAlpha,
+		userID,
+		extensionSlug,
+		erdSlugPlural,
+		erdVersion,
+		resourceID,
+	)
+
+	versions := []*v1alpha1.UserExtensionResourceVersion{}
+	if err := c.doExtensionResourceRequest(ctx, http.MethodGet, u, &versions); err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// RollbackUserExtensionResource restores the payload of a version of a user
+// extension resource, the restored resource is returned
+func (c *Client) RollbackUserExtensionResource(
+	ctx context.Context, userID, extensionSlug, erdSlugPlural, erdVersion, resourceID string, version int64,
+) (*v1alpha1.UserExtensionResource, error) {
+	if userID == "" {
+		return nil, ErrMissingUserID
+	}
+
+	if extensionSlug == "" {
+		return nil, ErrMissingExtensionIDOrSlug
+	}
+
+	if erdSlugPlural == "" {
+		return nil, ErrMissingERDIDOrSlug
+	}
+
+	if resourceID == "" {
+		return nil, ErrMissingResourceID
+	}
+
+	u := fmt.Sprintf(
+		"%s/api/%s/users/%s/extension-resources/%s/%s/%s/%s/versions/%d/rollback",
+		c.url,
+		governorAPIVersionAlpha,
+		userID,
+		extensionSlug,
+		erdSlugPlural,
+		erdVersion,
+		resourceID,
+		version,
+	)
+
+	uer := &v1alpha1.UserExtensionResource{}
+	if err := c.doExtensionResourceRequest(ctx, http.MethodPost, u, uer); err != nil {
+		return nil, err
+	}
+
+	return uer, nil
+}
+
+// doExtensionResourceRequest sends a request without a body to an extension
+// resource endpoint and decodes the response into out
+func (c *Client) doExtensionResourceRequest(ctx context.Context, method, u string, out interface{}) error {
+	req, err := c.newGovernorRequest(ctx, method, u)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return handleResourceStatusNotFound(respBody)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return ErrRequestNonSuccess
+	}
+
+	return json.Unmarshal(respBody, out)
+}
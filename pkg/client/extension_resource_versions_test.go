@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+const testExtensionResourceVersionsResponse = `[
+	{
+		"id": "7b5f1b69-3a0f-4c4b-9d47-0b9b1d0e6e21",
+		"resource_id": "673ccd3a-1381-4e68-bc90-04e5f6745b9c",
+		"extension_resource_definition_id": "a82a34a5-db1f-464f-af9c-76086e79f715",
+		"resource": {"age": 11, "firstName": "test", "lastName": "2"},
+		"resource_created_at": "2023-07-12T14:34:36.587673Z",
+		"resource_updated_at": "2023-07-13T14:34:36.587673Z",
+		"recorded_at": "2023-07-13T14:34:36.587673Z",
+		"version": 2
+	},
+	{
+		"id": "0d1f0f4e-2a52-4a8e-9d4a-2b3c4d5e6f70",
+		"resource_id": "673ccd3a-1381-4e68-bc90-04e5f6745b9c",
+		"extension_resource_definition_id": "a82a34a5-db1f-464f-af9c-76086e79f715",
+		"resource": {"age": 10, "firstName": "test", "lastName": "2"},
+		"resource_created_at": "2023-07-12T14:34:36.587673Z",
+		"resource_updated_at": "2023-07-12T14:34:36.587673Z",
+		"recorded_at": "2023-07-12T14:34:36.587673Z",
+		"version": 1
+	}
+]`
+
+func TestClient_SystemExtensionResourceVersions(t *testing.T) {
+	doer := &mockHTTPDoer{
+		t:          t,
+		resp:       []byte(testExtensionResourceVersionsResponse),
+		statusCode: http.StatusOK,
+	}
+
+	c := &Client{
+		url:                    "https://the.gov",
+		logger:                 zap.NewNop(),
+		httpClient:             doer,
+		clientCredentialConfig: &mockTokener{t: t},
+		token:                  &oauth2.Token{AccessToken: "topSekret"},
+	}
+
+	got, err := c.SystemExtensionResourceVersions(
+		context.TODO(), "test-extension-1", "erd-1", "v1alpha1", "673ccd3a-1381-4e68-bc90-04e5f6745b9c",
+	)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+
+	assert.Equal(t, int64(2), got[0].Version)
+	assert.Equal(t, int64(1), got[1].Version)
+	assert.Equal(t, http.MethodGet, doer.Request().Method)
+	assert.Equal(t, "/api/v1alpha1/extension-resources/test-extension-1/erd-1/v1alpha1/673ccd3a-1381-4e68-bc90-04e5f6745b9c/versions", doer.Request().URL.Path)
+
+	_, err = c.SystemExtensionResourceVersions(context.TODO(), "test-extension-1", "erd-1", "v1alpha1", "")
+	assert.ErrorIs(t, err, ErrMissingResourceID)
+}
+
+func TestClient_RollbackSystemExtensionResource(t *testing.T) {
+	tests := []struct {
+		name        string
+		resourceID  string
+		statusCode  int
+		resp        string
+		expectedErr error
+	}{
+		{
+			name:       "rolled back",
+			resourceID: "673ccd3a-1381-4e68-bc90-04e5f6745b9c",
+			statusCode: http.StatusAccepted,
+			resp:       testExtensionResourceResponse,
+		},
+		{
+			name:        "missing resource id",
+			expectedErr: ErrMissingResourceID,
+		},
+		{
+			name:        "version not found",
+			resourceID:  "673ccd3a-1381-4e68-bc90-04e5f6745b9c",
+			statusCode:  http.StatusNotFound,
+			resp:        `{"code":"extension_resource_version_not_found","message":"extension resource version does not exist"}`,
+			expectedErr: v1alpha1.ErrExtensionResourceVersionNotFound,
+		},
+		{
+			name:        "invalid against the schema",
+			resourceID:  "673ccd3a-1381-4e68-bc90-04e5f6745b9c",
+			statusCode:  http.StatusBadRequest,
+			resp:        `{"code":"bad_request","message":"missing properties: 'age'"}`,
+			expectedErr: ErrRequestNonSuccess,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doer := &mockHTTPDoer{t: t, resp: []byte(tt.resp), statusCode: tt.statusCode}
+
+			c := &Client{
+				url:                    "https://the.gov",
+				logger:                 zap.NewNop(),
+				httpClient:             doer,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+
+			got, err := c.RollbackSystemExtensionResource(
+				context.TODO(), "test-extension-1", "erd-1", "v1alpha1", tt.resourceID, 3,
+			)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.resourceID, got.ID)
+			assert.Equal(t, http.MethodPost, doer.Request().Method)
+			assert.Equal(t, "/api/v1alpha1/extension-resources/test-extension-1/erd-1/v1alpha1/673ccd3a-1381-4e68-bc90-04e5f6745b9c/versions/3/rollback", doer.Request().URL.Path)
+		})
+	}
+}
+
+func TestClient_UserExtensionResourceVersions(t *testing.T) {
+	doer := &mockHTTPDoer{
+		t:          t,
+		resp:       []byte(testExtensionResourceVersionsResponse),
+		statusCode: http.StatusOK,
+	}
+
+	c := &Client{
+		url:                    "https://the.gov",
+		logger:                 zap.NewNop(),
+		httpClient:             doer,
+		clientCredentialConfig: &mockTokener{t: t},
+		token:                  &oauth2.Token{AccessToken: "topSekret"},
+	}
+
+	got, err := c.UserExtensionResourceVersions(
+		context.TODO(), "d2b3c4e5-0000-4000-8000-000000000001", "test-extension-1", "erd-1", "v1alpha1",
+		"673ccd3a-1381-4e68-bc90-04e5f6745b9c",
+	)
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, "/api/v1alpha1/users/d2b3c4e5-0000-4000-8000-000000000001/extension-resources/test-extension-1/erd-1/v1alpha1/673ccd3a-1381-4e68-bc90-04e5f6745b9c/versions", doer.Request().URL.Path)
+
+	_, err = c.UserExtensionResourceVersions(context.TODO(), "", "test-extension-1", "erd-1", "v1alpha1", "673ccd3a-1381-4e68-bc90-04e5f6745b9c")
+	assert.ErrorIs(t, err, ErrMissingUserID)
+
+	_, err = c.RollbackUserExtensionResource(context.TODO(), "", "test-extension-1", "erd-1", "v1alpha1", "673ccd3a-1381-4e68-bc90-04e5f6745b9c", 1)
+	assert.ErrorIs(t, err, ErrMissingUserID)
+}
+
+func TestClient_UpdateExtensionResourceRevision(t *testing.T) {
+	doer := &mockHTTPDoer{
+		t:          t,
+		resp:       []byte(`{"code":"extension_resource_revision_conflict","message":"extension resource revision conflict: current revision is 4, not 3"}`),
+		header:     http.Header{"Etag": []string{`"4"`}},
+		statusCode: http.StatusConflict,
+	}
+
+	c := &Client{
+		url:                    "https://the.gov",
+		logger:                 zap.NewNop(),
+		httpClient:             doer,
+		clientCredentialConfig: &mockTokener{t: t},
+		token:                  &oauth2.Token{AccessToken: "topSekret"},
+	}
+
+	_, err := c.UpdateSystemExtensionResource(
+		context.TODO(), "test-extension-1", "erd-1", "v1alpha1", "673ccd3a-1381-4e68-bc90-04e5f6745b9c", 3, map[string]int{"age": 11},
+	)
+	assert.ErrorIs(t, err, v1alpha1.ErrExtensionResourceRevisionConflict)
+	assert.Equal(t, `"3"`, doer.Request().Header.Get("If-Match"))
+
+	conflict := &RevisionConflictError{}
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, int64(4), conflict.Revision)
+
+	_, err = c.UpdateUserExtensionResource(
+		context.TODO(), "d2b3c4e5-0000-4000-8000-000000000001", "test-extension-1", "erd-1", "v1alpha1",
+		"673ccd3a-1381-4e68-bc90-04e5f6745b9c", 0, map[string]int{"age": 11},
+	)
+	assert.ErrorIs(t, err, ErrMissingResourceRevision)
+}
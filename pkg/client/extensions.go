@@ -62,6 +62,52 @@ func (c *Client) Extension(ctx context.Context, idOrSlug string, deleted bool) (
 	return nt, nil
 }
 
+// ExtensionUsage fetches the resource counts and storage usage of an extension
+func (c *Client) ExtensionUsage(ctx context.Context, idOrSlug string) (*v1alpha1.ExtensionUsage, error) {
+	if idOrSlug == "" {
+		return nil, ErrMissingExtensionIDOrSlug
+	}
+
+	u := fmt.Sprintf(
+		"%s/api/%s/extensions/%s/usage",
+		c.url,
+		governorAPIVersionAlpha,
+		idOrSlug,
+	)
+
+	req, err := c.newGovernorRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, v1alpha1.ErrExtensionNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &v1alpha1.ExtensionUsage{}
+	if err := json.Unmarshal(respBody, usage); err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
 // Extensions list all extensions
 func (c *Client) Extensions(ctx context.Context, deleted bool) ([]*v1alpha1.Extension, error) {
 	u := fmt.Sprintf(
@@ -230,6 +230,111 @@ func TestClient_Extension(t *testing.T) {
 	}
 }
 
+func TestClient_ExtensionUsage(t *testing.T) {
+	usageResponse := `{
+		"extension_id": "a0af3f24-2dbc-4e40-a9ad-3bd80fe8d4a3",
+		"extension_slug": "test-extension-1",
+		"resource_count": 12,
+		"deleted_resource_count": 3,
+		"payload_bytes": 4096,
+		"last_activity_at": "2024-02-20T18:06:35.000000Z",
+		"erds": [
+			{
+				"erd_id": "3b3d8b42-3ab1-4c6d-8f0a-6fb54b6bd2c5",
+				"slug_plural": "some-resources",
+				"version": "v1",
+				"scope": "system",
+				"resource_count": 12,
+				"deleted_resource_count": 3,
+				"payload_bytes": 4096,
+				"last_activity_at": "2024-02-20T18:06:35.000000Z"
+			}
+		]
+	}`
+
+	tests := []struct {
+		name        string
+		id          string
+		httpClient  *mockHTTPDoer
+		expectedErr error
+		wantErr     bool
+	}{
+		{
+			name: "example request",
+			id:   "test-extension-1",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       []byte(usageResponse),
+				statusCode: http.StatusOK,
+			},
+		},
+		{
+			name: "not found",
+			id:   "test-extension-1",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusNotFound,
+			},
+			expectedErr: v1alpha1.ErrExtensionNotFound,
+		},
+		{
+			name: "non-success",
+			id:   "test-extension-1",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusInternalServerError,
+			},
+			expectedErr: ErrRequestNonSuccess,
+		},
+		{
+			name: "bad json response",
+			id:   "test-extension-1",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+				resp:       []byte(`{`),
+			},
+			wantErr: true,
+		},
+		{
+			name:        "missing id",
+			httpClient:  &mockHTTPDoer{t: t},
+			expectedErr: ErrMissingExtensionIDOrSlug,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.ExtensionUsage(context.TODO(), tt.id)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "/api/v1alpha1/extensions/test-extension-1/usage", tt.httpClient.Request().URL.Path)
+			assert.Equal(t, int64(12), got.ResourceCount)
+			assert.Equal(t, int64(3), got.DeletedResourceCount)
+			assert.Equal(t, int64(4096), got.PayloadBytes)
+			assert.True(t, got.LastActivityAt.Valid)
+			assert.Len(t, got.ERDs, 1)
+		})
+	}
+}
+
 func TestClient_CreateExtension(t *testing.T) {
 	testResp := func(r []byte) *v1alpha1.Extension {
 		resp := &v1alpha1.Extension{}
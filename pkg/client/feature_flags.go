@@ -0,0 +1,75 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// FeatureFlags gets the feature flags and their values
+func (c *Client) FeatureFlags(ctx context.Context) ([]*v1alpha1.FeatureFlag, error) {
+	req, err := c.newGovernorRequest(ctx, http.MethodGet, fmt.Sprintf("%s/api/%s/feature-flags", c.url, governorAPIVersionAlpha))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := []*v1alpha1.FeatureFlag{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// SetFeatureFlag sets the value of a feature flag and returns the flag
+func (c *Client) SetFeatureFlag(ctx context.Context, name string, enabled bool) (*v1alpha1.FeatureFlag, error) {
+	if name == "" {
+		return nil, ErrMissingFeatureFlagName
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodPut, fmt.Sprintf("%s/api/%s/feature-flags/%s", c.url, governorAPIVersionAlpha, name))
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(&v1alpha1.FeatureFlagReq{Enabled: &enabled})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewBuffer(b))
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := v1alpha1.FeatureFlag{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+var testFeatureFlagsResponse = []byte(`
+[
+	{
+		"name": "enforce-naming-policy",
+		"description": "Check new group names against the global and organization naming policies",
+		"enabled": false,
+		"default": true,
+		"set": true,
+		"updated_at": "2023-07-12T12:00:00Z"
+	}
+]
+`)
+
+var testFeatureFlagResponse = []byte(`
+{
+	"name": "enforce-naming-policy",
+	"description": "Check new group names against the global and organization naming policies",
+	"enabled": false,
+	"default": true,
+	"set": true,
+	"updated_at": "2023-07-12T12:00:00Z"
+}
+`)
+
+func TestClient_FeatureFlags(t *testing.T) {
+	testResp := func(r []byte) []*v1alpha1.FeatureFlag {
+		resp := []*v1alpha1.FeatureFlag{}
+		if err := json.Unmarshal(r, &resp); err != nil {
+			t.Error(err)
+		}
+
+		return resp
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		want       []*v1alpha1.FeatureFlag
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testFeatureFlagsResponse,
+				statusCode: http.StatusOK,
+			},
+			want: testResp(testFeatureFlagsResponse),
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusInternalServerError,
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+				resp:       []byte(`{`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.FeatureFlags(context.TODO())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_SetFeatureFlag(t *testing.T) {
+	testResp := func(r []byte) *v1alpha1.FeatureFlag {
+		resp := v1alpha1.FeatureFlag{}
+		if err := json.Unmarshal(r, &resp); err != nil {
+			t.Error(err)
+		}
+
+		return &resp
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		flag       string
+		want       *v1alpha1.FeatureFlag
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testFeatureFlagResponse,
+				statusCode: http.StatusAccepted,
+			},
+			flag: "enforce-naming-policy",
+			want: testResp(testFeatureFlagResponse),
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusNotFound,
+			},
+			flag:    "unknown",
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusAccepted,
+				resp:       []byte(`{`),
+			},
+			flag:    "enforce-naming-policy",
+			wantErr: true,
+		},
+		{
+			name: "missing flag name",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusAccepted,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.SetFeatureFlag(context.TODO(), tt.flag, false)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -36,6 +36,8 @@ type Client struct {
 	token                  *oauth2.Token
 	httpClient             HTTPDoer
 	authMux                sync.Mutex
+	retries                int
+	retryWait              time.Duration
 }
 
 // URL returns the governor url
@@ -60,6 +62,31 @@ func WithClientCredentialConfig(c *clientcredentials.Config) Option {
 	}
 }
 
+// WithTokener sets the source of the client tokens, for example the token
+// source of an extension's client credentials
+func WithTokener(t Tokener) Option {
+	return func(r *Client) {
+		r.clientCredentialConfig = t
+	}
+}
+
+// WithToken sets a static bearer token, which is never refreshed
+func WithToken(token string) Option {
+	return func(r *Client) {
+		r.clientCredentialConfig = staticTokener{&oauth2.Token{AccessToken: token}}
+	}
+}
+
+// WithRetries retries requests failing with a transient error up to retries
+// times, waiting wait before the first retry and twice as long before every
+// next one. A zero wait uses the default of 500ms.
+func WithRetries(retries int, wait time.Duration) Option {
+	return func(r *Client) {
+		r.retries = retries
+		r.retryWait = wait
+	}
+}
+
 // WithLogger sets logger
 func WithLogger(l *zap.Logger) Option {
 	return func(r *Client) {
@@ -87,6 +114,22 @@ func NewClient(opts ...Option) (*Client, error) {
 		opt(&client)
 	}
 
+	if client.clientCredentialConfig == nil {
+		return nil, ErrMissingCredentials
+	}
+
+	if client.retries > 0 {
+		if client.retryWait <= 0 {
+			client.retryWait = defaultRetryWait
+		}
+
+		client.httpClient = &retryDoer{
+			doer:    client.httpClient,
+			retries: client.retries,
+			wait:    client.retryWait,
+		}
+	}
+
 	t, err := client.auth(context.TODO())
 	if err != nil {
 		return nil, err
@@ -106,7 +149,7 @@ func (c *Client) auth(ctx context.Context) (*oauth2.Token, error) {
 }
 
 func (c *Client) refreshAuth(ctx context.Context) error {
-	if c.token != nil && !time.Now().After(c.token.Expiry) {
+	if c.token != nil && (c.token.Expiry.IsZero() || !time.Now().After(c.token.Expiry)) {
 		return nil
 	}
 
@@ -149,3 +192,13 @@ func (c *Client) newGovernorRequest(ctx context.Context, method, u string) (*htt
 
 	return req, nil
 }
+
+// staticTokener always returns the same token
+type staticTokener struct {
+	token *oauth2.Token
+}
+
+// Token returns the static token
+func (s staticTokener) Token(_ context.Context) (*oauth2.Token, error) {
+	return s.token, nil
+}
@@ -18,6 +18,7 @@ type mockHTTPDoer struct {
 	t          *testing.T
 	statusCode int
 	resp       []byte
+	header     http.Header
 	request    *http.Request
 }
 
@@ -30,6 +31,7 @@ type mockTokener struct {
 func (m *mockHTTPDoer) Do(r *http.Request) (*http.Response, error) {
 	resp := http.Response{
 		StatusCode: m.statusCode,
+		Header:     m.header,
 	}
 
 	m.request = r
@@ -141,3 +143,24 @@ func TestClient_newGovernorRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestNewClient(t *testing.T) {
+	_, err := NewClient(WithURL("https://governor.example.com"))
+	assert.ErrorIs(t, err, ErrMissingCredentials)
+
+	c, err := NewClient(WithToken("sekret"), WithRetries(2, 0))
+	assert.NoError(t, err)
+
+	req, err := c.newGovernorRequest(context.TODO(), http.MethodGet, "https://governor.example.com/api")
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer sekret", req.Header.Get("Authorization"))
+
+	retries, ok := c.httpClient.(*retryDoer)
+	assert.True(t, ok)
+	assert.Equal(t, 2, retries.retries)
+	assert.Equal(t, defaultRetryWait, retries.wait)
+
+	c, err = NewClient(WithTokener(&mockTokener{t: t, token: &oauth2.Token{AccessToken: "extension"}}))
+	assert.NoError(t, err)
+	assert.Equal(t, "extension", c.token.AccessToken)
+}
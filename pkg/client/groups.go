@@ -177,13 +177,58 @@ func (c *Client) CreateGroup(ctx context.Context, group *v1alpha1.GroupReq) (*v1
 	return &out, nil
 }
 
-// DeleteGroup deletes a group from governor
+// DeleteGroup deletes a group from governor, along with its memberships, hierarchies, pending requests and links
 func (c *Client) DeleteGroup(ctx context.Context, id string) error {
+	return c.deleteGroup(ctx, id, "")
+}
+
+// GroupDeletionImpact returns what deleting a group would remove, without changing anything
+func (c *Client) GroupDeletionImpact(ctx context.Context, id string) (*v1alpha1.GroupDeletionImpact, error) {
+	if id == "" {
+		return nil, ErrMissingGroupID
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodGet, fmt.Sprintf("%s/api/%s/groups/%s/deletion-impact", c.url, governorAPIVersionAlpha, id))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	c.logger.Debug("status code", zap.String("status code", resp.Status))
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrGroupNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := v1alpha1.GroupDeletionImpact{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// DetachGroup removes the memberships, hierarchies, pending requests and links of a group without deleting it
+func (c *Client) DetachGroup(ctx context.Context, id string) error {
+	return c.deleteGroup(ctx, id, "?detach_only")
+}
+
+func (c *Client) deleteGroup(ctx context.Context, id, query string) error {
 	if id == "" {
 		return ErrMissingGroupID
 	}
 
-	req, err := c.newGovernorRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/api/%s/groups/%s", c.url, governorAPIVersionAlpha, id))
+	req, err := c.newGovernorRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/api/%s/groups/%s%s", c.url, governorAPIVersionAlpha, id, query))
 	if err != nil {
 		return err
 	}
@@ -319,6 +364,47 @@ func (c *Client) UpdateGroupMember(ctx context.Context, groupID, userID string,
 	return nil
 }
 
+// UpdateGroupMemberNote sets the admin note of a direct group membership, an
+// empty note removes it
+func (c *Client) UpdateGroupMemberNote(ctx context.Context, groupID, userID, note string) error {
+	if groupID == "" {
+		return ErrMissingGroupID
+	}
+
+	if userID == "" {
+		return ErrMissingUserID
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodPatch, fmt.Sprintf("%s/api/%s/groups/%s/users/%s", c.url, governorAPIVersionAlpha, groupID, userID))
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(struct {
+		Note string `json:"note"`
+	}{note})
+	if err != nil {
+		return err
+	}
+
+	req.Body = io.NopCloser(bytes.NewBuffer(b))
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusAccepted &&
+		resp.StatusCode != http.StatusNoContent {
+		return ErrRequestNonSuccess
+	}
+
+	return nil
+}
+
 // AddGroupToOrganization links the group to the organization
 func (c *Client) AddGroupToOrganization(ctx context.Context, groupID, orgID string) error {
 	if groupID == "" {
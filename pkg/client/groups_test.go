@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"testing"
 
@@ -652,6 +653,115 @@ func TestClient_DeleteGroup(t *testing.T) {
 	}
 }
 
+func TestClient_GroupDeletionImpact(t *testing.T) {
+	resp := []byte(`{
+		"group_id": "8923e54d-0df6-407a-832d-2917915a3ff7",
+		"members": 2,
+		"lost_memberships": [
+			{"group_id": "8923e54d-0df6-407a-832d-2917915a3ff7", "user_id": "user-1", "direct": true},
+			{"group_id": "parent-id", "user_id": "user-1", "direct": false}
+		],
+		"parent_group_ids": ["parent-id"],
+		"member_group_ids": [],
+		"application_ids": ["app-id"],
+		"organization_ids": [],
+		"membership_requests": 1,
+		"application_requests": 0
+	}`)
+
+	tests := []struct {
+		name       string
+		id         string
+		statusCode int
+		want       *v1alpha1.GroupDeletionImpact
+		wantErr    error
+	}{
+		{
+			name:       "example request",
+			id:         "8923e54d-0df6-407a-832d-2917915a3ff7",
+			statusCode: http.StatusOK,
+			want: &v1alpha1.GroupDeletionImpact{
+				GroupID: "8923e54d-0df6-407a-832d-2917915a3ff7",
+				Members: 2,
+				LostMemberships: []v1alpha1.LostMembership{
+					{GroupID: "8923e54d-0df6-407a-832d-2917915a3ff7", UserID: "user-1", Direct: true},
+					{GroupID: "parent-id", UserID: "user-1"},
+				},
+				ParentGroupIDs:     []string{"parent-id"},
+				MemberGroupIDs:     []string{},
+				ApplicationIDs:     []string{"app-id"},
+				OrganizationIDs:    []string{},
+				MembershipRequests: 1,
+			},
+		},
+		{
+			name:       "not found",
+			id:         "8923e54d-0df6-407a-832d-2917915a3ff7",
+			statusCode: http.StatusNotFound,
+			wantErr:    ErrGroupNotFound,
+		},
+		{
+			name:       "non-success",
+			id:         "8923e54d-0df6-407a-832d-2917915a3ff7",
+			statusCode: http.StatusInternalServerError,
+			wantErr:    ErrRequestNonSuccess,
+		},
+		{
+			name:    "missing id in request",
+			wantErr: ErrMissingGroupID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mockHTTPDoer{t: t, resp: resp, statusCode: tt.statusCode}
+
+			c := &Client{
+				url:                    "https://the.gov",
+				logger:                 zap.NewNop(),
+				httpClient:             m,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+
+			got, err := c.GroupDeletionImpact(context.TODO(), tt.id)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, "/api/v1alpha1/groups/"+tt.id+"/deletion-impact", m.request.URL.Path)
+		})
+	}
+}
+
+func TestClient_DetachGroup(t *testing.T) {
+	m := &mockHTTPDoer{
+		t:          t,
+		resp:       testGroupResponse,
+		statusCode: http.StatusAccepted,
+	}
+
+	c := &Client{
+		url:                    "https://the.gov",
+		logger:                 zap.NewNop(),
+		httpClient:             m,
+		clientCredentialConfig: &mockTokener{t: t},
+		token:                  &oauth2.Token{AccessToken: "topSekret"},
+	}
+
+	assert.NoError(t, c.DetachGroup(context.TODO(), "8923e54d-0df6-407a-832d-2917915a3ff7"))
+	assert.Equal(t, http.MethodDelete, m.request.Method)
+	assert.Equal(t, "/api/v1alpha1/groups/8923e54d-0df6-407a-832d-2917915a3ff7", m.request.URL.Path)
+
+	_, detachOnly := m.request.URL.Query()["detach_only"]
+	assert.True(t, detachOnly)
+
+	assert.ErrorIs(t, c.DetachGroup(context.TODO(), ""), ErrMissingGroupID)
+}
+
 func TestClient_AddGroupMember(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -852,6 +962,69 @@ func TestClient_RemoveGroupMember(t *testing.T) {
 	}
 }
 
+func TestClient_UpdateGroupMemberNote(t *testing.T) {
+	tests := []struct {
+		name       string
+		httpClient *mockHTTPDoer
+		groupID    string
+		userID     string
+		wantErr    bool
+	}{
+		{
+			name: "example no content request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusNoContent,
+			},
+			groupID: "mushroom-kingdom",
+			userID:  "toadstool",
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusBadRequest,
+			},
+			groupID: "mushroom-kingdom",
+			userID:  "bowser",
+			wantErr: true,
+		},
+		{
+			name: "missing groupID in request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusNoContent,
+			},
+			userID:  "cappy",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			err := c.UpdateGroupMemberNote(context.TODO(), tt.groupID, tt.userID, "temporary for project X")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.MethodPatch, tt.httpClient.request.Method)
+
+			body, err := io.ReadAll(tt.httpClient.request.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"note": "temporary for project X"}`, string(body))
+		})
+	}
+}
+
 func TestClient_AddGroupToOrganization(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+var testMetaResponse = []byte(`
+{
+	"api_versions": ["v1alpha1", "v1beta1"],
+	"admin_groups": ["governor-admins"],
+	"features": {"jobs": true, "okta_event_hooks": false},
+	"feature_flags": {"read-only": false},
+	"erd_schema": {
+		"default_draft": "https://json-schema.org/draft/2020-12/schema",
+		"supported_drafts": ["https://json-schema.org/draft/2020-12/schema"]
+	},
+	"event_subjects": {"events": "governor.events", "v2_events": "governor.events.v2"}
+}
+`)
+
+func TestClient_Meta(t *testing.T) {
+	testResp := func(r []byte) *v1alpha1.Meta {
+		resp := v1alpha1.Meta{}
+		if err := json.Unmarshal(r, &resp); err != nil {
+			t.Error(err)
+		}
+
+		return &resp
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		want       *v1alpha1.Meta
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testMetaResponse,
+				statusCode: http.StatusOK,
+			},
+			want: testResp(testMetaResponse),
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusInternalServerError,
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+				resp:       []byte(`{`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.Meta(context.TODO())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// CreateNotificationDispatch dispatches a notification to a user, governor
+// publishes a dispatch event for each target the notification is sent to
+func (c *Client) CreateNotificationDispatch(ctx context.Context, dispatch *v1alpha1.NotificationDispatchReq) (*v1alpha1.NotificationDispatch, error) {
+	if dispatch == nil {
+		return nil, ErrNilNotificationDispatchRequest
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodPost, fmt.Sprintf("%s/api/%s/notification-dispatches", c.url, governorAPIVersionAlpha))
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(dispatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewBuffer(b))
+
+	return c.doNotificationDispatchRequest(ctx, req)
+}
+
+// NotificationDispatch gets a notification dispatch
+func (c *Client) NotificationDispatch(ctx context.Context, id string) (*v1alpha1.NotificationDispatch, error) {
+	if id == "" {
+		return nil, ErrMissingNotificationDispatchID
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodGet, fmt.Sprintf("%s/api/%s/notification-dispatches/%s", c.url, governorAPIVersionAlpha, id))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doNotificationDispatchRequest(ctx, req)
+}
+
+// AckNotificationDispatch records that a notification dispatch was delivered
+// on the target, which stops its failover
+func (c *Client) AckNotificationDispatch(ctx context.Context, id, target string) (*v1alpha1.NotificationDispatch, error) {
+	if id == "" {
+		return nil, ErrMissingNotificationDispatchID
+	}
+
+	if target == "" {
+		return nil, ErrMissingNotificationTargetID
+	}
+
+	req, err := c.newGovernorRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s/api/%s/notification-dispatches/%s/delivered", c.url, governorAPIVersionAlpha, id),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(v1alpha1.NotificationDispatchAckReq{Target: target})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewBuffer(b))
+
+	return c.doNotificationDispatchRequest(ctx, req)
+}
+
+func (c *Client) doNotificationDispatchRequest(ctx context.Context, req *http.Request) (*v1alpha1.NotificationDispatch, error) {
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := v1alpha1.NotificationDispatch{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+const testNotificationDispatchResponse = `{
+	"id": "2d5a1c4e-8ec5-4a37-9d0e-0dd7b1b7d1c2",
+	"user_id": "186c5a52-4421-4573-8bbf-78d85d3c277e",
+	"notification_type_id": "e8d1a0ba-4b70-4d8b-8a53-3c2b2f6b8c1a",
+	"targets": [{"id": "slack-id", "slug": "slack"}, {"id": "email-id", "slug": "email"}],
+	"attempt": 0,
+	"failover_after_seconds": 600,
+	"status": "pending"
+}`
+
+func TestClient_NotificationDispatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		call       func(c *Client) (*v1alpha1.NotificationDispatch, error)
+		wantErr    bool
+	}{
+		{
+			name: "create",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       []byte(testNotificationDispatchResponse),
+				statusCode: http.StatusAccepted,
+			},
+			call: func(c *Client) (*v1alpha1.NotificationDispatch, error) {
+				return c.CreateNotificationDispatch(context.TODO(), &v1alpha1.NotificationDispatchReq{
+					UserID:           "186c5a52-4421-4573-8bbf-78d85d3c277e",
+					NotificationType: "approvals",
+				})
+			},
+		},
+		{
+			name: "create with nil request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusAccepted,
+			},
+			call: func(c *Client) (*v1alpha1.NotificationDispatch, error) {
+				return c.CreateNotificationDispatch(context.TODO(), nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "get",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       []byte(testNotificationDispatchResponse),
+				statusCode: http.StatusOK,
+			},
+			call: func(c *Client) (*v1alpha1.NotificationDispatch, error) {
+				return c.NotificationDispatch(context.TODO(), "2d5a1c4e-8ec5-4a37-9d0e-0dd7b1b7d1c2")
+			},
+		},
+		{
+			name: "get without id",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+			},
+			call: func(c *Client) (*v1alpha1.NotificationDispatch, error) {
+				return c.NotificationDispatch(context.TODO(), "")
+			},
+			wantErr: true,
+		},
+		{
+			name: "ack",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       []byte(testNotificationDispatchResponse),
+				statusCode: http.StatusAccepted,
+			},
+			call: func(c *Client) (*v1alpha1.NotificationDispatch, error) {
+				return c.AckNotificationDispatch(context.TODO(), "2d5a1c4e-8ec5-4a37-9d0e-0dd7b1b7d1c2", "slack")
+			},
+		},
+		{
+			name: "ack without target",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusAccepted,
+			},
+			call: func(c *Client) (*v1alpha1.NotificationDispatch, error) {
+				return c.AckNotificationDispatch(context.TODO(), "2d5a1c4e-8ec5-4a37-9d0e-0dd7b1b7d1c2", "")
+			},
+			wantErr: true,
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusConflict,
+			},
+			call: func(c *Client) (*v1alpha1.NotificationDispatch, error) {
+				return c.AckNotificationDispatch(context.TODO(), "2d5a1c4e-8ec5-4a37-9d0e-0dd7b1b7d1c2", "slack")
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := tt.call(c)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "2d5a1c4e-8ec5-4a37-9d0e-0dd7b1b7d1c2", got.ID)
+			assert.Equal(t, "pending", got.Status)
+			assert.Equal(t, 600, got.FailoverAfterSeconds.Int)
+		})
+	}
+}
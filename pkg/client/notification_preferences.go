@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
 	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
 )
@@ -49,3 +50,58 @@ func (c *Client) NotificationPreferences(ctx context.Context, userID string) (v1
 
 	return p, nil
 }
+
+// NotificationDelivery resolves how a notification of a type, sent through a
+// target about a group, should be delivered to a user. The target and group
+// are optional.
+func (c *Client) NotificationDelivery(ctx context.Context, userID, notificationType, target, group string) (*v1alpha1.NotificationDelivery, error) {
+	if userID == "" {
+		return nil, ErrMissingUserID
+	}
+
+	if notificationType == "" {
+		return nil, ErrMissingNotificationTypeID
+	}
+
+	q := url.Values{}
+	q.Set("notification_type", notificationType)
+
+	if target != "" {
+		q.Set("target", target)
+	}
+
+	if group != "" {
+		q.Set("group", group)
+	}
+
+	u := fmt.Sprintf(
+		"%s/api/%s/users/%s/notification-delivery?%s",
+		c.url,
+		governorAPIVersionAlpha,
+		userID,
+		q.Encode(),
+	)
+
+	req, err := c.newGovernorRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	d := v1alpha1.NotificationDelivery{}
+	if err := json.NewDecoder(resp.Body).Decode(&d); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
@@ -148,3 +148,85 @@ func TestClient_NotificationPreferences(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_NotificationDelivery(t *testing.T) {
+	tests := []struct {
+		name             string
+		httpClient       HTTPDoer
+		userID           string
+		notificationType string
+		want             *v1alpha1.NotificationDelivery
+		wantErr          bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       []byte(`{"deliver": true, "elevated": true, "level": "elevated"}`),
+				statusCode: http.StatusOK,
+			},
+			userID:           "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			notificationType: "approvals",
+			want:             &v1alpha1.NotificationDelivery{Deliver: true, Elevated: true, Level: v1alpha1.NotificationLevelElevated},
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusNotFound,
+			},
+			userID:           "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			notificationType: "approvals",
+			wantErr:          true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       []byte(`{`),
+				statusCode: http.StatusOK,
+			},
+			userID:           "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			notificationType: "approvals",
+			wantErr:          true,
+		},
+		{
+			name: "missing user id in request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+			},
+			notificationType: "approvals",
+			wantErr:          true,
+		},
+		{
+			name: "missing notification type in request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+			},
+			userID:  "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.NotificationDelivery(context.TODO(), tt.userID, tt.notificationType, "slack", "announce")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
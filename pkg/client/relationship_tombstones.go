@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// RelationshipTombstones gets the tombstones of the removed relationships of
+// a kind, one of group_membership, group_hierarchy or group_organization.
+// Only the ones removed after since are returned, unless it's zero.
+func (c *Client) RelationshipTombstones(ctx context.Context, kind string, since time.Time) ([]*v1alpha1.RelationshipTombstone, error) {
+	if kind == "" {
+		return nil, ErrMissingTombstoneKind
+	}
+
+	u := fmt.Sprintf("%s/api/%s/tombstones/%s", c.url, governorAPIVersionAlpha, kind)
+	if !since.IsZero() {
+		u += "?" + url.Values{"updated_since": []string{since.UTC().Format(time.RFC3339Nano)}}.Encode()
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := []*v1alpha1.RelationshipTombstone{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
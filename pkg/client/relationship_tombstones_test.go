@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+var testRelationshipTombstonesResponse = []byte(`
+[
+	{
+		"id": "0d8e6b3c-59f1-4b4e-9a55-2d9f3c1a7e10",
+		"kind": "group_membership",
+		"record_id": "5b1a6f0e-2c3d-4e5f-8a9b-0c1d2e3f4a5b",
+		"group_id": "186c5a52-4421-4573-8bbf-78d85d3c277e",
+		"subject_id": "a9c8d84e-0cc2-4c3b-a4f8-1a4a0a4b0a3f",
+		"record": {"id": "5b1a6f0e-2c3d-4e5f-8a9b-0c1d2e3f4a5b", "is_admin": false},
+		"removed_at": "2023-07-12T12:00:00Z"
+	}
+]
+`)
+
+func TestClient_RelationshipTombstones(t *testing.T) {
+	want := []*v1alpha1.RelationshipTombstone{}
+	if err := json.Unmarshal(testRelationshipTombstonesResponse, &want); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		kind       string
+		since      time.Time
+		want       []*v1alpha1.RelationshipTombstone
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testRelationshipTombstonesResponse,
+				statusCode: http.StatusOK,
+			},
+			kind: "group_membership",
+			want: want,
+		},
+		{
+			name: "example request since",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testRelationshipTombstonesResponse,
+				statusCode: http.StatusOK,
+			},
+			kind:  "group_membership",
+			since: time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+			want:  want,
+		},
+		{
+			name:       "missing kind",
+			httpClient: &mockHTTPDoer{t: t},
+			wantErr:    true,
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusGone,
+			},
+			kind:    "group_membership",
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+				resp:       []byte(`{`),
+			},
+			kind:    "group_hierarchy",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.RelationshipTombstones(context.TODO(), tt.kind, tt.since)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
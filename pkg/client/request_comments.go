@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// GroupMembershipRequestComments gets the comment thread of a group membership request
+func (c *Client) GroupMembershipRequestComments(ctx context.Context, groupID, requestID string) ([]*v1alpha1.RequestComment, error) {
+	return c.requestComments(ctx, groupID, "requests", requestID)
+}
+
+// GroupApplicationRequestComments gets the comment thread of a group application request
+func (c *Client) GroupApplicationRequestComments(ctx context.Context, groupID, requestID string) ([]*v1alpha1.RequestComment, error) {
+	return c.requestComments(ctx, groupID, "apprequests", requestID)
+}
+
+func (c *Client) requestComments(ctx context.Context, groupID, kind, requestID string) ([]*v1alpha1.RequestComment, error) {
+	if groupID == "" {
+		return nil, ErrMissingGroupID
+	}
+
+	if requestID == "" {
+		return nil, ErrMissingRequestID
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodGet, fmt.Sprintf("%s/api/%s/groups/%s/%s/%s/comments", c.url, governorAPIVersionAlpha, groupID, kind, requestID))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := []*v1alpha1.RequestComment{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+var testRequestCommentsResponse = []byte(`
+[
+	{
+		"id": "5a5c1b8c-6a39-4f5c-a2f7-8d7c6f9a1e01",
+		"request_id": "0c0cbd37-5b8e-4a4e-9a9a-7f3d0a3c2b11",
+		"user_id": "186c5a52-4421-4573-8bbf-78d85d3c277e",
+		"user_name": "Hugh Jass",
+		"user_email": "hjass@example.com",
+		"body": "What do you need access for?",
+		"created_at": "2023-07-12T12:00:00Z"
+	},
+	{
+		"id": "5a5c1b8c-6a39-4f5c-a2f7-8d7c6f9a1e02",
+		"request_id": "0c0cbd37-5b8e-4a4e-9a9a-7f3d0a3c2b11",
+		"user_id": "6e2b0e5c-0f1a-4c32-9a4b-6a0f7b6f3e21",
+		"user_name": "Ella Vator",
+		"user_email": "evator@example.com",
+		"body": "Deploying the gophers service.",
+		"created_at": "2023-07-12T12:05:00Z"
+	}
+]
+`)
+
+func TestClient_RequestComments(t *testing.T) {
+	testResp := func(r []byte) []*v1alpha1.RequestComment {
+		resp := []*v1alpha1.RequestComment{}
+		if err := json.Unmarshal(r, &resp); err != nil {
+			t.Error(err)
+		}
+
+		return resp
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		groupID    string
+		requestID  string
+		app        bool
+		want       []*v1alpha1.RequestComment
+		wantErr    bool
+	}{
+		{
+			name: "membership request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testRequestCommentsResponse,
+				statusCode: http.StatusOK,
+			},
+			groupID:   "31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4",
+			requestID: "0c0cbd37-5b8e-4a4e-9a9a-7f3d0a3c2b11",
+			want:      testResp(testRequestCommentsResponse),
+		},
+		{
+			name: "application request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testRequestCommentsResponse,
+				statusCode: http.StatusOK,
+			},
+			groupID:   "31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4",
+			requestID: "0c0cbd37-5b8e-4a4e-9a9a-7f3d0a3c2b11",
+			app:       true,
+			want:      testResp(testRequestCommentsResponse),
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusInternalServerError,
+			},
+			groupID:   "31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4",
+			requestID: "0c0cbd37-5b8e-4a4e-9a9a-7f3d0a3c2b11",
+			wantErr:   true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+				resp:       []byte(`{`),
+			},
+			groupID:   "31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4",
+			requestID: "0c0cbd37-5b8e-4a4e-9a9a-7f3d0a3c2b11",
+			wantErr:   true,
+		},
+		{
+			name: "missing group id",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+			},
+			requestID: "0c0cbd37-5b8e-4a4e-9a9a-7f3d0a3c2b11",
+			wantErr:   true,
+		},
+		{
+			name: "missing request id",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+			},
+			groupID: "31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+
+			get := c.GroupMembershipRequestComments
+			if tt.app {
+				get = c.GroupApplicationRequestComments
+			}
+
+			got, err := get(context.TODO(), tt.groupID, tt.requestID)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
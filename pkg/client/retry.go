@@ -0,0 +1,117 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// defaultRetryWait is the wait before the first retry, it doubles with
+	// every attempt
+	defaultRetryWait = 500 * time.Millisecond
+	// maxRetryWait caps the wait between attempts, including the one asked by
+	// the server with Retry-After
+	maxRetryWait = 30 * time.Second
+)
+
+// retryDoer is an HTTPDoer retrying requests that failed with a transient
+// error. Idempotent requests are retried on network errors and on 429, 502,
+// 503 and 504 responses, other requests only on 429 responses since the
+// server didn't process them.
+type retryDoer struct {
+	doer    HTTPDoer
+	retries int
+	wait    time.Duration
+}
+
+// Do sends the request, and retries it when it failed with a transient error
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		body = b
+	}
+
+	for attempt := 0; ; attempt++ {
+		if body != nil {
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := d.doer.Do(req)
+
+		if attempt >= d.retries || !retryable(req.Method, resp, err) {
+			return resp, err
+		}
+
+		wait := d.backoff(attempt, resp)
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff returns the wait before the next attempt, the server's Retry-After
+// is used when it gives one in seconds
+func (d *retryDoer) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := d.wait << attempt
+
+	if resp != nil {
+		if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && s >= 0 {
+			wait = time.Duration(s) * time.Second
+		}
+	}
+
+	if wait <= 0 || wait > maxRetryWait {
+		wait = maxRetryWait
+	}
+
+	return wait
+}
+
+// retryable returns true when a request failed with a transient error
+func retryable(method string, resp *http.Response, err error) bool {
+	idempotent := method != http.MethodPost && method != http.MethodPatch
+
+	if err != nil {
+		return idempotent
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent
+	}
+
+	return false
+}
+
+// sleep waits for d, or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
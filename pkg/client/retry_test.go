@@ -0,0 +1,164 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errTestNetwork = errors.New("connection reset")
+
+type mockRetryDoer struct {
+	statuses []int
+	errs     []error
+	bodies   []string
+	calls    int
+}
+
+func (m *mockRetryDoer) Do(r *http.Request) (*http.Response, error) {
+	i := m.calls
+	m.calls++
+
+	if r.Body != nil {
+		b, _ := io.ReadAll(r.Body)
+		m.bodies = append(m.bodies, string(b))
+	}
+
+	if i < len(m.errs) && m.errs[i] != nil {
+		return nil, m.errs[i]
+	}
+
+	return &http.Response{
+		StatusCode: m.statuses[i],
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewReader(nil)),
+	}, nil
+}
+
+func TestRetryDoer(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		statuses   []int
+		errs       []error
+		wantCalls  int
+		wantStatus int
+		wantErr    bool
+	}{
+		{
+			name:       "success",
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusOK},
+			wantCalls:  1,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "retried until success",
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			wantCalls:  3,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "retries exhausted",
+			method:     http.MethodDelete,
+			statuses:   []int{http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout},
+			wantCalls:  4,
+			wantStatus: http.StatusGatewayTimeout,
+		},
+		{
+			name:       "client error isn't retried",
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusBadRequest},
+			wantCalls:  1,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "post isn't retried on server errors",
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusServiceUnavailable},
+			wantCalls:  1,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "post is retried when rate limited",
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusTooManyRequests, http.StatusAccepted},
+			wantCalls:  2,
+			wantStatus: http.StatusAccepted,
+		},
+		{
+			name:       "network error is retried",
+			method:     http.MethodPut,
+			statuses:   []int{0, http.StatusOK},
+			errs:       []error{errTestNetwork},
+			wantCalls:  2,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:      "network error on post",
+			method:    http.MethodPost,
+			statuses:  []int{0},
+			errs:      []error{errTestNetwork},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mockRetryDoer{statuses: tt.statuses, errs: tt.errs}
+			d := &retryDoer{doer: m, retries: 3, wait: time.Millisecond}
+
+			req, _ := http.NewRequestWithContext(context.TODO(), tt.method, "https://governor.example.com/api", nil)
+			req.Body = io.NopCloser(strings.NewReader(`{"name":"test"}`))
+
+			resp, err := d.Do(req)
+			assert.Equal(t, tt.wantCalls, m.calls)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errTestNetwork)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+
+			for _, b := range m.bodies {
+				assert.Equal(t, `{"name":"test"}`, b)
+			}
+		})
+	}
+}
+
+func TestRetryDoerBackoff(t *testing.T) {
+	d := &retryDoer{wait: time.Second}
+
+	assert.Equal(t, time.Second, d.backoff(0, nil))
+	assert.Equal(t, 4*time.Second, d.backoff(2, nil))
+	assert.Equal(t, maxRetryWait, d.backoff(10, nil))
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	assert.Equal(t, 7*time.Second, d.backoff(0, resp))
+}
+
+func TestRetryDoerContextCanceled(t *testing.T) {
+	m := &mockRetryDoer{statuses: []int{http.StatusServiceUnavailable, http.StatusOK}}
+	d := &retryDoer{doer: m, retries: 3, wait: time.Hour}
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://governor.example.com/api", nil)
+
+	_, err := d.Do(req)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, m.calls)
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+var testRoutePoliciesResponse = []byte(`
+[
+	{
+		"method": "GET",
+		"path": "/user",
+		"auth": "token",
+		"scopes": ["openid"],
+		"user_role": "AuthRoleUser",
+		"erd_auth": "none",
+		"step_up_enforced": false
+	},
+	{
+		"method": "DELETE",
+		"path": "/groups/:id",
+		"auth": "token",
+		"scopes": ["write", "delete:governor:groups", "openid"],
+		"group_role": "AuthRoleAdminOrGroupAdmin",
+		"erd_auth": "none",
+		"step_up": "groups",
+		"step_up_enforced": true
+	}
+]
+`)
+
+func TestClient_RoutePolicies(t *testing.T) {
+	testResp := func(r []byte) []v1alpha1.RoutePolicy {
+		resp := []v1alpha1.RoutePolicy{}
+		if err := json.Unmarshal(r, &resp); err != nil {
+			t.Error(err)
+		}
+
+		return resp
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		want       []v1alpha1.RoutePolicy
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testRoutePoliciesResponse,
+				statusCode: http.StatusOK,
+			},
+			want: testResp(testRoutePoliciesResponse),
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusInternalServerError,
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+				resp:       []byte(`{`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.RoutePolicies(context.TODO())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -7,20 +7,37 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
 )
 
 // handleResourceStatusNotFound handles a 404 responses
 func handleResourceStatusNotFound(respBody []byte) error {
-	respErr := map[string]string{}
+	respErr := v1alpha1.ErrorResponse{}
 	if err := json.Unmarshal(respBody, &respErr); err != nil {
 		return ErrRequestNonSuccess
 	}
 
-	errMsg, ok := respErr["error"]
-	if !ok {
+	switch respErr.Code {
+	case v1alpha1.ErrCodeERDNotFound:
+		return v1alpha1.ErrERDNotFound
+	case v1alpha1.ErrCodeExtensionNotFound:
+		return v1alpha1.ErrExtensionNotFound
+	case v1alpha1.ErrCodeExtensionResourceNotFound:
+		return v1alpha1.ErrExtensionResourceNotFound
+	case v1alpha1.ErrCodeExtensionResourceVersionNotFound:
+		return v1alpha1.ErrExtensionResourceVersionNotFound
+	case v1alpha1.ErrCodeUserNotFound:
+		return v1alpha1.ErrUserNotFound
+	}
+
+	// fall back to matching the message for servers that don't return error codes
+	errMsg := respErr.Error
+	if errMsg == "" {
 		return ErrRequestNonSuccess
 	}
 
@@ -68,6 +85,45 @@ func (c *Client) SystemExtensionResource(
 		u += "?deleted"
 	}
 
+	return c.getSystemExtensionResource(ctx, u)
+}
+
+// SystemExtensionResourceAsOf fetches a system extension resource as it was at
+// a time, from its history
+func (c *Client) SystemExtensionResourceAsOf(
+	ctx context.Context, extensionSlug, erdSlugPlural, erdVersion, resourceID string, asOf time.Time, deleted bool,
+) (*v1alpha1.SystemExtensionResource, error) {
+	if extensionSlug == "" {
+		return nil, ErrMissingExtensionIDOrSlug
+	}
+
+	if erdSlugPlural == "" {
+		return nil, ErrMissingERDIDOrSlug
+	}
+
+	if resourceID == "" {
+		return nil, ErrMissingResourceID
+	}
+
+	u := fmt.Sprintf(
+		"%s/api/%s/extension-resources/%s/%s/%s/%s?as_of=%s",
+		c.url,
+		governorAPIVersionAlpha,
+		extensionSlug,
+		erdSlugPlural,
+		erdVersion,
+		resourceID,
+		url.QueryEscape(asOf.Format(time.RFC3339Nano)),
+	)
+
+	if deleted {
+		u += "&deleted"
+	}
+
+	return c.getSystemExtensionResource(ctx, u)
+}
+
+func (c *Client) getSystemExtensionResource(ctx context.Context, u string) (*v1alpha1.SystemExtensionResource, error) {
 	req, err := c.newGovernorRequest(ctx, http.MethodGet, u)
 	if err != nil {
 		return nil, err
@@ -241,9 +297,11 @@ func (c *Client) CreateSystemExtensionResource(
 	return ser, nil
 }
 
-// UpdateSystemExtensionResource updates a system extension resource
+// UpdateSystemExtensionResource updates a system extension resource, revision
+// is the revision of the resource the update is based on. It returns a
+// *RevisionConflictError when the resource was changed since.
 func (c *Client) UpdateSystemExtensionResource(
-	ctx context.Context, extensionSlug, erdSlugPlural, erdVersion, resourceID string, resource interface{},
+	ctx context.Context, extensionSlug, erdSlugPlural, erdVersion, resourceID string, revision int64, resource interface{},
 ) (*v1alpha1.SystemExtensionResource, error) {
 	if extensionSlug == "" {
 		return nil, ErrMissingExtensionIDOrSlug
@@ -257,6 +315,10 @@ func (c *Client) UpdateSystemExtensionResource(
 		return nil, ErrMissingResourceID
 	}
 
+	if revision < 1 {
+		return nil, ErrMissingResourceRevision
+	}
+
 	u := fmt.Sprintf(
 		"%s/api/%s/extension-resources/%s/%s/%s/%s",
 		c.url,
@@ -272,6 +334,8 @@ func (c *Client) UpdateSystemExtensionResource(
 		return nil, err
 	}
 
+	req.Header.Set("If-Match", strconv.Quote(strconv.FormatInt(revision, 10)))
+
 	resourceJSON, err := json.Marshal(resource)
 	if err != nil {
 		return nil, err
@@ -295,6 +359,10 @@ func (c *Client) UpdateSystemExtensionResource(
 		return nil, handleResourceStatusNotFound(respBody)
 	}
 
+	if resp.StatusCode == http.StatusConflict {
+		return nil, revisionConflict(resp)
+	}
+
 	if resp.StatusCode != http.StatusOK &&
 		resp.StatusCode != http.StatusAccepted &&
 		resp.StatusCode != http.StatusNoContent {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
 	"github.com/stretchr/testify/assert"
@@ -347,6 +348,22 @@ func TestClient_SystemExtensionResource(t *testing.T) {
 			expectErr:   true,
 			expectedErr: v1alpha1.ErrERDNotFound,
 		},
+		{
+			name:        "resource not found error code",
+			extensionID: "test-extension-1",
+			erdID:       "erd-1",
+			erdVersion:  "v1alpha1",
+			resourceID:  "673ccd3a-1381-4e68-bc90-04e5f6745b9c",
+			fields: fields{
+				httpClient: &mockHTTPDoer{
+					t:          t,
+					statusCode: http.StatusNotFound,
+					resp:       []byte(`{"code":"extension_resource_not_found","message":"resource not found: sql: no rows in result set"}`),
+				},
+			},
+			expectErr:   true,
+			expectedErr: v1alpha1.ErrExtensionResourceNotFound,
+		},
 	}
 
 	for _, tt := range tests {
@@ -377,6 +394,37 @@ func TestClient_SystemExtensionResource(t *testing.T) {
 	}
 }
 
+func TestClient_SystemExtensionResourceAsOf(t *testing.T) {
+	asOf := time.Date(2024, 3, 1, 12, 30, 0, 0, time.FixedZone("", 2*60*60))
+
+	doer := &mockHTTPDoer{
+		t:          t,
+		resp:       []byte(testExtensionResourceResponse),
+		statusCode: http.StatusOK,
+	}
+
+	c := &Client{
+		url:                    "https://the.gov",
+		logger:                 zap.NewNop(),
+		httpClient:             doer,
+		clientCredentialConfig: &mockTokener{t: t},
+		token:                  &oauth2.Token{AccessToken: "topSekret"},
+	}
+
+	got, err := c.SystemExtensionResourceAsOf(
+		context.TODO(), "test-extension-1", "erd-1", "v1alpha1",
+		"673ccd3a-1381-4e68-bc90-04e5f6745b9c", asOf, true,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "673ccd3a-1381-4e68-bc90-04e5f6745b9c", got.ID)
+
+	assert.Equal(t, "2024-03-01T12:30:00+02:00", doer.Request().URL.Query().Get("as_of"))
+	assert.True(t, doer.Request().URL.Query().Has("deleted"))
+
+	_, err = c.SystemExtensionResourceAsOf(context.TODO(), "test-extension-1", "erd-1", "v1alpha1", "", asOf, false)
+	assert.ErrorIs(t, err, ErrMissingResourceID)
+}
+
 func TestClient_CreateSystemExtensionResource(t *testing.T) {
 	testResp := func(r []byte) *v1alpha1.SystemExtensionResource {
 		resp := &v1alpha1.SystemExtensionResource{}
@@ -712,7 +760,7 @@ func TestClient_UpdateSystemExtensionResource(t *testing.T) {
 			}
 			got, err := c.UpdateSystemExtensionResource(
 				context.TODO(), tt.extensionID, tt.erdID, tt.erdVersion,
-				tt.resourceID, tt.req,
+				tt.resourceID, 1, tt.req,
 			)
 
 			if tt.expectedErr != nil {
@@ -0,0 +1,240 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+// SystemResource is a system extension resource with its payload decoded
+type SystemResource[T any] struct {
+	*v1alpha1.SystemExtensionResource
+	Data T `json:"-"`
+}
+
+// UserResource is a user extension resource with its payload decoded
+type UserResource[T any] struct {
+	*v1alpha1.UserExtensionResource
+	Data T `json:"-"`
+}
+
+// SystemResources is a typed client for the system resources of an ERD, T is
+// the go type of the resources, matching the ERD schema
+type SystemResources[T any] struct {
+	client        *Client
+	extensionSlug string
+	erdSlugPlural string
+	erdVersion    string
+}
+
+// NewSystemResources returns a typed client for the system resources of an ERD
+func NewSystemResources[T any](c *Client, extensionSlug, erdSlugPlural, erdVersion string) *SystemResources[T] {
+	return &SystemResources[T]{
+		client:        c,
+		extensionSlug: extensionSlug,
+		erdSlugPlural: erdSlugPlural,
+		erdVersion:    erdVersion,
+	}
+}
+
+// Get fetches a system resource
+func (r *SystemResources[T]) Get(ctx context.Context, resourceID string, deleted bool) (*SystemResource[T], error) {
+	res, err := r.client.SystemExtensionResource(ctx, r.extensionSlug, r.erdSlugPlural, r.erdVersion, resourceID, deleted)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeSystemResource[T](res)
+}
+
+// List lists the system resources, queries filter them on their fields
+func (r *SystemResources[T]) List(ctx context.Context, queries map[string]string) ([]*SystemResource[T], error) {
+	if queries == nil {
+		queries = map[string]string{}
+	}
+
+	resources, err := r.client.SystemExtensionResources(ctx, r.extensionSlug, r.erdSlugPlural, r.erdVersion, false, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	typed := make([]*SystemResource[T], len(resources))
+
+	for i, res := range resources {
+		if typed[i], err = decodeSystemResource[T](res); err != nil {
+			return nil, err
+		}
+	}
+
+	return typed, nil
+}
+
+// Count counts the system resources matching the queries
+func (r *SystemResources[T]) Count(ctx context.Context, queries map[string]string) (int64, error) {
+	return r.client.SystemExtensionResourcesCount(ctx, r.extensionSlug, r.erdSlugPlural, r.erdVersion, queries)
+}
+
+// Exists tells whether a system resource matches the queries
+func (r *SystemResources[T]) Exists(ctx context.Context, queries map[string]string) (bool, error) {
+	return r.client.SystemExtensionResourcesExist(ctx, r.extensionSlug, r.erdSlugPlural, r.erdVersion, queries)
+}
+
+// Create creates a system resource
+func (r *SystemResources[T]) Create(ctx context.Context, data T) (*SystemResource[T], error) {
+	res, err := r.client.CreateSystemExtensionResource(ctx, r.extensionSlug, r.erdSlugPlural, r.erdVersion, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeSystemResource[T](res)
+}
+
+// Update updates a system resource, revision is the revision of the resource
+// the update is based on
+func (r *SystemResources[T]) Update(ctx context.Context, resourceID string, revision int64, data T) (*SystemResource[T], error) {
+	res, err := r.client.UpdateSystemExtensionResource(ctx, r.extensionSlug, r.erdSlugPlural, r.erdVersion, resourceID, revision, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeSystemResource[T](res)
+}
+
+// Patch applies a JSON Patch or JSON Merge Patch of contentType to a system resource
+func (r *SystemResources[T]) Patch(ctx context.Context, resourceID, contentType string, patch []byte) (*SystemResource[T], error) {
+	res, err := r.client.PatchSystemExtensionResource(ctx, r.extensionSlug, r.erdSlugPlural, r.erdVersion, resourceID, contentType, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeSystemResource[T](res)
+}
+
+// Delete deletes a system resource
+func (r *SystemResources[T]) Delete(ctx context.Context, resourceID string) error {
+	return r.client.DeleteSystemExtensionResource(ctx, r.extensionSlug, r.erdSlugPlural, r.erdVersion, resourceID)
+}
+
+// UserResources is a typed client for the resources of a user for an ERD, T
+// is the go type of the resources, matching the ERD schema
+type UserResources[T any] struct {
+	client        *Client
+	userID        string
+	extensionSlug string
+	erdSlugPlural string
+	erdVersion    string
+}
+
+// NewUserResources returns a typed client for the resources of a user for an ERD
+func NewUserResources[T any](c *Client, userID, extensionSlug, erdSlugPlural, erdVersion string) *UserResources[T] {
+	return &UserResources[T]{
+		client:        c,
+		userID:        userID,
+		extensionSlug: extensionSlug,
+		erdSlugPlural: erdSlugPlural,
+		erdVersion:    erdVersion,
+	}
+}
+
+// Get fetches a user resource
+func (r *UserResources[T]) Get(ctx context.Context, resourceID string, deleted bool) (*UserResource[T], error) {
+	res, err := r.client.UserExtensionResource(ctx, r.userID, r.extensionSlug, r.erdSlugPlural, r.erdVersion, resourceID, deleted)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeUserResource[T](res)
+}
+
+// List lists the user resources, queries filter them on their fields
+func (r *UserResources[T]) List(ctx context.Context, queries map[string]string) ([]*UserResource[T], error) {
+	if queries == nil {
+		queries = map[string]string{}
+	}
+
+	resources, err := r.client.UserExtensionResources(ctx, r.userID, r.extensionSlug, r.erdSlugPlural, r.erdVersion, false, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	typed := make([]*UserResource[T], len(resources))
+
+	for i, res := range resources {
+		if typed[i], err = decodeUserResource[T](res); err != nil {
+			return nil, err
+		}
+	}
+
+	return typed, nil
+}
+
+// Count counts the user resources matching the queries
+func (r *UserResources[T]) Count(ctx context.Context, queries map[string]string) (int64, error) {
+	return r.client.UserExtensionResourcesCount(ctx, r.userID, r.extensionSlug, r.erdSlugPlural, r.erdVersion, queries)
+}
+
+// Exists tells whether a user resource matches the queries
+func (r *UserResources[T]) Exists(ctx context.Context, queries map[string]string) (bool, error) {
+	return r.client.UserExtensionResourcesExist(ctx, r.userID, r.extensionSlug, r.erdSlugPlural, r.erdVersion, queries)
+}
+
+// Create creates a user resource
+func (r *UserResources[T]) Create(ctx context.Context, data T) (*UserResource[T], error) {
+	res, err := r.client.CreateUserExtensionResource(ctx, r.userID, r.extensionSlug, r.erdSlugPlural, r.erdVersion, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeUserResource[T](res)
+}
+
+// Update updates a user resource, revision is the revision of the resource the
+// update is based on
+func (r *UserResources[T]) Update(ctx context.Context, resourceID string, revision int64, data T) (*UserResource[T], error) {
+	res, err := r.client.UpdateUserExtensionResource(ctx, r.userID, r.extensionSlug, r.erdSlugPlural, r.erdVersion, resourceID, revision, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeUserResource[T](res)
+}
+
+// Patch applies a JSON Patch or JSON Merge Patch of contentType to a user resource
+func (r *UserResources[T]) Patch(ctx context.Context, resourceID, contentType string, patch []byte) (*UserResource[T], error) {
+	res, err := r.client.PatchUserExtensionResource(ctx, r.userID, r.extensionSlug, r.erdSlugPlural, r.erdVersion, resourceID, contentType, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeUserResource[T](res)
+}
+
+// Delete deletes a user resource
+func (r *UserResources[T]) Delete(ctx context.Context, resourceID string) error {
+	return r.client.DeleteUserExtensionResource(ctx, r.userID, r.extensionSlug, r.erdSlugPlural, r.erdVersion, resourceID)
+}
+
+func decodeSystemResource[T any](res *v1alpha1.SystemExtensionResource) (*SystemResource[T], error) {
+	typed := &SystemResource[T]{SystemExtensionResource: res}
+
+	if res.SystemExtensionResource != nil && len(res.Resource) > 0 {
+		if err := json.Unmarshal(res.Resource, &typed.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	return typed, nil
+}
+
+func decodeUserResource[T any](res *v1alpha1.UserExtensionResource) (*UserResource[T], error) {
+	typed := &UserResource[T]{UserExtensionResource: res}
+
+	if res.UserExtensionResource != nil && len(res.Resource) > 0 {
+		if err := json.Unmarshal(res.Resource, &typed.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	return typed, nil
+}
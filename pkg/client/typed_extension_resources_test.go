@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type testPerson struct {
+	Age       int    `json:"age"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+}
+
+func testTypedClient(t *testing.T, doer HTTPDoer) *Client {
+	return &Client{
+		url:                    "https://the.gov/",
+		logger:                 zap.NewNop(),
+		clientCredentialConfig: &mockTokener{t: t},
+		httpClient:             doer,
+	}
+}
+
+func TestSystemResources(t *testing.T) {
+	ctx := context.TODO()
+
+	doer := &mockHTTPDoer{t: t, statusCode: http.StatusOK, resp: []byte(testExtensionResourceResponse)}
+	people := NewSystemResources[testPerson](testTypedClient(t, doer), "test-extension", "people", "v1")
+
+	got, err := people.Get(ctx, "673ccd3a-1381-4e68-bc90-04e5f6745b9c", false)
+	assert.NoError(t, err)
+	assert.Equal(t, testPerson{Age: 10, FirstName: "test", LastName: "2"}, got.Data)
+	assert.Equal(t, "673ccd3a-1381-4e68-bc90-04e5f6745b9c", got.ID)
+
+	doer.statusCode = http.StatusAccepted
+	created, err := people.Create(ctx, testPerson{Age: 10, FirstName: "test", LastName: "2"})
+	assert.NoError(t, err)
+	assert.Equal(t, "2", created.Data.LastName)
+
+	body := testPerson{}
+	assert.NoError(t, json.NewDecoder(doer.request.Body).Decode(&body))
+	assert.Equal(t, testPerson{Age: 10, FirstName: "test", LastName: "2"}, body)
+
+	list := NewSystemResources[testPerson](
+		testTypedClient(t, &mockHTTPDoer{t: t, statusCode: http.StatusOK, resp: []byte(testExtensionResourcesResponse)}),
+		"test-extension", "people", "v1",
+	)
+
+	all, err := list.List(ctx, nil)
+	assert.NoError(t, err)
+	assert.Len(t, all, 3)
+	assert.Equal(t, "3", all[1].Data.LastName)
+
+	bad := NewSystemResources[[]string](testTypedClient(t, doer), "test-extension", "people", "v1")
+	_, err = bad.Get(ctx, "673ccd3a-1381-4e68-bc90-04e5f6745b9c", false)
+	assert.Error(t, err)
+}
+
+func TestUserResources(t *testing.T) {
+	ctx := context.TODO()
+
+	doer := &mockHTTPDoer{t: t, statusCode: http.StatusOK, resp: []byte(testExtensionResourceResponse)}
+	people := NewUserResources[testPerson](testTypedClient(t, doer), "user-id", "test-extension", "people", "v1")
+
+	got, err := people.Get(ctx, "673ccd3a-1381-4e68-bc90-04e5f6745b9c", false)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, got.Data.Age)
+	assert.Contains(t, doer.request.URL.Path, "/users/user-id/extension-resources/test-extension/people/v1/")
+
+	doer.statusCode = http.StatusAccepted
+	updated, err := people.Update(ctx, "673ccd3a-1381-4e68-bc90-04e5f6745b9c", 1, testPerson{Age: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, "test", updated.Data.FirstName)
+	assert.Equal(t, http.MethodPatch, doer.request.Method)
+
+	patched, err := people.Patch(ctx, "673ccd3a-1381-4e68-bc90-04e5f6745b9c", ContentTypeMergePatch, []byte(`{"age": 10}`))
+	assert.NoError(t, err)
+	assert.Equal(t, 10, patched.Data.Age)
+	assert.Equal(t, ContentTypeMergePatch, doer.request.Header.Get("Content-Type"))
+
+	_, err = NewUserResources[testPerson](testTypedClient(t, doer), "", "test-extension", "people", "v1").Get(ctx, "id", false)
+	assert.ErrorIs(t, err, ErrMissingUserID)
+}
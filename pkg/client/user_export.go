@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// UserExport gets the zip archive of everything governor stores about a user
+func (c *Client) UserExport(ctx context.Context, id string) ([]byte, error) {
+	if id == "" {
+		return nil, ErrMissingUserID
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodGet, fmt.Sprintf("%s/api/%s/users/%s/export", c.url, governorAPIVersionAlpha, id))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	return io.ReadAll(resp.Body)
+}
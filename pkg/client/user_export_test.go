@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+func TestClient_UserExport(t *testing.T) {
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		id         string
+		want       []byte
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       []byte("PK\x05\x06"),
+				statusCode: http.StatusOK,
+			},
+			id:   "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			want: []byte("PK\x05\x06"),
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusForbidden,
+			},
+			id:      "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			wantErr: true,
+		},
+		{
+			name: "missing id in request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.UserExport(context.TODO(), tt.id)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
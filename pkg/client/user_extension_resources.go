@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/goccy/go-json"
 	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
@@ -47,6 +50,51 @@ func (c *Client) UserExtensionResource(
 		u += "?deleted"
 	}
 
+	return c.getUserExtensionResource(ctx, u)
+}
+
+// UserExtensionResourceAsOf fetches a user extension resource as it was at a
+// time, from its history
+func (c *Client) UserExtensionResourceAsOf(
+	ctx context.Context, userID, extensionSlug, erdSlugPlural, erdVersion, resourceID string,
+	asOf time.Time, deleted bool,
+) (*v1alpha1.UserExtensionResource, error) {
+	if userID == "" {
+		return nil, ErrMissingUserID
+	}
+
+	if extensionSlug == "" {
+		return nil, ErrMissingExtensionIDOrSlug
+	}
+
+	if erdSlugPlural == "" {
+		return nil, ErrMissingERDIDOrSlug
+	}
+
+	if resourceID == "" {
+		return nil, ErrMissingResourceID
+	}
+
+	u := fmt.Sprintf(
+		"%s/api/%s/users/%s/extension-resources/%s/%s/%s/%s?as_of=%s",
+		c.url,
+		governorAPIVersionAlpha,
+		userID,
+		extensionSlug,
+		erdSlugPlural,
+		erdVersion,
+		resourceID,
+		url.QueryEscape(asOf.Format(time.RFC3339Nano)),
+	)
+
+	if deleted {
+		u += "&deleted"
+	}
+
+	return c.getUserExtensionResource(ctx, u)
+}
+
+func (c *Client) getUserExtensionResource(ctx context.Context, u string) (*v1alpha1.UserExtensionResource, error) {
 	req, err := c.newGovernorRequest(ctx, http.MethodGet, u)
 	if err != nil {
 		return nil, err
@@ -227,10 +275,12 @@ func (c *Client) CreateUserExtensionResource(
 	return uer, nil
 }
 
-// UpdateUserExtensionResource updates a user extension resource
+// UpdateUserExtensionResource updates a user extension resource, revision is
+// the revision of the resource the update is based on. It returns a
+// *RevisionConflictError when the resource was changed since.
 func (c *Client) UpdateUserExtensionResource(
 	ctx context.Context, userID, extensionSlug, erdSlugPlural, erdVersion, resourceID string,
-	resource interface{},
+	revision int64, resource interface{},
 ) (*v1alpha1.UserExtensionResource, error) {
 	if userID == "" {
 		return nil, ErrMissingUserID
@@ -248,6 +298,10 @@ func (c *Client) UpdateUserExtensionResource(
 		return nil, ErrMissingResourceID
 	}
 
+	if revision < 1 {
+		return nil, ErrMissingResourceRevision
+	}
+
 	u := fmt.Sprintf(
 		"%s/api/%s/users/%s/extension-resources/%s/%s/%s/%s",
 		c.url,
@@ -264,6 +318,8 @@ func (c *Client) UpdateUserExtensionResource(
 		return nil, err
 	}
 
+	req.Header.Set("If-Match", strconv.Quote(strconv.FormatInt(revision, 10)))
+
 	reqBody, err := json.Marshal(resource)
 	if err != nil {
 		return nil, err
@@ -287,6 +343,10 @@ func (c *Client) UpdateUserExtensionResource(
 		return nil, handleResourceStatusNotFound(respBody)
 	}
 
+	if resp.StatusCode == http.StatusConflict {
+		return nil, revisionConflict(resp)
+	}
+
 	if resp.StatusCode != http.StatusOK &&
 		resp.StatusCode != http.StatusAccepted &&
 		resp.StatusCode != http.StatusNoContent {
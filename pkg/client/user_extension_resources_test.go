@@ -824,7 +824,7 @@ func TestClient_UpdateUserExtensionResource(t *testing.T) {
 			}
 			got, err := c.UpdateUserExtensionResource(
 				context.TODO(), tt.userID, tt.extensionID, tt.erdID, tt.erdVersion,
-				tt.resourceID, tt.req,
+				tt.resourceID, 1, tt.req,
 			)
 
 			if tt.expectedErr != nil {
@@ -0,0 +1,227 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/metal-toolbox/governor-api/pkg/api/v1alpha1"
+)
+
+var testDuplicateUsersResponse = []byte(`
+[
+	{
+		"reason": "email",
+		"match": "hjass@example.com",
+		"users": [
+			{
+				"id": "186c5a52-4421-4573-8bbf-78d85d3c277e",
+				"name": "Hugh Jass",
+				"email": "hjass@example.com",
+				"login_count": 12,
+				"created_at": "2023-07-12T12:00:00Z",
+				"updated_at": "2023-07-12T12:00:00Z",
+				"status": "active"
+			},
+			{
+				"id": "6e2b0e5c-0f1a-4c32-9a4b-6a0f7b6f3e21",
+				"name": "Hugh Jass",
+				"email": "HJass+work@example.com",
+				"login_count": 1,
+				"created_at": "2023-08-01T12:00:00Z",
+				"updated_at": "2023-08-01T12:00:00Z",
+				"github_id": 1234,
+				"status": "active"
+			}
+		]
+	}
+]
+`)
+
+var testUserMergeResponse = []byte(`
+{
+	"user": {
+		"id": "186c5a52-4421-4573-8bbf-78d85d3c277e",
+		"name": "Hugh Jass",
+		"email": "hjass@example.com",
+		"login_count": 13,
+		"created_at": "2023-07-12T12:00:00Z",
+		"updated_at": "2023-09-01T12:00:00Z",
+		"github_id": 1234,
+		"status": "active"
+	},
+	"merged_user": {
+		"id": "6e2b0e5c-0f1a-4c32-9a4b-6a0f7b6f3e21",
+		"name": "Hugh Jass",
+		"email": "HJass+work@example.com",
+		"login_count": 1,
+		"created_at": "2023-08-01T12:00:00Z",
+		"updated_at": "2023-09-01T12:00:00Z",
+		"github_id": 1234,
+		"deleted_at": "2023-09-01T12:00:00Z",
+		"status": "active"
+	},
+	"moved_memberships": ["2e2f9e1c-5a6f-4b1e-8c3d-1f0a9b8c7d61"],
+	"combined_memberships": [],
+	"records": {
+		"membership_requests": 1,
+		"application_requests": 0,
+		"extension_resources": 2,
+		"notification_preferences": 0,
+		"request_comments": 3,
+		"audit_events": 27
+	}
+}
+`)
+
+func TestClient_DuplicateUsers(t *testing.T) {
+	testResp := func(r []byte) []*v1alpha1.DuplicateUsers {
+		resp := []*v1alpha1.DuplicateUsers{}
+		if err := json.Unmarshal(r, &resp); err != nil {
+			t.Error(err)
+		}
+
+		return resp
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		want       []*v1alpha1.DuplicateUsers
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testDuplicateUsersResponse,
+				statusCode: http.StatusOK,
+			},
+			want: testResp(testDuplicateUsersResponse),
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusInternalServerError,
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusOK,
+				resp:       []byte(`{`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.DuplicateUsers(context.TODO())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_MergeUsers(t *testing.T) {
+	testResp := func(r []byte) *v1alpha1.UserMerge {
+		resp := v1alpha1.UserMerge{}
+		if err := json.Unmarshal(r, &resp); err != nil {
+			t.Error(err)
+		}
+
+		return &resp
+	}
+
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		id         string
+		otherID    string
+		want       *v1alpha1.UserMerge
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				resp:       testUserMergeResponse,
+				statusCode: http.StatusAccepted,
+			},
+			id:      "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			otherID: "6e2b0e5c-0f1a-4c32-9a4b-6a0f7b6f3e21",
+			want:    testResp(testUserMergeResponse),
+		},
+		{
+			name: "non-success",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusBadRequest,
+			},
+			id:      "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			otherID: "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusAccepted,
+				resp:       []byte(`{`),
+			},
+			id:      "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			otherID: "6e2b0e5c-0f1a-4c32-9a4b-6a0f7b6f3e21",
+			wantErr: true,
+		},
+		{
+			name: "missing other id in request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusAccepted,
+			},
+			id:      "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.MergeUsers(context.TODO(), tt.id, tt.otherID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -118,6 +118,37 @@ func (c *Client) User(ctx context.Context, id string, deleted bool) (*v1alpha1.U
 	return &out, nil
 }
 
+// UserAccess gets the effective access of a user from governor, the groups it
+// is a member of, how each membership comes about and the applications granted
+func (c *Client) UserAccess(ctx context.Context, id string) (*v1alpha1.UserAccess, error) {
+	if id == "" {
+		return nil, ErrMissingUserID
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodGet, fmt.Sprintf("%s/api/%s/users/%s/access", c.url, governorAPIVersionAlpha, id))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := v1alpha1.UserAccess{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
 // CreateUser creates a user in governor and returns the user
 func (c *Client) CreateUser(ctx context.Context, user *v1alpha1.UserReq) (*v1alpha1.User, error) {
 	if user == nil {
@@ -287,3 +318,46 @@ func (c *Client) UsersQueryV2(ctx context.Context, query map[string][]string) (*
 
 	return &out, nil
 }
+
+// RemoveUserGroups removes a user from the given groups at once, or from every
+// group when all is true, and returns the ids of the groups the user was
+// removed from
+func (c *Client) RemoveUserGroups(ctx context.Context, id string, groupIDs []string, all bool) ([]string, error) {
+	if id == "" {
+		return nil, ErrMissingUserID
+	}
+
+	req, err := c.newGovernorRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/api/%s/users/%s/groups", c.url, governorAPIVersionAlpha, id))
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(v1alpha1.RemoveUserGroupsReq{GroupIDs: groupIDs, All: all})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewBuffer(b))
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrUserNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return nil, ErrRequestNonSuccess
+	}
+
+	out := v1alpha1.RemovedUserGroups{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return out.GroupIDs, nil
+}
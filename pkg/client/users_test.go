@@ -14,6 +14,46 @@ import (
 	"github.com/metal-toolbox/governor-api/pkg/api/v1beta1"
 )
 
+var testUserAccessResponse = []byte(`
+{
+	"user_id": "186c5a52-4421-4573-8bbf-78d85d3c277e",
+	"groups": [
+		{
+			"id": "31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4",
+			"name": "Gopher Admins",
+			"slug": "gopher-admins",
+			"direct": false,
+			"paths": [
+				{
+					"groups": ["d1e0a1a8-6a4e-4b57-9c43-2f0d1cd0c0a2", "31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4"],
+					"hierarchies": ["0c0cbd37-5b8e-4a4e-9a9a-7f3d0a3c2b11"]
+				}
+			]
+		},
+		{
+			"id": "d1e0a1a8-6a4e-4b57-9c43-2f0d1cd0c0a2",
+			"name": "Gopher SRE",
+			"slug": "gopher-sre",
+			"direct": true,
+			"paths": [
+				{
+					"groups": ["d1e0a1a8-6a4e-4b57-9c43-2f0d1cd0c0a2"],
+					"hierarchies": []
+				}
+			]
+		}
+	],
+	"applications": [
+		{
+			"id": "9262f9ea-bdd5-4ecf-b1e8-6cb7bfbf3d61",
+			"name": "Gophers",
+			"slug": "gophers",
+			"granted_by": ["31cba2a4-1a9f-4d4d-b8d4-6de4d4a2c1f4"]
+		}
+	]
+}
+`)
+
 func TestClient_Users(t *testing.T) {
 	testResp := func(r []byte) []*v1alpha1.User {
 		resp := []*v1alpha1.User{}
@@ -253,6 +293,95 @@ func TestClient_User(t *testing.T) {
 	}
 }
 
+func TestClient_UserAccess(t *testing.T) {
+	testResp := func(r []byte) *v1alpha1.UserAccess {
+		resp := v1alpha1.UserAccess{}
+		if err := json.Unmarshal(r, &resp); err != nil {
+			t.Error(err)
+		}
+
+		return &resp
+	}
+
+	type fields struct {
+		httpClient HTTPDoer
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		id      string
+		want    *v1alpha1.UserAccess
+		wantErr bool
+	}{
+		{
+			name: "example request",
+			fields: fields{
+				httpClient: &mockHTTPDoer{
+					t:          t,
+					resp:       testUserAccessResponse,
+					statusCode: http.StatusOK,
+				},
+			},
+			id:   "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			want: testResp(testUserAccessResponse),
+		},
+		{
+			name: "non-success",
+			fields: fields{
+				httpClient: &mockHTTPDoer{
+					t:          t,
+					statusCode: http.StatusInternalServerError,
+				},
+			},
+			id:      "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			fields: fields{
+				httpClient: &mockHTTPDoer{
+					t:          t,
+					statusCode: http.StatusOK,
+					resp:       []byte(`{`),
+				},
+			},
+			id:      "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			wantErr: true,
+		},
+		{
+			name: "missing id in request",
+			fields: fields{
+				httpClient: &mockHTTPDoer{
+					t:          t,
+					statusCode: http.StatusOK,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.fields.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.UserAccess(context.TODO(), tt.id)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestClient_CreateUser(t *testing.T) {
 	testResp := func(r []byte) *v1alpha1.User {
 		resp := v1alpha1.User{}
@@ -563,3 +692,88 @@ func TestClient_UpdateUser(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_RemoveUserGroups(t *testing.T) {
+	tests := []struct {
+		name       string
+		httpClient HTTPDoer
+		id         string
+		groupIDs   []string
+		all        bool
+		want       []string
+		wantErr    bool
+	}{
+		{
+			name: "example request",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusAccepted,
+				resp:       []byte(`{"user_id":"186c5a52-4421-4573-8bbf-78d85d3c277e","group_ids":["2e2f9e1c-5a6f-4b1e-8c3d-1f0a9b8c7d61"]}`),
+			},
+			id:       "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			groupIDs: []string{"2e2f9e1c-5a6f-4b1e-8c3d-1f0a9b8c7d61"},
+			want:     []string{"2e2f9e1c-5a6f-4b1e-8c3d-1f0a9b8c7d61"},
+		},
+		{
+			name: "all groups",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusAccepted,
+				resp:       []byte(`{"user_id":"186c5a52-4421-4573-8bbf-78d85d3c277e","group_ids":[]}`),
+			},
+			id:   "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			all:  true,
+			want: []string{},
+		},
+		{
+			name: "not found",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusNotFound,
+			},
+			id:      "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			all:     true,
+			wantErr: true,
+		},
+		{
+			name: "bad json response",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusAccepted,
+				resp:       []byte(`{`),
+			},
+			id:      "186c5a52-4421-4573-8bbf-78d85d3c277e",
+			all:     true,
+			wantErr: true,
+		},
+		{
+			name: "missing user id",
+			httpClient: &mockHTTPDoer{
+				t:          t,
+				statusCode: http.StatusAccepted,
+			},
+			all:     true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				url:                    "https://the.gov/",
+				logger:                 zap.NewNop(),
+				httpClient:             tt.httpClient,
+				clientCredentialConfig: &mockTokener{t: t},
+				token:                  &oauth2.Token{AccessToken: "topSekret"},
+			}
+			got, err := c.RemoveUserGroups(context.TODO(), tt.id, tt.groupIDs, tt.all)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// Package eventbus defines the event bus governor publishes its events on,
+// and an in-memory implementation of it for tests.
+//
+// The api routers depend on the EventBus interface, so an extension's
+// integration tests can run governor's handlers with a Memory event bus
+// instead of NATS, and check the events they publish:
+//
+//	bus := eventbus.NewMemory()
+//
+//	router := &v1alpha1.Router{EventBus: bus, ...}
+//
+//	// ... call the api
+//
+//	for _, e := range bus.Events() {
+//		fmt.Println(e.Subject, e.Event.Action)
+//	}
+package eventbus
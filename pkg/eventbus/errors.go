@@ -0,0 +1,10 @@
+package eventbus
+
+import "errors"
+
+// ErrEmptyEvent is returned when an empty event is passed
+var ErrEmptyEvent = errors.New("event is empty")
+
+// ErrQueued is returned when an event couldn't be published but was stored in
+// an outbox, it is published later so the change it describes isn't lost
+var ErrQueued = errors.New("event couldn't be published, it was queued in the outbox")
@@ -0,0 +1,29 @@
+package eventbus
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// EventBus is where governor publishes its events, and where the event
+// streams of the api subscribe
+type EventBus interface {
+	// Publish publishes an event on a subject, the subject doesn't have the
+	// event bus prefix
+	Publish(ctx context.Context, sub string, event *events.Event) error
+
+	// Subscribe calls handler with the messages published on a subject, which
+	// may have nats wildcards. The returned function unsubscribes.
+	Subscribe(sub string, handler func(*nats.Msg)) (func() error, error)
+
+	// ExtensionSubject returns the subject the resource events of an ERD of
+	// an extension are published on
+	ExtensionSubject(ctx context.Context, extensionID, slugPlural string) string
+
+	// TrimPrefix returns the subject of a received message without the event
+	// bus prefix
+	TrimPrefix(subject string) string
+}
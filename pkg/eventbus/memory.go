@@ -0,0 +1,180 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// defaultPrefix is the subject prefix of the events, the same as governor's
+// default nats subject prefix
+const defaultPrefix = "events"
+
+// Published is an event published on a Memory event bus
+type Published struct {
+	// Subject is the subject the event was published on, without the prefix
+	Subject string
+	Event   *events.Event
+}
+
+// Memory is an in-memory EventBus. It records the published events and
+// delivers them to its subscribers synchronously, as JSON nats messages like
+// the NATS event bus. Unlike the NATS event bus, it only publishes the
+// v1alpha1 events and ignores the subject rules of the extensions.
+type Memory struct {
+	mu        sync.Mutex
+	prefix    string
+	published []Published
+	subs      map[int]*memorySubscription
+	nextSub   int
+}
+
+type memorySubscription struct {
+	subject string
+	handler func(*nats.Msg)
+}
+
+// MemoryOption is a functional configuration option for a Memory event bus
+type MemoryOption func(m *Memory)
+
+// WithPrefix sets the subject prefix, events by default
+func WithPrefix(p string) MemoryOption {
+	return func(m *Memory) {
+		m.prefix = p
+	}
+}
+
+// NewMemory returns an empty in-memory event bus
+func NewMemory(opts ...MemoryOption) *Memory {
+	m := &Memory{
+		prefix: defaultPrefix,
+		subs:   map[int]*memorySubscription{},
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// Publish records an event and delivers it to the matching subscribers
+func (m *Memory) Publish(_ context.Context, sub string, event *events.Event) error {
+	if event == nil {
+		return ErrEmptyEvent
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	subject := m.prefix + "." + sub
+
+	m.mu.Lock()
+
+	m.published = append(m.published, Published{Subject: sub, Event: event})
+
+	handlers := []func(*nats.Msg){}
+
+	for _, s := range m.subs {
+		if subjectMatches(s.subject, subject) {
+			handlers = append(handlers, s.handler)
+		}
+	}
+
+	m.mu.Unlock()
+
+	for _, h := range handlers {
+		h(&nats.Msg{Subject: subject, Data: payload})
+	}
+
+	return nil
+}
+
+// Subscribe calls handler with the events published on a subject
+func (m *Memory) Subscribe(sub string, handler func(*nats.Msg)) (func() error, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := m.nextSub
+	m.nextSub++
+
+	m.subs[id] = &memorySubscription{subject: m.prefix + "." + sub, handler: handler}
+
+	return func() error {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		delete(m.subs, id)
+
+		return nil
+	}, nil
+}
+
+// ExtensionSubject returns the plural slug of the ERD, the Memory event bus
+// has no subject rules
+func (m *Memory) ExtensionSubject(_ context.Context, _, slugPlural string) string {
+	return slugPlural
+}
+
+// TrimPrefix returns the subject of a received message without the prefix
+func (m *Memory) TrimPrefix(subject string) string {
+	return strings.TrimPrefix(subject, m.prefix+".")
+}
+
+// Events returns the published events, in order
+func (m *Memory) Events() []Published {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Published{}, m.published...)
+}
+
+// EventsOn returns the events published on a subject, in order
+func (m *Memory) EventsOn(sub string) []*events.Event {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	evts := []*events.Event{}
+
+	for _, p := range m.published {
+		if p.Subject == sub {
+			evts = append(evts, p.Event)
+		}
+	}
+
+	return evts
+}
+
+// Reset forgets the published events, the subscriptions are kept
+func (m *Memory) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.published = nil
+}
+
+// subjectMatches returns true when a subject matches a nats subscription
+// subject, which may have the * and > wildcards
+func subjectMatches(pattern, subject string) bool {
+	pt := strings.Split(pattern, ".")
+	st := strings.Split(subject, ".")
+
+	for i, p := range pt {
+		if p == ">" {
+			return len(st) > i
+		}
+
+		if i >= len(st) || (p != "*" && p != st[i]) {
+			return false
+		}
+	}
+
+	return len(pt) == len(st)
+}
@@ -0,0 +1,81 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+func TestMemory(t *testing.T) {
+	ctx := context.TODO()
+	bus := NewMemory()
+
+	var all, members []*nats.Msg
+
+	unsubscribeAll, err := bus.Subscribe(">", func(m *nats.Msg) { all = append(all, m) })
+	require.NoError(t, err)
+
+	_, err = bus.Subscribe(events.GovernorMembersEventSubject, func(m *nats.Msg) { members = append(members, m) })
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, bus.Publish(ctx, events.GovernorGroupsEventSubject, nil), ErrEmptyEvent)
+
+	require.NoError(t, bus.Publish(ctx, events.GovernorGroupsEventSubject, &events.Event{Action: events.GovernorEventCreate, GroupID: "group-id"}))
+	require.NoError(t, bus.Publish(ctx, events.GovernorMembersEventSubject, &events.Event{Action: events.GovernorEventCreate, UserID: "user-id"}))
+
+	require.Len(t, all, 2)
+	require.Len(t, members, 1)
+	assert.Equal(t, "events.members", members[0].Subject)
+	assert.Equal(t, events.GovernorMembersEventSubject, bus.TrimPrefix(members[0].Subject))
+
+	event := &events.Event{}
+	require.NoError(t, json.Unmarshal(members[0].Data, event))
+	assert.Equal(t, "user-id", event.UserID)
+
+	published := bus.Events()
+	require.Len(t, published, 2)
+	assert.Equal(t, events.GovernorGroupsEventSubject, published[0].Subject)
+	assert.Equal(t, "group-id", published[0].Event.GroupID)
+
+	groups := bus.EventsOn(events.GovernorGroupsEventSubject)
+	require.Len(t, groups, 1)
+	assert.Equal(t, events.GovernorEventCreate, groups[0].Action)
+
+	require.NoError(t, unsubscribeAll())
+	bus.Reset()
+
+	require.NoError(t, bus.Publish(ctx, events.GovernorMembersEventSubject, &events.Event{Action: events.GovernorEventDelete}))
+	assert.Len(t, all, 2)
+	assert.Len(t, members, 2)
+	assert.Len(t, bus.Events(), 1)
+
+	assert.Equal(t, "widgets", bus.ExtensionSubject(ctx, "extension-id", "widgets"))
+	assert.Equal(t, "groups", NewMemory(WithPrefix("test")).TrimPrefix("test.groups"))
+}
+
+func TestSubjectMatches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		subject string
+		want    bool
+	}{
+		{"events.groups", "events.groups", true},
+		{"events.groups", "events.groups.extra", false},
+		{"events.*", "events.groups", true},
+		{"events.*", "events.groups.extra", false},
+		{"events.>", "events.groups.extra", true},
+		{"events.>", "events", false},
+		{"events.*.erds", "events.extension.erds", true},
+		{"events.groups.extra", "events.groups", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, subjectMatches(tt.pattern, tt.subject), "%s %s", tt.pattern, tt.subject)
+	}
+}
@@ -1,8 +1,16 @@
 package v1alpha1
 
+import "time"
+
 const (
 	// Version is the API version constant
 	Version = "v1alpha1"
+	// MemberVersion is the version of members events, they carry the Member
+	// details on top of the v1alpha1 fields
+	MemberVersion = "v1alpha2"
+	// HierarchyVersion is the version of hierarchies events, they carry the
+	// Hierarchy details on top of the v1alpha1 fields
+	HierarchyVersion = "v1alpha2"
 
 	// GovernorEventCreate is the action passed on create events
 	GovernorEventCreate = "CREATE"
@@ -16,11 +24,30 @@ const (
 	GovernorEventDeny = "DENY"
 	// GovernorEventRevoke is the action passed on revoke events
 	GovernorEventRevoke = "REVOKE"
+	// GovernorEventComment is the action passed on request comment events
+	GovernorEventComment = "COMMENT"
+	// GovernorEventTransition is the action passed on lifecycle state transition events
+	GovernorEventTransition = "TRANSITION"
+	// GovernorEventWarn is the action passed on warning events
+	GovernorEventWarn = "WARN"
+	// GovernorEventSync is the action passed when the desired state of an integration is requested to be applied
+	GovernorEventSync = "SYNC"
+	// GovernorEventErase is the action passed when the personal data of a user is erased
+	GovernorEventErase = "ERASE"
 
 	// GovernorUsersEventSubject is the subject name for user events (minus the subject prefix)
 	GovernorUsersEventSubject = "users"
+	// GovernorUserFieldsEventSubject is the subject name for the user field change events (minus the subject
+	// prefix), each field subscription gets its events on its own subject under it, e.g. users.fields.<name>
+	GovernorUserFieldsEventSubject = "users.fields"
+	// GovernorUserEmailVerificationsEventSubject is the subject name for the events asking a user to confirm a new
+	// email address (minus the subject prefix)
+	GovernorUserEmailVerificationsEventSubject = "users.email-verifications"
 	// GovernorGroupsEventSubject is the subject name for groups events (minus the subject prefix)
 	GovernorGroupsEventSubject = "groups"
+	// GovernorGroupMemberLimitsEventSubject is the subject name for the warnings sent to the group admins when a
+	// group exceeds its member soft limit (minus the subject prefix)
+	GovernorGroupMemberLimitsEventSubject = "groups.member-limits"
 	// GovernorMembersEventSubject is the subject name for members events (minus the subject prefix)
 	GovernorMembersEventSubject = "members"
 	// GovernorMemberRequestsEventSubject is the subject name for member request events (minus the subject prefix)
@@ -39,10 +66,16 @@ const (
 	GovernorNotificationTypesEventSubject = "notification.types"
 	// GovernorNotificationTargetsEventSubject is the subject name for notification target events (minus the subject prefix)
 	GovernorNotificationTargetsEventSubject = "notification.targets"
+	// GovernorNotificationDispatchesEventSubject is the subject name for the events asking the dispatchers to
+	// deliver a notification through a target (minus the subject prefix)
+	GovernorNotificationDispatchesEventSubject = "notification.dispatches"
 	// GovernorExtensionsEventSubject is the subject name for extensions events (minus the subject prefix)
 	GovernorExtensionsEventSubject = "extensions"
 	// GovernorExtensionResourceDefinitionsEventSubject is the subject name for extensions resource definition events (minus the subject prefix)
 	GovernorExtensionResourceDefinitionsEventSubject = "extension.erds"
+	// GovernorGithubTeamsEventSubject is the subject name for the events of the groups exported as github teams,
+	// meant for the github sync extension (minus the subject prefix)
+	GovernorGithubTeamsEventSubject = "integrations.github.teams"
 
 	// GovernorEventCorrelationIDHeader is the header name for the correlation ID
 	GovernorEventCorrelationIDHeader = "Correlation-ID"
@@ -61,13 +94,103 @@ type Event struct {
 	NotificationTypeID   string `json:"notification_type_id,omitempty"`
 	NotificationTargetID string `json:"notification_target_id,omitempty"`
 
+	NotificationDispatchID string `json:"notification_dispatch_id,omitempty"`
+
 	ExtensionID                   string `json:"extension_id,omitempty"`
 	ExtensionResourceDefinitionID string `json:"extension_resource_definition_id,omitempty"`
 	ExtensionResourceID           string `json:"extension_resource_id,omitempty"`
 
+	RequestID        string `json:"request_id,omitempty"`
+	RequestCommentID string `json:"request_comment_id,omitempty"`
+
+	// Member is set on members events, starting with MemberVersion
+	Member *Member `json:"member,omitempty"`
+
+	// Hierarchy is set on hierarchies events, starting with HierarchyVersion
+	Hierarchy *Hierarchy `json:"hierarchy,omitempty"`
+
+	// MemberLimit is set on group member limit warnings
+	MemberLimit *MemberLimit `json:"member_limit,omitempty"`
+
+	// EmailVerification is set on user email verification events
+	EmailVerification *EmailVerification `json:"email_verification,omitempty"`
+
+	// Changes are set on user field change events, they map the changed
+	// fields the subscription registered interest in to their old and new values
+	Changes map[string]FieldChange `json:"changes,omitempty"`
+
 	// TraceContext is a map of values used for OpenTelemetry context propagation.
 	TraceContext map[string]string `json:"traceContext"`
 
 	// Headers is a map of headers to be passed along with the event.
 	Headers map[string][]string `json:"-"`
+
+	// Before and After are snapshots of the changed object. They aren't part of
+	// the v1alpha1 payload and are only carried over to the v2 events.
+	Before interface{} `json:"-"`
+	After  interface{} `json:"-"`
+}
+
+// Member describes the group membership a members event is about. On delete
+// events it is the membership as it was before being removed.
+type Member struct {
+	IsAdmin        bool       `json:"is_admin"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	AdminExpiresAt *time.Time `json:"admin_expires_at,omitempty"`
+	// Direct is false when the user is only a member through a subgroup
+	Direct bool `json:"direct"`
+	// Source is how the membership was added, one of the MembershipSource
+	// values, and SourceRef identifies what added it, like the approved request
+	Source    string `json:"source,omitempty"`
+	SourceRef string `json:"source_ref,omitempty"`
+}
+
+const (
+	// MembershipSourceDirect is a membership added directly by a group admin or an admin
+	MembershipSourceDirect = "direct"
+	// MembershipSourceRequest is a membership added by approving a membership request
+	MembershipSourceRequest = "request"
+	// MembershipSourceImport is a membership added by a bulk import
+	MembershipSourceImport = "import"
+	// MembershipSourceRule is a membership added by a dynamic membership rule
+	MembershipSourceRule = "rule"
+	// MembershipSourceBreakGlass is a membership a responder granted themselves
+	// with break-glass access, the source ref is the incident reference
+	MembershipSourceBreakGlass = "break_glass"
+	// MembershipSourceHierarchy is a membership inherited through a subgroup, it
+	// is never stored and only describes indirect memberships
+	MembershipSourceHierarchy = "hierarchy"
+)
+
+// Hierarchy describes the group hierarchy a hierarchies event is about, the
+// members of MemberGroupID are inherited by ParentGroupID. On delete events it
+// is the hierarchy as it was before being removed.
+type Hierarchy struct {
+	ParentGroupID string     `json:"parent_group_id"`
+	MemberGroupID string     `json:"member_group_id"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+}
+
+// MemberLimit describes a group exceeding its member soft limit, AdminIDs are
+// the user ids of the group admins the warning is meant for
+type MemberLimit struct {
+	Members   int64    `json:"members"`
+	SoftLimit int64    `json:"soft_limit"`
+	HardLimit int64    `json:"hard_limit,omitempty"`
+	AdminIDs  []string `json:"admin_ids"`
+}
+
+// EmailVerification describes a pending change of a user email, the token is
+// meant to be sent to the new address and confirms the change
+type EmailVerification struct {
+	ChangeID  string    `json:"change_id"`
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// FieldChange is the old and new value of a changed field
+type FieldChange struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
 }
@@ -0,0 +1,8 @@
+// Package events is the v2 version of the events api. Every event is an
+// Envelope carrying the payload type of its subject, so consumers decode the
+// payload they expect instead of one struct with every possible field.
+//
+// v2 events are published under the v2 token of the subject prefix, for
+// example events.v2.members, alongside the v1alpha1 events while they are
+// deprecated.
+package events
@@ -0,0 +1,165 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// SchemaVersion is the schema version of the v2 events
+	SchemaVersion = "v2"
+
+	// SubjectToken is the subject token the v2 events are published under,
+	// between the subject prefix and the subject
+	SubjectToken = "v2"
+
+	// MembersEventSubject is the subject name for members events (minus the subject prefix)
+	MembersEventSubject = "members"
+	// GroupsEventSubject is the subject name for groups events (minus the subject prefix)
+	GroupsEventSubject = "groups"
+	// UsersEventSubject is the subject name for user events (minus the subject prefix)
+	UsersEventSubject = "users"
+	// HierarchiesEventSubject is the subject name for group hierarchy events (minus the subject prefix)
+	HierarchiesEventSubject = "hierarchies"
+)
+
+var (
+	// ErrUnsupportedSchemaVersion is returned when decoding an event of another schema version
+	ErrUnsupportedSchemaVersion = errors.New("unsupported event schema version")
+)
+
+// Envelope is a v2 event, the payload type depends on the subject
+type Envelope[T any] struct {
+	SchemaVersion string    `json:"schema_version"`
+	Subject       string    `json:"subject"`
+	Action        string    `json:"action"`
+	Time          time.Time `json:"time"`
+	AuditID       string    `json:"audit_id,omitempty"`
+	ActorID       string    `json:"actor_id,omitempty"`
+	CorrelationID string    `json:"correlation_id,omitempty"`
+
+	// TraceContext is a map of values used for OpenTelemetry context propagation.
+	TraceContext map[string]string `json:"trace_context,omitempty"`
+
+	Payload T `json:"payload"`
+}
+
+// Decode decodes a v2 event with the payload type T
+func Decode[T any](data []byte) (*Envelope[T], error) {
+	env := Envelope[T]{}
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, err
+	}
+
+	if env.SchemaVersion != SchemaVersion {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedSchemaVersion, env.SchemaVersion)
+	}
+
+	return &env, nil
+}
+
+// MemberEvent is the payload of members events. Before is nil on create
+// events and After is nil on delete events.
+type MemberEvent struct {
+	GroupID string  `json:"group_id"`
+	UserID  string  `json:"user_id"`
+	Before  *Member `json:"before,omitempty"`
+	After   *Member `json:"after,omitempty"`
+}
+
+// Member is a snapshot of a group membership
+type Member struct {
+	IsAdmin        bool       `json:"is_admin"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	AdminExpiresAt *time.Time `json:"admin_expires_at,omitempty"`
+	// Direct is false when the user is only a member through a subgroup
+	Direct bool `json:"direct"`
+	// Source is how the membership was added, see the v1alpha1 MembershipSource values
+	Source    string `json:"source,omitempty"`
+	SourceRef string `json:"source_ref,omitempty"`
+}
+
+// GroupEvent is the payload of groups events. The snapshots are only set when
+// the publisher had them, Before is nil on create events and After on delete
+// events.
+type GroupEvent struct {
+	GroupID string `json:"group_id"`
+	Before  *Group `json:"before,omitempty"`
+	After   *Group `json:"after,omitempty"`
+}
+
+// Group is a snapshot of a group
+type Group struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Slug          string     `json:"slug"`
+	Description   string     `json:"description"`
+	Note          string     `json:"note"`
+	ApproverGroup string     `json:"approver_group,omitempty"`
+	OwnerContact  string     `json:"owner_contact,omitempty"`
+	DocsURL       string     `json:"docs_url,omitempty"`
+	SlackChannel  string     `json:"slack_channel,omitempty"`
+	CostCenter    string     `json:"cost_center,omitempty"`
+	FrozenAt      *time.Time `json:"frozen_at,omitempty"`
+	ProtectedAt   *time.Time `json:"protected_at,omitempty"`
+	BreakGlassAt  *time.Time `json:"break_glass_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+}
+
+// UserEvent is the payload of user events. The snapshots are only set when
+// the publisher had them, Before is nil on create events.
+type UserEvent struct {
+	UserID string `json:"user_id"`
+	Before *User  `json:"before,omitempty"`
+	After  *User  `json:"after,omitempty"`
+}
+
+// User is a snapshot of a user
+type User struct {
+	ID             string     `json:"id"`
+	ExternalID     string     `json:"external_id,omitempty"`
+	Name           string     `json:"name"`
+	Email          string     `json:"email"`
+	Status         string     `json:"status,omitempty"`
+	GithubID       *int64     `json:"github_id,omitempty"`
+	GithubUsername string     `json:"github_username,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
+}
+
+// HierarchyEvent is the payload of group hierarchy events, the members of the
+// member group are inherited by the parent group. Before is nil on create
+// events and After is nil on delete events.
+type HierarchyEvent struct {
+	ParentGroupID string     `json:"parent_group_id"`
+	MemberGroupID string     `json:"member_group_id"`
+	Before        *Hierarchy `json:"before,omitempty"`
+	After         *Hierarchy `json:"after,omitempty"`
+}
+
+// Hierarchy is a snapshot of a group hierarchy
+type Hierarchy struct {
+	ID        string     `json:"id"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// ExtensionResourceEvent is the payload of extension resource events, which
+// are published on the plural slug of their resource definition. The
+// snapshots are the resource bodies, Before is nil on create events and After
+// on delete events.
+type ExtensionResourceEvent struct {
+	ExtensionID                   string          `json:"extension_id"`
+	ExtensionResourceDefinitionID string          `json:"extension_resource_definition_id"`
+	ExtensionResourceID           string          `json:"extension_resource_id"`
+	ResourceVersion               string          `json:"resource_version"`
+	UserID                        string          `json:"user_id,omitempty"`
+	Before                        json.RawMessage `json:"before,omitempty"`
+	After                         json.RawMessage `json:"after,omitempty"`
+}
@@ -0,0 +1,42 @@
+package events
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	data, err := json.Marshal(&Envelope[MemberEvent]{
+		SchemaVersion: SchemaVersion,
+		Subject:       MembersEventSubject,
+		Action:        "CREATE",
+		Time:          now,
+		Payload: MemberEvent{
+			GroupID: "group-id",
+			UserID:  "user-id",
+			After:   &Member{IsAdmin: true, Direct: true},
+		},
+	})
+	require.NoError(t, err)
+
+	env, err := Decode[MemberEvent](data)
+	require.NoError(t, err)
+
+	assert.Equal(t, now, env.Time)
+	assert.Equal(t, "group-id", env.Payload.GroupID)
+	assert.Nil(t, env.Payload.Before)
+	require.NotNil(t, env.Payload.After)
+	assert.True(t, env.Payload.After.IsAdmin)
+
+	_, err = Decode[MemberEvent]([]byte(`{"version":"v1alpha1","action":"CREATE","group_id":"group-id"}`))
+	assert.ErrorIs(t, err, ErrUnsupportedSchemaVersion)
+
+	_, err = Decode[MemberEvent]([]byte(`{`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,23 @@
+// Package eventtest captures the events published on the event bus and the
+// audit events written by the api handlers, and compares them with golden
+// JSON fixtures. It is meant for tests only.
+//
+// A Recorder replaces the nats connection of the event bus client and the
+// audit event writer of the api:
+//
+//	rec := eventtest.NewRecorder()
+//
+//	router := &v1alpha1.Router{
+//		AuditMW:  rec.AuditMiddleware(),
+//		EventBus: rec.EventBus(),
+//		...
+//	}
+//
+// After a request, the recorded events are compared with a fixture:
+//
+//	eventtest.NewGolden("testdata").Assert(t, "create_group", rec.Snapshot())
+//
+// Fixtures are created or refreshed by running the tests with
+// GOVERNOR_UPDATE_GOLDEN=1. Volatile values, like timestamps and audit ids,
+// are replaced by a placeholder before comparing.
+package eventtest
@@ -0,0 +1,128 @@
+package eventtest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	events "github.com/metal-toolbox/governor-api/pkg/events/v1alpha1"
+)
+
+// fakeT records the failures of the golden assertions under test
+type fakeT struct {
+	testing.TB
+	failed bool
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(string, ...interface{}) { f.failed = true }
+
+func TestRecorder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	rec := NewRecorder()
+	bus := rec.EventBus()
+
+	r := gin.New()
+	r.POST("/groups", rec.AuditMiddleware().AuditWithType("CreateGroup"), func(c *gin.Context) {
+		require.NoError(t, bus.Publish(c.Request.Context(), events.GovernorGroupsEventSubject, &events.Event{
+			Version: events.Version,
+			Action:  events.GovernorEventCreate,
+			GroupID: "group-id",
+		}))
+
+		c.Status(http.StatusAccepted)
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/groups", nil))
+	require.Equal(t, http.StatusAccepted, w.Code)
+
+	snap := rec.Snapshot()
+	require.Len(t, snap.Events, 2)
+	assert.Equal(t, "events.groups", snap.Events[0].Subject)
+	assert.Equal(t, "events.v2.groups", snap.Events[1].Subject)
+	assert.Contains(t, string(snap.Events[0].Payload), `"group_id":"group-id"`)
+	require.Len(t, snap.Audit, 1)
+	assert.Contains(t, string(snap.Audit[0]), `"type":"CreateGroup"`)
+
+	rec.Reset()
+	assert.Empty(t, rec.Snapshot().Events)
+	assert.Empty(t, rec.Snapshot().Audit)
+}
+
+func TestGolden(t *testing.T) {
+	dir := t.TempDir()
+
+	rec := NewRecorder()
+	require.NoError(t, rec.EventBus().Publish(context.TODO(), events.GovernorMembersEventSubject, &events.Event{
+		Version: events.Version,
+		Action:  events.GovernorEventCreate,
+		AuditID: "random-audit-id",
+		GroupID: "group-id",
+		UserID:  "user-id",
+	}))
+
+	t.Setenv(UpdateEnv, "1")
+	assert.True(t, NewGolden(dir).Assert(t, "member", rec.Snapshot()))
+
+	fixture, err := os.ReadFile(filepath.Join(dir, "member.json"))
+	require.NoError(t, err)
+	assert.Contains(t, string(fixture), `"audit_id": "`+Scrubbed+`"`)
+	assert.NotContains(t, string(fixture), "random-audit-id")
+
+	t.Setenv(UpdateEnv, "")
+
+	// the audit id and time change on every run
+	rec.Reset()
+	require.NoError(t, rec.EventBus().Publish(context.TODO(), events.GovernorMembersEventSubject, &events.Event{
+		Version: events.Version,
+		Action:  events.GovernorEventCreate,
+		AuditID: "another-audit-id",
+		GroupID: "group-id",
+		UserID:  "user-id",
+	}))
+	assert.True(t, NewGolden(dir).Assert(t, "member", rec.Snapshot()))
+
+	// a renamed action or a missing field fails
+	rec.Reset()
+	require.NoError(t, rec.EventBus().Publish(context.TODO(), events.GovernorMembersEventSubject, &events.Event{
+		Version: events.Version,
+		Action:  events.GovernorEventUpdate,
+		GroupID: "group-id",
+	}))
+
+	ft := &fakeT{TB: t}
+	assert.False(t, NewGolden(dir).Assert(ft, "member", rec.Snapshot()))
+	assert.True(t, ft.failed)
+
+	ft = &fakeT{TB: t}
+	assert.False(t, NewGolden(dir).Assert(ft, "missing", rec.Snapshot()))
+	assert.True(t, ft.failed)
+}
+
+func TestGoldenNormalize(t *testing.T) {
+	g := NewGolden("", WithScrubbedKeys("id"))
+
+	got, err := g.Normalize(map[string]interface{}{
+		"id":         "random",
+		"name":       "kept",
+		"created_at": "",
+		"nested":     []interface{}{map[string]interface{}{"updated_at": "2026-01-01T00:00:00Z"}},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": "<scrubbed>",
+		"name": "kept",
+		"created_at": "",
+		"nested": [{"updated_at": "<scrubbed>"}]
+	}`, string(got))
+}
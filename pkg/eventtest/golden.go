@@ -0,0 +1,163 @@
+package eventtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	// UpdateEnv is the environment variable that makes Assert write the
+	// fixtures instead of comparing with them
+	UpdateEnv = "GOVERNOR_UPDATE_GOLDEN"
+
+	// Scrubbed replaces the volatile values in the fixtures
+	Scrubbed = "<scrubbed>"
+)
+
+// DefaultScrubbedKeys are the keys whose values change on every run
+var DefaultScrubbedKeys = []string{
+	"audit_id",
+	"auditId",
+	"created_at",
+	"deleted_at",
+	"loggedAt",
+	"time",
+	"trace_context",
+	"traceContext",
+	"updated_at",
+}
+
+// Golden compares values with the JSON fixtures of a directory
+type Golden struct {
+	dir    string
+	scrub  map[string]bool
+	update bool
+}
+
+// GoldenOption is a functional configuration option for Golden
+type GoldenOption func(g *Golden)
+
+// WithScrubbedKeys scrubs the values of more keys, wherever they are
+func WithScrubbedKeys(keys ...string) GoldenOption {
+	return func(g *Golden) {
+		for _, k := range keys {
+			g.scrub[k] = true
+		}
+	}
+}
+
+// NewGolden returns a Golden with the fixtures of dir
+func NewGolden(dir string, opts ...GoldenOption) *Golden {
+	g := &Golden{
+		dir:    dir,
+		scrub:  map[string]bool{},
+		update: os.Getenv(UpdateEnv) != "",
+	}
+
+	for _, k := range DefaultScrubbedKeys {
+		g.scrub[k] = true
+	}
+
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g
+}
+
+// Assert compares got, encoded as JSON, with the fixture name. The fixture is
+// written instead when the tests run with GOVERNOR_UPDATE_GOLDEN set.
+func (g *Golden) Assert(t testing.TB, name string, got interface{}) bool {
+	t.Helper()
+
+	encoded, err := g.Normalize(got)
+	if err != nil {
+		t.Errorf("error encoding %s: %s", name, err)
+		return false
+	}
+
+	path := filepath.Join(g.dir, name+".json")
+
+	if g.update {
+		if err := os.MkdirAll(g.dir, 0o755); err != nil {
+			t.Errorf("error creating fixtures directory: %s", err)
+			return false
+		}
+
+		if err := os.WriteFile(path, encoded, 0o600); err != nil {
+			t.Errorf("error writing fixture %s: %s", path, err)
+			return false
+		}
+
+		return true
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			t.Errorf("fixture %s doesn't exist, create it by running the test with %s=1", path, UpdateEnv)
+			return false
+		}
+
+		t.Errorf("error reading fixture %s: %s", path, err)
+
+		return false
+	}
+
+	return assert.JSONEq(t, string(want), string(encoded), "payloads differ from fixture %s", path)
+}
+
+// Normalize encodes v as indented JSON, with the values of the scrubbed keys
+// replaced. JSON payloads embedded as raw messages are decoded, so their keys
+// are scrubbed too.
+func (g *Golden) Normalize(v interface{}) ([]byte, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+
+	enc := json.NewEncoder(&out)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(g.scrubValue(decoded)); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
+func (g *Golden) scrubValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			// missing or empty values are kept, so fixtures still catch a
+			// field that disappeared
+			if g.scrub[k] && item != nil && item != "" {
+				val[k] = Scrubbed
+				continue
+			}
+
+			val[k] = g.scrubValue(item)
+		}
+	case []interface{}:
+		for i, item := range val {
+			val[i] = g.scrubValue(item)
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,145 @@
+package eventtest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"sync"
+
+	"github.com/metal-toolbox/auditevent/ginaudit"
+	"github.com/nats-io/nats.go"
+
+	"github.com/metal-toolbox/governor-api/internal/eventbus"
+)
+
+// Message is a message published on the event bus
+type Message struct {
+	Subject string          `json:"subject"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// NATSConn is a nats connection recording the published messages
+type NATSConn struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+// Publish records a message
+func (c *NATSConn) Publish(subject string, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.messages = append(c.messages, Message{Subject: subject, Payload: append(json.RawMessage{}, data...)})
+
+	return nil
+}
+
+// PublishMsg records a message, its headers are ignored
+func (c *NATSConn) PublishMsg(m *nats.Msg) error {
+	return c.Publish(m.Subject, m.Data)
+}
+
+// Drain is a no-op
+func (c *NATSConn) Drain() error { return nil }
+
+// Messages returns the messages published since the last reset
+func (c *NATSConn) Messages() []Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Message{}, c.messages...)
+}
+
+// Reset forgets the published messages
+func (c *NATSConn) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.messages = nil
+}
+
+// AuditWriter records the audit events written as JSON lines
+type AuditWriter struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+// Write records audit events
+func (w *AuditWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.buf.Write(p)
+}
+
+// Events returns the audit events written since the last reset
+func (w *AuditWriter) Events() []json.RawMessage {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	events := []json.RawMessage{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(w.buf.Bytes()))
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), len(w.buf.Bytes())+1)
+
+	for scanner.Scan() {
+		if line := bytes.TrimSpace(scanner.Bytes()); len(line) > 0 {
+			events = append(events, append(json.RawMessage{}, line...))
+		}
+	}
+
+	return events
+}
+
+// Reset forgets the audit events
+func (w *AuditWriter) Reset() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf.Reset()
+}
+
+// Recorder records the event bus messages and audit events of the handlers
+type Recorder struct {
+	Conn  *NATSConn
+	Audit *AuditWriter
+}
+
+// Snapshot is what a Recorder recorded, in the order it was published or
+// written
+type Snapshot struct {
+	Events []Message         `json:"events"`
+	Audit  []json.RawMessage `json:"audit"`
+}
+
+// NewRecorder returns an empty recorder
+func NewRecorder() *Recorder {
+	return &Recorder{
+		Conn:  &NATSConn{},
+		Audit: &AuditWriter{},
+	}
+}
+
+// EventBus returns an event bus client publishing to the recorder
+func (r *Recorder) EventBus(opts ...eventbus.Option) *eventbus.Client {
+	return eventbus.NewClient(append([]eventbus.Option{eventbus.WithNATSConn(r.Conn)}, opts...)...)
+}
+
+// AuditMiddleware returns an audit middleware writing to the recorder
+func (r *Recorder) AuditMiddleware() *ginaudit.Middleware {
+	return ginaudit.NewJSONMiddleware("governor-api", r.Audit)
+}
+
+// Snapshot returns the recorded messages and audit events
+func (r *Recorder) Snapshot() Snapshot {
+	return Snapshot{
+		Events: r.Conn.Messages(),
+		Audit:  r.Audit.Events(),
+	}
+}
+
+// Reset forgets the recorded messages and audit events, between test cases
+func (r *Recorder) Reset() {
+	r.Conn.Reset()
+	r.Audit.Reset()
+}
@@ -0,0 +1,204 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// rootProperty is the property name of the changes to the keywords of the
+// schema itself
+const rootProperty = "$"
+
+// SchemaDiff is the difference between two versions of a schema. Properties
+// are named with their dot separated path, nested object properties are
+// compared one by one.
+type SchemaDiff struct {
+	Added   []string         `json:"added"`
+	Removed []string         `json:"removed"`
+	Changed []PropertyChange `json:"changed"`
+}
+
+// PropertyChange is a property present in both schemas whose definition, or
+// requiredness, changed
+type PropertyChange struct {
+	Property string `json:"property"`
+	// Before and After are the keywords of the property, without its nested
+	// properties, they are only set when the keywords changed
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+	// Required is set when the property became required, or optional
+	Required *bool `json:"required,omitempty"`
+}
+
+// Diff returns the difference between two schemas
+func Diff(before, after []byte) (*SchemaDiff, error) {
+	b, a := map[string]interface{}{}, map[string]interface{}{}
+
+	if err := json.Unmarshal(before, &b); err != nil {
+		return nil, fmt.Errorf("decoding previous schema: %w", err)
+	}
+
+	if err := json.Unmarshal(after, &a); err != nil {
+		return nil, fmt.Errorf("decoding schema: %w", err)
+	}
+
+	d := &SchemaDiff{
+		Added:   []string{},
+		Removed: []string{},
+		Changed: []PropertyChange{},
+	}
+
+	diffSchema(d, "", b, a, false, false)
+
+	sort.Strings(d.Added)
+	sort.Strings(d.Removed)
+	sort.Slice(d.Changed, func(i, j int) bool { return d.Changed[i].Property < d.Changed[j].Property })
+
+	return d, nil
+}
+
+// Empty returns true when the schemas are the same
+func (d *SchemaDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Summary returns a one line summary of the diff, listing the property names
+func (d *SchemaDiff) Summary() string {
+	if d.Empty() {
+		return "no changes"
+	}
+
+	parts := []string{}
+
+	if len(d.Added) > 0 {
+		parts = append(parts, "added "+strings.Join(d.Added, ", "))
+	}
+
+	if len(d.Removed) > 0 {
+		parts = append(parts, "removed "+strings.Join(d.Removed, ", "))
+	}
+
+	if len(d.Changed) > 0 {
+		changed := make([]string, len(d.Changed))
+		for i, c := range d.Changed {
+			changed[i] = c.Property
+		}
+
+		parts = append(parts, "changed "+strings.Join(changed, ", "))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// diffSchema compares the keywords of two versions of a (sub)schema, then
+// recurses into their properties
+func diffSchema(d *SchemaDiff, path string, before, after map[string]interface{}, requiredBefore, requiredAfter bool) {
+	name := path
+	if name == "" {
+		name = rootProperty
+	}
+
+	change := PropertyChange{Property: name}
+	changed := false
+
+	if kb, ka := keywords(before), keywords(after); !reflect.DeepEqual(kb, ka) {
+		change.Before, _ = json.Marshal(kb)
+		change.After, _ = json.Marshal(ka)
+		changed = true
+	}
+
+	if requiredBefore != requiredAfter {
+		change.Required = &requiredAfter
+		changed = true
+	}
+
+	if changed {
+		d.Changed = append(d.Changed, change)
+	}
+
+	pb, pa := properties(before), properties(after)
+	rb, ra := required(before), required(after)
+
+	for p, sb := range pb {
+		sa, ok := pa[p]
+		if !ok {
+			d.Removed = append(d.Removed, join(path, p))
+			continue
+		}
+
+		diffSchema(d, join(path, p), sb, sa, rb[p], ra[p])
+	}
+
+	for p := range pa {
+		if _, ok := pb[p]; !ok {
+			d.Added = append(d.Added, join(path, p))
+		}
+	}
+}
+
+// keywords returns the keywords of a schema, without the ones describing its
+// properties which are compared separately
+func keywords(s map[string]interface{}) map[string]interface{} {
+	k := make(map[string]interface{}, len(s))
+
+	for key, v := range s {
+		if key == "properties" || key == "required" {
+			continue
+		}
+
+		k[key] = v
+	}
+
+	return k
+}
+
+// properties returns the property schemas of a schema, properties defined
+// with a boolean schema are treated as empty schemas
+func properties(s map[string]interface{}) map[string]map[string]interface{} {
+	props := map[string]map[string]interface{}{}
+
+	p, ok := s["properties"].(map[string]interface{})
+	if !ok {
+		return props
+	}
+
+	for name, v := range p {
+		ps, ok := v.(map[string]interface{})
+		if !ok {
+			ps = map[string]interface{}{"$bool": v}
+		}
+
+		props[name] = ps
+	}
+
+	return props
+}
+
+// required returns the set of required properties of a schema
+func required(s map[string]interface{}) map[string]bool {
+	req := map[string]bool{}
+
+	list, ok := s["required"].([]interface{})
+	if !ok {
+		return req
+	}
+
+	for _, v := range list {
+		if name, ok := v.(string); ok {
+			req[name] = true
+		}
+	}
+
+	return req
+}
+
+func join(path, property string) string {
+	if path == "" {
+		return property
+	}
+
+	return path + "." + property
+}
@@ -0,0 +1,91 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	before := `{
+		"title": "widget",
+		"type": "object",
+		"unique": ["name"],
+		"required": ["name", "size"],
+		"properties": {
+			"name": {"type": "string"},
+			"size": {"type": "integer"},
+			"color": {"type": "string"},
+			"spec": {
+				"type": "object",
+				"properties": {
+					"replicas": {"type": "integer", "minimum": 1},
+					"zone": {"type": "string"}
+				}
+			}
+		}
+	}`
+
+	after := `{
+		"title": "widget",
+		"type": "object",
+		"unique": ["name"],
+		"required": ["name"],
+		"properties": {
+			"name": {"type": "string"},
+			"size": {"type": "number"},
+			"owner": {"type": "string"},
+			"spec": {
+				"type": "object",
+				"properties": {
+					"replicas": {"type": "integer", "minimum": 0},
+					"region": {"type": "string"}
+				}
+			}
+		}
+	}`
+
+	d, err := Diff([]byte(before), []byte(after))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"owner", "spec.region"}, d.Added)
+	assert.Equal(t, []string{"color", "spec.zone"}, d.Removed)
+
+	require.Len(t, d.Changed, 2)
+
+	optional := false
+
+	assert.Equal(t, "size", d.Changed[0].Property)
+	assert.JSONEq(t, `{"type": "integer"}`, string(d.Changed[0].Before))
+	assert.JSONEq(t, `{"type": "number"}`, string(d.Changed[0].After))
+	assert.Equal(t, &optional, d.Changed[0].Required)
+
+	assert.Equal(t, "spec.replicas", d.Changed[1].Property)
+	assert.JSONEq(t, `{"type": "integer", "minimum": 0}`, string(d.Changed[1].After))
+	assert.Nil(t, d.Changed[1].Required)
+
+	assert.Equal(t, "added owner, spec.region; removed color, spec.zone; changed size, spec.replicas", d.Summary())
+
+	out, err := json.Marshal(d)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `"required":false`)
+}
+
+func TestDiffRoot(t *testing.T) {
+	d, err := Diff([]byte(`{"type": "object", "unique": ["a"]}`), []byte(`{"type": "object"}`))
+	require.NoError(t, err)
+
+	require.Len(t, d.Changed, 1)
+	assert.Equal(t, rootProperty, d.Changed[0].Property)
+
+	d, err = Diff([]byte(`{"type": "object"}`), []byte(`{"type": "object"}`))
+	require.NoError(t, err)
+
+	assert.True(t, d.Empty())
+	assert.Equal(t, "no changes", d.Summary())
+
+	_, err = Diff([]byte(`{`), []byte(`{}`))
+	assert.Error(t, err)
+}
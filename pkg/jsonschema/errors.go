@@ -10,4 +10,8 @@ var (
 	// ErrUniqueConstraintViolation is returned when an object violates the unique
 	// constrain
 	ErrUniqueConstraintViolation = errors.New("unique constraint violation")
+
+	// ErrInvalidIndexHint is returned when the schema's x-governor-index
+	// property is invalid
+	ErrInvalidIndexHint = errors.New(`property "x-governor-index" is invalid`)
 )
@@ -0,0 +1,59 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// IndexHintKeyword is the ERD schema keyword listing the properties the
+// resources of the ERD are indexed on, like "x-governor-index": ["lastName"]
+const IndexHintKeyword = "x-governor-index"
+
+// IndexHints returns the properties an ERD schema declares in its
+// x-governor-index keyword. They must be top level properties of the schema,
+// the ones the resource listings filter on.
+func IndexHints(schema []byte) ([]string, error) {
+	s := map[string]interface{}{}
+	if err := json.Unmarshal(schema, &s); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidIndexHint, err.Error())
+	}
+
+	return indexHints(s)
+}
+
+// indexHints returns the index hints of a decoded schema
+func indexHints(s map[string]interface{}) ([]string, error) {
+	hint, ok := s[IndexHintKeyword]
+	if !ok {
+		return nil, nil
+	}
+
+	values, ok := hint.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: %s must be an array of property names", ErrInvalidIndexHint, IndexHintKeyword)
+	}
+
+	properties, _ := s["properties"].(map[string]interface{})
+	fields := make([]string, len(values))
+	seen := map[string]bool{}
+
+	for i, v := range values {
+		f, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s must be an array of property names", ErrInvalidIndexHint, IndexHintKeyword)
+		}
+
+		if _, ok := properties[f]; !ok {
+			return nil, fmt.Errorf("%w: %q is not a property of the schema", ErrInvalidIndexHint, f)
+		}
+
+		if seen[f] {
+			return nil, fmt.Errorf("%w: %q is listed more than once", ErrInvalidIndexHint, f)
+		}
+
+		seen[f] = true
+		fields[i] = f
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,64 @@
+package jsonschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexHints(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "no hints",
+			schema: `{"properties": {"lastName": {"type": "string"}}}`,
+		},
+		{
+			name:   "hints",
+			schema: `{"x-governor-index": ["lastName", "serial"], "properties": {"lastName": {"type": "string"}, "serial": {"type": "string"}}}`,
+			want:   []string{"lastName", "serial"},
+		},
+		{
+			name:    "not an array",
+			schema:  `{"x-governor-index": "lastName", "properties": {"lastName": {"type": "string"}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "not a string",
+			schema:  `{"x-governor-index": [1], "properties": {"lastName": {"type": "string"}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown property",
+			schema:  `{"x-governor-index": ["firstName"], "properties": {"lastName": {"type": "string"}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate property",
+			schema:  `{"x-governor-index": ["lastName", "lastName"], "properties": {"lastName": {"type": "string"}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid schema",
+			schema:  `{"x-governor-index": [`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IndexHints([]byte(tt.schema))
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidIndexHint)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
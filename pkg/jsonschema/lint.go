@@ -0,0 +1,266 @@
+package jsonschema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/metal-toolbox/governor-api/internal/models"
+)
+
+const (
+	// LintError is an issue that makes the schema unusable for an ERD
+	LintError = "error"
+	// LintWarning is an issue the schema works with, but probably shouldn't
+	LintWarning = "warning"
+)
+
+// supportedDrafts are the $schema values accepted in ERD schemas, schemas
+// without $schema use the latest draft
+var supportedDrafts = map[string]bool{
+	"http://json-schema.org/draft-07/schema":       true,
+	"https://json-schema.org/draft/2019-09/schema": true,
+	"https://json-schema.org/draft/2020-12/schema": true,
+}
+
+// DefaultDraft is the draft of the ERD schemas without $schema
+const DefaultDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// SupportedDrafts returns the $schema values accepted in ERD schemas, sorted
+func SupportedDrafts() []string {
+	drafts := make([]string, 0, len(supportedDrafts))
+	for d := range supportedDrafts {
+		drafts = append(drafts, d)
+	}
+
+	sort.Strings(drafts)
+
+	return drafts
+}
+
+// uiHints are the annotation keywords the ui renders resources with, and
+// their expected json type
+var uiHints = map[string]string{
+	"title":       "string",
+	"description": "string",
+	"examples":    "array",
+	"readOnly":    "boolean",
+	"writeOnly":   "boolean",
+	"deprecated":  "boolean",
+}
+
+// dataKeywords are the keywords whose values are instances rather than
+// subschemas, they aren't linted
+var dataKeywords = map[string]bool{
+	"const":    true,
+	"default":  true,
+	"enum":     true,
+	"examples": true,
+	"required": true,
+	"unique":   true,
+
+	IndexHintKeyword: true,
+}
+
+// LintIssue is a problem found in a schema. Path is the dot separated path
+// of the property, $ for the schema itself.
+type LintIssue struct {
+	Severity string `json:"severity"`
+	Path     string `json:"path"`
+	Keyword  string `json:"keyword,omitempty"`
+	Message  string `json:"message"`
+}
+
+// LintResult is the list of issues found in a schema
+type LintResult struct {
+	Issues []LintIssue `json:"issues"`
+}
+
+// Valid returns true when the schema has no errors, it may have warnings
+func (r *LintResult) Valid() bool {
+	for _, i := range r.Issues {
+		if i.Severity == LintError {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *LintResult) add(severity, path, keyword, format string, args ...interface{}) {
+	r.Issues = append(r.Issues, LintIssue{
+		Severity: severity,
+		Path:     path,
+		Keyword:  keyword,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Lint checks a proposed ERD schema for governor's requirements: a supported
+// draft, an object at the root, a valid unique constraint and index hints, well formed ui
+// hints and no keyword pulling in schemas from elsewhere. The schema is only
+// compiled when those checks pass.
+func Lint(schema []byte) *LintResult {
+	res := &LintResult{Issues: []LintIssue{}}
+
+	root := map[string]interface{}{}
+	if err := json.Unmarshal(schema, &root); err != nil {
+		res.add(LintError, rootProperty, "", "schema is not a json object: %s", err)
+		return res
+	}
+
+	lintRoot(res, root)
+	lintSchema(res, "", root, false)
+
+	sort.Slice(res.Issues, func(i, j int) bool {
+		a, b := res.Issues[i], res.Issues[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+
+		return a.Keyword < b.Keyword
+	})
+
+	if !res.Valid() {
+		return res
+	}
+
+	compiler := NewCompiler(
+		"lint", "lint", "v1",
+		WithUniqueConstraint(context.Background(), &models.ExtensionResourceDefinition{}, nil, nil),
+	)
+
+	if _, err := compiler.Compile(string(schema)); err != nil {
+		res.add(LintError, rootProperty, "", "schema doesn't compile: %s", err)
+	}
+
+	return res
+}
+
+// lintRoot checks the keywords only allowed, or required, at the root
+func lintRoot(res *LintResult, root map[string]interface{}) {
+	switch draft, ok := root["$schema"]; {
+	case !ok:
+		res.add(LintWarning, rootProperty, "$schema", "no $schema, the latest draft (2020-12) is used")
+	case !isString(draft) || !supportedDrafts[strings.TrimSuffix(draft.(string), "#")]:
+		res.add(LintError, rootProperty, "$schema", "unsupported draft %v, use draft-07, 2019-09 or 2020-12", draft)
+	}
+
+	if root["type"] != "object" {
+		res.add(LintError, rootProperty, "type", `resources are objects, the schema type must be "object"`)
+	}
+
+	if _, ok := root["unique"]; ok {
+		if _, err := (&UniqueConstraintCompiler{}).Compile(jsonschema.CompilerContext{}, root); err != nil {
+			res.add(LintError, rootProperty, "unique", "%s", err)
+		}
+	}
+
+	if _, ok := root[IndexHintKeyword]; ok {
+		if _, err := indexHints(root); err != nil {
+			res.add(LintError, rootProperty, IndexHintKeyword, "%s", err)
+		}
+	}
+}
+
+// lintSchema checks a schema and its subschemas, path is the path of the
+// property the schema describes
+func lintSchema(res *LintResult, path string, s map[string]interface{}, property bool) {
+	at := path
+	if at == "" {
+		at = rootProperty
+	}
+
+	for keyword, v := range s {
+		switch keyword {
+		case "$id":
+			res.add(LintError, at, keyword, "$id isn't allowed, governor sets the schema id")
+		case "$ref":
+			if ref, ok := v.(string); !ok || !strings.HasPrefix(ref, "#") {
+				res.add(LintError, at, keyword, "only local references are allowed, %v isn't in the schema", v)
+			}
+		case "$dynamicRef", "$recursiveRef":
+			res.add(LintError, at, keyword, "%s isn't allowed, use a local $ref", keyword)
+		case "unique", IndexHintKeyword:
+			if path != "" {
+				res.add(LintError, at, keyword, "%s is only supported at the root of the schema", keyword)
+			}
+		}
+
+		if want, ok := uiHints[keyword]; ok && jsonType(v) != want {
+			res.add(LintError, at, keyword, "ui hint %s must be a %s", keyword, want)
+		}
+	}
+
+	if property && !isString(s["title"]) && !isString(s["description"]) {
+		res.add(LintWarning, at, "", "no title or description, the ui only shows the property name")
+	}
+
+	for keyword, v := range s {
+		if dataKeywords[keyword] {
+			continue
+		}
+
+		switch keyword {
+		case "properties":
+			props, _ := v.(map[string]interface{})
+			for name, p := range props {
+				if ps, ok := p.(map[string]interface{}); ok {
+					lintSchema(res, join(path, name), ps, true)
+				}
+			}
+		case "patternProperties", "$defs", "definitions", "dependentSchemas":
+			subs, _ := v.(map[string]interface{})
+			for name, sub := range subs {
+				if ss, ok := sub.(map[string]interface{}); ok {
+					lintSchema(res, join(path, keyword+"."+name), ss, false)
+				}
+			}
+		default:
+			lintSubschemas(res, join(path, keyword), v)
+		}
+	}
+}
+
+// lintSubschemas lints the schemas found in the value of a keyword
+func lintSubschemas(res *LintResult, path string, v interface{}) {
+	switch sub := v.(type) {
+	case map[string]interface{}:
+		lintSchema(res, path, sub, false)
+	case []interface{}:
+		for _, item := range sub {
+			if ss, ok := item.(map[string]interface{}); ok {
+				lintSchema(res, path, ss, false)
+			}
+		}
+	}
+}
+
+func isString(v interface{}) bool {
+	_, ok := v.(string)
+	return ok
+}
+
+// jsonType returns the json type of a decoded json value
+func jsonType(v interface{}) string {
+	switch v.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	case float64:
+		return "number"
+	case nil:
+		return "null"
+	}
+
+	return fmt.Sprintf("%T", v)
+}
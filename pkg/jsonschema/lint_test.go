@@ -0,0 +1,92 @@
+package jsonschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name      string
+		schema    string
+		wantValid bool
+		want      []LintIssue
+	}{
+		{
+			name: "valid",
+			schema: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"type": "object",
+				"unique": ["name"],
+				"x-governor-index": ["name"],
+				"required": ["name"],
+				"properties": {
+					"name": {"type": "string", "title": "Name"},
+					"tags": {"type": "array", "description": "Tags", "items": {"$ref": "#/$defs/tag"}}
+				},
+				"$defs": {"tag": {"type": "string"}}
+			}`,
+			wantValid: true,
+			want:      []LintIssue{},
+		},
+		{
+			name:      "warnings",
+			schema:    `{"type": "object", "properties": {"name": {"type": "string"}}}`,
+			wantValid: true,
+			want: []LintIssue{
+				{Severity: LintWarning, Path: "$", Keyword: "$schema", Message: "no $schema, the latest draft (2020-12) is used"},
+				{Severity: LintWarning, Path: "name", Message: "no title or description, the ui only shows the property name"},
+			},
+		},
+		{
+			name: "errors",
+			schema: `{
+				"$schema": "http://json-schema.org/draft-04/schema#",
+				"type": "array",
+				"properties": {
+					"spec": {
+						"title": 1,
+						"description": "spec",
+						"unique": ["x"],
+						"$ref": "https://example.com/schema.json"
+					}
+				}
+			}`,
+			wantValid: false,
+			want: []LintIssue{
+				{Severity: LintError, Path: "$", Keyword: "$schema", Message: "unsupported draft http://json-schema.org/draft-04/schema#, use draft-07, 2019-09 or 2020-12"},
+				{Severity: LintError, Path: "$", Keyword: "type", Message: `resources are objects, the schema type must be "object"`},
+				{Severity: LintError, Path: "spec", Keyword: "$ref", Message: "only local references are allowed, https://example.com/schema.json isn't in the schema"},
+				{Severity: LintError, Path: "spec", Keyword: "title", Message: "ui hint title must be a string"},
+				{Severity: LintError, Path: "spec", Keyword: "unique", Message: "unique is only supported at the root of the schema"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := Lint([]byte(tt.schema))
+
+			assert.Equal(t, tt.wantValid, res.Valid())
+			assert.Equal(t, tt.want, res.Issues)
+		})
+	}
+}
+
+func TestLintUniqueAndCompile(t *testing.T) {
+	res := Lint([]byte(`{"$schema": "https://json-schema.org/draft/2020-12/schema", "type": "object", "unique": ["name"], "properties": {"name": {"type": "string", "title": "Name"}}}`))
+	assert.False(t, res.Valid())
+	assert.Equal(t, "unique", res.Issues[0].Keyword)
+
+	res = Lint([]byte(`{"$schema": "https://json-schema.org/draft/2020-12/schema", "type": "object", "minProperties": "one"}`))
+	assert.False(t, res.Valid())
+	assert.Contains(t, res.Issues[0].Message, "schema doesn't compile")
+
+	res = Lint([]byte(`{"$schema": "https://json-schema.org/draft/2020-12/schema", "type": "object", "x-governor-index": ["serial"], "properties": {"name": {"type": "string", "title": "Name"}}}`))
+	assert.False(t, res.Valid())
+	assert.Equal(t, IndexHintKeyword, res.Issues[0].Keyword)
+
+	res = Lint([]byte(`[]`))
+	assert.False(t, res.Valid())
+}